@@ -0,0 +1,53 @@
+package work
+
+import (
+	"math/rand"
+	"sync"
+)
+
+// The named backoffs of WithBackoff.
+var (
+	backoffsMtx sync.RWMutex
+	backoffs    = map[string]BackoffCalculator{
+		"default":     defaultBackoffCalculator,
+		"exponential": exponentialBackoffCalculator,
+		"linear":      linearBackoffCalculator,
+		"constant":    constantBackoffCalculator,
+	}
+)
+
+// RegisterBackoff adds a named backoff that jobs can be enqueued with, see WithBackoff.
+// It must be registered in the processes that run the jobs, before they fail.
+func RegisterBackoff(name string, backoff BackoffCalculator) {
+	backoffsMtx.Lock()
+	defer backoffsMtx.Unlock()
+	backoffs[name] = backoff
+}
+
+func lookupBackoff(name string) (BackoffCalculator, bool) {
+	backoffsMtx.RLock()
+	defer backoffsMtx.RUnlock()
+	backoff, ok := backoffs[name]
+	return backoff, ok
+}
+
+// exponentialBackoffCalculator doubles the wait after each failure, starting from 15 seconds, plus up to 15 seconds of jitter.
+func exponentialBackoffCalculator(job *Job) int64 {
+	shift := job.Fails - 1
+	if shift < 0 {
+		shift = 0
+	} else if shift > 16 {
+		shift = 16
+	}
+	return 15<<uint(shift) + rand.Int63n(15)
+}
+
+// linearBackoffCalculator waits 30 more seconds after each failure.
+func linearBackoffCalculator(job *Job) int64 {
+	return 30 * job.Fails
+}
+
+// constantBackoffCalculator always waits 30 seconds.
+func constantBackoffCalculator(job *Job) int64 {
+	return 30
+}
@@ -0,0 +1,33 @@
+package work
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNamedBackoffs(t *testing.T) {
+	for fails := int64(1); fails <= 3; fails++ {
+		b := exponentialBackoffCalculator(&Job{Fails: fails})
+		min := int64(15) << uint(fails-1)
+		assert.True(t, b >= min && b < min+15)
+	}
+	assert.True(t, exponentialBackoffCalculator(&Job{Fails: 100}) < 15<<16+15)
+	assert.EqualValues(t, 90, linearBackoffCalculator(&Job{Fails: 3}))
+	assert.EqualValues(t, 30, constantBackoffCalculator(&Job{Fails: 3}))
+
+	_, ok := lookupBackoff("nope")
+	assert.False(t, ok)
+	RegisterBackoff("nope", func(job *Job) int64 { return 7 })
+	defer func() {
+		backoffsMtx.Lock()
+		delete(backoffs, "nope")
+		backoffsMtx.Unlock()
+	}()
+	b, ok := lookupBackoff("nope")
+	if assert.True(t, ok) {
+		assert.EqualValues(t, 7, b(&Job{}))
+	}
+	_, ok = lookupBackoff("exponential")
+	assert.True(t, ok)
+}
@@ -0,0 +1,240 @@
+package work
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/garyburd/redigo/redis"
+)
+
+// ErrBatchCommitted is returned when enqueueing jobs in a batch that was already committed.
+var ErrBatchCommitted = fmt.Errorf("batch already committed")
+
+// ErrBatchNotFound is returned by Client.BatchStatus when there's no such batch, or it expired after completing.
+var ErrBatchNotFound = fmt.Errorf("batch not found")
+
+// BatchIDArg is the argument that holds the batch ID in a batch's callback jobs.
+const BatchIDArg = "batch_id"
+
+// batchRetention is how long a batch is kept in Redis after it completes, in seconds.
+const batchRetention = 7 * 24 * 60 * 60
+
+// Batch groups jobs so that callback jobs can be enqueued once all of them have finished.
+// A job is finished when it succeeds, or when it fails and won't be retried anymore.
+// Create one with Enqueuer.NewBatch, enqueue jobs into it, then call Commit. The batch can't complete before it's committed.
+type Batch struct {
+	ID string
+
+	enqueuer   *Enqueuer
+	onComplete *Job
+	onSuccess  *Job
+	committed  bool
+	mtx        sync.Mutex
+}
+
+// BatchStatus represents the progress of a batch.
+type BatchStatus struct {
+	ID          string `json:"id"`
+	CreatedAt   int64  `json:"created_at"`
+	CompletedAt int64  `json:"completed_at"` // 0 until the batch is committed and all of its jobs finished
+	Committed   bool   `json:"committed"`
+	Total       int64  `json:"total"`
+	Pending     int64  `json:"pending"`
+	Succeeded   int64  `json:"succeeded"`
+	Failed      int64  `json:"failed"`
+}
+
+// NewBatch creates a new, empty batch. Nothing is written to Redis until jobs are enqueued in it or it's committed.
+func (e *Enqueuer) NewBatch() *Batch {
+	return &Batch{
+		ID:       makeIdentifier(),
+		enqueuer: e,
+	}
+}
+
+// OnComplete sets the job that is enqueued once all the jobs of the batch have finished, whether they succeeded or not.
+// The batch ID is added to its arguments as BatchIDArg. It must be called before Commit.
+func (b *Batch) OnComplete(jobName string, args map[string]interface{}) *Batch {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	b.onComplete = b.callbackJob(jobName, args)
+	return b
+}
+
+// OnSuccess sets the job that is enqueued once all the jobs of the batch have succeeded. It isn't enqueued if any of them failed for good.
+// The batch ID is added to its arguments as BatchIDArg. It must be called before Commit.
+func (b *Batch) OnSuccess(jobName string, args map[string]interface{}) *Batch {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	b.onSuccess = b.callbackJob(jobName, args)
+	return b
+}
+
+func (b *Batch) callbackJob(jobName string, args map[string]interface{}) *Job {
+	job := &Job{
+		Name: jobName,
+		ID:   makeIdentifier(),
+		Args: make(map[string]interface{}, len(args)+1),
+	}
+	for k, v := range args {
+		job.Args[k] = v
+	}
+	job.Args[BatchIDArg] = b.ID
+	return job
+}
+
+// Enqueue enqueues a job as part of the batch. It returns ErrBatchCommitted if the batch was already committed.
+func (b *Batch) Enqueue(jobName string, args map[string]interface{}) (*Job, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	if b.committed {
+		return nil, ErrBatchCommitted
+	}
+
+	e := b.enqueuer
+	job := &Job{
+		Name:       jobName,
+		ID:         makeIdentifier(),
+		EnqueuedAt: nowEpochSeconds(),
+		Args:       args,
+		BatchID:    b.ID,
+	}
+
+	rawJSON, err := job.serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	conn := e.Pool.Get()
+	defer conn.Close()
+
+	batchKey := redisKeyBatch(e.Namespace, b.ID)
+	conn.Send("MULTI")
+	conn.Send("HSETNX", batchKey, "created_at", job.EnqueuedAt)
+	conn.Send("ZADD", redisKeyBatches(e.Namespace), "NX", job.EnqueuedAt, b.ID)
+	conn.Send("HINCRBY", batchKey, "total", 1)
+	conn.Send("HINCRBY", batchKey, "pending", 1)
+	conn.Send("LPUSH", e.queuePrefix+jobName, rawJSON)
+	if _, err := conn.Do("EXEC"); err != nil {
+		return nil, err
+	}
+
+	if err := e.addToKnownJobs(conn, jobName); err != nil {
+		return job, err
+	}
+
+	return job, nil
+}
+
+// Commit marks the batch as complete: no more jobs can be enqueued in it, and its callback jobs are enqueued once all of its jobs have finished.
+// If they all finished already, the callbacks are enqueued right away.
+func (b *Batch) Commit() error {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	if b.committed {
+		return ErrBatchCommitted
+	}
+
+	var onComplete, onSuccess []byte
+	var err error
+	if b.onComplete != nil {
+		if onComplete, err = b.onComplete.serialize(); err != nil {
+			return err
+		}
+	}
+	if b.onSuccess != nil {
+		if onSuccess, err = b.onSuccess.serialize(); err != nil {
+			return err
+		}
+	}
+
+	e := b.enqueuer
+	conn := e.Pool.Get()
+	defer conn.Close()
+
+	now := nowEpochSeconds()
+	if _, err := conn.Do("ZADD", redisKeyBatches(e.Namespace), "NX", now, b.ID); err != nil {
+		return err
+	}
+
+	script := redis.NewScript(3, redisLuaCommitBatch)
+	_, err = script.Do(conn,
+		redisKeyBatch(e.Namespace, b.ID),     // KEYS[1]
+		redisKeyBatchDone(e.Namespace, b.ID), // KEYS[2]
+		redisKeyKnownJobs(e.Namespace),       // KEYS[3]
+		redisKeyJobsPrefix(e.Namespace),      // ARGV[1]
+		now,                                  // ARGV[2]
+		batchRetention,                       // ARGV[3]
+		onComplete,                           // ARGV[4]
+		onSuccess,                            // ARGV[5]
+	)
+	if err != nil {
+		return err
+	}
+
+	b.committed = true
+	return nil
+}
+
+// markBatchJobDone counts a finished job against its batch, enqueueing the batch's callbacks if it was the last one.
+func markBatchJobDone(namespace string, pool *redis.Pool, logger Logger, job *Job, succeeded bool) {
+	if job.BatchID == "" {
+		return
+	}
+
+	outcome := "failed"
+	if succeeded {
+		outcome = "succeeded"
+	}
+
+	conn := pool.Get()
+	defer conn.Close()
+
+	script := redis.NewScript(3, redisLuaBatchJobDone)
+	_, err := script.Do(conn,
+		redisKeyBatch(namespace, job.BatchID),     // KEYS[1]
+		redisKeyBatchDone(namespace, job.BatchID), // KEYS[2]
+		redisKeyKnownJobs(namespace),              // KEYS[3]
+		redisKeyJobsPrefix(namespace),             // ARGV[1]
+		nowEpochSeconds(),                         // ARGV[2]
+		batchRetention,                            // ARGV[3]
+		job.ID,                                    // ARGV[4]
+		outcome,                                   // ARGV[5]
+	)
+	if err != nil && err != redis.ErrNil {
+		logError(logger, "batch.job_done", err, "job_name", job.Name, "job_id", job.ID)
+	}
+}
+
+func parseBatchStatus(batchID string, vals []string) (*BatchStatus, error) {
+	status := &BatchStatus{ID: batchID}
+
+	for i := 0; i < len(vals)-1; i += 2 {
+		key := vals[i]
+		value := vals[i+1]
+
+		var err error
+		switch key {
+		case "created_at":
+			status.CreatedAt, err = strconv.ParseInt(value, 10, 64)
+		case "completed_at":
+			status.CompletedAt, err = strconv.ParseInt(value, 10, 64)
+		case "committed":
+			status.Committed = value == "1"
+		case "total":
+			status.Total, err = strconv.ParseInt(value, 10, 64)
+		case "pending":
+			status.Pending, err = strconv.ParseInt(value, 10, 64)
+		case "succeeded":
+			status.Succeeded, err = strconv.ParseInt(value, 10, 64)
+		case "failed":
+			status.Failed, err = strconv.ParseInt(value, 10, 64)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return status, nil
+}
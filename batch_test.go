@@ -0,0 +1,182 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchCallbacks(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	batch := enqueuer.NewBatch().
+		OnComplete("batch_done", Q{"a": 1}).
+		OnSuccess("batch_ok", nil)
+
+	for i := 0; i < 3; i++ {
+		job, err := batch.Enqueue("export", Q{"i": i})
+		assert.NoError(t, err)
+		assert.Equal(t, batch.ID, job.BatchID)
+	}
+	assert.NoError(t, batch.Commit())
+
+	_, err := batch.Enqueue("export", nil)
+	assert.Equal(t, ErrBatchCommitted, err)
+
+	client := NewClient(ns, pool)
+	status, err := client.BatchStatus(batch.ID)
+	assert.NoError(t, err)
+	assert.True(t, status.Committed)
+	assert.EqualValues(t, 3, status.Total)
+	assert.EqualValues(t, 3, status.Pending)
+	assert.EqualValues(t, 0, status.CompletedAt)
+
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.Job("export", func(job *Job) error {
+		return nil
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	status, err = client.BatchStatus(batch.ID)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, status.Pending)
+	assert.EqualValues(t, 3, status.Succeeded)
+	assert.EqualValues(t, 0, status.Failed)
+	assert.True(t, status.CompletedAt > 0)
+
+	onComplete := jobOnQueue(pool, redisKeyJobs(ns, "batch_done"))
+	assert.EqualValues(t, 1, onComplete.ArgInt64("a"))
+	assert.Equal(t, batch.ID, onComplete.ArgString(BatchIDArg))
+	onSuccess := jobOnQueue(pool, redisKeyJobs(ns, "batch_ok"))
+	assert.Equal(t, batch.ID, onSuccess.ArgString(BatchIDArg))
+	assert.Contains(t, knownJobs(pool, redisKeyKnownJobs(ns)), "batch_ok")
+
+	batches, count, err := client.Batches(1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+	assert.Equal(t, 1, len(batches))
+	assert.Equal(t, batch.ID, batches[0].ID)
+}
+
+func TestBatchFailedJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	batch := enqueuer.NewBatch().
+		OnComplete("batch_done", nil).
+		OnSuccess("batch_ok", nil)
+
+	_, err := batch.Enqueue("export", Q{"fail": false})
+	assert.NoError(t, err)
+	_, err = batch.Enqueue("export", Q{"fail": true})
+	assert.NoError(t, err)
+
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.JobWithOptions("export", JobOptions{MaxFails: 1}, func(job *Job) error {
+		if job.ArgBool("fail") {
+			return fmt.Errorf("sorry kid")
+		}
+		return nil
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	// Nothing happens until the batch is committed, even though all of its jobs finished
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "batch_done")))
+	assert.NoError(t, batch.Commit())
+
+	client := NewClient(ns, pool)
+	status, err := client.BatchStatus(batch.ID)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, status.Succeeded)
+	assert.EqualValues(t, 1, status.Failed)
+	assert.True(t, status.CompletedAt > 0)
+
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "batch_done")))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "batch_ok")))
+
+	// A dead job that's retried and fails again isn't counted twice
+	assert.NoError(t, client.RetryAllDeadJobs())
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	status, err = client.BatchStatus(batch.ID)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, status.Failed)
+	assert.EqualValues(t, 0, status.Pending)
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "batch_done")))
+}
+
+func TestBatchEmptyCommit(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	batch := enqueuer.NewBatch().OnSuccess("batch_ok", nil)
+	assert.NoError(t, batch.Commit())
+	assert.Equal(t, ErrBatchCommitted, batch.Commit())
+
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "batch_ok")))
+
+	client := NewClient(ns, pool)
+	_, err := client.BatchStatus("nope")
+	assert.Equal(t, ErrBatchNotFound, err)
+}
+
+func TestBatchClearedJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	batch := enqueuer.NewBatch().
+		OnComplete("batch_done", nil).
+		OnSuccess("batch_ok", nil)
+	_, err := batch.Enqueue("export", nil)
+	assert.NoError(t, err)
+	assert.NoError(t, batch.Commit())
+
+	started := make(chan struct{})
+	jobTypes := map[string]*jobType{
+		"export": {
+			Name:       "export",
+			JobOptions: JobOptions{Priority: 1, MaxFails: 3},
+			IsGeneric:  true,
+			GenericContextHandler: func(ctx context.Context, job *Job) error {
+				close(started)
+				<-ctx.Done()
+				return nil
+			},
+		},
+	}
+	w := newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
+	w.start()
+	<-started
+	w.ClearWorker()
+	w.drain()
+	w.stop()
+
+	// The cleared job is neither retried nor counted as a success.
+	client := NewClient(ns, pool)
+	status, err := client.BatchStatus(batch.ID)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, status.Pending)
+	assert.EqualValues(t, 0, status.Succeeded)
+	assert.EqualValues(t, 1, status.Failed)
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, "1", "export")))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "batch_done")))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "batch_ok")))
+}
@@ -1,7 +1,9 @@
 package work
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
@@ -17,11 +19,15 @@ var ErrNotDeleted = fmt.Errorf("nothing deleted")
 // no object was actually retried by those commmands.
 var ErrNotRetried = fmt.Errorf("nothing retried")
 
+// ErrJobExpired is returned by RetryDeadJob when the job had expired, see WithTTL. It was deleted rather than retried.
+var ErrJobExpired = fmt.Errorf("job expired")
+
 // Client implements all of the functionality of the web UI. It can be used to inspect the status of a running cluster and retry dead jobs.
 type Client struct {
 	namespace string
 	pool      *redis.Pool
 	enqueuer  Enqueuer
+	logger    Logger
 }
 
 // NewClient creates a new Client with the specified redis namespace and connection pool.
@@ -33,6 +39,12 @@ func NewClient(namespace string, pool *redis.Pool) *Client {
 	}
 }
 
+// SetLogger sets the logger that the client logs Redis errors to, instead of the default one that writes to stdout.
+func (c *Client) SetLogger(l Logger) {
+	c.logger = l
+	c.enqueuer.SetLogger(l)
+}
+
 // WorkerPoolHeartbeat represents the heartbeat from a worker pool. WorkerPool's write a heartbeat every 5 seconds so we know they're alive and includes config information.
 type WorkerPoolHeartbeat struct {
 	WorkerPoolID string   `json:"worker_pool_id"`
@@ -64,7 +76,7 @@ func (c *Client) WorkerPoolHeartbeats() ([]*WorkerPoolHeartbeat, error) {
 	}
 
 	if err := conn.Flush(); err != nil {
-		logError("worker_pool_statuses.flush", err)
+		logError(c.logger, "worker_pool_statuses.flush", err)
 		return nil, err
 	}
 
@@ -73,7 +85,7 @@ func (c *Client) WorkerPoolHeartbeats() ([]*WorkerPoolHeartbeat, error) {
 	for _, wpid := range workerPoolIDs {
 		vals, err := redis.Strings(conn.Receive())
 		if err != nil {
-			logError("worker_pool_statuses.receive", err)
+			logError(c.logger, "worker_pool_statuses.receive", err)
 			return nil, err
 		}
 
@@ -108,7 +120,7 @@ func (c *Client) WorkerPoolHeartbeats() ([]*WorkerPoolHeartbeat, error) {
 				sort.Strings(heartbeat.WorkerIDs)
 			}
 			if err != nil {
-				logError("worker_pool_statuses.parse", err)
+				logError(c.logger, "worker_pool_statuses.parse", err)
 				return nil, err
 			}
 		}
@@ -131,6 +143,29 @@ type WorkerObservation struct {
 	ArgsJSON  string `json:"args_json"`
 	Checkin   string `json:"checkin"`
 	CheckinAt int64  `json:"checkin_at"`
+
+	// If the job reported its progress, see Job.Progress:
+	ProgressDone   int64                  `json:"progress_done"`
+	ProgressTotal  int64                  `json:"progress_total"`
+	ProgressAt     int64                  `json:"progress_at"`
+	ProgressFields map[string]interface{} `json:"progress_fields,omitempty"`
+	Percent        float64                `json:"percent"` // ProgressDone out of ProgressTotal, from 0 to 100
+	ETA            int64                  `json:"eta"`     // when the job should be done at the rate it's going, in epoch seconds. 0 if it can't be told yet.
+}
+
+// computeProgress sets the percentage done and ETA of the observation from its progress.
+func (ob *WorkerObservation) computeProgress() {
+	if ob.ProgressTotal <= 0 {
+		return
+	}
+	done := ob.ProgressDone
+	if done > ob.ProgressTotal {
+		done = ob.ProgressTotal
+	}
+	ob.Percent = float64(done) * 100 / float64(ob.ProgressTotal)
+	if elapsed := ob.ProgressAt - ob.StartedAt; done > 0 && elapsed > 0 {
+		ob.ETA = ob.StartedAt + elapsed*ob.ProgressTotal/done
+	}
 }
 
 // DrainDeadWorker deletes a dead job from Redis.
@@ -151,7 +186,7 @@ func (c *Client) WorkerObservations() ([]*WorkerObservation, error) {
 
 	hbs, err := c.WorkerPoolHeartbeats()
 	if err != nil {
-		logError("worker_observations.worker_pool_heartbeats", err)
+		logError(c.logger, "worker_observations.worker_pool_heartbeats", err)
 		return nil, err
 	}
 
@@ -166,7 +201,7 @@ func (c *Client) WorkerObservations() ([]*WorkerObservation, error) {
 	}
 
 	if err := conn.Flush(); err != nil {
-		logError("worker_observations.flush", err)
+		logError(c.logger, "worker_observations.flush", err)
 		return nil, err
 	}
 
@@ -175,7 +210,7 @@ func (c *Client) WorkerObservations() ([]*WorkerObservation, error) {
 	for _, wid := range workerIDs {
 		vals, err := redis.Strings(conn.Receive())
 		if err != nil {
-			logError("worker_observations.receive", err)
+			logError(c.logger, "worker_observations.receive", err)
 			return nil, err
 		}
 
@@ -202,12 +237,21 @@ func (c *Client) WorkerObservations() ([]*WorkerObservation, error) {
 				ob.Checkin = value
 			} else if key == "checkin_at" {
 				ob.CheckinAt, err = strconv.ParseInt(value, 10, 64)
+			} else if key == "progress_done" {
+				ob.ProgressDone, err = strconv.ParseInt(value, 10, 64)
+			} else if key == "progress_total" {
+				ob.ProgressTotal, err = strconv.ParseInt(value, 10, 64)
+			} else if key == "progress_at" {
+				ob.ProgressAt, err = strconv.ParseInt(value, 10, 64)
+			} else if key == "progress_fields" {
+				err = json.Unmarshal([]byte(value), &ob.ProgressFields)
 			}
 			if err != nil {
-				logError("worker_observations.parse", err)
+				logError(c.logger, "worker_observations.parse", err)
 				return nil, err
 			}
 		}
+		ob.computeProgress()
 
 		observations = append(observations, ob)
 	}
@@ -216,10 +260,12 @@ func (c *Client) WorkerObservations() ([]*WorkerObservation, error) {
 }
 
 // Queue represents a queue that holds jobs with the same name. It indicates their name, count, and latency (in seconds). Latency is a measurement of how long ago the next job to be processed was enqueued.
+// Paused indicates whether workers are currently skipping the queue (see PauseJob).
 type Queue struct {
 	JobName string `json:"job_name"`
 	Count   int64  `json:"count"`
 	Latency int64  `json:"latency"`
+	Paused  bool   `json:"paused"`
 }
 
 // Queues returns the Queue's it finds.
@@ -236,10 +282,11 @@ func (c *Client) Queues() ([]*Queue, error) {
 
 	for _, jobName := range jobNames {
 		conn.Send("LLEN", redisKeyJobs(c.namespace, jobName))
+		conn.Send("EXISTS", redisKeyJobsPaused(c.namespace, jobName))
 	}
 
 	if err := conn.Flush(); err != nil {
-		logError("client.queues.flush", err)
+		logError(c.logger, "client.queues.flush", err)
 		return nil, err
 	}
 
@@ -248,13 +295,20 @@ func (c *Client) Queues() ([]*Queue, error) {
 	for _, jobName := range jobNames {
 		count, err := redis.Int64(conn.Receive())
 		if err != nil {
-			logError("client.queues.receive", err)
+			logError(c.logger, "client.queues.receive", err)
+			return nil, err
+		}
+
+		paused, err := redis.Bool(conn.Receive())
+		if err != nil {
+			logError(c.logger, "client.queues.receive_paused", err)
 			return nil, err
 		}
 
 		queue := &Queue{
 			JobName: jobName,
 			Count:   count,
+			Paused:  paused,
 		}
 
 		queues = append(queues, queue)
@@ -267,7 +321,7 @@ func (c *Client) Queues() ([]*Queue, error) {
 	}
 
 	if err := conn.Flush(); err != nil {
-		logError("client.queues.flush2", err)
+		logError(c.logger, "client.queues.flush2", err)
 		return nil, err
 	}
 
@@ -277,13 +331,13 @@ func (c *Client) Queues() ([]*Queue, error) {
 		if s.Count > 0 {
 			b, err := redis.Bytes(conn.Receive())
 			if err != nil {
-				logError("client.queues.receive2", err)
+				logError(c.logger, "client.queues.receive2", err)
 				return nil, err
 			}
 
 			job, err := newJob(b, nil, nil)
 			if err != nil {
-				logError("client.queues.new_job", err)
+				logError(c.logger, "client.queues.new_job", err)
 			}
 			s.Latency = now - job.EnqueuedAt
 		}
@@ -292,6 +346,368 @@ func (c *Client) Queues() ([]*Queue, error) {
 	return queues, nil
 }
 
+// PauseJob pauses the queue for jobName: workers won't start any more of those jobs until UnpauseJob is called.
+// Jobs can still be enqueued while the queue is paused, and jobs already in progress aren't affected.
+func (c *Client) PauseJob(jobName string) error {
+	return c.PauseJobFor(jobName, 0)
+}
+
+// PauseJobFor pauses the queue for jobName like PauseJob, but the queue is automatically unpaused after the specified number of seconds.
+// If seconds <= 0, the queue stays paused until UnpauseJob is called.
+func (c *Client) PauseJobFor(jobName string, seconds int64) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	args := []interface{}{redisKeyJobsPaused(c.namespace, jobName), "1"}
+	if seconds > 0 {
+		args = append(args, "EX", seconds)
+	}
+	if _, err := conn.Do("SET", args...); err != nil {
+		logError(c.logger, "client.pause_job", err)
+		return err
+	}
+
+	return nil
+}
+
+// UnpauseJob resumes processing of the queue for jobName. It's a no-op if the queue isn't paused.
+func (c *Client) UnpauseJob(jobName string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	if _, err := conn.Do("DEL", redisKeyJobsPaused(c.namespace, jobName)); err != nil {
+		logError(c.logger, "client.unpause_job", err)
+		return err
+	}
+
+	return nil
+}
+
+// PausedJobs returns the sorted names of the known jobs whose queues are currently paused.
+func (c *Client) PausedJobs() ([]string, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	jobNames, err := redis.Strings(conn.Do("SMEMBERS", redisKeyKnownJobs(c.namespace)))
+	if err != nil {
+		logError(c.logger, "client.paused_jobs.smembers", err)
+		return nil, err
+	}
+	sort.Strings(jobNames)
+
+	for _, jobName := range jobNames {
+		conn.Send("EXISTS", redisKeyJobsPaused(c.namespace, jobName))
+	}
+
+	if err := conn.Flush(); err != nil {
+		logError(c.logger, "client.paused_jobs.flush", err)
+		return nil, err
+	}
+
+	var paused []string
+	for _, jobName := range jobNames {
+		isPaused, err := redis.Bool(conn.Receive())
+		if err != nil {
+			logError(c.logger, "client.paused_jobs.receive", err)
+			return nil, err
+		}
+		if isPaused {
+			paused = append(paused, jobName)
+		}
+	}
+
+	return paused, nil
+}
+
+// ConcurrencyStatus describes the concurrency limit of a job and how many of those jobs are in progress.
+type ConcurrencyStatus struct {
+	JobName        string           `json:"job_name"`
+	MaxConcurrency uint             `json:"max_concurrency"` // 0 means no limit
+	Overridden     bool             `json:"overridden"`      // true if MaxConcurrency was set with SetMaxConcurrency
+	Lock           int64            `json:"lock"`            // number of jobs in progress across all worker pools
+	LockInfo       map[string]int64 `json:"lock_info"`       // worker pool ID -> number of jobs in progress in that pool
+}
+
+// SetMaxConcurrency changes the max number of jobName jobs that may be in progress at once across all worker pools. A max of 0 means no limit.
+// The change takes effect on the next fetch. Worker pools overwrite it when they start, unless the job was registered with JobOptions.KeepMaxConcurrencyOverride.
+func (c *Client) SetMaxConcurrency(jobName string, max uint) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	conn.Send("MULTI")
+	conn.Send("SET", redisKeyJobsConcurrency(c.namespace, jobName), max)
+	conn.Send("SET", redisKeyJobsConcurrencyOverride(c.namespace, jobName), "1")
+	if _, err := conn.Do("EXEC"); err != nil {
+		logError(c.logger, "client.set_max_concurrency", err)
+		return err
+	}
+
+	return nil
+}
+
+// ResetMaxConcurrency forgets a max concurrency set with SetMaxConcurrency, so that the next worker pool to start writes the MaxConcurrency from its JobOptions.
+// The current limit stays in effect until then.
+func (c *Client) ResetMaxConcurrency(jobName string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	if _, err := conn.Do("DEL", redisKeyJobsConcurrencyOverride(c.namespace, jobName)); err != nil {
+		logError(c.logger, "client.reset_max_concurrency", err)
+		return err
+	}
+
+	return nil
+}
+
+// GetConcurrencyStatus returns the concurrency limit of jobName and the number of those jobs in progress, in total and per worker pool.
+func (c *Client) GetConcurrencyStatus(jobName string) (*ConcurrencyStatus, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	conn.Send("GET", redisKeyJobsConcurrency(c.namespace, jobName))
+	conn.Send("EXISTS", redisKeyJobsConcurrencyOverride(c.namespace, jobName))
+	conn.Send("GET", redisKeyJobsLock(c.namespace, jobName))
+	conn.Send("HGETALL", redisKeyJobsLockInfo(c.namespace, jobName))
+	if err := conn.Flush(); err != nil {
+		logError(c.logger, "client.get_concurrency_status.flush", err)
+		return nil, err
+	}
+
+	status := &ConcurrencyStatus{
+		JobName:  jobName,
+		LockInfo: make(map[string]int64),
+	}
+
+	max, err := redis.Uint64(conn.Receive())
+	if err != nil && err != redis.ErrNil {
+		logError(c.logger, "client.get_concurrency_status.max_concurrency", err)
+		return nil, err
+	}
+	status.MaxConcurrency = uint(max)
+
+	status.Overridden, err = redis.Bool(conn.Receive())
+	if err != nil {
+		logError(c.logger, "client.get_concurrency_status.override", err)
+		return nil, err
+	}
+
+	status.Lock, err = redis.Int64(conn.Receive())
+	if err != nil && err != redis.ErrNil {
+		logError(c.logger, "client.get_concurrency_status.lock", err)
+		return nil, err
+	}
+
+	lockInfo, err := redis.Int64Map(conn.Receive())
+	if err != nil {
+		logError(c.logger, "client.get_concurrency_status.lock_info", err)
+		return nil, err
+	}
+	for poolID, count := range lockInfo {
+		if count != 0 {
+			status.LockInfo[poolID] = count
+		}
+	}
+
+	return status, nil
+}
+
+// BatchStatus returns the progress of the batch with the specified ID. It returns ErrBatchNotFound if there's no such batch.
+func (c *Client) BatchStatus(batchID string) (*BatchStatus, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	vals, err := redis.Strings(conn.Do("HGETALL", redisKeyBatch(c.namespace, batchID)))
+	if err != nil {
+		logError(c.logger, "client.batch_status.hgetall", err)
+		return nil, err
+	}
+	if len(vals) == 0 {
+		return nil, ErrBatchNotFound
+	}
+
+	status, err := parseBatchStatus(batchID, vals)
+	if err != nil {
+		logError(c.logger, "client.batch_status.parse", err)
+		return nil, err
+	}
+
+	return status, nil
+}
+
+// Batches returns a list of BatchStatus's, newest first. The page param is 1-based; each page is 20 items. The total number of items (not pages) in the list of batches is also returned.
+// Completed batches are kept for a week.
+func (c *Client) Batches(page uint) ([]*BatchStatus, int64, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	if page == 0 {
+		page = 1
+	}
+
+	key := redisKeyBatches(c.namespace)
+	batchIDs, err := redis.Strings(conn.Do("ZREVRANGE", key, (page-1)*20, page*20-1))
+	if err != nil {
+		logError(c.logger, "client.batches.zrevrange", err)
+		return nil, 0, err
+	}
+
+	for _, batchID := range batchIDs {
+		conn.Send("HGETALL", redisKeyBatch(c.namespace, batchID))
+	}
+
+	if err := conn.Flush(); err != nil {
+		logError(c.logger, "client.batches.flush", err)
+		return nil, 0, err
+	}
+
+	batches := make([]*BatchStatus, 0, len(batchIDs))
+	var expired []interface{}
+
+	for _, batchID := range batchIDs {
+		vals, err := redis.Strings(conn.Receive())
+		if err != nil {
+			logError(c.logger, "client.batches.receive", err)
+			return nil, 0, err
+		}
+		if len(vals) == 0 {
+			expired = append(expired, batchID)
+			continue
+		}
+
+		status, err := parseBatchStatus(batchID, vals)
+		if err != nil {
+			logError(c.logger, "client.batches.parse", err)
+			return nil, 0, err
+		}
+		batches = append(batches, status)
+	}
+
+	// The batch hashes expire on their own, so clean up the index as we notice them missing.
+	if len(expired) > 0 {
+		if _, err := conn.Do("ZREM", append([]interface{}{key}, expired...)...); err != nil {
+			logError(c.logger, "client.batches.zrem", err)
+			return nil, 0, err
+		}
+	}
+
+	count, err := redis.Int64(conn.Do("ZCARD", key))
+	if err != nil {
+		logError(c.logger, "client.batches.zcard", err)
+		return nil, 0, err
+	}
+
+	return batches, count, nil
+}
+
+// WorkflowStatus returns the state of each job of the workflow with the specified ID. It returns ErrWorkflowNotFound if there's no such workflow.
+func (c *Client) WorkflowStatus(workflowID string) (*WorkflowStatus, error) {
+	statuses, err := c.workflowStatuses([]string{workflowID})
+	if err != nil {
+		return nil, err
+	}
+	if statuses[0] == nil {
+		return nil, ErrWorkflowNotFound
+	}
+	return statuses[0], nil
+}
+
+// Workflows returns a list of WorkflowStatus's, newest first. The page param is 1-based; each page is 20 items. The total number of items (not pages) in the list of workflows is also returned.
+// Workflows are kept for a week once all of their jobs are done.
+func (c *Client) Workflows(page uint) ([]*WorkflowStatus, int64, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	if page == 0 {
+		page = 1
+	}
+
+	key := redisKeyWorkflows(c.namespace)
+	workflowIDs, err := redis.Strings(conn.Do("ZREVRANGE", key, (page-1)*20, page*20-1))
+	if err != nil {
+		logError(c.logger, "client.workflows.zrevrange", err)
+		return nil, 0, err
+	}
+
+	statuses, err := c.workflowStatuses(workflowIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	workflows := make([]*WorkflowStatus, 0, len(statuses))
+	var expired []interface{}
+	for i, status := range statuses {
+		if status == nil {
+			expired = append(expired, workflowIDs[i])
+			continue
+		}
+		workflows = append(workflows, status)
+	}
+
+	// The workflow keys expire on their own, so clean up the index as we notice them missing.
+	if len(expired) > 0 {
+		if _, err := conn.Do("ZREM", append([]interface{}{key}, expired...)...); err != nil {
+			logError(c.logger, "client.workflows.zrem", err)
+			return nil, 0, err
+		}
+	}
+
+	count, err := redis.Int64(conn.Do("ZCARD", key))
+	if err != nil {
+		logError(c.logger, "client.workflows.zcard", err)
+		return nil, 0, err
+	}
+
+	return workflows, count, nil
+}
+
+// workflowStatuses returns the status of each of the workflows, in the same order. Missing workflows are nil.
+func (c *Client) workflowStatuses(workflowIDs []string) ([]*WorkflowStatus, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	for _, workflowID := range workflowIDs {
+		metaKey, graphKey, stateKey, _ := redisKeysWorkflow(c.namespace, workflowID)
+		conn.Send("HGETALL", metaKey)
+		conn.Send("HGETALL", graphKey)
+		conn.Send("HGETALL", stateKey)
+	}
+
+	if err := conn.Flush(); err != nil {
+		logError(c.logger, "client.workflow_statuses.flush", err)
+		return nil, err
+	}
+
+	statuses := make([]*WorkflowStatus, len(workflowIDs))
+	for i, workflowID := range workflowIDs {
+		meta, err := redis.Strings(conn.Receive())
+		if err != nil {
+			logError(c.logger, "client.workflow_statuses.receive", err)
+			return nil, err
+		}
+		graph, err := redis.StringMap(conn.Receive())
+		if err != nil {
+			logError(c.logger, "client.workflow_statuses.receive", err)
+			return nil, err
+		}
+		states, err := redis.StringMap(conn.Receive())
+		if err != nil {
+			logError(c.logger, "client.workflow_statuses.receive", err)
+			return nil, err
+		}
+		if len(meta) == 0 {
+			continue
+		}
+
+		statuses[i], err = parseWorkflowStatus(workflowID, meta, graph, states)
+		if err != nil {
+			logError(c.logger, "client.workflow_statuses.parse", err)
+			return nil, err
+		}
+	}
+
+	return statuses, nil
+}
+
 // RetryJob represents a job in the retry queue.
 type RetryJob struct {
 	RetryAt int64 `json:"retry_at"`
@@ -300,10 +716,19 @@ type RetryJob struct {
 
 // ScheduledJob represents a job in the scheduled queue.
 type ScheduledJob struct {
-	RunAt int64 `json:"run_at"`
+	RunAt       int64 `json:"run_at"`    // epoch seconds
+	RunAtMillis int64 `json:"run_at_ms"` // epoch milliseconds, for jobs scheduled with EnqueueAt at a fraction of a second
 	*Job
 }
 
+func newScheduledJob(job *Job, runAtMillis int64) *ScheduledJob {
+	return &ScheduledJob{
+		RunAt:       floorDiv(runAtMillis, 1000),
+		RunAtMillis: runAtMillis,
+		Job:         job,
+	}
+}
+
 // DeadJob represents a job in the dead queue.
 type DeadJob struct {
 	DiedAt int64 `json:"died_at"`
@@ -315,14 +740,14 @@ func (c *Client) ScheduledJobs(page uint) ([]*ScheduledJob, int64, error) {
 	key := redisKeyScheduled(c.namespace)
 	jobsWithScores, count, err := c.getZsetPage(key, page)
 	if err != nil {
-		logError("client.scheduled_jobs.get_zset_page", err)
+		logError(c.logger, "client.scheduled_jobs.get_zset_page", err)
 		return nil, 0, err
 	}
 
 	jobs := make([]*ScheduledJob, 0, len(jobsWithScores))
 
 	for _, jws := range jobsWithScores {
-		jobs = append(jobs, &ScheduledJob{RunAt: jws.Score, Job: jws.job})
+		jobs = append(jobs, newScheduledJob(jws.job, int64(math.Round(jws.Score*1000))))
 	}
 
 	return jobs, count, nil
@@ -333,14 +758,14 @@ func (c *Client) RetryJobs(page uint) ([]*RetryJob, int64, error) {
 	key := redisKeyRetry(c.namespace)
 	jobsWithScores, count, err := c.getZsetPage(key, page)
 	if err != nil {
-		logError("client.retry_jobs.get_zset_page", err)
+		logError(c.logger, "client.retry_jobs.get_zset_page", err)
 		return nil, 0, err
 	}
 
 	jobs := make([]*RetryJob, 0, len(jobsWithScores))
 
 	for _, jws := range jobsWithScores {
-		jobs = append(jobs, &RetryJob{RetryAt: jws.Score, Job: jws.job})
+		jobs = append(jobs, &RetryJob{RetryAt: int64(math.Floor(jws.Score)), Job: jws.job})
 	}
 
 	return jobs, count, nil
@@ -351,14 +776,14 @@ func (c *Client) DeadJobs(page uint) ([]*DeadJob, int64, error) {
 	key := redisKeyDead(c.namespace)
 	jobsWithScores, count, err := c.getZsetPage(key, page)
 	if err != nil {
-		logError("client.dead_jobs.get_zset_page", err)
+		logError(c.logger, "client.dead_jobs.get_zset_page", err)
 		return nil, 0, err
 	}
 
 	jobs := make([]*DeadJob, 0, len(jobsWithScores))
 
 	for _, jws := range jobsWithScores {
-		jobs = append(jobs, &DeadJob{DiedAt: jws.Score, Job: jws.job})
+		jobs = append(jobs, &DeadJob{DiedAt: int64(math.Floor(jws.Score)), Job: jws.job})
 	}
 
 	return jobs, count, nil
@@ -377,11 +802,12 @@ func (c *Client) DeleteDeadJob(diedAt int64, jobID string) error {
 }
 
 // RetryDeadJob retries a dead job. The job will be re-queued on the normal work queue for eventual processing by a worker.
+// A job that has expired, see WithTTL, is deleted instead, and ErrJobExpired is returned.
 func (c *Client) RetryDeadJob(diedAt int64, jobID string) error {
 	// Get queues for job names
 	queues, err := c.Queues()
 	if err != nil {
-		logError("client.retry_all_dead_jobs.queues", err)
+		logError(c.logger, "client.retry_all_dead_jobs.queues", err)
 		return err
 	}
 
@@ -402,16 +828,20 @@ func (c *Client) RetryDeadJob(diedAt int64, jobID string) error {
 	args = append(args, nowEpochSeconds())
 	args = append(args, diedAt)
 	args = append(args, jobID)
+	args = append(args, redisKeyMetricsCounter(c.namespace, metricExpired))
 
 	conn := c.pool.Get()
 	defer conn.Close()
 
 	cnt, err := redis.Int64(script.Do(conn, args...))
 	if err != nil {
-		logError("client.retry_dead_job.do", err)
+		logError(c.logger, "client.retry_dead_job.do", err)
 		return err
 	}
 
+	if cnt < 0 {
+		return ErrJobExpired
+	}
 	if cnt == 0 {
 		return ErrNotRetried
 	}
@@ -425,11 +855,12 @@ func (c *Client) StopWorker() error {
 }
 
 // RetryAllDeadJobs requeues all dead jobs. In other words, it puts them all back on the normal work queue for workers to pull from and process.
+// Jobs that have expired, see WithTTL, are deleted instead.
 func (c *Client) RetryAllDeadJobs() error {
 	// Get queues for job names
 	queues, err := c.Queues()
 	if err != nil {
-		logError("client.retry_all_dead_jobs.queues", err)
+		logError(c.logger, "client.retry_all_dead_jobs.queues", err)
 		return err
 	}
 
@@ -449,6 +880,7 @@ func (c *Client) RetryAllDeadJobs() error {
 	args = append(args, redisKeyJobsPrefix(c.namespace)) // ARGV[1]
 	args = append(args, nowEpochSeconds())
 	args = append(args, 1000)
+	args = append(args, redisKeyMetricsCounter(c.namespace, metricExpired))
 
 	conn := c.pool.Get()
 	defer conn.Close()
@@ -458,7 +890,7 @@ func (c *Client) RetryAllDeadJobs() error {
 	for i := 0; i < 1000; i++ {
 		res, err := redis.Int64(script.Do(conn, args...))
 		if err != nil {
-			logError("client.retry_all_dead_jobs.do", err)
+			logError(c.logger, "client.retry_all_dead_jobs.do", err)
 			return err
 		}
 
@@ -476,7 +908,7 @@ func (c *Client) DeleteAllDeadJobs() error {
 	defer conn.Close()
 	_, err := conn.Do("DEL", redisKeyDead(c.namespace))
 	if err != nil {
-		logError("client.delete_all_dead_jobs", err)
+		logError(c.logger, "client.delete_all_dead_jobs", err)
 		return err
 	}
 
@@ -494,22 +926,39 @@ func (c *Client) DeleteScheduledJob(scheduledFor int64, jobID string) error {
 	if len(jobBytes) > 0 {
 		job, err := newJob(jobBytes, nil, nil)
 		if err != nil {
-			logError("client.delete_scheduled_job.new_job", err)
+			logError(c.logger, "client.delete_scheduled_job.new_job", err)
 			return err
 		}
 
 		if job.Unique {
-			uniqueKey, err := redisKeyUniqueJob(c.namespace, job.Name, job.Args)
-			if err != nil {
-				logError("client.delete_scheduled_job.redis_key_unique_job", err)
+			if err := c.deleteUniqueJob(job); err != nil {
 				return err
 			}
-			conn := c.pool.Get()
-			defer conn.Close()
+		}
+	}
 
-			_, err = conn.Do("DEL", uniqueKey)
-			if err != nil {
-				logError("worker.delete_unique_job.del", err)
+	if !ok {
+		return ErrNotDeleted
+	}
+	return nil
+}
+
+// DeleteRetryJob deletes a job in the retry queue. A job enqueued with UniqueUntilSuccess stops being unique.
+func (c *Client) DeleteRetryJob(retryAt int64, jobID string) error {
+	ok, jobBytes, err := c.deleteZsetJob(redisKeyRetry(c.namespace), retryAt, jobID)
+	if err != nil {
+		return err
+	}
+
+	if len(jobBytes) > 0 {
+		job, err := newJob(jobBytes, nil, nil)
+		if err != nil {
+			logError(c.logger, "client.delete_retry_job.new_job", err)
+			return err
+		}
+
+		if job.Unique && job.UniqueMode == UniqueUntilSuccess {
+			if err := c.deleteUniqueJob(job); err != nil {
 				return err
 			}
 		}
@@ -521,19 +970,208 @@ func (c *Client) DeleteScheduledJob(scheduledFor int64, jobID string) error {
 	return nil
 }
 
-// DeleteRetryJob deletes a job in the retry queue.
-func (c *Client) DeleteRetryJob(retryAt int64, jobID string) error {
-	ok, _, err := c.deleteZsetJob(redisKeyRetry(c.namespace), retryAt, jobID)
+// cancelledJobTTL is how long a cancelled job that wasn't found is remembered, so that workers skip it if it shows up.
+const cancelledJobTTL = 24 * 60 * 60
+
+// cancelJobAttempts is how many times CancelJob looks for a job that moves before it's deleted.
+const cancelJobAttempts = 3
+
+// CancelJob cancels the job with the specified ID, wherever it's waiting: in its queue, the scheduled job queue or the retry queue.
+// It returns whether the job was found and deleted. If it wasn't, eg because it's being enqueued in a transaction or moved
+// between queues, its ID is remembered for a day, so that the workers drop it when they fetch it.
+// A job that's in progress isn't stopped, nor remembered, so it's retried as usual if it fails. Finding the job takes a scan of
+// the queues, a page at a time, starting where the job index says it is, see Enqueuer.EnableJobIndex.
+func (c *Client) CancelJob(jobID string) (bool, error) {
+	queues, err := c.Queues()
 	if err != nil {
+		logError(c.logger, "client.cancel_job.queues", err)
+		return false, err
+	}
+
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	keys := make([]jobSearchKey, 0, len(queues)+2)
+	for _, q := range queues {
+		keys = append(keys, jobSearchKey{key: redisKeyJobs(c.namespace, q.JobName)})
+	}
+	keys = append(keys, jobSearchKey{key: redisKeyScheduled(c.namespace), isZset: true})
+	keys = append(keys, jobSearchKey{key: redisKeyRetry(c.namespace), isZset: true})
+	keys, _, err = hintJobSearch(conn, c.namespace, jobID, keys)
+	if err != nil {
+		logError(c.logger, "client.cancel_job.index", err)
+		return false, err
+	}
+
+	for i := 0; i < cancelJobAttempts; i++ {
+		found, err := searchJob(conn, jobID, keys)
+		if err != nil {
+			logError(c.logger, "client.cancel_job.search", err)
+			return false, err
+		}
+		if found == nil {
+			break
+		}
+
+		deleted, err := redis.Bool(redis.NewScript(1, redisLuaDeleteFoundJob).Do(conn, found.key, found.rawJSON, found.isZset))
+		if err != nil {
+			logError(c.logger, "client.cancel_job.delete", err)
+			return false, err
+		}
+		if !deleted {
+			continue // it moved, look for it again
+		}
+
+		job, err := newJob(found.rawJSON, nil, nil)
+		if err != nil {
+			logError(c.logger, "client.cancel_job.new_job", err)
+			return true, err
+		}
+		writeJobStatus(c.namespace, c.pool, c.logger, job, JobStatusDead, "error", "cancelled", "finished_at", nowEpochSeconds())
+		markBatchJobDone(c.namespace, c.pool, c.logger, job, false)
+		markWorkflowJobDone(c.namespace, c.pool, c.logger, job, false)
+		return true, nil
+	}
+
+	poolIDs, err := redis.Strings(conn.Do("SMEMBERS", redisKeyWorkerPools(c.namespace)))
+	if err != nil {
+		logError(c.logger, "client.cancel_job.worker_pools", err)
+		return false, err
+	}
+
+	now := nowEpochSeconds()
+	args := make([]interface{}, 0, 1+len(queues)*len(poolIDs)+3)
+	args = append(args, redisKeyCancelled(c.namespace)) // KEY[1]
+	for _, q := range queues {
+		for _, poolID := range poolIDs {
+			args = append(args, redisKeyJobsInProgress(c.namespace, poolID, q.JobName)) // KEY[2, 3, ...]
+		}
+	}
+	script := redis.NewScript(len(args), redisLuaRememberCancelledJob)
+	args = append(args, jobID)               // ARGV[1]
+	args = append(args, now)                 // ARGV[2]
+	args = append(args, now+cancelledJobTTL) // ARGV[3]
+
+	if _, err := script.Do(conn, args...); err != nil {
+		logError(c.logger, "client.cancel_job.remember", err)
+		return false, err
+	}
+	return false, nil
+}
+
+// deleteUniqueJob deletes the unique key of a job that was deleted, unless a debounced enqueue set it to another job.
+func (c *Client) deleteUniqueJob(job *Job) error {
+	uniqueKey, err := job.uniqueRedisKey(c.namespace)
+	if err != nil {
+		logError(c.logger, "client.delete_unique_job.key", err)
 		return err
 	}
-	if !ok {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	script := redis.NewScript(1, redisLuaDeleteUniqueJob)
+	if _, err := script.Do(conn, uniqueKey, job.rawJSON); err != nil {
+		logError(c.logger, "client.delete_unique_job.del", err)
+		return err
+	}
+	return nil
+}
+
+// UniqueLock is the Redis key that keeps a unique job unique, see EnqueueUnique.
+type UniqueLock struct {
+	Key     string `json:"key"`
+	JobName string `json:"job_name"`
+	TTL     int64  `json:"ttl"`              // seconds until the lock expires, -1 if it doesn't
+	JobID   string `json:"job_id,omitempty"` // the ID of the job holding the lock, if it was enqueued with WithDebounce and is pending
+}
+
+// UniqueLocks returns the locks of the unique jobs, sorted by key. Deleting a lock with DeleteUniqueLock lets a duplicate be enqueued,
+// eg if a job was lost without releasing it.
+func (c *Client) UniqueLocks() ([]*UniqueLock, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	prefix := redisKeyUniqueJobPrefix(c.namespace)
+	var keys []string
+	cursor := 0
+	for {
+		values, err := redis.Values(conn.Do("SCAN", cursor, "MATCH", prefix+"*", "COUNT", 1000))
+		if err != nil {
+			logError(c.logger, "client.unique_locks.scan", err)
+			return nil, err
+		}
+		var batch []string
+		if _, err := redis.Scan(values, &cursor, &batch); err != nil {
+			logError(c.logger, "client.unique_locks.scan", err)
+			return nil, err
+		}
+		keys = append(keys, batch...)
+		if cursor == 0 {
+			break
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		conn.Send("GET", key)
+		conn.Send("TTL", key)
+	}
+	if err := conn.Flush(); err != nil {
+		logError(c.logger, "client.unique_locks.flush", err)
+		return nil, err
+	}
+
+	locks := make([]*UniqueLock, 0, len(keys))
+	for _, key := range keys {
+		value, err := redis.Bytes(conn.Receive())
+		if err != nil && err != redis.ErrNil {
+			logError(c.logger, "client.unique_locks.get", err)
+			return nil, err
+		}
+		ttl, err := redis.Int64(conn.Receive())
+		if err != nil {
+			logError(c.logger, "client.unique_locks.ttl", err)
+			return nil, err
+		}
+		if value == nil {
+			continue // expired since the scan
+		}
+
+		lock := &UniqueLock{Key: key, TTL: ttl}
+		// Job names are assumed not to contain a colon, which the key puts after them.
+		lock.JobName = strings.SplitN(strings.TrimPrefix(key, prefix), ":", 2)[0]
+		if string(value) != "1" {
+			if job, err := newJob(value, nil, nil); err == nil {
+				lock.JobID = job.ID
+			}
+		}
+		locks = append(locks, lock)
+	}
+
+	return locks, nil
+}
+
+// DeleteUniqueLock deletes the lock of a unique job, with the key returned by UniqueLocks. It returns ErrNotDeleted if there was no such lock.
+func (c *Client) DeleteUniqueLock(key string) error {
+	if !strings.HasPrefix(key, redisKeyUniqueJobPrefix(c.namespace)) {
+		return fmt.Errorf("not a unique lock: %s", key)
+	}
+
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	n, err := redis.Int64(conn.Do("DEL", key))
+	if err != nil {
+		logError(c.logger, "client.delete_unique_lock", err)
+		return err
+	}
+	if n == 0 {
 		return ErrNotDeleted
 	}
 	return nil
 }
 
-// deleteZsetJob deletes the job in the specified zset (dead, retry, or scheduled queue). zsetKey is like "work:dead" or "work:scheduled". The function deletes all jobs with the given jobID with the specified zscore (there should only be one, but in theory there could be bad data). It will return if at least one job is deleted and if
+// deleteZsetJob deletes the job in the specified zset (dead, retry, or scheduled queue). zsetKey is like "work:dead" or "work:scheduled". The function deletes all jobs with the given jobID with the specified zscore, or a fraction of a second later for jobs scheduled with EnqueueAt (there should only be one, but in theory there could be bad data). It will return if at least one job is deleted and if
 func (c *Client) deleteZsetJob(zsetKey string, zscore int64, jobID string) (bool, []byte, error) {
 	script := redis.NewScript(1, redisLuaDeleteSingleCmd)
 
@@ -552,7 +1190,7 @@ func (c *Client) deleteZsetJob(zsetKey string, zscore int64, jobID string) (bool
 	cnt, err := redis.Int64(values[0], err)
 	jobBytes, err := redis.Bytes(values[1], err)
 	if err != nil {
-		logError("client.delete_zset_job.do", err)
+		logError(c.logger, "client.delete_zset_job.do", err)
 		return false, nil, err
 	}
 
@@ -561,7 +1199,7 @@ func (c *Client) deleteZsetJob(zsetKey string, zscore int64, jobID string) (bool
 
 type jobScore struct {
 	JobBytes []byte
-	Score    int64
+	Score    float64
 	job      *Job
 }
 
@@ -575,21 +1213,21 @@ func (c *Client) getZsetPage(key string, page uint) ([]jobScore, int64, error) {
 
 	values, err := redis.Values(conn.Do("ZRANGEBYSCORE", key, "-inf", "+inf", "WITHSCORES", "LIMIT", (page-1)*20, 20))
 	if err != nil {
-		logError("client.get_zset_page.values", err)
+		logError(c.logger, "client.get_zset_page.values", err)
 		return nil, 0, err
 	}
 
 	var jobsWithScores []jobScore
 
 	if err := redis.ScanSlice(values, &jobsWithScores); err != nil {
-		logError("client.get_zset_page.scan_slice", err)
+		logError(c.logger, "client.get_zset_page.scan_slice", err)
 		return nil, 0, err
 	}
 
 	for i, jws := range jobsWithScores {
 		job, err := newJob(jws.JobBytes, nil, nil)
 		if err != nil {
-			logError("client.get_zset_page.new_job", err)
+			logError(c.logger, "client.get_zset_page.new_job", err)
 			return nil, 0, err
 		}
 
@@ -598,7 +1236,7 @@ func (c *Client) getZsetPage(key string, page uint) ([]jobScore, int64, error) {
 
 	count, err := redis.Int64(conn.Do("ZCARD", key))
 	if err != nil {
-		logError("client.get_zset_page.int64", err)
+		logError(c.logger, "client.get_zset_page.int64", err)
 		return nil, 0, err
 	}
 
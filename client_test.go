@@ -131,6 +131,48 @@ func TestClientWorkerObservations(t *testing.T) {
 	assert.Equal(t, 0, len(observations))
 }
 
+func TestClientWorkerObservationsProgress(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	conn := pool.Get()
+	defer conn.Close()
+	_, err := conn.Do("SADD", redisKeyWorkerPools(ns), "1")
+	assert.NoError(t, err)
+	_, err = conn.Do("HMSET", redisKeyHeartbeat(ns, "1"), "worker_ids", "a,b")
+	assert.NoError(t, err)
+
+	observer := newObserver(ns, pool, "a")
+	observer.start()
+	setNowEpochSecondsMock(1000)
+	defer resetNowEpochSecondsMock()
+	observer.observeStarted("foo", "bar", nil)
+	setNowEpochSecondsMock(1010)
+	observer.observeProgress("foo", "bar", 25, 100, map[string]interface{}{"file": "a.csv"})
+	observer.drain()
+	observer.stop()
+
+	observations, err := NewClient(ns, pool).WorkerObservations()
+	assert.NoError(t, err)
+	if assert.Equal(t, 2, len(observations)) {
+		ob := observations[0]
+		assert.Equal(t, "a", ob.WorkerID)
+		assert.True(t, ob.IsBusy)
+		assert.EqualValues(t, 25, ob.ProgressDone)
+		assert.EqualValues(t, 100, ob.ProgressTotal)
+		assert.EqualValues(t, 1010, ob.ProgressAt)
+		assert.Equal(t, map[string]interface{}{"file": "a.csv"}, ob.ProgressFields)
+		assert.Equal(t, 25.0, ob.Percent)
+		assert.EqualValues(t, 1040, ob.ETA) // 10s for the first quarter
+
+		ob = observations[1]
+		assert.False(t, ob.IsBusy)
+		assert.Equal(t, 0.0, ob.Percent)
+		assert.EqualValues(t, 0, ob.ETA)
+	}
+}
+
 func TestClientQueues(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "work"
@@ -182,6 +224,98 @@ func TestClientQueues(t *testing.T) {
 	assert.EqualValues(t, 0, queues[2].Latency)
 }
 
+func TestClientPauseUnpauseJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("foo", nil)
+	assert.NoError(t, err)
+
+	client := NewClient(ns, pool)
+	paused, err := client.PausedJobs()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(paused))
+
+	err = client.PauseJob("wat")
+	assert.NoError(t, err)
+	err = client.PauseJobFor("foo", 60)
+	assert.NoError(t, err)
+
+	paused, err = client.PausedJobs()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"foo", "wat"}, paused)
+
+	conn := pool.Get()
+	ttl, err := redis.Int64(conn.Do("TTL", redisKeyJobsPaused(ns, "foo")))
+	conn.Close()
+	assert.NoError(t, err)
+	assert.True(t, ttl > 0 && ttl <= 60)
+
+	queues, err := client.Queues()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(queues))
+	assert.True(t, queues[0].Paused)
+	assert.True(t, queues[1].Paused)
+
+	err = client.UnpauseJob("wat")
+	assert.NoError(t, err)
+
+	paused, err = client.PausedJobs()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"foo"}, paused)
+
+	queues, err = client.Queues()
+	assert.NoError(t, err)
+	assert.Equal(t, "wat", queues[1].JobName)
+	assert.False(t, queues[1].Paused)
+}
+
+func TestClientConcurrency(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	client := NewClient(ns, pool)
+	status, err := client.GetConcurrencyStatus("wat")
+	assert.NoError(t, err)
+	assert.Equal(t, "wat", status.JobName)
+	assert.EqualValues(t, 0, status.MaxConcurrency)
+	assert.False(t, status.Overridden)
+	assert.EqualValues(t, 0, status.Lock)
+	assert.Equal(t, 0, len(status.LockInfo))
+
+	err = client.SetMaxConcurrency("wat", 3)
+	assert.NoError(t, err)
+
+	conn := pool.Get()
+	conn.Send("SET", redisKeyJobsLock(ns, "wat"), 3)
+	conn.Send("HSET", redisKeyJobsLockInfo(ns, "wat"), "pool1", 2)
+	conn.Send("HSET", redisKeyJobsLockInfo(ns, "wat"), "pool2", 1)
+	conn.Send("HSET", redisKeyJobsLockInfo(ns, "wat"), "pool3", 0)
+	_, err = conn.Do("")
+	conn.Close()
+	assert.NoError(t, err)
+
+	status, err = client.GetConcurrencyStatus("wat")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, status.MaxConcurrency)
+	assert.True(t, status.Overridden)
+	assert.EqualValues(t, 3, status.Lock)
+	assert.Equal(t, map[string]int64{"pool1": 2, "pool2": 1}, status.LockInfo)
+
+	err = client.ResetMaxConcurrency("wat")
+	assert.NoError(t, err)
+
+	status, err = client.GetConcurrencyStatus("wat")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, status.MaxConcurrency)
+	assert.False(t, status.Overridden)
+}
+
 func TestClientScheduledJobs(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "work"
@@ -723,3 +857,197 @@ func getQueuedJob(ns string, pool *redis.Pool, name string) *Job {
 	}
 	return job
 }
+
+func TestClientDeleteScheduledJobMillis(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	client := NewClient(ns, pool)
+	enq := NewEnqueuer(ns, pool)
+	j, err := enq.EnqueueAt("foo", time.Now().Add(time.Minute).Truncate(time.Second).Add(750*time.Millisecond), nil)
+	assert.NoError(t, err)
+
+	// The job is found from its run time in whole seconds.
+	err = client.DeleteScheduledJob(j.RunAt-1, j.ID)
+	assert.Equal(t, ErrNotDeleted, err)
+	err = client.DeleteScheduledJob(j.RunAt, j.ID)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyScheduled(ns)))
+}
+
+func TestClientUniqueLocks(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.EnqueueUnique("wat", Q{"a": 1})
+	assert.NoError(t, err)
+	job, err := enqueuer.EnqueueUnique("taw", nil, WithUniqueKey("user:7"), WithDebounce())
+	assert.NoError(t, err)
+
+	client := NewClient(ns, pool)
+	locks, err := client.UniqueLocks()
+	assert.NoError(t, err)
+	if assert.Len(t, locks, 2) {
+		assert.Equal(t, "taw", locks[0].JobName)
+		assert.Equal(t, "work:unique:taw:user:7", locks[0].Key)
+		assert.Equal(t, job.ID, locks[0].JobID)
+		assert.True(t, locks[0].TTL > 0 && locks[0].TTL <= 600)
+		assert.Equal(t, "wat", locks[1].JobName)
+		assert.Equal(t, "", locks[1].JobID)
+
+		// Deleting a lock lets a duplicate be enqueued.
+		assert.NoError(t, client.DeleteUniqueLock(locks[1].Key))
+		assert.Equal(t, ErrNotDeleted, client.DeleteUniqueLock(locks[1].Key))
+		job, err = enqueuer.EnqueueUnique("wat", Q{"a": 1})
+		assert.NoError(t, err)
+		assert.NotNil(t, job)
+	}
+
+	assert.Error(t, client.DeleteUniqueLock("work:jobs:wat"))
+}
+
+func TestClientRetryDeadJobExpired(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	conn := pool.Get()
+	defer conn.Close()
+	for i, expiresAt := range []int64{nowEpochSeconds() - 1, nowEpochSeconds() + 3600} {
+		job := &Job{Name: "wat", ID: makeIdentifier(), EnqueuedAt: 12345, Fails: 3, FailedAt: 12347 + int64(i), ExpiresAt: expiresAt}
+		rawJSON, _ := job.serialize()
+		_, err := conn.Do("ZADD", redisKeyDead(ns), job.FailedAt, rawJSON)
+		assert.NoError(t, err)
+	}
+	_, err := conn.Do("SADD", redisKeyKnownJobs(ns), "wat")
+	assert.NoError(t, err)
+
+	client := NewClient(ns, pool)
+	jobs, _, err := client.DeadJobs(1)
+	assert.NoError(t, err)
+	if assert.Len(t, jobs, 2) {
+		assert.Equal(t, ErrJobExpired, client.RetryDeadJob(jobs[0].DiedAt, jobs[0].ID))
+		assert.NoError(t, client.RetryDeadJob(jobs[1].DiedAt, jobs[1].ID))
+	}
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyDead(ns)))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 1, hgetInt64(pool, redisKeyMetricsCounter(ns, metricExpired), "wat"))
+
+	// RetryAllDeadJobs drops them too.
+	job := &Job{Name: "wat", ID: makeIdentifier(), EnqueuedAt: 12345, Fails: 3, FailedAt: 12347, ExpiresAt: nowEpochSeconds() - 1}
+	rawJSON, _ := job.serialize()
+	_, err = conn.Do("ZADD", redisKeyDead(ns), job.FailedAt, rawJSON)
+	assert.NoError(t, err)
+	assert.NoError(t, client.RetryAllDeadJobs())
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyDead(ns)))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 2, hgetInt64(pool, redisKeyMetricsCounter(ns, metricExpired), "wat"))
+}
+
+func TestClientCancelJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	client := NewClient(ns, pool)
+	enq := NewEnqueuer(ns, pool)
+
+	// A job in its queue, behind others.
+	for i := 0; i < 3; i++ {
+		_, err := enq.Enqueue("wat", Q{"i": i})
+		assert.NoError(t, err)
+	}
+	queued, err := enq.EnqueueUnique("wat", Q{"i": 3})
+	assert.NoError(t, err)
+	ok, err := client.CancelJob(queued.ID)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.EqualValues(t, 3, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	// Its uniqueness was released.
+	queued, err = enq.EnqueueUnique("wat", Q{"i": 3})
+	assert.NoError(t, err)
+	assert.NotNil(t, queued)
+
+	// A scheduled job.
+	scheduled, err := enq.EnqueueIn("wat", 100, nil)
+	assert.NoError(t, err)
+	ok, err = client.CancelJob(scheduled.ID)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyScheduled(ns)))
+
+	// A job waiting to be retried.
+	job := &Job{Name: "wat", ID: makeIdentifier(), EnqueuedAt: 12345, Fails: 1}
+	rawJSON, _ := job.serialize()
+	conn := pool.Get()
+	defer conn.Close()
+	_, err = conn.Do("ZADD", redisKeyRetry(ns), nowEpochSeconds()+100, rawJSON)
+	assert.NoError(t, err)
+	ok, err = client.CancelJob(job.ID)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+
+	// A job in progress is neither deleted nor remembered.
+	job = &Job{Name: "wat", ID: makeIdentifier(), EnqueuedAt: 12345}
+	rawJSON, _ = job.serialize()
+	_, err = conn.Do("SADD", redisKeyWorkerPools(ns), "1")
+	assert.NoError(t, err)
+	_, err = conn.Do("LPUSH", redisKeyJobsInProgress(ns, "1", "wat"), rawJSON)
+	assert.NoError(t, err)
+	ok, err = client.CancelJob(job.ID)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobsInProgress(ns, "1", "wat")))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyCancelled(ns)))
+
+	// A job that isn't found is remembered.
+	ok, err = client.CancelJob("nope")
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyCancelled(ns)))
+	assert.EqualValues(t, 4, listSize(pool, redisKeyJobs(ns, "wat")))
+}
+
+func TestClientCancelJobPaged(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	client := NewClient(ns, pool)
+	enq := NewEnqueuer(ns, pool).EnableJobIndex()
+
+	// Jobs past the first page of their queue and of the scheduled job queue.
+	conn := pool.Get()
+	defer conn.Close()
+	for i := 0; i < jobSearchPageSize+10; i++ {
+		job := &Job{Name: "wat", ID: makeIdentifier(), EnqueuedAt: 12345}
+		rawJSON, _ := job.serialize()
+		_, err := conn.Do("RPUSH", redisKeyJobs(ns, "wat"), rawJSON)
+		assert.NoError(t, err)
+		_, err = conn.Do("ZADD", redisKeyScheduled(ns), 1, rawJSON)
+		assert.NoError(t, err)
+	}
+	queued, err := enq.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	scheduled, err := enq.EnqueueIn("wat", 100, nil)
+	assert.NoError(t, err)
+
+	ok, err := client.CancelJob(queued.ID)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.EqualValues(t, jobSearchPageSize+10, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	// Without the index hint too.
+	_, err = conn.Do("DEL", redisKeyJobIndex(ns, scheduled.ID))
+	assert.NoError(t, err)
+	ok, err = client.CancelJob(scheduled.ID)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.EqualValues(t, jobSearchPageSize+10, zsetSize(pool, redisKeyScheduled(ns)))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyCancelled(ns)))
+}
@@ -26,6 +26,8 @@ type deadPoolReaper struct {
 
 	stopChan         chan struct{}
 	doneStoppingChan chan struct{}
+
+	logger Logger
 }
 
 func newDeadPoolReaper(namespace string, pool *redis.Pool, curJobNames []string, jobTypes map[string]*jobType) *deadPoolReaper {
@@ -66,7 +68,7 @@ func (r *deadPoolReaper) loop() {
 
 			// Reap
 			if err := r.reap(); err != nil {
-				logError("dead_pool_reaper.reap", err)
+				logError(r.logger, "dead_pool_reaper.reap", err)
 			}
 		}
 	}
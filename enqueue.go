@@ -1,6 +1,7 @@
 package work
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -16,7 +17,11 @@ type Enqueuer struct {
 	knownJobs             map[string]int64
 	enqueueUniqueScript   *redis.Script
 	enqueueUniqueInScript *redis.Script
+	enqueuePriorityScript *redis.Script
 	mtx                   sync.RWMutex
+	logger                Logger
+	tracer                Tracer
+	jobIndex              bool // record where jobs are enqueued, see EnableJobIndex
 }
 
 // NewEnqueuer creates a new enqueuer with the specified Redis namespace and Redis pool.
@@ -30,20 +35,37 @@ func NewEnqueuer(namespace string, pool *redis.Pool) *Enqueuer {
 		Pool:                  pool,
 		queuePrefix:           redisKeyJobsPrefix(namespace),
 		knownJobs:             make(map[string]int64),
-		enqueueUniqueScript:   redis.NewScript(3, redisLuaEnqueueUnique),
-		enqueueUniqueInScript: redis.NewScript(3, redisLuaEnqueueUniqueIn),
+		enqueueUniqueScript:   redis.NewScript(4, redisLuaIndexAndStatus(redisLuaEnqueueUnique)),
+		enqueueUniqueInScript: redis.NewScript(4, redisLuaIndexAndStatus(redisLuaEnqueueUniqueIn)),
+		enqueuePriorityScript: redis.NewScript(1, redisLuaEnqueuePriority),
 	}
 }
 
+// SetLogger sets the logger that the enqueuer logs to, instead of the default one that writes to stdout.
+// Enqueued jobs are logged at LogLevelDebug.
+func (e *Enqueuer) SetLogger(l Logger) {
+	e.logger = l
+}
+
 // Enqueue will enqueue the specified job name and arguments. The args param can be nil if no args ar needed.
+// Options such as WithMaxFails override the options of the job type for this job.
 // Example: e.Enqueue("send_email", work.Q{"addr": "test@example.com"})
-func (e *Enqueuer) Enqueue(jobName string, args map[string]interface{}) (*Job, error) {
+func (e *Enqueuer) Enqueue(jobName string, args map[string]interface{}, opts ...EnqueueOption) (*Job, error) {
+	return e.EnqueueContext(context.Background(), jobName, args, opts...)
+}
+
+// EnqueueContext enqueues a job as per Enqueue. If the enqueuer has a Tracer, the trace context of ctx is stored in the job,
+// so that the span around the job's handler is part of the same trace. It's a shorthand for WithTraceContext(ctx).
+func (e *Enqueuer) EnqueueContext(ctx context.Context, jobName string, args map[string]interface{}, opts ...EnqueueOption) (*Job, error) {
 	job := &Job{
 		Name:       jobName,
 		ID:         makeIdentifier(),
 		EnqueuedAt: nowEpochSeconds(),
 		Args:       args,
 	}
+	op := newEnqueueOp(append([]EnqueueOption{WithTraceContext(ctx)}, opts...))
+	op.apply(job)
+	e.injectTraceContext(op.TraceContext, job)
 
 	rawJSON, err := job.serialize()
 	if err != nil {
@@ -53,9 +75,19 @@ func (e *Enqueuer) Enqueue(jobName string, args map[string]interface{}) (*Job, e
 	conn := e.Pool.Get()
 	defer conn.Close()
 
-	if _, err := conn.Do("LPUSH", e.queuePrefix+jobName, rawJSON); err != nil {
+	if err := e.indexJob(conn, job, e.queuePrefix+jobName); err != nil {
 		return nil, err
 	}
+	sendJobStatus(conn, e.Namespace, job, JobStatusQueued, "enqueued_at", job.EnqueuedAt)
+	if job.Priority > 0 {
+		_, err = e.enqueuePriorityScript.Do(conn, e.queuePrefix+jobName, rawJSON, job.Priority)
+	} else {
+		_, err = conn.Do("LPUSH", e.queuePrefix+jobName, rawJSON)
+	}
+	if err != nil {
+		return nil, err
+	}
+	logDebug(e.logger, "enqueuer.enqueue", "job_name", jobName, "job_id", job.ID)
 
 	if err := e.addToKnownJobs(conn, jobName); err != nil {
 		return job, err
@@ -65,14 +97,33 @@ func (e *Enqueuer) Enqueue(jobName string, args map[string]interface{}) (*Job, e
 }
 
 // EnqueueIn enqueues a job in the scheduled job queue for execution in secondsFromNow seconds.
-func (e *Enqueuer) EnqueueIn(jobName string, secondsFromNow int64, args map[string]interface{}) (*ScheduledJob, error) {
+func (e *Enqueuer) EnqueueIn(jobName string, secondsFromNow int64, args map[string]interface{}, opts ...EnqueueOption) (*ScheduledJob, error) {
+	return e.EnqueueInContext(context.Background(), jobName, secondsFromNow, args, opts...)
+}
+
+// EnqueueInContext enqueues a job as per EnqueueIn, storing the trace context of ctx in it as per EnqueueContext.
+func (e *Enqueuer) EnqueueInContext(ctx context.Context, jobName string, secondsFromNow int64, args map[string]interface{}, opts ...EnqueueOption) (*ScheduledJob, error) {
+	return e.EnqueueAtContext(ctx, jobName, time.Unix(nowEpochSeconds()+secondsFromNow, 0), args, opts...)
+}
+
+// EnqueueAt enqueues a job in the scheduled job queue for execution at runAt, with millisecond precision.
+// The time zone of runAt doesn't matter: eg, 9am in the customer's time zone is time.Date(y, m, d, 9, 0, 0, 0, customerLocation).
+func (e *Enqueuer) EnqueueAt(jobName string, runAt time.Time, args map[string]interface{}, opts ...EnqueueOption) (*ScheduledJob, error) {
+	return e.EnqueueAtContext(context.Background(), jobName, runAt, args, opts...)
+}
+
+// EnqueueAtContext enqueues a job as per EnqueueAt, storing the trace context of ctx in it as per EnqueueContext.
+func (e *Enqueuer) EnqueueAtContext(ctx context.Context, jobName string, runAt time.Time, args map[string]interface{}, opts ...EnqueueOption) (*ScheduledJob, error) {
 	job := &Job{
 		Name:        jobName,
 		ID:          makeIdentifier(),
 		EnqueuedAt:  nowEpochSeconds(),
 		Args:        args,
-		ScheduledAt: nowEpochSeconds() + secondsFromNow,
+		ScheduledAt: runAt.Unix(),
 	}
+	op := newEnqueueOp(append([]EnqueueOption{WithTraceContext(ctx)}, opts...))
+	op.apply(job)
+	e.injectTraceContext(op.TraceContext, job)
 
 	rawJSON, err := job.serialize()
 	if err != nil {
@@ -82,15 +133,17 @@ func (e *Enqueuer) EnqueueIn(jobName string, secondsFromNow int64, args map[stri
 	conn := e.Pool.Get()
 	defer conn.Close()
 
-	scheduledJob := &ScheduledJob{
-		RunAt: nowEpochSeconds() + secondsFromNow,
-		Job:   job,
-	}
+	scheduledJob := newScheduledJob(job, runAt.UnixMilli())
 
-	_, err = conn.Do("ZADD", redisKeyScheduled(e.Namespace), scheduledJob.RunAt, rawJSON)
+	if err := e.indexJob(conn, job, redisKeyScheduled(e.Namespace)); err != nil {
+		return nil, err
+	}
+	sendJobStatus(conn, e.Namespace, job, JobStatusQueued, "enqueued_at", job.EnqueuedAt)
+	_, err = conn.Do("ZADD", redisKeyScheduled(e.Namespace), epochMillisScore(scheduledJob.RunAtMillis), rawJSON)
 	if err != nil {
 		return nil, err
 	}
+	logDebug(e.logger, "enqueuer.enqueue_at", "job_name", jobName, "job_id", job.ID, "run_at", scheduledJob.RunAt)
 
 	if err := e.addToKnownJobs(conn, jobName); err != nil {
 		return scheduledJob, err
@@ -99,13 +152,64 @@ func (e *Enqueuer) EnqueueIn(jobName string, secondsFromNow int64, args map[stri
 	return scheduledJob, nil
 }
 
+// EnqueueOp holds the options of an enqueue, as set by EnqueueOption's.
 type EnqueueOp struct {
-	Expire    int
-	UniqueKey string
+	Expire     int
+	UniqueKey  string
+	UniqueArgs []string
+	UniqueMode UniqueMode
+	Debounce   bool
+
+	// Options of the job, overriding those of its job type. See Job.
+	Priority  uint
+	MaxFails  uint
+	Timeout   int
+	Backoff   string
+	TTL       time.Duration
+	ExpiresAt time.Time
+	StatusTTL int64 // seconds, see WithStatus
+
+	TraceContext context.Context // see WithTraceContext
 }
 
+// EnqueueOption is an option of an enqueue, eg WithUniqueKey or WithMaxFails.
 type EnqueueOption func(*EnqueueOp)
 
+func newEnqueueOp(opts []EnqueueOption) *EnqueueOp {
+	op := &EnqueueOp{}
+	for _, opt := range opts {
+		opt(op)
+	}
+	return op
+}
+
+// expire returns the expire time of the uniqueness of a unique job.
+func (op *EnqueueOp) expire() int {
+	if op.Expire > 0 {
+		return op.Expire
+	}
+	return expireTime
+}
+
+// apply sets the options of the job.
+func (op *EnqueueOp) apply(job *Job) {
+	job.Priority = op.Priority
+	job.MaxFails = op.MaxFails
+	job.Timeout = op.Timeout
+	job.Backoff = op.Backoff
+	job.StatusTTL = op.StatusTTL
+	if !op.ExpiresAt.IsZero() {
+		job.ExpiresAt = op.ExpiresAt.Unix()
+	} else if op.TTL > 0 {
+		// Count from when the job is due, rounding up to whole seconds.
+		from := job.ScheduledAt
+		if from == 0 {
+			from = nowEpochSeconds()
+		}
+		job.ExpiresAt = from + int64((op.TTL+time.Second-1)/time.Second)
+	}
+}
+
 // WithExpireTime sets the expire time(in seconds) of the uniqueness of an unique job.
 // The default expire time is 10 minutes.
 // It is ignored if expire time <= 0.
@@ -123,6 +227,132 @@ func WithUniqueKey(k string) EnqueueOption {
 	}
 }
 
+// WithUniqueArgs makes unique jobs distinguished by the specified arguments only, eg WithUniqueArgs("account_id", "report")
+// for a job that also has a "requested_by" argument. Unlike WithUniqueKey, it doesn't add an argument to the job.
+// A missing argument counts as null.
+func WithUniqueArgs(names ...string) EnqueueOption {
+	return func(op *EnqueueOp) {
+		op.UniqueArgs = names
+	}
+}
+
+// UniqueMode is how long a unique job stays unique, see WithUniqueMode.
+type UniqueMode int
+
+// The modes of unique jobs.
+const (
+	// UniqueUntilDone keeps a job unique while it's queued, scheduled or in progress, until it's processed, whether it succeeds
+	// or not. It's the default.
+	UniqueUntilDone UniqueMode = iota
+	// UniqueUntilStart keeps a job unique only while it's queued or scheduled: a duplicate can be enqueued as soon as it starts.
+	UniqueUntilStart
+	// UniqueUntilSuccess keeps a job unique until it succeeds, including while it waits in the retry queue.
+	// Its uniqueness ends if it dies or if it's deleted from the retry queue.
+	UniqueUntilSuccess
+)
+
+func (m UniqueMode) String() string {
+	switch m {
+	case UniqueUntilDone:
+		return "until_done"
+	case UniqueUntilStart:
+		return "until_start"
+	case UniqueUntilSuccess:
+		return "until_success"
+	}
+	return "unknown"
+}
+
+// WithUniqueMode sets how long a unique job stays unique. The default is UniqueUntilDone.
+// With the other modes, the expire time of the uniqueness, see WithExpireTime, is counted from when the job is scheduled to run,
+// and from when it's retried, rather than from when it's enqueued.
+func WithUniqueMode(mode UniqueMode) EnqueueOption {
+	return func(op *EnqueueOp) {
+		op.UniqueMode = mode
+	}
+}
+
+// WithDebounce makes a unique enqueue replace the pending job with the same unique key, rather than be dropped as a duplicate.
+// The pending job is removed from the queue or the scheduled job queue, and the new job, with its own ID and arguments,
+// is enqueued in its place as any other: eg EnqueueUniqueIn pushes the run time out, and EnqueueUnique puts the job at the back
+// of the queue. Both are done atomically, so the job runs once, with the arguments of the last enqueue.
+// If the pending job is already in progress, the new job is enqueued to run after it.
+// A job enqueued without WithDebounce is never replaced: a debounced enqueue of a duplicate is dropped.
+func WithDebounce() EnqueueOption {
+	return func(op *EnqueueOp) {
+		op.Debounce = true
+	}
+}
+
+// applyUnique sets the uniqueness of the job.
+func (op *EnqueueOp) applyUnique(job *Job, uniqueKey string) {
+	job.UniqueKey = uniqueKey
+	job.UniqueMode = op.UniqueMode
+	job.UniqueExpire = op.Expire
+}
+
+// expireAt returns the expire time of the uniqueness of a unique job scheduled at runAt, in epoch seconds.
+// Except with UniqueUntilDone, which keeps the old behavior, it's counted from runAt rather than from now.
+func (op *EnqueueOp) expireAt(runAt int64) int {
+	expire := op.expire()
+	if op.UniqueMode != UniqueUntilDone {
+		if d := runAt - nowEpochSeconds(); d > 0 {
+			expire += int(d)
+		}
+	}
+	return expire
+}
+
+// WithPriority makes the job skip ahead of the jobs of its queue with a lower priority, ie those enqueued without one.
+// Jobs with the same priority keep their order. Unlike JobOptions.Priority, which picks the queue that workers fetch from,
+// this orders the jobs within the queue. It's kept when the job is scheduled or retried.
+func WithPriority(priority uint) EnqueueOption {
+	return func(op *EnqueueOp) {
+		op.Priority = priority
+	}
+}
+
+// WithMaxFails overrides JobOptions.MaxFails for the job.
+func WithMaxFails(maxFails uint) EnqueueOption {
+	return func(op *EnqueueOp) {
+		op.MaxFails = maxFails
+	}
+}
+
+// WithTimeout overrides JobOptions.Timeout for the job. It's kept with millisecond precision.
+func WithTimeout(timeout time.Duration) EnqueueOption {
+	return func(op *EnqueueOp) {
+		op.Timeout = int(timeout / time.Millisecond)
+		if timeout > 0 && op.Timeout == 0 {
+			op.Timeout = 1
+		}
+	}
+}
+
+// WithBackoff overrides JobOptions.Backoff for the job with a named backoff: "default", "exponential", "linear", "constant",
+// or one added with RegisterBackoff.
+func WithBackoff(name string) EnqueueOption {
+	return func(op *EnqueueOp) {
+		op.Backoff = name
+	}
+}
+
+// WithTTL makes the job expire if it hasn't started within ttl of when it's due: when it's enqueued, or when it's scheduled to run.
+// An expired job is dropped instead of being run, whether it's in its queue, the scheduled job queue or the retry queue, and counted
+// in the "expired" counter of the metrics. Expiration has a precision of a second.
+func WithTTL(ttl time.Duration) EnqueueOption {
+	return func(op *EnqueueOp) {
+		op.TTL = ttl
+	}
+}
+
+// WithExpiresAt makes the job expire at expiresAt, as per WithTTL.
+func WithExpiresAt(expiresAt time.Time) EnqueueOption {
+	return func(op *EnqueueOp) {
+		op.ExpiresAt = expiresAt
+	}
+}
+
 const expireTime = 600
 
 // EnqueueUnique enqueues a job unless a job is already enqueued with the same name and arguments.
@@ -130,16 +360,11 @@ const expireTime = 600
 // Only if a job is processed, another job with the same name and arguments can be enqueued again.
 // Any failed jobs in the retry queue or dead queue don't count against the uniqueness -- so if a job fails and is retried, two unique jobs with the same name and arguments can be enqueued at once.
 // In order to add robustness to the system, jobs are only unique for 10 minutes after they're enqueued. This is mostly relevant for scheduled jobs.
+// WithUniqueMode changes both: eg with UniqueUntilSuccess, a job stays unique while it's retried, and scheduled jobs stay unique until they run.
+// With WithDebounce, the job replaces the pending duplicate instead of being dropped.
 // EnqueueUnique returns the job if it was enqueued and nil if it wasn't
 func (e *Enqueuer) EnqueueUnique(jobName string, args map[string]interface{}, opts ...EnqueueOption) (*Job, error) {
-	op := &EnqueueOp{}
-	for _, opt := range opts {
-		opt(op)
-	}
-	expire := expireTime
-	if op.Expire > 0 {
-		expire = op.Expire
-	}
+	op := newEnqueueOp(opts)
 	args, uniqueKey, err := uniqueKey(op, e.Namespace, jobName, args)
 	if err != nil {
 		return nil, err
@@ -152,6 +377,9 @@ func (e *Enqueuer) EnqueueUnique(jobName string, args map[string]interface{}, op
 		Args:       args,
 		Unique:     true,
 	}
+	op.apply(job)
+	op.applyUnique(job, uniqueKey)
+	e.injectTraceContext(op.TraceContext, job)
 
 	rawJSON, err := job.serialize()
 	if err != nil {
@@ -165,14 +393,19 @@ func (e *Enqueuer) EnqueueUnique(jobName string, args map[string]interface{}, op
 		return nil, err
 	}
 
-	scriptArgs := make([]interface{}, 0, 3)
-	scriptArgs = append(scriptArgs, e.queuePrefix+jobName) // KEY[1]
-	scriptArgs = append(scriptArgs, uniqueKey)             // KEY[2]
-	scriptArgs = append(scriptArgs, expire)                // KEY[3]
-	scriptArgs = append(scriptArgs, rawJSON)               // ARGV[1]
+	scriptArgs := make([]interface{}, 0, 20)
+	scriptArgs = append(scriptArgs, e.queuePrefix+jobName)          // KEY[1]
+	scriptArgs = append(scriptArgs, uniqueKey)                      // KEY[2]
+	scriptArgs = append(scriptArgs, op.expire())                    // KEY[3]
+	scriptArgs = append(scriptArgs, redisKeyScheduled(e.Namespace)) // KEY[4]
+	scriptArgs = append(scriptArgs, rawJSON)                        // ARGV[1]
+	scriptArgs = append(scriptArgs, job.Priority)                   // ARGV[2]
+	scriptArgs = append(scriptArgs, op.Debounce)                    // ARGV[3]
+	scriptArgs = append(scriptArgs, e.indexAndStatusArgs(job)...)   // ARGV[4...]
 
 	res, err := redis.String(e.enqueueUniqueScript.Do(conn, scriptArgs...))
-	if res == "ok" && err == nil {
+	if (res == "ok" || res == "replaced") && err == nil {
+		logDebug(e.logger, "enqueuer.enqueue_unique", "job_name", jobName, "job_id", job.ID, "replaced", res == "replaced")
 		return job, nil
 	}
 	return nil, err
@@ -180,15 +413,12 @@ func (e *Enqueuer) EnqueueUnique(jobName string, args map[string]interface{}, op
 
 // EnqueueUniqueIn enqueues a unique job in the scheduled job queue for execution in secondsFromNow seconds. See EnqueueUnique for the semantics of unique jobs.
 func (e *Enqueuer) EnqueueUniqueIn(jobName string, secondsFromNow int64, args map[string]interface{}, opts ...EnqueueOption) (*ScheduledJob, error) {
-	op := &EnqueueOp{}
-	for _, opt := range opts {
-		opt(op)
-	}
-	expire := expireTime
-	if op.Expire > 0 {
-		expire = op.Expire
-	}
+	return e.EnqueueUniqueAt(jobName, time.Unix(nowEpochSeconds()+secondsFromNow, 0), args, opts...)
+}
 
+// EnqueueUniqueAt enqueues a unique job in the scheduled job queue for execution at runAt, as per EnqueueAt. See EnqueueUnique for the semantics of unique jobs.
+func (e *Enqueuer) EnqueueUniqueAt(jobName string, runAt time.Time, args map[string]interface{}, opts ...EnqueueOption) (*ScheduledJob, error) {
+	op := newEnqueueOp(opts)
 	args, uniqueKey, err := uniqueKey(op, e.Namespace, jobName, args)
 	if err != nil {
 		return nil, err
@@ -200,8 +430,11 @@ func (e *Enqueuer) EnqueueUniqueIn(jobName string, secondsFromNow int64, args ma
 		EnqueuedAt:  nowEpochSeconds(),
 		Args:        args,
 		Unique:      true,
-		ScheduledAt: nowEpochSeconds() + secondsFromNow,
+		ScheduledAt: runAt.Unix(),
 	}
+	op.apply(job)
+	op.applyUnique(job, uniqueKey)
+	e.injectTraceContext(op.TraceContext, job)
 
 	rawJSON, err := job.serialize()
 	if err != nil {
@@ -215,21 +448,22 @@ func (e *Enqueuer) EnqueueUniqueIn(jobName string, secondsFromNow int64, args ma
 		return nil, err
 	}
 
-	scheduledJob := &ScheduledJob{
-		RunAt: nowEpochSeconds() + secondsFromNow,
-		Job:   job,
-	}
+	scheduledJob := newScheduledJob(job, runAt.UnixMilli())
 
-	scriptArgs := make([]interface{}, 0, 4)
-	scriptArgs = append(scriptArgs, redisKeyScheduled(e.Namespace)) // KEY[1]
-	scriptArgs = append(scriptArgs, uniqueKey)                      // KEY[2]
-	scriptArgs = append(scriptArgs, expire)                         // KEY[3]
-	scriptArgs = append(scriptArgs, rawJSON)                        // ARGV[1]
-	scriptArgs = append(scriptArgs, scheduledJob.RunAt)             // ARGV[2]
+	scriptArgs := make([]interface{}, 0, 20)
+	scriptArgs = append(scriptArgs, redisKeyScheduled(e.Namespace))             // KEY[1]
+	scriptArgs = append(scriptArgs, uniqueKey)                                  // KEY[2]
+	scriptArgs = append(scriptArgs, op.expireAt(job.ScheduledAt))               // KEY[3]
+	scriptArgs = append(scriptArgs, e.queuePrefix+jobName)                      // KEY[4]
+	scriptArgs = append(scriptArgs, rawJSON)                                    // ARGV[1]
+	scriptArgs = append(scriptArgs, epochMillisScore(scheduledJob.RunAtMillis)) // ARGV[2]
+	scriptArgs = append(scriptArgs, op.Debounce)                                // ARGV[3]
+	scriptArgs = append(scriptArgs, e.indexAndStatusArgs(job)...)               // ARGV[4...]
 
 	res, err := redis.String(e.enqueueUniqueInScript.Do(conn, scriptArgs...))
 
-	if res == "ok" && err == nil {
+	if (res == "ok" || res == "replaced") && err == nil {
+		logDebug(e.logger, "enqueuer.enqueue_unique_at", "job_name", jobName, "job_id", job.ID, "run_at", scheduledJob.RunAt, "replaced", res == "replaced")
 		return scheduledJob, nil
 	}
 	return nil, err
@@ -243,11 +477,37 @@ func uniqueKey(op *EnqueueOp, namespace, jobName string, args map[string]interfa
 			args = make(map[string]interface{}, 1)
 		}
 		args[UniqueKeyArg] = op.UniqueKey
+	} else if len(op.UniqueArgs) > 0 {
+		uniqueArgs := make(map[string]interface{}, len(op.UniqueArgs))
+		for _, name := range op.UniqueArgs {
+			uniqueArgs[name] = args[name]
+		}
+		k, err := redisKeyUniqueJob(namespace, jobName, uniqueArgs)
+		return args, k, err
 	}
 	k, err := redisKeyUniqueJob(namespace, jobName, args)
 	return args, k, err
 }
 
+// indexAndStatusArgs returns the arguments of redisLuaIndexAndStatus that write the job's entry in the job index, if it's enabled,
+// and its queued status, if it has one, see WithStatus.
+func (e *Enqueuer) indexAndStatusArgs(job *Job) []interface{} {
+	args := make([]interface{}, 0, 16)
+	if e.jobIndex {
+		args = append(args, redisKeyJobIndex(e.Namespace, job.ID), jobIndexExpire(job.ScheduledAt)) // ARGV[4], ARGV[5]
+	} else {
+		args = append(args, "", 0)
+	}
+	if job.StatusTTL > 0 {
+		key, expire, fields := jobStatusFields(e.Namespace, job, JobStatusQueued, "enqueued_at", job.EnqueuedAt)
+		args = append(args, key, expire) // ARGV[6], ARGV[7]
+		args = append(args, fields...)   // ARGV[8...]
+	} else {
+		args = append(args, "", 0)
+	}
+	return args
+}
+
 func (e *Enqueuer) addToKnownJobs(conn redis.Conn, jobName string) error {
 	needSadd := true
 	now := time.Now().Unix()
@@ -0,0 +1,324 @@
+package work
+
+import (
+	"github.com/garyburd/redigo/redis"
+)
+
+// bulkChunkSize is the number of jobs that EnqueueMany and friends write per round trip to Redis.
+const bulkChunkSize = 1000
+
+// BulkJob is a job to enqueue with EnqueueMany, EnqueueManyIn or EnqueueUniqueMany.
+type BulkJob struct {
+	Name    string
+	Args    map[string]interface{}
+	Options []EnqueueOption // eg WithMaxFails, or WithUniqueKey for EnqueueUniqueMany
+}
+
+// BulkJobs is a sequence of jobs to enqueue in bulk. It has the shape of an iter.Seq[BulkJob], so that jobs can be generated
+// while they're enqueued instead of being held in memory. Use BulkJobSlice for a slice.
+type BulkJobs func(yield func(BulkJob) bool)
+
+// BulkJobSlice returns the BulkJobs that yields each job of jobs.
+func BulkJobSlice(jobs []BulkJob) BulkJobs {
+	return func(yield func(BulkJob) bool) {
+		for _, j := range jobs {
+			if !yield(j) {
+				return
+			}
+		}
+	}
+}
+
+// BulkStatus is what happened to a job enqueued in bulk.
+type BulkStatus int
+
+// The statuses of jobs enqueued in bulk.
+const (
+	BulkEnqueued  BulkStatus = iota // the job was enqueued
+	BulkDuplicate                   // the job wasn't enqueued because it's unique and a job with the same unique key is already enqueued
+	BulkFailed                      // the job wasn't enqueued because of Err
+	BulkReplaced                    // the job was enqueued, replacing the pending job with the same unique key, see WithDebounce
+)
+
+func (s BulkStatus) String() string {
+	switch s {
+	case BulkEnqueued:
+		return "enqueued"
+	case BulkDuplicate:
+		return "duplicate"
+	case BulkFailed:
+		return "failed"
+	case BulkReplaced:
+		return "replaced"
+	}
+	return "unknown"
+}
+
+// BulkResult is the result of enqueueing one job in bulk. Results are in the same order as the jobs.
+type BulkResult struct {
+	Job    *Job // the job as it was, or would have been, enqueued. It's nil if it couldn't be built, eg if its unique key couldn't be computed.
+	Status BulkStatus
+	Err    error // set if Status is BulkFailed
+}
+
+// EnqueueMany enqueues jobs as per Enqueue, in chunks that each take a single round trip to Redis.
+// A failure only affects the jobs it's about, so the result of each job must be checked. See BulkResult.
+func (e *Enqueuer) EnqueueMany(jobs BulkJobs) []BulkResult {
+	return e.enqueueBulk(jobs, 0, false)
+}
+
+// EnqueueManyIn enqueues jobs in the scheduled job queue for execution in secondsFromNow seconds, as per EnqueueIn and EnqueueMany.
+func (e *Enqueuer) EnqueueManyIn(secondsFromNow int64, jobs BulkJobs) []BulkResult {
+	return e.enqueueBulk(jobs, secondsFromNow, false)
+}
+
+// EnqueueUniqueMany enqueues unique jobs as per EnqueueUnique and EnqueueMany, with the options of each job.
+// The jobs that weren't enqueued because they're duplicates have the status BulkDuplicate, and those that replaced a pending job,
+// with WithDebounce, have the status BulkReplaced.
+func (e *Enqueuer) EnqueueUniqueMany(jobs BulkJobs) []BulkResult {
+	return e.enqueueBulk(jobs, 0, true)
+}
+
+// bulkItem is a job of the chunk being enqueued, along with what's needed to write it.
+type bulkItem struct {
+	result    *BulkResult
+	rawJSON   []byte
+	uniqueKey string
+	expire    int
+	debounce  bool
+}
+
+func (e *Enqueuer) enqueueBulk(jobs BulkJobs, secondsFromNow int64, unique bool) []BulkResult {
+	var results []BulkResult
+	var chunk []BulkJob
+
+	flush := func() {
+		start := len(results)
+		results = append(results, make([]BulkResult, len(chunk))...)
+		e.enqueueChunk(chunk, results[start:], secondsFromNow, unique)
+		chunk = chunk[:0]
+	}
+
+	jobs(func(j BulkJob) bool {
+		chunk = append(chunk, j)
+		if len(chunk) == bulkChunkSize {
+			flush()
+		}
+		return true
+	})
+	if len(chunk) > 0 {
+		flush()
+	}
+
+	return results
+}
+
+// EnqueueJobs enqueues jobs that were built beforehand, eg by the outbox package, in chunks as per EnqueueMany.
+// Jobs are enqueued as they are, keeping their ID and EnqueuedAt, except that an empty ID is replaced with a new one.
+// Jobs with a ScheduledAt are put in the scheduled job queue, to run at that time.
+func (e *Enqueuer) EnqueueJobs(jobs []*Job) []BulkResult {
+	results := make([]BulkResult, len(jobs))
+	for start := 0; start < len(jobs); start += bulkChunkSize {
+		end := start + bulkChunkSize
+		if end > len(jobs) {
+			end = len(jobs)
+		}
+		items := make([]bulkItem, 0, end-start)
+		for i, job := range jobs[start:end] {
+			result := &results[start+i]
+			result.Job = job
+			if job.ID == "" {
+				job.ID = makeIdentifier()
+			}
+			rawJSON, err := job.serialize()
+			if err != nil {
+				result.Status = BulkFailed
+				result.Err = err
+				continue
+			}
+			items = append(items, bulkItem{result: result, rawJSON: rawJSON})
+		}
+		e.writeBulkItems(items, false)
+	}
+	return results
+}
+
+// enqueueChunk enqueues the jobs of a chunk, writing their results to results, which has the same length.
+func (e *Enqueuer) enqueueChunk(jobs []BulkJob, results []BulkResult, secondsFromNow int64, unique bool) {
+	now := nowEpochSeconds()
+	items := make([]bulkItem, 0, len(jobs))
+	for i, j := range jobs {
+		item, err := e.newBulkItem(j, &results[i], now, secondsFromNow, unique)
+		if err != nil {
+			results[i].Status = BulkFailed
+			results[i].Err = err
+			continue
+		}
+		items = append(items, item)
+	}
+	e.writeBulkItems(items, unique)
+}
+
+// writeBulkItems writes items to Redis in a single round trip, setting their results.
+func (e *Enqueuer) writeBulkItems(items []bulkItem, unique bool) {
+	if len(items) == 0 {
+		return
+	}
+
+	conn := e.Pool.Get()
+	defer conn.Close()
+
+	fail := func(items []bulkItem, err error) {
+		for _, item := range items {
+			item.result.Status = BulkFailed
+			item.result.Err = err
+		}
+	}
+
+	// Make sure all the job names are known before enqueueing, as EnqueueUnique does, so that no job is enqueued without it.
+	knownErrs := make(map[string]error)
+	ready := items[:0]
+	for _, item := range items {
+		name := item.result.Job.Name
+		err, ok := knownErrs[name]
+		if !ok {
+			err = e.addToKnownJobs(conn, name)
+			knownErrs[name] = err
+		}
+		if err != nil {
+			fail([]bulkItem{item}, err)
+			continue
+		}
+		ready = append(ready, item)
+	}
+	items = ready
+	if len(items) == 0 {
+		return
+	}
+
+	// Load the scripts we need, so that they can be pipelined with EVALSHA.
+	var loadPriority bool
+	for _, item := range items {
+		job := item.result.Job
+		loadPriority = loadPriority || job.Priority > 0 && job.ScheduledAt == 0
+	}
+	if unique {
+		if err := e.enqueueUniqueScript.Load(conn); err != nil {
+			fail(items, err)
+			return
+		}
+	} else if loadPriority {
+		if err := e.enqueuePriorityScript.Load(conn); err != nil {
+			fail(items, err)
+			return
+		}
+	}
+
+	for _, item := range items {
+		job := item.result.Job
+		if unique {
+			// The script writes the job's entry in the job index and its status if it's enqueued.
+			args := []interface{}{e.queuePrefix + job.Name, item.uniqueKey, item.expire, redisKeyScheduled(e.Namespace), item.rawJSON, job.Priority, item.debounce}
+			e.enqueueUniqueScript.SendHash(conn, append(args, e.indexAndStatusArgs(job)...)...)
+			continue
+		}
+		// The status is written first, so that a worker can't fetch the job before it says it's queued.
+		sendJobStatus(conn, e.Namespace, job, JobStatusQueued, "enqueued_at", job.EnqueuedAt)
+		if job.ScheduledAt > 0 {
+			e.indexJob(conn, job, redisKeyScheduled(e.Namespace))
+		} else {
+			e.indexJob(conn, job, e.queuePrefix+job.Name)
+		}
+		switch {
+		case job.ScheduledAt > 0:
+			conn.Send("ZADD", redisKeyScheduled(e.Namespace), job.ScheduledAt, item.rawJSON)
+		case job.Priority > 0:
+			e.enqueuePriorityScript.SendHash(conn, e.queuePrefix+job.Name, item.rawJSON, job.Priority)
+		default:
+			conn.Send("LPUSH", e.queuePrefix+job.Name, item.rawJSON)
+		}
+	}
+	if err := conn.Flush(); err != nil {
+		fail(items, err)
+		return
+	}
+
+	var enqueued, duplicates, replaced, failed int
+	for _, item := range items {
+		if !unique {
+			if item.result.Job.StatusTTL > 0 {
+				// HMSET and EXPIRE
+				for i := 0; i < 2; i++ {
+					if _, err := conn.Receive(); err != nil {
+						logError(e.logger, "enqueuer.enqueue_many.status", err, "job_name", item.result.Job.Name, "job_id", item.result.Job.ID)
+					}
+				}
+			}
+			if e.jobIndex {
+				if _, err := conn.Receive(); err != nil {
+					logError(e.logger, "enqueuer.enqueue_many.index", err, "job_name", item.result.Job.Name, "job_id", item.result.Job.ID)
+				}
+			}
+		}
+		reply, err := conn.Receive()
+		if err == nil && unique {
+			var res string
+			res, err = redis.String(reply, err)
+			if err == nil && res == "replaced" {
+				item.result.Status = BulkReplaced
+				replaced++
+				continue
+			}
+			if err == nil && res != "ok" {
+				item.result.Status = BulkDuplicate
+				duplicates++
+				continue
+			}
+		}
+		if err != nil {
+			fail([]bulkItem{item}, err)
+			failed++
+			continue
+		}
+		item.result.Status = BulkEnqueued
+		enqueued++
+	}
+	logDebug(e.logger, "enqueuer.enqueue_many", "enqueued", enqueued, "duplicates", duplicates, "replaced", replaced, "failed", failed)
+}
+
+func (e *Enqueuer) newBulkItem(j BulkJob, result *BulkResult, now, secondsFromNow int64, unique bool) (bulkItem, error) {
+	job := &Job{
+		Name:       j.Name,
+		ID:         makeIdentifier(),
+		EnqueuedAt: now,
+		Args:       j.Args,
+		Unique:     unique,
+	}
+	if secondsFromNow > 0 {
+		job.ScheduledAt = now + secondsFromNow
+	}
+	op := newEnqueueOp(j.Options)
+	op.apply(job)
+	e.injectTraceContext(op.TraceContext, job)
+
+	item := bulkItem{result: result}
+	if unique {
+		item.expire = op.expire()
+		args, uniqueKey, err := uniqueKey(op, e.Namespace, j.Name, j.Args)
+		if err != nil {
+			return item, err
+		}
+		job.Args = args
+		op.applyUnique(job, uniqueKey)
+		item.uniqueKey = uniqueKey
+		item.debounce = op.Debounce
+	}
+	result.Job = job
+
+	rawJSON, err := job.serialize()
+	if err != nil {
+		return item, err
+	}
+	item.rawJSON = rawJSON
+	return item, nil
+}
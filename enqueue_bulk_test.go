@@ -0,0 +1,162 @@
+package work
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnqueueMany(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	// More than a chunk, from an iterator.
+	n := bulkChunkSize + 10
+	results := enqueuer.EnqueueMany(func(yield func(BulkJob) bool) {
+		for i := 0; i < n; i++ {
+			name := "wat"
+			if i%2 == 1 {
+				name = "taw"
+			}
+			if !yield(BulkJob{Name: name, Args: Q{"i": i}}) {
+				return
+			}
+		}
+	})
+	assert.Equal(t, n, len(results))
+	for i, res := range results {
+		assert.Equal(t, BulkEnqueued, res.Status)
+		assert.NoError(t, res.Err)
+		assert.EqualValues(t, i, res.Job.ArgInt64("i"))
+	}
+	assert.EqualValues(t, n/2, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, n/2, listSize(pool, redisKeyJobs(ns, "taw")))
+	known := knownJobs(pool, redisKeyKnownJobs(ns))
+	sort.Strings(known)
+	assert.Equal(t, []string{"taw", "wat"}, known)
+
+	j := jobOnQueue(pool, redisKeyJobs(ns, "wat"))
+	assert.Equal(t, "wat", j.Name)
+	assert.EqualValues(t, 0, j.ArgInt64("i"))
+
+	// A job that can't be serialized only fails itself.
+	results = enqueuer.EnqueueMany(BulkJobSlice([]BulkJob{
+		{Name: "wat", Args: Q{"i": 1}},
+		{Name: "wat", Args: Q{"i": math.NaN()}},
+		{Name: "wat", Args: Q{"i": 3}},
+	}))
+	assert.Equal(t, 3, len(results))
+	assert.Equal(t, BulkEnqueued, results[0].Status)
+	assert.Equal(t, BulkFailed, results[1].Status)
+	assert.Error(t, results[1].Err)
+	assert.Equal(t, BulkEnqueued, results[2].Status)
+	assert.EqualValues(t, n/2-1+2, listSize(pool, redisKeyJobs(ns, "wat"))) // jobOnQueue popped one
+
+	assert.Equal(t, 0, len(enqueuer.EnqueueMany(BulkJobSlice(nil))))
+}
+
+func TestEnqueueManyIn(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	results := enqueuer.EnqueueManyIn(300, BulkJobSlice([]BulkJob{
+		{Name: "wat", Args: Q{"a": 1}},
+		{Name: "wat", Args: Q{"a": 2}},
+	}))
+	assert.Equal(t, 2, len(results))
+	for _, res := range results {
+		assert.Equal(t, BulkEnqueued, res.Status)
+		assert.True(t, res.Job.ScheduledAt > time.Now().Unix()+290)
+	}
+	assert.EqualValues(t, 2, zsetSize(pool, redisKeyScheduled(ns)))
+	assert.EqualValues(t, []string{"wat"}, knownJobs(pool, redisKeyKnownJobs(ns)))
+
+	runAt, j := jobOnZset(pool, redisKeyScheduled(ns))
+	assert.Equal(t, j.ScheduledAt, runAt)
+	assert.Equal(t, "wat", j.Name)
+}
+
+func TestEnqueueUniqueMany(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	job, err := enqueuer.EnqueueUnique("wat", Q{"a": 1})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+
+	results := enqueuer.EnqueueUniqueMany(BulkJobSlice([]BulkJob{
+		{Name: "wat", Args: Q{"a": 1}},
+		{Name: "wat", Args: Q{"a": 2}},
+		{Name: "wat", Args: Q{"a": 2}},
+		{Name: "wat", Args: Q{"a": 3}, Options: []EnqueueOption{WithUniqueKey("three")}},
+		{Name: "wat", Args: Q{"a": 4}, Options: []EnqueueOption{WithUniqueKey("three")}},
+	}))
+	var statuses []string
+	for _, res := range results {
+		assert.NoError(t, res.Err)
+		assert.True(t, res.Job.Unique)
+		statuses = append(statuses, fmt.Sprint(res.Status))
+	}
+	assert.Equal(t, []string{"duplicate", "enqueued", "duplicate", "enqueued", "duplicate"}, statuses)
+	assert.Equal(t, "three", results[3].Job.ArgString(UniqueKeyArg))
+	assert.EqualValues(t, 3, listSize(pool, redisKeyJobs(ns, "wat")))
+}
+
+func TestEnqueueJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	runAt := time.Now().Unix() + 300
+	results := enqueuer.EnqueueJobs([]*Job{
+		{Name: "wat", ID: "abc", EnqueuedAt: 1, Args: Q{"a": 1}},
+		{Name: "wat", Args: Q{"a": 2}},
+		{Name: "taw", ID: "def", ScheduledAt: runAt},
+	})
+	assert.Equal(t, 3, len(results))
+	for _, res := range results {
+		assert.Equal(t, BulkEnqueued, res.Status)
+	}
+	assert.True(t, len(results[1].Job.ID) > 10)
+
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(ns, "wat")))
+	j := jobOnQueue(pool, redisKeyJobs(ns, "wat"))
+	assert.Equal(t, "abc", j.ID)
+	assert.EqualValues(t, 1, j.EnqueuedAt)
+
+	score, j := jobOnZset(pool, redisKeyScheduled(ns))
+	assert.Equal(t, runAt, score)
+	assert.Equal(t, "def", j.ID)
+}
+
+func TestEnqueueUniqueManyDebounce(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	results := enqueuer.EnqueueUniqueMany(BulkJobSlice([]BulkJob{
+		{Name: "wat", Args: Q{"a": 1}, Options: []EnqueueOption{WithUniqueKey("user:7"), WithDebounce()}},
+		{Name: "wat", Args: Q{"a": 2}, Options: []EnqueueOption{WithUniqueKey("user:7"), WithDebounce()}},
+		{Name: "wat", Args: Q{"a": 3}, Options: []EnqueueOption{WithUniqueKey("user:7")}},
+	}))
+	if assert.Len(t, results, 3) {
+		assert.Equal(t, BulkEnqueued, results[0].Status)
+		assert.Equal(t, BulkReplaced, results[1].Status)
+		assert.Equal(t, BulkDuplicate, results[2].Status)
+	}
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+	j := jobOnQueue(pool, redisKeyJobs(ns, "wat"))
+	assert.EqualValues(t, 2, j.ArgInt64("a"))
+}
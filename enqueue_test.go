@@ -2,10 +2,12 @@ package work
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/garyburd/redigo/redis"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -352,3 +354,327 @@ func TestEnqueueUniqueIn(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, job)
 }
+
+func TestEnqueueAt(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	loc := time.FixedZone("UTC-5", -5*3600)
+	runAt := time.Now().Add(time.Hour).In(loc).Truncate(time.Second).Add(250 * time.Millisecond)
+	job, err := enqueuer.EnqueueAt("wat", runAt, Q{"a": 1})
+	assert.NoError(t, err)
+	if assert.NotNil(t, job) {
+		assert.Equal(t, runAt.Unix(), job.RunAt)
+		assert.Equal(t, runAt.UnixMilli(), job.RunAtMillis)
+		assert.Equal(t, runAt.Unix(), job.ScheduledAt)
+	}
+	assert.EqualValues(t, []string{"wat"}, knownJobs(pool, redisKeyKnownJobs(ns)))
+	assert.InDelta(t, float64(runAt.UnixMilli())/1000, zsetScore(pool, redisKeyScheduled(ns), 0), 0.0001)
+
+	jobs, _, err := NewClient(ns, pool).ScheduledJobs(1)
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(jobs)) {
+		assert.Equal(t, job.ID, jobs[0].ID)
+		assert.Equal(t, runAt.Unix(), jobs[0].RunAt)
+		assert.Equal(t, runAt.UnixMilli(), jobs[0].RunAtMillis)
+	}
+}
+
+func TestEnqueueUniqueAt(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	runAt := time.Now().Add(time.Hour).Truncate(time.Second).Add(500 * time.Millisecond)
+	job, err := enqueuer.EnqueueUniqueAt("wat", runAt, Q{"a": 1})
+	assert.NoError(t, err)
+	if assert.NotNil(t, job) {
+		assert.Equal(t, runAt.UnixMilli(), job.RunAtMillis)
+		assert.True(t, job.Unique)
+	}
+
+	job, err = enqueuer.EnqueueUniqueAt("wat", runAt.Add(time.Minute), Q{"a": 1})
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyScheduled(ns)))
+	assert.InDelta(t, float64(runAt.UnixMilli())/1000, zsetScore(pool, redisKeyScheduled(ns), 0), 0.0001)
+}
+
+func TestEpochMillisScore(t *testing.T) {
+	assert.Equal(t, "1425263409.250", epochMillisScore(1425263409250))
+	assert.Equal(t, "1425263409.000", epochMillisScore(1425263409000))
+	assert.EqualValues(t, 1425263409, floorDiv(1425263409250, 1000))
+	assert.EqualValues(t, -2, floorDiv(-1500, 1000))
+	assert.EqualValues(t, -1, floorDiv(-1000, 1000))
+}
+
+func TestEnqueueWithPriority(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	enqueue := func(name string, opts ...EnqueueOption) {
+		_, err := enqueuer.Enqueue("wat", Q{"name": name}, opts...)
+		assert.NoError(t, err)
+	}
+	enqueue("a")
+	enqueue("b")
+	enqueue("c", WithPriority(5))
+	enqueue("d", WithPriority(10))
+	enqueue("e", WithPriority(5))
+	enqueue("f")
+	job, err := enqueuer.EnqueueUnique("wat", Q{"name": "g"}, WithPriority(7))
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+
+	// Workers fetch from the right end of the queue.
+	conn := pool.Get()
+	defer conn.Close()
+	values, err := redis.Values(conn.Do("LRANGE", redisKeyJobs(ns, "wat"), 0, -1))
+	assert.NoError(t, err)
+	var order []string
+	for i := len(values) - 1; i >= 0; i-- {
+		j, err := newJob(values[i].([]byte), nil, nil)
+		assert.NoError(t, err)
+		order = append(order, j.ArgString("name"))
+	}
+	assert.Equal(t, []string{"d", "g", "c", "e", "a", "b", "f"}, order)
+}
+
+func TestEnqueueOptions(t *testing.T) {
+	op := newEnqueueOp([]EnqueueOption{WithPriority(3), WithMaxFails(4), WithTimeout(1500 * time.Microsecond), WithBackoff("linear")})
+	var job Job
+	op.apply(&job)
+	assert.EqualValues(t, 3, job.Priority)
+	assert.EqualValues(t, 4, job.MaxFails)
+	assert.Equal(t, 1, job.Timeout)
+	assert.Equal(t, "linear", job.Backoff)
+	assert.Equal(t, expireTime, op.expire())
+	assert.Equal(t, 30, newEnqueueOp([]EnqueueOption{WithExpireTime(30)}).expire())
+
+	op = newEnqueueOp([]EnqueueOption{WithTimeout(time.Microsecond)})
+	assert.Equal(t, 1, op.Timeout)
+}
+
+func TestEnqueueUniqueDebounce(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	job, err := enqueuer.EnqueueUnique("wat", Q{"a": 1}, WithUniqueKey("user:7"), WithDebounce())
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+
+	// The second job replaces the first one.
+	job2, err := enqueuer.EnqueueUnique("wat", Q{"a": 2}, WithUniqueKey("user:7"), WithDebounce())
+	assert.NoError(t, err)
+	if assert.NotNil(t, job2) {
+		assert.NotEqual(t, job.ID, job2.ID)
+	}
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	// Without WithDebounce, it's a duplicate as usual.
+	job, err = enqueuer.EnqueueUnique("wat", Q{"a": 3}, WithUniqueKey("user:7"))
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+
+	j := jobOnQueue(pool, redisKeyJobs(ns, "wat"))
+	assert.Equal(t, job2.ID, j.ID)
+	assert.EqualValues(t, 2, j.ArgInt64("a"))
+
+	// Once the job is in progress, a debounced job is enqueued to run after it, and stays unique when it's done.
+	job, err = enqueuer.EnqueueUnique("wat", Q{"a": 4}, WithUniqueKey("user:7"), WithDebounce())
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	w := newWorker(ns, "1", pool, tstCtxType, nil, nil, map[string]*jobType{})
+	w.deleteUniqueJob(j)
+	job, err = enqueuer.EnqueueUnique("wat", Q{"a": 5}, WithUniqueKey("user:7"))
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+
+	// A job enqueued without WithDebounce isn't replaced.
+	job, err = enqueuer.EnqueueUnique("taw", Q{"a": 1})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+	job, err = enqueuer.EnqueueUnique("taw", Q{"a": 1}, WithDebounce())
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "taw")))
+}
+
+func TestEnqueueUniqueInDebounce(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	job, err := enqueuer.EnqueueUniqueIn("wat", 100, Q{"a": 1}, WithUniqueKey("user:7"), WithDebounce())
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+
+	// The run time is pushed out.
+	job, err = enqueuer.EnqueueUniqueIn("wat", 300, Q{"a": 2}, WithUniqueKey("user:7"), WithDebounce())
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyScheduled(ns)))
+
+	score, j := jobOnZset(pool, redisKeyScheduled(ns))
+	assert.True(t, score > time.Now().Unix()+290)
+	assert.EqualValues(t, 2, j.ArgInt64("a"))
+
+	// Enqueueing it now moves it from the scheduled job queue to the job queue.
+	job2, err := enqueuer.EnqueueUnique("wat", Q{"a": 3}, WithUniqueKey("user:7"), WithDebounce())
+	assert.NoError(t, err)
+	assert.NotNil(t, job2)
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyScheduled(ns)))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	// And scheduling it again moves it back.
+	job, err = enqueuer.EnqueueUniqueIn("wat", 300, Q{"a": 4}, WithUniqueKey("user:7"), WithDebounce())
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyScheduled(ns)))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+}
+
+func TestEnqueueUniqueDebounceRequeued(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	job, err := enqueuer.EnqueueUniqueAt("wat", time.Now().Add(-time.Second), Q{"a": 1}, WithUniqueKey("user:7"), WithDebounce())
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+
+	re := newRequeuer(ns, pool, redisKeyScheduled(ns), []string{"wat"})
+	re.start()
+	re.drain()
+	re.stop()
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	// The requeued job can still be replaced.
+	job2, err := enqueuer.EnqueueUnique("wat", Q{"a": 2}, WithUniqueKey("user:7"), WithDebounce())
+	assert.NoError(t, err)
+	assert.NotNil(t, job2)
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	j := jobOnQueue(pool, redisKeyJobs(ns, "wat"))
+	assert.Equal(t, job2.ID, j.ID)
+}
+
+func TestEnqueueUniqueInModeExpire(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+	conn := pool.Get()
+	defer conn.Close()
+
+	// By default, the uniqueness expires 10 minutes after the job is enqueued, even if it's scheduled later.
+	_, err := enqueuer.EnqueueUniqueIn("wat", 3600, Q{"a": 1})
+	assert.NoError(t, err)
+	key, err := redisKeyUniqueJob(ns, "wat", Q{"a": 1})
+	assert.NoError(t, err)
+	ttl, err := redis.Int64(conn.Do("TTL", key))
+	assert.NoError(t, err)
+	assert.True(t, ttl <= 600)
+
+	// With another mode, it's counted from when the job runs.
+	_, err = enqueuer.EnqueueUniqueIn("wat", 3600, Q{"a": 2}, WithUniqueMode(UniqueUntilStart), WithExpireTime(60))
+	assert.NoError(t, err)
+	key, err = redisKeyUniqueJob(ns, "wat", Q{"a": 2})
+	assert.NoError(t, err)
+	ttl, err = redis.Int64(conn.Do("TTL", key))
+	assert.NoError(t, err)
+	assert.True(t, ttl > 3600 && ttl <= 3660)
+}
+
+func TestEnqueueUniqueWithUniqueArgs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	job, err := enqueuer.EnqueueUnique("report", Q{"account_id": 1, "report": "sales", "requested_by": "ann"}, WithUniqueArgs("account_id", "report"))
+	assert.NoError(t, err)
+	if assert.NotNil(t, job) {
+		// The job's arguments are left as they are.
+		assert.Equal(t, map[string]interface{}{"account_id": 1, "report": "sales", "requested_by": "ann"}, job.Args)
+	}
+
+	job, err = enqueuer.EnqueueUnique("report", Q{"account_id": 1, "report": "sales", "requested_by": "bob"}, WithUniqueArgs("account_id", "report"))
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+
+	job, err = enqueuer.EnqueueUnique("report", Q{"account_id": 2, "report": "sales", "requested_by": "bob"}, WithUniqueArgs("account_id", "report"))
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+
+	job, err = enqueuer.EnqueueUnique("report", Q{"account_id": 1, "report": "churn", "requested_by": "ann"}, WithUniqueArgs("account_id", "report"))
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+
+	// The uniqueness is released by the worker, which uses the key stored in the job.
+	j := jobOnQueue(pool, redisKeyJobs(ns, "report"))
+	key, err := redisKeyUniqueJob(ns, "report", Q{"account_id": 1, "report": "sales"})
+	assert.NoError(t, err)
+	assert.Equal(t, key, j.UniqueKey)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, nil, map[string]*jobType{})
+	w.deleteUniqueJob(j)
+	assert.False(t, keyExists(pool, key))
+}
+
+func TestRedisKeyUniqueJob(t *testing.T) {
+	long := strings.Repeat("x", 10000)
+	key, err := redisKeyUniqueJob("work", "wat", Q{"body": long})
+	assert.NoError(t, err)
+	assert.Equal(t, len("work:unique:wat:")+64, len(key))
+
+	key2, err := redisKeyUniqueJob("work", "wat", Q{"body": long + "y"})
+	assert.NoError(t, err)
+	assert.NotEqual(t, key, key2)
+
+	key, err = redisKeyUniqueJob("work", "wat", Q{UniqueKeyArg: "user:7", "a": 1})
+	assert.NoError(t, err)
+	assert.Equal(t, "work:unique:wat:user:7", key)
+
+	// Jobs enqueued before keys were hashed and stored in the job are still released.
+	key, err = (&Job{Name: "wat", Args: Q{"a": 1}}).uniqueRedisKey("work")
+	assert.NoError(t, err)
+	assert.Equal(t, "work:unique:wat:{\"a\":1}\n", key)
+}
+
+func TestEnqueueWithTTL(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	job, err := enqueuer.Enqueue("wat", nil, WithTTL(5*time.Minute))
+	assert.NoError(t, err)
+	assert.InDelta(t, nowEpochSeconds()+300, job.ExpiresAt, 1)
+	assert.EqualValues(t, job.ExpiresAt, jobOnQueue(pool, redisKeyJobs(ns, "wat")).ExpiresAt)
+
+	// The TTL of a scheduled job counts from when it's due, rounded up to a second.
+	scheduledJob, err := enqueuer.EnqueueIn("wat", 60, nil, WithTTL(1500*time.Millisecond))
+	assert.NoError(t, err)
+	assert.EqualValues(t, scheduledJob.ScheduledAt+2, scheduledJob.ExpiresAt)
+
+	expiresAt := time.Now().Add(time.Hour)
+	job, err = enqueuer.Enqueue("wat", nil, WithExpiresAt(expiresAt))
+	assert.NoError(t, err)
+	assert.EqualValues(t, expiresAt.Unix(), job.ExpiresAt)
+
+	job, err = enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, job.ExpiresAt)
+}
@@ -0,0 +1,142 @@
+package work
+
+import (
+	"github.com/garyburd/redigo/redis"
+)
+
+// EnqueueTx enqueues a job as per Enqueue, but sends its commands on conn, a connection the caller got from the enqueuer's pool,
+// without waiting for their replies. This way, a job can be enqueued in the same MULTI/EXEC transaction as other writes:
+//
+//	conn := redisPool.Get()
+//	defer conn.Close()
+//	conn.Send("MULTI")
+//	conn.Send("SET", "cache:report:7", "stale")
+//	job, err := enqueuer.EnqueueTx(conn, "rebuild_report", work.Q{"report_id": 7})
+//	if err != nil {
+//		conn.Do("DISCARD")
+//		return err
+//	}
+//	_, err = conn.Do("EXEC")
+//
+// The job is only enqueued once the transaction is executed. The returned error is about building the job or sending its commands.
+func (e *Enqueuer) EnqueueTx(conn redis.Conn, jobName string, args map[string]interface{}, opts ...EnqueueOption) (*Job, error) {
+	job := &Job{
+		Name:       jobName,
+		ID:         makeIdentifier(),
+		EnqueuedAt: nowEpochSeconds(),
+		Args:       args,
+	}
+	op := newEnqueueOp(opts)
+	op.apply(job)
+	e.injectTraceContext(op.TraceContext, job)
+
+	rawJSON, err := job.serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	if job.Priority > 0 {
+		err = e.enqueuePriorityScript.Send(conn, e.queuePrefix+jobName, rawJSON, job.Priority)
+	} else {
+		err = conn.Send("LPUSH", e.queuePrefix+jobName, rawJSON)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := conn.Send("SADD", redisKeyKnownJobs(e.Namespace), jobName); err != nil {
+		return nil, err
+	}
+	if err := e.indexJob(conn, job, e.queuePrefix+jobName); err != nil {
+		return nil, err
+	}
+	sendJobStatus(conn, e.Namespace, job, JobStatusQueued, "enqueued_at", job.EnqueuedAt)
+	logDebug(e.logger, "enqueuer.enqueue_tx", "job_name", jobName, "job_id", job.ID)
+
+	return job, nil
+}
+
+// EnqueueInTx enqueues a job in the scheduled job queue for execution in secondsFromNow seconds, sending its commands on conn as per EnqueueTx.
+func (e *Enqueuer) EnqueueInTx(conn redis.Conn, jobName string, secondsFromNow int64, args map[string]interface{}, opts ...EnqueueOption) (*ScheduledJob, error) {
+	job := &Job{
+		Name:        jobName,
+		ID:          makeIdentifier(),
+		EnqueuedAt:  nowEpochSeconds(),
+		Args:        args,
+		ScheduledAt: nowEpochSeconds() + secondsFromNow,
+	}
+	op := newEnqueueOp(opts)
+	op.apply(job)
+	e.injectTraceContext(op.TraceContext, job)
+
+	rawJSON, err := job.serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	scheduledJob := newScheduledJob(job, job.ScheduledAt*1000)
+
+	if err := conn.Send("ZADD", redisKeyScheduled(e.Namespace), scheduledJob.RunAt, rawJSON); err != nil {
+		return nil, err
+	}
+	if err := conn.Send("SADD", redisKeyKnownJobs(e.Namespace), jobName); err != nil {
+		return nil, err
+	}
+	if err := e.indexJob(conn, job, redisKeyScheduled(e.Namespace)); err != nil {
+		return nil, err
+	}
+	sendJobStatus(conn, e.Namespace, job, JobStatusQueued, "enqueued_at", job.EnqueuedAt)
+	logDebug(e.logger, "enqueuer.enqueue_in_tx", "job_name", jobName, "job_id", job.ID, "run_at", scheduledJob.RunAt)
+
+	return scheduledJob, nil
+}
+
+// EnqueueUniqueTx enqueues a unique job as per EnqueueUnique, sending its commands on conn as per EnqueueTx.
+// Since whether the job is a duplicate is only known once the commands are executed, the job is always returned.
+// The reply of the first command it sends is "ok" if the job was enqueued, "replaced" if it was and replaced a pending job,
+// see WithDebounce, and "dup" if it wasn't,
+// eg the first element of the reply of EXEC if nothing else was sent after MULTI. The job's status, see WithStatus, and its entry in the
+// job index are only written if it was enqueued.
+func (e *Enqueuer) EnqueueUniqueTx(conn redis.Conn, jobName string, args map[string]interface{}, opts ...EnqueueOption) (*Job, error) {
+	op := newEnqueueOp(opts)
+	args, uniqueKey, err := uniqueKey(op, e.Namespace, jobName, args)
+	if err != nil {
+		return nil, err
+	}
+
+	job := &Job{
+		Name:       jobName,
+		ID:         makeIdentifier(),
+		EnqueuedAt: nowEpochSeconds(),
+		Args:       args,
+		Unique:     true,
+	}
+	op.apply(job)
+	op.applyUnique(job, uniqueKey)
+	e.injectTraceContext(op.TraceContext, job)
+
+	rawJSON, err := job.serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	scriptArgs := make([]interface{}, 0, 20)
+	scriptArgs = append(scriptArgs, e.queuePrefix+jobName)          // KEY[1]
+	scriptArgs = append(scriptArgs, uniqueKey)                      // KEY[2]
+	scriptArgs = append(scriptArgs, op.expire())                    // KEY[3]
+	scriptArgs = append(scriptArgs, redisKeyScheduled(e.Namespace)) // KEY[4]
+	scriptArgs = append(scriptArgs, rawJSON)                        // ARGV[1]
+	scriptArgs = append(scriptArgs, job.Priority)                   // ARGV[2]
+	scriptArgs = append(scriptArgs, op.Debounce)                    // ARGV[3]
+	scriptArgs = append(scriptArgs, e.indexAndStatusArgs(job)...)   // ARGV[4...]
+
+	// Send uses EVAL rather than EVALSHA, since a NOSCRIPT error would only be seen once the transaction is executed.
+	if err := e.enqueueUniqueScript.Send(conn, scriptArgs...); err != nil {
+		return nil, err
+	}
+	if err := conn.Send("SADD", redisKeyKnownJobs(e.Namespace), jobName); err != nil {
+		return nil, err
+	}
+	logDebug(e.logger, "enqueuer.enqueue_unique_tx", "job_name", jobName, "job_id", job.ID)
+
+	return job, nil
+}
@@ -0,0 +1,110 @@
+package work
+
+import (
+	"testing"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnqueueTx(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	conn := pool.Get()
+	defer conn.Close()
+
+	conn.Send("MULTI")
+	conn.Send("SET", "work:test:cache", "stale")
+	job, err := enqueuer.EnqueueTx(conn, "wat", Q{"a": 1})
+	assert.NoError(t, err)
+	assert.Equal(t, "wat", job.Name)
+	scheduledJob, err := enqueuer.EnqueueInTx(conn, "taw", 300, Q{"b": 2})
+	assert.NoError(t, err)
+	assert.True(t, scheduledJob.RunAt > time.Now().Unix()+290)
+
+	// Nothing is enqueued before EXEC.
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyScheduled(ns)))
+
+	_, err = conn.Do("EXEC")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.Equal(t, job.ID, jobOnQueue(pool, redisKeyJobs(ns, "wat")).ID)
+	runAt, j := jobOnZset(pool, redisKeyScheduled(ns))
+	assert.Equal(t, scheduledJob.RunAt, runAt)
+	assert.Equal(t, scheduledJob.ID, j.ID)
+	assert.Equal(t, 2, len(knownJobs(pool, redisKeyKnownJobs(ns))))
+
+	// DISCARD enqueues nothing.
+	conn.Send("MULTI")
+	_, err = enqueuer.EnqueueTx(conn, "wat", Q{"a": 2})
+	assert.NoError(t, err)
+	_, err = conn.Do("DISCARD")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "wat")))
+}
+
+func TestEnqueueUniqueTx(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	conn := pool.Get()
+	defer conn.Close()
+
+	conn.Send("MULTI")
+	job, err := enqueuer.EnqueueUniqueTx(conn, "wat", Q{"a": 1})
+	assert.NoError(t, err)
+	assert.True(t, job.Unique)
+	_, err = enqueuer.EnqueueUniqueTx(conn, "wat", Q{"a": 1}, WithExpireTime(60))
+	assert.NoError(t, err)
+	values, err := redis.Values(conn.Do("EXEC"))
+	assert.NoError(t, err)
+	if assert.Equal(t, 4, len(values)) {
+		res, _ := redis.String(values[0], nil)
+		assert.Equal(t, "ok", res)
+		res, _ = redis.String(values[2], nil)
+		assert.Equal(t, "dup", res)
+	}
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+
+	job, err = enqueuer.EnqueueUnique("wat", Q{"a": 1})
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+}
+
+func TestEnqueueUniqueTxDupWritesNothing(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool).EnableJobIndex()
+
+	conn := pool.Get()
+	defer conn.Close()
+
+	conn.Send("MULTI")
+	job, err := enqueuer.EnqueueUniqueTx(conn, "wat", Q{"a": 1}, WithStatus(time.Hour))
+	assert.NoError(t, err)
+	dup, err := enqueuer.EnqueueUniqueTx(conn, "wat", Q{"a": 1}, WithStatus(time.Hour))
+	assert.NoError(t, err)
+	values, err := redis.Values(conn.Do("EXEC"))
+	assert.NoError(t, err)
+	if assert.Equal(t, 4, len(values)) {
+		res, _ := redis.String(values[2], nil)
+		assert.Equal(t, "dup", res)
+	}
+
+	// Only the job that was enqueued is indexed and has a status.
+	assert.Equal(t, redisKeyJobs(ns, "wat"), jobIndexEntry(pool, ns, job.ID))
+	status := readHash(pool, redisKeyJobStatus(ns, job.ID))
+	assert.Equal(t, JobStatusQueued, status["state"])
+	assert.Equal(t, "wat", status["name"])
+	assert.True(t, redisTTL(pool, redisKeyJobStatus(ns, job.ID)) > 0)
+	assert.Equal(t, "", jobIndexEntry(pool, ns, dup.ID))
+	assert.False(t, keyExists(pool, redisKeyJobStatus(ns, dup.ID)))
+}
@@ -0,0 +1,223 @@
+package work
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+
+	"github.com/garyburd/redigo/redis"
+)
+
+// jobIndexTTL is how long, in seconds, an entry of the job index is kept after the job is due, or after it last moved.
+const jobIndexTTL = 7 * 24 * 60 * 60
+
+// jobIndexExpire returns how long to keep the job index entry of a job that's due at dueAt, in epoch seconds.
+func jobIndexExpire(dueAt int64) int64 {
+	if d := dueAt - nowEpochSeconds(); d > 0 {
+		return d + jobIndexTTL
+	}
+	return jobIndexTTL
+}
+
+// EnableJobIndex makes the enqueuer record where each job it enqueues is, so that Client.FindJob finds it without scanning the queues,
+// and Client.CancelJob looks for it there first.
+// Jobs are followed as they move by worker pools with EnableJobIndex. The index takes a Redis key per job, which expires a week
+// after the job is due, or after it last moved.
+func (e *Enqueuer) EnableJobIndex() *Enqueuer {
+	e.jobIndex = true
+	return e
+}
+
+// EnableJobIndex makes the pool's workers and requeuers update the job index as jobs start, are retried, die, finish or are requeued.
+// See Enqueuer.EnableJobIndex. It must be called before Start.
+func (wp *WorkerPool) EnableJobIndex() *WorkerPool {
+	wp.jobIndex = true
+	for _, w := range wp.workers {
+		w.jobIndex = true
+	}
+	return wp
+}
+
+// indexJob sends the command that records that job is in key, the queue or zset it's enqueued in, if the job index is enabled.
+func (e *Enqueuer) indexJob(conn redis.Conn, job *Job, key string) error {
+	if !e.jobIndex {
+		return nil
+	}
+	return conn.Send("SET", redisKeyJobIndex(e.Namespace, job.ID), key, "EX", jobIndexExpire(job.ScheduledAt))
+}
+
+// jobSearchPageSize is how many jobs are read at a time when a queue is searched for a job, so that Redis isn't blocked for long.
+const jobSearchPageSize = 1000
+
+// jobSearchKey is a queue or zset searched for a job.
+type jobSearchKey struct {
+	key    string
+	isZset bool
+}
+
+// foundJob is a job found by searchJob, as it's stored.
+type foundJob struct {
+	rawJSON []byte
+	key     string
+	isZset  bool
+	score   int64 // if key is a zset
+}
+
+// searchJob looks for the job with the specified ID in keys, in order, a page at a time, and only decodes the jobs that contain the
+// ID. It returns nil if the job isn't found. Since the queues aren't searched atomically, a job that moves meanwhile can be missed.
+func searchJob(conn redis.Conn, jobID string, keys []jobSearchKey) (*foundJob, error) {
+	needle := []byte(`"id":"` + jobID + `"`)
+	for _, k := range keys {
+		for start := 0; ; start += jobSearchPageSize {
+			var values [][]byte
+			var err error
+			if k.isZset {
+				values, err = redis.ByteSlices(conn.Do("ZRANGE", k.key, start, start+jobSearchPageSize-1, "WITHSCORES"))
+			} else {
+				values, err = redis.ByteSlices(conn.Do("LRANGE", k.key, start, start+jobSearchPageSize-1))
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			step := 1
+			if k.isZset {
+				step = 2
+			}
+			for i := 0; i < len(values); i += step {
+				if !bytes.Contains(values[i], needle) {
+					continue
+				}
+				var j struct {
+					ID string `json:"id"`
+				}
+				if err := json.Unmarshal(values[i], &j); err != nil || j.ID != jobID {
+					continue
+				}
+				found := &foundJob{rawJSON: values[i], key: k.key, isZset: k.isZset}
+				if k.isZset {
+					score, err := strconv.ParseFloat(string(values[i+1]), 64)
+					if err != nil {
+						return nil, err
+					}
+					found.score = int64(score)
+				}
+				return found, nil
+			}
+
+			if len(values) < jobSearchPageSize*step {
+				break
+			}
+		}
+	}
+	return nil, nil
+}
+
+// hintJobSearch moves the key that the job index says the job is in, if it's enabled, to the front of keys, and returns whether
+// there was such a key.
+func hintJobSearch(conn redis.Conn, namespace, jobID string, keys []jobSearchKey) ([]jobSearchKey, bool, error) {
+	hint, err := redis.String(conn.Do("GET", redisKeyJobIndex(namespace, jobID)))
+	if err == redis.ErrNil {
+		return keys, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	for i, k := range keys {
+		if k.key == hint {
+			hinted := make([]jobSearchKey, 0, len(keys))
+			hinted = append(hinted, k)
+			hinted = append(hinted, keys[:i]...)
+			return append(hinted, keys[i+1:]...), true, nil
+		}
+	}
+	return keys, false, nil
+}
+
+// Where a job can be, see JobLocation.
+const (
+	JobInQueue     = "queue"       // waiting in its queue
+	JobInProgress  = "in_progress" // being processed by a worker
+	JobInScheduled = "scheduled"   // waiting in the scheduled job queue
+	JobInRetry     = "retry"       // waiting in the retry queue
+	JobInDead      = "dead"        // in the dead queue
+)
+
+// JobLocation is where a job was found by Client.FindJob.
+type JobLocation struct {
+	Job          *Job   `json:"job"`
+	Where        string `json:"where"`                    // one of JobInQueue, JobInProgress, JobInScheduled, JobInRetry or JobInDead
+	WorkerPoolID string `json:"worker_pool_id,omitempty"` // the worker pool processing the job, if it's in progress
+	Score        int64  `json:"score,omitempty"`          // when the job is due, was retried or died, in epoch seconds, in the scheduled, retry and dead queues
+}
+
+// FindJob finds the job with the specified ID wherever it is: in its queue, in progress, or in the scheduled, retry or dead queue.
+// It returns nil if the job isn't found, eg because it was processed.
+// The job is looked for where the job index says it is, see Enqueuer.EnableJobIndex. If it isn't there, or if the index isn't
+// enabled, it's only looked for everywhere else if scan is set. That takes a scan of the queues, a page at a time, as per CancelJob.
+func (c *Client) FindJob(jobID string, scan bool) (*JobLocation, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	jobNames, err := redis.Strings(conn.Do("SMEMBERS", redisKeyKnownJobs(c.namespace)))
+	if err != nil {
+		logError(c.logger, "client.find_job.known_jobs", err)
+		return nil, err
+	}
+	poolIDs, err := redis.Strings(conn.Do("SMEMBERS", redisKeyWorkerPools(c.namespace)))
+	if err != nil {
+		logError(c.logger, "client.find_job.worker_pools", err)
+		return nil, err
+	}
+
+	// Where each key the job might be in is.
+	locations := map[string]JobLocation{
+		redisKeyScheduled(c.namespace): {Where: JobInScheduled},
+		redisKeyRetry(c.namespace):     {Where: JobInRetry},
+		redisKeyDead(c.namespace):      {Where: JobInDead},
+	}
+	keys := make([]jobSearchKey, 0, 3+len(jobNames)*(1+len(poolIDs)))
+	for _, jobName := range jobNames {
+		key := redisKeyJobs(c.namespace, jobName)
+		locations[key] = JobLocation{Where: JobInQueue}
+		keys = append(keys, jobSearchKey{key: key})
+		for _, poolID := range poolIDs {
+			key := redisKeyJobsInProgress(c.namespace, poolID, jobName)
+			locations[key] = JobLocation{Where: JobInProgress, WorkerPoolID: poolID}
+			keys = append(keys, jobSearchKey{key: key})
+		}
+	}
+	keys = append(keys, jobSearchKey{key: redisKeyScheduled(c.namespace), isZset: true})
+	keys = append(keys, jobSearchKey{key: redisKeyRetry(c.namespace), isZset: true})
+	keys = append(keys, jobSearchKey{key: redisKeyDead(c.namespace), isZset: true})
+
+	keys, hinted, err := hintJobSearch(conn, c.namespace, jobID, keys)
+	if err != nil {
+		logError(c.logger, "client.find_job.index", err)
+		return nil, err
+	}
+	if !scan {
+		if !hinted {
+			return nil, nil
+		}
+		keys = keys[:1]
+	}
+
+	found, err := searchJob(conn, jobID, keys)
+	if err != nil {
+		logError(c.logger, "client.find_job.search", err)
+		return nil, err
+	}
+	if found == nil {
+		return nil, nil
+	}
+	job, err := newJob(found.rawJSON, nil, nil)
+	if err != nil {
+		logError(c.logger, "client.find_job.new_job", err)
+		return nil, err
+	}
+
+	location := locations[found.key]
+	location.Job = job
+	location.Score = found.score
+	return &location, nil
+}
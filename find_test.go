@@ -0,0 +1,191 @@
+package work
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientFindJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	client := NewClient(ns, pool)
+	enqueuer := NewEnqueuer(ns, pool)
+
+	queued, err := enqueuer.Enqueue("wat", Q{"a": 1})
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("wat", Q{"a": 2})
+	assert.NoError(t, err)
+	scheduled, err := enqueuer.EnqueueIn("foo", 100, nil)
+	assert.NoError(t, err)
+
+	// Without the job index, it's only found by a scan.
+	loc, err := client.FindJob(queued.ID, false)
+	assert.NoError(t, err)
+	assert.Nil(t, loc)
+
+	loc, err = client.FindJob(queued.ID, true)
+	assert.NoError(t, err)
+	if assert.NotNil(t, loc) {
+		assert.Equal(t, JobInQueue, loc.Where)
+		assert.Equal(t, queued.ID, loc.Job.ID)
+		assert.EqualValues(t, 1, loc.Job.ArgInt64("a"))
+		assert.EqualValues(t, 0, loc.Score)
+	}
+
+	loc, err = client.FindJob(scheduled.ID, true)
+	assert.NoError(t, err)
+	if assert.NotNil(t, loc) {
+		assert.Equal(t, JobInScheduled, loc.Where)
+		assert.Equal(t, scheduled.RunAt, loc.Score)
+	}
+
+	// A job in progress, in a worker pool.
+	conn := pool.Get()
+	defer conn.Close()
+	_, err = conn.Do("SADD", redisKeyWorkerPools(ns), "pool1")
+	assert.NoError(t, err)
+	_, err = conn.Do("RPOPLPUSH", redisKeyJobs(ns, "wat"), redisKeyJobsInProgress(ns, "pool1", "wat"))
+	assert.NoError(t, err)
+	loc, err = client.FindJob(queued.ID, true)
+	assert.NoError(t, err)
+	if assert.NotNil(t, loc) {
+		assert.Equal(t, JobInProgress, loc.Where)
+		assert.Equal(t, "pool1", loc.WorkerPoolID)
+	}
+
+	// Jobs in the retry and dead queues.
+	for i, key := range []string{redisKeyRetry(ns), redisKeyDead(ns)} {
+		job := &Job{Name: "wat", ID: makeIdentifier(), EnqueuedAt: 12345, Fails: 1}
+		rawJSON, _ := job.serialize()
+		_, err = conn.Do("ZADD", key, 12346+i, rawJSON)
+		assert.NoError(t, err)
+
+		loc, err = client.FindJob(job.ID, true)
+		assert.NoError(t, err)
+		if assert.NotNil(t, loc) {
+			assert.Equal(t, []string{JobInRetry, JobInDead}[i], loc.Where)
+			assert.EqualValues(t, 12346+i, loc.Score)
+		}
+	}
+
+	loc, err = client.FindJob("nope", true)
+	assert.NoError(t, err)
+	assert.Nil(t, loc)
+}
+
+func TestClientFindJobBig(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	// More jobs than a page of the scan, with the one we look for last.
+	jobs := make([]BulkJob, 0, 2500)
+	for i := 0; i < 2500; i++ {
+		jobs = append(jobs, BulkJob{Name: "wat", Args: Q{"i": i}})
+	}
+	results := NewEnqueuer(ns, pool).EnqueueMany(BulkJobSlice(jobs))
+	last := results[0].Job // LPUSHed first, so it's at the end of the list
+
+	loc, err := NewClient(ns, pool).FindJob(last.ID, true)
+	assert.NoError(t, err)
+	if assert.NotNil(t, loc) {
+		assert.Equal(t, JobInQueue, loc.Where)
+		assert.EqualValues(t, 0, loc.Job.ArgInt64("i"))
+	}
+}
+
+func TestJobIndex(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	cleanKeyspace(ns, pool)
+
+	fail := true
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:       job1,
+		JobOptions: JobOptions{Priority: 1, MaxFails: 3},
+		IsGeneric:  true,
+		GenericHandler: func(job *Job) error {
+			if fail {
+				return fmt.Errorf("sorry kid")
+			}
+			return nil
+		},
+	}
+
+	enqueuer := NewEnqueuer(ns, pool).EnableJobIndex()
+	job, err := enqueuer.Enqueue(job1, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, redisKeyJobs(ns, job1), jobIndexEntry(pool, ns, job.ID))
+	scheduled, err := enqueuer.EnqueueIn(job1, 100, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, redisKeyScheduled(ns), jobIndexEntry(pool, ns, scheduled.ID))
+
+	// The job fails and is retried.
+	w := newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
+	w.jobIndex = true
+	w.start()
+	w.drain()
+	w.stop()
+	assert.Equal(t, redisKeyRetry(ns), jobIndexEntry(pool, ns, job.ID))
+
+	loc, err := NewClient(ns, pool).FindJob(job.ID, false)
+	assert.NoError(t, err)
+	if assert.NotNil(t, loc) {
+		assert.Equal(t, JobInRetry, loc.Where)
+	}
+
+	// A stale entry isn't followed by a scan.
+	conn := pool.Get()
+	defer conn.Close()
+	_, err = conn.Do("SET", redisKeyJobIndex(ns, scheduled.ID), redisKeyRetry(ns))
+	assert.NoError(t, err)
+	loc2, err := NewClient(ns, pool).FindJob(scheduled.ID, false)
+	assert.NoError(t, err)
+	assert.Nil(t, loc2)
+	loc2, err = NewClient(ns, pool).FindJob(scheduled.ID, true)
+	assert.NoError(t, err)
+	if assert.NotNil(t, loc2) {
+		assert.Equal(t, JobInScheduled, loc2.Where)
+	}
+
+	// It's requeued once it's due.
+	_, err = conn.Do("ZINCRBY", redisKeyRetry(ns), -1000, loc.Job.rawJSON)
+	assert.NoError(t, err)
+	re := newRequeuer(ns, pool, redisKeyRetry(ns), []string{job1})
+	re.indexJobs()
+	assert.True(t, re.process())
+	assert.Equal(t, redisKeyJobs(ns, job1), jobIndexEntry(pool, ns, job.ID))
+
+	// It succeeds, so it's dropped from the index.
+	fail = false
+	w = newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
+	w.jobIndex = true
+	w.start()
+	w.drain()
+	w.stop()
+	assert.Equal(t, "", jobIndexEntry(pool, ns, job.ID))
+
+	loc, err = NewClient(ns, pool).FindJob(job.ID, false)
+	assert.NoError(t, err)
+	assert.Nil(t, loc)
+}
+
+func jobIndexEntry(pool *redis.Pool, ns, jobID string) string {
+	conn := pool.Get()
+	defer conn.Close()
+
+	v, err := redis.String(conn.Do("GET", redisKeyJobIndex(ns, jobID)))
+	if err == redis.ErrNil {
+		return ""
+	} else if err != nil {
+		panic("could not GET job index: " + err.Error())
+	}
+	return v
+}
@@ -27,6 +27,8 @@ type workerPoolHeartbeater struct {
 
 	stopChan         chan struct{}
 	doneStoppingChan chan struct{}
+
+	logger Logger
 }
 
 func newWorkerPoolHeartbeater(namespace string, pool *redis.Pool, workerPoolID string, jobTypes map[string]*jobType, concurrency uint, workerIDs []string) *workerPoolHeartbeater {
@@ -51,17 +53,18 @@ func newWorkerPoolHeartbeater(namespace string, pool *redis.Pool, workerPoolID s
 	h.workerIDs = strings.Join(workerIDs, ",")
 
 	h.pid = os.Getpid()
+
+	return h
+}
+
+func (h *workerPoolHeartbeater) start() {
 	host, err := os.Hostname()
 	if err != nil {
-		logError("heartbeat.hostname", err)
+		logError(h.logger, "heartbeat.hostname", err)
 		host = "hostname_errored"
 	}
 	h.hostname = host
 
-	return h
-}
-
-func (h *workerPoolHeartbeater) start() {
 	go h.loop()
 }
 
@@ -105,7 +108,7 @@ func (h *workerPoolHeartbeater) heartbeat() {
 	)
 
 	if err := conn.Flush(); err != nil {
-		logError("heartbeat", err)
+		logError(h.logger, "heartbeat", err)
 	}
 }
 
@@ -120,6 +123,6 @@ func (h *workerPoolHeartbeater) removeHeartbeat() {
 	conn.Send("DEL", heartbeatKey)
 
 	if err := conn.Flush(); err != nil {
-		logError("remove_heartbeat", err)
+		logError(h.logger, "remove_heartbeat", err)
 	}
 }
@@ -5,17 +5,39 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"time"
 )
 
 // Job represents a job.
 type Job struct {
 	// Inputs when making a new job
-	Name        string                 `json:"name,omitempty"`
-	ID          string                 `json:"id"`
-	EnqueuedAt  int64                  `json:"t"`
-	Args        map[string]interface{} `json:"args"`
-	Unique      bool                   `json:"unique,omitempty"`
-	ScheduledAt int64                  `json:"s"`
+	Name       string                 `json:"name,omitempty"`
+	ID         string                 `json:"id"`
+	EnqueuedAt int64                  `json:"t"`
+	Args       map[string]interface{} `json:"args"`
+	Unique     bool                   `json:"unique,omitempty"`
+	// Set for unique jobs: the Redis key of the job's uniqueness, how long it lasts, and how long it's kept after the job is
+	// scheduled or retried, in seconds. See WithUniqueMode.
+	UniqueKey    string     `json:"unique_key,omitempty"`
+	UniqueMode   UniqueMode `json:"unique_mode,omitempty"`
+	UniqueExpire int        `json:"unique_expire,omitempty"`
+	ScheduledAt  int64      `json:"s"`
+	BatchID      string     `json:"batch_id,omitempty"`
+	WorkflowID   string     `json:"workflow_id,omitempty"`
+	// TraceContext is the trace context of the code that enqueued the job, as stored by a Tracer.
+	TraceContext map[string]string `json:"trace,omitempty"`
+	// Options set when enqueueing, eg with WithMaxFails, overriding those of the job type. Zero values mean the job type's.
+	Priority uint   `json:"priority,omitempty"`
+	MaxFails uint   `json:"max_fails,omitempty"`
+	Timeout  int    `json:"timeout,omitempty"` // milliseconds
+	Backoff  string `json:"backoff,omitempty"` // the name of a backoff, see WithBackoff
+	// ExpiresAt is when the job expires, in epoch seconds, see WithTTL. A job that hasn't started by then is dropped.
+	ExpiresAt int64 `json:"expires_at,omitempty"`
+	// StatusTTL is how long the status of the job is kept after it's last updated, in seconds, if it has one, see WithStatus.
+	StatusTTL int64 `json:"status_ttl,omitempty"`
+	// NoOverlap is set on the runs of a periodic job that are skipped while the previous one is queued or in progress,
+	// see PeriodicOptions.NoOverlap. They hold the key of their uniqueness from when they're due.
+	NoOverlap bool `json:"no_overlap,omitempty"`
 	// Inputs when retrying
 	Fails        int64  `json:"fails,omitempty"` // number of times this job has failed
 	LastErr      string `json:"err,omitempty"`
@@ -25,6 +47,8 @@ type Job struct {
 	dequeuedFrom []byte
 	inProgQueue  []byte
 	argError     error
+	cancelled    bool   // set when it's fetched if it was cancelled with Client.CancelJob
+	result       []byte // set by SetResult
 	observer     *observer
 }
 
@@ -48,6 +72,20 @@ func (j *Job) serialize() ([]byte, error) {
 	return json.Marshal(j)
 }
 
+// uniqueRedisKey returns the Redis key of the uniqueness of a unique job. It's computed from the arguments of jobs enqueued
+// before it was stored in the job.
+func (j *Job) uniqueRedisKey(namespace string) (string, error) {
+	if j.UniqueKey != "" {
+		return j.UniqueKey, nil
+	}
+	return redisKeyUniqueJobUnhashed(namespace, j.Name, j.Args)
+}
+
+// expired returns whether the job has expired at now, in epoch seconds.
+func (j *Job) expired(now int64) bool {
+	return j.ExpiresAt > 0 && now >= j.ExpiresAt
+}
+
 // setArg sets a single named argument on the job.
 func (j *Job) setArg(key string, val interface{}) {
 	if j.Args == nil {
@@ -69,6 +107,28 @@ func (j *Job) Checkin(msg string) {
 	}
 }
 
+// Progress reports that the executing job has done done units of work out of total, eg records exported. The web UI shows it as a
+// progress bar, and WorkerObservation has the percentage done and an estimate of when the job will be done.
+// keyvals are optional key/value pairs, eg "file", "users.csv", that are shown along with the progress. They're merged into those
+// reported earlier by the job, so a field only needs to be reported when it changes.
+func (j *Job) Progress(done, total int64, keyvals ...interface{}) {
+	if j.observer == nil {
+		return
+	}
+	var fields map[string]interface{}
+	if len(keyvals) > 0 {
+		fields = make(map[string]interface{}, (len(keyvals)+1)/2)
+		for i := 0; i < len(keyvals); i += 2 {
+			var v interface{}
+			if i+1 < len(keyvals) {
+				v = keyvals[i+1]
+			}
+			fields[fmt.Sprint(keyvals[i])] = v
+		}
+	}
+	j.observer.observeProgress(j.Name, j.ID, done, total, fields)
+}
+
 // ArgString returns j.Args[key] typed to a string. If the key is missing or of the wrong type, it sets an argument error
 // on the job. This function is meant to be used in the body of a job handling function while extracting arguments,
 // followed by a single call to j.ArgError().
@@ -92,20 +152,8 @@ func (j *Job) ArgString(key string) string {
 func (j *Job) ArgInt64(key string) int64 {
 	v, ok := j.Args[key]
 	if ok {
-		rVal := reflect.ValueOf(v)
-		if isIntKind(rVal) {
-			return rVal.Int()
-		} else if isUintKind(rVal) {
-			vUint := rVal.Uint()
-			if vUint <= math.MaxInt64 {
-				return int64(vUint)
-			}
-		} else if isFloatKind(rVal) {
-			vFloat64 := rVal.Float()
-			vInt64 := int64(vFloat64)
-			if vFloat64 == math.Trunc(vFloat64) && vInt64 <= 9007199254740892 && vInt64 >= -9007199254740892 {
-				return vInt64
-			}
+		if vInt64, ok := toInt64(v); ok {
+			return vInt64
 		}
 		j.argError = typecastError("int64", key, v)
 	} else {
@@ -152,6 +200,182 @@ func (j *Job) ArgBool(key string) bool {
 	return false
 }
 
+// ArgStringSlice returns j.Args[key] typed to a []string. If the key is missing, or it isn't a list of strings, it sets an argument error
+// on the job, as per ArgString.
+func (j *Job) ArgStringSlice(key string) []string {
+	v, ok := j.Args[key]
+	if ok {
+		switch typedV := v.(type) {
+		case []string:
+			return typedV
+		case []interface{}:
+			vStrings := make([]string, 0, len(typedV))
+			for _, el := range typedV {
+				vString, ok := el.(string)
+				if !ok {
+					break
+				}
+				vStrings = append(vStrings, vString)
+			}
+			if len(vStrings) == len(typedV) {
+				return vStrings
+			}
+		}
+		j.argError = typecastError("[]string", key, v)
+	} else {
+		j.argError = missingKeyError("[]string", key)
+	}
+	return nil
+}
+
+// ArgInt64Slice returns j.Args[key] typed to an []int64. If the key is missing, or it isn't a list of integers, it sets an argument error
+// on the job, as per ArgInt64.
+func (j *Job) ArgInt64Slice(key string) []int64 {
+	v, ok := j.Args[key]
+	if ok {
+		rVal := reflect.ValueOf(v)
+		if rVal.Kind() == reflect.Slice {
+			vInt64s := make([]int64, 0, rVal.Len())
+			for i := 0; i < rVal.Len(); i++ {
+				vInt64, ok := toInt64(rVal.Index(i).Interface())
+				if !ok {
+					break
+				}
+				vInt64s = append(vInt64s, vInt64)
+			}
+			if len(vInt64s) == rVal.Len() {
+				return vInt64s
+			}
+		}
+		j.argError = typecastError("[]int64", key, v)
+	} else {
+		j.argError = missingKeyError("[]int64", key)
+	}
+	return nil
+}
+
+// ArgMap returns j.Args[key] typed to a map[string]interface{}, ie a nested object. If the key is missing or of the wrong type, it sets
+// an argument error on the job, as per ArgString.
+func (j *Job) ArgMap(key string) map[string]interface{} {
+	v, ok := j.Args[key]
+	if ok {
+		typedV, ok := v.(map[string]interface{})
+		if ok {
+			return typedV
+		}
+		j.argError = typecastError("map", key, v)
+	} else {
+		j.argError = missingKeyError("map", key)
+	}
+	return nil
+}
+
+// ArgTime returns j.Args[key] typed to a time.Time. The argument can be an RFC3339 string, which is how a time.Time is encoded in JSON,
+// or a number of seconds since the epoch. If the key is missing or can't be parsed, it sets an argument error on the job, as per ArgString.
+func (j *Job) ArgTime(key string) time.Time {
+	v, ok := j.Args[key]
+	if ok {
+		switch typedV := v.(type) {
+		case time.Time:
+			return typedV
+		case string:
+			t, err := time.Parse(time.RFC3339Nano, typedV)
+			if err == nil {
+				return t
+			}
+		default:
+			if epoch, ok := toInt64(v); ok {
+				return time.Unix(epoch, 0)
+			}
+		}
+		j.argError = typecastError("time", key, v)
+	} else {
+		j.argError = missingKeyError("time", key)
+	}
+	return time.Time{}
+}
+
+// ArgDuration returns j.Args[key] typed to a time.Duration. The argument can be a string as per time.ParseDuration, eg "1h30m",
+// or a number of nanoseconds, which is how a time.Duration is encoded in JSON. If the key is missing or can't be parsed, it sets an
+// argument error on the job, as per ArgString.
+func (j *Job) ArgDuration(key string) time.Duration {
+	v, ok := j.Args[key]
+	if ok {
+		switch typedV := v.(type) {
+		case time.Duration:
+			return typedV
+		case string:
+			d, err := time.ParseDuration(typedV)
+			if err == nil {
+				return d
+			}
+		default:
+			if nanos, ok := toInt64(v); ok {
+				return time.Duration(nanos)
+			}
+		}
+		j.argError = typecastError("duration", key, v)
+	} else {
+		j.argError = missingKeyError("duration", key)
+	}
+	return 0
+}
+
+// ArgStringOr returns j.Args[key] typed to a string as per ArgString, or def if the key is missing. A value of the wrong type still
+// sets an argument error on the job.
+func (j *Job) ArgStringOr(key string, def string) string {
+	if _, ok := j.Args[key]; !ok {
+		return def
+	}
+	return j.ArgString(key)
+}
+
+// ArgInt64Or returns j.Args[key] typed to an int64 as per ArgInt64, or def if the key is missing. A value of the wrong type still
+// sets an argument error on the job.
+func (j *Job) ArgInt64Or(key string, def int64) int64 {
+	if _, ok := j.Args[key]; !ok {
+		return def
+	}
+	return j.ArgInt64(key)
+}
+
+// ArgFloat64Or returns j.Args[key] typed to a float64 as per ArgFloat64, or def if the key is missing. A value of the wrong type still
+// sets an argument error on the job.
+func (j *Job) ArgFloat64Or(key string, def float64) float64 {
+	if _, ok := j.Args[key]; !ok {
+		return def
+	}
+	return j.ArgFloat64(key)
+}
+
+// ArgBoolOr returns j.Args[key] typed to a bool as per ArgBool, or def if the key is missing. A value of the wrong type still
+// sets an argument error on the job.
+func (j *Job) ArgBoolOr(key string, def bool) bool {
+	if _, ok := j.Args[key]; !ok {
+		return def
+	}
+	return j.ArgBool(key)
+}
+
+// ArgDurationOr returns j.Args[key] typed to a time.Duration as per ArgDuration, or def if the key is missing. A value of the wrong
+// type still sets an argument error on the job.
+func (j *Job) ArgDurationOr(key string, def time.Duration) time.Duration {
+	if _, ok := j.Args[key]; !ok {
+		return def
+	}
+	return j.ArgDuration(key)
+}
+
+// DecodeArgs decodes all of the job's arguments into v, which must be a pointer, eg to a struct with json tags.
+// It goes through the arguments' JSON encoding. If that fails, it sets an argument error on the job and returns it.
+func (j *Job) DecodeArgs(v interface{}) error {
+	if err := decodeArgs(j.Args, v); err != nil {
+		j.argError = fmt.Errorf("decoding job.Args: %v", err)
+		return j.argError
+	}
+	return nil
+}
+
 // ArgError returns the last error generated when extracting typed params. Returns nil if extracting the args went fine.
 func (j *Job) ArgError() error {
 	return j.argError
@@ -174,6 +398,27 @@ func (j *Job) IsRerun() bool {
 	return false
 }
 
+// toInt64 converts v to an int64 if it's any kind of number holding an integer. Floats must be exactly representable, which is what
+// JSON numbers are decoded into.
+func toInt64(v interface{}) (int64, bool) {
+	rVal := reflect.ValueOf(v)
+	if isIntKind(rVal) {
+		return rVal.Int(), true
+	} else if isUintKind(rVal) {
+		vUint := rVal.Uint()
+		if vUint <= math.MaxInt64 {
+			return int64(vUint), true
+		}
+	} else if isFloatKind(rVal) {
+		vFloat64 := rVal.Float()
+		vInt64 := int64(vFloat64)
+		if vFloat64 == math.Trunc(vFloat64) && vInt64 <= 9007199254740892 && vInt64 >= -9007199254740892 {
+			return vInt64, true
+		}
+	}
+	return 0, false
+}
+
 func isIntKind(v reflect.Value) bool {
 	k := v.Kind()
 	return k == reflect.Int || k == reflect.Int8 || k == reflect.Int16 || k == reflect.Int32 || k == reflect.Int64
@@ -4,6 +4,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"math"
 	"testing"
+	"time"
 )
 
 func TestJobArgumentExtraction(t *testing.T) {
@@ -253,3 +254,66 @@ func TestJobArgumentExtractionBadFloat(t *testing.T) {
 		j.argError = nil
 	}
 }
+
+func TestJobArgumentExtractionCollectionsAndTime(t *testing.T) {
+	j, err := newJob([]byte(`{"name":"x","id":"1","args":{
+		"strs":["a","b"],"ints":[1,2,3],"mixed":["a",1],"obj":{"k":"v"},
+		"rfc":"2017-03-04T05:06:07Z","epoch":1488603967,"dur":"1m30s","nanos":1500000000,"bad":"nope"}}`), nil, nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"a", "b"}, j.ArgStringSlice("strs"))
+	assert.Equal(t, []int64{1, 2, 3}, j.ArgInt64Slice("ints"))
+	assert.Equal(t, map[string]interface{}{"k": "v"}, j.ArgMap("obj"))
+	assert.Equal(t, time.Date(2017, 3, 4, 5, 6, 7, 0, time.UTC), j.ArgTime("rfc").UTC())
+	assert.Equal(t, time.Date(2017, 3, 4, 5, 6, 7, 0, time.UTC), j.ArgTime("epoch").UTC())
+	assert.Equal(t, 90*time.Second, j.ArgDuration("dur"))
+	assert.Equal(t, 1500*time.Millisecond, j.ArgDuration("nanos"))
+	assert.NoError(t, j.ArgError())
+
+	// Missing keys fall back to the default, without an error:
+	assert.Equal(t, "def", j.ArgStringOr("missing", "def"))
+	assert.EqualValues(t, 7, j.ArgInt64Or("missing", 7))
+	assert.Equal(t, 1.5, j.ArgFloat64Or("missing", 1.5))
+	assert.Equal(t, true, j.ArgBoolOr("missing", true))
+	assert.Equal(t, time.Second, j.ArgDurationOr("missing", time.Second))
+	assert.EqualValues(t, 3, len(j.ArgInt64Slice("ints")))
+	assert.NoError(t, j.ArgError())
+	assert.Equal(t, "nope", j.ArgStringOr("bad", "def"))
+	assert.NoError(t, j.ArgError())
+
+	// Wrong types result in an error:
+	for _, f := range []func(){
+		func() { assert.Nil(t, j.ArgStringSlice("mixed")) },
+		func() { assert.Nil(t, j.ArgInt64Slice("strs")) },
+		func() { assert.Nil(t, j.ArgMap("strs")) },
+		func() { assert.True(t, j.ArgTime("bad").IsZero()) },
+		func() { assert.EqualValues(t, 0, j.ArgDuration("bad")) },
+		func() { assert.EqualValues(t, 0, j.ArgInt64Or("bad", 7)) },
+		func() { assert.Nil(t, j.ArgStringSlice("missing")) },
+	} {
+		f()
+		assert.Error(t, j.ArgError())
+		j.argError = nil
+	}
+}
+
+func TestJobDecodeArgs(t *testing.T) {
+	j := Job{}
+	j.setArg("url", "http://x")
+	j.setArg("width", 300)
+
+	var args struct {
+		URL   string `json:"url"`
+		Width int    `json:"width"`
+	}
+	assert.NoError(t, j.DecodeArgs(&args))
+	assert.Equal(t, "http://x", args.URL)
+	assert.Equal(t, 300, args.Width)
+	assert.NoError(t, j.ArgError())
+
+	var wrong struct {
+		URL int `json:"url"`
+	}
+	assert.Error(t, j.DecodeArgs(&wrong))
+	assert.Error(t, j.ArgError())
+}
@@ -1,13 +1,163 @@
 package work
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
 )
 
-func logError(key string, err error) {
-	fmt.Printf("ERROR: %s - %s\n", key, err.Error())
+// LogLevel is the severity of a log entry.
+type LogLevel int
+
+// Log levels, from the least to the most severe.
+const (
+	LogLevelDebug LogLevel = iota
+	LogLevelInfo
+	LogLevelWarn
+	LogLevelError
+)
+
+func (l LogLevel) String() string {
+	switch l {
+	case LogLevelDebug:
+		return "DEBUG"
+	case LogLevelInfo:
+		return "INFO"
+	case LogLevelWarn:
+		return "WARN"
+	case LogLevelError:
+		return "ERROR"
+	}
+	return fmt.Sprintf("LEVEL(%d)", int(l))
+}
+
+// Logger receives the log entries of worker pools, enqueuers, clients and the web UI.
+// msg is a short, stable key such as "worker.fetch". keyvals are alternating keys and values, eg "error", err, "job_name", "send_email".
+// Entries about a worker pool carry its "pool_id", and entries about a worker or a job also carry "worker_id", "job_name" and "job_id".
+type Logger interface {
+	Log(level LogLevel, msg string, keyvals ...interface{})
+}
+
+// LoggerFunc is an adapter to use an ordinary function as a Logger.
+type LoggerFunc func(level LogLevel, msg string, keyvals ...interface{})
+
+// Log calls f(level, msg, keyvals...).
+func (f LoggerFunc) Log(level LogLevel, msg string, keyvals ...interface{}) {
+	f(level, msg, keyvals...)
+}
+
+// NewStdLogger returns a Logger that writes the entries at minLevel or above to w, one per line, eg:
+//
+//	ERROR: worker.fetch error="dial tcp: connection refused" pool_id=4a3c1a7fd4ba95bd1a5b6b5e worker_id=e0ebbc4f8c95e5a8ae5c5dd6
+//
+// The default logger is a NewStdLogger(os.Stdout, LogLevelInfo).
+func NewStdLogger(w io.Writer, minLevel LogLevel) Logger {
+	return &stdLogger{w: w, minLevel: minLevel}
+}
+
+type stdLogger struct {
+	w        io.Writer
+	minLevel LogLevel
+	mtx      sync.Mutex
+}
+
+func (l *stdLogger) Log(level LogLevel, msg string, keyvals ...interface{}) {
+	if level < l.minLevel {
+		return
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s: %s", level, msg)
+	for i := 0; i < len(keyvals); i += 2 {
+		var v interface{} = "(MISSING)"
+		if i+1 < len(keyvals) {
+			v = keyvals[i+1]
+		}
+		fmt.Fprintf(&b, " %v=%s", keyvals[i], formatLogValue(v))
+	}
+	b.WriteByte('\n')
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	io.WriteString(l.w, b.String())
+}
+
+func formatLogValue(v interface{}) string {
+	s := fmt.Sprint(v)
+	if s == "" || strings.ContainsAny(s, " \t\r\n\"=") {
+		return strconv.Quote(s)
+	}
+	return s
+}
+
+var defaultLogger = NewStdLogger(os.Stdout, LogLevelInfo)
+
+// fieldLogger adds its fields after the keyvals of every entry.
+type fieldLogger struct {
+	logger Logger
+	fields []interface{}
+}
+
+func (l *fieldLogger) Log(level LogLevel, msg string, keyvals ...interface{}) {
+	all := make([]interface{}, 0, len(keyvals)+1+len(l.fields))
+	all = append(all, keyvals...)
+	if len(all)%2 == 1 {
+		all = append(all, "(MISSING)")
+	}
+	l.logger.Log(level, msg, append(all, l.fields...)...)
+}
+
+// withFields returns a Logger that adds keyvals to every entry logged through l. A nil l means the default logger.
+func withFields(l Logger, keyvals ...interface{}) Logger {
+	if l == nil {
+		l = defaultLogger
+	}
+	if fl, ok := l.(*fieldLogger); ok {
+		fields := make([]interface{}, 0, len(keyvals)+len(fl.fields))
+		fields = append(fields, keyvals...)
+		return &fieldLogger{logger: fl.logger, fields: append(fields, fl.fields...)}
+	}
+	return &fieldLogger{logger: l, fields: keyvals}
+}
+
+type loggerContextKey struct{}
+
+func contextWithLogger(ctx context.Context, l Logger) context.Context {
+	return context.WithValue(ctx, loggerContextKey{}, l)
+}
+
+// LoggerFromContext returns the logger of the worker pool that's running the job whose context.Context is ctx,
+// with the pool ID, worker ID, job name and job ID as fields. It returns the default logger for any other context.
+func LoggerFromContext(ctx context.Context) Logger {
+	if l, ok := ctx.Value(loggerContextKey{}).(Logger); ok {
+		return l
+	}
+	return defaultLogger
+}
+
+func logAt(l Logger, level LogLevel, msg string, keyvals ...interface{}) {
+	if l == nil {
+		l = defaultLogger
+	}
+	l.Log(level, msg, keyvals...)
+}
+
+func logError(l Logger, key string, err error, keyvals ...interface{}) {
+	logAt(l, LogLevelError, key, append([]interface{}{"error", err}, keyvals...)...)
+}
+
+func logWarn(l Logger, msg string, keyvals ...interface{}) {
+	logAt(l, LogLevelWarn, msg, keyvals...)
+}
+
+func logInfo(l Logger, msg string, keyvals ...interface{}) {
+	logAt(l, LogLevelInfo, msg, keyvals...)
 }
 
-func logInfo(format string, a ...interface{}) {
-	fmt.Printf("INFO: %s\n", fmt.Sprintf(format, a...))
+func logDebug(l Logger, msg string, keyvals ...interface{}) {
+	logAt(l, LogLevelDebug, msg, keyvals...)
 }
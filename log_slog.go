@@ -0,0 +1,27 @@
+//go:build go1.21
+
+package work
+
+import (
+	"context"
+	"log/slog"
+)
+
+// NewSlogLogger returns a Logger that writes to l, mapping each LogLevel to the slog level of the same name.
+func NewSlogLogger(l *slog.Logger) Logger {
+	return LoggerFunc(func(level LogLevel, msg string, keyvals ...interface{}) {
+		l.Log(context.Background(), slogLevel(level), msg, keyvals...)
+	})
+}
+
+func slogLevel(level LogLevel) slog.Level {
+	switch level {
+	case LogLevelDebug:
+		return slog.LevelDebug
+	case LogLevelInfo:
+		return slog.LevelInfo
+	case LogLevelWarn:
+		return slog.LevelWarn
+	}
+	return slog.LevelError
+}
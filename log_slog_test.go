@@ -0,0 +1,22 @@
+//go:build go1.21
+
+package work
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlogLogger(t *testing.T) {
+	var buf bytes.Buffer
+	l := NewSlogLogger(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo})))
+
+	l.Log(LogLevelDebug, "too.quiet")
+	logWarn(withFields(l, "pool_id", "abc"), "worker.process_job.timeout", "job_name", "wat")
+
+	assert.NotContains(t, buf.String(), "too.quiet")
+	assert.Contains(t, buf.String(), "level=WARN msg=worker.process_job.timeout job_name=wat pool_id=abc")
+}
@@ -0,0 +1,111 @@
+package work
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type logEntry struct {
+	level   LogLevel
+	msg     string
+	keyvals map[string]interface{}
+}
+
+type testLogger struct {
+	mtx     sync.Mutex
+	entries []logEntry
+}
+
+func (l *testLogger) Log(level LogLevel, msg string, keyvals ...interface{}) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	e := logEntry{level: level, msg: msg, keyvals: make(map[string]interface{})}
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		e.keyvals[fmt.Sprint(keyvals[i])] = keyvals[i+1]
+	}
+	l.entries = append(l.entries, e)
+}
+
+func (l *testLogger) find(msg string) *logEntry {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	for _, e := range l.entries {
+		if e.msg == msg {
+			return &e
+		}
+	}
+	return nil
+}
+
+func TestStdLogger(t *testing.T) {
+	var buf bytes.Buffer
+	l := withFields(NewStdLogger(&buf, LogLevelInfo), "pool_id", "abc")
+	l = withFields(l, "worker_id", "def")
+
+	l.Log(LogLevelDebug, "too.quiet")
+	logError(l, "worker.fetch", fmt.Errorf("no way"), "job_name", "wat")
+	logInfo(l, "odd", "dangling")
+
+	assert.Equal(t, `ERROR: worker.fetch error="no way" job_name=wat worker_id=def pool_id=abc
+INFO: odd dangling=(MISSING) worker_id=def pool_id=abc
+`, buf.String())
+}
+
+func TestLoggerFromContext(t *testing.T) {
+	assert.Equal(t, defaultLogger, LoggerFromContext(context.Background()))
+
+	l := &testLogger{}
+	LoggerFromContext(contextWithLogger(context.Background(), l)).Log(LogLevelInfo, "hi")
+	assert.NotNil(t, l.find("hi"))
+}
+
+func TestWorkerPoolLogger(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	l := &testLogger{}
+	handlerLoggers := make(chan Logger, 1)
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool).SetLogger(l)
+	wp.JobWithOptions("slow", JobOptions{MaxFails: 3, Timeout: 10}, func(ctx context.Context, job *Job) error {
+		handlerLoggers <- LoggerFromContext(ctx)
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	enqueuer := NewEnqueuer(ns, pool)
+	enqueuer.SetLogger(l)
+	job, err := enqueuer.Enqueue("slow", nil)
+	assert.NoError(t, err)
+
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	if e := l.find("enqueuer.enqueue"); assert.NotNil(t, e) {
+		assert.Equal(t, LogLevelDebug, e.level)
+		assert.Equal(t, job.ID, e.keyvals["job_id"])
+	}
+
+	if e := l.find("worker.process_job.timeout"); assert.NotNil(t, e) {
+		assert.Equal(t, LogLevelWarn, e.level)
+		assert.Equal(t, "slow", e.keyvals["job_name"])
+		assert.Equal(t, job.ID, e.keyvals["job_id"])
+		assert.Equal(t, wp.workerPoolID, e.keyvals["pool_id"])
+		assert.Equal(t, wp.workers[0].workerID, e.keyvals["worker_id"])
+	}
+
+	handlerLogger := <-handlerLoggers
+	handlerLogger.Log(LogLevelInfo, "from.handler")
+	if e := l.find("from.handler"); assert.NotNil(t, e) {
+		assert.Equal(t, job.ID, e.keyvals["job_id"])
+		assert.Equal(t, wp.workerPoolID, e.keyvals["pool_id"])
+	}
+
+	assert.NotNil(t, l.find("worker_pool.start"))
+}
@@ -0,0 +1,294 @@
+package work
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+)
+
+// The counters that worker pools with metrics enabled keep for each job name.
+const (
+	metricProcessed = "processed" // the handler ran, whether it succeeded or not
+	metricFailed    = "failed"    // the handler returned an error, panicked or timed out
+	metricRetried   = "retried"   // the job was put on the retry queue
+	metricDead      = "dead"      // the job was put on the dead queue
+	metricExpired   = "expired"   // the job was dropped because it expired before it started, see WithTTL. It's counted even without EnableMetrics.
+)
+
+var metricCounters = []string{metricProcessed, metricFailed, metricRetried, metricDead, metricExpired}
+
+// Upper bounds of the histogram buckets, in seconds.
+var (
+	runDurationBuckets  = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 300}
+	queueLatencyBuckets = []float64{1, 2, 5, 10, 30, 60, 120, 300, 600, 1800, 3600}
+)
+
+// EnableMetrics makes the pool's workers record job outcomes, run durations and queue latencies in Redis, so that they're reported by
+// MetricsHandler, for this pool and all the others of the namespace. It must be called before Start.
+func (wp *WorkerPool) EnableMetrics() *WorkerPool {
+	for _, w := range wp.workers {
+		w.metrics = true
+	}
+	return wp
+}
+
+// MetricsHandler returns an http.Handler that serves the metrics of the pool's namespace, as per NewMetricsHandler.
+func (wp *WorkerPool) MetricsHandler() http.Handler {
+	client := NewClient(wp.namespace, wp.pool)
+	client.SetLogger(wp.logger)
+	return client.MetricsHandler()
+}
+
+// NewMetricsHandler returns an http.Handler that serves metrics in the Prometheus text format: job counters and histograms recorded by worker pools
+// with EnableMetrics, along with the queue sizes and latencies, busy workers, and the sizes of the retry, scheduled and dead queues.
+func NewMetricsHandler(namespace string, pool *redis.Pool) http.Handler {
+	return NewClient(namespace, pool).MetricsHandler()
+}
+
+// MetricsHandler returns an http.Handler that serves the metrics of the client's namespace, as per NewMetricsHandler.
+func (c *Client) MetricsHandler() http.Handler {
+	return &metricsHandler{client: c}
+}
+
+type metricsHandler struct {
+	client *Client
+}
+
+func (m *metricsHandler) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	rw.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	if err := m.writeMetrics(rw); err != nil {
+		logError(m.client.logger, "metrics.write", err)
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+func (m *metricsHandler) writeMetrics(out io.Writer) error {
+	queues, err := m.client.Queues()
+	if err != nil {
+		return err
+	}
+	observations, err := m.client.WorkerObservations()
+	if err != nil {
+		return err
+	}
+
+	namespace := m.client.namespace
+	conn := m.client.pool.Get()
+	defer conn.Close()
+
+	conn.Send("ZCARD", redisKeyRetry(namespace))
+	conn.Send("ZCARD", redisKeyScheduled(namespace))
+	conn.Send("ZCARD", redisKeyDead(namespace))
+	for _, name := range metricCounters {
+		conn.Send("HGETALL", redisKeyMetricsCounter(namespace, name))
+	}
+	for _, q := range queues {
+		conn.Send("HGETALL", redisKeyMetricsHistogram(namespace, "run_duration", q.JobName))
+		conn.Send("HGETALL", redisKeyMetricsHistogram(namespace, "queue_latency", q.JobName))
+	}
+	if err := conn.Flush(); err != nil {
+		return err
+	}
+
+	var sizes [3]int64
+	for i := range sizes {
+		if sizes[i], err = redis.Int64(conn.Receive()); err != nil {
+			return err
+		}
+	}
+	counters := make([]map[string]int64, len(metricCounters))
+	for i := range metricCounters {
+		if counters[i], err = redis.Int64Map(conn.Receive()); err != nil {
+			return err
+		}
+	}
+	runDurations := make([]map[string]string, len(queues))
+	queueLatencies := make([]map[string]string, len(queues))
+	for i := range queues {
+		if runDurations[i], err = redis.StringMap(conn.Receive()); err != nil {
+			return err
+		}
+		if queueLatencies[i], err = redis.StringMap(conn.Receive()); err != nil {
+			return err
+		}
+	}
+
+	w := bufio.NewWriter(out)
+
+	writeMetricHeader(w, "work_queue_jobs", "gauge", "Number of jobs waiting on the queue.")
+	for _, q := range queues {
+		writeMetric(w, "work_queue_jobs", q.JobName, "", float64(q.Count))
+	}
+	writeMetricHeader(w, "work_queue_latency_seconds", "gauge", "Age of the oldest job waiting on the queue.")
+	for _, q := range queues {
+		writeMetric(w, "work_queue_latency_seconds", q.JobName, "", float64(q.Latency))
+	}
+	writeMetricHeader(w, "work_queue_paused", "gauge", "1 if the queue is paused.")
+	for _, q := range queues {
+		paused := 0.0
+		if q.Paused {
+			paused = 1
+		}
+		writeMetric(w, "work_queue_paused", q.JobName, "", paused)
+	}
+
+	busy := make(map[string]int)
+	for _, o := range observations {
+		if o.IsBusy {
+			busy[o.JobName]++
+		}
+	}
+	writeMetricHeader(w, "work_workers", "gauge", "Number of workers in the live worker pools.")
+	writeMetric(w, "work_workers", "", "", float64(len(observations)))
+	writeMetricHeader(w, "work_busy_workers", "gauge", "Number of workers processing a job.")
+	for _, jobName := range sortedKeys(busy) {
+		writeMetric(w, "work_busy_workers", jobName, "", float64(busy[jobName]))
+	}
+
+	writeMetricHeader(w, "work_retry_jobs", "gauge", "Number of jobs waiting to be retried.")
+	writeMetric(w, "work_retry_jobs", "", "", float64(sizes[0]))
+	writeMetricHeader(w, "work_scheduled_jobs", "gauge", "Number of jobs scheduled to run later.")
+	writeMetric(w, "work_scheduled_jobs", "", "", float64(sizes[1]))
+	writeMetricHeader(w, "work_dead_jobs", "gauge", "Number of jobs on the dead queue.")
+	writeMetric(w, "work_dead_jobs", "", "", float64(sizes[2]))
+
+	for i, name := range metricCounters {
+		metric := "work_jobs_" + name + "_total"
+		writeMetricHeader(w, metric, "counter", "Number of jobs "+name+", by job name.")
+		for _, jobName := range sortedKeys(counters[i]) {
+			writeMetric(w, metric, jobName, "", float64(counters[i][jobName]))
+		}
+	}
+
+	writeMetricHeader(w, "work_job_run_duration_seconds", "histogram", "Time spent running jobs.")
+	for i, q := range queues {
+		writeHistogram(w, "work_job_run_duration_seconds", q.JobName, runDurationBuckets, runDurations[i])
+	}
+	writeMetricHeader(w, "work_job_queue_latency_seconds", "histogram", "Time jobs waited on their queue before running.")
+	for i, q := range queues {
+		writeHistogram(w, "work_job_queue_latency_seconds", q.JobName, queueLatencyBuckets, queueLatencies[i])
+	}
+
+	return w.Flush()
+}
+
+func writeMetricHeader(w io.Writer, metric, kind, help string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", metric, help, metric, kind)
+}
+
+func writeMetric(w io.Writer, metric, jobName, le string, value float64) {
+	var labels []string
+	if jobName != "" {
+		labels = append(labels, `job_name="`+escapeLabelValue(jobName)+`"`)
+	}
+	if le != "" {
+		labels = append(labels, `le="`+le+`"`)
+	}
+	if len(labels) > 0 {
+		metric += "{" + strings.Join(labels, ",") + "}"
+	}
+	fmt.Fprintf(w, "%s %s\n", metric, strconv.FormatFloat(value, 'g', -1, 64))
+}
+
+// writeHistogram writes a histogram from the hash written by observeHistogram, which counts the observations of each bucket separately.
+func writeHistogram(w io.Writer, metric, jobName string, buckets []float64, vals map[string]string) {
+	if len(vals) == 0 {
+		return
+	}
+	var cumulative int64
+	for i := range buckets {
+		n, _ := strconv.ParseInt(vals[strconv.Itoa(i)], 10, 64)
+		cumulative += n
+		writeMetric(w, metric+"_bucket", jobName, strconv.FormatFloat(buckets[i], 'g', -1, 64), float64(cumulative))
+	}
+	count, _ := strconv.ParseInt(vals["count"], 10, 64)
+	sum, _ := strconv.ParseFloat(vals["sum"], 64)
+	writeMetric(w, metric+"_bucket", jobName, "+Inf", float64(count))
+	writeMetric(w, metric+"_sum", jobName, "", sum)
+	writeMetric(w, metric+"_count", jobName, "", float64(count))
+}
+
+func escapeLabelValue(v string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(v)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// observeHistogram sends the commands that add value to a histogram: it counts the observation in the first bucket that fits it,
+// if any, and adds it to the count and sum.
+func observeHistogram(conn redis.Conn, key string, buckets []float64, value float64) {
+	for i, le := range buckets {
+		if value <= le {
+			conn.Send("HINCRBY", key, strconv.Itoa(i), 1)
+			break
+		}
+	}
+	conn.Send("HINCRBY", key, "count", 1)
+	conn.Send("HINCRBYFLOAT", key, "sum", value)
+}
+
+// recordJobMetrics records that a job ran for duration, waiting on its queue for latency beforehand (if known, ie not negative).
+func (w *worker) recordJobMetrics(job *Job, duration, latency time.Duration, runErr error) {
+	if !w.metrics {
+		return
+	}
+
+	conn := w.pool.Get()
+	defer conn.Close()
+
+	conn.Send("HINCRBY", redisKeyMetricsCounter(w.namespace, metricProcessed), job.Name, 1)
+	if runErr != nil {
+		conn.Send("HINCRBY", redisKeyMetricsCounter(w.namespace, metricFailed), job.Name, 1)
+	}
+	observeHistogram(conn, redisKeyMetricsHistogram(w.namespace, "run_duration", job.Name), runDurationBuckets, duration.Seconds())
+	if latency >= 0 {
+		observeHistogram(conn, redisKeyMetricsHistogram(w.namespace, "queue_latency", job.Name), queueLatencyBuckets, latency.Seconds())
+	}
+	if err := conn.Flush(); err != nil {
+		logError(w.logger, "worker.record_job_metrics", err, "job_name", job.Name, "job_id", job.ID)
+	}
+}
+
+// countJobMetric increments one of the job's counters, eg metricRetried.
+func (w *worker) countJobMetric(job *Job, name string) {
+	if !w.metrics {
+		return
+	}
+
+	conn := w.pool.Get()
+	defer conn.Close()
+
+	if _, err := conn.Do("HINCRBY", redisKeyMetricsCounter(w.namespace, name), job.Name, 1); err != nil {
+		logError(w.logger, "worker.count_job_metric", err, "job_name", job.Name, "job_id", job.ID)
+	}
+}
+
+// queueLatency returns how long the job waited on its queue, or -1 for retries, since we don't know when they were requeued.
+func queueLatency(job *Job, now time.Time) time.Duration {
+	if job.Fails > 0 {
+		return -1
+	}
+	readyAt := job.EnqueuedAt
+	if job.ScheduledAt > readyAt {
+		readyAt = job.ScheduledAt
+	}
+	latency := now.Sub(time.Unix(readyAt, 0))
+	if latency < 0 {
+		latency = 0
+	}
+	return latency
+}
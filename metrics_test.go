@@ -0,0 +1,82 @@
+package work
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetrics(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("export", Q{"fail": false})
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("export", Q{"fail": true})
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue("other\"job", nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueIn("export", 100, nil)
+	assert.NoError(t, err)
+
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool).EnableMetrics()
+	wp.JobWithOptions("export", JobOptions{MaxFails: 1}, func(job *Job) error {
+		if job.ArgBool("fail") {
+			return fmt.Errorf("sorry kid")
+		}
+		return nil
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/metrics", nil)
+	wp.MetricsHandler().ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	assert.Equal(t, "text/plain; version=0.0.4; charset=utf-8", recorder.Header().Get("Content-Type"))
+
+	body := recorder.Body.String()
+	assert.Contains(t, body, "# TYPE work_jobs_processed_total counter\n")
+	assert.Contains(t, body, `work_jobs_processed_total{job_name="export"} 2`+"\n")
+	assert.Contains(t, body, `work_jobs_failed_total{job_name="export"} 1`+"\n")
+	assert.Contains(t, body, `work_jobs_dead_total{job_name="export"} 1`+"\n")
+	assert.NotContains(t, body, `work_jobs_retried_total{job_name="export"}`)
+	assert.Contains(t, body, `work_queue_jobs{job_name="other\"job"} 1`+"\n")
+	assert.Contains(t, body, `work_queue_jobs{job_name="export"} 0`+"\n")
+	assert.Contains(t, body, "work_scheduled_jobs 1\n")
+	assert.Contains(t, body, "work_dead_jobs 1\n")
+	assert.Contains(t, body, "work_retry_jobs 0\n")
+	assert.Contains(t, body, `work_job_run_duration_seconds_bucket{job_name="export",le="+Inf"} 2`+"\n")
+	assert.Contains(t, body, `work_job_run_duration_seconds_count{job_name="export"} 2`+"\n")
+	assert.Contains(t, body, `work_job_queue_latency_seconds_bucket{job_name="export",le="3600"} 2`+"\n")
+}
+
+func TestMetricsDisabled(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("export", nil)
+	assert.NoError(t, err)
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.Job("export", func(job *Job) error {
+		return nil
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/metrics", nil)
+	NewMetricsHandler(ns, pool).ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	assert.NotContains(t, recorder.Body.String(), `work_jobs_processed_total{job_name="export"}`)
+}
@@ -30,6 +30,8 @@ type observer struct {
 
 	drainChan        chan struct{}
 	doneDrainingChan chan struct{}
+
+	logger Logger
 }
 
 type observationKind int
@@ -38,6 +40,7 @@ const (
 	observationKindStarted observationKind = iota
 	observationKindDone
 	observationKindCheckin
+	observationKindProgress
 )
 
 type observation struct {
@@ -57,6 +60,12 @@ type observation struct {
 	// If this is a checkin, set these.
 	checkin   string
 	checkinAt int64
+
+	// If this is a progress report, set these. Fields are merged into those of earlier reports.
+	progressDone   int64
+	progressTotal  int64
+	progressFields map[string]interface{}
+	progressAt     int64
 }
 
 const observerBufferSize = 1024
@@ -119,6 +128,18 @@ func (o *observer) observeCheckin(jobName, jobID, checkin string) {
 	}
 }
 
+func (o *observer) observeProgress(jobName, jobID string, done, total int64, fields map[string]interface{}) {
+	o.observationsChan <- &observation{
+		kind:           observationKindProgress,
+		jobName:        jobName,
+		jobID:          jobID,
+		progressDone:   done,
+		progressTotal:  total,
+		progressFields: fields,
+		progressAt:     nowEpochSeconds(),
+	}
+}
+
 func (o *observer) loop() {
 	// Every tick we'll update redis if necessary
 	// We don't update it on every job because the only purpose of this data is for humans to inspect the system,
@@ -138,7 +159,7 @@ func (o *observer) loop() {
 					o.process(obv)
 				default:
 					if err := o.writeStatus(o.currentStartedObservation); err != nil {
-						logError("observer.write", err)
+						logError(o.logger, "observer.write", err)
 					}
 					o.doneDrainingChan <- struct{}{}
 					break DRAIN_LOOP
@@ -147,7 +168,7 @@ func (o *observer) loop() {
 		case <-ticker:
 			if o.lastWrittenVersion != o.version {
 				if err := o.writeStatus(o.currentStartedObservation); err != nil {
-					logError("observer.write", err)
+					logError(o.logger, "observer.write", err)
 				}
 				o.lastWrittenVersion = o.version
 			}
@@ -167,7 +188,22 @@ func (o *observer) process(obv *observation) {
 			o.currentStartedObservation.checkin = obv.checkin
 			o.currentStartedObservation.checkinAt = obv.checkinAt
 		} else {
-			logError("observer.checkin_mismatch", fmt.Errorf("got checkin but mismatch on job ID or no job"))
+			logError(o.logger, "observer.checkin_mismatch", fmt.Errorf("got checkin but mismatch on job ID or no job"))
+		}
+	} else if obv.kind == observationKindProgress {
+		if (o.currentStartedObservation != nil) && (obv.jobID == o.currentStartedObservation.jobID) {
+			cur := o.currentStartedObservation
+			cur.progressDone = obv.progressDone
+			cur.progressTotal = obv.progressTotal
+			cur.progressAt = obv.progressAt
+			if len(obv.progressFields) > 0 && cur.progressFields == nil {
+				cur.progressFields = make(map[string]interface{}, len(obv.progressFields))
+			}
+			for k, v := range obv.progressFields {
+				cur.progressFields[k] = v
+			}
+		} else {
+			logError(o.logger, "observer.progress_mismatch", fmt.Errorf("got progress but mismatch on job ID or no job"))
 		}
 	}
 	o.version++
@@ -175,7 +211,7 @@ func (o *observer) process(obv *observation) {
 	// If this is the version observation we got, just go ahead and write it.
 	if o.version == 1 {
 		if err := o.writeStatus(o.currentStartedObservation); err != nil {
-			logError("observer.first_write", err)
+			logError(o.logger, "observer.first_write", err)
 		}
 		o.lastWrittenVersion = o.version
 	}
@@ -199,6 +235,10 @@ func (o *observer) writeStatus(obv *observation) error {
 		// args -> json.Encode(obv.arguments)
 		// checkin -> obv.checkin
 		// checkin_at -> obv.checkinAt
+		// progress_done -> obv.progressDone
+		// progress_total -> obv.progressTotal
+		// progress_at -> obv.progressAt
+		// progress_fields -> json.Encode(obv.progressFields)
 
 		var argsJSON []byte
 		if len(obv.arguments) == 0 {
@@ -211,7 +251,7 @@ func (o *observer) writeStatus(obv *observation) error {
 			}
 		}
 
-		args := make([]interface{}, 0, 13)
+		args := make([]interface{}, 0, 21)
 		args = append(args,
 			key,
 			"job_name", obv.jobName,
@@ -227,6 +267,27 @@ func (o *observer) writeStatus(obv *observation) error {
 			)
 		}
 
+		if obv.progressAt > 0 {
+			args = append(args,
+				"progress_done", obv.progressDone,
+				"progress_total", obv.progressTotal,
+				"progress_at", obv.progressAt,
+			)
+			if len(obv.progressFields) > 0 {
+				fieldsJSON, err := json.Marshal(obv.progressFields)
+				if err != nil {
+					return err
+				}
+				args = append(args, "progress_fields", fieldsJSON)
+			}
+		}
+
+		// The hash may still hold the progress of the worker's previous job.
+		if obv.progressAt == 0 {
+			conn.Send("HDEL", key, "progress_done", "progress_total", "progress_at", "progress_fields")
+		} else if len(obv.progressFields) == 0 {
+			conn.Send("HDEL", key, "progress_fields")
+		}
 		conn.Send("HMSET", args...)
 		conn.Send("EXPIRE", key, 60*60*24)
 		if err := conn.Flush(); err != nil {
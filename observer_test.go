@@ -105,6 +105,48 @@ func TestObserverCheckinFromJob(t *testing.T) {
 	assert.Equal(t, fmt.Sprint(tMockCheckin), h["checkin_at"])
 }
 
+func TestObserverProgressFromJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+
+	observer := newObserver(ns, pool, "abcd")
+	observer.start()
+
+	tMock := int64(1425263401)
+	setNowEpochSecondsMock(tMock)
+	defer resetNowEpochSecondsMock()
+	observer.observeStarted("foo", "barbar", Q{"a": 1, "b": "wat"})
+
+	tMockProgress := int64(1425263410)
+	setNowEpochSecondsMock(tMockProgress)
+
+	j := &Job{Name: "foo", ID: "barbar", observer: observer}
+	j.Progress(10, 100, "file", "a.csv", "errors", 1)
+	j.Progress(25, 100, "file", "b.csv")
+
+	observer.drain()
+
+	h := readHash(pool, redisKeyWorkerObservation(ns, "abcd"))
+	assert.Equal(t, "barbar", h["job_id"])
+	assert.Equal(t, "25", h["progress_done"])
+	assert.Equal(t, "100", h["progress_total"])
+	assert.Equal(t, fmt.Sprint(tMockProgress), h["progress_at"])
+	assert.Equal(t, `{"errors":1,"file":"b.csv"}`, h["progress_fields"])
+
+	// The next job doesn't inherit the progress of this one.
+	observer.observeDone("foo", "barbar", nil)
+	observer.observeStarted("foo", "bazbaz", nil)
+	observer.drain()
+	observer.stop()
+
+	h = readHash(pool, redisKeyWorkerObservation(ns, "abcd"))
+	assert.Equal(t, "bazbaz", h["job_id"])
+	assert.NotContains(t, h, "progress_done")
+	assert.NotContains(t, h, "progress_total")
+	assert.NotContains(t, h, "progress_at")
+	assert.NotContains(t, h, "progress_fields")
+}
+
 func readHash(pool *redis.Pool, key string) map[string]string {
 	m := make(map[string]string)
 
@@ -0,0 +1,115 @@
+// Package outbox enqueues jobs from SQL transactions, following the transactional outbox pattern: an OutboxEnqueuer writes jobs
+// to a table in the caller's transaction, so that they're only enqueued if it commits, and a Relay moves the committed jobs to Redis.
+//
+// The table must have an auto-incrementing id and a job column holding the JSON of the job, eg with SQLite:
+//
+//	CREATE TABLE work_outbox (id INTEGER PRIMARY KEY AUTOINCREMENT, job TEXT NOT NULL)
+//
+// With PostgreSQL:
+//
+//	CREATE TABLE work_outbox (id BIGSERIAL PRIMARY KEY, job TEXT NOT NULL)
+package outbox
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sanyfan/work"
+)
+
+// DefaultTable is the name of the outbox table if Options.Table is empty.
+const DefaultTable = "work_outbox"
+
+// Options configures the outbox table of an OutboxEnqueuer or a Relay.
+type Options struct {
+	Table  string // the name of the outbox table, DefaultTable if empty
+	Dollar bool   // write query parameters as $1, $2, etc, as PostgreSQL expects, instead of ?
+}
+
+func (o Options) table() string {
+	if o.Table == "" {
+		return DefaultTable
+	}
+	return o.Table
+}
+
+// placeholders returns the placeholders of n query parameters, separated by commas.
+func (o Options) placeholders(n int) string {
+	ps := make([]string, n)
+	for i := range ps {
+		if o.Dollar {
+			ps[i] = "$" + strconv.Itoa(i+1)
+		} else {
+			ps[i] = "?"
+		}
+	}
+	return strings.Join(ps, ", ")
+}
+
+// OutboxEnqueuer enqueues jobs by writing them to the outbox table in a SQL transaction. They're enqueued in Redis by a Relay,
+// once the transaction is committed.
+type OutboxEnqueuer struct {
+	options Options
+}
+
+// NewOutboxEnqueuer creates an OutboxEnqueuer that writes to the outbox table of options.
+func NewOutboxEnqueuer(options Options) *OutboxEnqueuer {
+	return &OutboxEnqueuer{options: options}
+}
+
+// Enqueue writes a job with the specified name and arguments to the outbox table in tx, as per work.Enqueuer.Enqueue.
+// The returned job has the ID it will have once enqueued.
+func (o *OutboxEnqueuer) Enqueue(tx *sql.Tx, jobName string, args map[string]interface{}) (*work.Job, error) {
+	job := &work.Job{
+		Name:       jobName,
+		ID:         makeIdentifier(),
+		EnqueuedAt: time.Now().Unix(),
+		Args:       args,
+	}
+	if err := o.insert(tx, job); err != nil {
+		return nil, err
+	}
+	return job, nil
+}
+
+// EnqueueIn writes a job to the outbox table in tx, to be put in the scheduled job queue for execution in secondsFromNow seconds,
+// as per work.Enqueuer.EnqueueIn. The time is counted from now, not from when the job is relayed.
+func (o *OutboxEnqueuer) EnqueueIn(tx *sql.Tx, jobName string, secondsFromNow int64, args map[string]interface{}) (*work.ScheduledJob, error) {
+	now := time.Now().Unix()
+	job := &work.Job{
+		Name:        jobName,
+		ID:          makeIdentifier(),
+		EnqueuedAt:  now,
+		Args:        args,
+		ScheduledAt: now + secondsFromNow,
+	}
+	if err := o.insert(tx, job); err != nil {
+		return nil, err
+	}
+	return &work.ScheduledJob{RunAt: job.ScheduledAt, RunAtMillis: job.ScheduledAt * 1000, Job: job}, nil
+}
+
+func (o *OutboxEnqueuer) insert(tx *sql.Tx, job *work.Job) error {
+	rawJSON, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf("INSERT INTO %s (job) VALUES (%s)", o.options.table(), o.options.placeholders(1))
+	_, err = tx.Exec(query, string(rawJSON))
+	return err
+}
+
+func makeIdentifier() string {
+	b := make([]byte, 12)
+	_, err := io.ReadFull(rand.Reader, b)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%x", b)
+}
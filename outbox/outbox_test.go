@@ -0,0 +1,170 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/sanyfan/work"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutbox(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	db := newTestDB(t)
+
+	outbox := NewOutboxEnqueuer(Options{})
+
+	// A rolled back transaction enqueues nothing.
+	tx, err := db.Begin()
+	assert.NoError(t, err)
+	_, err = outbox.Enqueue(tx, "wat", work.Q{"a": 1})
+	assert.NoError(t, err)
+	assert.NoError(t, tx.Rollback())
+
+	tx, err = db.Begin()
+	assert.NoError(t, err)
+	job, err := outbox.Enqueue(tx, "wat", work.Q{"a": 2})
+	assert.NoError(t, err)
+	assert.Equal(t, "wat", job.Name)
+	assert.True(t, len(job.ID) > 10)
+	scheduledJob, err := outbox.EnqueueIn(tx, "taw", 300, work.Q{"b": 3})
+	assert.NoError(t, err)
+	assert.True(t, scheduledJob.RunAt > time.Now().Unix()+290)
+
+	relay := NewRelay(db, work.NewEnqueuer(ns, pool), RelayOptions{})
+
+	// Uncommitted jobs aren't relayed.
+	n, err := relay.Relay(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 0, n)
+
+	assert.NoError(t, tx.Commit())
+	n, err = relay.Relay(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, 0, outboxSize(t, db))
+
+	client := work.NewClient(ns, pool)
+	queues, err := client.Queues()
+	assert.NoError(t, err)
+	counts := make(map[string]int64)
+	for _, q := range queues {
+		counts[q.JobName] = q.Count
+	}
+	assert.Equal(t, map[string]int64{"wat": 1, "taw": 0}, counts)
+	scheduled, count, err := client.ScheduledJobs(1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+	if assert.Equal(t, 1, len(scheduled)) {
+		assert.Equal(t, scheduledJob.ID, scheduled[0].ID)
+		assert.Equal(t, scheduledJob.RunAt, scheduled[0].RunAt)
+		assert.EqualValues(t, 3, scheduled[0].ArgInt64("b"))
+	}
+
+	// The job keeps its ID.
+	var jobID string
+	wp := work.NewWorkerPool(struct{}{}, 1, ns, pool)
+	wp.Job("wat", func(j *work.Job) error {
+		jobID = j.ID
+		return nil
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+	assert.Equal(t, job.ID, jobID)
+}
+
+func TestRelayBatches(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+	db := newTestDB(t)
+
+	outbox := NewOutboxEnqueuer(Options{})
+	tx, err := db.Begin()
+	assert.NoError(t, err)
+	for i := 0; i < 25; i++ {
+		_, err := outbox.Enqueue(tx, "wat", work.Q{"i": i})
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, tx.Commit())
+	_, err = db.Exec("INSERT INTO work_outbox (job) VALUES ('not json')")
+	assert.NoError(t, err)
+
+	relay := NewRelay(db, work.NewEnqueuer(ns, pool), RelayOptions{BatchSize: 10, Interval: 10 * time.Millisecond})
+	relay.SetLogger(work.LoggerFunc(func(level work.LogLevel, msg string, keyvals ...interface{}) {}))
+	relay.Start()
+	deadline := time.Now().Add(5 * time.Second)
+	for outboxSize(t, db) > 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	relay.Stop()
+
+	assert.Equal(t, 0, outboxSize(t, db))
+	queues, err := work.NewClient(ns, pool).Queues()
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(queues)) {
+		assert.EqualValues(t, 25, queues[0].Count)
+	}
+}
+
+func TestPlaceholders(t *testing.T) {
+	assert.Equal(t, "?, ?, ?", Options{}.placeholders(3))
+	assert.Equal(t, "$1, $2", Options{Dollar: true}.placeholders(2))
+	assert.Equal(t, DefaultTable, Options{}.table())
+	assert.Equal(t, "jobs_outbox", Options{Table: "jobs_outbox"}.table())
+}
+
+func newTestDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "outbox.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	if _, err := db.Exec("CREATE TABLE work_outbox (id INTEGER PRIMARY KEY AUTOINCREMENT, job TEXT NOT NULL)"); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func outboxSize(t *testing.T, db *sql.DB) int {
+	var n int
+	if err := db.QueryRow("SELECT COUNT(*) FROM work_outbox").Scan(&n); err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func newTestPool(addr string) *redis.Pool {
+	return &redis.Pool{
+		MaxActive:   3,
+		MaxIdle:     3,
+		IdleTimeout: 240 * time.Second,
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", addr)
+		},
+		Wait: true,
+	}
+}
+
+func cleanKeyspace(namespace string, pool *redis.Pool) {
+	conn := pool.Get()
+	defer conn.Close()
+
+	keys, err := redis.Strings(conn.Do("KEYS", namespace+"*"))
+	if err != nil {
+		panic("could not get keys: " + err.Error())
+	}
+	for _, k := range keys {
+		if _, err := conn.Do("DEL", k); err != nil {
+			panic("could not del: " + err.Error())
+		}
+	}
+}
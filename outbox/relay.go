@@ -0,0 +1,166 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/sanyfan/work"
+)
+
+// RelayOptions configures a Relay.
+type RelayOptions struct {
+	Options
+	Interval  time.Duration // how often the outbox table is polled, 1 second if zero
+	BatchSize int           // the maximum number of jobs relayed per transaction, 100 if zero
+}
+
+// Relay moves the jobs of the outbox table to Redis, with a work.Enqueuer, and deletes them from the table.
+// A job is deleted in the same transaction it was read in, once it's enqueued, so jobs are enqueued at least once: if the process dies
+// in between, the job is enqueued again by the next run. Only one relay should run for a table, since concurrent relays might enqueue
+// the same jobs.
+type Relay struct {
+	db       *sql.DB
+	enqueuer *work.Enqueuer
+	options  RelayOptions
+	logger   work.Logger
+
+	stopChan         chan struct{}
+	doneStoppingChan chan struct{}
+}
+
+// NewRelay creates a Relay that moves the jobs of the outbox table of db to Redis with enqueuer.
+func NewRelay(db *sql.DB, enqueuer *work.Enqueuer, options RelayOptions) *Relay {
+	if options.Interval <= 0 {
+		options.Interval = time.Second
+	}
+	if options.BatchSize <= 0 {
+		options.BatchSize = 100
+	}
+	return &Relay{
+		db:       db,
+		enqueuer: enqueuer,
+		options:  options,
+		logger:   work.NewStdLogger(os.Stdout, work.LogLevelInfo),
+
+		stopChan:         make(chan struct{}),
+		doneStoppingChan: make(chan struct{}),
+	}
+}
+
+// SetLogger sets the logger that the relay logs its errors to. It must be called before Start.
+func (r *Relay) SetLogger(l work.Logger) {
+	r.logger = l
+}
+
+// Start starts relaying jobs in a goroutine, until Stop is called.
+func (r *Relay) Start() {
+	go r.loop()
+}
+
+// Stop stops the relay, waiting for the current batch, if any, to be relayed.
+func (r *Relay) Stop() {
+	r.stopChan <- struct{}{}
+	<-r.doneStoppingChan
+}
+
+func (r *Relay) loop() {
+	ticker := time.NewTicker(r.options.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stopChan:
+			r.doneStoppingChan <- struct{}{}
+			return
+		case <-ticker.C:
+			if _, err := r.Relay(context.Background()); err != nil {
+				r.logger.Log(work.LogLevelError, "outbox.relay", "error", err)
+			}
+		}
+	}
+}
+
+// Relay relays all the jobs of the outbox table, one batch at a time, and returns how many were enqueued.
+// It stops at the first batch that fails.
+func (r *Relay) Relay(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		n, more, err := r.relayBatch(ctx)
+		total += n
+		if err != nil || !more {
+			return total, err
+		}
+	}
+}
+
+// relayBatch relays a batch of jobs, and returns how many were enqueued and whether the batch was full, ie there might be more.
+func (r *Relay) relayBatch(ctx context.Context) (int, bool, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, false, err
+	}
+	defer tx.Rollback()
+
+	table := r.options.table()
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf("SELECT id, job FROM %s ORDER BY id LIMIT %d", table, r.options.BatchSize))
+	if err != nil {
+		return 0, false, err
+	}
+
+	var ids, done []interface{}
+	var jobs []*work.Job
+	for rows.Next() {
+		var id int64
+		var rawJSON string
+		if err := rows.Scan(&id, &rawJSON); err != nil {
+			rows.Close()
+			return 0, false, err
+		}
+		var job work.Job
+		if err := json.Unmarshal([]byte(rawJSON), &job); err != nil {
+			// It would never be relayed, so drop it rather than have it block the table.
+			r.logger.Log(work.LogLevelError, "outbox.relay.decode", "error", err, "id", id)
+			done = append(done, id)
+			continue
+		}
+		ids = append(ids, id)
+		jobs = append(jobs, &job)
+	}
+	err = rows.Err()
+	rows.Close()
+	if err != nil {
+		return 0, false, err
+	}
+	more := len(ids)+len(done) == r.options.BatchSize
+
+	enqueued := 0
+	var enqueueErr error
+	for i, res := range r.enqueuer.EnqueueJobs(jobs) {
+		if res.Status == work.BulkFailed {
+			if enqueueErr == nil {
+				enqueueErr = fmt.Errorf("enqueueing job %s: %w", res.Job.ID, res.Err)
+			}
+			continue
+		}
+		done = append(done, ids[i])
+		enqueued++
+	}
+
+	if len(done) > 0 {
+		query := fmt.Sprintf("DELETE FROM %s WHERE id IN (%s)", table, r.options.placeholders(len(done)))
+		if _, err := tx.ExecContext(ctx, query, done...); err != nil {
+			return 0, false, err
+		}
+		if err := tx.Commit(); err != nil {
+			return 0, false, err
+		}
+	}
+	if enqueueErr != nil {
+		return enqueued, false, enqueueErr
+	}
+	return enqueued, more, nil
+}
@@ -0,0 +1,259 @@
+package work
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+)
+
+// ErrPeriodicJobNotFound is returned by Client.RunPeriodicJob when there's no periodic job with the specified ID.
+var ErrPeriodicJobNotFound = fmt.Errorf("periodic job not found")
+
+// ErrPeriodicJobRunning is returned by Client.RunPeriodicJob when the periodic job has NoOverlap and its previous run is still queued
+// or in progress.
+var ErrPeriodicJobRunning = fmt.Errorf("periodic job is still running")
+
+// PeriodicJob is a periodic job stored in Redis, see Client.AddPeriodicJob. Unlike those of PeriodicallyEnqueue, which are compiled
+// into the worker pools, they can be added, changed and removed at runtime, eg one per tenant.
+type PeriodicJob struct {
+	ID        string                 `json:"id"`
+	JobName   string                 `json:"job_name"`
+	Spec      string                 `json:"spec"` // as per PeriodicallyEnqueue
+	Args      map[string]interface{} `json:"args,omitempty"`
+	TimeZone  string                 `json:"time_zone,omitempty"`  // see PeriodicOptions
+	NoOverlap bool                   `json:"no_overlap,omitempty"` // see PeriodicOptions
+	Enabled   bool                   `json:"enabled"`
+
+	// NextRunAt is when the job is next enqueued, in epoch seconds, if it's enabled. It's set by ListPeriodicJobs.
+	NextRunAt int64 `json:"next_run_at,omitempty"`
+}
+
+// periodicJob returns the periodic job that the periodic enqueuer enqueues for p.
+func (p *PeriodicJob) periodicJob() (*periodicJob, error) {
+	pj, err := newPeriodicJob(p.Spec, p.JobName, PeriodicOptions{Args: p.Args, TimeZone: p.TimeZone, NoOverlap: p.NoOverlap})
+	if err != nil {
+		return nil, err
+	}
+	pj.id = p.ID
+	return pj, nil
+}
+
+// AddPeriodicJob adds the periodic job to Redis, or replaces the one with the same ID. If it's enabled, its runs of the next few
+// minutes are scheduled right away, and worker pools keep enqueueing it from then on, as per PeriodicallyEnqueue. If job has no ID,
+// it's given one.
+// It returns an error if the spec or the time zone is invalid.
+func (c *Client) AddPeriodicJob(job *PeriodicJob) error {
+	if job.JobName == "" {
+		return fmt.Errorf("periodic job has no job name")
+	}
+	pj, err := job.periodicJob()
+	if err != nil {
+		return err
+	}
+	if job.ID == "" {
+		job.ID = makeIdentifier()
+	}
+
+	stored := *job
+	stored.NextRunAt = 0
+	rawJSON, err := json.Marshal(&stored)
+	if err != nil {
+		return err
+	}
+
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	// The runs of the job it replaces that were already scheduled are dropped, since they might not happen anymore.
+	old, err := readPeriodicJob(conn, c.namespace, job.ID)
+	if err != nil {
+		logError(c.logger, "client.add_periodic_job.read", err)
+		return err
+	}
+	if old != nil {
+		c.unschedulePeriodicJob(conn, old)
+	}
+	if _, err := conn.Do("HSET", redisKeyPeriodicJobs(c.namespace), job.ID, rawJSON); err != nil {
+		logError(c.logger, "client.add_periodic_job.hset", err)
+		return err
+	}
+	// Rather than wait for the periodic enqueuer, which might miss the runs due before its next pass.
+	if job.Enabled {
+		pj.id = job.ID
+		now := time.Unix(nowEpochSeconds(), 0)
+		if err := pj.scheduleRuns(conn, c.namespace, now, now.Add(periodicEnqueuerHorizon)); err != nil {
+			logError(c.logger, "client.add_periodic_job.schedule", err, "periodic_job_id", job.ID)
+			return err
+		}
+	}
+	return nil
+}
+
+// RemovePeriodicJob removes the periodic job with the specified ID from Redis, along with its runs that were already scheduled.
+// It returns ErrNotDeleted if there was no such job. A worker pool's periodic enqueuer that read the job just before it was removed
+// can still schedule its runs of the next few minutes; use Enabled to stop it for sure, before removing it.
+func (c *Client) RemovePeriodicJob(id string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	job, err := readPeriodicJob(conn, c.namespace, id)
+	if err != nil {
+		logError(c.logger, "client.remove_periodic_job.read", err)
+		return err
+	}
+	if job == nil {
+		return ErrNotDeleted
+	}
+	c.unschedulePeriodicJob(conn, job)
+	if _, err := conn.Do("HDEL", redisKeyPeriodicJobs(c.namespace), id); err != nil {
+		logError(c.logger, "client.remove_periodic_job.hdel", err)
+		return err
+	}
+	return nil
+}
+
+// ListPeriodicJobs returns the periodic jobs stored in Redis, sorted by ID, with when they're next enqueued.
+func (c *Client) ListPeriodicJobs() ([]*PeriodicJob, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	jobs, err := readPeriodicJobs(conn, c.namespace, c.logger)
+	if err != nil {
+		logError(c.logger, "client.list_periodic_jobs", err)
+		return nil, err
+	}
+
+	now := time.Unix(nowEpochSeconds(), 0)
+	for _, job := range jobs {
+		if !job.Enabled {
+			continue
+		}
+		pj, err := job.periodicJob()
+		if err != nil {
+			logError(c.logger, "client.list_periodic_jobs.periodic_job", err, "periodic_job_id", job.ID)
+			continue
+		}
+		if next := pj.next(now); !next.IsZero() {
+			job.NextRunAt = next.Unix()
+		}
+	}
+	return jobs, nil
+}
+
+// RunPeriodicJob enqueues the periodic job with the specified ID right away, with its arguments, as per Enqueue.
+// It's enqueued even if the job is disabled. With NoOverlap, the run holds the lock that the scheduled runs take, so that they're
+// skipped while it's queued or in progress, and ErrPeriodicJobRunning is returned if the previous run still holds it. It returns
+// ErrPeriodicJobNotFound if there's no such job.
+func (c *Client) RunPeriodicJob(id string) (*Job, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	job, err := readPeriodicJob(conn, c.namespace, id)
+	if err != nil {
+		logError(c.logger, "client.run_periodic_job.read", err)
+		return nil, err
+	}
+	if job == nil {
+		return nil, ErrPeriodicJobNotFound
+	}
+	if !job.NoOverlap {
+		return c.enqueuer.Enqueue(job.JobName, job.Args)
+	}
+	return c.runPeriodicJobLocked(conn, job)
+}
+
+// runPeriodicJobLocked enqueues a run of job that takes its lock, as the runs scheduled by the periodic enqueuer do when they're due.
+func (c *Client) runPeriodicJobLocked(conn redis.Conn, job *PeriodicJob) (*Job, error) {
+	pj, err := job.periodicJob()
+	if err != nil {
+		logError(c.logger, "client.run_periodic_job.periodic_job", err, "periodic_job_id", job.ID)
+		return nil, err
+	}
+	run := pj.job(c.namespace, nowEpochSeconds())
+	run.ID = makeIdentifier()
+	run.ScheduledAt = 0
+	rawJSON, err := run.serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	e := &c.enqueuer
+	if err := e.addToKnownJobs(conn, run.Name); err != nil {
+		logError(c.logger, "client.run_periodic_job.known_jobs", err)
+		return nil, err
+	}
+	args := []interface{}{e.queuePrefix + run.Name, run.UniqueKey, run.UniqueExpire, redisKeyScheduled(c.namespace), rawJSON, run.Priority, false}
+	res, err := redis.String(e.enqueueUniqueScript.Do(conn, append(args, e.indexAndStatusArgs(run)...)...))
+	if err != nil {
+		logError(c.logger, "client.run_periodic_job.enqueue", err)
+		return nil, err
+	}
+	if res != "ok" {
+		return nil, ErrPeriodicJobRunning
+	}
+	return run, nil
+}
+
+// unschedulePeriodicJob removes the runs of job that the periodic enqueuer might have scheduled from the scheduled job queue.
+func (c *Client) unschedulePeriodicJob(conn redis.Conn, job *PeriodicJob) {
+	pj, err := job.periodicJob()
+	if err != nil {
+		return // it was never scheduled
+	}
+
+	now := time.Unix(nowEpochSeconds(), 0)
+	horizon := now.Add(periodicEnqueuerHorizon)
+	args := []interface{}{redisKeyScheduled(c.namespace)}
+	for t := pj.next(now); !t.IsZero() && t.Before(horizon); t = pj.next(t) {
+		rawJSON, err := pj.job(c.namespace, t.Unix()).serialize()
+		if err != nil {
+			logError(c.logger, "client.unschedule_periodic_job.serialize", err, "periodic_job_id", job.ID)
+			return
+		}
+		args = append(args, rawJSON)
+	}
+	if len(args) == 1 {
+		return
+	}
+	if _, err := conn.Do("ZREM", args...); err != nil {
+		logError(c.logger, "client.unschedule_periodic_job.zrem", err, "periodic_job_id", job.ID)
+	}
+}
+
+func readPeriodicJob(conn redis.Conn, namespace, id string) (*PeriodicJob, error) {
+	rawJSON, err := redis.Bytes(conn.Do("HGET", redisKeyPeriodicJobs(namespace), id))
+	if err == redis.ErrNil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var job PeriodicJob
+	if err := json.Unmarshal(rawJSON, &job); err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// readPeriodicJobs reads the periodic jobs stored in Redis, sorted by ID. Those that can't be decoded are logged and skipped, so that
+// they don't hold up the others.
+func readPeriodicJobs(conn redis.Conn, namespace string, logger Logger) ([]*PeriodicJob, error) {
+	vals, err := redis.StringMap(conn.Do("HGETALL", redisKeyPeriodicJobs(namespace)))
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*PeriodicJob, 0, len(vals))
+	for id, rawJSON := range vals {
+		var job PeriodicJob
+		if err := json.Unmarshal([]byte(rawJSON), &job); err != nil {
+			logError(logger, "periodic_job.unmarshal", err, "periodic_job_id", id)
+			continue
+		}
+		jobs = append(jobs, &job)
+	}
+	sort.Slice(jobs, func(i, j int) bool { return jobs[i].ID < jobs[j].ID })
+	return jobs, nil
+}
@@ -20,12 +20,96 @@ type periodicEnqueuer struct {
 	scheduledPeriodicJobs []*scheduledPeriodicJob
 	stopChan              chan struct{}
 	doneStoppingChan      chan struct{}
+	logger                Logger
 }
 
 type periodicJob struct {
 	jobName  string
 	spec     string
 	schedule cron.Schedule
+
+	// Set by PeriodicallyEnqueueWithOptions.
+	args      map[string]interface{}
+	location  *time.Location // nil: the local time zone
+	noOverlap bool
+	expire    int // seconds
+
+	id string // set for the periodic jobs stored in Redis, see Client.AddPeriodicJob
+}
+
+// newPeriodicJob returns the periodic job that enqueues jobName according to spec, with opts.
+func newPeriodicJob(spec, jobName string, opts PeriodicOptions) (*periodicJob, error) {
+	schedule, err := cron.Parse(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	pj := &periodicJob{jobName: jobName, spec: spec, schedule: schedule, args: opts.Args, noOverlap: opts.NoOverlap, expire: opts.Expire}
+	if opts.TimeZone != "" {
+		pj.location, err = time.LoadLocation(opts.TimeZone)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if pj.expire <= 0 {
+		pj.expire = expireTime
+	}
+	return pj, nil
+}
+
+// job returns the run of the periodic job at epoch. It's the same bytes each time, so that it's only scheduled once.
+func (pj *periodicJob) job(namespace string, epoch int64) *Job {
+	// A periodic job stored in Redis is told apart by its ID, since there might be several with the same job name and spec.
+	name := pj.jobName
+	if pj.id != "" {
+		name = pj.jobName + ":" + pj.id
+	}
+
+	job := &Job{
+		Name: pj.jobName,
+		ID:   makeUniquePeriodicID(name, pj.spec, epoch),
+
+		// This is technically wrong, but this lets the bytes be identical for the same periodic job instance. If we don't do this, we'd need to use a different approach -- probably giving each periodic job its own history of the past 100 periodic jobs, and only scheduling a job if it's not in the history.
+		EnqueuedAt:  epoch,
+		Args:        pj.args,
+		ScheduledAt: epoch,
+	}
+	if pj.noOverlap {
+		// The lock is only taken when the job is due, see redisLuaZremLpushCmd, and released once it's processed.
+		job.Unique = true
+		job.UniqueKey = redisKeyPeriodicLock(namespace, name, pj.spec)
+		job.UniqueExpire = pj.expire
+		job.NoOverlap = true
+	}
+	return job
+}
+
+// next returns the first time after t at which the job is to be enqueued. In a time zone, the schedule is followed on the wall clock:
+// a run falling in the hour skipped when clocks go forward happens just after it, and one falling in the hour repeated when they go
+// back happens once, the first time.
+func (pj *periodicJob) next(t time.Time) time.Time {
+	if pj.location == nil {
+		return pj.schedule.Next(t)
+	}
+
+	// The schedule is followed on a UTC clock showing the wall clock time, which has no DST.
+	t = t.In(pj.location)
+	wall := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	for {
+		wall = pj.schedule.Next(wall)
+		if wall.IsZero() {
+			return wall
+		}
+		n := time.Date(wall.Year(), wall.Month(), wall.Day(), wall.Hour(), wall.Minute(), wall.Second(), 0, pj.location)
+		// time.Date moves a time that doesn't exist, because clocks went forward, back by the change: move it forward instead.
+		if d := wall.Sub(time.Date(n.Year(), n.Month(), n.Day(), n.Hour(), n.Minute(), n.Second(), 0, time.UTC)); d > 0 {
+			n = n.Add(d)
+		}
+		// A time repeated because clocks went back is the first one, which is before t if t is in the repeated hour.
+		if n.After(t) {
+			return n
+		}
+	}
 }
 
 type scheduledPeriodicJob struct {
@@ -61,7 +145,7 @@ func (pe *periodicEnqueuer) loop() {
 	if pe.shouldEnqueue() {
 		err := pe.enqueue()
 		if err != nil {
-			logError("periodic_enqueuer.loop.enqueue", err)
+			logError(pe.logger, "periodic_enqueuer.loop.enqueue", err)
 		}
 	}
 
@@ -75,7 +159,7 @@ func (pe *periodicEnqueuer) loop() {
 			if pe.shouldEnqueue() {
 				err := pe.enqueue()
 				if err != nil {
-					logError("periodic_enqueuer.loop.enqueue", err)
+					logError(pe.logger, "periodic_enqueuer.loop.enqueue", err)
 				}
 			}
 		}
@@ -90,38 +174,53 @@ func (pe *periodicEnqueuer) enqueue() error {
 	conn := pe.pool.Get()
 	defer conn.Close()
 
-	for _, pj := range pe.periodicJobs {
-		for t := pj.schedule.Next(nowTime); t.Before(horizon); t = pj.schedule.Next(t) {
-			epoch := t.Unix()
-			id := makeUniquePeriodicID(pj.jobName, pj.spec, epoch)
-
-			job := &Job{
-				Name: pj.jobName,
-				ID:   id,
-
-				// This is technically wrong, but this lets the bytes be identical for the same periodic job instance. If we don't do this, we'd need to use a different approach -- probably giving each periodic job its own history of the past 100 periodic jobs, and only scheduling a job if it's not in the history.
-				EnqueuedAt:  epoch,
-				Args:        nil,
-				ScheduledAt: epoch,
-			}
-
-			rawJSON, err := job.serialize()
-			if err != nil {
-				return err
-			}
+	// Along with the pool's periodic jobs, enqueue those stored in Redis, which might have changed since the last time.
+	periodicJobs := pe.periodicJobs
+	stored, err := readPeriodicJobs(conn, pe.namespace, pe.logger)
+	if err != nil {
+		return err
+	}
+	for _, p := range stored {
+		if !p.Enabled {
+			continue
+		}
+		pj, err := p.periodicJob()
+		if err != nil {
+			logError(pe.logger, "periodic_enqueuer.stored_job", err, "periodic_job_id", p.ID)
+			continue
+		}
+		periodicJobs = append(periodicJobs, pj)
+	}
 
-			_, err = conn.Do("ZADD", redisKeyScheduled(pe.namespace), epoch, rawJSON)
-			if err != nil {
-				return err
-			}
+	for _, pj := range periodicJobs {
+		if err := pj.scheduleRuns(conn, pe.namespace, nowTime, horizon); err != nil {
+			return err
 		}
 	}
 
-	_, err := conn.Do("SET", redisKeyLastPeriodicEnqueue(pe.namespace), now)
+	_, err = conn.Do("SET", redisKeyLastPeriodicEnqueue(pe.namespace), now)
 
 	return err
 }
 
+// scheduleRuns adds the runs of the periodic job from now until horizon to the scheduled job queue. A run that's already there isn't
+// added again, since it's the same bytes.
+func (pj *periodicJob) scheduleRuns(conn redis.Conn, namespace string, now, horizon time.Time) error {
+	for t := pj.next(now); !t.IsZero() && t.Before(horizon); t = pj.next(t) {
+		epoch := t.Unix()
+		rawJSON, err := pj.job(namespace, epoch).serialize()
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.Do("ZADD", redisKeyScheduled(namespace), epoch, rawJSON)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (pe *periodicEnqueuer) shouldEnqueue() bool {
 	conn := pe.pool.Get()
 	defer conn.Close()
@@ -130,7 +229,7 @@ func (pe *periodicEnqueuer) shouldEnqueue() bool {
 	if err == redis.ErrNil {
 		return true
 	} else if err != nil {
-		logError("periodic_enqueuer.should_enqueue", err)
+		logError(pe.logger, "periodic_enqueuer.should_enqueue", err)
 		return true
 	}
 
@@ -106,6 +106,123 @@ func TestPeriodicEnqueuerSpawn(t *testing.T) {
 	pe.stop()
 }
 
+func TestPeriodicEnqueuerWithOptions(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.PeriodicallyEnqueueWithOptions("0 30 8 * * *", "foo", PeriodicOptions{Args: Q{"a": 1}, TimeZone: "Asia/Tokyo"})
+
+	// 2016-07-12 23:28:00 UTC is 2016-07-13 08:28:00 in Tokyo.
+	setNowEpochSecondsMock(1468366080)
+	defer resetNowEpochSecondsMock()
+
+	pe := newPeriodicEnqueuer(ns, pool, wp.periodicJobs)
+	assert.NoError(t, pe.enqueue())
+
+	scheduledJobs, count, err := NewClient(ns, pool).ScheduledJobs(1)
+	assert.NoError(t, err)
+	if assert.EqualValues(t, 1, count) {
+		assert.Equal(t, "foo", scheduledJobs[0].Name)
+		assert.EqualValues(t, 1468366200, scheduledJobs[0].RunAt)
+		assert.EqualValues(t, Q{"a": 1.0}, scheduledJobs[0].Args)
+	}
+
+	assert.Panics(t, func() {
+		wp.PeriodicallyEnqueueWithOptions("0 30 8 * * *", "foo", PeriodicOptions{TimeZone: "Nowhere/Special"})
+	})
+}
+
+func TestPeriodicJobNextAcrossDST(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip("no time zone database")
+	}
+
+	pjs := appendPeriodicJob(nil, "0 30 2 * * *", "foo")
+	pjs = appendPeriodicJob(pjs, "0 30 1 * * *", "bar")
+	pjs = appendPeriodicJob(pjs, "0 0 * * * *", "baz")
+	for _, pj := range pjs {
+		pj.location = loc
+	}
+
+	at := func(s string) time.Time {
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			panic(err)
+		}
+		return t
+	}
+
+	// Clocks go forward from 2:00 to 3:00 on 2024-03-10: the 2:30 run happens at 3:30.
+	assert.Equal(t, at("2024-03-10T03:30:00-04:00").Unix(), pjs[0].next(at("2024-03-10T00:00:00-05:00")).Unix())
+	assert.Equal(t, at("2024-03-11T02:30:00-04:00").Unix(), pjs[0].next(at("2024-03-10T03:30:00-04:00")).Unix())
+	assert.Equal(t, at("2024-03-10T03:00:00-04:00").Unix(), pjs[2].next(at("2024-03-10T01:00:00-05:00")).Unix())
+
+	// Clocks go back from 2:00 to 1:00 on 2024-11-03: the 1:30 run happens once.
+	assert.Equal(t, at("2024-11-03T01:30:00-04:00").Unix(), pjs[1].next(at("2024-11-03T00:00:00-04:00")).Unix())
+	assert.Equal(t, at("2024-11-04T01:30:00-05:00").Unix(), pjs[1].next(at("2024-11-03T01:30:00-04:00")).Unix())
+	assert.Equal(t, at("2024-11-04T01:30:00-05:00").Unix(), pjs[1].next(at("2024-11-03T01:10:00-05:00")).Unix())
+	// So does the 1:00 run of a job that runs hourly, the next one being at 2:00, two hours later.
+	assert.Equal(t, at("2024-11-03T02:00:00-05:00").Unix(), pjs[2].next(at("2024-11-03T01:00:00-04:00")).Unix())
+}
+
+func TestPeriodicEnqueuerNoOverlap(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	ran := 0
+	jobTypes := make(map[string]*jobType)
+	jobTypes["foo"] = &jobType{
+		Name:       "foo",
+		JobOptions: JobOptions{Priority: 1, MaxFails: 1},
+		IsGeneric:  true,
+		GenericHandler: func(job *Job) error {
+			ran++
+			return nil
+		},
+	}
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.PeriodicallyEnqueueWithOptions("0 * * * * *", "foo", PeriodicOptions{NoOverlap: true})
+
+	setNowEpochSecondsMock(1468359420)
+	defer resetNowEpochSecondsMock()
+	pe := newPeriodicEnqueuer(ns, pool, wp.periodicJobs)
+	assert.NoError(t, pe.enqueue())
+	assert.EqualValues(t, 3, zsetSize(pool, redisKeyScheduled(ns)))
+
+	// The first run is due, and queued.
+	setNowEpochSecondsMock(1468359480)
+	re := newRequeuer(ns, pool, redisKeyScheduled(ns), []string{"foo"})
+	assert.True(t, re.process())
+	assert.False(t, re.process())
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "foo")))
+	lockKey := redisKeyPeriodicLock(ns, "foo", "0 * * * * *")
+	assert.True(t, keyExists(pool, lockKey))
+
+	// The second is skipped, since the first hasn't run yet.
+	setNowEpochSecondsMock(1468359540)
+	assert.True(t, re.process())
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "foo")))
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyScheduled(ns)))
+
+	w := newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
+	w.start()
+	w.drain()
+	w.stop()
+	assert.Equal(t, 1, ran)
+	assert.False(t, keyExists(pool, lockKey))
+
+	// The third runs, since the first is done.
+	setNowEpochSecondsMock(1468359600)
+	assert.True(t, re.process())
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "foo")))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyScheduled(ns)))
+}
+
 func appendPeriodicJob(pjs []*periodicJob, spec, jobName string) []*periodicJob {
 	sched, err := cron.Parse(spec)
 	if err != nil {
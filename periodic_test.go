@@ -0,0 +1,163 @@
+package work
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientPeriodicJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	setNowEpochSecondsMock(1468359453)
+	defer resetNowEpochSecondsMock()
+
+	client := NewClient(ns, pool)
+	assert.Error(t, client.AddPeriodicJob(&PeriodicJob{JobName: "foo", Spec: "nope"}))
+	assert.Error(t, client.AddPeriodicJob(&PeriodicJob{JobName: "foo", Spec: "0 * * * * *", TimeZone: "Nowhere/Special"}))
+
+	tenant1 := &PeriodicJob{ID: "tenant1", JobName: "report", Spec: "0 * * * * *", Args: Q{"tenant": 1}, Enabled: true}
+	assert.NoError(t, client.AddPeriodicJob(tenant1))
+	assert.NoError(t, client.AddPeriodicJob(&PeriodicJob{ID: "tenant2", JobName: "report", Spec: "0 * * * * *", Args: Q{"tenant": 2}, Enabled: true}))
+	assert.NoError(t, client.AddPeriodicJob(&PeriodicJob{ID: "tenant3", JobName: "report", Spec: "0 * * * * *", Args: Q{"tenant": 3}}))
+	generated := &PeriodicJob{JobName: "cleanup", Spec: "0 0 * * * *"}
+	assert.NoError(t, client.AddPeriodicJob(generated))
+	assert.NotEqual(t, "", generated.ID)
+
+	jobs, err := client.ListPeriodicJobs()
+	assert.NoError(t, err)
+	if assert.Equal(t, 4, len(jobs)) {
+		tenants := jobs[len(jobs)-3:]
+		assert.Equal(t, "tenant1", tenants[0].ID)
+		assert.Equal(t, "report", tenants[0].JobName)
+		assert.EqualValues(t, Q{"tenant": 1.0}, tenants[0].Args)
+		assert.True(t, tenants[0].Enabled)
+		assert.EqualValues(t, 1468359480, tenants[0].NextRunAt)
+		assert.Equal(t, "tenant3", tenants[2].ID)
+		assert.False(t, tenants[2].Enabled)
+		assert.EqualValues(t, 0, tenants[2].NextRunAt)
+	}
+
+	// The enabled jobs are enqueued along with those of the pool, 4 runs each.
+	pe := newPeriodicEnqueuer(ns, pool, appendPeriodicJob(nil, "0 * * * * *", "report"))
+	assert.NoError(t, pe.enqueue())
+	assert.EqualValues(t, 12, zsetSize(pool, redisKeyScheduled(ns)))
+
+	scheduledJobs, _, err := client.ScheduledJobs(1)
+	assert.NoError(t, err)
+	tenantRuns := map[float64]int{}
+	for _, job := range scheduledJobs {
+		if tenant, ok := job.Args["tenant"].(float64); ok {
+			tenantRuns[tenant]++
+		}
+	}
+	assert.Equal(t, map[float64]int{1: 4, 2: 4}, tenantRuns)
+
+	// Changing a job drops its scheduled runs, and removing one too.
+	tenant1.Spec = "0 0 * * * *"
+	assert.NoError(t, client.AddPeriodicJob(tenant1))
+	assert.EqualValues(t, 8, zsetSize(pool, redisKeyScheduled(ns)))
+	assert.NoError(t, client.RemovePeriodicJob("tenant2"))
+	assert.EqualValues(t, 4, zsetSize(pool, redisKeyScheduled(ns)))
+	assert.Equal(t, ErrNotDeleted, client.RemovePeriodicJob("tenant2"))
+
+	// Running a job now enqueues it, even if it's disabled.
+	job, err := client.RunPeriodicJob("tenant3")
+	assert.NoError(t, err)
+	if assert.NotNil(t, job) {
+		assert.Equal(t, "report", job.Name)
+	}
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "report")))
+	_, err = client.RunPeriodicJob("tenant2")
+	assert.Equal(t, ErrPeriodicJobNotFound, err)
+}
+
+func TestClientPeriodicJobsBadEntry(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	setNowEpochSecondsMock(1468359453)
+	defer resetNowEpochSecondsMock()
+
+	client := NewClient(ns, pool)
+	assert.NoError(t, client.AddPeriodicJob(&PeriodicJob{ID: "tenant1", JobName: "report", Spec: "0 * * * * *", Enabled: true}))
+	conn := pool.Get()
+	defer conn.Close()
+	_, err := conn.Do("HSET", redisKeyPeriodicJobs(ns), "broken", "{nope")
+	assert.NoError(t, err)
+
+	// The entry that can't be decoded is skipped, and the others are still listed and enqueued.
+	jobs, err := client.ListPeriodicJobs()
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(jobs)) {
+		assert.Equal(t, "tenant1", jobs[0].ID)
+	}
+	pe := newPeriodicEnqueuer(ns, pool, nil)
+	assert.NoError(t, pe.enqueue())
+	assert.EqualValues(t, 4, zsetSize(pool, redisKeyScheduled(ns)))
+}
+
+func TestClientRunPeriodicJobNoOverlap(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	client := NewClient(ns, pool)
+	assert.NoError(t, client.AddPeriodicJob(&PeriodicJob{ID: "tenant1", JobName: "report", Spec: "0 * * * * *", NoOverlap: true}))
+
+	// The run takes the lock of the scheduled runs, and another can't be started while it holds it.
+	job, err := client.RunPeriodicJob("tenant1")
+	assert.NoError(t, err)
+	if assert.NotNil(t, job) {
+		assert.Equal(t, "report", job.Name)
+		assert.True(t, job.NoOverlap)
+	}
+	assert.True(t, keyExists(pool, redisKeyPeriodicLock(ns, "report:tenant1", "0 * * * * *")))
+	_, err = client.RunPeriodicJob("tenant1")
+	assert.Equal(t, ErrPeriodicJobRunning, err)
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "report")))
+
+	// Once it's processed, the lock is released.
+	jobTypes := map[string]*jobType{
+		"report": {
+			Name:       "report",
+			JobOptions: JobOptions{Priority: 1, MaxFails: 3},
+			IsGeneric:  true,
+			GenericHandler: func(job *Job) error {
+				return nil
+			},
+		},
+	}
+	w := newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
+	w.start()
+	w.drain()
+	w.stop()
+	assert.False(t, keyExists(pool, redisKeyPeriodicLock(ns, "report:tenant1", "0 * * * * *")))
+	job, err = client.RunPeriodicJob("tenant1")
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+}
+
+func TestClientAddPeriodicJobSchedules(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	setNowEpochSecondsMock(1468359453)
+	defer resetNowEpochSecondsMock()
+
+	// Its runs of the next few minutes are scheduled without waiting for the periodic enqueuer, even when it's saved unchanged.
+	client := NewClient(ns, pool)
+	job := &PeriodicJob{ID: "tenant1", JobName: "report", Spec: "0 * * * * *", Enabled: true}
+	assert.NoError(t, client.AddPeriodicJob(job))
+	assert.EqualValues(t, 4, zsetSize(pool, redisKeyScheduled(ns)))
+	assert.NoError(t, client.AddPeriodicJob(job))
+	assert.EqualValues(t, 4, zsetSize(pool, redisKeyScheduled(ns)))
+
+	job.Enabled = false
+	assert.NoError(t, client.AddPeriodicJob(job))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyScheduled(ns)))
+}
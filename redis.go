@@ -2,6 +2,8 @@ package work
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 )
@@ -72,11 +74,51 @@ func redisKeyJobsConcurrency(namespace, jobName string) string {
 	return redisKeyJobs(namespace, jobName) + ":max_concurrency"
 }
 
+func redisKeyJobsConcurrencyOverride(namespace, jobName string) string {
+	return redisKeyJobs(namespace, jobName) + ":max_concurrency_override"
+}
+
+func redisKeyCancelled(namespace string) string {
+	return redisNamespacePrefix(namespace) + "cancelled"
+}
+
+func redisKeyJobIndexPrefix(namespace string) string {
+	return redisNamespacePrefix(namespace) + "job_index:"
+}
+
+// redisKeyJobIndex is the entry of a job in the job index: the key of the queue or zset it's in. See EnableJobIndex.
+func redisKeyJobIndex(namespace, jobID string) string {
+	return redisKeyJobIndexPrefix(namespace) + jobID
+}
+
+func redisKeyUniqueJobPrefix(namespace string) string {
+	return redisNamespacePrefix(namespace) + "unique:"
+}
+
+// redisKeyUniqueJob returns the key of the uniqueness of a job with args: its unique key, if it was set with WithUniqueKey,
+// or a hash of the JSON of args, so that large args don't make large keys.
 func redisKeyUniqueJob(namespace, jobName string, args map[string]interface{}) (string, error) {
+	key := redisKeyUniqueJobPrefix(namespace) + jobName + ":"
+	if args == nil {
+		return key, nil
+	}
+	if v, ok := args[UniqueKeyArg]; ok {
+		return key + v.(string), nil
+	}
+
+	h := sha256.New()
+	if err := json.NewEncoder(h).Encode(args); err != nil {
+		return "", err
+	}
+	return key + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// redisKeyUniqueJobUnhashed returns the key of the uniqueness of a job enqueued before keys were hashed and stored in the job,
+// which has the JSON of args rather than its hash.
+func redisKeyUniqueJobUnhashed(namespace, jobName string, args map[string]interface{}) (string, error) {
 	var buf bytes.Buffer
 
-	buf.WriteString(redisNamespacePrefix(namespace))
-	buf.WriteString("unique:")
+	buf.WriteString(redisKeyUniqueJobPrefix(namespace))
 	buf.WriteString(jobName)
 	buf.WriteRune(':')
 
@@ -94,10 +136,66 @@ func redisKeyUniqueJob(namespace, jobName string, args map[string]interface{}) (
 	return buf.String(), nil
 }
 
+func redisKeyBatches(namespace string) string {
+	return redisNamespacePrefix(namespace) + "batches"
+}
+
+func redisKeyBatch(namespace, batchID string) string {
+	return redisNamespacePrefix(namespace) + "batch:" + batchID
+}
+
+func redisKeyBatchDone(namespace, batchID string) string {
+	return redisKeyBatch(namespace, batchID) + ":done"
+}
+
+func redisKeyJobStatusPrefix(namespace string) string {
+	return redisNamespacePrefix(namespace) + "status:"
+}
+
+// redisKeyJobStatus is the hash of the status of a job enqueued with WithStatus.
+func redisKeyJobStatus(namespace, jobID string) string {
+	return redisKeyJobStatusPrefix(namespace) + jobID
+}
+
+// redisKeyJobStatusDone is the list that a job enqueued with WithStatus is pushed to once it's done, for EnqueueAndWait.
+func redisKeyJobStatusDone(namespace, jobID string) string {
+	return redisKeyJobStatus(namespace, jobID) + ":done"
+}
+
+func redisKeyWorkflows(namespace string) string {
+	return redisNamespacePrefix(namespace) + "workflows"
+}
+
+// redisKeysWorkflow returns the keys of a workflow: its metadata hash, its graph, the state of its jobs, and the jobs waiting to be enqueued.
+func redisKeysWorkflow(namespace, workflowID string) (meta, graph, state, jobs string) {
+	meta = redisNamespacePrefix(namespace) + "workflow:" + workflowID
+	return meta, meta + ":graph", meta + ":state", meta + ":jobs"
+}
+
+// redisKeyMetricsCounter is a hash of job name -> count, eg for the "processed" counter.
+func redisKeyMetricsCounter(namespace, counter string) string {
+	return redisNamespacePrefix(namespace) + "metrics:" + counter
+}
+
+// redisKeyMetricsHistogram is a hash of bucket index -> count, plus "count" and "sum", for a job's histogram, eg "run_duration".
+func redisKeyMetricsHistogram(namespace, histogram, jobName string) string {
+	return redisNamespacePrefix(namespace) + "metrics:" + histogram + ":" + jobName
+}
+
 func redisKeyLastPeriodicEnqueue(namespace string) string {
 	return redisNamespacePrefix(namespace) + "last_periodic_enqueue"
 }
 
+// redisKeyPeriodicJobs is a hash of ID -> periodic job, as JSON, for the periodic jobs stored in Redis, see Client.AddPeriodicJob.
+func redisKeyPeriodicJobs(namespace string) string {
+	return redisNamespacePrefix(namespace) + "periodic_jobs"
+}
+
+// redisKeyPeriodicLock is the key held by the run of a periodic job with PeriodicOptions.NoOverlap while it's queued or in progress.
+func redisKeyPeriodicLock(namespace, jobName, spec string) string {
+	return redisNamespacePrefix(namespace) + "periodic_lock:" + jobName + ":" + spec
+}
+
 // Used to fetch the next job to run
 //
 // KEYS[1] = the 1st job queue we want to try, eg, "work:jobs:emails"
@@ -108,6 +206,10 @@ func redisKeyLastPeriodicEnqueue(namespace string) string {
 // KEYS[N] = the last job queue...
 // KEYS[N+1] = the last job queue's in prog queue...
 // ARGV[1] = job queue's workerPoolID
+// ARGV[2] = zset of the IDs of cancelled jobs, see redisLuaRememberCancelledJob
+// ARGV[3] = prefix of the job index, eg work:job_index:, empty if it's disabled
+// ARGV[4] = seconds to keep the job's entry in the job index
+// Returns: the job, its queue, its in prog queue, and 1 if it was cancelled, 0 otherwise
 var redisLuaFetchJob = fmt.Sprintf(`
 local function acquireLock(lockKey, lockInfoKey, workerPoolID)
   redis.call('incr', lockKey)
@@ -122,6 +224,19 @@ local function isPaused(pauseKey)
   return redis.call('get', pauseKey)
 end
 
+local function isCancelled(job, cancelledKey)
+  if redis.call('zcard', cancelledKey) == 0 then
+    return 0
+  end
+  return redis.call('zrem', cancelledKey, cjson.decode(job)['id'])
+end
+
+local function indexJob(job, inProgQueue, indexPrefix, expire)
+  if indexPrefix ~= '' then
+    redis.call('set', indexPrefix .. cjson.decode(job)['id'], inProgQueue, 'EX', expire)
+  end
+end
+
 local function canRun(lockKey, maxConcurrency)
   local activeJobs = tonumber(redis.call('get', lockKey))
   if (not maxConcurrency or maxConcurrency == 0) or (not activeJobs or activeJobs < maxConcurrency) then
@@ -152,7 +267,8 @@ for i=1,keylen,%d do
   if haveJobs(jobQueue) and not isPaused(pauseKey) and canRun(lockKey, maxConcurrency) then
     acquireLock(lockKey, lockInfoKey, workerPoolID)
     res = redis.call('rpoplpush', jobQueue, inProgQueue)
-    return {res, jobQueue, inProgQueue}
+    indexJob(res, inProgQueue, ARGV[3], ARGV[4])
+    return {res, jobQueue, inProgQueue, isCancelled(res, ARGV[2])}
   end
 end
 return nil`, fetchKeysPerJobType)
@@ -222,42 +338,138 @@ end
 return nil
 `
 
+// redisLuaPushJob defines pushJob, which pushes a job on its queue: at the back, unless it has a priority, in which case it goes
+// ahead of the jobs with a lower one. Workers fetch jobs from the right end of the queue, so the jobs with a priority are kept
+// there, highest first.
+var redisLuaPushJob = `
+local function pushJob(queue, job, priority)
+  priority = tonumber(priority) or 0
+  if priority <= 0 then
+    redis.call('lpush', queue, job)
+    return
+  end
+  local n = redis.call('llen', queue)
+  for i=1,n do
+    local pivot = redis.call('lindex', queue, -i)
+    if (cjson.decode(pivot)['priority'] or 0) < priority then
+      if i == 1 then
+        redis.call('rpush', queue, job)
+      else
+        redis.call('linsert', queue, 'after', pivot, job)
+      end
+      return
+    end
+  end
+  redis.call('lpush', queue, job)
+end
+`
+
+// KEYS[1] = the job's queue
+// ARGV[1] = job
+// ARGV[2] = job's priority
+var redisLuaEnqueuePriority = redisLuaPushJob + `
+pushJob(KEYS[1], ARGV[1], ARGV[2])
+return 'ok'
+`
+
+// Shared by the scripts that drop jobs. releaseUniqueJob releases the uniqueness of a dropped job, j being the decoded job,
+// if it holds it: a retried job only does with UniqueUntilSuccess (2), and the key might have been set to the job by a debounced enqueue.
+var redisLuaReleaseUniqueJob = `
+local function releaseUniqueJob(j, job)
+  if j['unique_key'] and (not j['fails'] or j['unique_mode'] == 2) then
+    local value = redis.call('get', j['unique_key'])
+    if value == '1' or value == job then
+      redis.call('del', j['unique_key'])
+    end
+  end
+end
+`
+
 // KEYS[1] = zset of jobs (retry or scheduled), eg work:retry
 // KEYS[2] = zset of dead, eg work:dead. If we don't know the jobName of a job, we'll put it in dead.
 // KEYS[3...] = known job queues, eg ["work:jobs:create_watch", "work:jobs:send_email", ...]
 // ARGV[1] = jobs prefix, eg, "work:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
 // ARGV[2] = current time in epoch seconds
-var redisLuaZremLpushCmd = `
+// ARGV[3] = current time in epoch seconds, with millisecond precision for jobs scheduled at a fraction of a second
+// ARGV[4] = hash of the expired counter, eg work:metrics:expired
+// ARGV[5] = prefix of the job index, eg work:job_index:, empty if it's disabled
+// ARGV[6] = seconds to keep the job's entry in the job index
+// ARGV[7] = prefix of the statuses of jobs, eg work:status:
+var redisLuaZremLpushCmd = redisLuaPushJob + redisLuaReleaseUniqueJob + `
+local function indexJob(id, key)
+  if ARGV[5] ~= '' then
+    if key then
+      redis.call('set', ARGV[5] .. id, key, 'EX', ARGV[6])
+    else
+      redis.call('del', ARGV[5] .. id)
+    end
+  end
+end
+
 local res, j, queue
-res = redis.call('zrangebyscore', KEYS[1], '-inf', ARGV[2], 'LIMIT', 0, 1)
+res = redis.call('zrangebyscore', KEYS[1], '-inf', ARGV[3], 'LIMIT', 0, 1)
 if #res > 0 then
   j = cjson.decode(res[1])
   redis.call('zrem', KEYS[1], res[1])
+  if j['expires_at'] and j['expires_at'] <= tonumber(ARGV[2]) then
+    -- The job expired before it could run, see WithTTL.
+    releaseUniqueJob(j, res[1])
+    redis.call('hincrby', ARGV[4], j['name'], 1)
+    indexJob(j['id'], nil)
+    if j['status_ttl'] then
+      -- The job has a status, see WithStatus: it's done.
+      local statusKey = ARGV[7] .. j['id']
+      redis.call('hmset', statusKey, 'state', 'dead', 'error', 'expired', 'updated_at', ARGV[2], 'finished_at', ARGV[2])
+      redis.call('expire', statusKey, j['status_ttl'])
+      redis.call('lpush', statusKey .. ':done', 'dead')
+      redis.call('expire', statusKey .. ':done', j['status_ttl'])
+    end
+    return 'expired'
+  end
   queue = ARGV[1] .. j['name']
   for _,v in pairs(KEYS) do
     if v == queue then
       j['t'] = tonumber(ARGV[2])
-      redis.call('lpush', queue, cjson.encode(j))
+      local job = cjson.encode(j)
+      if j['no_overlap'] then
+        -- A periodic job is skipped while its previous run holds the lock, see PeriodicOptions.NoOverlap. A retried run isn't,
+        -- but it only takes the lock if it's free.
+        if not redis.call('set', j['unique_key'], job, 'NX', 'EX', j['unique_expire'] or 600) and not j['fails'] then
+          indexJob(j['id'], nil)
+          return 'skipped'
+        end
+      -- Keep the unique key of a debounced job set to its payload, so that it can still be replaced.
+      elseif j['unique_key'] and redis.call('get', j['unique_key']) == res[1] then
+        local ttl = redis.call('pttl', j['unique_key'])
+        if ttl > 0 then
+          redis.call('set', j['unique_key'], job, 'PX', ttl)
+        else
+          redis.call('set', j['unique_key'], job)
+        end
+      end
+      pushJob(queue, job, j['priority'])
+      indexJob(j['id'], queue)
       return 'ok'
     end
   end
   j['err'] = 'unknown job when requeueing'
   j['failed_at'] = tonumber(ARGV[2])
   redis.call('zadd', KEYS[2], ARGV[2], cjson.encode(j))
+  indexJob(j['id'], KEYS[2])
   return 'dead' -- put on dead queue
 end
 return nil
 `
 
 // KEYS[1] = zset of (dead|scheduled|retry), eg, work:dead
-// ARGV[1] = died at. The z rank of the job.
+// ARGV[1] = died at. The z rank of the job, in whole seconds: jobs scored up to a second later match too.
 // ARGV[2] = job ID to requeue
 // Returns:
 // - number of jobs deleted (typically 1 or 0)
 // - job bytes (last job only)
 var redisLuaDeleteSingleCmd = `
 local jobs, i, j, deletedCount, jobBytes
-jobs = redis.call('zrangebyscore', KEYS[1], ARGV[1], ARGV[1])
+jobs = redis.call('zrangebyscore', KEYS[1], ARGV[1], '(' .. (ARGV[1] + 1))
 local jobCount = #jobs
 jobBytes = ''
 deletedCount = 0
@@ -278,7 +490,8 @@ return {deletedCount, jobBytes}
 // ARGV[2] = current time in epoch seconds
 // ARGV[3] = died at. The z rank of the job.
 // ARGV[4] = job ID to requeue
-// Returns: number of jobs requeued (typically 1 or 0)
+// ARGV[5] = hash of the expired counter, eg work:metrics:expired
+// Returns: number of jobs requeued (typically 1 or 0), or -1 if the job had expired and was dropped
 var redisLuaRequeueSingleDeadCmd = `
 local jobs, i, j, queue, found, requeuedCount
 jobs = redis.call('zrangebyscore', KEYS[1], ARGV[3], ARGV[3])
@@ -286,6 +499,11 @@ local jobCount = #jobs
 requeuedCount = 0
 for i=1,jobCount do
   j = cjson.decode(jobs[i])
+  if j['id'] == ARGV[4] and j['expires_at'] and j['expires_at'] <= tonumber(ARGV[2]) then
+    redis.call('zrem', KEYS[1], jobs[i])
+    redis.call('hincrby', ARGV[5], j['name'], 1)
+    return -1
+  end
   if j['id'] == ARGV[4] then
     redis.call('zrem', KEYS[1], jobs[i])
     queue = ARGV[1] .. j['name']
@@ -317,7 +535,8 @@ return requeuedCount
 // ARGV[1] = jobs prefix, eg, "work:jobs:". We'll take that and append the job name from the JSON object in order to queue up a job
 // ARGV[2] = current time in epoch seconds
 // ARGV[3] = max number of jobs to requeue
-// Returns: number of jobs requeued
+// ARGV[4] = hash of the expired counter, eg work:metrics:expired
+// Returns: number of jobs requeued, or dropped because they had expired
 var redisLuaRequeueAllDeadCmd = `
 local jobs, i, j, queue, found, requeuedCount
 jobs = redis.call('zrangebyscore', KEYS[1], '-inf', ARGV[2], 'LIMIT', 0, ARGV[3])
@@ -326,50 +545,364 @@ requeuedCount = 0
 for i=1,jobCount do
   j = cjson.decode(jobs[i])
   redis.call('zrem', KEYS[1], jobs[i])
-  queue = ARGV[1] .. j['name']
-  found = false
-  for _,v in pairs(KEYS) do
-    if v == queue then
-      j['t'] = tonumber(ARGV[2])
-      j['fails'] = nil
-      j['failed_at'] = nil
-      j['err'] = nil
-      redis.call('lpush', queue, cjson.encode(j))
-      requeuedCount = requeuedCount + 1
-      found = true
-      break
+  if j['expires_at'] and j['expires_at'] <= tonumber(ARGV[2]) then
+    -- The job expired, see WithTTL: drop it rather than requeue it.
+    redis.call('hincrby', ARGV[4], j['name'], 1)
+    requeuedCount = requeuedCount + 1
+  else
+    queue = ARGV[1] .. j['name']
+    found = false
+    for _,v in pairs(KEYS) do
+      if v == queue then
+        j['t'] = tonumber(ARGV[2])
+        j['fails'] = nil
+        j['failed_at'] = nil
+        j['err'] = nil
+        redis.call('lpush', queue, cjson.encode(j))
+        requeuedCount = requeuedCount + 1
+        found = true
+        break
+      end
+    end
+    if not found then
+      j['err'] = 'unknown job when requeueing'
+      j['failed_at'] = tonumber(ARGV[2])
+      redis.call('zadd', KEYS[1], ARGV[2] + 5, cjson.encode(j))
     end
-  end
-  if not found then
-    j['err'] = 'unknown job when requeueing'
-    j['failed_at'] = tonumber(ARGV[2])
-    redis.call('zadd', KEYS[1], ARGV[2] + 5, cjson.encode(j))
   end
 end
 return requeuedCount
 `
 
+// Shared by the unique scripts. A debounced enqueue, see WithDebounce, sets the unique key to the job rather than to '1',
+// so that a later one can find the pending job and replace it. replaceUniqueJob sets the unique key to job and removes
+// the job it was set to from queue or scheduled. It returns 'dup' if the key was set by an enqueue that wasn't debounced,
+// 'replaced' if the pending job was removed, and 'ok' if it wasn't pending anymore, eg because it's in progress.
+// Unless it returns 'dup', the caller then enqueues job.
+var redisLuaReplaceUniqueJob = `
+local function replaceUniqueJob(uniqueKey, expire, queue, scheduled, job)
+  local old = redis.call('get', uniqueKey)
+  if old == '1' then
+    return 'dup'
+  end
+  redis.call('set', uniqueKey, job, 'EX', expire)
+  if redis.call('lrem', queue, 1, old) + redis.call('zrem', scheduled, old) > 0 then
+    return 'replaced'
+  end
+  return 'ok'
+end
+`
+
 // KEYS[1] = job queue to push onto
 // KEYS[2] = Unique job's key. Test for existence and set if we push.
 // KEYS[3] = job expire time. Expired jobs can be enqueued again.
+// KEYS[4] = scheduled job queue, where the job replaced by a debounced enqueue might be
 // ARGV[1] = job
-var redisLuaEnqueueUnique = `
-if redis.call('set', KEYS[2], '1', 'NX', 'EX', KEYS[3]) then
-  redis.call('lpush', KEYS[1], ARGV[1])
+// ARGV[2] = job's priority, if any
+// ARGV[3] = 1 to debounce, replacing the pending job with the same unique key
+var redisLuaEnqueueUnique = redisLuaPushJob + redisLuaReplaceUniqueJob + `
+local value = '1'
+if ARGV[3] == '1' then
+  value = ARGV[1]
+end
+if redis.call('set', KEYS[2], value, 'NX', 'EX', KEYS[3]) then
+  pushJob(KEYS[1], ARGV[1], ARGV[2])
   return 'ok'
 end
-return 'dup'
+if ARGV[3] ~= '1' then
+  return 'dup'
+end
+local res = replaceUniqueJob(KEYS[2], KEYS[3], KEYS[1], KEYS[4], ARGV[1])
+if res ~= 'dup' then
+  pushJob(KEYS[1], ARGV[1], ARGV[2])
+end
+return res
 `
 
+// redisLuaIndexAndStatus wraps enqueue, redisLuaEnqueueUnique or redisLuaEnqueueUniqueIn, so that the job's entry in the job index
+// and its status are written along with it, if it's enqueued: a worker can't fetch it before its status says it's queued.
+// KEYS[1] to KEYS[4] and ARGV[1] to ARGV[3] = as per enqueue, KEYS[1] being where the job is put
+// ARGV[4] = key of the job's entry in the job index, empty if the enqueuer doesn't index jobs
+// ARGV[5] = job index entry expire time
+// ARGV[6] = key of the job's status, empty if it has none
+// ARGV[7] = job status expire time
+// ARGV[8...] = fields of the job's status
+func redisLuaIndexAndStatus(enqueue string) string {
+	return "local function enqueue()\n" + enqueue + `end
+local res = enqueue()
+if res == 'dup' then
+  return res
+end
+if ARGV[4] ~= '' then
+  redis.call('set', ARGV[4], KEYS[1], 'EX', ARGV[5])
+end
+if ARGV[6] ~= '' then
+  redis.call('hmset', ARGV[6], unpack(ARGV, 8))
+  redis.call('expire', ARGV[6], ARGV[7])
+end
+return res
+`
+}
+
 // KEYS[1] = scheduled job queue
 // KEYS[2] = Unique job's key. Test for existence and set if we push.
 // KEYS[3] = job expire time. Expired jobs can be enqueued again.
+// KEYS[4] = job queue, where the job replaced by a debounced enqueue might be
 // ARGV[1] = job
 // ARGV[2] = epoch seconds for job to be run at
-var redisLuaEnqueueUniqueIn = `
-if redis.call('set', KEYS[2], '1', 'NX', 'EX', KEYS[3]) then
+// ARGV[3] = 1 to debounce, replacing the pending job with the same unique key
+var redisLuaEnqueueUniqueIn = redisLuaReplaceUniqueJob + `
+local value = '1'
+if ARGV[3] == '1' then
+  value = ARGV[1]
+end
+if redis.call('set', KEYS[2], value, 'NX', 'EX', KEYS[3]) then
   redis.call('zadd', KEYS[1], ARGV[2], ARGV[1])
   return 'ok'
 end
-return 'dup'
+if ARGV[3] ~= '1' then
+  return 'dup'
+end
+local res = replaceUniqueJob(KEYS[2], KEYS[3], KEYS[4], KEYS[1], ARGV[1])
+if res ~= 'dup' then
+  redis.call('zadd', KEYS[1], ARGV[2], ARGV[1])
+end
+return res
+`
+
+// KEYS[1] = Unique job's key
+// ARGV[1] = job, as it was fetched
+// Deletes the key, unless a debounced enqueue set it to another job, see redisLuaReplaceUniqueJob.
+var redisLuaDeleteUniqueJob = `
+local value = redis.call('get', KEYS[1])
+if value == '1' or value == ARGV[1] then
+  redis.call('del', KEYS[1])
+end
+return 'ok'
+`
+
+// Shared by the scripts that look for a job by ID in short queues, such as in progress queues; long ones are searched from Go, a
+// page at a time. searchJob returns the job with the specified ID in the list or zset key, or nil, going through it a page at a
+// time, and only decoding the jobs that contain the ID.
+var redisLuaSearchJob = `
+local function searchJob(key, isZset, id)
+  local needle = '"id":"' .. id .. '"'
+  local pageSize = 1000
+  local start = 0
+  repeat
+    local jobs
+    if isZset then
+      jobs = redis.call('zrange', key, start, start + pageSize - 1)
+    else
+      jobs = redis.call('lrange', key, start, start + pageSize - 1)
+    end
+    for _, job in ipairs(jobs) do
+      if string.find(job, needle, 1, true) and cjson.decode(job)['id'] == id then
+        return job
+      end
+    end
+    start = start + pageSize
+  until #jobs < pageSize
+  return nil
+end
+`
+
+// KEYS[1] = queue or zset the job was found in
+// ARGV[1] = job, as it was found
+// ARGV[2] = 1 if KEYS[1] is a zset
+// Returns: 1 if the job was deleted, 0 if it had moved
+var redisLuaDeleteFoundJob = redisLuaReleaseUniqueJob + `
+local deleted
+if ARGV[2] == '1' then
+  deleted = redis.call('zrem', KEYS[1], ARGV[1])
+else
+  deleted = redis.call('lrem', KEYS[1], 1, ARGV[1])
+end
+if deleted == 0 then
+  return 0
+end
+releaseUniqueJob(cjson.decode(ARGV[1]), ARGV[1])
+return 1
+`
+
+// KEYS[1] = zset of the IDs of cancelled jobs that weren't found, scored by when they're forgotten
+// KEYS[2...] = in progress queues, which are only as long as the pools' concurrency
+// ARGV[1] = job ID
+// ARGV[2] = current time in epoch seconds
+// ARGV[3] = until when to keep the ID of the job in KEYS[1], in epoch seconds
+// Returns: 1 if the ID was remembered, 0 if the job is in progress
+var redisLuaRememberCancelledJob = redisLuaSearchJob + `
+-- A job in progress is left alone: were it remembered, it would be dropped if it failed and was retried.
+for i=2,#KEYS do
+  if searchJob(KEYS[i], false, ARGV[1]) then
+    return 0
+  end
+end
+
+redis.call('zremrangebyscore', KEYS[1], '-inf', ARGV[2])
+redis.call('zadd', KEYS[1], ARGV[3], ARGV[1])
+return 1
+`
+
+// KEYS[1] = Unique job's key
+// ARGV[1] = job, as it was fetched
+// ARGV[2] = seconds to keep the key
+// Keeps the uniqueness of a job that's retried, see UniqueUntilSuccess, unless a debounced enqueue set the key to another job.
+// The key is set to '1' rather than to the job, since a debounced enqueue can't replace a job in the retry queue.
+var redisLuaRetainUniqueJob = `
+local value = redis.call('get', KEYS[1])
+if not value or value == '1' or value == ARGV[1] then
+  redis.call('set', KEYS[1], '1', 'EX', ARGV[2])
+end
+return 'ok'
+`
+
+// KEYS[1] = job's max concurrency key, eg work:jobs:emails:max_concurrency
+// KEYS[2] = job's max concurrency override marker. If present, an operator has set the max concurrency at runtime.
+// ARGV[1] = max concurrency from the job's options
+var redisLuaSetMaxConcurrencyUnlessOverridden = `
+if redis.call('exists', KEYS[2]) == 1 then
+  return 'overridden'
+end
+redis.call('set', KEYS[1], ARGV[1])
+return 'ok'
+`
+
+// Shared by the batch scripts. If the batch is committed and has no pending jobs, marks it completed,
+// pushes its callback jobs onto their queues and expires its keys. Callers define KEYS[1..3] and ARGV[1..3] as below.
+//
+// KEYS[1] = batch hash, eg work:batch:<id>
+// KEYS[2] = set of job IDs already counted against the batch
+// KEYS[3] = known jobs set
+// ARGV[1] = jobs prefix, eg, "work:jobs:"
+// ARGV[2] = current time in epoch seconds
+// ARGV[3] = seconds to keep the batch around once it's complete
+var redisLuaCompleteBatch = `
+local function pushCallback(rawJSON)
+  local j = cjson.decode(rawJSON)
+  j['t'] = tonumber(ARGV[2])
+  redis.call('lpush', ARGV[1] .. j['name'], cjson.encode(j))
+  redis.call('sadd', KEYS[3], j['name'])
+end
+
+local function completeBatch()
+  local b = redis.call('hmget', KEYS[1], 'committed', 'pending', 'failed', 'completed_at', 'on_complete', 'on_success')
+  if b[1] ~= '1' or (tonumber(b[2]) or 0) > 0 or b[4] then
+    return 'pending'
+  end
+  redis.call('hset', KEYS[1], 'completed_at', ARGV[2])
+  if b[5] then
+    pushCallback(b[5])
+  end
+  if b[6] and (tonumber(b[3]) or 0) == 0 then
+    pushCallback(b[6])
+  end
+  redis.call('expire', KEYS[1], ARGV[3])
+  redis.call('expire', KEYS[2], ARGV[3])
+  return 'complete'
+end
+`
+
+// Used by a worker when a batch job succeeded or failed for good. See redisLuaCompleteBatch for KEYS[1..3] and ARGV[1..3].
+//
+// ARGV[4] = job ID
+// ARGV[5] = outcome: 'succeeded' or 'failed'
+// Returns: 'pending' or 'complete', or nil if the batch doesn't exist or the job was already counted
+var redisLuaBatchJobDone = redisLuaCompleteBatch + `
+if redis.call('exists', KEYS[1]) == 0 or redis.call('sadd', KEYS[2], ARGV[4]) == 0 then
+  return nil
+end
+redis.call('hincrby', KEYS[1], 'pending', -1)
+redis.call('hincrby', KEYS[1], ARGV[5], 1)
+return completeBatch()
+`
+
+// Used to commit a batch. See redisLuaCompleteBatch for KEYS[1..3] and ARGV[1..3].
+//
+// ARGV[4] = on_complete job, or empty
+// ARGV[5] = on_success job, or empty
+// Returns: 'pending' or 'complete'
+var redisLuaCommitBatch = redisLuaCompleteBatch + `
+redis.call('hsetnx', KEYS[1], 'created_at', ARGV[2])
+redis.call('hset', KEYS[1], 'committed', '1')
+if ARGV[4] ~= '' then
+  redis.call('hset', KEYS[1], 'on_complete', ARGV[4])
+end
+if ARGV[5] ~= '' then
+  redis.call('hset', KEYS[1], 'on_success', ARGV[5])
+end
+return completeBatch()
+`
+
+// Used by a worker when a workflow job succeeded or failed for good.
+// On success, enqueues the jobs waiting on it whose dependencies have all succeeded. On failure, cancels all the jobs that depend on it.
+//
+// KEYS[1] = workflow hash, eg work:workflow:<id>
+// KEYS[2] = workflow graph: job ID -> {"name", "index", "parents", "children"}
+// KEYS[3] = job ID -> state
+// KEYS[4] = job ID -> serialized job, for jobs that are still waiting
+// KEYS[5] = known jobs set
+// ARGV[1] = jobs prefix, eg, "work:jobs:"
+// ARGV[2] = current time in epoch seconds
+// ARGV[3] = seconds to keep the workflow around once all of its jobs are done
+// ARGV[4] = job ID
+// ARGV[5] = outcome: 'succeeded' or 'failed'
+// Returns: 'ok', or nil if the job isn't queued in the workflow (eg it was already counted)
+var redisLuaWorkflowJobDone = `
+local function finish(jobID, state)
+  redis.call('hset', KEYS[3], jobID, state)
+  if redis.call('hincrby', KEYS[1], 'remaining', -1) <= 0 then
+    redis.call('hset', KEYS[1], 'completed_at', ARGV[2])
+    for i = 1, 4 do
+      redis.call('expire', KEYS[i], ARGV[3])
+    end
+  end
+end
+
+local function node(jobID)
+  return cjson.decode(redis.call('hget', KEYS[2], jobID))
+end
+
+if redis.call('hget', KEYS[3], ARGV[4]) ~= 'queued' then
+  return nil
+end
+
+if ARGV[5] == 'succeeded' then
+  finish(ARGV[4], 'succeeded')
+  for _, childID in ipairs(node(ARGV[4])['children']) do
+    if redis.call('hget', KEYS[3], childID) == 'waiting' then
+      local ready = true
+      for _, parentID in ipairs(node(childID)['parents']) do
+        if redis.call('hget', KEYS[3], parentID) ~= 'succeeded' then
+          ready = false
+          break
+        end
+      end
+      if ready then
+        local j = cjson.decode(redis.call('hget', KEYS[4], childID))
+        j['t'] = tonumber(ARGV[2])
+        redis.call('lpush', ARGV[1] .. j['name'], cjson.encode(j))
+        redis.call('sadd', KEYS[5], j['name'])
+        redis.call('hdel', KEYS[4], childID)
+        redis.call('hset', KEYS[3], childID, 'queued')
+      end
+    end
+  end
+else
+  finish(ARGV[4], 'failed')
+  local pending = node(ARGV[4])['children']
+  local i = 1
+  while i <= #pending do
+    local jobID = pending[i]
+    i = i + 1
+    if redis.call('hget', KEYS[3], jobID) == 'waiting' then
+      redis.call('hdel', KEYS[4], jobID)
+      finish(jobID, 'cancelled')
+      for _, childID in ipairs(node(jobID)['children']) do
+        table.insert(pending, childID)
+      end
+    end
+  end
+end
+return 'ok'
 `
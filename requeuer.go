@@ -20,17 +20,24 @@ type requeuer struct {
 
 	drainChan        chan struct{}
 	doneDrainingChan chan struct{}
+
+	logger Logger
 }
 
 func newRequeuer(namespace string, pool *redis.Pool, requeueKey string, jobNames []string) *requeuer {
-	args := make([]interface{}, 0, len(jobNames)+2+2)
+	args := make([]interface{}, 0, len(jobNames)+2+6)
 	args = append(args, requeueKey)              // KEY[1]
 	args = append(args, redisKeyDead(namespace)) // KEY[2]
 	for _, jobName := range jobNames {
 		args = append(args, redisKeyJobs(namespace, jobName)) // KEY[3, 4, ...]
 	}
-	args = append(args, redisKeyJobsPrefix(namespace)) // ARGV[1]
-	args = append(args, 0)                             // ARGV[2] -- NOTE: We're going to change this one on every call
+	args = append(args, redisKeyJobsPrefix(namespace))                    // ARGV[1]
+	args = append(args, 0)                                                // ARGV[2] -- NOTE: We're going to change this one on every call
+	args = append(args, 0)                                                // ARGV[3] -- NOTE: And this one too
+	args = append(args, redisKeyMetricsCounter(namespace, metricExpired)) // ARGV[4]
+	args = append(args, "")                                               // ARGV[5] -- NOTE: set by indexJobs
+	args = append(args, jobIndexTTL)                                      // ARGV[6]
+	args = append(args, redisKeyJobStatusPrefix(namespace))               // ARGV[7]
 
 	return &requeuer{
 		namespace: namespace,
@@ -47,6 +54,11 @@ func newRequeuer(namespace string, pool *redis.Pool, requeueKey string, jobNames
 	}
 }
 
+// indexJobs makes the requeuer update the job index as it requeues jobs, see WorkerPool.EnableJobIndex. It must be called before start.
+func (r *requeuer) indexJobs() {
+	r.redisRequeueArgs[len(r.redisRequeueArgs)-3] = redisKeyJobIndexPrefix(r.namespace)
+}
+
 func (r *requeuer) start() {
 	go r.loop()
 }
@@ -88,20 +100,27 @@ func (r *requeuer) process() bool {
 	conn := r.pool.Get()
 	defer conn.Close()
 
-	r.redisRequeueArgs[len(r.redisRequeueArgs)-1] = nowEpochSeconds()
+	r.redisRequeueArgs[len(r.redisRequeueArgs)-6] = nowEpochSeconds()
+	r.redisRequeueArgs[len(r.redisRequeueArgs)-5] = nowEpochScore()
 
 	res, err := redis.String(r.redisRequeueScript.Do(conn, r.redisRequeueArgs...))
 	if err == redis.ErrNil {
 		return false
 	} else if err != nil {
-		logError("requeuer.process", err)
+		logError(r.logger, "requeuer.process", err)
 		return false
 	}
 
 	if res == "" {
 		return false
 	} else if res == "dead" {
-		logError("requeuer.process.dead", fmt.Errorf("no job name"))
+		logError(r.logger, "requeuer.process.dead", fmt.Errorf("no job name"))
+		return true
+	} else if res == "expired" {
+		logInfo(r.logger, "requeuer.process.expired")
+		return true
+	} else if res == "skipped" {
+		logInfo(r.logger, "requeuer.process.skipped")
 		return true
 	} else if res == "ok" {
 		return true
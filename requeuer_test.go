@@ -5,7 +5,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"testing"
 	// "fmt"
-	// "time"
+	"time"
 	// "os"
 )
 
@@ -82,3 +82,55 @@ func TestRequeueUnknown(t *testing.T) {
 	assert.Equal(t, nowish, job.FailedAt)
 	assert.Equal(t, "unknown job when requeueing", job.LastErr)
 }
+
+func TestRequeueMillis(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.EnqueueAt("wat", time.Now().Add(-100*time.Millisecond), nil)
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueAt("wat", time.Now().Add(time.Hour+500*time.Millisecond), nil)
+	assert.NoError(t, err)
+
+	re := newRequeuer(ns, pool, redisKeyScheduled(ns), []string{"wat"})
+	re.start()
+	re.drain()
+	re.stop()
+
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyScheduled(ns)))
+
+	// The requeued job's EnqueuedAt is still in whole seconds.
+	j := jobOnQueue(pool, redisKeyJobs(ns, "wat"))
+	assert.True(t, j.EnqueuedAt+2 >= nowEpochSeconds())
+}
+
+func TestRequeueDropsExpiredJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.EnqueueUniqueAt("wat", time.Now().Add(-2*time.Second), Q{"a": 1}, WithTTL(time.Second))
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueAt("wat", time.Now().Add(-2*time.Second), Q{"a": 2}, WithTTL(time.Hour))
+	assert.NoError(t, err)
+
+	re := newRequeuer(ns, pool, redisKeyScheduled(ns), []string{"wat"})
+	re.start()
+	re.drain()
+	re.stop()
+
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyScheduled(ns)))
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "wat")))
+	assert.EqualValues(t, 1, hgetInt64(pool, redisKeyMetricsCounter(ns, metricExpired), "wat"))
+	j := jobOnQueue(pool, redisKeyJobs(ns, "wat"))
+	assert.EqualValues(t, 2, j.ArgInt64("a"))
+
+	// The expired unique job released its uniqueness.
+	job, err := enqueuer.EnqueueUnique("wat", Q{"a": 1})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+}
@@ -1,13 +1,15 @@
 package work
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 )
 
 // returns an error if the job fails, or there's a panic, or we couldn't reflect correctly.
 // if we return an error, it signals we want the job to be retried.
-func runJob(job *Job, ctxType reflect.Type, middleware []*middlewareHandler, jt *jobType) (returnCtx reflect.Value, returnError error) {
+// ctx is handed to every middleware and handler that accepts a context.Context.
+func runJob(ctx context.Context, job *Job, ctxType reflect.Type, middleware []*middlewareHandler, jt *jobType) (returnCtx reflect.Value, returnError error) {
 	returnCtx = reflect.New(ctxType)
 	currentMiddleware := 0
 	maxMiddleware := len(middleware)
@@ -17,25 +19,9 @@ func runJob(job *Job, ctxType reflect.Type, middleware []*middlewareHandler, jt
 		if currentMiddleware < maxMiddleware {
 			mw := middleware[currentMiddleware]
 			currentMiddleware++
-			if mw.IsGeneric {
-				return mw.GenericMiddlewareHandler(job, next)
-			}
-			res := mw.DynamicMiddleware.Call([]reflect.Value{returnCtx, reflect.ValueOf(job), reflect.ValueOf(next)})
-			x := res[0].Interface()
-			if x == nil {
-				return nil
-			}
-			return x.(error)
+			return mw.call(ctx, returnCtx, job, next)
 		}
-		if jt.IsGeneric {
-			return jt.GenericHandler(job)
-		}
-		res := jt.DynamicHandler.Call([]reflect.Value{returnCtx, reflect.ValueOf(job)})
-		x := res[0].Interface()
-		if x == nil {
-			return nil
-		}
-		return x.(error)
+		return jt.call(ctx, returnCtx, job)
 	}
 
 	defer func() {
@@ -43,7 +29,7 @@ func runJob(job *Job, ctxType reflect.Type, middleware []*middlewareHandler, jt
 			// err turns out to be interface{}, of actual type "runtime.errorCString"
 			// Luckily, the err sprints nicely via fmt.
 			errorishError := fmt.Errorf("%v", panicErr)
-			logError("runJob.panic", errorishError)
+			logError(LoggerFromContext(ctx), "runJob.panic", errorishError, "job_name", job.Name, "job_id", job.ID)
 			returnError = errorishError
 		}
 	}()
@@ -55,8 +41,8 @@ func runJob(job *Job, ctxType reflect.Type, middleware []*middlewareHandler, jt
 
 // returns an error if the job fails, or there's a panic, or we couldn't reflect correctly.
 // if we return an error, it signals we want the job to be retried.
-func runHook(job *Job, ctx reflect.Value, middleware []*middlewareHandler) (returnCtx reflect.Value, returnError error) {
-	returnCtx = ctx
+func runHook(ctx context.Context, job *Job, hookCtx reflect.Value, middleware []*middlewareHandler) (returnCtx reflect.Value, returnError error) {
+	returnCtx = hookCtx
 	currentMiddleware := 0
 	maxMiddleware := len(middleware)
 
@@ -65,15 +51,7 @@ func runHook(job *Job, ctx reflect.Value, middleware []*middlewareHandler) (retu
 		if currentMiddleware < maxMiddleware {
 			mw := middleware[currentMiddleware]
 			currentMiddleware++
-			if mw.IsGeneric {
-				return mw.GenericMiddlewareHandler(job, next)
-			}
-			res := mw.DynamicMiddleware.Call([]reflect.Value{returnCtx, reflect.ValueOf(job), reflect.ValueOf(next)})
-			x := res[0].Interface()
-			if x == nil {
-				return nil
-			}
-			return x.(error)
+			return mw.call(ctx, returnCtx, job, next)
 		}
 		return nil
 	}
@@ -83,7 +61,7 @@ func runHook(job *Job, ctx reflect.Value, middleware []*middlewareHandler) (retu
 			// err turns out to be interface{}, of actual type "runtime.errorCString"
 			// Luckily, the err sprints nicely via fmt.
 			errorishError := fmt.Errorf("%v", panicErr)
-			logError("runJob.panic", errorishError)
+			logError(LoggerFromContext(ctx), "runJob.panic", errorishError, "job_name", job.Name, "job_id", job.ID)
 			returnError = errorishError
 		}
 	}()
@@ -92,3 +70,41 @@ func runHook(job *Job, ctx reflect.Value, middleware []*middlewareHandler) (retu
 
 	return
 }
+
+func (jt *jobType) call(ctx context.Context, returnCtx reflect.Value, job *Job) error {
+	if jt.IsGeneric {
+		if jt.GenericContextHandler != nil {
+			return jt.GenericContextHandler(ctx, job)
+		}
+		return jt.GenericHandler(job)
+	}
+	args := []reflect.Value{returnCtx}
+	if jt.TakesContext {
+		args = append(args, reflect.ValueOf(ctx))
+	}
+	args = append(args, reflect.ValueOf(job))
+	return errorFromCall(jt.DynamicHandler.Call(args))
+}
+
+func (mw *middlewareHandler) call(ctx context.Context, returnCtx reflect.Value, job *Job, next NextMiddlewareFunc) error {
+	if mw.IsGeneric {
+		if mw.GenericContextMiddlewareHandler != nil {
+			return mw.GenericContextMiddlewareHandler(ctx, job, next)
+		}
+		return mw.GenericMiddlewareHandler(job, next)
+	}
+	args := []reflect.Value{returnCtx}
+	if mw.TakesContext {
+		args = append(args, reflect.ValueOf(ctx))
+	}
+	args = append(args, reflect.ValueOf(job), reflect.ValueOf(next))
+	return errorFromCall(mw.DynamicMiddleware.Call(args))
+}
+
+func errorFromCall(res []reflect.Value) error {
+	x := res[0].Interface()
+	if x == nil {
+		return nil
+	}
+	return x.(error)
+}
@@ -1,6 +1,7 @@
 package work
 
 import (
+	"context"
 	"fmt"
 	"github.com/stretchr/testify/assert"
 	"reflect"
@@ -47,7 +48,7 @@ func TestRunBasicMiddleware(t *testing.T) {
 		Args: map[string]interface{}{"a": "foo"},
 	}
 
-	v, err := runJob(job, tstCtxType, middleware, jt)
+	v, err := runJob(context.Background(), job, tstCtxType, middleware, jt)
 	assert.NoError(t, err)
 	c := v.Interface().(*tstCtx)
 	assert.Equal(t, "mw1mw2mw3h1foo", c.String())
@@ -121,7 +122,7 @@ func TestRunBasicAndJobMiddleware(t *testing.T) {
 		Args: map[string]interface{}{"a": "foo"},
 	}
 
-	v, err := runJob(job, tstCtxType, append(middleware, jt.middleware...), jt)
+	v, err := runJob(context.Background(), job, tstCtxType, append(middleware, jt.middleware...), jt)
 	assert.NoError(t, err)
 	c := v.Interface().(*tstCtx)
 	assert.Equal(t, "mw1mw2jmw1jmw2jmw3h1foojmw2mw3mw2", c.String())
@@ -150,7 +151,7 @@ func TestRunHandlerError(t *testing.T) {
 		Name: "foo",
 	}
 
-	v, err := runJob(job, tstCtxType, middleware, jt)
+	v, err := runJob(context.Background(), job, tstCtxType, middleware, jt)
 	assert.Error(t, err)
 	assert.Equal(t, "h1_err", err.Error())
 
@@ -181,7 +182,7 @@ func TestRunMwError(t *testing.T) {
 		Name: "foo",
 	}
 
-	_, err := runJob(job, tstCtxType, middleware, jt)
+	_, err := runJob(context.Background(), job, tstCtxType, middleware, jt)
 	assert.Error(t, err)
 	assert.Equal(t, "mw1_err", err.Error())
 }
@@ -210,7 +211,7 @@ func TestRunHandlerPanic(t *testing.T) {
 		Name: "foo",
 	}
 
-	_, err := runJob(job, tstCtxType, middleware, jt)
+	_, err := runJob(context.Background(), job, tstCtxType, middleware, jt)
 	assert.Error(t, err)
 	assert.Equal(t, "dayam", err.Error())
 }
@@ -238,7 +239,62 @@ func TestRunMiddlewarePanic(t *testing.T) {
 		Name: "foo",
 	}
 
-	_, err := runJob(job, tstCtxType, middleware, jt)
+	_, err := runJob(context.Background(), job, tstCtxType, middleware, jt)
 	assert.Error(t, err)
 	assert.Equal(t, "dayam", err.Error())
 }
+
+func TestRunContextHandlerAndMiddleware(t *testing.T) {
+	type ctxKey struct{}
+	ctx := context.WithValue(context.Background(), ctxKey{}, "v")
+
+	mw1 := func(ctx context.Context, j *Job, next NextMiddlewareFunc) error {
+		j.setArg("mw1", ctx.Value(ctxKey{}))
+		return next()
+	}
+
+	mw2 := func(c *tstCtx, ctx context.Context, j *Job, next NextMiddlewareFunc) error {
+		c.record(j.Args["mw1"].(string))
+		c.record(ctx.Value(ctxKey{}).(string))
+		return next()
+	}
+
+	h1 := func(c *tstCtx, ctx context.Context, j *Job) error {
+		c.record("h1")
+		c.record(ctx.Value(ctxKey{}).(string))
+		return nil
+	}
+
+	middleware := []*middlewareHandler{
+		{IsGeneric: true, GenericContextMiddlewareHandler: mw1},
+		{IsGeneric: false, DynamicMiddleware: reflect.ValueOf(mw2), TakesContext: true},
+	}
+
+	jt := &jobType{
+		Name:           "foo",
+		IsGeneric:      false,
+		DynamicHandler: reflect.ValueOf(h1),
+		TakesContext:   true,
+	}
+
+	job := &Job{
+		Name: "foo",
+	}
+
+	v, err := runJob(ctx, job, tstCtxType, middleware, jt)
+	assert.NoError(t, err)
+	c := v.Interface().(*tstCtx)
+	assert.Equal(t, "vvh1v", c.String())
+
+	jt = &jobType{
+		Name:      "foo",
+		IsGeneric: true,
+		GenericContextHandler: func(ctx context.Context, j *Job) error {
+			return fmt.Errorf("%v", ctx.Value(ctxKey{}))
+		},
+	}
+
+	_, err = runJob(ctx, job, tstCtxType, nil, jt)
+	assert.Error(t, err)
+	assert.Equal(t, "v", err.Error())
+}
@@ -0,0 +1,221 @@
+package work
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+)
+
+// ErrJobStatusNotFound is returned by Client.JobStatus when the job has no status, because it wasn't enqueued with WithStatus
+// or its status expired.
+var ErrJobStatusNotFound = fmt.Errorf("job status not found")
+
+// defaultStatusTTL is how long the status of a job is kept after it's last updated if WithStatus isn't given a TTL, in seconds.
+const defaultStatusTTL = 24 * 60 * 60
+
+// The states of a job in its status, see JobStatus.
+const (
+	JobStatusQueued    = "queued"    // waiting in its queue or the scheduled job queue
+	JobStatusRunning   = "running"   // being processed
+	JobStatusFailed    = "failed"    // its last run failed, and it's waiting to be retried
+	JobStatusSucceeded = "succeeded" // done
+	JobStatusDead      = "dead"      // failed and won't be retried anymore, or dropped without running, eg because it expired
+)
+
+// JobStatus is the status of a job enqueued with WithStatus.
+type JobStatus struct {
+	ID         string          `json:"id"`
+	Name       string          `json:"name"`
+	State      string          `json:"state"`
+	Attempts   int64           `json:"attempts"`         // number of times the job was run
+	Result     json.RawMessage `json:"result,omitempty"` // what the job set with Job.SetResult, as JSON, once it succeeded
+	Error      string          `json:"error,omitempty"`  // the error of the last run, if it failed
+	EnqueuedAt int64           `json:"enqueued_at"`
+	StartedAt  int64           `json:"started_at,omitempty"`  // when the last run started
+	FinishedAt int64           `json:"finished_at,omitempty"` // when the job succeeded or died
+	UpdatedAt  int64           `json:"updated_at"`
+}
+
+// Done returns whether the job is done: it succeeded, or it's dead.
+func (s *JobStatus) Done() bool {
+	return s.State == JobStatusSucceeded || s.State == JobStatusDead
+}
+
+// DecodeResult decodes the result of the job into v, as per json.Unmarshal.
+func (s *JobStatus) DecodeResult(v interface{}) error {
+	return json.Unmarshal(s.Result, v)
+}
+
+// WithStatus makes the job keep a status as it goes through its queue, runs, and is retried, with its result once it succeeds,
+// see Job.SetResult. It can be looked up with Client.JobStatus. The status is kept for ttl after it's last updated, or a day if ttl is 0.
+func WithStatus(ttl time.Duration) EnqueueOption {
+	return func(op *EnqueueOp) {
+		op.StatusTTL = int64(ttl / time.Second)
+		if op.StatusTTL <= 0 {
+			op.StatusTTL = defaultStatusTTL
+		}
+	}
+}
+
+// SetResult sets the result of the job, which is stored as JSON in its status once it succeeds, if it was enqueued with WithStatus.
+// It returns an error if result can't be encoded.
+func (j *Job) SetResult(result interface{}) error {
+	rawJSON, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	j.result = rawJSON
+	return nil
+}
+
+// EnqueueAndWait enqueues a job as per EnqueueContext, with WithStatus, and waits until it's done, ie it succeeded or it's dead.
+// It returns the final status of the job, whose State tells whether it succeeded, and Result what it returned, see Job.SetResult.
+// If ctx is done first, it returns ctx's error, and the job keeps going: its status can still be looked up with Client.JobStatus.
+// Waiting until a deadline less than a second away needs Redis 6, which takes fractional timeouts.
+func (e *Enqueuer) EnqueueAndWait(ctx context.Context, jobName string, args map[string]interface{}, opts ...EnqueueOption) (*JobStatus, error) {
+	opts = append([]EnqueueOption{WithStatus(0)}, opts...)
+	job, err := e.EnqueueContext(ctx, jobName, args, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := e.Pool.Get()
+	defer conn.Close()
+
+	// The worker pushes to the done list when the job is done. Block on it a second at a time, to notice when ctx is done.
+	doneKey := redisKeyJobStatusDone(e.Namespace, job.ID)
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		reply, err := conn.Do("BLPOP", doneKey, blockTimeout(ctx, time.Second))
+		if err != nil {
+			return nil, err
+		} else if reply == nil {
+			continue
+		}
+		return readJobStatus(conn, e.Namespace, job.ID)
+	}
+}
+
+// blockTimeout returns how long to block for in a Redis command, in seconds, at most max: less if ctx's deadline comes sooner, so
+// as not to overrun it.
+func blockTimeout(ctx context.Context, max time.Duration) string {
+	timeout := max
+	if deadline, ok := ctx.Deadline(); ok {
+		if left := time.Until(deadline); left < timeout {
+			timeout = left
+		}
+	}
+	timeout = timeout.Truncate(time.Millisecond)
+	if timeout <= 0 {
+		timeout = time.Millisecond // 0 would block forever
+	}
+	return strconv.FormatFloat(timeout.Seconds(), 'f', -1, 64)
+}
+
+// JobStatus returns the status of the job with the specified ID, if it was enqueued with WithStatus.
+// It returns ErrJobStatusNotFound if there's no such status.
+func (c *Client) JobStatus(jobID string) (*JobStatus, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	status, err := readJobStatus(conn, c.namespace, jobID)
+	if err != nil && err != ErrJobStatusNotFound {
+		logError(c.logger, "client.job_status", err)
+	}
+	return status, err
+}
+
+func readJobStatus(conn redis.Conn, namespace, jobID string) (*JobStatus, error) {
+	vals, err := redis.Strings(conn.Do("HGETALL", redisKeyJobStatus(namespace, jobID)))
+	if err != nil {
+		return nil, err
+	}
+	if len(vals) == 0 {
+		return nil, ErrJobStatusNotFound
+	}
+	return parseJobStatus(jobID, vals)
+}
+
+func parseJobStatus(jobID string, vals []string) (*JobStatus, error) {
+	status := &JobStatus{ID: jobID}
+
+	for i := 0; i < len(vals)-1; i += 2 {
+		key := vals[i]
+		value := vals[i+1]
+
+		var err error
+		switch key {
+		case "name":
+			status.Name = value
+		case "state":
+			status.State = value
+		case "attempts":
+			status.Attempts, err = strconv.ParseInt(value, 10, 64)
+		case "result":
+			status.Result = json.RawMessage(value)
+		case "error":
+			status.Error = value
+		case "enqueued_at":
+			status.EnqueuedAt, err = strconv.ParseInt(value, 10, 64)
+		case "started_at":
+			status.StartedAt, err = strconv.ParseInt(value, 10, 64)
+		case "finished_at":
+			status.FinishedAt, err = strconv.ParseInt(value, 10, 64)
+		case "updated_at":
+			status.UpdatedAt, err = strconv.ParseInt(value, 10, 64)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return status, nil
+}
+
+// sendJobStatus sends the commands that set the state of the job in its status, along with fields, if it was enqueued with WithStatus.
+// Once the job is done, it's also pushed to its done list, for EnqueueAndWait.
+func sendJobStatus(conn redis.Conn, namespace string, job *Job, state string, fields ...interface{}) {
+	if job.StatusTTL <= 0 {
+		return
+	}
+
+	key, expire, fields := jobStatusFields(namespace, job, state, fields...)
+	conn.Send("HMSET", append([]interface{}{key}, fields...)...)
+	conn.Send("EXPIRE", key, expire)
+	if state == JobStatusSucceeded || state == JobStatusDead {
+		doneKey := redisKeyJobStatusDone(namespace, job.ID)
+		conn.Send("LPUSH", doneKey, state)
+		conn.Send("EXPIRE", doneKey, expire)
+	}
+}
+
+// jobStatusFields returns the key of the job's status, how long it's kept, in seconds, and its fields for state.
+func jobStatusFields(namespace string, job *Job, state string, fields ...interface{}) (string, int64, []interface{}) {
+	now := nowEpochSeconds()
+	expire := job.StatusTTL
+	if job.ScheduledAt > now {
+		expire += job.ScheduledAt - now
+	}
+	return redisKeyJobStatus(namespace, job.ID), expire, append([]interface{}{"name", job.Name, "state", state, "updated_at", now}, fields...)
+}
+
+// writeJobStatus sets the state of the job in its status, as per sendJobStatus.
+func writeJobStatus(namespace string, pool *redis.Pool, logger Logger, job *Job, state string, fields ...interface{}) {
+	if job.StatusTTL <= 0 {
+		return
+	}
+
+	conn := pool.Get()
+	defer conn.Close()
+
+	sendJobStatus(conn, namespace, job, state, fields...)
+	if _, err := conn.Do(""); err != nil {
+		logError(logger, "job_status.write", err, "job_name", job.Name, "job_id", job.ID, "state", state)
+	}
+}
@@ -0,0 +1,285 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/garyburd/redigo/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobStatus(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	cleanKeyspace(ns, pool)
+
+	fail := true
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:       job1,
+		JobOptions: JobOptions{Priority: 1, MaxFails: 3},
+		IsGeneric:  true,
+		GenericHandler: func(job *Job) error {
+			if fail {
+				return fmt.Errorf("sorry kid")
+			}
+			return job.SetResult(Q{"sum": job.ArgInt64("a") + job.ArgInt64("b")})
+		},
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	job, err := enqueuer.Enqueue(job1, Q{"a": 1, "b": 2}, WithStatus(time.Hour))
+	assert.NoError(t, err)
+
+	client := NewClient(ns, pool)
+	status, err := client.JobStatus(job.ID)
+	assert.NoError(t, err)
+	if assert.NotNil(t, status) {
+		assert.Equal(t, job.ID, status.ID)
+		assert.Equal(t, job1, status.Name)
+		assert.Equal(t, JobStatusQueued, status.State)
+		assert.Equal(t, job.EnqueuedAt, status.EnqueuedAt)
+		assert.EqualValues(t, 0, status.Attempts)
+		assert.False(t, status.Done())
+	}
+	assert.True(t, redisTTL(pool, redisKeyJobStatus(ns, job.ID)) > 3500)
+
+	// The job fails, and is retried.
+	w := newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
+	w.start()
+	w.drain()
+	w.stop()
+
+	status, err = client.JobStatus(job.ID)
+	assert.NoError(t, err)
+	if assert.NotNil(t, status) {
+		assert.Equal(t, JobStatusFailed, status.State)
+		assert.Equal(t, "sorry kid", status.Error)
+		assert.EqualValues(t, 1, status.Attempts)
+		assert.True(t, status.StartedAt > 0)
+		assert.False(t, status.Done())
+	}
+
+	// It succeeds when it's retried.
+	conn := pool.Get()
+	defer conn.Close()
+	_, err = conn.Do("ZUNIONSTORE", redisKeyRetry(ns), 1, redisKeyRetry(ns), "WEIGHTS", 0)
+	assert.NoError(t, err)
+	re := newRequeuer(ns, pool, redisKeyRetry(ns), []string{job1})
+	assert.True(t, re.process())
+
+	fail = false
+	w = newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
+	w.start()
+	w.drain()
+	w.stop()
+
+	status, err = client.JobStatus(job.ID)
+	assert.NoError(t, err)
+	if assert.NotNil(t, status) {
+		assert.Equal(t, JobStatusSucceeded, status.State)
+		assert.EqualValues(t, 2, status.Attempts)
+		assert.True(t, status.FinishedAt > 0)
+		assert.True(t, status.Done())
+		var result struct{ Sum int }
+		assert.NoError(t, status.DecodeResult(&result))
+		assert.Equal(t, 3, result.Sum)
+	}
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobStatusDone(ns, job.ID)))
+
+	// Jobs without WithStatus don't have one.
+	job, err = enqueuer.Enqueue(job1, nil)
+	assert.NoError(t, err)
+	_, err = client.JobStatus(job.ID)
+	assert.Equal(t, ErrJobStatusNotFound, err)
+}
+
+func TestJobStatusDead(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	cleanKeyspace(ns, pool)
+
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:       job1,
+		JobOptions: JobOptions{Priority: 1, MaxFails: 1},
+		IsGeneric:  true,
+		GenericHandler: func(job *Job) error {
+			return fmt.Errorf("sorry kid")
+		},
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	job, err := enqueuer.EnqueueUnique(job1, nil, WithStatus(0))
+	assert.NoError(t, err)
+	dup, err := enqueuer.EnqueueUnique(job1, nil, WithStatus(0))
+	assert.NoError(t, err)
+	assert.Nil(t, dup)
+	expired, err := enqueuer.Enqueue(job1, Q{"a": 1}, WithStatus(0), WithExpiresAt(time.Now().Add(-time.Second)))
+	assert.NoError(t, err)
+
+	w := newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
+	w.start()
+	w.drain()
+	w.stop()
+
+	client := NewClient(ns, pool)
+	status, err := client.JobStatus(job.ID)
+	assert.NoError(t, err)
+	if assert.NotNil(t, status) {
+		assert.Equal(t, JobStatusDead, status.State)
+		assert.Equal(t, "sorry kid", status.Error)
+		assert.True(t, status.Done())
+	}
+	assert.True(t, redisTTL(pool, redisKeyJobStatus(ns, job.ID)) > defaultStatusTTL-100)
+
+	status, err = client.JobStatus(expired.ID)
+	assert.NoError(t, err)
+	if assert.NotNil(t, status) {
+		assert.Equal(t, JobStatusDead, status.State)
+		assert.Equal(t, "expired", status.Error)
+		assert.EqualValues(t, 0, status.Attempts)
+	}
+}
+
+func TestJobStatusQueued(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	// The status is written along with the job, and only if it's enqueued, as is its entry in the job index.
+	enqueuer := NewEnqueuer(ns, pool).EnableJobIndex()
+	scheduled, err := enqueuer.EnqueueUniqueIn("wat", 100, Q{"a": 1}, WithStatus(0))
+	assert.NoError(t, err)
+	dup, err := enqueuer.EnqueueUniqueIn("wat", 100, Q{"a": 1}, WithStatus(0))
+	assert.NoError(t, err)
+	assert.Nil(t, dup)
+	results := enqueuer.EnqueueUniqueMany(BulkJobSlice([]BulkJob{
+		{Name: "wat", Args: Q{"a": 2}, Options: []EnqueueOption{WithStatus(0)}},
+		{Name: "wat", Args: Q{"a": 2}, Options: []EnqueueOption{WithStatus(0)}},
+	}))
+	assert.Equal(t, BulkEnqueued, results[0].Status)
+	assert.Equal(t, BulkDuplicate, results[1].Status)
+	results = append(results, enqueuer.EnqueueMany(BulkJobSlice([]BulkJob{
+		{Name: "wat", Args: Q{"a": 3}, Options: []EnqueueOption{WithStatus(0)}},
+		{Name: "wat", Args: Q{"a": 4}},
+	}))...)
+	assert.Equal(t, BulkEnqueued, results[2].Status)
+	assert.Equal(t, BulkEnqueued, results[3].Status)
+
+	client := NewClient(ns, pool)
+	for _, id := range []string{scheduled.ID, results[0].Job.ID, results[2].Job.ID} {
+		status, err := client.JobStatus(id)
+		assert.NoError(t, err)
+		if assert.NotNil(t, status) {
+			assert.Equal(t, JobStatusQueued, status.State)
+		}
+		assert.True(t, keyExists(pool, redisKeyJobIndex(ns, id)))
+	}
+	for _, id := range []string{results[1].Job.ID, results[3].Job.ID} {
+		_, err := client.JobStatus(id)
+		assert.Equal(t, ErrJobStatusNotFound, err)
+	}
+	assert.False(t, keyExists(pool, redisKeyJobIndex(ns, results[1].Job.ID)))
+	assert.True(t, keyExists(pool, redisKeyJobIndex(ns, results[3].Job.ID)))
+	assert.EqualValues(t, 3, listSize(pool, redisKeyJobs(ns, "wat")))
+}
+
+func TestEnqueueAndWait(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.Job("add", func(job *Job) error {
+		return job.SetResult(job.ArgInt64("a") + job.ArgInt64("b"))
+	})
+	wp.JobWithOptions("fail", JobOptions{MaxFails: 1, SkipDead: true}, func(job *Job) error {
+		return fmt.Errorf("sorry kid")
+	})
+	wp.Start()
+	defer wp.Stop()
+
+	enqueuer := NewEnqueuer(ns, pool)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	status, err := enqueuer.EnqueueAndWait(ctx, "add", Q{"a": 1, "b": 2})
+	assert.NoError(t, err)
+	if assert.NotNil(t, status) {
+		assert.Equal(t, JobStatusSucceeded, status.State)
+		var sum int64
+		assert.NoError(t, status.DecodeResult(&sum))
+		assert.EqualValues(t, 3, sum)
+	}
+
+	status, err = enqueuer.EnqueueAndWait(ctx, "fail", nil)
+	assert.NoError(t, err)
+	if assert.NotNil(t, status) {
+		assert.Equal(t, JobStatusDead, status.State)
+		assert.Equal(t, "sorry kid", status.Error)
+	}
+
+	// Nothing processes this one.
+	shortCtx, shortCancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer shortCancel()
+	start := time.Now()
+	status, err = enqueuer.EnqueueAndWait(shortCtx, "nobody", nil)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Nil(t, status)
+	assert.True(t, time.Since(start) < 500*time.Millisecond, "waited past the deadline")
+}
+
+func TestJobStatusCleared(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	job, err := enqueuer.Enqueue("export", nil, WithStatus(0))
+	assert.NoError(t, err)
+
+	started := make(chan struct{})
+	jobTypes := map[string]*jobType{
+		"export": {
+			Name:       "export",
+			JobOptions: JobOptions{Priority: 1, MaxFails: 3},
+			IsGeneric:  true,
+			GenericContextHandler: func(ctx context.Context, job *Job) error {
+				close(started)
+				<-ctx.Done()
+				return nil
+			},
+		},
+	}
+	w := newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
+	w.start()
+	<-started
+	w.ClearWorker()
+	w.drain()
+	w.stop()
+
+	// A cleared job is dead, not succeeded, and its waiters are told it's done.
+	status, err := NewClient(ns, pool).JobStatus(job.ID)
+	assert.NoError(t, err)
+	if assert.NotNil(t, status) {
+		assert.Equal(t, JobStatusDead, status.State)
+		assert.Equal(t, "cleared", status.Error)
+	}
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobStatusDone(ns, job.ID)))
+}
+
+func redisTTL(pool *redis.Pool, key string) int64 {
+	conn := pool.Get()
+	defer conn.Close()
+
+	v, err := redis.Int64(conn.Do("TTL", key))
+	if err != nil {
+		panic("could not TTL: " + err.Error())
+	}
+	return v
+}
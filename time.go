@@ -1,6 +1,7 @@
 package work
 
 import (
+	"strconv"
 	"time"
 )
 
@@ -13,6 +14,29 @@ func nowEpochSeconds() int64 {
 	return time.Now().Unix()
 }
 
+// nowEpochScore returns the current time as the score of a zset of jobs: epoch seconds, with millisecond precision
+// to compare with the scores of jobs scheduled with EnqueueAt.
+func nowEpochScore() string {
+	if nowMock != 0 {
+		return strconv.FormatInt(nowMock, 10)
+	}
+	return epochMillisScore(time.Now().UnixMilli())
+}
+
+// epochMillisScore returns the score of a zset of jobs for an epoch time in milliseconds, eg "1425263409.250".
+func epochMillisScore(millis int64) string {
+	return strconv.FormatFloat(float64(millis)/1000, 'f', 3, 64)
+}
+
+// floorDiv returns a/b rounded down, eg epoch seconds from epoch milliseconds before 1970.
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
 func setNowEpochSecondsMock(t int64) {
 	nowMock = t
 }
@@ -0,0 +1,85 @@
+package work
+
+import (
+	"context"
+	"time"
+)
+
+// Tracer connects jobs to distributed traces, eg with OpenTelemetry. The enqueuer stores the trace context of the caller in the job,
+// and the worker runs the job's middleware and handler in a span that is a child of it. The caller's context is passed with
+// WithTraceContext, or to EnqueueContext and EnqueueInContext; the jobs of batches and workflows don't carry it.
+type Tracer interface {
+	// Inject adds the trace context of ctx to carrier, eg the W3C "traceparent" and "tracestate".
+	Inject(ctx context.Context, carrier map[string]string)
+	// Extract returns a copy of ctx with the trace context found in carrier, as added by Inject.
+	Extract(ctx context.Context, carrier map[string]string) context.Context
+	// StartSpan starts a span as a child of the span in ctx, if any, and returns a copy of ctx that carries it.
+	StartSpan(ctx context.Context, name string, attrs map[string]interface{}) (context.Context, Span)
+}
+
+// Span is a span started by a Tracer.
+type Span interface {
+	// End ends the span. err is the error the job failed with, if any.
+	End(err error)
+}
+
+// The attributes of the span around a job.
+const (
+	SpanAttrJobName      = "work.job.name"
+	SpanAttrJobID        = "work.job.id"
+	SpanAttrRetryCount   = "work.job.retry_count"   // number of times the job failed before this run
+	SpanAttrQueueLatency = "work.job.queue_latency" // seconds the job waited on its queue, if known, ie not for retries
+)
+
+// SetTracer sets the tracer used to store the trace context of the callers in their jobs, see WithTraceContext.
+func (e *Enqueuer) SetTracer(t Tracer) {
+	e.tracer = t
+}
+
+// SetTracer sets the tracer used to run the middleware and handler of each job in a span, continuing the trace it was enqueued in, if any.
+// It must be called before Start.
+func (wp *WorkerPool) SetTracer(t Tracer) *WorkerPool {
+	for _, w := range wp.workers {
+		w.tracer = t
+	}
+	return wp
+}
+
+// WithTraceContext stores the trace context of ctx in the job, if the enqueuer has a Tracer, so that the span around the job's
+// handler is part of the same trace. Every enqueue that takes options honours it, eg EnqueueUnique, EnqueueMany or EnqueueTx.
+func WithTraceContext(ctx context.Context) EnqueueOption {
+	return func(op *EnqueueOp) {
+		op.TraceContext = ctx
+	}
+}
+
+// injectTraceContext stores the trace context of ctx in the job, if there's a tracer and ctx isn't nil, see WithTraceContext.
+func (e *Enqueuer) injectTraceContext(ctx context.Context, job *Job) {
+	if e.tracer == nil || ctx == nil {
+		return
+	}
+	carrier := make(map[string]string)
+	e.tracer.Inject(ctx, carrier)
+	if len(carrier) > 0 {
+		job.TraceContext = carrier
+	}
+}
+
+// startJobSpan starts the span around a job, if there's a tracer. The returned Span is nil otherwise.
+func (w *worker) startJobSpan(ctx context.Context, job *Job, latency time.Duration) (context.Context, Span) {
+	if w.tracer == nil {
+		return ctx, nil
+	}
+	if job.TraceContext != nil {
+		ctx = w.tracer.Extract(ctx, job.TraceContext)
+	}
+	attrs := map[string]interface{}{
+		SpanAttrJobName:    job.Name,
+		SpanAttrJobID:      job.ID,
+		SpanAttrRetryCount: job.Fails,
+	}
+	if latency >= 0 {
+		attrs[SpanAttrQueueLatency] = latency.Seconds()
+	}
+	return w.tracer.StartSpan(ctx, "work.job "+job.Name, attrs)
+}
@@ -0,0 +1,161 @@
+package work
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type traceKey struct{}
+
+type testSpan struct {
+	tracer   *testTracer
+	name     string
+	parent   string
+	attrs    map[string]interface{}
+	ended    bool
+	endedErr error
+}
+
+func (s *testSpan) End(err error) {
+	s.tracer.mtx.Lock()
+	defer s.tracer.mtx.Unlock()
+	s.ended = true
+	s.endedErr = err
+}
+
+// testTracer propagates a fake "traceparent" made of a trace ID and a span name.
+type testTracer struct {
+	mtx   sync.Mutex
+	spans []*testSpan
+}
+
+func (t *testTracer) Inject(ctx context.Context, carrier map[string]string) {
+	if tp, ok := ctx.Value(traceKey{}).(string); ok {
+		carrier["traceparent"] = tp
+	}
+}
+
+func (t *testTracer) Extract(ctx context.Context, carrier map[string]string) context.Context {
+	if tp, ok := carrier["traceparent"]; ok {
+		return context.WithValue(ctx, traceKey{}, tp)
+	}
+	return ctx
+}
+
+func (t *testTracer) StartSpan(ctx context.Context, name string, attrs map[string]interface{}) (context.Context, Span) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	parent, _ := ctx.Value(traceKey{}).(string)
+	span := &testSpan{tracer: t, name: name, parent: parent, attrs: attrs}
+	t.spans = append(t.spans, span)
+	return context.WithValue(ctx, traceKey{}, parent+"/"+name), span
+}
+
+func TestTracePropagation(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	tracer := &testTracer{}
+	enqueuer := NewEnqueuer(ns, pool)
+	enqueuer.SetTracer(tracer)
+
+	ctx := context.WithValue(context.Background(), traceKey{}, "trace-1")
+	job, err := enqueuer.EnqueueContext(ctx, "export", Q{"fail": false})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"traceparent": "trace-1"}, job.TraceContext)
+	_, err = enqueuer.EnqueueContext(ctx, "export", Q{"fail": true})
+	assert.NoError(t, err)
+
+	// Without a trace in the context, there's nothing to store.
+	job, err = enqueuer.Enqueue("other", nil)
+	assert.NoError(t, err)
+	assert.Nil(t, job.TraceContext)
+
+	var mtx sync.Mutex
+	var handlerTraces []string
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool).SetTracer(tracer)
+	wp.Middleware(func(ctx context.Context, job *Job, next NextMiddlewareFunc) error {
+		mtx.Lock()
+		handlerTraces = append(handlerTraces, ctx.Value(traceKey{}).(string))
+		mtx.Unlock()
+		return next()
+	})
+	wp.JobWithOptions("export", JobOptions{MaxFails: 3}, func(job *Job) error {
+		if job.ArgBool("fail") {
+			return fmt.Errorf("sorry kid")
+		}
+		return nil
+	})
+	wp.Job("other", func(job *Job) error {
+		return nil
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.Contains(t, handlerTraces, "trace-1/work.job export")
+	assert.Contains(t, handlerTraces, "/work.job other")
+
+	tracer.mtx.Lock()
+	defer tracer.mtx.Unlock()
+	assert.Equal(t, 3, len(tracer.spans))
+	var failed *testSpan
+	for _, span := range tracer.spans {
+		assert.True(t, span.ended)
+		if span.endedErr != nil {
+			failed = span
+		}
+	}
+	if assert.NotNil(t, failed) {
+		assert.Equal(t, "work.job export", failed.name)
+		assert.Equal(t, "trace-1", failed.parent)
+		assert.Equal(t, "export", failed.attrs[SpanAttrJobName])
+		assert.EqualValues(t, 0, failed.attrs[SpanAttrRetryCount])
+		assert.Contains(t, failed.attrs, SpanAttrQueueLatency)
+		assert.Equal(t, "sorry kid", failed.endedErr.Error())
+	}
+}
+
+func TestWithTraceContext(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	enqueuer.SetTracer(&testTracer{})
+	ctx := context.WithValue(context.Background(), traceKey{}, "trace-1")
+	want := map[string]string{"traceparent": "trace-1"}
+
+	job, err := enqueuer.EnqueueUnique("unique", nil, WithTraceContext(ctx))
+	assert.NoError(t, err)
+	assert.Equal(t, want, job.TraceContext)
+	assert.Equal(t, want, jobOnQueue(pool, redisKeyJobs(ns, "unique")).TraceContext)
+
+	scheduled, err := enqueuer.EnqueueUniqueIn("unique_in", 100, nil, WithTraceContext(ctx))
+	assert.NoError(t, err)
+	assert.Equal(t, want, scheduled.TraceContext)
+
+	results := enqueuer.EnqueueMany(BulkJobSlice([]BulkJob{{Name: "many", Options: []EnqueueOption{WithTraceContext(ctx)}}}))
+	assert.Equal(t, want, results[0].Job.TraceContext)
+	assert.Equal(t, want, jobOnQueue(pool, redisKeyJobs(ns, "many")).TraceContext)
+
+	conn := pool.Get()
+	defer conn.Close()
+	conn.Send("MULTI")
+	job, err = enqueuer.EnqueueTx(conn, "tx", nil, WithTraceContext(ctx))
+	assert.NoError(t, err)
+	_, err = conn.Do("EXEC")
+	assert.NoError(t, err)
+	assert.Equal(t, want, job.TraceContext)
+	assert.Equal(t, want, jobOnQueue(pool, redisKeyJobs(ns, "tx")).TraceContext)
+
+	// The option overrides the context passed to EnqueueContext.
+	job, err = enqueuer.EnqueueContext(context.Background(), "ctx", nil, WithTraceContext(ctx))
+	assert.NoError(t, err)
+	assert.Equal(t, want, job.TraceContext)
+}
@@ -0,0 +1,60 @@
+package work
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// EnqueueTyped enqueues a job whose arguments are args encoded as JSON. args must encode to a JSON object, eg a struct or a map.
+// Register the handler for it with JobTyped using the same type.
+func EnqueueTyped[T any](e *Enqueuer, jobName string, args T, opts ...EnqueueOption) (*Job, error) {
+	m, err := encodeArgs(args)
+	if err != nil {
+		return nil, err
+	}
+	return e.Enqueue(jobName, m, opts...)
+}
+
+// JobTyped adds a handler for 'name' jobs that receives the job's arguments decoded into a T, typically enqueued with EnqueueTyped.
+// If the arguments can't be decoded, the job fails with a NoRetryError and goes straight to the dead queue.
+func JobTyped[T any](wp *WorkerPool, name string, fn func(context.Context, *Job, T) error) *WorkerPool {
+	return JobTypedWithOptions(wp, name, JobOptions{}, fn)
+}
+
+// JobTypedWithOptions adds a handler for 'name' jobs as per JobTyped, with options as per WorkerPool.JobWithOptions.
+func JobTypedWithOptions[T any](wp *WorkerPool, name string, jobOpts JobOptions, fn func(context.Context, *Job, T) error) *WorkerPool {
+	return wp.JobWithOptions(name, jobOpts, typedHandler(fn))
+}
+
+func typedHandler[T any](fn func(context.Context, *Job, T) error) func(context.Context, *Job) error {
+	return func(ctx context.Context, job *Job) error {
+		var args T
+		if err := decodeArgs(job.Args, &args); err != nil {
+			return &NoRetryError{msg: fmt.Sprintf("decoding arguments of job %s: %v", job.Name, err)}
+		}
+		return fn(ctx, job, args)
+	}
+}
+
+// encodeArgs turns v into job arguments by way of its JSON encoding.
+func encodeArgs(v interface{}) (map[string]interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var args map[string]interface{}
+	if err := json.Unmarshal(b, &args); err != nil {
+		return nil, fmt.Errorf("job arguments must encode to a JSON object: %v", err)
+	}
+	return args, nil
+}
+
+// decodeArgs decodes job arguments into v, which must be a pointer, by way of their JSON encoding.
+func decodeArgs(args map[string]interface{}, v interface{}) error {
+	b, err := json.Marshal(args)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
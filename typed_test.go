@@ -0,0 +1,67 @@
+package work
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type resizeArgs struct {
+	URL    string   `json:"url"`
+	Width  int      `json:"width"`
+	Labels []string `json:"labels"`
+}
+
+func TestTypedJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	job, err := EnqueueTyped(enqueuer, "resize", resizeArgs{URL: "http://x", Width: 300, Labels: []string{"a", "b"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "http://x", job.ArgString("url"))
+	assert.EqualValues(t, 300, job.ArgInt64("width"))
+
+	_, err = EnqueueTyped(enqueuer, "resize", []int{1, 2})
+	assert.Error(t, err)
+
+	var got resizeArgs
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	JobTyped(wp, "resize", func(ctx context.Context, job *Job, args resizeArgs) error {
+		got = args
+		return nil
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.Equal(t, resizeArgs{URL: "http://x", Width: 300, Labels: []string{"a", "b"}}, got)
+}
+
+func TestTypedJobsDecodeError(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("resize", Q{"width": "wide"})
+	assert.NoError(t, err)
+
+	called := false
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	JobTypedWithOptions(wp, "resize", JobOptions{MaxFails: 3}, func(ctx context.Context, job *Job, args resizeArgs) error {
+		called = true
+		return nil
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.False(t, called)
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyDead(ns)))
+	_, job := jobOnZset(pool, redisKeyDead(ns))
+	assert.Contains(t, job.LastErr, "decoding arguments of job resize")
+}
@@ -89,7 +89,7 @@ func indexHtml() (*asset, error) {
 	return a, nil
 }
 
-var _workJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\x6b\x77\xdb\x3a\x96\x20\xfa\x7d\x7e\x85\xc4\xdb\xcd\x26\xae\x11\x46\x72\x9e\x87\x3a\x28\x77\x8e\xe3\x74\x79\x2a\x89\x3d\xb6\x4f\x55\xd7\xd5\xd1\xe8\x40\x24\x24\xa1\x4c\x11\x2a\x10\xf4\xa3\x24\xfd\xf7\xbb\xb0\x01\x92\x20\x45\x39\x4e\xba\x66\xd6\xdc\xb5\xae\x3f\x58\xc4\xfb\xb5\xb1\xb1\xb1\xb1\x1f\xfd\x79\x91\xc5\x8a\x8b\x2c\x60\x68\x53\x7e\xf7\x54\x20\xd0\x86\xcf\x83\x6c\x2c\x26\x48\x32\x55\xc8\xac\xa7\xbf\x43\xf6\xb0\x16\x52\xe5\xa3\x3b\x2a\x7b\x92\xe8\x28\xb2\xb1\x71\xd1\x66\x87\x79\x12\x09\x9c\x0a\x9a\xb0\x24\xea\x0f\x77\x23\x5b\x94\xe9\xa2\x31\x4d\xd3\x40\x96\x35\x60\x89\xeb\x6f\x85\xb0\x0c\x4d\x31\xd2\x1f\xd4\x09\x3b\xdd\x4c\x46\x36\x55\x45\x2a\x5c\x11\x86\x55\x18\x93\x0c\xab\x70\x4d\x3c\x0f\xab\x60\x80\x76\xc1\xb8\x1e\x06\x56\x38\x43\x1b\xaf\xc8\x59\x2f\x57\x92\xc7\xca\x1b\x55\xe3\x12\x7a\x94\x65\xa7\x7c\x9f\x85\xd3\x29\xcb\xbf\x88\xa4\x48\xd9\x09\x8b\x36\x09\x9b\xd3\x22\x55\x11\xdb\xed\xaa\x22\x52\xd7\x08\x93\xd1\x0f\x58\x8f\x67\xb9\xa2\x59\xcc\xc4\xbc\xa7\x10\x52\x4b\x29\xee\x7b\x19\xbb\xef\xdd\x3c\xae\xd9\x99\x94\x42\x06\xde\x29\xcd\x32\xa1\x7a\x7a\xb8\x3d\xda\x8b\x53\x9a\xe7\x3d\x9a\xf7\x68\xaf\xac\xd2\x43\x75\xed\x69\x5d\x3b\x73\xaa\xbb\x62\x73\x26\x59\x16\x97\x75\xaa\x25\xcf\x7b\x4b\x9a\x67\xff\xa6\x7a\x33\xc6\xb2\x1e\xcf\xb8\xe2\x34\xe5\x39\x4b\x7a\x2f\x7a\x79\xb1\x66\x32\x40\x8d\x1c\xba\x7d\x96\x78\xc8\x4e\x5d\x5f\x6d\xb7\x9e\x98\xfd\x8d\xc5\xca\xeb\x13\xf5\xb8\x86\x21\xf8\xbe\x57\xf5\xaa\x8e\x3d\x61\x91\xaa\xbb\x48\xab\x2e\x76\xe5\xf5\xfd\xac\x48\xd3\x3e\x21\xaa\x7b\x36\xae\x75\xd7\x7a\xec\x61\x2d\x59\x9e\xeb\xea\x56\x45\xae\x7a\x8c\xab\x25\x93\xbd\x19\xeb\xe9\xd2\x3d\x21\x9d\xe9\xc1\x3d\x3d\x7d\xde\x51\xd9\x02\x1a\xb1\x70\x2d\x85\x12\x3a\x82\x5c\xc0\x10\xc2\x58\x32\xaa\x58\xa0\x7c\x5f\xd5\x89\x78\x13\x8b\x2c\x57\xb2\x88\x95\x90\xd1\xe6\x8e\xa6\x05\x8b\x18\x66\x59\xb1\x62\x92\xce\x52\x16\xf5\x87\xf8\x5e\x72\x65\xbe\x07\x38\x16\xd9\x9c\x2f\x0a\x9b\x36\xd8\xed\x10\x56\xbe\x1f\xd8\x36\x72\xa6\x2e\xcb\xaa\x2f\xe6\x27\x9d\xb1\x30\x37\x91\x86\x23\xe8\xc5\x74\x4a\x14\x02\xa8\xe5\xa4\x82\x48\x67\x5f\x31\x33\x97\x73\x21\x03\x03\xda\x83\x51\xf6\xb3\x0a\x53\x96\x2d\xd4\x72\x94\x1d\x1d\xa1\x8d\x8e\x17\x44\x8d\xb3\xc9\x48\x84\x75\xd7\x89\x1b\xd8\x6e\xfb\x43\x2c\x42\xb7\xfb\x7a\xdf\x78\x30\x62\x8f\x67\x3d\xe1\xfb\x81\x08\xcb\xa1\x92\xfe\x00\x61\xdb\xfd\x84\xcd\x79\xc6\x2e\xa5\x58\x33\xa9\x1e\x03\x86\x45\x78\xcb\x1e\xb1\x40\xbb\x9d\xdd\x17\x55\xbf\x15\xce\xb0\xa8\xb6\x4b\xe6\xfb\x2c\x70\x27\x3b\x43\x58\x40\x1c\x16\x08\xab\xdd\x2e\x40\x38\x27\x59\x30\x44\x38\x26\x22\xc8\x11\x2e\x48\x16\xbc\x7a\x85\x70\xa2\x63\xdf\x1d\x23\xbc\x26\x22\x48\x10\x9e\xeb\xf0\xfb\x57\x08\x2f\x89\x08\xe6\x08\xaf\x20\xfc\x06\xe1\x19\x11\xc1\x0a\xe1\x05\x84\xdf\x22\x3c\x25\x22\x58\x20\x7c\x07\xe1\x77\x08\x3f\x12\x11\xdc\x21\xfc\x00\xe1\xf7\x08\xdf\xc3\xc7\x00\xe1\x5b\x22\x82\x7b\x84\xcf\x20\x7c\x8c\xf0\x29\x11\xc1\x19\xc2\x97\xe4\x00\x72\xd3\x48\x20\xd0\x7b\x0a\x2b\x34\x32\x0b\x91\x9a\x70\xa0\xea\xa5\xdc\x6e\xed\x9c\x2d\x9a\x4b\xae\x10\x32\xc8\x0c\x4a\x30\x54\x6e\xb1\x5e\x16\xe6\x8a\x2a\x46\x36\x5f\xf3\x3f\x03\xec\x79\xde\x0e\x67\xe5\xbc\xd2\x40\x61\x86\x30\x0f\x14\x1e\x6f\x6e\xd9\x63\xe4\xc5\x4b\x9a\x2d\xd8\x57\xba\x62\xf9\x9a\xc6\xcc\xc3\x06\x62\x1d\xc0\xd1\x7d\x63\x44\xb7\x63\xaa\x0e\x6d\xcd\xa3\x54\xc4\x34\xbd\x56\x42\xd2\x05\xd3\x20\x79\xae\xd8\x2a\xf0\xb2\xdc\xd3\x4d\xcc\x99\x8a\x97\x81\xf7\xd2\xd4\x3f\xcd\xca\x06\x5e\x7a\x47\x0c\x6f\x56\x4c\x2d\x45\x12\x79\x6b\x91\x2b\x6f\x87\x42\xb5\x64\x59\xe0\xb4\xa9\xab\xd6\x9f\xa1\x64\x1a\x25\x07\x68\x87\x76\x3b\xec\xf6\xf8\x3c\x5b\x17\x6a\xaf\xb7\x0c\x6d\x4c\x47\x99\xba\xd6\x7d\x0d\xaa\x69\x60\xa1\xa2\x72\xc1\x54\x08\x25\x9c\xea\x24\xcb\x12\x26\x9f\x1c\x77\x39\xb7\x71\x68\x91\xb3\xdd\xfa\x67\x29\x5b\xb1\x4c\x05\x5e\xc2\xef\x3c\xbc\x01\x34\xab\x67\x32\xba\xad\x33\x8a\x4c\x51\x9e\x31\x89\x73\xf5\x98\xb2\x68\xb3\xa2\x72\xc1\xb3\x1b\xb1\x8e\x5e\x0d\xb0\x09\xfc\x22\x94\x12\xab\xe8\xed\x60\xb7\xc3\x07\x9b\x58\x32\x0a\xdd\xd4\xb8\xea\x89\x5c\x43\x9b\xc3\x5b\x88\x58\xd2\xb9\x7a\x79\x2f\xe4\xad\x87\xd0\x13\x45\x6c\xa5\x4f\x64\x39\x3c\x3c\x29\xee\x9f\xe8\xf3\x8a\xf2\xec\xe0\xbc\xa4\x5f\x92\xe1\x60\x87\x61\xb9\xd6\x52\xac\xf3\x30\x5e\xf2\x34\x91\x2c\x7b\xa2\x27\x34\xe7\x09\x7b\xaa\xca\xe3\x27\xba\x93\xd1\xbb\x6f\xcd\x5f\x91\x36\x2a\x0f\x06\xf8\xb4\xcc\x8a\x82\xba\xa9\x8c\xde\xe1\x46\xe8\x92\xa7\x69\xde\x8c\xba\x56\x34\xbe\x65\x09\x7a\xa2\x43\x29\x2f\x57\xab\xda\x7f\x51\xaf\xe7\xe1\xc6\xc6\x5a\x38\x1b\xeb\xa9\x75\xac\x2a\x3b\x98\x83\x9b\x2d\xb3\x59\xa7\x34\x66\x4b\x91\x26\x4c\x46\xd0\xf2\xb5\xbb\xf3\xf7\x37\x3a\x16\xd9\x29\xec\xb9\x7a\x77\xa8\x9a\x82\x09\x9d\xfd\x18\x28\xa4\xcf\x2f\xaf\xe7\x1d\xee\xc5\xac\x50\x4a\x68\xb0\x10\xd9\x69\xca\xe3\xdb\xa7\x2a\xad\xa6\x25\xd0\xfb\xd5\xbb\x02\x64\xf0\x5f\x9b\x85\x87\xf0\x33\xcf\x6e\xf1\x46\x89\xc8\x7b\xb9\x96\x22\x66\x79\xce\x72\x6f\x87\xbd\xcb\x2a\xf0\xcf\x6b\xe0\xef\x05\x2b\x4c\xed\xff\xc3\x7c\xfd\xf3\xaa\x96\x4c\xc9\xc7\xe9\xdf\xc4\x0c\xaa\xbf\xd2\xa1\xde\x7f\xd7\xa1\x7f\x5e\x13\x79\xbc\x64\x9a\x2c\x4d\xaa\x66\xae\xcb\x98\x7f\x76\x53\x09\xa3\x75\x2b\x1f\x19\xad\x1a\x80\xbf\xdd\x6e\xa2\x8f\xf7\xa0\xae\xea\x54\xac\xd6\x22\x63\x99\x42\xa3\x4b\x40\x1f\x9a\xdc\xcb\xc9\xa6\xc4\x21\x51\x9d\xf5\xb2\x4c\x0d\x99\x69\x39\xe4\xf9\x15\xfb\x7b\xc1\x25\x4b\x76\x38\x18\xe0\x22\x34\x67\x00\x0a\x0e\x77\xf5\x4a\x14\x8a\x49\xbc\x59\xf2\x5c\x09\xf9\x18\x3d\x84\x4b\x9a\x2f\xff\x68\x42\x87\xb7\xb8\x2d\x88\x37\x6b\xaa\x96\x91\xf7\xd2\xc3\x71\xd9\xf1\xe8\xf2\xf9\xc5\x6a\x38\x75\xca\x3b\x47\xd5\x37\xce\xa7\x75\x19\x8f\x37\xb3\x22\x7f\xfc\x8b\x90\xb7\x4c\xfe\x7a\xf5\x39\xf2\x5e\xea\xf0\xf4\x1e\x22\x72\x0f\x9b\x8f\x4b\x21\x52\x93\x6a\xc2\xd3\xb5\x10\x69\xee\xed\x60\x6f\x3f\xb7\xcb\x16\xf2\x7f\xa8\xbf\xb3\xba\xbf\x85\x4c\x9d\x6d\xf4\x5d\x3d\x70\x36\xc8\x0f\xf5\x62\xda\xee\x85\xbb\xe3\xbe\xab\x27\xad\x7d\xf4\x43\xbd\x79\x6c\xf7\xa6\xbd\x39\xbf\xab\x47\xf5\x76\xfb\xa1\xce\x2c\xeb\xce\x00\x9d\x67\xa0\xc5\xa9\x14\xa6\xca\xc4\x9a\x59\x2b\xd3\x6c\xd2\x87\x34\x75\x53\x69\x9a\x4e\x9d\xd2\x09\x4b\x99\x62\x65\xa5\xfa\xdb\x29\x6f\x22\xaa\x0a\x6c\x7a\xb3\x86\x6f\x4d\xc6\x79\x96\xb0\x87\x2b\x96\x70\xc9\x62\x3d\x06\x29\x56\x91\xbe\xc6\xb7\xce\x04\x84\x10\x4e\x44\x5c\x00\xd2\x58\x30\x65\x2b\xf8\xe5\xf1\x3c\x09\x3c\xba\x5e\x7b\x08\xed\xf0\x93\x97\x7e\x56\x72\x11\x48\x16\x1c\x7f\x2b\xb3\xb9\x75\x65\xc1\x2b\x84\x25\xc9\x82\xd7\x08\xa7\xfa\x32\xf1\x0a\x61\xaa\x8b\x0f\x11\xe6\x3a\xfc\xc6\xdc\x71\x8e\x8f\xf5\x25\x27\x0b\x8e\x7f\xb2\xb7\x9c\x81\xb9\xe5\xbc\x82\x4b\x0e\x6f\x8e\x19\xcf\xab\x98\x4f\x54\xdf\x4c\x1f\xf1\x52\xc7\xa4\x22\xab\xb2\xac\x88\xc0\xb3\xc6\x6d\xa5\x3c\x8d\x77\x78\x41\x36\xa7\x25\x66\xdd\xac\xe8\x3a\x4a\xc3\x15\x5d\xe3\xb9\x90\x67\x34\x5e\x46\x69\x68\xbf\x70\x2c\x8a\x4c\x45\x69\x08\xbf\x58\x89\x0f\x52\xd2\xc7\x28\x0d\xed\x17\x16\x59\xfa\x18\x25\x3b\x5c\xe1\xef\x48\xd6\xb8\x1c\x5f\x16\x92\xb9\x29\x8d\x30\x6e\x8c\x28\x5a\x63\xb7\xf7\xd1\x12\x73\x4d\xad\xf0\xa4\x8c\xe0\x61\x33\x02\x57\xe7\x40\x94\xdb\xaa\x4e\x35\xa1\x17\x15\xb8\x31\x31\xd1\xdc\x86\xbf\xf0\x07\x9e\x45\x33\xfc\xf1\xe2\x4b\x44\xf1\x1d\x93\x39\x17\x59\x14\xe3\xe9\x34\x5f\x4b\x46\x93\x68\xb5\x73\xd6\x77\xd1\x5c\xdc\x43\xcc\x1f\x98\x57\x3e\x0f\xf4\xc9\x48\x08\x61\xdb\xed\x9d\xe0\x49\x6f\xa0\xbf\xbb\x99\x17\xf6\xfe\x47\xf3\x9c\x2f\xb2\x5e\x6c\x18\x3b\x33\x66\x79\x2b\xbd\x7b\xae\x96\x15\x03\xa3\xc8\xcc\xd5\xba\x66\xb9\xf4\x4c\xf1\x80\x39\xfc\x1e\x11\xa0\x8d\x92\x8f\xc0\xf0\x69\xd4\x6e\xb9\x6c\xfd\xe1\xc8\xdc\x7d\x74\x4f\xae\x95\xe4\xd9\x22\xf0\xe8\x2c\xf6\xd0\x88\xcf\x03\x36\x7e\x33\x21\x9e\xa6\xc2\xbd\x37\x1e\x21\xa4\xbe\x9f\x5e\xdc\x67\xe5\x9d\x1e\x08\xb7\x80\xa1\xf1\x60\x52\x57\x5a\x72\x1c\x14\xd9\xec\xb0\x61\x3b\x0c\x07\xc0\x70\x50\x63\x6f\xea\x1d\x99\xa6\x42\xbd\x1b\x4f\x97\x54\x9e\x8a\x84\x05\x19\x9a\x90\xcc\xee\x8c\x27\x5a\x52\x48\xc3\x63\xd0\x01\xbc\x6a\xcc\x26\x3b\xe8\xb8\x37\x18\x1e\xbf\x7a\xfd\xe6\xed\xbb\xf7\x3f\x79\x7d\x42\x44\xf8\x37\xc1\xb3\xc0\xf3\x50\x73\xd8\xb2\x66\xf5\xe9\x41\x27\x6c\xbe\x58\xf2\xbf\xdd\xa6\xab\x4c\xac\xff\x2e\x73\xe5\x85\xf9\x3a\xe5\x4a\x17\x2c\xa1\xbe\xd9\xee\x98\x4d\x08\xd3\x54\x70\x67\xe9\x7a\xc6\x6e\xd9\x63\x1e\x34\xe6\x3f\xd8\xec\xb0\x44\xa8\xea\xd8\x2e\xa6\xfa\x12\x5d\x0d\xa6\x3f\xdc\xed\x4c\x17\x3b\xa7\xe2\xfa\x71\x35\x13\x69\x8e\xd3\x32\xb9\xe2\x93\x68\x62\xc5\xc9\x88\xe9\x7e\x8e\xb5\x4d\x3b\xcf\xcf\x2a\x16\x8f\x03\xde\x22\x40\x27\x8d\xbe\x46\x4d\x68\x2f\xd7\x56\x60\x0e\xd8\x89\x69\xe4\x34\x1c\xc5\x3f\x53\xb9\x00\xfc\x99\x97\x0c\xa6\xf8\xe8\x08\x6d\xca\xc5\x0c\xaa\xe4\x71\x3c\x41\x15\x84\x14\x3d\x9e\xf5\x04\x4a\x0d\x8b\x43\xe0\x02\xf9\x7e\x90\x8f\x8b\x09\x11\xe3\x62\x02\xab\x29\xd1\x86\x13\x19\x88\xba\x50\x42\x06\xa3\xe4\x67\x5e\xb6\x93\x1c\x1d\x21\x5a\x56\xc0\xc7\xc9\xc4\xd4\xa1\xbf\x74\x35\xf0\x5b\x33\x9c\xf2\xdd\x37\x90\xb3\xcb\xbe\x35\xc9\xf5\xcd\x95\x30\x73\x8d\xd5\x97\x7d\xf6\xa0\x88\x32\x41\xc9\xe6\x39\x89\xcd\x77\xb1\x4e\xa8\x62\x92\x64\xdb\x6d\xde\xe2\xeb\xfe\x93\xea\x4a\x03\xb4\x01\xe8\xd0\x87\xc5\x1b\x73\x56\xbc\x32\x47\xc5\x5b\xbd\x18\x41\x16\xfc\x84\x70\x16\x0c\x07\x08\x8d\xb2\x60\x38\x84\xc0\x31\x1a\x09\x07\x0a\x34\x65\x4c\x63\x55\xe1\x5c\xbd\x53\xdd\xf4\x92\xaf\x42\x5a\xb8\xae\xcd\xd4\x65\x9d\x4c\x5d\x56\x32\x6a\xd9\x09\x0d\xbc\xf7\x6f\x3c\x14\x19\xec\xd7\x18\x57\xc8\x32\xa0\xf7\xae\x4b\x1e\x8e\x65\x71\x61\xe5\xfb\x5d\xf9\x4e\x69\x9a\xce\x68\x7c\x6b\x99\x69\xd8\x2b\x3b\xe9\xa1\x66\xe7\xe7\x42\xc6\xec\x57\x28\x4c\xf6\x38\x46\xad\x4a\x3f\xd5\x79\xa1\x5e\x84\xd9\x73\x5a\x67\xd8\x73\x5a\xf1\xd0\x6e\x94\x3a\x7c\x63\xa7\x33\x58\x3a\xf1\x1a\xcf\xa6\x6e\x4c\xe8\xf0\x90\x89\xc4\x3c\x70\xd2\xdc\x21\xa1\x46\x21\x9e\xeb\xf3\xb2\xb5\x80\xfd\x01\xae\x77\xf1\xa6\x3e\x5c\x45\xfb\xb0\xdd\x7d\xc7\x01\x56\x63\xf2\xf6\xf6\x7e\x31\xc4\x19\xf1\xbe\xf0\x8c\xcf\x39\x4b\x7a\xd0\x99\x1e\xd3\x27\x59\xef\xff\xf2\x8e\xd8\x91\x37\xea\xdd\xf1\x9c\xab\xde\x52\xa9\x75\xf4\xf2\xe5\x9c\xc6\x6c\x26\xc4\x6d\xb8\xe0\x6a\x59\xcc\x42\x2e\x5e\x4a\x5d\xe6\x65\x22\xe2\xfc\x25\x14\x7c\x91\xb0\x58\x24\x4c\x86\x4b\xb5\x4a\x4f\x78\x76\x47\x25\xa7\x99\x22\xde\x11\xc3\x82\x0c\x46\xe2\x67\x35\x12\x47\x47\x28\x3b\x22\x9e\x4f\xe5\x22\x1f\x4f\x74\x5a\xa6\x0b\xfd\x7a\x75\x5e\x0d\xd1\xc1\x35\xe2\x68\x38\x41\x23\x5d\xa0\x37\x17\xb2\xa7\x96\xac\x37\xd7\x47\xe8\x8a\xe5\x39\x5d\x30\x38\x4a\x73\x06\xf1\x99\xc8\x5e\xac\xca\xe1\x24\xec\xae\xc7\xb2\x3b\x2e\x45\xa6\x2b\x82\xc2\x50\x10\x3a\x9a\xf7\x68\x96\xf4\x68\x92\x70\x3d\x53\x34\xed\x2d\x59\xba\x9e\x17\x69\xef\x9e\xca\x8c\x67\x8b\x3c\xf4\xca\x37\x2b\x76\xdf\x33\xc7\x7b\x86\x46\xe6\xd4\x97\x61\x46\x57\x8c\x78\xe7\xe5\xf8\x7a\x7f\xe6\x22\x05\x26\xa8\x87\x65\x38\x97\xfa\x9c\xbb\x11\x97\x62\x4d\x86\x58\xee\x1c\xc2\xf2\xbb\x10\x97\xc5\x11\x52\xa3\x83\x77\x08\x6f\x78\xfe\x45\x53\x6a\x2c\x89\xf6\xcf\xce\xfe\x70\x87\x5b\x50\xde\xc2\xfa\x55\xba\xb3\x5d\x1a\x15\xe9\x36\x5b\x3b\xa2\x2c\x72\xc5\x80\x0f\x05\x3b\xb5\x55\x2d\x94\x92\x4e\xba\x53\xac\xc4\x0a\x5d\x45\x9c\x8d\xbf\x43\xce\xe1\x25\x9f\x49\x78\xbf\xd7\x84\xb7\x38\x5c\xf0\xc9\x3d\xd1\x7e\xb9\x70\xc8\xe7\x9d\x69\xc0\x49\xda\x8d\x44\xa8\x96\x54\x5d\x41\x96\x9c\x64\xb8\x11\xfe\x44\xd3\x9c\x91\x2c\xe8\x0f\x51\x33\xe1\x46\x16\x10\x3f\x68\xc5\x7f\xd5\xe4\x64\x16\x18\x3e\x6e\xb3\xc4\x92\xe7\x64\xbf\x53\x1a\x5b\xed\x9a\x39\x3f\xd8\xed\x71\xe0\x0a\xe0\xd0\x02\xcf\x9a\xce\xfe\x70\xf4\xbd\x45\x36\xbb\xef\x28\xd2\x3e\x90\xb1\xc0\x29\xa6\x9a\xfc\x00\x02\x5b\x06\x0a\xe1\x3e\x33\x6c\xfc\x58\x53\x0c\x15\xa1\xad\x50\xec\xec\xc0\x1a\x59\xb1\x87\x98\xad\x4d\x95\x71\x5c\x48\xc9\x92\xd1\xf3\xd1\x40\x85\x43\x0c\xb2\x2b\x31\xc9\x37\x31\x02\x1a\xb1\x34\x67\xd0\xcb\x82\x8c\x9d\x51\x4c\xb0\x26\x68\xdc\x9e\xaa\xd0\xee\x89\xe0\xe5\xbf\xe6\x2f\x17\x78\x7f\x55\x8b\x71\x72\x74\x34\xd9\x01\x5b\xee\x30\x3a\xd9\x19\x8c\x13\xb7\x90\x4a\x5c\x12\x97\xee\xfa\x7f\xcf\x8a\x74\x40\xf9\x8f\x2d\x67\x39\x9e\xc0\xf3\x8e\x18\xaa\x46\xfd\x88\xbd\x7f\xf1\x5f\xba\x2f\xd7\xf6\x5d\x1c\xce\x65\x1d\x79\x80\x78\x82\xcb\x28\x19\xb4\x5e\xbc\x75\x17\x4c\x97\x19\x14\xc6\x92\xb0\xb2\xe4\x48\x58\x51\x01\xac\x30\x33\xe5\x8f\x8e\x50\xeb\x3d\x5a\x57\x50\x5d\xe5\x58\x29\xa2\xc0\xec\x3c\x48\x78\xb2\x13\x22\x65\x49\xa0\xb3\x8e\x16\x01\xc3\x29\x16\xfa\xcc\x96\x2c\x65\x34\x67\x81\x70\xaa\xe4\x25\x18\xdb\xf1\x48\x96\x17\xa9\x2a\x47\x74\xcb\x1e\x2f\x25\x9b\xf3\x87\x72\x4c\x30\xdc\xac\x39\x5c\x71\x60\xb8\xb9\x3b\x5c\x3d\x4a\x53\x37\x4e\x09\xab\x2b\xc6\xb4\x9c\x07\x5e\xcf\x03\xce\x89\xa5\xa1\x79\x63\x26\x46\x70\x9b\x0f\x79\x0e\xbf\x41\x8e\x4e\xe2\x20\xc7\x12\x67\x78\xd6\x85\x52\x50\x64\xe8\xbe\xdc\xf7\x83\x55\xeb\x5e\x1e\xe4\x40\x98\x93\x95\xe1\x46\x7c\xc8\x12\x7b\x32\xfc\x89\x3d\x06\x39\x4e\x8f\x82\x3e\x0c\x7f\xbb\x85\x87\xf6\x5b\xf6\x48\x08\x81\x98\x13\xcf\x8b\x44\x00\x9f\xe8\xc8\x7b\xe9\xa1\xa3\x0c\x01\x45\x54\xe4\xcb\x20\x47\xce\xdc\xc6\x76\x6e\x25\x4e\xcd\x24\x50\xe2\x79\x23\xd3\xa7\xcc\xf7\x03\x4a\x44\x90\x99\x3a\x60\xf5\x62\xc2\x1b\xab\x47\xa1\x24\xac\x60\x8e\x63\x84\x79\xb5\x82\xb1\xd3\x4a\xf1\x6d\xa0\x18\x4f\xaa\xb7\xc4\x80\x61\x61\x18\xe4\x0a\x9e\xb1\xeb\x7a\x92\xb2\x9e\xf2\x49\xb7\x48\xd3\x3a\x75\x6d\x60\xde\xa6\xe9\x2e\x25\xe6\xfd\xae\xce\x32\x0f\x2c\xde\x53\xad\x06\x95\x69\xb0\x7b\x8d\xb0\x82\xfd\xbf\x24\x59\x30\x7c\x6d\x5f\xc4\xe1\x41\x1c\x0e\x46\xf3\x20\x8e\xf0\x94\x2c\x43\x75\x2f\xca\x52\x30\x45\x12\xdf\x91\x65\x38\x17\x85\x6c\x45\x3f\x92\x97\xbf\xbd\x3c\x7a\xb9\x18\xb9\x64\x6a\xc2\x2a\xd2\xd6\xc1\x15\x0e\x50\xc3\x9c\xb8\x70\xed\xc6\x18\xd0\xc6\xcb\x90\x26\x89\x6e\x85\x67\x8b\x1b\x11\x48\x3c\xd5\xab\xf2\xac\x46\xec\xc6\xaa\x2b\xad\xf7\x56\x1d\xf7\xc3\x3d\xe1\xf8\xce\xc0\xd0\x03\xd9\x94\xec\x31\x8a\x57\x74\x1d\x15\xfa\xff\x79\xa6\xc4\x5f\xb8\x5a\xfe\xa9\x6c\xf3\x3c\x53\x4c\x66\x34\x8d\x62\xcb\x3b\x5b\x57\x5c\xb3\xb9\x8b\x3f\x1f\x9e\x49\xc3\xbc\xd1\x34\x4c\x60\xee\x77\x2e\x22\x37\xe0\x00\xaf\xd9\x7c\x1e\xa8\xb0\x14\x22\xd2\x3d\xb7\xb4\xbb\xc9\x94\x91\x56\xe2\x5a\xac\x03\x54\x0b\x3f\x01\x36\xc8\xf4\x7d\xac\x92\x2a\xd0\x47\x14\xb0\x98\x10\x4e\x5b\x37\x43\x5b\xa3\x6d\x36\x3b\xdc\xac\x20\xd9\x13\xcd\x66\xe5\x45\x5e\x57\x8a\x85\xdb\xb0\x69\x68\x87\x29\x69\xcf\x8f\x95\x62\xb1\x6d\x8b\xc3\x6d\x4b\x22\x9e\x68\xbb\x3a\x0a\x4c\xb5\x58\xba\xad\x97\xdc\x80\x1d\xe6\xad\xf6\x35\x22\x37\x95\x97\x3d\x90\x87\x7b\x90\x12\xf9\x44\x0f\xa4\xe9\x41\x8a\xcb\x8a\x71\xea\xf6\x41\x56\x0d\xee\x70\x4e\x0e\x2c\x7a\x53\x6c\xec\xc4\x10\x43\x91\x08\xbc\xe3\x37\x1e\xc2\xee\x86\x09\x10\xee\x04\x8f\x9f\x55\xb8\x16\x22\xbd\xe6\xff\xd0\x57\xe1\x56\x77\x35\xca\x65\x68\x87\x63\x32\x1c\xe0\x82\x48\x9c\x74\x02\x02\xab\x17\xd4\x2d\x4f\xc6\x13\x9c\xd5\x18\x97\xa8\xed\xb6\xc0\x59\xd5\xdc\x76\x1b\xd4\x01\x12\x23\x9c\x95\xf8\x97\xe4\x38\xdb\xe1\x35\xd9\xb8\x7b\x30\x4a\xb0\x3e\x48\x1a\xa8\x28\x92\x78\x0f\x6b\x45\x7a\x2b\x4b\xd6\xce\x49\xf1\x3e\x26\x8b\xb8\xbb\x17\xd7\x3f\x40\xcb\xf4\xcc\x8c\xf7\x09\x1c\xbf\xf3\x06\x0d\xd3\x95\xe7\x96\x3d\xee\x0c\x64\x00\x1b\x87\x6a\xf4\xfb\x16\x61\x6e\x2f\x6e\xc0\xcc\xf9\x06\x9b\xcf\xca\x42\xbd\x43\x38\x26\x20\xeb\xd2\x1f\x60\xc9\xe6\xfa\x67\x3a\xcd\x59\x5a\x7e\x89\x42\xc6\x20\x7a\x86\x8b\x3d\x18\xd6\x07\x1f\xa6\x66\xf9\x38\xd9\xfc\xcb\xbf\x18\x56\x4e\x94\x63\xfd\x11\x31\xac\xeb\x55\x50\x6d\x86\x81\x81\x15\x51\x3c\x15\xf7\x99\x9e\xde\x4a\x64\x92\xef\x46\x45\xf3\x41\xa2\x73\xb3\xa6\x38\x27\x9b\x1d\x4e\x0c\x9a\x5d\x9b\x9f\xb9\xf9\x59\xc2\xcf\xc8\x1e\xad\x7d\x02\x77\x3e\xa5\x49\x87\x35\xd1\x84\xf1\x1c\x61\x69\xc2\x09\xf1\xbc\x23\x20\x14\x10\x9e\x93\x9a\xea\x0f\xcd\xa0\x4f\x74\xf9\xa8\x0c\xe1\x65\x2b\x07\x4c\x86\x93\x07\xc2\xc0\x63\x4c\x7b\x3c\xeb\x29\xc4\xad\xdc\x15\x4e\x91\xef\xf7\xe3\xd6\x9c\x07\xa9\x61\x33\xa6\x13\xa2\xc6\xe9\xc4\xc8\xe1\xad\xf6\xd9\x24\xc7\x7a\x24\x43\x42\xc8\x0a\xd5\x82\x2f\x24\x83\xcb\x40\x8f\xcf\x83\xd5\x1f\x86\x35\x9b\x65\x46\x0c\x99\x05\x72\x69\x83\xd1\xe2\xe7\xd5\x68\x71\x74\x84\x66\xe3\xc5\xa4\xae\x79\xbc\x38\x3a\x9e\x8c\x9c\xca\x66\x3b\x3e\x0f\x40\xc0\xd4\x3e\x83\xe9\x3e\xe6\x66\xa6\xa7\xa4\x19\x5b\x0f\x70\x8a\xaa\xf9\xd0\xa3\x28\x07\x33\x85\xc1\x94\xd7\x0c\x20\x3a\xd6\x78\x8e\x97\x98\x86\x70\x55\xca\x14\xce\xd1\x0e\x17\xcd\x47\xa6\x0e\x64\xd4\x82\x83\x70\xc6\xb3\x04\x96\x14\x33\xe7\x90\x01\xb6\x18\xc3\x0a\x6a\xdc\x23\x0b\x3b\x51\x4b\x11\x30\x28\x06\x74\xaa\x64\x73\xcc\x42\xb3\xc2\xfa\x17\x56\x51\x7f\x01\x64\x62\xc3\xee\xce\xeb\xe3\xa5\x6a\xe7\x29\xf0\xcc\x71\x42\xd2\x60\xb3\xab\x8a\xe3\xb5\xd9\xa8\x78\x6e\x36\x35\x5e\x92\xb2\xd1\x15\xa9\x9b\x9d\x91\xb2\xe1\x2e\xf0\x9d\x1b\xf0\xc5\x33\x52\x4d\x65\x05\xca\xeb\x1a\x94\xe1\x80\x5f\x8c\xcc\x18\xf5\xa2\x5a\x32\xa7\x5e\x43\xdf\x0f\x16\xa4\x23\xde\x70\xc8\xf3\x16\xf4\xe6\x9d\xd0\x0b\xb4\x78\xbd\x21\xc6\xf9\xc4\xf7\x2b\xa4\xb4\x38\x49\xc6\xf9\x84\x2c\xc6\xf9\x24\x82\x2f\x9d\x6e\x20\x7c\xfa\x04\x84\x4f\x51\xd2\x05\xe1\x53\x17\xc2\xef\x2c\x84\x4f\x11\x7e\x24\x83\xd1\xe3\xcf\xd3\xd1\xe3\xd1\x11\xba\x1b\x3f\xba\x10\xfe\xa8\x21\xdc\xa9\xec\xce\x01\x48\xb3\xf6\x06\x26\x57\x78\x86\x13\x80\xc6\xe6\x55\xa3\x83\xb3\x51\x72\xae\xc9\x1e\x8f\x9a\xc0\x2c\x97\x08\x4f\x6f\x07\x97\x07\x52\x3c\xc9\x19\xb2\x22\xe3\x76\x39\xe1\xf2\xe3\x9e\x1f\xd9\x33\x0a\xd7\xec\xf3\xaa\x6b\xe6\x7d\xc7\xf7\xcd\x6f\x38\x17\xd2\xfd\x0e\x3c\x60\x9c\x96\x42\x2e\x1e\xda\x6e\xdf\x0e\x86\x83\x57\x87\xdb\xfd\x26\xab\xb0\x16\x52\x3f\x3c\x4d\xb0\x01\x4e\xe2\x90\xe5\x31\x5d\xb3\x00\x82\x28\x52\xa1\x12\xf6\xe5\xf0\xd5\xdb\xf6\x85\x1d\x67\xe5\x35\x6c\x5d\x55\x07\x0f\x74\xf5\xf3\x65\x9f\x90\xb5\xef\x7b\x33\x7d\xf8\xd2\x0c\x82\xdb\x6d\xc0\x88\x61\x70\xd5\x8f\xa7\x5e\x0e\x8d\x78\x04\x32\x78\x59\xb1\x9a\x31\x59\x86\xaa\x3e\xeb\xba\x1a\x4b\xc9\x2b\x7d\x02\x20\xaa\x3c\x9d\x47\x9d\x14\x47\x7a\xdc\x03\x14\x29\x84\xcd\x5b\x20\x00\x13\x19\xe0\x19\x29\xb3\x44\xea\x28\xd1\x7d\x6d\xde\x7f\x19\x42\x25\x24\x1b\x04\xcd\xca\x57\x28\x8d\xa7\xe7\x84\x8d\x17\x13\xbc\x24\xb3\x23\x11\xcc\xf1\x02\xe1\xd5\x11\x91\x81\xae\x5c\xcf\x44\xcd\x00\x9a\x92\x3c\x60\xf0\xba\x35\x35\xf3\x73\x87\x1f\xc9\xd4\xec\x58\x1b\x0f\xb4\x01\xcb\x94\xe4\x2c\xaf\xda\x7c\x20\x83\x51\x3f\xb8\x23\x8f\x61\xc6\x1e\x54\x80\x50\x98\x88\x8c\x8d\xd0\x9c\xdc\x19\x71\xd7\xaa\xed\x87\xa3\xa3\xae\xd6\x7b\xba\xa6\xce\x2a\xa0\x1b\xf7\x65\x3d\xa3\x7b\x40\x58\xf7\xe3\xa1\x19\x4e\xb5\xec\xf7\xe3\xc1\x04\x1d\x25\xd0\xc6\xa0\xd5\x02\xda\xed\xca\x3d\xef\x2e\x89\xa9\xfa\x96\x78\x1e\x3e\x23\x16\x56\x18\x1a\xd1\xc0\x7b\x35\xf4\xb0\x37\x36\x59\xed\xfb\xf5\x44\x17\x39\x3b\xb1\xe5\xcd\xa3\xf7\x2d\x7b\xcc\x7b\x1b\xef\xc8\x7d\x4e\x65\xe5\xe3\x29\xee\x79\xe8\xc8\xdb\x79\xd1\x19\xbe\xad\x5f\xf9\x56\x1d\x2c\x20\xe7\x92\x4d\x08\x3b\x19\x44\x1a\x46\x3d\xcf\x50\xf5\x8d\xf7\xb4\xc0\x90\x60\x40\x52\x59\xda\xea\x27\xfb\xb2\x66\x5e\xd3\x8e\x07\x08\xe1\xc2\x52\x68\x5e\xe8\x21\x9c\x10\x2f\x72\xa5\x40\xd2\xef\x60\x28\x9b\xf3\x92\xf9\x7e\x20\x7c\x9f\x8d\xc5\x64\xbb\x65\x63\x69\x5e\x3f\x3b\xb0\x83\x2a\x61\x5a\x59\x76\xf3\xb7\x31\x08\x57\x4c\x52\x25\x24\x96\xc4\xfb\xf7\x7f\x2f\x43\xde\xb3\x31\x55\x57\x7f\x5f\x8e\x49\x34\x79\xb9\xc0\x19\xd9\x78\x7a\xf0\x64\xe0\x61\x2f\xd2\x1f\xc7\xde\x0e\x0b\xd2\xe2\xe9\x29\xdc\xc1\x6d\xce\xcc\x9b\xbd\xc5\xd0\xff\xe2\x1d\x89\x5d\x93\xa4\xb6\x4d\x05\x64\xb0\x25\xc7\xa8\x6c\x6d\xa0\x5b\xf1\xb0\x6e\x49\x37\xa8\x5b\xf3\x42\x0d\x38\x6c\x3c\x98\xf8\xbe\xf7\x2f\xe6\x7b\x38\x39\x61\x61\x5e\xcc\x0c\xea\x08\x8e\x51\xe4\x06\x87\x65\xbb\xba\xa3\xe2\x59\x1d\xb5\x6c\xd3\x8d\xd9\x0b\x51\x86\x8b\xcc\x7e\x8a\xdd\x0f\xbc\x42\x0c\xe1\x0a\x2f\x5a\xe2\x3a\x29\xd9\xd0\x48\x06\x1e\xf5\x10\xa6\xb3\x99\x84\xef\xd9\x4c\xea\x60\x92\x48\x96\xe7\x10\x63\x3e\x75\xa4\x64\x26\xbf\x64\x50\x44\x2a\x1e\xa7\xcc\xc4\xc0\xa7\x8e\xcc\x79\x62\xa2\x40\xac\x1a\x61\x5a\x24\x5c\x40\x84\xfe\xf0\x10\x9e\xe9\xc0\x4c\x7f\xd0\x1c\x72\xea\x5f\x1d\x4c\x38\x84\x12\x0e\x01\x61\x02\x50\x82\x2f\x20\xc0\x17\x3a\x90\x8a\xf8\xf6\xef\x85\x50\xa6\x6c\x15\xd2\x49\x22\x79\x84\x48\x91\x3c\xea\x20\x0c\x08\x86\x63\x04\x84\x21\x68\x44\x85\x11\x8e\x69\x76\x47\x61\x80\xe6\x0b\xa2\x80\x55\x6f\xe2\xd6\x46\xa5\x09\xc7\xdc\xb4\xa4\x7f\x75\x50\x98\xe1\xe9\x5f\x08\xa6\x26\x94\x9a\xc0\x42\x8a\x62\x6d\x63\xe0\x5b\x6f\x58\xaa\x60\xda\xf4\xaf\x0d\xa6\x3c\x57\x65\x94\xfe\xd6\xd1\x09\x44\x24\xfa\x93\x41\xa5\x09\x4b\x21\xa0\x28\x4f\x73\x13\x01\x9f\x3a\x72\x0e\xbd\x4c\xe6\xba\x87\x09\xa7\xa9\x80\x19\x32\x5f\x10\x75\x67\xc2\x77\x3a\x60\x6a\x83\xca\x4c\xab\xba\x3d\xb6\xd2\x9f\x6c\x05\x9f\x33\x96\x98\xd0\x8c\xe9\x0e\xcc\x39\x4b\x93\x9c\x41\xe6\xf2\x1b\xa2\x17\xce\x14\xd5\x21\x93\x54\x48\x66\xa3\x0b\xa9\xa7\x66\x2e\x84\x62\xb0\x04\xe6\x0b\xa2\xe4\xca\x44\x48\xdd\xee\x72\xa8\x03\xcb\xa1\xfe\x3c\x86\xcf\x63\xfd\xf9\x0a\x3e\x5f\xe9\xcf\xd7\xf0\xf9\x5a\x7f\xbe\x81\xcf\x37\xfa\xf3\x2d\x7c\xbe\xd5\x9f\x8c\x42\xc7\xf5\xaf\x0d\x9a\x26\xad\x6a\x03\xc2\xcb\x6a\x45\x96\xe5\x7a\x2c\x4d\x0e\x48\x55\x2b\x98\x1c\xfd\xeb\x21\x0c\x00\xa8\xc1\x8f\xc3\x33\x06\x84\xe0\x4b\x47\xad\x60\x8a\xf9\x4a\xcf\x2f\x88\xbd\x43\x10\xe4\xdf\x75\x44\x6e\x82\x7a\x75\x6e\x67\xd0\xa9\xdb\x99\xee\xd3\x2d\x7b\x5c\x30\x98\x30\xf3\xe5\x21\x9c\xd2\x99\x59\x61\xf8\xd0\x11\x6c\xc1\x32\x28\x63\xbe\x74\x14\xf4\x25\xe5\xf0\x99\xdd\x9a\x40\x76\xeb\x21\xbc\xa2\x1c\xaa\x03\x4d\x08\x04\x6c\x40\x08\xad\x21\x20\x6f\x4d\x48\x42\x56\x96\x15\x10\x64\x59\x61\x83\x5c\x99\x85\x2f\xbf\x21\xda\xc0\xa7\xfe\x35\x41\x33\x87\xf0\xe1\x21\x9c\x51\x00\xa6\x8c\x6a\x60\xca\x44\x1e\x4b\xbe\x86\xc1\x97\xdf\x1e\xc2\xe6\x10\xd5\x91\xf6\x38\x46\xd8\xec\x0c\xd8\x18\x62\xad\xaa\x65\x28\xbf\x4d\xb4\x85\x25\x51\xc2\x91\x28\x94\x9d\x59\xf3\xe5\x21\x0c\xa5\x74\xf6\x35\x95\x14\xfa\x0e\x1f\x3a\x82\xc7\xca\x42\x9d\xfd\xd4\x91\x36\xc2\x06\xc4\xa2\xc4\x63\xe5\xb7\x87\xf0\xdf\x75\xf8\xef\x1e\xc2\x12\x2a\x97\xba\x76\x09\xad\x4a\xdd\xa2\x2c\x66\x80\x47\xf4\xaf\x87\x30\x94\xd6\xc5\x72\xba\x82\xfc\xfa\x57\x07\xab\x89\xa8\xa6\x21\x67\x71\x39\x24\xfb\x09\x91\xa9\x9d\x1b\xf3\xa5\xa3\x56\x34\x85\xe9\x81\x0f\x1d\x61\x18\x26\x3a\x06\xbe\x74\xd4\x9a\x9a\x8a\xd6\x14\x6a\x51\x52\x64\x00\x82\xe6\x0b\xa2\x1e\x0d\xfa\x85\x0f\x1d\x51\x00\x72\xcd\x8b\x19\x04\x56\x2b\x2a\x1f\x4d\x04\x7c\x42\xa4\x19\x01\xcc\xbf\xd1\x17\x94\x81\x07\x1f\x3a\xa2\xc4\xa0\xca\xa2\x50\x05\x40\xa9\x34\x40\x2a\xf6\xa0\xca\x03\xa0\xfc\xd6\xd1\x7a\x73\x43\x9c\xfe\xd0\x11\x4b\x08\x2d\xe1\xd3\x6e\x4f\x65\xf7\xa7\xe2\x66\x53\xe9\x5f\x08\x2a\xdb\xbe\xfe\xd0\x11\x00\x77\x4a\xc2\x27\x8d\x6f\x4d\x88\xc6\x1a\x96\x01\x90\x35\x14\x17\x30\x71\x85\x9e\xb5\x3b\x0a\x05\xee\xa8\x2e\x71\xc7\x13\x06\x87\x06\x7c\x78\x08\xdf\x9b\x03\xe0\x1e\x4e\x80\x98\x4b\x7b\x56\x99\x2f\x1d\x95\xf2\xf5\x25\x35\xfd\x2d\xbf\x01\xe1\xce\x2d\xb6\x9d\xeb\x45\x67\x69\xca\xd7\xe6\xa0\xb2\x9f\x1e\xc2\xb0\x0e\x80\x08\x56\x74\x61\xf0\x84\xfe\x30\x9b\x95\xd9\xcd\x5a\x06\xa9\xfc\x0f\x49\x13\x0e\x12\x31\x26\xa1\x8e\x81\x3d\x9b\xdb\x3d\x9b\xdf\x02\x94\x9b\x1e\xad\x4d\x6f\xd6\x54\x29\x26\x33\x1b\xa3\x3f\x75\xa4\x48\x1f\x17\x06\xcc\xec\xa7\x8d\x2c\x5b\x2f\xbf\x35\x30\x53\x7d\x2a\xb8\x3d\x68\xc6\xe8\x2c\x16\x3c\xa5\x05\x4e\x25\x0c\x90\x28\x01\x60\x7e\x67\xa0\xee\x6e\x61\xa1\xa0\x84\x00\x1d\x2c\x81\x54\x19\x28\xdd\x1d\xa6\x4b\xbf\x49\x9b\xb4\x44\x6b\x53\x92\x05\xc7\xaf\x5c\x91\x8b\x34\x90\xcf\x15\x77\x3e\x7e\xed\x16\xdc\xe7\x18\xf5\x87\x15\x27\xde\xb0\xfa\x9f\x7f\x8b\x6d\x3e\xa1\x59\xd9\x9b\xe0\xf8\x8d\x91\xac\x2e\x05\xab\xdf\x19\x61\xb9\xe3\xf7\x9a\xa8\xff\x64\x4b\xc3\xad\xcb\x70\xa7\xbe\xc9\x66\x8d\x0f\x3d\x7a\xb7\x64\x24\x0f\x90\xf7\x37\x9a\xbc\xbf\x01\xf2\xfe\xeb\x73\xc8\xfb\xe6\x83\x63\x7d\x4d\x87\x1b\x2a\x70\x8f\xb7\xdb\xe1\x4b\x1d\x1c\xbe\x54\x11\x33\xac\x0c\xd5\x27\x44\x35\xde\x18\xed\x73\x98\x95\x54\x28\x1f\x9a\x73\x45\x63\x7d\x29\x6b\x3c\x38\x36\xfa\x5e\x72\x85\x39\xbc\x85\x6e\xf8\x3c\x48\x49\xba\xdd\x7e\xc6\x39\xc9\xb7\x5b\x89\xe3\x3e\x21\x14\xe2\x2d\x6f\xae\x70\xa5\x2d\x4e\x69\x9a\xf2\x6c\xd1\xab\xa4\xb1\x7b\x77\x1a\x92\xf4\xc5\x23\xef\x19\x81\xfc\xf4\xb1\xc7\x73\x50\xc0\xce\x8b\xb5\x9e\x48\x96\xf4\x66\x8f\x20\x61\xf1\xfb\x5a\x8a\xf5\x0b\x3d\x25\xf9\xef\xbd\x35\x8d\x6f\xe9\x82\x85\xbd\x5f\x73\xd6\xfb\xbd\x56\xf3\x89\x97\x2c\xbe\xad\x82\x01\xfa\xbd\xa7\x84\x51\x85\x57\x4b\xb6\x0a\x7b\x57\x8c\x26\xbd\x95\x90\xac\x47\x6b\x39\xb4\x59\xb8\x62\x2f\x8b\x9c\xbd\x80\xd2\x2f\xea\x76\xbc\x52\x42\xab\x78\x4a\x42\xab\xa8\xee\x98\xe6\x22\x29\xc6\x72\x72\x92\x9d\xe8\x81\x27\xa5\xb0\x37\xc4\x79\x37\x4b\xd6\xf3\x8e\xf8\x91\xd7\xfb\xdd\x3b\xca\x8f\xbc\xdf\xf5\x60\x35\x0d\xc0\x92\x1e\xcd\x7b\xd2\x6a\x26\xf5\xbc\x23\x4d\xa2\xe8\x4c\xe9\x91\xf7\x3b\xee\xcd\x0a\xd5\xe3\x0a\xa6\xab\x60\xba\xcc\xef\xba\xda\xdf\x43\x0f\x45\xcf\xae\x93\x67\x50\xed\x13\x75\x56\x2c\x18\x5d\xb1\x79\xb6\x8f\x58\x50\x2d\x38\xda\x95\x6f\x75\x35\xdf\xb6\x3f\x74\x9e\xca\x6a\xd5\xaa\x66\x9e\x41\xe3\x85\x7b\xde\xd2\x85\xee\x78\x6a\x50\xe3\x6c\x82\x73\xf2\x25\xe0\xb0\x23\x72\x0d\xc5\x56\x98\x87\x5c\xe8\x48\xe7\x01\x2c\x09\x60\x51\x52\xae\x67\x4d\x9a\x59\xd0\x03\xec\xe9\x7d\xf3\xb8\x66\xe5\xa0\x63\x1d\xa7\x61\x2a\xe5\x2c\xd1\x40\xf1\xbb\x77\x24\x60\x22\xd8\xc3\x9a\xc5\x1a\xce\x3c\x64\xb2\xb2\x23\x0f\x66\xc0\x5d\xd5\xf2\x7b\x1d\x28\x87\xa5\xb5\xac\xb1\xcc\xba\x21\xd0\xb1\x3a\x34\xc8\x43\x76\x0c\x2a\x69\x01\x3b\xa6\x12\xc1\xb8\xc3\xa9\xb4\x75\xca\xbe\xf7\x96\x34\xef\x71\x3b\xfa\x12\xe8\xf5\xe6\xa1\x46\xaa\x24\xd3\x57\xbb\x1e\x95\x92\x3e\x5e\xcc\x43\x2b\xdf\x60\xa6\x57\xcf\x6b\xbf\xc9\xc8\xe2\xc8\xb2\xba\xcd\xc4\xff\xc0\x1c\xe7\xdf\x9e\x63\x9a\x99\xfe\xc0\xfc\x96\x3c\xac\x98\x0c\x46\x71\x2d\xbd\x1d\x97\x66\x08\x0a\xc2\x02\x8e\x63\x33\x73\x47\xde\x18\x56\x71\xe2\x61\x0a\x60\x51\xb8\xaf\x9a\x80\x61\xca\x39\x2c\xbe\xbd\x72\xb3\xe0\xd0\xfa\x18\xb6\x4f\x35\x47\x2c\xa0\xa8\x84\xcb\x2f\x01\xfd\xa1\x79\xe1\xcf\x98\x97\x5e\xce\xb3\x45\xca\x8c\xd4\x6c\xf9\x40\xf2\x2c\x20\x5c\xec\x19\x78\x30\x02\x71\xe5\x50\xd8\x58\x99\xa1\xc8\xd6\xd1\xad\xa1\xe5\xb9\xa3\x13\xdf\x37\xba\xec\x19\xa3\x83\x4a\x0e\x0f\xd1\xd5\x5a\x99\x3e\xb9\x9d\xfa\x81\x5e\x2d\x07\x18\x18\xaa\xc6\x0e\x78\x5b\x1f\x50\x9c\x5c\x43\xb6\xff\x55\x0b\x08\xc8\xa3\xec\x83\x2e\xff\xbb\x77\x44\x9f\x8d\x48\xee\x0e\x0f\xb0\xdc\x24\x06\x26\x31\x27\x83\x11\xaf\x79\xcc\xfc\xe8\x08\xe9\xbd\x10\x50\xcc\xc6\x7c\x82\x90\xd3\xd4\xc8\xec\xe6\xff\x7e\x7d\xf1\x35\x34\x1c\x2e\x3e\x7f\x0c\x18\xee\x7a\x41\xbb\x08\x54\xc5\x74\xcb\x81\x43\xe8\x11\x42\xb2\x13\xcb\x9c\x55\x28\x52\xbb\x67\x4f\x9d\x39\x52\x7e\x2f\xb5\x7a\x02\x8a\x74\x82\x9e\xcb\xa7\xe7\x50\x64\x30\x73\x80\x48\xdc\x79\x6b\x33\xdc\x4f\xf4\xe4\x45\xce\x99\xf2\xf8\x7f\x10\xba\x35\xf7\xea\x36\xbe\xc5\x71\x75\x9c\xd5\x0a\x13\x24\x46\xff\x6b\x4e\x32\x9a\xd9\x6e\xe8\x59\x6c\x6a\xd6\x70\x0d\x2e\x79\xc0\x71\x81\xca\x77\x18\x8d\x64\x8b\x12\xc9\x86\xde\x51\x61\x11\xec\xfa\x20\x82\x5d\x7f\x1b\xa2\x1f\xbe\x0d\xd1\x1d\xa0\x6c\x0f\x20\x0d\xca\xa3\x4a\x92\x2e\x6f\xd0\x08\xa8\xa3\xe9\x27\xa7\xcf\x9d\x2a\x3b\x85\xa2\xdc\x98\xfb\xa7\x20\xab\x1a\x10\xa3\xa6\x99\x1c\xb6\x67\x26\x47\x12\x66\x8f\x89\x0e\x10\xab\x26\x2b\xae\x3b\x56\x3e\x67\x36\xfa\x24\x32\x76\x31\xd7\xa0\x14\xf6\xce\xda\xc7\x24\xc0\xa1\x26\x4a\x2b\xd9\xf2\xdc\x50\x6e\x92\xc5\x8c\xdf\x01\xa9\x78\x15\x48\x74\xe4\x69\x7a\x96\x67\x09\x7b\xe8\x01\xf6\x0d\x3d\x47\x54\xaa\xb9\x32\xf7\xdd\xe7\x85\xac\x48\x99\xd3\x40\x1f\x19\xc8\x08\x42\x1c\x3a\x09\xe4\x81\xa9\xdd\xc3\xfc\x80\xf2\xbf\x8a\xa4\x81\xea\x9b\xe8\xfd\xf6\x30\xac\x74\x12\x84\xce\x0e\xca\xbf\x6b\x07\x95\x34\xca\xb7\x51\xd1\xef\xa6\x89\xdf\x1b\x1b\x28\xd6\x1b\x88\x55\xb4\xc9\x38\x86\xb5\x2f\xca\x7d\x54\xb8\xc4\x4a\xe8\x1d\xc5\xe5\x3e\xaa\xb7\xcd\xb7\xf7\xcd\xd9\x81\xb9\xe0\xe5\xde\xa8\xb0\x49\xfe\xdd\xd8\x84\xb7\xe6\x22\xfe\x81\xb9\x30\x63\x07\x51\x08\xe8\x09\xab\xa7\x47\xe3\x8b\x9e\x9d\x8d\x39\x61\xe3\xb5\x21\x3b\xe6\xcf\xea\xd6\x2d\x03\xe4\xbb\x3e\x80\xdc\xc2\xdf\xb2\x5f\x68\x62\xb1\x5a\xd4\xf3\x8e\x5a\xa7\x1a\xf4\xc5\xd8\x53\xe9\xc1\xf3\xdf\x6f\x19\x90\x3a\xf0\x52\xd8\x91\xbf\xf9\x70\xe8\x94\x34\x23\x5c\x92\x79\x90\xe3\xb5\x99\x79\x58\xcc\xb5\x5d\xcc\x65\x39\x9a\xe5\xb7\xd7\xf2\x54\xdf\x82\xf3\x7b\xae\xe2\x65\x50\x5d\xe5\x37\x31\xcd\x59\xf9\x5a\x1d\x41\xc0\x3e\x64\x9b\x40\xfd\x10\x1e\x59\x55\x9c\xc1\x08\x12\xca\x07\xf1\x32\x5a\x99\x68\xbb\xfe\xd1\xde\xc3\xb4\xaa\x30\x99\x0a\xd9\x1d\x93\x8f\xc1\x29\xaa\x91\x2a\x51\xdb\x2d\xab\xf3\xf4\x07\x15\x4f\x46\x41\xae\xbe\x68\xa9\xc6\xe2\x94\x58\x69\x48\x93\x41\xf4\x09\x51\xd5\x73\xf4\xc6\xbe\x22\x4b\x92\xb6\x5e\x91\x75\x5d\xa7\x81\x34\x8f\xc8\xb5\xbe\xed\xce\x7d\x7b\xde\x2f\x65\xe9\x37\x5b\x4c\xb7\x47\x7d\xbf\x7f\x1a\xd0\xf1\x70\xe2\x54\x52\x75\xbe\xb4\x9c\x57\x2b\xcb\x56\xab\x70\xe9\x72\x4a\x1c\xe2\x86\x6d\xb7\xfd\xbe\xf2\xfd\xc0\xbb\xae\xe2\xd4\xd8\xfb\xf7\x7f\x2f\xc5\x17\x6e\xe8\xc2\x9b\x6c\xb7\x4f\xbc\xa5\x2a\xf7\x84\x34\x91\xce\xf2\x7f\x71\xc4\x30\x4b\x51\x87\x43\x14\x8d\x07\xe8\xde\x8b\x1a\xa2\x9a\x57\x6c\x71\xf6\xb0\x3e\xa9\x96\xf8\x12\x2c\x78\x9d\x94\x63\x70\x8d\xe4\x5d\x58\xdd\x72\x07\x7c\x6a\x79\x8d\xed\xb6\x94\x9a\xb0\x33\xe7\x79\x47\x46\x2a\x5d\x91\x2f\x56\xba\xc0\x79\x9e\x37\xc6\xf6\x1a\x3d\xf9\x48\x55\x55\x16\x74\xb9\x46\xed\x1c\xa6\xaf\x65\x1e\xc9\x16\xec\x61\xed\xed\xf6\xf9\x56\x57\xf5\xa4\xe8\x3e\x8f\xca\xed\x61\xf7\x85\x9d\x86\x06\x64\x97\x0a\xd9\x59\xcf\x3b\x52\xad\xbd\x00\x21\xe8\x91\xf9\xb4\x0d\x57\x65\xa0\x48\x13\x38\x7a\xca\x01\x8e\x6b\x57\xd1\xc9\x55\xbd\xf4\xfd\x46\x10\xee\x11\x27\xfb\x51\xd1\x67\xe0\x8a\xdc\x7c\xcf\x8b\xfb\xd7\xc6\x8b\x3b\xfe\x4c\xbc\x9f\x7f\xa6\x99\xc8\x1e\x57\xa2\xc8\xff\xf0\x07\x0f\x9f\x93\x0d\x35\x52\xe0\x81\x9d\x10\x84\xf5\x88\x75\xb8\x1c\xb9\x91\xf0\x8e\x5c\xd2\x43\xa3\x31\x8d\x55\x74\xa4\xc5\x2f\xd5\x83\xcf\xdc\x79\xf0\x31\xe8\x46\x47\x59\xc4\x83\xb0\x81\x28\x88\x32\xb0\x85\x30\xcd\x1e\xa3\x65\x80\xb0\x65\x1f\x44\x2b\x6c\xa5\x8b\xa2\x59\x80\xca\x6f\x4d\xb6\x44\x8b\x00\xde\xd5\x00\x12\x2e\xe6\xd1\x14\x67\x22\x61\xd1\x7d\x50\x36\x7e\x31\x8f\x1e\x31\x50\x39\xd1\x1d\xae\xa8\x9d\xe8\x01\xe7\x4b\xba\x66\xd1\x2d\x66\x0f\x34\x56\xd1\x59\x25\x32\x9a\x38\xfa\xb1\x40\x77\x3a\x1a\xb0\xe7\x2d\x16\x1f\xe1\xf8\x3c\x94\x2c\x67\xea\x2f\x46\xaf\xea\x94\xc6\x4b\x46\xf8\x7e\x1c\x3e\xaf\xcd\x01\x91\x73\x7c\xfe\x2d\x5e\x72\xc3\x7e\xc7\x5b\xf4\x7d\x36\x1e\x9e\x92\x2d\xab\xf8\xbf\x95\x58\x52\x6b\x17\xf4\xd2\xc8\x46\x30\x2b\xd4\x67\xe3\xd7\x00\xe4\xbd\xb9\xf9\xe1\xe6\x27\x36\x3f\xb9\xf9\x59\x45\x95\xda\x49\x09\xf6\x75\x5d\xae\x28\x94\xad\x32\x31\xc5\x96\xe6\xa7\xd8\x2f\x5d\x6f\x1a\xc8\xb1\x30\x19\x67\xe6\x87\x3a\xc9\xbb\x4e\xd1\x67\x3d\x21\x84\x90\xf9\xae\xdb\xfe\xa3\xc2\x5e\x6d\x02\xd5\xc3\xd6\x66\x66\x7f\xb0\x43\x56\x5d\xf6\x59\xb2\x70\x38\x25\xf2\xe4\x09\x71\xb8\x08\xa4\xe1\x30\xed\xcc\xa5\xd7\x98\xa6\x36\xd3\x5b\xcc\x3b\x33\xcd\x25\x5d\x38\x75\xbd\xc3\x79\x67\x36\x03\x0f\xd3\x15\x08\x1d\x40\xce\xf7\x38\xee\x6e\x55\x8a\x39\x4f\xf5\x16\xd5\xd9\x86\xaf\x71\x71\x28\xdb\x1d\x4f\xca\x6c\x83\x9f\x70\xd2\x99\xcd\xea\xa8\xd8\xca\x06\x78\xdd\x99\x8b\xe6\x8f\x59\xec\x74\x6e\x38\xc4\xf3\x43\xd5\x59\xf9\xc5\x66\xee\x65\xf7\xdc\x08\x79\x4f\x65\x32\x95\x6c\x6e\x73\x1e\xe3\x55\xf7\xf4\x14\xf9\x9a\x65\x79\x59\xe1\x2b\x3c\xeb\xcc\xb6\x62\x2b\x61\xb3\xbc\xc1\x8b\xce\x2c\x29\xfd\xc7\xa3\xcd\xf2\x76\x64\x64\x86\x2f\xe6\x60\x94\xf7\x83\x1e\xe4\x17\x91\x30\xb2\xc6\x2a\x3c\xad\x46\x02\x51\x73\x13\xa5\xe7\xea\x54\x64\x79\xb1\x62\x92\x24\x75\xdc\xa5\x9d\x6d\x52\x60\x15\x96\x82\xa3\x29\x56\xe1\x27\x33\xc4\x2b\x36\x27\x4b\x1d\xb4\xd0\x40\x38\x56\xe1\x67\xfa\x8f\x47\xb2\xc0\x2a\xfc\xc2\x56\x82\xcc\xb0\x0a\x2f\x01\xa2\x08\xc5\x60\x80\x0c\x96\x99\xc4\x58\x85\xd7\x00\x1d\xd0\x91\x5c\x07\xed\x6c\x90\x15\x56\x1d\xac\xb9\x2e\x89\xd5\x4a\xe4\xb1\x3e\xda\x3b\x36\x08\xdb\x6e\x19\x21\x84\x9b\x9f\xb9\xf9\x89\xcd\x4f\x6e\x7e\x56\xae\x8c\x64\x97\xf8\x6b\xd0\x10\x9a\x5c\x6c\xb7\x8d\xf0\xac\x15\x2e\x5a\xe1\xa4\x15\x5e\xa2\x1d\x8c\xb1\x5e\x9c\x0e\x89\x2a\x8d\x36\xb6\x5b\x8b\x30\xd6\xa6\x40\x6b\xfd\x44\x19\xd9\x58\xc1\x2e\xe9\x2c\x53\x4b\xb2\x73\x0b\x54\xcb\x7b\xb8\x40\x61\x0a\xfc\x53\x84\x86\x53\x53\x97\x03\x3b\x87\xdb\x5d\xda\xbc\x25\x60\x1d\xce\xc9\x4d\x4e\x80\xba\xc3\xb9\x16\x26\x17\x80\xe4\xe1\x5c\x33\x93\xcb\xc2\xeb\xe1\x7c\xd4\xe6\x2b\x81\xf9\x70\xce\xd8\xe4\x74\x20\xfd\x70\xde\xdc\xe6\x2d\xb7\xc1\xe1\x9c\xab\xa7\x0d\x54\x58\xa9\xe9\xeb\xb3\xd3\xab\xb3\x9b\xe9\xc7\x8b\xe9\xd7\x8b\x9b\xe9\xe5\x87\xeb\xeb\xe9\xcd\x1f\xcf\xaf\xa7\x17\x57\xd3\xbf\x5e\xfc\x3a\xfd\xcb\xf9\xe7\xcf\xd3\x5f\xce\xa6\x9f\xce\xaf\xce\x3e\x3e\x21\xe8\xf8\x1c\x6d\x73\x89\x36\x3b\xd1\x41\x76\xb8\x6f\xc1\x87\xb5\xe6\x0f\x12\x1a\xde\xf0\x4d\xf8\x36\x3c\xf6\x9e\xf9\x7a\xfe\xda\x3c\xc8\xd7\x56\xb2\x52\xfb\x4c\x4f\x49\xda\x7e\xa6\xe7\xc6\x16\x8c\x26\x66\x5e\x0d\xdd\x47\xf7\x3c\x90\x98\x62\xfe\xad\x17\xfb\xce\xc3\x9d\x75\x98\xb3\x99\xb7\x14\xb4\xcd\xe2\x4c\xdb\xda\x06\x0a\x9d\x4c\xc7\x6a\x02\xaf\x8c\xa3\xdb\xfd\x54\xdf\xcf\x03\xef\xe2\xcf\x67\x57\x57\xe7\x1f\xcf\xa6\xbf\x7c\xb8\x3e\x03\x86\x34\xf6\x8c\x8d\x93\x94\xe6\x39\xa8\x4f\xce\xc1\x70\xea\x5f\x45\xd1\xa3\xf0\xa8\xab\xd8\x6a\xad\x78\xb6\x00\xce\xda\x1d\x93\x92\x27\xac\xf7\xfb\xbf\xe6\xbf\xf7\xe6\x52\xac\x7a\x8f\xa2\x90\xd6\x26\x7a\xbe\x66\x31\x9f\x73\x6b\x6c\xb8\x77\x96\xe5\x85\x64\x3d\xb5\xa4\xca\xe4\x32\x76\x8a\x7b\x60\xbd\xb8\x97\x08\x78\x94\xd6\x35\xa6\x74\x6d\x84\x90\x8d\x81\x13\x93\x2d\x0f\x3d\xac\xc0\x50\x4c\x1e\x78\x1f\xcf\x3e\x9d\x7f\x3d\x9b\x7e\xf9\xf0\xf5\xaf\xd0\xe9\xed\xd6\x8d\x9a\x7e\x39\xbb\xfa\x8f\xb3\x8f\xdf\x3f\x1c\x43\x3a\x99\xc1\x88\xcc\x0e\xa5\x62\x52\xc3\xa3\xb6\x5a\xd2\xac\x27\xb2\x98\x85\xbd\x9b\x25\xcf\x7b\xb1\xc8\xe6\x29\xd7\xbd\xa4\x8f\xbd\x19\xeb\x25\x05\xd3\x35\xd1\xde\x8a\x3f\xf0\x0c\xfa\xdc\x14\x8b\xb6\x1a\xcc\x68\x93\x77\xf1\x34\x1b\xfd\x85\x6e\xfe\xdb\x5e\x2f\x35\xd0\x50\xa7\x5f\x66\xb2\xc1\x58\x4a\xa9\x40\x9f\x57\x1d\xe8\x9d\x67\xb9\x62\x34\xc1\xbd\xbf\x15\xb9\xb2\x65\x25\x5b\x14\xa9\x06\xf1\x92\x85\x8d\xf3\xa0\xaf\x82\x0c\xfd\x40\xeb\xdf\xdd\xd6\xa8\x34\x0b\x50\x5f\x39\x52\x22\xc2\xe9\x14\xe8\x8e\x0f\x85\x12\xbf\xf0\x2c\xb9\xa4\x5c\xe6\xa3\xac\x0d\xb6\x31\xf2\xfd\xc7\x10\x9a\xcb\xf5\x64\xda\xcf\x9a\x45\x46\x7b\x3c\xeb\x65\x08\xd4\x61\x5b\x65\x29\xf2\x7d\x0a\x5c\x3c\xcb\xf4\xcc\xc6\x74\x82\x0b\x22\xf6\x33\x82\x42\x69\x50\x60\x8a\xf0\xe3\x7e\x2a\x7a\x1c\xd3\x49\xc0\x30\x77\xb4\x0b\x92\xfd\x0d\x48\x11\x5e\x76\x51\xd7\x1c\xaf\xc8\xd2\xf7\xfb\x89\xef\xf7\x0b\xdf\xcf\x42\x5a\x28\x31\xe3\x59\xd2\x27\xa4\x3f\xd4\x4d\xaf\x90\x55\xfa\xd4\x68\x03\x8b\x31\x9d\x10\x5e\xa9\xf6\x58\xfe\xdf\x8c\x4c\xc7\x74\x32\xca\x83\xc4\xf7\x83\x03\xd0\x3f\x6b\xee\x0b\x88\x6a\xad\xf1\xaf\x59\xc5\x85\xd4\xdb\xb5\xb7\x16\x29\x8f\x1f\x7b\xff\x9a\x83\xdd\x8d\x5b\x06\x9f\xf7\x4b\x96\x99\x35\x5e\xe8\xe9\xad\x17\x5f\x17\xd1\x1b\x73\xa6\x67\xea\x50\x27\x4e\x60\x00\xeb\x40\xff\x60\x8e\xa2\xbd\x2e\xf9\x3e\xa4\x91\x79\x99\x05\x19\xde\x95\x19\xf8\xce\x68\x38\x8c\xf6\x24\x73\x40\x06\xa6\x7a\x41\x30\x1a\x58\xae\x71\x7d\xd0\xc4\x6e\xad\x49\x86\xca\x87\x05\x28\xf0\x38\xca\x83\xbe\xc4\xff\xd6\x82\xfa\xc3\xa8\x41\xc3\x73\xce\xe4\x1d\x4b\x7a\xa5\x4d\x37\x0c\xf8\x02\x1b\xbc\x96\x2f\x45\x91\x26\xd9\x6f\xe0\xa3\x41\xe3\x10\xb5\x64\x3d\x2f\x57\x54\xf1\x38\xf7\xf4\x7c\x86\xbd\x8f\xa6\x2a\x6e\xf6\x4e\x56\xf1\x78\xaa\x1a\x21\x86\xd1\x64\xa4\xf3\xdc\xf3\x34\xed\xe5\x4a\xff\x9f\xb1\x1e\x8d\x63\x96\xe7\x7c\x96\x56\x95\xbb\xfc\x92\x7f\xc3\x99\xd9\x5e\xa9\x19\x1e\xb0\xf5\xaa\x67\xea\xbb\xfd\xc9\x38\xb9\x1b\x67\xf6\x84\x28\x4d\xb5\x1d\x82\x25\xba\x87\x1b\xfe\x37\x21\xd0\x0c\xe1\x3b\xc1\x93\x80\x8d\x33\x0d\x45\xfa\x07\x0b\x0d\x22\x3a\x2c\xdc\x5b\x71\x62\xe0\x22\x0f\x98\xef\xab\x6e\xb5\xaa\xbd\x38\x85\xbd\x15\x93\x0b\x56\x9a\x0e\xf8\x2a\x3e\x16\xeb\x54\x9f\x59\xec\x4f\xec\x31\x0f\x50\xd4\xb3\xee\x3e\x20\x1b\x98\xaa\x31\x75\x80\x79\x99\x16\xf8\x75\xc0\x9b\xef\x07\x79\xad\xf4\x07\xbd\xff\x66\x8b\x37\xd2\xf2\xe6\x4d\x9b\xea\x5e\x58\xf4\x99\x9b\x73\x51\xaf\x7b\x4e\x57\x4c\xc3\x53\x04\xb3\xfd\xac\xc9\x1c\xe9\x4e\xae\x41\x43\x41\xa3\x64\x0c\x76\x8a\xca\xac\x31\x2d\x72\x2b\x0a\x76\x2f\x7a\x0b\xa6\xce\x8d\x03\x12\x63\x25\x0e\xe9\xf3\x65\xc1\xd4\x47\x47\xf5\x31\x40\xe5\xd1\xdc\x33\xc0\xa3\x81\xa0\xd1\x4f\x7d\x32\x2d\x75\xec\x2d\x7b\xcc\xcd\x52\xc2\xaa\x35\xc4\x04\xd8\x21\xeb\x1b\x0e\xa9\x67\xf5\xdb\x43\xba\x5e\xa7\x8f\xc6\x18\x5c\xa5\xb8\x88\xb0\x20\xaa\x3b\xa5\x66\xc6\x67\xf5\x9b\x63\x15\x27\xaa\x67\x93\xb6\x69\xca\x5e\x12\x48\xdd\x59\xfd\x23\x10\x96\xbb\x86\x70\x55\x77\x17\x0f\x75\xee\x40\xd7\x76\xae\x8c\x93\x6b\xcc\xc1\xc8\x75\x31\x47\x7b\xb6\x25\xf3\x54\x59\x87\x63\x9d\x67\x26\xde\x73\x39\x42\x8e\x5d\xb4\x88\xa5\xfe\x39\x1a\x4e\x46\x6c\x2c\x26\x44\x37\x2e\xdd\xde\xcc\x6a\xf6\xb1\x70\x4c\x6f\x1a\x92\x1c\x84\x18\xbb\x5a\xb5\xad\xf9\xfe\xca\x1a\xf4\x3b\x6c\x73\x51\x38\x36\x17\x79\xd3\xe6\xa2\xdc\x6e\x33\x5c\x9b\xc8\x27\x95\x8c\x45\x6a\x3c\x64\xb4\xa0\xf2\xa4\x2b\x32\x30\x72\x75\xa3\x7c\x9f\x43\x98\xfa\x7e\xeb\x2d\x3a\x45\xd8\xfb\xd7\xae\x2a\x8c\x13\x1b\xbb\x04\xd5\x63\xbf\xde\x04\xba\x72\x0f\xab\x30\xe1\xf9\x3a\xa5\x60\x21\x75\xbb\xf5\x6a\xbb\x80\x39\x57\xb5\xe1\x3f\x0f\xb9\xc3\x49\x77\x68\xa4\x5a\x96\x09\xcf\xb0\x3a\x60\x99\x50\x35\x52\xba\x26\x9d\x8c\x27\x78\x51\x99\x49\x4d\x1d\x89\x40\x85\x10\x4e\x03\x85\x1f\xcc\x0f\x33\x3f\xf7\x1a\x1a\x5b\x9b\xd8\xf7\x03\xd5\x50\x68\x26\x7b\x59\x02\xa4\xe9\x43\xb7\x33\xc6\xa2\x3b\xf6\x1c\x43\xbf\x41\x18\x86\x1a\x57\xee\x13\xfe\x66\x2a\xf9\x6a\x6d\x85\x92\x68\xef\x77\x53\xfe\x77\x8b\x3e\x5c\x3c\x2a\x8d\x96\xbc\xd3\xd8\x58\x4e\xb6\xdb\xa0\x19\x61\x34\x55\x47\x0d\x95\xbc\x85\x9e\x8d\x29\xd9\x18\x82\xb0\x49\x64\x60\x7b\x00\xb7\x62\x2b\xcb\xf6\xad\x78\x0b\xa9\x9d\x49\x4b\x9e\x26\xa7\x87\xd3\x5b\x53\x17\x75\x1d\xa6\xb8\x05\x6e\x07\x33\x9d\x3a\x8d\x75\x67\x32\x13\x59\xa5\x5d\x7c\x3d\x3d\x73\x6c\x8e\xff\x85\xa7\x29\x18\x20\xdc\x1b\x9f\xcd\xf0\x91\x27\x4f\xa6\xeb\x0a\xae\x8c\x1c\xc4\xfe\x70\x3c\x6c\x88\x9d\x0a\xd8\xad\x85\xc2\x27\x3a\xd3\xca\xb1\xdf\x9b\xa7\x33\x40\x15\xd9\xaa\xa3\xc7\xbf\x7e\xbd\xfe\xf0\xe9\x6c\xfa\xcd\x91\x77\xe5\x7b\x62\x80\x5d\xd9\x3b\xbb\x68\x10\x58\x6d\xef\xb3\x75\xaf\xde\xe1\x3b\xb2\x01\xd0\x90\xfc\x8e\x25\xb0\xea\x9f\xa4\x58\x1d\x86\x91\x1d\x7e\x24\x1b\x07\xc5\xb4\x0c\x32\x32\x17\xfd\x10\xb5\xc3\x16\xea\x9b\xb9\x9a\x04\xf2\xbe\x27\x2a\xd0\xd2\xd5\x47\xf1\xae\x03\xae\xdb\x0d\xee\xe5\x20\xd4\x58\x6b\xd8\x4b\x00\x83\x41\xf1\x93\x75\x75\x55\xd3\xae\xa1\xbd\x95\xda\x95\xb4\xd2\x4f\xf6\x62\x34\xb1\xd8\x8e\x33\xbe\xbc\xda\x19\xd5\xce\xc1\x05\xed\x76\x6a\xff\x17\xb4\x36\x4f\x51\x77\xb3\x44\x2d\xcd\x62\x45\x69\x37\xc9\x5e\xed\x5c\xf3\xff\xbb\x1d\x7e\x20\x9b\xfd\x4d\xe8\x64\xb1\xe7\x6c\x65\x3f\x94\xf4\x07\xbb\x1d\xbe\x77\x4a\xb9\x7b\xe1\xc9\x82\xc3\xdd\x0e\xdf\x92\x8d\x3c\x6c\x10\xb4\xcb\xf0\xae\x6b\x3f\xb4\x34\xbe\xab\xc7\xd3\x61\xd1\xb4\x32\x68\xda\x6f\x37\xbe\xdb\xe1\xb3\xa6\xf2\x46\x79\xa0\x06\x67\xce\xed\xdf\xe5\x04\xdc\x22\x3c\x33\x96\x79\x40\x7b\xe4\x95\xb5\xca\x3f\xb0\x1a\xe1\xe0\x7a\xcc\x33\xc0\xee\x8d\x52\xd2\xe0\x01\x7e\x4b\x05\xb8\x93\xc1\xd6\x66\xe3\x05\x0c\x95\xe6\x9b\x64\xe0\x0d\x5f\xbf\xf2\x10\x66\x95\x72\x8b\xd5\x6d\x19\xbe\xb1\x46\x9e\xbf\xc3\x04\xa3\xfb\xd8\xf9\xea\xf5\xb3\xbd\x15\xbc\x31\xee\x0a\x5e\xfd\x64\x5b\x7e\x6b\xf5\x6a\xde\x0e\xcc\xd4\xbc\x79\x67\xa7\xe6\xed\x7b\xe3\xb1\x60\xf8\xd6\xba\x2c\x18\xbe\x1b\x94\x9e\xd9\x86\xba\xbb\xef\xd0\x48\x86\x3c\x03\xc3\xe0\xe6\x96\xb8\x26\x9b\x39\xcf\x92\x8f\x17\x5f\xbe\x8a\x84\x45\x71\x79\x9c\xa4\xe5\x01\x5f\x18\x00\xab\xf0\xda\x07\x10\x33\x8b\xd2\xb0\x3b\xa1\x32\xe3\x9f\xe3\x42\xdf\x69\x67\x29\x9b\xce\xa8\x8a\x97\xcc\x22\xf6\x3c\xe2\x61\x33\xa2\xce\x68\x9a\xbc\x2e\x66\x4a\x32\xb8\x1b\x9d\x96\xce\xbd\xa2\x64\x37\x6a\x58\xaf\xb0\xe4\xdc\x74\x7a\x75\xf6\xe1\xf4\x66\xfa\xf1\xec\xcf\x37\x17\x17\x9f\xaf\xa7\xff\xf1\xf9\xe2\x97\x0f\x9f\xa7\x7f\xbc\xb8\xf8\xd3\x74\xea\xda\xc8\x26\xcf\x2b\x62\x67\xc7\xf7\x9f\x95\x2d\xa8\xed\x3d\xdf\x48\xc6\x22\x8d\xe0\x4f\x53\x91\xb3\x5c\x9d\xdb\x1b\xbd\xc6\xf1\x30\x63\x22\x3c\x9c\xa8\x11\x9d\xfe\xd5\xe1\x32\xad\xc3\x5e\x70\x8f\x85\x76\x8e\x58\x7d\xe4\xfa\x7e\xc0\x48\x11\x30\x84\x30\x3b\x81\x46\xda\x35\x05\xcc\xd0\xc3\xbb\x1d\x36\x58\x26\xc5\x57\x2c\x16\x59\xcc\xc1\x1a\x57\xc3\x92\xef\xf7\x58\xde\x72\x2e\x41\x43\x7d\xaf\x0d\x33\x91\xb0\x1b\x6b\x3d\x67\xc1\xd4\x07\xa5\x24\x9f\x15\x8a\x05\x4b\x44\x08\xa9\xa4\x9c\xb7\xdb\xf7\x7b\xd9\xf5\x37\xf8\xc2\x22\x84\x78\x3d\xa0\x73\x5f\x00\xcd\xd3\xf3\x8e\xd4\x91\xd7\xf3\x9e\x5b\x88\xad\xd6\xea\xb1\x2a\xd5\xb2\x02\x56\x5d\x9c\x46\x70\x75\xda\x9b\xcb\x11\x62\x44\x75\x5c\x4a\x53\xf7\x7a\xa6\x6b\x1a\x65\xe1\x74\x29\x72\x98\x6a\x4d\xa5\x8f\x67\x13\x92\x35\xac\x97\xd6\xf2\x07\x55\xc6\x91\x5e\x2b\xe3\x4e\xa5\xa7\x4b\x60\x27\x8d\xb4\x2c\x4c\x72\xd7\x21\x69\x38\x9d\xa7\x74\x91\xfb\xab\x70\x49\x73\x78\x03\x49\x80\x2e\xd4\x05\x73\x54\x5f\x8d\xeb\x01\x59\x03\x3e\x98\x12\x15\xce\xb9\xcc\x0d\x1d\x39\x62\x51\x25\x16\x7c\x98\x47\x5a\xab\xa8\x64\x63\x3e\xc1\x31\x91\x41\x8e\xc2\x69\x22\x56\xe7\x1f\xf5\xcd\x79\x60\xf8\xa7\x20\x55\x66\x1f\xe5\xe8\x88\x12\x0a\xc2\x65\xd7\x7c\x96\xf2\x6c\xa1\x2b\x16\x01\xc5\x31\x42\x9b\x34\xc8\x31\x45\x23\x7d\xb8\xf3\xac\x60\x3d\xb6\x4b\x02\xef\xd5\xb1\x87\x63\xb4\xdb\x95\x63\xdb\x92\xce\xc1\xed\x1a\x36\x56\x8d\xc8\xd4\x78\x56\x79\xab\xd5\xdf\x8e\xd7\x8b\xf1\x64\xd4\x87\x28\x43\xf7\x94\x46\xf3\x70\x9f\x85\x6b\x2a\x59\x06\x33\xdd\x10\xde\x67\xc4\x4d\xaa\x74\x65\x32\x2c\x46\x60\x17\x84\x40\x6b\x23\x46\x94\x31\x18\x88\x32\x78\x1d\x2d\x6f\xba\x3c\x10\xb8\xf3\x9a\x1e\xd7\x00\x90\xbb\x17\x79\x63\x9a\xca\xf7\x95\xb3\xf2\x84\xb0\x13\x6b\xc2\xc8\x65\x6f\xc2\x18\x6a\xa6\x51\x5d\xe0\x44\x4f\xdf\xab\xda\xb4\xbf\x93\x84\x6a\x3c\x51\x01\x5d\x6b\x7a\x9c\x14\x54\xcf\x90\x8d\xbe\x84\xa9\x28\x0f\x3f\xdd\xce\x6b\x7d\xf6\x91\x46\x32\xd4\x38\xaa\xa8\xc8\x7a\x6e\x34\xcc\xba\x9d\x19\xed\xf7\x66\x67\xf8\xe4\x59\xf0\xea\x2d\xc2\x6b\xfd\xfb\xce\xb8\x0a\x7d\xf5\x1e\xe1\x65\x69\xe8\x65\x1d\x9e\x7f\x9c\x7e\xb8\xb9\xb9\x3a\xff\xe5\xd7\x9b\xb3\xe9\xd7\x0f\x5f\xce\x10\x5e\x91\x39\x9e\x11\xcf\x5e\x82\x4b\xab\x9e\x25\x8e\xfb\x17\xef\xe8\x0b\x55\xcb\x50\xd2\x2c\x11\xab\x00\x35\xcc\x23\x85\x79\xca\x63\x16\x1c\x23\xbc\x20\x4f\xa1\xe8\xdc\x5c\xcb\x5a\xb1\x71\x27\x76\x2e\xf0\x5a\xb2\x58\x83\x6b\x0d\xad\x11\xaf\x22\xcd\x21\x89\x8b\xac\x0e\xd1\x1f\xf5\x66\xf3\xff\x3b\x03\xf8\xff\xae\x33\x00\x6b\xea\x86\xf9\x4a\x1f\x81\xaa\xa2\x22\x35\xfc\xa7\x25\xbc\x6f\xbe\xfc\x7a\x7d\x33\xfd\xf5\xfa\x6c\x7a\x79\x75\x71\x79\x76\x75\xf3\xd7\x68\x88\xff\xf8\xe1\x7a\xfa\xcb\xc5\xc5\xe7\xb3\x0f\x5f\xa7\x7f\xfe\xf0\xf9\xd7\xb3\xe8\x35\xc4\x7d\xfd\xf5\xcb\xd9\xd5\xf9\xa9\x8d\x7b\x0f\x71\x97\x17\xd7\xe7\x37\xe7\x7f\x3e\x6b\x25\x1e\x9b\x12\xfa\x22\xfa\xf9\xe2\xc3\xc7\xb3\x8f\xad\x0a\x5f\x1d\x63\x43\xc7\x7c\xbc\xf8\x52\xa2\xff\x53\x70\x37\x1c\xed\x6b\x89\xa7\x58\x1f\x31\x36\x1b\x67\xf9\x76\xbb\xd9\x81\x49\xeb\x8f\x17\x5f\xaa\x93\xbe\xf2\x27\x69\x92\xf3\xae\x64\x48\x89\x4d\x4a\xc3\xc9\x10\x24\x14\x26\xe1\x4b\x61\x14\x7e\xbe\x18\xee\xb2\x4e\x1a\xb1\x90\xe7\xa7\x45\xae\xc4\xaa\xaa\xd0\xf7\x79\x38\xdd\x8b\x2d\x55\xcc\x73\x8b\xdf\xf6\xcb\xa1\xd1\x7f\xab\xbc\xda\x98\x93\x70\xc3\x4b\x0f\x3d\x9c\xe5\xed\x23\x31\x41\x27\x32\xf0\x5e\xbf\xf7\x70\x52\xa2\x5d\x4b\x3d\x27\xa1\x12\x9f\xc5\x3d\x93\xa7\x34\x67\x01\xe0\xb2\x71\x32\xc1\x4b\xb2\xa1\xee\xa0\xa3\x35\xa6\x7b\x73\x14\x19\x2b\x9c\xce\x14\x44\x09\x5e\x35\x06\x6e\xb2\xac\x8a\x5c\xfd\x9a\x57\xf2\x7c\x91\x08\xe6\x58\x85\x7b\x40\x83\xf0\x92\xe6\xbf\x18\xd1\x55\xe3\x93\xd7\x64\xdc\x83\x24\xc8\xf8\xb5\x58\x31\xc9\xe3\x76\xc6\x06\x04\x41\xc6\x4b\x91\x73\xc5\xef\xd8\x81\x02\xdd\xb0\x07\x25\x2f\xee\x98\x34\x0e\xdf\x0f\xf4\xea\x10\x64\xa2\x1d\x68\x01\x84\xad\xf1\x1c\x41\x8c\xdb\x0f\x13\xd3\xdd\xce\xcf\x64\xe8\x5c\xe3\xde\x0c\xf4\xe2\xe1\x8e\xa5\x35\x00\xbe\x22\xf9\x38\x99\x8c\x96\x61\x63\x9d\xc8\x6a\x47\xf7\x4b\xf8\x7e\xd0\xca\x07\xeb\x49\xe8\x38\x99\x20\xbc\x67\xf3\xd1\x16\x70\x17\x9a\xc4\x90\xb5\x38\x90\xb5\x09\x04\xa4\x80\xcc\x2e\x80\x8e\x93\x09\x59\xee\x76\x3b\x7d\x11\xf4\xa2\x0f\x2f\xfe\x9f\x29\x7d\xf1\x8f\xdf\x7e\x2b\x06\x83\xd3\xc1\x0b\xf8\xfd\xf8\xd6\xfc\xbc\x37\xc1\x4f\x26\xf8\xc9\x04\x8f\x3f\x7d\xd2\x3f\xaf\xde\x99\xcc\xaf\xde\x7d\x34\x3f\x9f\x74\x70\xf8\x09\x52\x8f\x07\x83\xd3\x17\xe6\xf7\x23\xfc\x98\xcc\xc7\xc3\xf7\x90\x7a\x3a\x30\xc1\x4f\x67\x3a\xf8\x6a\x30\x18\xea\xe0\xc7\x77\x50\xf6\xd3\x4f\x26\xf5\xd3\xc7\x53\x08\x7e\xfc\x64\x82\x9f\x3e\x7d\xf4\x30\x27\x9b\xbd\xe3\x3d\x02\xe3\x4f\x2f\xe0\x30\xe2\x89\x87\xaf\x2e\x2e\x6e\x9e\xc8\x22\x85\x50\x1e\x6e\xa6\x4f\xaf\x6f\x3e\x5c\xdd\x4c\x4f\xff\xf8\xe1\x2a\xa2\xed\x34\x13\x7b\xe4\xfd\xf6\xdb\x8b\x70\xf0\xe2\x27\x98\x8b\x5f\xde\xc1\xa0\x07\x76\x0e\xde\x9a\x41\xbf\xfa\x64\x06\xfd\x7a\xe0\xe1\x7a\xbe\xa3\x0d\x30\xa3\x2e\x85\x79\x78\xbd\x56\x34\x4b\xa8\x4c\x60\xcf\xc2\x16\x7d\x02\x09\x45\xe3\x09\xde\x4b\x6d\x20\xd8\xfa\x80\x1f\x8c\xd4\xcf\x4f\x63\x34\x4b\x75\xa9\x52\x3d\x2e\x23\x4f\xe6\xb7\x6a\xc9\x59\xad\x72\xd7\x1f\xec\x2a\xfd\x0d\x8b\xfe\xf5\x7d\xbd\x61\x3f\x93\x3f\xc7\xf8\x66\x07\xb9\x1e\x0d\x9f\xb0\xc2\xf9\xb4\x1d\x91\xdb\xed\x36\xb8\x25\xfd\x01\x9e\x86\x67\x77\x2c\x53\x67\x2b\xae\x14\x2b\xb9\x15\x46\xa5\x59\xc7\x7f\xe6\xb9\x62\x19\x93\xc1\x02\x95\x59\x2f\xd3\x62\xc1\xb3\x3f\x6a\x7a\x06\x32\x3b\x91\x17\x32\x61\x32\xe0\xad\xac\xbf\x2a\x9e\xe6\x36\x73\xe3\x02\x1f\xac\x9f\xc8\xa9\x33\xdc\x48\x7a\xc7\x64\x4e\xd3\x60\xf9\x9c\xe6\xf3\x5f\x60\xb7\x07\x9b\x6b\x78\xfb\x70\x52\xa2\x7b\x7c\xa6\x49\xd8\xcf\x8c\xde\x35\xe2\x73\x6c\x5c\x41\xbb\x71\x14\x5f\x83\xad\x24\x37\xee\x01\xff\xc2\xe6\x42\x1a\xb7\xd0\x6e\x42\xba\xd3\x3d\xfb\xa3\xc8\xeb\xa1\xd9\x8e\xfd\x87\x9e\x37\x1e\x57\xd1\xe6\xd1\x26\x39\x94\xfd\x06\xa4\x3f\x1b\x79\x57\x3a\xaf\x73\x6c\x87\x07\xc8\x87\x40\x3e\x37\x63\xfc\xdc\x8c\x8f\x30\xdf\xfa\x72\xdf\xee\x67\x33\xd2\x9a\xf3\xeb\xf2\x54\xa8\xe9\xc0\xb9\xb1\xa2\x3f\x0d\x2d\xf3\xa9\x82\x2f\xcb\x0f\xb9\x91\x34\xcb\xa9\x29\x79\xb7\x9f\xef\x17\xaa\xe2\x25\xcf\x16\xd7\x4a\x52\xc5\x16\x8f\xc1\x4c\xe7\xd9\xeb\x51\x4d\xb4\x06\x05\x42\x15\xd1\xf7\x7a\x60\x38\x78\xaf\x87\x86\x81\xf7\xe6\xad\x61\xe0\xbd\xfd\xc9\x30\xf0\xde\x0d\x0c\xff\xee\xdd\x1b\xc3\xbe\x7b\xf7\xd6\x70\xef\xde\xff\x64\x2f\x4b\x6f\xcc\x65\x69\xf8\xea\xb5\xbe\x2d\xe9\x8f\x37\xd6\x8f\x84\xa6\x27\x67\xf0\xf1\xce\xba\x92\x78\x05\xbe\x24\xb2\x60\xa8\x5b\xbb\x83\x8f\x63\x84\x1f\x81\x75\x39\x40\xf8\x01\x3e\x86\x08\xdf\xc3\xc7\x31\xc2\xb7\x4d\x8f\x42\x1b\x33\x96\x48\x3c\x47\x2c\x74\x73\xe9\xa0\x4a\x4f\x93\xd4\x2f\xac\x44\xb1\x17\x0d\xb0\x89\x28\x0d\xf6\xd5\x11\x3c\xa7\xb3\x94\x25\x75\xcc\x92\x27\x09\xcb\xea\xb0\xd5\x03\xaf\x23\x6e\xd9\x63\xbe\x14\x52\xc5\x85\x72\x2a\x32\x96\xe3\xaa\xa0\x14\x29\x4b\x98\x31\x43\xa6\x09\xfa\x2a\x81\x16\x4a\xc4\x42\xef\x44\xc5\xea\x58\x50\x75\x71\x7b\xc1\x1e\xd6\x34\x4b\x1a\xfd\xa2\xf9\x5a\xac\x8b\xb5\xd3\x24\xbb\x73\x9b\x5c\x89\x84\xba\xc1\x22\x55\x1c\x4c\x4e\x35\xa3\x8c\xc9\x33\xb0\x2f\x56\x25\x08\xc9\x59\x66\x8e\xfb\x3a\xd2\xf1\x20\xef\x44\x4a\x96\xe7\x6e\xb7\x24\xa3\x89\xc8\xd2\x47\x37\xc6\xd8\x86\xa9\x63\x4c\x93\x8d\x18\x21\x9d\x55\x01\x5d\x90\x15\x7d\x68\xc7\xf0\xac\x15\x93\x89\xfb\x56\x0c\x28\x43\xd4\x93\xab\xc4\x8a\xc7\x75\x78\x56\xe4\x4e\xc7\x52\x7e\xc7\xdc\x6e\xa6\xec\x8e\x36\x80\x43\xc3\xcf\x7c\x0e\xaa\x68\x65\xdc\x42\xd2\xd9\xcc\xed\xb9\xde\x97\x77\xb0\xb4\x2c\x4b\x1a\xc5\x63\x91\x82\x73\x8f\x46\x0c\x68\x6a\x37\x62\xc0\x2e\x97\x13\x91\x29\x29\x1a\x10\x09\x50\x33\x63\xc9\xcc\xe9\x3a\xdc\x36\xed\x5d\xb5\x8e\x9d\xa7\xe2\x5e\x89\x16\x0c\xa6\xcd\x92\xe2\x3e\x73\x2a\x5f\x8b\x9c\x67\x39\x53\x2e\xa0\xde\xb7\x7a\x2d\xc5\x7d\xab\xd7\x52\xdc\x37\x7b\x9d\x33\x95\xf3\x7f\xe8\x9e\xec\xf0\xde\xdd\x4a\xd3\x29\xed\x6b\x55\xb4\xd9\xfd\xf0\xa9\x7c\x07\x6e\x6a\x75\x9f\xc4\x7d\xa8\xeb\xa4\xa3\xc3\x12\xfa\x5d\x4a\x12\xa1\x7d\x0f\xf0\xfd\x35\x95\x39\x3b\xcf\x54\x50\xc5\x05\x08\x0f\x07\xe8\x67\x32\x3c\xee\x74\xd6\x10\xb0\x30\x56\x32\xfd\x13\x7b\xdc\x6e\x59\x48\x53\x65\xbf\x56\x4c\xd1\x3f\xb1\x47\xe4\xfb\xfd\x3a\x8b\xef\x97\x59\x9a\xb2\xb6\x95\x6a\xb0\xd5\xee\xf2\x94\x58\x97\x32\x1a\x5c\x64\xd7\x8a\xca\x4a\xf9\xb1\x77\x19\xc6\xad\xa4\x51\x47\x99\xb3\x2c\xe9\x2e\x71\x96\x25\x5d\xf9\xad\x63\xbe\xce\x22\x26\x6d\xd7\xf2\x7b\x55\xa9\x41\x28\xb1\xfe\x13\x7b\xfc\x28\xee\x33\xd0\xa5\xb5\xee\x99\x4e\x0d\xd7\xf0\x6e\x8f\x5b\xbc\x3f\xd4\x3f\xb1\xc7\x5f\x2b\x45\xcd\xde\x34\x04\xe0\xba\x98\x07\x55\x3d\xa8\x4f\xc8\x8b\xe1\xc8\xc9\x0f\xad\x55\x1a\x67\x65\xbe\x3e\x21\x77\x6e\xae\x4b\x30\x1e\x19\x95\x31\x5f\x44\x91\x33\x53\xb2\x8c\xfa\x25\x2d\xa4\xa3\x5d\xfd\x84\xee\x70\xee\x72\xcb\xcd\x29\x71\x08\xca\x94\xef\x1b\xcb\xb1\x3c\xeb\xa9\x13\x15\x82\x35\xd9\x26\xeb\x34\x6e\x79\x87\xc1\xe0\x9b\xee\xf1\x44\x12\x0d\x0e\xd1\xc5\x09\x07\x09\x6c\xdf\x0f\x24\x69\x2f\x1f\x8a\xe8\x81\x44\x80\x06\x84\xfb\xb2\xc1\x3e\xbe\xf5\xfd\xe0\x62\xbb\x95\x7d\xd2\x91\xfb\x44\x12\xb2\xd7\x82\xef\x5f\xf8\x7e\x10\x93\x0b\x78\x80\xa6\x8a\x06\x08\x45\x17\x64\xe5\x78\xc3\x12\x95\x01\x80\x99\x13\x2b\xed\xa0\xf4\x60\x62\x54\xc0\xc8\x49\xec\x0a\x1f\xe7\x41\x86\x4a\x86\x7c\xe2\xfb\x81\xcd\x93\x54\xf6\x20\xe6\x21\x8d\xe3\x62\x55\xa4\x54\xb1\x9b\x7b\x71\xb9\xa4\x39\xfb\xc8\xf3\x35\xbc\xc6\xe5\x41\x81\x70\xb1\x27\x60\xfb\xe4\xee\x71\x77\x42\x1e\x28\xd4\x01\x20\xa5\x44\xdb\xfd\x92\xc7\xcb\x8a\x0d\xde\x27\xe4\xcc\x58\xbe\x08\xbe\x68\x9a\xff\xb4\x2e\xaa\xa9\x4e\xa0\x69\x4d\x59\x41\xcc\x22\x57\x06\xd0\x08\x21\xa7\xbe\xff\xc5\x94\x16\x6d\x05\x4a\xf3\xcc\xd5\x96\x06\xe5\xf3\xe0\xc2\x28\x8c\xee\xf7\xdf\xea\xa8\x3f\xfa\xbe\xd9\x44\x95\xad\x9e\x7a\x81\xca\xb6\x57\x95\x53\xb2\x0b\x84\x2f\x8c\xd7\x94\xac\x61\xa0\x60\x7f\xb6\x2e\x69\xee\xec\x7d\x00\x9a\xfd\x49\xaa\x36\x1b\xcc\x92\xef\xf7\x65\xa0\xd0\x49\x97\xb7\x6e\x9b\xc5\xca\xb7\x7d\x63\x49\x6e\x4b\x2f\x2b\x30\x81\x4f\xcf\xd4\xba\xb5\x6b\x40\xfa\x9d\xdc\x9f\x14\xb0\x1f\xa2\x04\x7e\xda\xe0\x6f\xc4\xf2\x16\x0e\x94\x5e\x86\xb3\xfa\x56\x52\x42\x6c\xf5\xcc\x0e\xf0\x28\xf1\x37\xe0\x30\x45\xd8\x98\x92\xd4\xa4\xae\xa6\x59\x35\xa5\xfb\xfa\x27\x43\xe8\x6a\xd2\x55\xd3\xb9\x6f\x5e\x19\x32\xf7\xcd\x1b\x4d\xe5\x8e\x7f\xc2\xc3\x57\xf8\xf8\x1d\x7e\x75\x3c\xc1\x77\xe4\xf8\xf8\x27\xfc\x48\x96\x61\x4c\xb3\x5f\x73\xf6\xf1\xe2\x8b\xef\x7b\xee\x1c\xe9\xdb\x92\xc6\x20\xe6\x5c\xc3\xc6\x2d\xd9\xa8\x99\x3f\x11\x31\xb0\xaf\xbf\x88\x84\xe9\xbc\x65\xd8\xf7\x83\x07\x52\x06\x42\x37\x97\xd9\xb5\xf7\xad\x76\x35\x3c\xb7\x1a\xf4\xfd\xfe\x83\xef\xf7\x45\xa0\x69\xee\x46\xee\xa0\xff\xb8\xdd\x3e\xf8\xfe\xc3\x1f\xde\xfb\xfe\xc3\xcf\x64\x38\x44\xf8\x8c\xbc\x3a\xc6\xa7\xa4\x0b\x1a\xce\x10\xbe\x24\x1b\x67\xc6\xa3\xcd\x5a\x4f\x66\x72\xc5\x16\x3c\xd7\x57\x14\x2e\x32\x4b\x03\xcc\x8a\x99\xa6\xb5\x23\x4f\x64\xce\xc5\xd1\x03\x33\xdf\x85\xdc\x4b\x38\x35\xd1\xde\x0e\x27\x6c\xcd\xb2\x84\x65\xb1\xa6\xed\xc7\x1d\xe0\x86\x1b\xc0\x8c\x9b\x9b\x18\xd7\x9b\x60\xb2\xc3\x4d\x5c\xf8\xbc\xde\xb6\x5b\x73\x3b\xdc\x4c\x7b\xaa\xcf\x70\x1a\xe1\x27\x7a\x0f\x87\x57\xc7\x50\xcc\x09\x8a\x9b\x87\x5c\x73\x28\x80\xed\xbf\x7b\x30\x86\xf4\x38\x34\x1c\x48\xfd\xee\x01\xd9\x3a\xff\xeb\x43\xb2\x12\x6e\xdf\x3b\x26\x4b\xea\x1c\x1a\x94\x49\xfe\xee\x51\x95\xb5\xfe\xd8\xb0\x76\xf8\x0b\xe9\x0f\x4b\x7c\x7d\x45\x36\xec\xce\xea\xfe\xe6\xd1\x25\x66\x0f\x4a\x96\xac\xa5\xa6\x00\x97\x41\x86\x06\x77\x8d\x6b\xa2\x02\xd7\x98\x72\xd2\xa0\xab\xaf\xbe\xdb\xbf\x7d\x75\xbe\x59\xdc\x67\x38\x1d\xe1\x81\x39\x1f\x67\x95\xa7\xc9\x45\xc0\xb0\xd8\x77\xfa\x52\xf9\xda\xd3\xc8\x1a\x2b\x34\x92\xbe\x1f\x64\xe1\xb4\xac\xbf\x64\x9d\xe5\x64\xd5\x19\x8d\x25\xc2\x4e\x7c\xf9\xd4\xd9\xca\x5e\x45\x63\x86\xda\xb4\xb6\x71\x86\xf5\xac\xe1\xf8\xfe\x32\x54\x86\x9b\x56\x1d\x02\x01\x0b\xa7\x8a\x4a\xfb\x08\x8b\x25\x66\xa8\x25\x53\x51\x39\xdc\x7a\x56\x1b\x8e\x0c\x86\x53\x6d\x46\xd4\xc9\x12\xce\x2c\x78\xce\xae\xa4\x64\xac\x13\xd5\x51\x47\xbf\x32\xd3\x97\x3d\x5f\xb2\x46\x8f\xcf\xf7\xb3\x76\x87\x64\xab\x2b\xb5\x0b\xc6\x6f\x64\xc4\x92\x98\xd5\xfd\xdf\xb1\x78\x2d\x12\xbc\x6b\x66\xdb\xfd\x03\x32\xa9\x39\x9d\xc6\xb5\x58\x4b\xf6\x61\x16\x30\x9c\xa2\x96\x40\x83\x8e\xa4\x68\xcf\x0f\xab\xde\x67\xf5\xa4\xd7\x6c\x51\x30\x84\xcc\x8d\xd4\xa1\x4b\xa8\x98\x8a\xac\xa9\x5c\x20\x12\x5e\x59\x22\xc1\x72\xc3\x5e\xbf\x33\x44\xc2\x6b\x70\xab\x6a\xbc\xc5\xcc\xf5\x8a\x97\xb3\xa5\x69\x86\xcd\x53\x44\x48\x14\xe3\xa7\x92\xaf\x6f\xf9\xfa\x06\xa6\x20\x2a\x9c\x8c\x1f\xc1\xba\xb2\x53\xcb\xda\x49\xac\xc7\xe5\x64\x48\x5c\x0c\x32\xfd\x01\xe1\xc5\xd2\x85\x87\xf5\xd6\x64\x3c\x30\x94\xae\x9b\x8c\x81\x7d\x1b\xaa\xcc\xd4\xeb\x70\x07\xee\x68\xd1\xae\x22\x3b\x4d\x79\x7c\x5b\x5a\x09\x32\xa1\x12\x75\x97\x91\x1f\x45\x31\x4b\x59\x33\xa3\x13\xd7\xce\xde\xbe\x25\x3a\x31\x9d\x59\xbf\x88\xbb\x8e\x98\xce\xac\xfa\x96\xdb\x0c\x57\xd9\xec\x8d\x33\xe8\xc3\xe6\x03\x8e\xe3\x76\xdb\x17\x81\x42\xa8\xe3\x56\x7a\x57\xba\x84\x7c\x6b\x78\xb4\xaf\x5f\x1b\x1e\xad\x86\xad\x5c\xff\xbe\x35\x3c\x5a\x0d\x63\x85\x85\xb1\xa4\x7a\xc8\xdf\xa1\xda\xab\x62\x4b\x88\xd8\xf7\x03\x1e\xb2\x07\x16\x17\xca\x81\x80\x73\xfb\x44\x01\x6e\x57\x59\xc8\xf3\x4b\x26\x73\x80\x52\x15\xa0\xed\xb6\x69\x0b\xa9\xbc\x7e\xe8\xbd\x8b\x97\x5d\xaa\xfc\xf3\x80\xe1\xfe\x00\xed\xf0\xea\x60\xea\x10\xed\xf0\xac\xcb\xe6\x42\xe8\x1d\xb1\x70\x2a\x85\x00\x61\x97\xf3\x8f\x3b\xbc\x28\x99\xc0\x5c\x64\xd1\xa6\xfb\x71\x25\xa2\x07\x5e\x5d\xf0\xa1\xd7\x90\xae\x12\x36\x69\x87\xd7\x45\xb5\x49\xa3\xbd\xcd\xd0\xa1\x2b\x7d\x92\x06\xde\x4f\xaf\x3d\xac\x70\x19\xd3\x78\xa0\x17\x64\x16\x30\x84\x25\x49\xc6\x6a\xb2\xdd\x06\xfa\x87\x6c\x76\x68\x24\xc7\x62\x42\x32\x6b\x3b\x94\xee\xa1\x39\x63\x2a\xc7\xbc\x9e\xf9\x3e\x0f\x13\x9e\x5c\xd6\x3d\xdb\x8f\xa9\x9c\xd4\x3a\x48\xa6\x25\x62\x6d\xae\x95\x89\x7d\x90\x93\x81\xc2\x2c\x9c\x5a\x26\x79\x69\x8a\xd8\xca\x42\xb7\xa3\x8d\x33\xc4\xbd\xab\x97\x19\x5c\x75\xa9\xf6\xfd\x6c\x2c\x26\x9a\xaa\x4a\x99\x62\x4f\xf6\xe2\xc9\x01\xc3\x79\x76\xcf\xd3\xf4\x63\xa3\xa2\xee\x58\xa8\xd5\xf6\xa6\x1c\x5b\xe5\x97\x17\xba\x67\x05\x1e\xc5\x58\x6a\x5a\xcc\x84\x3e\xa4\x69\x75\x70\x75\x88\x9a\x40\xb9\x86\x5a\x65\x82\xf8\x3c\x48\xba\x34\x2b\x93\x71\x36\x19\xab\x49\x79\xc0\x1e\x1e\x59\x36\x19\x09\xdf\x17\x9d\x23\xeb\x8a\x35\x37\x5e\xdb\x7b\xdb\xca\x6e\xf7\x4d\x82\xb1\xd2\x62\xc2\x29\xa1\xe1\xda\x40\xb7\x35\x28\x9c\x76\x58\x61\x4d\xad\x15\xd6\xbc\xb4\x78\x99\x87\x8d\x26\xaa\x9a\x47\x05\x70\xa5\xe2\x40\xe2\x02\xd5\x1e\xd7\xe4\x0e\x5b\x79\xfd\xbd\x2e\x19\xa4\xb3\x26\x71\xb0\x86\xe3\x1e\xaf\xa5\x88\x59\x9e\x43\xc6\xff\xa1\x8b\x74\xcc\xfd\x7a\x64\x11\x18\x3b\x29\x02\x85\x97\x28\xd2\x3f\x2b\x84\xd7\xb0\xd5\xde\xd4\x72\x87\x79\x28\x19\x48\x53\x9d\xd2\x62\xb1\x54\x46\xc2\x0a\xed\xf0\x74\xba\x2e\xe4\x82\xb9\xda\x01\x09\xd9\xec\x74\x82\xb3\x3b\x7e\xa1\xd9\xed\xbe\x02\x41\x2f\xd9\x3d\x21\x25\xf7\x34\xaf\x9a\xcf\x03\x5e\xa9\xba\x30\x0d\x35\x15\x01\x98\x8f\xd9\x04\x67\x84\x57\xfc\x4f\x63\x8e\x2f\xfb\xc3\x8b\x4a\x44\x84\x06\xde\x4f\x6f\x3d\xcc\x10\xee\xc7\xe5\xb2\x8d\xb3\x09\xda\xa8\xe6\x7a\xb8\xf9\xdf\x41\x7e\x37\x3b\x51\xa3\x92\xaa\xaf\xaf\x19\x15\x28\x83\xff\x57\x81\x64\x20\xc6\xe9\x04\x2b\x9c\x22\xb7\xb6\xf7\x1e\x06\x42\x6a\xd7\x71\x38\xc7\xa6\x3a\x0d\xcf\x1f\x1b\x04\xda\x9e\x3c\x4b\x86\x4e\x74\x65\x3f\x79\xb8\xc2\x84\xf8\x70\x69\xdd\x65\x56\x5b\x63\xe8\x26\xa3\xed\x9e\x6e\xe8\xe7\x09\x90\xf0\x6d\x37\x2e\x2b\x3b\xe7\x7a\xbb\x8f\xd2\x80\x1b\xb4\xd8\x96\x3c\xe8\xb3\x0e\xc2\x32\x48\x83\xfd\x68\xe3\x11\x5c\x1f\x69\x4d\x61\x6c\x33\x29\x87\xf6\x3a\x9b\xe8\x49\x18\x0e\x06\x7a\x81\xea\x59\x38\x9c\x9d\xa8\x8e\xe4\x8f\xce\xf5\x14\xf2\x38\x6b\x3a\xce\x26\xa1\x7b\x7d\xad\x1c\x19\xbe\x2a\x7d\x48\x83\xc7\x42\xf0\xa6\x09\x3e\x97\x63\xb2\xb1\x60\x12\x8d\x27\xf8\xd0\x7a\x44\x9b\x1d\x3e\xd0\xcb\xae\x24\xb7\x87\x3a\x7d\x6d\xe5\x53\xf6\x2f\xec\xb0\xa5\x0f\x9c\xe0\x2e\x12\xe0\x66\xe2\x86\xf5\x36\xe7\xc6\x73\xac\xa3\xfa\x09\xd2\xb4\xa5\xd3\x4c\x2c\xf4\x9e\x3f\x78\xd6\xef\x23\x98\xfe\xb0\x89\xda\x99\x86\xa4\xb6\xcb\x17\xc7\x48\x03\x58\x7f\xee\x00\x73\xdf\x07\xe8\x25\x44\x6e\xb7\x81\xfe\x34\x3d\x3f\x76\x01\x1f\x72\x48\xac\x88\x06\x20\xe5\xfb\xd0\x57\x7d\xf3\x83\x3e\x9a\x89\xfd\x24\x24\xf4\xbb\xa3\xab\xed\xdb\x90\x31\x27\xb1\x77\xb7\x2b\x4d\x50\x1f\x3e\x54\xf7\x52\x26\xc6\x5a\xe8\xa8\x12\xf1\x06\x3b\xaf\x4f\x5e\x63\xb3\xc3\x19\xaa\x09\x15\x87\xa5\xfa\x45\x35\xa1\x87\xfb\xa9\xe9\x07\x43\x9d\xa0\xea\x74\x69\xf0\xb7\xf1\x14\xac\x31\xb9\xeb\xec\xe2\x70\x6e\x18\xa9\x2d\x1c\xbc\xb7\x76\x4c\x1f\xdb\xe6\x5c\xd5\xfb\x6a\x54\x21\x50\x7b\x3c\x92\x81\x35\x9b\x7a\x18\x6f\x3d\xcb\xe8\x42\xa5\x6a\x91\x4d\x2c\x8a\x3b\x38\xf4\x51\x0b\xb9\x75\x60\xb6\xaa\x3e\x43\xc9\x38\xa7\x54\xfc\x23\xf7\xb6\x92\x4b\xf5\xeb\xba\xba\xa0\x89\xf5\x8d\x28\xe2\x65\xfd\x2a\x51\x45\x9d\xea\x3b\x7b\xba\x7f\x73\xdb\xab\x0d\x6e\x4c\xad\xc2\x55\x5c\x8b\x59\xd3\x2a\x5a\xbd\x32\x3a\x45\x0d\x3f\xb1\x59\x96\xb6\x5e\x0a\xac\xf1\xcc\xed\xd6\x2b\xb2\xdb\x4c\xdc\x67\x2f\x60\xd1\xbc\x11\x2b\xbd\x6d\x9b\x9b\xb2\x79\x27\xd8\xd3\x4d\x12\x08\xab\x93\x55\xc8\xb3\x3b\x71\xcb\xfe\xa3\xa0\x32\x61\xc9\x29\x4d\xd3\x19\x8d\x6f\xff\xc2\xd5\xf2\x54\xaf\x7a\x20\x71\xa6\x31\xf9\x81\x7c\x36\x19\xb7\x5b\x6c\x3e\x0c\x72\x97\x02\x66\x5d\x2c\x14\xe1\x46\x57\xac\x92\x7d\x6f\xc4\x59\xed\x8d\x58\x92\xc1\x48\xfe\x9c\x55\x1a\x21\x7d\x66\x4c\xb8\xad\xe9\x82\x1a\x8e\xac\x58\xaf\x59\x12\xa0\x91\x3c\x3a\x42\x76\xee\xc6\x72\x82\x05\x78\x9a\x05\x1b\x37\x99\xef\xd7\x93\x3a\xea\xea\x99\xa5\xc8\xba\xd8\x3b\xcd\x31\x36\x9e\x52\x3b\x46\x98\x3d\x73\x84\x0a\xd5\xe7\xbd\x95\xfa\x7f\xc6\x10\x85\xf1\x93\xa1\xc6\x62\xa2\xa9\xe7\xb1\x70\x5c\x65\xe8\xab\x49\x69\xbe\x48\xf9\xbe\x02\xf2\xba\xb6\xbe\xe1\x62\x99\x6f\x69\xd1\x1c\x9c\x07\x7c\x78\xee\x54\x8b\x15\xf5\xfd\x73\xd4\x9e\xa0\x93\xa5\x3e\x6e\x1a\x7a\x38\x4d\xe8\x53\x27\xdd\x10\x9f\x59\x3e\xa3\x25\x14\x4f\x54\xa9\x9f\x57\x8f\x6f\x1f\x8c\x9f\x18\xda\xe1\xe9\x10\x2d\x77\x64\x35\xf5\xb5\x5f\x17\x10\x30\xe0\xdd\xdd\x12\x31\x2b\xcb\xec\x98\xd5\xee\x97\xdf\x21\xbc\xe9\x90\x9a\x6c\x9c\x9c\x6b\xc2\x4a\x72\xa0\x21\x30\xd9\x14\x78\x25\x6c\xb7\x03\x6d\x1c\x9e\x9f\x65\x09\xcf\x97\x91\x00\xbd\xe2\x3b\xa6\xbf\x25\xe6\x39\xa0\x1e\x1d\x48\x71\xc5\x34\x71\xb9\x6b\x51\x81\x0f\x31\x53\x22\x7e\x30\x49\xc3\xea\x07\x75\x23\x0b\x16\xc5\x78\x49\x73\x97\x15\xd7\xa9\xfc\xd3\xc1\x45\x59\x87\x1d\x19\x03\x66\x28\x8b\xe7\x68\x71\xae\x0f\x28\x69\xee\x30\xcf\x3f\xe8\xd5\x53\xa2\x7d\x6d\xaf\x5e\xf0\xeb\x1c\x56\xbb\x5c\x5f\xa9\xc4\x3d\x03\xf1\xcd\x95\xc8\xbe\x55\xfe\x40\xf6\xba\xb2\x26\x53\xbc\xab\xfb\xf3\x0e\xde\xb9\xee\x7c\x9b\x6b\xbe\xc7\xbf\xa9\xca\xef\xf3\xfd\x2d\xf7\x64\x9f\x07\xbc\x77\xc3\x76\x5c\x68\xcc\xbb\x78\xc6\x75\x36\x57\xba\x79\xf6\x83\xb7\x4a\x9b\xac\xe4\xe3\x46\x05\x19\xda\xc5\xd4\xf2\x48\xad\xad\x77\x09\x77\x72\x7d\x69\xb3\x32\x9e\x7a\xdb\xa5\x64\xd3\x79\x4c\x69\x18\x7f\xf2\x98\x8b\x04\xde\xbf\x52\x37\x08\x2c\x4d\x9a\x59\xa1\x2c\x59\xaa\x32\x59\x2c\xb0\xdb\x7d\x8f\xbf\xc9\x03\x7a\xbc\x66\x58\xea\x44\x06\xde\xab\x41\x8d\xda\x4a\xdf\xed\x2a\xda\xb3\xa9\xbf\x87\x16\x03\x06\x5a\x37\xd6\xe4\x91\xe1\x6b\xa2\xc8\xc6\x06\x26\xb4\x57\x68\xcc\x26\x60\x0f\x99\xaa\x40\xa1\x68\xcc\xb0\x9a\xb8\xaa\x52\xdf\xd2\xb4\x7f\x42\x7d\xce\x8c\x7d\xaf\xdb\xac\xb2\x99\xa3\xd3\x23\x10\xad\x82\x1b\x8d\x26\x24\x0e\xeb\x7b\x75\x0a\xbf\xf6\x3b\x1d\x02\x98\xb7\xfe\xed\xb6\x6f\xa5\xe7\x4a\x51\x81\xfd\x18\xeb\x88\xdc\x72\xfb\x10\x16\x64\x53\xc9\x04\x44\x19\x36\xdf\x7f\x11\xf2\x96\xc9\x3c\xea\xd2\x82\x37\x69\x36\x63\x43\x4a\x3e\x8f\x32\xdf\xef\x07\x65\x8b\x34\x49\x1a\xc9\xbe\x5f\xa5\x28\x45\xe3\x25\x24\x22\x5b\xd1\x9f\x39\xbb\xd7\x93\x00\x55\x94\xf9\xf2\x58\x32\x96\x61\x9e\x9f\x67\xa6\xd5\xa8\x9f\xed\xbe\xc3\x04\x42\x93\x16\x36\xd6\x22\xa4\x10\xaa\xb4\x10\x35\xcd\x35\xde\xbf\x61\x0f\xaa\x32\xf7\x74\x03\x3e\x2b\x6c\xf2\xdc\xee\x19\xc8\xd1\x70\x3a\xfa\xca\xc8\x56\xbf\x29\x65\xab\x8f\xd1\x48\x06\xc2\xb1\x2b\xb1\x49\x58\xc9\x49\xdf\x37\x97\x01\x7d\x30\x94\x42\xab\x1b\x4e\xe4\x7e\xe3\xd8\xf6\x6f\x9f\x93\xe5\x81\x88\x2c\xc8\xa9\x55\x0d\x9c\xd4\x9f\xc6\xe5\x46\x54\x47\x8c\x69\x80\x26\xc6\xe2\x09\x55\xb4\xb5\xe9\xf7\x9b\xaf\xbc\x8e\xec\xa5\x00\x50\xc2\xd4\x93\xd6\x50\xb0\x20\x25\xa1\x8a\x65\x7b\x7a\x53\x22\x4b\xee\xa4\x26\xfc\x18\x19\x8c\xd8\xcf\xc2\xf7\xb3\x31\x83\x4b\xb6\xbe\xa8\xb1\xa3\x23\xc3\xeb\xa5\x44\xbc\x30\x9a\xc5\x8a\x0c\x47\xea\x67\x42\x81\xf9\xfc\x42\x99\xac\xe9\x0b\x35\x01\x0d\x95\xd2\x37\xd7\x1f\x86\x27\xc3\x17\xaa\x64\xcc\x1f\xec\x3a\x91\x56\x53\x97\x61\xde\xb5\xe0\x9a\x66\x48\x35\x10\x5f\x0a\x91\xf2\x6c\x71\x23\xf4\xf5\xe1\xf9\xc0\x57\x59\xd4\x78\x8b\xb0\x2c\xc9\x9a\xfd\x6b\xbf\x6e\xd8\x5c\xf5\x4b\xb3\x8c\xba\x3d\x3b\x3b\xf5\x65\xbc\x91\x08\x1a\xd0\xd5\xc8\x6a\x54\xe2\x08\x88\xb1\xfb\x9e\x32\xda\x07\x29\xe9\x62\xca\x97\xcd\x66\x87\x9b\xd5\x0b\x78\xb8\x59\xe3\x52\x37\x10\xf0\x62\x5a\x3b\xa6\xd2\x0d\x67\xa5\x2d\x1a\xd2\x9e\x20\x4b\x85\xda\xb6\xc5\xe1\xb6\xc1\x2b\xf1\xc1\xb6\xad\xd7\x1a\x89\x2d\xc7\x4e\xba\xad\x8b\xea\x80\xe7\x64\x9f\x63\x6e\x2a\x2f\x7b\x20\x0f\xf7\x40\x83\xe8\xe1\x1e\x48\xd3\x83\x14\x57\x92\x1a\x0d\xcf\x65\xb2\x6a\x70\x87\xbb\x9c\x20\x43\xfb\x0d\x67\x2b\x95\x1e\xbc\x08\xbc\xe3\x37\x9e\x06\xb4\x1a\x83\x68\x84\xd4\xd5\xd3\x9f\x55\xb8\x16\x22\xbd\xe6\xff\x80\x23\xa5\xd9\x5d\xa3\x6c\xbf\xc3\x31\x19\x0e\x70\x41\x24\x4e\x3a\x01\x81\xd5\x0b\xea\x96\x27\xe3\x09\xce\x6a\x59\x3b\xa2\xb6\xdb\x02\x67\x55\x73\xdb\x6d\x50\x07\x48\x8c\x70\x56\xbe\x17\x92\x1c\x67\x3b\xbc\x26\x1b\x77\xe7\x44\x09\x16\x19\xfb\x60\x95\xb4\xa1\x4a\x19\x49\xac\xee\x45\x2b\x4e\x23\x3f\xc9\xda\x39\x29\x9e\x8b\x42\xb6\x22\xf9\xee\xc7\x2c\x89\x54\x37\x93\xd4\xf7\x65\x43\x1e\x2e\x25\x9e\x35\x42\xaf\xac\x08\xdd\x9e\xe8\x9d\x3d\x36\x4f\x1a\x19\x23\x8f\x67\x19\x93\x37\xc6\x31\x77\x7d\x48\xbc\x36\x36\x74\xc0\xf4\xc3\x0f\x9d\x59\x4d\xb9\xa0\x12\xe8\x4a\xeb\x48\x06\xbe\x2a\x4b\x41\xaf\x75\x63\x9b\x5a\x46\x79\x24\x43\x5a\x80\xe9\x79\xa3\x4e\x25\x70\xfa\x3d\xe8\xab\xab\x1f\x80\x24\x9b\x5c\xca\xea\x28\xad\x85\x34\x88\x32\x51\x19\x55\xdc\xaa\x9b\x91\xd2\xe2\x65\x69\x98\xb1\x7a\x65\xae\x4c\x64\x37\xdf\x2d\x24\x82\xdd\xd9\xe2\x8b\xa5\x15\xc7\x5f\x8e\xd3\xc9\x88\xc3\x11\x37\x4e\x27\x84\xeb\x7b\xae\x67\xba\xe0\x11\x42\x52\x73\xf8\x99\x08\x22\xa2\x32\x5f\x36\x4e\x0d\xa9\x67\xee\xac\x7d\x92\xd5\xa6\x08\x81\x91\xc4\x92\x93\xfd\xa8\x48\x03\xb7\x5e\x81\xd2\xfc\x4b\xed\xc3\x3c\x37\x0d\xf1\xfc\x63\xab\x0c\xa1\xa1\x5a\x52\x75\x05\xd9\x72\xb8\x03\x3e\x2b\xe7\x27\x9a\xe6\x76\x4a\xbb\xf8\x1e\x87\x32\x1f\x22\x4c\xde\xdb\xf7\x81\x77\x08\x77\x28\x4c\x5c\x4a\xf1\xf0\x88\xc7\x5e\x73\x49\x3d\xec\x39\xab\xe9\x61\xcf\x59\x48\x0f\x7b\xfb\x23\x80\xc8\xfd\xbe\xea\x7a\xf6\x6e\xfe\x6e\x64\xc5\x40\xf0\x26\xf0\x6e\xa1\x7b\x65\xde\x0e\x4c\xf3\xe6\xbb\xc1\x9e\x88\x1a\xe3\xff\x0a\x77\x11\xe0\x0f\xc3\x1d\x10\xf2\x1b\x61\x41\xfb\x08\x11\x03\x2f\x93\xce\xd2\xb2\x62\xbe\x62\xd7\x8a\xae\xd6\xdd\x06\x8f\xaa\xe4\xed\xf6\x23\x55\x2c\xcc\xc4\x7d\x80\x76\x78\x1f\x20\xe0\x81\x43\x2f\x6b\x5e\x06\x77\x6d\x02\x70\x6d\x72\xdb\xc9\xda\x23\x02\xdb\x75\x12\xeb\xaf\x8d\x91\xf6\xe6\x19\x19\xdf\x1d\xcd\xfa\x4e\xda\x11\x01\x8a\x4a\x9e\xa8\xe3\x14\xd5\x05\x5c\xa8\xc5\x05\xe4\xfe\x10\xe1\x67\x83\x2f\x58\x9e\x13\x6b\x67\x99\xdd\x21\x3d\xd9\xf3\x56\xb9\x93\xbd\x98\x03\x7d\x37\x8b\xf7\x0b\xac\x27\x54\xe4\x46\x90\xfe\x00\x3d\x77\x9f\xd8\xfe\xaf\x8d\xb4\xcb\xde\x52\xb8\x72\x30\xfb\x05\x77\xd8\x4d\x8f\x3a\x36\x60\x37\xa9\xef\x4c\xc9\xd3\x08\x4f\x99\x87\x29\xc0\x51\x6a\xd2\x7c\xd5\x30\x46\x14\xb9\x6b\x44\x11\xf2\xf1\x71\x36\x31\x59\x35\x99\x2a\xea\x5a\x49\x8e\x45\x03\xef\x1f\xa4\xfe\xb0\x68\x9a\xca\x13\xae\xa1\xd8\xfa\xdb\x92\xe0\x40\x59\x8d\x64\x40\x5d\xeb\x79\xc8\x0d\x90\x46\x52\xc3\xb2\x2c\xc3\xcc\xe9\xa1\x0c\x36\x3b\x9c\xd5\x11\x46\x18\xa9\xd1\xe7\xac\x11\x6c\x93\xe1\x0c\xa7\xe1\x3e\x41\x80\x76\x7b\xe4\x7a\x77\xbe\xff\xe2\x21\xf8\x7f\xc2\x61\x5c\x52\xd2\x97\x8e\x36\xc4\x4d\x18\x83\x2e\xb7\x25\x8a\x6b\x5a\x19\x16\xc7\x33\x89\x1e\xbe\x7f\x5a\x1f\x42\x34\x3c\xfb\x4b\x97\x7b\x9d\x89\x84\x99\xc7\xf3\xfa\xbb\x69\x90\xa4\x72\x37\x5d\xc9\x02\x2a\x57\x4c\x50\xf9\xbe\x37\xe7\xa9\x79\x1f\x82\x6e\xb5\x1e\x89\xee\xac\x15\xe8\xcf\x98\xe1\x8b\x80\x21\x34\x3a\x6d\x99\xef\x0b\x68\x43\x3e\x13\xc4\x74\x1f\xc2\x86\x4c\x4a\xc0\x10\x7e\x08\xf7\xa4\x4f\x82\xfe\x70\xdf\xe2\xd9\x57\xc2\xf0\x67\xa2\xf0\x57\x97\x25\x11\x78\x22\x2b\x67\xcb\x95\x2a\xcd\x03\xb4\xf9\xea\xfb\xc1\x57\x50\x1b\xeb\xcc\x8c\xbf\x9a\x1b\xfc\xe7\xb6\x85\xb5\xd8\xdd\x7d\x5f\xac\xf1\x4b\xc0\xc2\xe7\x73\xa3\x82\x9f\xc0\xf3\x36\x51\x61\xce\xcd\xe2\x24\x9a\xd0\x18\xf8\xfe\xaf\xe1\x94\xa6\xa9\xb8\xbf\x2e\xe3\x2f\x69\x9e\xdf\x2c\xa5\x28\x16\x4b\xb8\xc1\x6d\xb7\x95\x61\x71\xd6\xe5\xb3\x00\x04\xdc\x4d\x17\x1d\x87\x91\xae\x07\xc7\xa4\x02\x3a\x25\xd6\x9f\x44\x5c\xe4\x90\xf3\x24\xc8\x03\x84\x39\x30\xad\x34\x79\x65\x25\xe6\x8d\x72\x60\x1e\xa0\x3d\x3b\xea\x07\xa7\xb3\xb4\x46\x52\xce\xd4\x12\xb9\x06\xce\x0f\x4c\xeb\x7e\xa1\x83\xd3\x0b\x5c\x52\xcb\x04\x01\xe0\x72\xcd\x9f\xf8\x7e\xac\x21\x0a\xf9\x7e\xda\xf0\x51\xbc\x3a\x34\xea\x79\x80\xf0\xba\x7b\xd4\x73\x77\xd4\x33\x47\x2e\x5b\xe7\x33\x76\x13\xb8\xc8\x9c\xd9\x36\xef\x9b\x46\x5b\xa0\x11\xae\x5e\x40\x6b\xa7\xd2\x9f\xf5\xad\xb5\xaa\x7d\xd1\xb5\xf7\xaa\x4b\xbf\xef\x3b\xfb\xaa\xb9\x0b\x7d\x3f\xf0\x40\xcd\x7c\x26\x1e\xea\xad\xb6\xdd\x7a\x92\x26\x5c\xd4\x31\x4d\x6f\xfc\x8d\x91\x18\x21\xdb\x66\xef\x54\xa3\x77\x77\xad\x12\xe7\xae\x2c\x70\x37\xc0\xb5\xab\x78\xac\x20\xd4\xd0\xe2\xcc\x79\x2e\xbd\x97\x74\xbd\x66\x12\xcc\xc1\x6e\xb7\xaa\x19\x31\x2a\xe5\xe0\xad\x4e\x75\xca\x12\xdf\x2f\xfd\x63\x9a\xf9\x00\x07\x8b\x06\x47\x7a\xde\x91\x65\x80\x8d\x54\xd3\x50\xa4\x05\x18\xd4\x27\x44\xe8\xfb\x73\xde\x91\xa8\xb1\xba\xe1\xb7\x3f\x58\xd9\xef\x7b\xab\x28\x76\x6b\x6f\x78\x67\xd6\x56\xc2\xa9\x35\x8e\x7a\x69\xf1\xff\x17\x92\x05\x6f\xdf\x80\xee\x5e\xf0\xf6\x2d\xc2\x57\xfa\xf7\x1d\xc2\xd7\xfa\xf7\x3d\xc2\x37\x64\x63\x20\xfb\x99\x8a\x2f\x76\x17\x34\xb4\x5d\x20\xee\x99\x2a\x2e\xb6\x7c\xbd\xbc\xb8\x86\x7b\x5c\x2f\x61\xa7\xe2\x4b\xa9\xea\xd2\x86\xf0\xc9\x6e\xd7\xdc\x93\xf8\x5c\x5f\x8e\x6e\x1b\xd7\xea\x73\x72\x15\x94\xc7\x8f\x06\xce\x7e\xa7\x42\xdb\x76\xdb\x19\xfd\x87\xf7\x56\x41\xf5\x6f\xfb\x15\xff\x4d\x57\x6c\x76\xc1\xf7\xd7\xfb\x93\xad\xf7\xd7\x86\x8e\xce\x0d\x3e\x8c\x6c\xa3\xfe\x00\x4f\x79\x5e\xdb\x40\xb9\x2e\xd6\xfa\xf8\x66\x49\xf4\xb7\xa7\x25\x35\x4b\x8f\x28\x98\xe3\x9c\xa8\x93\xb3\xce\x27\x39\x85\x22\xc3\xf6\x36\x12\xbb\x39\x3a\x39\x3f\xa1\xa4\x88\x38\x49\xa2\x6b\x1d\xfc\xdb\x09\x25\x77\x51\x40\xc9\x0c\x73\xb2\x42\xd1\x22\xc8\xf5\xb0\x29\x99\x22\x4c\x4d\x0b\x31\xb1\x6f\xfb\x56\x73\xd7\xba\x31\x17\x41\x0c\xbd\x28\xb1\xc7\x7a\xb7\xe3\x46\x05\x35\xd7\xc4\x57\x0b\xc7\x3d\x06\x0a\xe7\xa8\xf1\xc0\xf3\xeb\xf7\xb0\x57\x2e\x42\x30\xa0\xd3\x65\xe5\xc4\xf7\xef\x4b\x3e\x57\x11\x78\xc3\xe3\x57\x5e\x43\xb1\xc8\xd2\xe3\x92\x71\x63\xad\x9e\xff\xa3\x61\x22\xc5\xd2\xfb\x09\x97\x8e\x09\x96\xfc\xb3\x11\xe3\xa9\x39\xe7\xb1\x65\xe2\xc2\xb1\x4f\xd6\x0e\x89\x84\x4a\x4f\x10\xfb\x3d\x23\x4f\xf4\xda\xa9\xa1\x4b\x36\x10\x4b\x9c\xea\x05\xa8\x7c\x1e\x22\x7c\xdf\x26\x5b\xdc\x8c\x07\xf4\xef\x7b\x2c\x9c\x82\x91\x63\x78\x3b\x7e\xa1\xdc\x50\x83\x70\x71\x05\xc6\x3a\x66\x62\xa4\xfa\x84\x4c\xed\x8d\xe1\x04\xa6\x19\x44\xd6\xcb\xa8\xea\x9d\x6d\x1a\xe6\x42\xaa\x80\x22\x7c\x77\x74\xd4\xba\x71\x28\xb8\x6a\x58\x0c\x3a\x35\x5e\x3c\x44\x38\xd5\xe8\x05\x3c\xe3\x99\x19\xce\x47\x1d\x71\x8e\x13\x0d\x63\x57\x2d\x15\x8b\x1b\xb1\xfe\xcc\xee\x58\x7a\x05\x26\x63\xf3\x4a\x6e\x53\x97\xef\x90\x4a\x0f\x79\x0e\x8b\x51\x16\xfb\x8b\x41\xfe\xbe\x1f\x70\xdd\x8b\x3d\x4b\xba\x9a\xd8\x36\x1e\x31\x7a\x86\xb2\x8a\x7a\xde\x11\x87\x5d\x46\x57\x2c\x40\x58\x5f\x46\x44\x6a\xee\xf7\x41\x8a\x76\x7c\x1e\xac\xc2\x35\x93\x73\x21\x57\x66\x89\xce\xe7\x5f\x99\x26\x18\xa9\x7c\x0c\x04\x66\x9d\x30\x82\xef\x10\x4e\x7d\xdf\xad\xec\x2c\x4b\x82\x14\x61\x59\x89\xe8\xe4\x64\x30\xca\x7f\xae\x5e\x37\xf2\xa3\x23\xc4\x9a\x10\x59\x52\xab\x81\x1c\xe7\x13\x0c\x36\x94\x2f\x8b\x59\xca\xe3\x0a\x13\xec\x2b\x4b\x35\x40\x8b\xe7\xa5\x61\x20\x0b\x5d\x27\xc1\xb4\xb2\xad\x0a\x9e\x8b\xec\x0b\x6a\x38\x35\xb3\x01\xd9\xbf\xc2\xf1\x08\xd7\xe8\xfd\x68\x72\x77\x34\x44\xc8\x42\xc6\x1e\xf0\xe6\x6d\x8d\x2b\x0d\xb4\x8b\xa0\xa3\x27\xd6\x65\x53\x69\xc6\x88\xe6\x74\x0d\xee\x8c\xfe\x4d\xf5\xec\xa8\x7b\x34\xeb\xe9\xe8\x5e\x39\x27\xfa\xf2\x4b\x7b\xd6\x18\x7f\xef\x7e\xc9\x24\x33\xdd\xcb\xc1\xdd\x53\x26\x54\x6f\xc6\x78\xb6\xe8\xd9\x4e\x85\x1e\xc2\x8f\xd5\x1c\xc2\xe5\xf1\x81\xf4\x07\x3b\x23\xcf\x6e\x9e\x5b\x12\xc3\x08\x5b\xeb\x93\xf8\xbd\xb1\x62\xf4\x66\x68\xb4\xb6\xde\x58\xd5\xee\xb7\x56\xb5\xfb\xed\x6b\x6b\xc1\x68\x08\xaa\xdd\x13\x7c\x47\x06\xf8\xb1\x85\x35\x1e\x48\x7f\xa8\xcf\x7d\x8d\x62\x6e\xc9\xa6\xc6\x4e\xfb\x5c\x9d\x4e\xdc\x54\x6e\xbd\x1d\x8e\x53\x91\x3f\xb3\x94\xbb\x8b\x83\x69\x98\xaf\xe1\xb1\x6a\xf0\x04\x0e\x44\xf8\x32\x40\x28\x9a\x56\x92\x8d\x60\x0f\xff\xa9\xee\x36\x01\x13\xc4\x2d\x03\x74\xa8\x97\xcd\xcc\x99\x50\x7c\xfe\xf8\x21\x4d\x03\xb4\xdb\xe1\x53\x32\xbe\xc5\x67\x93\x51\x12\xb8\x9e\xbd\x67\x78\xb3\x60\xca\xd9\x41\x76\x23\xe7\x1d\xb2\xf8\xa7\xbb\x03\x7c\x94\x6f\xe1\xfc\x75\xa5\xa9\xb4\xdf\x4b\xd4\x75\x22\x40\xa9\xa7\xd0\x7d\xa3\xbe\x2e\x44\xf0\xd4\x39\x62\x5e\x6a\x2d\x6e\x39\x24\x95\x32\x2b\x91\x8f\xc3\x44\x38\x58\x65\x99\xf7\x70\x0e\x7b\xe5\xdf\xed\x10\x9e\x37\x59\x20\xd2\x10\x39\x97\x2e\xab\x07\x2c\x2a\x97\x40\xb2\xdd\x3e\x18\x03\xd6\xd3\xc6\xeb\x17\x23\xd2\xdd\x01\x23\x56\x76\x22\xe0\xa5\x0e\x26\x76\x55\xc4\x34\x46\x7d\x40\x1b\xbd\x51\xac\x38\xed\xe3\xa8\xbd\x8b\x94\x0b\x33\xee\xb2\x69\x0a\x1b\x7f\x29\xd5\xbf\xba\x96\xa4\xa9\x67\xd2\x20\x21\xde\x7a\xe8\xa9\xc5\xac\x05\xc4\xda\xd6\xd4\x9e\xa8\xf3\x9d\xe7\xb2\xcb\x1d\x8e\x64\x13\x31\x9a\xc3\xf5\x7d\x2d\xb9\x52\xb9\xf2\x77\x8a\xec\x63\x6b\x28\xf5\x53\x5d\xea\x9e\xb0\xdd\x0e\x5f\x90\xcd\xc1\x41\x58\xc6\x76\xd3\xb3\x41\x5a\xaa\xe2\x58\xf5\xf9\x1c\xcf\xd3\x22\x5f\xfe\xd2\xcc\x75\xe9\xc8\x25\x7d\xc1\x80\x90\x63\x97\xb2\xbb\xf8\x4e\xd1\x1d\x63\x23\xbe\xf1\x72\x89\x90\x91\x0d\xca\xd8\x7d\x4f\x53\xd1\x46\x37\xc7\xb3\x5e\xec\x34\x80\x6b\x04\x0f\x7e\x9a\xc0\x77\x67\x35\xb1\xa8\x65\xa0\xb8\x7c\xb6\x68\xbf\x95\x3b\x1e\x68\x35\xb4\x6c\x84\xe5\xba\x95\xaf\xf6\x71\x83\xf4\x28\x23\xcd\x89\xd2\x88\xa3\x72\x41\xd4\xae\x22\xb7\x6a\x66\xa5\x9d\xc9\x16\xaf\xb4\x5d\x7b\x2b\xbc\xdd\x8e\x27\xed\x1e\x54\xe7\x70\xab\x13\xcd\xa0\x5b\xd2\x46\x95\x02\x4b\xbb\x06\x13\xb5\xda\x30\xa4\x9b\xb1\x5c\x37\x8c\x55\xab\x8d\x4a\x22\x83\x4a\x50\x25\x60\xbe\x0f\xde\x65\xd2\xf2\x70\x29\x65\x6c\x4f\x64\xe0\x1d\xbf\xae\xc1\xf1\xb9\x73\x3a\x6a\x8a\xec\x96\x35\x83\x5c\x2e\x1b\x8b\x89\x45\x6d\x63\x31\xd1\xd7\x11\x56\x1d\x4a\x95\x45\x7b\x38\x9f\x1a\x2c\xe3\x25\x8b\x6f\xd7\x82\xbb\xce\xb2\x2b\x94\xd9\xea\xd6\x49\x7b\xe2\x4d\x9d\xd1\xa0\x59\xa5\x14\x26\xbd\xf1\x06\xdf\x2a\xea\xfb\xcd\xd1\xf9\x7e\xd0\x5d\x79\xf5\xd6\x59\x2d\x5a\x19\xdf\x5a\x36\x38\x48\xc9\x9e\xd8\xcf\xb7\xe7\xb4\x59\x4d\x7d\x1e\xee\xd5\x55\x1d\xd5\x6c\x17\x20\x57\x56\xad\xc5\x02\xa7\xe8\x39\xf6\x14\xf7\x29\xf4\xa8\xff\xe3\xc6\x55\xa5\xe5\xfe\x5d\xb1\x79\xee\x1c\x6d\x2d\x42\xbf\xde\xfc\x6f\x87\xd6\x3a\xf9\xdb\x57\xa5\xdc\x6f\xd3\xd9\x4b\xfb\x3a\x6d\xef\x5b\xe6\x0e\xc1\xc2\x66\xe6\xa0\xce\xe1\x88\x70\x37\xdb\x2e\xfd\xbb\xef\x2b\xbf\x4a\x36\xf7\x7d\x60\x13\x01\x32\x06\x0f\x26\x57\x8c\x26\x8f\x01\xaa\x68\x4e\xa1\x31\x0f\x07\xe9\xa9\x3f\x5a\x4f\x03\xdd\xaf\x89\x4e\x06\xbd\x54\x6d\x1f\x36\x6d\xa9\x59\xcb\xff\x84\x69\xeb\x50\xcc\x45\x98\xed\xb9\xc1\x01\x84\x21\x8d\x3f\xb1\x83\xd3\x55\xf9\x63\xdd\xab\x12\xe4\x8e\xfa\x84\xd0\xed\x16\xbc\xdd\x57\xd0\x58\x3d\xb3\x87\xc6\xe5\x9a\x39\x4d\xa0\x67\x14\x2b\x34\xe2\xbe\xdf\xea\x2e\x45\xe6\xd2\xd4\xe8\x49\x60\x9a\xc7\xdc\xf7\xbf\x77\x0d\xb2\x6f\xaf\xc1\xae\x22\xb3\xf6\xae\x70\x7b\x74\x57\xe7\xa5\x87\x90\x4c\x77\xec\xe0\x3d\x50\x13\x26\xe8\x87\xc5\x5c\x9b\x4a\xe4\xb5\x91\xc2\x13\x16\xa8\xce\x4b\x5f\x04\x7b\xb7\x76\x71\x94\x5f\xb1\xf9\x8d\x08\x14\x66\x0d\xb6\xa9\xfc\x46\xe5\xc0\x1a\x07\x97\x4a\x2b\xe1\xac\x05\x54\xf7\x49\x8a\x55\x59\x61\x69\x7b\xe0\xed\xb1\x3e\x73\x37\xbb\x11\x75\xf6\x6d\xeb\x24\xac\x78\xb4\xa4\xcb\xcd\xad\xe3\x4b\x54\xb2\xb9\xb5\x0a\x97\xf9\xbe\x08\x80\x32\x0d\xa7\xe2\x3e\x63\x52\xaf\x17\xdd\x83\xa7\xce\xe7\x49\xa3\x4f\x60\x4e\x19\xdb\x6e\x97\x2b\x5d\xe6\xfb\x41\x46\x34\xd8\xcd\x8d\x36\x8d\x69\x67\xd4\x90\x8e\x76\x75\x1d\x9e\x1a\x03\xc8\x56\xc3\x00\x70\x4a\xaa\x3e\xe3\xac\x0f\x3a\x7f\x5e\x0e\x16\xb1\xea\xfc\xd2\xf7\x75\x55\x42\x8f\xa9\xde\x08\xff\x94\x69\x93\x7b\xd3\x56\x83\x20\xfd\x01\xed\xaf\x7e\xd0\x67\xdb\x6d\x27\x3d\x5b\x2d\xf8\x81\xf4\x6a\x64\x6d\x52\xad\x34\x41\xd1\x01\xb0\x7b\x7b\x4f\x80\xdf\xe7\xda\xc5\xd9\xf0\x27\x0f\xe1\xac\x6e\x1b\xfc\x85\x6a\x34\xd6\x0d\xaf\xdf\xac\xef\x78\xe0\x55\x4e\xa8\xbb\x36\xd6\x28\xf5\xfd\x14\xdc\xbf\x8e\x41\x64\x94\x75\x65\x02\xb3\x3a\xac\xea\xd1\x77\xee\x7c\x2b\x2e\xd9\x94\xf4\xda\x24\x6c\x56\x2c\x6e\x84\x48\xf7\xcc\x1a\x3f\x4f\x58\x74\x03\xd2\x9b\x9b\x03\xec\xd0\xbd\x3b\xb2\xda\xbf\x67\xd7\x72\xb7\xfb\x69\x25\x37\xd4\xbe\xac\x9c\x67\x1c\x84\x81\x4f\xba\x22\x2b\x8a\x2d\xea\x4a\x25\x15\x35\xcb\xf3\xf3\xcc\xbd\x7c\xf5\x87\x3b\xdc\x8e\x8c\xfa\x43\x7c\x80\x2b\x60\xc5\x6a\x9a\xd9\xf7\x48\xc1\xd2\xef\x5f\x2b\xe3\xa1\x4b\xb7\x21\x06\x30\xc7\x79\x25\xee\xd1\x28\x17\xa0\x13\x11\x78\xfa\xce\xe7\xda\xf2\x88\x71\x31\x02\x45\x8c\xee\x71\x0d\x70\xac\xff\x99\xfa\xaa\xd5\xd1\x37\xdb\x01\x02\xaf\x22\x86\xf8\x6d\xb4\xae\x8b\x0c\x77\x73\x9e\xd1\x34\x7d\x04\x2d\x0f\xe0\xd1\x57\xad\x00\xd7\xc5\x54\x51\xab\x7e\x18\x35\xb6\xbd\xf4\xaa\x96\x43\xd3\x5e\xde\x72\x0a\x7d\xfd\x75\xfa\x77\xc0\x15\xc0\x21\x00\xc2\x19\x61\x6d\x2f\x9e\xae\xcb\xfb\xaa\xf3\x2d\x90\xb0\xaa\xd0\x07\x52\x84\x33\x67\x27\x6e\xa0\x66\x87\x58\x27\x72\xe5\x38\x4b\xb1\xf4\x8e\xca\x08\x91\xf5\x1c\x36\xd7\x22\x3b\x1a\xba\x53\xb9\xdb\x59\xd6\x56\x7b\x1e\x0e\x80\x85\x23\x91\xfb\xde\x71\x19\xfc\x03\xb3\x95\x62\x6a\x7c\x61\x73\x72\x60\x18\x30\x93\xa9\x86\x29\xde\x07\x4d\x1f\x6a\xd6\xbb\xfa\x70\x38\x45\x5c\xa3\x05\x07\x94\xc0\xe7\xfe\x3e\x18\xed\x8d\xfe\xc9\x9d\xfd\xc3\x0a\x3d\xf5\x73\x04\xf0\xfc\xb2\x8e\xd7\xea\xcc\x7d\xad\xce\x9e\x7e\xad\x56\xee\x43\xb5\x42\x9d\x1a\xc8\xbd\xd6\x0b\xb1\x79\xde\xbd\x91\x34\xbe\x75\xdf\x4a\xd2\xd2\xa3\xea\xe1\xcc\x44\xb5\x84\x49\x9e\xcc\xbc\xa7\xe2\x6b\xc7\x5e\x5d\x34\xf4\xd5\x91\xe8\x39\x39\xf1\xbc\x23\x7b\xa1\x65\x49\x64\xab\x41\x58\x95\xc2\xa7\xf0\x62\x1c\x93\xcd\xd4\x60\x42\x5d\xfb\x53\x5a\x80\x32\xc8\x0f\xe8\x01\x1a\x3d\xb6\xf8\xb6\x69\x57\x61\x1e\xf4\xf5\x74\x55\xfa\xac\x07\x74\x00\x71\x46\x44\xa0\xd0\x89\x57\x59\xa5\x2f\x5f\xbd\x39\xb9\x00\x6a\x45\x17\x74\x24\x70\x3f\x5a\x43\xf7\x42\x06\xaa\x21\xd5\x56\xf3\x7c\x33\xf0\x98\xea\x1d\x19\xf4\xb0\xaf\x29\xdf\x49\x69\xc0\x5b\xcd\x81\x94\x5c\xa7\x04\xb6\x3b\x46\x1b\xa9\xaa\x4e\x23\xd8\x0d\xcb\x8a\x15\x93\x20\xe3\xc9\xc3\x3a\x80\x63\x90\x66\x2d\x4c\x4a\x7f\xa0\x0f\x9d\x0e\xbe\x33\x34\xed\xe0\x9d\x1d\xce\x59\xd3\x3c\x03\x0c\x87\x61\xe8\x89\x2b\xf4\x05\xfc\x56\x0d\x63\x9b\x05\x53\xc6\xd9\xd0\x7e\xf5\x31\xd4\xd7\x4a\xad\x2a\x35\x32\x95\x00\x00\x3c\x5b\xb8\xc5\x35\x30\x62\xc7\xa6\xc0\x6e\x87\x34\x25\xd8\x25\x38\xb4\xb7\xf4\xa5\xc4\x45\xc5\x8b\x95\xd6\x04\x4d\x5f\xd5\xe6\x23\x00\x6a\xc0\x5f\xe1\x60\x54\x92\xa1\xe5\x38\x02\x84\x05\xe1\xc1\x21\xb0\xa9\x65\xcb\xfa\x70\x85\x0a\x40\x74\xc2\x94\x14\xc6\x7e\xca\x81\x71\x55\xc8\x02\x7a\x04\xae\x09\xdd\x8c\x01\x7a\xca\xd4\xc1\x93\x6e\xeb\x2a\x1c\x04\x62\xc2\xdb\x2d\x0b\x73\x19\xdb\x1b\xe5\x76\x6b\x1f\xd6\x6b\x8d\x19\x21\x25\xcb\xd7\x02\x5e\x2e\x7f\xcd\x59\x75\x27\x0d\x14\x39\x98\x8a\xf0\x2b\x10\x2e\x29\x9d\x82\x9e\x28\xc7\x9b\x63\xa4\xfe\x0b\x7e\xdb\xf4\xd2\xa4\xb5\x68\xc3\x76\xab\x7c\xbf\x1f\x78\x6d\x05\x3a\x57\x23\x01\x35\xd7\x38\x23\x9e\xc8\x8c\xd7\x3c\x63\xc2\xca\x66\x83\x55\x17\x25\x03\xa0\x5b\x07\x30\xf0\x12\x7e\xe7\xa1\x11\x6d\x0a\xc0\x64\xd8\x33\x4d\x8c\x3c\x0d\x0e\x1d\xb7\x4d\x7d\x6e\x95\x46\x76\x84\xef\x4b\xdf\xf7\xee\x97\xcc\x9a\x8e\x00\x87\x96\x55\x83\x95\x37\x7e\x90\x6e\xd1\x58\xe1\x13\xa3\xaa\x90\x2c\xf0\x8c\xc8\x44\x68\x4a\x62\xef\x55\x38\xf0\x10\xc2\x62\x57\xda\xb5\x02\x29\x9b\x51\xda\x90\xfc\x90\x87\x6a\xf6\xfd\x6f\x37\xf9\x9c\x3c\x81\xe7\x61\x0f\x24\x83\xfa\x83\x27\x04\x35\x9f\x03\x92\xae\xb8\xe4\xb7\x45\x27\x6b\xa1\xae\x93\x7e\x5f\x8c\xcd\xa1\x3a\x89\x1a\x06\x15\xcd\x29\x22\xc8\x26\x16\xa9\x90\x1a\xb5\xc1\x03\x80\xfd\x55\x7c\x05\xdf\x5e\x19\x78\x91\x8a\x98\xa6\x9e\x8e\x63\x2b\xca\x53\xfd\xb1\x12\x99\x02\x81\x16\x23\x35\xa5\xbf\xd6\x34\xcf\xef\x85\x4c\xf4\xb7\x04\xb9\xa4\xfe\x00\xe7\x8c\xca\x18\x32\x2a\x96\x9a\x9f\x07\x05\xbf\xb6\x95\x42\x42\xf4\x3d\x63\xb7\x51\x7f\xf0\x04\xcf\xb0\x93\xf3\x38\xf6\xae\xcc\x46\x63\xd2\x31\xf3\xe2\x61\x6f\xcf\x85\x91\x87\xbd\x1b\xba\x6e\x46\x74\xfa\x34\xf2\xb0\xb7\xe7\xd3\x48\x57\xd8\xf6\x69\xe4\x61\xaf\xdb\xa7\x91\x37\xf9\x0e\xc6\x67\x79\x6f\x7b\x7d\x6c\xdd\x66\x5b\x87\xdd\xef\xe0\x21\x63\xb3\x12\x85\xd5\x17\x8f\x36\x6d\x63\x30\x51\x69\x3d\x12\xd2\xbd\x0e\xe1\x2d\x48\xbd\x28\x65\xb2\x4c\xe8\x8e\x49\x6f\xb2\xc3\x50\xb1\xd1\x59\x3f\x5c\x31\xa4\x7f\x5f\xc5\x3b\xcc\x1b\xf2\x50\xf4\x69\xc1\x26\x5e\xc9\xff\xd5\x95\xd8\xcd\x0f\xcf\xa8\x54\xb1\xe4\xc6\x62\xe4\x0c\xcc\x89\x97\xb8\xb4\x61\x5f\xb0\x51\x47\xa1\x3c\xcb\xef\x69\x54\xdb\x77\x24\x09\x2b\x09\x13\x50\xe2\xe3\xa1\x41\xef\x84\x10\x8e\x72\xc2\x6b\x37\x05\x31\xe1\x21\x30\x51\x3e\x96\xe8\x30\x27\xf1\x49\x5c\xea\x5e\xfc\x99\xb3\xfb\xed\x36\xb6\x58\xfc\x2f\x50\x89\x95\xc1\x32\xe2\x04\x38\xd9\xeb\x1a\x08\x34\x6e\x0a\x6b\x7a\x6d\x4d\x3a\x86\xa9\x44\xc9\x5e\x4d\xc8\xfa\x44\x3e\xe1\x89\x3b\x58\xdb\x6b\x0e\x5c\xf2\x0a\xc3\xb2\x4a\x08\x60\xed\x82\x10\x92\xec\x0d\x78\x4e\x8c\x6c\x47\x71\x92\x47\xb2\xf3\x78\x2e\x40\xce\x01\x32\x25\x07\x33\x25\x08\xaf\x48\xea\xbc\xce\xd2\xb0\x86\x28\x5c\xc0\xca\x8e\x56\x56\x72\x1c\x52\x52\x03\x4b\xab\x52\xa7\x6a\x8e\x57\xcd\x91\x93\x25\x74\x70\xd6\x55\x2d\x40\x38\x4e\x4c\xb5\xd5\x13\xb8\x53\x3b\x33\x5b\x60\x56\xd6\xbe\xc4\xb3\x56\xed\x73\x2c\xc2\xa7\xcd\xdb\x06\x2b\x3c\xc3\x05\x4e\x10\x1e\xaf\xf0\xac\x69\x34\x89\xff\x2f\x92\xf3\x7f\x77\x6c\xb7\xbb\x75\x92\xff\x0e\xec\xb7\x6e\x8c\xde\xfa\x7f\x1a\x7e\x86\x09\xfc\xd5\xea\x23\xa5\x9c\x65\xea\x3f\xdd\x40\x99\x62\x5c\xcb\xd8\x32\x4b\x3e\x57\x55\xc8\xb8\x9a\xb1\xde\x39\x8d\x3f\x1a\x13\x58\x30\xf5\x45\x24\x7c\xce\xed\x6d\x25\xa2\xd8\x58\xe8\xed\x34\x2f\x66\x92\xca\x83\x06\x5c\x3b\x68\x8a\x82\x9d\xa8\xe8\x18\x0e\x9c\xe3\xe8\x35\xfc\x0e\xa3\xc1\xce\x56\x64\x79\x32\x8d\xb5\xe8\x7e\xec\x68\x6d\x83\x80\xb9\xdb\x1d\xb8\x6e\x35\x41\x76\xc2\xea\x4d\x12\x35\x32\xa2\x1d\x5e\xd3\x05\xfb\xcf\x8e\x36\x3c\x48\x30\x3d\x66\xa1\xc9\xc5\x42\x3b\xa1\x47\x69\x69\xc6\xe6\x3a\x96\x22\x4d\x3f\xb3\xb9\x32\x55\xfd\xf5\x50\x55\x7f\x75\xab\xfa\x6b\x55\xd5\x5f\xdb\x55\xdd\x88\xf5\xae\x43\x7f\x83\xff\xef\xd3\x23\x31\xf2\xc3\x94\x6c\xee\x38\xbb\x6f\x53\xfb\x2c\xd4\xb1\xb5\x2f\x6e\x1d\xb2\x64\x7f\x6a\xc9\x7e\x55\x23\xc8\x5a\x95\xbf\x22\xfa\x9b\x08\xb2\x44\x38\xb5\x1a\xa4\xc1\x92\x59\x27\x96\xc4\xc6\x81\x4e\x37\x44\x98\xb4\xed\x76\xd0\x35\x7d\xf4\xf9\x54\x95\x7d\xa4\xdc\x5b\xdf\x68\x80\xdb\x0b\x15\x0d\xb0\x64\x73\xc9\xf2\xa5\x89\x82\x3b\x49\xd3\x4a\x69\xb6\x0f\x28\x84\x85\x0f\x38\xdb\x5b\x75\xc2\xc2\xc7\xa7\x9c\x59\x3d\x83\xf8\x83\x55\xd5\x93\xec\x2a\xc5\x81\xd6\x7d\x7b\xeb\x56\x98\x7e\x2f\x25\x60\xa5\xc9\x5b\x30\x8b\x50\xf2\x40\x85\xef\xf7\xfb\x60\xe8\xaa\x8b\xe7\xdf\xcb\x2c\x0c\x6d\x3e\xa4\x2a\xf2\x0c\x06\xf1\xf0\xa9\x11\x98\x8f\x3c\x8b\x6d\x3c\xfc\x85\x29\x1a\x79\x16\xab\x78\xf8\x5a\x23\x9e\xc8\x2b\xf1\x8f\xf7\x3d\xd6\x36\x2a\x1e\xf6\x3b\x84\x8d\xfe\xbe\x15\x3c\xd9\x77\x1e\x8c\xd3\x46\xfa\x9e\xcf\x60\x4c\xf7\xd2\x1b\x0e\x7f\x31\xdf\x4b\xef\xf6\x0c\x8c\xf3\xbd\x8c\x87\xdc\x00\xe3\x98\x6c\xf6\x3d\xa7\x5e\xb1\xc5\xd9\xc3\xda\x79\x94\x57\x2c\x57\xe1\x8c\x67\x49\x90\xb1\xfb\x9e\x49\x0e\xbc\xff\x19\x24\x54\xd1\x2d\x95\x9c\xa2\x17\x63\xef\x48\x84\x1d\x3e\x61\x8f\xbc\xc9\xff\xfd\x2f\xfa\x72\xe3\xfa\x2f\xa4\x71\xcc\xd6\x1a\x96\xcd\xc7\xe9\x92\xca\x9c\x95\xe1\x3c\xd7\xb8\x5e\x7f\x03\x2b\x7c\x80\x41\x38\xfd\x53\x91\xa6\xd7\x70\xaa\x44\xa9\x89\x01\xbe\x25\x6c\xd0\x18\xb2\xa7\x50\x41\x0e\xff\x1e\xb3\x58\x67\x2b\x94\x38\xb5\xde\x08\x75\x74\xa1\xc4\x65\x4a\x1f\xa3\xb4\x54\x28\xd0\x5f\x2c\x4d\x2f\x69\xa2\x6f\xbd\x7a\x6f\xb1\x34\xbd\x5e\xd3\xd8\x86\x96\x54\x5e\x43\xbf\xe2\x25\x4d\x53\xa6\xef\x07\xfa\xdb\x30\xb8\xe4\x36\xc5\x31\x87\x9a\x41\xd0\xe7\xfc\x63\xf9\x05\x04\xe9\x00\xc7\x22\xcd\x23\xae\x7f\xae\xd7\x34\x83\x08\xd0\x9d\xaf\xbf\xce\x12\x0e\xbe\x0a\xcb\x98\x07\xf5\x85\x65\x85\x0d\x49\x5d\x3c\xad\x3e\xf5\x85\x18\x52\x84\x4c\xf4\x20\x63\x29\xf2\xfc\x42\xf2\x05\xd7\x55\x83\xd6\x9d\xb9\x0b\xdd\x70\x68\xbd\x34\xbc\x9e\xea\x2f\xb0\x32\x90\x70\xa9\xe3\xad\x85\x76\x1d\x21\xee\xb3\x54\xd0\x24\xca\x71\x22\xe9\x62\x61\xbb\xc2\xb2\x58\x13\xc2\xd1\x00\xc3\xcb\x82\xf9\xf9\x50\xae\x86\x0e\x9c\x35\x72\x58\x47\xda\x26\xf0\x55\xfc\x99\xa6\x1c\xee\x66\x29\x44\xd8\xf3\x73\x80\xc1\xe5\xfb\x2f\x02\xec\x87\x0d\xf0\x92\x51\x90\xb9\xd0\x5f\x7c\xb1\xd4\x19\x8c\x6f\xca\x28\xc5\x4b\xbe\x58\xea\xb0\x64\x73\xfb\xf3\x99\xc2\x82\x2c\xd5\x2a\xfd\x24\xa0\xb8\x52\xeb\xb3\xbf\x17\xfc\x2e\x1a\x60\x1e\x43\xc7\xb8\xee\x02\x5c\x25\xbf\x88\x84\xc1\xb7\x62\x0b\xc9\x95\x86\x0e\xae\x5b\xba\x65\x8f\x97\x54\xd2\x95\xfd\xb6\x43\xb8\xe5\x99\x2e\x09\xee\x05\xe1\x17\x9a\x4a\xcd\x74\xa7\x42\xac\xa3\x14\xa7\xe2\x3e\x1a\xe0\x15\xcd\xf8\x9c\x41\xfc\x8a\xca\x05\xcf\xfe\x58\x76\xdd\x04\xff\xc2\x13\xb5\x84\xd0\x83\xf9\xff\xd9\xca\xe7\xe0\xff\xb6\x62\x09\xd7\x0b\x04\xbf\xff\x21\x45\xb1\x86\x80\x9d\xb8\x15\xac\xe1\x8a\x67\x55\x01\x70\x66\xb9\x4e\x19\x40\xd9\xaa\x50\x16\xde\x32\x03\x59\x99\xc8\x62\xf3\xeb\x4c\xb6\x58\xc3\xe4\x89\xb5\xe2\xab\x42\x2f\xdb\x9a\x2a\xc5\xa4\xae\xd9\x71\x76\x69\x42\x8f\xf9\x79\x96\xf2\x4c\x17\x5b\x8b\x5c\x99\x68\xc9\x00\x18\xf4\x97\x98\x73\x00\x05\x60\x4a\x97\xdd\x95\x8c\x26\x17\x59\xaa\xf7\x8f\xd4\x20\x25\x99\xbc\x14\x29\x87\xdd\x27\x61\xee\x4a\xe7\x98\x90\x03\xec\x86\xc1\xa7\x30\x75\x89\x7b\xbd\x1d\xa4\xb8\x87\xed\x40\x71\x4e\xb3\x64\x26\xf4\x54\xe5\xb1\x80\xa5\x80\x5f\x5d\x24\x87\xf3\xc8\xec\xc2\x9c\xd1\x55\xca\x72\xbd\x17\x4a\x57\x9b\x30\x17\xf9\x92\x9a\x42\xfc\x1f\x2c\xe2\xf0\xa3\x17\x36\xd7\x95\x73\x9c\xaf\x59\x9a\x9e\xea\xad\xaa\xe3\x64\x6c\xfe\x7f\x14\xf6\xc3\x02\x54\x2e\x63\xb3\xc1\xc1\x84\x8f\xee\x93\x62\x6b\x08\x3e\x42\x9f\xf3\x62\xb5\xa2\x52\x0f\x50\xd1\xd9\x79\x96\xb0\x07\xf8\xb4\x10\xad\xb8\x82\x5c\xca\x00\x52\x91\xb3\x2f\x54\x97\x36\x96\x87\x06\xf8\xde\xc2\xc3\xfd\xca\x00\xe4\xbd\x84\x64\x3a\x13\x85\xb2\x7b\xd6\x16\xe5\x99\x85\xb7\xb5\x64\x73\xfe\x60\xd6\xc0\xb8\xa2\xd7\xb3\x9a\x8b\x42\xc6\x65\x4b\x42\x6f\x8a\x3b\x11\xd3\x99\xc5\x69\xa7\x74\xcd\x95\x91\x75\xb6\x11\x42\x4a\x16\x2b\x1b\xba\xd6\x17\x66\xc0\x46\xb0\x73\xb8\x62\x2b\x8d\x8b\xed\xe7\x35\x4c\x7d\x0a\xdf\x76\x43\xe8\x4f\x40\x66\xfa\xe3\x0a\xf6\xa0\x64\x79\x91\x2a\x98\x5e\x16\x17\x76\x4f\x15\x59\xed\x6d\xf5\x80\xaf\xcc\x26\x7e\xf7\x4c\xf0\x45\x6c\xc2\x9e\x83\x2d\x3d\xf8\xf4\xaa\x1d\xee\xcd\x85\xf4\x9c\x5d\xee\xe9\xcf\x17\x1a\xbc\xee\xbc\x4e\x07\x9c\x3a\xee\x4b\xc3\xa9\x7b\x1e\x6d\xee\x5a\x2c\xe8\x0e\x3b\x6c\xcc\xca\x8c\xec\x6b\xc8\xc1\xcb\x68\x50\xaa\xd8\xf5\x6b\x9d\x42\x30\xa5\xbc\x9f\x1f\x6c\x64\x9c\xb0\x6e\x8d\x3a\xcf\x3b\x52\xc8\xbc\x89\xf0\x84\xab\x47\x30\xf2\x59\x06\xc2\x19\x4d\x80\x23\xe3\xfb\xac\x49\x9a\x86\xc6\x21\xab\xbd\x28\x18\x16\xc1\x53\x2d\xa0\xdd\x77\xd9\xcd\x2d\xa9\x97\x77\xef\x0c\x27\xe7\xfd\x7b\x78\x7c\xb7\x7a\xc4\xe0\x75\x5c\xb2\xac\x14\xab\x95\x61\x42\xb3\x05\x93\xa2\xc8\xd3\xc7\xcb\xce\x3c\x58\x32\xc0\x35\xfa\xf2\xfd\x17\xae\x96\x5f\xa8\xbc\x2d\xd6\x91\x70\x4b\x5e\x75\x65\xf9\x27\x58\xd6\x6b\x9b\xbc\x33\x8c\xed\xf1\x70\x82\xb0\x3a\x51\x61\xc6\x1e\xd4\x35\x9f\x01\x52\x61\xe1\x9c\xcb\x5c\x41\xe7\x3b\x84\x8c\x8a\x90\x67\x39\x93\x60\x5e\xd3\xf0\xcb\x4a\xf3\x4a\x6d\x35\xa8\xb6\xdd\x3b\x85\xc0\xf9\xa5\x1a\x0f\x26\x58\x37\x8d\x33\x14\xad\xf6\x0a\xd3\x8a\x03\xbe\x6f\x13\xd6\xde\x50\xc6\xc3\xc9\x48\xf7\x7e\x30\xc1\x56\x91\xca\xc8\x9a\x81\xb8\x88\xee\x76\x90\xa1\x5d\x33\x42\xb5\x55\xbd\x5b\xee\x12\x88\x1a\x8d\x6a\x33\x4f\xce\x74\x68\xe2\x5c\x77\x52\x62\xa1\xc1\x80\x90\x0c\xcd\x24\xa3\xb7\x23\x49\xd2\xa6\x52\x8e\x19\x32\xc8\xd2\x8f\x6a\xef\x5a\xad\xaa\x84\x53\x43\xb3\x87\xc2\xd5\xf1\x89\x9b\x5a\xfd\xcc\x79\x57\xc0\x92\xb0\x46\xad\x12\xae\xe8\x99\xef\xaf\x02\x81\x5b\x0c\xfd\x1b\xf6\x60\xa4\x0d\x33\x84\x25\x8a\xb2\x93\x00\x8c\x19\x23\xac\x6f\x5c\x12\x2b\x84\xa2\xdc\x5a\xec\xaa\xf4\x65\xde\xbd\xb7\x6e\xbf\x5f\x23\xbc\x26\x81\x61\x61\x96\xb2\x98\xef\x87\xc8\xa8\xcf\xbc\x1f\x18\xf5\x99\xf7\xc7\x08\xaf\xc8\x3a\xd8\x97\xaf\x33\x60\x62\x41\x04\xd6\x18\xe1\x19\x49\xbe\x09\xed\x78\x41\x36\xdf\xca\x13\xcd\xca\xcd\xf4\x91\xa5\x7c\xc5\x15\x4b\xc0\x0e\x5e\x5c\x3a\x99\x28\xb7\x65\x13\xbd\xb5\x54\xda\xf6\x5e\xe5\xb9\x91\x61\xb0\xbe\x88\xb8\xd5\x1a\x06\xc7\x3e\xe7\x5f\xaf\xcf\xae\x6e\xa6\x5f\x3e\x5c\xfd\xe9\xd7\x4b\x2f\xd2\xdb\x81\x87\x96\x52\xc5\x02\x42\x74\xae\x98\xd4\xfd\x44\x68\x64\x56\x18\x4a\x7e\xb9\xf8\xf3\xd9\xf4\xec\x3f\xcf\xaf\x6f\xce\xbf\xfe\x87\x17\xa5\x90\x77\x6e\x19\x7e\x4f\x17\xbd\x3e\xab\x5b\x9c\xbb\x2d\x36\x72\xdd\x9c\xfd\xe7\xcd\xf4\xf4\xe2\xeb\xcd\xd9\xd7\x1b\x2f\x5a\x1e\xcc\x77\x75\x06\x5d\xf9\x7a\xf1\xf1\xcc\x03\x4f\xb3\x75\x37\x34\x6a\xfc\x51\x1b\xa5\xb0\x57\x67\x4d\xcd\x74\x78\xf4\x8f\x2d\xfa\x33\xf7\x5b\xab\x4c\xd2\x94\x11\xcf\x5c\x19\xf1\x85\x86\x12\x90\x0e\x2f\xd2\xb4\x34\x4e\x6d\x45\x42\xf5\xd1\x77\x92\x80\xd7\x1a\xfd\x69\xb8\xa4\x3a\x41\xdf\x0b\x7c\x1f\x44\xc6\xe0\x1b\xb5\xfd\x57\x68\x50\xac\x77\x4f\x68\xc1\xc6\x62\x05\xd3\x59\x70\x23\xa0\xda\x08\x0c\x6d\x66\x27\xf5\x20\x4a\x71\xfc\xc8\x0c\x30\xa4\xeb\x35\xcb\x12\xb7\x9a\x7d\x1c\xa6\xcb\xeb\xde\x12\x15\x25\x81\x9d\x98\x3d\x44\x64\xb2\x29\x30\x39\xa9\x17\x79\x3f\x5b\xde\x92\x79\x87\xf6\xcb\x47\xa3\x96\xb9\x6a\x93\x6f\xa3\x53\x23\x86\xcb\xce\x47\xe3\x09\x10\x0f\xd6\xe4\x91\xde\x29\xe6\x4b\x18\xbf\x9e\x51\xbe\xab\xb7\xff\x4f\x76\xfb\x0f\x8c\xbe\xdc\xfb\xa1\xdd\xf0\xe0\x0a\x75\x88\x57\x64\x38\xc4\x33\xd2\x65\x08\xb4\x76\x52\x5a\xeb\xde\xb7\x92\x5a\x9a\xd8\x5d\xb5\x64\xf4\x8e\x2f\xa8\x12\xd2\xf7\xf7\x84\x2c\xab\xb4\xb0\xc8\x99\xfc\xb0\x80\xc6\x5e\xfe\x36\x3b\x4b\x16\xec\xb7\x97\xbf\x25\x2f\xe1\x36\x1e\x74\x64\x43\x78\xd1\x81\xa5\x54\x3d\x99\x9a\xbe\x23\x84\xac\xb6\xdb\xfd\xc8\xa5\x2b\xac\x49\x54\x73\x05\xf6\xa4\x57\x2c\xf5\x64\x73\x69\x12\x6c\x4d\x63\xf6\xeb\xd5\x79\x5d\xb5\x13\x49\x08\x29\x0c\x4c\x9f\x04\x22\x30\x6e\xb8\x1a\x98\xd3\x6e\x28\x04\x56\x6c\xbb\x93\x00\x7c\xd1\x0e\x8d\xe2\xbd\xc3\x99\x2c\xc0\xb5\x48\x0d\xf4\x1a\x89\xea\x64\x22\x71\x1c\x82\xec\x34\x44\x93\xb4\x0c\xfe\xf1\xe6\xcb\x67\x42\xcb\x10\x18\xc6\xe4\xf8\xb9\x6f\xf0\x96\x35\x07\xc0\x06\x64\x69\xf4\xf2\xe5\xfd\xfd\x7d\x78\xff\x2a\x14\x72\xf1\x72\xf8\xd3\x4f\x3f\xbd\x7c\xd0\xa9\x1e\x5e\x51\xb5\x3c\x98\xeb\xfd\xcb\x2f\x54\x2d\xe1\xdf\x97\xcf\x1e\xce\xef\x16\x5d\x19\x8f\x07\x83\xc1\xcb\xfc\x6e\xe1\x7d\x8f\x6a\x02\x60\x1f\xec\x98\xe3\x03\x90\xa7\xe4\xe5\xff\x1c\xf7\x7e\x93\xbf\x65\xbf\xa9\xdf\xe6\x93\x97\x98\x93\x97\x3f\x07\xfd\x17\x2f\xb6\x29\xcf\x6e\xb7\x99\x30\x72\x2e\xdb\x15\x53\x74\x6b\xbf\xe1\x22\x84\xea\x52\xbf\xbd\xfc\xc3\xe4\x25\xb8\x69\xd3\x9b\x26\x26\x79\xb0\x27\xfd\xdb\x5c\xfb\x3e\x21\x69\x98\xdf\x2d\xc0\x26\xce\xff\xcb\xdc\x9b\xf6\xb7\x6d\x6b\x09\xe3\xef\x9f\x4f\x61\xe1\xe9\x8f\x97\xb8\x82\x15\x29\x6b\x43\x05\xd5\x93\xc6\xee\x6d\xe6\x36\x4d\x26\x71\x6f\xdb\x51\x35\x2e\x4d\x42\x12\xc7\x14\xc1\x0b\x92\x5e\x2a\xe9\xbb\xff\x7f\x38\x58\x08\x2e\x72\xec\xf4\xce\xcc\xff\x85\x2d\xe2\x60\xdf\x0e\x0e\x0e\xce\x92\x31\x21\x87\x1e\x98\xd0\x58\xce\xb4\x06\xd0\x52\xbd\x5f\x71\xca\x1d\xd3\x05\x7d\x0f\xf6\x84\x3b\xb9\xd0\xab\xe2\x6a\xf5\x0d\x1a\x96\x43\xf4\xea\x11\x7c\x3a\x1a\xdd\xdc\xa1\xf1\xa6\x99\x1b\xc0\x4d\xd4\xe6\xc6\x81\x08\x2c\x58\xe1\xb3\x2f\xef\xc6\x6b\xd4\xdd\x72\x04\x95\xdb\xac\x23\x49\x8d\xc3\xa2\xb7\x40\x70\x49\xde\x33\x54\x35\xda\x06\xa9\xfc\x03\x48\x1c\xf0\x77\xa8\x36\x7e\x89\x77\x3b\xf4\x0a\x36\xe9\x7c\xbc\xf0\xbc\xc4\x80\x15\x41\x62\x1a\xd1\xe7\xcd\xf8\xf9\xb3\xc7\x2f\x5e\xe2\xa1\x61\x83\xbb\x54\xf0\x74\x02\x52\x6a\x71\x2d\x1d\x37\x6b\x93\x9b\x41\x36\x52\xb2\x39\xe0\xb3\x7b\x4c\x26\x58\x3d\xe1\x35\xe6\x71\x0f\x3e\xfb\xe0\x79\xef\x41\xdb\xa9\xe7\xbd\xa2\x07\x6f\xbe\xfb\xf4\x3a\xcf\x3d\x0f\x7e\xc0\xd3\xdf\x4f\x59\x11\x2e\xd9\x0f\x3c\x0a\xd3\xef\x74\x09\x33\x5b\x94\xb5\x66\x7e\x67\x7a\xbf\x2b\xbf\xc4\x6c\xd6\x3d\xde\x07\xec\xc1\x3b\x4f\xef\x3b\xb8\x51\x69\xdb\x84\xf2\xa8\x09\xbb\x0b\xc0\xf1\x96\xe1\xcc\x85\x36\xf5\x02\x0f\x48\x69\xa8\xa1\x9e\xf7\x04\xa6\xc8\xe0\x6c\xc3\x3c\x57\xca\xef\x00\x56\x16\xee\x4a\x79\x31\x70\x4c\x75\xd2\x72\x3f\xe5\x0d\x49\x92\xfb\x5a\xfc\xdc\xed\xfc\x76\x9b\x75\xa5\x4f\xa0\x6d\x56\x28\x08\xda\xc0\x1a\x6d\x50\x72\xc5\x92\xca\x10\x0a\x71\xe3\xc3\x12\xfd\xf7\x78\x54\x00\x69\xb4\x8c\x0a\x98\x45\xbf\x54\x92\x5d\x59\xf3\x61\x87\x93\x94\x22\x44\x42\x3a\x06\x6f\x70\x12\x15\x84\x60\xce\x37\x66\x37\xd3\xb0\xa6\x85\x43\x49\x0b\x6b\x02\xb8\x1c\x45\x7a\x77\xbc\x2e\xfd\x10\x2b\x4a\xf8\xe8\xc9\xd3\x80\x53\xe4\xfd\xb3\xe2\xe5\x14\x39\xf4\xe5\xd1\x93\xaf\x21\x22\xdc\xe4\x2d\xf8\x4b\x80\xff\xdf\x9b\xc7\x2f\x9a\x11\xcf\xc7\x10\x91\xb6\xca\x79\xfe\x18\xc0\xab\x1a\x6c\xd8\xb5\x92\xaa\x4d\xb2\x8a\xed\x93\x01\xa5\xa1\xe7\xf9\xe9\x90\x96\xa3\xa2\xba\x50\x74\x82\x9f\x90\x10\x63\x92\xd0\x70\x38\x21\xe9\x90\x5a\x1b\xc4\x90\x7c\x96\x0e\xdb\x69\x83\xb4\xf7\xad\xc4\x6a\xed\xd6\x8a\x25\xbb\x5d\x87\xa4\x61\x20\xd2\x19\xc8\x79\xd0\x4f\x2b\x8f\xe6\xe8\x2f\xde\xab\x6f\x16\x8f\xbe\xe4\x8d\xe4\xb9\xda\x13\x2f\xbe\xc6\x46\xc4\x49\xd9\xeb\x7c\xa6\x1c\x80\x7e\x8d\x49\x61\xd5\x00\x3e\x7b\x3d\xea\xec\x25\x47\x5a\xca\x91\x62\x7e\xf6\x1c\xc9\xfb\xbf\x03\x00\x45\x67\x38\x86\xec\x22\x96\x34\xce\x4c\xc6\xb9\x7a\xcd\x6d\xc2\xac\xf6\xed\xe8\x97\x24\xc1\xf3\xf1\x62\x7a\x18\x63\x2b\x23\xf7\x12\x3d\x8a\x5e\xd2\x44\x59\x8b\x6e\xe8\x5b\x14\x5f\x24\x7f\xbc\x01\x59\xe7\x0a\x3b\x46\xb2\xca\xf9\x64\xd1\xa4\xd8\x3a\xf7\x05\xd5\x91\x68\x1a\x99\x81\x29\xfc\xc1\xc4\x28\x11\xc9\xf2\x49\x4a\x85\xe7\x25\xbe\x80\x9d\x96\xe2\x6d\xe6\x60\xf9\x74\x3e\x59\x0c\xd9\x30\x9d\x3f\x5e\xd8\x03\xb7\xa2\xa9\x1c\x91\xea\xf8\x78\x8a\x33\x9a\xd5\x78\x4b\x8d\x82\x9b\x5d\x99\x57\x8c\x95\xe2\x8a\x46\x35\xc5\xb7\xb7\x67\xe1\x0a\x2c\x97\x20\x45\x7a\x20\x3c\x8d\xad\x47\x1c\xbf\x6c\x34\x94\x84\x7e\x8c\x6b\xff\x02\xb8\x96\x53\xcf\xb5\x2f\x35\x79\xee\xf9\x99\xba\xda\xc8\xd9\x29\xf0\xd4\x69\xd3\x14\x67\xcd\xc3\xad\x8e\x72\xec\x05\x19\x65\x31\xbb\x4e\x9f\xeb\x75\xfa\x42\x39\xaa\x9d\x00\x05\xe4\x2e\xbb\x3b\x69\x04\x75\x1f\xa9\xe8\xa3\xff\xfc\xad\xf8\xeb\x2b\xff\xb7\xeb\x21\x76\x77\x91\xf8\xa2\xe9\x37\xee\x2e\xdb\xac\x24\x26\xa9\x04\x4d\xcf\x0f\x1c\xdd\xb1\x3e\x8d\xa7\x59\x28\xc7\xd4\xae\x7b\xcb\xf0\x34\xeb\xbe\x19\x3f\x56\xf2\xea\xe5\xf1\x04\x4c\x78\xd6\xfe\x15\xe5\xbc\xf4\xa9\xe7\xc1\x5b\x3f\x6b\xd5\xd2\x76\x47\x07\xa2\xfc\x0d\x46\xde\x01\x6f\x78\x8e\x70\x7f\x4d\xeb\x19\xf6\x19\xd1\x9e\x94\xe0\xae\x2d\xef\xd9\x94\xcd\xb9\x75\xea\x9e\xf5\xca\xd5\x0f\x06\x4c\x1e\x89\x1d\x3d\x3b\x57\x32\xbb\x86\x62\xcf\x43\x6a\xcc\x81\x88\x05\x91\xd5\x82\x95\x60\xd7\xf3\x2a\x4c\x15\x65\x5b\x5f\x0e\x1d\xb5\x32\x73\x58\x7a\x5e\xdf\x54\xa9\x31\x82\xec\x92\x52\x2e\xd9\x46\x15\xd5\xeb\x51\x0c\x9a\x3f\xeb\xf1\xb8\xa1\x6c\xfb\xe3\x40\x2e\x91\x60\xce\x16\xd6\x67\x62\xd3\xb7\xc7\xc3\x34\x1d\x74\x9d\xa1\x99\xe9\x14\x66\x3a\xef\xfa\x9c\xdb\xed\x7c\x46\xd1\x5f\x11\x26\x49\x6f\x24\xfa\xab\xb2\x35\x18\x36\x48\x78\x79\xf7\x38\x7a\xf4\x0d\x0a\x9a\x60\x34\x64\x43\xf4\xcd\xab\x47\xea\x17\x91\x64\xce\x16\x74\x10\xba\xb4\x3a\xc0\x66\xf9\x9c\x2d\x82\x86\x9f\x0a\x73\xe7\x99\x80\x3c\xa2\x78\xd8\xfe\x4c\xe8\x76\x4f\x0a\x3a\x9f\x90\xbf\xbc\x52\xcf\x1b\x47\xe6\x2d\x8e\xa2\x52\x54\x0c\x7d\xf3\x17\x22\xaf\x1b\x10\xf7\x0d\x5a\x90\x48\x26\x46\xaf\xe0\x19\xe4\x1b\x24\xe3\xf4\xe7\x82\x54\x74\xfe\xc4\x46\xbd\x2a\x2f\x78\x7c\xfb\xcd\xab\x52\xe8\x54\xe2\x9b\x57\x8f\x34\xac\xce\x12\xeb\xaa\xaf\x56\x47\x37\x9b\x34\x2b\xe8\x5d\x77\x42\xdd\x14\x79\xf3\x59\x90\x9c\x6e\xd1\x5f\x51\x20\x1b\x33\x7b\x15\x27\x57\xaa\x16\xf8\x58\x90\x50\xb0\x10\xa2\x5e\x6d\xc2\x5c\xc5\xc0\xc7\x82\x44\x3c\x0d\xe6\x8f\x3b\xad\x34\x2d\x8b\x78\xba\x12\xbc\xd2\x79\x6c\xc8\x69\x71\xca\x56\x2c\x8b\x55\xe1\xcb\x84\xa5\x71\xc1\x4a\x95\xba\x0e\x2d\x48\x1e\x8a\x70\xa3\x12\xa9\xad\xa6\x92\x98\xef\x05\x29\x45\x4f\x33\xd4\x40\xb5\xc7\x88\xe7\x25\xb4\x22\x28\xe0\xb5\x93\x67\x41\x01\x2f\xfa\xf2\x2b\x22\xa6\x8d\x41\x44\x20\xa7\xfc\x5d\x72\x5e\xca\xdf\x35\x0b\x63\xf9\x1b\x07\x15\x29\xd7\x41\xb5\x27\x4b\x3a\x47\x51\x22\xa2\x94\x21\x82\xa2\x34\xc9\x3f\x84\xe5\x1a\x11\x14\xb3\x65\x81\x08\x62\x69\x9a\xe4\x85\x8c\x5b\x21\x82\x92\x4d\x08\xe6\x04\xd3\x24\x33\x3f\xa1\xf8\x9b\x08\xe3\x44\x99\x09\xdf\x84\xc5\x25\x22\x28\x57\x45\xe8\xf7\x57\xf9\xc5\xd3\xdb\x15\x37\x5f\x3a\xb7\xcc\x16\xa6\x4e\x6e\x21\x11\x90\xa4\x3b\x38\x58\x1e\x64\x37\x20\xab\x5a\xe4\x61\x86\x16\xd3\xa5\x3d\xee\xdc\xab\x92\x5c\xfc\x34\xd6\x9b\x63\xbc\xff\x12\x57\x1d\xe6\xed\x47\x49\xf1\x6e\x3f\xfb\xc4\xd3\xeb\xee\xba\x5f\xe2\x92\x61\x65\x60\xd9\xe1\x56\xfb\x19\xd0\x3d\x5f\x8c\x8c\xe4\xa5\xd9\xf1\x2d\xd7\xd2\xa2\x57\xda\xc3\xb5\xe7\x4f\x47\xdb\xd8\xda\x45\x83\xdb\x95\xbe\x38\xa0\xa3\xb3\x75\x52\x1c\x9d\xbc\x7f\x77\x24\xd1\xf3\xd1\x75\x58\x1c\x19\x43\x6b\x47\x17\xb7\x47\xbf\xa3\x61\x36\x44\xbf\x8f\x90\x51\x2d\x44\xa8\x43\x4a\x95\x9e\xe7\xff\xfb\x9c\x8d\xce\xcb\x70\xb5\xd0\xac\xb1\x01\x2d\x2d\x57\x55\xb5\x46\x42\x9c\xa1\xfd\x24\x8f\x0c\x8d\xec\x66\x17\x3e\x9a\x3c\x79\x81\x88\x2a\xa3\xc7\x3b\xb9\xea\xd6\x0c\x1d\xc1\x7b\xf6\x51\xb9\x66\xba\x95\x47\x4a\x82\xe0\x88\x2f\x8f\xc0\xb5\x7c\x6f\xbe\xba\xeb\x43\x34\x42\x01\xb2\x24\xae\xf2\x9e\x7b\x47\xcb\x7a\x7a\x23\x1b\xfb\xdc\xf1\x5b\xd5\x55\xf0\x3e\x5c\xd8\x3f\xc0\x93\xe8\xc1\x61\xd0\xc7\x8b\xac\x60\x82\x70\xdd\x38\x60\x41\xd5\xdc\xc9\x9a\x2e\x51\x31\xd0\xa2\xc7\x88\xc8\xe5\x61\x7b\xd6\xb5\x57\xc8\xb5\x19\x1f\xee\x9a\xf1\x79\x5b\xfb\xc0\x1d\x9d\xaf\x79\x01\x97\xe2\x30\xc9\x98\x78\x9b\x2d\x39\x78\xec\x39\xcf\x80\x37\xa3\x3f\x5c\x8e\xe9\xb7\x24\xa1\xe9\x4c\x47\x04\x42\x8f\xb6\x15\x2a\xfc\x27\xdc\x12\x94\xad\xbb\xc3\x36\x1d\x42\xb2\x95\xed\x09\x58\xc7\xf3\x70\x50\x82\x0c\x0a\x78\x98\xcf\xf6\x2e\xcf\x3f\x6c\xd8\xc3\x99\x9e\x8e\x72\xd7\x4d\x3e\x78\x34\x21\x3d\x7e\x9e\xd9\xc8\x94\xd7\xe0\xcf\x37\xca\xfa\x38\xca\x79\xa1\x5a\xaa\xf5\x3b\x1b\xd6\x83\x8b\x66\xea\x1f\xef\x4e\x1d\x35\x53\x7f\xba\x3b\x75\xe5\xe3\xed\x4f\x5a\x43\x4b\x29\xa5\xd9\xa8\xb8\x59\x10\x53\xfe\x9c\x24\xba\x79\x7b\xe2\x2e\x9b\x27\x5a\x27\xbe\xa4\xdf\x4b\xdc\x63\xae\xf8\x6e\x92\xa7\xe0\x65\x46\xee\x33\xfd\xe8\x95\x80\x58\x12\x0a\x20\xa0\x97\x58\xd0\x56\x88\x4c\xad\x3f\xc8\xf9\x07\xd9\xc4\x5c\x99\xe3\x8f\xb5\xd9\xe6\x92\xe7\x3f\xf0\x30\x96\x27\x02\xfc\x94\x78\xe1\x3e\x4a\x5d\x25\x31\xe3\xba\x86\xb0\x8a\x13\x7e\x67\x05\x8b\xa6\xe7\xdd\xbf\xe3\xbf\xf7\x79\xde\x3d\x58\x80\x7a\xc4\xe9\x69\x65\x46\xfe\x3e\xcf\x16\xa4\x6c\x3e\xbf\x29\xc9\x92\x2f\xe9\x31\x58\xbc\x92\x27\xa4\xfa\x6d\xf5\x39\xd9\xac\xfe\x25\x85\x92\x2f\x18\xef\x25\x17\x9b\x2f\xa9\xfc\x23\x03\xa1\x14\x24\xd4\xef\xe1\xca\x3f\x55\x17\x9b\x04\x8e\x6a\xfd\xd1\xee\x3c\xe8\x33\xa9\x09\xd7\x66\xe1\x55\xc0\x2a\x34\x7d\x41\xeb\xde\x66\x20\x2e\x22\xa9\x10\xf3\x55\xe2\xc5\xde\xb5\x47\x8e\xb7\x67\xb0\xc7\xd4\x59\x6b\x36\x59\x6b\x33\xc1\x01\xfa\x95\xba\x4e\xfd\x1b\x01\xc2\xfc\x3f\x14\x0b\x99\x61\x77\xa7\x3c\x03\xd7\xfb\xff\xa6\x28\x8b\xa6\xd9\xf1\x86\xfd\x56\xe3\xf0\x1f\x1d\x23\xfc\x0d\x1d\xd7\xc7\x5d\x52\x34\xcc\x8e\x37\x55\xa6\xa7\xf2\x70\xee\xb5\x91\xe4\x38\xd7\x59\xb5\xad\x7c\xeb\x98\xc6\xdb\x92\x63\x56\xca\xda\x48\xd5\x87\x95\x1b\x97\x0b\x76\x95\xf0\xaa\xf8\x24\xcf\x8c\x83\x11\x6f\x78\x7e\xeb\x46\xae\xb5\x29\xa3\x36\x4c\x39\xe7\x6c\xd4\x6d\x31\x12\x35\xe6\xc4\x62\xbe\x71\x42\x9d\xe3\xc5\xcd\xed\xae\x05\x17\x5e\x36\x8d\xc1\x36\x5c\xe5\xa5\xe1\xaa\xa0\xca\x06\xe8\x85\xe6\xae\xad\x94\x0d\xd0\x73\x9a\xf9\x2f\xc7\x98\x5c\xc9\xdf\xc7\x98\xdc\x6a\xa6\xdb\x8d\x7e\x08\xba\xd6\x02\xcb\x97\xf2\x92\x34\x1e\x2b\x73\xde\x4f\x9f\x28\x73\xde\x4f\x9f\x2a\x73\xde\x93\xf1\x63\x65\xcf\xfb\xc9\xd7\xca\x9e\xb7\x24\x10\x3f\x42\xc4\x33\x65\xd0\x7b\x32\x06\x8b\xde\xf2\xe3\x25\x98\xa9\xf7\x27\x93\x31\x26\x3f\xd4\x66\xad\x26\x93\x09\xc6\xe4\xad\x8c\x79\x32\xc6\xe4\xbf\x64\xcc\xd7\x20\x63\xf1\x04\x63\xf2\x53\xed\xf7\xf6\xf9\x0b\x95\xfc\x25\x04\x9e\x61\x4c\x5e\x43\xe4\x13\x63\x1d\xeb\x1d\x26\x27\xf4\x54\x3f\x40\x98\xc3\x8e\x7c\x4f\xdf\xf7\x11\x9e\xe4\x9f\xf4\x83\xde\x51\x67\x9c\xfc\x4a\xdf\x1c\x72\x4a\x4e\xbe\xa3\x5b\xc5\xe6\x6b\xa8\x05\xee\xc9\xcf\x14\x01\x71\x81\xc8\x3f\x28\x3a\x3f\x57\xaf\x79\xbf\xd0\xad\x7d\x6d\x56\x5a\x4b\xfd\x94\x8c\x56\x67\xa9\xf2\x5c\x48\xf2\xb9\x29\xea\xfb\x73\x28\x32\x59\x9f\xb2\x7c\xf6\x2d\x9d\x4c\xc8\xdf\xe9\xb6\xe4\xf9\xeb\x0b\x2e\xca\x00\x85\xf2\x07\x91\x92\xe7\x6f\xc2\x0c\xa4\x95\x51\x14\x66\x79\x1a\xde\xba\x40\x63\x9d\xdb\xc4\x95\x2a\x0c\x49\x4e\x2a\xd5\x4f\xa5\x11\x18\xa0\x58\x87\x8d\x4b\x01\x89\x66\x37\x79\x99\xb0\x38\x40\x4c\x7d\x28\x60\x16\x89\xdb\xbc\x04\xb0\xf9\xd4\x11\xb1\x02\xc6\x06\x00\x5e\x53\x2d\x92\x56\xd8\x98\xc5\xe0\x56\x11\x50\x32\x8b\xe3\xb0\x0c\x9d\xa8\x77\xac\x0c\x63\x27\x7a\xa3\xc3\x36\x09\x38\x23\x56\xb1\x20\x8a\x09\x11\x1f\xc2\xaa\x60\x01\xca\xe5\x8f\x02\xc0\x78\xd8\xc1\x90\x41\x39\x96\x00\x49\xb2\x95\x02\x0a\xbe\x92\xe3\x1e\xa0\x5c\x7f\x01\xf8\x63\x58\x32\x33\x22\x22\x2c\x99\x33\x1a\x9f\x18\xbb\x94\x1d\x2c\xe0\xd7\x82\xa0\xe4\x42\x7d\x28\x60\x19\xa6\x60\x98\xbe\x50\x1f\x0a\x58\x15\xb9\xbc\xfe\xa2\x42\x7d\x00\xf0\x2c\xd9\x18\x0b\x95\xa8\x4c\x36\xda\xb8\x2f\x44\xfd\x83\xa7\xd5\xc6\xb6\xe4\x0a\x42\x4e\x5b\x7e\x0e\x93\x12\x2a\xbe\x56\x1f\x68\x4f\xfe\x46\xb7\x70\x7b\x1f\x8c\xc9\x45\x58\x80\x02\xea\x05\x28\xaf\xca\x9b\x3b\xe8\xb7\x5e\x30\x50\x60\x5d\x03\x34\xd9\xc0\x62\x86\x33\x48\x7e\x5c\xb2\xdb\x15\xcb\xe4\x57\x9a\x64\x97\xa0\x07\xcb\xca\x50\x29\xbf\xca\x2b\xf9\x60\x4c\xb4\x78\xe9\x60\xac\xcd\x2a\x0c\xc6\xe4\xfa\x42\x6d\x84\x3f\xe8\x56\x6e\x24\xbd\x43\x72\xc1\x8c\x3e\xac\x6e\xd2\x9e\xfc\x3b\x5d\xf9\xdb\x0d\xcb\xaa\xa4\x64\x1b\x80\xfc\x0d\x93\xff\xa0\x8f\xfe\x73\x1e\x1e\xff\xf1\xfa\xf8\x3f\x16\xfa\x37\x38\xff\x6d\xf4\xdb\xf1\x6f\xf1\xe2\xaf\x5f\x3d\x22\xff\x46\xb7\x7b\xf2\x15\xdd\xee\x5b\xb4\x0d\x61\x8c\x4e\xa6\x1b\xf0\xb7\x96\x86\x20\xdc\xa9\x8d\x5c\x9f\xbc\x7f\x67\xcd\x24\x21\xb2\x19\xbd\x4b\x6e\x92\x8c\x7e\xc6\x68\x1e\x77\x9d\x8f\x6a\xec\xcc\xd8\x70\xd8\x40\xd0\xd9\xe8\x3c\x89\xdf\xc8\x72\x98\xb0\x51\x0e\x92\x2f\x0f\x23\x70\x6d\x81\xa9\xef\x18\x03\x6f\x1c\x85\xa5\x41\xcd\x89\xa6\xa9\x4e\x4d\x06\x3a\xe4\x4a\x97\x18\x05\x12\x0a\xbe\xe4\xc4\xb5\x28\xd4\x06\xf1\xe6\x52\x99\x3d\xe7\xc9\x36\xad\x7d\xd5\x6a\x7b\x8b\x77\xde\x49\x62\xe8\x30\xee\xa1\x68\x3e\x2a\xbb\x83\x2e\x65\x41\x52\x02\xa6\xc8\x3f\x1a\xcb\x7f\x72\x2a\xb5\x09\x44\x70\xf7\x73\x67\x55\x95\xaa\xea\x0b\x5a\xa4\x18\x3f\x28\xf8\x74\xb8\x49\x9f\x7a\x9b\xd4\xa0\x7e\x35\x71\x76\x76\xb8\x90\xb3\x2f\xea\x57\x4f\x83\x6b\xda\xef\xc7\xc3\xb5\xfd\xf8\x3f\x30\x8a\x7b\x61\x87\x42\x39\x68\xc8\xb5\x19\xb6\x72\xe6\x87\xb4\x6c\x92\x57\x24\x97\x10\xb9\x70\x83\x0c\x7e\xc1\xf9\x42\xd6\x49\xa4\x22\x31\xd1\x92\x41\xe1\x6e\x17\x52\x4a\x6f\x46\xc5\xd5\xca\xf3\xe4\x12\x67\xc9\x2a\xab\xe5\x8b\x72\xe5\xc4\xe1\x46\x49\x05\x11\x95\x56\x7e\x7b\x9e\x8f\x8a\xab\x15\x88\x37\x98\x3d\x33\x0b\x55\x41\x01\xda\x84\xe5\xba\x11\xa5\x4b\x51\x72\x36\xb8\x97\x3e\x0c\x95\x0e\x31\x98\x83\x1d\x55\x05\x7b\xd3\x70\x05\x0d\x44\xe9\x9a\x6c\x64\x07\x9a\xf7\xf7\x64\xe9\xd7\xcd\xc2\xc9\xd2\xbe\x4b\xb9\xf5\xab\xfc\x17\x74\xd3\x2f\xac\xb2\xea\x47\x0d\x40\x00\x5f\xb4\xd8\xdd\x2b\xcd\xee\x5e\x01\xbb\x7b\x4d\x2f\x1a\x2f\x54\x17\x0d\x21\x15\x78\x52\x5b\xd3\x74\x94\x14\xb3\x76\xd5\x07\x6b\x24\x32\x39\x0e\xee\x9d\x5e\x8b\x27\xae\xdb\x9d\xfb\xf1\x93\x1f\xf6\x52\xee\x3a\xd7\xfb\x51\x2e\x58\x14\x46\x6b\x78\xb1\x55\x6b\x6d\x8d\x5d\xaa\x75\x47\x5f\x4b\xbc\xff\x46\xa6\x51\xc4\x3a\x3c\xd1\x75\x50\xef\x6e\x77\xd9\x90\x75\xff\x8e\x8b\x8f\x9c\x97\xbe\x2d\x4d\x9d\xab\xb5\x36\x40\xc2\x0a\x5f\x99\x40\x24\x5a\x81\xf0\x8a\xde\xfa\x6b\x3c\xd5\xcd\x85\x6e\xbc\x55\xe6\xb2\xcc\x25\xc1\x67\x24\x25\x9c\x5c\x61\xb2\xa4\x57\x7b\xab\x46\x7f\x4d\xdd\x3c\xef\x73\x96\x9d\x85\x2b\xf5\xf4\xfc\x3a\x8b\x3f\xd4\x1c\x97\x42\x16\x20\x49\x68\x37\xbd\x26\xfa\x54\x7a\x55\x03\x9e\x2e\xe9\xe0\xd4\xf3\xfe\x36\xb7\xab\x67\x31\xbb\x1e\xa2\x47\xdf\xa0\xe0\x5a\xce\xf9\xf0\x74\x88\xe4\x02\x38\x38\xb4\x32\xd1\xfe\xc0\x81\xa2\x11\xf4\x67\x30\x40\xa2\x11\x45\x3a\x0a\xab\x92\x83\x1b\x1c\xcf\xfb\x4c\x9e\xf3\xd1\x52\xa6\x73\xcf\xdf\xbb\x90\xdb\x67\x4a\x2b\xfe\xbb\x5a\x60\x50\xf9\xbf\xbc\x60\xa5\x13\xfe\xdf\x50\xb0\x39\xc1\x3e\x53\x56\x64\xb0\xb6\xba\x70\x2f\xf7\xe4\x1e\x6b\xb2\x97\x49\x2f\xb1\xcc\x61\x7c\x64\xa5\x96\x81\x4b\x8b\x41\x85\xba\xc5\x78\xe2\x96\x5d\x5a\xce\xf9\x62\x6a\x2d\x7d\x82\xe3\xda\x5f\x7b\x52\x0b\xcf\xd3\x5a\xde\x9c\x08\xa2\x31\xca\x96\x53\x4a\x7f\xf6\x3c\x5f\x28\xf3\xa3\x07\x6e\xe1\x2b\x7f\xbb\x27\x9a\xc7\x8b\x31\x11\xf4\x4a\xa3\x21\xd5\xe1\xef\xb8\x80\xb4\x85\xaf\x4c\xee\x61\xe3\x37\xdd\x31\xa0\xea\x1c\x13\xce\x8e\x21\x25\x9e\xfd\xd2\x6d\x2c\x08\x3b\x5d\xb6\xeb\x68\xa9\xc6\xfa\x9c\x08\x8c\x83\x9e\x84\x75\x49\x44\x60\x12\x7a\x9e\x9f\x0d\x29\x3a\x42\xc3\x10\xef\xf7\x8e\xc1\x80\x2c\x66\xe2\x8c\x4b\xc2\x2c\x89\x54\xe6\x59\x16\xf8\x5d\xcc\x67\xb2\x77\x2a\x02\x0c\x88\x31\x39\x14\xff\xf6\xc4\x77\xa8\x5b\x8c\xed\x7a\x69\xe0\xa4\x8e\x81\xd1\x2b\xed\x37\x8c\x88\xc3\xef\x01\xee\x8c\xeb\xdf\x91\xba\x1c\x83\xe1\x23\x13\xc0\xb5\x31\x92\x94\x7e\x37\xb7\xec\x7a\x73\x79\x5e\xcc\xea\x6f\x6d\xec\x41\x7b\x26\x4e\x67\xf2\x37\x28\x9b\x72\xf5\x2a\x0a\x73\xfa\x5f\xbe\x91\x99\xb7\x60\x6b\x12\x1b\xfa\xac\x6e\x03\x06\xb1\x87\x60\xf3\x77\xca\x69\x32\xfa\x2f\x9e\x64\x3e\x42\xf5\x54\xfc\xe1\x60\x61\xcf\x43\xbf\x81\x5b\x3a\x0e\xc2\x60\xaf\x4b\x7f\x8c\x67\x12\x34\xe4\x01\xb7\xc3\xd7\x3a\x36\x7a\xae\x1a\x7a\x6b\x7c\xc9\xe8\xdd\xd6\x72\xc9\x72\x01\xfd\xf7\x0e\x24\x42\x03\x4a\xeb\x4a\xcf\xd8\x4d\x09\xbe\x39\x3b\x43\x6b\x50\xc2\x1d\xa3\x4b\x94\xef\x9f\xc4\xf5\xfd\x73\xeb\xc8\x5c\xfb\x9c\x24\xf3\x62\x81\xf7\x9f\x35\xd9\xed\x3a\xca\xef\xd8\xec\xbe\x8b\x51\xa8\x88\x00\xd7\xfe\x36\x57\xc6\x9e\x49\x2b\xe6\xc0\x94\xa5\x54\x5f\xd6\x48\xf8\x05\x37\x39\x7d\xf0\x1e\xbc\x00\x85\xbd\x11\x61\xef\x49\x70\xe8\xca\x42\xc2\xde\x88\xb0\xff\x00\x3c\x74\x65\x09\x7b\x23\xc2\x03\xe7\xf8\xc1\xbb\x48\xd8\x1b\x11\x62\x43\x99\x08\x03\xb8\x83\x46\x4b\x49\x58\x7b\xb2\xb0\x09\xec\xd2\x82\x68\xc2\x31\x39\x30\xd8\x1f\xf5\x9c\x37\x38\xdc\xe4\xa7\x7e\x0f\xa3\x77\x5e\xc4\x7a\xca\xe9\x1b\xd3\xcf\x1c\xd1\xb9\x3e\xa2\xf7\xa4\xaf\xb7\xfd\xeb\x9c\x08\x12\xc2\x99\xcb\x95\x27\x64\xb0\x54\xd8\x3d\x95\x24\xa9\xd1\x03\xd4\xaa\x41\x73\xbe\xc0\x5a\xe3\xee\xe7\x06\x22\xec\x9e\xa7\x50\x97\x90\x75\x25\xb8\x23\x9e\x23\xd4\x75\x2c\xdc\xed\xb6\x7b\x12\xce\xc5\x82\x22\x64\x88\xe6\x7e\xfe\xb8\xba\x82\xf4\x9c\xf9\x33\xd9\x28\xcf\x3b\xd1\xc7\x3e\x0e\xdc\x93\x97\x1d\x38\x79\x2f\x35\x63\x17\xa6\xee\x3b\x2e\xea\xf3\xf6\x7b\x3d\xb9\x1c\x07\xfe\xb5\xf1\x70\x9a\xb0\x62\xce\x17\xbb\xdd\xf5\xa8\x63\xbb\x42\x52\x1d\x9e\xd7\x2e\xcf\x56\x56\x17\x57\x8f\xbd\xa6\x8f\x0a\x20\x6a\x48\x44\x61\x34\x67\x07\x3a\x6f\x94\xb2\xa0\x9b\xc6\x76\x72\x3f\xad\xe4\x79\xc5\x80\xd2\xc8\x3e\xa2\x17\xe6\x69\x24\xc2\xf5\xa4\x25\x4b\xbf\x98\x15\x77\x13\x41\x05\x0e\xee\x7c\xaa\x88\x94\xd6\x1f\x4c\x6e\x84\x07\x51\x77\x76\x77\xbb\xc2\xf3\x8a\x3e\x78\x77\xd6\x6d\x51\x05\xee\xc9\xe1\x79\xd1\x5c\x2c\x06\x94\x16\x73\xb1\x68\x2f\x1a\x09\xd3\x97\xd3\x90\x16\xf6\x38\xe9\xa3\x0c\x8b\x99\x25\x0c\x0b\x92\xe1\x20\x72\x96\x8c\xcd\xd8\x24\xda\xf0\x81\xa5\x63\xcc\x70\xf6\xaf\x1b\x52\xd4\xe5\xdd\x77\x01\x69\x8d\x0f\x5d\x86\x26\x26\x8b\x59\xa3\x2a\xdb\x88\x0a\xea\x08\x0e\x2e\xb9\x0a\xbc\xa9\x86\x9e\x77\xe5\x66\xd7\xb4\xab\x69\x66\xa8\x31\x08\xe9\x62\xc3\x83\x84\x86\x25\x08\x98\x43\x10\xb0\x16\x41\x70\x6f\xba\x81\x1d\x96\xdb\x38\x18\xa5\xc9\x14\x92\xdc\x25\x42\x72\x30\xca\xe4\x2e\x34\xcd\x22\x14\xcd\x52\xf7\x80\x44\x87\xa8\x19\xad\x63\x32\xa0\xc2\xec\xa9\x90\xc4\x26\xb1\x01\x25\x66\xe2\x14\xba\xa4\x34\x9a\xb9\xf4\x82\x39\x6c\xa0\xff\x72\x58\x83\xca\xf3\x06\xb1\x76\x8c\xa3\x12\x9d\xd5\x7a\x12\x92\x7a\x24\xa6\x39\x42\x11\x50\x07\x53\x0e\xad\xf6\x66\x32\x0b\x07\x94\x26\x8d\xb4\x9a\x2b\x80\xd0\x30\x31\xc9\xa2\x46\x02\xdb\xb6\x48\x59\xc6\xea\xf7\xba\x62\xdf\x60\xbf\x37\x46\x7c\xef\x70\xb6\x52\x2b\x3c\xfc\x8f\x71\xa2\x1d\xb3\xe1\x07\xde\xba\x95\x88\xd6\x1d\x1a\xcb\xe5\x3c\x5b\x68\x36\x98\xdf\xc7\x8f\x6e\x9d\xe7\x3f\x35\x0d\xeb\x76\x8e\x73\x78\xc6\x53\x99\xd6\x2c\x8c\xf5\xe7\x05\x8f\x6f\x11\x3c\x79\x3f\x47\x0e\xc5\xa1\x2c\x87\x9b\x21\xb5\xcc\x22\x4c\xde\x8f\xaa\xac\xc9\xde\xc2\xc4\xbc\x4c\xbe\x4e\xd3\x9a\x2f\xa4\xa2\xee\xf9\x3c\xdc\x79\x01\x86\x59\x6f\x7a\x6c\xb8\x63\xee\xf7\x64\xe5\x6f\x1c\x8b\xd4\xfa\x81\x84\xfc\xa0\x7e\x5d\xc1\xc0\xcd\x7d\xd5\x35\x9e\x29\xc1\xc0\x97\xe0\xaa\x68\xdb\xe1\x68\x34\x5a\xe3\xf3\x7e\xff\xbe\x70\x33\xbf\x4b\xde\xef\x4e\xdd\x9f\x52\xdc\x6e\x99\xe2\xa5\xf8\x0d\xb3\xee\x0f\x57\xc8\x7a\xf9\x04\x3b\x0a\x91\xf6\x85\xfa\xe5\x53\xf8\xff\x1c\x2b\x11\xff\x97\x2f\x94\x88\xff\xcb\x97\x5a\x17\x45\x86\xfd\x1e\x4b\x6f\xa1\xcf\x40\xa7\x29\xa2\x83\x09\xa9\x28\x8a\x8a\xe2\xbb\x94\x87\x25\xea\xd5\x1e\x8c\xef\xd6\x1e\x54\x4c\x0e\xb0\x86\x1f\x8f\x96\x3c\x2b\x29\x42\x75\x6f\x23\xf0\xf4\x09\x94\x06\xa5\xf4\x90\xae\x96\x2a\x63\x64\xda\xe1\x79\x7e\xa5\xdf\xb0\x55\xbb\x94\x02\x4f\x4e\xb7\xbd\x4c\x94\x7e\x7e\x11\x6a\x32\x85\x80\x48\xed\x21\x47\xcd\x81\x34\x86\x2b\x74\x2d\x0f\x72\x8c\xe4\xa0\x82\x04\xbe\x46\xd2\x8a\x2b\x52\xf8\x1c\x0f\x51\x80\x48\x36\xa4\xa9\xcf\xe5\xe1\x47\x04\x1e\xa2\x29\xb2\x5c\x2e\x2d\x35\x59\xdb\x11\xef\x6f\xa9\xa1\xa6\x05\x65\x7a\x0c\x4d\x83\xc3\xc3\x5c\xac\x10\x1b\x52\x59\x36\x38\x6c\x35\xb8\xa0\xa9\x1f\x92\x72\x1e\x2e\x94\xe5\xce\x64\xe9\xa3\x25\x8c\xa0\xd2\xc7\xaa\x67\x5a\xf9\x90\xf2\x43\x5a\x61\x92\x60\x21\x4f\xf7\xba\x0e\x97\xec\x28\xb0\x98\x87\x0b\x4d\x10\xe9\xf5\x10\x79\x1e\x1f\x15\x6b\x2e\xca\x75\x98\xc5\x26\xdf\xe9\x4d\x1e\x66\x45\xc2\xb3\x62\x1e\x02\x7f\x2d\xb6\xd8\x31\x07\x0b\xd7\x58\xcc\x73\x49\xa6\xa9\xb2\xa1\x58\x84\x5a\x86\x0d\xf2\x7b\x6f\x30\x57\x8e\x67\x58\xd6\xcc\x8f\x51\xc9\x7f\x92\xc8\x48\x09\xdd\x34\x54\xca\x26\xd8\xd8\x61\x0e\xb3\x64\x03\x72\x05\x6f\x4b\xa6\x05\x0e\x24\xa6\x84\xe7\x69\x30\xf2\xf5\x76\x13\xae\xd8\xfb\xaa\x2c\x58\x1b\xf8\x29\x4d\xd4\x73\xb3\x03\x53\xb6\xb3\x00\x76\xf3\x5d\xca\x6e\x9c\x4f\x65\x7f\x4a\x85\xdf\x8b\x38\xc9\xc2\xd4\x82\x22\x9e\x56\x9b\xba\x66\x15\x2c\xe4\xe7\x52\x17\xb2\x54\x25\x5c\x9b\xef\x0f\xbc\x48\xca\xe4\x8a\x99\xf0\xa7\xb5\xd0\xef\xe2\x32\xf4\x23\x5b\x85\x6e\x2c\x78\xa2\x06\x43\xfa\x22\x89\x3f\xaa\x52\xf4\xe7\x69\x16\x3b\x21\xb0\x69\xe5\x04\x41\x9e\x41\x87\xdf\x40\xab\x9a\x21\x27\xb7\x02\xb8\x05\x68\x88\x29\x43\xa2\x84\x9f\x95\xa9\x31\xf5\x8c\xcf\xde\xa4\xe1\x26\x37\x81\xef\x6d\x14\xcf\xc3\x28\x29\x6f\xe1\xd3\x34\x9c\x8b\x7c\x1d\xaa\x21\x29\xc3\x8b\x4f\xc9\x1f\xd0\xb7\xeb\x24\xe6\xd7\x00\xfc\x43\x99\xb5\x92\x5f\x9c\x83\x38\xc0\x32\x49\xd3\xf7\x75\x49\xcb\x94\xf3\xd8\x09\xcb\x33\xb6\x11\x14\xfc\x92\x9d\x84\xc5\x3a\x14\x22\x6c\x81\xf8\x72\xa9\xa7\x5f\xc1\xde\x25\x25\x13\x60\xcd\xa4\x86\x75\xca\x32\x4b\x61\x4f\x04\x9d\xa3\x9f\xd9\xc5\x25\x08\xdb\x6d\x0a\x44\xd0\x3b\xfe\x07\x22\xe8\x3d\x5a\x4c\xb5\x73\x84\x4b\x76\x2b\xf1\x49\xaf\x74\xbc\xe8\x42\x4b\xbc\xe5\xf3\x0c\xbc\x2f\x2d\x28\x9f\xb3\xc5\x1e\xef\x8d\x23\xa5\xed\x45\x18\x5d\xae\x04\xaf\xb2\x38\x70\xbe\x5f\x83\xcf\x26\x30\xdb\x0a\x22\x18\x06\xfe\xc6\x18\x22\xaf\x41\xb0\x92\x9b\x20\xb5\xdc\x78\xf6\x4b\x3f\xf8\xd7\x26\xf8\x23\xcb\x59\x58\x42\xdf\xbb\x69\xdd\x46\xdd\xa3\xd8\xbd\xde\x5e\xc1\x56\xfd\x3a\x3b\x4c\x06\x01\x9d\xd6\x41\xd3\x1b\x93\xeb\x5b\x5e\x96\x7c\x63\xf2\xaa\x50\xab\x04\x05\x6c\x95\xa3\x80\xed\xd2\xc0\x74\xe9\xb6\xfe\x6e\x95\x24\x41\xad\x72\x24\xa8\x5d\xca\x47\x58\xe7\x5b\x27\xd0\x2a\x07\x60\xad\x82\x00\xd6\x2e\xe9\x8c\xe7\xa6\x9c\x33\x9e\xb7\x4a\x39\xe3\x79\xab\x8c\x33\x9e\xd7\x25\xc8\xdd\x18\x6c\xe5\x7f\x9b\x4a\x06\xfe\x11\x8a\x24\xcc\x7a\xf6\x2b\xa4\xd4\xdb\xae\xb9\x5d\x65\xcc\x77\xe1\x26\x49\x6f\xa1\x60\x5e\x95\x60\x93\x6f\xab\x3f\x6c\xb3\x74\xd8\x56\xa7\xc3\xb6\x49\x7b\x12\xd2\x6d\x52\xfc\x94\x25\x65\xca\x8a\x42\x79\xfa\x0b\x38\xb9\xe3\x7c\x09\x52\xf7\xc8\xf8\x12\x0f\x6b\x47\xc2\xb7\x3a\xaf\x7e\x2a\xb7\xe7\x31\xc2\xb5\x15\xdf\x97\xa0\x4e\xf2\xe8\x3f\x8f\x37\xc5\xf1\x1d\xca\xc2\x77\x92\x7f\xce\xb3\x89\xaa\x86\x93\x5e\x6d\xf4\xb2\x79\x60\xed\xcd\x19\xf5\xe8\xd8\x1f\xe1\x47\xab\x2f\xf3\xe9\xd9\xba\x6d\x6b\x8b\x27\xbb\x5d\x57\x73\x5a\x02\x41\x2a\x00\x68\x3f\xa3\x5a\x82\xf4\x7b\x54\x52\xfc\x18\xfe\xa8\x35\xd6\xf9\x6e\x17\xee\x76\x5a\x83\x32\xed\x2a\xc9\x79\x5e\x3a\x67\x0b\x5b\xc2\x10\x4a\xec\x53\x44\x2e\x69\x39\x2a\x45\xb2\xf1\x2d\xc5\x54\x0e\x51\x7e\x83\xea\xe1\x7f\xa2\xc9\xdc\x17\x98\x88\x51\x7b\x6d\xe0\x07\x68\x6f\x1f\x9a\x7b\xec\x4e\xfe\x31\xcc\x7e\x5d\xfb\xd7\x6a\xf2\xff\xb5\x73\x8f\x8e\xbf\x9a\x20\xdc\xd2\x6c\xd6\x33\xed\xcf\x5f\x1f\xff\xc7\xe2\xce\xc9\xbe\x87\x95\x81\xed\xde\xe8\x8a\xda\x8c\x99\xb3\xca\x7a\xfc\xfa\xf8\xf2\x8a\x4a\x5d\x47\x55\x19\xc6\x44\x39\xaf\xf9\xb2\x65\x57\xeb\xa5\x76\x18\x79\x0c\xef\x76\x83\x0e\x53\x4e\x2e\x1b\x3f\xb1\x22\xdc\x7e\xa4\x44\xb6\xc9\x60\x8c\x03\xbf\x30\x81\x09\xee\x6a\x81\x37\x4d\x21\x6a\xc3\x86\xdf\xaa\xe5\x0d\x54\xb7\xe7\x0d\x0c\xf8\xc7\x6a\xc3\x44\x12\x69\xb0\x59\xd4\x3a\xd2\xd0\x54\xcd\x44\xe5\xab\x89\x8e\x7f\x7f\xc5\x84\x12\xfc\x6c\x96\x5e\x82\xcd\x44\xab\x68\xfd\xe4\x85\xf6\x84\xed\x9a\x63\x9b\x8c\x27\x60\xf0\x40\xad\xe5\x86\xfd\xe1\x39\x1a\xa6\x6d\x9b\xc3\x9f\xce\x5e\x7f\x3c\x33\x96\x87\xfb\x12\x34\x8c\x12\x17\x74\xbb\x27\x91\xfc\x57\x75\x6e\x46\x6f\x4f\xfa\x4c\x7c\xa7\xa3\xb7\x27\xe7\xcd\x22\x87\x88\xa2\x21\x5c\x08\x49\x4b\x5a\xa5\x51\x84\xb2\xd3\xd5\xb0\xdd\xd8\x53\x18\x29\xf1\x9e\xf4\x3c\xf9\xf6\xdc\xfd\xd3\xd1\xc7\xf7\xef\xcf\xda\x8d\xf9\x0b\x45\xe8\x2f\x9d\x96\x34\x8b\x60\x3d\x2d\xe9\x29\x8b\x20\xd4\x6d\x8c\x59\x77\xbd\x57\xc6\xd4\xe1\xb0\x76\xd7\xe9\xcc\x8d\x36\x6a\xbb\x4a\x1f\x6f\x2b\x51\x27\x28\x07\x36\xf1\x27\xa7\xe0\x34\xb3\x36\x2a\x6a\x95\xb9\xdb\x6b\x75\xb7\xcb\x3e\xbb\xd4\xc6\x33\xae\xc6\x27\xe0\x7a\xd2\x4a\x8b\x40\xd3\x1e\x5e\x30\xc3\x33\x63\x27\x14\xa1\x80\xd9\x3c\x5a\x8e\xf2\x6e\x59\x81\x7e\xbb\xa3\x1c\xf6\xab\x11\xb8\x73\x8a\x44\xa8\x71\xdb\xed\x1d\xe6\xfa\x95\xf4\xae\x81\x2e\x5b\x03\x5d\xd6\x03\x6d\xbd\x22\xf1\xd1\xa6\x61\x2c\x15\xc4\xdd\x70\xe8\xc3\x8b\x39\xdc\x53\x61\x42\xb8\x44\x67\x8e\xa1\x19\x65\x9d\xed\x00\xa7\x9b\x11\x7d\xca\x8d\x36\x55\x51\xfe\x54\x58\x97\x65\x98\xcd\xb9\x69\x10\xc8\xf4\x2e\x68\x56\x5f\x86\x13\xca\x9b\x53\x0c\xf6\xcb\x1b\x10\x10\xe6\x9b\x16\x2d\xe3\xaa\x3f\x7e\xf2\x0b\x92\x10\x84\x86\x19\x0e\x78\x77\x3d\xf0\xbb\xd6\x43\xa6\xd6\x43\x6b\x1b\xc8\xd2\x70\xd0\x03\x1c\x66\x78\xbf\xdf\x9b\x1b\x7d\xdf\x5a\x29\x9b\x03\x55\xf5\xbf\x4b\x68\x53\xa0\xa4\x2f\xb2\x33\xd5\x72\x2c\xc1\xb0\x93\x5a\x84\x59\x8f\xb1\xda\xb2\xd3\xda\xd2\xb4\x96\x1c\x78\x53\xeb\xa0\xa4\x6e\x99\xed\xbc\x5f\xbc\xe7\xef\x58\x8a\x76\x29\x67\x3d\x4b\x91\x63\x49\x80\x69\x65\xce\x5a\x2e\xa1\xb3\xb0\x0c\x71\xd6\x58\x5d\xd3\x0e\x6e\x98\xb1\xb9\x58\xd0\xc1\x24\x60\xea\x95\xcb\x18\xae\x6a\xf7\xbc\x85\x6b\xf4\x5b\x56\xff\x6c\x7b\x5e\xef\xc8\xb9\x64\x75\xf5\x05\xc7\xfe\x5f\xd0\x5f\x86\x92\xba\x1a\xfe\x05\xfd\xc5\xd2\x53\x5f\x3f\xf9\xd3\x14\x9b\xbe\x2e\xd7\x56\x35\x9a\x33\xa5\xcd\x6b\x90\x0d\x58\x71\x98\x6f\x16\x74\x39\x1c\x92\x78\x2e\x3f\x17\x74\xbb\xc7\xe6\xdb\x71\x51\xa6\xfd\xbf\x3c\x7d\xaa\x78\xa6\x93\xf1\x13\x65\x17\xe5\xc5\x13\x4c\x22\x00\x3c\x05\x6b\x60\xa0\xdd\x13\xcb\x43\x36\xa7\x83\x09\x59\xd2\x31\x59\xf7\xab\xbc\xbc\x36\x3c\xa5\xd3\x2c\x0e\x22\x1f\x59\x1e\x13\xcb\x62\x84\x77\xbb\x26\xa0\x91\xc3\x72\xa1\x1a\xf9\x12\x03\x6d\xe6\xae\xc1\x8d\x32\x14\xc3\xc5\xcd\xaf\x74\x51\x1a\x79\x6b\xf5\x94\x6f\xd3\x4a\x04\xe8\x22\xad\xc4\x9f\xd2\xd7\x31\xba\x20\x8e\x16\xc8\x9b\x34\x89\x2e\x03\x14\xc9\x1f\x05\xe0\x9b\x5c\x5f\xed\xe5\xd0\xa0\xa8\x0e\x9b\x91\x70\x92\x68\x65\x1a\x27\x51\xdd\x68\x27\x99\xd1\x50\x71\xd2\x39\x8a\x2a\x6f\x1c\x87\x04\x48\x7b\x27\xd8\xb0\xac\xd2\x91\xb9\xec\x28\xcf\x75\x2f\x2b\x59\x5d\xa5\x6a\x38\xe1\xd5\x45\xca\x74\x17\xe2\x8b\xb4\xee\xc5\x89\x08\x57\x01\x8a\x45\xb8\xb2\x41\xe8\x8d\x84\x98\x6e\x28\x60\xc9\x84\x01\x83\x93\x24\x13\x71\x93\x94\x1a\x7e\x93\x94\x16\xac\x7c\x82\x01\x5c\xbb\x6c\xd2\x11\x12\xf9\x2b\x38\xbf\x72\x8a\xd1\xe3\x23\xe1\xf5\xc0\x9c\x08\x9e\x4b\x18\xcf\xff\xb7\xb4\xa8\x40\xb6\x34\x40\xf0\xdc\x01\x00\xb0\x09\x1e\xe8\xc7\x2e\x09\xf8\x3b\xbb\x3d\xe1\xd7\x59\x80\x2e\xd9\x6d\xcc\xaf\x33\x03\xfc\xa0\x14\x9e\x2e\xd9\x6d\x6e\x15\x9e\xfe\xce\x6e\x7f\xca\x01\x56\xe5\xff\x9d\x4a\x5a\xe0\x25\x4c\xb5\x0a\xdc\x5a\xd9\x76\x41\xc4\x3b\x2e\x67\x06\x22\x24\xaa\xac\x23\xde\xcb\x9e\x01\x9c\x57\x4e\x41\x6a\xc6\x14\xdc\x4c\x19\x44\xc8\xbe\x00\x58\xf7\xe6\x43\x58\x94\xa0\x1b\x56\xe8\xf5\xfa\x3f\xa5\x2c\x06\x9e\x09\x02\xa4\x3c\x14\x3c\x4c\x7f\x0c\xa4\x89\x9c\x35\x55\x18\x80\x5b\xfe\xfd\x95\xcc\xd8\x4d\xa9\x57\x48\x69\x3e\x3f\xa7\x7d\x76\xc6\xab\x68\xfd\x26\xcc\x22\x96\x06\xa8\x94\x81\x08\x02\x75\x24\x6c\x48\x88\xb1\xf5\xc8\x80\x9a\x47\x80\xdb\x79\x84\x08\xbd\x26\x20\xa6\x5e\x14\xe0\x9b\x25\x71\x30\x79\x69\x01\x06\x95\x37\x21\x5f\xa2\x1a\x07\xc0\x35\x93\x5d\x51\x8e\x34\xf7\x64\x43\xd1\xb9\x60\x61\x54\x4b\x42\xbf\x3d\x41\x43\x65\x0b\xd3\x7f\x17\x96\xeb\x91\x08\xb3\x98\x6f\x7c\x8c\xb5\xb1\xa4\xc7\x98\x5c\xd0\xd4\xdf\xee\x49\x42\xb6\x20\xe2\xd5\x70\x3f\xaa\x8d\x02\x19\x67\x3a\xc1\x56\x7d\xf6\x24\xec\x5c\xae\xbe\x0f\xb3\x38\x65\x67\x5a\x83\xd7\xbf\x18\xad\x1b\x00\x4c\x2e\x46\xdd\x62\x28\xdb\x03\x71\x78\x9a\x29\x27\x2d\x6e\xa9\x7d\xe9\x3d\xaf\x0f\x3a\xaa\x4b\x80\x6b\x69\x52\x74\xca\xb3\x6c\x06\x7f\xd0\x57\xc2\x6e\xd7\x0b\x1e\xd9\x92\x7c\x8c\xf7\xc4\xe8\xd8\x1e\xb4\x41\x5e\x12\x41\xb9\x9f\x61\x92\xd2\xb0\xa3\x84\x7b\xe2\xf8\x68\x9c\xb3\x05\x18\x6d\x4c\x5e\xa5\xd6\xa6\x99\x31\x59\x5e\xd0\x74\x9e\x2c\xa6\xa2\x4d\x5c\x16\xd8\xf3\xc4\xbc\x58\xec\x76\xa0\x20\xff\xb3\x71\xc3\x5a\xcc\x2a\x5f\xaf\x08\x3c\xeb\xed\x45\xaf\x82\xfd\xcf\xda\x19\xab\x76\xca\x9a\xe1\xa0\xf2\x15\xca\xf9\xf2\xb2\x9c\xec\xb2\xc0\x87\x17\x70\xf2\xfe\x1d\xa0\xbf\x4f\x1a\xdd\x64\x38\x40\x16\x0b\xd9\xce\x1a\x64\x34\x18\xdf\xd1\xc8\x37\xca\xe7\x91\x6b\xc7\x40\x67\xb3\xf9\x1f\xd4\xc4\x4e\xee\xde\xac\x3f\xbf\xfd\xf1\xe4\xfd\xcf\xe7\xdf\xbf\xfe\xf1\xe4\x87\x53\xd5\x78\x38\xe7\xa0\xed\x12\x0d\x28\x3a\x4a\x75\xc5\xaf\x7c\x73\xfc\xc9\xae\xf8\x0f\xe8\x8b\x2a\x94\x98\xec\x59\xff\xf6\x3a\x90\x19\x5a\x40\x34\x29\x97\x61\x98\x79\x28\x28\xc9\x10\xf6\xbc\xc3\xed\xe8\x0c\x4a\xa3\x19\x49\x76\x77\x43\x3a\xb9\x75\x3b\x20\x33\x1c\x8b\x19\xc6\x44\x8c\x74\x0c\x1d\x8c\x55\x00\x2a\xa1\x83\x31\x0e\xd6\x7d\x7b\xe2\x7e\xf5\x15\x64\x3d\x2f\x16\xb2\x7d\x72\x0f\x81\x8d\x07\x70\xab\xde\x48\xd5\xb9\x92\x5a\x47\x96\xf7\xa9\xc2\x5c\x76\x3b\x83\xfe\xe0\x62\x9b\x53\x66\x2f\xd1\x55\x0e\x37\x22\xe5\xc8\x36\x5c\xb1\x5f\x7e\x75\x11\x5c\xb2\xf4\x07\x6d\xb9\x0b\x99\xb2\xe9\x45\x9a\x75\x64\x33\xd4\x74\x28\x37\xb5\xf2\x1b\x59\x9b\x90\x5a\xf8\xd3\xf3\x1c\xbf\x8c\x7b\xc2\xb2\xa2\x12\xcc\x71\xbe\xf7\x4e\xde\x30\xb8\x68\x79\x37\x4f\x96\xbe\x95\xe2\x50\xaa\x2f\x17\xa3\x9e\x1e\xf8\x18\x93\x81\xf0\xbc\x41\x6e\x8c\xde\x14\xa3\x1e\xff\x7e\xd3\xde\xb1\xda\xa8\x9a\x9d\x84\x3e\xc3\xf2\xda\x35\xde\x37\x8d\x8d\x5e\x3c\xec\x0e\x69\x44\x9d\x95\x47\x5c\x59\xa6\x30\x86\xbd\x00\xf4\xef\x20\x07\x3d\x98\xd4\x4f\x00\x4f\xe1\x01\x62\xdb\x3c\xf3\xda\xb2\x8c\xa9\x61\x47\x89\x51\xc3\xe5\xae\x8d\x9e\x72\x3f\xfc\x72\x73\x61\x35\x8f\xa2\x66\xec\x67\xf3\x96\x35\xfb\x45\xc7\xc8\x48\x66\x1f\x9d\x87\x6c\x41\xd1\xb5\xfe\x2e\x65\xc4\x3b\xfe\x87\x82\x6e\xe4\x07\x80\x36\x85\x82\xbc\xfb\xa4\x01\xef\x55\x98\xa3\x61\xa7\xe4\x96\x05\xca\x64\xe9\x27\xf5\x83\xcf\x91\xfc\x06\xc3\xc6\xa1\x03\x64\xda\xa4\x91\x84\x35\x4d\x03\xf5\x8b\xb7\x64\xd8\xf3\x32\x25\xbf\xeb\x14\xab\xbc\x7e\x58\x23\x66\x4d\xd3\xa6\x5b\xf7\x5a\x1d\x70\x1f\xd9\x1b\x31\x22\xc8\xbd\x93\x23\x4c\xba\x77\xe8\x83\x19\xde\xd6\x97\x6f\xd2\xbc\x3e\x1f\xcc\xf2\x49\xdd\xb7\x49\x83\x38\x94\xa9\x6b\x82\x12\x11\xd4\xa0\x2e\x11\xde\x1b\xa3\x8d\xdb\x7d\xcb\x65\x7a\x71\x1f\xb9\x2b\xb7\x8b\x57\xda\x22\xb6\x72\xf5\xb9\xdb\xf9\x8a\x0f\x76\x14\x8e\xdc\x21\xaa\x03\xa4\x1b\x6f\xc7\xe5\xce\x54\x30\x0c\x75\x10\x37\x3a\xd5\x6e\x85\xcd\xde\x18\x16\x27\xe4\x8a\xf7\x3d\xc0\x8e\x6c\xd7\x3a\x82\xe7\xe5\x7d\x3a\x11\xad\x65\xab\x4d\x72\x83\x23\xc4\x0b\x7e\xa3\xec\x28\x2b\xdf\x58\x60\xd5\x90\xd7\x10\x6b\x96\x78\x66\xbc\x99\x18\xf7\x89\x26\x0c\xee\xab\x5a\x66\x4e\x5d\xd1\xd1\x3e\x65\x1c\x92\xd1\x08\x2c\x81\x57\x25\x7b\xaf\xaf\x53\x20\xd3\x31\x8d\x47\x61\x11\xe6\x3e\xd7\xea\x96\x46\x25\x2b\x0b\x37\x0c\xde\x52\x6d\xf3\x94\xd6\xa3\xe1\xc4\x8b\x9a\x68\x4d\x69\x75\x58\x94\x91\x24\x34\x9d\x26\x8e\xd5\xe7\x29\x4e\x68\x23\x6c\x8a\x29\x68\x32\xfa\x67\xc5\xc4\xad\xba\xf5\x71\xf1\x3a\x4d\x7d\x75\x95\x9f\x67\x60\xd6\x62\xf8\x6f\x9f\xde\xff\x38\x52\x8f\xbb\xc9\xf2\xd6\x47\x68\x28\xf0\xf0\x2f\x8b\xb9\xf2\x11\xad\x5a\xba\xf8\x8b\x44\xdf\xe3\x69\xfe\xaa\x30\x74\x71\x6e\xe8\xe2\x25\x2d\xe6\x39\x20\x8c\xa5\x92\x47\x06\x1b\x91\x1b\x4a\x69\x0a\x1f\x5a\xb9\x5d\xf5\xa7\xe3\x86\x7b\x89\xa7\xeb\xda\x78\x2f\x7a\x39\x46\x98\xd8\xd1\x5b\xe3\xbd\x55\x95\xcb\xac\x15\xd9\x27\xda\x00\xb2\xe6\xf3\x81\xe9\x20\xc5\xe8\x7b\xae\x18\x7d\x4f\x9e\x29\xc7\x2a\xcf\x5e\x68\xbf\x4a\xca\xba\xcf\x0b\x4c\xb6\xae\xca\x50\x2f\x1b\x39\x92\xad\x54\x47\x16\x18\xf2\x85\xf0\x1b\xb5\x58\x24\x44\xd0\xc4\xdf\x82\xbb\x3c\x6d\x7a\x10\x5c\xf5\xe9\xef\x4d\x92\xd9\xcf\xf0\x46\x7f\xee\x49\x49\xb6\xda\xae\xba\x2e\xc8\x24\x32\x6e\x7e\xc1\x3f\x9c\x86\x29\x67\x71\x6a\x3d\x64\xb3\xac\x63\xa2\x2b\x51\x0a\x80\x90\x87\x34\x57\x31\x9f\xf1\x43\xc9\x75\xc5\xc4\xde\xfa\xdb\xe9\x4c\xc4\xde\x52\x19\x02\x5c\xdd\x5b\x03\x11\xbd\xa3\x55\x8e\xdc\x2e\x4c\x59\xdb\xe4\x47\xb3\xf6\xa0\x36\xe3\x08\xe1\x99\xfd\x0a\x6c\x41\xa6\xa1\x6e\x37\x6d\x3e\x18\x9b\x99\xfe\x0d\x32\xd2\xb4\x28\x52\x77\x2e\x55\x5e\x62\x19\x36\x6e\x4b\xe5\x9d\x53\x00\x5f\x9c\x34\xf0\x4a\xaf\xb3\xee\x43\x7b\xdd\xb6\x56\xcb\x91\x66\x9e\x57\xf4\xeb\x62\x1c\x30\x43\x4a\x90\x2e\x00\x91\x6c\xb7\x33\x96\xce\xf9\x81\x9d\x2e\xa9\x9b\xe6\x72\x6c\xa9\x3a\x6b\x0a\x0e\x29\x9d\x51\x18\x13\xac\x7f\x29\x1a\x23\xfb\x5e\x51\xdb\xd3\xd7\xe8\xc6\x28\x1c\xe6\xa1\x28\x94\x5c\xae\xaf\xf3\x91\xc9\x18\xef\x76\xe3\xa9\x2f\x40\x83\x41\xc0\x7e\xd6\x71\xb2\x56\xcf\xf3\x6d\x0d\x12\x4f\xa8\x97\x09\x9b\x00\x80\xed\x34\xb5\x7b\x29\x6a\xa6\xd0\xbe\x3c\x36\x96\x8f\xac\xc9\x0d\xab\xf2\xda\x69\xfc\x66\xa2\x6e\x12\xa5\x1f\x41\xeb\xe9\xea\xd4\xf6\xc6\xc0\xeb\xb2\x34\x88\x0e\x06\xed\x54\x78\x4f\xda\x06\x23\x1f\xb4\x6a\x0e\x4d\xae\xd5\xe4\x74\x7d\x9f\x69\x4b\x7e\x4a\x23\x40\x19\x00\x0c\x1c\xcd\x01\x70\x9e\xa9\x63\x81\x99\x56\x7f\x96\xc9\xa6\x1d\x3c\x4e\x79\x14\x1a\x4d\x83\x0d\xcf\xca\xb5\x51\x55\xa8\xd3\x5e\x33\x76\x89\x82\xcc\xce\x17\x31\x9f\x59\x73\x67\x37\xbd\x43\xd4\x89\xd4\xb1\x69\x5e\xd0\xe0\x7c\x03\xbd\x5e\xee\x79\xbe\x0a\x53\xd0\x58\xe9\x8c\x73\x1b\x72\x9f\x24\xed\x92\x79\xcb\x75\x41\xfe\xa0\xdb\x44\x8b\x12\xf8\x21\xc9\x2e\xad\x46\xa3\xea\x97\x04\xcd\x12\x1f\x7d\xfd\xc2\x5a\x9a\x6d\x91\x20\xe0\xa3\xa0\x91\xc7\xa8\xfd\xd4\x18\x15\x4a\xf8\xba\xa7\x84\xb4\x53\x82\x6e\xca\x81\x32\x5e\xf6\x94\x11\x76\xad\x11\x37\x4c\x0c\x97\xd6\xc4\xf0\x1d\x46\x7b\x7f\x07\xdf\x45\xbf\x8f\xd0\xbe\x41\x99\x27\xfa\xac\x55\x87\xec\x0b\x75\xc8\x3e\x7e\xa2\xce\xd8\xc7\xf2\x88\x8d\xfc\x6a\x94\x14\xe0\xfc\xd7\x58\xb2\x69\x1f\xb8\xca\x6e\x05\x08\x45\x6b\x3a\x4d\x59\x07\xd3\x12\xaa\xda\xd9\xf2\x40\xfb\xf8\x85\x0f\x66\x64\x75\x61\x33\x04\x60\x50\x7a\x49\x7b\xbc\xa8\xd6\x57\xf6\x01\x9b\x97\x8b\xdd\x2e\x9f\x2b\x72\x6f\xb1\xdb\xd5\x63\x27\xbf\x8d\xcf\x60\xf9\x6d\x3c\x4f\x2b\x6d\xae\x8c\x5d\x1f\xc1\x13\x88\x8f\x7e\xe5\xd5\x51\x2e\xf8\x55\x12\xb3\xf8\x28\x3c\xfa\x1d\x2a\xfc\x5d\x82\xf2\xa3\x92\x1f\x85\x47\x92\x9a\x39\x02\x6b\xe2\x47\xd7\x49\xb9\xe6\x55\x79\x14\x66\x47\xbf\x9b\x8a\x7e\x3f\x52\xd7\x4c\x31\x52\xf6\x9c\xaf\x93\x34\x35\x83\x1d\x1e\xc9\x26\x1c\xf3\x2c\xbd\x55\x25\x8c\x8e\xde\x2e\x61\x36\x54\x79\xc5\x9a\x57\x69\x7c\x74\xc1\x8e\x36\x15\x18\xe8\x3b\x92\x0b\xf7\x77\x77\x0f\xfe\x3e\x3a\x7a\x5f\xae\x99\xb8\x4e\x0a\x46\x8e\x0a\x56\x1e\xb1\x44\x86\xdd\x06\x70\x71\xf4\xbb\xe9\xeb\xef\x23\x10\x9b\xd1\x07\xec\x9d\xe3\xf6\x2f\x18\x2c\x5d\xcf\xff\x2f\x86\x4b\xe3\x8b\x2f\x1b\x30\x4b\x44\xc4\x23\x39\x66\x7b\xb2\x96\xb7\xba\x0d\xdd\x42\x0f\xe5\x19\x7f\x76\x9b\xf7\x28\x80\x34\x74\x54\x96\xb0\x29\x97\x3d\x4a\x2a\xea\x2a\xb0\x9c\xf3\x85\xb6\x10\x80\x72\x78\xeb\x53\xc6\x1a\x95\xd7\x2f\xd7\x36\x34\x0c\xb7\xe7\x0d\x7c\x31\xda\xb0\xa2\x08\x57\x4c\x96\xbf\xc6\x78\xbb\x9e\x5b\xd0\x82\x0e\xc6\xd3\xd0\x07\xe1\x10\x62\x08\x86\x3e\xb1\x31\x17\xb1\xf9\x12\x83\x11\x07\xa2\x49\x88\xc0\x5c\x86\x48\x4d\xf9\xf6\x97\xe5\x60\xce\x99\x9f\xaa\xd2\x1c\x58\x5d\x9e\x06\xee\x49\xeb\xb2\xd4\x2f\x9b\x74\x77\x23\x05\xfb\x67\xc5\x8a\xd2\xdc\xb6\x46\xca\x0d\xb6\xae\xcb\xa9\xec\x60\xab\x0e\x14\xa0\x93\x40\x11\x16\xed\xd6\x9f\x4a\x32\x41\xd3\xe8\xc6\xaf\xd4\x78\xfa\x7f\x1a\x5c\xa1\xcd\x3d\x5c\x8c\xa1\x4f\xa7\x6f\x3e\x9e\x9e\x9d\x9f\xbc\x3f\xff\xf1\xfd\xd9\xf9\x87\xd7\x9f\x3e\x9d\x9f\x7d\xff\xf6\xd3\xf9\xfb\x8f\xe7\xbf\xbe\xff\xe9\xfc\xe7\xb7\x3f\xfc\x70\xfe\xed\xe9\xf9\x77\x6f\x3f\x9e\x9e\xa0\x2f\x14\x1a\xae\xfd\x55\x4d\xad\x5c\x99\x51\x1e\xb4\xda\x08\x8c\xb8\xb3\x6a\x99\x8a\x5d\x49\xdf\x7e\x9f\x4c\xe5\x90\xb2\xa0\xd8\xed\x7c\xe0\x01\xe3\x3d\x26\xa5\x65\xbb\x69\x77\x63\x8f\x95\x68\xc6\x93\x67\x46\x34\xc3\xd1\x67\x1b\x80\xdb\x9a\xed\xc1\x2b\x46\xad\x54\x65\x45\x75\x14\xd5\x6d\xa8\xd7\x6c\x8a\x8c\x07\x06\x04\x97\x4d\x6d\x89\x2d\x95\x9f\xb5\x0d\x1b\xab\x2e\xeb\x79\xc6\x90\x82\x9b\x5a\xd0\x44\x9e\x98\xea\x7a\x0c\xfb\x46\x4b\x1c\xf8\xa9\x16\x23\x0f\x5b\x0d\x10\xfa\x39\x48\x42\x8c\xd2\x70\xeb\x6a\x32\x44\x28\xe0\x7e\xad\x28\x2c\x87\x61\x30\x21\x4d\x57\x29\x02\xd7\x48\x23\xa2\xe3\x69\xf4\x4a\x98\xbb\x75\x34\x1c\x82\xa9\x37\x34\x14\xf3\x68\x41\x29\x2d\xf0\x36\x94\x7b\x1c\xc8\xb0\xbd\xd1\x6d\x97\xf1\x32\x72\xdf\xb9\x77\x59\x0f\xf5\xe1\xfe\xcf\x90\xaf\x75\xa7\xcd\x06\x33\xbe\xa7\x0e\x11\xb5\x59\xbf\x57\x70\x93\x7d\x6f\x95\x88\x0f\xdf\xc0\x85\x5f\x37\x5f\xef\x37\xab\x25\x6e\x6f\xd4\x46\x1d\xbe\x7d\x8d\x35\x39\x81\x48\x34\x81\x83\xa9\x8c\xca\x4d\x63\xae\xec\x8e\x81\x32\x0c\x98\xa6\x98\x64\x0f\xf2\x6a\xde\xe0\x70\x49\x52\xac\xc3\xe4\xea\x51\x4a\xce\x59\x16\x27\xd9\x4a\xbd\x9b\x1b\xc6\xd8\xe1\x14\xd4\xbe\x1e\xdc\xc1\xa5\x2a\xd5\x12\x37\x7c\x78\x63\x95\xca\xf3\xb4\x8d\x14\x2d\xa2\xe6\xb3\x91\x71\x31\x83\x49\xd7\xbb\xaf\x6b\x32\x24\xa5\xc5\x81\x05\x30\x52\x46\x64\xb4\x4e\x35\xac\x6f\x2e\xcf\x4f\x41\xc7\x53\x51\x3b\x21\x16\xc3\x21\xe6\x73\x84\x86\xd9\x5c\x2c\xe0\xf4\x02\x7b\x0f\x90\x28\x75\x13\x59\x39\xd1\xd6\x49\x9a\xce\xc5\x42\xaf\xa9\x29\x7c\x9b\x29\x35\xee\xea\x5c\x18\x0d\xb1\xda\x33\xba\x3d\xb2\x5e\xd2\x53\x59\xb2\x74\xca\x95\x97\xea\x86\x73\xc3\x66\x91\x83\x31\x9e\xa6\xb5\x63\xb0\x74\x3e\x6e\x46\xee\x1f\xce\x4b\x4c\xfa\x79\x89\xae\x43\xe2\xc6\xf2\xf1\x3f\xbf\x3a\xc6\x98\x44\x0d\x56\x24\x71\xd8\x68\x06\x29\x6b\x12\x5f\x62\xe9\x48\x73\xcd\x2a\x07\x49\xc7\xf4\x2e\xae\x99\x55\x44\xde\x02\x9f\xeb\xb3\xfc\x25\xcd\xdf\xd2\x1b\x19\xdf\x83\xc7\x94\x34\x58\x20\x1d\x54\xd7\xe8\x71\x30\x98\xf4\x71\x8e\xb2\x59\x16\x34\x99\x04\xf7\x60\x1d\x5d\x87\xc5\x3b\xbd\x1f\x02\xb3\x45\xca\x7a\x8b\xec\x89\x7d\x46\x2b\xcd\xd5\xcf\x81\xb8\x95\xed\x76\xd5\x6e\xe7\x57\xb0\x2c\x48\xef\x61\xd3\x4f\x5f\x1d\x66\xfc\x29\xa4\xfe\xd3\x97\xb1\xb2\xda\x63\xd8\x28\x99\x6a\xc2\xe6\x4a\xfb\x03\x6d\xa6\x74\xc6\xa4\x53\x8a\x13\x47\x7b\xc6\x4b\xf3\xba\x9a\xb3\x69\xf8\x96\x7e\xbb\xb0\x36\x96\x23\x12\x07\xf5\x94\x4a\x38\xc6\x41\x36\xa0\x7d\x35\x3a\x7e\x63\xdc\xd9\x98\x1d\x2a\xaa\xc5\x46\x0a\x0e\xa7\x33\xdf\xb3\xf9\x22\x40\xa8\x65\x59\x20\xfe\x73\x2f\x1f\xd5\xbd\x5f\x3e\xfe\x04\x32\xe9\x45\x0a\xa9\xbe\xef\xdf\x0b\x39\xdc\x8b\xa5\xfe\x39\x97\x43\xa9\x8f\x5e\x4e\x2c\x57\x43\xaf\x39\x83\x4a\x5c\x44\xd1\xcb\x29\xb7\x54\x01\x02\xaf\x43\x87\xb9\xe4\x0f\x60\x7a\x67\x0f\x45\x48\x84\x53\x6b\xd5\x8e\x1a\xe2\x34\x6c\x2d\x39\x52\x38\xbe\x8b\x6a\x7b\x30\xc6\xc2\x1d\x8c\xc3\xe3\xda\x9b\x51\x93\x4e\x2c\xe4\x42\x36\xcf\x2e\xaf\xe8\xa4\x76\x80\x87\x5e\x3e\x01\x53\x01\xc5\x7c\xbc\x90\xd3\x86\xd0\xb0\x30\xdc\xcf\x10\xac\x22\x21\x24\x1b\x18\x76\x09\xc4\x06\x92\x44\x68\xc8\x0f\x22\x44\x61\x10\xe2\x9f\xe2\x9d\x1f\xa2\x17\x48\x1b\x23\x58\x8a\xd3\xaa\x3c\xca\xd6\x4d\xc5\x80\x1a\x6e\x23\x90\x65\xea\x88\x16\x35\xaf\xb7\xc5\x24\x6e\xb2\x2f\xa9\xc0\xfb\x7e\x8e\x73\x3b\x61\x0b\x07\xdc\x8b\x78\x3e\xd8\xb7\x8c\x96\xae\x07\xe4\xa9\x72\xa5\x7c\x78\xa1\xca\x23\xdd\x70\x56\x5b\xac\xcd\x87\xc8\xf0\xbb\x0c\x1e\xf5\x54\x85\xde\xfe\xf8\xe9\xf4\xe3\xd9\xf9\xbb\xd7\x1f\xff\xfe\xd3\x07\x04\xaf\x22\x2c\x2b\x03\x46\x96\xd0\xe4\x98\xdd\xa8\x59\x5f\xea\x67\x39\x15\x2a\xb9\x8e\x22\xe1\xb2\x64\x02\x22\xca\x3e\xba\xb0\x51\xd7\xbb\xf7\xff\x38\x3d\x3f\xfd\xe5\xed\xa7\xb3\xb7\x3f\xfe\xad\xae\xcb\x96\xaf\xca\x64\xa3\x73\xd8\x68\x10\xaa\xeb\xcd\x47\x8e\x91\x21\x39\x86\x9f\x6b\x43\xea\x92\x21\xba\x05\x1f\x4f\xa1\x0d\x3f\xbe\x3f\x39\x7d\x68\xfd\x65\x5d\x21\x18\xa4\xb2\x75\x82\xaa\x55\x8b\xef\xda\xa8\xf4\xd3\xe9\x97\x8f\xef\x9d\x55\x25\x9d\xaa\xce\x4e\x7f\x39\x3b\x7f\xf3\xfe\xc7\xb3\xd3\x1f\xcf\xfe\xc5\x95\x15\x4d\x8d\x66\xcf\xf3\x19\x65\xbb\xdd\x7c\x41\x98\x72\xe9\x54\x62\x4c\x98\xeb\x5c\x0b\xd2\xc7\x46\x0c\xa7\xe5\xa5\x4f\xf9\x17\x56\x76\xcc\xd4\xe1\x12\x83\x7f\x96\xc7\x96\x4f\xfc\xc4\x51\xb1\x7c\x0e\xdf\x63\x8c\xc9\x12\x52\x3d\xc5\x64\x6d\x7d\xb5\x4c\x1e\xbf\xc4\x98\x6c\xac\x67\x66\x30\x28\x14\x6c\xcf\x05\x8b\x78\x16\x25\x29\x13\x6a\xeb\x95\x89\x63\xba\xea\x90\x84\xd7\x72\x94\x74\x13\x5b\x81\x2e\xa7\xcc\x9f\x1a\x96\xb0\x3a\x46\xd0\xe4\x5d\x48\xa3\x7c\x90\x1b\x35\xd4\x30\x5d\x2b\xb7\x6b\xcb\xb6\x29\x2d\x46\x42\x9d\x0f\xae\x5e\x65\xbf\x4b\x07\x92\xca\xcc\xa1\x3e\x8b\x0e\x76\xc6\xb5\x54\x7a\xe7\x30\xe8\x0c\xd3\xb2\xdf\x3f\x11\xd7\x02\x0a\xf3\x05\x49\xb5\xc7\x72\x6b\xbc\x86\x83\x91\xc0\xc3\xc6\x6b\xf8\x3c\x5c\x90\x82\x8e\x49\x44\xf3\x51\xd3\xee\x97\x9f\x90\xf2\xee\x6e\x66\xc0\xf1\x74\x77\x22\x4d\x87\x43\x22\xd4\x52\x8b\xac\x52\xa4\x30\x47\x8f\x63\xdf\xac\x07\x11\xf7\x77\x6f\xba\xec\x98\xcf\x2a\xe5\xc5\xa6\x2d\xc5\xd4\x2b\xc2\x54\xf9\x68\x32\xf9\x1a\x19\xea\x75\x2e\x37\xe3\x62\x1a\x19\x1b\x81\xa6\x5d\x1d\x6b\xc6\xff\x83\x4d\x0a\xfb\x9b\x74\x70\xd5\xb8\xe6\x4e\xbb\x4b\xff\x33\x99\x9b\x4b\xae\xd9\x2b\x22\xe4\x4d\x3f\x95\xcb\x28\xec\xac\xca\xe6\x46\xf2\x39\x61\x24\x95\xbb\x40\x2e\xcb\x64\xe9\x87\xbb\x9d\x3e\xf0\x13\x6d\x55\x8f\xc4\x74\xac\x95\xb4\xc6\x64\xa3\x78\x70\x72\x78\x12\x39\x34\xa1\x5c\x95\x61\x7b\x74\x12\x6c\x1c\x21\x70\xcf\xe3\xf3\x64\x41\x56\x34\x9c\x27\x8b\xe9\x05\xa5\x74\x35\xf3\x2b\x5a\x68\x27\x15\x23\xc7\xa5\x01\xd9\x90\x98\x2c\x01\xe9\x80\x68\xfe\x26\xbc\xf1\x2f\x9a\xa7\x03\x26\x0d\x00\x8d\x71\xe0\x5f\x78\x9e\x7f\x47\x0e\x49\x26\xdb\xda\xce\xeb\xb9\x7e\xad\x52\xf8\x2b\x92\xce\xd7\x0b\xa8\xbb\x04\xf9\xda\xf5\x70\x88\x49\x3c\x1c\x92\x0d\x6d\x1e\x82\x2b\xbc\xb7\xfd\x16\xb8\x23\x7e\x9e\x60\x30\xb2\x55\xd7\xe5\xae\x2c\x1f\x46\x41\xcc\x93\x05\xc6\x78\x5a\x79\x9e\x5e\x26\x15\x3e\xe4\xab\x2c\xdc\xd7\x06\xfc\xba\x8b\xe0\x0b\x96\x35\x3b\x58\x95\xd2\x5e\xb6\x33\xd1\x63\x61\x12\x0c\x7d\x39\xe3\xfa\xca\x72\x64\x84\x5d\xb1\xda\xaa\x78\x4f\x09\x8e\xb2\x73\x46\x4a\xd2\x28\x09\xef\x89\xe3\xd7\xa2\x9f\xa7\xa1\x48\x8b\x3d\xe9\xce\xde\x81\x73\xa0\xbe\xb8\x3b\x6b\x45\xdd\xb1\x46\x4e\x33\x7d\x46\x32\x55\xb0\x3b\x5a\xfd\xf2\x35\x32\xab\xeb\x80\x03\x94\x9b\x7b\xeb\x51\x06\x24\x5b\xf2\xb5\xf7\x36\xb2\xf7\x1c\x13\x61\x8e\xd6\x81\xb2\xb4\xa7\x2d\x5e\xc8\x35\xf8\x73\x52\xae\x35\x8a\x83\x6a\xfa\x0f\xfb\x03\x4a\x2a\xa7\xd9\x55\x22\x78\xb6\x69\xe3\x6c\x31\xe3\x3e\x9a\x8c\x9f\xd6\xf7\x9e\x74\xd4\x5b\x27\x65\xfd\x70\x92\x1e\xa0\x3a\x28\x3b\x10\x41\x84\x12\x41\xbe\xb7\x1d\x40\x2d\xb6\xab\x66\xa0\xa5\x5b\xa3\x34\x7c\xc0\xe5\x7a\x16\xa6\x86\xee\xa7\x86\x45\xbd\x62\x87\xb8\x3a\xbd\xf9\xf6\x64\x1d\x16\x7d\x19\x54\x79\x03\xb8\x39\x1c\xc8\x59\xb0\xb2\xa3\x4d\x7d\xa0\x75\x65\xa3\xf3\x3d\x4f\x4a\x0d\x1b\x55\xf7\x34\x4a\x63\xd9\x5e\x6c\x9e\x2d\x1c\xf6\xb2\xa4\x1e\xe7\xd9\x82\xa6\xf2\x74\x1b\x3b\x56\x79\x9e\x8f\x8d\x6b\xf4\xe7\xda\xd4\xc6\xe4\xf1\x53\x45\xe4\x8d\xb1\xe6\x3c\x3c\x7e\x56\xbf\x15\x6d\x7b\xa8\xb5\xf6\x1e\x14\x80\x2e\xd4\x75\x90\x61\x47\x52\xde\x98\xd5\xb2\xb2\xd7\x89\xcf\x08\x27\x29\x26\xe9\x67\xce\x4a\xc2\x49\x42\x0a\x12\x91\x8a\xc4\x8a\x85\xbf\xdb\x69\x14\x98\x93\x25\x9c\x46\xf9\x61\x09\xe8\x1c\xe3\xed\x92\x32\xcf\x63\xf3\x7c\x31\x55\x32\x90\x4b\xcf\x5b\xb6\xef\xc8\x64\x43\x4b\x2d\x40\xa9\x86\x6e\xe9\x79\xa1\xbf\x26\x1b\x8c\xc5\xa8\x6d\xad\xde\x5f\x92\x0d\x49\x00\x7f\xcf\xf3\x05\x5d\x2a\xfb\x05\x4b\xcf\xf3\xb9\x0c\x8b\xc6\xc9\xb1\xc4\x44\x8c\xda\xd6\x58\xfd\x25\x58\x61\x99\xaa\x83\x32\xf5\x37\x72\x6a\xa6\x50\xda\x05\x00\x57\x54\xb4\x09\xb9\x0b\x67\x1c\xa6\x99\xa2\xce\x56\x78\xbf\xb7\x23\xc0\xf0\xa0\x63\x91\x31\xc7\xbb\x5d\xe9\x79\x3d\xe3\xb2\xdb\xf9\x4b\x2a\x47\x85\x3c\xb0\xd1\x77\x9e\x4d\x0d\x95\xaf\xc3\x86\x22\x33\x6c\xa8\x18\x58\xad\x3d\x75\x71\x78\xfd\x68\xe0\xd1\x62\x8f\xb5\x6d\x1d\xf0\x0f\xf9\x0c\xe3\x36\xda\x70\xec\xf8\x48\x12\x4b\xf0\xeb\x23\x47\x5a\xa2\x60\xe5\x59\xb2\x61\xbc\x2a\x8f\xd6\x61\x71\x94\xf1\xf2\xe8\x82\xb1\xec\x28\x66\xcb\x24\x63\x31\x72\x18\x7c\xbc\x2f\x7f\x94\xb2\x50\xdc\xb7\x04\x23\xd3\x5f\x51\x4a\xeb\x8a\xcd\x96\xa8\x21\x3e\x23\x63\x20\xbe\x20\x65\xb6\xdb\x0d\x2a\xec\x79\xdd\x1c\x95\x53\x0a\x69\x67\x2f\xc5\xad\xc1\x54\x15\x40\xb4\xc5\xd0\x52\x99\x4d\x35\x51\x6a\xf4\xe0\x80\x68\x26\x6a\x26\x00\xe2\x04\x12\xb4\xdf\x4f\x92\xa5\x1f\x53\x4a\xdd\x81\x30\xed\x73\x61\x3e\x53\x3d\x92\x69\xf9\x6e\x37\x88\xb1\xe7\xf5\xe5\x89\x1b\x25\x91\x76\x11\x4e\xd3\x81\xf5\xd5\xd7\xa7\xd8\xed\x53\xb7\x47\xb1\xdb\xa3\x46\x7f\x42\x1f\x6f\x37\x9e\x27\x37\xed\x06\x2c\x11\x68\xde\xde\x6c\x4d\x97\x23\x49\x2f\x87\xa5\xbf\xc6\xc1\x05\x3d\x9e\x90\xb5\x7d\x62\x4a\x7c\xdc\x72\x98\x9d\x2c\xfd\xc1\xc6\x68\xe7\x08\x3f\xc4\xd3\x8d\x79\x49\x53\x54\x9a\xc9\x3c\x2d\xa7\x6a\x6b\x2c\xe9\x9a\xac\xe9\x7c\x31\x1d\x0e\x2f\x5e\x95\x53\x2c\xd1\xd1\xfc\x62\x31\x12\x55\xe6\xe3\x29\x54\x58\xe7\xda\x2f\x15\x4d\x01\x6d\x4c\x81\x01\xd8\x66\x13\x00\x79\xb2\xac\x32\xe3\x03\x03\x4c\x2f\xd2\xb2\xe9\x7b\x5b\x5d\xff\x49\x4c\xe4\x41\x6e\x36\xd4\x76\x3f\x1d\x38\x4a\x49\x72\x58\x2b\x8a\x0c\xa4\x16\x33\xa8\x97\xdb\xac\xfe\x0c\xb2\xda\x2c\x6d\x45\xb3\x3d\x58\xac\xed\xcb\xed\x4e\xeb\xcc\x0d\x04\xbc\x2e\x21\xa6\x7c\xbf\xf7\x15\x42\x5c\xc2\xf0\xa8\x2e\xcb\xe1\x98\xe6\xa3\x8c\xdd\x94\x67\x49\x74\x49\xbb\x54\xb0\xdc\xa0\x8a\x47\x1b\x8a\x15\xe8\x77\x18\x36\xed\xf1\x44\xdd\x6a\x5a\xe0\x6f\x26\x8e\x99\xe9\xc9\x34\x7b\xd5\x4e\x60\xcc\x4d\x1f\x4f\x16\xd4\xc6\x49\x34\xb5\x56\x48\x57\xd6\xa8\xc6\x1e\x93\xc9\x80\xda\xb9\xda\xed\x36\xbb\x9d\xf0\x13\xbc\x27\x85\x63\x5c\x43\x54\x19\x75\x47\x59\x4f\xd7\x28\xcc\xf3\xf4\x56\xad\xdc\x7a\xde\xf0\x9e\xe4\xa3\x32\x29\x53\x46\xd1\x85\xe0\xd7\x05\x13\x88\xe4\x23\xfd\x49\x07\x63\x92\x8f\x58\x76\x05\x06\x34\x46\xa1\x58\x5d\xc9\x91\xca\x47\x57\x4c\x14\x09\xcf\x28\x42\x75\xa0\x50\x89\x78\x46\x23\x99\x36\x8e\xad\x5e\x71\x04\xe0\x88\xa9\x8f\xe5\x12\x7e\x15\x79\xd5\x48\xa3\x4d\x9a\x38\x76\xac\x01\xcc\x36\x49\x09\x1f\xb9\x00\xad\xdd\x46\x1e\x0d\x7b\x9f\x45\xcd\xb2\x6a\xa7\xd6\x5d\xf2\x6a\xbe\x90\x0d\xbd\x48\xe0\xb5\xa9\x11\xdf\xc1\xc1\x9a\xa4\x34\x89\x8f\x12\x85\x85\xb5\xee\x1c\xa0\x60\x92\x8f\xa2\xeb\x98\x76\xb4\x9b\xd1\x23\x04\x71\xeb\x38\x11\xf7\xab\x04\x92\x1e\xa8\xa2\xda\x84\xc5\x65\xb7\x92\xa3\xf1\xfe\x41\x12\x42\xff\x0d\x52\xa3\xcd\x23\x48\x83\xbb\xdb\x92\x79\x1e\xaa\x32\x73\x72\x0d\x2c\xb8\x5e\xb9\x9e\xd7\x97\xcd\x59\xd9\xcd\x23\xfb\xb3\xc9\xdb\x64\x54\x87\x7f\x0c\x5b\xb2\x7e\xc7\xa1\x6c\xb7\x63\x60\x15\x0e\x67\x34\xd2\x97\x37\xd7\xed\x13\xb2\x4e\x24\x4d\x6d\x86\x21\xc6\x48\x41\x13\xa5\x74\x04\x46\x9b\x4d\xbb\x6c\x37\x0b\xcf\x33\x12\x56\x35\x4c\x53\x95\x14\xa1\x69\x3e\xa4\xdc\x4f\xb4\x1f\x48\x4c\x42\x1f\x4d\x9e\x8c\x91\x7e\xe8\x28\x66\x45\x60\xf2\x90\x1c\xef\x3b\xb2\x5a\xaa\xea\x59\x46\xab\x91\x71\x18\xa5\xae\x00\x0e\x5b\x0e\x07\xc2\x57\xe9\xf0\xcc\xcf\x00\x8d\xa9\xa0\x9f\x60\x92\xb9\xd4\xd8\x6e\xe7\x37\xc2\x34\x53\x0b\x05\xac\xec\xf1\x98\x61\x1c\xa8\xfc\xb1\xc4\x3d\x72\x70\xee\x29\x3c\xe6\xb6\x4f\x6b\x04\x71\x01\x2e\xa0\x18\x0e\xa0\xcb\x13\x44\xec\xd0\xd6\xd6\xd6\xdc\x1b\xef\x98\xd8\xf0\x26\x5c\x31\x73\x03\x3e\xa4\x26\x34\xd1\x12\xcc\x93\xc7\x13\x25\xc2\x3c\x79\x0c\x42\xcc\x70\xfb\xd0\x7e\xc2\xf5\x9b\x66\x73\x8b\xca\xdb\x3b\xcf\x8a\x52\x54\x51\x09\xf6\xd6\xa7\x89\x1f\x3b\x46\xee\x0b\xb2\x3d\x77\x2e\x28\xa0\x0f\x5b\x9b\xa7\x4f\xf7\xae\x5a\xdc\x43\xf4\x46\xf1\xb6\x63\x2a\xb1\x45\x14\x59\xdb\x09\xce\x5a\xdf\xed\xdc\xd0\x28\x29\x9a\xed\xc1\xbd\x6f\x18\x9f\x29\xe2\x43\x25\x58\xbb\x98\xb6\x84\xb8\xb6\xb6\xf4\x58\x8f\xed\xe4\xb1\xe6\xf5\x3f\xc7\x24\xa7\x99\xff\xf4\xb9\xe1\xea\x3f\xd1\x5c\x7d\xe3\x9b\xfd\x6b\x60\xeb\xab\x4b\xe1\x05\xbc\x39\x63\xb2\xaa\x9f\x98\x27\x93\x97\x58\xf9\x92\x97\x97\x43\x72\x65\xae\x84\x6f\x37\x79\x25\xd8\x9b\x34\x2c\x8a\x60\x4c\x3e\xd8\xef\x09\x81\x87\xb5\x94\x15\xc5\x77\xba\xa7\x61\x1a\x3c\xde\xe3\x29\x6f\x20\x04\x89\xcd\x5c\x24\xaa\xc8\xa8\xa5\x5c\xdf\xea\xcd\xbd\xdf\x7b\x69\x49\x95\x0e\x9e\x91\xad\xd2\xab\xa3\x64\x37\xa5\xeb\x07\x4c\xd4\x6a\x54\x30\x73\xa4\xdc\x03\x81\x71\x4b\x27\xe4\x86\x6e\xed\x7a\x0a\x3a\x4b\xed\x80\x7b\xb1\x3e\xd7\x0d\xc6\x62\x12\x3b\xbb\xcd\x1b\xfe\xfa\x8d\xfc\xb0\x0b\x73\x5c\x49\xb7\xa0\x2d\x6f\xd2\x75\xa4\xea\xca\xb7\x92\x50\x52\x06\x5b\xdd\x48\x2d\xa1\x61\x9a\xd9\x8d\x81\x69\x00\x8d\xec\x9e\xc8\x8f\x8a\xa1\xa3\x5e\xa3\x07\x93\x66\xe4\x77\x5c\x44\xac\x16\xfd\x68\xb2\x0f\xe5\x18\xb4\xfb\x6b\x59\x8b\x66\x79\xba\x91\x7a\x76\x5c\x10\x20\x0d\xb0\xa9\x6e\xc7\xb2\xd4\xba\xe1\xfa\x99\xb7\xa7\xc9\x6f\xc2\x34\xbd\x08\xa3\xcb\xa2\x51\x38\x58\xc7\xb1\xf5\xfe\x9c\xa4\xe9\x4f\xea\x3e\x49\x07\x13\xf3\x7e\x73\xc8\xf3\x5b\x61\xa7\x5c\x37\xb1\xe8\xb6\xef\xf6\x4b\x7c\x81\x57\x24\xbe\x4b\x3a\x24\xb7\x8e\x9e\x14\x93\x4c\x0b\xbc\x16\x72\x63\xf6\x66\x53\xbe\x3c\x14\x95\xa0\xe6\x45\x69\xda\xcb\x8d\x9a\xfa\x6b\xb9\x3d\x6d\x47\xd4\xba\xae\x4f\x9b\x95\xa4\xfc\xc9\x06\x4f\x57\x46\xad\xe5\xdc\xa8\xd9\x9c\xeb\x6d\x38\x0b\xfd\x35\x9e\xf5\xad\xe9\xab\x51\xbd\xb1\xfb\xe3\x5d\x34\xe0\x57\xf4\x9c\x08\x7f\x4d\x2a\x23\x17\x40\xcf\x77\xbb\x73\x38\xc7\x77\xbb\xa8\xa5\xaa\xe2\x9f\x63\x23\x4a\x91\xf8\x68\x32\x7e\x86\xc8\xda\xf5\xf8\xbe\xdb\xad\x41\xcd\x68\xb7\x43\xb5\xcb\x77\xc0\x44\xec\xfa\x88\xd7\xde\x7f\xdb\x2d\xea\x41\x3f\x78\x7a\xae\x86\x9e\xc6\xe4\xdc\xa0\x0b\x9a\x13\x39\x00\xcb\x82\x5e\x90\x73\x83\x36\xe8\xa6\xbd\x85\xcf\xc9\x72\x54\xb0\xd2\x3f\x77\x74\x89\x6f\xe8\xf9\xa8\x90\xd5\x4c\x2d\xeb\xed\xc6\xf3\x7c\x0d\xa4\x8a\x19\x8c\x89\x21\x52\x2c\x99\x71\xb3\xdb\x35\x65\x4b\x6e\xf0\x0c\xfa\x6e\x5c\xce\x58\x2a\x70\xb7\x43\x35\xc2\x97\x9d\x73\x86\xc0\xb0\x6b\xff\x5b\x76\x3b\xf4\xef\xda\x20\xcf\x6b\x7a\x3e\xaa\xe4\x48\x5c\xa4\xec\x5c\xa9\x8b\x00\xa1\xac\x16\x4b\xce\xc4\x92\x8b\x8d\x76\x89\xa9\xa4\x34\x92\x72\x0d\x29\xc0\xec\x51\x92\xad\xfc\x0a\xb6\x1a\xb3\xee\xcb\x7a\x32\x39\x69\x60\x7a\x74\x4f\x4f\x92\x18\xa2\xef\xe1\x67\xb7\x93\x87\x9c\x63\x72\xbd\x27\x3d\x1b\xa2\xe7\x99\xa3\x21\xe1\xd9\xcd\xf1\xb3\x52\x9e\x79\x2f\xe9\x41\x9b\xa9\xb7\x6c\x37\xe5\x61\xcf\xa0\x07\x7d\xef\x1a\x16\xf5\x4c\xae\x70\xe1\xab\x5c\x81\xf9\xd8\x93\xfb\x8c\x77\xcf\xd3\x88\x16\xd8\x27\x21\x78\x36\x65\xd1\x65\xce\x93\xac\xf4\x15\x97\x25\xa5\x07\x67\xa5\x2e\x40\x5f\xd6\x13\xbc\xe5\x23\xc1\x15\x1a\xf6\xad\x6b\x47\xb3\x55\x7a\x57\x8a\x24\x69\x0f\x2c\x54\x2b\x41\x6b\x0b\x50\xdb\xa7\x81\x1c\x3f\x38\xb9\xda\xc9\x1d\x12\xa0\x06\xea\xdd\x0d\x9e\x7c\x9a\xfd\x3d\x74\x58\x75\x39\x90\x83\x31\x26\xcd\x9e\xde\x6f\x98\xcc\xc3\x55\xef\x54\x1d\x9e\x19\xda\xec\x02\x09\xe9\x78\x9a\xd6\x6b\x5a\x1e\x6a\x7a\x23\xf8\x7d\x60\xff\xae\x11\x4e\x3f\x3b\xa8\xa9\x1e\xc7\xb4\x1e\x3a\x5c\xcb\xd6\x32\x10\x41\x71\x47\x0e\x70\x78\x58\x3a\x03\xe8\x6b\x76\x76\x42\xd7\x72\xa3\x4a\x3c\x0c\x4c\xbc\x7e\xba\x21\x99\x2a\xc3\x08\x6e\xa7\x7b\x08\x77\x9f\x91\x04\x38\x2c\xa7\xef\x3e\x9c\xfd\xda\x91\x9d\xb0\x94\x46\x31\x55\x0a\x1c\x9b\x36\xd3\xbc\x20\x1c\xc6\xb9\x79\xd6\xae\x93\x34\x36\x07\xae\xbc\xdd\xd5\x22\x99\x9f\xf3\xaf\xb6\x69\xb0\xc9\x0f\x34\xe7\x73\xee\xd7\x6a\x3d\x84\x4e\xce\xc6\xd3\xae\x59\x0b\xca\xa9\x64\xd4\x43\xdf\x78\xde\xa0\xbc\x8b\xfe\x51\x6f\x22\x77\x12\x48\x63\xc2\x1a\x0f\x9d\xf6\xf4\x19\xa2\xde\x2a\x7d\x8c\xa6\xf9\x28\xc9\xae\xf8\x25\xfb\x5b\x15\x8a\x98\xc5\x86\x28\xf3\x33\xd2\xdf\x4c\x25\xbd\x58\x62\xad\xaa\xde\x9f\xc8\x3f\x38\xbd\x9e\xe7\x6f\xba\xfb\xf3\x40\xe2\xee\x5b\xf7\x83\x89\xd5\x06\xe5\x7e\x70\x5f\xfd\x4b\x08\xea\xbb\xc8\xda\xc3\xc4\x7d\x0f\x35\xdd\x73\x33\xe9\xa5\xa6\x97\xc6\x87\x1e\xd8\x61\x38\xdf\x84\xc5\x65\x9f\xf8\xfb\x1d\xc2\xcd\x40\x87\x82\x21\x06\x95\x0f\x34\x31\xc1\x54\x51\x66\x18\xfb\x17\xfa\x4d\x7b\xbb\xb7\x8c\x70\x70\x23\x9a\x61\x3e\x17\x0b\xca\xe6\xc2\xd8\x1f\x3a\xe2\x7b\xd2\x22\x81\x0f\xb6\xc3\x69\xab\xef\xa8\x64\x38\x05\x38\xfb\xba\x5b\x0a\xc9\xee\xe8\x0f\xef\xd9\x72\x5c\x59\x1d\xa9\xcb\x94\x67\x15\xed\x40\x7d\x8c\xe1\xc9\xba\x4d\xe7\x69\xb5\xa5\x37\xce\x28\x29\x3a\xef\xc5\x17\xd0\x79\xcd\x71\x2c\xb1\x1a\xce\x9e\x1a\x5c\x62\xfa\xeb\x07\x54\x44\xea\xcb\x72\xe1\x6f\xf7\x04\xa4\x2e\x0c\x2d\x22\xe9\x1c\x79\x86\xba\x35\x75\xe4\x3c\xfe\x94\x57\x6f\x22\x68\x63\x69\x4f\x3f\xb3\x05\xfa\x1d\x7d\x0b\x10\x46\xd1\xc7\xae\xda\x6a\x6f\x97\x3f\x32\xb9\x36\x42\x71\x1b\x74\x35\x2d\xfb\x6a\x99\x6d\xba\x0f\xbe\x8e\x00\x5d\x33\x31\x61\xcd\x2d\x69\x9c\x78\xd2\x03\x48\x63\xb7\x3b\x84\x13\x66\xbd\xfd\xb2\xc5\x37\x47\xeb\x2e\xa0\xcb\x07\xa9\x9b\x75\x17\x33\xe1\xf3\xde\xd1\x0f\x51\x29\x53\x75\xc3\x4b\x61\x61\x3f\xf9\x92\x0b\x8c\xf2\x8c\x43\x0a\x79\xeb\x68\x22\x37\x4a\xc5\x2c\xa4\x96\x20\x09\xfc\xb0\xff\xc6\x2c\x30\x01\xe5\x57\x4d\x02\x98\xfb\x75\xa5\x2d\xc1\x17\xd3\x72\x40\x69\x06\x16\xc0\x24\x49\x57\x78\x5e\x8b\x7e\xfa\xa8\x66\x1b\x74\x27\xee\x8c\xf4\x2b\x49\x2a\x5c\x81\xd3\xbe\xc3\xa4\x94\x4c\x04\x66\xf6\xa6\x87\xa6\x7a\xb7\x93\x14\x19\x68\xcf\xdb\x01\xd1\x8b\x20\xa7\x07\x62\xfc\x8a\xc4\x24\xc4\xbd\x17\x70\xea\x5e\xd1\x3d\xcf\xcf\xe9\x60\xe5\x47\xa4\xc2\xbb\xdd\x60\x65\x88\x3f\x12\x4b\x92\xee\x4e\x9e\x52\x3e\xf3\xef\x73\x62\xc1\xee\x6a\x37\x2f\x23\xd0\x40\xb9\x09\x31\x0e\xfc\x5e\x2e\x5a\xd6\x3a\xbe\x04\xd1\x64\x27\xad\x88\x21\x51\xe3\x9a\x04\xa5\x21\xae\x71\xbb\x3b\xc4\x87\xa5\xb4\x6a\xf2\x99\x1f\xda\x81\x16\xd3\xf4\x9c\xd9\x53\x4b\x99\xdd\xe7\x40\x6f\x93\x02\x03\x2b\x18\x97\x69\xc6\x40\xb2\xf4\x85\xe7\x4d\xc0\x34\x90\x7a\x23\x34\x29\xf8\x7c\x5c\x5b\xf0\x4b\x29\xa0\x5c\x31\x93\xd0\x40\x67\x96\x57\x17\x31\x9b\x04\xe3\x69\xf8\xca\xe4\x9e\x86\x46\x1b\x53\xc9\xfc\x4e\x0b\x3f\x25\x3d\xaf\x2e\xc9\x2c\xd1\xef\xe2\x24\x05\x4c\x1e\x24\xee\xbd\xe4\xc0\x1c\xde\x2d\x58\x4d\x0a\x12\xb5\x07\xb9\xb2\x1a\x5f\x51\xe3\xf6\xad\x35\x67\xa7\x15\xe8\xbf\x44\x7a\x47\x26\x34\xd2\x4b\xb1\xa0\x91\xbd\x67\x90\xa8\x45\x0d\x42\x5e\xcf\xeb\x05\xeb\x5b\x70\x2f\xfe\xa3\x2d\x54\x4c\x53\xa2\x6b\xa6\x25\xd1\x35\xd3\x8c\xd8\x9a\x8d\x50\xa0\xde\x0d\x1f\xdb\x14\xa1\x2f\x7b\x4f\x2a\xcf\x13\x77\x33\x1e\xfa\xba\xae\x08\xde\x88\xc0\xb8\x61\x12\xd5\x42\xbd\x9d\x6a\xee\x58\xcc\x5d\xda\x96\xd3\xec\xe0\xc9\x79\xf8\x62\x06\x02\xe5\xc9\xd2\x3f\xf7\x25\x22\xc7\x3d\xe7\x5b\x46\x84\x3d\xc9\xfa\x2e\x49\x25\xc6\xb5\x93\x96\x90\x36\x6f\x41\x19\x9e\xf6\x90\xe7\x19\x31\xb6\xb5\xdc\x1b\xa1\xf8\xd3\x37\x42\xf1\xa7\x6f\x84\xac\xc3\xcb\x3d\x28\x1b\x7f\xd7\x88\x90\xb4\xae\xbd\x47\x30\xd2\x0f\x49\x44\xc0\xf7\x4a\x7f\x7c\x87\x38\xaa\xfa\x05\x2c\x5d\x85\x88\xfe\x09\x76\x19\x4e\xef\x45\x87\xfe\xd5\xaf\x2a\xa4\x73\xab\x34\xd4\x1d\x2d\x35\x0b\xd8\xbf\xab\x96\x4e\x81\x35\xb2\x6c\x9c\x46\x03\x7a\x80\xfd\xba\x8d\x47\x7a\xe5\x42\x43\x80\xf1\xf4\x39\xa6\x42\x6f\xd7\x7c\xbc\x5f\x26\x59\x98\xa6\xb7\x4e\x91\x4a\x85\x46\xf9\x72\xf9\xb2\x42\xa7\x0d\x17\x68\xf5\xe3\x73\x0f\xd3\x9a\x35\x99\xd6\x2f\x1f\x42\xf7\x10\xb6\x27\x21\xb8\x11\xfd\xc8\x96\x87\x37\x7f\xc7\xe1\xb7\x8f\xa7\xc6\xf5\x8f\xac\x74\x32\x6e\x92\x50\x9c\x96\x7d\x79\x88\xa0\x99\xe2\x6f\x53\x7a\x31\xd3\x9f\xdb\x7d\xa0\xbe\xa6\x62\xce\x16\x94\xef\x49\xcc\xba\x0d\x6a\x5c\xbe\x7a\x8a\x56\x25\x68\x7b\xa4\xe5\x9c\x2d\x80\x77\x22\x07\xa0\xb3\x54\xee\xb8\x78\xb5\x57\xa5\x31\xc8\xe0\xb2\xf2\x14\x83\x95\x8b\x5a\xe6\xba\xf1\x42\x00\xf2\x8d\x0d\x08\xd3\x6f\x06\x10\xa3\x3e\xef\xe1\x46\xdd\x6d\x6a\x7b\x93\x1c\x22\xbb\x7a\x16\xba\xb2\xd2\x24\xef\x4c\x87\xdf\xa3\xa1\x35\x8e\x68\xe3\xcd\x83\x44\xc4\xe1\x81\x37\xb5\x4a\x58\xdf\xbf\xff\x74\x16\x8c\xc9\x9b\xf7\xef\x3e\xbc\xff\xf4\xf6\xec\x34\x98\x10\x40\x8e\xc1\x63\xa2\xd1\x6e\x9f\x48\x73\x7b\x99\xcf\x52\x85\x52\x03\xd1\xb3\xda\x7b\x25\x3b\x40\xc6\x21\x1d\xd5\xf5\xa6\x23\x68\x0a\xac\x4a\xee\xa3\xc7\xcf\x11\x08\xdd\xe1\x2f\xf2\xf8\xee\xda\x22\xa2\x94\x96\x33\x10\xbf\xde\xed\xe4\x4f\xb9\xdb\x4d\x1e\x49\xe8\xe4\x51\x19\x48\x8c\x23\x97\x8d\x84\xef\x9b\xf2\xd1\x4a\x1a\x59\x89\x6a\xe9\x37\x76\x38\x08\x3b\x77\x75\xbd\x40\xd4\x78\x74\x62\x4b\x1b\xdb\xb2\x17\xce\xa9\xeb\x79\x98\xc9\x49\x71\x01\xc6\x05\x9a\x22\xde\x06\x94\xa6\x4d\x2a\x70\x30\xa9\xd5\xbc\x68\x87\xce\x4b\x96\xfe\x40\x18\x81\x57\x49\xed\x49\x72\x3e\xf3\xd9\x5c\x7e\x2f\x48\xa9\x7e\x71\x5d\x96\xe9\xa1\x96\xf0\xfe\x8c\x97\xa7\x2f\xf2\xc5\x59\x23\xa9\xf6\xf2\x21\xdc\x80\xca\xdd\x4e\xf9\x72\x04\x49\x9e\xdd\xae\xa6\x8a\x25\x1d\x6c\xcc\xf0\xea\x71\x27\xa9\x1d\x64\x63\x91\xda\xca\xae\x50\xe1\x4a\xad\x50\x31\x73\xa2\xd2\x46\x54\x1a\xd4\xa2\x40\x34\xf5\x3c\xb5\x36\x1d\xcb\xbe\x4c\x4e\x08\x84\x2f\xd9\xed\x61\x67\x9c\x7d\x8a\x07\x84\x53\xa3\x43\xb1\xc9\xcb\x5b\xbb\x87\xbf\x0b\x25\x4a\x6a\x31\x15\xc0\x65\x87\x30\xee\x23\x7b\xb7\x1d\xc8\x70\x4e\xc5\xc8\xaa\x68\x50\xfe\x65\x16\x9b\xeb\x32\x13\xc7\x76\xef\x64\x32\x41\x44\xf5\x5f\x79\xc8\x4c\x64\x85\x7d\x32\x61\x47\x5a\x8e\x11\xf7\xca\xb3\x1c\x31\xd7\x00\x5a\xd1\x12\xe8\x31\x82\x21\xc0\x1f\xd5\x4c\xcb\xc8\x8c\xd3\xdf\x58\xc6\x44\x12\xd9\x91\x52\xef\xc2\x0d\x06\x38\x65\x7b\xad\xa3\xa2\x94\x09\x0f\xa6\x2c\x60\x44\xad\x43\xce\x8e\x1c\x55\xc0\x49\xaf\x04\x53\x20\x48\x52\x34\xca\x0e\x5c\xad\x98\xe8\x0e\xd7\x6c\x87\x97\x7f\xad\xcb\x34\x1c\x6a\x73\x9b\x93\x3f\xe5\x63\xf6\xd1\x9c\x06\x8b\x47\x2b\x92\xd1\x2d\xa2\x28\x40\x74\x8c\x08\x0a\xe4\xc7\x63\xb4\x27\x9c\xfa\x08\x0d\x1d\xe7\xba\x25\xe9\x5b\x50\xe0\xb8\xdc\x6c\x9e\xaf\xd0\x90\xef\x9b\x8b\x44\x57\xe5\xd3\xf1\x8e\x3e\xc6\xa6\xb6\xb1\xac\x05\x11\x59\x93\xac\x50\xd6\x86\x46\x60\xab\x7b\x3e\x5e\x78\x1e\xfa\x4a\x7d\x4f\x16\x33\xe6\xb8\xfc\x7f\x0c\x2e\x06\x6d\x70\x62\xea\x95\x0d\xe5\xf7\x6a\xa8\x46\x4e\x5b\x56\x44\x61\xce\x82\x8c\x54\x99\xfe\xe4\xee\x9c\x3c\x64\x1f\xb8\x27\x85\xe7\x75\x85\x02\x6b\x83\xa2\x97\xec\x76\x16\x8d\x54\x7d\x3e\x04\x71\x50\x8e\x4a\xae\x9d\x02\x3d\x79\xde\xf6\x8b\x5b\xfb\x25\xc8\x6d\x71\x70\x80\xb8\x92\x93\x34\xf7\x3c\xeb\x09\x5a\x06\x77\x3b\xdf\xbc\x1d\x38\xa7\x4a\x8d\xbc\xf2\x06\xf2\xca\xeb\x13\x87\x42\x59\x6c\xf4\xd5\x57\xaa\x32\x4a\x69\x62\xb1\xa7\x2f\xaf\xf2\xca\xb9\xf4\xac\x1a\x72\x90\xf3\x0f\x4a\x4c\x26\x46\x68\x9a\x6c\xe8\x98\x5c\x50\x93\x24\x28\x87\xe0\x3f\xb1\x29\x6c\xc0\xb0\x95\x7e\x5e\xd1\xf1\x74\xf5\x8a\x99\x33\x67\x35\x1c\xe2\x25\x65\xf3\xd5\x82\xac\xe9\xc5\x90\xfb\x4b\xb2\xc2\x64\x33\xa4\xc2\x97\x85\x83\x0b\x06\x7b\x07\x3c\xa7\x85\x96\xf2\x3f\x57\xe3\x73\x45\x6e\xe9\xb9\xf6\x1c\xa8\xe0\xa0\x2d\xc5\xb2\x52\x24\xac\xb0\x75\xde\xd0\xf1\x74\xe0\x5f\xd1\x5b\x10\xe9\xf6\x31\x1e\xc5\x3c\x63\x53\xbc\xa4\x57\xda\xf8\xb1\xa9\xfb\x66\x38\xec\xab\xfd\x48\x96\xd4\x5b\x04\x34\xe3\xda\x94\x33\xbd\x06\xd5\xd0\xeb\xf9\x44\x75\xc7\x4e\xfb\xf5\x7c\xbc\xc0\xc3\x18\xea\x18\xb7\x6a\xc0\xb5\x5b\x4f\x77\x4a\x54\xd1\x97\x14\x21\x72\x4a\xf5\x5a\x61\x78\x1a\xfa\xe8\xc9\x04\x11\x34\x57\x49\xb5\x5b\xc5\x85\xcc\x72\x3a\xd3\xf9\xc1\xe8\xe6\x91\xa4\x06\x8e\xb6\x68\xd8\xa4\x16\x46\xff\xc5\x93\xcc\x47\xe4\x08\xe1\x21\xda\xa3\xe0\x94\x5c\x62\x6b\x69\x7d\xd3\x92\x8e\x75\x34\x29\xb5\x7a\xd5\x6c\x0c\xc6\x71\x10\x52\xf7\xc3\x2e\x82\x7e\xae\x94\xb9\x9e\x63\x2d\x7b\xf9\x18\x64\x2f\x6b\x99\xbe\xc7\x4f\x71\x6d\x64\x0a\x8d\x10\x26\xb1\x44\x42\x0f\xd4\xcb\xeb\x21\x0d\x3f\xdd\x6e\x2e\x78\xea\x79\xea\x77\xb4\xe4\xc2\xfd\xf6\x11\x68\xc5\x8d\x98\x22\x2f\x11\xde\xed\x9e\x8f\x27\xe3\x27\x7f\x0a\x93\x82\x4d\x6a\xcf\x63\x73\xbe\xd8\xed\xd8\x5c\x2c\x70\x53\xf0\xd7\x71\x98\x6e\xdf\x8d\x14\x06\xff\x7c\xfb\x95\x17\x07\x2e\x88\xa0\xe8\xff\xfd\x3f\x13\xfa\xb3\x56\x21\xcd\x85\xc1\x21\xd0\x0c\x22\x22\xd9\xe7\x88\x37\xc2\xa9\xf5\x7c\x8d\x86\xa5\x66\xf6\xd5\x08\xf8\xd1\xfc\xb7\xdf\xfe\xf3\xab\xd1\x5f\x87\x33\x1f\xcf\x7f\x5b\x6c\xf7\x3b\x79\xcc\xa0\xdf\x7e\xfb\xca\x43\x4e\xaa\x66\xa1\x3b\xab\x76\x88\x47\x7f\x9d\xf9\x33\xfa\xdb\x6f\xbf\xf9\x78\x27\x77\xdc\xd1\x68\xa8\x01\x0b\x79\x82\xa0\xaf\x26\xa3\xbf\xce\xe4\xb2\xfd\x0a\x29\x09\x12\x4d\xd5\x59\x04\x60\x0e\x4a\xe5\x8a\xdc\x0a\x8f\xd4\xb2\xae\x93\x7d\xaf\x39\xa5\x48\x63\x0f\xc7\xb8\x3d\xbc\x71\xbd\x3d\x29\xa6\x15\x98\x73\xb1\x96\x33\x05\x6e\x5b\x20\xa9\xf7\x08\xfa\x2d\x3b\x3a\x3a\x3a\x4a\xb2\x23\x34\xf4\x25\xf2\xfd\x29\xbb\xcc\xf8\x75\x86\xf0\xd0\x2f\x67\xe8\xc8\x0f\xcb\x23\x39\x6e\xcb\x24\x05\xef\xae\xf5\x90\xfc\xe7\xe8\xaf\xf3\xdf\x7e\xfb\xed\xd1\xe2\x11\x41\xb2\x83\x81\x4c\x96\x26\x19\x53\x8c\xf2\x21\xc2\x28\xc8\x64\x09\x8a\xec\x88\x8f\x2e\x6e\x8f\xd0\x30\x03\x38\x42\xfd\x82\xbd\x76\xc7\xa2\xff\xcb\x24\x0d\x89\x82\x7b\x0a\x68\xa3\xff\x5b\xb2\x9b\xb2\x2f\xb9\xba\x7c\xa9\x9f\x40\xfd\xb4\xaf\xc0\x00\xd3\xb2\x73\xa6\xfb\x2d\x83\x26\xe6\x5d\xf4\x8d\xbc\xdf\x9f\xd4\xd9\x95\x4d\x20\x80\xd6\x97\x40\x22\x14\x04\xf8\x26\x6f\x4f\x9c\x59\x16\xf0\x30\xda\x29\x44\x60\x4c\x52\x3f\x23\xdc\xf3\xf8\xe8\xbc\xe0\x95\x88\xac\x25\x12\xad\xc8\x48\x72\xa2\x0e\xae\xcc\x7f\xa6\x05\x8f\x9f\x37\x04\x8f\x25\x66\xea\x6e\x50\x75\xa4\x2d\x05\xdf\xf4\x2a\x1d\xbc\x0b\x73\xcf\xe3\xfe\xbb\x30\xb7\xee\xb5\xdf\x85\xf9\x67\x14\x1b\x1a\x29\x00\x45\xeb\x32\x5a\x50\xdc\x9b\xfb\x13\x2b\x65\xf2\x4f\xac\xb4\x55\x7e\x62\xe5\x67\xaa\x6c\xa4\xb0\x55\x76\xa1\x18\xb6\xc4\x0a\xeb\x93\x57\xd2\xed\xef\xc2\x9c\x5c\xc1\xd7\x27\x56\x4e\x5d\xa5\x1a\x89\x2e\xcf\x41\x58\x51\x29\xce\x47\x3d\x0a\x37\x47\xe7\x20\x61\xcd\xb0\x24\xad\xdd\xe8\x73\xed\xcf\x1b\xa2\x7a\x74\x68\x9c\x91\xf7\xcf\xd5\x39\x86\xf1\x9e\xe4\x4d\xbd\xac\x51\x18\x2b\x5f\x83\xcb\x16\xdc\x29\x7c\x7d\x77\xe1\x57\xb6\xf0\xbd\xa5\x39\x6e\xe9\x76\x4f\x6e\xe4\xbf\xeb\x9e\x3e\xa1\x11\x1a\xb2\x3d\xb9\xec\xeb\x2e\xf8\x58\x78\x2b\x57\xb1\x26\x5e\xfd\x09\x26\x93\x31\xde\x77\x46\x4e\x21\x9d\x6b\xb9\xb8\x6f\xe7\xd9\x82\x96\xed\x01\x54\x68\xea\xda\x59\xfd\xb7\xf3\x72\xd1\x1e\x47\x27\x95\x66\x90\xa9\x54\x7d\x43\xea\x12\x05\xb7\x78\xb4\x09\x73\xff\xb2\x3b\xa6\x75\x81\x37\xf3\x72\x41\x07\xe3\xce\xe8\x76\xaa\xbc\x81\x2a\xfb\x06\xda\xad\xf2\xc6\x56\x09\x1b\xf3\x94\xce\x17\xe4\x0d\xdd\xf2\xec\x13\x3b\xa4\xe0\xab\x06\x09\x50\x75\x66\xae\x9e\x1b\x1f\x4d\x9e\x3e\x05\x2f\x03\x06\x61\xd3\xd2\xb2\x37\x38\x1d\x4f\xf9\xab\xd2\x90\x9a\xdc\x3c\x63\x09\x5a\xce\xf9\x82\xa4\x34\xf2\x05\x9e\xa6\x8d\xc2\xc6\xc8\x90\xcf\xa9\x2d\xb2\x87\xbc\x4f\x0d\x21\x61\xf6\x9d\x05\xcc\xa0\x98\x89\x6b\xda\x20\x29\xe0\x09\x87\xc5\x4e\x4d\x2f\x26\x4e\x45\xca\x7b\x0f\x18\xf6\xac\x03\x94\x61\x52\x87\x24\x5d\xab\x8a\x7e\x8c\x88\x70\x22\x08\xb3\xae\x01\xf6\x84\x67\xdf\xb2\x25\x17\xec\xdd\x5d\xc2\xe7\x46\x22\x62\xab\x5b\x1c\x94\xc4\x14\x16\x64\x04\xd8\xf8\xea\x6a\xad\xbb\x1f\xcc\x17\xc4\x76\x21\x18\x4c\xec\xbb\x7d\x95\x95\xc1\x78\x3f\x2d\x7c\x06\x32\xa2\xa6\xf2\x9f\xee\x78\xd6\x6f\x4e\xa3\xe7\x65\xf5\xe0\x80\x45\x36\xdd\x24\x5a\x42\x79\x07\xbb\xd1\x38\xb8\xcb\xce\x6a\x28\xeb\x52\xe9\xc0\x18\x17\x54\xc6\x39\x4d\x4f\x65\xe5\x39\xe0\x04\xe3\x86\xfa\xf3\xf5\x78\x5e\xe9\x36\xb7\x1c\x39\x03\x31\x1c\x42\x49\x77\x09\xe0\x75\x68\x8d\x46\x33\x27\x07\x9b\xb9\x94\xcd\x3c\x55\x5a\xa0\x60\x97\xae\x12\x2b\xa6\x6b\x72\x1e\x18\x8a\xb6\xe7\xc1\x37\xa3\xf3\x5c\x30\xf0\xeb\x57\x89\x55\x92\xad\x6a\x25\x79\x46\xc7\x53\xf6\xea\xd4\xec\x0c\x66\x76\x46\x49\x4f\xe7\x6c\x31\x05\x7f\x39\x26\x96\xca\x85\x55\xcf\xff\x81\x1e\x69\x02\x6b\xd0\x1c\x24\x65\x48\x5e\x31\xe0\x3f\x95\x61\x74\xf9\x3a\x8e\x59\x16\x57\x9b\x9e\x62\x10\x9a\xd6\x7a\x89\x19\x0d\x15\x39\xc0\xb4\x36\xdc\xb4\x1c\x52\x79\xac\x33\x7b\xa6\xc3\x01\x6f\x1f\x3f\xcc\x15\xff\xc2\xbc\xcd\x90\x84\x0a\xcf\x13\xa3\xf3\x98\x5d\x54\xab\xb7\x27\x96\xa7\x3e\x54\x34\x43\xa3\x39\xdf\xde\xbe\x3d\x01\x15\xda\xbe\x88\x46\x5b\x6b\x15\x6b\x84\xa6\x6c\x8a\xcb\x21\x4d\xc0\xd0\xbc\x2a\xf5\x83\x9e\xb7\xa6\xa8\x98\x91\xdd\x92\x9b\xe9\xce\xf1\x3b\x2a\x67\x35\xe9\x19\xcc\xd5\xe3\x86\x43\xdd\xf4\xe4\x6e\xd1\x4b\x75\x41\xa1\xaf\xe5\x82\xa0\x10\x9d\xe2\x1e\xd5\x1b\xac\x60\xb3\x6a\xd2\xeb\x01\x75\xcb\x35\xa0\xa6\x6d\x66\x3e\xec\x3c\xd4\xe5\x9a\xb1\xba\x47\x9b\x6a\xfc\x64\x32\x7f\x82\x45\x70\x20\x2b\xc9\x68\xbb\x27\x9a\x53\x0c\x06\x81\xcd\x12\x82\x08\x47\x24\x70\xc5\x80\x31\x78\xef\x8e\x76\x08\xe4\x72\x56\x06\x1d\x82\xba\x9c\x21\x34\x74\x86\xf3\x27\x07\x7b\x7e\xbe\xe7\x4d\x5c\x2b\xf3\x7f\xe4\xbc\x94\xab\x63\x0d\x01\xf0\x68\xcc\x04\x83\x05\x13\x13\x89\x24\x7e\x04\x2f\x86\x59\x1c\x8a\xf8\xe7\x50\x64\x4a\x66\x24\xba\x6c\xe1\xe1\xfe\x8b\x6a\xc4\xb3\x82\xa7\xe0\x08\x24\x52\x3b\xc1\xec\xc7\xf9\x82\x70\x67\x77\x09\xaa\x08\x6c\xb3\xbb\xe4\x75\x4c\xee\x0d\x26\x71\x3a\xa0\xaa\xad\xbc\x05\x04\x62\xd6\x43\xa1\x6b\x23\x85\xfa\x1a\x14\xc8\x7e\xda\x00\x44\xd5\x57\x1f\x88\x74\x82\x30\x8c\x78\x2a\xa6\x46\x34\xac\x31\x35\x02\x93\xb0\xb5\x11\x05\x26\x49\xf3\x0a\x01\xa2\x5b\x49\xb7\x5d\x89\x6e\x57\x44\x53\xcf\x4b\xcd\x22\x99\x36\xba\x53\xd4\xad\x8e\x66\xd1\xe1\x56\xcb\xc8\x4e\xab\x89\xa0\xe1\xbe\xbe\x93\xee\xbb\x83\x0d\x7e\x3c\x48\xce\xf3\xcf\xce\x21\xde\xde\x6b\xf6\x4e\xb3\xd8\xf3\x7a\xc1\x7e\xd3\x74\xf1\x9b\x3f\x6b\x0f\x48\xe2\xee\x3e\x3e\xa8\x21\xb9\x18\x49\x6b\x6b\x41\x62\x9e\x2d\xa6\xa9\xf5\xce\xe5\x79\xbe\x00\xba\xb7\x63\x7a\xbe\xcf\xb2\x0f\x33\xb6\x99\xac\x59\x9f\x14\xcc\xfa\x64\xb5\x35\x63\xd7\xac\xd0\x33\x8c\xa7\xf2\x4a\xd7\xe0\xf3\xde\x6d\xd3\xe5\x33\xac\x14\x78\x96\x15\x4c\xdb\x6f\x4d\xfe\x60\xe0\x39\x33\xd4\x13\x43\x74\x74\x16\x33\x71\xc6\x3f\x95\x61\x99\x44\xc6\x9a\x95\x16\x23\x2d\xd8\x1b\xd7\x19\xa8\x95\xf1\xaa\x6a\x05\x3b\xa5\x9a\xad\x4d\x40\x5f\x35\x7d\x6b\x3c\x9b\x28\x4b\xcd\xcf\x9f\x6a\xa6\x9b\xd6\xa7\x7d\x32\x01\xa6\xdb\x7c\x41\x22\xba\xd5\x82\x42\xee\x8a\x51\x6f\x1a\x12\xcb\xd5\xed\xd5\x62\xda\x45\x8f\xd6\x41\xa1\xd0\x4d\x53\x04\xa9\x27\x5d\xe4\xa0\xb5\x7f\x6f\xd7\xe9\xbe\x64\x3b\xdd\xdb\x93\x98\x99\x07\xf6\x46\x13\x49\xad\x36\xd3\x84\x1b\x95\x98\x66\x87\xa6\xc2\x77\x74\x7b\x49\x48\x2a\x49\x34\x87\x71\xfc\x81\xf3\x34\xc9\x56\x67\xdc\xe7\xae\x12\x36\x7f\x20\x87\x5f\xd2\x51\x7e\xe3\x51\xaa\xc4\xb8\x36\xdd\x70\x76\x9b\x6b\x5d\x23\xf4\x26\xcc\x32\x5e\x1e\xc9\x65\x75\x14\x1e\x45\x69\x58\x14\x47\x61\x71\x14\x1e\xd9\x6d\xda\x66\xf3\x6b\xfb\x28\x60\x0d\xeb\xc9\x63\x6d\x0d\xcb\x55\x41\x77\x4c\x0b\x31\xb9\x07\xb9\x31\x29\xa3\x56\x4b\x59\xcf\x22\x2d\xad\xd4\x74\x43\x7b\xdb\x50\x97\xdd\xdb\xe9\x60\xb2\x27\x6e\x5a\xbd\x5c\x8c\x4a\x00\x6d\x8f\x52\xbb\xc6\x51\x52\xbc\xcd\x1a\xeb\x5e\xe2\xcc\x56\x29\x56\x8a\xa8\xa7\x26\x57\xcc\xd3\x6d\xde\xe7\x2b\x9a\xa5\x3d\x65\xf8\xda\x6a\x3b\x5a\xd6\x30\xd4\x5f\x73\x43\xbc\xb2\x79\x28\x3e\xa4\x72\xb7\x18\xc8\xad\xea\x17\x0e\xf8\x40\x03\x3e\x01\x91\xf9\xa7\x2a\x37\x45\x38\x15\x17\x1a\x04\x95\xee\x7d\xec\x22\xbc\xf0\x41\xf8\xad\x30\xb5\xd8\xa1\xed\x37\x42\xef\x3e\x4d\x59\xe9\x05\x5a\xf3\xba\xad\x27\x01\x47\x9a\xc6\xf3\x1a\x41\x23\x32\x43\xda\x42\x0d\x35\x2f\x57\xdb\xcf\x19\x4b\x72\x43\x5b\x42\x7f\x3c\x9e\x65\x43\x74\xe4\xcb\xb4\xc1\x11\x1a\xf2\xc6\xa3\x06\x46\x41\xd6\x6f\xe5\x43\x19\x1c\xd7\x17\xb0\x41\xf3\x6d\xe3\x90\xb3\xd9\xfa\x4d\x63\xf2\x04\x6b\xbb\x74\x0a\xdf\x3e\x7b\x81\x1b\x4f\x1b\x60\xad\xee\x8e\x7b\x92\xad\xdc\xb9\x2b\x0d\x06\x65\x8f\x1c\xe1\x9e\xb4\x36\x52\xe7\x06\x1f\x8d\xae\xb4\x84\x99\xdd\x6b\x60\x4f\x55\x9d\x14\x2e\x33\x5c\xcc\x7c\xd1\xd5\xf8\x99\xf5\xc0\x8c\x95\xe7\xa0\x27\x8e\xce\xcb\x05\xd1\x22\x36\x02\x9b\x7b\x45\xab\x95\xe6\x9d\xbc\x6b\x91\xb0\x53\x7d\x0f\xcc\x56\xdf\x13\x07\xd5\x73\xb8\xfd\x76\xb7\x7e\xcf\x50\xa7\x1d\x4c\x30\x05\x3d\x9a\x7e\x21\xf3\x31\xe1\xa0\x26\x46\x7a\x76\xf6\x01\xc7\x54\x69\x77\xab\x4f\xc1\xa5\x7e\x9f\x9c\xb6\x6c\xbc\x38\x20\xdd\x3d\x26\xd6\xca\x63\x66\xe8\x21\xd0\x1b\xe8\x99\xe1\xac\x5d\x25\x79\xd0\xcc\x66\x07\x66\x36\x5b\x60\x02\xb3\x6a\x07\xc0\x60\x97\x5e\xd6\x4d\xda\x44\x36\x20\x6f\x63\xfd\x75\xf5\x2a\x9f\xf8\x7d\x60\x3a\x5f\xe0\xa9\x30\x93\x4e\xb8\x22\x7d\x8d\x97\x7f\x45\x18\xf5\x2e\x28\x39\x09\xac\xa3\xa4\x03\x46\x5e\xad\x86\x9a\x5e\x2b\xed\x11\x6c\x2f\x4c\x97\x3f\x5e\x4b\x65\xcd\x42\x1f\x4d\x1e\x3f\x46\xa4\x24\x12\xdb\xd5\xec\x35\xfc\x00\x17\x50\x8a\xc5\xe6\x03\xcd\x96\xf9\x5f\x63\x6d\x64\xf5\x05\x26\x1c\x3f\x40\xe2\xc0\x8a\xe0\x29\x09\xbc\x17\x5f\x2b\x0a\xf0\xc9\x33\x49\x2e\x74\x8e\xcd\x8e\xea\x43\xd3\xda\x87\x32\xe3\xf3\xa7\xec\x82\xc4\x7c\xf3\xf6\x84\x8e\xf7\x53\xee\x87\x0e\xdd\xb5\xbd\xdb\xda\x05\x37\xbe\x31\xb2\xd1\x79\x12\xc3\x65\x96\x89\xe1\x70\xea\x96\x19\x3e\xdc\xd6\x45\x42\xd1\x11\xdc\x69\x8e\xe1\x81\x4b\x9e\x04\x4e\x89\x43\x74\xa4\x38\x4a\x1d\x7a\x5b\x7b\x71\x93\xad\x01\xde\xc4\x89\x76\xd1\x4f\x22\x6a\xad\xf5\xf2\xcd\x46\x19\x4c\xaa\xbd\xe8\xe5\x82\x45\x61\xb4\x66\x56\x09\x97\x44\x98\x08\xc7\x56\x39\xeb\xa5\xfa\x67\x08\x05\xe8\xd5\xe0\xf8\x18\x0d\x93\x21\x3a\x3e\xfe\x06\xdd\xa5\xb3\x26\x49\xdd\xbb\x95\x81\xd3\xc3\xce\xe1\xef\xd2\x00\xc6\xdb\x74\x54\x65\xcd\x0e\xe0\x7d\xc3\x42\xd1\x43\xa8\x04\x78\x1f\xb7\xab\x0a\x25\xd9\x11\x33\x6c\xd8\xc2\x47\x4f\x9e\x20\x4c\x9a\xd1\x65\x2b\xba\x36\x78\x4e\xc7\x84\x53\x36\xe5\x53\x4e\x79\xc3\x7d\x5f\x36\x1c\xd6\x1a\x88\x74\x4c\x52\x5a\x4e\xd3\x69\xdb\xc9\x9f\xd0\xa9\xa6\xd9\xb1\xf8\x66\x3c\xc5\x8c\xb2\x86\x94\x7d\x76\x7c\xac\xe2\xc5\x71\x26\xe3\x4b\x5a\x36\xe2\x85\x8e\xd7\x2b\x74\x1a\x1e\x1f\x4f\xd5\x75\xd6\x11\xbd\x3c\x62\xd3\x76\xb9\xad\x72\xf6\x2e\x2d\xd1\x26\xf4\xef\x18\xa9\x67\x77\x8f\xd4\x33\x3d\x52\x60\xc8\x31\x59\xfa\x25\xad\x6f\xc2\x83\xf1\xb4\xbf\x11\x83\x49\xaf\x74\xdd\x1d\xad\x78\x8e\xe4\x42\x70\x4b\x72\x9f\x94\x5b\x6e\x4e\xe9\x7c\x31\x65\x53\xcc\x0d\x4b\x9a\xb4\x86\x46\x51\x21\xda\xaf\x9b\x95\x2e\x15\xc7\xc7\x47\x30\xfe\x3e\x9f\x8b\x05\x41\x51\x98\x97\x95\x00\x8f\xe8\xd8\xf1\x01\xc7\x5d\xb7\x6c\x26\xed\x45\x75\x71\x91\xaa\xa4\x8d\xc7\x64\x65\xfe\x97\xa4\x75\xe3\x42\x90\xca\x9d\xa9\x15\xaa\xcd\x52\x43\x7b\x3d\x8f\x0d\x28\x0d\xa7\x38\x39\xd8\x6c\x53\x86\xbc\x42\x03\xb7\x5f\x65\xb0\x94\x49\x7b\xca\x95\xb2\x07\x64\x53\x5e\x1c\x13\xd7\x8b\x63\xe6\x27\xf3\xc8\x6d\xbb\xc0\x3a\xa9\x35\xf9\x18\xe9\x21\xc9\xfc\x02\x92\xd6\x43\x92\xea\x07\x6b\x45\x86\x4e\x81\xc4\x74\x8e\x8d\x6d\x52\xbc\xce\x22\x56\xc8\xdb\xb3\x90\xf8\xe2\x07\x7e\xcd\x24\x92\xdc\x6c\x78\x66\x63\xb8\xc3\x65\x35\x92\xe7\x29\x29\x45\x78\xc5\x44\xc1\xce\xae\xf9\x87\x75\x58\xb0\x20\xb4\xa0\x53\x89\x97\x7f\x60\xe1\x15\x0b\x92\xcf\x59\x14\x6c\x0b\x87\x6b\xde\xc4\x8b\x17\x8a\x37\x21\x4f\xaa\x44\x9f\x54\xb2\x17\x5f\x3f\x69\x8a\x01\x3d\x79\xd2\x63\x6b\xed\x90\x6e\x95\xe2\xb3\x9e\xc9\xa3\x1d\xa1\x21\x7b\xf0\x91\xa6\x4f\x2d\xd7\xc4\x92\xb1\x23\xa7\xeb\x4d\x79\x21\x89\x22\x85\xf5\x9b\x4a\xef\x00\x92\xf5\x28\x89\xd3\x3d\x9e\x0a\x3f\x7a\xf0\xd9\x27\x5a\x67\x1f\x49\xed\xe1\x05\x2f\x6e\x47\x68\x28\xe4\x89\x45\x22\x8a\x8e\x1e\xd5\x11\xea\x0c\x73\xbb\x21\xfa\x0e\xca\x43\x87\x5c\xd5\x3d\xe4\x62\x6b\x7f\xcf\x1c\x72\x29\x26\x79\x07\x18\x61\xb2\xa4\xa1\x6f\xc0\x26\xfb\x77\x22\x5c\x6d\xb4\xe1\x0d\xe3\xf2\x64\xa4\x6e\x01\x60\xa5\x7e\x49\x42\x3f\xb6\x2a\x9f\xf5\xc4\x79\x5e\x27\x99\x29\x5a\x46\x3b\x86\x2d\xea\x3c\x18\x63\xd2\xc9\x95\x63\x4c\x92\x9e\xb3\x38\xc6\xfd\x73\x99\x93\x25\x6c\xa5\x35\x2d\xba\x15\x4c\xef\x3e\xb9\xd7\xe6\xe0\x4e\xd5\xc1\x3d\x5c\x0f\x35\x20\xfa\xfc\x49\x6e\xf8\x47\xcc\xea\x65\x37\x57\xf7\xa1\x35\x6f\x24\xdc\xd0\x10\x6e\xd6\x99\xcd\xee\xb4\x7b\xdb\xd9\x17\xd9\xd4\x51\x73\x77\xf5\xe4\xf0\xd4\xda\xd6\x3f\x61\x69\xb2\x49\x4a\x16\x83\xb5\x45\x3e\x1f\x2f\x08\x9f\x4f\x16\xc4\x3a\x8f\xee\xa3\x3a\x1a\xaa\x34\xce\x66\x50\x8f\x75\xf6\x74\x94\x17\xea\xbe\xf1\xc7\xf5\x83\x59\x72\x98\x70\x01\xcb\x0e\x19\xbb\x29\x3f\x25\x17\x69\x92\xad\xa6\x53\x87\xf1\x9b\xcd\xb8\x8f\x9e\x1b\xfb\x05\x6a\x17\xd8\xd7\xf5\xaf\x29\xa5\xd9\x28\xd3\xfa\x7c\x9e\xd7\xda\x3c\x36\x56\x39\xf5\xda\xf6\x6f\x77\xed\x5c\x37\x93\x69\xeb\x46\x58\xb2\x8e\xce\x9b\x08\xa7\x77\xa1\x2d\xfa\x10\x06\x23\xec\x6e\x9a\xec\xc1\xe8\x87\x24\x9f\xa3\xe2\x1e\xe4\x9c\xf6\x6e\x56\x76\x87\xf1\xfc\xc2\x65\x3c\x83\x77\xa6\xc2\x3a\x94\x4b\xfe\x60\x41\x42\x64\x57\x1a\xeb\x27\x1f\x25\x05\xe8\x7c\x5b\x9f\x8e\x05\x1d\x4c\xf6\x7b\x25\xb9\xdf\xc9\x99\x8e\x96\x69\x55\xac\x21\x07\xd3\x7a\xad\xca\xf0\xae\x9f\x82\xe0\xd0\x3c\x22\xc5\xa2\xc3\xf6\xbd\x3f\x57\xbb\x92\xf7\x38\xa5\x4d\x0f\xd6\xdc\x48\x2e\x4f\xd3\x56\x0b\x83\xc1\x84\x5c\x34\x9a\x70\x97\x9a\x32\xed\xe9\xa3\xc1\x2c\xbd\xdd\x1f\x93\x70\xa6\xb5\x8a\xa1\x98\x20\x36\xe6\x9d\x7c\xa6\xdc\xc1\xd7\x71\x8d\x57\x9a\xfc\x41\x7c\x3c\xa0\x1b\x9b\x24\x4e\x9b\x3a\x9e\xaa\xad\x19\xdf\xe5\xc7\x4e\x3d\xbe\xca\xd8\xa9\x35\x70\xbe\x62\xe5\x1b\x39\x5f\x85\xa5\x2c\xbe\xd3\x2e\xc7\xfc\x0c\x4f\xff\x4f\x87\x00\x56\xbc\x4d\x6d\x1a\x06\xf4\xd8\xf4\x2e\xca\xc0\xa0\xec\xe9\x95\x73\xdf\x0b\x35\xfd\x22\xc9\xb0\x5e\x57\xba\xf2\x4e\xe7\xe4\x93\xad\xbc\xb3\x45\x25\x50\x28\xd3\x98\x1f\xb1\xba\x70\x45\xd4\x09\x19\x25\x3c\x8f\xfb\x02\x4f\xaf\xd7\x49\x0a\xca\xc2\xb5\x8a\xfc\x78\x9a\xbe\x72\x33\x69\xc2\x2d\x95\xf4\x1d\x75\x22\xe6\xe9\x82\x6c\x46\xda\xfa\xd8\x99\xee\xa7\xcf\x1a\x5d\x26\x19\x69\xb4\x9b\xb4\xfb\xd1\x96\xe3\x54\xbd\x5d\xfb\xd7\x49\x16\xf3\x6b\x3c\x05\xf3\x39\x57\xc6\xf8\xaa\x96\xaf\x7e\xf2\x52\x59\x5f\x7d\xfa\x52\x99\x5f\x7d\x36\x51\xd6\x57\x25\xe1\x95\xeb\x3d\xbb\x94\x7b\xf6\x39\x26\x6b\x99\xe1\xe9\x18\x4f\x13\x5f\xb8\x14\x4c\xff\x5b\x4c\x77\xce\x6a\xc4\xe4\x4e\x9b\x63\x4c\xbc\x35\x4e\x74\x2c\x51\x53\xd5\x7c\x8f\x11\xa4\x1a\x95\xd7\xfc\xb5\xb6\x71\x2e\x23\x98\x50\x5b\x72\x43\xb7\xe7\x2c\x0b\x25\xb9\x1c\x0c\xc6\xa4\x35\x9c\x8a\x9a\xff\xf9\xed\x8f\x27\xef\x7f\x3e\xff\xfe\xf5\x8f\x27\x3f\x9c\x06\xd1\x28\x0a\xb3\x9f\x0a\x76\xf2\xfe\xdd\x4c\x0d\x93\x4a\x55\xb0\xf2\xfb\x66\x66\x97\xd8\xec\xcc\x14\x55\xae\x54\x4e\x75\xdd\xad\xb4\xba\x49\x74\x30\x60\x7b\x92\x14\x9d\x54\x8e\x9d\x2e\x93\x76\x2f\x69\xea\xfc\x5b\x45\xfa\xc3\x30\x1d\xf2\x50\x94\xcd\x0a\x6d\xa6\x1c\x5c\x15\x6d\x40\xa0\x56\x22\x0c\xc8\xa5\x30\x9f\x76\x34\x60\xd8\xae\xb2\xe8\x37\xfa\xaa\xf0\xd9\xb2\xf5\x9d\xe2\xde\x85\x6f\x78\x96\x94\x5c\x7c\x8a\x04\x4f\xb5\x93\xd0\x2e\x7f\x35\x6c\x64\x9d\xda\x1e\xa8\x29\x20\xa8\x80\xdc\x08\x44\x41\x1b\x55\x76\xc9\xa3\x7a\xcc\xac\x17\x78\x78\xbf\x97\xcb\x42\x3b\x3d\x2a\xc5\xed\x36\x1f\x35\xf1\xb1\x9f\xc2\x5d\x50\x6b\x64\x8b\x91\x60\x29\x0b\x0b\x06\x5c\xc4\x06\xca\xbc\xaf\x1f\xa4\xaf\x25\x26\xd8\xaa\x7e\x1c\x1a\x4f\x26\x57\x32\xf4\xc3\x58\x99\x9f\xf9\x5d\x18\x20\xf6\xc1\x04\x93\x8e\xf3\x20\xbc\x65\xda\x10\x56\x6f\x86\xfd\x1e\x07\x6c\xa4\xd4\xb4\x21\x01\x94\x5e\x07\x7d\xc4\x33\x34\x94\x0d\xea\x2f\x5a\xe9\x53\xb7\x92\xca\x42\x8d\x63\x22\xbd\x16\xfe\x15\xdd\x1b\x3f\xb4\x7b\x63\x68\x89\xc9\xc3\xf7\x92\x74\x56\xb2\x2b\x27\xca\xed\x6a\xeb\x61\xf7\x20\x8f\xf4\x4e\xbd\x0c\xdb\x93\x9f\x61\x25\x7a\x5e\xe3\xed\xd6\x80\x67\xdb\x9b\x80\x8d\xf2\x70\xc5\x7e\x79\xbf\x5c\x16\xac\xdc\xed\xd8\x28\xd6\x37\x1c\xfb\x61\x34\xc6\xd5\x62\xfe\x81\x2d\x4b\x72\xab\xb3\xfd\x7a\xef\x6c\x67\x3c\xdf\x07\x50\x5d\xab\x98\x3a\xfa\xb0\x66\xe8\xe1\x4b\xf7\x0b\x75\xe9\x7e\xaa\x09\xb3\xa7\xcf\x14\x61\x06\xb6\xb7\x13\x63\xe9\x1c\xce\x87\xf1\x63\x63\xfb\x5c\xdb\xe7\x96\x07\x42\x4c\xb7\x35\x39\x1a\xd6\x5a\xa1\xe4\xe4\xfd\x3b\xa3\x98\x11\x70\x07\xde\xd4\x42\x0d\x12\x37\x0a\x24\x0c\xd3\x6a\x95\x64\xdf\x57\x17\x81\xe8\x8f\xfa\xa9\x4c\xd2\x22\x48\xdb\x91\xa7\x9b\xa4\x2c\x99\x08\x0a\x27\xe2\x7b\x60\xf7\x9a\xaa\x22\x27\xc6\xd0\x62\x55\x0d\xfb\x42\xf7\xdb\x7f\x46\x8a\xc3\x48\x6b\x88\xa6\x64\x04\x4d\x1d\xbc\xe5\xd8\xf0\xeb\x48\x7b\xb0\x0e\x59\xfd\xb5\x9a\x3d\x79\x74\x27\x66\xce\x60\xf2\x9e\x1a\xae\x89\x7a\x71\x7c\xae\x74\xa7\xb4\xbc\x40\xdc\x20\xa0\xe1\xd6\xa7\xdc\xcb\x27\x3c\x7b\x9b\x49\x6a\x32\x84\x51\x53\x84\xb5\x6c\xaf\x3c\x9b\x99\x4d\xb3\x07\xd2\xb7\x2e\xa1\x73\xe9\x4b\x46\xf6\xb0\xab\x0d\x55\x24\xa3\xfa\xa0\xf4\x25\x96\x63\xfb\x36\xd1\xcf\xf0\xb6\x91\x0a\x1c\x48\x2f\x0f\xd5\xd5\x37\x96\xb2\xad\xac\xf4\x71\xa7\xe8\x03\xc9\x33\x5e\x26\xcb\xdb\xd7\x69\xea\xcb\xaa\xd6\x74\x0e\x3a\x1e\x0b\xb2\x79\x80\xb8\xcb\xfa\x9e\xe2\x2e\x7d\x0d\xb8\x87\x04\x4c\x75\x48\xb4\xe5\x8e\x62\x1b\x36\x64\xfb\x44\x60\xd8\xa1\xe1\x33\xf6\x63\x41\xa3\xa2\x97\xba\x4b\xed\x91\xd9\x57\x02\xee\x5f\xe2\xca\xde\x49\xeb\xf6\x15\x91\x0d\x26\xe1\xbf\x48\xe8\xc6\x71\xc1\x78\x08\xb3\x12\x56\x5f\x4c\x27\x4f\x9f\x6a\x7f\x72\x4f\xb5\xfb\xfa\x97\x66\x13\x49\x82\xb8\xa0\xdb\x75\x58\xd8\x15\xff\x26\xcc\xc3\x8b\x24\x4d\xca\x84\xf5\x09\xe2\x82\xca\x7e\xc6\x63\x10\xe9\x73\xbf\x47\x25\xff\x81\x5f\x33\xf1\x46\x0e\x97\x2b\xe6\xea\xa3\x24\xcb\x2b\xd0\xd7\x2c\x3d\x0f\x81\xde\x14\x78\xfa\x56\xbe\x14\x00\x10\x0a\x16\x22\x65\xa1\x00\x95\xa2\x62\x2a\x81\x76\xdd\x7c\x1a\x27\x60\xb7\x58\x8b\x1e\xf7\x6c\xdf\x9e\x6d\x69\x9b\xb0\x5d\xf2\xa8\x2a\x18\xd8\xcc\x08\x18\x29\x4c\xf6\x8f\xe0\xbe\xbd\x18\x1d\xea\xbb\xcf\xf0\xac\x89\x2f\x7c\xa6\xe9\x3f\x79\x2c\x37\xd1\x44\x9f\x24\x83\x2f\xef\x5c\x6c\xe4\x54\x4f\x04\x65\xa3\x66\x03\x8c\x09\x3c\x0e\xbe\x6b\xfd\x3b\x9a\x23\xe3\x0b\x89\x31\xea\xf6\x64\x04\x1c\xa0\x64\xb8\x35\x32\xdd\xd6\x80\xf8\x89\xad\xf9\x53\x19\x8a\x12\x9e\x37\x70\x49\xb7\x85\x0c\x05\x4e\xc3\x20\x9a\xb0\x2c\x76\x81\xa7\x59\xbc\xb7\xee\x5d\xec\xaa\xb3\xd1\xcd\x55\x51\xcf\xf8\xa1\xf5\x61\xe8\xd8\x6e\x49\x9a\xe1\x09\xa3\xe3\x83\x3b\xbd\xd0\x61\xfd\xf9\x58\x1b\x3d\xb6\x0d\x3f\xce\xc0\x5b\x2d\x34\xda\x47\xd1\x3a\x14\x61\x54\x32\x81\xc8\x31\x53\xfa\xc6\xc6\xb2\x07\xf4\x48\xa7\x3e\xcd\xe2\x3b\xd3\xee\x8d\x3d\x5c\x45\x68\x2b\x4a\xc6\x15\xb9\x29\x77\x3b\x5d\xff\x18\xca\x1d\xef\xe1\x72\xd4\x37\x05\x8e\xf9\xa0\x11\x64\x21\x9c\x96\x23\x96\x81\x0a\xb8\x95\xf6\xe4\x9e\xe7\x73\x9a\x61\xd2\x3b\x4d\xed\xd9\xa1\xf2\x9a\xec\xce\x8d\xf6\xb4\x9b\x80\xeb\xe0\x66\x5f\xfe\xe5\xb3\x96\xca\xad\x69\xb9\xd2\x66\xa2\xd2\x51\xc4\xd3\x34\xcc\x0b\x06\xf6\xb9\xd3\x03\xb3\x92\x99\xa8\xf6\x14\xf0\x63\x88\x52\x6d\xf3\xf5\xf8\x0b\xb9\xe0\xed\xe8\x83\xe3\xc1\x86\xdb\xc1\x87\x79\xfd\x77\x8c\xc9\x33\x0a\xdb\xae\x94\x23\xdf\x2b\xb8\xd5\x1d\x2c\x65\x59\xd7\x5d\x9c\x60\xf4\x4d\xa2\x2f\x3d\xd4\x60\xd2\x29\xae\xf2\x34\x89\xc2\x12\x90\x20\xf4\xf4\x8c\xeb\xc5\xab\x1d\xf8\x10\xa1\x0e\xfe\xf8\x03\x1c\x5a\xe8\x34\x8b\x81\x7a\x12\x25\x32\xe2\x49\x29\x15\x8d\x82\x43\x9a\x0e\xb9\x41\x68\x6a\xdd\xa5\xb0\xee\xc2\xb6\x23\x40\xad\x6c\x06\x24\x7c\x03\x7b\x79\x5e\x0f\x50\xf9\xb3\x1a\x94\xbb\x9d\x52\xa9\x11\xb2\x63\xf0\xfe\xd2\x71\x11\x2a\x7b\x1f\x66\xd1\x9a\x83\x63\x7c\x22\x6c\x50\x4d\x0f\x49\x69\xa9\x70\x1d\x44\x87\x26\xa4\x63\x13\x65\x04\x0b\x06\xee\x75\xe9\x6b\xcf\x88\x89\xda\x10\x56\x6e\xc1\xf2\xa8\x49\x22\x37\x48\x17\xde\x56\x56\x56\x2f\xc9\xea\x15\x90\xfb\x8d\x06\xb6\x9a\xe7\x36\xae\xd1\x34\x49\x3c\x16\xb3\x71\x90\xd4\xd6\x26\xb0\x19\xf6\x8a\x26\xa3\x28\xe5\x99\xc5\x46\x95\x5e\x0e\xb2\x18\xed\x68\x1d\xac\x0b\x55\x7a\x46\xfd\x76\x8f\x88\x06\xe8\xba\x34\x53\x95\xfb\x55\x3b\x5d\xe5\xa6\x23\x55\xa3\xfb\x2a\x68\x9a\x9b\xd3\x78\x36\x0e\xaa\x9e\xe6\x2e\x69\x3e\x8c\xc8\xba\x5e\xbb\x4d\x54\xba\x1e\x29\x89\x24\x51\xaa\x93\x63\x6d\x1a\x9d\x1a\xe3\xa9\x1b\xba\xb6\xbb\x38\x6e\x2e\xb7\xcd\x6c\x19\xe4\xb0\xe4\x36\xb3\x3c\x58\xee\x5b\x6f\xdf\x1a\xc5\x11\x4e\xc4\x67\x71\x7a\x63\x8b\x58\x04\x08\x28\x71\xe6\xbb\x58\x32\xc3\x81\x0e\x7c\xe3\xc4\xb2\x2c\x06\x0c\x0a\x11\x38\xf0\x3b\x78\x55\x6e\xb0\x03\xfb\xee\x20\x4e\xba\x63\x4b\x0a\x93\xb1\x17\x63\x89\x1a\x63\x35\x1f\xdd\x81\x71\xd3\xb3\xe5\xac\x26\x6b\xdf\x6e\x24\x9c\xb2\x79\xe5\xe3\x45\x8d\x51\x2c\x62\xb7\xbd\xc4\xae\x96\x80\x1a\x18\x11\x38\xc9\x60\x80\xb4\xf8\xe6\x88\xdd\x94\x2c\x8b\x3d\x4f\x7c\x63\x39\xf1\xe9\x34\xa5\x02\xf4\x2c\x14\x9f\x34\xf2\x19\x01\x7d\x0f\xf9\x91\x42\xc6\xc4\xf3\xb4\xcf\xb5\xf8\xd0\x6a\x8a\xeb\xd5\x94\xc0\xfe\x24\xc9\x88\xeb\x25\x9a\xd5\x0e\x0a\x21\x79\xe1\x63\x22\xbe\x49\x67\x7e\x26\x29\x60\x55\x42\x2c\x93\xa9\xd6\xf9\x85\x2a\xa0\x30\x05\xe0\xc0\x8f\xcd\xe2\x6c\xc7\x91\x46\x19\x78\xbf\xb7\x42\x00\x4f\x35\x97\x77\x22\x6f\xfa\x70\x8b\x87\x0b\x60\x51\xb3\x3f\x3d\xaf\x3e\x5d\xe5\xc1\x6a\xba\xe6\x79\x03\x1f\xb9\x33\x21\x23\x35\x47\x59\x5e\x00\x6b\x12\x20\x90\x63\x0d\xac\xd3\x1a\x10\x06\xc9\x1d\xef\x0f\x77\xb2\x62\xd4\xeb\x83\x3c\x86\x97\x89\x28\x94\x02\x9c\x7a\x7f\x70\xc2\xd6\xd6\x77\xdf\xcb\x85\x16\xc3\x71\xdf\xe4\xec\x83\x63\xe3\xb5\x50\x16\x5a\xbf\x52\x74\x75\x48\x6a\x21\xa2\x4c\xd9\x4b\x1b\x83\xa3\x0b\xa1\x2a\x78\x42\x29\xad\xd1\x30\x80\xe4\x99\xa4\x4e\x29\x8d\x09\xcd\x9a\x4d\x5f\x49\x42\x3f\xfc\xc6\x0a\x06\x6d\x65\xbe\x40\x10\x35\x83\x41\x79\x9c\xee\xa7\x29\x0d\xf7\xb2\x2a\x25\x54\xb9\xff\xb3\xf6\x85\x06\xfe\x80\xed\x76\x83\x12\x83\x3f\x0c\xb8\x48\x0c\xe4\x69\xee\x79\xbe\xf0\x4b\xac\x64\x5d\x9c\xfe\xe3\x00\x45\x0a\xbd\x16\x4a\xce\x47\xdd\x36\x64\xd8\x2f\x71\x30\x18\x30\xb0\x1d\x1b\x0a\xfb\xa8\xff\x81\x17\x89\x3a\x47\x07\x03\x7f\xf2\xdc\x3b\x98\xc0\x2f\x31\xc6\xee\xed\xab\xa1\x56\xf3\x45\xf7\x3c\xd5\x93\x27\x86\x30\x83\x93\xb0\x2e\xff\xeb\x3b\xca\xbf\x8f\x81\x96\x19\x1b\x35\xa4\x1f\x76\x3b\x16\x98\x9d\x01\x44\x4f\xac\x98\x8f\xff\x48\xd8\xf5\x6e\xa7\xb6\xc5\xb4\x31\xec\x7d\x5a\x72\xd9\x48\x8e\xf3\xac\xc1\x5b\x54\xb0\xa0\xcf\x10\x54\xd7\xc6\x86\xfb\x60\xdd\xb5\xb2\x61\x08\x54\x8c\xef\x6d\x34\xae\xd9\x79\x10\x5e\x63\xbb\x9d\xdf\xe7\x6b\xd3\x74\x7f\x66\x3e\x34\x7b\x18\x13\x27\xb5\xa3\xbf\xe5\x12\x4b\x8e\x0f\x64\x36\x0a\x23\x78\x03\x52\xe7\xd0\x6e\xc7\x46\x17\x3c\xbe\xed\x38\x43\xd6\xe0\x87\x99\xbf\xa3\xdb\x9b\x34\xc9\x2e\x03\xb4\x2e\xcb\x3c\x78\xf4\xe8\xfa\xfa\x7a\x74\xfd\x64\xc4\xc5\xea\xd1\xe4\xe5\xcb\x97\x8f\x20\x16\x91\x9b\x4d\xda\x97\xe4\x97\x77\x3f\xc8\x64\x5f\x3f\xca\xc2\x0d\x2b\xf2\x30\x62\x60\x69\x6c\x1b\x46\x11\xcb\xca\xef\x59\xb2\x5a\x97\x01\x52\xa1\xe3\x35\x04\x11\x09\xa3\xa8\xda\x54\x69\x58\xb2\x60\x4c\xc2\x38\x4e\x64\xe7\xe4\x67\x9a\xac\x32\xd9\xc3\x6f\xc3\x82\xa5\x49\xc6\x02\x64\x41\xc7\x17\x1a\x86\x48\x98\xa6\xfc\xfa\x23\xe3\x22\x66\x42\xa6\xa8\x43\x32\x2e\x5f\x87\x17\xac\x4c\x22\x59\xde\x26\x4f\x93\xb2\x8a\xa1\x6c\x11\x5e\x24\xd1\x77\x5c\x6c\x02\xa4\xbe\x8f\xe5\xbd\x1f\x91\xb0\x90\x6d\x93\x29\xca\x52\x24\x17\x55\xa9\xb4\x0c\x51\x23\x88\xea\x58\x30\xb7\x89\x1a\x41\x44\xc2\xaa\xe4\x1f\x19\x08\x76\x05\xc8\x09\x20\x12\xfe\x91\x6c\xaa\x72\x1d\x8c\x89\xec\xc0\x77\x82\xfd\xb3\x62\x59\x74\x1b\xa0\x46\x10\x41\xec\x07\xc1\x97\x49\xca\x54\x9c\x0e\xa8\x18\xd9\xf1\x4f\xeb\x64\x59\xaa\x38\x19\x3c\x2e\x64\x18\x91\x8b\x0b\x7e\x23\x4b\x67\xab\x24\x93\xbf\x49\x58\xc8\x9f\xdb\x60\x4c\xa2\x30\x8d\xde\xc1\x4e\x31\x5f\x88\x44\x61\x6e\x66\x25\x0a\x73\x3b\x25\x51\x9a\xe4\x32\x47\x9a\xe4\x1f\xc2\x72\x1d\x20\xf9\x75\x9c\x87\xe5\x5a\xc5\x7d\xac\x64\xbb\x00\x28\x2a\xd9\x2a\x93\xf2\xa7\x2c\x29\x0b\x15\x63\x83\x88\x44\x3c\xe5\x02\x44\xca\x73\x9e\x2a\xde\x0a\x02\xd8\x71\xe2\x02\xfb\x12\x7e\x97\xa4\x25\x13\x45\x6f\xfa\xe3\xa5\x8a\xd4\xf9\xec\x78\xa9\xa4\xb9\x19\x31\x08\x2a\x3b\xdc\x49\xb6\x32\xd1\xc2\x00\x64\x02\x38\x69\x3e\x45\x22\xc9\x95\xfd\x54\xd4\x01\xd5\xa9\xca\xdb\x94\x35\x13\x19\x08\x22\x51\x25\x0a\x2e\xe4\xc8\xc9\x49\x88\xe4\xa8\xc7\xf2\x8f\x45\x2c\x65\x42\xad\xf0\x98\x99\x25\x16\x27\xcb\x65\x55\x48\x92\x1f\xec\xc6\x06\xa8\x05\x40\x24\x4e\x84\xbe\xf9\x8f\x89\x36\xf7\x03\x5f\x57\x89\xaa\x26\xe6\x9b\x24\x0b\xdd\x1d\x62\x20\xce\x06\x89\x2b\x48\x2a\x5b\x14\xcb\xec\x2c\x5e\x31\xb5\x0e\xcc\x17\x22\x2c\x65\x57\xa1\xae\x48\x3d\x0a\x7e\x1b\x46\x97\x2b\xc1\xab\x2c\x0e\x90\x82\x1c\x5f\x58\x10\x52\xec\x09\x22\x11\x4b\xa6\x3a\x23\x89\x2e\x91\x85\xe9\x47\xa6\xb4\x77\x8b\x8f\xec\x9f\x55\x22\x98\xcc\x7e\x28\x0a\x91\x65\x92\xa6\xc1\x18\x7e\xde\xe7\x61\x94\x94\xb7\x01\x92\x81\x63\xae\x42\x2a\x85\x5a\x6e\x00\x57\xcb\x4d\x4d\xbc\xca\x58\x32\xf1\x91\x15\x10\xad\x3e\x4d\xb4\x5e\x8b\x4e\x00\x91\x65\xca\x79\xfc\x46\x2e\x80\x00\xc1\xf7\x31\xac\x06\x1d\x51\x37\x01\xa2\xea\x36\xc8\x8b\x9d\x1c\x02\x59\x23\xcf\xca\xef\xc2\x4d\x92\xca\x64\x3c\x2b\x8f\x97\x10\x40\x10\xf1\x29\xf9\x83\x69\x70\x91\xfc\xc1\x6a\xe0\xeb\xf8\xbf\xaa\xa2\x74\xa2\x8e\x43\x80\xe8\x14\xa5\x60\x65\xb4\x36\xd1\x2a\x64\xa2\x6e\x53\x5b\xa4\xfc\x56\xe0\x7f\x84\x22\x81\x25\x03\x11\x57\x2a\xa4\xa2\x7e\xd6\xfb\x19\x62\xae\xf5\x86\x56\x4c\x4d\xd9\x7c\xc1\x37\xf2\x47\xae\x86\xa5\x5c\x0d\xab\x89\xfc\xf7\x58\xfe\x4b\x6f\xf3\xb5\xc2\x76\xf0\x79\x9c\x01\xaa\x83\xef\xf7\x22\x61\x59\x09\x2b\xe4\x7b\x2e\x92\x3f\x24\x55\x93\x9a\x74\xbc\x8e\x3c\x5e\xdb\xd8\x6e\xce\x7f\x30\x51\x26\x51\x7f\xbe\x2b\x1d\xa7\x73\x7d\x64\x4b\x9d\xea\x23\x5b\x22\xb2\x12\x61\x2c\xd3\xc2\x7b\xc2\x12\xb0\x76\x07\x54\xa7\xd2\x13\xdf\x08\x22\xb2\x0e\xb3\x95\xdc\xff\x63\x02\x6d\x7c\x1d\x5f\xfd\x12\x20\xf8\x3c\x0e\xe3\xab\xe3\x1b\xa4\xe0\xef\x45\xb2\x4a\x32\x1b\xc5\x21\x28\x63\x93\x98\xf1\x95\x08\xf3\x35\x1c\x26\xc9\x26\x5c\x31\x07\xa9\x40\xd8\x45\x2a\x80\x7e\x93\xec\x31\xfc\x2f\x99\x88\x58\x2e\xc7\xff\x52\xfe\xc9\x21\xbf\x94\x31\x97\x4f\xe4\xbf\xa7\xf2\x1f\x13\x19\x4b\xdf\x85\xa5\x48\x6e\x02\xe4\x86\x90\x8e\x93\xdd\xf8\x01\x68\x61\x13\x5f\x43\x54\x1a\xd5\xbb\x4b\x76\x0b\xf7\xcd\x42\x26\xd3\x9f\x32\xfe\xf6\x53\x2e\xf1\x81\x02\xeb\x6f\x80\x9f\x25\x1b\x0d\x85\x2f\x44\x14\xc5\x6d\x96\xac\x1b\x92\x71\x65\xc9\xc4\x27\xb9\x33\x64\xb7\x55\xf0\xb8\x50\x61\x44\x52\xb9\xdc\x40\xa0\x0e\xb6\x98\x09\x9a\x5d\x06\x52\x90\x10\x9d\xb1\xd7\xd9\x4a\xae\xec\x0e\x08\x91\x94\xcb\x45\x32\x26\x9b\x50\x5c\x32\x71\x2a\x31\x90\xfa\x3c\x66\x12\xf5\xa8\xef\x77\x49\x0d\xde\x24\x16\x0c\x77\x47\x1b\x51\xa8\xcb\xb6\x0a\x99\x83\xce\x0d\x99\x38\xbd\x64\x9c\x80\x89\xf9\x39\x89\xe5\x88\x3b\x01\x19\x53\x5c\x42\xfb\xb4\x83\x2a\xbb\xe4\xda\x10\x95\xd4\x89\xb4\xd0\x72\xcd\x36\xa1\xda\x0d\x63\xb2\xe1\x40\x94\x64\xd5\xe6\x7d\x54\x86\x57\x72\x36\xea\x6f\x64\x6e\x38\x63\xa2\x11\x12\x7c\x29\xab\x84\xf2\x13\x08\x1f\xf9\x9b\x28\x4c\xec\x6c\x2b\x15\x52\xa4\x00\xbf\x62\x62\x99\xf2\x6b\xfd\x29\xe7\xdf\x5c\x2e\x02\x64\x20\xc7\xb9\x06\x21\x9b\xe8\x6c\x9d\x44\x97\x19\x2b\x0a\x27\x55\x69\x60\x88\xe4\x61\xa2\xdd\xe6\x06\x08\xbe\x8f\x35\xed\x95\x87\x19\x2f\xd8\x44\x42\xe5\xc7\xf1\x44\x82\xca\xb5\x59\xc2\xf5\x37\xc0\xe5\x09\xd1\x1c\xcb\x1e\xa0\x4d\xe9\xe0\x81\x36\xc4\xa6\x69\x16\x63\xf2\x73\xd8\x8c\xf0\x02\x2e\x23\x55\xf0\x18\x6c\xf5\x98\x68\x49\x30\xa9\x8f\xd7\xe5\x2f\x3a\x8d\xfc\x44\x16\xfa\x6b\x0d\xfd\xb5\x86\xfe\x47\x0d\xfd\x0f\x44\x72\xc1\x0a\x26\xae\xd8\x6b\x49\x82\x06\xa8\x11\x74\x62\x8b\x9c\x45\xe5\x47\x39\x59\x4e\x9a\x1a\x28\x53\xc2\x06\xb9\x62\xa6\x3f\x8d\x30\x22\x72\xea\x25\xa2\xab\x64\xb3\x05\x5b\xfe\x12\x20\xf9\x1f\xc9\xef\x5f\xe1\xfb\x57\xf9\xad\xf1\xd2\x5b\x18\x4c\x09\xd6\x00\xa0\xa8\xc0\x1f\x18\xcb\x59\x58\x2a\x73\x27\xc8\x09\x98\x98\x93\x4a\x18\xf8\x49\x25\x24\x54\x9d\xe1\xa7\x37\x25\xcb\x8a\x84\x67\x85\x8c\x6e\xc3\xea\x74\xdf\xb1\xb0\xac\x04\x73\x52\x19\x08\x82\x87\x2f\xf5\xea\x21\x58\x51\xa5\xf0\xc1\x4b\x45\x2e\x09\x79\x52\x09\xb9\xe6\x8b\x28\x84\x13\xb8\x60\x4c\xd2\x1e\xc5\x3a\xcc\x5d\x0c\x0c\x61\x17\x03\x17\x29\xcf\x21\xbd\x46\x56\xf2\x8b\x45\x55\x1a\x8a\x9a\xe2\x6a\x43\x90\x4d\x73\x6a\x48\x1b\xd4\x86\x40\x1a\xd5\x84\x5c\xb0\x30\x7e\xc7\xca\x35\x8f\x65\xba\x3a\x84\x88\xc3\x78\x0d\x90\x13\x90\x31\xf1\x09\xbb\x4a\x34\x1d\xec\x86\x64\x1c\xdb\xc8\xdb\x81\xfc\xbd\x82\xdf\xa4\x8c\xd6\x67\x49\x2a\x07\xce\x09\xc8\x94\x3c\xd7\x58\x56\x7e\x1a\x0c\x2b\xbf\x2d\x19\x03\x11\x96\x8a\x91\xf7\xbc\x4b\x56\xae\x05\xaf\x56\xeb\x7a\xeb\x37\xc0\xce\xfe\x6f\xc0\x1d\x24\xd0\x4c\xef\x60\x02\x75\x9d\x86\x46\x0b\x7e\xc9\xec\xc7\x49\x58\xac\x43\x21\x42\x68\x90\x04\x1c\xc7\x06\x82\x9c\x24\xdc\x8e\x95\x4d\xc3\xed\x88\x49\xd0\x0f\x49\xc6\xa2\x30\xb7\x29\x52\x15\x76\xa3\xff\x8b\x27\x59\x23\x5e\x02\x4c\x82\x77\x49\x29\xd1\xd7\x26\xa9\x2b\xd9\x58\x90\x49\xe4\x8c\x1d\xa4\x70\x47\x8f\x5f\x32\x7d\x1a\xe8\xc8\x6b\x75\x1c\x14\x95\x58\x86\x11\xfb\x04\xeb\x13\xb9\x21\x44\x8a\x5b\x39\x95\x3f\x84\xd9\xaa\x0a\x57\x32\xb6\x11\x46\x04\xde\xa0\x41\xd8\xaf\x08\x90\x13\x90\x31\x62\xc5\x24\x02\xd2\x1f\x06\xf2\xab\x81\xfc\x8a\xc0\x64\xdc\x6b\x78\x90\x08\xe0\xc5\xfb\x58\xbd\x4e\xa8\x88\x13\x16\x71\xa1\x57\x19\x44\xc6\x16\xa0\x12\x38\x9b\x07\xe2\x9d\xbd\x23\xc3\x06\x53\xd7\xdf\x88\x94\x3c\x18\x93\xd2\x62\xdf\x31\xa9\x24\x2d\x53\x49\x5a\xa6\x92\x99\x9b\xa7\x8a\x05\x39\xcb\xca\xc2\x9c\x25\x55\xa7\x73\x96\x53\x95\x25\x91\x3a\x17\xf5\xd7\xb7\x49\x9c\xc8\xb4\x10\x38\xbe\x48\xe2\xc4\x26\x52\xcf\xee\x36\x0e\x1e\x9c\x20\xb2\x2c\x3e\x30\x71\xba\x81\xa8\xb2\x38\xce\x25\xae\xdf\x20\x72\xf5\xba\x66\x0c\xa0\xab\xe3\x9a\x4d\x80\xc8\xd5\xf7\x9a\x4e\x44\x57\xc7\x9a\x64\x44\xe4\xea\xad\x43\xfc\xa1\xab\x63\x87\x16\x44\xe4\xea\x9d\x7b\x9a\xa3\xab\x63\xf7\x74\x47\xe4\x4a\x4d\xee\x98\x5c\xb1\xa8\xe4\xe2\x74\xb9\x64\x51\x19\x20\x15\x3a\x66\x10\x44\xe4\x8a\x89\x42\x1d\xd8\x92\x12\x7e\x1d\x5f\xfd\x2a\x93\x88\x12\x68\xd3\x5b\x88\x2f\x2d\x69\x0a\x11\x35\x65\x5a\xc7\xfd\xda\x8c\x93\xf9\x12\x76\xfd\x2d\xbf\x09\x90\xfe\x50\x90\x33\x58\x40\x0a\xa8\xbe\x25\xbc\x48\x40\x2e\x40\xe2\x5a\x58\xd9\xb2\xd1\xd7\x5c\xc4\x96\xda\x93\x81\x9a\xd6\xbb\x16\x40\xb8\xa9\xab\xa4\x0e\x1c\x6f\xe0\x3a\x29\x71\xf6\x8d\xa1\xb7\x6e\x2c\x5b\xe1\x46\x2e\x96\x1b\xb9\x58\x6e\xde\xac\xc3\x2c\x63\xa9\xe2\x8d\xcb\xe5\xdb\x86\x20\x02\xac\xa6\xd7\x51\x59\xc9\x83\x00\x29\xb6\x54\xa8\x82\x26\x52\x44\x82\xa7\x75\xa4\x0a\xea\xc8\xef\x85\xbc\x43\xa8\x98\xb5\x90\xb7\x08\xf8\xfe\xe8\x64\x70\x52\x7f\x5a\xf3\x6b\x03\x2e\xd6\xfc\x5a\x83\xcf\x92\xb2\x4e\x5e\xca\x80\x89\x00\x0e\x80\x86\xc3\xdd\xff\x66\x93\xca\x6b\x78\x80\x6e\x36\x69\x20\x6f\xdf\x00\xca\xe4\x20\xc2\xef\x2f\x8a\xaf\xa6\x60\x35\x1b\x4d\xa2\x02\x95\x27\x0d\xe5\xa8\xde\x6c\x52\x39\xde\xba\x1c\xc5\x43\x23\x72\x4a\x6e\xe5\xe0\xdd\xca\xc1\xbb\xed\x0c\x5e\x1b\x82\xc8\x1f\xc1\x98\xfc\xc1\xf9\xe6\x75\x16\x7f\x08\xb3\x00\xd5\xdf\x08\x3c\x56\x68\x59\xc3\x84\x15\xc1\x76\x4f\x4e\xde\xbf\x7b\xed\x32\xb8\xa0\xda\x22\xd8\x36\xa6\x20\x1b\x41\xb0\x39\xf4\x0d\x20\x0c\x79\x03\xf2\xb1\x93\x06\x06\xba\x01\x51\x63\xdc\x04\xc9\xd1\xb5\x10\x3d\xb0\xd9\xe8\x66\x93\xda\x21\xb3\x21\x35\x5a\x10\xec\xe9\x48\xb0\xdd\xef\xa7\xae\x81\x13\x8e\xad\x45\xe9\x86\x6d\x9e\x51\x3d\x22\x73\xb6\xa0\x63\xc2\xe7\x6c\x01\x76\x25\x3a\x65\x82\x13\xa5\x39\x5b\xe0\x86\xf6\xcf\xc3\x5c\x79\x1c\x10\x9d\xf1\xbc\x3b\x65\x87\x70\xe3\xf5\xf4\x5e\xd2\x35\x77\xbd\x17\xf6\x3e\xe9\xd7\x32\x4e\xf5\xbb\x77\xd0\x78\x04\x77\x9f\xc0\x83\xd6\x8b\xb8\x7d\x0f\x0f\xdc\xb7\x71\xe7\x65\x3c\x68\xbc\x93\xef\xf7\xbd\x22\x24\xf7\x16\xe4\xd1\x2d\x6d\x48\xf3\x04\x1d\xe9\x1e\x6d\x5a\x75\xa4\x1c\xa5\xf2\x3c\xc8\x46\x17\xbc\x02\x2b\x16\x67\x3c\x27\x29\x5b\x96\x0e\xe8\x07\xb6\x2c\xf7\xbd\xe6\xd0\xce\x8d\x63\xa0\xcd\x6e\xb7\x19\x50\x5a\xf9\xb8\x47\xa2\x81\xfb\x1b\xf5\x4e\xba\xda\xed\x06\xb9\xbf\x22\x19\xc6\xdb\x95\xd6\x13\x14\x34\x72\x84\x55\xd7\xba\x63\xe4\x02\xdc\x36\x5a\xcb\x31\xca\x9f\x8d\x5e\x20\x88\x88\x91\x3a\xf1\xe9\x86\xa4\xa3\x9a\x5d\x6e\xf4\x88\x4f\xb4\x9c\x3f\x2b\x40\xab\x66\xdf\x96\x62\x00\x39\xe5\xc7\x4a\x48\xef\xe9\xcb\xa6\x76\xb0\x16\x74\xcd\xfc\x67\x4f\xb5\x80\xab\x4c\x11\xd3\xcc\x7f\xfe\xb5\x52\x63\x99\x4c\x5e\x82\x42\x6a\xe3\xc1\xd3\x4c\xcc\x3b\xad\xd2\x5e\xbf\x79\x76\x64\x07\x65\x92\x57\x74\x32\x21\x6b\xba\x55\x1d\x0a\xb6\xb9\x6c\x76\xac\x4c\x28\x2a\x8a\x44\x6f\x56\xad\xb2\x1d\x20\x9e\x7d\xd2\x9d\x37\xaa\xd9\x35\x4c\x6b\x60\xa0\x3d\x89\x59\x2e\x69\x96\x2c\x92\x78\x6c\x8e\x4a\x9e\x7f\x9b\xca\xcb\x09\x02\xe2\x18\x4c\x92\xbc\x63\x59\xa5\x00\xdf\xc9\x65\xa7\x3e\xff\xce\x6e\x4f\xf8\x75\x66\x03\x3f\xe5\xea\xf3\x1d\xaf\x0a\x56\xc7\x40\xd0\xc4\xd5\x7b\x72\x0d\xe4\xc5\x62\xbf\x27\x1b\xa5\x82\x73\xa1\x7e\x56\xea\xe7\x9c\x0e\x26\xe4\x4a\xfe\xbb\xa5\x5b\xb8\x59\x2a\x07\xca\x6b\xc2\x6e\x4a\x11\x46\xa5\xbe\x7d\x76\xd5\x95\xe5\xa2\xb9\xea\xac\xa8\x94\x96\xb3\x03\x1a\x9c\x38\xd0\x0f\x67\xc5\x75\xa2\x45\x58\x22\x79\xf2\xd8\xde\x06\x7e\xec\xa7\xd8\x91\x7f\x4c\x3b\xf2\x8f\x9e\xe7\x6f\xa8\xec\x43\xa9\x3b\x80\x95\x52\xe6\xd4\x94\x04\x43\x1a\xf4\x75\xb5\x95\xb0\x1e\xbd\xe0\x9c\x0e\xc6\xad\x58\x77\x3e\x82\x46\x96\x9f\x72\x14\x18\x43\xe5\x72\xd8\x84\x2f\x47\xc3\x66\x6c\x0f\x7c\x90\x2c\xfd\x25\x6e\x16\x6e\x26\x34\x70\x00\x4e\xb1\xaa\xc4\xc6\xc6\x20\x71\x12\x7f\xa8\xac\xaa\x44\x47\x33\xa3\x5e\x81\x4a\xc4\xd4\xbf\xa2\xa0\x49\xe1\xbc\x8a\xde\x7e\xc1\xab\x2b\x98\x2d\x77\xdd\xb7\xf7\xfa\x6a\x42\x17\x55\x59\xc2\xbb\x27\xb8\x92\xa9\x45\xe8\x76\x3b\x83\x14\x74\xc8\x95\x73\x65\x76\xb7\x3f\xd1\x22\xb9\xa0\xa3\x96\xe8\xed\x5f\xe8\x6d\x0f\xd2\x0c\xcf\x9e\xe8\xed\x2e\xf7\x7d\xac\xf7\x3f\x6c\xf7\x67\xcf\x94\xda\xda\xe4\x99\xd6\x5b\x7b\x31\xc1\x60\xb1\x7f\xf2\xec\xa5\xb1\xd9\x3f\xc6\x72\x05\xf8\x93\xe7\x13\x4c\xce\x65\x92\xc7\x98\x5c\x01\xe0\x31\x26\xb7\x4a\xa7\xe7\x06\xb2\xbc\xc0\xe4\xda\x7a\xae\xdb\x63\x72\x49\xb7\xfb\xe9\x1c\x85\x17\x5c\x94\x88\xa0\x30\x4b\x94\xb0\xed\x69\x16\xbb\xc1\xb7\xe0\x75\x03\x2e\x0c\x35\x50\x8b\xce\xa0\x0b\xb5\xc7\xa3\x30\xfb\x90\xca\x3b\xa4\xf9\x3a\x53\x77\x52\x09\x48\x93\xe8\x52\xfe\x36\x70\x40\xc4\x73\x48\x5d\xc9\x42\x62\x5e\x5d\xa4\xec\x8d\x4e\x18\x8b\x70\xa5\x7f\x54\x4b\xd4\x17\x48\xe4\xa8\xef\x9b\xa4\xd4\x9f\x60\xa6\x41\x7f\xbf\xbf\xb2\x29\x4c\xeb\x62\xc1\x25\xc6\x88\x2b\xd5\x01\xbd\x68\x09\x62\x9b\xbc\x4c\x98\x2c\x9b\x65\x91\xb8\xcd\x4b\xfd\x1d\xab\x5f\x21\x24\xd9\x86\x96\x1a\x49\xa9\x29\x97\xbf\x60\x4a\x09\x11\x74\x69\x71\xd6\x25\xbb\xfd\x20\xe0\x8e\x24\x3f\x01\x3f\xa5\x3c\x8c\xf5\x0f\x8b\x4f\xc2\x32\xb4\x81\x77\xac\x0c\xe3\x1a\x60\x5a\xb9\x71\x30\x1d\x7c\xbf\xe3\xd0\x29\xf8\x7e\x5f\xd9\x24\xba\x83\x1b\x8b\x09\xf3\xb0\x90\xf4\x37\xca\xc3\xaa\x80\x5f\x35\x05\xf2\x07\x6e\x05\x28\x17\x7c\xa5\x5b\x27\xc2\x92\xd9\xfe\x83\x62\x01\xaa\x35\xd4\x50\xc1\xd8\x25\xf4\x5d\x7e\xa8\xbc\x45\x19\xa6\xa9\x82\x55\x17\x70\x2b\x47\x45\x55\xe4\xc0\xf6\x45\x65\xb2\x31\x46\xc5\x24\x42\xae\xa2\xf5\x1b\x89\x05\x53\x13\x52\x13\x07\x9f\xba\x33\xf0\x6d\x7a\x0c\xb7\xd6\xa4\x5e\x6b\x57\x3c\xad\x36\x75\xf3\xae\x43\xb8\xc0\xc8\xaf\x35\x63\x29\x5a\xf4\x92\x89\x5a\xb8\x61\x3e\x5e\x8c\x4a\xfe\x53\x9e\x1b\x19\xd6\x21\x1b\x15\x69\x12\x31\x7f\x82\x49\x46\xb5\x0a\x18\xa7\x12\x13\xc9\x2f\x41\x3f\x7f\xe4\x65\xf5\x49\x97\x0d\xd1\xa1\x33\x8e\x2f\xf6\xd3\x6b\x49\x80\x0a\x72\x39\xe7\x0b\x2a\xb4\x4e\xf5\x29\xdd\xee\xc9\x9b\xc6\x79\x73\xfd\xd9\xf3\x46\x11\x24\x97\x73\xb6\x00\x7a\x45\x74\x8e\x9e\xb0\xe7\x60\x79\x0d\x5b\xd7\x22\xda\x37\x7a\x17\xb6\x01\x66\x33\x5a\xf8\x49\x73\x43\x58\xf8\xa9\xde\x17\x35\xc0\x6e\x0f\x07\x14\x37\x82\xb0\x59\x6c\xf0\x2d\xec\x15\x27\xa8\xb6\x8c\x05\xfc\x20\x37\x47\x23\xa4\xf7\x48\x0b\x66\xb7\x4a\x03\xae\xd6\x8f\x05\x7d\x80\x75\x5f\x07\x1b\x7d\xff\xa0\x77\x41\x0d\x30\x9b\xc1\x42\x3e\xd6\x7b\xa2\x86\xc1\xd6\x08\xea\x03\x0f\x36\x46\x23\xdc\x28\xf5\x93\xde\x26\x35\x40\xed\x16\x27\xac\x36\x8d\x05\x9c\xd5\x7b\xc7\xc2\xfe\xe1\x6e\x00\x0b\xfd\x59\xef\x83\x20\xa4\xf1\xb4\x73\xc8\x2a\xbc\x23\x4f\xe0\x31\xa5\xf4\xc6\xcf\x9a\x04\xf0\xe7\x8e\xe3\x90\x2e\x7b\x89\x8b\x16\xd1\x12\xd2\xbc\x4d\x3c\x00\x9e\x96\xf5\x3e\x06\xc3\x12\xea\x7c\xec\xad\xfb\xc4\x41\xec\x41\x0f\x69\xd2\x00\x01\xa2\xe8\x52\x23\x0d\xc0\x7b\x77\x71\xbd\xb3\x48\x31\xe8\x25\x6c\x42\xba\x6e\x35\xfd\x44\x1e\x2d\x81\x1b\x3a\x75\x67\xe6\xc4\x1e\x34\x4d\xd0\x8d\x3b\x9f\x27\xf6\xd8\x69\x80\x9a\xed\x38\xb1\x87\x90\x03\xe2\x30\xea\x9b\x56\x9b\xce\x1c\xec\x19\x34\x80\x8d\xb6\x9d\x59\x5c\xda\x04\xe9\x5a\x42\x7a\xd1\x2a\xf7\xb5\x7b\x9c\x07\x1d\x68\x7d\xaa\x77\xe3\x6c\x99\x51\xbb\xad\x0d\xbc\x1d\x84\x74\xd5\x4a\xa0\x14\xa6\x65\xcc\x79\x2b\xe6\x67\xc0\xe4\x41\x48\xaf\x3a\xb4\x68\xee\x62\xac\xaa\xb1\xbf\xe5\xf9\x16\x84\xb4\xda\x87\xc6\x4e\x57\xea\xa3\xaf\xc1\xb9\xad\xf6\x59\x1e\x3a\x57\x38\xa1\x91\x69\xad\xa5\x77\xe7\x05\xad\xc0\xa4\xf8\x2c\xed\x09\x66\x5d\x33\xb5\x84\x15\xf5\x39\x10\x3e\x1b\x9d\x97\xe1\xca\x6a\x4b\x48\x9a\x92\x24\xb4\x38\x60\x3f\x62\x7a\x3a\x4f\x17\xbb\x9d\x2f\x7f\x68\x68\xb4\xc4\x13\x4b\x1a\xdd\x62\xbc\xdf\x93\xeb\x24\x4d\x4f\xc0\x7b\x4c\x6f\x5b\xee\xd3\x92\x0c\x5a\x32\x3d\x9d\x67\x0b\x2d\x9f\xeb\x63\xa2\x3d\xd2\x48\xe0\xfe\x73\xc6\xce\xef\xab\x5c\x61\x7c\xe3\x82\x21\x66\x1d\x67\xe5\x15\x9f\x81\x32\xda\xd6\x92\x8a\xb5\x69\x78\xa6\xe4\xce\x25\x02\x54\x80\xbc\x60\x55\xcc\x4f\x5d\x5f\x0b\x53\x31\x0a\x2b\x30\xae\x04\x1e\x49\x7d\x4e\xd2\x2f\x37\x5d\xfd\xe0\x46\x47\x69\x92\x5f\xf0\x50\xc0\x99\xd4\xe3\x43\x16\x35\x12\x58\xc1\xd2\x46\x2e\xcd\xc7\x69\x00\xf7\xff\x5b\xfd\x7a\x01\xee\xaa\xb7\x82\xc9\x3d\x10\x6b\x5e\xf3\xff\xe6\x40\xab\x06\xe9\xeb\x08\xdc\x8b\x9e\x69\x23\x69\x2f\x9e\x82\xaa\xe2\x25\xbb\x0d\x42\x90\x3b\x80\x87\x0b\xe5\x8c\xa0\x14\xe9\xdf\xd9\xad\xb6\x6a\xb1\x4e\x96\xa5\x0d\x85\x69\xfd\xbd\x61\x65\x68\x03\xea\x7d\xf4\xff\x63\xef\xcd\xdb\xdb\xc6\x95\x7c\xe1\xff\xef\xa7\x50\x78\xee\x71\x4b\x6d\x42\xe1\xa2\x5d\xad\xe4\xc6\x5b\xec\xc4\x8e\x77\x3b\x8e\xe3\xd1\x43\x91\x94\xc4\x98\x22\x65\x92\xf2\x1a\x7d\xf7\xf7\x01\xc0\x05\x00\x01\x4a\x5e\x32\xcb\xfb\xcc\xe9\x99\x6e\xab\xf0\x43\x01\x05\x14\x80\x02\x08\x54\xc5\x31\x09\x7c\xf4\x3d\x08\x6f\x97\xed\x68\xcf\xb7\x9c\xa1\x83\x2e\x28\x44\xc8\xe9\xce\xd8\x08\xd6\x29\x8f\x4f\x59\x87\x5f\xdb\x0f\x53\xb4\xd0\xa6\x2f\x1a\x3f\xba\x65\xbb\xd2\x51\xe6\xf2\xb5\xfd\x50\x90\xcb\x82\x2b\x1c\xf9\x0c\xf2\xda\x7e\x98\x4d\x09\x36\x28\xc0\x18\x62\xa0\xcc\xe5\xbb\xb1\x63\x8e\x9f\x53\xfe\xb3\x8b\xe0\x74\x78\x58\xd0\xe1\x8b\xef\x15\xa3\xc7\x8f\x31\xff\x24\x16\x48\xd2\x92\x78\x64\x94\xa3\x9e\x5d\x4d\x48\xb2\x82\x27\x2d\x55\xc7\x2f\xb3\xca\x51\x4f\xd5\x2b\x95\x4e\xd4\xf3\xe4\xe8\x43\x4f\xd7\x7e\xff\x46\x49\xd1\xc7\xa8\xa3\x3c\xc3\x1b\x40\xee\x2c\x17\x07\x97\x4d\x7c\x20\x5f\xa2\x9f\x57\xbf\x7f\xa3\xff\xa2\x67\x92\xa7\x9e\x63\xd9\x5e\x04\x55\xc0\xa2\x7d\x75\xcf\x61\x72\xbe\xc5\x53\x9f\x1c\xc4\xfb\x40\x2c\xc6\x47\x29\xb6\x17\xf0\x4b\x1d\x14\x40\x6d\x3d\x16\xb8\xec\x25\xa7\x1c\x4b\xf5\x95\x71\x99\xb6\xe6\x15\x0a\x1b\x48\x54\xb2\x23\x49\xd9\x75\x70\x38\x70\xdc\xde\xd3\x66\x68\x76\xa4\x4d\x14\x58\x55\x92\xd1\xc1\xfb\xc0\x08\x3a\x52\x49\x92\x77\xed\x61\xd4\x91\x3e\x05\x81\x7f\x07\xff\x94\xe4\xd3\x69\xfc\x13\xee\x1a\x8f\xf0\x97\x20\xf4\xfb\x08\x7f\x0b\x82\xc6\x58\x4c\xc1\xdb\xd0\x0d\xdb\xed\x48\x78\x31\x92\xe4\x73\xc7\xeb\x48\xfb\xc7\x92\x0c\xad\xaa\x8e\x44\x9d\xe5\x7d\x9a\x4e\x43\x86\x84\x4d\x80\x8e\x84\xff\xbb\xeb\xc3\x15\x6e\xcf\x7f\x3c\x08\x1c\x0f\x1d\x72\xc1\x01\x4a\x4b\x37\x97\x8d\xde\x53\xab\x23\xad\x19\xe6\x75\xfc\x91\xa5\xdd\x91\x4e\x8c\x81\x24\xab\x5a\x47\x5a\x77\x6d\x23\x90\x64\x55\xef\xc4\x8d\x2d\xab\x8d\x8e\x74\x8c\xef\xd8\xaa\x4d\x5c\x7c\xe0\xbb\x92\xac\xb6\x3a\xd2\x27\x17\x52\xdb\x1d\x09\x6f\x0e\x64\x4d\xe9\xc0\x0d\x5c\x88\x2b\xa2\x35\xb3\x36\xd3\x35\xd4\x5a\xba\x0e\xb1\x23\xb4\xa3\xd6\x6b\xf8\x6f\xdc\x0a\x7a\x1d\x96\x68\x49\xb2\xde\xe8\x48\xdb\xfe\x04\xe6\x69\x52\x0d\xab\xb7\x88\x86\xd5\xdb\x74\xab\xd6\x14\xaa\x4d\x6b\xf5\x8e\xb4\xe3\x85\x36\xdc\x00\xd7\x1a\x59\xf3\xaa\x50\xc6\x2d\x15\xfe\xa1\x77\xa4\x2d\x0d\xfe\x51\xeb\x48\x5b\x3a\xfc\xa3\xde\x91\xb6\x6a\xf0\x8f\x46\x47\xda\xaa\xc3\x3f\x9a\x1d\x69\xab\x01\xff\x68\x75\xa4\xad\x26\xfc\xa3\xdd\x91\xb6\x5a\xb0\xa9\x94\x8e\xb4\xd5\x86\x7f\xa8\x90\xa1\x02\xff\x42\xac\x21\x6f\x0d\xf2\x56\x21\xf3\x5a\xad\x23\x7d\x9b\x4d\x70\x7b\xa8\xb0\x56\x64\x4f\x69\x5a\xad\x23\xc1\xcd\x97\x34\x7f\xe1\x9b\x86\x67\x2d\x06\x2a\x52\x65\xb8\xd1\xc3\x97\x86\x92\x28\x31\xff\xc5\x8b\x13\x5c\x8a\x8c\xde\x13\x3a\xb3\xb0\x43\xbc\x6e\xe0\x63\xff\x13\x92\x64\xa2\xfd\x9a\x45\xd1\x84\xeb\x51\xc1\x1a\x96\x5b\x92\x78\x0d\x60\xfc\x27\x9a\x41\xd3\xd8\xfb\xc9\xff\x08\xd3\x2d\x51\x22\xdb\x8d\x8c\xef\xbc\x25\x14\xa7\x24\xc6\x5a\x8c\xc3\x47\x4b\x1b\x44\x12\xb0\xab\x04\x0d\x2e\xca\x08\x7a\x21\x64\x79\x41\xb1\xbc\x20\x59\x5e\x70\x58\x52\x00\x4e\x7a\x5a\xe2\x0f\xdc\xc2\xe8\x6f\xf4\x45\xff\xed\x5b\x37\x7b\x0d\xe6\x65\xef\x0f\x93\x07\xe5\x72\x94\xbe\xa8\xc7\xc1\x23\x3d\x14\x35\x12\x2d\xad\x70\x35\xff\x14\x95\xfd\x0a\x5c\x37\xb3\x5f\xc9\x0a\xea\x67\xbe\x44\x63\xe7\x67\x10\x86\xff\xfc\x08\x54\x32\x24\x45\x40\x7a\x2a\xfa\x98\x3d\x06\xea\xf5\x7a\x3b\x1f\x6d\xd6\xdd\x45\x87\x7c\x30\xd7\xa1\xdd\x4b\xbb\x94\xcf\xa3\x91\x1d\xa5\x9f\x88\x57\x56\xe8\xdf\xe5\x93\xca\xef\xdf\x92\x94\x73\xeb\x2c\xfb\x72\x10\x6f\xe6\xa0\x85\x70\x5f\x75\xfd\x51\xe2\x15\x0d\xdf\xcc\x09\x93\xb7\x95\x36\xeb\xab\xb4\x0a\x07\x4b\x88\xe3\xd5\xc9\x4e\xcf\x40\xab\x79\xd7\xed\x49\xc8\x89\x4a\x09\x79\xbd\xec\x94\xa4\xd5\x72\xee\x8d\x93\xf3\xd1\xe9\x38\x74\xe8\x60\x07\x85\x02\xa9\xc8\x49\xb0\xaa\xc8\x99\xd8\xe5\xd4\x0d\xf2\x66\x95\xf6\xa1\x59\xb6\x65\x0f\x7b\x4a\xbc\x45\x9d\x2a\x07\xb2\x52\xe9\xba\x59\xac\x2b\x98\x1d\xbd\xff\x45\x7e\xad\x73\x11\x36\xaa\xfd\xc4\xb1\x5d\xec\x11\xa6\x67\xcb\xdb\x89\x6b\xe0\x89\x31\xb2\x77\xbc\xc8\x47\xce\x03\x43\x39\x92\x71\x74\xa9\xbc\xe7\xe9\xf4\xf0\xf2\xa0\x8a\x44\x3e\xb2\x4d\xdf\x33\x1d\x97\x74\x25\x44\x6c\xd2\xdf\x79\x2b\x2b\x0f\x79\x47\xbd\xdd\x20\xf5\xfe\x68\x60\x99\x20\xf7\x00\xf1\x97\x8b\x38\x27\x0e\x5c\x02\xa2\x6a\x21\xe9\xad\x7b\xb3\xca\xfa\x1e\x85\xed\x56\x91\x23\x4a\xe5\x90\x41\x9a\xf3\xb2\x52\xe9\x46\x55\xd7\x48\xdf\x6d\x46\xf1\xb6\x1a\x3b\xe2\x25\x92\x88\xb2\xcd\xec\x6c\x3a\x60\x23\x6a\xa3\xd8\xbf\x1c\xcf\x8c\xd9\x63\x3b\xef\xf7\xef\x77\x1e\xe5\xd9\x9d\xf8\xfa\x3d\x23\x22\x17\xa1\x77\x79\xd9\xa8\x79\xd7\xeb\xed\x26\xce\x1e\xe2\xdf\x3b\xcc\xef\x5f\x44\x25\x2d\xba\x92\xb2\x07\x2d\xf3\x05\xb5\x2b\x79\x2b\x2b\x74\xdd\x3e\x7a\xcc\x48\x9c\x12\xee\x15\x98\x10\x7e\xf9\xf8\x05\x39\xed\xeb\xa4\x5f\xc9\x87\xf1\x77\xb3\x71\xec\x43\x7b\x12\x7b\xfb\x1a\xf4\xbc\xb2\x16\x7f\xef\x52\xb4\x8a\xdc\xcf\x02\xd3\xe8\xf5\x4a\xf2\xdd\xab\x86\xbf\x7b\xa9\x8d\x3a\xfe\xf2\x55\x6f\x57\xe4\xbb\x1e\x8e\x5d\x23\x5f\x13\xa1\xc2\x1a\x8d\x4a\x45\xde\xec\x79\xe5\x86\x52\x91\xd7\x13\x8f\x52\x07\xb1\x7b\xb0\x3d\x54\x4a\x45\xde\x47\x59\x1b\x15\xf9\x28\xf3\xdc\xdd\x52\x2a\x15\xf9\x18\x39\x14\x53\x2a\xf2\x49\x1c\xd4\x62\x52\xdd\xd9\xe8\x7f\x3a\x39\x39\xda\x59\x3b\x3d\xd9\xec\x7f\xfb\xb4\xb7\x59\x91\xbf\xf5\x26\xd5\xa3\xfd\xfd\x13\x26\x41\xde\xed\xa9\xf2\x4e\xaf\x2d\xff\xea\xa9\xaa\x7c\xda\x7b\x9a\xcb\x9f\x7a\xaa\xbc\xd1\xcb\x79\x79\x42\x91\x0e\x7b\x9f\x56\x57\xe7\xdd\x0d\x2a\xae\x15\x1a\x13\xa9\x52\x43\x0e\x64\x3a\x1e\xed\x9c\x08\xc4\x88\x2b\x31\x69\xc1\x6c\x31\xb3\x13\x66\x3a\x88\xc3\xc6\x6e\xf7\x9e\x98\x94\xce\x86\xdc\x4f\x9e\x7d\x86\x6b\x0f\x78\x74\xa2\x8a\x76\x4e\x65\xfc\x55\x69\x0f\xbb\x50\x64\x0e\xb1\x90\x0b\xa7\x3e\x8e\x81\x06\x33\x88\x3d\x86\xc3\x19\x39\xae\xf1\x76\x95\x62\x59\xf6\xc9\xe8\x60\xeb\x55\x7e\xb8\x94\x78\x0a\x90\x83\x95\x95\x75\x36\x24\x17\x81\x09\xd0\xcd\xa1\xb9\x1c\xcf\x8e\xdf\xec\xbb\xe2\x6a\x55\x9e\x66\xe5\xa8\x92\x9c\x46\x0e\xcb\x92\xde\x94\x2a\xf2\xa8\x6a\x7b\xe1\x2c\xb0\x09\x87\x91\x71\x65\x91\xff\x86\xf8\xba\xc9\x7e\xd9\x96\xdf\xa9\x95\xee\x01\xeb\xc6\xd1\x91\xd3\xa3\xcb\xc4\x39\x48\xda\xbc\xb1\x02\x24\x63\xe9\xf4\xd2\xbd\xea\x05\x72\x30\x8f\x2f\x92\x1f\xcf\x06\x51\x60\xa3\x99\x3a\x1d\x5d\x9c\x4a\x13\xa7\xf3\xef\x7a\xf6\xca\xca\x5d\x75\x6c\x20\x0f\x48\x84\x20\x2d\xa9\x02\xa7\x7f\x31\xdf\x94\x5d\xda\x5c\x4b\x96\xbe\x9e\x0f\xe3\xe3\xcb\x78\x81\xdc\xd8\xdf\x8b\x55\x55\xaa\xc8\x83\xaa\x13\x9e\x41\x64\x72\x5d\x88\x6e\xe9\x36\xfa\x2c\xc9\x46\x1b\x95\x4a\x70\xce\xb2\x0d\xab\xe4\x0f\x4b\x53\x23\x0c\x1d\x6f\x54\x32\x4a\x18\x58\x72\x9d\x6b\xbb\xf4\x97\xe5\xdc\xfe\x25\xa3\xc4\x12\x2a\x35\xbe\xba\x94\x14\x83\xd2\x2b\x25\x3f\x28\xfd\x63\x39\xb7\xa5\xf7\x1f\xaa\x52\x87\xf3\xe2\x59\x58\x16\x0e\x74\x87\x8a\xda\xf2\xfd\x82\x82\xb6\x7c\x1f\x17\xb3\xe5\xfb\xb8\x98\x34\xf8\x63\x1a\x01\x29\xb6\x28\x3e\x4a\xa5\x93\xb1\x13\x96\x26\xc6\x43\x69\x60\x97\x4c\xb8\x9d\xb5\x4a\x83\x87\xd2\xcc\xc3\xef\x04\x1c\x1f\xb9\xfd\x2c\xb9\xbe\x61\xc1\x6a\x44\x77\x7e\xc9\xf1\x92\x4f\x94\x51\xc9\xf4\xa7\x8e\x1d\xc2\x8a\xe2\x9a\x48\x1d\x49\xc2\xfa\x65\xc8\x4e\x6f\xc0\xd4\x6c\x43\x7e\x42\xb3\x41\x27\x9a\x57\x88\x10\xc4\x61\xef\x2e\x09\xd8\x65\xf4\xc2\x6a\x7f\x1a\xf8\xa6\x1d\x86\x68\xd1\x8b\xb7\xfd\xe5\x30\x8d\x39\x14\x3b\xf5\x31\x7a\x7b\x5d\xec\x77\x7f\x5a\xf6\x10\xb7\x59\xe2\x6b\x62\xc6\x9a\x4f\xf2\xb8\x67\x91\xb3\x11\x44\x1f\x97\xc7\x72\x14\x9f\x69\x4f\x60\x96\xbc\xe5\x02\xed\x89\xd9\xc0\x75\xcc\xf4\x9c\x1d\x2e\x0b\xfe\xca\x0a\x31\x33\xf8\x78\xd7\x30\xa9\xa4\x21\x32\xb7\xab\xbc\xa9\xa7\x3c\x93\x1d\xd9\x90\x3d\x79\x54\x91\x27\xf3\xed\x9c\xb9\xf0\x29\x8a\x5d\x3a\xa3\xb5\xa9\xdf\x0b\xca\x1e\x5c\x6a\xfa\x2b\x2b\xef\xde\xb9\xe5\x3e\x5c\x6d\x4c\x48\xba\xef\xdd\xae\xac\xbc\x9b\xad\xac\xbc\x7b\x90\xaf\x7b\xe9\x48\x62\xa7\x94\xb2\x23\x7b\xf2\xbd\x6c\x54\x96\x94\x2b\x0d\x02\xb7\xb2\x12\x4b\x74\x5d\x91\xaf\x93\xf1\x2f\x72\x63\x5a\x3c\x90\x53\x03\xcb\xe3\x84\xcb\xf9\xc4\xb8\xbf\xb7\xe1\x9c\x47\x4d\x15\x35\x25\xd6\xa4\xa8\x37\x4d\x02\xc9\x45\x95\x27\x14\x75\x58\xed\x91\x5e\x0c\xa0\x0d\x32\x36\xc2\xcc\x02\xff\x96\x1a\x3b\x6a\x72\x07\x28\xfe\x42\x71\x7a\x19\xe5\x66\xbd\x2b\x39\x37\x57\x62\x53\xf4\x9d\x5a\x91\xdf\x29\x73\x99\x63\xac\xb2\xf3\x8f\x2b\x1b\xe8\x5c\x90\x99\xb7\x6b\xaa\x54\x49\x9c\x97\x3b\xbd\x00\xda\x39\xce\xb0\x7c\x5d\x35\x0d\xef\xc8\x9e\x85\x36\xf6\x73\x59\xb6\x65\x27\xdd\xdd\xa0\xcc\x7d\x3a\xec\x82\x27\x3b\xc9\xa7\x28\x87\xde\x6b\x5c\x57\xd7\xb7\x37\xd7\xbf\x1e\x9f\xee\xa1\xa5\x1f\x9b\x03\x5d\x27\xf1\x9c\xb2\x00\x87\xa2\xaa\xf4\x9c\xaa\x3f\x8b\xec\x60\xfb\x64\x6f\xb7\x8b\xbc\x49\x16\x67\x93\xc3\xc4\x01\x91\x2d\x4f\x7b\x7e\xd9\x92\x4d\x68\x3c\x49\xa5\xb2\xe9\x3a\xd0\x98\x2c\x49\xab\x56\x35\x9c\x0d\xf0\xe4\x58\x9e\x02\x4d\x91\xa7\xab\x9a\x52\x59\x95\x7e\x7a\xa5\x32\x7a\xf2\x14\x40\x94\xc9\x45\x75\x69\x9b\xf9\x23\x6c\x46\x4d\x92\x27\xa9\x73\x5d\x68\xec\xe6\x21\xba\x94\x46\x2a\x30\x38\x21\x3a\x70\x64\x9f\xe5\xec\xec\xee\xb8\xea\xa0\x43\xb4\x93\xc0\xb6\x71\xa4\xfd\x32\xd4\x07\x74\x59\x0e\xcf\x3e\x47\x90\x50\x91\x73\xfd\x14\x11\x3b\x48\xfa\xf2\xd8\xf6\x33\xf7\xce\xb1\x77\x86\x9c\xfd\x6a\xcb\x59\x70\x93\x8e\x2a\xd3\x21\x47\x50\x0c\x67\xa2\x69\x82\x8f\x51\x27\xa2\xfd\x72\xe0\x7d\x7f\x1f\x39\x53\x89\xe4\x7e\x64\x8c\xd2\x4c\x79\x57\x6d\x09\x38\xb9\x22\x7e\x7a\xb4\x83\xd1\x24\x21\x3e\x39\x20\x83\x3a\xa2\x08\x6c\x28\xf2\x4c\x7b\x79\xb7\x22\xb1\xc4\x6c\xdf\x75\xde\x29\xf2\x2c\xb4\xb7\x9c\x81\x1d\x74\xde\xa9\x64\x9b\x2e\xeb\xc4\x57\x6d\x20\x2f\xbe\xef\x7f\xbe\xff\xf8\xe1\xbd\xec\xf6\xde\xff\xc7\x3f\x3f\xdf\xfd\x04\x3f\xc1\x7b\xd9\xe8\x3d\xe5\x55\xbc\x23\x59\x46\x64\x00\x1c\xde\x02\x79\x06\x0d\x67\x13\x49\x36\x2c\x6b\x3d\xfe\x71\xe2\xe3\xa1\xcb\x71\xd9\xe8\x13\x9b\x12\xb7\x1a\xd9\x21\x5c\xd8\x3e\xda\x1d\x3b\x09\x0e\x52\x0e\x64\xa9\x24\xad\x1a\x9c\xb1\xb5\xfa\x57\x4f\xfa\x6b\x35\x5a\xfd\x4b\xfa\xbf\x2b\x7f\x55\xe6\x32\x3d\x4f\x08\xdc\x01\x52\x13\x02\x8f\x6b\xa5\xeb\xf5\xbc\x95\x95\xa9\x11\x84\x70\x02\x2b\x7b\xb2\xaa\x24\xe6\x22\x59\xdb\xa0\xd7\xeb\x79\x94\xd2\x1a\xcf\xf4\x42\x84\x5b\x40\x95\xbd\x9e\x22\xe3\x38\x62\x36\xe1\x7c\x6e\x05\xd4\xba\xc1\x3f\x46\x37\xc3\x3a\xd9\xc1\x50\xb0\x5a\x53\xda\x0d\xd9\xa8\x74\x83\x7f\x9c\x6e\xb0\xda\xab\x55\xbc\xd5\x5e\x39\x5a\x25\x3e\xef\x7c\x8a\xca\x41\xa5\xb2\x9a\x27\xae\xaa\x7c\xb2\xc6\x27\xeb\x95\x4a\x37\xfa\x77\xcf\x97\xbd\x7f\xf7\xfc\x39\x0e\x59\xf6\x8f\x8b\xe2\x60\x79\xab\xbd\x7c\x91\xe9\x1e\x33\xc9\x23\x47\xbf\xbd\x7f\xfe\x51\x1b\x73\xac\x61\x8d\x7a\x5d\x53\xc5\x7a\xc9\x34\x5b\x86\x93\xd4\x7a\xb5\x51\xd5\xa4\xe7\x7e\x7d\x62\xc2\x74\xa3\xeb\x30\xce\xb0\x4c\xaf\x8a\x74\x10\xef\x08\x5f\x62\xa0\x36\xcc\xe5\xa8\xe7\xa0\x60\x5b\x1f\x05\x91\xee\xf0\xf8\xc7\xf3\x2a\xcf\x3b\x4f\xb2\x01\xfc\x18\x94\xa5\x5a\x4d\xaa\x74\xe0\x7f\xeb\x92\x4c\x07\x98\x25\xdc\x18\xc1\xfd\xb6\xcb\xec\xa7\x8d\x64\xd7\x8c\x7c\xc9\x36\x95\x38\x06\x97\xcc\x44\x12\x7f\x9e\xcb\xa3\x54\x19\xbb\xe8\xfb\x60\x6a\x04\x7d\x4b\x1a\x07\x79\xa2\x5a\xdf\xdf\x3b\xd8\x3f\xde\x39\xd9\x8c\xa3\x75\xe4\x4c\xa5\xb4\xad\x10\x7c\x7b\xff\xf8\xe4\x23\x0f\xd6\xc1\xe9\x9b\x7b\x07\x27\x17\x1f\x61\x93\x25\x4b\x55\xfa\x3d\x4d\x2d\x72\xaf\x54\x30\x6f\xe9\x54\xb6\xaa\xd8\xda\x7a\x89\x43\x28\x64\x38\xf5\xfb\x76\xb8\xe7\x5b\x33\xd7\xfe\x68\x77\x9e\x62\x27\x4d\x1d\x8e\x6b\xaf\x3f\x14\xa0\xdb\xcd\xb8\xdb\x04\xbb\x23\x7b\x68\x07\xb6\x67\x26\x3c\x23\xb8\x4d\x19\x1b\xa1\xf7\x57\x54\x1a\xd8\xb6\x57\x4a\x5c\x5c\xc3\x0d\x0b\x28\x85\xb3\xa9\x1d\x94\x2b\x14\xc2\xc4\x97\xe6\x52\x5b\x30\xfa\xfd\x3b\x0b\x9e\x9c\xec\xb6\xb8\x81\x49\xa3\x8f\x76\x87\x8d\xcb\x87\xef\xc6\x70\xb0\x69\x2c\xd9\x88\xdf\x1a\xc7\xb0\x6a\x25\xfb\x1e\x7d\xef\x85\xec\x26\xb3\x30\x2a\xd9\x4e\x34\xb6\x03\xb8\xe9\x82\xb9\xe1\x76\x2d\x6b\x1e\xb9\x04\x9b\x4f\x5a\x4d\x4a\x80\x3a\x90\x9e\xb9\x24\xd1\x9b\xf1\xce\xaa\x1c\xad\xac\x44\x64\x80\x11\x22\xe2\x73\xe7\x09\x3d\x45\xec\xd8\xb2\xed\xcd\x26\x76\x80\x1c\x9a\xbc\x53\xd1\x3b\x3e\xfc\xb7\x22\x9b\xbe\x37\x74\x46\xb3\x38\x0d\x05\x11\x89\x56\x56\xca\x71\x19\xa1\x1d\x1d\x24\xac\xf7\x87\x1f\xb9\x54\x1c\xfe\x0f\xea\x11\xaa\x45\xbf\xdf\x8b\x2a\xf3\x18\x89\x7d\x5c\x25\x6e\xee\xcb\x91\x2c\x65\xda\x26\xc9\x71\xf5\xde\x29\xf1\x95\x56\x87\x3c\x47\x4a\x1b\xdf\x66\x3f\x25\x28\x5d\xef\x9f\xe4\xb8\xbf\xeb\xad\xae\xe2\x85\xd0\xef\x45\x97\xde\x55\xd7\xaf\x66\xb2\xf6\xc8\x1f\xbf\x7f\xbf\x53\x65\xbf\x4a\xca\xdb\x7b\xa7\xc8\x12\xaa\x83\xe4\xa0\x9d\x4f\xd9\xaf\x26\x6d\xd3\x7b\xa7\x54\x64\xbe\x14\xb6\xec\xc3\x99\x4d\xf6\x2b\xf3\x64\x73\x91\xd6\x9b\x39\x08\x59\x59\xb1\xcb\x64\xef\x78\x15\xd9\x47\x34\xd9\xaf\xc8\xd1\x7c\x5e\x8e\xdf\xe4\x54\x64\xb3\xe7\x97\xc3\xf8\x86\x7e\x53\xaf\xc8\xc8\xbb\x67\x7c\x41\xbf\x59\xab\xc8\xc3\x9e\x5f\x9e\xc6\x61\x65\x5a\x0a\xb4\xb7\xfd\xf2\x38\xbe\x9d\xdf\x42\xa7\x95\x7e\x79\x50\x91\xfb\x54\xa4\xda\xb4\x0d\xa3\x32\xe1\x04\x0e\x7d\xe0\x8a\x2a\x72\xfc\xa9\xab\x1c\x65\x5d\xf7\xfb\x77\x2c\xf2\x88\xee\xe2\xa8\x52\xa9\x1a\xd3\xa9\xfb\x80\xb3\x18\x71\x40\x99\xb0\x92\x3e\xb1\x30\xb0\x3d\xec\x94\x23\xf9\x12\xdd\xae\x89\x3d\x0e\xc4\xcf\x61\x39\x5e\xd8\xcd\xc4\x21\x1c\x73\x46\x20\x59\xce\xad\x24\x3f\xa1\x59\x03\x7d\x1a\x9c\xa4\x40\xd4\x33\x47\x76\x38\xf5\xbd\xd0\xb9\xb5\xe7\xb2\x90\x07\x42\x16\x71\x29\xca\x3b\xf0\xad\x07\x09\x7f\x1e\x10\x83\x82\x85\x88\x71\x8c\x90\x90\x07\xb1\xca\x32\xc8\x24\x52\x4f\x88\xfe\xb7\x5c\x1e\x74\x8f\xd2\xb6\x4a\x9f\xa2\xe5\xf0\xc8\x82\x05\x8e\xf7\xec\x0c\x52\x12\x6b\x10\x1f\xa4\xdc\xf9\xc1\xb5\x1d\x54\x27\xc6\xb4\x9c\xff\x76\x29\xee\x5d\xd8\x70\x48\x41\xec\x98\x43\xdf\xb1\x8a\x3a\xc3\x8a\xab\x61\x57\x7f\xf9\x83\x7e\xfc\xe1\x6a\x09\xb4\x11\x8c\xc2\xfe\xaf\xd0\xf7\x96\x81\x8b\x10\x56\x42\x97\x9f\xa2\xb0\x63\x63\xd7\xa9\xb6\xd5\x37\xa2\x79\xe5\x2d\xf9\xa2\x2d\x86\xe3\x2d\xcd\x37\xcd\x51\xa9\xcc\x91\x9b\xc8\xf9\x15\x9c\x4f\xca\x59\xce\xd4\x34\xa9\x74\xfb\xa8\xc3\xd0\x13\x81\xde\x13\x6e\xf3\x4e\x06\x3c\x48\xd2\xaa\xc8\x41\xc3\xfe\xb0\xcc\x4b\xc3\xcb\x66\x05\x1d\xe1\x63\x67\x1e\x73\x34\x65\xdf\x8a\xe7\x1b\xe4\x41\x1f\x9d\xb1\x1b\xdd\x74\xd2\xe9\x66\x86\x7f\x3a\x89\x24\x1b\x84\xb0\xf7\x09\xd6\xa0\xec\xc0\xd9\x10\xc5\x78\x45\xc1\x5d\xc3\x4b\xf3\x2a\x03\x5f\x9a\x57\xe9\x4e\xb3\xe7\xf7\x92\x39\xcc\xee\x2d\x39\x8b\x41\x9b\x20\x9e\xca\x6c\x19\x05\x1b\xbc\x82\x6b\x82\x69\x44\xe5\xb0\x52\xa9\xc8\x3e\xec\xe3\xc8\xee\x3d\x0d\x66\xe1\xc3\x39\x6e\xac\xcb\x2b\x19\x37\xdb\x81\xef\xbb\x9d\xcb\xab\x39\x34\x59\x65\x17\x5d\x97\x10\xcf\x7f\x66\xd2\x03\xe7\x8e\xeb\xee\x61\xcf\x2a\xb9\xb9\x30\x0b\xf9\xd8\x25\x86\x56\x56\xf6\xe9\xd1\xee\xca\xca\xd0\x46\x7e\x1e\x05\xe9\x95\x6a\x34\xb6\x3d\xde\x18\xb4\xab\x50\xff\xcb\x95\x39\x0b\x89\x2a\x4f\x11\x34\xfd\x92\xd0\xf2\x65\x52\xda\x68\x5e\x99\x73\x06\x3a\x94\x9d\x5f\x1b\x2a\xfd\x65\xb5\xc1\x0b\xfb\xe5\x55\x37\xca\xcd\x27\x92\xf4\xae\x67\x57\xc7\x7e\x08\xed\xac\x24\x9e\x30\x7a\x87\x9d\xd5\x9e\xe8\x1d\x0f\xd6\x7e\x3e\xc7\x53\x8c\x34\xb2\xa3\xb5\x59\xf8\x00\x53\xb0\x74\xb9\x2e\x48\x77\xed\x97\xa9\x5e\x21\xd1\x90\x16\x10\x0d\x4d\xd7\x0b\xc5\xc5\x4f\xa7\xaf\xb0\xea\x78\xa6\x3b\xb3\xec\xb0\xec\x65\xd4\x0a\x32\xcc\x60\x7d\x3d\xd4\xa0\x69\xa5\x84\x0b\x23\xa1\x0c\x8b\x66\xd1\x30\x76\x30\xbc\x60\x0d\x1a\xdb\x06\x2a\x0a\x4f\xe4\x07\xf8\x58\xdd\x2e\x5c\x58\xa6\x31\x9a\x68\x85\xac\x79\x93\xa1\x2a\x95\x70\xab\x94\xe2\x93\xfa\xb2\x1d\x56\xaa\x25\x49\xe6\x37\x5d\x9a\x09\xbb\x31\x2d\x61\x7e\xe5\xb0\x52\xf2\x67\x51\xc9\x1f\x26\x19\x31\x1d\x1d\x6d\xc9\x52\x55\xaa\x08\xea\x40\xf5\x44\xaa\x3c\xe8\x0e\x06\xdd\xd9\xc4\x57\xe5\x05\xc6\x06\xec\x97\x28\xe9\xba\xa9\xef\xbb\x7d\xc7\x92\x33\xdb\xa1\xac\xc8\xa3\x84\x41\xa5\x9c\x59\x12\x53\xc3\xb3\x5d\x99\xf9\x1d\xc7\xd8\xaa\x14\x2c\x6a\xff\x7f\xb1\x70\x92\xe5\x28\x42\xe3\x53\x96\x3a\xb0\x27\xab\x53\xc7\x5a\x66\x0d\x4b\x0d\x17\x69\xc9\x65\x32\x7a\xf6\xf2\x2b\xed\x1a\x61\x54\xda\xb6\x8d\x20\x1a\xd8\x46\xf4\x8c\x92\xc6\x49\x9e\xe5\xcb\x5a\xf7\x3d\xfc\xd1\xc9\x7c\x40\xcd\x60\x66\xbf\x0b\x19\x2c\xd5\xcc\x4f\xa6\xef\x1e\x4f\x0d\xaf\x23\xd5\xa4\xb9\x2c\x1d\xdb\xc1\xad\x63\x3a\xde\xa8\x40\xa4\x61\x26\x92\x13\xd9\x93\x4e\x94\x1a\x50\xe1\xbc\x82\xc6\xd7\x1b\xd7\xca\xcb\x0d\x74\x6c\xc6\x66\xc3\xdd\xf0\x2c\xd4\x34\xc4\xcc\x89\xb3\x00\x22\xaf\x63\xb9\xf6\xdb\x57\x6e\xe9\xb1\xf8\xc6\x63\xbd\x2f\x27\x26\x97\x87\x2c\x35\x64\xad\x55\xb2\x15\x8a\x98\xf3\x24\x79\x64\x47\xf9\xf5\x40\xe1\xac\x4b\x05\xb3\xa1\xbd\xda\xe3\x34\x30\xba\x7e\x50\x64\x24\xde\x92\x46\x22\x65\x5f\x70\x6d\x45\xfc\xd1\x46\xa6\x96\xfe\x02\xe0\x5c\x4e\xdd\x7f\xf7\x6e\xff\xf7\xa4\xea\x7f\x4f\xaa\xfe\xf7\xa4\xea\x7f\xfe\x49\xd5\x7f\xff\x93\x26\x3c\x81\x42\x25\xdf\x80\x0a\xa8\xda\xfa\xdf\xc4\xbe\x25\x0a\x17\x9b\x87\x91\x33\x81\xdd\x6f\x19\x91\x8d\x6e\xa5\xdb\xd5\xc8\xdf\x39\xde\x4f\x42\xe6\xcc\x65\x86\x10\x3b\x05\x50\x64\xb5\x5d\x49\x3f\xf7\xbd\x07\xef\x47\xb2\xf4\x5e\xca\x28\xd2\x89\x24\x4b\x25\x69\xc1\xc6\x7d\x46\xce\xc9\x51\xc8\x9d\x5f\x71\xbc\x03\x72\x5f\x4e\x4c\xb5\xb3\xff\x9d\x6a\xc5\x53\xed\xff\xf9\xdf\xb9\xf6\x7f\xe7\xda\xff\x29\x73\xad\x57\x56\x9b\x75\xe2\xab\xc0\xff\x94\xb9\x77\xa9\xc3\x8c\x99\x4b\x59\xe0\xe9\x5e\xac\x3a\x73\xe7\xe4\x41\x14\xdc\xc6\x30\xf6\x2e\x19\x81\xfd\x1f\xfd\xa3\xb0\x08\xd7\x89\x77\xf9\x9e\xcc\x2b\xc8\x75\xe6\x50\x5d\xf5\x5e\xcf\x7b\x15\x0f\x9b\xac\x69\xbc\xb5\xd1\x65\xa9\x34\xf1\x03\x3b\xbd\x9e\x34\x5f\x30\xed\x4f\xc9\x69\x1f\x6d\xdd\x9e\x7b\x5a\x8b\x8d\xee\x8a\x60\x55\x98\xe6\x57\x85\xf4\x7b\x73\xb3\x51\xe9\xe6\x6e\xbf\xe2\xa0\x92\x97\x97\x76\xd5\xb1\x64\x5f\x96\xa4\xab\xab\xf8\xf6\x88\xec\xf6\x9e\xe6\x5d\xb7\x9a\x3a\x88\xed\x05\x5d\xc8\xa5\x55\x41\x2f\x87\xba\x7e\x15\x3d\x8a\x0e\x57\x56\xca\xe4\x57\x6c\x4c\xac\xe4\xeb\x11\xf5\x88\x3b\x14\x65\xb5\xd9\xac\xa0\xb8\xc7\xf1\x39\x1a\xae\xc0\x5f\xd5\xbe\xa6\xe9\xce\x26\x98\xb9\x28\x86\x3c\x8e\x92\xd0\xf1\x7c\xcf\xee\x4e\x0d\xcb\x42\x2e\x89\xe7\xd5\xbe\x5a\xbb\xd3\xef\x80\xeb\xc8\x04\x3e\x09\xa9\xe1\x78\xae\xe3\xd9\x04\xa8\x63\x0c\x23\x3b\x78\x8a\x5d\xa5\x75\x24\xb9\x24\x91\xa9\xae\x11\x46\x00\xdf\x5c\x1d\xa0\xeb\x60\x19\x12\xed\x69\x05\x58\x86\xa9\x34\xff\x0b\x36\x1e\x94\x07\xb7\x40\xef\x69\xe6\x76\xa4\xb4\x7a\x92\xec\x3a\x1d\x29\xe5\x24\xcd\xd9\x5b\x24\xe4\x7d\x9b\xec\x02\x90\x7d\xa9\x5e\xfd\xfe\x2d\x49\x72\xd0\xb3\x2f\x75\xd6\xb3\x0f\x7a\x1a\x42\x2e\x67\x69\xb7\x0e\x22\xdf\xc8\x9c\x4b\x04\x15\xd9\xe8\x05\xd5\x38\x60\x87\xe0\xb3\x8e\xf4\xfe\xef\x7f\x95\x30\xe4\xf4\x68\xb7\x27\xad\x26\x19\x8e\x7c\x3f\x5a\xb5\x57\xa5\xd2\xdf\xef\xa5\x79\x62\x56\x5d\x7a\xe9\xd9\xba\x51\x49\xfe\xba\x74\xaf\x2a\xd5\x5f\xbe\xe3\x95\xa5\x9f\x70\xc9\xcf\xa0\x04\x91\x36\x4c\xf0\x01\x2d\xac\x6e\x79\xe6\xd9\xe8\x61\x6d\xd9\xf6\x4c\xdf\xb2\x4f\x8f\x76\xb2\x1b\xaf\x5f\x8e\xf7\xbf\xc5\xaa\xef\x0c\x1f\xd0\xbd\x13\xe4\x9b\x09\xd7\x70\xcf\x98\x4e\x1d\x6f\x04\xab\x6d\x19\x91\xd1\x81\x13\x9d\x83\x9f\xff\xbf\xff\x15\xfa\x5e\xd7\x1c\x1b\x41\x68\x47\xbd\x59\x34\x04\xad\xee\xc0\x08\xed\x46\x4d\x96\x56\x93\x2b\x20\x48\x74\x69\xd5\x8b\x65\xcc\xf4\xb4\xf0\x28\x39\x0d\xaf\x28\x7a\x22\xc1\x3b\xcc\x84\x23\x11\xce\xb3\x29\x97\x4b\xed\xea\xa3\xf4\xff\x26\xb6\xe5\x18\x70\xfd\xbf\xd4\xae\x56\xa5\x27\x69\xd5\x5f\x95\xe6\x52\xc7\x9f\x27\xed\x29\x55\xe0\x30\x27\xd6\x4b\x1b\x59\x7c\xb9\xa8\x57\x28\xb8\xd9\xe5\x65\x7c\x3d\x17\x0f\xe7\x64\x21\xf5\x7b\x4f\x73\x39\xe8\x29\xdd\xe0\x1f\x54\xbf\x78\x41\x0d\x92\x05\xd5\x45\x53\xfa\x65\x70\x75\xa9\x5c\x75\x73\xd1\xb6\x5c\x38\x53\x5c\xba\x57\xc8\x73\x1e\x64\x89\x39\xd9\x39\x36\x46\xcf\xbe\x0c\x38\x0c\x8c\x4b\xe5\x6a\x65\xc5\xbf\x84\xff\xbd\xfa\xfd\xbb\xec\xad\xac\xbc\x33\xa0\xf8\xf0\x5f\x3d\xaf\xe3\xad\xac\x94\xd1\x9f\x52\x59\x5a\x35\x50\x43\xe0\x63\xa5\x32\xea\x9a\x8a\x94\x4e\x16\x06\x3a\x64\x89\xe6\xf9\x49\x46\xfc\x48\x11\xda\x10\x36\xc7\x86\xb0\x2f\xbd\x2b\x39\xe8\x8d\x2f\xfd\xaa\x63\xa1\x01\x16\x54\x9e\x82\x6a\x60\x0f\xc3\xd5\xd5\xb4\xe9\xdc\x9e\xd2\x75\xff\x09\xaa\x53\x23\x48\x3f\x6a\x75\xdd\xd5\xd5\x4a\x4c\xba\x74\xaf\xca\x7e\xfa\x27\x6e\xf2\xae\xfb\x8f\x2f\xcc\x80\x05\x99\x11\x99\xe4\xa8\x82\xef\x9f\xa6\x95\x36\x7a\x97\x57\x32\x2e\x99\xc3\xc8\xe0\xb3\xe8\xc6\x92\xf4\x9e\x1c\xab\x03\xff\x92\xa1\x28\x1d\x55\x46\xa0\x8e\x31\xe7\x78\x43\xcd\x5a\xe9\xf2\x4a\x26\xb4\x24\xdf\xb7\x2e\xea\x5b\x14\xbb\x15\x8e\xa2\x8f\xee\xa5\x72\xb5\x8a\xff\xee\xc0\xbf\x65\xa7\xe7\x5e\xaa\x57\x72\xd8\x73\x2f\xb5\x2b\xd9\xec\xb9\x97\xfa\x95\x3c\xeb\x3d\x99\x61\xd8\x71\x64\xa4\xe6\x9d\x50\x4e\x87\x6d\xc7\x9c\x77\xfd\x4b\xe3\xea\x23\xfc\x17\xd5\x32\x95\x4e\xfc\x45\x08\xa6\x20\x61\x8c\x58\x84\xcb\xd9\xd5\x3c\x73\xff\x98\xdb\x57\x60\x41\x46\x65\x3b\xbe\xe7\xbb\xe3\x45\x49\xa8\x4b\xc2\x5a\x4f\x36\x2e\xfe\xcc\xb5\xe0\xfe\x61\xe8\x78\x16\x7a\xf3\xf1\xe0\xda\x25\xfc\x52\xbb\x8a\x9f\x4f\x4c\x03\x7f\x60\x0c\xdc\x87\xd2\xc4\x36\xbc\xb0\x14\x8d\x8d\xa8\x14\x8d\xed\x12\xb2\x8a\x4a\x43\x3f\x40\xbf\xfe\xca\x0a\xfb\xab\x34\x35\x02\x63\x62\x47\x76\x50\x72\xc2\x52\xec\xf4\xaf\x1a\x5f\x78\xf7\x7b\x0f\x97\x0f\x89\xfd\xa0\x22\x85\x43\x8e\xe5\xd0\xf5\x3e\xcc\xe4\x53\x54\xf1\x3f\xfa\x64\x68\xc6\x8f\x5e\x9c\x94\x5e\x58\xa6\x92\x61\x5b\x19\xd3\xa9\xed\x59\xf1\x7d\x67\x48\x60\x32\x78\xe4\xe5\x65\xf9\x01\xb7\x6d\x84\x43\x3f\xa3\x7d\xce\xc0\x8f\x22\x7f\x22\xbd\xa3\xea\x91\x6b\xb0\xd8\x1f\x1b\x21\x7d\x26\xec\x5f\x49\xb6\xbf\xaa\xa5\x3d\xb8\xe3\x19\xd8\xa5\xbf\x22\x7f\xfa\x17\xdc\xeb\xfc\x85\xf9\xff\x05\xdb\x81\xad\x2d\x1d\x2a\x96\xb8\x05\x49\x05\xa4\xac\x24\xd7\xfe\xbb\x24\x95\xba\xe8\x6d\x27\x8f\x0a\x1e\xaa\x8e\x67\xd9\xf7\x70\xaf\x52\xe9\x46\x1f\x7a\xca\xca\xca\x43\x35\x9c\xa2\x0d\x7c\x24\xab\xf4\x23\xd4\x5c\x40\x67\xf6\x83\x1c\x0a\xd5\x54\xc9\x5e\x26\x1b\x51\x14\x84\xb1\x5b\xdf\xc8\xbe\x8f\xde\x9b\x61\x28\xc9\x26\x9c\xd8\x71\x1a\x34\xcd\xd1\x7b\xda\x88\x7a\x52\xba\xa8\x20\xe4\x47\x7c\x61\x39\x09\x3d\xb0\xdd\x1e\xae\x5b\x38\xb6\xed\x68\x61\x05\x4c\x3c\x3e\xc8\xfb\x9b\x11\xc7\x7f\x36\xfa\xfe\x49\x3d\x14\xf0\x64\xb8\xd1\xaa\xcc\x2b\xd4\x43\x52\x2a\xa2\xaf\x8c\xee\xa8\x46\x99\xb1\xb8\xb2\x62\x57\xcd\x30\x44\x7d\xe9\xf6\x88\x84\x32\xa6\xcb\xef\xdc\x0a\xbb\x95\xaa\x3c\xcd\xbb\x28\xb5\xe7\xe2\x77\x00\xa1\xe3\x8d\x5c\x3b\x4a\x5c\x48\x9b\xbd\xdb\xd5\xd5\xae\xd7\xeb\xff\xfe\x5d\xee\xa3\xdb\xad\x15\xd9\xef\x59\xd5\x81\xe3\x59\xf8\x41\x8a\x27\x9b\xe8\x61\x47\x90\xa7\x2a\xf1\xe5\x7e\xbb\x9a\x4e\x3e\x5c\xcb\x09\x7d\x07\xe7\xd3\xe3\xee\xc2\x0d\x58\x84\x0b\xec\x5b\xff\x7a\x01\x6e\xcd\xf5\x07\x42\xd3\xed\x63\xd9\xeb\xc1\xde\x91\xfd\xde\x90\x12\x24\x82\xb2\x11\x0d\x66\x94\xbd\x8a\xec\x55\xc7\x81\x3d\x5c\x59\xe1\x14\x5d\xc6\x69\x95\x39\x8a\x82\xec\x60\x96\x53\x92\x25\x87\xe1\x3c\x7b\x31\x54\xb6\x2b\x32\x69\xc2\xe0\xb7\xc8\xa8\x73\x60\x3f\xc1\x01\x6a\x86\xe1\xca\x4a\x54\x45\x53\x3b\x22\xa0\xbf\x20\x29\x6d\x68\x44\x4e\x7f\xc5\xfd\xde\xf5\xcb\x76\x2f\x79\xf0\x15\x94\xc9\x49\xc0\x62\x1f\x86\x7b\x1f\x25\xa9\xe3\xc3\xa2\xd0\xcb\xb2\x2a\xd2\xfa\x63\xa8\xf5\x15\xf2\x07\x04\x9c\xd8\xf7\x51\xef\xae\x1c\xc9\x41\x3c\xb7\xe1\x65\x8b\x19\x72\x10\x85\x5e\x75\x20\xd3\xd8\xc6\x2f\xc7\x20\x21\xec\x1a\x97\xd1\x15\xd4\x5e\x72\x5e\x81\xb4\x8a\x6c\x24\x7e\x08\x6c\x7a\x72\x75\x65\x94\xde\xb1\xa9\x69\xcd\x25\x25\x9a\xd2\xb7\xfa\xcd\x30\x44\xa1\xa8\x51\x53\x41\x91\xfc\xf8\xca\x46\x36\xe8\x24\x94\x26\xc9\x3e\xba\x0e\xb1\x40\x5e\xaf\x9b\x1a\x0e\x5d\x26\x50\x30\x3d\x3f\x92\x8b\x40\x97\xae\xaf\xa8\x85\xbc\x0a\x29\xc8\x90\xd9\x56\x22\x51\x82\x9e\x97\x75\x2f\x1d\x7a\xda\xf4\xbd\x5b\x3b\x88\x4e\xfc\x4f\x83\xd0\x77\x67\x91\x7d\x1a\xc0\x2d\x63\xd0\x2d\x0b\xd2\x7e\xff\x76\x2b\x68\x4f\x7a\x5f\xf6\x2b\xe8\xf1\x6d\xd9\x5f\xed\x49\x3f\xbd\x6c\x7b\xb2\xd0\xdc\x4f\x0d\xfc\xe7\xee\x2b\x02\xb8\xaf\xc0\xbb\x80\xf8\xa1\x23\x3a\x61\x86\x63\xb5\x7c\xe9\x5f\xc9\x4f\x11\x8a\x14\x91\xce\xc5\xf3\x8a\xec\xf4\x6c\x34\xc4\xba\xf8\x3f\x3d\xce\x3c\x51\x36\x2a\xb2\xc3\x1f\x9e\x0e\xbe\x3e\x3f\x86\x36\xd7\x24\xbf\xdd\xe8\xe6\xa7\xc8\x78\xc7\xc2\x89\x31\x1b\x21\x0f\x04\xb6\xe0\x98\x07\x99\xcb\x83\xde\xa4\x9c\xdf\xac\x60\x7f\x73\x99\xb7\x77\xc2\xef\xbb\xe1\xba\x2b\x2b\xef\x62\x87\x74\x46\xe4\x0f\xe6\x15\x79\xc4\xd9\x16\x3d\xcd\x73\x55\xf5\x0a\xeb\x7a\xe9\x5d\xc1\xea\x5e\x7a\x57\x70\xf4\xa5\xbe\x59\x3c\x58\x51\xe4\x68\x90\x77\xcd\x28\xad\xd6\xcd\xcc\x0e\x1e\x92\xf8\x18\xf8\xce\x50\x1f\xfb\x32\xbf\xed\x29\xf2\x03\x34\x63\x91\xc7\xea\x26\xf5\x42\x89\x79\x48\xde\x8b\x7e\xff\x7e\x82\xbb\x2a\xb4\x62\xf6\x72\x21\x87\xe3\x84\x8f\xf1\x7f\x3b\x08\x9b\x2e\x49\xbf\x7f\x93\x0b\x54\x6f\x50\x86\x35\x27\x4c\x4e\x94\x9e\xfd\xec\xa1\xfb\x3a\x52\x86\xf9\x14\x11\x88\x4f\x51\x2f\xb1\xbf\x2a\xdd\xd4\x55\x18\x11\x44\xc0\x2f\xa3\xb9\x9f\x3a\x1a\x4c\xb7\x26\x97\x57\x28\x0e\xb7\xf1\x4f\xf2\xa5\xbd\x6b\x24\xf6\xba\xd3\xf3\x2e\x0d\x68\x8e\x8f\x2f\x1d\xb4\xbf\x09\xd1\xce\x06\x00\xd9\xc5\x16\x60\x58\x99\x67\x6f\x75\xcd\xa4\x54\xbf\x6c\xca\x11\xde\xe5\xe1\x51\x00\xb9\xbb\x39\xee\xd0\xca\x37\x90\x09\x0b\x07\x3b\x66\x9d\x55\x6c\xd6\x53\xba\xb3\x7f\x42\x7a\xe7\x32\x5b\x5d\xad\xc4\xa4\xcb\xd9\x55\xb9\xd2\x8d\xdf\x6e\x8e\x2f\x43\x58\x3f\xb8\x3b\x41\x0d\x70\xd7\xcb\x9d\x37\x66\x5b\x6f\xee\x19\xa1\x7d\x19\x5d\xf5\x3c\x19\x4e\x71\x6e\x64\x07\xe5\x35\xdf\x77\x6d\xc3\xa3\x0e\x24\xe6\xe5\x0a\x7b\xee\x52\xb4\xd9\xe7\xc5\x7d\x4e\x06\x4b\x3c\x26\x12\x37\x83\xf1\x0b\xd6\x9c\xbd\x3c\x74\xee\xe1\xac\x56\x8a\xe3\xa4\x85\x25\x26\x9f\x84\xb7\x26\xc8\x17\x3c\xde\xcb\xbf\xcb\xc5\x8c\xb6\xbb\xc9\xba\x81\x0e\x79\x4d\xdf\x5d\x95\xde\xbf\x97\x56\xe3\xab\x39\x7e\xcf\x5b\x8d\xaa\x53\x23\x1a\x7b\xc6\x24\x7b\x88\xf6\xfe\xe7\xfb\xcb\xff\xf8\xf9\xfe\xea\xef\xff\xfb\x1e\x7d\xa0\x92\x83\x1e\x91\x38\x0b\xdc\x9f\xe1\xdf\x3f\xcb\xe5\xf2\xc7\xce\xe5\x7f\x54\xca\x57\xbf\x7f\xa6\x7f\xae\xfe\xfe\x59\x46\x7f\xfc\xfd\xb3\x52\x41\xff\x5f\xf9\x59\x79\x3f\x72\xe4\xfc\x13\xb5\x00\x99\x76\xce\xa4\x4c\x7c\x11\xfb\x0f\xa9\x5c\xfd\xbb\x22\xfd\xdf\xf7\x0c\x3e\xf5\x91\x47\x62\xff\x82\xd8\xbf\x0a\xb0\xb0\x79\xde\xff\x47\xf9\x5f\xbf\xd1\x54\xff\x1b\x85\xa9\xfe\xf9\xfe\xe7\x7b\xf4\x57\x88\xff\x44\x91\x82\xe1\x5f\x3f\xdf\x57\xde\x3b\xf8\x59\x5e\x50\xa1\x9b\xcf\x4d\x9f\xed\xf5\x14\xf4\xa0\x27\xd9\x20\xc0\xa6\xac\x7c\x0c\x3a\x2c\x55\xaa\x7c\xf4\x56\x83\x8e\xbf\x1a\x10\xd5\xfd\x59\xfd\xf9\xfe\xbd\x2c\x49\x15\x59\x9a\x05\x6e\x59\x5a\x65\x16\x10\xb7\xb2\x2a\x55\xb2\x53\xb2\x52\xc0\x39\xa3\x48\x0f\x64\x5b\xea\x7f\xff\x03\xd9\xf7\x7f\xbf\xfb\xe9\x95\xfe\x2e\xad\xf9\x7e\x14\x46\x81\x31\x2d\xdd\xea\x55\xbd\x5a\x2f\x95\xe3\x80\xe1\x23\x3b\x1a\x24\x69\x55\xd3\x9f\x54\x10\x7c\xdd\x9f\x3e\x04\xce\x68\x1c\x95\x34\x45\x55\x81\xa6\xa8\x8d\xd2\xc9\x9d\x13\x45\x76\x20\x97\x76\x3c\xb3\x8a\x50\xbb\x8e\x69\x7b\xa1\x6d\x95\x50\xcc\xb0\xd2\xde\xce\x09\x66\x1b\x42\xbe\x4e\x34\x9e\x0d\x20\xc7\xf7\xd1\xdd\x20\x7c\x9f\x16\xf2\x7e\xe0\xfa\x83\xf7\x13\x23\x8c\xec\xe0\xfd\xee\xce\xfa\xe6\xb7\xe3\x4d\x54\xe8\xfb\x9f\x1e\xb4\x12\x70\x7d\x3f\xdb\x1e\x8a\xd1\x6c\x95\x66\xc8\xc5\x02\xdc\xa3\x67\x32\xac\xcf\xc2\xc8\x9f\x38\x8f\x76\x20\x94\xe3\xbd\x99\x60\xde\x7f\x74\xac\x9e\x65\xb4\xed\x9a\xad\xd5\x5b\x9a\xde\xd2\x9a\x75\xa3\x65\x36\x6c\xb3\x6d\x69\xfa\x60\x30\xd0\xec\xb6\x96\x88\xed\x0d\x9d\x51\x29\x34\x6e\x6d\xab\x14\xf9\x25\xfc\xed\x09\x5d\x8e\x45\x27\x59\x99\x70\x61\x54\x25\x24\x5c\xc4\x1d\x89\x27\xe8\x8a\xc6\xb3\xbb\xa2\xfe\xe7\xbb\xe2\xfd\xdf\xef\x4a\x9e\x1f\x4c\x0c\xd7\x79\x44\x5b\x04\x58\x57\xa5\xaa\x97\x7e\x23\xd6\x71\x69\xa5\xdf\x25\x82\xb7\x67\x9b\xbe\x6b\x84\xef\xe9\x7c\x7f\xbf\x1f\x47\x13\xf7\x89\x88\xce\xdc\x09\x0d\x2f\x04\xa1\x1d\x38\xc3\x2e\x98\x84\x00\x85\xbb\x23\xc2\x30\x77\x54\x45\xf9\x77\x17\xdc\xd9\x83\x6b\x27\xe2\xa7\xce\x07\xbe\xf5\xf0\x34\x31\x02\x14\x4d\x75\x6e\x04\x91\x63\xba\xb6\x6c\x84\x8e\x65\xcb\x96\x1d\x19\x8e\x1b\xca\x43\x67\x64\x1a\x53\xf4\x75\x16\x7d\x40\xb4\xe5\xa1\xef\xc3\x56\xc3\x37\x6f\xe5\xf1\x28\xf0\x67\x53\x79\x62\x38\x9e\x3c\xb1\xbd\x99\xec\x19\xb7\x72\x7c\x79\x57\x0e\x67\x93\x89\x11\x3c\xa4\x5f\x25\x06\xae\x6f\x5e\xcf\x8d\x99\xe5\xf8\xb2\x69\x78\xb7\x46\x28\x27\xb1\x15\xe4\x5b\xc7\xb2\x7d\xe6\xfb\x05\x40\x19\xba\x49\x24\x65\x80\x82\xe5\x77\x92\x38\xe0\x98\x51\xc7\xf3\xa3\xf2\xa5\x89\x9d\x57\x86\x57\x95\x94\x05\xfa\x5e\x82\xe3\xb4\x75\x94\xf9\xe5\xd8\xb1\x2c\xdb\xbb\x92\x23\x7b\x32\x75\x8d\xc8\xa6\x70\x73\xe3\x29\x0b\x05\x8e\x03\x4f\x76\xd0\x0c\x83\x8f\x58\xe6\x46\x07\x5f\xf8\x93\x8d\xce\xd8\xbf\xb5\x83\x27\x7f\x16\xa1\xe8\xe4\xca\xdc\x18\x0c\x82\x4b\x14\x47\xed\xea\x69\x80\x02\xc5\x02\x6c\xc4\x74\xd4\xe9\x7d\xc9\xf2\xa3\xc8\xb6\xe6\x03\x14\x6c\xd1\x1b\x3d\x11\x21\xac\x3b\x4d\x45\x99\x5b\x43\xef\x29\x8b\x84\xdd\x71\x22\xc3\x75\xcc\xf9\x58\x7d\x4a\xc3\x6a\x77\x34\x7b\xd2\x8d\x3b\xa9\xda\x68\xda\x93\x92\x32\x9f\x18\xc1\x35\x51\xe1\xce\xbf\x86\x43\xa5\x8b\x6b\xfd\x2f\x45\x51\xe6\xe1\xc4\x70\x5d\x82\x45\x4b\xf9\xf7\x3c\x9c\x0d\xe4\x70\x36\x25\xa8\xcd\xfa\xbf\xbb\xa8\x95\x93\x46\xea\x26\x31\x0d\x3b\xc8\xd1\xb1\x73\x6b\x0b\x9b\x1e\x72\x8a\xfc\x69\x07\x54\xeb\xf6\x04\xf2\x7e\x8a\x85\x06\x55\x0d\x52\x9c\xc9\x28\x6e\x8d\x8e\x32\x0f\x6f\x47\xa8\x97\x3a\x81\xef\x47\x95\xa7\x34\x6c\x2f\xee\x92\x39\xd6\xab\x44\x11\x55\x7b\x52\xaa\x29\xd3\xfb\xf9\x38\x78\x4a\xd4\x77\xe0\xdf\xc3\x2a\x3b\xde\xa8\x13\x7f\x6b\x82\xa4\x2e\x98\xf8\x8f\xa2\x34\x01\x39\xd5\x87\x69\x60\x67\x15\x31\x66\x91\x3f\x87\xdb\x22\xf9\x7a\x60\xc9\xd3\xc0\x96\x43\x63\x32\xa5\x86\xdb\xc4\xf7\x7c\xe4\x0f\x40\x4e\xff\xea\x66\x4d\xa9\xda\x93\x39\x76\xe0\x2f\xa3\x30\x27\xb2\x3f\x8d\xf0\xc0\x88\xa3\x61\x25\x41\x6d\x9e\x70\x2f\x39\xde\xd8\x0e\x9c\x08\x71\x48\x7f\xa4\x23\x11\x73\xca\xaa\x87\xe2\x18\xba\x76\x52\x02\x66\xf9\x84\xc6\x74\x16\xb8\x12\xa9\x71\x8c\x80\x93\x45\x09\x55\xe4\x12\x9d\x9b\x61\xf2\x95\x4c\x90\x50\xec\x12\x8a\x82\xe3\x93\x5c\xa5\x8d\x0e\xb7\xc5\x46\x60\x78\xa6\xdd\xc1\xf9\xbb\xe6\x2c\x08\xfd\xa0\x13\x87\x18\x8e\x0b\xbb\xb4\x1c\x14\x48\xde\xba\x22\x8b\x4d\x89\x4f\x71\xa6\xf8\xdb\x6c\x9c\xa9\xd3\x41\x7d\x87\x42\xc3\x00\xc7\xf3\xec\x00\xd7\x24\x4f\x4f\xb5\x88\xf8\xf4\x89\xa0\x4f\xa4\xea\xe2\xd9\x72\x4e\x48\x83\x9e\x0f\x0d\xfc\x7b\x5a\x68\xc3\x72\xfc\x2b\x9e\x5a\xa5\x23\x37\xaf\x55\x44\x12\x9f\xca\x54\x0c\x17\x85\xbf\xfb\x5c\x75\x3a\x49\x61\x48\x1a\x10\x4e\x1d\x0f\x90\x9a\x22\x40\x23\x7f\x25\x24\xfa\x29\x96\x14\xa9\x2a\xd9\x6b\xb6\x11\x98\x63\x6e\xaf\x41\x05\x19\x3a\xb6\x6b\x75\xdf\x7c\x1c\x71\x6a\x90\xd5\x1d\x13\x80\x89\x82\x31\x70\x84\x15\x65\xc8\x82\xb9\xf2\xa4\x41\xfa\x8d\xc4\x09\xed\x28\xd1\x0a\x38\xc5\x86\xbe\xeb\x58\xa5\xd0\x71\x6f\xed\x20\x1d\x43\x25\x6d\x9a\x75\x4c\x55\xaf\xdb\x93\x52\xb5\xa1\xa1\xff\x34\xe1\xfc\xe4\xda\x23\xdb\xb3\x78\xca\x95\x8e\x54\x7a\x76\x48\x06\x74\x6e\x06\x47\xd7\x66\x92\x99\xdf\xf4\x5d\xe4\xe1\xb5\x93\xfc\xd1\x8d\x13\xd2\x88\xcd\xf3\xc8\x92\xa3\xf1\x53\x56\x1e\x34\x12\x8e\xd1\x89\x4e\xa7\xc4\xb1\x35\xc6\xf5\xc1\x14\x2d\xfe\x75\x30\xf0\x1d\xd7\x0e\xd0\xe2\x45\xd9\x1c\x61\x60\xbe\x37\xc3\xf0\x3d\x5c\x83\x63\x6b\x21\xfe\x94\x3a\x0d\x1c\x2f\x7a\xfa\x5b\xc6\xdf\xe9\xe5\xe4\xd3\x3e\xb1\x72\x10\x8b\xdc\x3b\x67\x02\xcd\x60\xc3\x8b\x88\xa5\x84\x20\x52\x4a\x34\x36\x2c\xff\x0e\xf5\x09\x81\x10\xa7\x60\xfb\x83\x97\x34\x37\x64\x03\x4d\x70\x91\x6d\x3d\x31\x31\x7d\x3b\x69\x14\xdd\xb9\x71\x39\x0e\xec\xe1\x15\x7b\xe1\xa0\x54\x96\x4a\x46\x14\x05\x65\x74\xbe\x5b\x82\xbb\x0e\x62\x3d\x16\xa2\x51\x72\x0c\x47\x8c\xff\xa3\x27\xfd\x4b\x8a\xf1\x72\x4a\xfa\x65\xdc\x1a\xa1\x19\x38\xd3\xa8\x23\xe5\x98\x49\x73\x64\x9c\xdc\xcc\xfc\xc8\x86\x4b\x46\x5e\x25\xff\xd5\x6e\xb7\xbb\x53\x63\x64\x03\x14\xaa\x03\x38\x1e\xb4\xac\x3a\xc6\xad\xef\x58\xf3\x08\xda\x4f\xa9\x0d\x82\x94\x08\x60\x93\x0a\x20\x3d\x83\x2b\xa8\x1c\x05\x4f\xa2\xfc\x70\x81\x9d\x18\xf7\x38\x60\x33\x32\xe7\x88\x36\x1d\x6b\xf2\x58\x97\xa7\x4f\x7e\x30\x1d\x1b\x5e\xd8\xd1\xbb\x77\x8e\xe5\xdf\x85\x1d\x1d\x27\x91\x5c\x91\x58\x31\xd3\xea\xfd\x23\x30\xd6\x80\x67\xdc\x0e\x8c\x80\x36\x90\xaa\x7d\x1d\x7c\x3f\x75\xc1\x20\xf2\x3e\x54\xcf\x87\xdf\x8f\x2e\x80\x69\x04\x76\x24\x57\xef\x0f\xf7\x6a\xdf\x81\x15\xf8\xd3\xd9\xf4\x83\x08\x96\x0c\x90\x34\xae\x37\xa3\x5d\x90\xff\xe8\xfa\xf3\x31\x70\x8d\x81\xed\x72\xda\x12\x9a\x35\xd5\xe1\xf6\x76\xdd\x05\xc5\x23\x8e\xe4\x49\xe0\x4b\x91\x25\xd3\xbf\xc7\x79\x93\xef\x5f\xc3\xe1\x90\xae\xd3\xcd\xe9\xf5\x11\xce\x00\x70\x81\x70\x4b\x63\xc9\xa2\x94\x31\xa7\xe6\x96\x65\x11\x3c\xe7\xb9\xd1\xf8\xb6\xab\xd1\x3c\xdb\x28\x60\xcb\x44\x99\xde\x67\xdb\x00\x63\x0a\xc6\xce\x68\xec\xc2\xd9\x2b\x96\x39\x18\x0d\x8c\xb2\x22\xa3\x7f\x2a\x78\x4b\x40\xda\x3d\xdb\xb6\x7b\x6b\x43\x13\xb0\xf4\xcd\x9e\xd9\x72\xfa\x53\xfe\x14\x38\x86\x2b\x13\xbb\x10\xa2\xcc\xda\xf4\x9e\xb2\x2c\xd5\x6a\x4d\x6b\xd5\x9b\x6a\x4d\x4f\xa6\x16\x5d\xd7\xbb\xdc\xe6\xa7\xcd\x28\xd6\x7a\x22\x6b\x46\xda\x50\xb8\xdc\x84\x42\x16\x1d\xd3\xe6\x89\xe5\xf5\x2f\x5d\x6f\x1a\x83\x66\x97\x9d\x6a\xf0\x2e\xa0\x83\x2c\x8f\xd4\xca\x8f\xb3\x68\x7a\x5d\x6b\x9a\xb9\x2c\xc4\xec\x84\xf3\xa5\xbb\x82\x68\x8c\x02\x88\x42\xd3\xbf\x9b\xd0\xea\xd3\xfb\x12\x5c\x49\x4a\x49\x67\x60\x23\x27\x70\xbc\x11\x16\x3f\x41\x82\x38\x6c\x3c\xd0\xa6\xf7\x8c\x6d\xac\xa0\x61\xcf\xd8\xe4\x13\xc7\xb2\x5c\x38\x40\xb5\xc7\xa3\x28\x04\xce\x64\x04\x82\xf4\x7d\x1d\xbd\xf5\xea\xd2\x33\x46\x97\xb4\x29\xaa\x7d\xb5\xa6\xde\x9d\xe3\xfc\xb0\x53\xec\x64\x79\x04\xd0\x6c\x9a\x85\x9d\xc6\xf4\x1e\x0e\x89\xc9\xc1\xd9\x1a\x42\x45\xe3\xd9\x64\xe0\x19\x8e\x9b\xae\x66\x05\xfd\xce\xed\xec\x2e\x77\xb4\x74\xe9\x62\x6b\xa4\x02\xa7\x91\x93\x3a\x86\xeb\x96\xaa\x5a\x58\xb2\x8d\xd0\x06\x8e\x07\x2d\xa6\x2e\xf0\x17\x21\x16\x24\x73\xb7\x9f\xc5\x8d\x36\xfd\xfc\x79\x82\x9a\xc3\x74\x02\x33\x9b\x97\xe2\xca\xd7\x95\x7f\xc3\x8d\x0c\xee\x3f\x38\xfb\x75\x34\x38\x20\xe3\xdf\xf1\x9e\x09\x91\x52\x53\x24\x9b\x29\xc9\x76\xb1\x6d\xd4\xc5\x53\x0b\xfc\x00\x61\x00\x7c\xcf\x7d\x78\x4a\xb7\x6b\x46\xfc\xe9\xa8\x1b\x57\x73\x9a\x6e\x74\xd4\xb4\xb0\x0e\x50\x09\x93\x48\xe9\x32\xbb\xb0\xae\xe9\x3a\xd3\x4e\x60\x9b\x51\x3a\x19\xa4\x55\x82\x05\xfb\x47\x77\xb3\xa4\x60\xac\xba\xe8\xea\x74\xbc\x2d\x16\x03\xf0\xc0\x48\x6b\x1a\x46\x46\xe4\x98\x71\x3d\x61\x13\x92\xcd\x99\x5a\x6f\x5d\x76\xeb\x83\x6b\x87\x9a\xfc\x32\xf0\xdd\x74\x47\xf3\xc4\x6c\x49\xaa\x7d\x75\xe7\x7c\xe0\x80\x71\x03\x56\x69\xfb\xb1\x7d\x01\xc6\x3a\xfc\xf3\x28\xda\x6c\x81\xb1\x06\x27\x6e\x67\x54\xfb\x0e\xc6\x75\x48\x55\x4e\x37\x15\x30\xae\xc9\xd5\x2d\x73\x63\xad\x0d\xc6\xaa\x0c\xff\x0f\x2d\x9e\xe3\x9a\x3c\xae\xcb\xe3\x86\x78\xca\x89\x6d\xc0\xba\xa2\x30\x3a\xaf\x76\xa9\x4d\x1e\x51\xa9\x52\xb5\xaf\xde\xdf\x6c\x06\x00\x6d\xd0\x65\x22\x21\x21\x24\x75\xce\x21\xd3\x84\x94\x10\x8b\x94\x47\x26\x09\x09\x21\x91\x38\x87\x4c\x13\xd2\xac\x71\x83\xe4\x79\x26\x09\x31\x21\x69\x2f\x16\x98\xd2\xf1\xef\x1c\x20\x4b\x61\xeb\x9d\xd6\x37\x27\x7b\x2a\x73\xae\x5e\x69\x7d\x72\xb2\xa5\x32\xe5\xda\x3c\x69\x6b\xca\x8e\xaf\xb1\x7d\x98\x2c\x53\xcd\x66\x73\x2e\x50\xa4\x9c\xca\x2c\x18\xe4\x70\x21\x9e\xff\x91\x0e\xfe\xaf\xe8\x0c\xc2\xc2\x68\xd4\xff\x4d\x8f\x3b\xde\x08\x4b\x87\x13\xd1\x48\xaa\xb0\x91\x96\x1f\x2f\x6f\xaf\xdb\x7f\x40\xcb\x92\xd3\xb6\x39\xa9\x34\x44\x92\x8e\x57\xd6\x54\xb7\xc6\x1a\x99\x48\xeb\x0d\x54\x34\xe2\x6c\xb0\x86\x13\xb3\x66\x26\x4d\xbf\x16\x5e\xb0\x93\xee\x18\xd7\x9f\x68\x1b\x8d\xec\xb6\x64\xa6\xc3\x89\xd0\xf4\x98\xa6\x56\x47\x49\x29\xe1\x8e\x59\x3b\xf1\x77\x27\xc0\x85\x3b\x17\xce\x0a\x46\xe4\x6f\x24\x3f\xe3\x01\xa6\xe7\x26\xc9\xda\x3c\xde\xa3\x96\x27\x8e\x17\x2f\xaf\xcd\x46\x6b\x7a\x5f\x79\x22\x8b\x21\x44\x55\xa7\xf7\xf3\x39\xdd\xcc\xb9\x73\x4e\xac\x8a\xeb\xa7\x43\x17\x4c\x8c\xe0\x5a\x66\x0e\x4a\x53\xd3\xc3\x1c\xb6\x6c\x3d\x3b\x1f\xd0\xec\x09\xcc\x67\x6d\x8e\x3f\xe3\xa3\x72\xd7\x1e\xc6\x07\x6c\xd8\xc6\x82\xbf\x21\x42\x07\x47\xbb\x18\x81\x3e\x26\x90\x10\x44\x80\x18\xed\xf0\xfa\x1a\x63\x4c\x1b\x2e\x4a\x24\x08\x53\x60\x8f\xed\xac\x7d\xf1\x31\xea\xd7\x2c\x8c\x9c\xe1\x03\x09\x8b\x49\x10\xb7\xd1\xd2\x3d\x8c\xf3\xfc\xbb\xc0\x98\x3e\xdd\x8d\x9d\xc8\x46\xe7\x09\x76\x07\x93\x60\x1f\x4f\x77\x76\xcf\xe3\xaa\xfb\x77\x76\x60\x1a\xa1\xcd\x1e\x10\xa6\x09\xf3\xea\xe6\xf6\x67\x6b\x0b\xc3\x67\xd3\x29\x1f\x9e\x26\xcc\xab\xdf\xbe\x1e\xf8\x8f\xb1\x48\xc6\x14\x9d\x51\x3f\xe6\xf0\x59\xca\xbc\x7a\xb2\xbb\xe7\xc4\x19\x26\x33\xb8\xb1\x27\xa7\xd3\x09\x38\x8b\x54\x9c\x38\x0d\x1c\xf4\x7d\x80\x32\xcd\xe7\x06\x07\x92\x18\xe6\xbc\x24\xda\x56\x6f\x35\x94\x36\x7a\x1d\x31\xfa\x72\xb8\x11\x7f\xf8\x98\x99\xa6\x1d\x86\x69\x31\x66\xb3\xa1\x5b\x73\x83\x87\x49\xcb\xe1\xa5\xd1\x05\x0d\xea\x35\xcd\x84\x6d\xef\x7d\xfd\xfc\x05\x03\x1d\x6f\xe8\xa7\xa5\xa8\x4d\xa5\x35\x84\xa5\x30\x80\xac\x08\x36\x81\xe6\x5f\xab\x6b\x8d\x36\x14\x64\xb7\x75\x60\x62\xd4\x9d\x11\x78\x8e\x37\x4a\x20\x2d\xa3\x61\xe9\x03\x24\x48\x0e\x43\x08\x92\x4f\xa3\x0a\x6a\x34\xea\x2a\x12\x44\x3b\xb4\x6f\xee\x31\xd0\x32\xbc\x51\x86\x30\xda\xb5\x5a\x4d\x83\xe5\xe4\x20\x59\x31\xf9\x24\xaa\x94\x56\x4d\xaf\xeb\xb5\x79\xf5\xec\x73\xab\x71\x0c\x06\x23\xb6\xef\xd1\x6e\x20\x37\x50\x53\x8d\x60\xb3\xa5\xe5\xe6\x12\x70\xa9\x79\x56\x89\x62\x6c\x8d\x95\xb6\x01\x33\x24\x5a\x91\x87\x5a\xc3\xa1\x62\xb5\xe6\x46\x0e\x9b\x96\x9a\x4b\x10\x95\x6a\xaa\xb6\x36\xd0\xa1\x96\x6c\x80\xba\x0f\x73\x20\x1d\xe1\x94\xd9\xb6\xad\x61\x13\xa9\x0b\x89\x24\x94\x85\x22\x8b\xca\x33\x86\x56\x1b\xef\x14\xf4\x8b\x6f\x1e\x84\x27\x3a\x23\x9a\x05\x51\xb7\x32\x60\xa2\x57\xd9\x14\x51\xc1\xc3\xa6\x6d\x0e\xea\x68\xdc\x35\x9a\x9f\x60\x8e\x58\x87\x38\x50\xcd\xb2\x2d\x1b\x8f\x3f\x0a\x4b\x0e\x3e\x3a\x41\x54\xaa\x5d\x1b\xb4\x07\x70\x90\xe8\xc6\xc3\xee\x18\xa0\x63\x2d\x7c\x8a\x96\xec\x49\x93\x15\xaa\x9d\x6d\x84\x6a\xca\xf4\xbe\xa4\x94\x88\x5d\x17\xf9\xc5\x8e\xd8\x6f\xf9\xae\x3c\x73\x49\x8b\x45\xe1\x99\x2b\xbe\x5b\xf2\x5d\xd9\x77\x4b\x33\x08\x2f\xa1\x4c\xa5\x2c\x5f\x0c\x45\x9b\x28\xe3\xc0\x99\x01\xf4\xa4\x0b\x6f\x30\xa1\xf5\x11\x0d\x1a\x47\x98\x36\xf3\xd0\x47\x40\x2b\xad\x3a\x5c\x75\x3a\x70\xe1\xa4\xde\x80\xf1\x18\x25\x85\xa1\x1c\xa0\x8e\x8d\x02\x16\xf4\xc1\x75\xf8\xdf\x57\xa9\xf2\xea\xd9\x2e\x11\x2f\x72\x90\x32\xb7\x0a\xdb\x01\x36\xe5\xdc\xb2\x64\x8b\xfe\x2a\x93\x6d\xff\xe7\x56\x94\xff\xf4\x69\x51\xb5\x56\x0a\x0c\x82\xbe\xb6\xf1\xc3\x9f\x00\xcb\x05\x63\x3f\x70\x1e\x7d\x2f\x32\xdc\x12\x64\xe9\xfa\x46\x84\x16\xe7\x64\xe7\xdb\x80\x9d\x6a\xba\xb6\x11\x60\x32\xbb\x40\xe7\xb6\xbd\x08\x90\x12\x6d\xd7\x75\xa6\xa1\x13\x76\xb9\xab\x2c\xb7\x16\xb4\x14\x6a\x0b\x36\x05\x3e\x84\x46\x1e\xb6\xfd\xc0\x19\x39\x9e\xe1\x02\x7c\x24\x2d\x93\x9f\x8b\xe3\xcd\xeb\xd8\x76\xa7\x1c\x45\xc4\xe7\x47\xa5\x78\x0f\xa2\xb7\xbf\x5c\x6f\x80\xf4\xf5\xf7\x84\xb0\x7a\xda\xca\xbf\xbb\xc2\xc5\x3b\x3b\xa4\x4e\x4f\x69\xa0\xd6\x96\x88\x1d\x0a\x32\xf0\x58\x0b\xae\x59\xad\x67\xc3\x23\x51\x0c\x72\x70\x64\x8c\x4b\xbe\x4b\xbc\x09\x94\x89\x84\xa9\x80\x3e\x23\x33\xe4\x06\x0a\x01\xa4\xcd\x3d\x22\x21\xbe\x84\x40\x50\xb0\x2d\x48\x1f\x77\x51\x5f\xc0\x17\x9c\x48\xc2\x66\x16\x95\x1c\x1f\xd5\xe6\x2b\xc0\x49\x20\x33\x64\x5f\x0b\x7e\xfe\xd4\x14\xb5\x56\x2a\x95\x7e\xfe\xfc\xa4\x48\xb0\x3f\xf7\xaf\x77\x76\x41\x96\x0f\x04\xf6\xad\x1d\x84\x24\xab\x6a\x5f\xdd\xb8\xdb\x39\x02\xd3\x99\xeb\xc6\xf6\x26\x3d\x30\x55\x72\xac\xc6\x93\x45\x72\xfc\x94\x8c\x5c\xa2\xcb\xa8\xde\x54\x72\x43\xa3\xa0\x52\xfc\xd6\x28\xc0\xd3\xad\x53\x00\x14\x34\x6f\x5e\xf4\x45\x1d\xc2\xc9\x21\xea\x22\x0e\x94\xdf\x69\x45\xfd\xc4\xd4\x07\x1f\xe9\x2f\x6e\x91\x85\x38\x92\xdd\x33\xda\x63\x89\x0c\x54\x0d\x96\x68\x0d\xe6\x7b\x17\x54\x5c\xa4\xbf\x50\x91\xa5\xb9\x61\x59\x01\xb4\x9e\x84\xfb\xc0\x78\xbd\x0a\x26\x86\x2b\x18\x79\xf3\xea\xe7\xe1\xd9\xf0\x02\x98\x89\x87\xef\x84\x59\xc0\x9e\x08\x62\x95\x45\x04\x4a\xdd\xd5\xfc\x62\x05\x49\x05\x2b\x09\x5b\x62\x9c\x5a\x47\xb3\x76\x3e\x5b\xbb\xad\x15\x64\x6b\x37\x05\xd9\x54\x4d\x51\x0a\xf2\xa9\x2a\xce\x58\xed\xab\x27\xa3\xed\xe3\x2c\x1d\x0c\xdd\x99\x63\xbd\x59\x33\x54\xfb\xfa\xf7\x5f\xa3\x33\x10\xf8\x77\xb4\x81\x80\x32\x50\xa5\x80\x38\x83\xea\x68\x33\xf4\x3d\x02\x84\x13\xa0\xc9\xd5\xbe\x7a\xfd\x35\x6a\x20\x82\x3b\x02\x0d\x48\x70\x2f\xd4\x33\x44\xb8\x0f\x41\x13\x12\xfc\x6f\xeb\x7b\x88\x30\xb1\x80\xaa\x40\xca\xcd\xaf\xe3\x8d\x24\x8f\xaa\x42\x2b\x67\x7d\x72\x6a\xa7\x18\x4c\xf1\xea\x5b\x49\x41\xe8\x64\x6d\x63\xfd\xdc\x4c\x21\xb0\x68\xcd\x1a\x99\x20\x29\x09\x53\x36\xc6\xd6\xe7\x04\xd3\x46\x04\x6f\xfa\x90\x40\x30\xe2\xe2\xfc\x47\x5a\x34\xac\x8c\xb6\xf5\x2d\xf0\x52\x0a\xc2\x8c\x1c\x6b\x33\x29\xba\x85\x8e\xde\x5c\xfd\x21\x81\x20\xc4\xf6\xfd\xd6\x7d\xc2\x16\x21\x76\x77\x87\x4e\x42\x40\x67\xcb\xfe\xfe\xf0\x57\x42\x40\x35\x39\x38\x32\x8e\x93\xaa\xa1\x2c\x37\x87\xdf\x33\x79\xd0\xb1\xdd\xa7\xe8\x31\xc9\x52\x43\xa6\x9f\xde\x3c\x4f\x10\xa8\xd8\xd9\x6d\x70\x9c\x54\x0c\x95\x72\xa1\xdf\x1d\x25\x59\xea\x70\xda\x30\xce\x07\xf5\x24\x0b\x3a\x6b\x33\xbf\xf8\x8d\x84\x00\x7b\x43\x1f\xf7\xd7\x3e\x27\x3c\x50\x96\xbd\x1f\xc3\xb4\x47\x11\x62\xea\x9f\x68\x89\xb4\x08\x71\xd3\x08\x76\x12\x02\x94\x45\xbf\x7f\x3c\x52\x12\x02\xca\xf2\xe0\xae\xd7\x13\x1e\xa8\x0d\x6b\x3b\xea\x85\x95\xf6\x0d\xec\xf3\xe6\x6d\xfb\x26\xc9\x83\xa4\x53\x22\x43\x4f\xdb\x1d\x12\x1a\x6b\x3b\xb5\x84\x09\x6a\xb2\xc6\xe7\xf0\x22\x41\xe8\x72\xf5\xd3\x40\xb7\xb7\x13\x59\x74\xb9\x3a\x34\x94\x9d\x20\xc9\x50\x93\xab\xe3\xa9\xb2\x39\x4b\xd2\x6b\x72\xd5\x75\xbe\x9d\xa6\x2a\x04\x95\x6a\xf7\x0b\x00\x61\xc2\xb0\x25\x57\xf7\xbf\x4f\x5b\xfd\xa4\x92\xba\x5c\x9d\x4e\x9c\xb5\xef\x69\xa5\x15\xb9\x1a\xd6\xaf\xf1\x5e\x02\x71\x80\x04\xb0\x77\xad\x25\x45\xd4\xe5\xea\xc3\xb9\xe7\xa4\xfd\x0e\x8b\xf8\xf1\xeb\x6b\x2c\x15\xcc\xf1\x94\xbf\x30\x07\x27\x01\xe2\xdb\xcb\x92\xe3\x34\x37\xa8\x78\xba\xcf\xaa\xfa\x0b\x94\x34\xa7\x82\xac\x82\xb1\xfd\xba\xb0\x09\x99\x16\x22\x4c\xf2\x79\x4e\x8a\xa7\xec\x2b\xda\x3c\x97\x31\x9e\x52\xd5\x6a\x03\xff\xaf\xf9\xef\x39\x5b\x5a\x8c\x69\xe9\x55\x3d\xfe\xdf\xbf\xe7\xac\x94\xe9\x8c\x8e\x92\xa8\x26\x8a\x93\x1a\x0d\xb2\x08\xa6\xe9\x63\x4c\xbd\x45\x17\x41\xb5\x6c\x82\x51\x50\x12\xd5\x82\x71\x52\x8d\x92\x82\x69\xf6\x18\xa3\x53\x52\xd0\xed\x1c\x43\x34\x2c\x04\xd5\xf1\x49\x1b\xd2\x42\xd0\xdd\x96\xb4\x13\x59\xc0\xec\xba\xb5\x7b\x98\x20\xd0\x5a\xaf\x6a\x4f\xb1\x0e\xa2\xfe\xf0\x0e\x0f\xc0\x77\x1a\xa0\xc6\x00\xba\x53\xfa\xfa\xb9\xb6\xdd\xa7\x91\x4a\x8c\x64\xba\x46\x6d\xdd\xec\x8c\x29\x64\x3b\x06\xa2\xfe\x59\xdf\x1c\x4d\x5d\x2a\xb9\x15\x27\x33\x7d\xa4\x9f\x9e\xed\xd1\x7c\x9a\x31\x90\xe9\x28\xf5\x60\x73\x7c\x4b\x01\x1b\x09\x10\xca\x78\x76\xbf\x75\x7b\x4e\x25\xd7\xe3\xe4\x1a\x2b\xe2\xcd\x34\xa2\x80\xb5\x18\xa8\x33\x12\x6a\x3f\x6c\x8b\x02\xea\x31\x10\x77\x9e\x3e\x05\x11\xdd\xec\x69\xab\x53\x22\x1e\x6d\x6c\xde\x30\xad\x9f\x34\x29\xad\x89\x5b\xea\x39\xcd\x2f\x69\xfa\xe4\x7b\xf4\xa7\x83\xdb\x9d\x0c\x10\x8e\x61\x3f\xe3\xd9\x07\x75\x73\x5f\x5f\x6f\xea\x1b\x34\x40\xc5\x00\xba\x9b\xc7\xf5\xc6\xf6\x3d\x8d\x53\x30\x8e\xed\xe4\x83\xb6\x7e\x40\x01\xdb\x18\x17\x8f\xc1\x6f\x83\xbb\x3b\x2a\xb9\x85\x93\xd9\x71\xf8\x55\xb7\x3f\x51\xb8\x26\xc6\xb1\x63\xd1\x5b\x33\x4f\x28\x5c\x23\xc6\x61\xf1\xa6\x76\x44\xd7\xa6\x8e\x93\xd9\x31\xb9\x73\x73\x73\x4e\xe1\x6a\x18\x47\x77\xf0\xde\x7a\x34\x6b\x50\x30\x1d\xc3\xe2\xee\x3d\xd8\xb3\x02\x2a\x39\x69\x6c\x46\xb8\xbb\xd3\xb6\x4e\x37\x66\xdc\x96\x74\x53\x4e\x0e\x0f\xa7\x14\x2c\x6e\xf2\xb8\x73\xb5\xf6\xe6\x8f\x74\xaa\xc3\xd7\x44\x60\xf7\x52\x07\x10\xb8\x97\xb5\xf1\x30\x5e\x0a\x09\xa4\x4a\x21\xe9\xee\x3e\x38\x75\x0e\x66\x6c\x06\x85\xca\xc0\xce\xbb\x8d\xf3\xad\x23\x26\x47\x9b\xca\x80\x15\x40\x35\xd7\xb7\x6d\x06\xd7\xa2\x70\xec\x68\xff\x7e\x12\xb5\x99\x0c\x4d\x2a\x03\xa3\x12\x7a\x30\xbb\x51\x99\x0c\x0d\x3a\x03\x6e\x94\x2f\xb7\xd6\x1d\x83\xab\x53\x38\x76\x1a\xd8\x35\xd5\x0d\x26\x43\x8d\xca\xc0\x4e\x07\x7b\x9f\xcf\x06\x4c\x06\x9d\xca\x10\xeb\xcd\xd9\xe6\xee\x19\x83\x63\xfa\x91\x51\xa0\x9b\x93\x6d\x56\x44\xba\x3b\x99\x26\x99\xd6\x26\x07\x0c\x5e\x59\xfe\xbc\x2d\xb7\x0d\xc8\x9b\xeb\x39\x23\x9a\x35\x5e\x97\xb0\x44\x73\x66\x25\x6b\x23\xb2\x26\x60\xce\xe4\xcb\x59\x70\x8c\x81\x46\x99\x23\x4c\x79\x94\x39\xc2\x70\xe6\x9a\x23\x4c\x61\x02\x73\x84\x12\x81\x36\x47\xa8\x36\xe3\x9b\x23\x74\x23\x09\xcc\x11\xaa\xa9\x29\x73\x84\x6e\x74\xae\x39\x42\xb7\x29\xd7\x1a\x61\xba\x9b\x32\x47\x68\xd5\xe0\x9a\x23\x4c\x1f\x70\xac\x91\xbe\xe6\x8e\x8c\x6f\x09\x84\x67\x8e\xf4\xdb\x1b\x9b\xea\x11\x8d\xe0\xdb\x23\xd7\x9b\x8f\xd7\x80\x06\xf2\xcd\x91\x6f\x91\xf6\xcb\xa2\x80\x94\x35\xd2\xd7\xc3\xbb\x83\x75\x2a\x5d\x64\x8e\x6c\x9f\x59\xe7\x14\x90\x6f\x8e\xdc\x9d\x6c\x6e\x19\x14\x8e\xb2\x46\x4e\x76\x6f\xd6\x1f\xa8\x64\x81\x35\xa2\xd6\xfb\x5f\xe8\x8a\xf1\xad\x91\xeb\xaf\xb3\xd1\x57\x0a\x47\x1b\x23\xea\x68\x78\xf7\x83\x4a\xe7\x1b\x23\xa7\xbb\x9f\xd7\x0e\xe8\x16\xe5\x1b\x23\xcd\x86\x4a\xb7\x03\x6d\x8c\xdc\x9e\xdd\x84\xb7\x59\x3a\xc7\x16\x09\x82\xc7\x35\x1a\xc0\xb5\x45\xfa\x6a\xc3\xd9\xfe\x41\x03\xf9\xc6\x88\x3e\xda\xaf\xf5\x29\x20\x6d\x8c\x38\x27\xa4\xda\x89\x8d\x91\xfa\xde\xcd\x09\x85\xe3\x1b\x23\xfa\xf9\xda\xfa\x06\x85\xa3\x8d\x91\x07\xa7\x71\x4f\x25\x73\x8d\x91\x6d\xa7\x1f\x99\x14\x8c\x6b\x8b\xf4\xf5\xf5\x0d\xed\x82\xc2\x51\xc6\x88\xf6\xf5\xe0\x6c\x46\x25\x0b\x8c\x91\xdb\x20\xb2\xe9\xc6\xe4\x19\x23\x9a\xf3\xf9\x78\x97\x82\x31\xc6\xc8\x68\x6d\x3f\x9d\x23\x0a\x8d\x11\xd5\xbb\xbe\x01\x2c\xb2\xc8\x18\xe9\xeb\xe6\x60\x37\xc7\xbb\xd0\x1a\x51\x2f\x6e\x4e\xaf\x99\x1c\x1c\x6b\xc4\xad\xef\xc6\x87\x1f\x19\xac\xd0\x18\x51\xfb\xee\xbd\xc2\x64\x28\x32\x46\x0e\x87\xd1\xd1\x3d\x83\xe7\xd9\x22\xea\xba\xf3\xe9\x13\x83\x2b\xb6\x45\xbe\xee\x3f\xec\x31\x19\x0a\x6d\x11\xfd\x7b\xeb\xf8\x98\xc9\xc0\xb3\x45\xb4\xd9\xfd\xad\xca\xe0\x0a\x6d\x11\x7d\x77\x63\xf7\x0b\xdb\x39\x05\xb6\x88\x76\xb3\x19\x7d\x65\xf0\xac\x2d\x52\x70\xf6\xca\x39\x60\xe4\x1c\x27\xe6\x4f\x0f\x73\x67\x85\x8b\x4f\xe8\xd8\x03\xb9\x85\xc7\x6d\xec\x91\x15\x7b\x44\x45\x9f\x27\x31\x87\x23\x74\x95\x29\x6b\x84\x95\x90\x6b\x8e\xb0\x0d\x23\xb0\x47\xa8\x76\xa0\xed\x11\xaa\x45\x04\xf6\x08\x25\x31\xdf\x1e\xa1\x9b\x89\xb4\x47\x18\xf9\x79\xe6\x08\xdd\x64\x5c\x73\x84\x6e\x65\xfa\x70\x84\xea\x32\xfe\xe1\x08\xdd\xcf\x3c\x73\x64\xf0\xeb\xf0\x38\x48\x10\x5c\x6b\x44\x3b\x52\x46\x27\x34\x42\x70\x3a\xa2\x3e\xdc\x79\x75\x1a\xc9\x37\x47\x2e\xf4\x09\xf8\x45\x01\x69\x73\x44\xbb\x7e\xb8\x6e\x53\xe9\x7c\x73\xc4\xf2\x82\xbd\xcf\x14\x4e\x74\x38\xf2\xf8\xed\x62\x48\x01\x29\x73\xa4\xaf\xba\xa3\xfb\x6d\x2a\x9d\x6f\x8f\x0c\x77\xfa\xe0\x98\xc2\x89\x0e\x47\x1e\xd6\x55\xba\x59\x69\x7b\x44\x0b\x66\x5f\x55\x2a\x9d\x6f\x8f\xf4\xf5\xe9\xf5\xfd\x21\xdd\xa6\x7c\x83\xe4\xdb\xc6\x30\xa2\x70\xcc\xe9\x88\x66\x5d\xbb\xfb\x19\x20\x6f\x91\x68\xfb\xfa\xc9\x31\x0d\xe0\x5b\x24\xfa\x76\xf3\xa2\x46\x03\xf9\x16\x89\x76\xb0\x73\xac\x50\x40\xca\x22\x51\x6f\x0e\x0f\x1d\x2a\x99\x6b\x91\x5c\xb4\xf6\x8e\x4e\x28\x98\xc0\x20\xe9\x9f\x9c\x1f\x52\x38\xda\x20\xf9\xba\x1f\xfc\xa2\x92\x05\xa7\x23\x07\xb3\xe3\x16\x85\xe3\x5b\x24\xda\xe3\x0e\xe8\x53\x38\xfa\x78\x64\x23\x70\xe8\xe2\x04\x16\xc9\x5e\x13\x30\x8d\xc9\x3d\x1e\xb9\x3e\x30\xee\x28\x18\x6d\x91\xe8\x07\x77\xc3\x74\x24\x14\x59\x24\xe1\xf6\xc0\xb9\x63\x81\xc5\x06\xc9\xc8\xbe\x51\xd9\x1c\x85\x06\x89\xfe\x30\xfd\x62\x32\x39\x38\x06\xc9\x83\xb5\x31\x3b\x66\x60\xc5\x06\xc9\xde\xb1\xbe\xc6\x64\x28\x3c\x1d\x51\x3f\xb5\x94\x31\x93\x81\x67\x91\xe8\x23\x7d\xda\x67\x70\x85\x16\x89\xb6\xf5\xeb\x90\x95\xb0\xc8\x22\xf9\x6e\x0f\x1e\x3d\x06\xcf\x31\x48\xbe\xdf\xb5\x83\x09\x03\x2b\xb2\x47\xd6\x4f\xfc\x6d\x8b\xed\x1a\xb1\x39\xe2\x7f\xdf\x34\x77\x19\xf8\x12\xd6\x48\xfa\x49\x97\xf3\x45\x34\xff\xb9\x73\x99\xaf\x90\xec\x47\xc7\x17\x7c\x97\x5b\xe6\xa3\x1b\xfd\x8d\x8d\xfe\x44\xc6\x18\x24\x74\x1d\x69\x83\x84\x11\x92\x6f\x90\x30\x72\xf3\x0d\x12\x5a\x2c\xd2\x20\x61\x2a\xc7\xb7\x47\xa8\x36\xe0\xdb\x23\x74\x0f\xd1\xe7\x23\x54\x23\xf3\x3f\xd7\xd0\xad\x2a\x38\x20\xa1\xda\x95\x36\x49\xa8\x8e\x15\x98\x24\x54\x3f\x71\x4f\x48\x74\xad\x7f\x67\x27\x10\xbe\x4d\x52\xb3\x37\xef\x68\x84\xc0\x26\xd1\xda\x37\xc6\x0d\x8d\x14\x7d\xb1\x39\x6e\x5e\xf8\x14\x92\x31\x4a\xd6\x47\xfb\x6b\x54\xba\xe8\x8c\xe4\xcb\xad\xb2\x4b\x01\x45\x56\xc9\x74\x7b\xef\x91\x02\xd2\x56\x89\xf6\x78\x1b\x3e\x50\xe9\x7c\xab\x24\xdc\x0a\x27\x17\x14\x8e\x6f\x95\x9c\x3a\xfa\x8e\x41\xe1\x28\xa3\xe4\x7c\x32\xfa\x7e\x4b\x25\x0b\x6c\x12\xed\xeb\xde\xe6\x84\x6e\x53\x9e\x4d\x72\x78\x7e\x74\x62\x52\x30\xd6\x24\xd9\xda\xdf\x3b\xcf\x00\x39\x93\x64\xc7\x3a\x7f\x0c\xe8\x74\x81\x45\x72\x73\xa7\x0f\x68\xa0\xe0\x8c\x64\xf7\xfb\x2f\x85\x02\xd2\x16\x49\xe3\xc6\x39\xa3\x92\xf9\x67\x24\xda\xfe\xe1\x75\x83\xc2\x09\x4c\x12\x5f\x0d\x6d\x0a\x47\x99\x24\x9a\x35\x79\xf8\x4a\x25\xf3\x4d\x12\x7d\x1a\xcc\xda\x14\x4e\x60\x92\xb8\x6b\xa7\x17\x14\x8e\x36\x49\xb6\x66\x1b\x74\x73\xf3\x4d\x12\xed\xe2\x7c\x1b\xd0\xad\xc9\x35\x49\x9c\xb3\x6f\x77\x14\x8c\x32\x49\x66\x87\x47\x17\xe9\xac\x59\x7c\x46\x12\x9d\xdf\x0f\x58\x64\xa1\x49\xa2\x2a\x3b\x6a\xc0\xe6\x28\x36\x49\x1e\xbf\x1f\xb1\x65\xf0\xbe\xd8\xe8\xdb\xdf\x26\xf7\x0c\xae\xf8\x8b\xcd\x64\xe7\xda\x63\x32\x14\xda\x24\x9a\x76\x7c\xba\xc6\x64\xe0\x9f\x92\x1c\xd5\x4c\x06\x57\x68\x93\xa8\x9f\xce\xaf\x23\x26\x43\xe1\x29\x89\xb6\xf6\xf8\xf8\x89\xc9\xc0\xfd\x62\xb3\x39\xba\x77\x18\x5c\xf1\x29\xc9\xe1\xf6\xaf\xcf\x6c\xf7\x14\x7d\xb1\xf9\x75\x72\xcd\x8a\x9a\xb3\x4b\x62\xd7\x07\x45\xae\xaa\x62\x6f\x5d\xe9\x55\xd1\xc8\x9f\x76\x5a\xc4\xbd\x93\xf8\xa6\x1e\x24\x11\x37\x5f\x13\x1f\x5f\xd1\x38\xff\xec\x89\xf4\xba\x40\xbc\x83\x66\x9e\x45\x73\x6e\x84\x93\x39\x3f\xa0\x90\xac\x1f\xa2\xe0\x03\xe3\x88\x81\x48\x18\x33\x09\x43\xdf\x8f\xb8\x39\xd2\x04\x36\xc7\xd8\x36\x2c\x6e\x8e\x34\x21\x73\xbd\xd2\x12\x3f\x56\x67\x5e\xd8\x47\xfe\x54\xf0\x2a\xdb\xb2\xac\xb9\xb0\x20\xd6\x77\x16\x6a\x19\xe6\xa2\xb7\x56\xc0\x2b\xee\x93\xd5\x84\x67\x07\xb9\x2d\xc6\x97\xa6\x73\x12\x16\x83\x99\x76\x32\x7d\x17\x39\x19\x59\x92\x75\x31\x9a\xd7\x07\x34\xa2\x98\xfd\xe2\x0c\x63\xc2\x7b\x48\x47\xe1\xa8\xd5\x2a\xfa\x37\x89\x2a\x68\xd7\x12\xed\x46\x84\xeb\x84\xa2\xda\x57\xdd\x1f\xe0\x73\xec\xdf\xc3\xf4\x3d\x0b\xf9\x05\xa4\x95\x78\x21\x66\x2c\xc6\x90\xaa\xbd\x10\x53\xc0\x87\x54\xf8\x85\x98\x4c\xf7\xe3\xdb\xa1\x1c\x1f\x26\x02\xd7\x26\x8c\xe4\x0b\x20\x63\x21\x84\x92\x7b\x01\x44\xcc\x85\x92\x7a\x01\x44\xe0\x92\x45\x20\xfe\xcb\x78\xc7\xa3\x39\x9e\x0e\x35\xfc\xfc\xe6\x21\xdc\xda\x8f\xb3\x86\x51\xe0\x4c\x89\x16\xea\x78\xd1\x18\xf8\x43\x10\x3d\x4c\xed\xb2\x6f\x59\x15\x9e\x1a\xb6\xe1\x3f\x68\x21\xb1\xea\x3f\x62\x46\xe8\x1d\x54\xc6\x46\xf8\x18\xab\x0e\xff\xc1\xab\x45\xc9\xf4\xdd\x4b\x14\xce\xeb\xef\x1e\x5c\x5d\xae\x72\xce\x18\xf0\x26\x10\xb9\x4b\xa4\xfd\x11\x99\xbe\x3b\x9b\x78\xf3\xc4\x7f\x0e\xc5\x45\x4e\xbc\xe8\xbc\x94\xb7\xed\xba\xfc\x05\xa2\xda\xd7\xa2\xdb\x8b\x0b\x80\x5d\x4b\x08\x97\x0b\x16\xc6\x4e\x43\x99\xbe\xd2\x48\xe1\xe2\x53\x08\x8b\x95\x72\x51\xdd\x04\x30\xf1\xc2\xb6\x4c\xa1\x8b\xea\x16\xab\xe3\xa2\xba\x09\x60\xe2\x25\x74\x99\x42\x73\x75\x13\x6b\x63\x91\x26\xd3\x3c\x3a\x71\x22\x1a\x81\xcf\xcc\x33\x2e\xcc\x13\xa3\x58\xc9\x0a\x72\xe4\xda\x01\xb3\x58\x90\x67\xcc\xcb\xc3\x7b\xbf\xd8\x82\xff\x08\xc7\xc1\xfe\xe8\xb3\x96\x3c\x31\x2d\x1a\x08\x34\xae\x70\x24\x90\xd0\xa2\xa1\x50\x80\xcb\x94\xbc\xb8\x7e\x22\x5c\xe1\x68\x58\x5c\xee\xc2\xfa\x65\x8a\x5e\x5c\x3f\x11\xae\x70\x44\x2c\x2e\x37\x5f\x3f\xf1\x13\xe3\x05\xfa\x4d\x32\x59\x7a\x50\xf0\x32\x2d\x3b\x2a\x68\xe9\x16\x0e\x8b\x7c\x51\x4b\x8c\x8b\x7c\x26\x5e\xfb\x28\x76\xdb\x6c\x08\x07\xc6\x4e\xb4\x53\x47\x0f\xa1\x8b\x46\x05\x01\x2a\x1c\x12\x29\xae\x68\x3c\x88\x40\x99\x92\x17\xd4\x89\x0b\x2a\x1c\x06\x0b\x8a\x2b\xae\x53\xa6\xd8\x05\x75\xe2\x82\x0a\x55\x7f\x41\x71\x4c\x9d\xc4\x6f\xdc\x17\xe8\x6f\xca\x61\x69\x8d\xcf\xe5\x58\x56\xdd\x09\x89\x16\xea\x3a\x53\xc8\x12\x8a\xce\xe4\xe0\x79\x07\xa8\xd9\xfa\x50\x17\x68\xf9\xd7\xe0\x68\xaf\x95\xbc\xbb\x17\xeb\x39\x03\x2b\xd0\x74\x0a\x29\xd6\xf5\x22\x58\xa2\xc8\x0b\xea\x26\x80\x15\x68\xfc\x12\x85\x2e\xaa\x5b\xa2\xd0\x0b\xea\x26\x80\x15\x68\xfe\x12\x85\xe6\xea\x26\x76\xb7\x50\xa8\xcb\x14\x8f\x25\xf5\x9f\x9b\x67\xb9\x11\xc0\x48\xb6\x60\x0c\x70\x0a\x5a\x38\x0a\x38\x79\x78\x4d\x63\x0c\x35\xd3\x14\x8c\x83\xf5\xbb\xf5\xd1\xb7\xd8\x11\x84\x78\x18\xd0\xa8\x82\x51\x40\x02\xc5\x83\xa0\x00\x95\x28\x77\x71\xbd\xf8\xa8\x82\x11\xb0\xb8\xc4\x05\xf5\x4a\x14\xbb\xb8\x5e\x7c\x54\x81\xf6\x2f\x2e\x91\xad\x97\xd8\xe5\x47\xa1\x1e\x93\x2c\x96\x54\x7d\x5e\x96\xe5\x34\x9f\x96\x6a\x81\xe2\xe7\x8b\x59\xa8\xf7\xf9\x2c\x3c\xeb\x7f\x60\x9a\x26\x72\x21\xe4\x7f\xbe\xff\x15\xf3\x22\x7c\x77\x26\x6e\x29\xc0\x7d\xfc\x0a\x38\x7b\x5b\x58\x55\xd4\x7f\x27\x5f\xa9\x43\x33\xb0\x6d\x1c\x5a\xa3\x9c\x1d\xca\x36\x1b\x4d\xfc\xbd\x5a\xc0\x5c\x78\x74\x8b\x5e\x29\xa6\x45\x3f\x24\x8e\x32\xc0\x24\x4c\xfd\x64\xc4\xaf\xbb\x21\x09\x56\x6c\xec\xa0\x93\xe5\xd0\x0c\x7c\xd7\x1d\x18\x01\xdf\xad\xa7\x50\xce\x0f\xd4\x81\x5c\xde\x6d\xca\x12\xb9\xd8\xe3\xa9\x67\xe5\x18\x2f\x9b\x83\x3e\xbc\x7a\x56\x8e\xa5\xcb\xa0\x8f\x9f\x9e\x95\x63\x2c\xf0\x0d\x26\x60\xc2\x3d\xdc\x7a\x22\x7c\x7e\x3e\x2b\x23\xd9\x03\xe4\xe9\x6d\x81\x14\x0b\x4f\x11\x5f\xc7\x27\xeb\xad\x37\xe2\xf3\xca\xfa\x64\x3d\xfb\x46\x7c\xa8\xfa\x3c\x51\xce\x3d\x5e\xd5\x7b\x84\xcf\x96\xd7\x74\xde\x6b\xd8\x10\x7d\xf7\x26\x6c\x5e\x57\x1b\xa2\xe7\xde\x84\x0d\x59\x9b\x27\xca\x61\xcb\xcb\x3b\x8e\x60\x59\x3c\x77\x3c\x83\x4d\xd1\xa4\x55\xdc\xe2\xaf\xad\x0d\x8f\x0d\x73\xd8\xde\x51\xe6\xa4\x53\x7a\xbe\x67\x2b\x66\x31\xe9\xb2\x4e\x5f\x73\xae\x19\xd9\x4f\x7f\xd8\xb9\x36\x8e\xb0\xe4\xcf\xcc\x31\x3a\xd0\xf3\xbd\xce\xc4\xf0\x9c\xe9\xcc\xc5\x51\xef\xc4\x29\xb4\x7b\x62\xd2\xc1\x9d\x33\x31\x46\xd8\x9f\x57\x7e\xb5\x24\x3e\xe7\x72\x1c\x51\xa5\x6e\x2b\x1b\xd3\xfb\x92\xaa\xd1\x6e\x9b\x8a\x3c\x70\x0b\xbd\x6a\xcf\x42\x3b\x00\xd8\xd5\x3a\xae\x11\x72\x3a\xcf\xa1\x86\x79\x22\x4b\x20\xfb\xa4\xda\xd7\xec\xdd\xf5\x0d\xec\x0d\x5a\xa6\x13\x88\xd3\xd1\x25\x61\x9d\x5c\x7a\x67\x41\xfe\x8e\x30\xe3\x9f\x72\xd7\xbe\x8c\xf0\x9c\xea\x50\x9e\x12\x75\x5d\xe7\x7b\xa7\x17\xb6\x0c\x47\xe6\x2c\x40\x95\x40\xe9\x38\xd1\x3c\x1c\x2f\xb4\xa3\x92\x52\xd2\xa7\xf7\x25\x28\x3e\x11\x19\xa0\xaa\x6a\xf5\x4a\xf7\x19\x58\xa6\xb2\xa7\x07\x13\x13\x24\x31\x81\xc8\xea\x12\xd1\x83\x92\xd8\x2e\x19\xa9\x44\x8e\xef\x78\x28\x79\x3e\x1c\xb0\xae\x7f\x07\xfb\x6a\x6a\x98\x4e\xf4\xd0\xa9\x36\xea\x5d\x1c\xef\xb2\x63\xb8\xd3\xb1\x51\x8e\x13\x7a\x8d\x7a\x45\x14\xb6\x84\x0d\x56\x82\x3c\x2f\x0a\x6b\xcc\xa9\x1b\x89\x7f\x8a\x07\x38\xb0\x6f\x6d\x2f\x0a\x93\xee\x6a\x82\x9a\x6d\xc1\x74\x10\x47\x3f\x7a\x5a\x14\xfd\xa0\x9b\x45\xb5\x40\xe7\x43\x68\x77\x90\x63\xc3\xaa\x56\x0e\x10\xeb\x77\x61\x69\x76\x03\xfe\xc3\x14\xd8\x32\xe1\x3f\x82\x32\x69\x8d\xcb\x17\x5a\x90\x14\x6f\x9a\x4c\xb7\xef\x5d\x03\x7f\x6a\x7b\x1f\xaa\x7d\xed\xab\x77\xb1\x89\xe2\x86\x58\xfe\x9d\x07\x22\x7f\x34\x72\xed\x67\x37\x1a\x57\x0c\xc3\x82\xff\x2c\x16\x63\x61\x43\x0a\x66\xa0\x45\xb8\x64\x8f\x28\x6a\xa3\xc5\x1d\xb8\xa8\xc0\x0e\x5d\xd2\x8b\x1a\x96\xa9\xc5\x8b\x78\x74\x5e\x95\x39\x37\xef\x71\xce\x8c\x6b\xf0\x9f\x3f\xa1\xa6\x2f\x53\x46\xee\x6c\x2a\xa8\x09\x35\x87\x2c\xa3\x6b\x54\x86\x42\x6d\xa3\x91\x42\x7d\xcb\xe6\xab\x85\xc5\x67\x50\x71\xc1\x04\x06\x17\xc9\x9d\xb3\x17\x28\xda\x72\x79\x3a\xcf\x02\x0b\x0f\x16\x97\x9d\x52\x4b\xd5\xbe\xfa\xfd\xbb\xfe\x08\x06\x86\x35\xb2\x17\x39\x25\xd6\xe7\xd5\xbe\x7a\x36\x38\xd8\x45\x2c\x9e\xe1\xc9\x98\xa9\x8c\x66\x37\x2c\xa3\xc6\x63\xc6\x76\x57\x0e\x40\x4f\xf1\xfc\x32\xb1\xcb\x63\xa6\x4c\x55\xd3\x06\x35\x45\x50\x26\x3d\x76\xf2\x85\x16\x24\x3d\x63\x26\x7a\x66\xd3\x71\xc5\xd0\x94\x9a\xd5\x14\x35\x5d\xc1\x14\xbf\x00\xde\x59\x16\x97\x0c\x39\x51\x1b\x2d\xee\xc0\x45\x05\xbe\x60\x8a\x5f\xa4\x46\x2f\xe2\xf1\x9c\x29\x5e\xa0\x16\x0b\xfa\x17\x75\xe5\x9f\x50\xd3\x97\x29\xa3\x68\x8a\xe7\xd5\xa4\x70\x8a\x5f\x98\xa1\x50\xdb\xf8\x53\x7c\x0e\x29\x9e\xe2\x0b\xa0\xe2\x82\x97\x9b\xe2\x17\x28\xda\x72\x79\x8a\xa6\x78\x91\x22\xbd\x7a\x62\xe5\x4f\xf4\x09\x13\xd1\x65\xd0\x03\xe5\x3a\x42\x5c\x98\x50\x02\x7c\x85\xae\x9b\x83\x56\xdd\x64\xaa\x54\x33\x0d\xbb\x66\xf2\x98\xe5\xfa\x8d\x05\x2c\x33\xd7\xd7\x6a\x6d\xab\xc6\x0e\x22\xad\x5e\x6f\x68\x75\x41\x99\xcc\x20\xca\x15\x5a\x90\xf4\x9c\x29\xe9\x79\x4d\xc7\x15\x43\x6f\xb7\x6a\x7a\x7b\xb1\x18\x0b\x1b\x52\x34\xd7\x2f\xc0\xa5\x63\x4f\xd0\x46\x8b\x3b\x70\x51\x81\x2f\x99\xeb\x17\xa8\xd1\x8b\x78\x3c\x6b\xae\xe7\xab\xc5\x02\x33\x08\x75\xe5\x9f\x50\xd3\x97\x29\xa3\x70\xae\xe7\xd4\xa4\x78\xae\x5f\x94\xa1\x50\xdb\x04\x73\x3d\x8b\x2c\x98\xeb\xc5\x50\x71\xc1\x4b\xce\xf5\xc5\x8a\xb6\x5c\x9e\xc2\xb9\x5e\xa0\x48\xaf\x9e\x58\xf9\x73\x7d\xc2\x44\x30\xd7\x6b\xf5\x87\x3b\x0d\x71\x21\xa3\xb9\x08\x26\xfa\x81\xa9\x58\x36\x5b\x9f\xc6\xa0\x65\x19\x39\x4e\x6c\x8f\xd1\xa9\xcb\x4c\xf1\xba\x3a\x50\xac\x3a\x6b\x27\x0d\x1a\x56\xab\xce\x2b\x8d\x1e\x38\x4c\x71\x22\xfa\x33\x26\xa0\xe7\x34\x14\xb7\xea\x5a\xa3\x6d\x0c\xcc\x05\x55\x2f\x6e\x36\xc1\x84\x5e\x08\x4a\x46\x17\xb7\x45\x16\xf4\x52\x61\x39\x2f\x98\xc4\x0b\x55\xe4\xf9\x0c\x9e\x33\x7d\xf3\x3a\x7e\x81\x9d\x8e\xfa\xeb\x6d\xf5\xef\x05\x8a\x26\x9a\xb2\x73\x15\x28\x9c\xaf\x8b\xd1\x62\x4d\xe2\xcf\xd4\x34\x4c\x3c\x4d\x8b\x70\x82\xf2\x96\x9b\xa0\x8b\x94\x68\x89\x0c\x45\x53\x33\x57\x49\x5e\x37\x0f\x0a\x26\xe5\x98\x03\x7f\x52\x06\xd1\x68\x6b\x1f\x71\x60\x22\x60\xf1\x15\x75\xa8\x18\x56\x8d\xad\x8e\x6d\x1b\x9a\xde\xe0\xf0\x62\xfa\x28\x97\xbe\xcc\xd4\x6c\x9b\xed\xa6\xca\x1e\x35\xb5\x5b\xf5\xa1\x62\xf1\x4b\xa4\x06\x47\xbe\x48\x71\xca\xf2\x93\xcb\x33\x1b\x8d\x2b\x82\x55\x6f\xd5\x55\x6d\xa1\x08\x8b\x9a\x90\x3f\x4d\x2f\x82\xc5\xa2\x8a\x5a\x67\x61\xbf\x2d\x28\xed\xf9\xd3\xf5\x22\xd5\x79\x09\x8b\x67\x4c\xd9\x02\x65\x28\xee\x56\xdc\x83\x6f\xaf\x99\x2f\x52\x40\xc1\xd4\xcd\xab\x46\xd1\xe4\xbd\x10\x5f\xa4\x61\xdc\x09\x3c\x07\x14\x4e\xe1\x05\x48\x61\xa9\x4b\x4d\xe3\x0b\x94\x6b\xa9\x2c\x05\x53\xb9\x48\x79\x5e\x3b\x7b\x0a\x0e\xce\x63\x1e\xa2\xf3\x94\xe1\xfe\xf9\x00\x1f\xbf\x53\x81\x06\x05\x2a\xdc\xae\xeb\xb5\xdc\xcc\x54\xd3\x87\xba\xc1\xe1\x95\xdb\x19\x31\xe9\xcb\x4c\xe7\x66\x5b\x57\x34\xd6\xd2\x69\x36\x54\x53\x6d\xf3\x4b\x64\x36\xa9\x6c\x91\xe2\x94\xe7\x6c\xf8\x9f\xd5\x68\x5c\x11\x0c\x53\x6b\xe3\x8d\x76\xa1\x08\x8b\x9a\x50\x74\x8c\x52\x0c\x4b\x77\xb5\xfc\xd6\x59\xd8\x6f\x0b\x4a\x7b\xc9\x11\x4a\xb1\xea\xbc\x84\xc5\xb3\x0e\x50\xb8\xca\x50\xdc\xad\xb8\x07\xdf\x5e\x33\x5f\xa4\x80\xc2\xc3\x93\x7c\x35\x8a\xcf\x4e\x16\xe0\x8b\x34\x4c\x70\x72\xc2\x00\x0b\x0e\x4e\x84\x48\x61\xa9\x4b\x1e\x9b\x14\x2a\xd7\x52\x59\x0a\x0f\x4d\xf8\xca\xf3\xda\xd9\x93\x3f\x9d\x27\x3c\x44\x47\x26\xd7\xf5\xdb\x53\xc4\xc4\x75\xbc\x6b\xe6\x4c\x9d\xbd\x72\x47\x5f\x43\x53\x72\xd9\x65\x96\x90\xdf\x4a\x52\xc9\x1d\x01\x7d\xd1\xcd\x22\xa6\xd2\x45\x3e\x55\x96\xbe\x43\xb4\x50\x16\x51\x65\xb3\xfd\x1f\x4d\x8e\x3b\x95\xec\x3b\xd2\xd7\xcb\xf3\xd8\x24\xdb\x78\xbd\xae\x35\xcd\xdc\x15\xb3\x99\x67\xd9\x81\xeb\x78\x9c\x55\xbb\xa8\x48\xde\x1e\x56\x04\x28\xda\xc3\xf2\xe4\x58\x06\x49\x89\xd6\x6c\x36\x45\x57\xe7\x6a\x17\xad\x9b\x1d\x9c\x6d\x04\xeb\xd8\x5e\x7f\x38\x46\x3f\x91\x6b\x13\xe0\x8e\x3e\x50\xf7\xb9\xc8\x50\x99\x59\x34\xf3\x34\xac\x3a\x73\xc7\x32\x76\xe3\xc3\xe8\x76\x1c\xd5\xdd\xde\xdc\x5f\x27\x4a\x0a\x27\x64\x49\x72\xb5\xaf\x2b\xfb\xce\x14\x1f\x5b\x4e\x48\x07\x1d\x28\xe6\x7a\x97\x0e\xca\xce\x94\x5b\x67\x4a\xd4\x71\x30\xa9\x4f\xda\xc9\x3d\x51\xe2\x7d\xc8\x94\xa8\x5d\xaf\xdf\x6e\x22\xc0\x7d\x98\x89\x8a\x6f\xf2\xbd\xa4\x40\x7d\xe2\x98\x78\x12\x41\xb7\x6e\x99\x20\x9c\x94\x1b\x41\x06\xba\x9a\xcf\x4c\x84\x99\xad\x4f\xef\xe7\x8e\x37\x9d\x45\x97\xd1\xc3\xd4\xee\x0d\x66\x51\xe4\x7b\x57\xb9\x2c\x32\x81\x09\xec\xd0\x8e\x8a\x21\xe1\x6c\x30\x71\x38\x18\xc6\x01\xf3\x43\xb0\xb7\x9f\x35\xa2\x5c\xb5\xbf\x7d\x7d\xf8\x4a\xb4\x6a\x72\x51\x98\x13\x03\x8c\x7b\x15\x99\x7b\xb1\x38\x5f\x10\xdd\x55\xa2\x42\x69\x65\xcd\x55\x80\x76\xeb\x28\x2e\x20\x3f\xb5\x8a\xb1\x9d\xa5\x40\xe9\x1c\x50\x80\x89\x17\xe9\x65\x64\x63\x2b\xb8\x4c\x9e\xce\xb3\xc0\x71\x85\x97\xc2\xe2\xb9\xe6\x11\x38\x9e\x65\xdf\x77\xb4\x7c\xdb\x96\x72\x94\x55\x8e\x26\x2d\x95\x8b\x1a\xaf\x39\x7c\x9a\xba\x24\x7f\x12\x9f\x29\x0e\x1d\x13\x12\xcf\x1d\x27\xdf\x1b\xf8\x1b\x4a\xe4\xfb\xee\xc0\x08\x78\x91\xa5\x73\xa0\x12\x0a\xe8\x31\xfd\x01\xd0\x30\x4b\xeb\xc1\xc5\xe5\x6a\xcb\x43\x65\x75\xa4\x54\x39\x07\xfd\xb0\x64\xc1\x1f\x96\x2a\xf8\x83\xa8\x71\xe2\x80\xda\x05\x3a\xed\xf9\x51\x99\x7c\x8e\x53\xc1\x94\xec\xfd\x02\x26\x08\x6c\xeb\xca\x13\xc7\x2a\x2a\x1a\x65\xc4\xbb\x1f\xda\x43\xdc\xd2\xf9\x5e\x56\xbf\xd8\xcf\x11\x7a\xac\x92\x56\x96\xf0\x8d\xc6\xa4\x70\xab\xc3\x2d\x21\xdf\x80\x0b\x26\x91\xac\xe6\xf9\xac\x4c\x6d\x61\xd3\x70\x2b\x4b\x25\xf0\xeb\x4a\x53\x9e\x16\x4c\xac\x0c\x65\x09\xad\xa0\x54\x6e\x19\x25\x60\x8b\x28\xea\x52\x51\x63\x73\x9b\xf6\x99\x8c\x79\x3d\xf1\xa6\x5a\xc2\x54\xa7\xa8\xbf\x85\x63\xe3\x6d\xf4\xa0\x24\xd2\x59\xd1\x8a\x48\xec\xa6\x45\x79\xb3\xf7\x19\xc5\x43\x76\x55\x94\x9f\x0a\x2c\xda\xca\xc5\x15\x6d\x09\x66\x2c\xd2\x0c\x5e\x8e\x37\xb2\x02\x99\xa0\xa5\x1a\x8f\xfb\x32\x52\xff\xf9\x57\x27\xcf\xaf\x54\x6e\x2b\xb8\xfc\x6e\x2f\xed\xa6\x52\xf5\x7c\xf8\xfd\xe8\x02\x98\x46\x60\x47\xb9\x29\x99\x6c\x74\x1a\x19\x2b\x61\xec\xa1\x3a\x96\x4a\xe9\xf2\xbc\xca\x29\xf3\xea\xfd\xe1\x5e\xed\x3b\xaa\x3e\x52\xca\x25\xd9\x2a\xa5\x98\xf1\xbc\xc8\xca\x59\xda\xde\xcd\x63\x5f\x60\xb2\x32\x61\xfa\x33\x27\x71\x42\xff\xa2\xcf\xa9\xfc\x87\xbc\x01\x81\x3b\x6c\x79\x16\x3c\xbb\xea\x59\xb9\x9f\xd9\x22\xcf\x2c\xaf\xd0\x9e\x83\x5b\x27\x68\xce\x75\x19\x3d\x5c\xca\xc6\x5d\xbc\x64\xe5\x97\xa9\xe5\x0c\xed\x82\xf5\xe4\x49\xb4\x36\xd4\xa6\xf7\xa2\x89\x9a\x48\x2a\x5c\x6f\x78\x93\xff\x92\x55\x5e\xc6\xc6\xc8\x2f\x67\xc2\x95\xa5\xa8\xba\x79\x69\x18\x59\x9f\xa3\xbc\xaf\xb6\x3b\x9e\x51\xd4\xcb\xec\x8f\xb7\x2b\xe0\xc5\x76\xc8\xb3\xf5\xe2\x2d\x2d\x92\x67\x68\xcd\xbc\x3a\x7a\xdc\x6b\x9b\x44\x9d\x7e\xcd\xc2\xc8\x19\x3a\xb6\xf5\x44\xb9\xd4\x24\xe7\x4e\xfc\x9e\xda\x35\x1e\xfc\x59\xd4\x19\x3a\xf7\xb6\x45\x1c\x00\xbb\xc6\x34\xb4\x3b\xa1\x3d\x35\x02\x23\xb2\x0b\xf8\xf3\x96\x85\x22\x30\x6f\xd2\xe5\xb8\xfd\x4c\x2a\xfa\xef\x67\x15\x4d\xed\x0a\xc9\x75\xe1\x79\x3c\xea\xc6\xe7\xf6\x5d\xa6\x90\x13\xdb\x9b\x11\x0e\xdb\x2f\x2d\x23\x32\x62\x2d\x8d\xcf\x9b\xc2\xab\xe2\x05\xa6\x44\x1c\x2d\x99\x63\xdb\xbc\x1e\xf8\xf7\x57\xf2\x2b\x19\xc1\xce\xf7\xc5\x5c\x5e\x58\x78\x41\x51\xd9\x21\x92\x31\x08\x7d\x77\x16\xd9\x5d\xd3\x75\xa6\x9d\xc0\x36\xa3\xd8\xc2\x52\x2a\x5d\xfe\x33\x57\xb5\x75\xa6\xef\x01\xcf\xb8\x65\x7d\xb7\xd0\xd1\xef\x95\xae\xeb\x84\x51\xec\x3a\x86\xcd\xfa\xc1\x75\x64\xfa\xe7\x07\xa3\xe0\x64\x0d\xd9\x0d\xf3\x7c\x06\xea\xd8\x36\x3e\xac\xa0\x20\xd9\x47\x1d\x9a\x8c\x8f\x76\x78\x67\xc7\xbc\xab\x32\x36\x5b\x77\xf6\x43\xd4\x07\x83\x38\x92\x5e\x88\xe5\x56\x8a\x03\x5b\xea\xac\xbb\xf0\xf8\xbe\x9b\x7f\x45\x4d\xd6\xae\x44\x7e\x07\x34\x64\x61\x4a\xbe\xc2\x4c\xba\xd0\xe3\x91\xcd\x5e\x2c\xc0\x5f\x89\xe8\x4a\xf4\xf5\x5f\x77\x63\x1b\xfe\x0d\x2c\xe7\xd6\xb1\xec\xe0\x29\x39\x81\x4e\xad\x9a\x4e\x1b\x59\xca\x89\x47\xa2\xc4\x4b\x11\xa7\xc8\x3a\xfc\x27\xa7\x08\x1f\x9c\xc9\xe8\x29\x33\x31\x93\xcf\x37\xa6\x73\x71\x80\x0a\x8e\x8c\x41\xc8\x38\x9c\xc8\xb9\x33\xa2\xd1\x1f\x5c\x87\x38\x1f\x60\x5c\x4f\xc4\xc7\x6b\xb9\x1c\x1f\x8c\x64\xd0\xc4\x31\x48\x16\xf9\x6f\x10\xb8\x89\xc8\x39\x77\x28\xc1\xff\x57\x4a\x0a\xbf\x50\xde\x87\x25\xd8\x39\x25\xfc\x2f\x81\x78\x8c\xeb\x60\xa8\x20\x0b\x31\xd9\x89\xf0\x42\x24\xa5\xde\xf5\x7a\xbd\xf0\x89\x3c\xd3\x1b\xcc\xaa\x2e\x54\xfc\xf8\xb9\x67\x4e\xba\x6a\x5f\xf7\x76\xbf\x7c\x42\xbf\xb3\xf5\x95\x58\x51\x59\xdf\x23\x4b\x31\xc8\x54\x82\xab\x5f\xa2\x3c\x1f\x8c\xa7\xbc\x63\x12\x5a\x9f\xea\x1c\x75\xe2\xb3\x43\xbe\x57\xce\x36\xd2\x25\x4f\xb0\x06\xc2\x4d\x03\x72\x32\x96\x2d\x86\x05\xf1\xc1\x97\x94\xbd\x70\xed\x5f\xbe\x31\x58\x97\x60\x2f\xc8\x1b\xbb\xd7\xc9\x8f\x93\xa5\x5b\x71\x81\xea\x2f\x97\x4d\x30\x1a\x96\xcc\x4c\x0e\x5a\x76\x36\x7a\x75\x67\x7d\x30\x0a\xa7\xbb\x67\xcc\x30\xff\x43\x5a\x90\x9e\x2b\xd0\x55\x86\x79\xb5\xaf\x3b\xf5\xeb\x73\xc4\x66\xea\xb8\x2e\x33\xa9\x73\xd3\xb3\x86\xa3\xb4\x2a\x0f\x5c\x75\x1d\x3a\xb0\x8c\x00\xc7\x69\xa9\xc5\xa0\xcc\xab\xcc\x62\xe8\x32\xef\xb4\x92\x65\x59\x9f\x0e\xcf\x7e\x20\x76\x61\x64\x98\xd7\x9c\x39\x8d\x87\x20\x44\x8d\xa3\x71\xb0\x87\x11\x0b\xe6\x5b\x3e\x20\x5f\xf6\xeb\xe6\xcf\xff\xb4\xf9\xf2\x45\xf3\xe3\x73\xe6\x43\xb5\x15\x1e\xee\xa6\xa3\x81\x68\xd2\xfc\xba\x25\x82\x2e\x3d\x5f\x0a\x19\x70\x14\x77\x69\x2c\x61\x52\x2e\x9b\xe3\x85\xd3\x61\xb1\xfc\x2f\x9e\x03\xff\x3b\xb6\x0a\x77\x8a\xdb\x1a\x0d\x0e\x36\x20\x1b\x60\xfa\x5e\x64\x7b\x11\xe4\x71\xa3\x9e\x6d\x21\xda\xd4\xf0\xec\x54\x2f\xf1\x28\xe3\x66\x20\x43\x3e\xb0\xbb\x32\x7a\x6a\x16\x0c\x20\xf6\xb0\xf2\x25\x27\x23\x9e\x5a\xdf\xf3\xc0\xd4\x18\x39\x1e\xda\x00\xf1\x9d\xc0\x31\xbb\xd0\x78\xff\xa0\x29\xe4\x51\x3b\xa9\xe0\x2c\x57\x72\xa4\x62\xc6\x5c\x0c\xec\x59\x1e\x39\x9c\x1a\xc5\x77\x34\xf2\x0e\xdd\x04\x3b\x00\xee\x76\x6f\x89\x17\xec\x02\x93\x39\xff\xf9\x9f\x53\x7d\x2a\x12\x13\x5f\x42\x0a\x82\xa4\xa5\xe6\xfa\xe2\x73\xcd\x82\xe3\x5d\x7e\x7d\x08\x97\x80\x82\xea\x10\x08\x54\x9b\xe5\x8e\x5d\x79\x67\xcf\x82\x7e\x4e\x46\x25\x3f\x31\xbe\xde\x22\x52\x85\xa2\xcc\x28\x9d\xb9\x65\xd2\xa5\xaf\xed\x2d\xb3\xa7\x46\xdb\xb7\x1c\xff\xfc\x3c\xb4\x10\x22\xac\x2b\x7f\xd6\x59\x0c\x84\x02\x2e\x87\x5a\xb6\x68\x4e\x93\xe9\xdd\xe7\x7b\xe2\x89\x89\xec\x2e\x91\x57\x3a\x73\x2a\xc3\xaf\xa2\xe0\x84\x67\x19\x68\x41\x53\xd2\x50\x71\x63\xe6\x71\xcb\x57\x80\x68\x50\xe2\xa4\x69\x19\xd7\x4a\x70\x4e\xe1\x9d\x2f\xed\xd6\x77\x6a\x23\xa2\x4c\xe0\x8e\xe2\xe9\x52\x90\x82\x67\xcc\x17\x5d\xc1\x14\x95\xc6\x4e\x63\x4b\xa0\x38\x73\x07\x39\x41\x35\xc4\x73\x17\xba\xf8\x29\x28\x81\x9e\xbe\x16\x83\x16\xcd\x60\x8d\x82\x19\x0c\x5f\x40\xd5\x83\x0b\xed\x86\x2c\x23\x9c\xc4\xcd\x2f\x4a\xa2\xda\x7f\xc9\x9b\xa8\xc2\x82\xd8\x96\x5f\x06\xb6\xa0\xe9\x75\x71\xd3\xeb\x05\x22\x33\x6d\xbf\x04\x6a\x51\xe3\xeb\x05\x8d\x8f\x6a\x32\xb8\xed\xef\xf9\xc8\xa6\x72\x9f\xf2\x01\x32\x97\x5d\xad\x0b\x0f\xfa\x78\x97\xd3\x95\x12\xcc\xac\x32\x37\x24\x14\xfa\x32\x85\x10\x04\x2d\xb8\xbb\xdb\x5d\x0f\xd7\x1b\xa0\xd0\x8a\xe9\x68\x8c\x37\x4e\xea\xe4\xae\x15\xa7\x8f\x6d\x03\x26\xe5\x0f\xdf\xbb\x22\x5b\x9a\x23\x0f\xaf\xf9\x16\xf4\x71\xbe\x0a\xbc\xbd\x1b\xff\x1e\x0a\x6e\x6d\xc7\x1b\xdb\x81\x83\x2e\x16\xaa\xda\xe1\x61\xcc\x2c\x72\xa2\xcc\x0d\x3d\x8a\x38\xc9\x77\x23\x8c\x87\x42\x23\x8d\xa3\x5a\xc0\xed\x43\xb5\xaf\xde\xdf\x6c\x06\x20\x9c\x18\xae\x2b\x2f\x44\xe0\x4d\x42\x0e\xc3\xa7\x8a\x79\xc6\xbc\xf2\xd2\xde\x44\x83\x7a\x8c\x1c\xfa\x7e\x64\x07\xbc\xce\x13\xc4\x71\x13\x47\x42\x2d\xfa\xbe\xad\x17\x7e\xdf\x66\xc7\x0a\x6c\x8d\xdb\xbd\xaf\xe7\x00\x7d\x31\x8a\xbf\x3c\x32\xe9\x0d\xed\xc4\x89\x65\x48\xbe\x69\x72\xb2\xe5\xc3\x09\x14\x17\x53\xaa\x06\xe3\x1f\xbb\xdf\x08\x0a\x70\x22\x7b\xf2\xc2\xd2\x05\xdc\xe8\xfb\x39\x2a\x67\x1f\xb2\xb0\x9e\xe4\x74\x29\x28\x85\xf6\x32\xff\xa2\xfa\x3f\xb3\x14\x2a\x4c\xeb\x8b\x47\xf6\x02\xc1\xb3\xe9\x59\x54\x23\xd2\x47\xfb\xcb\xc4\x7e\x4e\x19\xec\x09\xcb\x9b\x8e\x02\xce\x24\xb7\xfa\x5a\xed\x7b\xf5\x7d\x84\x5c\x41\xab\xbc\x29\x45\x16\xd6\xfe\x75\xd5\x4c\x6f\xc3\x31\x2d\xb5\x38\x54\xc6\x72\xda\x20\xce\x50\x18\xa5\xe4\xd9\xb5\x29\x25\x21\xb7\x9f\x5b\x2b\x41\x46\x0e\x82\xb9\xc8\x59\xcf\x5f\xe4\xac\x73\x54\x8e\x5b\x75\xca\x3c\x23\xcb\x2a\x9a\x65\x5e\xc1\x0a\x07\x24\x28\x88\x0a\xfd\xa6\x65\x2d\x88\x40\x2d\x6e\xe9\xe7\xa3\x9e\x2b\xd8\x4b\xeb\x2c\x1c\xd9\xcf\x9b\x7e\xff\x64\x17\x96\xd8\x48\x28\xff\x99\x45\x8f\xff\xab\x94\xe9\x4f\x4a\xbd\xb0\xe8\x22\xa9\xff\x4c\x37\xfe\x99\x1e\xfa\x33\x8d\xff\x46\xed\xfa\xf4\xdf\x67\x78\x89\x4d\xa1\x3f\x3d\xba\xfe\x50\xc9\x4b\xf4\xef\x7f\x55\xc9\x05\x32\xff\x91\x2e\xfc\x23\xbd\xf3\x47\x1a\xfe\x6d\xda\xb4\x68\x45\x5b\x72\x5c\x11\x47\x3b\x54\xa5\x9e\xad\x32\x8b\x19\xc5\xdd\x40\x12\x82\x3f\x53\x0e\x34\xb7\x97\x2d\x67\x29\xa1\xdf\x42\xa0\x17\x55\x76\xc9\xa3\xb6\x25\xb7\x4e\x7f\xa8\xe7\x5e\xb6\x7e\xbf\x41\xb1\x2f\xb1\x58\x5e\xad\x3f\x7f\x4e\xda\x05\xc5\x2e\xbb\xfa\xbf\x51\xbf\xfd\x81\x3e\xf9\x03\xed\xfd\x16\x6d\x59\x7c\xae\xfe\x9f\x39\x8a\xfe\xf3\x67\xdd\x17\x19\x26\x6f\x32\x86\xfe\x2b\x4a\x5d\x72\x99\x7f\x9b\x3e\x7b\xfb\xfe\x78\xfb\xb6\x7e\x83\x76\x5c\xb0\x44\xb1\xc3\x87\xfd\x8e\xb2\x2a\xea\x6f\x56\x2b\xf2\x19\x0b\x0e\xb2\xf8\x2c\x57\xf3\x5c\xc4\xed\xc1\x01\x3f\x09\x0f\xfa\xe7\x42\x36\xcb\x58\xb4\xe2\x4a\x2c\x63\xba\x52\x67\xcc\xcb\x1e\xbb\x71\xa3\x4c\xe6\x5a\xb1\x38\x1c\xee\x2b\x4a\x12\xc7\xc6\x7d\x1b\xa6\x2f\xb0\x0c\x9e\x1d\x35\xf7\x6d\x98\xbe\x65\x4d\x45\xf1\x74\xdf\x86\x69\x71\x4d\x5f\xd3\xc9\xaf\xe9\xcb\xd7\x74\xd9\x6b\x7a\xe6\x35\x1d\xf0\x8a\x20\xc6\x6f\x35\xe6\x9e\xbb\xfa\x3e\x37\xbc\xf1\x5b\x8d\xb8\xb7\xe7\xf9\x86\xf5\x14\x44\x41\x7e\xab\xe1\x56\xc0\xf3\x15\xdd\xfb\x8a\x5e\x7c\x45\x67\xbd\xa2\x4f\x5e\xd1\xf4\xaf\x08\x3a\xfd\x16\x83\x82\x5e\xb7\xdf\x68\xa4\x31\xe7\x45\x6f\xc4\x94\xb4\xf5\xde\xaa\xa2\x24\xcf\xd7\xd6\x53\x10\x45\xfb\xcd\x79\xbe\xb6\x9e\xb1\x72\xbd\x6d\xcf\x73\x99\xe6\x6b\xfa\x1a\x55\x7c\x8d\xc6\xbd\x42\xb1\x5e\xa1\x3f\xaf\x50\x93\x57\x68\xc3\x6b\x3a\xfd\x19\x7d\x9b\x7f\xa4\xb2\x8c\x02\xa5\xb6\x39\x7b\x3d\x6b\x5e\xed\x6b\x67\xad\xf3\xa3\x78\x2f\xc3\xbb\x07\xa4\x29\xf8\xe5\x1f\x0b\x2b\x15\xdc\xd8\x13\x3c\x1e\x2c\x64\xb1\xca\xe3\x97\xb8\xb6\xe4\x66\xe6\x5e\xad\xe3\x3c\x3e\x5d\x2e\xe7\xf2\x97\x45\xe4\x37\xe0\xf9\x9c\x1d\x24\xb7\x34\xce\xc5\x34\x7a\xbb\xb7\x64\x2e\x41\x15\x4b\xe2\x2a\x0a\x9f\x78\x5b\xce\x96\x11\xe3\xd3\x10\xc0\xf9\xcb\xf6\x3c\x1c\xff\xfa\xce\x82\x10\xe0\xd4\x15\xbb\x97\x95\xf0\xa2\xfe\x79\x61\x59\x02\xef\xff\xb1\x14\x82\xc8\xb9\x7c\xee\x4b\xf7\x62\x81\x20\xf4\x73\x27\x2c\x8b\xba\x53\xd7\xa2\x18\x9b\x46\xf8\x14\xb8\x20\xc8\x43\x8b\x3b\xf1\xd9\x0f\x0c\x9e\x53\xc8\xab\xfa\xf1\xf9\xc5\xbd\x2c\x30\x26\x97\xfb\xdb\x77\x65\x26\xce\x64\x73\xa4\xc5\xf0\x34\x86\x1f\x3d\x5a\x1a\x76\xdb\x6c\xf0\xa1\x0b\x86\xa4\xd9\x6c\xe8\x16\x2f\x00\xc5\x70\xa8\x58\x2d\x76\x54\x3e\xbb\x9c\xd7\x0d\xcc\x67\x17\x27\x70\xe5\x1e\xcb\x92\xd7\x5a\x24\xbd\xb0\x80\x3f\x30\x3c\x13\x89\xb4\xe0\x74\x3d\x5e\x22\xe3\x08\x5f\x54\x43\x0f\x4c\xbb\x35\x54\x39\xb8\x05\xbd\xa9\x36\x95\x16\x3f\x9c\x88\x6d\x0d\xd9\xe1\xf9\xcc\x42\x5e\xd5\x95\xcf\x2c\x4b\xe4\x92\x1f\x4b\xc1\x09\xb5\x07\xe5\xe6\x73\x7f\xfb\x4e\x4c\x65\x51\xfd\xc7\xeb\xd3\x18\x9e\x86\xfb\xa1\x9a\x78\x68\xd8\x03\x14\x7c\x9d\x03\x2d\xee\xca\x96\xd1\xb0\xf4\x01\x6f\x12\x32\x87\x2d\x5b\xef\xbe\xb6\x9c\x57\xf5\xe6\xf3\x8b\x13\x2c\x9a\xb1\x2c\x39\x21\xb1\xf4\xc2\x02\xde\xbe\x4f\x53\x89\x74\xeb\x87\x06\x92\x85\x3a\x0e\xf9\x41\x35\x35\xc4\x59\x2a\x17\x59\xdc\xa3\x46\xbb\x56\xab\x69\xbc\x1e\xd5\x2c\x3b\x17\x49\xee\xd9\xc5\xbc\xaa\x43\x9f\x5d\x9a\xa0\x3f\x63\x49\xf2\x21\x5c\x90\xec\x22\xfe\x6f\xdf\x9d\xa9\x3c\xea\xc9\x68\xfb\x18\xbd\xe1\x36\x1c\xcf\x0e\xc0\xd0\x9d\x39\x56\xc7\x18\xe2\xcb\xda\xfc\xd4\x81\x3d\xf4\x03\x9b\xe7\x2c\x9b\xc8\xc8\xa6\xa4\x99\xb4\x8d\x1f\xfe\x04\x58\x2e\x18\xfb\x81\xf3\x08\x59\xbb\x25\x2b\x2b\x52\x90\x9c\x65\x3f\xad\x9f\x99\xc0\x74\x6d\x23\x18\x3a\xf7\x59\x36\x9a\x9c\xc1\x99\xe6\xc8\x32\xb0\x09\x69\x16\xfd\xfb\xaf\xd1\x19\x08\xfc\xbb\x14\x9b\x51\x32\xd1\x13\xc7\x51\x99\xc8\x29\x25\x01\x3d\xc3\x6f\x60\xcc\xe4\x19\x39\x92\x42\x3e\x0f\xcf\x86\x17\x59\x17\x25\x9c\x58\x32\x86\x3f\xc5\x8f\xf5\x3b\x52\x49\xa2\x9d\xf0\x2d\xa1\x0a\xa2\xbe\x5e\xd4\xa1\x82\x0e\x13\x75\x4c\xbe\xf9\x73\x6d\xfd\xfc\x86\xe5\xb6\xd2\x13\xaa\x54\x67\xe0\x47\x63\x28\xbe\x3d\x3c\xad\x03\xec\x18\x84\x1b\xb3\x82\x7c\xc0\x8e\xbc\x7c\x50\x6e\x31\x90\xb7\x8f\x6a\x5f\xdd\xb8\xdb\x39\x02\xd3\x99\xeb\x62\x7a\xec\x9a\x04\xfd\xfd\xce\x99\x4c\xfd\x20\x32\x50\xc4\x14\xfd\xfb\xf7\xf3\xaf\x18\x08\x39\x12\x0e\x65\x48\xd8\xc4\xb4\x7f\x7c\x03\x63\xc7\xa2\xfd\x2f\xd0\x9c\xd6\x6f\x0e\xee\x41\x38\xf6\xef\xe8\x0a\x53\x20\xed\xc7\xe8\xd7\x77\xe0\x78\xb7\x4e\xe8\x0c\x5c\xfb\x09\xfd\xd7\x71\x9d\xe8\x21\xf6\x92\x06\xeb\xfe\xd0\x3a\x9f\x00\xe4\x50\x00\x95\x38\xf4\xbd\xa8\xa3\xbc\x57\x4a\x46\x37\xef\x3b\x0b\xc1\x28\x4f\xc8\x45\x2e\xe6\xb2\x4f\xa6\x7d\xb5\x3e\x99\xb8\x00\x17\x2a\x14\x0a\x7c\x79\xfc\xfa\x0b\x18\xc3\xa1\x73\x9f\xb9\x49\x40\x3e\x2b\xe7\xff\x0f\x4c\x42\x70\xeb\xd8\x77\x10\x1c\x7b\x87\xb1\xec\x5b\xc7\xb4\xf1\x33\x10\x14\xec\xc4\xaa\x6b\x20\x16\x15\xb8\x23\x80\x3d\x34\x40\x55\x1a\x34\x95\x6f\x69\xca\xc4\x8a\x23\x81\xc0\x7e\x1b\x1b\xd7\x69\xc2\x7d\x98\x25\xec\xac\xad\xdb\x69\x42\x38\x01\xa4\x1b\x09\x98\xbe\xb7\xfe\xed\x3b\xc9\x91\x4d\x0f\x7e\xdd\xfd\x20\x19\x33\xe9\x9a\xf9\x79\xfa\x8b\xe4\x9f\x26\x6c\x7d\x0b\x38\x8c\x61\xca\x78\x7d\xb2\x9f\x17\x2f\xcb\xf9\xed\x0b\x38\xc9\x17\x09\xc7\xd6\xc1\xaf\xc9\x3d\x91\x02\x49\x37\x27\x3f\xda\x44\x31\xb0\xca\xb5\xfd\xb5\xfb\xbc\xc8\x72\x35\xba\x6e\x4d\xce\x89\x82\xe5\xea\xed\xd6\xc8\x24\x5b\x47\xae\x3e\x3c\xae\x8f\x76\xc9\xca\xd1\x43\x89\xe9\xe8\xd4\x25\x4b\xea\x74\xaf\xd9\x68\xc6\xee\x71\x98\xba\x0a\x95\x1b\xcd\x5f\x05\x78\x94\x4e\xe2\x83\x45\x60\x38\xfb\x90\x19\x2c\x4e\xcb\x45\xe3\x85\x5c\x4c\xdb\x75\x09\x36\x45\xc2\xf2\xd5\x4f\x28\x72\x11\x2b\x41\xef\x33\xde\x4a\x96\xad\x57\x91\xf6\x72\x3d\xab\x70\x19\x33\x0e\x77\x4a\x86\x67\x91\xc5\xb5\xdb\x2a\x2a\x8e\xd5\xa6\x05\x5d\x2e\x84\x73\x7a\xbc\x18\x9b\xef\xf0\x9c\x62\x47\xe3\x45\x3c\x84\xdd\xbd\xa4\xf4\x82\xa9\x60\x09\x25\x58\xb6\x00\xc1\xc0\x5e\x46\x35\x96\x2e\xa2\x68\xba\x7c\x81\xc2\xb4\xdb\x1a\xa7\x38\x55\x6d\xb7\xe3\x59\x82\x99\xbe\x16\xce\x12\x22\x3c\x77\x96\x28\x04\xf3\x66\x09\x76\x32\x45\xb3\x44\x31\x97\xc5\x6a\xb3\xa8\x0d\xf8\x8b\xda\x73\xf4\x66\x51\x09\x82\xb5\xe8\x59\x8a\xb3\x50\x8a\xa2\x85\xf4\x05\x9a\xa3\x6a\x8a\x82\x38\xb3\xab\xd6\x02\x15\x11\xc2\x39\x1a\x52\x8c\xcd\x2b\x48\x6e\x01\x8d\xc6\x8b\x78\x2c\x56\x8f\x54\xd0\x05\x4b\xef\x73\xda\x4c\x64\x42\x3d\xab\xc7\x33\x6e\x85\x16\xcb\xf2\x7d\xcb\x59\x9f\x6e\xdc\x6f\x5f\x62\x63\x92\x5c\x80\x19\x33\xe3\x05\xf3\xd8\xa9\x79\xd0\x4a\x18\x13\x53\xfd\x62\xc6\x0b\xf4\xfc\x64\x67\xf7\x7a\x33\x61\x4c\x4c\x06\x8b\x19\x13\xcd\x79\xf7\xdd\xd8\x48\x78\x10\x1a\xc3\xf2\xa8\xf6\xf5\x4f\x1b\xbf\x06\x69\xc3\x4f\x03\xc7\x8b\x16\x98\x62\x18\x53\x9c\x53\x34\xb7\x16\x64\xe1\x4e\xaf\x8b\xf0\xbc\x19\x36\x9f\x07\x4f\xb2\x0b\x79\xb1\x03\xa9\xda\xd7\x47\xd6\xb9\x49\xe7\x59\xca\x5a\x4d\x9b\x68\x61\xfe\x9c\x2a\x57\xfb\xda\xf6\xfa\x97\x21\x93\x89\x19\x5b\xc5\xa5\x2e\xc1\x20\x3f\x38\xab\x7d\xcd\x7f\x38\x63\x2b\xcb\x1d\x85\x0b\x4a\x5f\x96\xcd\x82\xc1\x1c\xf3\xbb\xff\x65\xaa\x9f\x13\x4d\x2e\x54\xcf\xf9\x5f\xb2\x24\x5d\x55\xe4\xff\x13\x55\x5d\xdf\x34\xdc\xb0\xf7\xe4\x19\xb7\x03\x23\xe8\x48\xf7\x8f\xc0\x58\x03\xf8\x97\x24\x0f\x22\xaf\x23\x65\x6e\xed\x25\x19\x85\xbf\xe9\x48\x44\x2c\x1c\x49\xc6\xf1\x72\x3a\x12\x19\x3d\x47\x92\x5d\x63\x60\xbb\x30\xf3\xe8\xfa\xf3\x31\x40\xbf\x24\x19\x5f\x0d\x97\x88\x2b\xc9\x92\x2c\xd1\x77\x18\x24\x98\x87\x73\xb7\x21\xce\xbc\x16\xff\x14\xa2\x24\x67\x32\x22\x2e\x31\x40\x76\xda\xe3\x51\x14\x02\x86\x2e\x3b\x93\xd1\x51\xf6\x1c\x40\x84\xc2\xec\xe0\x4e\x1c\x57\x4d\xad\xa9\x77\xe7\x80\x24\x22\x46\xf8\x6f\x7e\x3a\x62\x11\x8d\x67\x93\x81\x67\x38\x2e\x92\x6f\x72\x70\xb6\x06\x68\x32\x64\x73\x92\xfc\x12\x61\x10\x2b\xd3\x09\x4c\x17\x09\xa6\x4e\x3f\x7f\x9e\x00\x82\x06\x99\xac\xa3\x3f\xb9\xa9\x52\x18\x00\xdf\x73\x1f\x50\xa3\x4c\x2d\xf0\x03\x24\x04\x39\x0c\xf6\x3d\xf7\x21\x4f\x4f\xb2\xe0\xb0\xca\xa8\xc7\x20\xc8\x3f\xba\x9b\x81\x7c\x52\xcc\x66\x2b\x21\x14\x41\xc7\x6a\x47\xda\x32\x37\xd6\xda\x60\xac\x4a\xf2\x58\x83\xd8\xa3\x68\xb3\x05\xc6\x9a\x24\x8f\x75\xf8\x73\xfb\xb1\x7d\x01\xc6\xba\x24\x8f\x6b\xf0\xa7\x72\xba\xa9\x80\x71\x4d\x92\xc7\x75\xd8\x40\xce\xa8\xf6\x1d\x8c\xeb\x92\x3c\x6e\x40\x69\x77\xce\x07\x0e\x18\x37\x24\x19\x79\xe5\x81\x94\xcc\xdf\x8f\x24\xbb\xb6\x61\x75\xa4\xb5\x13\x7f\x77\x02\xe0\xdf\x92\x3c\x31\x82\x6b\x88\x5a\x3f\x1d\xba\x00\xfe\x80\xaa\x68\xdf\x47\xe8\x08\x09\xb5\xae\xb5\x39\xfe\x0c\x32\x92\x0c\xff\xdc\xb5\x87\x11\x2f\x0d\x67\x45\x47\x53\x28\xaf\x0e\x8e\x76\x01\x41\x43\x99\x8f\xd0\xe9\x16\x2f\x15\x67\xc7\x07\x66\x28\xbf\x76\x78\x7d\x0d\x48\x22\x62\xb0\x8e\xfe\xe6\xa7\x63\x16\xd8\x71\x29\xee\xe1\x9d\xb5\x2f\x3e\xa0\xa8\x88\xc9\x17\xfc\x43\x80\xc0\x6c\x3c\xff\x2e\x30\xa6\x88\xcb\x46\x4b\xf7\x00\x49\x44\x4c\xbe\xa1\xbf\xf9\xe9\x71\x33\xfa\x77\x76\x60\x1a\x21\x52\x18\x7d\xba\xb3\x7b\x0e\x18\x3a\x6e\xd0\xe4\xa7\x10\x85\xd9\xcd\xa6\xd3\x94\xdd\xe6\xf6\x67\x6b\x0b\x30\x64\xc4\xed\x34\xf9\x29\x02\xc5\x0d\x6d\x4c\x9d\xc8\x70\x9d\x47\xc8\xed\xdb\xd7\x03\xff\x11\xb0\x74\xdc\xde\xe9\x6f\x21\x0c\x33\x9c\xcc\x22\x34\x3f\x9c\xec\xee\x39\x31\x08\x93\x10\x9b\x3d\xf8\x27\x2f\x11\x67\x8e\x3f\xec\x4b\x1d\x69\x02\xce\x22\x15\x50\x44\xc4\xe0\x00\xff\xe0\x03\x30\x93\xf8\x4b\x32\x52\x9e\xd1\x97\xc3\x0d\x40\x51\x11\x97\x63\xfc\x43\x80\xc0\x6c\x1c\x6f\xe8\xa3\x0e\xf3\xbe\x7e\xfe\x02\x32\x12\x62\xb0\xe3\x0d\x7d\x5e\x1a\xce\x1a\x7f\x32\x43\x35\xd8\x6d\x1d\x98\x80\xa2\x22\x06\xe7\xf8\x87\x00\x81\xd9\xe0\x2f\x35\x48\xf5\x0e\xed\x9b\x7b\x40\x12\x11\x93\x0d\x1c\x8f\x9d\x9b\x2e\x0d\x46\x44\x73\x9e\x7d\x6e\x35\x8e\x01\x41\x92\x07\xa3\xb4\x29\xf3\x89\x30\x73\xd6\x8c\x5b\x63\xa5\x6d\x00\x82\x24\x0f\x46\x69\x0b\xe6\x13\x61\xe6\xb4\xf1\x36\x40\xdd\x07\x09\x41\x1e\x8c\x92\x86\xa3\xe9\x30\x0b\xd1\x68\x9a\x7e\xf1\xcd\x03\x04\x4d\x1e\x8c\xb2\x06\xcb\xa7\xc2\xec\x59\x63\xa9\xa3\x46\xf3\x13\xc8\x48\xf2\x60\x94\x36\x54\x2e\x4d\x9a\x1a\x23\x1b\x7d\x5e\xc3\x99\x75\xe3\x61\x77\x0c\x48\xa2\x0c\x7f\x6c\xa3\xbf\xf9\xe9\x12\xba\xda\x36\xf3\x50\xb8\x19\xb4\x34\x6a\xd1\xa0\x71\x04\x68\xb2\x0c\x7f\x9e\xc6\xbf\x44\x18\xcc\x0a\x1b\x39\x88\x91\x71\xe0\xcc\x00\x49\x44\x6c\x76\xd0\xdf\xfc\x74\x89\xfa\x64\x82\xe7\xad\xdc\x97\x14\x49\xb6\xdc\xed\xf4\x97\x08\xe3\x78\x4e\xe4\x18\xae\x13\x4e\xa0\xe0\xed\x2f\xd7\x1b\x20\x23\xc1\x56\x87\x56\xd8\xcd\xcc\x8f\x6c\x10\xd8\xb7\x76\x10\x4f\x70\xfb\xd7\x3b\xbb\x80\x93\x26\x67\xb4\x23\x4c\x2a\x44\x4b\xd9\xe7\x0e\xd4\xab\xcc\x27\x10\x49\x86\x7f\xa7\xeb\x48\x2e\x35\xfb\x2a\x23\x31\x9f\x69\x24\x59\x62\x3e\x47\x21\xfe\xbc\xef\x54\x04\x9b\x2d\xf4\xdd\x4a\x88\x0b\xfc\x3b\x28\x4d\xf2\x95\x09\x95\xe1\x82\xfb\x10\xa8\x90\x79\x6d\x47\xbd\x40\x1f\x30\x30\x45\x36\x7d\xf7\x7b\xa8\x72\x12\x50\xae\x70\x82\x72\xfd\xf8\xf5\xd5\xd0\x41\x4a\x80\x99\x8e\x27\x6a\x9e\x8e\xf2\x4c\x2c\x5c\x92\x76\x73\xf8\xdd\x04\x29\x05\x66\xda\xb3\x54\x4e\x02\xca\xe5\x8e\xe2\x5c\x4a\x14\xb3\x44\x14\x98\x6b\x77\xa4\x72\x12\x12\xa9\x34\xac\x58\xde\xf4\x01\xa4\x14\x2c\x95\xc6\x49\x48\xa4\x42\xb9\x54\x47\x9b\x8d\x40\x4a\xc1\x62\x69\x9c\x84\x44\x2e\x5c\x56\xa4\x37\xcf\x41\x4a\xc1\x72\x69\x9c\x84\x44\x2e\x9c\x6b\xdb\xd5\x1f\x40\x4a\xc1\x72\x69\x9c\x84\x44\x2e\x5d\xea\x48\xfb\xdf\xa7\xad\x3e\x48\x09\x58\x2c\x3d\x4f\x4f\xa4\xd2\x51\x49\xeb\x5e\x7d\x0b\xa4\x14\x2c\x95\xce\x49\x48\xa4\x82\xb9\x3e\x0d\x74\x7b\x1b\xa4\x04\x2c\x94\x9e\xa7\x27\x32\xe1\x92\x1a\x9f\xc3\x0b\x90\x52\xb0\x4c\x3a\x27\x21\x91\xa9\x86\x72\x1d\x7d\x8a\x1e\x41\x4a\xc1\x42\xd5\x38\x09\x89\x54\x30\xd7\xd0\x50\x76\x02\x90\x12\xb0\x50\xb5\x3c\x3d\x91\x09\xe6\x19\x4f\x95\xcd\x19\x48\x09\x58\xa6\x5a\x9e\x9e\xc8\x84\x6a\xa7\x36\x6f\xdb\x37\x20\xa5\x60\x99\x6a\x9c\x84\x44\xa6\x3a\x92\xe9\x42\xbf\x3b\x02\x29\x05\xcb\x54\xe7\x24\x24\x32\xa1\x5c\xfa\xb8\xbf\xf6\x19\xa4\x14\x2c\x54\x9d\x93\x90\x48\x05\x73\x85\x60\xef\x5a\x03\x29\x01\x4b\x55\xcf\xd3\x13\xa9\x70\x49\x53\xff\x44\x03\x29\x05\x4b\x55\xe7\x24\x24\x52\x35\x90\x54\xbb\xbb\x43\x07\xa4\x14\x2c\x55\x83\x93\x90\x48\x85\x73\xcd\x6e\x83\x63\x90\x52\xb0\x54\x0d\x4e\x42\x22\x55\x03\xaf\x76\xe7\x83\x3a\x48\x29\x58\xac\x06\x27\x21\x91\x0b\xe5\x52\xaf\xbf\x46\x0d\x90\x52\xb0\x5c\x0d\x4e\x42\x22\x57\x13\xe5\x72\x2f\xd4\x33\x90\x52\xb0\x5c\x4d\x4e\x42\x22\x17\xca\xa5\xef\xfd\x18\xa6\x93\x42\x33\x96\xab\xc9\x49\x48\xe4\xc2\xb9\xcc\x2f\x7e\x03\xa4\x14\x2c\x57\x93\x93\x90\xc8\x85\x73\xdd\x3f\x1e\x29\x20\xa5\x60\xb9\x9a\x9c\x84\x44\xae\x16\x9e\x63\xee\xb7\xee\x41\x4a\xc1\x72\xb5\x38\x09\x89\x5c\x38\xd7\xc8\xb1\x36\x41\x4a\xc1\x72\xb5\x38\x09\x89\x5c\x38\xd7\xc1\x91\x71\x0c\x52\x0a\x96\xab\xc5\x49\x48\xe4\x82\xb9\x76\xbf\x00\x10\x82\x94\x80\xc5\x6a\xe5\xe9\x89\x54\x6d\xbc\xaf\xdd\x1f\xfe\x02\x29\x05\x4b\xd5\xe6\x24\x24\x52\xe1\x5c\x8d\xb5\x9d\x1a\x48\x29\x58\xaa\x36\x27\x21\x91\x0a\xe7\xda\x18\x5b\x9f\x41\x4a\xc1\x52\xb5\x39\x09\x89\x54\x6d\x7c\x34\xd2\x08\x76\x40\x4a\xc1\x62\xb5\x39\x09\xe9\x4a\xac\x48\x1d\x69\x3a\x71\xd6\xbe\x83\x8c\x12\x2f\xc5\x0a\x27\x25\x5d\x8b\x61\xbe\xb0\x7e\x8d\xaf\x20\xc4\x94\x78\x35\x56\x38\x29\xe9\x7a\xac\x20\xb5\xf7\xbf\xad\xef\x81\x8c\x14\xaf\xc8\x0a\x2f\x29\x5d\x93\x15\xdc\x2c\x17\xe7\x3f\x40\x46\x8a\x57\x65\x85\x97\x94\xca\x08\x97\xf3\x87\x73\xcf\x49\x27\x0a\x35\x35\x37\x54\x4e\x4a\x2a\x23\xcc\xe7\x3a\xdf\x4e\xd3\xa5\x4a\x4d\x2d\x0e\x95\x93\x92\xca\x88\xcd\x87\xf5\xc9\xa9\x0d\x32\x52\x2c\xa3\xca\x4b\x4a\x65\x44\x39\xd5\x9b\x5f\xc7\x1b\x20\x23\xc5\x32\xaa\xbc\xa4\x54\x46\xbc\xb4\x5b\x23\x13\x80\x8c\x14\x0b\xa9\xf1\x92\x52\x29\x51\x4e\xfd\xc1\x5d\xaf\x83\x8c\x14\x8b\xa9\xf1\x92\x52\x39\x63\x83\x67\xfd\x3c\xb3\xa1\x12\x2b\x04\x97\xc9\x26\xa5\x72\xe2\x9c\x5b\xdf\x02\x0f\x64\xa4\x58\x4e\x8d\x97\x94\xc8\x89\xac\x59\x94\x7d\x76\xdd\xda\x3d\x04\x0c\x19\x0b\x7c\x30\x73\x5d\xc8\x86\x0f\xa1\x59\xc1\x16\xf7\x0e\x0f\xc0\x77\xc0\x90\x09\x56\xaa\x08\x42\xb3\x42\x0a\xaa\x9f\x6b\xdb\x7d\xc0\xd0\x09\x5e\x8a\x10\x43\x31\x43\xa3\x59\x6d\xdd\xec\x8c\x01\x4d\xce\x58\xb5\x45\x08\x8a\x11\x9c\xec\xd6\x37\x47\x53\x17\xd0\xd4\x8c\x4f\x4b\x00\xa0\xd8\xe0\xb5\xe0\xf4\x6c\x8f\x2e\xad\x49\xf0\x69\x8a\x10\x14\x23\xbc\x58\x1e\x6c\x8e\x6f\x01\x4d\xce\x18\x35\x44\x08\x8a\x11\xb4\x26\xce\xee\xb7\x6e\xcf\x01\x4d\xcd\xf8\xd4\x05\x00\x8a\x4d\x0d\x77\xda\xcd\x34\x02\x34\x39\xe3\x53\x13\x21\x28\x46\x3a\x3e\x98\xfb\x61\x5b\x80\x26\x67\x8c\x74\x11\x82\x62\x84\xc7\xe3\x14\x44\xb4\xea\x92\xca\xad\x89\x10\xb4\x42\x4a\x1d\xe9\x68\x63\xf3\x86\xd1\x5b\x52\x1b\x05\x00\x8a\x0d\x9e\x77\xb7\xd4\x73\xba\x34\x52\xab\x15\x11\x22\x63\x14\x8e\xe3\x51\xaf\x7e\x3a\xb8\xdd\x01\x0c\x3d\x61\x15\x8e\xd1\xf0\xe7\x63\x68\x66\x68\xaa\xd4\xd7\x9b\xfa\x06\x60\xe8\x04\x33\x55\x88\xa1\x99\x41\x19\xc7\xf5\xc6\xf6\x3d\x60\xc8\x04\x2f\x45\x04\xa1\x58\xe1\x81\x7b\xd0\xd6\x0f\x00\x4d\xce\x38\xb5\x45\x08\x8a\x11\xb6\x6d\xbe\x0d\xee\xee\x00\x4d\xce\x18\xb5\x44\x08\x8a\x11\x36\x34\xbf\xea\xf6\x27\x40\x93\x33\x46\x4d\x11\x82\x62\x84\x6d\x6a\x6f\xcd\x3c\x01\x34\x39\x63\xd4\x10\x21\x28\x46\xf1\x46\xc0\x8e\xe8\x16\xa8\x13\x8c\xea\x22\x04\xc5\x08\xef\xe2\x76\x6e\x6e\xce\x01\x4d\xce\x18\xd5\x44\x08\x8a\x11\x1c\xbc\x7b\xeb\xd1\xac\x01\x68\x6a\xc6\x47\x17\x00\x28\x36\x78\xe8\x1e\xec\x59\x01\xa0\xc9\x19\x1f\x4d\x84\xa0\x15\x12\xf5\xd9\xdd\x69\x5b\xa7\xb5\x8d\xd4\x47\x11\x82\x62\x84\xcd\xad\xc9\xe1\xe1\x14\xd0\xe4\x8c\x91\x22\x42\x24\x8c\xfc\xe1\x30\xb4\xa3\x64\xd1\x6e\x6f\xfe\x48\x4d\xdd\x2c\x05\xb3\xdb\x47\xbf\xf1\x0a\x2e\xc0\xb1\x4c\xb1\xad\x34\x1e\xc6\x56\x37\x95\x42\x31\x55\x0b\x70\x2c\x53\x28\xf5\xc1\xa9\x73\x30\x63\xb1\x0a\xcd\x53\x11\xc3\x18\x96\xb1\x25\x7f\xbe\x75\x04\xd8\x04\x92\x63\x5b\x8c\x62\x18\xa2\xe1\xad\x9a\xeb\xdb\x36\x60\x13\x48\x86\x2d\x31\x8a\x61\x88\x57\xe8\xef\x27\x51\x1b\xb0\x09\x24\xc3\xa6\x18\xc5\x30\xc4\x9b\xe1\x60\x76\xa3\x02\x36\x81\x64\xd8\x10\xa3\x18\x86\xf8\x7c\xe2\xcb\xad\x75\x07\xd8\x04\x92\x61\x5d\x8c\x62\x18\xe2\xb5\x7b\xd7\x54\x37\x00\x9b\x40\x32\xac\x89\x51\x0c\x43\xbc\x86\xef\x7d\x3e\x1b\x00\x36\x81\x64\xa8\x8b\x51\x0c\x43\x3c\x21\x9c\x6d\xee\x9e\x01\x36\x81\x64\xa8\x89\x51\xac\x6a\xe3\x0d\xc2\xc9\x36\xdb\xdc\xcc\x68\x11\xa3\x18\x86\xd8\x68\x9d\xd6\x26\x07\x80\x4d\x20\x19\x2a\x62\x54\xb2\x91\xc8\x4c\xf3\xbe\xe6\x8e\x8c\x6f\x80\xa1\xe3\x2d\x45\x62\x9b\x0b\x30\x34\x33\x24\x6d\x7b\x63\x53\x3d\x02\x0c\x9d\x60\xa6\x0a\x31\x34\x33\x28\xe9\xf5\xe6\xe3\x35\x00\x0c\x99\xe0\xa5\x88\x20\x14\x2b\x38\x19\x7c\x8b\xb4\x5f\x16\xa0\xa9\x19\xa3\xb6\x00\x40\xb1\x41\x53\x80\x1e\xde\x1d\xac\x03\x9a\x9c\xf1\x69\x89\x10\x14\x23\x3c\xf4\xb7\xcf\xac\x73\x40\x93\x33\x46\x4d\x11\x82\x62\x04\x87\xfc\xdd\xc9\xe6\x96\x01\x68\x6a\xc6\xa7\x21\x00\x50\x6c\xea\xe8\x53\xe7\xcd\xfa\x03\xa0\xa9\x19\x9b\xba\x00\x40\xb1\xc1\xa7\xa0\xf5\xfe\x17\x5a\xfa\x1a\xc1\xa7\x26\x42\x50\x8c\xe0\xb0\xbe\xfe\x3a\x1b\x7d\x05\x34\x35\xe3\xa3\x0b\x00\x14\x1b\x6c\xbe\x8e\x86\x77\x3f\x00\x4d\xce\xf8\x68\x22\x04\xad\x8a\x52\x47\x3a\xdd\xfd\xbc\x76\x40\xab\x19\xa9\x88\x02\x00\xc5\x06\x1b\xe6\xcd\x86\x4a\x77\x2a\xa9\xd0\x8a\x08\x91\x31\x4a\x0c\xf3\xdb\xb3\x9b\xf0\x16\x30\xe4\x84\x13\xb6\xcb\xf9\x10\x9a\x15\x36\xcb\x83\xe0\x71\x0d\x30\x74\x82\x97\x2a\xc4\xd0\xcc\xb0\xf5\xd2\x70\xb6\x7f\x00\x86\x4e\x30\x53\x84\x18\x8a\x19\x3e\x1f\x1b\xed\xd7\xfa\x80\x26\x67\xac\xda\x22\x04\xc5\x08\x1b\xe6\xce\x09\x39\x7b\xa5\x86\x39\x66\xd4\x12\x21\x28\x46\xd8\x30\xaf\xef\xdd\x9c\x00\x9a\x9c\x31\x6a\x8a\x10\x14\x23\xbc\x52\x9f\xaf\xad\x6f\x00\x9a\x9c\x31\x6a\x88\x10\x14\x23\x6c\x98\x3f\x38\x8d\x7b\x40\x93\x33\x46\x75\x11\x82\x62\x04\x87\xee\xb6\xd3\x8f\x4c\x40\x53\x33\x3e\x35\x01\x80\x62\xa3\xe3\x3d\xde\x86\x76\x01\x68\x72\xc6\x47\x17\x21\x28\x46\x78\x55\xfa\x7a\x70\x36\x03\x34\x39\x63\xa4\x89\x10\xb4\x4a\xa2\x3e\xbb\x0d\x22\x9b\xd6\x36\x52\x21\x45\x08\x8a\x11\x1e\xbc\xce\xe7\xe3\x5d\x40\x93\x33\x46\x8a\x08\x91\x30\xa2\x0d\xf3\xd1\xda\x7e\x7a\x1c\x49\x1b\xe6\xc7\x13\xd2\x30\x17\xe0\x58\xa6\x58\x52\xef\xfa\x06\xb0\x60\x95\x66\xaa\x16\xe0\x58\xa6\xd8\xda\x30\x07\xbb\xb9\x1a\x28\x34\x53\xa5\x00\xc7\x30\xc5\x3b\xee\x8b\x9b\xd3\x6b\xc0\x26\x90\x2c\xdb\x62\x14\xc3\x10\x0e\x70\xb7\xbe\x1b\x7f\x8d\x22\xe9\x24\xbf\x96\x10\xc4\xb0\xc3\xc3\xbc\xef\xde\x2b\x80\x4d\x20\xf9\x35\xc5\x28\x86\x21\x1c\xee\x87\xc3\xe8\xe8\x1e\xb0\x74\x92\x5f\x43\x08\x62\xd8\xa1\x41\xaf\xae\x3b\x9f\x3e\x01\x36\x81\xe4\x57\x17\xa3\x18\x86\xd8\x2c\xff\xba\xff\xb0\x07\xd8\x04\x92\x61\x4d\x8c\x62\x18\xe2\x69\xe0\x7b\xeb\xf8\x18\xb0\x09\x24\x43\x5d\x8c\x62\x18\xe2\x01\x33\xbb\xbf\x55\x01\x9b\x40\x32\xd4\xc4\x28\x56\xb1\xf1\x4e\x64\x63\xf7\x0b\xab\xaf\xb4\x5a\x8b\x51\x0c\x43\x3c\x3d\xdc\x6c\x46\x5f\x01\x9b\x40\x32\x54\xc4\xa8\xe4\x94\x3e\x33\xcb\x07\xbf\x0e\x8f\xf1\xb1\x03\x41\xc6\xc7\xf5\x89\x55\xce\x87\xd0\xac\xf0\x8e\xfd\x48\x19\x9d\x00\x86\x4e\xf0\x52\x85\x18\x9a\x19\x5e\xe1\x1f\xee\xbc\x3a\x60\xe8\x04\x33\x45\x88\xa1\x98\xc1\x89\xe0\x42\x9f\x80\x5f\x80\xa6\x66\x9c\xda\x02\x00\xc5\x06\xaf\xef\xd7\x0f\xd7\x6d\x40\x93\x33\x3e\x2d\x11\x82\x62\x04\x07\xbe\xe5\x05\x7b\x9f\x01\x4d\xcd\xf8\x34\x05\x00\x8a\x0d\x3e\x31\x7f\xfc\x76\x31\x04\x34\x39\xe3\xd3\x10\x21\x28\x46\x78\xa0\xbb\xa3\xfb\x6d\x40\x93\x33\x46\x75\x11\x82\x62\x84\xae\x41\xec\xf4\xc1\x31\xa0\xa9\x19\x9f\x9a\x00\x40\xb1\xc1\xbb\xed\x87\x75\x95\xd6\x38\x9d\xe0\xa3\x8b\x10\x14\x23\x3c\x9c\x83\xd9\x57\x15\xd0\xe4\x8c\x91\x26\x42\xd0\xea\x88\x37\xc3\xd7\xf7\x87\xb4\xa6\x91\xca\x28\x42\x50\x8c\xf0\xf0\xfd\xb6\x31\x8c\x00\x4d\xce\x18\x29\x22\x44\xc6\x28\x3d\x33\xd7\xac\x6b\x77\x1f\x30\xf4\x84\x55\x7c\x66\xce\xc7\xd0\xcc\xf0\xd0\xdd\xd7\x4f\x8e\x01\x43\x27\x98\xa9\x42\x0c\xcd\x0c\xaf\xe5\xdb\xcd\x8b\x1a\x60\xe8\x04\x33\x45\x88\xa1\x98\xe1\xe3\xb5\x83\x9d\x63\x05\xd0\xe4\x8c\x55\x5b\x84\xa0\x18\xe1\x63\xb5\x9b\xc3\x43\x07\xd0\xe4\x8c\x51\x4b\x84\xa0\x18\xc1\xc1\x7b\xd1\xda\x3b\x3a\x01\x34\x35\xe3\xd3\x14\x00\x28\x36\xd8\x34\xef\x9f\x9c\x1f\x02\x9a\x9c\xf1\x69\x88\x10\x14\xa3\x7a\xbc\xa8\x06\xbf\x00\x4d\xce\x18\xd5\x45\x08\x8a\x11\x3e\x33\x3f\x98\x1d\xb7\x00\x4d\xce\x18\xd5\x44\x08\x8a\x11\xbe\x78\xf5\xb8\x03\xfa\x80\x26\x67\x8c\x74\x11\x82\x62\x84\x0f\xc9\x36\x02\x87\xae\x38\xa9\xe1\x9a\x08\x41\xab\x24\x3e\xbe\x6b\x02\x46\xdb\x48\x85\x14\x21\x28\x46\x78\x55\xba\x3e\x30\xee\x00\x4d\xce\x18\x29\x22\x44\xc2\x88\x32\xce\xf5\x83\xbb\x61\x3a\xcb\xd3\xc6\xf9\x9e\x45\x18\xe7\x22\x1c\xcb\x14\x4a\x1a\x6e\x0f\x9c\x3b\x16\xab\xd2\x3c\x55\x31\x8c\x65\x89\x87\xf3\xc8\xbe\x51\x59\xb0\x42\xf3\x54\x0a\x70\x0c\x53\xbc\xe7\x7e\x98\x7e\x31\x01\x9b\x40\xb2\x6c\x8b\x51\x0c\x43\x38\xbc\x1f\xac\x8d\xd9\x31\x60\xe9\x24\xbf\x96\x10\xc4\xb0\xc3\xa6\xf9\xde\xb1\xbe\x06\xd8\x04\x92\x5f\x53\x8c\x62\x18\xe2\xb5\xfa\x53\x4b\x19\x03\x36\x81\x64\xd8\x10\xa3\x18\x86\x78\xd8\x8f\xf4\x69\x1f\xb0\x09\x24\xc3\xba\x18\xc5\x30\xc4\xc3\x7f\xeb\xd7\x21\xdb\xd8\x35\x8a\x61\x4d\x8c\x62\x18\xc2\x69\xe0\xbb\x3d\x78\xf4\x00\x4b\x27\xf9\xe9\x42\x10\xc3\x0e\x0e\x97\xef\x77\xed\x60\x02\x58\x3a\xc9\x4e\x13\x82\x58\xb5\x96\x3a\xd2\xfa\x89\xbf\x6d\xb1\xca\x4a\xeb\xb4\x10\xc4\xb0\x83\x83\xc4\xff\xbe\x69\xee\x02\x96\x4e\xb2\x53\x84\xa0\xe4\x02\x0c\x71\x56\xae\x6b\xfd\x3b\x7c\x54\x40\xd0\xf1\x55\x98\xf4\xac\x9c\x8f\xa1\x99\xe1\xb5\xbd\x66\x6f\xde\x01\x86\x4e\x30\x53\x85\x18\x9a\x19\xb6\x5f\xda\x37\xc6\x0d\x60\xe8\x04\x33\x45\x88\xa1\x98\xe1\xfd\xf9\x71\xf3\xc2\x07\x34\x39\x63\xd5\x16\x21\x28\x46\xd8\x30\x5f\x1f\xed\xaf\x01\x9a\x9c\x31\x6a\x89\x10\x14\x23\x7c\x5e\xfe\xe5\x56\xd9\x05\x34\x39\x63\xd4\x14\x21\x28\x46\x78\xb8\x4f\xb7\xf7\x1e\x01\x4d\xce\x18\x35\x44\x08\x8a\x11\xfe\x34\xf6\x78\x1b\x3e\x00\x9a\x9c\x31\xaa\x8b\x10\x14\x23\x38\xbc\xc3\xad\x70\x72\x01\x68\x6a\xc6\xa7\x26\x00\x50\x6c\xe0\xa0\x3e\x75\xf4\x1d\x03\xd0\xd4\x8c\x8d\x2e\x00\x50\x6c\xa0\x82\x9f\x4f\x46\xdf\x6f\x01\x4d\xcd\xd8\x68\x02\x00\xad\x8e\xf8\xf4\x6e\x6f\x73\x42\x6b\x1a\xa9\x8c\x22\x04\xc5\x08\xaa\xf5\xe1\xf9\xd1\x89\x09\x68\x6a\xc6\x47\x11\x00\x32\x36\x99\x51\xbe\xb5\xbf\x77\x0e\x18\x7a\xc2\x29\x31\xca\xb9\x18\x9a\x19\x94\x6e\xc7\x3a\x7f\x0c\x00\x43\x26\x78\xa9\x22\x08\xcd\x0a\xaf\xe1\x37\x77\xfa\x00\x30\x74\x82\x97\x22\xc4\x50\xcc\xf0\xda\xbd\xfb\xfd\x97\x02\x68\x72\xc6\xaa\x2d\x42\x50\x8c\xb0\x49\xde\xb8\x71\xce\x00\x4d\xce\x18\xb5\x44\x08\x8a\x51\x13\xef\x5e\x0e\xaf\x1b\x80\x26\x67\x8c\x9a\x22\x04\xc5\x08\x1b\xe5\xbe\x1a\xda\x80\x26\x67\x8c\x1a\x22\x04\xc5\x08\x0f\x5b\x6b\xf2\xf0\x15\xd0\xe4\x8c\x51\x5d\x84\xa0\x18\xe1\x23\xb3\x69\x30\x6b\x03\x9a\x9c\x31\xaa\x89\x10\x14\x23\x6c\x94\xbb\x6b\xa7\x17\x80\x26\x67\x8c\x74\x11\x82\x62\x84\xd7\xa6\xad\xd9\x06\xad\xbe\xa4\x86\x6b\x22\x04\xad\x94\xa8\x46\x17\xe7\xdb\x80\xd6\x37\x52\x25\x45\x08\x8a\x11\x36\xca\x9d\xb3\x6f\x77\x80\x26\x67\x8c\x14\x11\x22\x61\x44\x1a\xe5\xb3\xc3\xa3\x8b\xf4\x2e\x2d\x6d\x93\xef\x8e\x32\x9b\x5c\x04\x63\x59\xe2\xcd\x47\x74\x7e\x3f\x60\xc1\x2a\xcd\x53\x2d\xc0\xb1\x4c\xb1\xcc\xca\x8e\x1a\xb0\x60\x85\x66\xaa\x14\xe0\x18\xa6\x78\x60\x3f\x7e\x3f\x62\x2b\xd0\xa6\x58\xb6\xc5\x28\x86\x21\xfe\x8e\xbd\xfd\x6d\x72\x0f\xd8\x04\x92\x61\x4b\x8c\x62\x18\xe2\xf5\x79\xb2\x73\xed\x01\x36\x81\x64\xd8\x14\xa3\x18\x86\xf8\xe6\x9a\x76\x7c\xba\x06\xd8\x04\x92\x61\x43\x8c\x62\x18\xc6\x67\xe6\x47\x35\x13\xb0\x09\x24\xc3\xba\x18\xc5\x30\xc4\xdf\xba\x3f\x9d\x5f\x47\x80\x4d\x20\x19\xd6\xc4\x28\x86\x21\x9e\x08\xd6\x1e\x1f\x3f\x01\x36\x81\x64\xa8\x8b\x51\x0c\x43\x3c\x21\x6c\x8e\xee\x1d\xc0\x26\x90\x0c\x35\x31\x8a\x55\x6e\xc4\xf0\x70\xfb\xd7\x67\x56\x67\x69\xd5\x16\xa3\x18\x86\x78\xf5\xfb\x75\x72\xcd\x36\x37\x3d\x56\x14\x31\x2a\x76\x36\x61\xfa\x9e\x65\x7b\x61\xec\xd2\xc1\xfd\x01\x3e\x03\x36\x01\xbb\x9b\x58\x4f\x7e\x8b\x71\x31\xcb\x30\x0a\x9c\x69\xfc\x10\xf6\x21\xdc\xda\x07\x34\x19\xb3\x3b\xc6\xbf\x44\x98\x98\xd5\xd8\xbf\x8d\x9f\xe5\x1e\x5a\xf5\x1f\x80\x24\x62\x36\xdb\xf0\x6f\x7e\xba\x61\x46\xb1\x3b\x8b\xe8\xf6\xe2\x02\xe0\x9f\x92\x1c\xa6\x4f\xbf\xb5\xfd\xd1\x67\x2d\x7b\xb6\xec\xe0\x97\xc9\xda\x4e\xb4\x53\x8f\x9f\x25\xdf\x25\x6f\x8e\xbf\x06\x47\x7b\xad\xec\xbd\xb1\x15\x3f\x27\x5e\xbf\x5b\x1f\x7d\xcb\x9e\x12\xb3\x61\x43\xf0\xd2\xcb\x0b\x28\x12\xd7\x9e\xf2\xba\x21\x42\x22\x47\x11\xb0\x62\xf6\xee\xfa\x06\x76\x1b\x21\xc9\x96\x83\x7c\x47\xa0\xf6\x3b\x3d\x98\x98\x20\x21\x48\xb2\x34\x88\xbc\x24\xd8\x01\xac\x41\x13\xd4\x6c\x0b\x90\x44\x79\x10\x79\x1b\xf8\x6f\x7e\xba\x3f\xb5\xbd\x8e\x64\xba\x7d\xef\x1a\xc0\xbf\x25\x59\xb2\x02\x7f\x6a\xf9\x77\x1e\x88\xfc\xd1\x28\x76\x7d\xf1\xd5\xbb\xd8\x04\x6c\x82\x9c\x10\x4e\xd0\x6f\x31\x0e\xb9\x30\x86\xca\x94\x39\x34\x8e\x2b\x9f\x3d\x5b\xef\xab\x67\x83\x83\x5d\x40\x12\x61\xe5\xd3\x97\xeb\xbc\x74\xc4\x82\xf4\x01\x70\xa0\x5c\x63\x17\xb3\xd9\xf3\xf5\xc8\x23\x3c\x00\xe4\xd3\x11\x8b\xe4\x09\xbb\x56\x7f\xb8\xd3\x40\x4a\x81\x99\xe3\x47\xec\x6c\x0a\xca\x96\x3d\x63\x07\xd1\x68\x0b\x3b\x8d\xcd\x9e\xb1\x47\x5e\xfa\x8e\x9d\x93\x8c\xbb\x2e\x7b\xc8\x3e\xdc\x3f\x1f\x00\x82\x86\x3a\x2e\x7d\xca\x9e\x4b\x45\xd9\x5d\xc7\xbb\xc6\x5f\x75\xea\xb7\xa7\x20\xa5\xc0\xac\xbb\x8e\x77\xcd\x49\xc1\xd9\xd0\xc3\xfb\xda\x45\xeb\x66\x07\xc4\xbf\x51\x96\x51\x8e\x2a\x65\x1e\x72\x71\x26\xad\xbd\xfe\x70\x0c\x28\x2a\xcc\xfa\x19\xfe\x40\xf9\x79\x00\xdc\x4b\x13\x34\x44\x94\x7d\x67\x0a\xe2\xdf\xa8\x6f\x26\x39\x2a\x51\x28\xce\xa4\xd9\x9b\xfb\xeb\x80\xa2\xa6\x85\xa2\xfc\x3c\x00\xe2\x72\x1f\xe2\xe6\x59\xbf\xdd\x04\xf1\x6f\x98\xf3\x7b\x98\xa3\x12\x85\xe2\x4c\xea\x27\xed\xe4\x1e\x50\xd4\xb4\x50\x94\x9f\x07\x40\x5c\xd0\x03\x77\xbc\xd4\x3b\x26\xee\x35\x4c\x82\xf9\xd7\xe0\x5f\xbc\xb4\xac\x02\x78\x32\xa5\x9c\x10\x67\x45\xf3\xd2\xa4\xbc\x1f\x63\xa9\x23\x89\x5c\x1c\x67\xbc\xce\x62\x4a\x11\x56\x22\xfc\x35\xa3\x66\x61\x9d\x38\x23\x76\x27\xb1\x43\x67\x6e\xba\xe4\x78\xd3\x59\x44\xc8\xe6\x8e\xa6\x3f\x00\x49\x94\xd1\x8f\x54\xbe\x7c\x3a\x21\x21\x76\xd9\xe2\xa0\x35\x67\xf4\xb8\xd7\x36\x01\x2f\x2d\x95\xf1\x4b\x42\x2a\x44\x67\x53\xde\xc4\xf6\x66\xb0\x92\x75\xe3\x73\xfb\x0e\xd0\xe4\x74\xba\xdb\xb3\xbd\x99\x08\xe3\x19\xb7\xe8\x01\x53\xec\x70\x5a\x92\x25\xcf\xb8\x05\x96\x73\xeb\x24\x5e\x27\x7e\xdd\x8d\x6d\x40\x12\x61\x9e\x0d\xfc\x37\x3f\x1d\xb1\x88\x8c\x01\xd6\x66\xd3\xb9\x38\x00\x29\x05\x66\x3e\x31\x06\x21\x27\x05\x65\x23\xdb\xab\xaf\x7b\xbb\x5f\x3e\x01\x9a\x0c\x19\x10\xad\xc4\xc7\x24\x42\xe2\xb5\xeb\x6c\x23\x95\x3a\x2e\x65\xea\xb8\x2e\xaa\x9d\xee\xd4\xaf\xcf\x41\x46\x82\xdc\x0f\xe0\x5f\xbc\x34\x94\x35\x8c\x0c\xf3\x3a\xae\xde\x74\x78\xf6\x03\x90\x44\x98\xfd\x18\xff\xcd\x4f\x4f\x9b\x86\x16\x54\x6d\x85\x87\xbb\x80\x93\x96\x34\x17\x29\xb1\x18\x0c\x97\x79\x10\x7b\x3c\x97\x3a\xd2\xd6\x68\x70\xb0\x01\x48\x1a\x5c\xdb\xd7\x13\x8f\xe8\x9c\x64\xc4\x60\x6a\xc4\x1e\x3e\x6e\xd4\xb3\x2d\x90\x52\x60\xde\x03\x03\xfb\xf6\x60\x52\xa6\xc6\xc8\xf1\x0c\xe4\xb2\x5a\xf2\xd4\xfa\x9e\x07\x32\x0a\xf6\x63\x12\xff\xc0\xd3\xf2\x6e\x7d\xa7\x36\x02\x34\x95\xe0\x01\x27\x66\x2e\x84\x64\x14\xcf\xcf\xc1\x85\x76\x03\x68\x32\xc1\x09\xcf\xd6\x7c\x8c\x67\xbb\x1d\x89\x88\x4b\x86\xd8\x27\xbe\xd9\x91\xf8\x8c\xbf\xf6\x38\xd3\x9a\x6f\x3d\x70\x53\x25\x2a\x1c\x02\xaa\x5d\x3e\x4a\x42\xcc\x64\x1b\xff\x12\x61\x62\x56\x91\x13\xc5\x2e\xc4\x54\xed\xf0\x10\x90\x44\xcc\xe6\x04\xfe\xcd\x4f\x97\xc8\xe0\x09\xf8\x6b\x2c\x1b\x52\x21\x66\xb2\x85\x7e\x08\x10\x52\x16\x1b\x0d\x5f\xb9\xa3\xe3\xa5\x61\x9f\x2f\xc9\x4c\x98\x4f\x95\xe8\x68\x0d\xf8\x08\x2a\x1f\xc5\x21\xae\xc9\x7a\xfc\x53\x88\x22\x6a\x03\x9c\xc8\x86\x2a\x10\x8c\x7f\xec\x7e\x03\x2c\x3d\xab\xd6\x4e\x64\x4f\x84\x28\x89\x08\xa1\x86\xba\x9c\x8d\xab\x16\x57\x2c\x9d\xea\xf3\xe9\x12\x15\xc1\x0b\x1f\x44\xe5\x02\x7b\xc5\x6c\x32\xc3\x96\x8b\x91\xa8\x08\x52\xa8\xad\xf2\x81\xa5\x62\x56\x84\x99\xc9\xc3\x48\x54\xe0\x22\xc4\x2a\x1f\xcf\x28\x66\x45\x98\x9b\x3c\x8c\x94\xc5\xce\xc1\xf7\x3b\xe8\x78\x3a\x31\x93\xc4\xec\xcc\xa5\x4a\x54\xa4\x16\x54\x93\x7c\x00\x97\x98\x09\xe1\x78\x8a\x87\x91\xc8\x20\x21\x68\x7c\xe5\x42\x87\x24\x0d\x9d\x18\xa2\x3c\x44\x1a\x98\x41\x62\x22\x35\xc0\x9d\x2c\x11\x0c\x01\xd5\x35\x17\x22\x41\x92\xf1\xaf\xc4\x30\xe2\x21\xb0\x7b\xa0\xc4\x43\x1d\x13\xf7\x00\x3b\x07\x8a\x3d\xd4\xe5\xd2\xc6\x8e\x65\x77\xa4\x2c\x08\x82\x24\x87\x63\xec\xbe\x27\x8d\x79\x00\xcd\x8f\xd8\x1d\x25\x14\x81\x0e\x73\x90\xf8\xe7\x42\x79\xd1\xad\x1d\x2a\xc2\x01\xf6\xcd\xb5\x8d\x0a\xc9\xa7\x61\xaf\x94\xe8\xae\x73\x16\xb1\x40\x92\x51\x50\x82\x8e\x44\x44\x28\x90\x64\x29\xf3\x90\x8e\x3f\xac\xd3\x7e\xe1\x25\x39\xfe\x1b\x99\x9e\xf9\x54\x29\xf3\x97\x2d\x75\x24\xd6\xcd\x78\x9a\x1b\xce\xdf\xf9\x44\x29\x73\x99\x8c\x0f\xda\x69\x6f\xd3\x69\xee\x3d\x8b\x9b\x2a\x65\x5e\x79\xa5\x8e\xc4\x3a\x23\x4e\x73\xc3\x55\x28\x9f\x28\xb1\x1e\xeb\x51\x23\x73\x7d\xd9\x13\x6d\x90\x2a\x8b\x00\x28\xe5\xfc\xcd\xe3\x8b\x4b\x5c\xbf\xf6\x04\xdf\xcc\xd9\x96\x08\x9a\xe7\x4c\x54\xbb\xc8\xd5\x7d\xae\x94\x54\x86\xe2\x5c\x12\xeb\xcd\x1d\xdb\x80\x3c\x3f\xef\x44\x37\x27\xcc\x45\x40\x29\xe7\x5f\x1d\x09\xc0\xf7\xee\x4e\xf0\x4d\x1b\x48\x08\xcd\x73\x26\x1a\xa8\xc8\xb5\x7b\xae\x94\xb4\x81\x8a\x73\x49\xac\xdb\x72\x54\x12\xd7\xa1\x39\xa1\xc9\x29\x73\x01\x50\xca\xb9\x11\x97\x62\xff\x0d\x1c\x0f\xe3\x04\xdf\x4c\x83\x44\xd0\x3c\x67\xa2\xda\x45\x1e\xcc\x73\xa5\xa4\x32\x14\xe7\x92\x58\x7f\xde\x52\x47\xe2\x3b\xfa\x26\xc6\x6a\xcc\x5b\x84\x93\x72\x9e\xb8\xf1\x9e\x99\xeb\xf2\x9b\x60\x9b\xe9\x8f\x08\x9a\xe7\x4c\xa8\x7d\x91\x13\xf0\x5c\x29\xe9\x18\x28\xce\x25\xa5\xbe\xbf\xb1\x55\x47\xb9\x03\x4f\xe6\x70\xbc\xe3\x67\xd3\xa4\xd4\xbb\x37\xca\x4a\x3b\xfc\x4e\xb2\x22\x9b\x39\x97\x26\xa5\xfe\xbb\xa5\x8e\xc4\x78\xf4\x4e\x72\xc2\xf9\x36\x97\x24\xa5\x4e\xbb\xb1\x4d\x4d\xf9\xf1\x4e\x72\xe2\xa3\x18\x36\x4d\xa2\x9c\x2e\xa3\xa9\x3e\xef\xf3\x3a\x6d\xc6\x03\xf8\x4b\x84\x91\x38\x3e\xab\xf1\x95\x1e\x81\x43\x6b\x9a\x6d\x7a\x0a\x22\x86\x4b\x3c\x0f\xd1\x48\x64\xa1\xf7\x6a\xba\x8c\x6c\x24\x16\x64\xe0\x96\x42\x28\xdc\x02\x5f\xd5\xbc\x12\x53\xb5\x5b\x98\x57\x22\xbd\x56\x4b\x1d\x29\xef\xca\x3a\xe9\xcf\xb8\x2f\x38\x80\xf9\x5c\x1e\xce\x3c\x13\xee\xc3\xca\xb6\x1c\x55\x9e\xa4\x59\x68\x97\xc2\x28\x70\xcc\x48\xea\xee\x0f\x7e\xd9\x66\x54\xb5\xec\xa1\xe3\xd9\x07\x81\x3f\xb5\x83\xe8\xa1\x1c\xc9\x52\xbf\x6f\xff\x7f\xec\xbd\x7b\x9b\xd3\x38\xd6\x2f\xfa\xff\xf9\x14\x89\xdf\xd9\x1e\xa9\x23\x42\x52\xd0\x17\x1c\xd4\xd9\x0c\xd0\x87\xde\xd3\x0c\xf5\x72\x99\x7e\x39\x21\x53\x8f\xcb\x56\x12\x37\x8e\x94\x96\x95\xaa\xca\x49\xfc\xdd\xcf\xa3\xab\xe5\x4b\x8a\x02\x7a\x1a\x98\xc3\x4c\x3f\x54\x6c\xcb\xb2\x2e\x4b\x4b\xbf\x75\xd1\x5a\xc5\x53\x96\x6e\x25\xc0\xd9\x5f\xc4\xf9\x96\x44\xfd\x51\x09\x91\x2a\x2b\xd1\x33\x76\xb5\xc2\xfd\x82\x71\x70\x11\xf3\x1e\xc1\x31\x5f\x6e\xd7\x84\x8a\x62\x98\x13\xba\x14\x2b\x24\xf0\x03\xce\xe3\x1d\x20\x10\x51\x3c\x9a\xd0\xfb\x64\x42\x07\x03\x28\x66\x74\x5e\x15\x9e\xd1\xf9\x84\x13\xb1\xe5\xb4\x27\x86\xbf\xb1\x8c\x82\xa0\x17\xc0\x46\xcb\x11\x6d\xb4\xdd\x3e\xec\x31\x40\xe0\xde\xbc\x4f\xc2\x90\x0c\xab\xc6\x4f\x49\xb4\x37\x0d\x8e\x48\x59\xba\x57\xb8\x1e\x8b\x6c\x01\xfa\x80\xf4\x32\x5a\x88\x98\x26\x84\x2d\x7a\x02\x42\xb1\xe2\xec\xb2\x47\xc9\x65\xef\xe5\x6e\x43\x1e\x73\xce\x38\x08\x1e\xc6\x94\x32\xd1\x4b\xe2\x3c\xef\xc5\xbd\x24\x8f\x8b\xa2\x17\x17\xbd\xb8\x67\xab\x0c\x60\x55\x7b\x5e\xd5\x4e\xbc\xea\x9e\x93\x05\xe1\x84\x26\xb6\x4e\xb1\xca\x8a\xde\x2a\x2e\xe8\x5f\x45\xef\x9c\x10\xda\x73\x01\x35\x49\xda\xbb\xd5\x2b\xb6\x1b\xc2\x01\xac\x95\x90\xdf\x27\x69\x00\xcd\x70\xf5\xc5\xe1\x10\x30\x35\x87\x41\x1f\x8b\xdd\x46\x75\x21\x0c\x03\xd7\xaa\xea\xee\x94\x44\xa2\x6a\x62\xec\x9a\xd8\x55\x36\x0c\xe9\x36\xcf\xfb\x18\x8b\xee\xd1\x78\x21\x9b\xd6\x23\x57\x1b\x4e\x8a\x42\x56\xb7\xde\x16\xa2\x47\x32\xb1\x22\xbc\x77\x4e\x7a\xf2\xed\x1e\xe3\xde\xf0\xa0\x9e\x1c\xbe\x60\x60\xbf\x00\x27\x64\xb8\xe1\x4c\x30\x79\x03\x1b\x32\x4c\x38\x89\x05\x01\x22\x0c\x45\xf5\x10\xed\x13\x46\x0b\xc1\xb7\x89\x60\x3c\x32\xb4\x48\x10\xa1\xdb\x35\xe1\x2a\x9a\x76\x7f\x8c\x2e\x79\xa6\x83\xa8\xf7\x47\x28\x61\x74\x91\x2d\xb7\xe6\xd9\xa8\x94\x54\x1b\x86\xc0\x7c\xa3\x20\xe2\xd4\x56\xfd\x6c\x31\xed\xbc\xab\xc6\x26\x92\x74\xa4\x5a\x71\x76\x86\x05\x2c\xdf\x77\xa9\x4c\xe4\x8a\xc8\x6a\xcb\xc4\x0e\x3e\xd1\x83\x6f\x97\x8d\x5e\x18\xc2\x2c\x19\xb5\x3e\xf6\xf2\x3e\xc3\x72\x99\x4c\xd8\xb0\xea\x2b\xf6\x2f\x0e\x87\xfe\x18\xb1\xa1\xdf\x5f\xdc\x1f\xa1\x40\xb5\x21\xc8\x68\x8f\x85\x21\x60\x43\x3b\x36\xb8\x3f\x82\xa8\xbb\x17\x04\xb1\xe1\x5b\xb2\x43\x0c\x96\xa5\x59\x48\xae\xdd\x02\x51\xc4\xdc\xfa\xa2\x61\x48\x80\x3f\x3b\x14\x22\xa6\xee\x21\x06\x91\x28\x4b\x00\x51\x81\x29\x18\x43\x94\x60\x06\x0a\x88\xb6\xf2\xea\x87\xbb\x10\xa5\x98\x81\x2d\x44\x1b\x79\xfd\xfd\x1d\x88\x16\x98\x81\x0d\x44\x2b\xf5\x7c\x04\xd1\x1a\x33\xb0\x82\xe8\x5c\x5d\x9f\x40\xb4\xc4\x0c\x9c\x43\x74\x56\x0d\x21\xf1\xc6\x50\x00\x3d\x48\x44\x36\x0f\xc5\x13\x0e\xe4\x72\x42\x02\x4e\xec\xb0\x66\x6d\x6e\x54\x18\x6e\x94\xc9\xd6\x8d\x26\xc9\xfd\x6c\x92\x0c\x06\xb0\x98\x25\x3e\x37\x4a\x1c\x37\xa2\x98\xe1\x5c\x57\x0c\x08\x16\x15\x41\x1c\x0e\x66\x20\x97\x75\xc2\x11\x10\x0e\xe5\x1a\x1d\xc6\x9b\x4d\x2e\xc7\x75\x26\x5f\x9e\xcb\x39\x4a\x62\x01\x0a\x08\x21\x62\xc3\x42\xc4\x82\xe0\x7d\x41\x72\x92\x08\x92\x46\xb3\xb9\x8a\x2b\x1c\x8d\x51\xc2\xb6\x54\x44\x23\xf4\x1b\x3b\x2f\xa2\xd9\xbc\x44\x31\xa6\x28\x07\x0c\xc5\xd0\x4e\x4c\x0c\x04\x22\x10\x65\x40\xa0\xd9\xfe\x2d\xd9\x45\xc1\x82\x88\x64\x15\x20\x4d\x7a\x47\x07\xcb\x32\x48\xd3\x34\xd5\x29\xd7\x67\x57\xbb\x18\x0a\xf6\x42\xf0\x8c\x2e\x7d\xca\x75\xef\xda\x87\x00\x96\x48\x94\xc0\x2b\xa2\xf9\xbf\xac\x75\x22\xff\x91\x14\xb2\x29\x86\xaa\x69\xaf\x9e\xff\x12\x86\xea\x17\xe8\x78\x34\x08\xa6\xb2\xf3\x38\x18\xa8\x87\x6a\x6c\x86\xf2\x0e\x1c\x8a\x15\xa1\xc0\xef\x90\x6b\xc7\x6f\x85\xfc\x68\xd9\x2c\x22\xe0\x9e\xc8\xb5\xfc\x42\x56\x02\x6a\x03\xac\x47\x56\x0c\xd5\x5f\x3d\xbe\x72\xaf\x39\x2f\x4a\xa8\xfe\x8f\xf4\x58\x26\x6c\xbd\x61\x94\x50\xf1\x6b\x96\xe7\x4f\x65\xd9\xd6\xc0\xc2\xbd\x6a\xa8\xee\x90\xdc\xa5\xf4\x9b\xdb\x4d\x1a\x0b\x72\x1a\x2f\x49\xd7\x54\xe8\xbe\xb9\x96\xa9\xe9\x26\x25\xaa\x6a\xaa\x2a\x4a\x56\x44\xab\xae\xdb\xb5\xd8\x49\xaa\x06\xca\x76\x71\x98\xd1\x24\xdf\xa6\xa4\x00\xa4\x51\x53\x57\x3d\x72\xb2\x04\xee\xae\x26\x25\x57\x92\x03\xc2\x89\xf8\x11\x8f\xa6\x5d\x65\x8a\x4d\x9e\x25\x72\xc5\x8f\x61\xd4\xf5\x7c\xb3\x2d\x56\x72\xc3\x6f\xf4\xd9\xcd\x46\xc7\x3b\x65\xa3\xd1\x0f\xf2\xbc\x63\xdc\x9b\x34\xd6\xf8\xae\x5e\xe4\x3f\xda\x46\x77\x91\x41\x09\x23\xe0\xbd\x2a\xe7\x7f\xb8\x8e\x37\x4d\x0a\xea\xe8\x8f\x80\xe5\x7b\xf6\xa8\xea\x52\x4a\x72\x22\xc8\xcd\xfa\xa4\x17\x87\x7b\xa3\x7b\xed\xf8\x8f\xd1\x7e\x4d\xc4\x8a\xa5\x51\xb0\x61\x85\x08\x5a\x6b\x42\x76\xa8\xa2\x4d\x30\x86\x65\xb3\x61\x2f\x4c\x93\xaf\x6d\x1d\x12\x78\x36\xef\x1c\xf6\xda\xf8\x51\xf9\x39\xbf\x91\xaa\x03\x42\x8f\xa1\xee\x47\xeb\xf9\x20\xb8\x1d\x0c\xe8\x30\xcd\x48\x7a\x16\x0b\x73\x95\xa5\xad\x8e\xc9\x19\x38\xe5\x6c\x9d\x15\x64\x18\xe7\x39\x10\x5d\x5d\xb5\xcb\xb2\xea\x24\x27\x82\xef\xde\x67\xf0\xed\x0b\xdd\x63\xef\x3d\xfd\x98\xa1\x57\xd5\xfc\x9b\x46\x5e\xd5\x7d\x7c\xe0\xed\xe3\x7f\xfb\xb8\x53\x65\x9c\xbc\x6e\xd4\x0d\x43\x4b\xac\x54\x61\x20\xe0\xe3\x9c\xc8\x6d\x09\x04\x69\x76\x11\x20\x89\x26\xd1\xf5\x45\xf6\x0a\x91\xff\x23\x5e\x93\x08\x8c\xd0\xd2\x96\x85\x60\xed\x5e\x53\x3a\x69\xd4\xb8\x36\x06\x02\x58\xde\xbc\xfe\x46\x0d\xc6\xb0\x54\xa2\xe0\x11\x89\xd3\xde\xff\x61\xe7\x45\x00\x3f\xb8\xb6\xbf\xb1\x74\x77\x4d\x5b\x36\x66\x30\x3c\x62\xd0\xfb\x59\xd0\xfb\x8d\x9d\x83\x02\xf6\x62\x4e\x7a\x29\x89\xd3\xe1\x35\x8d\x48\xed\x7d\xa4\xf7\xa1\xc6\xbe\x8b\x04\x13\x71\xfe\x50\xef\x97\xcd\x0f\x6d\x08\x97\xb4\x1c\x9d\x8c\xd0\x6f\xdb\xf5\xe6\x25\x8b\x7c\xd6\xd9\xc4\x8e\xde\x8e\xed\xad\x03\x01\xcb\xb2\x84\x1f\x34\x48\x0d\x4f\xa9\x6b\x86\xca\xa4\x51\x3a\x5e\xcb\x75\xef\x6a\xfb\xe2\xf5\x74\x27\xf8\x3b\x4b\xac\xde\x55\x42\xf9\x30\x04\x68\x2f\xd1\xa3\xd9\xf9\xce\xd9\x55\x80\x0c\x04\xe8\xdc\x5d\xed\x2e\x87\x18\x7d\xb8\x8a\xe9\xb2\xb6\xb4\xdc\x70\xdb\x5d\x54\x02\x94\xeb\x86\xda\xb5\x31\x90\x63\x74\x1d\xe5\x56\x25\x1f\x58\xd4\x78\xb3\xe2\x4a\x66\xbc\x59\xd1\x47\x19\x49\x7b\x0f\x44\x00\x21\x7a\xd7\x2e\xfd\x2e\xce\x21\xa7\x47\xf1\x21\x31\xcc\xd2\xeb\xe6\x3a\xfd\xd8\x49\x32\x83\x4d\x52\x20\xe0\x0d\x26\x45\x91\xff\xb5\x53\x62\x5b\x24\x86\x34\x5e\x93\x9b\x94\xfc\x3f\x2f\x9e\xfd\x63\x58\x28\x64\x9e\x2d\x76\x40\x0c\x63\xbe\x2c\x6e\xf8\x0d\xc2\xf9\x4d\x0a\x1e\x2b\xb1\xa8\x98\x89\x90\x90\x5a\x94\x50\x6e\x18\xf2\x7f\x1f\xb2\xc0\xad\x5b\x0e\xe2\x2c\x27\x51\xa0\x6d\xbe\xd7\x4c\xdf\xf9\x56\x08\x46\xdd\xec\xd8\xcb\x77\x6f\x07\xe7\x82\xa2\xda\x95\xdd\x0a\xe4\x14\xe6\x59\xf2\xb6\x73\x06\xeb\x80\x49\xa1\xff\xe0\x91\xba\xd7\xb3\x37\xdf\xb9\x05\xfc\xc9\x4d\xae\x01\x0d\xdd\xe2\xe7\xf2\xd6\x67\xdb\x60\x07\x6e\x6b\xc3\xfb\x20\xcf\x3f\xb7\x86\x5a\x24\xe8\x0f\x6a\xd5\x4c\x89\xff\xe7\x50\x4a\xc9\x55\x7b\x1f\x5a\xe9\x12\x4e\xce\x14\x18\x7b\xb9\xdb\x90\x02\xef\xad\x2c\x1c\x55\x45\x4f\xed\x53\xb3\xae\x91\x83\xcb\xef\x2e\xa4\xd1\xe9\x75\xe5\x2c\x04\x7c\x67\x99\x77\x56\x55\x7a\x2a\xe1\xb3\xaf\x1a\xdb\xaf\x1a\xdb\xaf\x1a\xdb\xff\x0c\x8d\xed\xc8\xd3\xd8\xbe\x4b\xa9\xe8\x94\xaf\xc8\x6a\x4b\x6f\xa8\x2b\xed\xd4\x45\x5e\xa3\xea\x2c\x56\x6c\x9b\xa7\x2f\x94\x53\xce\x51\xf5\xd8\x18\x63\x72\x38\x10\xac\x15\x5c\x4a\x9a\x91\x72\xc7\xe1\xf0\x34\x16\xab\x61\x7c\x5e\xf8\xe2\xbc\xca\xde\x46\xe0\x7d\x3c\x7e\xb7\xe0\x9a\x2d\xc0\xa8\x59\x2b\xb4\xc3\xbc\xcd\xf3\x49\x65\x08\x9b\xcd\x91\xc0\xe3\x89\xb8\xdf\x28\x3e\x11\x83\x01\x14\xa6\x92\xaa\x05\x53\xa2\x05\xf5\xa3\x5b\x5b\x9e\x59\x38\xeb\x6d\x67\x4e\x90\x1b\xea\x03\x2d\xd7\xe0\xa4\x62\x13\x53\x0b\xf9\x20\xb4\x7a\x3b\x37\x9a\x40\xc0\xf7\x68\xc2\x35\xdf\x89\x03\xb4\xb7\x7b\xa6\xd7\x47\x2d\x2b\x4a\xe8\x7b\xe4\xf3\xb7\xc6\x50\xee\x03\x1f\x35\x08\xf6\xd8\xcb\xcd\x86\x21\x18\x0e\xe5\x26\xfd\x4e\x15\xc4\x36\xaf\x41\xd5\xd4\x13\xd8\xad\x83\x6b\x89\x3c\xa5\xab\x9b\xea\x00\x2d\x89\xe8\x00\x0e\x8a\x0a\x13\x92\xe5\x3e\x19\x56\x32\xf7\x6d\x9f\x34\xb4\xb4\x7d\x3d\x92\xd8\xf8\x48\x42\x09\xf4\x5d\xbb\x35\xdd\xae\xcf\x09\x1f\x66\xc5\x73\xf2\xfb\x36\xe3\x24\x75\xa2\xfc\xcd\x4a\x7b\x4a\x81\x9b\xbd\x60\xd4\x03\x5d\x85\xe5\x98\x78\x45\x7d\x08\xb1\xf9\x0a\x21\xbe\x42\x88\xaf\x10\xe2\x33\x81\x10\xff\xd7\x1f\x88\x21\x8c\x55\xd7\x59\x7d\xff\x53\xad\xba\xbf\x6f\xc9\x96\xdc\xdc\x78\x7b\x23\x83\x63\xb7\x9d\x62\xcb\xf3\x0e\xfb\xc4\x96\xe7\x1f\x6f\x39\x35\x9d\x10\xe5\x8d\x14\xfa\x37\xd2\xe2\xd7\x55\xf4\x8b\x4a\x0c\x4f\x1b\x2a\xfa\xf4\xa3\x55\xf4\xe9\x31\x15\xbd\xee\xd6\xfb\xe8\xe7\xd3\x8f\xd5\xcf\xeb\x4f\x5a\x52\x0d\x7a\xea\x1a\x14\xb0\x77\x99\x89\x55\x2f\xee\xa9\x7d\xb5\xc7\x16\xbd\x40\xbf\xa5\x9e\xa7\x0f\x8d\x56\x3f\x13\x64\x2d\x0b\xeb\xbb\x12\xb0\x7c\x48\xd3\x3f\x46\x6b\x9e\x7e\x32\xad\xf9\x7b\x68\xa4\xd5\x70\xdd\xac\xe8\x2f\xb1\x20\x34\xd9\xf5\x40\x41\x12\x46\xd3\x02\x36\x94\xcd\x66\xbe\x6a\xea\x66\xf2\x1e\xea\x66\xa5\xae\x3e\xa3\xf1\xfa\xda\xa1\xb2\x5a\xd5\xaa\xf4\x4d\x74\xb0\xc6\x08\x73\xb3\xa2\xb9\xee\xa8\x55\xc6\xba\x55\xec\x11\x58\x0b\xa1\x6a\x3e\x33\x9a\xb4\xfd\x0c\xba\x86\x45\xf2\x8c\x01\x36\x2e\x15\x25\x44\xe4\x5a\x98\xba\xf2\x61\xea\x96\xe7\x37\xd4\x29\xad\xbe\x02\xc2\xaf\x80\xf0\x2b\x20\xfc\x4c\x00\xe1\x57\x2f\xc0\x3f\x1c\x2f\xfe\x87\x7a\xfe\x1d\x05\xa7\xff\x46\x7f\xbf\x2f\xc7\xc7\xef\x0f\xf3\x8d\xf9\x1c\x1c\x5f\xb4\xe5\xeb\x53\x7b\xbe\x14\xc9\x8a\x48\xf6\x9b\xf6\x04\x93\xbb\x0f\x27\x82\x67\xe4\xab\x23\xcc\xe7\xe4\x08\xf3\x59\x38\x99\x18\x43\xed\xbb\xbd\x4c\xde\x0f\xf6\xdf\xcc\xcb\x84\x7c\xa8\x4f\x07\xb9\xb9\x4f\x07\xf9\x63\x7d\x3a\x88\xef\xd3\x71\x73\x9b\xf6\xcd\x21\xfe\x57\xb3\xf1\x57\x88\xff\x15\xe2\x7f\x85\xf8\x5f\x21\xfe\x57\x88\xff\x15\xe2\x7f\x96\x10\xff\x85\x03\xd7\x9f\x0d\xcc\xff\x0a\xec\xff\x7f\x01\xec\x2b\xca\xfb\x49\x02\xfc\xff\x50\xc8\xfe\x9f\x8b\xc4\x85\xf7\x48\x82\x16\xdb\xc3\xa7\x64\xcd\xf8\xee\x49\x56\x08\xc6\x77\x58\x0c\x57\x71\xb1\xaa\xae\xce\x39\xbb\x2c\x08\xaf\x6e\xa8\x7d\xed\x39\xdb\x0a\xc2\x9f\x66\x69\x9a\x93\xcb\x98\xcb\x8d\x7a\xc1\xf8\x3a\x16\xa7\xb1\x10\x84\x53\x2c\x86\xdb\x82\xe8\x52\xd5\xab\xeb\x58\x24\x2b\x2c\x86\x5c\xdd\x7f\xb1\x8a\x37\xf2\xc5\x9c\x25\x3a\x62\x9d\xb9\x76\xc3\x82\xc5\x50\xd6\x90\xd1\xa5\x8a\xdb\x77\x25\xcc\x0d\xc2\xab\x6b\xdd\x05\x75\xb7\xf0\x3e\x6a\xdf\x25\x55\xc9\x5f\xb2\x05\x49\x76\x49\x2e\x3f\x51\x35\x49\x15\x92\x7f\x49\x9a\x71\x92\xc8\x82\x3f\xd3\x94\x5c\xd9\xfb\xfa\xa2\x7a\x78\x99\x89\x95\x6e\x83\x7d\xf8\x4b\x46\xdf\xaa\xea\xd5\x1f\xf3\xec\x82\x65\x69\x6f\xa4\xf0\x28\x57\xe0\xea\x1e\x3c\x1e\xe1\xc1\xef\x43\x80\xf6\x3e\xcc\x1e\x1d\xf1\x5c\xe2\xb5\x8e\x97\x06\xfa\xe6\xf2\x53\xf7\x46\xd7\x7c\xaa\x36\xd6\x37\xfc\x56\x5e\x9f\x21\x09\xee\x8f\xd6\xef\xcd\xec\x8d\x6b\xf7\xde\xb1\x1d\x89\x55\x47\xbe\xbd\xa6\x23\x35\x6a\xbb\xe1\xa7\xe2\x3a\x8d\x96\x4e\x60\x90\x1f\xfb\x5e\x82\x68\xa6\x61\x2a\x05\x27\x27\x3f\x48\x18\xcd\x40\x22\x41\xb4\xbc\xbe\x27\x61\x34\x03\xa9\x04\xd1\x14\x9c\xdc\x19\x49\x18\xcd\xc0\x42\x82\x68\x79\x7d\x22\x61\x34\x03\x6b\x09\xa2\xe5\xf5\x5d\x09\xa3\x19\x58\x42\x74\xa1\xae\xef\x40\xb4\xc3\x0c\x5c\x40\x74\xa5\xae\xbf\x85\xe8\x12\x33\x70\x05\xd1\x5b\x75\xfd\x1d\x44\x8f\x31\x03\x6f\x21\x7a\xa8\xae\xbf\x87\xe8\x14\x33\xf0\x10\xa2\xa7\xea\xfa\x07\x88\x9e\x61\x06\x9e\x42\xf4\x5c\x5d\xdf\x83\xe8\x05\x66\xe0\x39\x44\x2f\x55\xfb\xbe\x85\xe8\x1f\x98\x81\x97\x10\xfd\x22\xaf\xef\x8e\x20\xfa\x19\x33\xf0\x0b\x44\xbf\x61\x06\x72\x88\x5e\xa9\xdb\x63\x88\x1e\x60\x06\x5e\x41\xf4\x48\x5d\x7f\x0b\xd1\x13\xcc\xc0\x23\x88\x7e\x57\xd7\xdf\x41\xf4\x1a\x33\xf0\x3b\x44\x3f\xa9\xeb\xef\x21\xfa\x15\x33\xf0\x13\x44\xff\x94\xd7\xdf\x8e\x20\xfa\x1f\xcc\xc0\x3f\x21\xfa\x9b\x7a\x7e\x02\xd1\xdf\x31\x03\x7f\x83\x13\x47\xfb\x85\xe3\x94\x66\x55\x6c\xbd\x1b\xd5\x92\xd9\x78\x77\xbd\x55\xb5\x6a\x16\x76\x8b\xef\xbc\xf5\x44\xad\xd1\x33\xef\xb6\x2b\xbb\xf3\x6f\xaa\x62\x97\xde\x1d\xbb\xfc\x1f\xd7\x5a\x6a\xd9\xc3\x69\xf3\x5d\xcb\x43\x9e\x79\x0f\x2a\x46\xf3\xa2\x59\xdc\x71\xa7\x7f\x34\x9e\x78\x8c\xec\x67\xef\x51\xc5\xef\x7e\xf3\xee\x6a\x5e\xf9\xa0\xe3\x93\x8e\xa1\x3e\xf1\x1e\x76\x73\xe5\xd7\x5e\x89\x06\x23\xff\xd5\x7b\xe4\x73\xfc\xff\xf1\xee\x77\xed\x0f\x7f\xb7\xcf\xff\xbd\xaa\xa2\x4a\xfa\xdd\xe6\xb9\xf2\x22\x76\xf4\x32\xcc\x8a\x7f\xc6\x79\x96\xda\x9d\x99\xd4\xd5\x40\x9e\x2b\x34\x81\x87\x83\x92\x3e\x87\x59\x61\x83\xdf\xc8\x16\x90\x0b\xc2\x77\x80\xc3\x96\x6e\xc6\xbc\xb9\x05\xfb\x12\xc9\x1b\x55\x81\xac\x3a\xac\x4f\x86\x5a\x0a\xc7\x31\x70\xfb\xb5\x9c\xc3\x02\x99\x63\xbd\x70\x92\x2d\x00\x1d\x26\xab\x2c\x4f\x39\xa1\x56\xb3\x50\x78\xf7\x10\x85\x13\x66\x44\xe4\x30\xb4\x0f\x0c\x45\x31\x68\x4e\x8f\xf4\xaa\x17\xac\xe0\x48\xab\x26\x15\xba\xcd\x5a\x9d\x31\x73\x02\x74\x35\x4e\x0f\xcd\xbb\x92\xed\x3d\x8e\x93\x15\x20\xc8\x87\x67\xd9\x02\x5c\x33\xa6\x30\x5b\x00\xdd\x53\x7f\xab\xf9\x89\xb3\xf5\x73\x12\x27\xc2\x14\xb4\x7d\x7b\x77\x49\xd5\xd8\x09\x0b\x43\xaa\x5d\x8b\x19\x2c\x49\x5e\xc8\x2e\xaa\x4b\x39\xbe\xb0\x84\xc8\xeb\x5f\xe2\xcd\xa5\x9c\xd7\x29\xc1\x05\x20\x30\x22\x61\xd8\x6f\xcf\x2a\x20\x78\x46\xe6\x10\x91\x26\xce\x51\x3c\x7e\x6b\xf5\x5d\x71\x51\x64\x4b\x7a\x38\xd4\xe4\x77\xa3\xbd\xd0\x6e\xe2\x4d\x0d\x86\xf2\x13\x37\xa3\x5c\x29\x2c\xc4\xdc\x29\x3d\x58\x2f\xa3\x3d\x0a\xcd\x07\x9c\x9a\x46\xae\xaa\x67\x97\xd4\x6e\x5a\x4a\x47\x01\x28\x62\xaa\xad\x33\x36\xc7\x74\xc6\xe6\x4e\x21\x40\xca\x89\x50\x8e\xb8\x71\x22\xec\xbc\xe1\x1c\x89\xeb\x86\x14\x67\xf5\xe7\x85\x2b\xe0\x6a\x28\x1a\x25\x70\xa2\x86\x23\xd5\xea\x22\xbd\xa1\xbd\xd7\x42\x96\x54\x51\x5f\xc4\xd6\xed\x9e\x4c\xf4\x18\xee\x4b\x45\xfe\x4a\x99\x49\xa0\x53\xb8\xc9\x41\x22\x37\x1b\x24\x82\xa8\x1c\x24\x15\x99\x8a\xcc\xe8\x1c\x56\x21\xa9\x2c\x3c\x26\x48\x74\xb3\x8b\xf7\x85\xc0\xdc\x62\x39\x6e\x61\x23\x37\xc8\xcf\x29\x2e\x0a\xff\xc2\xc3\xab\x12\x2a\x3b\x1c\xb9\x88\xf3\x62\xd7\x01\x66\x7d\xa8\xeb\x81\xc2\x5c\x4f\x80\xc6\x39\x63\x88\x32\x0c\x38\x88\x21\x92\x97\x77\x61\x0d\x8a\x8c\xef\x9d\x48\x28\x02\xb8\xc4\x22\x79\xc3\x77\x5b\xa2\x13\xff\x9e\x56\x4c\xa3\x4d\xed\x66\x21\xbf\x8e\x16\xf5\x7b\xfa\x64\x59\x13\x8d\x6f\xc0\x5e\x2e\xc7\x68\xeb\xfb\x8f\x73\xb2\xc9\xe3\x84\xd4\x6f\x2e\x59\xf3\xfa\x6f\x71\xf2\xb6\x79\xef\x27\xc6\x2f\x63\x9e\xd6\x6f\x17\x44\x28\x6a\xfc\x85\xc4\x17\xe4\x09\x63\x8d\xb7\xb2\xe2\x81\xce\x11\xe2\xdf\x2d\x25\xce\x6a\x0e\xaf\x6c\x6d\x2c\x56\x52\x28\x8c\x16\xf5\x2f\xc4\x3c\x59\x35\xee\x49\x41\x35\x4a\x55\x02\x12\x46\xeb\xcf\xa4\x10\xba\x28\x25\x82\xb3\x33\x59\xe8\xbf\x68\xe9\xcd\x72\x61\x7f\xa1\x33\x9f\x0c\xd6\xe8\xa2\x46\x21\x45\xf5\x1b\xed\xea\x84\xe4\x3d\x2a\xd0\x95\x23\xb6\x42\xff\x45\x97\x18\x38\x42\x34\xf7\x8a\x8a\x46\x57\x12\x2a\xee\x55\xb3\xa2\x73\x64\x9a\x12\x2d\x91\x6d\x48\x74\x86\x5c\xed\xd1\x45\xf5\xbb\x88\x76\x48\x55\x11\x5d\xe9\xbf\x3c\xba\x94\xdc\xc6\xae\xa5\xb7\x7f\x8a\xa8\x19\xd3\x57\x85\x84\x12\xe7\x3c\xa6\xa4\x25\x1f\x49\x1a\xcf\x31\x60\x80\xc3\x76\xe1\xfe\x18\x75\xe9\x29\x4b\xe8\x75\x22\xff\x53\x2c\x57\xc2\x45\xe5\x09\x52\xb2\xe1\x24\x89\x05\x49\x03\xd8\xc7\xf8\xd6\x58\x15\x90\x1b\x83\x61\x88\x13\xf9\x1b\xf7\x47\xa5\xc0\xc1\x8c\x4b\xae\x7c\x4b\x8f\xfe\xbc\x17\x0c\xc4\xa4\xb2\x44\xb4\x54\xe6\xcc\xa8\xcc\xe9\x8f\x27\x53\x7a\xeb\x24\x1a\x41\xc4\xf1\xc9\x84\xdf\xa7\x13\x3e\x18\x40\x36\xe3\xb7\x4e\x7c\xe5\x39\x9f\x4f\x32\xb7\x91\x6b\xb5\xb3\x1e\x5f\x34\x23\x48\x38\x4d\x38\x83\x35\xa0\x04\xf7\x05\xde\x77\x4d\x95\x1d\x52\x8e\xc4\xf0\x8c\x93\x82\x88\x5f\x63\x4e\x49\x8a\x73\x4f\x36\xbb\x23\x79\x16\x93\x2c\x4b\xd5\x72\xfd\xe0\x6b\x8c\xe0\x09\x64\xe5\x84\x0c\xc9\xd5\x86\x71\x51\x60\xf6\x1e\x33\xa7\x1f\xcb\x3d\xa8\x1a\x67\x65\x28\x33\x46\xb2\xfb\xc1\x80\x0e\xfe\xfa\x63\x4f\x9f\x67\x52\xf6\xaf\x55\x7c\x41\x7a\x71\x2f\xf8\xeb\x40\x0c\xfe\x1a\xf4\x24\x40\xfb\x2b\x3c\xb6\x11\xdc\x88\xff\x5b\x76\x60\xc6\xd8\x72\x0c\x56\x51\xb3\xa4\x65\xde\xe0\xd4\x28\xae\xdd\x32\x8c\x3a\xab\xdd\x8c\xe5\xb4\x3f\x5b\xa0\xa2\x5e\x94\x92\x67\x0b\xf9\x13\x25\xb5\xfb\x44\xa3\x00\xb4\xad\xdd\xd5\xcc\x3e\xad\xdf\xd3\xcc\x7e\x23\xd9\x8b\x6d\xfd\x16\xec\xf3\xac\x10\x84\x46\x79\xed\x28\x92\xe4\xfe\x79\x17\xf7\xcf\x1b\xdc\x3f\xef\xe0\xfe\x79\x37\xf7\xcf\xeb\x8c\xbc\xc6\x2c\xc1\x2c\x47\xe9\x1c\x4a\xb1\x1a\xd4\x79\x25\x98\x6d\x50\x3c\x87\xc8\x71\x49\x30\x8b\x51\x32\x87\x72\xd9\x5b\x26\x09\x66\x0b\x94\x81\x05\x9c\xbf\x1f\x8a\xf9\x70\x71\x84\x0c\xcd\x78\x80\xdb\xb3\xe1\x37\x83\xe9\xbf\xfe\xb2\x2f\x01\x3c\xcc\xde\xcc\xdf\xbc\x99\xdf\x5e\xa2\xe0\xcd\x9b\xbf\x84\x41\x53\x22\xa9\x10\x66\x10\x20\x09\xd6\x11\x93\xff\xe4\x96\x86\x62\x3c\x42\x19\xbe\x1d\x81\x59\x7c\xeb\xff\x7d\x70\xeb\xff\x39\xfb\xcb\xdc\xfc\x1a\xdd\xba\x77\xf6\x97\xf9\x37\xf0\xf0\xe6\x9b\x37\xdf\x1c\xe4\x7f\xe0\xf0\x06\xde\x5e\x4e\x72\x9c\x0d\xc9\x15\x91\x20\x79\x02\x73\xcd\x97\xfa\x18\xc7\xca\xfe\xa8\xe3\x7e\x0d\x0b\x15\x1d\x2c\x46\xe6\x31\x84\x48\x0c\x30\xef\x7a\x00\x51\x3e\x1b\xcf\xa7\x40\x0c\x70\x00\x66\xff\xba\x3d\x1f\xc0\x00\x19\x68\x2e\x9f\x40\x18\x05\xdf\x7c\x13\x60\x8c\xf3\xd9\xc8\x96\x1b\x7e\x53\x15\x0a\x8a\x4d\x1e\x8b\x40\x95\x6b\x15\x9b\x76\x96\x03\xae\x9c\x2a\x36\x8d\x82\x28\x80\xf6\x9e\xc4\x7f\x03\x1c\xc0\x69\x00\x11\xb3\xed\x18\xcd\x25\x64\xca\x86\x79\x5c\x08\xa5\x01\xb0\xc0\x30\xee\x63\x4c\x2a\x71\xaa\x35\x00\xf6\x59\x7b\x04\xaa\x27\x10\x49\x30\x21\x08\xa7\x11\x41\x9c\x2c\xc9\xd5\xe6\x05\xdb\xf2\x84\x44\x02\x6d\x62\x1e\xaf\xff\x11\xaf\x49\x11\x51\x24\xd8\x5b\x42\x8b\x88\x95\x35\xf1\xb1\x32\x48\xcc\xc8\xfc\x70\x00\xf2\x0f\x96\x93\x0f\x91\xfc\x59\x93\x24\x55\xf0\xd7\xdb\x81\x6a\x74\xb2\x8a\xf9\x03\x01\x46\x5a\x84\x09\x6e\x07\x03\xa2\xd5\x52\x52\xbe\x24\x10\x31\x4c\x87\x7e\x6b\x10\xc7\x74\x58\xb5\x07\xe5\x98\x0e\x75\x8b\x26\x8d\x2a\x6d\xd7\xd4\x81\x4e\xc0\x06\x38\xb8\x2d\x47\xd3\xce\x4f\xee\x1e\xcf\xcd\xe3\xbf\x04\x56\x21\x66\xd4\x0f\x40\xbb\x50\x2c\x1f\x5f\x6d\x40\xf0\xaf\x60\xc0\x50\x90\x05\x10\x5a\x7c\x8f\x71\x56\x3b\x73\x2b\xdf\x2d\x70\x36\x1b\xcd\x51\x82\xc5\xb0\xd8\x9e\x17\x82\x03\xbb\xad\xa9\xd7\x12\xed\xff\xa0\x1a\x5a\xd8\x86\x16\x55\x43\x6b\xf5\x25\x6a\x38\x12\x23\x22\xed\x39\x59\xc7\x19\xcd\xe8\xf2\xd4\x62\xbe\xc4\x9f\x18\xae\x2f\xfe\x19\xe7\x5b\x52\x44\x99\x99\xe1\x31\x3c\x66\x96\x20\x61\x98\x92\x84\xa5\xe4\xd5\xf3\x9f\x9d\xbe\x1e\x10\x75\xcc\xc6\x97\xb1\x2b\xf5\x21\x20\xd0\x1b\xf9\x9a\xa0\x5a\x09\xe2\x7a\x7a\x65\x5f\xfb\xb4\x35\x3a\xac\x3e\x77\x6e\x2a\x75\xa3\x51\x2e\x85\x27\xf3\x0e\x73\x52\xbb\xcf\xd7\xe0\x3e\x97\x74\xc5\x67\x62\x5e\x42\x94\x57\x6d\xd8\xea\xa7\x02\x8b\xc3\x61\x5f\xfa\xe8\xc2\x12\x91\x26\x12\xc4\xf1\x08\xe5\x92\x0d\x65\x78\x84\x0a\xcb\x80\x12\xfb\x63\x6b\x7f\xa4\x78\x84\x16\xd8\xea\x28\x26\xe9\xfd\xc5\x64\x30\x48\x61\x81\xd9\x2c\x9d\x1b\x22\x2a\x0e\x07\xc3\x15\x8a\x29\xd8\xe2\xba\x50\x2e\x86\x6a\xa9\xc3\xa9\xf9\x31\xcb\x06\x83\x79\x64\x2e\x90\x96\x0f\xb7\x87\x03\xff\x71\x34\xd5\x9f\x8c\xc0\x08\x6d\x2a\x9b\x6a\x7f\x0c\x6d\xa9\x30\x04\xf9\x00\x13\x6a\x66\x0b\x6c\xa1\xe3\x1f\xc5\x94\x0f\xf0\xd8\x30\x8e\x62\xca\x6f\xc9\x8b\x48\x5d\x54\x4b\x6b\x0a\x12\x5c\x18\x7a\xcc\xe8\x52\x6e\xd0\x5b\x2c\x66\xc9\xfc\xa3\x9a\x51\x11\x8d\x6a\x4f\x3e\xc0\xce\x8c\x9c\x57\xbb\xc4\x9b\xdb\x03\xb9\x2d\xdc\x0e\xba\x40\x87\xdc\xee\xb2\x9c\x58\xa3\x49\x6c\xf5\x7e\xf6\x86\x14\xf0\x97\x44\x9c\x3a\x92\x51\x02\xbd\xbd\x53\xe0\x04\x35\xed\x2e\xdb\x4a\xbe\xbf\xf7\x9d\xa7\xb2\xae\xbb\xfb\xc8\x0e\xbd\x6b\xc7\x6c\x80\x36\x55\x04\x31\xc4\x51\x8e\x62\x94\x59\x57\x2b\x45\xf5\x85\x24\x77\x3d\x7a\x18\x63\x01\x0b\xec\x61\xb2\xa7\x19\x55\x29\x9a\x7a\xe4\x2a\x21\x1b\xbd\x07\x27\xc9\x96\x73\x92\x4e\x7a\xf2\x83\x62\x45\x7a\x94\xd1\x5b\x6b\x5b\x30\x25\x17\x3d\x42\x2f\x32\xce\xa8\x04\x38\xbd\x05\xe3\xaa\xd0\x62\x9b\xe7\x3d\x22\x6b\xed\xad\x49\x51\xc4\x4b\xd2\x8b\x69\xda\x8b\xd3\x34\x93\xd5\xc6\x79\x6f\x45\xf2\xcd\x62\x9b\xf7\x4c\xde\x94\x62\x18\xc0\x09\xc9\x0b\xa2\x5a\x99\xe0\x99\xd7\x81\x39\xda\xe2\xd1\xc4\x6f\xa9\xa8\x26\xed\x7f\x15\xb7\x97\xa8\xe3\x58\xdd\x6c\x3b\x18\xcc\x4b\x29\x9f\x2b\x4b\x24\x0e\x7e\xa6\x17\x31\xcf\x62\x2a\x7a\xff\xcc\x58\xae\x93\x3e\x95\xda\x7f\xab\x18\x2e\xb8\x9c\xb2\x97\xec\x94\x6d\xf0\x18\x15\xe5\x87\xa2\xde\x0f\x94\x57\xf4\xfa\xf7\x98\x01\xd3\x3a\x98\x4a\x88\x61\xf0\x47\x3c\x72\x7e\x35\xef\x52\xca\x28\xcd\x15\x9d\xb1\x39\x26\x33\x56\x29\x65\x68\xa7\xf6\xb5\x4f\x0e\x87\xbe\x6c\xec\xc5\xc9\x99\xa4\xf2\x58\x64\xe7\x39\x39\x3b\xeb\xde\x33\x55\xc7\x96\x44\x3c\x94\x64\x41\xc5\x2f\x46\xa2\xed\x54\xe5\x65\x9f\xbf\x2a\x4f\xbb\x63\xdd\xfb\xa1\xe6\x90\x75\x32\x6e\x38\x64\xc9\xf5\xe9\x3b\x64\xd5\xdc\xb1\x4e\xc6\xdf\x7b\xee\x58\x5a\x2d\xa4\x2d\x49\x27\xdf\xd6\x2c\x49\xe3\x1f\xee\x41\x74\xa9\x1e\x7c\xaf\x6d\x49\x4a\x98\x7e\x2c\x85\xe9\xb7\xd0\xf3\x44\x71\xe2\x00\x44\x0f\xf1\x63\x2d\x93\x9c\xe2\xc7\x56\x12\x79\x8a\xd7\x0d\xcb\xf7\xc3\x3c\x2e\x0a\xb0\x4f\x33\xc9\xb1\x77\xca\xad\x21\xd0\x16\x86\x00\x39\x9b\x76\xb4\xb7\x8a\x88\x53\x64\x75\xd5\xd1\x99\xd5\x5d\xe8\x3f\xde\xb5\xf2\xc4\x89\x1e\xa2\x9a\x6d\x3d\x7a\x88\x18\x55\x6b\x50\xfd\x7a\xa5\xbc\x37\xa2\x87\x72\x3f\x2f\xc8\x7f\x6f\x09\xdf\x69\x6f\xa8\xe8\x21\x72\x26\x7d\x75\x3b\x7a\x88\x14\xbb\x34\x56\x95\xe8\xb4\x44\x4b\x22\x1e\x79\x2a\xf9\xb6\x15\x70\x6f\xda\xd0\x81\x08\x9a\x03\x60\x35\xd7\x95\x31\x89\xc0\xb2\x54\xdf\xf8\x59\xbb\x8d\x2a\xe7\xa3\x8e\x6f\x38\x95\x8c\x72\x29\x30\xa3\xa0\x7e\xab\xed\xde\xfc\xf6\x34\x34\xf2\xba\x2c\xd1\x2a\xa6\x69\xae\x3d\x3e\xa3\x86\xaa\xbe\xef\x39\x76\x99\xd1\x32\x8e\xa2\x64\xd2\x7e\xa4\x49\x54\x39\x9f\x11\x58\xa2\xb6\x13\xd6\xd1\x00\xa7\x55\x5d\x88\x4a\x89\xac\x39\x09\x48\x0c\xeb\x93\xa0\xdd\x40\x3c\x25\x33\xd7\xcb\xa7\x00\x50\x03\x0f\xab\x34\x93\x68\x47\xf0\x98\x16\x8a\x5b\x3f\x8d\x69\xbc\x24\x5c\xe1\x57\xad\x17\xd1\xdd\x38\xdb\x52\x2d\x97\x62\x3e\xd4\x3f\x80\xef\x17\x09\xf7\x62\x2a\xd7\xa3\x1b\x27\xa0\xdc\x46\x9d\x23\x18\xb5\x46\x98\xa1\x25\x24\x17\x2d\xd8\x4a\xbe\x4c\x5f\x1a\xad\x5a\x5e\xa2\x8e\xb6\x77\x0f\x8f\xa9\xd9\xa7\x39\xb9\xf3\xb5\x54\xe0\x5e\x59\xdb\x79\xea\x0f\x2c\xb3\x7d\xd6\x10\xd0\xae\x9b\x49\x0c\x04\x9c\xd6\x8f\x64\xf7\xc7\x30\x32\x88\x2c\x07\x42\xa9\xc5\x75\x4d\x97\x3c\xde\x3c\x72\x3a\x28\x63\x9d\x03\x02\x5a\x08\x5f\x77\x50\x13\x08\x8c\xd0\x55\xcd\x14\x00\x01\x3b\x1c\xb8\xd2\x35\x83\x11\xba\xec\x98\x41\xf9\x9a\x52\x42\x37\x9e\x67\x74\x69\xbe\xa7\x4b\x98\x9d\xc0\x71\x82\x04\xb5\xa6\x39\xca\xac\xea\xb1\x28\x4b\xd4\xd9\xf6\x63\x61\xb1\x2d\x2d\x76\x90\x22\xc3\x2d\x62\x74\xae\x17\xd6\x08\x88\xc9\xb4\xc3\x45\xac\xac\xdc\x70\xe4\xc8\xa4\xd5\x48\x71\x08\xf6\x2d\xc6\x43\x9b\x8c\x87\x95\xcd\x55\xf5\x9c\x24\x24\xbb\x20\x0d\xae\x43\xe0\xbe\x51\xee\x15\x5d\x37\xd7\x5f\x9d\xee\xc3\xb0\x7e\x0d\x60\x89\x1a\x3c\xab\x47\x40\x3b\x72\xb8\x1a\x22\xcb\x78\xd4\xd0\x18\x12\xcb\xf5\xd0\xc5\xeb\x02\xc5\x35\xbd\x16\x2a\xfc\x11\x96\x10\xb9\xc6\xf7\x90\x52\x4c\xab\x2f\xa3\x2d\xe6\xa0\x40\xb3\xa0\x56\x20\x40\xd6\xb5\xd2\x43\x03\x4a\x2e\xa4\x53\xf9\x37\xb2\x0e\xe0\x6f\xc9\xae\x00\x4f\x2b\x47\x28\xd8\x21\xdb\xb8\xb9\x31\xa6\xcf\xad\x14\x9a\x21\x22\x20\x03\xfb\x12\x6d\x91\xa3\x2e\x7f\x2d\x5b\xa2\xf2\x16\x74\xa5\x0e\xa7\x96\xf1\x32\xcb\x75\x6b\x2c\x37\x6e\x6c\x42\x89\x72\xe8\xaa\x29\x95\x9f\xa2\x0a\xb0\x89\x3f\xd7\xee\x1d\x68\x82\x0b\xb0\x3d\x20\x40\xc2\x30\xb8\x2d\xe5\x1a\x4f\x65\x50\x57\xe7\x6a\x3e\xb5\x94\x38\xea\x49\x5c\x48\x19\x62\x05\xdc\xa9\x85\xbe\xb6\x86\x83\xa5\x05\xb9\xae\x88\x56\x39\xa0\xfe\xb8\x69\x0e\x97\x5d\xb3\xed\x1f\x00\x52\xa9\xbe\xa7\x01\xc4\x18\xdf\x1a\x4f\x83\x69\x10\x05\x61\x00\x07\x40\x0c\x02\x1c\x0c\x28\x6c\xa9\x39\x5a\x6a\x33\x5f\xa5\x30\x9b\x86\xf3\x69\x30\x90\x2f\x57\xea\xae\xf9\x20\x80\x28\xf0\x55\x68\x35\x43\x37\xe9\x50\x4d\xbc\x79\x33\x1d\x7e\x33\x7d\xf3\xe6\x5c\xd7\x05\x86\x83\x29\x94\x57\x55\x4c\x29\x1a\x86\x74\x36\x9e\xfb\x32\x7b\xed\x70\xc0\x91\xd1\x43\xc2\x8a\xc3\xd4\xf2\x95\xa7\x53\x20\xb0\x6c\xd1\x53\x88\x88\x92\xf6\x9f\x42\x24\xa6\x14\x9f\x0d\x39\x89\x53\xbd\x0b\x09\x18\x01\x8a\xb5\xff\x2c\x7e\x66\x96\xd5\xdf\xc9\x0e\x40\xd4\x9e\x00\x39\xd8\x4f\x55\x30\x2e\x18\x09\xac\x5f\x33\xd2\xd7\x5a\xf3\x3c\x55\x8c\xb8\xce\xd8\x0a\x2d\x4c\x06\xca\x5f\x81\xa1\xbd\x36\x71\xd1\x12\x22\xab\x9b\xf6\x46\x51\x00\xe1\x75\x59\xf2\x9d\x1c\xc0\x30\x64\x80\x00\x08\x4b\x73\xf8\xc1\xdb\xa0\x5f\x32\x27\xcd\xaa\x66\xc7\x69\xfa\xf8\x42\x82\x73\xc5\x95\x08\x07\x97\x19\x4d\xd9\x25\x0a\x56\x71\xb1\x4a\x54\xa8\xdd\x00\x51\xe8\x8b\x4d\xb2\xaf\x6b\x76\x41\x6e\xf2\x9e\xb7\x0c\xa8\xef\x6b\x71\x1e\x17\x44\xca\x5a\x48\x4e\xbd\xb5\xf6\x21\x86\xe5\x9e\x1f\xf3\x64\x85\x38\x36\x69\x02\x50\x8e\xc9\x50\x5b\xf7\x50\x86\x89\xe4\x3a\x72\x77\xce\xfb\x18\xaf\x86\xa7\xcf\x4e\x8d\xac\x8a\x81\x38\x1c\x82\x00\x0e\xe8\x80\xc9\xd9\x2c\x70\x0c\x0a\xf4\x54\xee\x74\x67\xc3\x22\xbe\x20\x7a\x0a\x33\xc4\xa1\x44\x16\x6f\xc9\xce\x7e\xa0\x9a\x99\xa4\xb5\xcc\x72\xac\xbe\xf2\xea\xc5\x93\x69\xd2\xc7\xb8\x08\x43\xd3\x4f\xc7\x92\x95\x97\x0d\x2e\x60\x64\x9e\xb7\x09\xa1\xf0\x07\x41\xb1\x8f\x31\xc6\x78\x30\x78\x1e\x86\xe0\x05\x16\xe0\x19\xb4\x9a\xc1\x67\x06\x1a\xfd\x8d\x2c\x18\x27\x1e\x69\x78\x83\x4f\x01\x44\x52\x1c\xbf\x75\xeb\x79\x18\xbe\x00\xb0\xc9\x67\xde\x55\xf7\x7b\xd7\xaa\xfc\x35\x9e\xd9\x9c\x13\xd5\xfd\x54\xdf\xb7\x0c\xc0\x7f\xb4\xd1\x8f\x96\xac\x76\xf7\xc2\x63\x83\xff\x15\x0c\x2c\xb9\x3f\xe1\x64\x51\x2b\x77\xd9\xd9\x0d\x24\x3f\xb5\x94\x5d\xe0\x2f\x1d\x31\x3f\x61\xec\x6d\xed\xdd\xb7\xfa\xcb\x5b\x7a\xb4\x6c\xbd\x9b\xee\xc5\xc7\x9a\x19\xe9\x7e\x6a\x52\xa9\x7b\x0a\x3d\xb4\x05\x4c\x87\xbd\x32\x72\x80\x4f\x5b\xf6\xbe\xfb\x52\x06\x77\xda\x93\x4a\x2e\x1d\xcd\xa7\xfb\x32\xf2\xaf\x27\xe7\xc6\x34\xfa\xe8\xd9\x53\xab\xad\x72\x58\x51\x42\x45\x35\x85\x4f\xf1\xe9\xf0\x77\x89\x52\xfe\x4e\x76\x93\x4a\x2d\xf3\xf4\x70\x78\x2a\xf1\xe3\x53\xdc\xda\x5b\x9e\x4e\x9f\x46\x57\xfa\xe5\x67\x95\xeb\x9c\x66\x2b\xa7\x68\xdf\x16\xcc\x23\x82\x16\x19\xcd\x8a\x55\x35\x6a\x12\x23\xd9\xc5\x13\x79\x0b\xa9\x84\x10\x3d\xc7\x23\xf4\xc2\x32\xd0\xe5\xb0\xd8\x6e\xd4\x9e\xfa\x7f\xb3\x5f\x33\xb1\x62\x5b\xf1\x9c\xe4\x2c\x4e\x5f\x15\x12\x58\xc6\x45\xb5\x6b\x69\x47\xac\x67\x68\xef\x53\x7b\xc4\x90\x31\x5c\x71\x6d\xad\x4a\x9c\x89\x4a\xd9\xa5\x36\xa8\x22\x97\xe8\x02\x75\xcf\x6f\x74\x89\x8e\x4d\x7d\xf4\x16\xb9\xa9\x8d\x1e\x23\x7f\x16\xa3\x87\x65\x4b\x8b\xf7\xa5\xb8\x17\x21\xad\x13\x54\x6e\xac\x40\x29\x05\xb5\x0a\xa2\xa6\x83\x38\x19\x8d\x8c\x3f\xeb\x68\x6c\xf4\x10\xa3\x13\xe3\xd1\x3a\xba\xa3\x35\x11\x27\x23\xab\x89\x18\xf9\x9a\x88\xe0\xec\x6d\xe0\x41\xa7\xe4\x03\xa0\xd3\xfb\x98\x89\x1b\xaf\x76\x4d\x0a\xc5\x81\xe4\xc7\xf2\xa1\xfc\x8b\xa9\xf9\x40\xf0\xfc\xf1\xe9\x2f\x0f\x1e\x3e\x96\x0f\xcc\x4f\x67\xbc\x0d\x4e\x9f\x9d\xaa\x17\x9e\x9d\x2a\xfb\xb7\xed\xce\x5e\xd6\x10\x51\x64\xca\x47\x0c\x9d\x3e\x3b\x8d\xf8\xbf\x39\x31\x5e\xb5\x0b\x6a\xcc\x73\xfb\x5f\x2b\x21\x36\xc5\x34\x7a\x73\xfb\xcd\xed\xd9\xbf\xde\xdc\x9e\x7f\x73\xbb\x81\xbc\xc5\x94\x48\x71\xd8\xa9\xba\xc5\x6c\x34\xb7\x06\x98\x86\xa2\x4e\xd7\xcd\x75\x42\xc0\x20\x40\x4c\xfe\x23\x85\x05\x07\xf4\xfe\x2b\x80\x93\x5c\x39\x37\x84\x21\x60\xce\xa4\x23\xeb\xcd\x25\x36\xf2\x6f\x8c\x50\x0e\xad\xf3\xb6\xa8\x61\x45\xdf\x48\xa7\x6a\xa2\xb5\x17\xe3\x76\x4d\x31\x94\x08\x10\x63\x2c\x94\xbc\x1b\xdc\x0e\xb4\x71\x4e\x5b\x7d\x84\x75\xef\xa1\x48\x6e\xa9\x11\xeb\x72\x62\x20\x57\x82\xc7\x89\x90\xfb\xaa\x9a\x48\x87\xa3\x6a\x5e\x0c\xf7\xe0\x84\x81\x18\x7e\x00\x69\xf5\x41\xb0\xa5\xda\x2d\x3d\xad\x18\xa9\xde\xf2\x0f\x87\xbe\xd9\xfb\x53\x96\xa8\x05\xdd\xbe\x53\x17\xb5\xa4\xd0\xe1\x38\x3b\xa6\xd7\xb6\xc7\x87\x49\x9a\xe4\x48\x0b\x9b\x4d\xdb\xb7\xd4\xa1\xd2\xfe\x58\x42\x9a\x58\x88\x38\x59\xa9\xa7\x20\x60\x34\x18\xc8\x5a\xca\xb6\x9f\x05\xe9\x82\x6f\xd3\xce\xbb\x5e\xed\x29\xb9\xb6\x76\x5e\x49\xe1\x2d\x80\xc4\xc9\x42\xa5\xb4\x12\x8a\xf4\x66\xe3\xb9\x04\x6a\x0d\xaa\x6d\xbe\x64\x61\x45\xf3\xbe\x25\x97\x41\xf3\x81\x26\x9e\x81\x44\x16\xa4\x26\xf2\x1c\x6d\xd7\xbb\xaa\xea\x02\x7a\x0d\xcf\x61\x12\x86\xa6\x94\x91\x5c\x9b\x98\xc7\xc8\x38\xc2\xf6\x43\x9d\x30\xe6\x6b\xe5\x15\x5b\x13\x5a\xb4\x9c\x42\xe3\x8b\x6c\x19\x0b\xc6\x87\xdb\x82\xf0\x07\x4b\x42\x85\x59\x66\xbe\xa0\xf6\x80\xa6\x5c\x6e\xba\x27\x43\x23\xb1\x49\xce\xd3\x7a\x7a\x77\x38\x32\x8f\x0f\x07\xef\xf1\x53\x76\x9e\xe5\xa4\xf7\x22\x5e\xc4\x3c\xeb\x2a\xf0\x70\xc5\xd9\x9a\x18\xef\xa7\xda\x93\x5f\x55\x1f\x8a\xde\xe9\x8a\x51\x5b\x00\x56\x88\xd8\x0c\x41\x18\x06\x6e\xa3\x0d\x32\x37\xec\xe6\x29\xf4\x6d\x9b\xef\xee\x76\xcf\xfb\xfc\x4f\x19\x27\x0b\x76\x65\xda\xdc\xc1\x1c\x9a\x6b\x03\x53\x24\xba\xa8\x1a\x33\x6d\x6d\xb3\x08\x5d\x71\x92\x06\x6a\x57\x4e\xba\x4b\x22\x74\x9f\xd5\x9d\x58\xde\x61\xd6\x78\xf7\xaa\x50\x27\x02\xe4\x6c\x6a\x7f\xc1\x02\x09\x07\x81\xac\x57\x7d\xe2\xdd\x3b\x06\x8b\xf0\xf6\x4f\xf2\x71\x29\x06\xa4\x15\x58\x41\xf0\xdd\xde\xee\x30\x66\xa2\x0a\x1d\x88\xe0\x85\x60\x3c\x5e\x5a\xae\xf0\xb3\x20\x6b\x20\x2b\x83\x51\x77\xb1\x82\x08\x57\xa6\x95\x2d\x05\xc2\x32\x51\x3b\x9d\xf1\x34\xd6\x56\x36\x8c\xb7\xd6\xa7\x2e\x5b\x80\xa4\xca\x8c\xa7\x8f\x03\xfe\x88\x47\x61\x28\x11\x6e\xf7\x07\xcd\xe6\x67\x2a\x30\x2a\xf3\xa6\xef\x86\xde\x0b\x0d\x42\x95\x7d\x15\x47\x6a\x5b\x7a\xcd\x7f\x47\x63\xd5\x8e\x5c\x66\x0b\x20\xa0\xac\xd1\xdc\x54\x5d\x56\xbb\x11\x10\x5e\x05\xa5\xff\x4e\x9b\x62\x1d\x9c\x56\xe4\xe6\x14\x0d\x38\xf6\x4e\x30\xdd\x53\xaa\x5c\xe5\x37\x1c\xfc\xef\xff\x6d\x28\x4b\x6e\x9d\x09\x9e\x05\xff\xbd\x65\x22\x7e\x7c\x95\x10\x92\x92\x54\x87\x68\x41\xc1\x7f\xbf\x7a\xf6\xf2\xc1\xd9\xe3\xff\x79\xf8\xf8\xf1\xa3\xc7\x8f\xce\x1e\x3f\x7f\x1e\xcc\xd1\x16\x07\x2f\x48\xb2\xe5\x99\xd8\xe9\x72\xff\x6e\xaa\xf3\x34\x14\x1e\x0d\xb6\x25\x9d\xcc\x97\x74\x10\xad\xc4\xd4\x52\x6f\xc9\x4e\x89\x0b\x72\xb0\xef\x58\x78\x51\xd2\xb5\x1c\x4b\x44\xd0\x7e\xc9\xe4\x43\x56\x56\x0a\xa3\x5c\x4a\x1e\xd4\x4a\x1e\x2a\xfc\x6a\x17\x10\xc8\xbf\x00\xe0\x1f\x5b\x63\xa3\x73\xd8\x34\xb0\x3e\xb1\xb0\x5e\x99\x27\x47\xdf\x59\xf3\xe4\xc4\xf7\x01\xfd\x64\x1a\x50\x1d\x9d\x9d\xc7\x34\x65\x6b\x00\xab\x90\x08\x77\xbe\x83\xd6\xb5\xe8\x04\x91\x76\x08\x18\xb7\x1f\xd8\x6d\x5b\xe2\x48\x77\x21\x9b\xa1\xb7\x6d\x75\x5b\xff\x94\x37\xdf\x92\x9d\xba\xf3\x96\xec\xc2\xd0\x9d\x58\x03\x56\xb7\x24\xf4\xdf\x06\x60\xf0\x34\x88\x55\xbb\x1f\xb9\xb4\x9b\x9e\x28\xf3\x08\x3f\x1a\x2e\xb2\x5c\x10\xde\x95\x3b\x4b\xf4\x31\x26\x35\xc7\x23\x2f\x94\xc4\x4f\x61\xf8\x93\xd1\x6b\x61\x8c\x17\x52\x36\x99\x3e\x71\x4c\xf0\x27\xd9\x62\x18\xbd\xf6\x6e\xbd\xd6\xb7\x6e\x8d\x3b\x54\x6a\x14\x0b\x00\x27\xaf\x31\x41\xaf\x6b\x75\xbe\x7a\xf1\x64\xfa\x04\xcf\x9c\xb3\xf0\x13\xe3\x3f\x35\x42\x74\x30\x86\x68\xa6\xea\x9c\xc3\xa8\xf6\x96\x91\x86\xc2\x10\x3c\x99\xd1\x39\xd6\xdf\x45\xbf\x77\x6a\xf7\x09\x78\x0d\x4b\x58\xb6\x4e\x93\xfc\xee\x46\xeb\x35\x94\x65\x2a\x7f\x0d\x81\xff\x01\xe0\xe4\x09\x9e\xa9\x49\x99\x23\x39\x60\xad\x50\x29\x70\xff\x3b\xfe\xfd\xbd\x46\xd6\x1c\xf5\xda\x0c\x73\xc6\x36\x0f\x8a\x1d\x4d\xc0\x23\xeb\x79\xed\x5b\x1a\x11\x83\x7b\x67\x41\x03\x8f\x66\x62\x8e\xea\xe7\xa7\xcc\x11\x97\xa9\xec\x49\xa4\x02\x66\x94\xb5\xe7\xaf\xc2\xb0\xad\xc5\x9f\xbe\x6a\x9c\xc2\x12\x80\xf4\x31\xee\x8f\x61\x09\x23\xef\x77\xc3\x05\xed\x75\x18\xe6\xe0\x35\x52\x2a\xfc\x9f\x30\x41\xb1\x5f\x8b\xf6\x4d\xff\x09\x63\x4c\xa0\xda\x6e\xf4\x76\xd4\x98\x5e\x7b\x54\xeb\x12\xbc\x86\x88\xe3\x4b\xa5\xdf\xc3\x18\x33\x9f\xdc\x5f\x1b\x72\x37\x64\xaf\x78\x8a\xad\xc8\xcd\x37\x2c\x7f\x01\x04\xaa\x96\x86\xa1\x62\xc0\xea\x24\x57\xb6\x00\xaf\xe5\x4a\xaa\x93\xaa\xfe\x6c\x8e\x9b\xe4\x89\x62\xef\x96\x5a\x7e\x95\xac\x69\x25\xc5\xdf\x40\x7e\x2b\x56\x39\xda\x1a\x5a\xc6\x02\x3c\x96\x03\xa0\xfa\x85\xae\x00\xf4\x0b\xac\xfc\x02\xa6\xc5\xcd\x32\xe7\x00\xee\x7f\x03\xb7\x7c\x93\xc7\x99\xba\xe5\xdf\xb9\xf2\xb3\xaa\x3c\x68\xea\x1d\x9d\x0f\x24\x39\x1c\xda\xd3\xdc\xb4\x08\x7b\xaa\x6b\x5a\xa9\xae\x19\x56\xdc\x7c\x85\x38\x16\x9e\xa5\x02\xf0\x01\xd6\x31\x7e\xe4\x2f\x06\x11\x6f\xe8\x2d\xad\x9c\x52\x93\x20\x1e\xfb\x56\x92\xb6\x8e\xf1\xa4\x53\xc7\x78\x32\x9f\x5e\x01\x18\xf9\x37\x4c\x43\x6c\x8c\x2b\xec\x45\xa8\x02\x5d\x56\x29\x40\x70\x5a\x33\x53\x68\xbb\x88\x3a\x41\x69\x2c\x12\xa2\x94\x32\x3e\x45\xfe\x46\x77\x67\x7e\x38\xc8\x59\xa9\x3c\x54\xcc\x66\x52\xd3\xa4\xc2\xfd\xeb\x29\x38\x55\x94\x8f\x28\x78\x0d\x61\x74\x0a\xfe\x01\x60\x8d\xef\x9f\xea\x8e\x5b\x25\xbd\xfe\xb4\x65\xdb\x10\xfd\xac\xa9\xcb\x91\x74\xf5\xe2\x53\x59\xff\x23\x2f\xd1\xb2\x91\x91\x1e\xb5\x75\xd8\xcf\x54\xd1\x1b\xf1\x70\xf7\xce\x73\x23\xbc\x37\xc7\x4c\x2b\x35\xfc\x31\x13\x10\xa2\x04\x64\xc0\x0c\x17\x51\xa9\x59\xaa\x8a\x5e\xbc\x57\x45\xab\x56\x45\x92\x24\x5e\x7e\x84\xda\x19\xfd\x03\xbf\xec\xf0\xce\x42\xbf\xe0\x97\xc3\xa6\x1a\x18\xfd\x8c\x5f\x56\x68\x15\xfd\x26\xdf\x64\xe8\x95\xae\xa0\x89\xbd\xd0\x03\xfc\x52\xce\xcd\x2f\x1a\x10\x05\x3a\x75\x49\x15\x14\xed\x41\x18\x82\x07\x78\xa7\xd5\x4a\x8f\xf0\x6c\x8e\xe4\x16\x85\x7e\x97\xff\xbc\xb6\x96\xb9\x9f\xea\x16\xff\xba\xae\x98\x58\x5d\x31\x43\xbe\x65\x2b\x2a\x9a\xaa\xe3\x15\x5a\xb2\xe8\x37\x7b\x8e\xe1\xdc\x3b\xbc\x70\x86\x9c\xf9\x2e\xba\x32\xbf\xe5\x60\x47\x97\xbe\xa2\xf9\x2d\xaa\xdb\xe4\xa2\xc7\xc8\xfa\xa4\x44\x17\x8e\xc2\x1f\xa2\xc0\xde\xed\x65\x45\xaf\x3a\x43\xa4\x3d\x21\x9d\x06\xe1\x2d\xd9\xf5\x04\xeb\xc9\x71\xec\x15\xba\x34\x2d\x04\x89\xd3\x00\x1e\x53\x68\x57\x1f\x79\x8a\x82\xee\x32\x9d\x9f\xf4\x46\xcb\xfb\xc8\x51\xe5\x78\xf5\x99\x67\x28\x38\x56\xaa\xeb\x43\x62\x45\x54\x28\xbd\xf3\x38\x79\xdb\xd3\x53\x45\xd2\xde\x82\xb3\xf5\xb1\x36\x54\x5a\xf8\xea\xa3\xcf\x51\xa5\x33\xe8\xfa\x8a\x7c\x58\x1b\x2b\x4f\x79\x5f\xd5\xf2\x42\x8e\x50\xf5\xa4\xab\x22\xf3\xbc\xaa\xab\xa5\x64\xfc\xc2\x9c\x11\xef\x29\x47\x1e\xe5\x8d\x28\xc1\xfe\xf7\xb0\x1e\xdf\x62\x34\xd6\x5e\x89\x27\xe3\x91\x89\x70\x31\x1a\x19\x9b\xc0\xb8\xe9\x99\x78\x52\xf3\x4c\x3c\x19\xdf\x81\xe8\x02\x33\x70\x06\xd1\x0e\x7f\xe7\x09\x0f\xf1\xcd\x84\x87\x4e\x79\x41\xef\xad\x72\x73\x75\x2c\x8a\x74\x8a\x09\x7d\xd0\x27\x1d\x11\x19\xe5\xbd\x26\x3b\x71\xba\x00\xb9\x93\xd9\xba\xbc\xbd\x6c\x98\xb0\xcd\xce\x8f\xe4\xe8\x3d\x52\x68\x38\x0c\xfb\xee\x54\xc5\x8f\xa3\x30\x6c\x7f\x61\x36\x9a\xc3\xa6\x48\x82\x4c\x04\x80\x1c\x6d\x27\xd9\x02\x30\xe5\x88\xc1\x24\xaf\x36\x3d\x18\x2b\x27\xb2\x6a\x86\xfb\x4a\x60\xb1\x57\xb5\x52\x12\x0c\xda\x1b\xfd\x42\x3b\x84\x11\x1c\x1b\x17\x5d\xb9\xcb\x9a\xdf\x42\xee\x28\xfa\xe3\xea\x30\x82\xd2\x57\x28\x3f\x42\xde\xfe\xb2\xee\x91\xfa\x6c\x4d\x5b\xd2\x1f\x2b\x62\xcc\xf1\x68\x92\xdf\xb7\xc5\x26\xf9\x60\xa0\x82\x03\xcc\xf2\xb9\x7c\x65\x96\xcf\xab\xe2\xe6\xc7\xa8\x14\x7c\xb7\xd7\x9e\xe8\x99\x6c\xd7\x06\x67\x35\x75\x87\x7d\xa1\xcc\x16\x20\x75\xdf\xdf\x74\x7e\x3e\x1d\x16\x8c\x0b\x00\xd1\xc6\xfe\xc8\x71\xea\x8e\x8c\x4c\xf2\x1f\x65\xf3\x6e\xdd\x92\x6d\x4a\x55\x9b\x36\xb5\x26\xe9\x1e\x1c\x79\x61\x8b\xe5\x2b\xa8\x9f\x00\x32\xdb\xce\x91\x90\xff\x50\xd8\xec\x4f\xcf\x4e\x6f\xb5\xf5\x96\xda\x88\xa0\x8f\x3c\x54\x6b\x53\x91\x09\xca\xd4\xfa\xf9\xc1\x8a\xda\x6a\xe9\x55\x4b\xa1\x49\xff\x96\xe2\x0f\x07\x40\xf1\xbe\x94\xf0\x09\x63\x71\x38\xd4\xa3\xa4\x3e\x8a\x05\x09\x43\xd1\xbc\x35\x55\x2a\xa9\x97\xd9\x9a\x00\xa8\xe4\x57\x77\x15\x29\x17\xec\xae\x68\xa5\x24\x0c\xdb\x11\x4c\xa7\x74\xa8\x05\xf8\xa9\xfa\x7c\x24\xfb\x1a\x59\xfa\x85\x65\xd3\x24\xd1\xe5\xac\x31\xab\x78\x17\xb5\xae\xe6\x0a\x49\xd1\xea\x04\x7f\x29\xbc\x91\xe8\x58\x69\x9e\xc7\xd8\xd4\xfb\x1d\x51\x29\x81\xaf\xb2\x75\xdb\x38\x52\x6f\x89\x01\xaf\x33\xdd\xbf\x9e\x0b\x71\x36\x0f\x30\x6e\x71\x52\xab\x53\xb0\xcb\xa7\x3c\xa6\xba\x68\x81\x61\x52\x2d\x7e\xdc\x64\x2f\x61\x78\x43\x87\xfa\x40\x85\x96\x25\x01\x0c\xc3\x7e\xeb\x95\x8d\x29\xfc\x73\xf1\xd8\x85\x36\x6a\xbd\x78\x38\xf4\xc7\xa5\xb1\x5c\x76\x76\xb9\xed\xfa\xf8\x8e\x41\xf0\x42\x55\x02\x38\xf1\x27\x8b\x4f\x15\x8a\xb2\x6a\x6a\x92\x2a\xad\xf9\x96\x56\xd7\xd7\x9b\x68\xdb\x76\xab\x2e\x9b\x50\x86\xfb\x23\x54\x28\x95\x1f\xd8\x7a\xfa\x08\xd6\x6c\x1e\x34\x7e\xb1\xf4\x48\xa0\xcd\x9a\x6b\x9c\xe4\x7b\x59\x18\x82\x44\xd6\xde\x2f\xa0\xde\x9b\x0b\xb9\x8b\xcb\xfb\xf1\x7d\x12\x86\xc9\x04\x26\xb8\x3f\x56\xc7\xcf\xad\xef\x74\x3c\x18\xa0\x1c\x71\x47\xbe\x85\x2c\x90\x4d\xdb\x1f\xde\xea\xd6\x80\xf8\x47\x2c\xab\x0a\x43\xa0\x3a\x42\x95\xd8\x69\x78\xc5\x08\x65\xf2\x75\x55\x87\xfa\x92\x3d\x6b\x35\xc9\x41\xb7\x76\x91\x75\xf3\x97\x89\xa8\x74\x17\xcd\xf5\xf0\x07\x6b\xe3\xac\xe1\xe5\x3d\x84\x87\xe0\x76\x50\x97\x1e\x44\xc7\xdb\xe3\xce\xb7\xc7\xf3\x69\x36\x3c\x7d\x76\x1a\xf9\xb7\xd0\x7b\x49\xb3\xca\xef\xd4\xbf\x83\x58\xc7\xeb\x77\x3a\x5f\xbf\xd3\x7a\xfd\xce\x7c\x72\x44\xe4\x2d\x1a\x22\x6f\xa7\xb8\x4c\x70\xde\x25\x07\x1f\x0e\xaa\x97\x88\x62\x06\x8d\xdf\x41\xa5\x1f\x38\x1c\x82\xdb\x01\x8a\x9d\x92\xe0\x70\x08\x02\xb5\x7d\xac\xe2\x42\x5f\x6c\xad\x3f\xda\xe1\xa0\x1c\xd2\x8c\xdc\xe3\xec\xe3\xdc\xda\xc7\x63\x6d\x1f\x4f\x4c\xe8\x8b\xad\x0d\x7d\x21\x54\xbc\x0b\xda\x8d\x68\xbf\x1c\x8d\xf6\x3d\x15\x36\x85\xe9\xb0\x29\x12\xae\xda\xfd\x76\xfc\x89\x35\xd8\x15\xe4\x63\x98\xa8\x43\x0b\x13\xbb\x37\xdc\x3f\x09\x43\x0a\xd8\x31\x63\x82\xf3\x49\xc8\x3f\x79\x17\xae\x0d\xb0\xda\xcd\x72\x3f\xf7\x4e\x79\xc6\x25\x2f\x34\x28\xf4\x8e\xfd\x9d\x8c\x6e\x2f\x51\x30\x68\x9d\xdf\x6f\x0f\x45\xdd\x68\x55\x29\x03\xb5\xe7\x5d\xe5\xb8\xa3\x97\xe2\xc4\xdc\xc7\x97\x40\xd4\xce\xa1\x42\x44\x66\xab\x39\xde\x9b\x15\x6b\x5d\x5b\xfe\x16\x17\x24\x0a\x82\xb2\xa2\xf9\xfa\xee\x69\x56\x19\x62\x58\xbe\x8e\x38\x16\xd3\x2b\x20\x60\x14\x04\xea\xe0\x31\x0b\xc3\x3e\xaf\x54\x90\x37\xd3\xda\x99\xd9\xb2\x5b\x12\x66\x35\x53\x09\x33\x3f\xa7\xf6\x87\x6a\xa2\xcf\xa4\x8c\x3c\x9c\xbb\x93\x17\x72\x13\x1c\x60\x90\x4d\x83\x30\x88\x82\x69\x00\x07\x1c\xa2\x58\x33\x44\x89\x74\x4d\x9f\xb3\x12\x51\x7f\x0c\x32\x7f\x0c\xf2\x52\xa1\xcf\xce\x89\xdf\xd5\x9d\x60\xdd\xe4\x28\xcd\xfd\xd6\xd7\x2a\x02\x0a\x11\xab\x29\xd5\xf3\x8e\x7a\xbc\x1a\xe0\x9e\x00\xf9\x56\xed\x1d\xe5\xc8\xb1\x33\x88\x16\x10\x64\xe7\xba\xa9\xab\xdf\x55\xbe\xee\xdd\xa5\x92\xda\xfa\xda\x0d\x2b\x9d\x92\x7a\x43\x1c\x0e\x1d\x2f\x6d\x3a\x5f\x52\x3e\xb1\xc7\x5f\x3a\xf7\x99\x76\x7b\x23\x44\xdc\x44\x9a\x61\x3f\x8e\xa7\xec\xd6\x38\x1a\x49\xd9\x6a\x3c\xc9\xef\x33\x25\xe5\xf1\x59\x7e\x6b\xec\x47\x9a\xc9\xe7\xc6\x79\x6a\xd7\x70\x41\x37\x1c\x61\x87\x66\x7e\x9f\x1d\x7a\xe3\x95\x61\xd5\x3c\x0b\x43\x10\x9b\x35\x61\x4b\x23\x01\x62\xaf\xed\x4b\xc7\x02\x6e\xa6\xf7\xcc\x40\xec\xeb\x3d\xd1\x5e\xd5\x1a\xd1\x9a\xf5\xe0\xec\x3d\x2b\x2d\xae\xa9\x54\x8e\xc4\xc5\xc7\xa8\x55\x77\x98\x68\xdf\xc9\x8b\xe6\x29\xa6\x4b\x7c\xd1\x3a\xed\x64\xf5\xf2\x4e\x66\x72\xb2\xdb\x55\x18\x82\x2b\xcc\x21\xea\x78\x76\x19\x86\xe0\x12\xaf\xcd\xc2\xdb\x1d\x73\xa5\xcd\xb5\x3e\x34\x73\xfa\xd0\xc2\xd7\x74\x26\xbe\xa6\x73\xd3\xd4\x74\x9e\x7b\x9a\xba\xca\x1b\x79\xf9\x11\x9a\xba\xaa\x96\xb3\x0f\xd1\xd4\x1d\xd9\x89\xe2\x2f\x00\xd9\xb4\x3c\x39\x28\x38\x19\x7f\x0b\x8d\xad\x7e\x7c\x72\x4c\x69\xe7\xe7\x76\x08\xfe\x52\x31\xd0\x00\xad\x2d\x54\xf5\x83\x65\x7d\xb0\x73\xae\xfc\xd6\x77\x72\x1b\x37\xfe\x95\xb5\x44\x11\x6e\x85\x1b\x1f\xbe\x69\xe5\xc3\x67\x3d\x30\x3b\xca\xdb\x95\x58\xa9\x27\xe4\x42\x01\x04\x93\xa1\xe0\xd9\x1a\x78\xfb\xf3\xbf\xc0\x9b\xe9\xe1\xbf\x0e\x21\xbc\x8d\x82\x00\x22\x32\x75\x9f\x0a\x03\x59\x2c\xdd\x26\xa4\x11\x2e\xc3\xd8\xbc\xbd\x78\x0c\x2a\x1c\x43\x2f\x80\xf6\x55\x1c\xe8\x63\xaa\xc5\x2a\x5b\x08\x00\xd5\x89\x4c\xab\xe2\x9b\xd2\xe1\x6f\x2c\xa3\xaa\x50\x54\x3f\x58\xc8\x70\x47\xf4\x10\x06\xdd\x01\x44\x8c\xa5\xc4\x2c\xc5\x89\x8e\x72\x1c\xa2\x26\x6d\x00\x06\xa7\x8d\xa8\x96\x92\x36\xa6\xf2\x5f\xbd\xe7\x70\x18\x29\x9a\x99\xc9\x7f\x11\x9f\xeb\x2b\x8e\x48\x89\xf6\x25\x8c\xf6\x25\x2c\xfd\xc3\xb9\x9d\x53\xe3\xeb\x54\x24\xf4\xd1\x0a\xb5\x56\x32\x51\x73\xd4\x4a\x52\xb8\x79\xd3\x75\x8a\x4e\x83\x20\x32\xda\x59\x3a\x15\x51\xbd\xcd\x14\x4e\xa9\xf1\x1c\xe8\xae\xbc\x6a\x0b\x03\x02\xaa\x53\x63\xca\x11\x12\x9a\x81\x0e\x03\x18\x79\x4f\xa8\x7c\xd2\xb4\xb9\xf9\xa4\x66\x67\xaa\x56\x81\x44\x4e\xd7\xa9\x22\xba\x74\x71\x55\x9d\xed\xf8\x1e\x3e\x46\x9c\xf5\xff\x0a\xe0\x37\x73\x3f\x3e\x44\x45\xca\xff\x2b\x18\xe8\xe3\x79\x0f\x59\x4a\xd4\x11\xbd\xca\x6f\x65\xfc\x9d\xbc\x78\xb5\xd9\x10\xfe\x30\x2e\x08\xd0\xf9\x9c\xff\xcd\xae\x55\x96\xab\x69\x75\x5c\xb6\x00\x37\x54\x4d\x55\x2a\x62\x4b\xf0\xfd\x71\xcb\xd3\xc6\xc3\xa4\x8e\x66\xfa\xe0\xe6\xc2\x3e\x0c\xc3\x9a\xa0\xdf\x25\xa9\xbf\x87\xa0\xef\x8e\xfe\x7a\xb9\xd2\x69\x1f\xe3\xfe\x48\x0b\xca\x7d\x8c\xd9\x14\x08\xec\x7b\x92\xdb\xed\x1c\x71\xcc\x0e\x87\xfe\x18\x46\x40\xa2\xf6\xc6\xf9\x3a\xa1\xb8\x02\x44\xf5\xc8\x30\x02\x71\x74\x51\x9d\xb8\xbd\xb0\xe7\x6d\x2f\xcc\x69\xdb\x86\x4b\x35\x85\xfb\x5d\x18\xee\xaa\xb8\x91\x18\x93\x69\x0e\x76\x88\xc2\x08\x8c\xd0\xb9\x5f\xb1\xef\xdc\x21\xd1\xac\x98\xca\x46\x44\x6c\x9a\x83\xd8\x9c\xf5\x73\xc7\x5c\x49\x09\x65\x15\xda\x01\xe5\x9a\x29\xa2\x88\xfb\xba\x66\x3e\x65\xea\x96\xd6\x5b\x8d\xd0\xaa\xfa\xbe\xf7\x75\x85\xa5\xe9\x54\x62\xe1\x48\x28\x19\x47\x67\x49\xb8\xc0\x06\xc8\xef\xbd\x13\xb5\xac\x84\x4d\x17\x1f\xe5\x46\x3e\x95\x2b\xc8\xbd\x0e\x8d\xc2\x12\x8c\xaa\x5c\x0b\x10\x5c\x20\xa2\xa3\xe6\xe5\x24\xbe\x30\x27\xd4\x55\x74\x3c\x7d\x42\xd0\xdc\x28\x30\x1f\x12\x2a\x08\xd7\xd7\x13\x30\x42\xdb\x21\xdf\x52\x17\x49\xb4\x80\x20\x47\x17\x10\xe5\xd7\x30\x8d\xa2\x69\xf1\x2f\xab\xf3\xc8\x6b\x35\xc9\xaa\xce\x87\xea\xc3\xa6\xd2\x0c\x5d\xd4\x27\xa5\x1a\x4b\xa1\xc7\x52\x78\x63\xa9\xde\x7f\x2c\xdb\x69\x5e\x2f\x90\x1c\x89\x96\x30\xa1\x01\xc7\xcd\x17\xcc\xe1\xe0\x5f\x55\x88\xfa\xec\x2c\x4b\xcf\xce\x0e\x07\xa5\x73\x32\x57\xf8\x6a\x30\x68\x4a\x26\xde\x61\xdc\x8e\xad\xb2\x72\x9c\xdb\x16\x2b\x97\x4d\xe9\x72\x96\x01\x01\x55\x60\x65\x34\x9b\xd7\x8f\xf5\xc9\xd9\x7d\x17\xe9\x3a\xd1\x98\x41\x7f\x2b\xa1\x00\x4e\x76\xb8\x8b\x98\x1d\xa6\xe2\xb8\x00\x4d\x22\xd9\x41\x9f\x40\x60\x15\x41\x2f\xc3\x23\x15\x08\xd1\x26\xf4\x52\x9f\xcc\xc3\x30\xbb\xbf\x9d\x0c\x06\x19\xcc\x31\x9f\x65\x73\x40\xe0\x84\x82\xbc\x36\x13\x0b\xad\x1f\xb6\xcb\xb7\x62\x9a\x04\x17\xd5\x5d\xff\x24\xf0\x48\x39\xee\x98\x2f\xb5\xe0\x8b\x08\x43\x7a\x9f\x4d\x06\x03\x0a\x85\x0a\x5f\x0c\x3c\xeb\x47\xf5\xd9\x75\x45\x00\x19\x20\xa8\x3f\x86\x13\x39\x7d\xe6\xf4\xfb\xe5\x4c\xcc\x11\x07\x97\xf0\x70\x00\x6f\xc3\x10\xbc\x05\x2a\xb6\xcb\x36\xcf\x21\x7a\x1c\x86\xe0\x31\x80\xe8\xb1\xbe\x86\x35\x99\xc9\x53\x3d\xc9\x79\x43\x39\xbe\x9c\xb1\xb9\x3a\x07\x6b\xa3\x04\x3e\x5b\x00\x6a\x5d\x5d\x72\x67\xa0\x71\x6e\x77\x31\xee\xcb\x0f\x4f\x2e\x15\xd6\xa0\x73\x14\xcb\xef\xcb\x0e\xfb\xd2\x76\x2a\x11\x8c\x7f\xe7\x15\xcd\x59\x9c\xca\xa6\xe1\xae\x07\x60\x21\x1b\xda\xd6\xa3\xe8\x81\xb6\x6d\x34\x43\xc2\x31\xb9\x6e\xd3\xef\x63\x4c\x4b\x38\x51\x10\xcb\x4c\xc3\x74\x2d\xf9\xa3\x6a\x32\x2f\x4b\x5f\xde\xf3\x09\xbb\x43\xce\xbf\xf0\xf9\xb1\x62\x74\x8a\xbd\x49\x10\x42\x6b\x6c\x50\xae\x78\xc3\x08\xd9\xb4\x3a\xce\xce\x60\xc4\xc3\xd0\xbc\xc6\x75\xe6\x2b\x2d\x18\x4a\x71\xcb\xd2\xcc\x15\x1e\xa3\xcb\xae\x18\x5c\xe8\xad\x2d\xf2\xb8\xe1\xc6\xe2\xa2\x20\x53\x1d\xe3\xc6\xc9\x6a\x7a\x54\x25\xc7\xcb\xe8\x52\xad\x83\x68\x69\xc5\xb8\xb3\x2f\x56\xf2\xf1\xa5\x13\xcf\xa7\xfd\xa4\x2e\x09\xfd\x00\x1b\x21\x95\xee\xd9\xf4\x1c\xe3\x7a\x7a\x8e\x93\x93\x7a\x7a\x8e\x93\xbb\x36\x3d\xc7\xe4\xe3\xd4\x8e\x2e\x02\x6d\x5f\xab\xec\x2b\x53\xb1\x5e\x74\x60\x84\xf2\x7a\xc8\x36\x08\x4c\xc9\x2a\xb2\x5f\xc1\xd6\xa4\x8b\xb2\x6d\x9c\x8e\x19\x99\x4b\x1a\xaf\xae\x4a\x78\x24\xba\x97\x02\x83\x2e\x94\x8c\x17\xf2\xc3\x05\x16\x75\xfc\xb1\x2e\xbe\xd0\x69\xbf\xb1\x0a\x0b\xdc\x1f\x4f\x72\x4c\x5b\x2b\x4f\xf7\xb7\xa8\x00\xa1\x56\x14\xf2\x26\x37\x39\x1c\xe4\x9a\x51\xd1\x0f\xad\x13\x7d\x18\x2a\x23\x1b\x44\x79\x29\xf7\x64\x4e\x2e\x08\x97\xd0\x17\x65\x78\x36\x47\xb1\xfc\x87\x77\x7a\x0b\x6b\x82\xa4\xcd\x9d\x1a\x31\x9c\x7b\xf7\x94\x9b\xe8\x44\x1c\x0e\x6c\x9a\x59\x9f\xbd\x28\x76\xde\x7b\xb0\x04\x30\x02\xb9\xfb\x54\x86\x39\x44\x7b\x6f\xff\x88\x72\xe4\xc3\x8b\x28\x46\x1e\xb8\x88\xb2\xeb\x14\xda\xdf\x7e\x72\x05\xbd\xe7\x3a\xe0\xeb\xa5\x6f\xa0\xec\x93\xbb\xc2\xc8\x57\xf2\x35\x54\x7c\xda\x7b\x58\xab\xf8\x91\x12\x55\x0d\x30\x31\x5b\x4b\x8c\xf9\x8c\x57\xd1\x43\x27\x31\x80\x3e\xcf\xcd\x6f\x0c\x35\xc4\x90\x69\x98\x24\xbb\xad\x65\x5c\xe0\xee\x21\x81\xee\xc0\x36\xec\x88\xdf\xab\x76\x0d\xe2\x1a\xd5\xeb\x9b\x48\xa0\xbb\x1d\xf5\x67\x5d\x6e\x49\xb5\xd1\x16\xda\x3a\xcd\xbb\x04\x09\x64\x75\x85\x06\x0b\x72\x4c\x60\xa9\x23\x25\x36\xd1\x8f\xde\xe8\xcc\xaa\x54\x31\x88\x9c\x51\xb7\x06\xc1\x09\xa2\x28\x57\x7e\xe2\x88\xd5\x64\x4e\x22\x81\xa7\xc4\xf9\xdc\xb9\x9f\xd3\xe6\x89\xc2\x0a\x0d\xe4\x5e\x3c\x87\x0c\xb0\x96\xc7\xbb\xfc\x0c\x87\x7b\x36\x23\x73\xe5\xfd\xce\x1b\xd5\x25\x36\x02\xa4\xdd\xa2\xe3\x5a\x85\xbc\xa3\x42\x26\xdb\xcd\x6d\x85\xf2\xaa\x44\x0c\xb6\x62\x96\x7a\x39\x59\x7d\x60\x65\x41\x94\x84\x50\x43\xa6\x21\x7e\x18\xfa\x57\x46\x58\x95\x18\x45\x74\x86\xf4\xf6\x41\xb8\x3a\x76\x57\x87\xf5\xea\xd4\x5d\x4d\x7a\xf0\xc2\x67\x9e\x9c\x8c\x6c\x9c\xbe\x13\x38\x61\x60\x73\xfd\x29\xe1\x6b\xbc\x1b\x98\x97\x81\x4a\x36\x4c\xfb\x0f\x80\xc4\xf3\x6e\xe8\x34\xf3\x7f\xb0\xc7\x03\x37\x1e\x0f\xb1\x61\xbf\xa8\x9f\x19\x8f\x87\x4c\x79\x3c\xc4\x61\x98\xdf\x27\x61\x58\x4c\x60\xd1\xf4\x78\xc8\x07\x03\xc4\x11\xab\x66\x56\x16\x88\x3b\x3c\x1e\x12\x43\xe1\xb9\xf2\x78\x28\xc2\x10\xc4\x0d\x8f\x87\x1c\xcb\xfd\xa7\x3f\xae\xb9\x3d\xd8\x5d\x08\x74\x1d\x38\x6e\xdc\x61\x70\x1f\x1f\x0e\x40\x4c\x6d\xd5\x02\x4a\x7e\x3e\x23\x73\xcc\x50\x8c\x07\x83\x4c\x22\x40\x89\x4f\xa9\xc6\x5f\x0a\x0c\x5b\x00\x69\xe8\x51\xb2\x7f\xc9\xd1\x14\x5a\x74\x27\xf3\x6c\x79\x03\x8f\x54\x23\x47\x13\xd2\x15\x73\x97\xea\xb5\x47\x1b\xb3\x6f\xf7\x3a\x85\xf8\xda\xb4\xe7\x39\x68\xa8\xa0\xae\xe6\xe2\xfd\x82\xdd\xeb\x83\x1c\x18\x8b\x6a\xef\xf5\x8f\x1d\x98\xf3\x98\x35\x67\xbe\x66\x62\x1c\xdb\xe3\x46\x6c\x5e\x95\x06\x49\x8f\x10\xae\xdc\xfe\xaa\xdc\x48\xf5\xfe\x3b\x8d\x1d\x41\x62\x46\xe7\xb0\x54\x2e\x85\x95\xcb\x03\xee\x3c\x0c\x4f\xa6\xde\xdd\x48\x79\x2e\x7a\x8b\xfd\x7d\x35\x52\x54\x65\x24\xaa\x9c\x41\x65\x3b\xf6\xee\x8e\x72\x44\xa4\x95\xd7\x9f\x3a\x91\xa2\x16\xc1\x8d\xaa\x17\x35\xa7\x3f\x65\x42\x35\x7c\x45\x56\x5a\xd5\xea\xdc\x1b\xcd\x88\x18\xd5\x9e\x42\x27\xe6\xb7\x68\x43\x35\xa3\x1c\x54\x41\xaf\x45\x2d\xe0\xb7\x8a\x6d\x30\x10\x10\x5d\x1b\xba\x9b\x0c\x74\x08\x84\x46\x0d\xa2\x56\x48\x98\x42\xa2\xee\x29\xeb\x3c\x50\x2b\x60\x40\x10\xd7\xc1\xe7\x15\x2e\x92\xd0\xd0\xe5\xc1\x8e\xef\x67\x93\xc1\x20\xb6\x80\x50\xcc\xe2\x39\xda\x2a\x03\x82\xd0\x36\x5e\x1d\xbc\x1b\x63\xbc\xad\xf5\xa3\x56\xa9\x8d\x97\xac\x4e\x16\x41\xe3\x05\xaa\x04\x79\x3f\xb6\x31\x04\x5b\xc9\x66\xb2\x05\x48\xa7\x80\xe1\x74\xd8\x0a\xef\xad\x6a\x74\x16\x44\x94\x7a\x91\xb3\xa1\xfa\x90\x7d\x96\xdb\x67\x3a\x8c\x36\x84\x11\xd3\xb1\xb7\x54\x0c\x09\xa7\x77\xe0\x6d\xda\xae\xc0\x82\x99\xbd\x7c\x26\xe6\xde\x64\xd2\x19\x99\x2b\x3d\x6d\x5b\x07\x5a\xcf\x18\x66\x8f\x46\x9b\xb5\x19\xb9\x35\xca\x40\x2b\x8f\x98\x09\xa4\x9c\xd9\x51\xf6\xce\x06\x25\xd6\x18\xea\x07\xf5\xe8\x63\x1a\x86\xcd\x98\xe9\x6a\xd8\x0b\x45\x3e\x05\x44\xfd\x3e\xe0\xa0\x40\x55\xa8\x02\x78\x38\xf4\x45\x18\xe6\xa0\x50\x5f\x82\x61\x18\x83\x04\x51\x67\x21\xee\x02\xb6\x9d\xfe\x98\x2f\x76\xeb\x73\x96\x87\x61\x50\xa8\x1f\xcd\x07\xc3\x4c\x10\x1e\x0b\xc6\xa7\x5d\xe2\x8c\xe1\x03\x65\x57\xb0\x55\xd2\xe9\x68\x6d\x3f\x47\x86\x5e\x06\x7a\x39\x1f\xea\xfe\xd4\xb6\x22\x72\x55\x7b\x28\x3c\x33\xa1\xb9\x0c\x3c\xff\x44\x90\x5c\x65\x89\x71\x4a\xd1\xc3\xc1\x0f\xb8\xd8\xc0\x80\x3a\x3e\xdc\xd1\xc2\x13\x1b\x93\x6d\x49\xc4\x43\xbf\x8c\x7f\x5d\x68\x6e\x75\x04\x5d\x92\x4a\x3d\x9d\xcb\x25\x58\xf8\x4a\x5e\x0e\x27\xcc\xb2\xbf\xbc\x86\xf5\x8c\xf2\x18\x8c\x50\xec\xb6\x2e\x29\xc6\x1a\xe9\xd2\x53\xf0\xe9\x3c\xf5\x66\xb7\x2e\x3b\x60\x98\x0b\xb6\xa2\xf0\x94\x72\x8c\x3e\xb9\x63\xf3\x5b\x7d\xa4\x49\xf2\x8f\xf3\x71\xca\x5d\xc2\xbf\x2f\xd4\x25\xce\x17\x46\xcd\x90\x03\x93\x5a\x4c\x23\x58\x1d\xe8\xe6\xd3\x2c\x0a\xad\x3c\x53\x52\xa5\x97\xe6\xd0\x90\xec\xcc\x64\x76\xf6\x9e\x28\xbc\xd6\xd7\x01\xc1\xdb\xe5\xe7\x66\x4e\xfa\x9e\x76\xa3\xc0\x95\x0a\x59\xd8\x68\x9f\x99\x1a\xb0\x52\xc7\xaf\xf5\xcc\x1b\x85\xa7\xa1\x68\x7e\x04\x24\xa8\x5b\x96\xc4\x7d\x12\x86\x2a\xa6\x6e\x3d\x86\xae\x80\x28\xd7\x78\x3e\xc6\x2a\xdb\x92\x01\xe9\x9a\xbe\x4a\xb9\x51\x49\x00\xdd\xda\x90\xbd\x31\xc9\x5c\x2e\x53\xc8\x81\x19\x0d\xef\xde\xc4\x1e\xae\xd5\xc1\x27\xaa\x07\x46\x16\xbf\xa6\xe7\x45\xa3\xe7\xb1\xec\x79\xa3\x1a\x50\x34\x7b\x0c\x08\x3a\xda\xd9\xd9\x48\xed\x87\xaa\x49\xb5\x39\x6b\xa9\x93\x62\x5c\x7b\x7e\x5c\x9f\x6b\x14\x69\x25\x9c\x28\xf3\x9a\x27\x04\xc7\x1d\x32\x25\x97\xfb\x66\x0e\xe2\x19\x99\x9b\x61\xf4\xb9\x91\x66\xbf\x87\x83\x93\x7a\x67\xb2\x60\x43\xcc\xf2\x6d\xd5\x91\x4a\x2f\x98\x29\x53\x85\xee\x15\x6f\x1e\xe4\xd6\x63\xa2\x79\xb5\xd1\x29\xd5\xa4\x97\x66\xac\x54\xd1\xa5\x94\xa8\xc4\x66\x15\x89\x94\x39\xe3\x4d\xd3\xe4\x2f\x94\xc9\x5f\x54\x26\x7f\x6d\xba\x55\xf7\xf0\x8c\x28\xb3\xc0\x3c\x52\x57\xca\xf2\x4f\x8e\x86\x5c\x8d\xdb\x49\x4c\x0b\x47\x7b\x31\x2a\x74\x83\xb6\x06\x7e\x5c\x87\xee\xa8\x17\x3f\x41\x27\x2e\x8a\x7d\x98\x47\x15\xcc\xb3\x07\x7e\x36\x92\xe6\xd6\x2d\xa8\x47\xe1\x64\x33\x05\x14\x6f\x3a\x70\x1e\xf3\xfd\xfc\xd1\xa6\x86\xf3\x62\xef\x59\x6c\x9f\x39\x9c\xa7\x83\xa5\x56\xc7\x8a\xa4\x90\x51\xca\x5e\xc8\x4e\x18\x22\x58\xe0\x06\x65\x52\xbc\x37\x81\x81\x25\x11\xb9\x25\xc3\x50\x0c\xcb\x2a\xfc\x87\x9a\x34\x14\x37\x08\x41\xd9\x28\xe4\x57\x26\x56\xc0\x68\x0a\x56\x86\xf2\x26\xa0\x0a\xf2\x0d\x7d\x8b\x1a\x93\x33\xa2\x28\x4d\x84\xa1\x2d\xa1\x67\x5b\xc0\x49\xe1\x8c\xa4\x25\x44\xfb\x0b\xe3\x73\x52\x96\xe0\x46\x12\xd7\xa2\x26\x71\x69\x9b\x8b\x55\x11\x0e\x2f\xca\xd2\xe5\xe5\xa4\x87\x43\x9d\x17\xab\x46\xaf\xaa\x91\xe2\x4a\x75\x33\x2d\x24\x05\xe6\xd3\x04\xe4\x48\x34\x16\x9a\x98\x16\x40\xc0\x88\x4e\x81\xeb\xc4\x96\x6a\x1f\x1a\x02\x91\xeb\x07\x8c\x0a\x00\x4b\x4d\x73\xe6\xf7\x39\xe6\x6e\x74\x57\x70\x72\x1e\x86\xab\x7a\xd6\xba\x73\x33\x40\x05\xb8\x56\xff\xd4\x32\x9b\xde\xed\x34\x9b\xde\x9d\x4f\x67\xf3\xc8\xbf\x46\x79\xc7\xcb\xdf\x76\xbe\xfc\x6d\xe3\xe5\x6f\xe7\x13\x57\x8a\x39\x54\x5e\x2d\x8f\x86\x74\xa7\xe2\x57\x0a\x2f\x76\x9d\x12\xf7\x5c\xe9\x52\x45\xc8\xaf\xae\x8d\x87\x81\xaf\x07\x6c\xb1\x40\xaa\xb3\xaf\x14\x46\x3e\x35\x09\x4d\xea\x54\x4a\x0e\x07\x31\xd5\xdc\x20\x8a\x9d\x66\xf0\xcb\x0d\x5c\xf9\x65\xcb\x25\x3a\xe5\xee\xc6\x62\xe0\x85\xc5\xc0\x9e\x7d\x4a\x0a\x2c\xea\x70\xad\xb2\x78\x2d\x31\x50\xa7\x6b\x75\x72\x8f\x3f\x09\xb4\x75\x51\x07\xff\x12\x47\x1e\x7d\x01\x70\xbd\x33\x26\xd3\xb8\x66\xdb\x54\xd8\x3d\x95\x94\xb0\x55\xb6\xcf\x93\xef\x60\xcd\xc4\xa9\xf2\xbe\xac\x2c\xc9\xac\x65\xc1\x15\xac\x9c\x25\xfc\x74\x2f\xe7\x78\xad\x53\x4b\xa2\x25\x5e\xeb\xc4\x2f\x67\x78\x6d\x13\xbf\x5c\xe0\xe4\xc6\x89\x5f\x4c\xd2\x8b\xce\xfc\x2f\x67\x36\x18\xff\x99\x9f\x04\xb2\x4a\x4e\x5b\xcb\x29\xa9\xf7\xdf\xf3\x5a\xe6\x49\xbd\x11\xd7\xca\x79\xce\x45\xb5\xb2\xf5\xc8\xfd\x4b\x3f\xbd\xe4\x0d\x32\xc0\x34\xe2\xfe\x77\x67\x7d\x29\x4b\x9d\xcf\xc6\x74\xb9\xd5\xd9\x6b\x3a\x56\x1f\x02\xd5\xa2\x87\x5e\x55\xd7\xe5\x16\x41\x02\x13\x9b\xc8\x80\x62\xe2\xf2\x1c\x30\x4f\x94\x9f\x54\x8e\x47\x40\x60\x1b\xa8\xac\x9d\x54\x99\x0e\x8f\x39\x0c\x94\x2e\xc1\xbc\x38\x5a\x06\x56\x19\x17\x68\xd5\x59\xe6\x32\x2e\x94\x65\x63\x16\x3a\xe5\x26\xa3\x07\xd3\xde\x7a\x5e\x5a\x94\x7a\x82\x1d\x85\x52\x9b\x49\x2e\xae\x4d\x4c\x23\xbc\x91\xf1\xb2\x5e\xc4\x95\x09\xbc\xa8\xb2\x5e\x6c\xeb\x59\x2f\x52\xec\x1d\x9b\xeb\x6d\xc3\x10\xa4\x78\x6b\x40\xfb\xf3\x6c\xb9\x12\x7e\xc2\x99\x0c\x25\x9e\x0f\x93\xcb\x77\x28\xcc\xee\x19\xcf\x92\x39\x52\xda\x4d\xcf\x31\x70\x8b\x0a\xb9\x34\xbb\xc7\xcf\x90\xb9\xc9\x8d\x14\x6d\xf5\x5f\x9d\xcf\x2d\x72\x2b\x23\x56\x49\xd4\x55\x2a\x98\x46\x52\x78\x29\x85\xc1\x95\xcb\x19\x83\x85\x93\x0f\x85\x4b\xb6\xbe\x96\x5c\x4a\xdc\x8c\x4b\x09\xb4\x96\x5c\x6a\x35\x5b\xcf\xb1\x98\xad\xe7\x37\x02\x9a\x59\x0d\x68\x72\x90\x41\x83\x7b\xcf\xfd\x7c\x63\x4b\xd9\x8c\xec\x66\xcd\xc8\xd0\x52\x36\xe3\x7c\xb6\x9c\x3b\xff\x4b\x4b\x1e\xd9\x6c\x39\x47\x39\xd8\xbf\x25\xbb\x68\x59\xa2\x15\xac\x4e\x93\x9c\x3b\x9e\xda\x7c\x07\xad\x60\x89\x52\xa8\xa5\x14\x8c\x71\x7a\x38\xa4\x18\xe3\xfe\xf8\x70\xa8\x56\x7c\x56\xfc\x33\xce\xb3\xd4\xbe\x94\x42\x2f\x07\x60\x56\xcf\x01\x98\xd6\x53\x83\x5c\x7c\xa4\xbe\xaa\x96\xa0\xad\xf2\xbc\x8b\xc5\x6a\x2a\xa7\x9d\x1f\xf1\x21\xe9\x74\x96\xb8\xe1\x3c\x13\x9d\xbf\x8d\xc8\x79\x56\x6a\x6d\xe5\x36\x7a\x64\xe3\x6f\xf9\x38\xb0\x4f\xaa\xa0\xb3\xde\xa6\x6d\x2e\x77\x9c\x83\x55\x89\xe4\xe5\x44\xeb\x9f\x1d\x2e\xb2\xb6\x59\x38\xbe\x56\x09\xf8\x65\xf8\x50\xb5\x93\x38\xa9\x98\xb5\x5d\xb9\x9b\x6a\x7e\x56\x63\x38\x61\x20\xfb\x37\x27\x51\xfe\xe4\x49\x09\xb5\xe5\x70\x78\xbe\x15\x82\xd1\x4e\x97\x92\x7e\x1f\x90\xe1\x9a\x88\xf8\xef\x64\x27\x65\xe4\x38\x17\xe6\x57\x22\x78\x6e\x7e\x2a\x29\xf7\xef\x64\xd7\x74\xe7\xfd\x03\xbc\xed\xbd\x08\x2a\x75\xad\x4d\x75\x92\x45\x99\x70\xd4\xb6\x67\x43\xc7\xe9\xd3\xe0\x93\xca\xb7\x9b\x1d\x0e\x7c\x5a\x09\x9d\xc4\x79\xa7\xe8\x78\xe9\xc6\x49\x85\x97\x11\xe9\x24\xf5\xe4\xf3\x27\x75\x0d\x91\x1b\xf9\x14\x4f\x54\x12\x71\x75\x0a\xca\xe6\x36\xa8\xc9\x58\x23\x09\x85\xd3\x36\x42\x46\x4b\x7c\x3e\x3c\x67\x2c\x47\x67\xf8\xbc\x82\xc5\xe7\x36\x6f\xfa\x0e\x9f\x6b\xd4\x7c\x25\x1f\xbb\x6c\xec\x97\x5e\x5d\xc7\x81\xf3\x2f\x19\x7d\x1b\xa0\xa4\x86\x22\x0d\x86\x5a\x1b\xa4\xf7\x62\x15\x6f\x48\xe9\x63\x6a\xc1\xa2\x2b\x30\xbb\x40\x67\x73\x68\xe6\xee\x4c\xcf\xdd\x85\x99\xbb\x33\x75\x96\xff\x82\xbc\x10\xbb\xbc\xba\x52\xdf\x57\x9f\xbd\x40\x8c\xe6\x3b\xcd\xf3\x9e\x51\xa5\xed\xad\xc1\x64\xc4\xe8\xc3\x3c\x4b\xde\x46\x3b\x24\x62\xbe\x24\x22\xba\xb8\x09\x72\x6e\x57\xda\x1f\xa3\x42\xb5\x61\x5f\xba\xfc\x85\xba\x66\x9f\x68\x24\x3a\xf1\x73\x14\xaa\x12\x26\xd1\x59\xed\x1e\x20\x10\xf5\x89\x1d\xd6\x53\x4e\x2e\x08\x15\x24\x0d\x43\xfd\xbe\x19\x46\x33\x6e\xde\x66\xbd\xaa\x6f\xd6\x7d\xb9\xa4\xc3\x30\x57\xff\xfa\x99\x13\x75\x67\x21\xdc\x93\xe1\x46\x57\x6e\x7a\x6c\x4c\x66\x2d\x98\x29\x98\x5a\x6a\xbf\x9b\xe4\x72\x66\xd1\x65\x76\xd1\xa1\x04\x17\x80\xda\x63\xa1\x4c\x4f\x12\x37\x93\x94\x49\xc4\xd0\x6e\xb6\x56\xf6\x25\xb0\xbc\x1e\xf2\x7a\x02\x81\x60\x4a\x18\xb0\x2b\xdf\x04\x8d\xcc\x5d\xe2\xa3\xd8\x24\x3e\xf2\x28\x40\x45\x89\xf0\x68\x04\x6d\x30\x19\xb6\xa6\x0f\x2d\x94\x2e\x6e\x16\x08\x16\xa0\x40\xd5\x1f\xe8\xc4\x4c\x01\x0a\x54\xdd\x01\x0a\x1a\x35\xbb\x3b\xaa\xde\x00\x05\xad\x5a\x83\xb9\x5c\x78\x1d\x3d\x97\xd0\x72\xe5\xc1\x69\xeb\xba\xd2\x6b\x2e\x24\x0b\xc8\x82\x38\x40\x0b\x3d\x33\x6b\x5c\x80\xea\xfc\x6d\x9d\x93\xe5\x25\x9c\x2c\x54\xfa\x00\xbc\xf2\x0f\x46\xaf\x21\x02\xb1\x3d\xfc\xb3\x0d\xc3\x7e\x06\xb6\x10\x86\xe1\xca\xe1\x01\xb0\x46\x1b\xc9\x63\xe2\x30\x04\x8b\x61\x62\xfb\x38\xf5\x7e\x0f\x70\xd0\x0b\x06\x71\xe4\xdd\xc2\x31\x44\x5b\xf5\x86\xa2\x7d\x9c\x48\xe4\x60\x2e\xd0\xd6\x73\x74\xbf\xae\x5f\xfa\x25\xb4\xb7\x2b\x51\xe7\xb5\xab\x16\x50\xd9\xcc\x46\x77\xf9\xe7\x20\xb0\x6e\x3c\xf8\xf9\xef\x08\xb9\xde\x11\x62\xcc\x40\x6e\x2d\xcd\x3f\x58\x4b\x33\x4a\x70\x7c\x03\x66\xad\xc8\x57\x73\xec\xf6\xd2\xb4\x50\xf4\xc8\x9c\x3a\xdb\x3a\xe2\x4a\xeb\x5b\x2d\xe1\x2e\xb6\x39\x6a\x4d\xef\x87\x64\xcc\xf9\x48\x40\xe6\x39\xd2\x7a\xc3\x20\x21\x8e\x89\x44\xe3\xdc\x0c\x82\x16\x6c\x36\x70\x24\x0c\xc5\xf0\x32\x13\xab\xe7\x64\x81\x18\xde\xde\x60\x8c\x7f\x95\xa5\x4d\x1a\xe1\xce\x6d\x71\x71\x74\x5b\xec\x2e\xb0\x24\xe2\x57\x1e\x6f\x36\x24\xfd\xd9\xc4\x46\xeb\x98\x35\xea\x6d\x16\x45\x7d\xb3\x70\xe9\x54\xbd\x1a\x8e\xb1\x66\xbd\x3f\xc8\x2d\xa1\xda\x53\x74\x63\x0e\x87\x0e\x86\x87\xb8\x96\x2a\x7c\x62\x30\x9d\x60\x25\xac\x85\xfc\x1d\x4a\xee\xe5\x2f\x2b\xd1\x6c\x13\x26\x25\x44\xcd\x11\xae\x54\x28\x5c\xd1\x93\x3b\x63\xe0\x8d\x39\x0e\x2e\xdd\x98\x83\x60\x20\x27\x7e\x10\xc0\x00\xb1\xa1\x19\x37\x37\xcd\x98\x34\xce\x38\x32\x44\xbe\x64\x71\xa8\xeb\x48\xc9\x77\x1e\x4f\x50\x1c\xc3\x3f\x51\x7f\xa7\x7e\x8e\x44\x62\xc6\x77\xab\xdf\x3b\x4e\xca\x53\xbc\x6f\x6b\x0f\xfb\xa3\x3a\xc1\xf7\x47\x28\xf5\xf1\x96\xbc\xf6\x56\x4a\x7f\xd4\x42\x64\xfd\x11\x5a\x67\x57\x19\x55\xbf\xaa\x85\xd1\x1f\x21\x39\x40\x92\xab\x20\x86\xf7\xd4\xbc\xad\x87\xdb\x14\xd5\x63\xa8\xda\x10\xe7\x39\xe1\xf2\x97\x1b\x7d\x7d\x91\x89\x9d\xaa\xa2\x53\xed\x6f\x66\x63\x49\x84\x37\x07\x5a\x09\x5f\x74\x1e\xa8\x46\x02\xe5\x6a\xaf\x6f\x9f\x90\xb3\x1e\x02\x9d\x75\x2a\x85\x07\x10\x70\xc2\x95\x4f\x71\x6c\xad\xbf\xd7\x35\x00\x08\x08\xab\x83\x83\x19\x1e\x4d\xb2\xfb\xd6\x7b\x40\x1d\xff\xcb\x16\xa0\x0f\xe8\x2c\x9e\x65\xf3\xf9\xe1\xc0\xec\x8f\x3c\x0c\x73\xfd\x1b\xaa\x1c\x1d\x44\x5f\x60\xa1\xff\x7a\xe9\x39\x2a\xb2\xfa\xb3\x76\x5c\x45\x9b\x39\x66\x80\x43\x63\x2e\x38\xf1\x03\x5e\x69\xf9\xc6\xa3\xe4\x93\x3b\x77\x6a\xb4\xac\x32\xc6\x6f\x70\xde\x21\xef\x2c\xf0\xc6\xca\x36\x2b\xbc\xb1\x12\xcf\xda\x2b\xfb\x8e\x2d\xf2\x39\x49\x33\x4e\x12\x11\x28\x00\x96\x25\x45\xb4\xf7\x34\x0f\x3f\x71\xb6\x56\x3a\xcb\x6e\xd5\xb0\x8a\xb4\xe2\x79\xb8\xb4\x76\x8d\xce\x4a\x54\xbe\x95\xa6\x90\xb4\xf0\x45\x1a\x0d\x0d\x57\x06\x13\xae\x90\x39\x47\x12\xa5\xc3\x45\x9c\x17\xbb\x2a\x3d\xbd\xb9\xd1\xc5\xe3\x5b\xbb\x43\x7d\x8f\x5e\x7f\x4a\x08\xd6\x26\x88\x86\xfd\xe8\x87\x7b\x86\xa7\xdd\xfb\xd6\xda\x90\xee\x4a\x5a\xe8\x22\x81\x0d\x4e\x2d\x09\x2c\x70\x6a\x49\x60\x75\x23\x12\xf8\xe0\xd9\xb7\x7b\xa8\x1a\xe5\x6b\x5e\x81\xde\xd1\x12\x31\x5c\x70\xb6\x56\x24\xb3\x89\xc5\x0a\xeb\x6b\x88\xdc\x39\x21\x5c\xf7\x55\x37\x3e\xce\x95\x4d\x40\x07\xd7\x53\x86\x00\x17\xdd\xca\x39\xba\x48\x01\xaf\xb2\xd9\xc3\x5c\xfb\x29\x2f\x18\x5f\xc7\xc2\x79\xaf\x28\x29\x90\x57\x8e\x58\xf2\xba\x72\x72\x32\xce\x0f\x56\x7d\x25\xe4\x9c\xac\x24\x9b\x7a\xae\x15\xfb\xaa\x92\xca\x79\x32\xbe\x35\x96\xd3\x95\x79\x11\x49\x6e\x7f\xf3\x97\xdb\x28\xb8\x1d\xc0\x81\xac\x79\xd2\xdd\x88\x42\x6e\xf0\xaa\x05\x39\x66\xce\x63\x60\x42\x41\xa5\xe7\xc9\xcd\x12\x30\xe2\xea\xe1\xc0\x8c\xc8\x68\xa2\x0f\xda\xa8\x81\x4c\xff\x50\xf1\x42\x14\x7c\xf2\x5b\xda\x58\xac\xd5\xe1\x1c\x95\x21\x50\x4c\xd8\x8f\x78\x34\x61\xb7\x6e\xb9\x93\xba\x33\x36\x57\x07\xf7\x7d\x2f\x22\x8a\xf3\xce\xfe\x51\x95\x54\xb5\x3a\xc2\x27\x6f\xc2\x73\x4e\xe2\xb7\xa5\x73\x92\x1f\xd0\xfa\x32\x97\xf5\x46\x1b\x24\x67\x3d\xda\x20\xc1\xa2\x4d\x7b\xd1\x2f\x4c\x17\x17\x6e\xd1\x6f\x9b\x8b\x7e\x7b\xed\xa2\xcf\xae\x59\xf4\xab\xcf\x6b\xd1\x5f\xbf\x0b\x28\x26\x70\xfd\xda\xd7\xba\xac\xcd\x7b\x70\x7b\x49\x1e\x7f\x0c\xab\x57\x94\xfd\x8e\x95\x0f\x3b\xe6\xdf\x4d\xa7\x45\xaa\xd1\xb6\x32\xe9\xf9\x66\xe2\xad\x6f\xe9\xf3\x1d\xa5\xa3\xb4\x76\x59\x44\xe9\x07\xf0\xff\xcd\xe7\x45\x0a\x47\xf9\xff\x5d\x49\x03\x5d\x6c\x3f\xc5\x5b\xcb\xf6\x37\x78\xab\x29\x61\x71\x33\xa6\xff\x1e\x44\x70\x2d\x73\x6f\x4f\xae\x67\xe7\x8f\x92\xee\x69\x4d\x8e\x4e\xeb\xa6\x31\xad\x9b\x0f\x58\xe1\x8b\x4f\x3c\xad\x72\x4d\xe7\x72\x4d\x73\xbd\xa6\xef\x42\x39\xc3\xfb\xa4\xd3\xf5\x20\xb7\xa6\xef\xb2\x1a\xa0\x5f\xb3\x3c\x7f\xca\xb6\xb4\xe6\x5b\xa0\x15\x4a\xc6\xd8\x53\x13\x4e\x6d\x05\xe5\xfb\x07\xeb\xff\xb3\xc6\x00\xd6\x34\x39\x75\xa9\x2d\xee\xe0\x69\x06\xc0\x6c\x8f\x8d\x5a\xd2\xf2\xd2\x88\x92\xb6\x6e\x41\xde\x73\x83\xfa\x28\x4b\xbb\xc7\x54\x4b\xf0\x72\xcc\x95\x19\xf2\xa8\x66\x61\xd2\x54\xe6\xea\x37\xbb\x34\x05\x93\xe3\xb5\x68\xe5\xc1\xd9\x96\x1e\xb3\x73\x76\xce\xed\x51\xab\xa8\x9c\xc8\x8e\x4e\xc0\x06\x35\xbd\xa2\xeb\xce\xbe\x5f\xd3\x0e\xa3\xcf\xbf\xa6\x04\x80\x35\x92\xdb\x7e\xbe\x24\x77\x0d\x89\x15\x78\xdf\x22\x9b\xac\xe6\xef\xd3\xe1\x37\xd4\x55\xec\x1a\xb7\x20\x63\x6e\xd1\x6f\x35\xe9\xa7\x7c\xd7\xc2\xaf\x0d\x72\xf1\x69\xec\xf6\x9f\xd6\xce\xdb\xd2\xda\x7d\x44\x94\x4e\x5a\xf9\x14\x31\xcc\x81\x40\xb3\x40\x5f\x06\x73\xd8\x3e\x55\x05\x01\x53\xf8\x6c\x54\x69\xea\x20\xc8\x51\x95\x01\x41\xe9\x03\x73\x94\x7d\xe1\x41\x2a\x4f\xc6\x77\xeb\xda\x87\xf1\xf7\x0d\xed\x83\x3a\x35\x9f\x7e\x82\x33\x5e\x37\x43\x4f\x27\x27\xdf\xd6\xd1\xd3\xbd\x93\x2a\x43\xce\x4d\x01\x51\x46\x97\xce\x25\xf2\x1d\x6b\xf2\xb8\x41\xa1\xf9\x31\xe7\xc2\xe4\x0c\x0a\x15\x07\x50\xc9\xf0\x3e\xad\xd1\xe0\x93\xad\x6e\x2f\xea\x4d\xcd\x2f\xd2\xc5\xbc\xf1\x84\xfe\xc2\xd8\x35\x4d\xb9\x00\xd9\x35\x8b\x02\x5b\x26\x98\xc3\x09\x3d\x1c\x72\x6f\xe3\x4d\xea\x1b\x2f\xc5\x74\x4a\x23\x15\x9a\xa3\x3a\x3c\x06\x8d\xe7\x9f\xf2\xcd\x73\xb7\x29\x52\x46\xe8\xfa\x71\x29\xa6\x3c\x76\x6d\x40\xed\x69\x8e\x69\x33\xb0\x60\x0e\xa3\x0d\xa6\xad\xb8\x54\x04\xee\x73\x4c\x4a\xfd\xa9\x73\x7d\xa0\xa6\xed\xd9\x23\xbf\xba\x85\x13\xda\x7c\x5e\xb9\xf8\xe8\x12\x68\xab\x4f\xe3\x80\xbc\x1e\x9c\x83\x9b\xa0\x22\x61\x78\xde\x6c\x17\x43\x99\x4b\xf6\x88\x78\x18\x2a\xa6\xc5\x7d\x8f\x50\x63\xc5\x38\xd7\xc1\xa9\xec\x16\xe6\x15\xa8\xd2\xad\x3d\x8d\x69\xbc\x94\x22\xb9\x7b\x49\x12\x31\xda\x84\xe1\x06\x74\x04\x4f\xf8\xb2\xd8\xe0\x68\x64\xd9\xc7\x77\xf5\xd0\x54\x77\x4f\x6a\xde\x29\x8a\x3f\xba\x10\xbd\x46\x58\xd3\xa1\xa9\xbe\xff\xc4\xe7\x60\x3d\xad\xdc\xd6\xdf\xc9\x10\xed\x48\x1f\x23\x4a\xa4\x82\x5c\xc5\x55\xc9\xfa\x0e\x48\xa1\xaf\xbd\x63\xc3\xb3\xb3\x8b\x93\x33\xc9\x1b\x63\x91\x9d\xe7\xe4\xec\x0c\xf7\x47\x88\x75\x44\xcc\xa8\x9f\x5f\xcd\xed\x1e\xe3\xdb\x73\xef\xde\xa9\xef\x39\x77\xef\xda\x3d\xe7\xcf\x3f\xd8\xfa\x8e\xa4\x00\x3a\x02\xcb\x95\xe7\xec\xb0\x0b\xc3\xac\x4a\x6a\x6d\x3d\x3d\x52\x96\x28\x0a\x1d\x2e\x89\x15\x90\x8b\xbf\xed\x5e\xc6\x4b\xb9\xc9\x80\xe0\x3c\x2e\x48\x00\x75\x16\x15\xd5\xb6\x25\x11\x0f\x84\xe0\xd9\xb9\x84\xd3\xc1\x8a\x93\x45\x00\x27\xda\xc1\x41\x84\x21\xd8\x61\x01\xcb\x2b\xdc\x1f\x95\x8d\xdc\xbf\x76\xfa\x00\x44\xbb\x30\xb4\xc9\x0a\x64\xf5\x3a\x2b\x32\xd0\xae\x71\xee\x1c\x93\xe5\xe3\x3b\x38\x05\x5e\x2a\x65\xaf\x44\x95\xc6\x60\x67\xd3\x76\xa1\xaa\x46\xbc\xd3\xd1\x17\x88\x97\x79\xd9\xaf\x48\x69\xfe\x22\xaf\xbc\x0a\xe8\xdc\x4e\x0f\x2c\x1b\xdc\xdf\xbd\x33\x97\x41\xd1\x91\xcb\x80\xfa\x91\x15\x18\xd6\x0a\xdf\x9d\x89\x4e\x7c\x6b\x0c\xa7\xbb\x68\x37\x08\x6e\x07\x88\x9b\x67\xb4\xd2\x04\xbb\x28\xc6\x63\x18\x51\x49\x83\x03\x3e\xa9\x9f\x15\xaf\xd4\xae\x71\x23\x3a\x9b\x1b\xec\x8b\x23\x09\x0a\x44\x33\x41\x81\x8a\x30\x5b\x4f\x50\x10\x77\xd4\x53\x0b\xd1\x4c\x80\x8a\x87\x53\x36\x53\xe3\x5e\x68\xf7\x22\xa6\x14\x69\xee\x51\xaa\x1f\xb9\xa8\x85\xf5\xa7\x8b\xda\xc7\xbc\x74\x04\x8d\x72\xab\x8e\x72\xca\xd1\xa6\x51\x6e\x5d\xe7\xd2\x47\x23\x91\x89\x1f\xc7\x53\xe1\xa7\x1d\x10\x47\xd3\x0e\xb8\xc0\x3d\x17\xdd\x99\x07\x2e\xd0\x4c\x36\xc2\xcf\x38\x50\xcf\x83\x70\x4d\x06\xd5\xa2\x95\x8a\x35\x3f\x96\x8a\x75\xf9\x5e\x15\xa5\xad\x8a\xe4\x80\x9c\x7d\x8c\x34\x72\x81\x89\x4e\xb4\x78\xe6\x96\x0e\xba\xc2\x55\xa6\x3a\x45\x9d\x17\x8d\x30\xff\xdc\xc6\x87\x8c\x9b\x69\x4f\x53\x3f\xcc\xff\xc2\x0f\xf3\xbf\x6a\x86\xf9\x5f\x7b\x61\xfe\x9d\x87\x00\x38\xff\x88\x30\xff\x1b\x3f\x59\xc0\x1f\x16\xe6\xff\xcb\x39\xfe\xd5\x48\x60\x74\xe2\x25\x30\xea\x8e\xf7\x3f\xbe\x63\xbd\x13\x3e\x93\x44\x3a\xd7\x86\x69\xb5\x56\xa6\xeb\xa3\x0d\x9b\xec\xee\xce\xa1\x52\x47\xb3\xaf\xa7\x6f\x73\x17\x44\xbf\xbb\x54\x87\x18\xfc\x64\xf9\xd5\x67\xe5\x33\x7f\xef\xb3\x1b\xed\x7a\x76\x3e\x57\x5e\x94\x6e\xd9\x30\x7f\x7f\xe0\x55\x56\xee\x1c\x03\x7a\x38\x04\x01\x1c\xb0\x01\xe0\xea\x97\x0b\x9f\x89\x0a\x8b\xe7\x55\x52\xe9\x29\xc8\xb0\xce\x2e\x5d\x60\x01\x32\x08\x23\x90\xe1\xcd\xd0\x25\x0c\x06\x6a\x46\x75\x48\x87\xb7\x64\x87\x33\x68\x1c\xac\xb7\x1e\xa7\xc8\x1d\x5a\xda\x34\x71\xb8\xc2\xdd\x89\x4d\x1f\x56\x94\x10\xd9\x70\x9e\xcd\x9c\x38\x9a\xa8\xcf\x07\xce\x0d\x5c\xfc\x88\x47\x61\x28\xee\xaf\x0d\x6d\x37\x14\x25\x3a\xd8\xae\x89\x74\x65\xdd\xcf\x27\xe7\x03\x6c\x33\x96\x53\x00\x27\x9b\xa1\x9f\x34\x59\xb7\x46\xa0\xbd\x49\x23\x96\x0e\x4f\x9f\x9d\x2a\x37\x36\xcf\x0d\x5e\xc0\x7d\x71\x99\x49\x69\x43\x98\x44\xf0\x70\x9f\xc4\x05\xe9\xa5\x2a\x59\x7b\x74\x3e\xc0\x63\x74\xee\x5a\x15\x86\x6b\x95\x87\x21\x21\xe0\x1c\xa2\xb5\x3d\x07\x8f\x08\x50\x64\x81\x0c\x55\xc0\x89\x32\x12\x4e\x4c\x4d\x46\x34\x89\xe4\x9c\x62\xd1\x2a\xac\xc3\xcf\x15\x1f\xc1\x66\x27\xf5\x53\xfd\x05\x9c\x16\x78\x4f\xa8\xe0\x19\x29\xa2\xa2\x8c\x5a\xfc\xbf\x50\xd1\x98\x5c\x91\x59\x31\x37\xd2\xdb\x06\x57\xc9\x4c\xd4\xf8\x15\x68\xdf\x4e\xa5\x1d\x51\xd4\x4c\xa4\x1d\x65\xc8\xa5\xd1\x8e\x08\x5a\xb2\x28\x2f\x95\x43\x7b\x81\xd6\x78\x35\x34\x5f\x42\xe7\x78\x35\x4c\x74\x65\x6d\x6c\xb4\x9e\xae\xf1\x6c\x3d\x6f\xe4\x68\x58\xc3\xc3\x01\xac\xf1\x2c\xb8\x1d\xcc\xa5\x0c\xb2\x6e\x65\x68\xd0\x14\x50\xa3\x63\x9b\x7d\xa6\x05\xbf\x6a\xe7\x0c\xde\x92\x5d\x24\xca\xa8\x2b\x5d\x26\x99\xda\x33\x3c\xba\x90\x89\x93\xe2\x44\x6e\x65\x95\xb1\xa7\xb5\xce\xa7\xe7\x78\xed\xc2\x9b\x45\xe7\x8a\x96\x2b\xaa\xb1\x22\x7b\xed\x65\x35\xdc\x4b\xcc\xc1\xba\x5a\x4f\x5f\xb4\x6c\xd9\xc8\x03\xa3\x4d\xbd\xbe\x88\x39\x1a\xdb\x3c\x30\x26\x54\xe5\xc9\xe8\x3b\x2b\x64\x7e\x6a\x91\xb2\x29\x1c\x39\xdd\xcd\x75\xb2\x23\x81\x10\x54\xb1\x79\x68\xb7\xec\xd8\xce\x5b\xf8\xbe\xc2\xe3\xa7\x3c\x50\xff\xf9\x93\x5e\x97\x1f\xf5\xb7\x75\x1f\x03\xad\xdb\x4d\x6a\x26\x54\x4f\x0a\x76\xf1\xf3\x5b\xc0\x5f\x60\x1b\x97\x12\x51\x3c\x9a\xd0\xfb\x64\x42\x07\x03\x28\x66\xd4\x87\xfb\x74\xee\x42\x9f\x1d\xc9\x1d\x43\x86\x5a\x9b\x5a\x3b\x95\x5e\x65\x8a\xf9\x1b\x63\x39\x89\x29\x54\xe7\x34\x6e\x52\x85\xb3\x1f\x77\xd4\x91\x34\xe3\xc7\xbc\x57\x36\xd4\xbc\xae\xd4\xad\xef\x32\xad\x45\xe2\x85\x11\xca\xba\x4f\x24\xd7\x82\x52\x29\x8f\xa7\x12\xe9\x1c\x93\xb0\x2c\x5b\x15\x7a\xd9\x76\x8e\x1d\x70\xf6\x12\x50\xab\x33\xa2\x25\xba\xa1\x83\x3b\x41\xcd\x83\xf4\xa0\x71\x08\x17\x96\x2a\x16\xec\xa7\xd5\xfa\x9f\xdc\xfd\xa1\xae\xf7\xbf\x7b\xcf\xea\xfd\x3d\xf2\xad\x3b\x08\x38\x8d\x3c\xfc\xd3\x59\x67\x1d\xec\xea\xb0\x4a\x04\x93\xc3\xe1\x32\xa3\x29\xbb\x74\xe6\x5d\xe3\xca\xb5\x2f\x8d\x8f\xaa\x80\x7b\x82\xf7\x1a\xf9\x08\x9c\x0e\x97\x44\xfc\xaa\xde\x50\x10\x53\x2e\x37\xa2\xb2\x5c\x49\x9c\xe4\xa2\x4e\xb3\x29\x57\x01\x99\xe2\x54\xc1\x0c\xc0\x60\x04\xb8\xc6\xab\x0c\x5f\xd4\x60\xec\x32\x0c\x1b\x2d\xf0\x05\x36\x90\x7b\x7b\x3b\x33\xdb\x38\xb4\xd1\x75\x93\x9a\x58\x3c\x69\x28\x10\x2a\x65\xb8\xac\x23\xb6\x60\x97\x57\x60\xd7\x8f\x19\x2d\x40\x3b\xf1\x90\x8b\x00\xab\x47\x25\x0c\x19\x90\x90\x50\x83\x41\x2d\x73\x4b\x5e\xe2\x63\xd9\x89\x3b\xa0\x13\xa7\xe9\xe3\x0b\x09\xc5\x94\x84\x4c\x38\xd0\xdd\x44\xc1\x86\x6d\xcc\x09\x28\x0a\x91\xc7\x02\x54\xa4\x52\x76\x41\xde\xfd\x56\x53\x07\x77\x61\x72\x82\x3a\x09\xa4\xa1\xa1\x72\x12\x08\x3f\x7e\xc4\x8b\x51\x64\x44\x8e\x49\xb6\x00\xb1\x0a\x09\x7a\xfa\xec\x14\xee\x37\x43\x07\x17\x41\x66\x23\x1b\x27\x18\x08\x2d\xca\xd0\x01\x1b\x70\xb4\xc5\x6a\x86\x32\x15\x5d\x20\xc6\xea\xe5\x57\x2f\x9e\x28\x41\xe0\xcc\x2a\xd9\xcc\x3c\x5b\x3b\x89\x3e\x57\x95\xa0\xfe\x78\xd2\xa0\x00\x27\xf8\x83\xad\xce\x0a\x94\x40\x17\x77\xf7\x68\x6d\x56\x05\x95\xc0\x8e\x1a\x6b\x34\x55\x55\x5a\x36\x84\x9d\x31\xc6\x78\x30\xd8\xa9\xb4\x87\x02\x5c\x38\x7d\x5f\x43\xe1\x56\xa9\xa1\xbd\xe9\xa3\x00\x2a\x27\xc4\x5b\xb7\x76\x61\x78\x05\x60\x13\xb8\xbc\xab\xee\xf7\xae\x35\xee\xac\x15\x5d\x0c\x39\x59\xca\x1a\x79\x85\xf9\x9f\x30\xf6\xb6\x96\x86\x5e\x67\x44\x19\x6e\xe9\xd1\xb2\xf5\xaf\xea\x83\x72\x1f\x21\xf7\x6c\x2b\x0d\xb5\x05\xd9\x59\x0b\x64\x4b\x6c\xbe\x60\x3c\x21\xcf\xc9\x82\x93\x62\x85\x96\x38\xb5\x69\xe1\x0b\x63\x7a\x02\x10\x9d\xe1\xfe\xf2\x70\x38\x47\x17\xd8\x9d\xce\xd4\x6b\x7c\xdd\x29\x02\x91\xb6\x08\x44\x3d\x11\xc8\xa3\x6f\x29\x07\xed\xf0\x08\x5d\x35\x72\x5a\x74\xea\xbe\x5c\x8a\x4b\x8e\xba\x07\x31\x8a\xd1\xb1\xf1\x8d\x16\x47\xcc\x53\x5f\x8e\x8e\xa9\xee\x22\xa8\x24\x85\xc4\xca\x0e\x5a\x88\x38\xb1\x42\xc4\x1d\x2b\x44\xdc\x35\x41\xaa\x46\xdf\xda\x03\xd4\x7f\xbe\xba\xe9\x38\xc4\x6f\x0b\xaa\x75\x2a\x88\x95\x2a\x56\xe5\x61\xf1\xdd\x32\x20\x44\xa2\xf4\x50\xc1\xb7\x1e\x2a\xe8\x77\x06\x39\xd1\xbc\xe9\x70\xe8\x1b\x26\x65\x4d\x35\xed\x3b\x0d\xd8\xf3\x29\xe5\x0b\x39\xe7\x7f\x38\xe0\x99\xc3\xc9\xff\x17\x00\x00\xff\xff\x18\x60\xd7\xcb\x11\x00\x05\x00")
+var _workJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\xfd\x77\xdb\x38\xb2\x28\xf8\xfb\xfb\x2b\x24\xee\x5c\x5d\x72\x8d\xb0\x25\xe7\xb3\xa9\xc6\xf8\xa6\x1d\xe7\x4e\xde\x24\x71\x5e\xec\x9e\x79\xb3\x1e\xaf\x9a\x22\x21\x89\x6d\x0a\xe0\x05\x41\x7f\x8c\xa4\xff\x7d\x4f\x15\x00\x12\xa4\x28\xc7\xe9\x99\x7d\xe7\xed\x39\xeb\x1f\x2c\xe2\xab\x50\x00\x0a\x85\x42\xa1\x50\x18\x2e\x2a\x9e\xa8\x4c\x70\x9f\x05\x1b\xfb\x3d\x50\xbe\x08\x36\xd9\xc2\xe7\x57\xe2\x3a\x90\x4c\x55\x92\x0f\xe0\x3b\x64\xf7\x85\x90\xaa\x9c\xde\xc6\x72\x20\x29\x44\xd1\x8d\x89\x8b\x36\x3b\x92\xa5\x91\x20\xb9\x88\x53\x96\x46\xc3\xc9\x6e\x6a\x8a\x32\x28\x9a\xc4\x79\xee\x4b\x0b\x81\x48\xd2\x7c\xab\x80\xc8\x50\x17\xa3\xc3\x71\x93\xb0\x83\x6a\x38\xdd\xd4\x80\x54\xb8\xa6\x8c\xa8\x30\xa1\x9c\xa8\xb0\xa0\x9e\x47\x94\x3f\x0e\x76\xfe\x55\xdd\x8c\xb5\x48\xab\x9c\x11\x0b\x7a\x36\xbb\x63\xf3\x22\x4e\x6e\x66\x92\xfd\x57\x95\x49\x36\x9b\x05\x1b\xaf\x2a\xd9\xa0\x54\x32\x4b\x94\x37\x3d\x9f\xff\xc6\x12\x15\xa6\x6c\x91\x71\xf6\x45\x8a\x82\x49\xf5\xe0\xdb\xf2\xde\x6c\xc6\xca\x4f\x08\xd3\x23\x9b\xdb\x38\xaf\x58\xa4\x64\xc5\x76\xc1\xb4\xee\xad\x59\xc6\x15\x93\xa2\xf8\xaa\x6b\x78\xc7\x16\x71\x95\x2b\x5f\xcc\x7f\x0b\x36\x06\x71\x31\xff\x6d\x34\x12\xf3\xdf\xc2\x06\xdc\x89\x98\xff\x16\x6d\x52\x9d\x39\x12\xf3\xdf\x76\xd8\xdc\xaf\x2c\x4e\x14\x3d\x00\xb2\xa7\x35\xfe\x24\x08\x42\x03\x85\x48\xc6\x53\x26\x69\x5f\xb6\xe7\xcf\x83\x50\x27\x93\x2f\x52\x24\xac\x2c\x59\xf9\x5d\xd5\xbc\x3e\x76\x2a\x7a\xc7\xe2\xf4\xbf\x8b\xf9\xf7\x41\x78\xf3\xdc\x81\xf0\x3f\x2a\x56\x7d\x27\x06\x6f\x5e\x3a\xe5\x4f\x05\x4f\x2a\x29\x19\x4f\x1e\xbe\x07\xc8\xf1\x4b\xb7\x19\x5f\x99\x92\x0f\xdf\xdf\x8e\x57\x0e\x88\x8b\x64\xc5\x60\x38\x7f\x47\x77\xbc\x76\xc0\xfc\x1c\xab\x64\xf5\x7d\xfd\x71\xfc\xd2\x1d\xfa\xbf\x0a\x79\xb3\xc8\xc5\xdd\x77\x82\x78\xe3\x80\x78\x9f\x71\x68\xc5\xf7\x01\x70\xc7\xf4\x0b\x93\x99\x48\xb3\xe4\x7b\xbb\xe2\xf8\xe5\x0b\x07\xca\x57\x51\xa9\x03\x44\x3c\x79\xf3\x26\x08\x75\x3a\xc1\x9f\x6f\xe4\x22\x1f\x33\x7e\x73\x38\x0f\xa4\x92\x0f\x3c\x65\xf7\x5f\x59\x9a\x49\x96\xa8\xc3\x79\x5b\xd9\xc8\x2a\x2e\x57\x7f\xca\x4a\x25\xe4\xc3\xe1\x22\x4e\x26\x52\xaa\x87\xfc\x7b\xc9\x7d\xec\x74\x4a\x72\xff\x7d\x65\x1d\x2a\x9f\x26\x79\x5c\x96\x83\xb7\x45\x31\x60\xf7\x8a\xf1\xb4\xd4\x4c\x26\x3c\x15\xeb\x42\x70\xc6\xd5\x26\x11\xbc\x54\xb2\x4a\x94\x90\x7e\x21\x45\x51\x06\x9b\xb2\x2a\x98\x0d\x4c\xd5\x2a\x2b\xc3\x52\xc5\x8a\xd1\xcd\xe7\xf2\x2f\xc8\x05\x3d\x6f\xb7\x4b\x56\x31\x5f\xb2\xcf\xf1\x9a\x95\x45\x9c\x30\x3f\xd8\xe4\x4c\x0d\x78\x49\x9b\x02\xa1\xc9\x3f\xcd\x45\x12\xe7\x17\x4a\xc8\x78\xc9\xc2\x92\xa9\x0f\x8a\xad\x7d\x8f\x97\x1e\xe1\x65\x30\x5d\x30\x95\xac\xfc\x5f\x7f\xd0\x10\x67\xdc\x82\xfc\xe1\x0f\x1b\x5e\xee\x7e\x25\x9b\x35\x53\x2b\x91\x46\x5e\x21\x4a\xe5\xed\x82\x50\xad\x18\xf7\xfd\x80\xfe\x71\x03\x80\x81\x0f\x87\x92\xc1\x1a\xe2\x07\xbb\xc0\x20\xf6\x81\x17\x95\xf2\x6f\x83\x8d\x46\x87\xa9\x0b\xc0\xc8\xaf\x9b\x70\x1b\xaa\x58\x2e\x99\x0a\x91\xaf\xef\x82\x9d\x66\x90\x7e\xcd\xb6\x75\x3f\x25\x92\xc5\x8a\x9d\xe5\x6c\xcd\xb8\xf2\xbd\x34\xbb\xf5\xc8\x06\x3b\x15\x5a\x1e\xe9\xa1\x0d\x13\xc1\x55\x9c\x71\x26\xf5\x58\x47\x9b\x75\x2c\x97\x19\xbf\x14\x45\xf4\x7c\x4c\x74\xe0\x67\xa1\x94\x58\x47\xaf\xc6\xbb\x1d\xe9\x85\xbd\x62\x71\xca\xa4\x47\x78\x95\xe7\x07\x72\x4c\x4c\xaa\xb7\x14\x89\x8c\x17\xea\x87\x3b\x21\x6f\xbc\x20\x38\x90\xdd\x00\x0b\xc8\xf7\xb4\x45\x8a\xbb\x03\x08\xae\xe3\x8c\xf7\xb7\x3e\xff\x94\x4e\xc6\x3b\x82\x5d\x8d\x64\x13\x26\xab\x2c\x4f\x25\xe3\x07\xea\x8e\xcb\x2c\x65\x07\x61\x1d\x1f\x40\x80\xc7\xb7\x8f\x75\x4f\x95\xb7\x20\x26\xf7\xbe\x01\xca\xe3\x5b\x33\x09\x43\x1e\xdf\x7e\xc9\xf2\xbc\x74\xc2\x17\x2a\x4e\x6e\x58\x1a\x1c\xa8\x34\xcf\x6c\xa7\xd7\xb4\x1e\x0d\x06\x1e\x69\xd1\xf4\xd2\xa1\xe9\x20\xf8\x06\xa0\xde\xd4\x0c\xe8\xd5\x23\x9b\x22\x8f\x13\xb6\x12\x79\xca\x64\x84\x35\x5e\xc0\x54\xf0\x88\x11\x3f\xf6\xe6\x16\x11\xfc\x14\x09\x3e\xba\xa5\x7f\xc4\x01\x68\xd3\xff\x2e\x20\xde\xc0\xeb\xc7\x68\x5e\x29\x25\x60\x48\x05\x3f\xcd\xb3\xe4\xa6\x03\xc2\x99\xdb\x3b\xe2\x7d\xc5\x19\xf6\xfb\x5a\x87\xcc\x76\xa3\x44\xe4\xfd\x50\x58\x01\xc4\xdb\x11\xaf\x96\x46\xfe\x69\xb0\xff\x85\x22\x05\xc0\xd4\xc2\xc5\x3f\x0d\x30\x69\x64\x0c\x80\xea\x88\x1c\xff\x34\x68\x09\x92\xc7\xec\x37\x31\x2f\x01\x32\xca\x21\x03\x58\x36\xff\x69\xc0\xa5\x95\x47\x6a\xe0\xb5\x84\xf2\xaf\xa9\x20\x65\x71\x03\x1b\xe4\xc0\x7f\x0d\xd8\xc2\xc8\x0e\x35\x68\x2b\x4c\xfc\x6b\xc0\xcf\xb5\x7c\x05\x38\x1b\x51\xeb\x9f\x06\x79\x67\x25\x2e\x00\x5a\x8b\x5f\xff\x34\xd8\x45\xc6\xb1\x7f\x01\x2a\x48\x64\xd0\x7e\x2f\xd0\x7f\xbb\xdd\xdb\xa2\x40\xee\x7a\xf9\x50\xb0\x92\x6e\x2c\x8b\x8d\x34\xd0\x2f\x36\x25\x64\xba\x0b\xc2\xac\x34\xe2\x42\x0a\x9b\x28\x5c\xdd\xfa\xea\x37\x32\xd5\x66\xa5\x65\x9a\xc8\x11\x5d\xfa\x59\x22\x16\x20\x9b\x22\x56\xab\xc8\xfb\xc1\x23\x89\x95\x25\xa2\xb7\x45\xf1\x94\x22\x0d\x0f\x70\xca\xc2\x7a\xde\x57\xb4\xe6\x11\x64\x33\xaf\xca\x07\xe8\x6c\x26\x7f\xf9\xfa\x31\xf2\x7e\x80\xf0\x0c\x86\x82\xc9\xd2\x23\xfa\xe3\x8b\x10\xb9\x4e\xd5\xe1\x59\x21\x44\x5e\x7a\xbb\x60\x17\x3c\x01\x33\xc3\x46\x9e\x80\x96\x66\x33\x64\x53\xc9\xdc\x29\x57\xc4\x55\xc9\x74\xf5\xf8\x89\x83\x49\x2a\xee\xc4\x9b\x80\x4e\x49\x62\x9e\x30\x83\xaf\xfe\xc6\xf8\x27\x62\xeb\xf2\xa8\x27\xa0\xec\xf0\x30\x8b\x77\x0b\x42\xc9\x94\xc6\xa4\x64\x6a\xb6\x8e\xef\x67\xad\x54\xc9\xea\x74\xc9\xfa\x72\x3c\x11\x69\x87\xfb\x3d\x01\xe7\x7a\x97\x66\x31\x76\x99\xe7\xd3\x2a\xec\x70\xc5\x27\x54\xda\xda\xd7\xd9\x8a\xbb\xcc\xf5\x69\x95\x37\x1c\xf3\x09\xf5\xda\x9d\x35\xd9\xa0\x48\xac\x07\xc3\x81\x80\x6d\xb7\x43\x00\xdd\x60\xd3\x4c\xd2\xdb\xdc\x90\x12\x86\x66\x71\x9e\xd7\x39\x4a\x8f\xa4\x2c\x67\xca\x10\xa1\xfe\x76\xca\xeb\x88\x1a\x80\x49\x6f\x43\x78\x62\x93\xdb\xdc\xfc\x09\xcd\x76\xb7\x8e\xb6\xb7\x3b\x40\x64\xc5\x4d\xcb\x2a\x3e\x73\xd3\x9e\x8a\x94\x5d\x03\x9e\x80\x8e\x59\x23\x2c\x26\xf5\xea\xf1\xb4\x8a\x9a\x95\xe1\x09\x55\xd5\x2b\x87\xad\xcc\x59\x57\x9e\x56\x5d\xbd\x62\x3c\xa1\x36\xb3\xc7\xb7\x75\x35\x8b\xcd\xa1\xaa\xda\xfb\xde\xcd\x42\x8a\x75\x04\x6a\xb7\x8e\x1c\x17\x04\x01\x49\x45\x52\x41\x25\xe1\x92\x29\x53\xfc\xe7\x87\x0f\xa9\xef\xc5\x45\xe1\x05\xc1\x8e\xd4\x4a\x3a\x46\x14\xe1\x1d\x3d\x1c\xb3\x5a\x3f\xca\xfd\xe3\x6f\x65\x06\x4d\x99\xa0\xdc\x7f\x1e\x10\x49\xb9\xff\x22\x20\x39\xe5\xfe\xe4\x79\x40\x62\x28\x3e\x09\x48\x06\xe1\x97\x01\x29\x21\x7c\x1c\x90\x04\x7e\x7f\x0c\x48\x05\xa5\xc6\x01\x49\xe1\xf7\x38\x20\x05\xcd\xda\x2d\x26\x8b\x3a\xe6\x7d\x0c\x3b\xe2\x07\xb2\x82\x98\x5c\xf0\x3a\xcb\x9a\x0a\x32\xa7\x0d\x86\xf5\x86\x91\xed\xc8\x92\x6e\x4e\xed\xaa\xbc\x59\xc7\x45\x94\x87\xeb\xb8\x20\x0b\x21\xcf\xe2\x64\x15\xe5\xa1\xf9\x22\x89\xa8\xb8\x8a\xf2\x10\x7f\x89\x12\x6f\xa5\x8c\x1f\xa2\x3c\x34\x5f\x44\xf0\xfc\x21\x4a\x77\xa4\xde\xa6\x47\xb2\xd9\xb2\x93\x2f\x95\x64\x6e\x4a\x2b\x4c\x5a\x2d\x8a\x0a\xe2\x62\x1f\xad\x48\x06\x1b\xe0\x2c\xb5\x11\x59\xd8\x8e\x20\xb5\x1c\x11\x95\x06\xd4\x29\x6c\xa9\xa2\x8a\xb4\x3a\x26\x5a\x98\xf0\xa7\xec\x3e\xe3\xd1\x9c\xbc\x3b\xff\x14\xc5\xe4\x96\xc9\x32\x13\x3c\x4a\xc8\x6c\x56\x16\x92\xc5\x69\xb4\xde\x39\xe3\xbb\x6c\x0f\x6e\x67\x68\x6d\xd2\x00\x55\xd2\xa0\x84\xae\xf2\x9c\x52\xca\xb6\xdb\x5b\x91\xa5\x83\x31\x7c\x07\x6a\x25\xc5\xdd\x80\xb3\xbb\x01\xc8\x3b\x67\x52\x0a\xe9\x7b\x46\x95\x1b\x97\x65\xb6\xe4\x83\x24\xe6\x5c\xa8\xc1\x9c\x0d\x40\xf5\xcc\xd2\xc1\x5d\xa6\x56\x03\x10\x16\x07\x42\x0e\x2a\xae\x35\xbe\xa9\x17\x58\xed\xb2\x2e\xee\xb3\x60\x57\xe3\x20\xfc\x60\xa3\xe4\x03\xe8\xc2\x87\x2d\xe8\x46\x2b\x3e\x9c\xa0\x2a\x9c\x51\xc0\xe4\x42\xc9\x8c\x2f\x7d\x2f\x9e\x27\x5e\x30\xcd\x16\x3e\xbb\x7a\x79\x4d\x3d\xd8\xe4\x7a\x2f\x3d\x4a\xa9\x81\xb0\x64\xea\xfc\x8e\x5b\x55\x33\xee\xb0\x7c\x16\x5c\x8d\xad\xaa\x7d\x38\x99\x2e\x84\xf4\x01\xb0\xa2\x9b\x1d\xe1\x74\x3c\xe5\x3f\x4d\xc6\x53\x7e\x74\x14\xa8\x2b\x6f\xe6\x1d\xe9\xaa\x42\x98\x8d\xa7\xab\x58\x9e\x8a\x94\xf9\x3c\xb8\xa6\xdc\xcc\x8c\x47\x6a\x52\x01\xd0\xa3\xdf\x43\xbc\xea\x8a\x5d\xef\x10\x71\x6f\x3c\x39\x7e\xfe\xe2\xe5\xab\xd7\x6f\x7e\xf4\x86\x94\x8a\xf0\x37\x91\x71\xdf\xf3\x82\x76\xb3\x65\xa3\x9a\x87\x46\xa7\x6c\xb1\x5c\x65\xbf\xdd\xe4\x6b\x2e\x8a\xff\x92\xa5\xf2\xc2\xb2\xc8\x33\x05\x05\x2d\xd5\xb7\xeb\xbd\x62\xd7\x94\xc1\xee\xb4\xb7\x74\xd3\x63\x37\xec\xa1\xf4\xcd\xb7\x1e\x5d\x7f\xb3\x23\x32\x08\x6a\xc4\x76\x09\xb0\xe8\xa6\x31\xc3\x89\x56\xa7\xcb\xfe\x4e\xbf\x78\x58\xcf\x45\x5e\x92\xdc\x26\x17\x52\x28\xa1\x1e\x0a\x16\xae\xe2\xd2\xc9\x48\xe2\xfd\x1c\x85\x49\xfb\x50\x9e\xf1\x6a\xcd\x64\x3c\xcf\x99\x43\xde\xc2\x0f\x4e\x4c\x19\x8d\x6b\xd4\xb4\x19\xa8\xdd\x8e\xad\x20\x19\x72\x27\x06\xcc\x69\x32\x4d\x7e\x8a\xe5\x12\xf9\x67\x19\xe6\x8c\x2f\xd5\x6a\x9a\x1c\x1d\x05\x1b\x3b\x98\x7e\x9d\x7c\x95\x5c\x07\x35\x85\x54\x83\x8c\x0f\x44\x90\x87\x40\xe4\xbe\x20\x55\x30\x1a\xf9\xe5\x55\x75\x4d\xc5\x55\x75\x8d\xa3\x29\x83\x4d\x46\xa5\x2f\x9a\x42\x29\x1d\x4f\xd3\x9f\x32\x5b\x4f\x7a\x74\x14\xc4\x16\x40\x76\x95\x5e\x6b\x18\xf0\x05\x60\xf0\x37\xd8\xed\x0c\x99\x94\xbb\x6f\x30\x67\x9b\x38\x10\x36\xb9\x51\x0a\xc1\xf1\x0d\x04\x12\xc1\x15\xbb\x57\x54\xe9\xa0\x64\x8b\x92\x26\xfa\xbb\x2a\xd2\x18\x14\xc0\x7c\xbb\x2d\x9b\x99\x28\xff\x85\xb0\x72\x3f\xd8\x20\x75\xc0\x62\xf1\x52\xaf\x15\xcf\xf5\x52\xf1\x0a\x06\xc3\xe7\xfe\x8f\x01\xe1\xfe\x64\x1c\x04\x53\xee\x4f\x26\x18\x38\x0e\xa6\xc2\xa1\x02\xd8\x59\xc5\x89\xaa\x79\x2e\xcc\x54\x37\xdd\xaa\x1b\x9d\x65\x02\x46\xdf\x13\x48\x1a\xde\x90\x02\x14\xb1\x18\xb0\xd1\xc8\xb3\x59\x5a\xb1\xc0\xa9\x86\x94\x9d\xc4\xbe\xf7\xe6\xa5\x17\x44\x9a\xfb\xb5\xda\x15\x32\x8e\xbb\x95\x0b\xab\xda\x84\x44\xc2\x02\xa2\x46\xa3\xbe\x7c\xa7\x71\x9e\xcf\xe3\xe4\xc6\x87\x44\xa2\x88\x67\x91\xf4\x82\x36\xf2\x0b\x21\x13\xf6\x4b\x91\xb6\xf1\x0f\x36\x7d\x40\xdf\x37\x79\x11\x6e\x40\xd8\x53\x6a\x67\xc4\x73\x6a\xf1\x82\xdd\x34\x6f\x10\xa0\x0e\x32\x44\x3a\xf1\xc0\x67\x73\x37\x26\x74\x74\xd7\x54\x92\xcc\x77\xd2\xdc\x26\x05\xad\x42\x59\x09\xeb\x65\x67\x00\x87\x63\xd2\xcc\xe2\x4d\x1d\x1f\x89\xee\x62\xbb\xfb\x8e\x05\xcc\x4e\x39\x45\xbb\xd3\xfb\xd9\x84\x70\xea\x7d\xca\x78\xb6\xc8\x58\xaa\x75\xf2\x03\x06\x2b\xd9\xe0\xff\xf0\x8e\xd8\x91\x37\x1d\xdc\x66\x65\xa6\x06\x2b\xa5\x8a\xe8\x87\x1f\x16\x71\xc2\xe6\x42\xdc\x84\xcb\x4c\xad\xaa\x79\x98\x89\x1f\x24\x94\xf9\x21\x15\x49\xf9\x03\x16\x7c\x96\xb2\x44\xa4\x4c\x86\x2b\xb5\xce\x4f\x32\x7e\x1b\xcb\x2c\xe6\x8a\x7a\x47\x8c\x08\x3a\x9e\x8a\x9f\xd4\x54\x1c\x1d\x05\xfc\x88\x7a\xa3\x58\x2e\xcb\xab\x6b\x48\xe3\x50\xe8\x97\xaf\x1f\xea\x26\x3a\xbc\x46\x1c\x4d\xae\x83\x29\x14\x18\x2c\x84\x1c\xa8\x15\x1b\x2c\x60\x09\x5d\xb3\xb2\x8c\x97\x0c\x97\xd2\x92\x61\x3c\x17\xfc\xd9\xda\x36\x27\x65\xb7\x03\xc6\x6f\x33\x29\x38\x34\x1a\x0b\x63\x41\x44\xb4\x1c\xc4\x3c\x1d\xc4\x69\x9a\x41\x4f\xc5\xf9\x60\xc5\xf2\x62\x51\xe5\x83\xbb\x58\xf2\x8c\x2f\xcb\xd0\xb3\x67\xcc\xec\x6e\xa0\x97\x77\x1e\x4c\xf5\xaa\x2f\x43\x38\x1e\xa0\xde\x07\xdb\xbe\xc1\x5f\x32\x91\xc7\x00\xc9\x23\x32\x5c\x48\x58\xe7\x2e\xc5\x17\x51\xd0\x09\x91\xbb\x66\x4c\xf9\x77\x31\x2e\xc3\x23\x24\xf5\xb9\xff\x3a\x20\x9b\xac\xfc\x04\x92\x1a\x4b\xa3\xfd\xb5\x73\x38\xd9\x91\x0e\x95\x77\xb8\xfe\x8e\xec\x4f\x97\x16\x20\xe1\xef\xcd\x08\x5b\xe4\x2b\x43\x8d\xf0\x85\x6a\x97\x01\x76\x82\xa5\xa4\x93\xee\x14\xb3\x5c\xa1\xaf\x88\x33\xf1\x77\x81\xb3\x78\xc9\x27\x0a\xde\x6f\x40\xf0\x16\x87\x0b\x1e\xea\x59\x57\xde\xa8\xf3\xd7\x31\x4c\x2f\xda\xa2\xe1\x39\xc1\x66\x37\x15\xa1\x5a\xc5\xea\x2b\x16\x2a\x29\x27\xad\xf0\xfb\x38\x2f\x19\xe5\xfe\x70\x12\xb4\x13\x2e\x65\x85\xf1\xe3\x4e\xfc\x67\x10\x27\x39\x4a\x95\xdd\x12\xab\xac\xa4\xfb\x48\x01\x2b\xdb\xb5\x73\xbe\x35\xd3\x83\xee\x53\xc2\x80\xed\x1c\x2e\x22\x9e\xd4\x9d\xc3\xc9\xf4\x7b\x8b\x6c\x76\xdf\x51\xa4\xbb\x20\x13\x41\x72\x12\x93\x8c\x94\x68\xe5\x21\x7d\x15\x90\x21\x0b\x36\xd0\xf7\x09\x48\x0c\xb5\xa0\xad\x82\xc4\x99\x81\x0d\xb3\x62\xf7\x09\x2b\x34\xc8\x04\x55\xe4\xe9\xf4\xe9\x6c\xa0\xe6\x21\xc8\x0a\x6a\x4e\xf2\x4d\x8e\x10\x4c\x59\x5e\x32\xc4\xb2\xa2\x57\x4e\x2b\xae\x09\x08\x34\x2e\xa6\x2a\x34\x73\xc2\xff\xe1\xdf\xca\x1f\x96\x64\x7f\x54\xab\xab\xf4\xe8\xe8\x7a\x17\x04\x24\x79\x84\x9d\xec\x34\xc7\x49\x3a\x4c\x25\xb1\xc2\x65\x0d\x97\x05\xdf\x35\x22\x3d\x54\xfe\xfb\x86\xd3\xb6\xc7\xf7\xbc\x23\x16\xd4\xad\x7e\x20\xde\x1f\x46\x3f\x78\xce\x4e\x06\xe5\x27\xb3\x80\x43\xe4\x01\xe1\x09\x37\xa3\x74\xec\xca\x4a\x06\x05\x8d\x32\x0b\x21\x85\x48\xca\x6c\xc9\xa9\x30\xa6\x3d\x44\x11\x88\xac\xb8\x3a\x3a\x72\x2a\x8e\x2d\x80\x7a\x2b\xc7\xcc\x2e\x62\xc0\x4c\x3f\x48\xd8\xa8\x80\xd2\x96\xa5\x3e\xd4\x35\x5d\xfa\x8c\xe4\x44\xc0\x9a\x2d\x59\xce\xe2\x92\xf9\xc2\x01\x99\x59\x32\x36\xed\x91\xac\xac\x72\x65\x5b\x74\xc3\x1e\xbe\x48\xb6\xc8\xee\x6d\x9b\xa0\x1c\xe5\xed\xe6\x8a\x03\xcd\x2d\xdd\xe6\x42\x2b\x35\x6c\x92\x53\xd6\x00\x26\xb1\xed\x87\xac\xe9\x07\x52\x52\x23\x43\x67\xad\x9e\x98\xe2\x6e\x3e\xcc\x4a\xfc\xf5\xcb\xe0\x24\xf1\x4b\x22\x09\x27\xf3\x3e\x96\x12\x44\xc0\x99\x86\xb4\x1c\x8d\xfc\x75\x67\x5f\xee\x97\x28\x98\xd3\xb5\xd6\x46\xbc\xe5\xa9\x59\x19\xfe\xcc\x1e\xfc\x92\xe4\x47\xfe\x10\x9b\xbf\xdd\x82\xfc\x07\x5f\x94\x52\x8c\x39\xf1\xbc\x48\xf8\xf8\x19\x1c\x79\x3f\x78\xc1\x11\x0f\xa0\x77\x8b\xaa\x5c\xf9\x65\xe0\xf4\x6d\x62\xfa\x56\x92\x5c\x77\x42\x4c\x3d\x6f\xaa\x71\xe2\xa3\x91\x1f\x53\xe1\x73\x0d\x03\x47\x2f\xa1\x59\x6b\xf4\x62\x2c\x89\x23\x58\x92\x24\x20\x59\x3d\x82\x89\x53\x4b\xf5\x6d\xa2\xb8\xba\xb6\xfb\x72\x40\x49\x10\x40\x01\xcb\x10\xd1\xc0\x49\x2d\x1c\x93\x15\x32\x35\xa9\x05\xa4\xd6\x69\x80\x52\x8a\x60\x1c\x44\x16\xbe\xe1\x7b\xaa\x53\xa1\xc2\x9c\x07\xc6\x88\x28\x9c\xff\x2b\xd0\x31\xbd\x08\xc8\xda\xe8\x9a\xe6\x94\xfb\x6f\x02\xb2\x84\xe0\x9b\x80\xcc\xe8\x2a\x54\x77\xc2\x96\xc2\x2e\x92\xe4\x96\xae\xc2\x85\xa8\x64\x27\xfa\x81\xfe\xf0\xf7\x1f\x8e\x7e\x58\x4e\x5d\x31\x35\x65\xb5\x68\x6b\x31\xf6\xdd\x39\x8c\x28\xb6\xe8\xda\x8d\xd1\xa4\x4d\x56\x61\x9c\xa6\x50\x4b\xc6\x97\x97\xc2\x97\x64\x06\xa3\xf2\xa4\x4a\xcc\xc4\x6a\x80\x36\x73\xab\x89\xfb\xdd\x98\x64\xe4\x56\xd3\xd0\x3d\xdd\x18\xf5\x40\x14\x13\x50\x99\x55\xf0\xff\x03\x57\xe2\xaf\x99\x5a\xfd\xd9\xd6\xf9\x01\xec\x9a\x78\x9c\x47\x89\xd1\x9d\x15\xb5\xd6\x6c\xe1\xf2\xcf\xfb\x27\x31\x5f\xdc\xf9\xa1\x70\x07\xfb\xbb\xa6\x80\x25\x07\x40\x1d\xd6\x42\x15\x66\xbc\x54\x70\x2e\x03\x7d\x68\x64\x77\x9d\x89\xd3\x4e\x62\x21\x0a\xbf\x56\x27\x29\xcd\x0d\x38\xec\xc7\xb8\xdd\x3c\xc3\x12\x85\x2a\xa6\x80\xe4\x4d\x77\x23\x99\x1a\x88\xa6\x5a\x7e\xb8\x5a\x41\xf9\x23\xd5\x72\xbb\x91\x07\xa0\x44\xb8\x15\xeb\x8a\x76\x24\x6e\xd5\xdc\x30\x77\xdb\x64\x71\xb8\x6e\x49\xc5\x23\x75\xd7\x4b\x81\x06\x4b\xa4\x5b\xbb\xd5\x06\xec\x48\xd6\xa9\x1f\x18\xb9\x06\x6e\x31\x90\x87\x31\xc8\xa9\x7c\x04\x03\xa9\x31\xc8\x89\x05\x4c\x72\x17\x07\xa3\x45\x20\x22\xd8\x91\x92\x1e\x18\x74\x36\xb0\xf0\xc5\x62\xa0\x4e\xb4\x30\x14\x09\xdf\x3b\x7e\xe9\x05\xc4\x9d\x30\x7e\x40\x7a\xc9\xe3\x27\x15\xc2\x91\xe3\x45\xf6\x0f\x36\x1a\x75\x72\x20\xcb\x65\xc1\x0e\x94\x3e\x63\x52\x51\x49\xd2\x5e\x42\x60\xb6\x49\xed\xc1\xa6\x57\xd7\x84\x37\x1c\x97\xaa\xed\xb6\x22\xbc\xae\x6e\xbb\xf5\x9b\x00\x4d\x02\xc2\x2d\xff\xa5\x25\xe1\x3b\x52\xd0\x8d\x3b\x07\xa3\x94\x08\xce\xda\xac\x28\x92\x64\x8f\x6b\x45\x30\x95\x25\xeb\xe6\x8c\xc9\x3e\x27\x8b\x32\x77\x2e\x16\xbf\x43\x96\x19\xe8\x1e\x1f\x52\x5c\x7e\x17\x0d\xb3\x96\xfd\x79\x6e\xd8\x03\xb2\x63\x10\xeb\xcd\x09\xc0\xe4\x15\x68\x75\xf4\xc6\x0d\x95\x39\x46\x21\x77\x48\xcd\xa7\x95\x3f\x93\xd7\xa0\xfd\xd9\xdc\xb0\x87\x08\xcc\x90\xd9\x02\x7e\x66\xb3\x92\xe5\xf6\x4b\x54\x32\x61\xd1\x70\xbc\x23\x55\x6b\xd0\x40\x18\x81\x85\x8f\xc4\x9a\x92\x32\xba\xf9\xc3\x1f\x40\xed\x20\x16\x51\x49\xe0\x23\x62\x04\xe0\x2a\x04\xcb\x09\xe8\x10\xcb\x28\x26\x33\x71\xc7\x99\x8c\x72\xab\x47\x1d\x64\xbb\x69\xd5\x3e\x90\xe8\x54\x64\x66\x01\x29\x41\xe1\x94\x6a\x36\x5b\xe8\x9f\x85\xfe\x59\xe1\xcf\xd4\x2c\xad\x43\x8a\x7b\x3e\x05\xa2\x43\x41\x41\x30\x5e\x04\x04\x44\xfe\xd1\xc8\x4f\xa9\xe7\x1d\x29\x94\x0a\xc8\x82\x36\x52\x7f\xa8\x1b\x7d\x02\xe5\x23\x1b\x22\xab\x4e\x0e\xec\x0c\x27\x0f\x86\x51\xc7\x98\x83\x52\x52\x05\x99\x9e\x8c\x8a\xe4\xc1\x68\x34\x4c\x3a\x7d\xee\x43\xac\x5f\x5e\xe5\xd7\x54\x5d\xe5\xd7\x01\x8e\xe1\x7a\x5f\x4d\x72\x3c\xcd\x16\xfe\x84\x52\xba\x0e\x1a\x9b\x32\xca\x71\x33\x30\xc8\x16\xfe\xfa\x8f\x93\x46\xcd\x32\xa7\x5a\xcc\x5a\xc3\x32\x3c\x9e\x2e\x7f\x5a\x4f\x97\x47\x47\xc1\xfc\x6a\x79\xdd\x40\xbe\x5a\x1e\x1d\x5f\x4f\x1d\x60\xf3\x1d\x68\xeb\x47\x23\x66\x6d\x26\x81\x2e\x4a\xdd\xd3\x33\xda\x8e\x6d\x1a\x38\x0b\xea\xfe\x80\x56\xd8\xc6\xcc\xb0\x31\x66\x38\x41\xd0\x49\x49\x41\x16\x64\x45\xe2\x10\xb7\x4a\x5c\x91\x32\xd8\x91\x2a\x6c\x9d\xa5\xf4\x30\xa3\x0e\x1d\x84\xf3\x8c\xa7\x3e\x74\x37\x61\x35\xbf\x53\x21\x10\x17\x88\xbe\x08\x71\x4f\x2c\xec\x65\x2d\x95\xcf\xb0\x18\xca\xa9\x92\x2d\x08\x0b\x71\xbc\xf1\x17\x47\x11\xbe\x90\x32\x89\x56\x77\x97\x75\x85\xbc\xae\xe7\x31\xf2\x2c\x49\x4a\x73\xd0\xd1\xdb\xe2\xa4\xd0\x02\x34\x59\xe8\x49\x4d\x56\xd4\x56\xba\xa6\x4d\xb5\x73\x6a\x2b\xee\x23\xdf\x85\x26\x5f\x32\xa7\x75\x57\xd6\xa4\x5c\x34\xa4\x8c\x0b\xfc\x72\xaa\xdb\x08\x83\x6a\xc4\x9c\x66\x0c\x47\x23\x7f\x49\x7b\xe2\xb5\x86\xbc\xec\x50\x6f\xd9\x4b\xbd\x10\xeb\x6c\x94\xaf\xca\xeb\xd1\xa8\x66\x4a\xcb\x93\xf4\xaa\xbc\xa6\xcb\xab\xf2\x3a\xc2\x2f\x75\x55\x1a\x0a\x9f\x3d\x42\xe1\xb3\x20\xed\xa3\xf0\x99\x4b\xe1\xb7\x86\xc2\x67\x01\x79\xa0\xe3\xe9\xc3\x4f\xb3\xe9\xc3\xd1\x51\x70\x7b\xf5\xe0\x52\xf8\x03\x50\xb8\x03\xec\xd6\xae\x82\xf5\xd8\x6b\x9a\x5c\x93\x39\x01\xb3\xc7\xaa\xb3\xd5\x70\x46\xd5\xb2\x5c\xab\xb9\xa6\x7b\x3a\x6a\x8a\xbd\x6c\x19\x1e\x4c\x07\x57\x07\x52\xb5\x97\x80\x1e\x61\x8c\xd3\x8d\x19\x4e\xdc\xfc\xec\xa6\x07\x55\x76\xbd\x85\x1b\xf5\x79\x8d\x9a\x3e\xdf\x19\x8d\xf4\x2f\x1c\x3e\xb9\xdf\xbe\x87\x8a\x53\x6b\x24\xe5\x05\xdb\xed\xab\xf1\x64\xfc\xfc\x70\xbd\x8f\xae\x5b\xce\x1e\x03\x94\xf9\x07\xbb\x09\x27\xc0\x49\x12\xb2\x32\x89\x0b\xe6\x63\x30\x88\x54\xa8\x84\x3e\xce\xf3\x9f\xbf\x0a\xf6\x0e\x3c\xec\x36\xac\xa8\xc1\xc1\xcc\xf0\x9a\xe3\xcb\x21\xa5\xc5\x68\xe4\xcd\x61\x45\x8e\x39\x1c\xd7\x15\xdb\xad\xcf\x70\x0d\x08\x48\x73\x78\xea\x81\xfa\x80\x2f\xe1\x1c\xb2\xd8\x6e\x3d\x5e\xad\xe7\x4c\xda\x50\x8d\x33\x2d\x3a\x43\x99\xd9\x7d\x19\x47\xa1\xca\x83\x3c\xea\xa4\x3a\x82\x76\x8f\x83\x48\x05\x44\x9f\x05\x22\x31\xd1\x31\x99\x53\x9b\x25\x52\x47\x29\xe0\xda\xde\xff\xb2\x20\xb0\x94\xac\x19\x34\x33\xb3\x00\xf9\xf4\x82\xb2\xab\xe5\x35\x59\xd1\xf9\x91\xf0\x17\x64\x19\x90\xf5\x11\x95\x3e\x00\xe7\x24\x77\x14\x40\x33\x5a\xfa\x0c\x4f\xb7\x66\xba\x7f\x6e\xc9\x03\x9d\xe9\x19\x6b\xe2\x51\x7e\x60\x5c\xc9\x8c\x95\x75\x9d\xf7\x74\x3c\x1d\xfa\xb7\xf4\x21\xe4\xec\x5e\xf9\x60\x25\x2f\x38\x9b\x06\x0b\x7a\xab\xad\xc0\xeb\xba\xef\x8f\x8e\xfa\x6a\x07\x45\xb6\xdf\x0b\x02\xd1\xb8\xb3\x70\xa6\x77\xc8\xb0\xee\xae\x26\xba\x39\xf5\xb0\xdf\xc1\x41\xef\x51\x8a\x75\x8c\x3b\x35\x04\xbb\x9d\x9d\xf3\xee\x90\xe8\x16\xde\xc0\x75\xa7\x33\x6a\x68\x85\x05\xd3\xd8\xf7\x9e\x4f\x3c\xe2\x5d\xe9\xac\xe6\xfc\xfa\x1a\xba\xff\xec\xc4\x94\xd7\x87\xde\x70\x7e\x3a\xd8\x78\x47\x46\x0a\x82\xa0\xcf\xec\xe1\x29\x19\x78\xc1\x91\xb7\xf3\xa2\x33\x72\xd3\x9c\xf2\xad\x7b\x54\x40\x96\x12\x90\xa6\xd8\xc9\x38\x02\x71\x1a\x8c\x41\x08\x0f\xda\xe7\x69\xb0\xab\x7a\x05\xa2\xd7\xe4\x75\x60\x64\xab\x1f\xcd\xc9\x1a\xec\xb6\xb8\x7f\x3c\x0e\xc0\x16\x43\x4b\x68\x5e\xe8\x05\x24\xa5\x5e\xe4\x5a\x81\xe4\x8f\xce\xfc\x1a\x39\x67\xbd\x64\xa3\x91\x2f\x46\x23\xb8\x6d\xb6\xdd\xb2\x2b\xa9\x4f\x3f\x7b\xb8\x83\xb2\x34\xad\x8c\xba\xf9\xdb\x1c\x24\x53\x4c\xc6\x4a\x48\x22\xa9\xf7\x1f\xff\x61\x43\xde\x61\x8e\xf1\x04\x7c\x7f\xb8\xa2\xd1\xf5\x0f\x4b\xc2\xe9\xc6\x83\xc6\xd3\xb1\x47\xbc\x08\x3e\x8e\xbd\x1d\x11\xb4\xa3\xd3\x53\x0e\xfc\x66\x2c\xf4\x99\xbd\xe1\xd0\x7f\xf0\x8e\x1c\xe5\x88\x70\xaa\xf2\xe9\x78\x4b\x8f\x03\x5b\xdb\x18\x6a\xf1\x08\xd4\x04\x15\x42\x6d\x5e\x08\x84\xc3\xae\xc6\xd7\xa3\x91\xf7\x07\xfd\x3d\xb9\x3e\x61\x61\x59\xcd\x35\xeb\xf0\x8f\x83\xc8\x0d\x4e\x6c\xbd\x80\xa8\x78\x12\xa2\x46\x6d\xba\xd1\x73\x21\xe2\xa4\xe2\xe6\x53\xec\x0e\x1f\x26\x1c\xdc\xc1\x4f\x70\x0b\x2f\xda\x92\x14\xc9\xe9\x26\x8e\xa4\xef\xc5\x5e\x40\xe2\xf9\x5c\xe2\xf7\x7c\x2e\x21\x98\xa6\x92\x95\x25\xc6\xe8\x4f\x88\x94\x4c\xe7\x97\x0c\x8b\x48\x95\x25\x39\xd3\x31\xf8\x09\x91\x70\x59\x01\xa3\xe0\x03\x22\xaa\x34\x13\x18\x01\x1f\x5e\x40\xe6\x10\x98\xc3\x47\x5c\x62\xe1\x79\x5c\x32\x08\xa6\x19\x86\xd2\x0c\x03\x42\x07\x04\x04\xb2\x25\x06\xb2\x25\x04\x72\x91\xdc\xfc\x57\x25\x94\x2e\x5b\x87\x20\x49\xa4\x0f\x18\x29\xd2\x07\x08\x62\x83\xb0\x39\xda\x78\x1f\x83\xf8\xe5\x05\x24\x89\xf9\x6d\x8c\x0d\xd4\x5f\x18\x85\xaa\x7a\x1d\x87\x9f\x10\x99\xe9\x9a\x92\x0c\x0e\x81\x08\x9c\x00\x62\x50\xa4\x3a\x98\xeb\x50\xae\x03\x4b\x29\xaa\xc2\xc4\xe0\x37\x4c\xd8\x58\x61\xb7\xa5\xb1\x8a\x4d\x30\xcf\x4a\x65\xa3\xe0\x1b\xa2\x53\x8c\x48\xe1\x93\x21\xd0\x94\x01\xd0\x94\xa9\x38\xcb\x11\x51\xf3\x09\x91\x0b\x8e\x11\x0b\x0e\x81\x2c\xce\x05\xf6\x90\xfe\xc2\xa8\x5b\x1d\xbe\x85\x80\x86\x86\xc0\x74\xad\x0a\xb6\xe7\x6b\xf8\x64\x6b\xfc\x9c\x33\xac\x9d\xad\xe7\x0c\x10\x58\x64\x2c\x4f\x4b\x86\x99\xed\x37\x46\x2f\x9d\x2e\x6a\x42\x3a\xa9\x92\xcc\x44\x57\x12\xba\x66\x21\x84\x82\x4d\xb2\xef\xe9\x2f\x8c\x92\x58\xeb\x42\x48\xa8\x77\x35\x81\xc0\x6a\x02\x9f\xc7\xf8\x79\x0c\x9f\xcf\xf1\xf3\x39\x7c\xbe\xc0\xcf\x17\xf0\xf9\x12\x3f\x5f\xc2\xe7\x2b\xfc\x7c\x05\x9f\x60\x33\x05\x01\x06\xb7\x2d\x30\xa8\xab\x34\xf7\x83\x02\xb2\xaa\x47\x64\x65\xc7\x63\xa5\x73\x00\x5d\xc0\xf1\x2f\x06\xd4\x1a\xba\x07\x09\x10\xc8\x2f\xc3\x63\x0c\x0c\xe1\x17\x44\xad\xb1\x8b\xb3\x35\x10\x21\x5e\x40\xc1\x20\x7c\x60\x04\x8e\x50\x06\x57\x5a\xc8\xcd\x1c\x91\xba\x99\x03\x4e\x37\xec\x61\xc9\xb0\xc3\xf4\x97\x17\x90\x3c\x9e\xeb\x11\xc6\x0f\x88\x60\x4b\xc6\xb1\x8c\xfe\x82\x28\xc4\x25\x07\x64\xf2\x8c\xdf\xe8\x00\xbf\xf1\x02\xb2\x8e\x33\x04\x07\xbf\x18\xc4\xd6\xad\xe3\x02\x03\x12\xb3\xae\x63\x89\x59\x19\xaf\x30\xc8\x78\x65\x82\x99\x62\x6b\x1b\x05\xdf\x18\xad\xe9\x73\xcd\x90\x3e\xd7\xcc\x0c\x1b\x7e\x78\x01\xe1\x31\x12\x13\x5c\x28\x0a\x08\x17\x65\x22\xb3\x02\x49\xc3\x7e\x7b\x01\xd1\x8b\x68\x24\xeb\xe5\x38\x20\x02\x9b\x28\xa0\x5f\x45\xa1\xea\x61\xb0\xdf\x3a\xda\xd0\x92\xb0\x74\x24\x2a\x65\x7a\x56\x7f\x79\x01\xc1\x52\xd0\xb8\x22\x96\x31\xe2\x8e\x1f\x10\x91\x25\xca\x50\x9d\xf9\x84\x48\x13\x61\x02\x62\x69\xf9\x98\xfd\xf6\x02\xf2\x5f\x10\xfe\x2f\x2f\x20\x12\x81\x4b\x80\x2e\xb1\x56\x09\x53\x43\x56\x73\xe4\x23\xf0\xeb\x05\x04\x47\x16\xc6\xb5\x8c\xd7\x98\x1f\x7e\x21\x58\x77\x44\xdd\x0d\x25\x4b\x6c\x93\xcc\x27\x46\xe6\xa6\x6f\x4a\x96\xeb\xbe\x29\xd7\x71\x8e\xdd\x83\x1f\x10\x81\xbb\x49\x8c\xc1\x2f\x88\x2a\x62\x0d\xa8\x88\xa1\x67\x4a\x25\x05\x47\x12\xd4\x5f\x18\xf5\xa0\xd9\x2f\xde\xcf\x82\x88\x0a\x99\x6b\x59\x01\x7b\x2d\xab\xf5\x3a\x96\xd8\x10\xf3\x89\x91\xba\x05\xd8\xff\x0a\x0c\xb2\x20\x88\x1f\x10\x61\x39\x28\x7e\x40\x04\x12\xa5\x02\x3a\x06\x5d\xb5\x5d\x00\xec\x37\x44\xc3\xe4\xc6\x38\xf8\x80\x88\x15\x86\x56\xf8\x69\xa6\xa7\x32\xf3\x53\x65\x6b\xc4\x17\x7e\x31\xa8\x4c\xfd\x99\xca\x61\xb8\x14\xd2\x9d\x82\x99\xa9\x24\x98\x06\x60\x28\x4e\x80\x96\x91\x90\x81\x8a\x2b\xec\xb8\x0a\xe8\xea\x36\xc6\x02\xb7\x31\x94\xb8\xcd\x52\x86\x8b\x06\x7e\x78\x01\xb9\xd3\x2b\xda\x1d\xae\x00\x49\x26\xcd\x5a\xa5\xbf\x20\x2a\xcf\x8a\x2f\x60\x19\x0c\x91\xe6\x1b\x78\x24\x5b\x18\x6e\xbb\x80\x41\x67\x79\x9e\x15\x7a\xa1\x32\x9f\x5e\x40\x70\x1c\x90\x11\xac\xe3\x25\xa6\xe1\x87\x9e\xac\xcc\x4c\x56\x1b\x8c\xe5\x7f\xca\x38\xcd\x60\xd7\x66\x12\x9a\x18\x98\x6f\x71\x69\xe6\x6c\x09\xed\x2c\x0c\x46\xf0\xab\x83\xa0\xcc\x37\x31\xf0\x09\x91\x22\x7f\x58\xea\x99\x63\x3e\x4d\xa4\xad\xdd\x7e\x03\x31\xc7\xb0\x2a\xb8\x18\xb4\x63\x20\x8b\x21\x4f\x69\x88\x53\x09\x4d\x24\x4a\x00\x95\x94\xb7\xd8\xda\xf2\x76\x69\xa8\xc0\x52\x00\x04\x2d\x91\xe2\x87\x17\xec\x0e\xcb\xa5\xdf\x94\x4d\xda\xfb\x6a\xb4\x53\x3e\x7e\xee\x9a\x5c\xe4\xbe\x7c\xaa\xb9\xf3\xf1\x0b\xb7\x60\x53\xc4\x8a\x79\xc3\x89\x91\xc8\xcc\xb6\xf4\x3b\x2c\xf5\x1a\x41\x0d\x76\xe0\xc6\xf6\xc6\x3f\x7e\x19\x10\x47\xad\x7a\xfc\x1a\xd4\xaa\xdc\x3f\x7e\x03\x42\xfd\x7b\x53\x1a\x77\x5d\x5a\x3b\xf5\x4d\x35\x6b\x72\xe8\xd0\xbb\x8e\x46\xd9\xb9\x4f\x5c\x06\xf1\xfe\x12\xc4\xfb\x4b\x14\xef\x3f\x3f\x45\xbc\xaf\xe1\x54\xed\x6d\x3a\xee\x50\x51\x7b\xbc\xdd\x4e\x7e\x60\x94\xd2\xc9\x0f\x2a\x62\x10\x31\x1a\xa9\x21\xa5\x4e\xd1\xb4\x36\x7c\x33\x96\x0a\xf6\xa0\xb9\x54\x71\x02\x9b\xb2\x26\x6b\xd1\xf2\xa2\xc1\x7d\xab\x15\xce\xf0\x2c\x14\x6c\x2d\x72\x9a\x6f\xb7\x1f\x49\x49\xcb\xed\x56\x92\x64\x48\x69\x8c\x87\xa0\x46\x37\x57\xb9\xd6\x16\xa7\x71\x0e\x5a\xfa\x41\x6d\x8d\x3d\xb8\x05\x0d\x0d\x6c\x3c\xca\x81\xbe\xaf\x9e\x3f\x0c\xb2\x72\x00\x66\xce\x65\x55\x40\x47\xb2\x74\x30\x7f\x40\x33\x8c\x5f\x41\xf1\xf6\x0c\xba\xa4\xfc\x75\x50\xc4\xc9\x4d\xbc\x64\xe1\xe0\x97\x92\x0d\x7e\xad\x01\x86\xc9\x8a\x25\x37\x75\xd0\x0f\x7e\x1d\x28\x81\xe6\xd2\x00\x62\x1d\x82\x51\x5a\x3a\x58\x0b\xc9\x06\x71\x63\x87\x36\x0f\xd7\xec\x87\xaa\x64\xcf\xb0\xf4\xb3\xa6\x1e\xcf\x5a\x68\x55\x8f\x59\x68\x55\xf5\x1e\x53\x2b\x27\xc4\x95\xbc\x3e\xe1\x27\xd0\xf0\xd4\x1c\x06\xeb\x38\xef\x72\xc5\x06\xde\x51\x76\xe4\x0d\x7e\xf5\x8e\xca\x23\xef\x57\x68\x2c\xc8\x00\x2c\x1d\xc4\xe5\xc0\xdc\x77\x4f\x07\xde\x11\x48\x2c\x90\x29\x3f\xf2\x7e\x25\x83\x79\xa5\x06\x99\xc2\xee\xaa\x18\x94\xf9\x15\xc0\xfe\x1a\x7a\x41\xf4\x64\x98\x19\x47\xb0\x8f\xc0\xac\x55\x30\x00\x58\x1f\xdb\x47\xcc\xaf\x07\x3c\x30\x7b\x4b\xee\xe8\x6d\x87\xf5\x96\x69\x20\x9c\xab\x79\xed\x3c\x60\xb0\xd4\x90\xd4\xa2\x45\x52\xca\xef\x39\x6a\x50\x57\xfc\x9a\x94\xf4\x93\x9f\xe1\x8c\x28\x81\x8a\x8d\x31\x0f\x3d\xf7\xb3\xba\x4a\xdd\xc3\x38\x28\x79\x06\xbd\x26\x75\x2f\x40\xa7\x0d\x60\xde\x3c\x14\xcc\x36\x3a\x81\x38\xa0\xa9\x1c\xac\x78\x94\x80\x7c\x02\x3b\x82\xdd\x17\x2c\x51\x2c\x05\xad\x01\x66\x65\x47\x1e\xf6\x80\x3b\xaa\xf6\xbb\xf0\x95\xa3\xd2\x5a\x35\xe6\x37\x45\xcb\xa0\x63\x7d\xa8\x91\xc1\xa6\x35\xc5\x1b\xf3\xd8\xa0\xdd\x26\xcb\x60\xdc\xe6\xd4\x97\x61\x2c\xee\x83\x55\x0c\xaa\x5d\x9c\x44\xf5\xa4\x82\xc9\x83\xa6\x83\x70\xe8\x97\xa5\x6c\x10\x83\xba\xea\x7c\x11\x1a\xfb\x06\xdd\xbd\xd0\xaf\xc3\xb6\x22\x2b\x0b\x8c\xaa\x5b\x77\xfc\xef\xe8\xe3\xf2\xdb\x7d\x1c\x73\x8d\x0f\xf6\xaf\xd5\x61\x25\x60\xec\xd4\x58\x6f\xa3\x95\x38\xc4\x57\x94\xf9\x19\x49\x74\xcf\x1d\x79\x57\xde\x51\x72\xe4\x5d\x7b\x24\x46\xb2\xa8\xdc\x53\x4d\xb4\x92\xb2\x7d\x58\x7d\x7b\xe4\xe6\xfe\xa1\xf1\x81\x8a\xe3\xa6\x8f\x98\x1f\x9b\x7e\xc9\xe8\x27\x3f\xfe\x5d\xfd\x92\x3d\xa1\x5f\x06\x65\xc6\x97\x39\x03\x06\x95\xd8\xcb\x46\x4f\x23\xc2\xa5\xdb\x14\x56\x1f\xde\xd5\x4d\x61\x57\x4a\x37\x45\x76\x54\xe2\xc0\x22\x9f\xda\x3a\xf1\x7d\xad\xe3\x4f\x68\x1d\x76\xd1\xe1\x26\xba\xb7\x56\x66\x8f\x4e\xa7\xa1\x0f\xa3\xe5\x10\x03\x33\x6d\x02\xab\x26\xe0\xdb\xb0\x40\x65\xf4\x02\xb3\xfd\xbf\x35\x80\xc8\x3c\x2c\x0e\xd0\x45\xbf\x7a\x47\xf1\x93\x19\xc9\xed\xe1\x06\xda\x49\xa2\x69\x92\x64\x74\x3c\xcd\x1a\x1d\x73\x76\x74\x14\xc0\x5c\xf0\x63\xc2\xae\xb2\x6b\x7b\x9f\x05\xab\xc2\xe9\x5e\xd2\xff\x7e\x71\xfe\x39\x04\x01\x89\x2f\xb3\xc5\x83\xcf\x48\xdf\x09\xda\xb9\xaf\x6c\xcf\x78\x25\x6a\x08\x41\x61\xc6\x4f\x8c\x72\x56\x05\x91\xda\x3d\xb9\xeb\xf4\x32\xf5\xab\xbd\xd5\xe3\xc7\x01\xf4\x9f\x77\xe4\x7b\x8f\xf7\xa1\xe0\x0c\x8a\xe3\x32\xe6\xf6\x5b\x57\xe1\x7e\x02\x13\x20\x72\xd6\x94\x87\xff\x8d\xd8\xad\xde\x57\x77\xf9\x2d\x49\xea\xe5\xcc\xee\xbc\x87\x94\x26\xc1\x13\x7b\xf4\x3b\x57\xb2\x98\x1b\x34\xa0\x17\xdb\x37\x6b\x32\x20\x97\xd2\xcf\x48\x65\x26\x49\x81\x4c\xb6\xb2\x4c\x36\xf4\x8e\x2a\xc3\x60\x8b\x83\x0c\xb6\xf8\x36\x45\xdf\x7f\x9b\xa2\x7b\x48\xd9\x2c\x40\x40\xca\xf6\xbc\x94\xd2\xb2\x29\x4c\xe2\x20\xe8\xa9\xfa\xd1\xee\x73\xbb\xca\x74\xa1\xb0\x13\x73\x7f\x15\x64\x75\x05\xa2\xee\x38\x7d\x5f\xad\x46\x94\x5b\x44\x25\x65\x66\x99\xe8\x21\xb1\xba\xb3\x92\x06\x31\x7b\x9c\xd9\x1a\x3e\xc1\xd9\xf9\x02\x78\x71\x38\x38\x73\x06\x10\x97\x49\x18\x7b\x14\x4a\x6b\xdb\xf2\x52\x4b\x83\x92\x25\x2c\xbb\x05\xa1\xe5\xe8\xab\x2f\x83\x23\x0f\xe4\xd9\x0c\xae\xb6\x0e\x90\xfb\x86\x9e\x63\x2a\xd5\x1e\x99\xbb\xfe\xf5\x42\xd6\xa2\xcc\xa9\x0f\x4b\x46\xa0\x0d\x21\x0e\xad\x04\xf2\x40\xd7\xee\x71\x7e\x5c\xd0\x3e\x8b\x94\xb9\x33\xba\xcd\xde\x6f\x0e\xd3\x4a\xaf\x40\xe8\xcc\xa0\x32\x78\x0a\x09\xd8\x19\x64\x65\x94\x6f\xb3\xa2\x5f\x75\x15\xbf\xb6\x26\x50\x02\x13\xc8\x48\xa2\x15\x65\x57\x09\x8e\x7d\x65\xe7\x51\xe5\x0a\x2b\xa1\x77\x94\xd8\x79\x64\x71\x2c\x5a\x1b\x85\xfe\xd1\x39\x3b\xd0\x17\x99\x15\xce\x6a\x6e\x52\x7e\x37\x37\xc9\x5c\x6e\x62\x39\xc9\x77\xf6\x05\x20\x51\x69\x53\x08\xc4\x84\x35\xdd\x03\xfc\x62\x60\x7a\x03\xce\x3d\x0b\xec\x9e\xe1\xe2\x49\x68\xdd\x30\x64\xbe\x45\x97\xac\x0c\x3a\xe1\xdf\xf9\xcf\x71\x6a\xb8\x5a\x34\xf0\x8e\x3a\xab\x1a\xe2\x02\x04\x4b\xbc\x01\x08\xf2\xde\xdf\x39\x6a\x29\x06\x70\x34\xd8\x93\xbf\x7d\x70\x68\x7c\x21\x41\x49\xcd\xb4\x57\x74\xe1\x97\xa4\xd0\x3d\x8f\x83\x59\x98\xc1\x5c\xd9\xd6\xac\xbe\x3d\x96\xa7\xbe\x0a\x36\xe5\x5d\x06\x17\x39\x0d\x57\x50\xc1\x26\x81\x63\x14\x73\x5a\x1d\x61\xc0\x1c\x64\xeb\x40\x73\x10\x1e\x99\xab\x38\xe3\x29\x26\xd8\x03\x71\x1b\xad\x74\xb4\x19\xff\x68\xef\x60\x5a\xd5\x9c\x4c\x85\xec\x96\xc9\x07\xff\x34\x68\x98\x2a\xd8\xd9\xb1\x26\xcf\x70\x5c\xeb\x64\x14\xe6\x1a\x8a\xce\xd5\x58\x92\x53\x63\x0d\xa9\x33\x08\x50\x2a\xd4\xc7\xd1\x1b\x73\x8a\x2c\x69\xde\x39\x45\x06\x58\xa7\xbe\xd4\x87\xc8\x75\x75\x93\x9d\x7b\xf6\xbc\x5f\xca\xc8\x6f\xa6\x18\xd4\x17\x8f\x46\xc3\x53\x3f\xbe\x9a\xd4\x42\xce\x70\x62\xba\x7d\x38\x9e\x1a\xa3\x17\xdb\x37\x93\x5d\x33\x0a\x5f\x5c\x4d\x89\x23\xdc\xb0\xed\x76\x38\x54\xa3\x91\xef\x5d\xd4\x71\xea\xca\xfb\x8f\xff\xb0\xe6\x0b\x97\xf1\xd2\xbb\xde\x6e\x7b\x14\x32\xf6\x2c\x55\xb9\x2b\xa4\x8e\x74\x86\xff\x53\xa3\xea\x31\xe5\x6a\x13\xc8\xee\x9a\x73\xe2\x21\xbb\xf7\xa2\x96\xa9\xe6\x57\xb6\x3c\xbb\x2f\xec\xc9\xb7\x17\x7d\xf1\x15\x81\xbc\xa6\x0d\x91\xa3\xd3\x39\x37\x77\xcb\x1d\xf2\xa9\xb1\x65\xdb\xad\x19\x73\x2b\xf6\xc0\x39\x2c\x8e\xb7\xa2\x9f\x8c\xd5\x81\xad\x04\x14\x49\x08\xa9\x85\xc9\xbb\x58\xd5\x65\xf1\x2e\xd7\xb4\x9b\x43\xe3\x6a\xf3\x48\xb6\x64\xf7\x85\x67\xa7\x85\x83\xe8\xd7\xa6\x53\x00\xe7\xa9\x9d\x1e\x66\x5e\x98\x6e\x68\x51\xb6\x81\x19\x83\x02\x43\x75\xe6\x02\x86\x10\x23\x3d\x7b\x4c\xc5\x86\x0e\xbc\x18\x8b\xb4\x89\x63\xa0\x1c\xe2\xb8\x70\x4e\x74\x5b\x57\x2f\x47\xa3\x56\x10\xf5\x3f\x27\xfb\x51\xd1\x47\xd4\x8a\x5c\x7e\xcf\x89\xfb\xe7\xd6\x89\x3b\xf9\x48\xbd\x9f\x7e\x8a\xb9\xe0\x0f\x6b\x51\x95\x7f\xfc\xa3\x47\x3e\xd0\x0d\xf6\x43\xb4\xf0\x0d\x5d\xc0\x99\xa9\xc8\x21\x6c\x5b\xae\x2d\xbc\x23\x57\xf4\x00\xe3\x18\xe0\x2a\x10\x69\xf8\x4b\x7d\xe0\xd3\x0c\x30\x1c\x47\x00\x75\x43\x2e\xc3\x78\x02\xa2\x29\x0a\xa3\xf0\x0b\xce\x83\xf9\x43\xb4\xf2\xe1\xf4\x18\xd5\x07\xd1\x9a\x18\xeb\xa2\x68\xee\x07\xf6\x1b\xc4\x96\x68\xe9\xe3\xb9\x1a\x4a\x8a\xe7\x8b\x68\x46\x38\x9c\xbd\xde\xf9\xb6\xf2\xf3\x45\xf4\x00\x56\xb9\xe7\x8b\xe8\x96\xd4\xd2\x4e\x74\x4f\xca\x55\x5c\xb0\xe8\x86\xb0\xfb\x38\x51\xd1\x59\x6d\x32\x9a\x36\xfa\x56\x8a\x72\x67\x13\x26\x1f\x3a\x2a\x3e\x9a\x91\x0f\x21\xfa\xee\xf9\xab\xbe\x57\x75\x1a\x27\x2b\x46\xb3\xfd\x38\xf2\xa1\x71\x27\x45\x3f\x90\x0f\xdf\xd2\x25\x37\xaa\x5c\xee\x1f\xbf\xea\x68\xb4\x3b\x79\xf7\x7c\x3c\xd8\xde\xde\x37\x9a\xb2\xaa\x2c\x45\x1b\xb3\xa4\xce\x2c\x18\xe4\x91\x89\x60\xc6\xa8\xcf\xc4\x17\x48\xe4\x83\x85\xfe\xc9\xf4\x4f\xa2\x7f\x4a\xfd\xb3\xb6\x54\xce\x6a\x9e\xd8\xc0\x72\x4d\xa1\x0c\xc8\x54\x17\x5b\xe9\x9f\x6a\xbf\xb4\x89\x50\xbb\x1d\xe6\x58\xea\x8c\x73\xfd\x13\x3b\xc9\xbb\x5e\xd3\x67\xb0\xed\xa0\x94\x2e\x76\xfd\x6e\x89\x55\xaf\x43\xe2\xe1\x78\x17\x98\xeb\xb2\xdf\x9e\x57\x0b\x21\x49\x4e\xe5\xc9\x23\xe6\x70\x11\x5a\xc3\x91\xb8\x37\x17\x8c\x71\x9c\x9b\x4c\xaf\x48\xd6\x9b\x69\x21\xe3\xa5\x03\xeb\x35\x29\x7b\xb3\x69\xda\x99\xad\xd1\xe8\x00\x73\xbe\x21\x49\x6f\xce\x42\x8a\x45\x96\xc3\x14\x85\x6c\x93\x17\xa4\x3a\x94\x0d\x8e\xc9\x4c\xb6\xf1\x8f\x24\xed\xcd\x66\xee\xa8\x18\x60\x63\x52\xf4\xe6\x8a\xcb\x07\x9e\x38\xc8\x4d\x26\x64\x71\x08\x9c\xb1\x5f\x6c\xe7\x5e\xf5\xe6\x5e\x08\x79\x17\xcb\x74\x26\xd9\xc2\xe4\x3c\x26\xeb\xde\x9c\x65\x55\x16\x8c\x97\x16\xe0\x73\x32\xef\xcd\xb6\x66\x6b\x61\xb2\xbc\x24\xcb\xde\x2c\x79\xfc\x8f\x07\x93\xe5\xd5\x54\xdb\x0c\x9f\x2f\xe0\x4e\x5c\xf8\x16\x1a\xf9\x49\xa4\x8c\x16\x44\x85\xb5\x7b\x30\x85\x51\x0b\x1d\x05\xa7\x62\xa7\x82\x97\xe0\xec\x82\xa6\x4d\xdc\x17\xd3\xdb\xb4\x22\x2a\x34\x6a\x2a\x9a\x13\x15\xbe\xd7\x4d\xfc\xca\x16\x74\x05\x41\x43\x0d\x34\x23\x2a\xfc\x18\xff\xe3\x81\x2e\x89\x0a\x3f\xb1\xb5\xa0\x73\xa2\xc2\x2f\x48\x51\x34\x86\x4f\x33\xcc\xe0\x0b\x22\x04\xb1\x22\xd1\x88\x94\x10\x34\xbd\x41\xd7\x44\xf5\xa8\xe6\x9c\x13\x24\x3b\x9b\x1a\x93\x47\xcb\x55\x7a\xc5\x13\xb6\xdd\xc2\x09\x50\xa6\x7f\x16\xfa\x27\xd1\x3f\xa5\xfe\x59\xbb\x36\x92\xfb\x2c\x6a\x34\xf2\x1b\x4e\x41\x29\x5d\x6e\xb7\xad\xf0\xbc\x13\xae\x3a\xe1\xb4\x13\x5e\x05\x3b\x6c\x63\x33\x38\xfb\x8d\x83\x23\xbe\x60\xbb\x35\x0c\xa3\xd0\x05\x3a\xe3\x27\x6c\x64\x6b\x04\x7b\x60\x19\x28\x69\x0d\xa5\x35\xbc\x87\x0b\x54\xba\x80\x1d\xfb\xfd\x8c\x8f\xf7\x5a\xab\xd1\xb9\x86\xe5\xd0\xce\xe1\x7a\x57\x26\xaf\x25\xac\xc3\x39\x33\x9d\x13\xa9\xee\x70\xae\xa5\xce\x85\x24\x79\x38\xd7\x5c\xe7\x32\xf4\x7a\x38\x5f\x6c\xf2\x59\x62\x3e\x9c\x33\xd1\x39\x1d\x4a\x3f\x9c\xb7\x34\x79\xed\x34\x38\x9c\x73\xbd\x7b\x74\xf5\x35\x56\xd3\x17\x67\xa7\x5f\xcf\x2e\x67\xef\xce\x67\x9f\xcf\x2f\x67\x5f\xde\x5e\x5c\xcc\x2e\xff\xf4\xe1\x62\x76\xfe\x75\xf6\xb7\xf3\x5f\x66\x7f\xfd\xf0\xf1\xe3\xec\xe7\xb3\xd9\xfb\x0f\x5f\xcf\xde\x3d\x62\xe8\xf8\xd8\xa1\xb2\xa3\x2c\xd9\x89\x1e\xb1\xa3\x86\x13\x6c\x1e\xb9\x35\xdf\x81\xdf\xe4\xf3\x26\x2f\xc3\x57\xe1\xb1\xd7\xce\xbe\x87\x90\xdd\xa9\xbd\xd0\xc6\x82\xb5\x97\x0b\x3c\xd9\x06\x13\xc2\x98\xe6\x1d\x86\x82\xa7\xdc\xaf\xe0\x90\x9b\xfb\xcf\x27\xee\xa1\x7b\xe9\x4b\xb8\x3a\xff\xad\x13\xfb\xde\xc5\x9d\xed\x59\x77\x07\x9b\xee\x05\x6d\x40\x96\xd3\x59\xf7\xb6\x81\x0a\x4e\x66\x57\xea\x1a\x4f\x19\xa7\x37\xfb\xa9\xa3\x51\xe9\x7b\xe7\x7f\x39\xfb\xfa\xf5\xc3\xbb\xb3\xd9\xcf\x6f\x2f\xce\x60\x7f\xc6\xc1\xb5\x2c\xf8\x38\x01\xef\x5d\x78\x7d\x12\x9c\x88\x44\x83\xbf\x89\x6a\x10\xe3\xa1\xae\x62\xeb\x42\xc1\x21\x33\x68\xd6\x6e\x99\x94\xa0\x93\xfd\xf5\xdf\xca\x5f\x07\xe0\x5b\x6a\xf0\x20\x2a\x39\x48\xa0\xf4\xa0\x2c\x58\x92\x2d\x32\xe3\x6b\x7b\x70\xc6\xcb\x4a\x82\xdf\x8f\x58\xe9\x5c\xda\x41\xf7\x00\xa4\xfc\x72\x90\x0a\x38\x57\x43\x88\x79\x5c\x68\x23\x64\xc4\xc4\x64\x2b\x43\x0f\x6e\x27\x32\x44\xfb\xdd\xd9\xfb\x0f\x9f\xcf\x66\x9f\xde\x7e\xfe\x1b\x22\xbd\xdd\xba\x51\xb3\x4f\x67\x5f\xff\xf3\xec\xdd\xf7\x37\x47\xeb\x05\x74\x63\x40\xe9\x0d\x48\x36\x4a\x6a\x3c\xd4\x56\x2b\x50\xfd\xf2\x84\x85\x83\xcb\x55\x56\x0e\x12\xc1\x17\x79\x96\xa8\xc1\x3a\x7e\x00\xc7\x61\x69\xc5\x00\x52\x3c\x58\x83\x83\x33\xc4\xb9\x19\x42\x30\x8b\x36\x37\x98\x83\x4d\xd9\xa7\x36\x6f\xe1\x8b\x68\xfe\xfb\x1e\x96\x40\x34\xb1\x83\x97\xee\x6c\x74\x96\x62\xea\x89\xcb\x1a\x81\xc1\x07\x5e\x2a\x16\xa7\x64\xf0\x5b\x55\x2a\xf4\xb8\x10\x0f\x24\x5b\x56\x79\x2c\x1b\x15\x36\x29\xfd\xa1\xf2\x79\xf0\x3b\x6a\xff\xee\xba\x8c\x1a\x84\x39\x5b\x0e\x50\x7c\xcc\x66\x28\x52\xbe\xad\x94\xf8\x39\xe3\xe9\x97\x38\x93\xe5\x94\x77\xc9\x36\x09\x46\xa3\x87\x10\xfb\x16\xee\xdd\x73\xf3\xd9\xa8\xc8\x62\x50\x91\x71\x50\xc1\xef\x95\x8d\x83\xd1\x28\xc6\x33\x01\xa3\xf4\xe4\x57\xf1\x35\xa9\xa8\xd8\xcf\x08\x9b\x6f\xe9\x83\x9a\x9e\x3c\xec\xa7\x06\x0f\x57\xf1\xb5\xcf\x48\xe6\xdc\x2e\x48\xf7\x27\x60\x1c\x90\x55\x9f\x74\x9d\x91\x35\x5d\x8d\x46\xc3\x74\x34\x1a\x56\xa3\x11\x0f\xe3\x4a\x09\x38\xf1\x1f\x52\x3a\x9c\x40\xd5\xeb\xc0\x5c\xfa\x04\xb6\x41\xc4\x55\x7c\x4d\xb3\xfa\x6a\x8f\xd1\xff\xcd\xe9\xec\x2a\xbe\x9e\x96\x7e\x3a\x1a\xb5\x26\x84\x43\xfd\xf3\xf6\xbc\x80\x09\x31\xef\x8c\xf1\x2f\xbc\xd6\x42\xc2\x74\x1d\x14\x22\xcf\x92\x87\xc1\xbf\x95\x70\x6b\x01\x74\x7b\xf0\x79\xb7\x62\x5c\x8f\xf1\x12\xba\xb7\x19\x7c\x28\x02\x13\x73\x0e\x3d\x75\x08\x89\x13\x6c\x40\xe1\xc3\x0f\xc9\x82\x68\x0f\x25\x30\xc2\x87\x2c\x0b\x9b\x25\xd0\xba\x2b\x08\xd1\x6c\xa7\x6f\x38\x4c\xf7\x2c\x73\xd0\x06\xc6\x8e\x3b\x07\xc4\x0c\x33\x14\xf5\xe1\xb2\xea\x8e\x09\x0f\xec\xc1\x02\x16\x78\x98\x96\xfe\x50\x92\x7f\xef\x50\xfd\x61\xd6\x00\xf4\x5c\x32\x09\xe7\x02\xd6\xa7\x1b\x41\x7e\x41\x34\x5f\x2b\x57\xa2\xca\x53\xfe\xf7\x7f\x47\x2f\x82\x82\x83\x51\xcc\xc0\x03\xd7\xeb\x59\x52\x7a\xd0\x9f\xe1\xe0\x9d\x06\x95\x29\xb0\x1e\x89\x79\xad\xe3\xa9\x21\x62\x0c\x8b\xd3\xe9\x20\x53\x83\xbb\x2c\xcf\x07\xa5\x82\xff\x73\x36\x88\x13\x70\x83\x9e\xcd\xf3\x1a\xb8\xab\x2f\xf9\x77\xf0\x84\x61\xee\xb5\x42\xf3\x18\x10\x53\x7d\x4c\x7d\xbb\xdf\x19\x27\xb7\x57\xdc\xac\x10\x66\xc1\x29\xfd\x03\xc3\x18\x13\xef\xc9\xbd\xf4\x2f\x65\xa0\x3c\x20\x70\x49\xce\x87\x93\x20\x5a\xe0\x0f\x11\x41\xb0\x83\x0f\x2a\xdc\x5d\x31\xba\x76\x00\xc6\xca\x46\x23\xd5\x7f\xad\x6a\x2f\x4e\x11\x6f\xcd\x24\x38\xbe\xd7\xae\x03\x3e\x8b\x77\x55\x91\xc3\x9a\xc5\xfe\x0c\xaa\xeb\x20\x1a\x9c\x6a\xaf\x90\x98\x0d\x5d\xd5\x68\x18\xe8\x5e\xa6\x43\x7e\x3d\xf4\x06\x77\x3c\x9b\x4b\x7f\x80\xf4\xb7\x6b\xbc\x94\x46\x37\x8f\x19\x07\xea\x4e\x18\xf6\x59\xea\x75\x11\x88\xaa\x8c\xd7\x0c\xe8\x29\x42\xf2\x7b\x52\x67\x4e\x81\x26\x0a\xbc\xa1\x00\xec\x1f\x48\x16\xad\xa3\x30\x6b\x02\xbe\xa6\xb5\x29\xd8\x9d\x18\xc0\xcb\x04\x3c\x53\x59\x9c\xa3\x8b\x28\x3f\x00\xaf\x5d\x4b\xa6\xcc\x13\x22\xd0\xbe\xd2\x0f\xec\xd2\x3c\xd0\xc4\x03\x53\xa5\x85\x27\xac\x4c\x2b\x88\x85\x33\x02\x3d\x94\xd0\x01\xb4\x65\x26\xc0\x0e\x79\xdf\xb0\xd1\xbe\x15\x71\x58\x18\x17\x45\xfe\xa0\x9d\xc1\xd9\x93\xbe\x32\x20\x82\xaa\xfe\x94\x46\x19\xcf\x8d\xde\x74\x20\x9a\x38\xab\x7f\x1f\x70\xa3\x09\xd9\x34\xfa\x31\x5f\x02\xb2\xa9\x2f\xd1\xa5\x8c\x43\x63\x8b\x43\x28\x1e\x42\xee\x00\x6a\x0e\xc8\x95\x2b\xc6\x29\x6d\xd7\xd5\x5c\xd7\xe5\x4d\xc6\x75\xdb\x3b\x1c\xeb\x5d\x33\x8d\xef\x4f\xd5\x9c\xa5\xd2\x63\x97\x2d\x12\x09\xdd\x7f\x34\xb9\x9e\xc2\xad\x27\x0a\x95\x4b\x17\x9b\x79\xa3\x3e\x16\x8e\xeb\x4d\x38\xa8\x31\x36\x8d\x7d\xb5\x9a\xda\x46\xa3\xb5\x71\xe8\x77\xd8\xe7\xa2\xf1\xa3\x83\xfe\x1b\xb3\xb6\xcf\x45\xb9\xdd\x1a\xa7\x3b\xc0\x31\xf5\x85\x44\xc3\xcf\x30\xb6\x43\x95\x27\x7d\x91\xbe\xb6\xab\x9b\x96\xfe\xde\x5c\xcf\x47\xa3\xce\x59\x74\x1e\x10\xef\xdf\xfa\x40\x0c\xd6\x20\xc1\x98\x21\xa8\x0f\xfb\x61\x12\x00\x70\x8f\xa8\x30\xcd\xca\x22\x8f\xd1\x43\xea\x76\x6b\x58\x23\x70\xba\x32\x53\x8d\xe3\x3f\xcf\x74\x84\x6e\x4e\xbe\x0b\xa6\x8e\xa5\xab\x36\xdc\x24\xae\xed\xab\xc3\xcc\xa9\x6a\xa5\xf4\x75\x3a\x38\x76\x58\xd6\x6e\x52\x73\xc7\x22\x50\x05\x01\x81\x5b\xf4\xf7\xfa\x87\xe9\x9f\x3b\xa0\xc6\xce\x24\x1e\x8d\x7c\xd5\xba\xd0\x4c\xf7\xb2\xf8\x70\x1f\xcf\x77\x91\x31\xaf\x82\x79\x8e\xa3\x5f\x3f\x0c\x43\xe0\x95\xfb\x82\xbf\xee\xca\x6c\x5d\x18\xa3\xa4\x78\xf0\xab\x2e\xff\xab\x61\x1f\x2e\x1f\x95\xc0\xa2\x66\x81\x53\xd9\x95\xbc\xde\x6e\xdd\xda\xaf\xe4\x35\x12\x46\x3d\x43\xf4\x95\xbc\x25\xf4\xc6\x8c\x6e\x90\xd3\x95\x6d\x21\x83\x98\x05\xb8\x13\x5b\xbf\x8a\xd0\x89\x37\x94\xda\x9b\x04\x57\xad\x4f\x0f\xa7\x77\xba\x2e\xea\x5b\x4c\x49\x87\xdc\x0e\x66\x3a\x75\x2a\xeb\xcf\xa4\x3b\xb2\x4e\x3b\xff\x7c\x7a\xe6\xb8\xf4\xfe\x6b\x96\xe7\xe8\x80\xb0\x55\xd8\xc9\xf0\x2e\x4b\x1f\x4d\x07\x00\x5f\xb5\x1d\xc4\x7e\x73\x3c\xa2\x85\x9d\x9a\xd8\x8d\x87\xc2\x47\x90\xe9\xe4\xd8\xc7\xe6\xf1\x0c\x80\xce\x2f\x7c\xdd\x83\xf1\x2f\x9f\x2f\xde\xbe\x3f\x9b\x7d\xb3\xe5\x7d\xf9\x1e\x69\x60\x5f\xf6\x5e\x14\x35\x03\xab\x7b\x22\xea\xec\xab\x77\xe4\x96\x6e\x90\x34\x24\x98\x94\xe0\xa8\xbf\x97\x62\xbd\x5f\xa7\x1d\xd9\x1d\x79\xa0\x1b\x87\xc5\x74\x1c\x32\x32\x97\xfd\x50\xb5\x23\x86\xea\xdb\xb9\xda\x02\x72\x77\x59\x38\x0a\x60\x3b\x0a\x4b\x42\xb0\xeb\xa1\xeb\x6e\x85\x7b\x39\x68\x0c\x26\x0a\x3d\x09\xb0\xe5\x25\xc9\xa3\xb0\xfa\xc0\x38\x71\x08\xa1\x3b\x95\xba\x40\x3a\xe9\x27\x7b\x31\xb4\xf0\xf7\xe2\x88\x0a\xa2\xbd\x48\xe8\xc0\x86\x17\x74\xeb\xa9\x53\x2c\xa6\x75\x04\xa2\x69\x59\x4b\xbb\x58\x65\xfd\x26\x99\xad\x5d\x93\x1c\x6c\x76\x3b\x72\x4f\x37\xfb\x93\xd0\xc9\x62\xd6\xd9\xda\x7f\x28\x1d\xc2\xfb\x5f\x77\x74\xd3\x3b\x17\x1e\x2d\x38\xd9\xed\xc8\x0d\xdd\x98\x9b\xb6\x48\x7a\x4d\x4d\x8d\x83\xbf\x8e\xe3\x5d\xe3\x0e\xc4\x75\x11\x8c\xad\xad\x01\xbb\xd8\x1a\x93\x80\x61\xb7\xf2\xdd\x8e\x9c\x35\x3a\xc7\xa0\x11\xaf\x62\xff\xac\xe1\xe7\xc4\xd5\x04\xdc\x04\x64\x8e\xec\x3c\xc7\xdb\x23\xcf\x8d\x57\xfe\xb1\xb9\x11\x3e\x81\x1b\xe1\x9e\x26\x76\x6f\x9a\x83\x3f\x9b\xa7\x5f\x01\xee\x55\xb0\x75\xd5\x78\x3e\x0b\xac\xfb\x26\xe9\x7b\x93\x17\x70\xf9\x92\xd5\x97\x5b\xcc\xdd\x96\xc9\x4b\xe3\xe4\x79\x7a\x50\x03\xb9\x57\x7b\x93\x93\xfb\xcf\x5f\x04\x4f\x54\x40\x3e\xc7\x2b\x41\xdc\x7f\xfe\xa3\xa9\xf9\x95\xb9\x57\xf3\x6a\xac\xbb\xe6\xe5\x6b\xd3\x35\xaf\xde\x40\xdf\xc0\x87\x79\xb2\x60\xf2\xda\xbc\x59\x30\x79\x3d\x01\x74\x5f\x07\x53\x19\x66\x1c\x44\x22\x3f\x40\xa9\xaa\xa0\x1b\x78\xcd\xe1\xdd\xf9\xa7\xcf\x70\x9e\x9c\xd8\xe5\x24\xb7\x0b\x7c\xa5\x09\xac\xe6\x6b\x6f\x15\x66\xcc\xc3\xfe\x84\xda\x8d\x7f\x49\x2a\xb0\x6b\x9c\xe7\x6c\xa6\xdf\xc1\x30\x8c\xbd\x8c\xb2\xb0\x1d\xd1\x64\xd4\x55\x5e\x54\x73\x25\x19\xee\x8d\x4e\xed\xeb\x78\x51\xba\x9b\x3a\x56\x17\xb5\xae\x6c\x36\xfb\x7a\xf6\xf6\xf4\x72\xf6\xee\xec\x2f\x97\xe7\xe7\x1f\x2f\x66\xff\xf9\xf1\xfc\xe7\xb7\x1f\x67\x7f\x3a\x3f\xff\xf3\x6c\xe6\xfa\xc8\xa6\x4f\x2b\x62\x7a\x67\x34\x7a\x52\x36\xbf\xf1\xf7\x7c\x29\x19\x8b\x80\xc1\x9f\xe6\xa2\x64\xa5\xfa\x60\x76\xf4\xc0\xe3\xa1\x63\x22\x11\x1e\x4e\x04\x71\x00\x32\x41\x66\x9b\xe6\xcc\xd0\x9a\x52\x59\x68\xfa\x88\x35\x4b\xee\x68\xe4\x33\x5a\xf9\x2c\x08\x08\x3b\x11\x61\x0f\x24\x9f\x69\x79\x78\xb7\x23\x38\x73\x23\x78\x4d\x2a\x11\x3c\x81\xa3\xd1\x28\xde\x05\xbf\xd3\xf3\x96\xb3\x09\x9a\x80\xdd\x4b\x08\x26\x09\x97\xc6\x7b\xce\x92\xa9\xb7\x4a\xc9\x6c\x5e\x29\xe6\xaf\xe0\x7c\xa1\xb6\x72\xde\x6e\xdf\xec\x65\x87\xa2\xf8\x2a\x1d\xa5\xd4\x1b\xa0\x9c\xfb\x0c\xd6\x02\xb0\x2a\x53\x47\xde\xc0\x7b\x6a\x21\xd0\xd7\x3c\xd4\xa5\x9a\x3d\x8d\x6c\x6d\x9c\xa6\x8a\xf6\xf5\xe5\x34\x60\x54\xf5\x6c\x4a\x73\xbd\x29\x85\x09\xc3\x29\x40\x9a\xf2\x70\xb6\x12\x25\x76\x35\x48\xe9\x57\xf3\x6b\xea\xec\xd2\xe2\x66\x03\xc5\x9a\x8c\x53\xb0\x83\xd2\xef\xe3\x0c\xd4\xd5\xfc\x9a\x38\x69\xb4\xe3\x61\x32\xab\x97\xef\xa1\xcf\xc2\xd9\x22\x8f\x97\xe5\x68\x0d\xaa\x05\x34\xb3\x48\x51\x2e\x84\x82\xa5\xd1\x66\xf1\x56\x83\x8c\x03\x1f\x12\x53\x15\x2e\x32\x59\x2a\xcc\x3f\x65\x91\xed\x81\xec\xb0\x8e\xb4\xb9\xa2\xc2\xaf\xb2\x6b\x38\x59\xf7\xcb\x20\x9c\xa5\x62\xfd\xe1\x1d\xec\x9c\xe1\x02\x5c\x62\x6c\xd1\xcc\xa1\x5c\x3c\x8d\x69\x8c\x26\x69\x17\xd9\x3c\xcf\xf8\x32\xc8\x16\xbe\xf0\x63\x92\x04\xc1\x26\xf7\x4b\x30\xeb\x03\x69\x20\xe3\x15\x1b\xb0\x5d\xea\x7b\xcf\x8f\x3d\x92\x04\xbb\x9d\x6d\xdb\x96\xf6\x36\xce\xd9\x94\x96\xc6\xe8\x83\x5d\xcd\xeb\xd7\xa5\xe1\xbb\xde\x37\xa0\x2f\xce\x21\x46\x69\xb9\xc7\x3a\xcd\x23\x43\x16\x16\x31\x1c\x6f\x02\xcc\x7a\xc7\x0f\x67\x22\x8c\xba\x49\x3b\x0b\x8a\x13\x31\x85\x8b\x83\x82\x02\xb8\x60\xca\x28\xb8\xe6\x2b\xfc\x20\xe0\x78\x3a\x6a\x77\xba\x99\x2f\x48\xef\x36\x3d\x69\x08\xa0\x74\x37\xf2\xd8\x5d\xe8\x66\xb5\x19\x79\x4a\xd9\x89\x8a\xda\x3e\x48\x2b\xd3\xd8\x46\x69\xd4\x90\xca\x09\x74\xdf\xf3\xc6\xb5\xbf\x93\x54\xf7\x4b\x13\xd5\xed\x1e\x27\x25\x68\x7a\xc8\x44\x7f\xc1\x5e\xb2\x8b\x1f\xd4\x03\xde\x06\x98\xad\x5d\x27\x23\xc4\x69\x2d\x45\x36\x7d\x03\x34\x6b\x72\x02\xfc\xba\xd5\x4e\x1c\xae\xa2\xf8\x88\xce\xab\x80\x14\xf0\xfb\x3a\x20\x0b\xf8\x7d\x03\x9a\x72\xe3\xe8\xa5\x08\x3f\xbc\x9b\xbd\xbd\xbc\xfc\xfa\xe1\xe7\x5f\x2e\xcf\x66\x9f\xdf\x7e\x3a\x0b\xc8\x9a\x2e\xc0\x4d\x90\xd9\x04\x5b\xaf\x9e\x96\xc7\xfd\xc1\x3b\xfa\x14\xab\x55\x28\x63\x9e\x8a\xb5\x1f\xb4\xdc\x23\x85\x65\x9e\x25\xcc\x3f\x06\xef\x6e\x8f\xb1\xe8\x12\xf8\xf0\x5e\x6c\xd2\xcb\x9d\x2b\xb8\x5b\x9f\x00\xb9\x36\xd4\x1a\x65\x75\x24\xe4\x8f\x72\x52\xf1\x26\x14\xff\xde\xd7\x6c\x9a\x11\xac\x75\x47\xff\xff\x63\x00\xff\x1f\x79\x0c\x40\x4f\x01\x9f\x8d\x14\x2c\x81\xaa\x96\x22\x9f\xbf\x02\x61\xce\xd0\xfb\xe6\xd3\x2f\x17\x97\xb3\x5f\x2e\xce\x66\x5f\xbe\x9e\x7f\x39\xfb\x7a\xf9\xb7\x68\x42\xfe\xf4\xf6\x62\xf6\xf3\xf9\xf9\xc7\xb3\xb7\x9f\x67\x7f\x79\xfb\xf1\x97\xb3\xe8\x05\xc6\x7d\xfe\xe5\xd3\xd9\xd7\x0f\xa7\x26\xee\x0d\xc6\x7d\x39\xbf\xf8\x70\xf9\xe1\x2f\x67\x9d\xc4\x63\x5d\x02\x36\xa2\x1f\xcf\xdf\xbe\x3b\x7b\xd7\x01\xf8\xfc\x98\x68\x71\xe7\xdd\xf9\x27\xcb\xfe\x4f\x05\x5f\x64\xcb\x96\xf0\x01\x38\x2b\x9a\x13\x58\x62\x4c\xb6\x8c\x95\xdb\xed\x66\x87\x2e\xad\xdf\x9d\x7f\xaa\x57\xfa\xfa\xe1\x57\x9d\x5c\xf6\x25\x63\x4a\xa2\x53\x6c\xad\x4d\x42\xa5\x13\x3e\x55\xb0\xa7\x12\xfc\x13\xaa\x87\x30\x69\x0a\x0f\x70\x9c\x56\xa5\x12\xeb\x1a\xe0\x68\x94\x85\xb3\xbd\x58\x7b\xc5\xbc\x34\xfc\x6d\xbf\x5c\x30\xfd\x6f\x76\x56\xa5\x7a\x25\xdc\x64\xf6\x85\x9e\x8c\x95\xdd\x25\x31\x0d\x4e\xa4\xef\xbd\x78\xe3\x91\xd4\xb2\x5d\x23\x3d\xa7\xa1\x12\x1f\xc5\x1d\x93\xa7\x71\xc9\x7c\xe4\x65\x57\x29\xf8\xee\xda\xc4\xb6\x2e\x68\x5b\x54\x90\x56\x18\xfb\x08\x79\x3e\xb1\x27\x3e\x98\x2d\x25\xeb\x56\xc3\x75\x16\xd0\x9e\xfd\x52\xd6\xf6\x7c\x11\x38\x01\x53\xe1\x1e\xd1\x04\x64\x15\x97\x3f\x6b\xd3\x55\x14\x8b\x4c\xc6\x3d\x4a\xc2\x8c\x9f\xc1\xaa\x27\x4b\xba\x19\x5b\x14\x84\x19\xbf\x88\x32\x53\xd9\x2d\x3b\x50\xa0\x9f\xf6\xb0\xe4\x39\x1c\xe4\x8b\x38\x65\xe9\x01\xac\x0e\x51\x66\xb0\x03\x41\x63\x15\x76\xda\x73\xb4\x0a\x3b\x88\xeb\x98\xfe\x7a\x7e\xa2\x13\x67\x1b\xf7\x72\x0c\x83\x47\x7a\x86\x56\x2f\xd4\x6b\x5a\x5e\xa5\xd7\xd3\x55\xd8\x1a\x27\xba\xde\xc5\xfb\x25\x46\x23\xbf\x93\x0f\xc7\x93\xc6\xf0\x08\x12\x49\x0e\x14\x70\x07\x9a\x26\x98\xb5\x3a\x90\xb5\x4d\x04\xb4\xc2\xcc\x2e\x81\x5e\xa5\xd7\x74\xb5\xdb\xed\x60\x23\xe8\x45\x6f\x9f\xfd\x5f\xb3\xf8\xd9\x3f\xfe\xfe\xf7\x6a\x3c\x3e\x1d\x3f\xc3\xdf\x77\xaf\xf4\xcf\x1b\x1d\x7c\xaf\x83\xef\x75\xf0\xf8\xfd\x7b\xf8\x79\xfe\x5a\x67\x7e\xfe\xfa\x9d\xfe\x79\x0f\xc1\xc9\x7b\x4c\x3d\x1e\x8f\x4f\x21\x78\x3c\x1e\xbf\xc3\xa0\xce\x7c\x3c\x79\x83\xa9\xa7\x63\x1d\x7c\x7f\x06\xc1\xe7\xe3\xf1\x04\x82\xef\x5e\x63\xd9\xf7\x3f\xea\xd4\xf7\xef\x4e\x31\xf8\xee\xbd\x0e\xbe\x7f\xff\xce\x23\x19\xdd\xec\x2d\xef\x11\x3a\x7f\x7a\x86\x8b\x51\x96\x7a\xe4\xeb\xf9\xf9\xe5\x23\x59\x24\x78\x57\x21\xed\xf4\xd9\xc5\xe5\xdb\xaf\x97\xb3\xd3\x3f\xbd\xfd\x1a\xc5\xdd\x34\x1d\x7b\xe4\xfd\xfd\xef\xcf\xc2\xf1\xb3\x1f\xa1\xb5\xe3\x9f\x5f\xc3\xcf\xf3\xb1\xe9\x83\x57\x80\xe9\xf1\xf8\x39\xf6\xc1\xf1\xf8\xc5\xd8\xb3\x53\x33\x63\x65\xb4\xd9\x81\x14\xf0\x45\xe8\x83\xd7\x0b\x15\xf3\x34\x96\x29\x0c\xa5\x9e\xa2\x8f\x30\xa1\xe8\xea\x9a\xec\xa5\xb6\x18\x6c\xb3\xc0\x8f\xa7\xea\xa7\xc7\x39\x9a\x91\xba\x94\xbd\x1e\xc7\xe9\xa3\xf9\xcd\xb5\x64\xde\x5c\xb9\x1b\x8e\xed\x85\x8e\xc9\xce\xb0\x7f\x70\xd2\x93\xbb\xd2\x49\xf6\x14\xe7\x9b\x3d\xe2\x7a\x34\xd9\x4d\x7f\xd7\x5a\x19\x6c\x6e\xb6\x5b\xff\x86\x82\xbf\xe4\xf0\xec\x96\x71\x75\xb6\xce\x14\x28\xa3\x34\x86\x28\xd9\x60\xfc\xc7\xac\x54\x8c\x33\xe9\x2f\x03\x9b\xf5\x4b\x5e\x2d\x33\xfe\x27\x90\x67\x30\xb3\x13\x79\x2e\x53\x26\xfd\xac\x93\xf5\x17\x95\xe5\xa5\xc9\x5c\x0b\x2c\xb0\x81\xf7\x8b\x47\x72\x42\x86\x4b\x19\x83\x82\x23\xce\xfd\xd5\x53\xaa\x2f\x7f\x46\xcd\xad\xbf\xb9\xc0\xb3\x0f\x27\x25\xba\x23\x67\x20\xc2\x7e\x64\xf1\x6d\x2b\xbe\x24\xfa\x51\x76\x37\x2e\x26\x17\xe8\x2b\xc9\x8d\xbb\x27\x3f\xb3\x85\x90\xec\x03\xf8\xdc\x72\x13\xf2\x1d\x60\xf6\x27\x51\x36\xaa\x19\x83\xd8\x7f\x42\xbf\x65\x49\x1d\xad\x0f\x6d\xd2\x43\xd9\x2f\xd9\x7d\x13\xa7\xf3\xae\x21\xaf\xb3\x6c\x9b\x9c\x4e\x8c\x16\x1f\x7c\xf9\xd4\x8c\xc9\x53\x33\x3e\x40\xc6\x33\xd8\xdc\x77\xf1\x6c\x47\x1a\xc7\xc8\xed\x17\x03\xcd\xee\x8b\xdd\xa1\x63\x0c\xec\x20\xa3\x7c\x32\x30\x6a\x7d\xc8\xa5\x8c\x79\x09\x4c\x48\x70\xff\x76\x3f\x1f\xbe\xf0\x9a\xf1\xe5\x85\x92\xb1\x62\xcb\x07\x7f\x0e\x79\xf6\x30\x6a\x84\x56\xbf\x0a\x82\x5a\xe8\x7b\x31\x06\xa1\x8f\xfb\x2f\x26\xc0\xb7\xb9\xff\x12\xfd\x8d\x73\xff\xd5\x8f\x5a\x81\x07\xda\x3a\xd0\xdf\xbd\x7e\xa9\xd5\x77\xaf\x5f\x69\xed\xdd\x9b\x1f\xcd\x66\xe9\x25\x0a\x18\xfe\xe4\xf9\x0b\xd8\x2d\xc1\xd3\xa5\x2f\xcd\x3b\x12\x20\x4f\xc2\x43\x12\x93\xe7\xaf\xcd\x53\x12\xcf\xf1\x2d\x09\x78\x6a\x62\x12\x90\x5b\x48\x7a\x71\x0c\xce\x7f\xb9\x3f\x79\x39\x0e\xc8\x3d\xc4\xbc\x9c\x04\xe4\x0e\x3f\x8e\x03\x72\xd3\x7e\x51\x68\xa3\xdb\x12\x89\xa7\x98\x85\x6e\xcc\x68\x21\xab\xf4\xc0\x7b\xcb\x33\x63\x51\xec\x45\x63\xa2\x23\xac\xc3\xbe\x26\x22\x2b\xc1\xd5\x56\xda\xc4\xac\xb2\x34\x65\xbc\x09\xc3\xf6\x23\xcf\x9c\x0c\x60\x44\xb0\x12\x52\x25\x95\x72\x00\x69\xcf\x71\x75\x50\x82\x8b\x7b\xa6\xdd\x90\x81\x40\x5f\x27\x80\x91\x15\x68\xcf\x41\x03\xd3\xc4\xe2\x55\x17\x17\x0b\x76\x5f\xc4\x3c\x6d\xe1\x15\x97\x85\x28\xaa\xa2\x89\xc9\xd9\xad\x5b\xe5\x5a\xa4\xb1\x1b\xac\x72\x95\xa1\xcb\xa9\x76\x94\x76\x79\x06\x8d\x6e\xf2\x0a\x09\x8e\xa7\xe2\x36\xa6\xa8\x79\x5f\x89\x1c\x2e\x25\x34\x91\xe0\xb0\xcd\x45\x0b\x1e\x49\x85\xf7\x5e\xdd\x18\xed\x88\xa6\x89\xd1\x55\xb6\x62\x84\x54\x4d\x08\xaf\xfe\xad\xe3\xfb\x6e\x4c\xe6\x60\x83\x31\x5c\xdc\x75\x62\x40\x3f\xd7\x44\xc5\x4a\xac\xb3\xa4\x09\xc3\x13\xeb\x4d\x28\xcf\x6e\x9d\x36\xc3\xe3\x02\xb7\x71\x8b\x38\x80\x7e\x16\x0b\x96\x38\x71\x4b\x19\xcf\xe7\x2e\xe6\x30\x2f\x6f\x71\x68\x19\x4f\x5b\xc5\x13\x91\xe3\xe3\x1e\xad\x18\xbc\xa9\xdd\x8a\x41\xbf\x5c\x4e\x04\x57\x52\xb4\x28\x12\xa9\x66\xce\xd2\xb9\x83\x3a\xee\x36\xcd\x5e\xb5\x89\x5d\xe4\xe2\x4e\x89\x26\x8c\x34\x98\xb7\x4b\x8a\x3b\xee\x00\x07\xbb\x00\x5e\x32\x07\x6b\x29\xee\x3a\x58\x4b\x71\xd7\xc1\x5a\x8a\xbb\x36\xd6\x25\x53\x65\xf6\x0f\xe8\xcc\x1d\xd9\xdb\x5b\x81\x9c\xe2\xf0\x4e\x1b\xf7\xbb\x57\x65\x98\xdd\x8c\x02\x4e\xe2\x2e\x84\x19\x1e\x1b\xa5\xce\x9e\x61\x05\xeb\x55\xb6\xb3\xd0\x9c\x07\x8c\x46\x45\x2c\x4b\x50\xea\xfb\x75\x9c\x1f\x90\xc9\x38\xf8\x89\x4e\x8e\x1b\xc5\x97\x6c\xf8\xb5\xcf\xc2\x44\xc9\xfc\xcf\xec\x01\xee\x39\xc4\xb9\x32\x5f\xe0\x99\xf1\xcf\xec\x01\xdc\xab\x37\x59\x40\x1d\xac\xb3\x38\x4a\xd4\x1c\x80\xd9\x8b\x59\xfa\x2a\x96\xa7\x44\x61\x6d\x34\x32\xc1\x2f\x54\x2c\xeb\xcb\x8f\x83\x2f\x61\xd2\x49\x9a\xf6\x94\x39\xe3\x69\x7f\x89\x33\x9e\xf6\xe5\x37\x0f\xf3\xf5\x16\xd1\x69\x8e\x96\x33\x76\xd5\x06\x80\xec\x9f\xd9\xc3\x3b\x71\x07\xe7\x17\x70\xf5\x01\x2f\x53\xc3\xbb\xbd\x94\xd2\xdb\x3d\x6d\xf1\x7e\x53\xff\xcc\x1e\x7e\xa9\x2f\x6a\x0e\x66\x21\x12\xd7\xf9\xc2\xaf\xe1\x04\x43\x4a\x9f\x4d\x6a\xb4\x6d\x6d\xb6\x44\x9d\x6f\x48\xe9\xad\x9b\xeb\x0b\xf0\x22\x73\x33\x54\x89\xe2\x93\xa8\x4a\x86\x78\xd6\x51\x3f\xe7\x95\xf4\xa2\xa7\xdc\x1d\x2e\x1b\x25\x03\x0b\xf5\x2a\x71\x88\xca\xc0\xa4\x0f\x76\x0a\xe0\xb8\x4b\x9d\xa8\x10\xbe\x3b\xaa\x53\xfb\x14\x95\x7d\x1d\x86\xe0\xdb\x74\x0f\x27\x92\x02\x39\x44\xe7\x27\xd0\x59\x1c\xb6\x64\x92\x76\x87\x2f\x88\xe2\x03\x89\x48\x28\x01\x19\xd6\x0e\x27\xa0\xd2\xe9\xcd\x68\xe4\x9f\x6f\xb7\x72\x48\x7b\x72\x9f\x48\xda\x89\x3e\xe3\xe9\x68\x74\x3e\x1a\xf9\x09\x3d\x87\x83\x9a\x77\xb1\x8a\xfd\x20\x88\xce\xe9\xda\x79\x0d\x4b\x98\xeb\xf1\x15\x9d\x3b\xb1\xd2\x34\x0a\x1a\x93\x04\x15\xb6\x9c\x26\xae\xf1\x71\x09\xea\x2d\xa3\x90\x07\x83\x60\x93\x27\xad\xfd\x41\x2c\xc2\x38\x49\xaa\x75\x95\xc7\x8a\x5d\xde\x89\x2f\xab\xb8\x64\xef\xb2\xb2\x00\x99\x86\x95\x7e\x15\x90\xaa\xe9\xc6\xea\x10\x49\x1d\x98\x09\x25\xf8\xb6\xd9\x27\x10\x6b\xd1\x76\xb7\xca\x92\x95\x19\xd5\x01\x1f\x82\xaf\x71\x40\x35\xf2\x3f\x81\xcc\x7f\xda\x14\x05\xa9\x13\x65\x5a\x5d\x16\xec\xfa\xa0\x19\xb6\xa8\xa0\x94\x9e\x8e\x46\x9f\xb4\xdf\x0c\xd1\xbd\x40\x09\xb1\xfb\xd6\xa0\xd9\xc2\x3f\xc7\xff\x3d\xf8\x9b\x3b\xea\x0f\xa0\xcc\x87\x16\xdb\x5d\x95\x33\x40\xb6\xee\x75\xfd\x28\xd9\x79\x40\xce\xf1\xc4\xc6\x79\xa2\x09\x6a\xde\xef\xad\x2f\x71\xe9\xcc\x7d\x28\xd2\xd3\x49\x26\xd5\xf4\xd2\x68\x34\x84\xd7\x29\x4e\xfa\x5e\xeb\x36\x59\x82\xa8\x05\xe9\xc0\x90\xdc\xd8\x57\x56\xb0\x03\x1f\xef\xa9\xa2\x33\x6b\x80\xcc\x24\xbd\x3b\x01\x32\xe0\x41\x04\x66\xb5\x7c\x8f\xfc\xa1\xa7\x72\xba\x74\xa8\xf4\x4b\x38\x6f\x76\x25\x96\x62\x4d\x91\x5c\xd3\xa3\x24\xdf\xa0\xc3\x3c\x20\xda\x95\x24\x88\xba\x20\xb3\x82\xa4\xfb\xe2\x47\x2d\xe8\x82\xe8\x0a\x72\xee\xcb\xe7\x5a\xcc\x7d\xf9\x12\xa4\xdc\xab\x1f\xc9\xe4\x39\x39\x7e\x4d\x9e\x1f\x5f\x93\x5b\x7a\x7c\xfc\x23\x79\xa0\xab\x30\x89\xf9\x2f\x25\x7b\x77\xfe\x69\x34\xf2\xdc\x3e\x82\xdd\x12\x70\x10\xbd\xae\x81\x28\x0c\xe3\xd2\xce\x9f\x8a\x04\xd5\xd7\x70\xbd\x0a\xf2\xda\xf0\x68\xe4\xdf\x53\x1b\x08\xed\x07\xe4\xd2\xb3\xf6\xae\x53\x2f\xd0\x73\xa7\xc2\xd1\x68\x78\x3f\x1a\x0d\x85\x0f\x32\x77\x2b\xb7\x3f\x7c\xd8\x6e\xef\x47\xa3\xfb\x3f\xbe\x19\x8d\xee\x7f\xa2\xe0\xf5\xfe\x8c\x3e\x3f\x26\xa7\xb4\x8f\x1a\xce\x02\xf2\x85\x6e\x9c\x1e\x8f\x36\x05\x74\x66\xfa\x95\x2d\xb3\x12\xb6\x28\x99\xe0\x46\x06\x98\x57\x73\x90\xb5\x23\x4f\x70\x67\xe3\xe8\xa1\x9b\xef\x4a\xee\x25\x9c\xea\x68\x6f\x47\x52\x56\xc0\xa9\x22\x4f\x40\xb6\xbf\xea\x21\x37\xd2\x22\x66\xd2\x9e\xc4\xa4\x99\x04\xd7\x3b\xd2\xe6\x85\x4f\xc3\xb6\x5b\x9b\x8b\x70\x3b\xed\x31\x9c\x71\x35\x22\x8f\x60\x8f\x8b\x57\x4f\x53\xf4\x0a\x4a\xda\x8b\x5c\xbb\x29\xc8\xed\xbf\xbb\x31\x58\xea\x60\x73\x30\xf5\xbb\x1b\x64\x60\xfe\xf3\x4d\x32\x16\x6e\xdf\xdb\x26\x23\xea\x1c\x6a\x94\x4e\xfe\xee\x56\x59\xa8\xbf\xaf\x59\x3b\xf2\x89\x0e\x27\x96\x5f\x7f\xa5\x1b\x76\x6b\xee\xfe\x96\xd1\x17\xc2\xee\xc1\xb7\xb2\xd6\xd9\xb4\x0d\xb8\x90\x77\x19\x41\xec\xaa\x11\x2a\x48\xc3\x29\xaf\x5b\x72\xf5\xd7\xa7\xcb\xd5\x26\xb9\x5e\xdf\x0c\xef\xd3\xba\x93\xf0\x40\x9f\xc3\x75\x16\xc3\x49\xe1\x4d\x4a\x11\xf4\x5c\x0b\x34\x6f\xed\xf9\x8c\x70\x02\xae\xe6\x46\x23\x9f\x87\x33\x0b\xdf\xaa\xce\x4a\xba\xee\x8d\x26\x32\x20\x4e\xbc\x3d\xea\xec\x64\xaf\xa3\x09\x0b\xba\xb2\x36\x9a\x72\x3c\xad\x39\xa3\xd1\x2a\x54\x5a\x9b\x56\x2f\x02\x60\xe9\xa0\x62\x69\x0e\x61\x89\x24\xae\xff\xaa\xd8\x9e\xff\x3f\xbd\x8e\x46\xaa\x74\xc1\x72\xaa\x4e\x56\xb8\x66\xe1\x71\xb6\x6d\x8f\x6f\x1e\x51\x9d\xf6\xe0\xc5\x35\x2e\x7b\x6f\xc9\xa2\x50\xc1\xe1\xfe\x56\x07\x21\xd9\x41\xc5\x0e\xf6\x37\x33\x12\x49\xf5\xe8\xfe\xaf\x18\xbc\xa6\x3d\xe5\xa1\xd1\xeb\xe2\x87\x62\x52\xbb\x3b\x61\x5a\xb9\x03\x85\xb6\x0f\x73\x78\x8b\xd7\x89\xac\x6c\x64\x1c\xec\xbd\xc3\x0a\xf3\xac\xe9\xf4\x46\x2d\xea\xc3\x25\xe0\x4c\x5b\x1d\xd6\x65\x0a\x0b\xa8\x0c\x1a\x21\xe1\xb9\x11\x12\x8c\x36\xec\xc5\x6b\x2d\x24\xbc\xc0\x67\x55\xf5\x6b\x31\x0b\x18\x71\xdb\x5b\x20\x33\x6c\x1e\x13\x42\xa2\x84\x3c\x96\x7c\x71\x93\x15\x97\x48\x51\x51\xe5\x64\x7c\x87\xde\x95\x1d\x28\x85\x93\xd8\xb4\xcb\xc9\x90\xba\x1c\x64\xf6\x1d\x1c\xa4\xde\x22\xea\xc7\x3c\x8c\xab\x25\xf3\x02\x83\x09\x18\x07\xfb\x26\x54\xbb\xa9\x87\x70\x0f\xef\xe8\xc8\xae\x82\x9f\xe6\x59\x72\xe3\x45\x6e\xc8\xb2\x6e\x1b\xf9\x4e\x54\xf3\x9c\xb5\x33\x3a\x71\xdd\xec\x0d\x5b\xde\x8b\xe9\xcd\xfa\x49\xdc\xf6\xc4\xf4\x66\xfd\xa5\xe8\x86\xeb\x6c\x66\xc7\xe9\x0f\x71\xf2\x81\xf2\x2d\xdd\x6e\x87\x02\xdc\x72\xf5\xec\x4a\xeb\x27\x21\x5f\x69\x1d\xed\x8b\x17\x5a\x47\xfb\xe2\xa5\xd6\xd1\xbe\x78\xa5\x75\xb4\x40\x63\x95\xa1\xb1\xb4\x3e\xc8\xdf\x05\xcd\xab\x8a\x1d\x23\xe2\xd1\xc8\xcf\x42\x76\xcf\x92\x4a\x39\x14\xf0\xc1\x1c\x51\x00\x91\x13\x38\xe0\xfe\x02\xfa\x12\xa0\x52\xe5\x07\xdb\x6d\xeb\x3a\x48\xbd\xfd\x80\xb9\x4b\x56\x7d\x57\xf9\xe1\x6a\xd2\x70\x1c\xec\xc8\xfa\x60\xea\x24\xd8\x91\x79\x4f\x2a\xf8\x5f\x03\x13\x32\x21\xd0\x52\xe7\xc3\xbb\x1d\x58\xcb\x34\x07\x44\xe6\xd3\x39\x6a\x40\xcc\xa3\x38\xec\x4f\x20\x7b\xd1\xe6\x34\x24\x8a\x0f\x1e\x94\xec\x48\x51\xd5\x93\xb4\xb3\x1a\x07\x9b\xbe\xbb\xd2\x27\xb9\xef\xfd\xf8\x02\x1e\x93\xb2\x31\xad\x03\x7a\x41\xe1\x2e\x13\x91\x34\xbd\x52\xd7\xdb\xad\x0f\x3f\x74\xb3\x0b\xa6\xf2\x4a\x5c\x53\x6e\x7c\x87\xc6\x7b\x6c\x58\xbb\xca\xd1\xa7\x67\x60\x63\x90\x66\xe9\x97\x06\xb3\xfd\x18\x83\x22\x1e\x12\xf6\xe2\x6f\xb7\x95\x80\x00\xee\xad\xc0\xcb\x58\x38\x33\x4a\x72\x63\x78\x1c\x1a\x5b\xe8\x6e\x34\x9c\x43\x96\xc1\xde\xd6\x4b\x37\xce\x0a\x05\xb0\x0c\x5d\x89\x6b\x90\xaa\x40\xbf\xfd\x28\x16\x8f\x36\x18\x00\x85\x70\xe5\xf4\x5d\x0b\x50\x7f\x2c\x34\x3c\x30\xd8\xd8\xb6\xd9\x3d\xa4\x46\xcf\x18\x3c\x82\x6f\xf6\x9d\x45\xee\x6d\x9e\x5b\x00\xae\xd0\x65\xd7\x6b\x2c\x67\x0f\x45\xf1\x5a\x65\x0a\x06\x85\x69\xf7\xb4\x1c\x14\x35\xe9\x15\xbf\x06\x47\x9a\x66\x81\x3d\xdc\x32\x7e\x3d\x15\xa3\x91\xe8\x6d\x59\x5f\x2c\x08\x51\x81\xc1\x77\x60\x6a\xd9\xed\xbe\x29\x30\x5a\xb4\xc1\x5d\x52\x1c\x16\x38\x51\x4a\xe3\x50\x38\xef\xf1\xc2\x9a\x1b\x2f\xac\x46\x58\xa9\x68\x19\xb6\xaa\xa8\x21\x4f\x2b\xd4\x4a\x25\xbe\x04\xaf\xb2\xb5\x93\x4b\xb9\x23\xc6\x5e\x7f\x0f\x25\xcd\x74\x0a\x9a\xf8\x05\xca\x6a\x70\x9e\x0d\x57\x87\x31\xe3\xff\xa8\x58\xd5\xb6\x31\x06\x7c\x14\x2d\xa6\x86\x81\xb1\x93\xca\x57\x64\x15\x44\xf0\xb3\x0e\x48\x81\x53\xed\x65\x63\x77\x58\x86\x92\xa1\x35\xd5\x69\x5c\x2d\x57\x0a\x7d\x88\xf9\xc1\x8e\xcc\x66\x45\x25\x97\x0e\xec\x60\x93\x52\xb8\xf1\x30\x9b\x39\xb3\xe3\xe7\x98\xdf\xb8\x59\x4c\x73\xd2\xdd\x23\x56\x72\x8f\xad\x88\x28\x8a\x65\xf5\x55\x17\x06\x54\x53\x0b\x80\xe5\x15\xbb\x26\x70\x52\x6e\xf5\x9f\xda\x1d\x1f\xff\xe3\xb3\xda\x44\x24\xf6\xbd\x1f\x5f\x79\xf0\xea\xf6\x30\xb1\xc3\x06\xf7\x62\x36\xaa\x3d\x1e\x6e\xfe\xd7\x98\xdf\xcd\x4e\x95\x99\x0b\x2a\x6c\xb6\x19\xb5\x09\x26\x3e\x70\x2b\x02\xe9\x8b\xab\xfc\x9a\x28\x92\x07\x2e\xb4\x37\x1e\x41\x41\x6a\xd7\xb3\x38\x27\x1a\x1c\xd0\xb3\x5d\x3b\xb4\x80\xb6\x67\xcf\xc2\x83\x13\x40\xed\x47\x8f\xd4\x9c\x90\x1c\x2e\x0d\x37\x71\xed\xd3\xf9\xec\x90\x18\x6d\xe6\xb4\x6d\x85\xd4\xad\x80\xc8\x6e\xe5\xd2\xa8\xcf\x32\x7c\x8a\x61\x9a\xfb\x19\xa2\xbf\xeb\x5a\x1e\x0c\xd9\xde\x34\x1d\x8d\xfc\xdc\xdf\x8f\xc6\xf2\xf0\xba\x41\xcf\xc3\x82\xc9\xc1\xb9\xce\xae\xa1\x13\x26\xe3\x31\x0c\x50\xd3\x0b\x87\xb3\x53\xd5\x93\xfc\xce\xd1\x7c\x5c\xb1\xeb\xd6\x98\x5e\xf1\xeb\xd0\xdd\xbe\xd6\x0f\x19\x3e\xb7\x6f\x48\x83\x3c\x00\x13\x09\xc4\x06\x34\x78\xdb\x18\x32\x01\x7b\x90\x43\xe3\x01\xe7\x37\x07\xb0\xec\x4b\x72\x31\x84\xf4\xc2\xd8\xa7\xec\x0d\x21\xea\x0b\xf7\x57\x64\x5c\xa8\x9b\x59\x08\x1b\x2c\xdd\x71\x93\x66\x9a\x67\xfa\xe5\xd8\xe6\x82\x8e\xb6\xa6\xb5\x8f\x66\x12\x01\x73\xfe\xd0\x82\x1e\xf5\xbe\x36\x63\x29\x09\x59\x3b\x03\xd6\xce\x0e\x3b\x69\x80\xdb\xe2\xd3\x1e\x32\x1f\x8d\x70\xc2\x51\x2a\xb7\x5b\x1f\x3e\x35\xe6\xc7\x2e\xe1\x43\x34\x95\x44\x51\x20\x20\x35\x1a\x21\xae\xb0\xf3\x43\x1c\x75\xc7\xbe\x17\x12\xf1\xee\x41\xb5\xbb\x1b\x82\x89\xa0\xf6\xc8\xc4\xae\xcb\x87\xe9\x6b\xbf\xcc\xb5\xf6\x16\x3a\xad\x4d\xbc\xd1\xcf\xeb\xa3\xdb\x58\x7e\x38\x43\xdd\xa1\xe2\xb0\x55\xbf\xa8\x3b\xf4\x30\x9e\xfc\x4a\x5c\xe3\x62\x54\x9f\x72\xc8\x7a\x9d\x01\x74\x77\x64\x06\xae\x2f\x5a\xe3\xdc\x74\x5b\xb0\xc9\x70\xe5\x98\x76\x78\xf0\xde\xd8\x31\x58\xb6\xf5\xba\x0a\x73\x6f\x5a\x33\x50\xb3\x3c\xd2\xb1\x71\x9b\x7a\x98\x6f\x3d\xc9\xe9\x82\xa9\x03\xae\x37\x1a\x16\x77\xb0\xe9\xd3\x0e\x73\xeb\xe1\x6c\x35\x3c\x60\x6d\xad\x55\x2a\xf9\x3d\xfb\x36\xab\xa5\xfa\xa5\xb0\x7b\x37\xd0\x9b\x8a\x2a\x59\x35\xa7\x12\x75\xd4\x29\xec\xd9\xf3\xfd\x9d\xdb\x1e\x34\xd8\x1f\x75\x0b\xd7\x71\x2d\x16\xba\x57\xb4\x3e\x65\x74\x8a\x6a\x7d\x62\xbb\x6c\xdc\xc8\x3a\x66\x82\xa2\xd8\xba\xdd\x7a\x15\xbf\xe1\xe2\x8e\x3f\xc3\x41\x03\xdf\x5f\x46\x8c\xd5\x3b\x65\x7d\x4e\xd0\xb5\xa3\xf7\x45\x40\xd4\xc9\x3a\xcc\xf8\xad\xb8\x61\xff\x59\xc5\x32\x65\xe9\x69\x9c\xe7\xf3\x38\xb9\x01\x67\x1c\xa7\x30\xfd\xc0\x15\x03\x70\xf2\x03\xf9\x4c\x32\xe9\xd6\xd8\x3e\x18\x34\x87\xa4\xf5\x65\x19\x3b\xb7\xad\x44\x52\x12\x41\x59\x8f\xaa\x64\xff\x35\x62\xde\xbc\x46\x2c\xe9\x78\x2a\x7f\xe2\x86\x72\x47\xa3\x21\xee\xe1\xa4\x28\xe2\x25\x32\x88\x0b\x25\x8a\x82\xa5\x7e\x30\x95\x47\x47\x81\xe9\xbb\x2b\x79\x4d\x80\x8a\xcc\xdb\xc0\x7c\x34\x6a\x3a\x75\xda\x87\x99\x91\xc8\x7a\x90\xeb\xb4\xb1\x75\x94\xba\x0f\x87\x70\xda\x07\x64\xbf\x85\x70\x0e\x66\x9b\x68\xac\xfe\x9f\xd0\x44\xb8\x17\x00\x2c\xf2\x4a\x80\x6b\x24\x7e\x25\x6a\x2f\xd2\x03\x08\xec\xac\xfb\x22\xb8\x00\x03\x19\x9a\xd4\x7a\x13\xd3\x6a\xcd\x81\x5b\x34\x7d\x4d\xd8\xeb\xa1\x4e\xdf\x39\xce\x91\xab\xc6\x39\xf2\xf7\xf4\x51\xb7\x83\x4e\x56\xb0\xdc\xb4\xee\xe1\xb4\xa9\x4f\x9d\xf4\x53\x3c\x37\x7a\x46\x23\x28\x9e\x28\x7b\x3f\xaf\x69\xdf\x3e\x19\x3f\xd2\xb4\x3e\x6c\x75\x77\x38\x8f\x77\xa4\xcd\x8c\x1f\x0e\xfb\x60\xa1\x00\x83\xaf\xbb\x1b\x21\x66\x6d\x94\x1d\xf3\x5a\x98\x01\x35\x9a\xd1\x02\xd4\x16\x6f\x78\xed\xd1\xd6\x02\x75\x14\x94\xed\x48\x8f\xc1\x64\x2b\xd3\x82\x32\xb0\x5d\x06\xfd\x42\x79\xc6\xd3\xac\x5c\x45\x02\xef\x15\xdf\x32\xf8\x96\x04\x9c\x1b\x82\x93\x9a\x72\x15\xe5\xa4\x56\x9a\xb8\xda\xb5\xa8\x22\x87\x94\x29\x51\x76\x30\x09\xa6\xe3\x5b\x75\x29\x2b\xb8\xea\xba\x8a\xcb\x26\x43\x94\xf6\x5e\xfe\x71\xb1\x36\xc3\x53\x84\x3d\x19\xc1\xac\xf0\xa9\xb7\x38\x8b\x5e\xb2\x00\x00\x59\xf9\x16\x46\x4f\x89\xee\xb6\xdd\x94\x5c\x84\x4d\x0e\x73\xbb\x1c\xb6\x54\xe2\x8e\xa1\xf9\xe6\x5a\xf0\x6f\x95\x3f\x90\xbd\x01\xd6\x56\x8a\x3b\x60\xda\x40\xda\xd9\xb0\xf5\x5d\xad\x79\x0b\x05\xe7\x21\xf0\xc5\xbe\x7e\xdd\x6a\x4f\x6c\x42\xa3\x2b\xed\x00\x69\x3d\xa1\xb1\x08\xf7\xf3\x3b\xd9\x2c\x19\x82\xf2\x6a\xfe\x3b\x77\x95\x26\x59\xc9\x87\x0d\xf8\xc6\xdb\x25\xb1\xd1\x91\x1a\x5f\xef\xa0\xa4\x97\x14\x36\x6d\xc6\xc6\x13\xa6\x5d\x4e\x37\xbd\xcb\x14\xd0\xf8\xa3\xcb\x5c\x24\xc8\xfe\x96\xba\xe9\x01\xdc\xe2\x4a\x6b\x94\x25\xcd\xab\x79\xa6\x52\xb6\xdb\x7d\xcf\x7b\x93\xfd\xf7\x94\xcc\x23\xed\x0a\x6e\xbb\x3c\x1f\x37\xac\xcd\xbe\xdd\xae\xa2\x36\x17\x64\xc1\xc9\x1e\x5b\xf4\x19\xde\xba\x31\x2e\x8f\x80\xae\x60\xe5\x36\xb1\xbe\x0e\xed\x15\xba\x62\xd7\x21\x58\xe0\xc6\x0a\x8e\x61\xae\x18\x51\xd7\xb6\x47\x81\x19\x7d\xeb\xa6\xfd\xa1\xc6\xd5\x6d\x6f\x57\xc8\x82\x13\x56\xfb\xcc\x81\xf4\x08\x4d\xab\x70\x47\xc3\x61\x0b\x7e\xd0\x5a\xae\x53\x11\xa0\xc8\xe9\xb0\xf7\x41\x00\x6d\x5c\xb0\xdd\x0e\x8d\xf5\x9c\x35\x15\xd8\x8f\x31\x0f\x91\x1b\x6d\x1f\xf8\xb2\xda\xd4\x36\x01\x11\x87\xb7\xb9\x7f\x29\xd9\x5f\x85\xbc\x01\x6d\x59\xdf\x2d\x78\x9d\x66\x32\xb6\xac\xe4\xcb\x88\x83\x99\x9c\xad\x31\x4e\xd3\x56\xf2\x68\x54\xa7\x28\x15\x27\x2b\x4c\x0c\x0c\xa0\xbf\x64\xec\x0e\x3a\x01\x41\xd8\x7c\x65\x22\x19\xe3\x24\x2b\x3f\x70\x5d\x6b\x34\xe4\xbb\xc3\x03\xf3\x28\xdd\x59\x57\x15\x70\x9b\xc3\x7a\x88\x9a\x95\x20\x72\x82\xd1\x04\xb5\x9e\x9d\x20\xe0\x1b\x27\x4a\xb3\x85\x99\x33\x10\x89\x84\xdf\x90\x89\xb6\xad\x7e\x69\x6d\xab\x8f\x83\xa9\xf4\x85\xe3\x57\x62\x93\x32\xab\x49\x77\xe7\x94\x83\x03\xc0\xdb\x43\xc3\x89\xdc\xaf\x9c\x18\xfc\x5c\x80\x46\xae\x46\x13\x59\xb0\x1c\x69\x2a\x38\x69\x3e\x43\x4c\x8e\x9a\x88\xab\xd8\x0f\xae\x51\x7f\x0c\x56\x61\x2e\x3c\x10\x98\xf6\xaa\xb7\x92\xd3\x7e\x0a\x6e\x75\xb0\xeb\x69\xa7\x29\x04\xce\x1f\xb5\x14\x47\x64\xb7\x7b\x73\x2a\x4d\x1a\xee\x85\x18\x1d\x4f\xd9\x4f\x02\x74\xca\xec\x1a\x18\x1d\x6c\xd4\xd8\xd1\x91\xd6\xf5\xc6\x54\x3c\x63\x98\x4f\xd1\xc9\x54\xfd\x44\x63\xc8\x28\x9e\x29\x9d\x35\x7f\xa6\xae\xa7\xca\x66\xce\xa8\xfa\xe3\xe4\x64\xf2\x4c\x19\x6e\x32\x3d\x88\x3a\x95\xe6\xa6\x2e\xf8\xd5\xec\xe9\x73\x90\x19\xf2\x30\x4e\x53\xb0\x36\xca\xf8\xf2\x52\xc0\xf6\xe1\xe9\xc4\x57\x7b\xd4\x78\x05\x1e\x35\x8c\x58\xd3\x94\xb0\x52\x21\x54\x0c\x22\x31\x98\xf3\xeb\xf5\x0d\xea\x33\xbd\xd3\x6c\xc6\x5b\x89\x78\x03\xba\x6e\x59\xc3\x4a\x1c\x03\x31\x76\x37\x00\x51\x0f\xda\x40\xfb\x94\xf2\xb6\x5a\x7e\xb8\x5a\x41\x3b\x89\xad\x6a\xb9\xae\x56\xe0\x89\x69\xf3\x30\x15\xb8\x18\xe3\x66\x81\x8f\x5b\x35\x3b\x46\x08\xa6\x6e\x71\xb8\x6e\x49\x3b\x89\xad\xba\xcd\xab\x35\x92\x18\x8d\x9d\x74\x6b\xb7\xeb\xe8\x8e\x64\x9d\xfa\x9b\x5d\xa4\x6d\xbd\x3c\xdc\xfa\x9c\x76\x12\x5b\x18\x48\x8d\x41\x4e\x2c\x60\xd2\x7a\xb9\xcc\xa8\x52\x89\x00\x7f\x3d\xf4\xc0\xa0\xb7\x1e\x5b\x51\x56\x39\x2b\x7c\xef\xf8\x25\xdc\x83\x0f\x1b\x0e\x02\x0c\xa9\x0f\xd3\x9f\xe0\x32\xbc\xc8\x2f\xb2\x7f\xe0\x92\xd2\xca\x61\x2e\xdb\x83\x2e\x70\x32\x26\x15\x95\x24\xed\x25\x84\xfa\x01\x9b\xf6\x60\x83\x73\x33\xde\xd8\xda\xc1\xdb\x42\x15\xe1\x75\x75\xdb\xad\xdf\x04\x68\x02\xd6\x00\xe6\xbc\x90\x96\x84\xef\x48\x41\x37\xee\xcc\x89\x52\x78\x25\xe4\xad\xb9\xa4\x8d\x20\x65\x24\x89\xba\x13\x9d\xb8\x9c\xa8\x95\x64\xdd\x9c\x31\x59\x88\x4a\x76\x22\xb3\xdd\xef\xf3\x24\x62\x79\xe6\x30\x1f\x8d\x64\xcb\x1e\x2e\xa7\x9e\x71\x42\xaf\x8c\x09\x5d\xbd\x68\xda\x0f\xb3\x6c\x9e\xb4\x32\x46\x5e\xc6\x39\x93\xc0\x54\x3c\x6b\x4e\x28\x8d\x19\x61\x8e\xac\xfb\x77\xae\x59\x86\x86\x0c\xc2\x96\xe8\xac\x77\x24\x4c\xab\x2b\x7b\xf9\x02\x2a\xdb\x34\x36\xca\x53\x19\xc6\x15\xba\x9e\xd7\xd7\xa9\x04\xc9\x03\xf2\xcf\xe1\x01\x35\x77\xb4\x94\xf5\x52\xda\x18\x69\x80\x8b\x12\x88\xe2\x31\x5c\xa0\xc0\x55\xde\x1c\x7e\x9a\x75\xc0\x3d\x65\xae\x5d\x64\xd7\x4a\x31\x3c\xb7\x90\xa0\xa7\x95\x5d\xbd\x58\x6e\xd4\x8a\x19\x95\x57\xf9\xf5\x34\xc3\x25\xee\x2a\xbf\xa6\x99\xcf\x83\xc8\xd3\x28\x80\x5a\x29\xc7\x94\x50\x47\x50\x11\xd9\x7c\xfc\x2a\xd7\xa2\x9e\xde\xcd\x0e\x29\x6f\x5c\x11\xa2\x22\x89\xa5\x27\xfb\x51\x11\x10\x37\x90\x8c\x75\xff\xd2\xbc\x61\x5e\xea\x8a\xb2\xf2\x5d\xa7\x0c\x8d\x43\xf0\xcf\xfb\x15\xb3\x95\xb8\x07\x7c\x52\xce\xf7\x71\x5e\x9a\x2e\xed\xd3\x7b\x1c\xca\x7c\x48\x30\x79\x63\xce\x07\x5e\x07\xa4\xe7\xc2\xc4\x17\x29\xee\x1f\xc8\x95\xd7\x1e\x52\x8f\x78\x8e\xc9\x8d\x47\x3c\x67\x20\x3d\xe2\xed\xb7\x00\x23\xf7\x71\x05\x38\x16\x72\x2d\x22\xba\x91\x76\x43\x57\x7a\xd7\x78\x6e\x01\x58\x21\xed\x12\x5d\xbd\xfe\x6e\xa9\x27\xa2\x56\xfb\x3f\x43\x3a\x76\x23\xee\xec\x74\x7e\x6d\x2c\x58\x9a\xc2\x00\x3f\x07\x33\x0c\x03\x38\x5b\xb3\x0b\x15\xaf\x8b\xbe\xad\x32\x0b\xeb\xe4\xed\x16\xde\xc7\x0a\xb9\xb8\x03\x25\xfe\x3e\x41\x00\xac\x0c\x86\xb5\xb4\xc1\x5d\x57\x00\x2c\x74\x6e\xd3\x59\xae\x8c\x05\x03\xb6\x47\x64\xd4\xbc\xd7\xc6\x68\x77\xf2\xa0\x73\x18\x16\xb6\xe1\x9d\x74\x23\xfc\x20\xb2\x3a\xd1\x46\x56\x67\x2e\xe1\x22\x14\x97\x90\x87\x93\x80\x3c\x89\x28\x81\x7c\x61\x25\x03\xeb\xda\x66\x98\xdd\x26\x3d\x8a\x79\xa7\xdc\xc9\x5e\xcc\x01\xdc\xf5\xe0\xfd\x8c\xe3\x89\x80\xdc\x08\x38\x65\x79\xea\x3c\x31\xf8\x17\xda\xda\x65\x6f\x28\x5c\x3b\x98\xfd\x82\x3b\xe2\xa6\xb7\x09\x50\x4f\xc0\x7e\x51\xdf\xe9\x92\xc7\x19\x1e\x3c\x39\x37\x60\x01\xe4\x04\x83\x91\xd6\xa9\x86\x76\xa2\x98\xb9\x4e\x14\x31\x5f\x76\xc5\xaf\x75\x56\x10\x53\x45\x03\x95\x96\x44\xb4\xf8\x7e\xef\xa2\x0f\x30\x88\xf3\x8c\x08\xb8\xca\x73\x88\x97\xf2\xe6\xdb\x88\xe0\x28\x59\x4d\x25\x3c\x4a\xdc\xa4\x05\x6e\x80\xb6\x92\x5a\x9e\x65\xc1\xcc\xa4\xc1\x50\x82\x57\x43\xde\x44\x80\xfc\xc8\xda\x38\xf3\x56\xb0\x2b\x86\x33\x92\x87\xfb\x02\x41\xb0\xdb\x13\xd7\xfb\xf3\xfd\x93\x8b\xe0\xff\x0e\x8b\xb1\x95\xa4\xbf\x34\x12\x9a\x7f\x19\x26\x78\x97\xdb\x08\xc5\x76\x85\x12\x21\x8c\x14\xf5\x74\xa2\x47\xee\x1e\xbf\x0f\x21\x5a\x2f\xfb\xcb\x46\x64\xd5\x0e\xd0\xe0\x14\xcb\x3a\x43\x83\xef\xb6\x43\x12\x53\xa7\x63\x0b\xa8\x5c\x33\x41\xb8\x4f\x05\xaf\xcd\xc0\xb7\x7e\x04\xad\xa9\x29\x6f\x6a\x12\xfe\x47\xc2\x08\x3c\x25\x18\x4c\x4f\x3b\xee\xfb\xfc\xb8\x65\x9f\x89\x66\xba\xf7\xd6\x87\xa4\xb5\x61\x09\xc8\x7d\xb8\x67\x7d\xe2\x0f\x27\xc1\xde\xf1\xcc\x67\xca\xc8\x47\xaa\xc8\x67\x57\x25\xe1\x7b\x82\xdb\xde\x72\xad\x4a\x4b\x3f\xd8\x7c\x1e\x8d\xfc\xcf\x78\x6d\xac\x37\x33\xf9\x8c\x73\x92\x7c\xec\x7a\x58\x4b\xb4\x87\x35\x18\x35\x4e\x3f\x19\xe7\x97\xc8\x85\x3f\x2c\xf4\x15\x7c\xd0\x99\xa3\x63\xf1\x32\xd3\x86\xa0\x29\xa5\x74\x38\x1e\x8d\x7e\x09\x67\x71\x9e\x8b\xbb\x0b\x1b\xff\x25\x2e\xcb\xcb\x95\x14\xd5\x72\x05\x7b\x18\xbe\xdd\xd6\x8e\xc5\x9d\x0e\xad\xea\xdb\x48\x70\xc0\xa6\xeb\xf0\x9c\x07\x23\x07\x6a\xcf\x34\x36\xd8\x40\xd6\xf7\x22\xa9\x4a\xcc\x79\xe2\x97\xf0\x0e\x20\x3c\x58\x1b\x04\x51\x6d\x31\x0f\x49\xf0\x28\x4a\xb0\xe7\x47\xfd\x60\x77\x1a\xdf\x16\x0f\xb6\xa7\x56\x4e\xd9\xc5\xa1\x6e\xdd\x2f\x74\xb0\x7b\xf1\xce\x98\x87\x5a\x0e\xc4\xbc\xe5\xfe\x64\x34\x4a\xfc\x8f\x04\xce\x81\xf3\xd6\x1b\xc5\xeb\x43\xad\x5e\xf8\x01\x29\xfa\x5b\xbd\x70\x5b\x3d\xb7\x00\x4c\x27\x6b\xbf\x09\x99\xe0\x4e\x6f\xeb\xf3\x4d\x7d\xaf\xa3\x15\xae\x4f\x40\xed\x78\x00\x92\xdc\x81\xbe\xec\x9b\x7b\x76\x56\xc3\x54\x6a\xe6\x55\x7b\x16\xc2\xf3\x1c\x78\xcd\x7c\x2e\xee\x9b\xa9\xb6\xdd\x7a\x32\x4e\x33\xd1\xc4\x38\x75\xcd\x3a\x2d\x41\x83\xda\x0e\x76\xaa\x85\xdd\x6d\xa7\xc4\x07\x8b\x8c\x69\x61\x0f\xc1\x75\x41\x3c\xd4\x14\x0a\xa3\x39\xb4\xaf\x28\xe2\xa9\xe1\x9d\x8c\x8b\x82\x49\x74\x07\xbb\xdd\xaa\x76\xc4\xd4\xda\xc1\x9b\x3b\xd5\x39\x4b\x47\x23\xfb\x3e\xa6\xee\x0f\x7c\x60\x11\xa0\x09\xea\x79\x47\x46\x01\x36\x55\x6d\x47\x91\x86\x60\xe0\x56\xaa\x80\xfd\x73\xd9\x93\x08\x5c\x5d\xeb\xdb\xef\x8d\xed\xf7\x9d\xb9\x28\x76\x63\x76\x78\x67\xc6\x57\xc2\xa9\x71\x8e\xfa\xc5\xf0\xff\x4f\xe0\x34\xf5\x25\xde\xdd\xf3\x5f\xbd\x0a\xc8\x57\x08\xbf\x0e\xc8\x05\xfc\xbe\x09\xc8\x25\xdd\x68\xca\x7e\xe2\x65\x1e\x33\x0b\x5a\xb7\x5d\x30\xee\x89\x57\x5c\x4c\xf9\x66\x78\x49\x43\xf7\xa4\x19\xc2\xde\x8b\x2f\xf6\xaa\x4b\x97\xc2\xe1\xc2\x4b\x6b\x4e\x92\x0f\x60\x72\x73\xd3\xda\x56\x7f\xa0\x5f\x7d\xbb\xfc\xc0\x4d\xd8\xe1\xde\xae\x1a\x2e\xb4\x6d\xb7\xbd\xd1\x7f\x7c\x63\x2e\xa8\xfe\xb6\x0f\xf8\x37\x00\xac\x67\xc1\xf7\xc3\xfd\xd1\xc0\xfd\xa5\x75\x47\xe7\x92\x1c\x66\xb6\x11\xf8\x8b\x01\x5d\xb4\xf5\x81\x72\x51\x15\xb0\x7c\xb3\x34\xfa\xed\x71\x4b\x4d\xfb\x22\x0a\xc9\x48\x49\xd5\xc9\x59\xef\x91\x9c\x0a\x22\xad\xf6\x06\xf2\x96\x7e\x19\x9c\x7c\x38\x89\x69\x15\x65\x34\x8d\x2e\x20\xf8\xdb\x49\x4c\x6f\x23\x3f\xa6\x73\x92\xd1\x75\x10\x2d\xfd\x12\x9a\x1d\xd3\x59\x40\x62\x5d\x43\x42\xcd\xd9\x3e\x5a\x1c\x26\xf6\x19\x73\xe1\x27\x88\x85\xe5\x1e\xc5\x6e\x97\xe1\xdd\x0a\x52\x82\xf0\xd5\xe1\x71\xf0\x84\x67\x19\xb4\x0e\x78\x7e\x79\xba\x7c\x12\x6c\xce\x43\x74\xa0\xd3\xe7\xe5\x64\x34\xba\xb3\x7a\xae\xca\xf7\x26\xc7\xcf\x3d\x87\x1f\x48\x2b\x8f\x4b\x96\x69\x6f\xf5\xd9\x3f\xdc\xc2\x56\x2d\x9f\x66\xd2\x71\xc1\x52\x7e\x44\xa5\x9d\x39\x78\x87\xf4\xc4\x28\x71\xd1\xe8\x94\x16\x8e\x88\x64\x00\xc8\x1e\xcc\xe8\x23\x58\x3b\x10\xfa\x6c\x03\xe1\x75\x78\x18\x00\xd3\xb9\x70\xd3\xf2\xae\x2b\xb6\xb8\x19\x0f\xdc\xbf\x1f\xb0\x70\x86\x4e\x8e\xf1\xec\xf8\x99\x72\x43\x4d\x91\xcc\x5d\x15\x7a\x7b\x62\xaa\x86\x94\xce\xcc\x8e\xe1\x04\xbb\x19\x4d\xd6\x6d\x54\x7d\xce\x36\x0b\x4b\x21\x95\x1f\x07\xe4\xf6\xe8\xa8\xb3\xe3\x50\xb8\xd5\x30\x52\xe6\x0c\xde\x90\x01\x8d\xec\x0c\xd8\x0b\xbe\x8c\xa7\x7b\xb8\x9c\xf6\xc4\x39\x8f\x68\x00\x11\xae\xc2\x5c\x2c\x2f\x45\xf1\x11\x7c\x98\x7c\x05\x13\x47\x69\x6c\xcc\x32\x2a\xa0\xbc\xd9\xdf\x1b\xcd\x1a\xb2\xee\x30\x2b\x71\x30\x6c\xb1\xbf\xea\xd5\x00\x2e\x35\x50\xe1\x38\x9e\xb5\x2d\x07\x61\x5b\xbf\x88\x31\xd0\x92\x15\xb8\xe4\xcd\x60\xd8\x80\x8f\xfa\x01\x38\x87\x2f\x45\xae\xf7\xf7\x7e\x1e\xec\xe0\xc9\xab\xb0\x60\x72\x21\xe4\x5a\x0f\xd1\x87\xc5\x67\x06\x02\x63\x2c\x1f\xc0\xcd\x6a\x2f\x8d\x90\xdb\x80\xe4\xa3\x91\x0b\xec\x8c\xa7\x7e\x1e\x10\x59\x9b\xe8\x94\x74\x3c\x2d\x7f\xaa\x4f\x37\xca\xa3\xa3\x80\xb5\x29\xd2\x4a\xab\xbe\xbc\x2a\xaf\x09\xfa\x50\xfe\x52\xcd\xf3\x2c\xa9\x39\xc1\xfe\x65\xa9\x16\x69\x65\xa5\x75\x0c\x64\xa8\xeb\xc4\x9f\xd5\xbe\x55\x61\x6c\xcd\x73\x33\x2c\x9c\xe9\xde\xc0\xec\x9f\x71\x79\xc4\x6d\xf4\x7e\x34\xbd\x3d\x9a\x04\x81\xa1\x8c\x3d\xe2\x85\xfb\x5b\x7b\xa2\xec\xd2\xef\xc1\xc4\x3c\xd9\x64\x42\x61\x5c\xc6\x05\x3e\x67\xf4\xef\x6a\x60\x5a\x3d\x88\xf9\x00\xa2\x07\xb6\x4f\x60\xf3\x1b\x0f\x8c\x33\xfe\xc1\xdd\x8a\x49\xa6\xd1\x2b\xf1\xb9\x27\x2e\xe0\x61\x2b\x78\xd2\xc7\x20\x15\x7a\x01\x79\xa8\xfb\x10\x50\x21\xf7\xe0\xad\x1e\x08\xae\x32\xc7\x2d\xe0\xc6\xe8\x39\x5e\x8e\xf1\x5f\xbe\xd1\x5e\x8c\x40\x21\x06\xb7\xb6\x5e\x9a\xab\xdd\xaf\xcc\xd5\xee\x57\x2f\x8c\x07\xa3\x09\x5c\xd3\xba\x82\x0b\xdd\x63\xf2\xd0\xe1\x1a\xf7\x70\xa7\xf3\x0e\x09\x1b\x5c\xdb\x37\xdc\x69\x4f\x95\xd0\xcf\x9b\xec\xd4\xdb\x91\x04\xfc\x75\x3f\xad\x94\x3b\x8b\xfd\x59\x58\xc2\x23\x51\xcc\x1f\x3f\xc2\x03\x03\xf2\x05\x1c\x3c\xd8\x52\x14\x5c\xf8\x9f\x3d\x8a\x6e\x9b\x30\xd1\xdc\xd2\x0f\x0e\x61\xd9\xce\xcc\x85\xca\x16\x0f\x6f\xf3\xdc\x0f\x76\x3b\x72\x4a\xaf\x6e\xc8\xd9\xf5\x34\xf5\x65\xa3\x03\x20\x73\x02\x0e\x70\x9d\x19\x64\x26\xb2\xb3\x40\xd6\xb4\x7d\xba\x3b\xa0\x47\x39\xdc\x5e\x3d\x20\x45\x7d\x53\x69\x1f\xcb\x80\xec\xc7\xe9\x52\x8f\xb1\xfb\x16\xbc\x3e\x46\xf0\xd8\x3a\x02\xd0\x77\xc4\xf0\x96\xa8\xbb\x6a\x9a\xc6\xce\x2d\xf3\x71\x94\x08\x07\x41\xda\xbc\x87\x2b\x35\x5b\x7e\x50\x05\x2d\xda\x2a\x10\xa9\x85\x9c\x2f\xae\xaa\x07\x58\xd5\xd4\x12\xc9\x76\x7b\x3f\x45\x21\xde\x46\x58\xc5\x95\x74\x67\xc0\x94\x59\x24\xfc\xcc\xde\xc1\x24\xb2\xee\x28\x86\x1c\xf5\x3e\xd8\xc0\x44\x31\xe6\xb4\x0f\xd3\xee\x2c\x52\x2e\xcd\xb8\xc3\x06\x12\x36\xf9\x64\xaf\x7f\xf5\x0d\x89\xd3\x8f\xc1\x86\xb5\x44\x88\x57\x5e\xf0\xd8\x60\x36\x06\x62\x5d\x6f\x6a\x8f\xc0\x7c\xed\xb9\xea\x72\x47\x23\xd9\x66\x8c\x7a\x71\x7d\xd3\x58\xae\xd4\x4f\xf9\x3b\x45\xf6\xb9\x35\x96\xfa\xb1\x29\x75\x07\xf6\x69\xe4\x9c\x6e\x0e\x36\xc2\x28\xb6\x5b\x95\x83\xa9\x9a\x66\x82\xe6\xfa\x7c\x49\x16\x79\x55\xae\x7e\x6e\xe7\xfa\x62\x5a\x0f\x50\x3e\x11\xe0\xbc\x51\xe2\x4a\x76\xe7\xdf\xa5\x79\x42\x4a\x19\xfa\xed\x93\xcb\x20\xd0\xb6\x41\xa0\x22\xbc\x7c\x28\x98\xbe\x9b\xe3\x99\x57\xec\x80\xc0\xe1\xfd\x4e\x50\x7b\xe1\xfb\x83\xf5\x1b\x66\x5e\xa3\x2f\x7b\xfe\xca\x3d\xb6\xe8\x9e\x95\x3b\x2f\xd0\x32\x5f\x05\x1b\x61\xb4\x6e\x66\x1a\xce\xec\xf4\x36\x86\x88\x26\x52\xaf\x28\xad\xb8\x58\x2e\xa9\xb2\xe7\xb4\xae\xb2\xd2\xf4\x64\x33\x4b\x9a\x77\x4a\x1c\xe8\x9d\xf0\x76\x7b\x75\xdd\xc5\xa0\x5e\x87\x3b\x48\xb4\x83\x6e\x49\x13\x65\x0d\x96\x76\xc4\xc5\xab\x9e\x30\xb4\x5f\xb1\xdc\x54\x4c\x14\x6d\x03\xac\x2d\x32\x62\xb9\x04\x39\x0c\x4e\x88\xe1\x75\x99\xdc\x2e\x2e\xd6\xa7\x3a\x98\x5f\x1d\xbf\x68\xc8\xf1\xa9\x7d\x5a\x4b\x8c\xda\x64\xd7\x42\x46\xbb\x5c\x78\xe9\xcd\xb0\xb6\x2b\x71\x0d\xda\x48\x9b\x4c\xc7\xb5\x47\x7b\x5c\x9f\xdc\xd6\xa2\xd2\xa2\x10\x99\xfb\x58\x76\xcd\x32\x3b\x68\x9d\x74\xc2\x06\x66\x34\x6e\x83\x04\xed\x00\xe4\x6f\x00\xb2\xbd\x71\x1d\x8d\xda\xad\x1b\x8d\xfc\x4e\x0e\x8b\x30\x23\xed\x9c\x75\x7c\x67\xd8\x70\x21\x75\xdb\xf0\xd4\x3e\x6d\x83\x69\xd6\xc3\x3d\x58\xf5\x52\xcd\x76\x7e\xe0\xda\xaa\x75\x54\xe0\x71\xe7\xdd\x97\x7e\x7f\x8a\xfb\x12\x7a\x34\xfc\xfd\xce\x55\xa5\xd1\xfe\x7d\x65\x8b\xd2\x59\xda\x3a\x82\x7e\x33\xf9\x5f\x4d\x8c\x77\xf2\x57\xcf\xad\xdd\x6f\xfb\xb1\x17\x87\x53\x3b\xdb\x28\xb3\x87\x60\x61\x3b\xb3\xdf\xe4\xb0\xdb\xdd\xbd\x3b\xae\xf6\x7d\xf7\xfd\xcb\xaf\x92\x2d\x40\x13\xb4\x64\x0a\x99\xf1\x27\x00\xfd\x95\xc5\xe9\x83\x1f\xd4\x32\x27\xbc\xc2\x40\x32\xb4\x9e\xfa\x93\x79\x69\xa0\xb5\x96\xd4\xb5\x3a\x19\x40\xaa\xaa\xf8\x23\xcd\x82\x6e\xd3\x6a\x65\xec\xb6\x9e\x8b\xb9\x70\x5e\xd0\x05\x01\x6b\x27\x91\xfa\x3d\xb1\x7e\xb8\x8e\xf6\x61\xbf\xb9\xc0\x17\x60\xb7\x18\x6f\xb7\xf8\xda\x7d\x4d\x8d\x76\x83\x26\x43\xfd\xe4\x9a\x5e\x62\x10\x33\x50\xc0\x4f\xb3\xd1\xa8\x83\x6e\x0c\xe8\x75\x31\xc1\xb1\xc8\x03\x92\x8d\x46\x7b\x75\x7f\x63\x0c\xf8\xb7\xc7\x60\xb7\x23\x87\xb6\x70\x9d\x2e\x08\x36\xbd\x9b\x1e\x4a\xf9\x68\x54\x4b\x36\xfb\xfb\x40\x15\xec\x76\xc1\xef\x36\x73\x6d\x5f\x22\xaf\xcf\xdc\xd9\x09\xf3\x55\xef\xa6\x2f\xc2\xb9\x5b\x77\xde\xdb\xf2\x2b\x5b\x5c\x0a\xb8\xba\xdd\x52\x9b\xca\x6f\x00\x87\x6e\x0d\xa2\x3c\x94\x6c\x2d\x9c\xb1\x40\x70\xa0\x70\xb2\x00\xad\xef\x81\x57\xc7\xb0\xe6\x6e\x76\xd3\xd8\x99\xb7\x0d\xc3\x69\xeb\x68\x69\xdf\x33\xb7\xcd\xb1\x62\x28\xd9\xc2\x78\x85\xe3\x70\x01\x0e\x25\xd3\x70\x26\xee\x38\x93\xb0\x4d\x88\xf7\xe8\xa9\x53\x11\x20\xc5\xcd\x7d\x02\xfc\xb1\x3e\xe6\xfa\x9e\xd2\x85\x73\x71\xd0\x16\x4b\xb6\xd0\xb7\x69\x74\x3d\xc6\xd4\x04\x0a\x5a\xf3\x1b\x33\x25\x1f\x6b\x03\xda\x56\x23\xf1\x91\x9c\xd6\x38\x13\xf0\x1e\x27\xc1\xbd\x05\x7a\xc4\x6a\xf2\xcb\xd1\x08\xd0\x12\xd0\xa6\x94\xfd\x4b\xbb\x4d\xee\x75\x5b\x43\x82\xf1\x77\x90\xa0\xe5\x44\x43\x7f\x08\x4a\xf9\x3e\x79\xb6\x1e\xf0\x03\xe9\x75\xcb\xba\xa2\x9a\x11\xd1\x36\x3d\x04\xbb\x37\xf7\x84\xcf\xeb\xab\xc7\xf0\xc4\xd9\xe4\x47\x0f\xec\xc5\xea\xba\x81\x20\x91\x8d\xf5\xd3\xeb\x37\xe1\x1d\x8f\xcd\x1b\xef\x39\xe5\x7d\x13\x6b\x9a\x8f\x46\x30\x1d\x16\xe0\x70\x00\x4e\x28\xfa\x32\x81\xc3\x81\xba\xb5\xdf\x3d\xf3\xa1\x73\x0c\xbd\x36\x85\x36\x29\x9b\x57\xcb\x4b\x21\xf2\x3d\xb7\xc6\x4f\x33\x16\xdd\xa0\xf5\xe6\xe6\x80\x3a\x34\xea\xca\x04\x6a\x7f\x9f\xdd\xd8\xdd\xee\xa7\x59\x6d\xa8\x39\x59\x81\x27\x6d\xdf\xc5\x2a\x3e\xe9\x8b\xb4\x82\xce\x38\xea\x4b\xa5\xb5\x34\x0b\xda\x71\xa7\x2a\x3a\x9c\xec\x48\x37\x32\x1a\x4e\xc8\x01\xad\x80\x31\xab\xf9\xc0\x0f\x34\xb3\xfd\xee\x5f\x27\xe3\xa1\x4d\xb7\x16\x06\x40\xf5\x6e\xfa\xa9\x53\xce\x0f\x4e\xc0\xe6\xf2\x75\x2d\x01\xe3\x60\x24\xa4\x9a\xe2\x45\x8c\xfe\x76\x8d\x49\x02\xff\x34\xbc\x7a\x74\x40\x1b\x32\x06\x4f\x2d\xe6\xc2\x72\xbb\x76\x28\x32\xd9\x2d\x32\x1e\xe7\xf9\xc3\x06\x80\xa3\x77\xcd\xba\x16\xd4\xba\x68\x10\xcd\xd5\x0f\x7d\x8d\x6d\x2f\xbd\x86\xd2\x8f\xde\xa4\xbe\x49\x5b\xed\x48\x0b\x3f\xa7\x73\x9a\x4b\x77\x8a\x1e\x22\x20\xb8\x9f\xd6\x7d\xc5\xd3\x68\x85\xf1\xa6\x6b\x8d\x7c\x87\x24\xcc\x55\xe8\x03\x29\xc2\xe9\xb3\x13\x37\x60\xba\x0d\x1e\x74\x36\x8e\x56\x4d\x3b\xad\x59\x7a\x0f\x30\x4a\x65\xd3\x87\x0d\x28\xe8\x28\x7e\x34\x71\xbb\x12\xd4\x0c\xb6\x13\x5b\xfd\x70\x80\x2c\x0c\x8f\x01\xea\x78\xe3\x3c\x19\xfc\x3b\x7a\x0b\x1e\x97\x84\xfe\x22\x19\x3d\xd0\x0c\x24\xb6\x1c\x68\x2a\x83\x15\x67\x34\x8a\xf5\x78\xd7\x1f\x4d\xd7\x80\xb9\x7a\xee\x92\x12\xbe\xb9\x5f\x77\x41\x4d\x26\x7b\xad\xef\xab\xda\xa0\x0a\x7b\xb1\xdf\x29\xe9\x34\xc7\x11\xb0\x6c\x10\xde\x73\x5a\xcd\xdd\xd3\x6a\xfe\xf8\x69\xb5\x72\x0f\xaa\x55\xd0\x7b\x03\x79\xd0\x39\x21\xd6\xc7\xbb\x97\x32\x4e\x6e\xdc\xb3\x92\xdc\xbe\xa8\x7a\x38\x33\x75\xac\x20\xd0\x98\xe4\xd1\xcc\x7b\x57\x7c\x4d\xdb\x6d\x3b\x41\x20\x81\x57\xcc\x59\x70\xe2\x79\x47\x66\x43\xcb\xd2\xc8\x80\x09\x88\xb2\xc6\xa7\x78\x62\x9c\xd0\x0d\x38\x29\x31\xd0\x1f\xbb\x05\x28\xfd\xf2\xc0\x3d\x40\x7d\x15\x2e\x71\xbc\x9b\x80\x5f\x85\x85\x3f\x84\xee\xb2\x43\x53\x1e\xb8\x03\x48\x38\x05\xcf\x54\x27\x9e\x41\xd4\x8b\xec\xa9\x77\x46\xcf\x51\x5a\x81\x82\x8e\x05\xee\x3b\xe3\xe8\x1e\xee\x62\xb4\x9c\x45\xd5\x9b\x56\x30\xc7\x4f\xf0\xcc\x1d\xd9\x43\xd7\x82\x97\x07\xbd\x92\x06\x9e\xd5\x1c\x48\x29\x99\xda\x6e\x7d\x83\x8e\xbe\x8d\x54\x83\x03\x06\xbb\x61\x1c\xde\xe8\x41\x1b\xcf\x2c\x6c\x02\x70\xe8\xb3\xc8\x96\x15\x06\xe0\xec\x16\xcc\x47\xf7\x16\x13\x5d\xb5\xc3\x77\x76\xa4\x74\xf3\x81\x2f\x62\x68\x0e\x23\x59\x58\xba\x19\x41\x66\x81\xf5\xd9\x67\xa8\xe4\x46\x5b\x9e\x1e\xf0\x09\xc2\xeb\xa4\xd6\x40\xb5\x4d\x25\x12\x40\xc6\x9d\x77\xb0\x82\x0d\x10\xa3\xf5\x07\x04\x9c\x63\xb7\x83\x53\x21\xd2\x67\x38\xd4\x82\x0b\xfa\x39\x6b\x71\x51\xeb\x62\xa5\x71\x41\x33\x54\xb5\x2d\x06\xb0\xaf\xe4\x06\x2a\x31\x76\xa7\x20\x86\xda\x76\xf8\x70\x2d\x2c\xf3\x0f\x91\x4d\x7d\x8e\x2c\x86\xb8\x85\xf2\xd1\x74\x02\x91\x82\x2b\x32\x70\x46\x7a\xa0\x5d\xf5\xd9\x25\x62\x04\x37\xb3\x43\x37\xa3\x1f\xb4\xb8\x50\xc7\xd5\xc1\x21\x1e\xe4\x80\x65\xc6\xe4\x1b\x7c\x99\x95\x32\x31\x3b\xca\xed\xd6\x1c\xac\x1b\xb4\x55\x98\x08\x29\x59\x59\x08\x3c\xcd\x84\x6b\x6c\x76\x4f\xea\x2b\x7a\x30\x35\x20\xcf\x81\x25\x21\x77\x03\x3d\xe7\x89\x72\x5e\x73\x8c\xd4\x3f\xf1\x6e\x1b\x0c\x5a\xde\x98\x36\x6c\xb7\x6a\x34\x1a\xfa\x5e\xf7\x02\x9d\x7b\x23\x21\x68\x8f\x31\xa7\x9e\xe0\x40\xa5\x82\xf2\x81\x93\x0d\x47\xdd\x5c\x7b\x89\x69\xff\x1d\x40\xdf\x4b\xb3\x5b\x2f\x98\xc6\x6d\x03\x18\x4e\x3c\x5d\xc5\xd4\x03\x72\x68\xe6\x66\xbd\x81\x81\x75\xcb\xc8\x19\x43\x31\x1a\xc9\xd1\xc8\xbb\x5b\x31\xe3\x3a\x02\x1f\xb4\xac\x2b\xac\x5f\xe3\x8f\xa1\x53\x80\x2b\xbc\x67\x31\x78\x6d\xf5\x3d\x6d\x32\x11\xea\x92\xc4\x7b\x1e\x8e\xbd\x00\x8c\x10\xad\x5f\x2b\xb4\xb2\x99\x3a\xdd\x83\x3b\xb5\x03\x90\x47\xa3\x6f\x57\xf9\x94\x3c\xbe\xe7\x11\x0f\x2d\x83\x86\xe3\x47\x0c\x35\x9f\x42\x92\xae\xb9\xa4\xfb\xdd\x5e\x06\xcd\x94\x6a\x96\x49\x75\x32\x1c\x8a\x2b\xbd\xa8\x5e\x47\x2d\x87\x8a\x7a\x15\x11\xf0\x38\x77\x2e\x24\xb0\x36\x60\x0a\xf6\x17\x8e\xa5\xe1\xdb\xb3\x81\x67\xb9\x48\xe2\xdc\x83\x38\xb6\x8e\xb3\x1c\x3e\xd6\x82\xab\x15\x7c\x68\xab\x29\xf8\x2a\xe2\xb2\xbc\x13\x32\x85\x6f\x09\xc6\x3a\xf0\x51\xb2\x58\x26\x98\x51\x31\x2c\x08\x78\xe0\xaf\xa9\xa5\x92\x18\x7d\xc7\xd8\x4d\x34\x1c\xef\xa6\x07\x27\x41\xa7\xab\xa0\x05\x9c\x5e\x79\x5f\xf5\x44\x63\xd2\x71\xf3\xe2\x11\x6f\xef\x09\x23\x8f\x78\x97\x71\xd1\x8e\x68\x2e\x62\xb7\xe3\xf7\xde\x34\x02\x80\xdd\x37\x8d\x3c\xe2\x39\x1e\xa8\xdd\x84\xeb\xe9\xd3\x67\xb2\xdd\xb7\x81\x41\x98\x34\xcb\x3a\x90\xec\xeb\x09\x2a\x55\xd6\xe0\x74\x05\xd1\x8c\x36\x5d\x67\x30\x91\xf5\x1e\x89\xe9\x5e\x8f\xf1\x16\xa6\x9e\x5b\x9b\x2c\x1d\xba\x65\xd2\xbb\xde\x91\x35\x04\xf0\x39\xa7\x47\x00\x63\xfa\xf7\x01\x86\x4b\x73\xae\x3d\x54\xfc\xb8\x61\x53\x56\xdb\xff\x35\x40\xcc\xe4\xc7\x63\x54\x30\x57\xbd\x34\x1c\x99\xa3\x3b\x71\xcb\x4b\xed\x42\x64\x1d\x13\x35\x30\x2a\xe5\x19\x7d\x4f\x0b\xec\xd0\xb1\x24\xac\x2d\x4c\xf0\x1a\x61\x16\x6a\xf6\x4e\x29\xcd\x82\x92\x66\xcd\x33\x05\x09\xcd\x42\xd4\xe3\xbc\x33\x13\x7e\x5a\xd2\xe4\x24\xb1\x77\x2f\xfe\x92\xb1\xbb\xed\x36\x31\x5c\xfc\xaf\x08\xc4\xd8\x60\xe1\x0c\xab\x48\xba\x87\x1a\xb4\x2e\xd8\x54\xc6\xf5\x5a\x41\x7b\x9a\xa9\x84\x69\xe4\x34\xa5\xc5\x89\x7c\xe4\x25\x6e\xbf\x30\xdb\x1c\xc0\x78\x50\xa1\x02\x81\xa4\x54\x41\xad\x15\xa5\x34\xdd\x6b\xf0\x02\xf3\x50\x5a\x9d\x94\x91\xec\x5d\x9e\x2b\xb4\x73\xc0\x4c\xe9\xc1\x4c\x29\x18\x41\xe4\xce\xe9\x6c\x1c\x36\x14\x45\x2a\x1c\xd9\xe9\xda\x58\x8e\x63\x4a\xae\x69\x69\x6d\xef\x54\x2d\xc8\xba\xdd\x72\xba\x42\x04\xe7\x7d\x60\x91\xc2\x49\xaa\xc1\x9a\x26\xcd\x5d\xe8\x70\xe7\x44\x7a\x64\x6e\xa1\xaf\xc8\xbc\x03\x7d\x41\x84\x63\xb8\xde\xcc\x7c\xc7\x74\x7d\x4d\xe6\xa4\x22\x69\x40\xae\xd6\x64\x7e\xbd\x7b\xe4\x89\xb9\x7f\x99\x9d\xff\xeb\x63\x33\xdd\xcd\x23\xf9\xaf\xd1\x7f\xeb\x46\xdf\x5b\xff\x9f\x38\xb6\x44\x07\xfe\x66\xee\x23\xe5\x19\xe3\xca\xa4\xe8\x80\x4d\xd1\x4f\xcb\x98\x32\xab\x6c\xa1\xea\x90\x7e\x6a\xc6\xbc\xce\xa9\xdf\xa3\xd1\x81\x25\x03\x5b\x46\x78\x8e\x57\xef\x83\xa2\x98\x68\x0f\xbd\x3d\x12\x17\x0b\x75\x92\x5d\x68\xf0\x69\x07\x90\x28\xc0\xd1\xc2\x31\xac\x2a\x27\xc7\xd1\x0b\xfc\x9d\xc0\x29\x9a\xce\x6d\x74\x32\xad\xb1\x68\x01\x37\xbd\xc4\xda\xc3\xb5\xdd\xfa\xcc\x9d\xee\x30\x6b\x1c\x81\xec\x84\x35\x93\x24\x6a\x65\x84\x5b\x3a\xf1\x92\xfd\xcf\x9e\x3a\x3c\x4c\xd0\x18\xc3\x3b\xda\x90\x8b\x85\xba\x0f\xff\xe7\x51\x6e\xdd\xd8\x5c\x24\x70\xe6\xf7\x91\x2d\x94\x06\xf5\xb7\x43\xa0\xfe\xe6\x82\xfa\x5b\x0d\xea\x6f\x5d\x50\x97\xa2\xd8\xf5\xdc\xdf\xc8\xfe\xd7\xdd\x23\xd1\xf6\xc3\x31\xdd\xdc\x66\xec\xae\xd5\x1c\x38\xd7\x0d\x21\xd6\x32\x0a\x1d\x32\x62\x7f\x6e\xc4\x7e\xd5\x30\xc8\x7a\x03\xa0\x6a\xa1\xbf\xcd\x20\x4d\x32\x6f\xae\x41\x6a\x2e\xc9\x7b\xb9\x24\x01\x95\x69\x96\xf7\x74\xb1\x51\x1e\x67\xf9\x76\x3b\xee\xeb\xbe\xf8\x91\xee\xeb\x74\x9e\x46\x74\xb3\x37\xbe\xd1\x98\x74\x07\x2a\x1a\x13\xc9\x16\x92\x95\x2b\x1d\x85\x7b\x12\x77\xd5\x0a\x36\x7c\x9f\x50\x28\x0b\xef\x49\x27\xfe\x52\x14\x94\x85\x0f\xbb\xdd\x61\x61\xe0\xd0\x18\x3b\x93\x0e\x47\x15\x3a\xd9\xbd\x14\x07\xd6\xeb\x61\x77\xea\xda\x71\xd9\x4f\xf1\xcd\x5b\x1f\x42\xbb\x45\x30\xf2\x36\x08\xdc\xc3\x21\x3a\xba\xea\xd3\xf9\x0f\xb8\xa1\xa1\xcd\xdb\x5c\x45\x9e\xe6\x20\x1e\x81\xab\xcd\x52\xe4\x91\x67\xb8\x8d\x47\x3e\x31\x15\x47\x9e\x79\xe5\xca\x23\x17\xc0\x78\x22\xcf\xf2\x1f\x6f\x37\x3d\x38\x4a\x07\x65\x21\x78\x14\x10\xac\x45\x6b\xc3\x93\xfd\xc7\x83\x49\xde\x4a\xdf\x7b\x33\x98\xc4\x7b\xe9\xad\x07\x7f\x49\xb6\x97\xde\xff\x32\x30\x29\xf7\x32\x1e\x7a\x06\x18\x9c\x66\xee\x3d\x6d\x1a\x7d\x65\xcb\xb3\xfb\xc2\x39\x94\x57\xac\x54\xe1\x3c\xe3\xa9\x0f\xf6\x2e\x3a\xd9\xf7\xfe\x6f\x1f\x2e\x5e\x6f\xe1\x11\xb5\xe0\xd9\x95\x77\x24\xc2\x9e\x37\x61\x8f\xbc\xeb\xff\xf3\x0f\xb0\xb9\x31\xfa\x0b\xf4\xa3\x19\x27\x09\x2b\x54\x34\x06\xe7\xed\xac\x50\xf0\xdc\x0a\x28\x20\x74\xb8\x2c\x61\x11\x80\x6f\x40\x1f\x32\x81\x71\xfa\xfb\x2a\xcf\x2f\x70\x55\x89\x60\x71\xc8\xc5\x1d\xaa\xb3\x71\x82\x26\x98\x3d\x47\x00\x25\xfe\x7b\xe0\x09\x64\xab\x94\x80\xb3\x15\x70\x2a\x08\xd1\x95\x12\x5f\xf2\xf8\x21\xca\xed\xf3\x19\xf0\xc5\xf2\xfc\x4b\x9c\xc2\x9e\x18\xe6\x16\xcb\xf3\x8b\x22\x4e\x4c\x68\x15\xcb\x0b\xc4\x2b\x59\xc5\x39\x28\x0d\x19\x7e\xa3\xde\x28\x92\xdb\x9c\x24\x19\x42\x4e\x80\x3f\x7e\x78\x67\xbf\x50\x20\x1d\x13\x78\x0e\x2f\xca\xe0\xe7\xa2\x88\x39\x24\x82\xd9\x03\x57\xcd\xd7\x59\x9a\x29\xd4\xd4\x98\x98\x7b\xf5\x89\xf1\xca\xa4\xc3\xc3\x79\x51\x5e\x7f\xc2\x86\x18\x53\x84\x4c\xa1\x91\x89\x14\x65\x79\x2e\x33\x78\x91\x14\x37\x41\xb1\xfe\x61\x97\xb0\x4b\x19\xdb\xfb\xbb\x51\x0e\x5f\xe8\x65\x20\xcd\x24\x64\x31\x1e\xda\x21\x42\xdc\xf1\x5c\xc4\x69\x54\x92\x54\xc6\xcb\xa5\x41\x85\xf1\x04\x04\xe1\x68\x4c\xc0\xf8\xcd\xfc\xbc\xb5\xa3\x01\x71\x67\xad\x1c\xe6\x21\x6d\x9d\xf4\x59\xfc\x25\xce\x33\xc0\x23\xca\x31\xc2\xac\x9f\x63\x82\x4f\xbe\xff\x2c\xc0\xb4\x3b\x1a\x93\x15\x8b\xd1\xe6\x02\xbe\xb2\xe5\x0a\x3a\x45\xbf\x4d\x19\xe5\x64\x95\x2d\x57\x10\x96\x6c\x61\x7e\x3e\xc6\x38\x20\x2b\xb5\xce\xdf\x0b\x68\xc5\x4a\xa9\xe2\xec\xbf\xaa\xec\x36\x1a\x93\x2c\x41\x32\xc9\x52\xf8\x07\x5b\x49\xb8\x02\x81\xdf\x8a\x2d\x65\xa6\x80\x3a\x32\xe8\xb2\x1b\xf6\xf0\x25\x96\xf1\xda\x7c\x9b\x26\xdc\x64\x1c\x4a\xe2\xf3\x82\xf8\x8b\x55\xe5\xba\xbb\x73\x21\x8a\x28\x27\xb9\xb8\x8b\xc6\x64\x1d\xf3\x6c\xc1\x30\x7e\x1d\x4b\x78\x92\xd6\xa2\xae\x83\x7f\xcd\x52\x05\x88\xaf\xe3\x7b\xfd\xff\xa3\xb1\xcf\x21\xff\x6d\xcd\xd2\x0c\x06\x08\x7f\xff\x53\x8a\xaa\xc0\x80\xe9\xb8\x35\x8e\xe1\x3a\xe3\x75\x01\x7c\xcc\xb2\xc8\x19\x52\xd9\xba\x52\x86\xde\xb8\xa6\x2c\x2e\x78\xa2\x7f\x9d\xce\x16\x05\x76\x9e\x28\x54\xb6\xae\xd6\x11\x6c\x6e\xe1\x7d\x5f\x80\xec\x3c\x76\xa9\x43\x0f\xe5\x07\x9e\x67\x9c\x45\x39\x29\x44\x09\x5b\xb5\x31\x29\x24\x43\x62\x80\x2f\xb1\xc8\x90\x14\xf0\xf6\x94\x45\x17\x1e\xc3\x3c\xe7\x39\xcc\x1f\x09\x24\x25\x99\xfc\x22\xf2\x0c\x67\x9f\x64\x39\xfe\xd7\x8f\x63\x62\x0e\x74\x40\x86\x9f\x42\xc3\x12\x77\x65\x94\xc1\x0f\x4e\x87\x98\x94\x31\x4f\xe7\x02\xba\xaa\x4c\x04\x0e\x05\xfe\x42\x91\x12\xd7\x23\x3d\x0b\x4b\x16\xaf\x73\x56\x96\x10\x6d\x9e\xda\xc4\x5e\x29\x57\xb1\x2e\x94\xfd\x83\x45\x19\xfe\xc0\xc0\xc2\x5b\x8e\x10\x2c\x58\x9e\x9f\x82\x8a\x17\xe2\x64\xa2\xff\xbf\x13\xe6\xc3\x10\x54\x29\x13\x3d\xc1\xd1\x29\x52\x14\x93\x52\x31\x68\x6a\xa9\x1e\x10\xe7\xb2\x5a\xaf\x63\x09\x0d\x54\xf1\xfc\x03\xb8\xb6\xc6\x4f\x43\xd1\x2a\x53\x98\x0b\x78\x64\x34\x26\xe0\x1d\x34\x86\xd2\xa8\x4f\x8e\xc6\xe4\xce\xd0\xc3\xdd\x5a\x13\x24\x68\xe4\x81\x0f\xcd\x45\xa5\xcc\x9c\x35\x45\x33\x6e\xe8\xad\x90\x6c\x91\xdd\x47\xff\x0f\x73\x7f\xda\xe6\xb6\x8d\xec\x8d\xc3\xef\xef\x4f\xa1\xe6\x9d\x4b\x21\x46\xb0\x2c\xd9\x89\x17\xca\x88\x6e\xc7\xee\x4c\x7c\x26\x5e\x8e\xbb\x33\x99\x39\xb2\xa6\xc3\x96\x20\x35\x8f\x29\x52\x87\xa4\xdc\xee\x91\xf4\xdd\x9f\xeb\x07\x14\x40\x70\x91\xba\xed\xcc\x9c\xe7\xff\xc2\x6e\x0a\x4b\x61\x2b\x14\x0a\x85\x5a\x06\x36\x68\x3d\x26\x4e\xe6\xe9\x26\x9b\x99\x96\x52\x6c\x8a\x4f\xe9\x2c\xbc\x24\x9a\xf6\x22\x5c\x47\x85\x7a\x57\x32\x09\x90\xf4\xcd\x0a\xfa\x75\x86\x0b\x33\x08\x07\xe4\x26\x03\x1e\x15\x72\x05\x5a\x4c\x9f\x67\x98\xf2\x20\x56\xdf\xb4\x21\xf0\xa9\x88\x19\x3e\xde\xab\x3d\x98\xc9\x7c\x13\x17\x6a\x7a\xe5\x6c\x43\x7b\x6a\x93\x94\xd1\x56\x0f\xc4\xca\xac\xd2\x77\x4f\xff\xbc\x37\xd3\xf4\xde\x73\xa8\xa5\xa7\x3e\x3d\xbb\xc3\xbd\x45\x9a\x79\xce\x2e\xf7\xf0\x79\x4f\x62\xc7\x7b\xad\x01\x38\x91\xf6\xba\x12\xd4\x3d\x0f\xb6\x9f\x6a\x22\xe8\x16\x3f\x6c\x92\x74\x46\x1a\x46\x70\xfa\x65\xd4\x37\x26\x76\x27\xa5\x4d\xa1\x72\xa5\xdc\x2c\x0f\xd3\xd5\xe1\x58\xb6\x5b\xd4\xe1\x71\x80\xe9\x37\x91\x68\x1e\x15\x37\xca\x8f\xa9\xf9\xd1\xbf\x0c\xe7\x4a\x22\x03\x51\x59\x85\x35\xed\xe3\x10\xfc\x64\x84\x96\xe8\x43\xb7\x7b\xac\x05\xb6\x3f\x26\x4c\x3e\xc8\xbd\x3c\x56\xdc\x4b\xe2\x3f\x79\x02\xd6\x7b\x4b\x76\xc4\x2a\xea\x78\x26\x29\xa8\x14\xae\xd5\x73\xc8\x98\xb2\x74\x93\xc7\x37\xef\x5a\xcb\xf0\x4c\x2a\x5a\x83\xcb\x37\x9c\xee\xbd\x0e\xb3\x8f\x9b\x75\x90\xba\x35\xdf\xb7\x15\xf9\xda\x87\x38\x67\x45\xab\x1e\xe8\x0a\x98\x89\x15\xa2\x98\x0c\xa7\xf0\x7f\x5b\xf4\x13\xf9\xb9\x38\x8b\x2e\xa1\x23\x88\xeb\x57\x94\xe5\x85\xea\x7c\x8b\x92\xd1\x06\x5e\x81\x64\x56\x9c\x67\x52\x6a\x79\x19\xe5\x34\xcc\xa0\xea\x7e\xef\x0a\xa6\x82\x5f\x16\x93\xc1\x94\xa3\x69\x78\xbe\x5b\x35\x2a\x93\x09\x54\xab\x4f\x58\xba\xa1\x4c\x86\xd3\x11\x7a\x3f\x98\x72\x32\xa4\xc2\xdd\x81\xd4\x45\xd0\x6d\xf8\x4a\xac\x26\x14\xac\x11\x3d\xa9\x12\x2e\x41\x14\xa3\x51\xe9\xe6\xc9\x99\x0e\x5c\x97\xd0\xc9\x0c\x2e\x9d\x10\x52\x33\x61\x97\x99\x0c\x3f\x8e\x32\xe1\x06\x30\x34\xfd\x50\x2f\xe8\x06\x54\x2a\x2a\x33\x0b\x50\xa9\x03\xa1\xda\xc3\xd4\xb5\xf1\xa1\x80\x5f\x06\x8c\xa4\xbb\x16\xd0\x82\x67\x42\x56\xa0\xa2\x53\xc5\x38\xe9\x76\x57\x7e\xca\xad\x20\x5b\x0b\xf4\xe1\x81\x08\x95\xfc\x04\x26\x49\x41\x32\xf6\xaf\xe0\xad\x98\x71\xdc\xb8\x32\xc4\x9b\x0c\x72\xf2\xd8\x65\xed\x65\x1e\x3f\xa1\xb0\xdf\xdf\xc1\x60\xc6\xd7\x22\x4c\xa3\x8b\xf9\x64\xc8\xb4\xf9\xcc\x93\x81\x12\x2b\xf9\x4f\x1e\x40\x72\xb4\xf6\x9b\xfa\x75\x1a\x4d\x08\x45\x90\xb6\x67\xfc\x52\xcc\x6f\xc5\x76\xc4\x7d\xb9\xad\x4c\x70\x69\x36\xd3\x4b\x19\x47\xab\x08\xa2\x08\xc8\xa1\x67\x26\xc8\x84\xd9\x96\x6e\xb7\xd8\xb6\x66\xd2\xd6\x78\x95\x8f\x04\x5e\xd6\x46\x14\x8b\x28\x22\xab\x61\x15\xe8\xe7\xd5\x9b\xb3\xd3\xf7\xe7\x17\xaf\x9f\xbf\xff\xcb\xaf\xef\xbc\x00\xdb\x21\xea\x13\xa7\xca\x21\x24\x8a\xfa\xe1\xa2\x90\x19\xfa\xc9\xd8\x48\xaf\xb0\xaa\xf9\xfa\xed\x5f\x4f\x2f\x4e\xff\xf6\xea\xec\xfc\xd5\x9b\x3f\x7b\x41\xac\x6a\x2e\x48\xe0\x77\xbc\xea\xd9\x69\xd9\xe2\xc2\x6d\xb1\x52\xea\xfc\xf4\x6f\xe7\x17\x2f\xde\xbe\x39\x3f\x7d\x73\xee\x05\x57\x07\xcb\xbd\x3f\x55\x5d\x79\xf3\xf6\xe5\xa9\x17\x84\x95\x6e\xb0\x7d\x95\x36\x7e\x89\x8f\x52\x25\xf1\xbd\x2c\xa5\x4a\x78\xce\x50\x8f\xfe\x33\x22\x7f\xc0\x7b\xe3\xf9\xcf\x9a\xc5\x69\x1d\x71\x93\xac\x74\xc4\x97\xd0\x0e\x55\xda\xe1\x50\x58\x24\xe7\xd4\xa4\x12\x8a\xa3\x6f\x3c\x87\x62\x98\xfa\xd4\x52\x52\xe8\x8a\xe2\xf9\x01\x56\xf8\xc8\xc1\xb7\xbb\x8b\x32\xf3\xdc\x5f\xee\x9e\x3e\xa1\x0d\x51\x05\xf5\x74\x07\xed\xdd\xb4\x42\x21\x48\x4f\xe0\x72\x5c\x0e\xc2\xa8\xe3\x07\x7a\x80\xf0\x2f\x2a\x93\xb9\x0b\xa6\x49\xc3\x50\x1f\xbd\x15\x05\x22\x98\xaa\x7a\xbc\x68\xfa\x9c\x40\x31\x74\x5d\x14\xc1\xa2\xad\x58\x5e\x3e\x1e\x43\x7c\xa0\xe0\xd8\x47\xa3\xb2\xd8\xac\xbc\xec\x6f\x91\x1b\x48\x6e\x3a\x8f\x98\x0f\xe8\x88\x9a\x35\x8e\xb6\xe8\x2b\x3d\x53\x5a\x8c\x41\xbe\x2f\xb7\xff\x53\xda\xfe\x03\x6c\x7f\xb5\xe3\xf5\x86\x57\xa1\x50\x87\x7c\x25\x86\x43\x7e\x29\xda\x1c\x81\x1a\xe2\xe3\xda\xde\xd7\xb2\x6a\x96\xd8\x6d\x50\x92\xf0\x53\xb4\x0c\x8b\x14\xaf\x87\x75\x25\x4b\x9b\xd7\xdf\xe4\x32\x7b\xbe\x54\x6a\xc3\xf7\x3f\x5c\x9e\xce\x97\xf2\xc3\xfd\x0f\xf3\xfb\xea\x36\xee\xb7\x14\x83\x51\x5f\x93\x4a\x15\xe5\x64\x82\xbf\x13\x42\xac\x76\xbb\x66\xe2\x95\xab\xac\x29\x8a\xea\x0a\x34\xb4\x57\x88\x7b\xa2\x52\x60\xc1\xd6\xe1\x4c\xfe\xfa\xfe\xd5\x6e\xd7\x92\x28\x84\xd8\x68\x9c\x1e\xfb\xc0\x42\x5e\xa7\x9c\xb4\xa1\x98\xf2\x62\xdb\x9e\xc5\x51\x91\xed\xd9\x68\xd6\x38\x9c\xc5\x92\xcf\x2a\x48\x0f\x22\x8a\xb3\x5b\x64\x7c\xd6\x57\x46\x2e\x2f\x80\x25\x22\x36\x3f\x7f\x3e\x7f\xfd\x8b\x08\xcd\x2f\x9c\x20\x22\xe2\x07\xd9\xa6\x76\xd1\x1c\xc6\xa3\xd9\xd2\xe0\xfe\xfd\xeb\xeb\xeb\xfe\xf5\xc3\x7e\x9a\x2d\xef\x0f\x9f\x3e\x7d\x7a\xff\x33\x72\x3d\xbe\x0a\x8b\xab\x83\xa5\x9e\xdc\x7f\x1d\x16\x57\xea\xbf\xd7\xbf\x78\x3c\xff\xb4\x6c\x2b\xf8\x60\x30\x18\xdc\xcf\x3f\x2d\x2b\x52\xa9\xe4\x16\xda\x05\x44\x4f\xb9\xe3\x8e\xcf\x7f\xfc\x14\xc2\xfa\xfb\xff\x98\x74\x3e\x64\x1f\x92\x0f\xc5\x87\xc5\xf4\x3e\x8f\xc4\xfd\x67\xfe\xc9\xbd\x7b\xbb\x38\x4a\x3e\xee\x92\x54\xeb\xb9\xec\x20\x18\xdb\xd1\xb7\xba\x08\xb1\xb2\xd6\x87\xfb\x3f\x4c\xef\xf3\x9c\x36\xcd\x4c\xe4\x15\x7c\x23\xe1\xac\xbb\xf6\x27\x42\xc4\xfd\xfc\xd3\x52\xf9\xc4\x49\x64\x86\xa9\x57\x42\x68\x26\xfb\x36\x41\x14\x8a\x14\x6e\x53\x91\xee\x76\x47\x1f\xec\x79\xea\xd4\xf2\x9e\xe5\x9f\x96\x3f\x78\xbd\xa2\xe7\x3d\xc3\x1c\xfd\xe0\x59\x7d\xb5\x44\xa4\x0e\x8f\x37\x4a\xdc\x1f\xac\x4a\xda\xdc\x3c\xa8\xb7\x80\x96\x3b\x3e\x12\x4d\xd4\xa8\xa3\xdd\x1a\x6d\xdc\x6e\x75\xc0\xef\x2b\xa4\xb7\x89\x2a\x24\x79\xcb\x54\x95\x64\xbb\xdb\x75\x7f\x55\x89\xb8\xa2\xdf\xa1\xde\xf8\x05\xb4\x89\x9e\xa9\xd7\xf2\xc9\x60\x8a\x40\x69\x94\x0c\x85\xff\xb2\x13\x6d\xd1\x8c\x1f\x7d\xff\xe0\xf1\x53\xd6\x33\x62\x70\x97\x0b\x1e\x0d\xc1\xb5\xf5\xe7\xa5\x76\xdc\xb8\xca\xbb\xc1\xa7\x7e\x5f\xeb\xe6\x40\x85\xce\x1f\xf0\x21\xd3\x7a\x9a\x95\x75\xdc\x43\x13\x14\xd4\x61\xff\x65\xdb\xa9\x2c\x60\x08\x7c\x1b\xdd\x7c\x7d\xf6\x7c\xbd\xee\x76\xd5\x1f\x15\xe9\xef\xd7\x24\x0f\x17\xf2\x17\xbc\xf5\xff\x44\x10\xc6\x16\x94\xf5\x66\x7e\xb4\x7c\x89\xc0\xa6\xe5\x8e\xf4\xad\x87\x73\xb6\x0f\xe4\x17\xef\x3c\xda\x77\xd8\x7f\x4f\xc8\x37\x21\x78\xc9\xaa\x6b\x58\x85\x00\x56\xfb\xbf\xb2\x16\xe4\xea\x45\x3d\x20\xc5\x21\xa5\x76\xbb\xd0\xc4\x49\x2c\xcd\x36\xc2\x73\x75\x4b\xd5\xc9\x4a\xf2\x2f\x0a\x5c\x0c\x1c\x57\x9d\xa2\xd8\x8f\xd2\x8a\x26\xc9\x5d\x3d\x7e\xee\x76\x7e\xbd\xcf\xd4\x28\xba\x52\x1e\x1f\x4a\xd1\xdd\x97\x95\x3e\xa8\xdb\xb3\xf2\x16\x81\x50\xeb\x6c\xcf\xd8\x61\x8d\xfe\xda\x1c\xb6\x3d\x2a\x28\x6d\xb4\x44\x64\x6a\xd5\x11\x08\x1f\x3a\x3e\x89\x99\x03\x8a\x09\x06\x8f\x08\x1e\x0f\x05\x42\x2a\x0d\x14\x29\x08\x95\x3b\xdf\xb9\xfc\x3c\x0a\x4b\x5e\x38\xec\xf5\x6c\x30\xce\x02\x0e\xc4\xd4\xee\x78\x0e\xaf\x10\x3a\x34\x67\xe7\xe1\x77\x41\x2a\xbc\xee\xff\x6c\xd2\x62\xe4\x39\xfc\x65\xe7\xe1\x13\x95\x11\xae\xd6\xb5\xf4\xa7\x2a\xfd\xff\x7e\x7e\xf0\xb8\x9a\xf1\x68\xa0\x32\xe2\x1a\x9c\x47\x0f\x54\xf2\xb2\x4c\x36\xe2\x5a\x70\xbf\x51\xb2\x91\x7b\xa8\xc4\x86\xdd\xae\x1f\xf7\xe0\x1f\x6b\x73\x09\x0c\x4b\x96\x7e\xc4\x43\x86\xf7\xd6\xb0\x37\xe4\x71\x4f\x58\x1f\xc4\xaa\xf8\x38\xee\xd5\xcb\x06\x71\xeb\x5b\x89\xb5\xda\xb5\x7c\x07\x58\x95\x3a\x4b\x23\x95\x4a\x67\x80\xc7\x1d\x7a\x5a\xb9\x3f\xf1\xbe\xed\x3e\xfb\x61\x7a\xff\x6b\xde\x48\x94\x9e\xbf\xbe\x7d\x91\x8a\x93\x7a\x40\x7e\xf2\x3d\x06\xa4\xfc\x76\xe6\xd6\x0c\xe0\xd6\xeb\x91\xdb\xa4\xda\x4b\x8e\xb6\x94\xb1\x94\x48\x7d\xef\x7b\xd8\x4a\xbb\x8e\x86\xbf\x57\x86\xce\xea\x18\xb2\x48\x0c\x1e\x07\x0a\xf1\xdf\xbb\x76\xcd\x75\xc6\xcc\xee\xd5\xd0\x2f\x78\xc4\x26\x83\xe9\xe8\x30\xc5\x86\x67\x6a\x4d\x1e\xb3\x4a\x86\x61\x4d\x54\xaf\xab\xf6\x16\xf9\x2d\xd3\xd8\xae\x7f\xbc\x82\x22\x80\xbf\xb1\x2a\x06\xd0\x2f\x9c\x0c\xa7\x55\x8e\xad\x71\x5f\x40\xed\x44\xcc\x46\x33\x33\x31\x39\x5c\xc5\x8d\x6c\x74\x6b\x2c\x50\x06\x6f\x39\x99\xda\x69\x31\x9e\x10\x4b\x2a\x1f\x4f\x86\xd3\x9e\xec\xc5\x93\x07\x53\x7b\xe0\x6e\x44\x8c\x19\xd9\xdc\xbb\x37\x62\x89\x48\x4a\xba\xa5\x67\xc1\xad\xae\xc3\xe1\xcd\xb5\xe1\x0a\x91\x9a\xfc\xc7\x9b\xf3\x70\x89\x75\xf0\x3d\xcd\x7a\x78\x6c\x34\xa7\x1d\x0b\x49\x4e\xa5\xa3\x3c\xf4\xe7\xac\x8c\x2f\xc0\x4a\x3d\xf5\x35\xc5\x52\xc3\xe5\xd3\x4f\xf4\xd5\x06\xab\x93\xb3\x91\xd3\xa7\x11\x4b\xaa\x87\x5b\x99\x65\x27\x72\x6d\x8d\xc5\x2c\x9e\x3e\x22\x3c\x7d\x0c\xcf\xad\x1a\x4f\x67\xc2\x45\xbb\xa3\x3c\x82\xbe\x8f\x6c\xc4\xfd\x7f\x7c\xc8\xff\xf4\xcc\xff\x70\xdd\x63\xee\x2e\xca\xbe\x6a\xf9\x89\xa4\x35\x44\x49\x12\x5c\x02\xf1\xf3\xf6\xf8\x94\xf0\x45\x48\x60\xca\x43\x55\x8e\x43\x2c\xbe\xc5\x7b\x22\x02\x36\xbf\xa8\xe6\x0f\xc0\x79\xec\x76\xc5\xbd\x21\x74\x2e\xad\x0f\x01\x55\xa6\xcd\xdf\xae\x36\x0b\x91\xb5\x56\xea\xe1\xe8\x94\x35\x03\xcd\xfc\xd1\x68\x78\x8e\x72\xbf\x59\xf3\x44\x18\xf1\x19\xa7\x48\x4a\xea\xae\x8d\x77\x62\x01\xa3\x6c\xb3\xa2\x49\x65\x1b\x18\x4a\x78\x72\x02\x0d\xf6\xf2\xee\x63\xba\xbd\xdb\xb5\x0d\x86\x75\xbb\x9e\x9e\x73\x1c\x9c\x52\xa9\xac\xe6\x70\x01\x5d\xc8\xec\x53\x18\xab\x44\xd6\xed\x36\x26\xb1\x3c\x2c\xbb\xdd\xb6\xa5\xc2\xe8\xa4\x54\xd5\xc1\x29\x17\x72\xa5\xbe\x59\x6b\x44\xb1\x4e\xd6\x8c\x0b\xa2\x22\x6e\xa8\x99\xf2\x59\x00\x14\x09\x26\x72\x6a\x63\x26\x0e\x87\xec\x2b\x05\xac\xb6\xcd\xd0\xac\x74\xac\x56\x7a\x5d\x5b\x40\x35\x0c\x5f\x0a\xef\x4f\x1e\xe3\x51\x6b\xa6\xf7\x27\x70\xad\x72\x1c\x3a\xb4\xc0\x7b\x86\xbb\x47\xe7\xfe\x0f\x5e\x50\x4d\xf6\x7a\xb2\xe7\xfd\xf0\xec\xbe\xfe\xeb\xf1\x08\xf1\x21\x4f\x42\x87\x4d\x62\x2a\x6d\xbc\x9e\xc8\x69\x50\x89\x53\x61\xee\x3c\xd8\x9f\xa1\xc8\xbe\x6c\x7f\x46\x62\xbb\xe7\xb9\x98\x0c\xf9\xb7\xcf\xf4\xf3\x46\xc7\xbc\xc5\x09\xaf\xc8\x36\xd2\xfb\xe1\x5b\x8e\xeb\x86\xca\xfb\xc1\x9b\xf2\x19\x0a\x7b\xcf\xd4\x43\xee\x0f\x1e\xf2\xe8\x73\xca\x37\x62\xf2\xd0\x66\x3d\x2b\x2e\xd3\xf9\xcd\x0f\xcf\x8a\x8c\x4a\x65\x3f\x3c\xbb\x4f\x69\x65\x95\x39\x35\xfd\x69\xd9\xf9\xbc\x8a\x93\x5c\x1c\xbb\x13\x52\x57\x70\xf3\x99\xc2\xfd\xbc\xf7\x27\x2f\x40\x67\xc6\xcf\xe6\xd1\x27\xdd\x8a\xfa\x98\x72\xc4\x25\x57\x59\xcf\x56\xe1\x5a\xe7\xa8\x8f\x29\xde\x85\x82\xc9\x83\x46\x2f\x4d\xcf\x66\x69\xbc\xcc\xd2\x0d\xd5\xb1\xbf\x9c\x41\xc6\x72\x29\x93\xb9\x06\xbe\x88\x64\x3c\xcf\x65\xa1\x4b\x97\xbf\xa6\x7c\x8d\x87\x57\x5d\x48\x6f\x35\x5d\xc4\x7c\x4f\x79\x91\xb5\x74\x43\x95\x69\xcc\x51\xba\x2e\x54\x9f\x82\x9c\xa7\x6b\x60\x71\x90\xab\x17\x7d\x7c\xcd\xb8\xe9\x63\x30\xe3\xaa\x26\xfe\x2e\xd2\x14\x92\xd3\x02\xaf\xce\xf8\x3b\x0f\x36\xbc\xb8\x0a\x36\x7b\xbe\x10\x13\x6f\x16\x65\xb3\x18\x4f\x28\xb3\x38\x5a\xbf\x0b\x8b\x2b\x8f\x7b\x73\xb9\xc8\x3d\xee\xc9\x38\x8e\xd6\x39\xf2\x96\xf0\xf8\xbd\x0a\xe1\x94\xd3\xc3\xd3\x29\xfd\x09\xb3\x3f\xe3\x91\x54\xbb\x09\x5f\x85\x39\x5c\x0c\xae\x35\x08\x7a\x7f\x45\x42\x1a\xdf\x2c\x53\xf3\x45\xb5\x51\x2d\x8c\x9d\xda\x78\xb3\xf3\xc0\x77\xa4\xca\xf3\x20\x3c\xeb\x73\xaf\xc0\x7b\xa6\x37\x1d\x2d\xec\x71\x57\x6e\x5d\xb6\x05\xf2\x8b\x39\x6d\x8e\xc1\xfe\x88\x7e\xd1\x41\xae\xcc\xbc\xfd\x40\x04\x1e\x0b\x97\xef\x6a\x7f\xe2\xa9\xc9\x9b\xb1\xe9\x12\xd1\xae\x71\x29\xd9\x28\xed\x57\xa5\xd5\x7e\xa2\xf8\x9e\xaf\x26\x46\xd1\xc2\x3d\xfd\xea\x56\xf4\xda\x80\xb9\x8c\xfc\x69\xfb\x57\x94\x7e\xd1\x90\x6e\x2e\x0e\x5e\xe7\xfc\x2a\xca\x3b\x2f\xdf\xbe\xee\x80\x3c\x77\xae\xc3\xbc\x63\x1c\xad\x75\x2e\x6f\x3a\xbf\x7b\xbd\xa4\xe7\xfd\xde\xf7\x8c\x69\xa1\xe7\x55\xce\x10\x70\x9e\x45\xb7\xeb\xff\xe7\x44\xf6\x2f\x8a\x70\x39\x25\xd1\xd8\x09\x0c\x2a\x68\xda\x74\x6f\x90\xe2\x4c\xed\x19\x8e\x0c\x22\x76\xe3\x4b\xdf\x1b\x3e\x7c\xec\xc1\xfb\x41\x11\x2e\x5b\xa2\x93\xeb\x61\x8d\xbd\x8e\x7a\xcf\xee\x14\x57\x92\x7a\xd9\x59\xa9\x97\xce\x4e\xba\xe8\x78\xbd\x43\xf5\xca\xa1\xf7\xbc\xbe\x17\x78\x96\xc5\x65\xfc\x96\x9e\xb5\x8c\x06\x9d\x7d\xe4\xc4\xad\x6a\x9c\x9b\x47\x80\xfd\x15\x0c\xc3\xc1\x7c\x73\xbc\xa0\x81\xa1\xc7\xca\xce\x29\x11\x54\x29\x9d\x2c\xf9\x12\x9d\xa3\x7a\xf4\xc0\xc3\x2b\x83\x71\xe6\xd6\xe6\xaf\x30\x25\x37\x3e\xa9\xeb\xc6\xe7\x15\x3d\xb8\xe1\xbd\xe9\xe2\x2a\xcd\xd5\xa5\x38\x8c\x12\x98\x0a\x2e\x52\xf0\xc0\xfd\x0b\x20\x06\x3c\x3f\x5c\xd4\x25\xa6\x3f\xf2\x48\xc4\x63\xca\x08\xb2\xfe\x45\xe5\xe5\x76\xf4\x3f\xea\x96\xc0\xd3\xe3\x3e\x1d\x42\xbe\x45\x7f\x02\xd9\x88\x3c\x1c\x14\x3c\x26\x1b\x98\x20\xd9\xbb\x32\xff\xb0\xe2\x0f\x67\x74\xda\x77\x42\xfa\x6b\xf9\x29\x5e\x0a\xea\xf0\xb8\xec\x1b\x78\xce\xf4\x44\x55\x58\xef\xfb\x50\x07\x51\xde\x27\xc8\xbe\xd3\x77\x85\xfe\x79\xb5\xf4\x9b\xe3\xa5\x67\xd5\xd2\x67\xc7\x4b\x6f\x7c\xb6\xfd\x95\x2c\xb4\x00\xdd\xc9\x9a\x57\x01\x49\x1d\xcf\xe9\x4d\x3a\x97\xaf\x5e\xba\x68\xf3\x90\x6c\xe2\x0b\xf1\x33\x14\x08\xcd\x15\xdf\x2d\xf2\x9d\x8a\x32\x83\xbd\xaa\xaf\xfa\x5e\xa4\xd4\x92\xbc\x40\xfd\x20\x14\x0b\xea\x06\x91\x66\xe2\x72\x31\x79\x87\x2e\xae\xb5\x3b\xfe\x39\xb9\x6d\x2e\xd2\xf5\x2f\x69\x38\xc7\x89\xa0\xfe\x14\x6c\xea\x5c\xf6\xbd\x4f\xd1\x5c\xa6\xd4\x42\xb8\x81\x95\xe7\xb1\x06\xca\x2b\x95\x32\x6a\xfa\x0b\xfb\x4b\x9d\x99\x4a\x58\xb7\x7b\x10\x80\x7e\xc4\x69\xe9\x65\xc2\xff\x02\x63\xdc\xa2\xfa\xfc\xa6\x35\x4b\xbe\x66\xc4\xca\xe3\x15\x4e\x48\xfd\xb7\x36\xe6\x68\xb5\xfc\x97\x00\xe5\x5f\x31\xdf\xd0\x3a\xfb\x9a\xc6\xdf\xc3\xbf\x90\x3a\x87\xd5\xdf\xc3\x8d\x9f\x6d\x2e\x57\x11\x0e\xe7\x9c\x3e\xea\x83\x87\x2e\x07\x2d\x38\xb9\x85\xd7\x3f\xac\x41\xd3\x57\xf4\xee\x55\xa2\xd4\x45\xc0\x85\x98\xaf\x82\x4d\x1d\xb2\xb0\xf6\xd9\xf6\x5c\xed\x31\xfd\x32\x6c\x36\x59\x6d\x33\xa9\x03\xf4\x1b\x75\x29\xf3\xff\x83\x2b\xc6\xfc\xbf\xb4\x64\x59\x5a\xeb\x6f\xec\x94\xef\x11\xd9\x9d\xff\x87\xe6\x2c\x9c\xfa\x57\x15\x41\xa0\xb4\x01\xff\xbd\x7b\x1e\xfb\x41\x0c\xca\xe3\x2e\xca\xcb\x4a\x2b\xf7\xd4\x06\xfd\x1e\xe1\x70\x6e\xf5\x91\xe4\x04\xd7\x59\xd6\xbd\x7c\x53\x8e\xfb\xbe\xe0\xba\x95\xb2\x3e\x52\xe9\xb0\x72\xf3\x10\x38\x24\x4a\x37\xf9\x19\xce\x8c\x83\x19\x2f\xd2\xf5\x8d\x9b\x79\x45\xae\x8c\xea\x69\x3a\x38\xa7\x9b\x5a\x52\x24\x41\x2e\x12\x2e\xe6\xe9\xca\xf9\xd5\x38\x5e\xdc\xda\x2e\x2e\xb8\xe9\x45\xd5\x19\xac\x9b\xb5\x88\xc3\x65\x2e\xb4\x0f\xd0\x4b\xf2\x01\xba\xd4\x3e\x40\x2f\x44\xe2\x3f\x1d\x30\xfe\x09\x7f\x1f\x30\x7e\x43\x2a\x0f\x9f\xe9\x21\xe8\x9a\x14\x96\xe1\xcd\x7b\x38\x18\x30\x7e\x4a\x6e\xbe\x5f\xe0\xef\x77\x8c\xbf\x53\x19\x0f\xb4\x3f\xef\x87\x4f\xb4\x3f\x6f\x30\x88\xf0\xe7\x3d\x1c\x7c\xaf\x1d\x7a\x0f\x07\xca\xa3\x37\x3e\x9e\x2a\x37\xf5\xfe\x70\x38\x60\xfc\x97\xd2\xad\xd5\x70\x08\x5d\x8a\x57\xc8\x79\x38\x60\xfc\xbf\x91\xf3\x04\x19\x4f\x1e\x32\xc6\x7f\x35\xc2\x3e\xed\x23\x1c\xdf\x4f\xf1\xe7\xd1\xf7\x8c\xf1\xe7\x2a\xf3\xa1\xf1\x8e\xf5\x9a\xf1\x97\xe2\x94\x1e\x20\xcc\x61\xc7\x7f\x16\x6f\xdb\x18\x4f\xfe\x3f\xe2\x1d\xed\xa8\xf3\x94\xff\x5d\xbc\x38\x14\x94\x9c\xff\x24\xb6\x5a\xcc\x57\x31\x0b\xdc\xf3\xdf\x84\xa7\x98\x0b\x8f\xff\x55\x78\x17\x17\xfa\x35\xef\x6f\x62\x6b\x5f\x9b\xd5\x42\x1c\xe0\x64\xc8\x9c\x65\xb3\x5e\x67\x60\x9f\xab\xaa\xbe\xbf\x85\x59\x82\xe7\x68\x94\xd9\xf3\x1f\xf1\xd2\xfc\x17\xb1\x45\xd0\xdc\x4b\x44\x82\xf4\x42\xfc\xf1\x38\x1c\x96\x87\x89\xd2\x56\xf6\x66\x61\xb2\x8e\xc3\x1b\x37\xd1\x78\xe7\x36\x79\x85\xfe\xad\x8a\xbc\xdc\xe8\x43\x5f\x5b\x04\x06\xde\x9c\x7e\x9b\x90\x02\x45\xba\x3e\x5d\xad\x8b\x48\xce\x03\x4f\xea\x0f\x9d\x98\xcc\xb2\x9b\x35\xb4\x2b\x3d\x69\x3e\x29\x63\xae\x13\xe7\x26\x01\x24\x3f\xb0\x44\x5a\x9f\x7e\x72\x8e\x77\xa1\x40\x1d\x81\x72\x3e\x0f\x8b\xd0\xc9\x82\xc6\xfd\xdc\xc9\x5e\xd1\x6f\x5b\x44\x05\x23\xd6\xb9\x4a\x15\x53\x65\xbc\x0b\x37\xb9\x0c\xbc\x35\xfe\xe8\x04\x35\x1f\x76\x32\x30\x13\x98\x4b\x95\x02\x4f\x45\xaa\x4c\x96\x2e\x31\xef\x81\xb7\xa6\x2f\x55\xf6\x7d\x58\x48\x33\x23\xf0\x85\xe9\xcc\xc6\x99\x94\xd0\xe7\xf6\x72\xf5\xd7\x26\x29\xc8\x48\x33\x90\xcf\x8a\x30\x86\xe2\xb4\x97\xeb\x0f\x5d\x72\x93\xe3\x29\x31\xf0\x72\xfd\xa1\x12\xa1\x82\x4d\x1e\x2a\x3d\x98\xa6\x6e\xd4\xb7\xca\xfa\x6b\x1a\x6f\x56\xb6\x27\x9f\xd4\x2f\xa7\x2f\xbf\x85\x51\xa1\x1a\xbe\xd6\x1f\xde\x9e\xff\x59\x6c\x71\x66\x00\x43\x2f\xc3\x5c\xaa\xbf\xca\x00\x16\x37\x77\x65\xdf\x7a\x29\xe7\xf8\x7d\xa5\x52\xa3\xd5\x52\xfd\xc1\x19\x84\x8f\x8f\xf2\x66\x29\x13\x7c\x41\xd2\x82\xbf\x98\x7c\xfc\xd5\x57\x72\x18\xbc\x2a\x26\x00\x49\x8a\xfd\xc2\xc7\xf5\x25\x80\xed\xf9\x3f\xc5\x16\x1b\x89\x76\xc8\x3a\x93\xc6\x1e\x96\xba\xb4\xe7\xff\x29\x96\xfe\x76\x25\x93\x0d\xb4\x45\x55\xca\x9f\x19\xff\x2f\x71\xff\x1f\x93\xf0\xde\x3f\x9f\xdf\xfb\xaf\x29\xfd\x0d\x2e\x3e\xf4\x3f\xdc\xfb\x30\x9f\xfe\xe9\x9b\xfb\xfc\x3f\x20\x65\xf9\x46\x6c\xf7\x35\xde\x86\x4b\x29\x86\xa3\x95\x8a\xb7\x16\x87\x4a\xb9\x93\x9c\x5c\xbf\x7c\xfb\xda\xba\x49\x82\x49\xde\xeb\xe8\x73\x94\x88\x5b\x9c\xe6\x99\xf0\x6d\x2e\x75\x96\xb2\xd7\x23\x12\xaa\x09\x74\xd2\xbf\x88\xe6\x2f\x00\x47\x66\xbd\x5e\x93\xc8\x53\x64\xb7\xe6\xfd\x80\x02\xbc\xc5\xa2\xed\x18\xc3\xbd\x77\x9d\x5b\x1e\xd4\x9c\x68\x6c\xeb\xb2\x81\x0e\xbb\xd2\x64\x46\x15\x0b\xa5\x92\xb1\x70\x35\x0e\xb5\xc2\xbc\xb9\x5c\x66\xcb\x79\xb2\xb5\xcc\x05\x51\x1d\x79\xfc\x4e\x32\x57\x03\x66\x2d\x1c\xcd\xfb\xfe\xca\x65\xdf\x51\x8c\xc7\xe0\xd2\x62\xf1\xde\x78\xfe\x83\x44\x97\x5c\x20\xc6\xec\xb6\xa6\x36\xba\xa9\xaf\xe8\x91\x16\xfc\x78\xc1\xd9\xe1\x2e\x9d\xb5\x76\xc9\x05\x62\x98\xb3\xf3\xc3\x40\xce\xbf\x6a\x5c\x2d\x1d\x2e\x79\xbf\x37\x87\x5b\x7b\xf3\xbf\x30\x8b\x7b\xd3\x24\x45\x9a\xe5\x6b\x72\xc3\x56\x8c\xfd\x50\x14\x55\xf6\x8a\xaf\x91\x82\xeb\x52\x90\xa8\xbf\x2a\xf8\x42\xd2\x28\xa4\x33\x19\x27\x37\xec\xe1\x6e\x17\x0a\x21\x3e\x43\xf3\x03\x8f\x0e\x69\x26\xa3\x65\x42\xc8\x2b\x84\x58\x43\x3b\x37\x14\x9f\xb5\x56\x10\xd7\x65\xf1\x0d\x51\x3c\x24\x9b\x78\x64\x30\x7b\x66\x8c\x82\x4a\x3d\x06\xfa\x34\x95\x2c\x82\x82\xf4\x55\xcc\x5a\xf9\xc3\x50\x8d\x72\x01\xb1\x8f\x84\x4a\xd5\x0b\x57\xf4\xab\x99\xd2\x2b\x78\x47\xaf\xdf\xdf\xa3\x85\x5f\x76\x0b\x91\x19\xcd\xbb\x94\xdb\xbe\xae\x7f\x29\x56\xad\x12\x65\xbe\x6c\x27\x0d\x8a\x01\xbe\xac\x89\xbb\x97\x24\xee\x5e\x2a\x71\xf7\x95\xb8\xac\xbc\x50\x5d\xba\x82\x6f\xfd\xa4\x76\x25\xe2\x7e\x94\x8f\xeb\x4d\x1f\x6c\x91\xa3\x38\x0b\xee\x5c\x9e\xd4\x13\xaf\xea\x83\x7b\x73\x86\x80\x54\x87\x6b\xbd\xed\xaf\x33\x39\x0b\x67\x57\x4a\xe9\x55\xe3\xda\x95\x59\x1a\xc5\xb5\xee\xc4\x73\xd0\xfd\x17\x28\xa3\xf5\x0b\x51\x32\x6f\x90\xde\xdd\xee\x63\x45\xd7\xfd\xa7\x34\x7b\x9f\xa6\x85\x6f\xa1\xe9\x73\xb5\xb4\x06\x88\x20\x60\x04\xf3\x15\x73\x32\x20\xfc\x24\x6e\xfc\x2b\x36\xa2\xee\xaa\x61\xbc\xd2\xee\xb2\x8c\x58\xd3\x97\x3c\xe6\x29\xc2\x1c\x2c\xc4\xa7\xbd\x35\xa3\xbf\x36\x4b\xa7\xea\xbc\x5d\xcb\xe4\x3c\x5c\xea\xa7\xe7\xe7\xc9\xfc\x5d\x29\x71\x81\x5a\x74\x0c\x16\xda\x2d\x4f\x4c\x9f\x2e\x8f\x02\x3c\x65\xa3\x85\x38\x39\xed\x76\xff\x3c\xb1\xd8\x33\x1d\x5f\xf7\x3c\x3c\x7d\x5c\x63\xcd\x7b\xa7\x50\x63\xf2\x7a\x07\xa7\x16\x85\xf6\x07\x0e\x14\x22\xd0\xb7\x50\x80\x88\x08\x45\xdc\x87\x61\x88\x0a\xff\xd4\xed\xde\x52\xe7\xa2\xbf\x40\x39\xf7\xfc\x3d\x46\xdc\x6e\x81\x96\xff\xbb\x7a\x60\x48\xf9\xbf\x1c\xb0\xb6\x09\xff\x37\x00\x36\x27\xd8\x2d\xb0\x66\x86\x6a\xeb\x0b\xf7\x62\xcf\xef\x80\x93\xad\x42\x7a\xef\xd9\x11\xe4\xb2\x52\x27\x08\x48\x3b\x05\x08\x5e\x51\x17\x3c\xa5\x56\x5c\x5a\xe0\x59\xd5\x7a\xfa\x54\x81\x6b\xff\xde\x52\x1a\x0e\x43\x15\xaa\x72\xa8\xe2\x4b\x66\xb4\xfc\x84\xf8\x0d\x0e\x6d\xf0\x4f\x1c\xba\x85\x2f\x11\x31\x91\x64\xbc\x0c\x4f\x14\x9f\x88\x0c\xe9\x01\xff\x94\x66\xea\xc6\x9e\xfb\xda\xe5\x1e\x45\x36\x0a\x5d\x07\xaa\x76\x93\x74\xbb\xce\x8e\xe1\x05\x1b\xff\xad\xd9\xd9\xdd\xce\x0f\xc5\xc7\x7a\x1b\x35\xd3\x58\x98\x14\x30\x16\xb4\x14\x2c\x21\xf1\x8c\xf1\x10\xa2\xf3\x9e\xf0\x3a\x5e\x2f\x64\xd6\x2f\x21\x84\xb2\x90\x50\x9c\xa7\x10\x49\x45\x33\x5d\x79\x9c\x04\x7e\x93\xf2\x99\xea\x8d\x86\x14\x05\x84\x78\xfc\x40\xfe\xab\x97\x04\x4d\x71\xb7\x8c\xed\x79\x1b\x4d\xaa\x71\xca\xc6\x0a\xc3\xf3\x10\xb7\xfe\x90\x88\xde\x5d\x71\xfa\xdb\xd7\x97\x63\xe5\xf8\xc8\xfc\xa0\x65\x06\xc4\x58\xfc\x34\xb1\xe2\x7a\x73\x79\x9e\x8e\xcb\x6f\x72\xf6\x40\x91\x89\xe3\x31\xfe\x06\x65\x76\xd9\x62\xcc\x52\xf1\xdf\xbe\xd1\x99\xb7\xc9\xd6\x25\xb6\x1a\xb3\xe2\xab\x2c\x61\x0f\x95\xcf\xdf\x51\x2a\xa2\xfe\x7f\xa7\x51\xe2\x7b\x5e\xb9\x14\xff\x74\xa8\x70\xb7\xeb\x7d\xc0\x1b\xbf\x48\x95\x32\xd8\xf3\xc2\x1f\xb0\x31\x92\x7a\x69\x90\xee\x79\xfb\xb1\x11\x1c\x0c\xb3\xfe\x35\xb3\x77\x53\xea\x25\x03\x81\xfe\xbd\x13\xe9\xc1\xd7\x4c\xd9\x28\xd4\x9f\x55\x6c\xce\xc6\xd4\x1a\x92\x70\x64\x76\xb9\x8e\xfd\x63\x83\xb5\x22\xf6\x0f\xc1\x25\x9b\x1f\x1e\x4d\xf2\x29\xdb\xdf\xea\xb2\xdb\xa0\x60\x1b\x85\x1a\xb5\x25\x1a\x41\xa1\x66\x02\x2c\x58\xbf\xe0\xb0\xf6\x41\x2c\xfc\x5a\xce\x81\x25\x8b\x05\x5d\xd6\x78\xf8\x15\x37\x39\x3a\x78\x0f\x5e\x80\xc2\xd6\x8c\xb0\xf5\x24\x38\x74\x65\xe1\xa1\x38\xbb\x15\x88\x3d\x00\x0f\x5d\x59\x42\x71\x7e\x2b\x90\xf2\x1c\x3f\x78\x17\x09\x5b\x33\x42\x66\x38\x13\xba\x5d\x84\xc7\x78\x34\x78\x87\x95\x8d\x02\x16\xb5\x54\x36\x8c\xd1\x0e\x4c\xf6\x7b\x5a\x73\xf7\x06\xc5\xf8\xaf\xfd\x36\x47\x81\xfe\x11\x5e\xe5\x4d\x1b\x9c\xb6\x39\xbd\xe5\x88\x5e\xd3\x11\xbd\xe7\x6d\xa3\x6d\xc7\x73\x9e\xf1\x50\x9d\xb9\xea\xbc\x95\x38\x41\x4f\x5a\x0e\x5c\xb0\x67\x2d\x89\x64\x1a\x34\x49\xa7\xa8\x08\x8b\xbb\xdf\x2a\x84\xb0\x79\x9e\xaa\xb6\x32\xb4\x15\xb1\x86\x7a\x4e\xa6\xaf\x63\xe1\x6e\xb7\xdd\xf3\x70\x92\x4d\x85\xe7\xb1\xd1\x01\x48\xa4\xde\x0d\x5a\xd1\x72\xe6\x8f\xa1\x71\xd5\xed\xbe\xa4\x63\x9f\x05\xee\xc9\x2b\x0f\x9c\xbc\x1f\x49\xb0\xab\x14\x98\x7f\x4a\xb3\xf2\xbc\xfd\x99\x16\x37\x65\x81\x7f\x6d\x22\x9c\x46\x32\x9f\xa4\xd3\xdd\x0e\xc1\xb8\x1a\x07\x34\x63\xdd\x6e\x1d\x9e\x6d\xac\x04\x57\xce\x3d\xf1\x47\xb9\x62\x6a\xf8\x4c\xa8\xd9\x1c\x1f\x18\xbc\x31\xca\x52\xc3\xa4\xd7\xe6\x51\x3b\xaf\xd4\xed\xe6\x27\x42\xcc\xec\x23\x7a\x6e\x9e\x46\x66\xac\x5c\xb4\x68\xe1\xe7\xe3\x5c\x1c\x68\x0d\xa2\xb5\x3d\xcf\x59\x70\x28\x1f\x00\xf9\x4c\x5b\xfd\xa9\xc5\x9d\xb1\x93\x59\xbd\x2b\x19\xdb\xed\xf2\x6e\x37\x6f\x4b\x6f\xae\xba\x05\x95\xb3\x96\x1a\xdd\xee\x6c\x92\x4d\x4f\x84\xc8\x27\xd9\xb4\x8e\x34\x48\xa3\xcb\x69\x28\x72\x7b\x9c\xb4\x71\x86\xf9\xd8\x32\x86\x39\x8c\x64\x67\x0e\xca\xd8\x8a\x2e\xea\x14\x8c\x1d\x40\x1d\xe3\x86\xb3\x1d\x6f\x78\x5e\xb2\x0c\x77\x45\x20\xb2\xf8\x20\x18\xc4\x4c\xe6\xe3\x4a\x53\xb6\x13\x1b\xd5\x46\x70\x10\xe5\x36\x1c\x66\xaf\x61\xb7\xfb\xc9\xad\x4e\xbc\xab\xe9\x66\x48\x14\x84\x37\xa9\xe1\x81\x53\x2b\x2b\x19\x82\xd2\x82\x6f\xea\x58\xf3\x29\x4c\xe5\x77\xe6\x1b\xe4\x21\xb6\xa5\xdb\x3d\x98\x45\x6c\x0a\x1c\x3c\x1f\xae\x5d\xdc\x56\x3b\x27\x9e\x25\xd3\x3c\x4b\x39\x02\x3e\x3b\xc4\xcd\x90\x8d\xc9\x89\x20\x5d\x9f\x13\x11\xc2\xa0\x4f\x7d\xc5\x26\x29\x32\x0b\xa7\xa3\x6e\x08\x31\x1b\xbb\xfc\x02\x81\x52\x9b\x53\x89\x8a\x83\x4d\xb7\x7b\x32\xa7\xc0\x38\xba\xd0\x79\x69\x27\x01\xee\x91\x54\x52\xe2\x71\xa6\x19\xa8\x83\x25\x7b\xd6\x7a\x33\x1a\x87\x27\x42\x44\x95\xb2\x24\x15\xf0\xbc\x5e\x64\x8a\xcd\x2a\x05\xec\x41\x88\x08\xa6\x29\x3b\x10\x75\xc5\xbe\xc1\x12\x12\x1d\x0d\xb6\x52\x1a\x3c\xd4\x8e\xd4\x7f\x9f\x24\xda\x71\x1b\x7e\xe0\xad\x5b\x11\x4e\x76\xc4\x62\x19\xa6\xca\x24\x06\xf3\x2b\x07\x33\x31\x02\xb5\xf3\xfc\xd7\xaa\x63\xdd\xc6\x71\x0e\x74\xa3\x4a\x50\x04\xa4\x4f\xe8\x08\x7a\xea\xc9\xfb\x91\xe7\x70\x1c\x7b\xf5\x69\xa6\xd4\xac\x88\x44\xe0\xb5\x4d\x52\x15\x6f\x31\x6e\x5e\x26\x9f\xc7\x71\x29\x17\xd2\x59\x77\x7c\x1e\xae\x48\xec\x81\x14\x6a\xd5\xab\x11\x1b\x8e\xac\xfd\x9e\x2f\xfd\x55\xa9\x33\x6d\x1e\x48\xf8\x2f\xfa\xaf\xab\x18\xb8\xba\xa3\x62\x20\xde\x7b\xa1\x18\xf8\x54\x85\x2a\xda\x36\x24\x1a\x95\xde\xf8\x69\xdb\x43\xac\xee\xdc\x51\x7d\xbf\x43\xda\x7e\x98\xea\x2d\x94\xc2\xa5\x96\x3f\xf9\x15\xb7\xee\x5f\x6e\x90\xf5\xf4\x21\x73\x0c\x22\xed\x0b\xf5\xd3\xef\xf0\xc0\xfc\xf4\x11\x03\x7f\x9c\xf8\x4f\x1f\x6b\x15\xff\xa7\x4f\xc9\x16\x05\xbf\x1d\x13\x47\x3b\xef\x70\x55\x0d\x9b\x26\x84\x37\xe0\x1b\xe1\xcd\xf2\xfc\xa7\x38\x0d\x0b\xaf\xd5\x7a\x70\x7e\xdc\x7a\x50\x0b\x39\x94\x37\xfc\x79\x7f\x91\x26\x30\x77\x2a\x47\x3b\x53\x91\x3e\x15\xa7\x21\x44\x09\xc8\x7c\x98\x5b\x8a\x82\xd1\x37\xfd\xe8\x76\xfd\x0d\xbd\x61\xeb\x7e\x69\xff\x7a\x6b\xb1\xad\x89\x0b\xf4\x41\xd4\x2e\x2f\x2a\xcd\x29\x4b\x26\xb5\x85\x1d\x35\x07\x12\x2c\x03\x52\x47\x1f\xe4\x9e\x87\x49\x05\xa3\x44\x94\x98\xa4\x22\xb9\x9f\xb2\x9e\x17\x78\x10\x61\xc4\x7e\xca\x43\x5e\xf0\x8c\xf5\xbc\x91\x67\xa5\x5c\x89\x26\xe2\x7b\xde\x38\x32\x5b\xb9\x69\xe8\xef\xa9\xb1\xda\x0e\x87\x87\xa5\x58\x21\x1d\xf0\x91\xea\x70\x58\xeb\x70\x2e\x62\x88\xb7\x27\xe1\x54\x7b\xee\x84\xe0\x7f\xa1\x66\x10\x06\x56\xdd\x6e\xb9\xd2\xf8\xad\x84\x46\x1b\xc6\x23\x96\xe1\x74\x2f\xdb\x70\xd9\x8e\x9c\x65\x93\x70\x4a\x0c\x11\xe1\xc3\xac\xdb\x4d\x11\x17\x28\x2b\xae\xc2\x64\x6e\xea\x9d\x7e\x5e\x87\x49\x1e\xa5\x49\x3e\x09\xa7\xc0\xa4\xb9\xa5\x8e\x6b\x0c\x68\xce\xb2\xc9\x1a\x6c\x9a\x02\xd5\x51\x60\x3d\xaf\xe6\xd8\x60\x7d\xe7\x0d\xe6\xea\xf1\xf4\x8a\x52\xf8\xd1\x2f\xd2\x5f\x41\xae\xb5\xd2\x4d\xc5\xa4\x6c\xc8\x8c\x1f\xe6\x30\x89\x56\x4a\xaf\xe0\x55\x21\x49\xe1\x00\x87\x0f\xde\x87\x2f\x95\x93\xaf\x57\xab\x70\x09\xf7\xb1\xb9\xac\x27\x9e\xc1\x4c\xa1\x96\xf6\x9b\xf2\x95\xa4\xd2\x3e\xff\x14\xcb\xcf\xce\xa7\xf6\x3f\xa5\x7f\xbf\xcd\xe6\x88\x8e\x61\x93\x66\x78\x40\x2f\x5b\xd6\x3f\x73\x7c\x2e\x08\x08\xfe\xfe\x39\x4b\xaf\xcd\xf7\xbb\x34\x8f\xe0\x53\xc7\xfc\x3e\xbb\xca\xe8\x5d\x1c\xbf\xde\xc8\x65\xe8\xe6\xaa\xb8\xd4\x28\xba\xcc\xa2\xf9\xfb\xf4\xda\xf9\x3c\x4d\xe6\xce\x2f\xe5\xd3\xca\xf9\x09\x25\x06\x93\xfd\x42\xf5\xaa\xfa\xcb\xa9\xad\x13\x5c\x00\x94\x62\x60\x80\x24\xfc\xa6\x5d\x8d\xe9\x67\x7c\xf9\x22\x0e\x57\x6b\xf3\x83\xbc\x90\x9d\x0c\x78\x0a\xd7\x75\xc5\x0d\x32\x52\xd3\xf1\x34\x5b\x5f\x85\x7a\x4a\x8a\xf0\xf2\x0c\x5e\xa2\xf0\xbe\x1f\xcd\xe1\x8e\xeb\x64\xc0\xff\xa9\xdd\x5a\xe1\x2b\x4d\x95\x3a\xc0\x22\x8a\xe3\xb7\x25\xa4\x45\x9c\xa6\x73\xe7\x37\xce\xd8\xca\xcf\x2c\xfd\x28\x5f\x86\xf9\x55\x08\x73\x96\x6a\x52\xba\x58\xd0\xf2\xeb\xb4\xd7\x51\x21\x33\xe5\xcd\xa4\x4c\x6b\xc0\x32\xa8\xb0\xe7\x99\x98\x78\xbf\xc9\xcb\x8f\x4a\xd9\x6e\x05\x7d\xfc\xd7\xe9\x3f\x3d\xee\xbd\xf5\xa6\x23\x0a\x8e\xf0\x51\xde\x80\x9e\xb4\x6a\xc7\x67\xcd\x54\x44\xd2\x9c\x24\x70\xb2\xc1\xa6\x22\x85\x89\x0c\xdb\x9b\x40\x4a\x5b\xc4\x29\x84\xe9\x40\x32\x0f\x9c\xef\xe7\x2a\x66\x13\x48\x2d\xfa\x5c\xa6\xbf\x30\x8e\xc8\xcb\x24\x85\xc9\xd5\x24\x8d\x6e\x69\xf2\xb7\xf6\xe4\xbf\x57\x93\xdf\xcb\xb5\x0c\x31\x5f\xfb\x96\xb2\xc1\xb6\x99\x76\x04\xec\x9e\xb6\x57\xb0\xd5\x7f\x9d\x1d\x86\x9f\x67\xa0\x97\xe5\x4f\x33\x1a\x53\xeb\xc7\xb4\x28\xd2\x95\xa9\xab\x7f\xd5\x20\xe8\xc4\x1a\x1c\x9d\x58\x87\x06\x8f\xa3\x06\x16\xbe\x6b\x90\x90\x54\x83\x83\xa4\x3a\x94\xf7\x0a\xcf\x09\x8c\xfa\x51\x83\xa3\xd2\x6a\x80\x54\x5a\x1d\x12\xdc\xa6\x12\x9c\xf3\x74\x5d\x83\x72\x9e\xae\x6b\x30\xce\xd3\x75\x09\x01\xbb\x31\xd8\xe2\x7f\x5b\x0a\x3f\xfe\x0a\x3f\x98\x49\xcb\x7e\xc5\x2f\xb3\xed\xaa\xdb\x15\x39\x3f\x85\xab\x28\x06\xf2\xef\x79\xba\x29\x90\x1f\x6c\xe9\xc3\x76\x8b\x7e\xdb\xe6\xe8\xb7\xed\xd2\x1e\x6e\xd7\xa3\xfc\xd7\x04\xfe\xe8\xf2\x5c\x47\xfa\x0b\x52\x7e\xe4\x7c\x09\xe2\xfd\xe8\xa0\x3d\x76\x83\x89\x6a\x35\x08\xcb\x7c\x6b\xf3\xea\xc7\xdc\x5b\xe5\xf7\x3c\x66\xcc\x84\x13\xff\xa9\x32\x27\xb9\xff\x8f\x7b\xab\xfc\xde\x11\x63\xe1\x23\xa7\x53\x79\x36\xd9\x66\xd2\x5a\x5d\x2a\x50\x54\x0f\xac\xbd\x39\xa3\xee\xdf\xf3\xfb\xec\xfe\x72\x74\x77\x6e\xb1\xcd\x67\x31\x8d\x48\x5d\x21\x11\xa8\xa7\x61\x39\x8d\x44\x3c\x1f\x28\x9d\x81\xcc\x98\x96\x78\xf4\x1e\x15\xe5\x6f\xc2\x37\x64\xb1\x9e\xee\x76\xe1\x6e\x47\x16\x94\x71\x9d\x31\x91\xac\xdb\x8d\x27\x72\x6a\x21\xf4\x14\xc4\x36\x43\xe4\x02\x9a\xb1\x59\xb4\xf2\x2d\xc7\x54\xf4\xbc\xf5\x67\xaf\x9c\xfe\x87\xc4\xe6\x3e\x66\x3c\xeb\xd7\x71\x83\x1d\x5e\x90\x3b\xaf\x3d\x73\x17\x1f\xab\xec\x84\x36\x7e\xaa\x2c\xbc\xef\xff\xe3\x5f\xbb\xf6\xde\xbd\x6f\x86\x1e\xab\x6a\x04\x9b\x95\xf6\x27\x50\x35\x3b\xba\xd8\x47\x9b\x03\x98\x42\x6c\xf7\xc6\x56\xd4\x56\x2c\xe3\x97\x37\x18\x49\xc4\xf5\xf1\x71\x45\xb5\xa1\xcf\x20\x22\x4f\xa0\x86\x82\x20\x21\x5f\x19\x1b\xc5\xb4\x77\xd2\x14\xe4\x41\x59\xfb\xa4\x21\x94\x03\xda\xf8\xe4\x05\x44\xb2\xb1\x3f\xd3\x2a\xdb\xfc\x64\xc0\x02\x3f\x37\x3f\x86\x8c\x35\x4c\x97\xaa\xae\x10\x11\x3f\xe6\x2a\xcc\x7f\xd4\xe8\xad\x04\x55\xdd\xee\x89\x49\x7e\x83\x10\x43\xd1\x8c\x92\x0d\x52\x53\xa6\xe1\xa9\xaa\x85\x8a\x67\x43\xca\x47\x30\x03\xa8\x76\xca\x79\x15\x7a\xa1\x7c\x26\x5a\x43\x6b\xe8\x24\x87\x75\x77\x6c\xc3\x01\x74\x88\x23\xc2\xe5\x8a\xff\xe1\x89\xd7\x8b\xeb\x3e\x87\xcf\xce\x9f\xbf\x3f\x37\x9e\x87\xdb\x0a\x50\x96\x76\x4a\x9c\x43\x0f\x71\x86\xff\x36\x8d\x9b\xd1\xab\x97\xce\x5d\xc3\x4e\x56\xdc\x7f\xf5\xf2\xa2\x0a\xb2\xe7\x09\xaf\xa7\x2e\x84\xbc\xa6\xad\x52\x01\x81\x19\xaf\xf9\x6e\x6c\x01\x06\xf3\x38\x5e\xeb\x09\x9e\x7c\x4b\x40\x4e\x57\xde\xbf\x7d\x7b\x5e\xef\xcc\xb7\xc2\xf3\xbe\x6d\xf4\xa4\x0a\x42\xb6\xf4\xa4\x05\x16\xa2\xc1\x34\x3a\x63\xf0\xae\xf5\xca\x18\x3b\x12\xd6\x26\x9e\x8e\xdd\x6c\x63\xb6\x0b\x12\x97\x28\xe3\xac\x4c\x19\x07\x56\xe9\x67\x2a\x54\xd0\xcc\xd2\xa9\xa8\xd9\xa0\x49\x1d\x57\x11\x6c\xe3\x36\x54\x1b\x8c\x53\x3d\x3f\x41\x4a\x8b\x56\x58\x02\x1a\xb7\xc8\x82\x25\x1b\xd3\xe6\x18\x7b\x5e\x20\x6d\x1d\xd2\xa3\xac\xcd\x4c\xad\x76\x6d\x82\xa8\x19\xec\x70\xf3\x7e\x53\x8c\x1d\x90\x9e\x57\xb9\xed\xb6\x4e\xb3\x7d\x3d\x3a\x3a\xd1\x45\x6d\xa2\x8b\x72\xa2\xe9\x38\x83\xa7\xf1\x55\xc5\x59\x2a\x32\x43\x16\xfa\x78\x38\x55\x97\x4b\xbd\x20\x29\x4f\x6c\xd4\x12\xf5\xd8\x01\x81\xce\x21\x49\x37\x86\x09\x38\x80\x9d\x17\xbf\xe6\xd2\xe4\x30\x39\x49\x4d\x87\x94\x4e\xef\x54\x24\xe5\x65\x38\x12\x69\x75\x89\x79\x5e\x4f\x51\xca\x7c\xa3\xbc\xe6\x5c\xf5\xcd\x99\x9f\xf3\x08\x8e\x4f\x13\x16\xa4\x4d\x7c\x48\x8f\xe1\x43\xa2\xf1\xa1\xb6\x0d\x00\x8d\x05\x2d\x89\xbd\x04\xfe\x03\xcd\x8d\xbe\x0d\x57\xca\xf0\x2e\x6a\xa2\x36\xae\xb4\xbf\x2c\xa5\x17\x71\xcf\xdb\x32\x1b\x4b\x8d\xb9\x84\x77\x8b\x2d\x96\x4f\x88\xc4\xba\x64\x2a\xe1\x15\x8d\xde\x16\xa6\xb7\x14\x49\xed\x78\x33\x6c\xdb\x06\x73\xcf\xdb\x97\xf8\x8b\xf7\xfc\x11\x54\xb4\xa8\x9c\xb4\xa0\x62\xca\x52\x5f\x72\x32\xe6\x1c\x99\x69\x4f\x1a\x88\x65\x98\x33\xd3\x86\xc2\xae\x51\x83\x36\x8c\x25\x5e\xb7\x4e\x86\x81\xfa\xeb\x79\xc6\x71\x55\x7d\xe4\x35\x5a\x43\x6f\x59\xed\xab\xdd\xed\x36\xeb\xd7\x4c\x9b\x37\x5f\x64\xda\xac\xb7\xd9\xb7\xde\xb7\x3d\x70\x57\xbd\x6f\xbd\x6f\x2d\x3f\xf5\xe4\xe1\x1f\xe6\xd8\xe8\xba\x5c\x7a\xd5\xa8\xae\x14\xb9\xd7\xe0\x2b\xf0\x34\x72\xb2\x9a\x8a\x45\xaf\xc7\xe7\x13\x7c\x4e\xc5\x76\xcf\xcc\xb7\x13\xa2\x8c\xe2\xbf\x7c\xa7\xe2\xbf\xc0\x0a\xe8\x21\x0e\x54\x1d\x18\x66\x06\x9b\x9f\xc1\x77\xca\x1b\x98\xb2\xee\x99\xe3\x90\x5d\x43\x5a\xba\x10\x03\x7e\xd5\x6e\xf2\xf2\xdc\xc8\x94\x20\x1d\x99\xf9\x9e\x95\x31\xc9\x64\xee\xb1\xdd\xae\x9a\x50\xa9\x61\xa5\x50\x95\x7a\x91\x49\xad\xd6\x2e\x93\x2b\x30\xb4\x11\x8a\x5b\x5f\xdb\xa2\x54\xea\x96\xe6\x29\x3f\xc6\x9b\x2c\xf0\x2e\xe3\x4d\xf6\x87\xec\x75\x8c\x2d\x88\x63\x05\xf2\x22\x8e\x66\x1f\x03\x6f\x86\x3f\x3a\x01\xda\x2d\xe0\xb1\xf4\xd4\x78\xb3\xf2\xb7\x99\x09\xa7\x08\x19\xd3\x38\x85\xca\x4e\x3b\xc5\x8c\x85\x8a\x53\xce\x31\x54\x79\xe1\x04\x24\xf0\x28\x3a\x01\xcc\x3c\x28\x73\x8d\x81\xa6\x6b\x1a\xe5\x06\xcd\x21\xb6\x16\xec\x8f\xd2\xcd\x65\x2c\x69\x08\xf3\xcb\xb8\x1c\xc5\xcb\x2c\x5c\x06\x1e\x42\x0d\xd8\x9f\x6a\x34\x48\x31\xc3\x40\x19\x15\xe9\xc8\x24\x17\x32\x2b\x33\x3e\x47\x05\xa5\x7f\x8e\xa8\xb5\x2c\x5c\xaa\x08\x4e\x3a\x9d\x42\x36\x51\x06\xf8\x4e\x9d\x9e\x7e\x72\xc0\xd0\xfc\x20\xbd\x9c\x98\x97\xf0\x89\xee\xcd\xb3\x74\xfd\xff\x2f\x2b\x2a\xa5\x5b\x1a\x78\xea\xb9\x43\x95\x50\x3e\xc1\x03\xd2\x7a\x41\xc2\x5f\xe4\xcd\xcb\xf4\x3a\x09\xbc\x8f\xf2\x66\x9e\x5e\x6b\xf4\xfd\x8b\xbc\x79\xa7\x0d\x9e\x3e\xca\x9b\xb5\x35\x78\xfa\x8b\xbc\xf9\x75\xad\x4a\x6e\xd6\xff\x4e\x23\xad\xd7\x88\x65\xa5\x7b\xb5\xc2\xa7\xed\x97\xca\x78\x9d\x7e\x92\x94\x01\x52\x59\x66\xbc\x05\xca\xa8\xf4\x94\x06\x67\xc3\x9e\x99\x74\xb3\x64\x2a\x03\x63\x51\xc9\x34\x9a\x77\x61\x0e\xdc\x5d\xe3\xcf\xff\xaa\xb1\x98\x8a\x4c\x10\xc0\x18\x20\x8d\xe3\x2f\xb3\x1f\x53\x1a\x5a\x0e\x4e\xe5\x26\xc1\x85\x7f\x77\x23\x33\xf9\xb9\x20\x0c\x29\xcc\xe7\x6d\xd6\x67\xe7\xe9\x66\x76\xf5\x02\x6f\x89\x71\xe0\x15\xf8\x31\x53\x3f\xca\x4c\xb5\x21\x55\x8e\x6d\x07\x3f\xf4\x3a\xaa\x74\xbb\x8e\x0a\x18\xe1\x84\xca\x29\x91\x42\xc5\x66\xb1\xe4\x6a\xe6\x7b\x85\x4d\x30\xa4\xbc\x9a\xf2\x35\xa6\x71\xa8\xf3\x1b\x22\x68\x06\x14\x82\x73\xcf\x57\xc2\xbb\xc8\xa0\xa7\x65\x5f\x61\x5f\xbd\xf4\x7a\xda\x17\xa6\xff\x3a\x2c\xae\xfa\x59\x98\xcc\xd3\x95\xcf\x18\x39\x4b\x7a\xc0\xf8\xa5\x88\xa1\x66\x13\xf1\xad\xd2\xe8\xae\x84\x1f\x25\xa7\x40\x26\x98\x4e\xb0\xd5\x01\x7e\x5a\x0a\x36\x2e\x57\x3f\x87\xc9\x3c\x96\xe7\x64\xc1\xeb\x5f\xf6\xaf\x2a\x09\x8c\x5f\xf6\x9b\x60\x84\xdc\x2b\xe6\xf0\x34\x81\xb1\x68\x35\xcc\x6d\x5b\xf9\x6e\xb7\x2d\xb5\x5f\x42\x50\xd7\xd2\x28\x6f\xc0\x33\x8c\xc1\x89\x7f\xd2\x06\x61\xb7\x6b\x4d\xee\x5b\x48\x3e\x34\x8b\x8d\x8d\xed\x41\x1f\xe4\x05\xcf\x44\x0a\xcf\xec\xb1\x08\x1b\x46\xb8\x2f\x9d\x18\x8d\x13\x39\x55\x4e\x1b\xa3\x67\xb1\x51\x00\x88\x8c\xcb\xf2\x5c\xc4\x93\x68\x3a\xca\xea\xcc\x65\xce\xba\xdd\x6c\x92\x4f\xe1\x5a\xca\xa0\x02\xe4\x70\xf9\x78\xe3\x13\x46\xb0\x71\xeb\x28\x5a\x0d\xec\x7f\xa3\x60\xac\xba\x26\x94\x92\x36\xbe\xa6\x44\x5f\x0f\xcb\xa9\x0e\x80\x5f\x0e\x00\x51\x2d\x00\xe3\x8c\xc8\x4d\xc2\x02\xcf\x52\x21\x3b\x58\x43\x8c\x4e\x06\x47\x3a\xf9\x42\xc7\x3c\x72\x1a\x31\x40\x2d\x31\xfb\xa2\x2e\x36\x6a\xb7\x56\xfd\xed\xd5\x9b\x97\x6f\x7f\xbb\xf8\xf9\xf9\x9b\x97\xbf\x9c\xea\xce\xab\x73\x4e\xf5\x7d\xb7\xf3\x88\x8f\x52\x3f\xc7\xfe\xc6\x37\xc7\x1f\x86\xe2\x7f\xc1\x58\x34\x50\x6e\xaa\x27\x8c\x7f\x41\x65\xd5\x03\x4e\xac\x5c\xc2\xd4\xca\x2b\x40\x51\xe2\x41\xba\x76\xf7\x49\xa9\x74\x23\x4a\x8e\x77\xa4\x51\x9b\xfa\xa1\x2a\xab\x63\x31\x81\xe5\x43\x9f\x72\x04\x82\xd4\xf6\x4d\x23\x02\xf2\xbd\xab\xb6\x3d\x71\xb7\xf6\x72\x7e\x35\xc9\xa7\x50\xe1\xc6\x1e\x02\xb4\xfd\x9e\xd7\x4b\x55\x36\x36\x2f\x65\xa1\x77\x6b\xc2\x5c\x76\x1b\x93\xfe\xc5\x60\xab\x4b\x66\x2f\xd1\x9b\xb5\xba\x11\xa9\xd4\x77\xe1\x52\xfe\xcd\x09\x7c\xa8\x44\x4a\x27\x75\xbd\x0b\x94\xac\x46\x91\x96\x0d\xdd\x0c\x94\xf1\x3d\xc5\x75\x28\xc8\x9e\xf5\x09\x49\xca\x9f\xdd\xae\x13\x97\x71\xcf\x65\x92\x6f\x32\xda\xa1\xea\xba\xf9\x1a\x37\x8c\x14\x67\x4e\xad\x3b\x56\x8b\x43\x9b\xbe\x5c\xf6\x5b\x46\xe0\x33\xc6\x4f\xb2\x6e\xf7\x64\x6d\x9c\xde\xe4\xfd\x96\xf8\x7e\xa3\xd6\xb9\x5a\xe9\x96\x9d\x82\xf0\xe5\xb9\x86\x06\x49\xd5\xd9\xe8\xe5\x97\xdd\x21\x8d\xaa\xb3\x9a\x8f\x1c\x30\x33\xe3\xd8\x4b\x25\xfd\x27\x32\xe1\x17\xd0\x5e\x59\xbf\x53\x0f\x10\xdb\xea\x99\x57\x4e\x87\x5a\x42\x1e\x1b\x71\x54\xd6\xaf\x84\xdc\xb5\xd9\xa3\xd4\x0f\x8f\xaa\x0f\x1d\xed\x78\x29\xa3\x28\x05\xfb\xc9\xa4\xe6\xcd\x7e\xda\x70\x32\x92\xd8\x47\xe7\x9e\x9c\x0a\xef\x9a\xbe\x11\xb9\x41\xbd\x3f\xab\xd4\x15\x3e\x54\xd2\x2a\xd7\x29\xaf\xcf\x28\xe1\xad\xfe\x9d\x7a\xbd\x06\xe4\x8a\x08\x5e\xa1\x44\x54\x3e\xf8\x74\xf0\x0d\x01\xda\x49\xe8\x24\x4a\x72\x69\x84\x34\xab\xde\x92\x1c\x56\x6f\x81\x77\x20\x95\x9d\xbb\x60\x75\xd4\x0f\x23\x5a\xad\xb9\x36\xdd\xda\xcb\x2d\x98\xcc\xd4\xf7\xec\x8d\xd8\xe3\xe5\xf7\x29\x18\x37\xde\xbc\x43\x1f\xac\x60\xaf\xe4\x6e\x35\xc5\x23\x1e\xac\xa2\xb8\x49\x38\xec\x75\x99\x43\x94\x2e\x19\x4a\x8f\x3b\x3f\x54\x9f\x54\xfc\xe6\xbd\x92\xe5\xd7\x42\xa6\xe7\x77\xd1\xbb\x72\x9a\x57\xb8\x8c\x5d\xad\x43\x7d\xee\x76\xbe\x96\x83\x75\xc2\xbe\x1d\x80\x4c\xe6\xe5\x0f\xde\xcc\xb7\xf3\x72\xb4\x94\x9a\x86\xf2\x27\xab\x0c\xaa\xde\x0b\x5b\xbd\x32\x2d\xce\x2f\xc6\xbf\xca\x8f\x6c\xd3\x3b\x42\xb7\xbb\x6e\xb3\x89\xa8\xa1\x2d\xb9\xe4\x9e\xc1\x1b\xdd\x65\xfa\xd9\x13\x65\x6c\x2c\x0f\xee\x0c\xd3\x32\xc5\x6c\xbb\x62\x4c\xb4\xb3\x6f\xc2\x27\x9a\xdf\x2a\x7c\x55\xd9\x40\x5c\x36\x70\xd8\x18\x87\x27\x62\xa6\x3c\x81\x6f\x0a\xf9\x96\xae\x53\x4a\xa7\x63\x34\xef\x87\x79\xb8\xf6\x53\x32\xb7\x34\x26\x59\xb0\x08\xc7\xce\x2a\xbb\xa7\xad\x95\x8d\x24\x3e\x2b\x99\xd6\x58\x6c\x0e\xab\x32\xf2\x48\xc4\xa3\xc8\xf1\xfa\x3c\x62\x91\xa8\xfc\x36\x60\x72\x11\xc1\x0c\x2a\xbb\x39\x53\x97\xbc\x34\x7b\x1e\xc7\xbe\xbe\xca\x4f\xd0\x1b\xe1\xf5\xfe\xe3\xec\xed\x9b\x3e\x96\x25\x59\x46\x8b\x1b\xa8\x0d\x67\xac\xf7\xed\x54\xa9\x75\x0b\xea\xe9\xf4\x5b\x90\xef\xc1\x68\xfd\x2c\x37\x7c\xf1\xda\xf0\xc5\x0b\x91\x4f\xd6\x53\x0c\x6b\xa1\xf5\x91\x95\x8f\xc8\x95\x40\xc4\x05\x7c\x90\x71\xbb\x1e\x4f\x23\x0c\xf7\x82\x8d\xae\x8c\xbf\xa7\xd0\xf7\x9e\x0e\x3c\xc6\xed\xec\x5d\x41\xda\x4d\x4b\x97\x58\x2f\xb2\x0f\xc9\x01\x32\xc9\xf9\x94\xeb\x20\x2d\xe8\x7b\xa4\x05\x7d\x50\x19\x45\x60\x95\xef\x1f\x53\x5c\x25\xed\xdd\xe7\x31\xe3\x5b\xd7\x64\xa8\x55\x8c\x3c\x43\x2f\xf5\x91\x55\x30\x9e\xea\xdf\xca\xe1\xa1\x44\x6c\x2b\x9e\x89\xc8\xdf\x62\x6a\xc8\xea\x42\x87\xea\xa3\x6f\x04\x50\x34\x9f\xe1\x67\xfa\xdc\xf3\x82\x6f\xc9\xaf\x3a\x01\x32\x85\x28\x55\x35\x67\xd2\x14\x22\x12\x5a\x26\xe3\xa4\xe1\xa2\x2b\xd2\x06\x80\xaa\x8e\x0d\x02\xaa\x8b\xa7\xe3\xf4\x50\x71\x6a\x98\xdb\x5b\x7f\xbd\x9c\xc9\xd8\x5b\x2e\x23\xdb\x73\xd7\x41\x44\xeb\x6c\x15\x36\x52\x31\xba\x33\xaa\x01\x15\xdb\x6a\xeb\x34\xaa\xc2\x6c\xbe\xb1\xfd\x0a\x2c\x20\xd3\x51\xaa\xf9\x57\x67\x36\x0a\x84\xad\xdb\xc8\x31\xfd\x0d\x12\xeb\x99\x90\x42\x65\xdb\xc1\xc5\x3a\x4a\xac\x64\x26\x6c\x29\xee\x9c\x08\x00\xb0\xdf\xf3\x0a\x5d\x71\x06\x65\xb6\xbb\x3c\xb8\xd7\x6d\x6f\x49\x8f\x34\x81\x31\x8b\xf3\x82\x62\xcf\xbe\xf6\x9d\x2b\x19\xf7\x08\x80\xc7\x93\xdd\xce\x78\x3a\x4f\x0f\xec\x74\x70\x37\x55\x74\x74\x4d\x37\xa3\x85\x4f\x1c\x9c\x8a\xf1\x91\xea\x39\x61\xf4\x57\x78\x03\x52\xce\x04\xb5\x21\x2f\xd0\x96\xdc\x18\x83\xc3\x35\xe2\x1f\x2a\x2d\x52\x9f\xea\xf1\xe1\x80\xed\x76\x83\x91\x9f\x9d\x88\x78\xb7\xcb\xd4\x7e\xa6\x3c\xb4\x0a\x03\x5b\xd3\x02\xe8\x84\x7e\x99\xb0\x05\x54\x62\xbd\x4c\x19\x5e\x4a\x98\x25\xb4\x2f\x8f\x15\xf4\x81\x2e\xaa\xfb\xfb\x44\x00\x40\x15\xc5\x14\x70\x37\xa1\x59\x44\xbb\xec\x14\xe5\x72\x35\x5a\x23\x1c\x73\x61\x51\x92\x38\x39\xa9\x97\x42\x58\xf4\x9a\xc3\xc8\x2f\xc2\x9a\x43\x8b\x6b\x2d\x39\xdd\xd8\x67\xe4\xc9\x4f\x5b\x04\x64\xca\x11\x60\xe0\x58\x0e\xa8\xe0\x99\x64\x2f\x00\x2c\x76\x3e\xe1\xdf\xa9\xf6\xf3\x5e\x8c\x80\x24\x94\xb8\x4a\x93\xe2\x8a\xbe\x9d\xb2\xd7\x52\x7e\xf4\x82\xc4\xae\x17\x37\x9f\x65\x0c\x72\xe4\x90\xf9\x02\x25\xd9\xf2\xf4\x97\xb4\x5f\x12\x15\x6d\x69\xa4\xec\x7a\x53\xe8\x58\xab\xdf\xb0\xe1\xe3\x49\x6d\x52\xc5\x49\x3d\xe5\x2e\x45\xea\x90\x53\x56\xbd\x4d\xac\xef\xce\x73\x48\xb6\xad\x71\x02\xbf\x44\xc9\xc7\x6e\xb7\xc2\x0e\x20\x69\x1c\xf9\xde\x93\xc7\xd6\xd3\x6c\x8d\x05\xc1\xb5\x84\x57\xea\x18\xb3\x9f\x92\xa2\x2a\x08\x4f\x5a\x20\xc4\x0d\x08\xd4\x95\x03\x30\x9e\xb6\xc0\x08\x9b\xde\x88\x2b\x2e\x86\xcd\x2d\xf3\xa8\xd3\xde\xdf\x55\xec\x22\xf8\x17\xae\x70\xe6\x11\x9d\xb5\x78\x4c\x1b\x0e\x1e\xeb\xd7\xb4\x07\x0f\xf5\x19\xfb\x00\x47\xec\xcc\xdf\xf4\xa3\x5c\x05\xff\x25\xb4\x6f\x1c\xb8\xda\x6f\x05\xd4\x0c\x0d\x9f\x86\xef\xc8\x68\xa8\x52\xb0\xe5\x13\x8a\xf1\x8b\x24\x85\xf6\xf8\xd0\x9b\x01\x3a\x89\x70\x8a\xd2\x12\x45\xb5\xbc\xb2\x9f\xc8\x49\x31\xdd\xed\xd6\xb8\x55\xdd\xac\xe5\x74\xb7\x2b\xe7\x0e\xdf\x26\x66\x30\xbe\x4d\xe4\x69\xc5\x0a\x06\xd0\xe4\x51\xee\x3e\x7d\xef\xef\xe9\xa6\xb3\xce\x52\xf8\x49\x9d\x77\xc2\xce\xef\xaa\xc1\xdf\x91\xb4\xee\x14\x69\x27\xec\x80\x9b\xe9\x28\x6f\xe2\x9d\xeb\xa8\xb8\x4a\x37\x45\x27\x4c\x3a\xbf\x9b\x86\x7e\xef\xe8\x6b\x66\xd6\xd7\xfe\x9c\xaf\xa3\x38\x36\x93\x1d\x76\xd0\x85\x7b\x69\x12\xdf\x68\x08\xfd\xce\xab\x85\x72\xa1\xac\x7e\x75\xf2\xab\x74\x13\xcf\x3b\x97\xb2\x83\x57\xe8\xcb\x58\x76\x80\xb8\xbf\xd3\x06\x50\xe4\xf1\xf7\x7e\xe7\x6d\x71\x25\xb3\xeb\x28\x97\xbc\x93\xcb\xa2\x23\x23\xfc\x76\x3b\x90\x66\x9d\xdf\xcd\x58\x7f\xef\x2b\xb5\x19\x3a\x60\x8f\xce\xdb\xbf\x60\xb2\x08\x75\xff\x3f\x31\x5d\x44\x2f\xbe\x6e\xc2\x4c\x5e\x30\xef\x63\xce\xf6\x78\x24\x86\x44\x7f\xab\x46\x88\x33\xfe\xfc\x66\xdd\x62\x00\x62\x38\x6e\x65\xa3\xb2\x50\x97\xea\x45\xfd\x52\x9c\x32\x86\x22\x99\x58\x4c\xd2\x29\x79\x08\x80\x7f\xc0\xb5\xa7\x88\x08\xac\x35\xa0\xef\xe2\xfa\x86\x56\xd3\x8d\xf8\x0e\x59\x7f\x25\xf3\x3c\x5c\x4a\xc0\xbf\x62\x6c\x7b\x35\xb1\x49\xd0\xad\x1b\x85\x88\xc5\xba\x57\xf6\x5a\x7f\xad\x6d\x15\xb3\xdc\x1d\x97\xb0\xf9\xa0\x60\xdc\x49\x21\x16\x22\xa0\x24\x05\x89\xa6\xb2\x1d\x16\xad\xb9\x22\x93\x3e\xa8\x19\xaf\xa4\x95\xf0\x28\x71\xcf\x6b\x97\xa5\x76\xdd\xa4\xe3\x9d\xcc\xe4\xff\x6c\x24\x5c\x50\x61\x91\xfc\xa2\xaf\xc3\x60\x53\x5b\x2c\xb8\xbd\x57\x07\x00\x50\x11\x05\xc2\xe0\xc0\xb8\xfc\xd4\x9a\x09\xc4\xa3\x9b\xb8\x52\x83\xd1\xff\xa9\x48\x85\x6a\x36\x6d\xb5\xe3\x87\xcc\x99\xce\x4e\x5f\xbc\x3f\x3d\xbf\x78\xf9\xf6\xe2\xcd\xdb\xf3\x8b\x77\xcf\xcf\xce\x2e\xce\x7f\x7e\x75\x76\xf1\xf6\xfd\xc5\xdf\xdf\xfe\x7a\xf1\xdb\xab\x5f\x7e\xb9\xf8\xf1\xf4\xe2\xa7\x57\xef\x4f\x5f\x7a\xa3\xaf\xd5\xde\x34\xf1\xaa\x0c\x37\x1f\xf7\x8d\xf1\xa0\xb5\x46\x90\x0e\x3c\x7b\x1c\x76\xbb\x4d\x4d\xdf\xf6\x98\x4c\x45\x4f\xc8\x20\xdf\xed\xfc\x1c\x52\x5b\xb6\x67\xbc\xb0\x62\x37\x0a\x37\xf6\x40\xab\x66\x3c\xa4\xc8\x4a\xc3\x81\x63\xcf\x76\x32\xc4\x81\xb2\x3d\x78\xc5\xe0\xa4\x99\xa3\x75\x9e\x4b\xd6\x97\x92\x63\x91\x8c\x3c\x13\x81\x01\x1c\x70\x4c\x2e\x76\xfc\x58\xc4\xae\x0f\x1b\x73\xba\xc6\x08\x72\xa9\x2e\xc1\x95\xd2\x99\x88\xc0\xa0\xe9\xeb\xb1\xda\x37\xa4\x71\xe0\xc7\xa4\x46\x1e\xd6\x3a\x90\xd1\x73\x10\x52\x8c\xd1\x70\xed\x6a\xd2\xf3\xbc\x20\xf5\x4b\x43\x61\x4c\xc3\xc9\x90\x57\x43\xa5\x64\xac\x24\x1a\x33\x31\x18\xcd\x9e\x65\xe6\x6e\x3d\xeb\xf5\x20\x54\x03\x37\x3d\x99\x4d\xf1\x26\xc1\xb6\x21\xf6\xb8\x62\xc3\x48\x01\x2c\x04\xeb\x9b\x21\x73\xdf\xb8\x77\xd9\x08\xf5\xe1\xfe\x8f\xb0\xaf\xe5\xa0\x69\x60\xe6\xde\x17\x1e\x62\x6a\x93\xf6\xa8\xe0\xa6\xfa\x7e\xcf\x6f\xbd\x81\x67\x7e\xd9\x7d\xda\x6f\x66\x1e\x0d\xfb\x03\x0d\x3f\xc3\x22\x55\x46\xde\x37\x35\x15\x93\x68\x7e\x1c\x2c\x65\x4c\x6e\x2a\x6b\x65\x77\x8c\x82\x61\x92\x45\x0c\xb9\xe9\x7e\x74\x30\x9e\xe0\xb1\xfd\xa8\x4e\x83\xa6\x90\xab\xc5\x28\x19\x4f\x91\x51\xb2\xd4\x3a\x31\x6c\x7b\x5b\x09\x61\x5f\x0f\x8e\x48\xa9\x0a\x8d\xe2\x46\x0e\x4f\x33\x57\x74\xbb\xe4\x23\x85\x54\xd4\x7c\xd9\x37\x21\x66\x18\x6f\x46\xf7\x35\xfb\x11\x2e\xb4\x62\x91\x1f\x40\x80\xbe\x76\x22\x93\x53\xd8\x0b\xe0\x77\x8a\xf3\x33\x13\x83\x51\x56\x06\x21\xce\x7a\x3d\x96\x4e\xa0\x21\x38\xc9\xa6\xea\xf4\x42\x49\x5d\xc8\xbe\xbc\x66\xe6\xe5\x15\x7a\xa2\xb5\x93\x34\x9e\x64\x53\xc2\xa9\x91\xfa\x36\x4b\x6a\xc2\xd5\xb9\x69\x02\xc2\x7b\xec\x19\xb5\xdf\xe0\x5e\xaa\x97\xf0\x96\xc6\xa2\x85\x03\x17\x97\x6a\x23\xba\x06\xda\xd5\x40\x9e\x0c\xd8\xc8\x54\x56\xed\x0d\xaa\x99\xfb\x2f\x97\x25\x46\xed\xb2\x44\xea\x43\x13\x7d\xfc\xdb\xb1\x03\x92\xb1\x8a\x28\x92\x3b\x62\x34\x43\x94\x89\xc5\x07\x95\x9e\x91\xd4\x6c\xe3\x10\xe9\xb9\x38\x26\x35\xa3\xde\x85\x50\x5d\x28\xf8\xd6\x1c\x97\x0d\x39\x94\xc9\x20\xf9\x16\x6d\x64\x76\x07\x19\x93\x83\xbc\x05\x6b\x8a\x98\x2a\x23\x0e\x4e\x86\x6d\x92\x23\xc8\xd1\xec\xbd\x5e\x65\xdc\x41\x74\x74\x1d\xe6\xaf\x69\x3f\x04\x66\x8b\x14\xe5\x16\xd9\x93\x5e\xa8\xb0\x52\x8d\xdd\xce\x49\x71\x1b\xdb\xed\x36\xbb\x9d\xbf\x51\x68\xc1\x5b\x0f\x1b\x67\xe0\x0e\x1b\x54\x9d\x41\x77\x5c\x7b\xde\x70\xb0\xff\x05\x54\xfd\x18\x64\x41\x8c\x8d\x89\x07\x5a\x2d\xe9\xcc\xc9\xe8\x48\x9e\x68\x99\x2f\x92\x75\x55\x57\xd3\xc8\x2d\x7d\x79\x1c\x8f\x87\x1c\x34\xa8\x05\x2a\x4f\x19\x0b\x92\x13\xd1\xd6\xa2\x75\x79\x53\x5d\x8d\xf1\x21\x50\xd5\x62\x2c\x38\x5c\xce\x7c\x8f\x27\x53\x04\xb2\xa9\x3e\x0d\xce\xbf\xe4\x5c\x68\xee\xea\xcd\x9d\x5f\x3e\xfe\x00\x31\x69\x25\x0a\x31\xdd\xf7\xef\x44\x1c\xee\x24\x52\xb7\xd3\xdf\xee\x7a\x65\x1c\xfb\xde\xd3\xa1\x95\x6a\x50\x0c\x5e\x43\x4a\x5c\x42\xd1\x2a\x29\x37\x47\x73\x80\xe8\xa0\x47\x50\xba\xdc\xdc\xf5\x52\x4d\xa1\x77\xf2\xa5\x04\x89\xa7\xc2\x7a\xb5\x13\x86\x39\x0d\x6b\x04\x80\xe7\xa2\x64\x30\x08\xe9\x73\x8b\x9e\xa1\x9a\x87\x07\x76\x1e\x6a\x7c\x22\x74\x29\x7c\xf3\xec\xf2\x4c\x0c\xcd\x6b\x09\x2a\x3d\xf4\xb0\x3c\xf9\x64\x30\xc5\xb2\x79\x5e\x2f\x37\xd2\xcf\x50\x79\x45\x82\xec\x2d\x15\x61\x93\x41\x74\xe7\x07\xf3\x97\x1e\x24\x88\x99\x21\x88\x7f\x48\x76\x7e\x88\x5f\xe0\x75\x8a\x60\x39\x4e\x6b\xf2\x88\xde\x8d\xe0\xea\x86\xa4\x8d\x98\x37\x3a\xa2\xb3\x52\xd6\xeb\x4e\xb7\x2a\xe1\x26\x88\x8c\xed\xdb\x48\x41\xb3\x60\x35\xff\x6e\xb2\xdf\x83\x63\xc3\x93\x81\x13\xe3\x78\x04\x63\x8f\x63\x88\x8a\x23\x9d\x46\x96\xd4\x44\x9b\x5f\xa2\xc3\xef\x0a\x78\xf4\x53\x95\xf7\xea\xcd\xd9\xe9\xfb\xf3\x8b\xd7\xcf\xdf\xff\xe5\xd7\x77\x9e\x7a\x15\x81\xfe\x8b\xe4\x08\x50\xaa\x2d\xef\x31\x3f\xea\x27\x08\x91\xc6\x81\x22\xa5\x2c\x1e\x2e\x0a\x99\xa9\x8c\xa2\x8d\x2f\xac\xb4\xf5\xfa\xed\x5f\x4f\x2f\x4e\xff\xf6\xea\xec\xfc\xd5\x9b\x3f\x97\x6d\x59\xf8\x1a\xa6\xec\x5f\xa8\x8d\xa6\x7e\x95\xed\xae\x8d\x53\x3f\x34\x86\xbb\xfb\x6d\x7d\x88\x5d\x36\x84\x7a\xf0\xfe\x54\xf5\xe1\xcd\xdb\x97\xa7\x5f\xda\x7e\x51\x36\x88\xfe\x96\x6d\xa2\xba\xd3\x6c\xe8\xcb\x5a\xa3\x67\xa7\x5f\x3f\xbf\x47\x9b\x8a\x1a\x4d\x9d\x9f\xfe\xed\xfc\xe2\xc5\xdb\x37\xe7\xa7\x6f\xce\xff\xc5\x8d\xe5\x15\xae\x0e\x41\xe5\xa4\x90\xbb\xdd\x64\xca\xa5\x0e\xe9\x54\x20\x82\x76\x59\x7e\xa6\xcb\xcf\x8d\x1a\x4e\x2d\x4a\x9f\xca\x55\x87\xcb\x86\x0e\x17\xbc\xcb\x0e\x87\x0f\xac\x9c\xf8\xa1\x63\x62\xf9\x48\x7d\x0f\x18\xc4\xbd\x28\xf5\x1d\xe3\x57\x36\x56\xcb\xf0\xc1\x53\xc6\xf8\xca\x9c\x3d\x5b\xe5\x50\x28\xd8\x5e\x64\x72\x96\x26\xb3\x28\x46\x30\x04\x5c\x43\x8a\xc8\x71\x5d\xe5\xec\x56\x17\x53\x3b\x8b\x7e\xd4\x2c\x4c\x65\xf6\xdc\x81\xf9\xeb\x7a\x7e\x18\x1c\x57\x77\x21\x22\xf9\x4a\x6f\x94\xc0\x87\xe2\x4a\x87\x5d\x5b\xf4\x37\x15\x00\xbe\xe4\x21\xd5\xc3\xe9\x7d\x28\xa4\x03\x8f\x51\x39\xa4\xb3\xe8\x40\xeb\xc6\x70\x89\x58\x88\x63\xd3\x40\x15\x46\x07\xe2\x13\xa5\x14\x71\x79\x32\xe5\xb1\x18\x54\x9d\xd7\xa4\x10\x44\xa4\x87\x9d\xd7\xa4\x93\x70\x0a\xef\xab\x7c\x26\xd6\xfd\xaa\xdf\x2f\x3f\xe2\xc5\xf1\x61\x26\x4a\xe2\xe9\xee\x44\x11\xf7\x7a\x3c\xd3\xa8\x36\xb3\x46\x91\xd9\x9e\x37\xfc\x9b\xb5\x10\xe2\xf6\xe1\x8d\x16\x0d\xf7\x59\x05\x2e\x36\x75\x2d\xa6\x56\x15\xa6\x8d\xef\x0d\x87\x4f\x3c\xf3\x52\x3b\xc1\x66\x9c\x8e\x66\xc6\x47\xa0\xe9\x57\xc3\x9b\xf1\xff\x62\x97\xc2\xf6\x2e\x19\xc8\x4e\xa7\x14\x12\x10\xcb\xd9\xc0\x4b\x42\xfd\x5b\x2a\x57\x51\xae\x3a\x2a\x9e\xe1\xa6\x1f\x8b\xc9\xd4\xba\xb0\x3d\xb4\x91\x7c\xf8\xc6\x8d\xb1\x0b\x80\x96\xd1\xc2\x0f\x77\x3b\x3a\xf0\x23\xf2\xaa\xc7\xe7\x62\x40\x46\x5a\x03\xbe\x52\x49\x6a\x7a\x22\x4c\x4d\x08\xac\x0c\xeb\xb3\x13\x11\x56\x5e\xe2\x91\x30\x9d\x44\x53\xbe\x14\x21\x74\xb6\x2f\x85\x10\xcb\xb1\xbf\x11\x39\x05\xa9\xe8\x3b\x21\x0d\xf8\x8a\xcf\xf9\x42\x11\x1d\xa5\x9a\xbf\x0a\x3f\xfb\x97\xd5\xd3\x81\xf1\x4a\x82\x98\xb3\xc0\xbf\xec\x76\xfd\x23\x35\x18\x77\x5a\xbb\x28\xd7\xfa\xb9\x2e\xe1\x2f\x79\x3c\xb9\x9a\xaa\xb6\x31\xb1\x8c\x5f\xf5\x7a\x8c\xcf\x7b\x3d\xbe\x12\xd5\x43\x70\xc9\xf6\x76\xdc\x19\x6b\xa8\x9f\x47\x60\x0f\xdd\xb6\x5c\xcc\xf2\xd5\x2c\x64\x93\x68\xca\x18\x1b\x6d\xba\x5d\x42\x93\x0d\xe3\xed\x6b\x28\x20\x30\xac\xe1\xe6\x1f\x43\x6b\x79\xb0\x29\x2c\xe9\x9e\xdb\x95\xa8\xe1\x1a\x85\xca\xac\x9c\xd3\xcf\xac\x44\xc6\xb0\x1e\xc6\xfa\xb9\x0d\x82\xa5\xf6\x29\x2c\xba\x79\x05\x12\xdb\xf3\x4c\xb6\x37\x6d\xab\x69\xd6\x62\xcf\x9b\xab\x77\xe0\x1c\xa0\x7a\x95\x86\x84\x16\xbc\x90\xe2\x9f\x02\xe2\x4b\x9e\xe0\x58\xe4\x95\xa5\x6a\xd7\xaf\xc1\xcc\x39\x1d\x55\x3d\x1a\xb5\xb6\x83\xd9\x84\x84\x72\xcf\x0e\x3f\x48\x34\xd8\x46\x7a\xb8\x57\x27\x73\x66\x8e\x56\x48\xe6\x63\xb1\x25\x8f\x17\x60\xc4\x7e\x8b\x8a\x2b\x22\x71\xaa\x99\xf6\xc3\xfe\x80\x91\xca\x69\xf2\x29\xca\xd2\x64\x55\xa7\xd9\xd9\x38\xf5\xbd\xe1\xe0\xbb\xf2\xde\x13\xf7\x5b\xdb\x14\xb2\x3d\x9d\xc7\x07\xb8\x0e\x21\x0f\x64\xf0\x4c\xab\x20\x1f\x56\xe4\x6d\x99\x9f\x04\x53\x81\x15\xa8\xf4\x1e\xd1\x3f\xa1\x4e\xaf\x42\xae\x27\x61\x6c\xf8\x7e\x92\x9f\x28\x39\x77\x75\xb8\x76\xd1\x5a\xeb\xed\xf9\x55\x98\xb7\x55\xd0\xf0\x4e\x84\x38\x5c\x33\xaf\x34\x85\x41\x1c\x2a\x2b\x8a\xfd\xfe\xf8\x93\x52\xc5\x47\x55\x65\x2e\xea\x57\x8c\xd2\x29\x8d\x15\x7b\x49\x68\xf7\xd2\x45\xab\xdb\x85\x76\x39\x52\x44\x8c\xd3\x6d\xe0\x78\xe5\x79\x34\x30\xa1\xd1\x1f\x91\xab\x8d\xe1\x03\xe5\x01\x71\xf8\x00\xdc\x9f\x12\x4b\x3e\xf8\xbe\x7c\x2b\xda\xb6\x70\x6b\x95\x11\xf3\x84\x67\x8a\x5c\xa0\x71\x21\xa4\x21\x13\xf8\x69\xdc\x6a\x59\xdd\xeb\xc8\x97\x1c\x8e\xe6\x79\x7c\xcb\x59\xc9\x53\x1e\xf1\x9c\xcf\xf8\x86\xcf\x15\x74\x78\x21\xd1\x83\x5e\xf3\x85\x3a\x8d\xd6\x87\x35\xa0\xd7\x78\xdf\x11\xb2\xdb\x95\x50\x8f\x44\xa5\x2b\xb1\x80\x76\x64\xed\x8e\xcc\x57\xa2\x20\x05\x4a\x74\xf7\x04\x85\x42\xff\x8a\xaf\x18\xcb\xfa\x75\x6f\xf5\xfe\x82\xaf\x78\xa4\xe8\x37\x5c\xf0\x2d\x94\x72\xd5\x76\x01\x55\x26\xfc\xce\x2a\x27\xc7\x02\xfa\xf1\x9b\xa4\xc6\x95\x2d\xc0\x69\x68\xee\x01\x26\x67\x2b\x2c\xcd\x08\x3d\x10\x97\x2a\x71\x29\xb2\x3a\x23\x77\xe9\xcc\xc3\x28\xd1\xdc\xd9\x12\x2f\x04\x66\x06\x24\x3b\x69\x78\x64\x5c\xb3\xdd\xae\xe8\x76\x5b\xe6\x65\xb7\xf3\x17\x02\xb3\xc2\xbf\xb0\xd3\x47\xcf\xa6\x8a\xc9\xd7\x61\x47\x91\x09\x31\x09\xa9\x00\x6e\x8e\x5a\xda\x4a\x41\x9c\xab\x74\x34\xdf\x33\xf2\xad\x03\x24\x1d\x7e\x0f\xab\xb3\x5a\xcb\xf4\xab\x03\x1b\x0b\xd8\x55\x5f\x77\x1c\x6d\x89\x5c\x16\x08\xc6\x07\x8d\x91\xab\x30\xef\x24\x69\xd1\xb9\x94\x32\xe9\xcc\xe5\x22\x4a\x24\x94\xc7\x6b\x52\xc1\x5a\xfd\x59\x2c\xc3\xec\xae\x10\x8c\x4e\xff\x06\x6f\x83\xb6\x61\xb3\x25\xca\x14\x5f\xf2\x81\x62\xbe\x54\x49\x68\x24\x6e\x58\xb7\xdb\xac\xb1\x71\xa0\xf0\xf2\x53\x57\x87\xb7\x50\x53\x4e\xa5\x90\xc7\x50\x84\x24\x77\xb2\xf4\xec\x01\xbf\x79\xb5\x50\xb5\x00\x8e\x4a\x5d\xc0\xb9\x93\xc6\x34\x9e\xb9\x10\xc2\x9d\x08\xd3\x3f\x37\x0d\x6f\x90\x18\x11\xca\xa6\xbb\xdd\xc9\x9c\x75\xbb\x6d\x75\xe6\x15\x48\xdc\xfd\xe1\xcb\xca\xa8\x94\xe8\xab\x6d\x4c\x73\x77\x4c\xcd\x11\x51\xb6\x1e\x51\x65\x3c\x08\x9c\xbd\xea\x76\xb1\x69\x57\xca\x13\x01\xc9\xf6\xc6\x57\x62\xd1\x07\xbf\x1c\x22\xb6\x54\x70\x29\xee\x0d\xf9\x15\xe5\x75\xbb\x91\xef\xba\x4e\x42\xc0\x6c\x98\x68\x90\x76\xb5\x14\x99\x1f\xb2\xd1\xca\xbc\xa4\x61\x0f\x14\xc2\x54\x1e\x15\x23\xbd\x35\x16\xe2\x8a\x5f\x21\xb6\x73\xaf\x77\xf9\xac\x18\x31\x90\xa3\xc9\xe5\xb4\x9f\x6d\x12\x38\x36\x46\x83\x65\xad\xfd\x42\x31\xdd\x5c\xf5\x11\x6b\xd0\x14\x13\x60\x70\xd0\x92\x31\x31\x30\x94\xeb\x45\x51\x94\xe5\x66\x3e\xdb\xea\xeb\x3f\x9f\xf3\xb5\x28\x37\xd4\x76\x3f\x3a\x31\xa5\x80\xf0\xd9\xcd\x76\x23\x3c\x93\x52\xaa\x19\x94\xe8\x36\x2e\x3f\x83\x84\x66\x5b\xb2\xed\x46\x24\x7b\xac\xd6\xbc\xad\xb6\xbb\xac\x63\xf7\x47\x90\x96\x10\xe6\x22\xdd\xef\x7d\x4d\x10\x17\x6a\x7a\xf4\x90\x31\xff\xa3\x75\x3f\x91\x9f\x8b\xf3\x68\xf6\x51\x34\xb9\x60\x6c\x50\x25\xab\xf5\xc3\x6c\xb9\x01\x83\x63\xc4\xb4\xf7\x86\x0a\x0d\xeb\xc9\x3f\x0c\x1d\x37\xd3\xc3\x51\xf2\xac\x5e\xc0\xb8\x9b\xbe\x37\x9c\x0a\x9b\x07\x32\x75\xa5\x89\x2e\x5a\xd4\x22\x1a\xc6\x87\x27\xc2\xae\xd5\x6e\xb7\xda\xed\x32\x3f\x62\x7b\x9e\x97\xee\x97\xb1\xae\x65\xb7\xcb\xe5\xea\x87\xeb\x75\x7c\xa3\xe4\xac\xce\xba\x41\xd8\xd9\x2f\xe0\x9f\x4d\x78\x97\x59\x7a\x9d\xc3\xee\x7d\xdd\xa7\x4f\x58\xfd\xad\xfb\x32\xf9\x84\x3b\xde\xba\x1f\x66\xcb\x4f\x98\xa9\x75\xff\x93\xcc\xe0\xca\x0f\xba\xa3\xf6\x07\xd6\x97\xaf\xfb\x69\x22\x66\x7c\xdd\x0f\xe7\x73\x63\x19\xa6\x7e\xa7\xc9\x4c\xea\x8f\xc5\x42\xfd\xd5\xec\x55\xa5\x0c\xb9\x34\x71\xfc\x58\xab\x64\xb9\x8a\x0a\xf5\xb1\xce\x94\xd5\x6e\xa5\x0e\xa5\xbd\x4d\x66\x55\x58\x56\xb2\x5d\x59\x42\xbd\xbd\x27\x53\x8c\x06\x12\xee\x28\x59\x56\xf2\x1b\x34\x98\x58\x4a\x53\xb8\x13\x69\x2a\x4c\xb6\x73\x8a\x04\xf3\x75\x7f\x76\x3d\x2f\xc1\x98\x56\xbc\xfb\x1e\x9a\x99\x5d\xcd\xa3\xec\x6e\x8d\xa8\xa2\x07\x9a\xd8\xac\xc2\xfc\x63\xb3\x91\xce\x60\xdf\x64\xe7\x0e\x72\x70\xff\x16\xad\x51\xdb\x40\x56\xce\x70\xcb\xb6\x94\xdd\xae\xb7\x49\xcc\xc9\x75\x62\x93\x4b\xcc\xed\x76\xdb\xaa\x39\x98\x5d\x3d\xb2\x6f\x2d\x5e\x67\xa3\x1a\xf2\x63\x4c\x83\xf3\x8e\x03\x99\xa7\x84\x6b\xa6\x21\x4b\xc4\x8c\x2e\x6f\x6e\xd8\x27\xcf\x06\x91\x34\xad\x19\x81\x98\xe4\xb0\xb9\x41\x2a\xc0\x95\xfd\xb2\xc3\xcc\xa1\x88\xa4\xcc\x6d\x9c\x34\xe2\x2a\xe1\x53\x79\xdd\x13\xa9\x1f\x51\x1c\x48\xc6\x43\xdf\x1b\x3e\x1c\x78\xf4\xd0\x91\x8f\xf3\xc0\xd4\xe1\x6b\xb6\x6f\xe8\x6a\xe9\xa6\xc7\x89\xd8\x50\xaf\xcd\x15\xc0\x8e\xdd\x8f\xf0\xb4\xa9\xcb\xb1\xb1\x9f\x08\xe0\xb8\xfe\xe9\x47\x8c\x27\x2e\x37\xb6\xdb\xf9\x95\xdf\x42\xfd\x7a\xa3\x7c\x13\x23\x1f\x8f\xaf\xaa\xfe\x1c\xb4\x07\x93\x73\x47\xe5\x31\xb7\x7f\x64\x11\x94\x66\x90\xee\xf9\x92\x05\x6a\xc8\x43\x8f\x9b\xd2\xe5\xeb\x5c\xe5\xc6\x3b\xe0\xf6\x37\xd4\x89\xcd\x0d\xf8\x90\x99\xd0\x90\x34\x98\x87\x0f\x86\x10\xce\xe0\x8a\xa1\x94\x98\xd5\xed\x83\xe2\x84\xd3\x9b\xa6\x59\x34\xdf\xa8\xe0\xcc\x60\x31\x98\x6d\x66\x88\x4e\xbf\x67\xa3\xc8\x9f\x97\xc8\xc5\x73\xbe\xbd\x70\x2e\x28\xca\x1e\xd6\xce\x76\x10\xef\x19\x3f\x78\xdd\xbc\x65\x8b\x36\x5c\x25\x56\xf0\xd6\xec\xb0\x13\xff\xc4\xc1\xf5\xdd\xce\xfd\xd5\x8f\xf2\x6a\x7f\x58\xeb\x1b\xc6\x2d\x20\xde\x6d\x32\x59\x07\x53\xd7\x10\x27\x6f\x4b\x0f\x68\x6e\x87\x0f\x48\xd6\xff\x08\xa2\xfe\xc4\xff\xee\x91\x91\xea\x3f\x24\xa9\x3e\x09\xfd\x87\x4f\x94\x58\x5f\x5f\x0a\x2f\x51\x63\x80\x08\xf2\x24\x8b\xc0\xff\x90\xfb\x23\x96\x3c\x2e\x87\xfc\x93\xb9\x12\xbe\x5a\xad\x37\x19\x3c\x50\xe7\x79\x30\xe0\xef\xec\xf7\x90\xab\x87\xb5\x58\xe6\xf9\x4f\x34\xd2\x30\x0e\x1e\xec\xd9\x28\x75\xc6\xa4\xe5\x5e\x2e\x11\xc5\x78\xa4\x58\x00\xbf\x95\x8c\x8c\xd5\xef\x69\x6a\x87\xf0\x42\xe8\x60\x0b\xf4\xb2\x69\xb0\x03\xcf\x7c\x6e\x1c\xb0\xcc\xe2\xac\x5e\x39\x5e\xec\x15\x83\x71\x23\x86\xfc\xb3\xd8\x5a\x7c\x0a\x1a\xa8\x56\x6b\xd5\xb0\x56\x6d\xa1\x1b\x8c\xc7\x24\x09\x8d\x64\x37\x28\xbf\xd1\x1f\x76\xd3\x4a\x8d\xc8\x7a\x6a\x45\x26\xef\x66\xea\xa1\xfc\x08\x46\x49\x3b\x6c\x75\x33\x71\xfc\x46\xc9\xd2\x74\xb3\x99\xa3\x96\x41\x59\x64\xb7\x64\xbe\xd7\x82\x1e\x55\x06\xcc\x56\x25\xf3\xa7\x34\x9b\x91\xeb\x98\x32\xcf\x88\x0f\x41\x76\xea\xe3\xb5\xa2\x45\x83\x9e\x6e\x26\xad\x8e\x9b\xa4\x88\x88\xf2\xa9\x6e\xe7\xb2\x20\xdb\x70\x7a\xe6\x6d\xe9\xf2\x8b\x30\x8e\xe1\xf9\x3a\x77\xf3\xc0\xe3\x3b\xed\xfe\x16\xc5\xf1\xaf\xfa\x86\x0a\x8f\xa2\xf4\x7e\x63\x72\x2b\x97\x55\xf5\xf8\xb1\xad\x76\x31\x6f\xf6\xef\xe6\x6b\x62\x81\x6f\xf8\x5c\xb4\x21\x13\x21\xed\x9a\x32\x89\xd9\x30\x0a\xaf\x39\x36\x66\x6b\x35\x85\xf9\x2b\x6d\x5b\xa2\xd7\x45\xad\xab\x8f\x8d\x1a\x23\x0e\xee\x85\xa9\x67\xf0\xda\xce\x88\xbf\x04\xe7\xcf\x57\x6c\xb4\x34\x66\x2d\x17\xc6\xcc\xe6\x82\xb6\xe1\x38\xf4\xaf\xd8\xb8\x0d\xa7\x3f\xf5\xcb\x8d\xdd\x9e\xef\x92\x01\x7f\x23\x2e\x78\xe6\x5f\xf1\x8d\xd1\x0b\x10\x17\xbb\xdd\x85\x3a\xc7\x77\xbb\x59\xcd\x54\xc5\xbf\x60\x46\x95\x22\x82\x20\xf2\x7b\x8f\x5f\xb9\x11\xdf\x77\xbb\x2b\x65\x66\xb4\xdb\x79\x76\x34\x1e\x86\x8a\xf3\x2e\x2d\xa3\xff\xd6\x7b\xd4\x42\x7e\xd8\xe8\x42\x4f\xbd\x98\xf3\x0b\x43\x2e\xc4\x9a\x63\x02\x16\xb9\xb8\xe4\x17\x86\x6c\x88\x55\x7d\x0b\x5f\xf0\x05\x74\x6a\xfd\x0b\xc7\x96\xf8\xb3\xb8\xe8\xe7\x68\x66\x64\x45\x6f\x9f\xbb\x5d\x9f\x12\x85\x16\x06\x33\x6e\x98\x14\xcb\x66\x7c\xde\xed\xaa\xba\x25\x9f\x19\x2c\x8b\x86\x03\x13\x72\xc6\x72\x81\xbb\x9d\x57\x12\x7c\x2c\x87\x33\x05\x46\x5c\xfb\x6f\xd9\xed\x6a\x7c\xd7\x86\x78\x5e\x8b\x8b\xfe\x06\x33\x71\x19\xcb\x0b\x6d\x5d\xa3\xb8\x71\x8d\x2c\x6b\x99\xc1\xc6\x84\x42\x62\x6a\x15\xe7\xa8\xb8\x52\x25\x94\xdb\xa3\x28\x59\xe2\xc1\x87\x27\x5c\xda\xf0\x65\x2d\x95\x9c\x32\x6a\x79\x68\xa4\x2f\xa3\xb9\xca\xbe\x43\x9c\xdd\x46\x1d\x7e\xc1\xf8\xf5\x9e\xb7\x6c\x88\x3a\x11\x48\xcd\x19\xdc\x39\xb4\x85\x20\x09\x4f\x37\xc5\xdb\xeb\x44\xd2\xb3\x87\x7a\xe6\xbb\xa5\xe4\xe1\xc8\xa0\x87\x76\xb8\x99\x74\x39\x06\x86\x67\xbe\xae\x15\x98\x8f\x3d\xbf\xcb\x7c\x37\x9a\xe5\xa4\x2f\x8f\xf0\xaa\x88\x6c\x2a\x67\x1f\xd7\x69\x94\x14\xbe\x96\xb2\xc4\xe2\xe0\xaa\x94\x00\xe8\xb2\x1e\xb1\x6d\xda\x87\x4b\x11\x90\x61\x3f\x64\xb5\xad\xd2\x8a\x29\x60\x69\x0f\x20\xaa\xd5\xa0\xb5\x00\xf4\xf6\xa1\xe2\x9a\x38\xbe\x73\x6a\xd5\x8b\x3b\x2c\x40\x99\x48\xbb\x9b\xb1\xc6\x78\x0f\x1d\x56\x4d\x09\xe4\xc9\x80\xf1\xea\x48\xef\x36\x4d\xb4\x80\x71\xeb\x52\x1d\x5e\x19\x51\x1d\x02\x0f\xc5\x00\x1e\x2c\x4c\x77\x70\xa8\xd1\x46\xf0\xdb\x92\xfd\x63\x33\x1c\xdf\x3a\xa9\x31\xcd\x63\x5c\x4e\x1d\x2b\x75\x6b\x25\x1e\x11\xa8\xb6\x3e\x2d\x94\xb9\x61\x58\x38\x13\xe8\x93\x38\x3b\x12\x57\x38\xa1\x70\x72\x28\x21\x5e\x3b\xdf\x10\x8d\x28\xce\xa2\x33\xe8\x16\xc6\xdd\x97\x3c\x52\x12\x96\xd3\xd7\xef\xce\xff\x5e\x87\x66\x8b\x89\x5c\x81\x9b\x89\x55\x5d\x68\x9e\xf3\x54\xad\x4c\x65\xe4\x4a\x6e\x6d\x0e\x5c\x44\x68\xb6\x7c\xe2\xec\xb6\xf8\x6a\x2b\xf7\x22\xe6\x1f\xe8\x0e\x2b\x25\xe4\x26\xc9\x59\x77\xd7\x0e\xa1\x51\x93\x44\x01\x55\x5c\xc0\x1d\xb6\xe8\xcf\x5a\xf8\x1b\x38\x65\x3f\xc6\xff\xe8\x37\x91\x23\x05\x20\x51\xa2\x5b\x73\x22\xaa\xa7\x4f\xcf\x6b\x6d\xd2\x67\xde\x68\xdd\x8f\x92\x4f\xe9\x47\xf9\xe7\x4d\x98\xcd\xe5\xdc\x30\x65\x78\xe1\x6d\xad\xa3\xc4\x35\xf0\x5e\xac\x8d\x66\xda\x0b\xf9\x07\x97\x17\x32\xda\xe6\xfe\x3c\x50\xb8\xf9\xd6\xfd\xc5\xcc\xaa\x99\x77\x3a\xc1\x0f\xec\x2b\xb7\xc6\x97\x1f\xb1\x77\x60\x6b\x29\x8f\x4e\x07\x37\x87\xf6\xa8\x9b\xd4\x72\x33\x69\xe5\xa6\x17\xf4\x92\xad\xfd\x30\x5c\x40\x8a\x45\x3b\xe1\xa0\x86\x41\x1b\x1f\x0a\xad\x4a\xea\x05\xa6\x56\x99\x9a\x9c\x24\x46\xb0\x7f\x49\x6a\x31\xdb\xbd\x15\x84\xab\x30\xa2\x09\x4b\xe1\x74\x19\x9e\x97\xcd\x8e\x4b\xf7\xdc\x6e\xcb\x5b\xfa\xe1\xf4\xd5\x2f\xc5\x11\xc5\x9e\xb7\xed\xeb\x26\x14\x9e\x1c\x19\x4f\x2a\xaa\x4b\x8f\xf1\xa8\x30\x76\x2e\x4c\x9c\x55\xa2\x91\x0a\x27\x5d\x78\xa6\xad\xf3\x79\x64\xb6\x44\xa5\xd4\x2c\x69\x3e\xef\xf1\x57\xf0\x79\xd5\x79\x2c\x98\x9e\xce\x96\x16\x5c\x66\xfa\xc9\x17\x34\xc4\xcb\xcb\x72\x0e\xdd\x6f\xdc\x97\xcd\x51\x26\xc1\x43\xe1\x0c\x75\x5b\x72\x26\x18\x14\x96\x6d\xff\x50\x54\x6f\x6e\x59\x22\xdd\xc4\xe8\x96\x2d\x40\x4f\xc6\xb5\x40\xdf\x99\x52\x9f\xa2\x63\x57\x73\xb3\xaf\x16\x6f\x24\x70\x23\xcc\x6e\x82\xa6\xa5\x65\x5b\x2b\xe3\x55\xf3\xc1\xd7\x51\xa0\xab\x16\xe6\x92\x57\xfa\x6d\x82\x78\x8a\x03\x44\x63\xb7\x3b\x44\x13\xc6\xad\xe3\x92\xbc\x75\xb6\x8e\x25\xba\x72\x90\xb2\x5b\xc7\x84\x09\x7b\x5e\x6b\xb6\xb6\x70\x87\xb9\x14\xa5\x46\x2f\x44\xac\x10\xfb\xe1\xd7\x5c\x60\x14\xa5\x08\x79\x8e\x5b\x47\xa5\xcb\xf0\xab\x32\x0e\x85\x65\x48\x02\xdf\xe8\xb3\x55\xc9\x85\x9f\x41\xf4\x85\xe7\x73\x40\x9a\xd9\x00\xee\x1b\xf2\x04\x9f\x8f\x0a\xa8\xad\x77\xbb\xda\x44\x96\xe7\xdd\x6e\x8d\x7f\x7a\xaf\x57\x1b\x6f\xe3\xc7\x33\xfd\x0d\x58\x05\x34\x33\x3f\xc2\x4a\xa1\x90\x72\xb3\x37\x3a\xb4\xd4\xbb\x9d\x1f\x23\xe0\xdf\x26\x2e\xcf\x1f\x42\x82\xb5\x38\x90\xe3\x6f\xf8\x9c\x87\x2c\x68\xbb\xee\x0a\xf7\x8a\xde\xed\xfa\x6b\x71\xb2\xf4\x67\x7c\x83\x10\x31\x4b\xc3\xfc\xf1\x39\x63\x8c\x1f\x43\x03\xbe\x1e\xfb\x87\xba\xec\x9e\x58\x6a\x77\xd5\xbb\x97\x40\x2f\x41\xc5\x6a\xcf\x18\x0b\xfc\x36\x04\x15\x09\xaf\xae\x70\xc6\x89\xed\x14\x1b\x6e\x58\xd4\x79\xc9\x82\xc2\x62\x90\xb7\x4d\x71\x05\x3d\x2b\xcc\x8b\x45\x4c\x4b\xd0\x1b\x3b\x90\x67\xd5\x1c\xf7\xcc\x1e\x59\xce\xac\x25\xb3\x71\x68\x97\x30\xf5\xf4\x9d\x58\xc5\xb8\x84\x04\x03\xd1\xc2\xcf\xba\xdd\x21\x9e\xc1\xe9\x8d\xd0\x94\x48\x27\x83\xd2\x83\x5f\x2c\x14\xc9\xcd\xc6\x48\x0d\xa8\x32\xae\x2e\xd9\x78\x18\x0c\x46\xe1\x33\x53\x7b\x14\x1a\x6b\x4c\xad\xf3\x3b\x42\xf8\xfc\x96\x57\x97\x68\x1c\xd1\xbb\x38\x8f\x41\xa1\x58\x10\xb9\xf7\x92\x03\x6b\x78\x5c\xb1\x1a\xba\x27\xf5\x49\xde\x58\x8b\xaf\x59\xb9\x6b\x5e\x46\x73\xb2\x9c\x85\xae\xa3\x1f\x8a\x19\xed\xc8\x48\xcc\x08\x15\x73\x3c\xe7\x10\x65\xe2\x4e\x55\x6c\x38\x5d\xb7\xdb\x6d\x4d\xa6\x5b\x30\x6f\x45\xaf\x1a\x29\x16\x31\xa7\x96\x45\xc1\xa9\x65\x91\x70\xdb\xb2\x48\x2b\xbb\xe1\x7d\x9d\x23\xf4\x31\x7a\xbe\xe9\x76\xb3\xe3\x82\x87\xb6\xa1\x6b\x86\x77\xc6\xd5\xbc\x31\x3e\x2b\x95\x7a\x1b\xcd\x1c\x41\xe6\x06\x93\x0a\x3b\xa7\x83\x27\xe7\xe1\x8b\x99\x52\x28\x8f\x16\xfe\x85\x9f\xf2\x8c\xb1\x96\xf3\x2d\xe1\x99\x3d\xc9\x68\xc3\x55\x58\x9c\x82\x51\x24\x18\xe0\x5e\x28\xaa\xb7\xa0\x84\x8d\x5a\xd8\xf3\x84\x9f\x0c\x9b\x37\xc2\x8c\x8d\x0e\x30\xe7\x77\xbd\x11\x66\x7f\xf8\x46\x28\x1b\xb2\xdc\x43\x92\xdc\xa3\x33\xc2\xe3\xb2\xf5\x16\xc5\x48\x3f\xe4\x33\xb0\x23\x7b\xde\x9e\x5f\xdb\x6d\x6c\xbb\x69\x57\xb0\xa4\xec\x3d\x3f\xb8\xc0\xae\xc0\xe9\x6d\x46\x1d\x0c\xea\xaf\x2a\xbc\xa8\xed\x5e\xc3\xee\xc1\xde\x4a\xcf\x9f\x7f\xac\x95\x06\xc0\x92\x58\x56\x4e\xa3\x13\x71\x40\xfc\xba\x9d\xf7\x09\x73\x55\x47\x94\xe0\xe9\x36\xa1\x42\xeb\xd0\x7c\xb6\x5f\x44\x49\x18\xc7\x37\x0e\x48\x50\x60\xf2\xc9\xf0\x95\x40\xcd\x7c\xd4\x1f\x9f\x5b\x84\xd6\xb2\x2a\xb4\x7e\xfa\x25\x7c\x0f\x97\x7b\x1e\xaa\x30\xa2\xef\xe5\xe2\xf0\xe6\x6f\x04\xfc\xf6\x19\x31\x5b\x89\x62\xb6\x86\x83\x2a\x0b\x95\x8a\xa2\xad\x0e\xe2\x5c\x6b\xf9\xb6\x10\x97\x63\xfa\xdc\xee\x03\xfd\x35\xca\x10\x12\x2e\x45\x88\x9f\x66\x87\x2a\x97\xaf\x16\xd0\x1a\x02\xf9\x23\x2d\x10\x3e\x95\xd3\x04\x34\x50\x85\x16\xa4\x4a\xb8\xcc\x53\x5e\x15\x2b\xbb\xdd\xea\xef\xbe\x15\xb0\xa6\x99\xc5\xd8\xea\x0b\x41\xd1\xed\x16\xd5\x14\x49\x6f\x06\x2a\x47\x7f\xde\x21\x8c\xba\xdb\x55\xd3\xbc\x69\xb2\x05\xd1\xc5\x01\x44\xd7\x5e\x9a\x70\x67\x3a\x48\xc4\xd4\x25\xc1\xd5\x01\xfe\xfc\x45\x2a\xe2\x89\xff\x80\x22\x3a\x42\x53\x7c\xfb\xf3\xdb\xb3\xf3\x60\xc0\x5f\xbc\x7d\xfd\xee\xed\xd9\xab\xf3\xd3\x60\xc8\x95\xb8\x2c\x78\xc0\x89\xec\x96\xa3\xb4\x8f\xcd\x0d\x34\x1f\xc7\x9a\xa4\x06\x59\x0b\xb6\xb7\xf0\x18\xda\xc9\xd7\x38\xee\x97\xed\xc6\x7d\xd5\x15\xb5\x35\x52\xdf\x7b\xf0\xc8\x53\x4a\x77\x6c\x74\xf0\xe9\xfd\xd0\xc3\x3b\x65\x52\x57\xb1\x0f\x8b\xb1\x52\xbf\xde\xed\xf0\xa7\xd8\xed\x86\xf7\x91\x3a\xbc\x5f\x04\x70\x79\x88\xa0\x6e\x48\xdf\xd7\x5d\x3e\x43\x99\x44\xc1\x22\xc6\xeb\x64\x30\x72\x14\x47\xec\x5d\x9d\x10\x44\x6f\xfb\x46\x6e\x61\x73\x8d\x4e\x0e\x3d\x91\xa4\xc2\x8d\x3c\x2c\xb1\x28\x6e\x82\x09\x81\xa6\x99\x37\xf8\x65\xa5\xcf\x12\x8a\x61\x01\x43\xd1\xe0\xf3\x20\x51\xc9\x48\x7f\x91\x83\xdb\x03\x3b\x9f\xf8\x72\x82\xef\x29\x2f\xf4\x5f\x13\x69\xec\x64\x48\xa8\x8a\x00\xf1\x90\x13\x98\x9e\x94\xcf\xed\x55\x1d\xdc\xc3\x41\xa4\x6e\x59\x14\x74\x37\x11\x75\xf4\x01\x6b\x42\x73\xb4\xdb\xe9\x58\x8e\x18\x7c\x02\x93\x22\x83\x71\x42\x58\x2b\x37\x33\xef\x3c\xb6\x93\x4c\xfd\x2f\x75\x57\x44\xe6\x6a\xad\x88\x6c\xec\x64\xc5\x95\xac\x38\x30\x6b\x86\x1f\x78\x2a\x02\x50\xeb\x6a\x13\x09\x1f\xe5\x8d\xfa\xfd\x51\xde\x1c\x0e\xc6\x59\x1b\xb9\x1a\x29\x4f\x85\xb1\xa1\x58\xad\x8b\x1b\xbb\x87\x7f\x0a\x41\x92\x6a\x42\x05\x15\xb2\x23\x33\xe1\x23\x5b\xb7\x9d\x8f\x2d\x31\xca\xfa\xd6\x44\x43\xa4\x5f\xe7\xb1\xb9\x84\x19\x99\xd3\x08\x3a\x3b\xc3\xa1\xc7\xf5\xf8\x19\xc7\x13\x12\x8c\xe5\x5a\x75\xc2\x3a\xa4\xc7\xc8\x5a\xf5\x59\x3a\xd2\x75\x80\x96\xd7\x14\x7a\x8c\x62\x88\x92\x8f\x92\xd0\x72\x66\xe6\xe9\xcf\xd0\xf3\x8b\x66\x76\xa6\xd4\xb5\xb4\x32\x17\x91\x90\x7b\xae\x0b\x9f\xcb\xcf\xc5\x91\x92\xb9\x9a\x51\x1b\x90\xb3\xa1\x47\x15\xa4\x64\x7b\x64\x08\x3a\x69\x30\x05\x19\x8f\xf2\x0a\xec\xc0\xb5\x8a\x99\xed\x0f\x9b\x75\x1f\x9a\x71\xe7\x15\x20\xed\xf5\xc8\xdd\xe6\x70\x74\x57\x64\x72\xe0\xd8\xa3\xf5\xfe\x44\x04\xd3\xfb\x4b\x9e\x88\xad\x27\xbc\xc0\x13\x03\x8f\x7b\x01\x3e\x1e\x78\x7b\x9e\x0a\xf8\x81\x76\x82\xeb\x16\x0e\x7c\xdb\x97\x04\x27\xaf\x91\xdd\x79\xdf\x78\xbd\x74\xdf\xe6\x84\xec\xbe\x2f\x06\x3b\xf1\x80\x99\xd6\x06\x68\xc5\xe3\x68\x09\x0d\xa2\x35\xaf\x0f\xa7\x5c\x72\x32\x98\x76\xbb\xde\x37\xfa\x7b\x38\x1d\x4b\x27\xe4\xff\x03\x16\xb8\x3f\x87\xa6\x5d\x74\x34\xbd\x53\x47\x89\x38\x6d\x65\x3e\x0b\xd7\x32\x48\xf8\x26\xa1\xcf\x74\x3f\xfa\xba\x7d\xe0\x9e\x14\xdd\x6e\x49\x09\x0c\x8d\x29\x1d\x8a\x7e\x94\x37\xe3\x59\x5f\xb7\xe7\xab\x9f\x2c\x80\x5f\x7e\x0a\x0a\xf4\xf0\x51\x75\xa7\x50\xe0\x01\xd2\x0a\x34\xe0\x40\xd6\x2a\x9a\x93\x62\xdd\xed\xda\x48\xd0\x27\x42\xac\x11\x36\x8f\xde\x0e\x4a\x32\xe9\x10\xaf\x75\x85\x78\xad\xcb\x13\x07\xbf\x40\xac\xbe\xf9\x46\x37\x26\x84\x88\x2c\xf5\xf4\x71\x95\x57\x7e\x86\x8b\xf1\xa6\x87\xf3\x6d\xc0\x82\x82\xf1\xa1\x51\x9a\xe6\x2b\x31\xe0\x97\xc2\x14\x09\x8a\x9e\x8a\x9f\x58\x55\x36\x90\xcc\x6a\x3f\x2f\xc5\x60\xb4\x7c\x26\xcd\x99\xb3\xec\xf5\xd8\x42\xc8\xc9\x72\xca\xaf\xc4\x65\x2f\xf5\x17\x7c\xc9\xf8\xaa\x27\x32\x1f\xc0\x13\x4e\x9a\x97\x6a\x3a\x2e\x44\x4e\x5a\xfe\x17\x1a\x99\x3f\xf1\x1b\x71\x41\x91\x03\xd5\xb1\x77\x81\x63\xb9\x2f\x93\x22\x8b\x64\x6e\xdb\xfc\x2c\x06\xa3\x13\xff\x93\xb8\x51\x2a\xdd\x3e\x63\xfd\x79\x9a\xc8\x11\x5b\x88\x4f\xda\xe9\x82\x6d\xfb\x73\xaf\xd7\xd6\x7a\x07\x90\x5a\x41\xa8\x6e\x5c\x1b\x38\xa3\x6b\x65\x1a\x7a\x3d\x19\xea\xe1\xd8\x65\xbf\x86\x9f\x8e\xde\x5c\xb5\x31\xa8\xb5\x40\x1e\xaa\xaa\xba\xa5\x82\x82\x6f\x7c\x84\x76\xf5\xa9\x20\x5c\x91\x6c\x14\xfa\xde\xc3\xa1\xc7\xbd\x89\x5e\xbd\x8e\x3e\x70\xa7\x98\xfe\xd3\x31\xd5\x57\x4e\x37\x3b\x1f\xe5\x4d\xde\xd9\x7a\x3d\x97\x39\x90\xac\xff\xdf\x69\x94\xf8\x1e\xef\x78\xac\xe7\xed\xbd\xe0\x94\x7f\x64\xd6\xd3\xfa\xaa\xc4\xc4\x58\x63\x62\xb9\x91\x14\x4e\xc9\xf1\x20\xc8\x7c\x60\x04\xd0\x94\xb5\x10\x68\x65\xc9\x3f\x7c\xf0\x88\x91\xee\xe5\x03\xa5\x7b\x69\x48\xb7\xb2\xf6\x2a\x9d\x4c\x79\x7d\xf8\x7c\x07\x11\xba\x33\xb7\x46\xae\x14\x9b\xac\xe1\xd9\xcd\xea\x32\x8d\xbb\x5d\xfd\x17\xae\x0e\xdd\x6f\xdf\x53\xb6\x77\x7d\xa9\xaf\x01\x1e\xdb\xed\x1e\x0d\x86\x83\x87\x7f\x88\x92\xe2\x85\x3a\x85\x95\x57\x0a\x4f\xaa\x93\x6c\xca\xaa\x8a\xbf\xb6\x6f\x86\x83\xeb\x14\x44\xc1\x6f\xef\xbf\x8e\xe2\x90\x66\x3c\x13\xde\xff\xfb\x7f\xe6\x97\x77\xb8\xbf\xb7\x9c\xda\x68\xb7\x10\xe6\xc2\xe0\x30\x68\x86\x10\xf1\xe4\x36\xe6\x8d\xa7\xc2\x46\xbe\x86\xeb\x6d\xb5\xef\x92\x92\x00\xdf\x9f\x7c\xf8\xf0\x8f\x6f\xfa\x7f\xea\x8d\x7d\x36\xf9\x30\xdd\xee\x77\x38\x66\xbc\x0f\x1f\xbe\xe9\x7a\x4e\xa9\x2a\xd0\x9d\x35\x3b\x64\xfd\x3f\x8d\xfd\xb1\xf8\xf0\xe1\x83\xcf\x76\xd8\x71\x9d\x7e\x8f\x12\xa6\x38\x41\xbc\x6f\x86\xfd\x3f\x8d\x81\xb6\xdf\x78\x5a\x83\x84\xb8\x3a\x4b\x00\x68\x92\x53\x1d\x8a\xdc\x2a\x8f\xd8\xd3\xe0\x64\xe8\x18\xb2\x64\xe5\xac\xcc\x88\x7a\x94\x97\x61\xfd\xc6\xf5\xea\x65\x3e\xda\x28\x77\x2e\xd6\x73\x66\xc6\xf6\x07\xf7\x88\xf7\x21\xe9\x74\x3a\x78\x23\xf3\x7a\x3e\x3c\x67\xfe\x9a\x7c\x4c\x10\x8c\x90\xf5\xfc\x62\xec\x75\xfc\xb0\xe8\x60\xde\x16\x51\xac\xa2\xbb\x96\x53\xf2\x8f\xfe\x9f\x26\x1f\x3e\x7c\xb8\x3f\xbd\x8f\xf0\xbf\x3d\x2f\x40\xb1\x38\x4a\xa4\x16\x94\xf7\x3c\xe6\x05\x09\x20\x68\xb6\x63\xde\xb9\xbc\xe9\x78\xbd\x44\xa5\x7b\x5e\xbb\x62\xaf\xdd\xb1\xde\xff\x95\xe0\x21\xbd\xa0\x3c\x0c\x8e\x2a\x68\x7b\xff\x17\xb2\xa6\xb6\xe2\x8a\xc1\x1b\x6b\x3e\x2f\xd0\x7f\xea\x57\x60\x95\x46\xba\x73\x66\xf8\x65\xf7\x22\x3b\xe7\x3c\x11\x2f\x20\x3a\x78\x59\x56\xc7\x44\xa7\x3a\xb5\xbc\x04\xf2\x4c\xa7\x28\xc5\xa6\x57\x2f\x9d\x55\x86\x8d\x69\xd1\x04\x92\x31\xc6\x21\x82\x86\x1f\x80\xfe\x45\x9e\x6e\xb2\x99\xf5\x44\x42\x86\x8c\x7c\xcd\x71\xb6\xac\xe0\xf1\x8e\x14\x8f\x1f\x55\x14\x8f\x1f\xb3\x36\xf9\xb6\x3a\xca\xfa\xf0\xb4\xd2\x6a\x74\xf0\x3a\x5c\x77\xbb\xa9\xff\x3a\x5c\xdb\xf0\xda\xaf\xc3\x75\xb9\x99\x0e\x55\x72\xb6\x1b\x28\x36\xc1\xa8\xa5\xb2\xd6\xda\x67\xb2\x40\xf1\x33\x59\xd8\x26\xcf\x64\x71\x4b\x93\x95\x12\xb6\xc9\x66\x2a\x53\x5b\x62\xc9\xe8\xe4\x05\xdf\xfe\x3a\x5c\xf3\x4f\x4a\xe9\xff\x4c\x16\xa3\xbc\xd4\xa1\xc6\x0c\x6f\x2f\x94\xb2\x22\x3e\x11\xd1\xbe\xcc\xb3\x08\x79\x81\xb5\x02\xeb\xcf\x37\x95\xec\x0b\x8a\xe7\xad\xb2\x5a\x6c\x68\x9c\x99\xf7\x2f\x54\x8f\x7d\x58\x61\xae\x2b\x40\x3e\xc1\xd8\x48\x41\x58\xd4\xd2\x1d\xe0\x57\xc7\x81\x7f\xb2\xc0\xf7\x96\xe7\xb8\x81\x41\xd3\x67\xfc\x77\x5d\x81\x4b\x5b\xbe\xef\xf5\xe4\x9e\x7f\x6c\xc9\xea\xa8\x18\x0b\xaf\x20\xb8\x23\xe6\xd5\x1f\x32\x44\x59\xd8\x37\x66\x0e\xed\x24\xe2\x1a\xc8\x7d\x03\xab\xe9\xa2\x3e\x81\x28\x50\xe8\x02\x04\xfc\x66\x52\x4c\xeb\xf3\xe8\x94\x22\x01\x99\x2e\xd5\x36\xa5\x2e\x53\x70\xc3\xfa\xab\x70\xed\x7f\x6c\xce\x69\x09\xf0\xf3\xa4\x98\xc2\x82\x9e\x2f\x0e\x95\xa0\x26\x3f\xab\x26\xaf\x6e\x69\xf2\xb3\x6d\x52\x6d\xcc\x53\x18\x94\xbd\x10\xdb\x34\x39\x93\x87\x0c\x7c\x29\x48\x0b\x3a\x93\x98\xab\xe7\xca\xf7\x86\xdf\x7d\xa7\xa2\x0c\x18\x82\x2d\x0a\x2b\xde\x48\xc5\x60\x94\x3e\x2b\x0c\xab\x99\x9a\x67\xac\x4c\x14\x93\x14\x0e\x6f\x66\x7e\xc6\x46\x71\x05\x18\x82\xd0\xd0\xf3\xb2\x3d\x03\x5a\xd8\xfb\xd8\x30\x12\x66\xdf\xd9\x84\xb1\x02\x53\xba\xb6\xe3\x08\xde\xad\x9e\x70\xe4\xdc\x69\xe9\xf1\xd0\x69\x48\x47\xef\x81\x0b\x40\xbf\xfc\x21\x20\xd9\xb1\xbf\xc0\xd7\x6a\xd0\x0f\x3c\x9e\x39\x19\x5c\x9a\x96\xf6\xf0\x60\xfe\xa3\x5c\xa4\x99\x7c\x5d\x79\x7d\x38\xa0\x11\xb1\xa5\x1e\x07\x05\x37\xc0\x82\x84\x83\xf6\x2b\x49\x21\x37\xc3\x0f\x26\x53\x6e\x87\x00\x37\x9b\xe6\xdd\x7e\x93\x14\xc1\x60\x3f\x82\x55\x62\xca\xca\xc6\xc9\xdf\x4b\x6b\xeb\xd5\x65\xec\x76\x93\x72\x72\x94\x47\x36\xea\x92\x00\x0d\x49\x93\x83\xc3\x30\x88\xa7\xc0\x14\x95\x05\x04\x36\x14\x25\x54\xbc\x88\xa3\x6c\x22\xa0\x52\x58\xd8\x69\x43\xe3\x6b\x45\x13\x4c\x18\xea\xdb\xdb\xe9\x76\x1d\xc0\xf8\xe1\x4c\x44\xaf\x87\x0e\x93\x52\xd9\xad\xa0\x34\xaf\x51\xe9\xe6\xf0\x60\x37\x11\xe2\x61\x7f\xaa\xad\x40\xd1\xe1\xf5\x26\x5b\x4a\x6a\xc9\x79\x60\x70\x44\x18\x4a\xd9\xef\xe4\x05\x34\x05\x24\x42\x79\xbd\xdb\x64\xcb\x28\x59\x96\x46\xf2\x52\x0c\x46\xf2\xd9\xa9\xd9\x19\xd2\xec\x8c\x42\x9c\x22\xc8\x9b\x8a\x97\x63\x72\x05\x8c\x0b\x6d\x47\x0f\x8d\x88\x18\xac\x93\xea\x24\x29\xd9\xf7\x0b\x2d\x80\x3f\x2b\xc2\xd9\xc7\xe7\x88\xf9\x30\xdf\xac\x5a\xc0\x78\xde\xa8\xb4\x4b\x4c\x04\x38\x19\x9e\xc2\xf5\x45\x8a\xa3\x7f\x54\xf4\x04\x8e\x75\x69\xcf\x74\x75\xc0\xdb\xc7\x0f\xe3\x61\xe2\xd2\xbc\xcd\xf0\x48\x64\x78\x45\xbd\x98\xcb\xcb\xcd\xf2\xd5\x4b\x43\x38\x8b\x9e\xe6\x19\x2a\xdd\xf9\xf1\xe6\xd5\x4b\x98\xb1\xf1\xb6\x8c\x4a\x5f\xcd\x0c\x2a\x07\xeb\x72\xc4\x8a\x9e\x00\x53\xc3\xa5\x86\xfa\x8e\xd6\xcd\xa1\xd4\x85\x9e\x04\xb3\x99\x8e\xce\x5f\xa7\x18\x97\xac\x67\x30\xd1\x8f\x1b\x0e\x77\xd3\x52\xbb\xc6\x2f\x95\x80\x42\x9f\xf4\x82\xf6\xbc\x2c\x71\x87\xe6\x0d\x55\xb0\x55\x89\xf5\xfa\x82\xb6\x81\x03\xda\x88\x71\x6c\x3e\xec\x3a\x94\x70\xcd\x5c\xdd\xa1\x4f\x66\x3b\x94\x95\xcf\x14\x63\x77\xa0\x2a\x94\xee\x6a\x23\x21\x49\xb1\x72\x08\x6c\x50\x48\x65\x98\x66\x52\x35\x56\x08\xef\xee\x3c\xd0\x06\x83\x5c\x8c\x8b\xa0\xc1\x50\x17\x63\xc4\x29\x2a\x7b\x6e\xa8\xcd\xe6\x4e\xab\x51\xa5\xb5\xa8\xff\x3e\x4d\x0b\x60\xc7\x95\xfa\xa1\x22\x1a\xe3\x11\x1a\x49\x73\x0e\x22\xf1\x26\x4d\xce\x8a\x30\x99\x87\xd9\xfc\xb7\x30\x4b\xb4\xce\xc8\xec\xa3\xd3\x18\xbd\x51\xb4\x30\x87\x78\xf7\x4a\x63\xd9\x57\x97\x65\xb5\x13\xcc\x7e\x9c\x4c\x79\xea\xec\xae\x4c\xa8\xfd\x67\x77\x17\xae\x63\xd8\x1b\x12\x34\x1d\xbd\xf0\xb7\xb8\x05\x04\xd9\xb8\x85\x43\x27\x27\x85\x74\x0d\x0a\x8a\x71\x79\x27\xd2\x59\xe5\xd5\x47\x65\x3a\x3f\x91\xbd\x67\xa3\x8c\x24\x2f\x71\x75\x69\xa0\x56\x5d\xdb\x88\x19\x04\x13\x95\x2b\x84\x52\xdd\x8a\x9a\xfd\x8a\x18\x9d\x7b\x10\xe6\xc7\x06\x49\x8c\xd3\x13\x3d\x9c\xbc\xec\xf5\x6c\x3c\x3b\xdc\x6b\x64\x36\x7a\xcd\x33\xf8\xd8\xb2\x77\xd2\x7d\x73\xb2\x55\x1c\x0f\xbe\x4e\xd7\xb7\xae\x21\xdb\xde\x69\xf5\x4e\x93\x79\xb7\xdb\x9a\xec\xb3\x8a\x3f\xa0\x17\x7f\xd4\x1f\x10\x68\x77\x9b\x1c\xd4\xb0\x5c\x92\xc7\xc2\xaa\xf6\x67\x70\x6c\x10\x1b\xde\x09\xd6\x05\x48\x11\x4d\xd7\xf3\x6d\x9e\x7d\xe4\xa8\x1e\x52\x15\xf7\xf0\x94\x27\xa5\x37\x63\xd7\xad\xd0\xf7\x8c\x8d\x70\xa5\x73\x06\x9b\xdd\xe2\xd3\xe5\x16\x51\x0a\xb9\xe6\x22\xff\xad\xd1\x3f\xa5\x8a\x9c\x19\xd2\xc2\x70\xca\x86\x1a\xc0\x79\x8a\xa7\xda\x68\x66\xbc\x59\x91\x1a\x69\x2e\x5f\xb8\xc1\x40\xad\x8e\xd7\xa6\x34\xb0\x53\xb7\xac\x88\x5c\x40\xd7\x62\x6b\x7c\x3f\x04\x9a\x27\xfe\xa3\xef\x80\xdb\xa5\x3d\xed\xc3\xa1\x12\xba\x4d\xa6\x7c\x26\xb6\xa4\x28\xe4\x62\x8c\x7e\xd3\x00\x95\x2b\xfb\x4b\x6a\xda\x15\xe6\x81\x66\x35\xdf\xf3\xa6\x0a\x52\x4b\xb9\x99\x43\xd6\xfe\xb3\xde\x26\x95\xa9\x0f\x6f\xcf\xe7\xd2\x3c\xb0\xbb\xc5\x29\x6a\x91\x32\x9b\xa9\xa6\x1b\x93\x98\x4a\xea\x7e\x94\xf9\x8e\x6d\x2f\x0f\x61\xff\x17\xe3\x26\xf8\x2e\x4d\xe3\x28\x59\x9e\xa7\x7e\xca\xf8\xc1\x47\xc7\xa3\x0b\x4d\xd8\x77\xe2\x57\x1e\xa5\x0a\xc6\x4a\xd7\x0d\x78\xe6\x26\xf7\x0d\x2f\xc2\x04\x0e\x1b\x20\x8a\xea\x84\x9d\x19\xde\x94\x3a\x61\x8e\xd0\x51\x04\xd2\x63\x0d\xdc\xb6\xbe\xb7\x87\x0f\x1f\x90\x37\x2c\xd7\x04\xdd\x71\x2d\x04\x7f\xd3\xdb\x94\x9c\xe4\x90\x42\x65\x51\xae\xa2\x28\x8c\x04\xb7\x6a\xbd\x4d\x6c\x58\xe5\xca\x66\x45\x61\xdc\x2d\x4b\xe8\x62\x4c\x02\x44\xab\xeb\x48\xa7\xc5\x7e\x94\xbf\x4a\x2a\x78\x0f\x9a\x59\x83\x42\x95\x5b\x5b\x72\xd5\x3c\xdd\xee\xdd\xde\xd0\x38\x6e\x81\xe1\x4b\xb8\x36\x90\x88\x7b\x6e\xd3\xbc\xf6\x96\x2b\xea\x95\xee\x38\xbf\xac\x71\x17\x8c\xaa\xad\xdb\x27\x99\x1e\xf6\xfd\xa1\x0e\x9c\xc9\xe2\x8f\x36\x6e\x40\x38\x0d\xe7\x94\xa4\x1a\xdd\xfb\xcc\x25\x78\xe1\x17\xd1\xb7\xdc\xb4\x62\xa7\xb6\x82\xb9\x86\x55\x31\x14\xbe\xaa\xdb\x20\x4a\x59\x37\xd1\xe9\x8a\x36\x4d\xb7\x5b\xf9\x69\x54\x66\x78\x5d\xa9\xc1\x90\x76\xa3\x9c\xf0\xc3\x00\xec\x86\xfe\x7e\xf6\x60\x30\x4e\x7a\x5e\xc7\x87\xb4\x26\xe8\x78\xbd\xb4\xf2\xa8\x01\x59\x69\xbb\x97\x0f\xed\x70\x9c\x2e\x60\x27\x56\x6e\xab\x24\xa5\x66\x03\x25\x07\xdf\x34\x86\x0f\x19\xf9\xa5\xd3\xf4\xf6\xfb\xc7\xac\xf2\xb4\x01\xd7\x04\x76\xcb\xb5\xb0\x76\xb6\x71\xe7\xae\x84\xa0\x93\xa4\x32\xe6\x5c\xe5\xf6\xbc\xb6\x91\x1c\x68\x7a\xf5\x66\x78\x6f\x52\x1a\x79\xa6\x08\x94\x54\x4d\xb8\x61\x57\x18\x9e\x8d\xfd\xcc\x2a\x0f\x9b\xc2\xf9\xb8\x25\xcd\x78\x79\x0e\x5a\xf2\xc4\xa4\x98\x72\x52\xb1\x81\xaa\xb5\x9a\xb1\x7a\x2f\xcd\x3b\x79\xa5\xb7\xca\x23\x61\x1d\xdc\x58\x1e\x69\xbe\x25\x4f\x35\x0f\xdc\xdc\xf3\xe6\xd6\x6f\x99\xea\xb8\x41\x09\x70\x7b\xf7\x0b\x0b\xd9\xa9\x2e\x4e\x06\x3c\x55\x66\x62\xbc\x65\x67\xb7\xca\x4e\xd4\x0c\xd7\xb7\xfa\x08\x02\xe9\xac\x4d\x4f\x1b\x9d\x2f\x33\x5c\xe8\x68\xdb\x7a\x79\x4c\x0c\x3f\xa4\xec\x06\x5a\x56\x38\xa9\x37\xc9\xb3\xe6\x54\x1d\x5e\xd9\xe4\xc0\xca\x26\x53\xc6\xd5\xaa\xee\x79\x8d\xba\x54\x06\x6f\xf6\x50\x5c\x25\x36\xd8\xfd\x76\x5a\x92\x96\xe1\x2b\x47\x32\xcd\x64\x31\x99\xb2\x11\xb9\x7b\x2e\xd0\x01\xc5\xfa\x52\x07\x88\x31\x6a\x45\x28\xa0\x7f\x89\x22\x86\x85\x2a\xb8\x2c\x75\xb0\x13\xc2\x95\xfa\x0c\xd6\xc6\x53\x91\x8f\x5b\xbd\x2b\x39\x86\x42\xcb\x83\x07\x1e\x2f\x10\x7a\x84\x95\xe2\x35\xf6\x05\x21\xa0\x30\x57\x29\x68\x85\x22\x15\x70\x74\x92\xd1\xf1\x9e\xb2\x2f\xd0\x38\xb0\x2a\x78\xa8\x9f\xf8\x8f\x9f\x40\xdb\x4b\xc7\xe4\x08\x9b\xc7\x66\x5d\x37\xdd\xb1\xd4\xbb\x22\xbd\xe9\x7a\x1a\xb9\x95\xa8\xa5\x56\xf4\x91\xdd\x2a\xf3\x74\x05\x5b\xbf\xfd\x28\xf5\xc3\xf2\x60\xe3\xdb\x83\x62\x2f\xd7\x00\x3d\x84\x1e\x79\x34\x57\x97\x59\x99\xf5\x7a\xa4\xc8\xac\x61\x86\xcd\x4e\xdd\xe6\xeb\x22\x12\x1e\x82\x45\xce\x8a\x7b\xea\x81\x0b\x27\x81\x03\xb1\xe7\x75\xb4\x44\xa9\xc1\x6f\x53\x14\x37\xf4\x46\x09\x29\x5e\x52\x88\x7e\x3e\x13\xd6\x5b\x6f\xba\x02\x67\xee\x47\x96\x8e\xc2\x68\x44\xce\xc2\xd9\x95\xb4\x46\xb8\x7c\xc6\x78\xe6\xf8\x2a\x97\xad\x5c\xff\xd8\xf3\x02\xef\xd9\xc9\xbd\x7b\x5e\x2f\xea\x79\xf7\xee\xfd\xe0\x1d\xb3\x59\x03\xab\xeb\xa8\xb9\xbb\x19\xb6\x23\x6d\x31\x27\xd0\x9f\xa3\x16\xc0\x6c\x1b\xf7\x37\x49\x75\x00\x50\x93\xe4\x5f\xc7\x25\x80\x61\xf1\x2c\x56\x79\xf0\xb9\x69\xc4\xb0\xb9\xef\x3d\x44\x74\x92\x6a\x76\x51\xcb\xb6\x52\xfa\x44\x0c\x20\xe7\x1b\xa5\xa3\x54\xa4\xee\xfa\xb3\xa4\xd7\xb3\xa5\x32\x31\x80\xd6\xde\x28\x1e\xd5\x83\xfc\x65\x54\x6a\x94\xdc\xcb\x7e\x18\x8c\x98\x14\xd2\xcd\xe7\xc9\xbd\x7b\x0a\xca\x28\xbb\x97\x20\xbf\x10\x45\x25\x3f\xa3\x7c\xc2\xd0\x51\x78\xef\xde\x48\x31\xfe\xd2\x51\xbd\xec\xc8\x51\x1d\x6e\x0d\x8e\xe5\x1f\x70\x32\xd6\x19\xfd\x23\x33\xf5\xfd\xf1\x99\xfa\x9e\x66\x4a\x39\x72\x84\xd8\x58\x94\x37\x61\x18\x7b\xb5\x76\xe2\x64\x58\xe1\x7f\x0c\xea\x1c\xe9\xc5\x23\xa8\x88\x57\x20\x59\x00\xa1\xc1\x05\x33\x49\x29\xdc\x48\xca\x11\x4b\x8d\x48\x9a\xd7\xa6\x46\x73\x21\xaa\xdb\x99\xb5\x41\x1a\x65\xf7\xee\x75\xd4\xfc\xfb\x30\xc7\xe5\xde\x2c\x5c\x17\x9b\x4c\xce\x3d\xf0\x2d\x65\x0c\xb8\xb2\x7c\xaf\x67\xcb\x5e\x6e\x2e\x11\x8a\x16\x45\xcb\x91\x45\x44\x5d\x60\x31\x63\x3b\x17\xc2\xed\x6e\x31\xd6\x18\x4a\x6e\xa9\x55\x7f\xbb\x5d\xa8\xec\x86\x23\x16\x1d\xec\xb6\x81\x91\xa3\x02\x04\xd9\xba\x82\xe5\x4c\xea\x4b\xae\xc6\x39\x53\xe3\xd4\x51\x1c\x23\xd3\x77\x44\x71\x4c\xfc\x68\x32\x73\xfb\x9e\x31\x2a\x6a\x5d\x3e\xce\x68\x4a\x12\x3f\x9f\xcc\x2a\x53\x12\xd3\x83\xb5\xd6\x7d\x1c\x41\x4a\xe1\x1e\x1b\xdb\x28\x7f\x9e\xcc\x64\x5e\xa4\x59\x90\xe1\xf6\xfd\x4b\x7a\x8d\xc0\xa6\xe9\x6a\x95\x26\x36\x27\x75\xa4\xac\x44\x27\x82\x98\x17\x59\x08\x5f\x8d\xf2\xfc\x3a\x7d\x77\x15\xe6\x32\x08\x6d\xd2\x29\xce\xdb\x5f\x64\xf8\x49\x06\xd1\xfe\xae\x27\x13\x29\x87\x93\x6c\xe2\xf1\x63\x2d\x9b\xc0\x49\x15\xd1\x49\x05\x3d\xa0\x27\x0f\xab\x6a\x40\x0f\x1f\x32\x7e\xeb\xf9\x65\x6c\x66\xd0\x66\xb2\x84\x6c\x16\x01\xfc\x4c\xea\xdd\x8f\x34\x3a\xb5\xe8\x57\xc5\x8f\x1c\xb5\x1b\xa7\x39\x18\x26\x4d\xf5\xdd\xaa\x33\x9d\x84\x76\xb4\xc6\xe9\x9e\x8d\x32\x7f\xf6\xc5\x67\x5f\x56\x3b\xfb\x78\x6c\x0f\x2f\xf5\xe2\xd6\xf1\x7a\x19\x4e\x2c\x3e\x13\x5e\xe7\x7e\x99\xa1\xcf\x30\x77\x18\x59\x73\xa8\x05\x3f\x74\xc8\x6d\x9a\x87\xdc\xdc\xfa\xdf\xa3\xe1\xfa\x31\xfc\xb4\xd5\x13\x67\x70\xda\x16\xfa\x26\xd9\x54\xff\x29\x0b\x97\x2b\x72\xbc\x41\x14\x2f\xec\x2b\x9e\x4d\x3d\xdb\xfa\x0b\x1e\xfa\x73\xc6\x1a\x0b\xd7\xed\x36\x8a\x19\xd0\x58\x57\x7b\x24\xb9\x75\x18\x3c\xa9\xd4\x6b\xad\xe1\x25\xbc\xe5\x2c\x9e\x9b\x36\x6b\x6b\xb9\xe6\x0b\xb5\x95\xae\x44\xde\x6c\x60\x74\xfc\xe4\xbe\x32\x07\x77\xac\x0f\xee\xde\x55\x8f\x12\x66\xb7\x9f\xe4\x46\x7a\x29\xad\x5d\x76\x15\xbb\x0f\xe1\x3c\xdd\x9a\x81\xe8\x58\xfa\xc4\x56\x77\xfa\xbd\xad\xa7\x10\x47\x44\xf6\xa7\x0e\x03\xe1\x23\x56\x25\xdd\x1a\x5e\xca\x38\x5a\x45\x85\x9c\x03\x86\x0f\xb3\x4f\x9e\x4e\x86\x53\x6e\x83\x47\xb7\x71\x1d\x15\x53\x1a\x67\x33\xa0\x6f\x8e\x48\x16\x17\xea\xb6\xf9\xb7\x3a\x98\x74\xff\x6d\x67\x5c\xf0\x60\xa3\x14\x2b\xcf\xa2\xcb\x38\x4a\x96\xa3\x91\x23\xf8\x4d\x10\x2d\xe0\x91\xf1\x5f\xa0\x77\x81\x61\xca\xf9\x13\xf8\xaf\xee\x27\x64\xcf\xd7\xed\xd6\x36\x8f\xcd\x55\x31\xbe\xd8\xb6\xad\x8b\x22\xa1\xe0\xba\x89\x48\xdc\x4e\x98\x13\x5d\x8a\x49\xb9\xe3\xd0\xff\x56\x44\x9b\xf2\xe6\x1c\x09\xc9\xe5\x71\x9e\xec\x8b\xc9\x0f\x8f\x6e\xe3\xe2\xbe\x28\x38\xed\x71\x51\x76\x43\xf0\x4c\xc4\x5d\x0b\x9e\x55\x74\xa6\xdc\x06\x94\x8b\xfe\x29\x83\x88\x63\x28\x15\xfc\x59\xf7\xa3\x5c\x99\xfe\xdb\x98\x8e\xb0\xba\xdf\x43\xc3\xa5\xad\x66\xdc\x5f\xc4\x9b\xfc\x4a\xd5\x90\x64\xd7\x9a\x6b\xc3\xd6\x18\xfc\xad\x98\xcc\x78\x3e\x6d\x88\x7d\xef\x2e\xd5\xde\xe0\x1e\x87\x71\xcd\x95\x88\x3d\xe5\x6b\xb1\x6d\xf4\x10\x5a\x0e\x97\x95\x2e\x34\xc8\xba\x63\xa6\x2c\x5a\xc6\x68\x28\x4b\xeb\xf0\x07\x3c\x1c\x4b\xbf\x04\x13\xcc\x8d\x7b\x27\x04\x08\xc1\x2a\x97\x79\x95\x57\x9a\xf5\xdd\xd7\x96\xde\xaa\x47\x55\x16\xa7\xce\x1d\x2b\xaa\x51\x88\x79\xeb\xd6\x84\xe2\xa3\xd5\x45\x40\xae\x19\x94\x2a\xfe\x02\x2b\x9d\x5b\xce\xe2\x27\x0a\x39\xe6\x27\x6c\xf4\x7f\x1a\x0c\xb0\xc2\x33\xe3\x1a\x06\x52\x73\xf3\x16\x92\x28\x87\xb2\xa7\x9f\x9c\xfb\x5e\x48\xfc\x0b\xd8\xb0\xd6\x50\xba\x0b\x5f\xba\xf5\xd0\xcb\xa3\x3d\x2a\x14\x87\x32\x9a\xa7\x1d\x59\x02\xd7\x4c\x5d\x86\xac\x0c\x4a\x74\x19\x1b\x5d\x5f\x45\x31\x14\x08\xed\x75\x20\x86\x37\xac\x67\x6e\x25\x62\xdc\x62\xf0\x77\xc2\xc9\x98\xc4\x53\xbe\xea\x93\xf7\xb1\x73\x1a\xa7\x2f\x2b\x43\x86\x9f\x39\xb7\xdf\xbc\x3e\x0e\x87\xb7\x0d\xcb\xd1\x5e\xf9\xd7\x51\x32\x4f\xaf\xd9\x48\xfa\xae\xf3\x55\xd2\xaf\x7e\xf8\x14\x8c\x55\xe2\x7f\xf7\x14\x6a\xd5\xfa\x71\x68\x4e\x8c\xd7\x9a\xf6\x2c\xbc\xaf\x3e\x7a\x04\x1f\x8f\x89\x3f\xfc\x0e\x9e\xff\xfd\xcc\xe5\x60\xda\xdf\x62\x9a\x6b\x56\x12\x26\xa7\xdb\x4e\x6a\x7d\x9e\xa0\x3a\xc2\xf8\xa6\xfa\x1e\x93\xf1\x4d\xbf\xb8\x4e\x9f\x93\x8f\x73\x64\xc8\x4c\x6f\xc9\x95\xd8\x5e\xc8\x24\x04\xbb\x1c\x9c\x0c\x78\x6d\x3a\x03\xd5\xd0\x6f\xaf\xde\xbc\x7c\xfb\xdb\xc5\xcf\xcf\xdf\xbc\xfc\xe5\x34\x98\xf5\x67\x61\xf2\x6b\x2e\x5f\xbe\x7d\x3d\xd6\xd3\xa4\x4b\xe5\xb2\xf8\xb9\x5a\xd9\x0e\x4c\xb2\x6d\x63\xa5\x60\x19\x94\xcb\xe2\x94\xda\xae\x95\xa5\x2e\x89\x93\x13\x18\x10\xe5\x8d\x52\x96\xb6\x94\x65\xf7\xe0\xa9\xd7\x3f\x6a\xd6\x5f\x4d\x53\x50\xdf\xb8\x54\x27\x19\xe7\xe4\xa6\x1c\x8e\xac\xf8\x4a\x29\xd4\x82\xf4\xa8\x5a\x9a\xf2\x51\xa0\x01\x23\x76\x05\xe8\x17\x74\x55\xb8\x15\x36\xdd\x29\xee\x0c\x7c\x95\x26\x51\x91\x66\x67\x33\x3c\xb8\xa9\x53\xd3\x81\x6e\x70\x32\xac\x54\x1d\xd9\x11\xe8\x25\xe0\x5e\xae\x6a\x7b\x4a\x15\xb4\xd2\x64\xa5\xa7\xea\x8c\x2f\xe7\xcc\x08\x18\x95\xe7\x03\x85\x16\x73\x8c\x47\xab\x7a\xaf\xfb\x55\x7a\xec\xc7\x3c\x29\x2d\xb2\x11\x2d\x25\x96\x61\x0e\xb7\x00\xfb\x6a\x94\x9f\xbb\xc6\x41\x7a\x02\x4a\xb0\xd5\xe3\x38\x34\x9f\x12\x98\xac\xc6\x61\xbc\xcc\x8f\xfd\x66\x9a\x22\xec\x88\xa4\xd4\x08\x1e\x04\xc1\xa5\x4e\x6b\xad\xb0\xdf\x43\xf8\xad\xcd\xb4\x55\x81\xb1\x5f\xf9\xe9\x7b\x69\xe2\xf5\xd0\xa1\x76\xd0\x73\xd9\x52\x74\xbf\xb7\x02\x4c\x4e\xb8\xf0\xaf\x18\xde\xe0\x4b\x87\x37\x50\xc3\x33\x75\x52\x58\x2c\x92\xee\xca\x4b\x1d\x85\xd5\x85\xb3\xdf\x1f\x96\x91\x1e\x3a\xf2\x5c\xd5\x5b\xd9\xff\x4d\x61\x62\xb7\x5b\x79\xbb\x35\xc9\xe3\x2d\xa2\x90\xae\xc3\xa5\xfc\xdb\xdb\xc5\x22\x97\x08\xee\xd3\x9f\xd3\x0d\xc7\x7e\x10\x47\xde\xd7\xc8\xfc\x8b\x5c\x14\xfc\x86\xaa\xfd\xfd\xce\xd5\xce\xd3\xf5\x3e\x50\xcd\xd5\xc0\x94\xd9\x87\x2d\x43\x0f\x22\xeb\xc3\xc7\xc0\xd6\xc4\xff\x8e\x18\xb3\xef\xbe\xd7\x8c\x99\x0a\xaf\x19\x19\x4f\xe7\xea\x7c\x18\x3c\x30\xbe\xcf\xc9\x3f\x37\x0e\x84\xb9\xd8\x96\xec\x68\x58\x5a\x85\xf2\x97\x6f\x5f\xbf\xcb\xd2\xb5\xfc\xff\xb1\xf7\xa7\xdd\x6d\xe3\xca\xc2\x28\xfc\xfd\xfd\x15\x0a\xf7\x69\x87\x6c\x83\xb2\x28\xc9\x13\xd5\x8c\x5f\xc7\x76\x62\x77\xec\xc4\xf1\x90\xa1\x1d\x6f\x2d\x8a\x84\x24\xc6\x14\xc9\x70\xf0\x10\x59\xff\xfd\xae\xc2\x44\x80\xa2\x64\xa7\x77\x9f\xfb\x3c\xe7\xae\xb3\xb3\xdb\x22\x0a\x05\xa0\x50\x28\x00\x85\xa9\x2a\xcd\x1f\xec\x58\x82\xab\xaf\x50\xed\x40\x8e\x82\xb6\x3e\x0d\x8b\x51\x10\x1d\x16\x03\x3b\xad\x8f\xba\xcc\x83\x30\xb3\xc3\x6a\xe4\xc1\x24\xc8\x73\x9c\xda\x99\x14\x01\xab\x90\xb2\x28\x4f\x8a\x61\x7d\xdf\x2e\x4a\xd8\xdf\x74\xbf\x8d\x9f\x52\x7d\x97\xdc\xe2\xe0\xb7\x35\x52\xf5\x66\x84\x13\x4a\xe3\x96\x64\xc3\xaf\xba\x30\x77\xf0\x9c\x5a\xbd\x45\x5b\x0f\xa6\xee\x80\xb7\x19\x69\xbc\x2e\xdf\x35\xd9\x20\xc7\x08\x1b\xf4\xed\x14\xbb\x2f\xe0\x2b\x0a\x34\x31\xde\x40\xdd\xcb\x07\x71\x04\x76\x44\xd2\x89\x0b\xb5\x65\x8a\x35\xd0\x0b\x73\x33\x16\x38\x70\xdb\x5b\xce\x61\x6e\xd1\x17\x34\xc5\x64\x57\x1a\xaa\x08\x9a\xe5\x44\xa9\xc3\x28\x87\x67\xac\x04\x91\x1e\xde\xda\xca\x58\xf0\xf6\x18\x0d\x17\x95\x55\xc7\x4b\xa0\x15\xe7\xba\x31\x97\xf5\x02\xf4\x28\xce\x83\xe1\xc3\x6e\x18\xc2\xad\x26\x34\x76\xae\xc0\xf0\xf4\xf0\x1a\x4d\x7e\xe1\xba\xcb\xf8\x99\xd7\x5d\xea\x08\x78\xc6\x0d\x98\x62\xd1\xd5\x96\x25\xd9\x2a\x36\x64\xeb\xae\xc0\x94\x62\xac\xa6\xe3\x98\xe4\x14\xac\x5e\xbb\x0b\xc5\x94\x59\x97\x83\x81\xea\xa0\x44\xd5\x9b\xcd\x2a\xab\x2f\x0f\x4d\x60\x9f\xe6\x9f\xb9\x74\x23\xf8\x11\xea\x8b\x46\x56\x84\xcb\x85\xa9\xd5\xed\x32\x7f\x72\x5d\xe2\x4f\x2e\xd2\xb7\x79\x27\x02\x85\x38\x73\xa6\x63\x37\x13\x12\xbf\xe7\x26\xee\x20\x08\x83\x3c\xc0\x59\x8d\x56\x03\x0e\xdb\xc8\x66\xc1\x7b\x77\xa2\x7c\x37\xf3\x18\x36\x58\xd3\x3d\x60\x97\xe8\x09\x70\xa2\xac\x05\x51\x52\xc0\x75\x37\x27\x5f\x59\xd1\x60\xc3\x0e\xbe\xe9\x33\xa8\xc7\x47\x02\x70\x53\xec\x02\x30\x87\x70\x5a\x60\xf8\x66\xb6\x82\xa3\xfc\xc0\x0f\x88\xdd\x62\x76\xf5\xb8\xa6\xfb\xd6\x74\x4b\x41\xc2\x74\x18\x7b\x45\x86\x89\x85\x18\x1b\xa3\x8c\x27\x3f\x23\xee\xdb\xb3\xe6\xa2\xba\xeb\xd8\xd8\x51\xc7\x0b\xb8\x3e\x43\x1b\x17\x55\x87\x89\x1a\x46\x05\x3a\xac\xb9\x70\x53\x2a\x1e\xa5\x0e\x6e\xaa\x04\x70\x13\x78\x70\xb4\x0b\x86\xf0\x16\x93\x03\xf1\x19\x8c\x18\x22\x5a\x8f\x10\xdc\xe5\xd4\x23\xa3\xc2\x99\x79\x6a\x60\x23\x4a\x13\x25\x9f\xe7\x6e\x9a\x93\xe3\x0d\x23\x77\xa6\x19\x84\x6c\x89\x30\x12\x8d\x70\xe4\xcb\xc0\x83\xc8\x9f\x09\xf7\x2e\x42\xea\x44\xb4\x2a\x15\x65\x8b\x2f\x92\x0f\xae\xc7\xce\xe7\xc4\x36\x3c\x49\xf3\x80\x65\x1d\xb6\xb4\x66\x72\xad\x1b\xcc\xe8\xb1\x20\xdc\x8c\x88\xb7\x5a\x42\xb4\xae\x79\x63\x37\x75\xbd\x1c\x7c\x33\x99\x18\x6e\x07\x14\xfc\x15\xb5\x41\x6a\xc4\xb0\xe1\x6e\xe7\x32\xdc\x19\xb7\x87\x4b\xbd\xef\x51\x4d\x46\xbe\x72\x93\x3f\x3e\xb2\xf2\x5b\x24\x5f\xb8\xff\x9f\xd5\x37\x41\x79\x2b\x20\x07\x53\x67\x69\x0e\x16\xf0\x9a\x38\x22\x4f\xc0\xc5\x6d\xcf\x78\x65\x45\x8f\x9d\xc8\x40\xb5\xcd\x54\x6d\x1d\x27\x42\x12\xe8\x20\xf2\x99\xa7\xdd\x80\xb8\x0e\x56\xeb\xf2\x8f\xb7\x5a\xe8\x60\x69\x57\x9a\x37\x14\x18\x07\x0c\x43\x37\xc9\x30\xb1\xcf\x1d\x2e\x68\x95\x88\x47\x55\x9b\x20\x36\x49\x14\xa5\x4d\x67\xfc\x4f\x9b\x99\xc4\x7d\x58\x2a\xc9\xba\x4c\xf6\x0b\x63\x26\x3f\x67\x60\xed\x07\xe7\x96\xd1\xca\x0a\x98\x43\x89\x6b\x2f\x6e\xcd\x33\x8b\xec\xf3\x28\xc2\x09\xf7\xe5\x9b\x30\x7c\x31\x56\x83\xba\xd9\xf4\x8b\x24\x0c\x3c\xb0\x88\x07\x57\x37\xa0\xa6\x17\x31\x13\x5e\xe6\xc0\x07\x91\x5a\x1d\x44\xfe\x29\x4c\x73\xba\x76\x10\xf9\x44\x7b\x4a\x73\x8d\x5f\x4f\x0a\x9d\x54\xc9\xd8\x75\xc2\xd5\x98\x0f\x68\x44\x88\xec\x90\xc8\x9d\x3b\xab\xdd\xfd\xa1\x6b\x4c\x65\xf4\x5a\x59\xa9\x01\xea\xf4\x96\x57\xfe\xf8\xd8\x82\xd1\xb7\x99\x42\x63\x92\xbb\x07\x73\x2e\x42\xa1\xf6\x6e\xe4\x8d\x63\xe2\x18\x1f\xa5\x22\x48\x9b\x07\x0e\x9e\xe9\x58\x47\xa2\x5d\x1e\x62\xb1\x01\x35\x82\x45\x18\xb7\x9b\xeb\xcc\x33\x62\x40\x3b\x84\xb8\xb7\x20\xf6\xa8\x51\x00\x1d\x64\x1e\x5e\x7d\xac\x4c\x6f\xa5\x41\x8b\x65\x4e\xac\x2b\x04\x56\xc8\x93\x89\x53\x48\x03\xe5\x31\xdb\x69\xd9\x41\x69\x6d\xc2\xe0\x6c\x2f\x9c\xa0\xe9\x85\x71\xc4\x1b\xbc\x57\x30\x71\x80\x12\x80\x3a\x78\x52\x04\x2d\x5a\xb0\x16\xd5\xab\x35\x42\x0c\xc0\xca\x62\x9b\xaa\xb1\x5e\x54\xf1\x18\x80\x51\x5b\x28\xd5\xa7\x41\x4e\x6e\xe2\xf8\x3b\x2d\xbb\xa8\x21\x77\xe8\x24\xab\x1e\x1a\x97\xb2\xab\x0e\xa5\x63\x20\x92\xc8\x19\x9d\x39\xc6\x9c\xe8\x90\x1b\x4f\x9d\x38\x63\xd1\x8b\x7d\x55\xdc\x26\x3b\x43\x3b\x21\x22\x37\xd9\x49\xec\xa1\x24\x76\xae\x34\xc4\xa1\x18\xa5\x35\x5d\x47\x25\x44\xe9\x22\x62\x00\xcc\xa1\x8e\x3b\xba\x3c\x4a\x46\x60\x03\x84\x04\x5e\x49\xb1\x38\xf2\xc9\x08\x4a\x22\x0c\x5b\x49\x41\xd0\x0c\xb4\xb0\xdf\x2d\x1c\x93\x96\x74\xc9\x94\x27\xac\x1d\xb1\xd2\x72\xc4\x12\x1c\x09\x28\x47\x82\xa1\x5e\xd3\xe5\xf8\x64\x50\xdb\x1b\xe1\x92\xc7\x55\xa1\x1b\xd7\xbc\x49\xd3\x72\x60\x17\xb5\x34\xe4\x57\x02\xa4\xc6\x3b\xa9\x2d\xa1\x11\x06\xb1\xeb\x9b\x4d\x7c\x9f\x63\x78\xdf\x90\xbe\x12\x3b\xf1\x70\x3d\x04\x4c\x26\xb8\x6c\x9f\xd4\xd3\x31\x88\x43\x06\xcf\x82\xc0\x98\x47\x30\xd4\x83\x95\x95\x8c\xa2\xfb\x8b\xa4\xc9\x2f\xa5\x29\x20\xfd\x16\x05\xe0\xb1\x90\xf4\xa8\x88\x6d\x71\xec\x86\x21\x41\xcf\xc0\xdc\xdd\xab\x70\x47\x8f\x60\x23\x88\x80\x74\x1f\x1e\xa4\x52\xea\xf4\x8c\x66\x90\xf1\x0c\x0c\x5b\xf7\xb9\x70\x56\xe3\x90\x92\x07\x9c\xca\xf1\x4b\x00\x5d\xb6\xcb\x6b\xc1\x4a\x1f\x56\x80\xeb\x64\x01\x98\x95\xdb\x9f\xe0\x5a\x8e\x33\x1b\xf4\x1f\x5e\xb5\x95\x95\x17\xba\x26\xb7\x04\x44\xb2\x1d\x65\x58\x00\x96\x2a\x80\xed\xef\xa4\x76\x88\xca\x59\xc9\xf6\x77\x5c\x3b\x58\x72\xfe\xb0\x68\x4e\x12\x2f\xe5\x7a\x44\x8d\x1e\x06\x69\x46\x1f\xc0\xd1\xdb\x39\x52\x98\x0d\x75\x78\x56\x77\x72\xc1\xae\xe1\xc8\x67\x72\xe2\xc0\x51\x06\x92\xab\x39\xe5\x29\xc5\x6c\xee\xf4\x81\xef\xe7\xc3\x8a\x01\x5e\xd5\x3a\x2d\xe2\xe8\x02\x1e\x11\x05\x43\xbd\x03\x66\xbe\xc4\x30\x4c\x40\x30\x27\xd1\x59\x8a\x8d\x84\x5c\x66\xc3\x3f\x40\xd1\x77\x5f\x89\x8b\x41\x53\x48\x67\xa7\x88\xb6\xae\x9d\x9b\xe1\xac\x17\x3a\xee\x0c\x8a\x82\x43\x05\x63\x36\x5b\xb8\x93\xf5\xc4\xa4\xce\x27\x02\xf0\xd0\xf6\xf8\xf8\x02\xde\xf8\xeb\x30\xb7\xe7\x8f\x8f\x2f\xe0\x62\x22\x5c\x37\xd5\x73\x83\xde\x75\x91\x4e\x69\x0c\x5b\x83\xd5\x85\x1b\x44\x19\xb4\x35\xde\xa1\xab\x0d\x08\xc3\xf5\xda\x17\x2f\x20\x3c\x01\x7c\x7e\xa8\x7f\x1a\x67\x01\x10\xb5\xb2\xf2\xe2\x85\x6e\x6d\xac\x2c\x44\x80\x6b\xb2\x92\x33\x6f\xab\xab\x3c\xab\xf9\x5b\xeb\x3c\x5a\x93\x0e\x57\xcc\xc8\x4c\x58\xe6\xbf\xb5\x24\xff\x45\xb9\x4b\x0b\x15\xa8\xbb\x72\xfb\xe1\xf1\x11\xdb\xbc\x67\x10\xa5\xc7\xa7\x9b\x8f\x9f\x02\x7c\xf7\xf8\x48\xbb\x45\x4f\x61\x7b\x9d\x39\x97\xa8\x09\x72\xb6\xa3\xec\x2d\x52\x98\x64\x12\x8e\x5f\x27\x5d\x59\x99\xb7\xb1\x21\xaa\x5a\x3a\x84\xac\x44\xc2\xb2\x02\x3c\x57\x2c\xda\x1a\x5c\x5a\x79\x38\x9a\x07\xeb\x4f\xaa\xc5\x28\x96\x3f\xaf\xfe\x0e\xff\x60\xdb\xc3\x06\x92\xb0\x4b\x6a\x78\x8f\x03\x6d\x44\x76\x8e\x8c\x9b\xb0\xa3\x72\xcb\x77\xb5\x60\x2b\x74\x10\xfb\x0f\x4c\x8b\xc9\x45\xfb\x32\xf0\xb3\x6b\xc2\xde\x76\xdd\x87\x41\x74\x63\x6b\xe3\x3c\x4f\xec\xb5\xb5\xbb\xbb\xbb\xe6\x5d\xa7\x19\xa7\xa3\x35\x6b\x7b\x7b\x7b\x8d\xc4\x6a\xe8\x7e\x12\xd6\xa1\x7c\x39\x39\x06\xb4\xad\x35\x78\x5c\x90\x25\xae\x87\x89\x5d\xb3\xa9\xeb\x79\x38\xca\x0f\x71\x30\x1a\xe7\xb6\x46\x43\xe6\x98\x04\x35\xe4\x7a\x5e\x31\x29\x42\xb8\xf5\xdc\x42\xae\xef\x07\x50\x39\xbb\x85\xdc\x30\x18\x45\x50\xc3\xd7\x6e\x86\xe1\x49\x9f\xad\x09\x90\x39\x60\x30\x0d\xb9\x61\x18\xdf\x9d\xe1\x18\x3c\xb7\xd9\x9a\x1c\x82\xb8\x64\xec\x0e\x70\x1e\x78\x90\xdf\x24\x09\x83\xbc\xf0\x49\x31\xa9\x3b\x08\xbc\x37\x71\x3a\xb1\x35\xfa\x6d\xc2\xba\x5f\x43\x6e\x06\xb4\x01\x46\x9e\xa7\xc1\xa0\xc8\xc9\x82\xc5\xd6\x94\xa0\x56\xc6\x42\xaf\x91\x62\x21\xa8\x21\xb7\xc8\xe3\x33\x4c\x2e\x76\xd9\x9a\x14\xd0\x90\xfb\x33\x98\x14\xf9\xd8\x6e\x21\xa8\xc0\x9b\x14\xff\x28\x70\xe4\x3d\xd8\x9a\x12\xd4\x48\xec\x69\x1a\xc3\x2b\x47\x5b\x93\x02\x34\x06\x98\x71\x3e\x0e\x86\x39\x8d\x83\xa0\x99\x41\x58\x43\x83\x41\x7c\x0f\xb9\xe3\x51\x10\xc1\x6f\xe0\x82\xa7\xc6\xc1\x83\xdd\x42\x9e\x1b\x7a\x27\x30\x66\x6a\xfc\x4b\x83\x73\x09\xde\x2a\x9e\x9b\x88\x26\xf1\xc2\x20\x81\x14\x61\x90\x9c\xba\xf9\xd8\xd6\x00\x60\x26\x6e\x3e\xd6\x08\xf0\xac\x00\xba\xe0\xcb\x4c\x8b\x10\x6b\x02\xf3\x32\x0a\xf2\xcc\xd6\x94\xa0\x86\xbc\x38\x8c\x53\x72\xa5\x3c\x89\x43\xba\xb7\xa2\x11\x98\x19\xc8\xc0\x3a\xc4\x37\x41\x98\xc3\x75\x82\x3a\x7c\x73\x48\x23\x59\x3a\xc1\x2f\x12\x32\x13\xce\x31\x12\xa4\x76\xb8\x83\x68\xc4\xa3\x53\x0e\x00\x04\x32\xd3\x9c\x7b\x69\x90\x10\x67\x17\xb6\x36\x07\x2a\xb1\xf2\x87\x10\xab\x48\x1c\xa2\x21\xaf\x48\xb3\x38\x05\xce\x41\x23\x78\xc0\x75\x1f\xfe\xc3\x1e\x0e\xc1\x8c\x14\x88\x9e\x8f\xb9\x88\xf9\xc1\x70\x08\x7b\xd4\x60\xf1\xd6\x8d\x72\x5b\xab\x00\x34\xe4\x07\x29\x5b\xf9\x03\x36\x79\x30\x0b\x39\x04\xb7\x01\x2d\xc6\x8f\x27\x41\xe4\xca\x3d\x84\x43\xa4\x0e\xe2\x17\x04\x15\x28\xf2\x81\x22\xec\x8f\x30\xb4\xbe\xad\xf1\x2f\x0d\xe1\x10\xdf\xba\xac\x20\x7a\xe8\xfa\xda\xf5\x6e\x46\x69\x5c\x44\xbe\xad\x51\x88\x39\x10\x20\x8d\xe8\xec\x2d\x04\x43\x24\xb9\x58\x03\x9f\xf4\xc5\xc0\x19\xa6\xaf\x77\xb3\x33\xfc\xa3\x08\x52\x0c\xc9\x17\x45\x69\xf0\xa6\x37\xb4\x5b\xe4\xe7\x43\xe2\x7a\x41\xfe\x60\x6b\x10\x30\x63\x1a\xa2\x18\x54\xdc\xe0\x8b\x89\x1b\x6d\x78\x9a\x30\xc7\xe9\x19\xce\x48\x34\xfd\xe4\xd1\x4c\x16\xa5\x80\x86\x86\x61\x1c\xfb\x7b\x20\x10\xb6\x46\xbe\x4d\x22\x0d\x2c\xa2\x24\x81\x44\x95\x34\xc0\x9a\x13\x58\x00\x25\xc6\x51\xfe\xc6\x9d\x04\x21\xa0\xc5\x51\x6e\x0e\x49\x40\x23\x11\xe7\xb0\x9b\x4f\xc1\x59\xf0\x13\x97\xc0\x5d\xff\x7b\x91\xe5\x52\x94\xe9\x12\x08\xc3\xc8\x53\x9c\x7b\x63\x1e\x4d\x43\x3c\xea\x21\x14\x59\x82\xa0\x51\xf0\x27\x37\x0d\x88\xc8\x40\xc0\xbc\xa5\x21\x1a\xf5\x99\xf5\x67\xf8\x36\xef\x58\x87\x86\xc1\xcd\x05\x99\x03\x23\x4a\xf0\x03\xd2\x30\x04\x69\x18\x59\xf0\xa7\x0d\x7f\xc2\x87\x64\x4c\x47\x3b\xf2\x69\xc2\x20\xae\x51\xf0\x87\x34\xc0\x11\x3c\xa8\x8d\xa3\xc3\x38\x0d\x7e\x82\x96\x13\x72\xbc\xb8\x8c\x34\xc7\x22\x76\x3e\xe5\x27\x9c\xe6\x81\x57\x9f\xee\x96\xc5\xb1\x54\x60\x9a\x5a\xe3\x5f\x1a\x1a\xa5\xae\x0f\xb8\xe4\xa0\x08\xea\x62\x6b\x73\xa0\x12\x8b\x35\xbc\x12\xd4\xd0\xd8\x8d\xc0\x14\x87\xdd\x42\x84\xc6\x5d\xff\xf6\x8b\xad\x91\x4f\xd3\xf5\x6f\xcd\x7b\x8d\xc2\x3f\xa4\xc1\x28\x88\x44\x54\x4c\x82\x10\x1b\xf8\x38\x1e\xa5\x6e\x32\x26\x93\x49\x00\xde\x8f\xa5\x41\x85\x84\xe5\x41\x85\x0c\xbf\x41\x04\x8c\x25\x83\x9c\x87\x13\xe0\xff\x0d\xfc\x07\x2c\xbf\x81\x98\x9b\x0e\xfc\xe9\xc2\x1f\x9c\x46\x38\x3c\x71\xf3\x34\xb8\xb7\x35\x39\xa4\xb1\x38\xa8\xc6\x31\xd1\x85\x6d\xad\x0a\xa1\x38\x40\x08\xe4\xf4\x40\xd6\x9b\x99\xad\x89\x4f\x88\x7f\x38\x4f\x60\x88\xa0\x60\xf6\x4d\xe0\x17\xc1\x84\x41\xc9\x97\x86\xa8\xc6\xcd\x45\x56\x0e\x41\x1c\x9c\x1a\x9e\x43\xcf\x80\xb1\x94\x06\x4d\x98\xea\xc9\x50\x1a\x82\xb8\x91\x0b\x75\xa4\x8b\xf1\x20\xef\x65\xe4\x16\x24\x89\x8e\xf0\x6e\x34\x02\xc9\x9e\x03\x69\x28\x8c\x41\x48\x5a\x68\xe2\xa6\x37\x38\x3d\x80\x11\x88\x7e\x9a\x18\x86\x1e\xfa\x7d\x12\x94\xe0\x49\x20\xc0\x64\xed\x28\x22\xc8\xda\x96\x47\xf1\x89\x4e\x0e\xf1\x38\x26\x32\x52\x80\xc7\x7c\x0e\x7c\x98\x00\xa5\x00\xc4\x64\xd0\x8e\xc2\x41\x95\x10\xb9\x2a\x84\xa2\x4a\x91\x02\x9a\x8f\x31\x1c\x12\xb2\x8a\xc2\x68\xdc\x42\x51\x31\xf9\xe0\xe5\xee\x2d\xb4\x46\xf9\xad\xf1\x15\x4e\x0b\xb1\x01\x89\x7c\xc1\x74\x42\xe6\x00\xa2\xea\x90\x5f\x10\x76\xf1\xc1\x47\x73\x2a\xc0\x00\xbe\xc5\xe9\x30\x8c\xef\xd8\x27\xb4\x3f\x5f\x5c\xd8\x1a\x87\x98\x09\x03\x69\x02\xe9\x62\x1c\x78\x37\x11\xce\x32\x09\x2b\xe7\x30\x0d\x25\x6e\xc0\xdc\xe6\xda\x1a\xf9\x36\x99\xee\x95\xb8\x51\x9c\x61\x0b\xa0\xf0\x61\x5a\x80\x9b\x8f\xb9\x08\x97\xdf\x04\x9e\xe3\x34\x52\x79\x59\x03\x14\x98\xd2\x38\x50\x85\x08\x1c\x35\x1b\x9e\x1e\xba\x03\x4e\xc9\x4d\x0d\x88\xa4\x41\x13\x93\x30\x8b\x06\x85\x89\x7e\xec\xe6\x5f\x18\x4e\xb6\x9b\x7f\xe1\xd1\xbb\xf9\xd7\x12\xfa\xb5\x84\xfe\x55\x42\xff\xd2\x50\x02\x07\xa9\x29\xec\x56\x24\x63\xd7\xd6\x94\xa0\x14\x9b\x25\xd8\xcb\xcf\xa0\xb1\x6c\xad\x06\x08\x98\xa4\x83\xdc\x62\x5e\x1f\x25\xac\x21\x10\x01\x18\xe8\x0a\x20\x3b\xc5\xc3\x2f\xb6\x06\x7f\x35\x94\xe2\xe1\x57\xf2\xfd\x55\x43\x62\x5c\x02\x25\x0c\xa6\x0d\x01\x20\x1a\x15\x4c\x1d\x29\x4e\xb0\x9b\x93\x5d\x5a\x5b\x93\x02\x3c\x66\xbf\x48\x39\x7c\xbf\x48\x01\x4a\xe7\xf0\x03\xd8\x6c\xc9\x82\x38\xca\x6c\x6d\x1e\x56\xe2\xbd\xc1\x2e\x5c\x9b\x92\xb0\x38\x04\x70\xf8\xa9\x47\x8a\xb3\x22\x24\x1f\x31\x3c\x63\x84\x0f\x98\xa9\x52\x98\xa9\x32\xcf\x25\x33\x70\x86\x31\x28\x55\xd9\xd8\x4d\xe4\x11\x98\x84\xe5\x11\x38\x0b\xe3\x84\xe0\xb3\xc1\x0a\xbe\xb0\x57\x84\x6e\x5a\x6a\x5c\x55\x88\x26\x70\x0e\xb8\x6a\xa3\x55\x21\x04\x87\x92\x90\xa4\xd8\xf5\x4f\x70\x3e\x8e\x7d\x5b\x93\x43\x1a\x92\x36\x5e\x6d\x4d\x0a\x40\x8c\xbf\x8f\x6f\x03\xa6\x07\xcb\x21\x88\xc3\x13\x58\x1d\xc0\xef\x2d\xf9\x0d\x72\x6f\x7c\x11\x84\x30\x26\x48\x01\xc0\x8c\x13\x36\xca\xc2\x27\x1f\x61\xe1\x5b\xa8\x31\x10\x28\x35\x29\xd8\x0c\xb8\xc1\x60\x42\xa1\x18\x8d\xcb\xae\xaf\x80\xa5\xfe\xaf\xc0\xa5\x41\x40\xc5\x97\x46\x02\x88\xa0\x6c\xce\xd3\xf8\x06\x8b\x8f\x7d\x37\x1b\xbb\x60\xea\x8f\x94\x15\xdf\x60\xd3\xe7\x10\x4d\x42\x61\xa3\x9c\x8c\x13\x0b\x8e\x01\xd2\x71\x10\x61\xcf\x4d\x44\x2e\x30\x12\x79\x6e\x22\x47\xc3\x63\x74\x25\x1e\x00\x1c\xe1\x04\x4c\xb9\x92\xe9\x46\xa0\x4c\x04\x88\x23\x49\xbc\x83\xa0\xc2\xbd\xf8\x06\xb3\xd9\x80\x45\xde\x41\x48\x43\x59\x91\x0e\xe1\x69\x30\x91\x4f\x4d\x0e\x69\x28\x7b\x80\xa6\x3c\x76\xa3\x51\xe1\x8e\xb0\xad\xa9\x61\x0d\x91\x33\x68\x72\xd9\x2f\xb3\x35\x29\x00\x31\xe9\x08\xc3\x00\xc4\x3e\x38\xe4\x2b\x87\x7c\xd5\x88\xc9\xb8\x5d\x72\x5e\x62\x93\x13\x6f\xd3\x25\x01\x1a\xb1\x8f\xbd\x38\x65\x52\x06\x61\xd3\x17\x00\x8a\x20\x75\x1e\x08\xca\x7d\x07\xc2\x7c\xa4\x2e\xbf\x35\x94\xc7\x76\x0b\xe5\x62\xf4\x6d\xa1\x02\x74\x99\x02\x74\x19\xd8\xc2\xa8\xcc\x2a\x02\x24\x89\x95\x80\x49\x22\x55\xe2\x49\xe2\x54\x44\x81\x47\xe7\x45\xf6\xf5\x3a\xf0\x03\x5b\x63\x01\x73\x10\xf8\x81\x40\x22\x9b\xbf\x65\x1c\x39\x70\x22\x91\x79\x76\x8a\xd3\x83\x09\x89\xca\x33\x33\x81\xb1\x7e\xa2\xa1\xdb\xdd\x72\x63\x40\xbb\x35\xcb\x6d\x02\x0d\xdd\x1e\x32\x3d\x51\xbb\x35\x99\xca\xa8\xa1\xdb\x23\x49\xf9\xd3\x6e\x4d\x49\x17\xd4\xd0\xed\x89\x3c\x9b\x6b\xb7\xa6\x3c\xbb\x6b\x88\x1c\x9b\xc2\xe0\x7c\x8b\xe1\x26\xc8\xc1\x70\x88\xbd\xdc\xd6\x68\xc8\xc4\x24\xa8\x21\xd8\x1f\xa0\xeb\x3c\xd0\x84\x77\xfd\xdb\xaf\x80\x92\xe6\x44\x37\x7d\x20\xf1\xb9\x50\x4d\x21\x20\x69\xa6\x65\xdc\x57\x35\x0e\xd2\x05\xf8\xee\x75\x7c\x6f\x6b\xec\x83\x42\x2e\x88\x48\x51\x20\xfd\x06\x78\x16\x90\x7b\x01\x30\xd6\x12\xc9\x06\xa2\xef\xe2\xd4\x17\xda\x1e\x04\x4a\x5d\xef\x2e\x25\x8a\xdb\x09\x34\x92\xc6\x02\xe6\x04\x9e\x52\x22\x18\xb3\xef\xb9\xbe\x75\x2f\xb6\x15\xee\x41\x58\xee\x41\x58\xee\xf7\xc6\x6e\x14\xe1\x90\x9e\x52\x80\xaa\x58\x85\x68\x88\x6c\x35\xed\x7a\x79\x01\x13\x81\x46\x42\xb6\x4b\x83\x3c\x32\x85\xab\x7c\x65\x24\x0d\xb2\xc8\xc3\x14\xd6\x10\xe4\xd3\x1e\xa7\x78\xc8\xc0\x67\x52\x02\x09\xfb\x7c\x1c\xdf\x71\x70\x36\x8e\xef\x18\xf8\x22\xc8\x4b\xf4\x3c\xc8\x05\x3e\xac\xf7\x05\x9c\xec\x0f\xdc\x4f\x42\x58\x86\xdb\x1a\xec\x8d\xc1\xea\x9b\xec\x92\x45\xc0\x44\xf2\xfb\x85\x14\x09\xb1\x51\x66\x97\xdb\x68\x30\x14\xd0\x34\xa1\x0b\x5c\xbd\x9f\x84\xc0\x6f\x96\x0f\x30\x1b\x6b\x08\x9a\xe4\x01\x98\xf7\x00\xcc\x7b\x98\x63\x5e\x15\xa2\xa1\x9f\x76\x0b\xfd\x8c\xe3\xc9\x6e\xe4\x9f\xba\x91\xad\x95\xdf\xf0\x08\xda\x99\x32\x23\xd0\x70\x1d\x67\x3a\x83\xcb\x87\xbb\x7c\x0b\xeb\x3d\xdf\xba\xcb\xec\xa9\xd2\x04\x51\x93\x04\x55\xd6\x2b\x40\xc2\x72\x05\x72\x36\x87\x43\x18\xad\x40\x28\x8f\x55\xd0\x43\x22\x41\x18\x63\xa3\xe6\xfd\x24\x14\x2c\x13\x21\xca\x2d\x12\xac\xa9\x88\x3d\x9d\xcd\x7a\xb2\x81\x93\xd8\x10\x16\xa5\xcb\xbd\x50\x63\x9a\x36\x4b\x8e\x80\x93\xa4\x16\x8a\xaf\xf0\x35\x6c\xf4\x37\xe7\xf2\x84\x78\x88\x35\x94\xd7\x3f\xbf\x72\xc4\x40\x8e\x3a\xea\xee\x64\xac\xac\x2c\xb9\xac\x83\xb9\xf3\x97\x5f\xb9\x5d\xb3\xec\xbc\xb0\xf6\x48\xbf\xbc\xe3\x54\x9e\x7b\xdb\xca\x21\xb8\x7c\x04\x6e\x57\x4e\xc4\xc5\x79\xb8\x2d\x9f\x8d\x4b\x27\xe3\x1c\x4e\x93\xcf\x66\xb5\x57\x48\xf8\x71\xe6\x53\x87\xbe\x9c\x52\xe5\x36\x8f\x3d\x77\xbb\x87\x99\x56\x25\xc7\x4a\x28\x8f\x13\x3b\x6a\x0e\x60\x1b\x8b\x3c\x8b\x48\x50\x88\x87\xb9\x04\x3a\xc6\xc3\x7c\x36\x7f\x94\x05\x3e\x0e\xb8\x63\xa0\xc9\xe3\xe3\xe4\x85\xe3\x14\xba\x51\x73\xa3\x21\xd6\x27\xe4\xb8\xf3\xc5\xe8\xf1\xf1\x45\xa2\x8f\x50\x64\x18\xd3\x11\x7b\x27\x98\x3a\x9e\x74\x59\x75\xcc\x2a\x86\x06\xe0\x8e\x8f\x57\xa7\x91\x92\x5b\x6c\x0e\x13\x10\x0d\xa5\x4d\x3a\xe3\x3b\x13\x14\x36\xcb\xed\x72\xfe\x8e\x78\x9f\xdd\xf3\xc7\x19\x38\x47\x4d\x67\xd5\x5b\x0c\x70\x4b\xaf\x4b\xcc\x5c\xd1\xe3\x4c\xf9\x75\x30\xbb\xe8\x1a\xe9\xeb\x5d\x76\xc1\x15\x30\xe0\x19\xcb\xc6\x16\x7d\xc6\x62\x59\xdb\xe4\x41\xaa\x72\xe0\xc9\x1b\xe6\x84\x3d\x69\xe7\xe1\x95\x95\xb9\xbb\x83\x80\xf2\x87\x63\x59\x68\xec\x4c\x69\x85\xec\x69\x02\x64\xfb\x67\xe4\x1a\x3a\x55\x51\x58\x67\x65\x4f\xb6\x6d\x0d\xec\x11\x03\xae\xc6\xaf\xce\x4b\x30\xf6\x02\x43\x03\x53\x66\x60\xdd\x04\x47\x1e\x8c\x63\x57\x5a\x1e\x27\xaf\x43\x58\x9c\xc0\x17\x73\xf4\x76\x82\xa3\x82\x02\xde\x80\xd8\xd1\xcf\x77\xf8\x61\x1f\xec\xa3\xf3\xc0\x65\x42\xe1\x27\x71\x91\xe1\x32\x86\x04\x79\x9c\xe8\x20\x30\xd6\x8e\xb0\x76\x3d\x9b\xa1\x09\xb9\x97\x09\x86\xcc\xe1\x67\x44\x7f\xfa\x60\x55\xee\x16\xfe\x3c\x38\x53\x7c\x0b\xdb\x56\xc4\x81\xf2\x18\xf6\x48\xe1\x98\x9f\xad\x3e\xb9\x80\x95\xd7\x88\x40\x68\x6e\xe7\x24\x2a\x74\xf2\x9d\x05\x2f\x38\x0d\x9b\x1d\x9c\x65\x77\x01\xbb\xc2\xe2\xc1\xcc\x23\x6a\x6b\xeb\xf0\x7a\x4f\xba\xff\x18\xce\xdd\x7f\x04\x6f\xeb\x4e\x88\x06\x4e\xce\x2a\x60\xd0\x47\x99\x3d\x9e\x13\x61\xa9\x5d\x57\xd5\x0a\x62\xc9\x3d\xbb\x0f\xd6\x7f\xd5\x58\xb9\x3d\x6c\x25\xc9\x65\xa2\xd9\xac\xd2\x84\x77\x29\x18\x91\x37\x44\xc2\x2a\xe3\xed\x60\xa8\x0f\x0d\x35\x73\xde\xa0\x22\xe3\x77\xf8\x41\xca\x96\xe6\xa8\x74\x0c\xe4\x07\xfe\x69\x21\x9e\x4a\x28\x57\xeb\xc8\xf1\xb0\x90\x40\x38\xf8\x5d\x59\xd1\x6f\xc1\x9d\xb0\x72\x53\xec\xe1\x97\x46\x7c\x66\x09\x02\xcc\x96\xcb\xee\xdb\x95\x71\x46\x60\x0d\x8a\x3c\x27\xe7\x9e\x70\x98\x28\x5d\xa1\x03\xbf\x32\x82\xaa\xea\x3d\x57\x2c\x7a\x7b\x87\x5d\xc9\xb5\x3a\xac\xbb\x77\xd9\xbd\x76\xe8\xf6\xd0\xdb\xad\xf5\x0e\xeb\xee\xd0\xef\x7d\xd6\xff\x49\x77\x5f\x5f\x87\xee\x0e\x1f\xec\xdd\xda\xa6\x65\x80\xa0\xeb\xd6\xfa\x36\xb7\xd9\xdf\x02\xa3\xfd\xf0\x61\x19\xa8\x0f\x28\x6d\x03\xcc\xd3\xeb\xd6\x46\xdb\x40\x0f\xf4\x4d\xcf\x3d\x84\xe1\xc9\xc3\x1d\x37\x3e\x30\x9d\x19\xe8\xc6\x99\xce\x7a\x57\x9a\x3b\x88\xe1\x62\x9a\xe6\x46\x01\x6c\x83\x91\xb9\x4a\x0e\x1e\x11\xaf\x1b\x64\xc1\x50\x02\xd9\xd5\x19\x6d\x00\x02\x09\x66\x2c\xa2\xd3\xd0\x7d\x28\xbf\x2e\xe8\xd2\x16\x00\x61\xe0\xdd\xc0\xaf\x32\x06\x78\x71\x42\xb0\x0b\xc8\xc4\x8f\x8b\x41\x88\xf7\x18\xa2\x9f\xba\x23\xf6\x43\x29\x01\x00\x31\xca\xc0\xbf\xef\x61\x8d\x48\x3e\x89\x99\x06\xf6\xfd\xe1\x56\x60\x70\xea\xfc\x34\x4e\x00\x54\xa4\xae\x24\xb4\x48\x03\x4b\x39\x01\x9c\x79\x68\x38\xf2\xd2\x87\x24\x67\xdf\x3e\xfd\x05\x5b\x86\x1a\x18\xd1\xa3\x83\x14\x6d\x72\xf8\x25\xa6\x94\x34\xa4\xdd\x88\x31\x0b\xb6\x75\x53\xb2\x46\x02\x20\x19\x9f\xc2\xd8\xf5\xd9\x0f\xf6\xf7\xdd\xdc\x15\x81\x13\x9c\xbb\x7e\x09\xe0\x54\x4e\xa4\x91\x8e\x7c\x9f\xc4\xb7\x98\x7f\x7f\x28\x04\x0a\xab\xe0\x44\x8c\x84\x89\x9b\x81\xfe\xad\x25\x6e\x01\x2a\xae\x06\x67\x52\xec\x87\x2c\x95\xb4\x24\x8d\x47\x8c\x3a\x38\xf1\x12\xf5\x87\x0d\xb0\x5c\x2b\x5f\xa8\x69\x19\xc6\x37\xa4\xee\xf0\x41\xd3\x66\xb9\x1b\x12\x6b\x23\x5a\x56\x0c\x26\x84\xe3\x59\x91\xc1\x18\x0f\x63\x70\x30\xe1\x46\xc5\x90\x96\xc7\x85\x37\xde\x83\x1b\x00\x21\x0f\xd1\x86\x23\x11\xac\x32\xe4\x9b\xd7\x98\xac\x5a\x83\x52\xd6\x6e\xe3\xb0\x98\x94\xe4\xdd\xb9\x64\x01\xa3\x21\xed\x6e\x8c\x71\xa8\x5d\xd7\xaa\x89\xec\x72\xc3\x55\xeb\xba\x99\xc7\x97\xf0\xd2\x99\xde\x3c\x5e\xc5\xcd\x2c\x0c\x3c\x0c\x8e\x06\x22\x87\x3d\x01\x8b\x1d\x18\x9a\xb4\xd5\x1c\x14\xee\x27\xa7\xbc\xa8\x9c\xe9\xa2\x55\x6d\xd1\x1c\x17\x5f\xcf\x7a\x77\xa0\x80\xa6\xe8\xe6\x2a\xbe\x76\x52\xf6\xa6\xfa\x00\xfc\x24\xec\x29\xf3\xcd\xdd\x93\xf3\x0d\xd4\x26\x75\x6e\xc0\xf0\x38\x4c\x3d\xe9\xdc\xd4\xe3\xd6\x4c\x2c\xbb\xa4\xeb\x8a\x81\x76\x8f\xf5\xc2\x2a\x80\x77\x46\x01\xdf\x57\x3b\x84\x80\x1f\xb0\x7e\x51\x02\x44\xf7\x90\x40\xbe\x12\x24\x9d\x45\x04\x8f\x48\x5f\x91\x82\xb4\xcb\x08\xc0\x71\xec\x56\x42\xac\x8f\x54\x60\xa2\xab\x28\x70\x2a\x3f\x02\x74\x4a\xe4\xbe\x0c\x2a\x75\x3f\x65\xbd\xa0\x04\xf0\xce\x20\x20\x67\x65\x9f\x28\x61\xa4\x6b\x88\xe0\x39\xed\x18\x4a\x58\xc9\xf5\x9c\x75\x93\x12\x40\x7b\x8b\x14\xa6\x9d\x46\x00\x2e\xca\xbe\x23\x60\x9f\xe4\x0e\x20\xa0\x9f\x59\x3f\xb0\x5d\xc7\xaf\xcc\xe0\xef\xf8\xb8\x03\x33\x70\xcb\x71\x9c\x7b\x78\x25\x20\xcb\xcc\x53\xd3\xb1\xeb\x0c\x2b\x79\x52\xe5\xa2\xa2\xb4\xb8\x4e\x52\x41\xa3\xe3\x34\x94\xdb\x86\x3b\xd6\x4d\x3a\x3f\xd6\x96\xbd\x2f\x0d\xec\x76\x8d\x6a\xa2\x80\xc8\x40\xa1\x82\x2e\x93\x0a\xe0\x83\x2c\x5c\x27\x62\x50\xb4\x6b\x15\x1b\xd7\x19\x57\x48\xdf\x87\xa9\x45\x20\xef\xb3\x19\xa6\x02\xc8\xe5\x0c\xf7\xf9\x7c\xa3\x40\xe8\xb4\xa3\x80\x54\x3a\xf6\xc5\x24\x24\x81\xe2\x04\x68\x9a\x54\x68\xba\x90\x46\x4f\x81\x7c\xc1\x07\x51\x15\xa2\xb2\xe8\xa2\x1c\x52\x6d\xd7\x19\x54\xf2\xdd\xe5\x53\xb5\x92\xcd\xee\xfc\xac\x3e\x1f\x27\xf2\xf4\xaa\xb4\x2a\xe3\xb6\xed\x3a\xa3\x0a\x02\x7d\x30\x0d\xd5\xec\x57\x62\x3e\x93\x91\xdc\x76\x9d\xdb\x4a\xc4\x1e\x68\x02\x82\x86\x3d\xb9\x81\x4f\xc9\xfc\x66\xbb\x4e\x31\x73\xb9\x9d\xae\x50\xd7\xb6\x88\x73\x5b\xe6\xb3\xdc\x95\x96\x70\x29\x1b\x4c\xf9\xda\x2d\x58\xbe\x40\xcb\x0c\x94\x3d\xa9\x7b\xc2\xe6\x40\x1c\x51\x99\xa7\xda\xe7\x8b\x54\xc7\xcd\x7e\xee\x8e\xc4\x6b\x09\xd0\x29\x51\xe0\x64\xb5\x0b\x03\x6c\xf4\x0e\xae\xc2\xeb\xc7\x47\x1d\x7e\x1c\x97\xbf\x12\x0f\x84\x6a\xf4\x00\xae\x76\xd0\x5d\x10\x86\xfb\xc4\x7b\x4c\x2d\x2d\xcf\xa1\x24\x22\x94\xf4\x0e\xae\xa2\x6b\x76\x3f\x57\x37\x10\xf3\x48\x03\xc0\xd9\x53\xc6\xce\x17\xaa\xcc\x7c\x96\x62\x8c\xe5\xbe\x71\x89\x21\x66\x16\x27\xee\x43\x82\xf6\x1a\x3a\x53\xa1\x2a\x96\xa6\xe1\x31\xbd\x77\x0e\x03\x20\x05\x24\x19\x2e\x7c\x7e\x89\x9b\x80\x66\xbd\xb4\xe9\x16\xc4\xb8\xd2\x5e\xe8\x66\x99\x1e\xa3\xf0\xef\xbe\xa2\xfb\x1b\x44\xc3\x65\xa9\x41\xec\xa6\x64\x4e\x92\xf8\xcf\x33\xd1\x14\x04\x71\xb1\x54\x49\xc5\xf6\x71\x14\xcc\xd9\xff\xa9\x7a\x6d\xb6\x49\xbd\x52\x0c\x9b\x14\x3e\xdb\x6b\xfe\x3f\xc9\x68\x4a\x10\x5b\x8e\x90\x75\xd1\x3a\x33\x92\xb6\xd9\x85\x75\xd1\xf4\x06\x3f\xd8\x2e\xb9\x77\x00\xd9\x33\x67\x04\x79\x1a\xbe\xc3\x0f\xcc\xaa\x05\xdc\xae\x13\x21\x37\x2c\xbf\x27\x38\x77\x45\x80\x9e\x8f\x32\x9f\x04\x70\x8b\x81\xc9\xdc\x08\xe7\x27\xb1\x1f\x0c\x03\x9c\xc2\x83\x66\x62\x74\x67\x0c\xa7\x8e\xb2\xc5\xa7\x72\x8d\x78\x83\x1f\xe0\x2c\x38\x2b\x5f\x34\xee\xc0\x33\x1c\xbb\x35\x83\xfb\x1a\x4b\x52\xf9\x30\xe9\xca\xcf\x20\x6f\xf0\x43\x91\x48\xd9\x10\x07\x63\x24\x83\xd6\x0c\xdd\x8d\x03\x6f\xfc\x2b\xe5\xff\x72\x11\x35\x0d\x9e\x2d\x69\xf0\xa7\xef\x15\xa3\xc8\x11\xf9\xb3\x11\x97\x3c\x9e\xd8\x63\xfb\x50\x78\x47\xcf\xe1\x21\x19\x03\x21\xd0\x52\xf2\x95\x15\x0b\x6e\x9a\x47\xe4\x81\x1f\xd8\x92\xb6\xc1\x50\x6d\xfe\xca\xe9\xb4\x1f\x1f\x49\x14\xb8\x0f\x69\x2d\xbe\x28\xbb\x54\x10\xd9\x55\xf9\x1b\xfc\xc0\x97\x07\xe1\x15\xa1\x11\x3c\x53\xc2\x2f\xec\x05\x6a\x97\x51\xe0\xe3\x28\x07\x11\xf0\x55\x5b\xdd\xb0\x03\x5a\xc3\x71\x3e\xba\xa6\xd2\xfb\x40\x42\x6b\xb4\xa3\x31\x7d\x81\x7a\x8f\x24\x0e\xd4\xf6\x58\x85\xc1\x0a\xc7\x2f\x88\x83\x7b\x25\xb8\x79\xfd\xf8\xa8\x12\x69\x6b\x9a\xe8\x40\xa4\xe3\x84\xce\xf4\x20\xf3\x6c\xed\x80\x38\x56\xd5\x10\xd9\x78\x1f\xb8\xa9\xad\x35\x34\x04\x9b\xa6\xb6\xb6\x9b\xa6\xf1\x1d\x7c\x6a\xe8\x32\x61\x41\x58\x9f\x9e\xd1\x93\x20\x12\x26\xdf\x1a\x02\x65\x8c\x41\xe0\x53\x43\xfb\x38\xb4\x35\x3a\x19\x69\xe8\x33\x9c\xe3\x7e\x38\xd7\x10\x68\x55\xb6\x26\xab\x58\x68\x37\x49\xb2\x0a\x88\xaa\x00\xb6\x46\x7f\x8f\x63\x58\xd3\x9f\xc4\x3f\x4f\xd3\x20\x22\x9b\x5c\xd0\x41\xd5\xda\xcd\x90\xeb\x4c\xb7\x6c\x0d\xae\x39\xb2\x43\x96\x6d\x5b\xbb\x70\x07\x1a\xb2\xda\xb6\xb6\x17\x62\x37\xd5\x90\xd5\xb1\x19\xb3\x91\xb5\x61\x6b\xe4\xce\xad\x86\xac\x4d\x5a\x7c\x1a\x87\x1a\xb2\xb6\x6c\x6d\x37\xcc\x35\x64\x6d\xdb\x1a\x5d\x1c\xa0\x76\xcb\x86\x05\x5c\x46\x09\x69\x6f\x96\x3c\xeb\xb4\x09\xb7\x3a\x1d\xc0\x1d\x61\xe0\x4d\xa7\x4b\xbf\x29\x17\x3a\xeb\x50\xa2\xaf\xa1\xce\x86\xad\x1d\xc6\x70\x01\xaf\xb3\xc9\xf8\x44\x19\xdb\xd9\x92\x18\xdb\xd9\x56\xb9\xda\x6d\xb1\x30\xcd\xad\xbb\x6e\x6b\x47\x51\x86\x61\xaf\xa5\xbb\x51\xb2\xd7\x82\x3a\xbe\xb1\xe0\xa3\x63\x6b\x6f\xda\xf0\xd1\xb5\xb5\x37\x1d\xf8\x58\xb7\xb5\x37\x5d\xf8\xd8\xb0\xb5\x37\xeb\xf0\xb1\x69\x6b\x6f\x36\xe0\x63\xcb\xd6\xde\x6c\xc2\xc7\xb6\xad\xbd\xd9\x02\x56\xb5\x6c\xed\xcd\x36\x7c\x58\x90\x61\x0b\xbe\x48\xd6\x90\x77\x1b\xf2\xb6\x20\xf3\x6e\xd7\xd6\xde\x17\x13\xca\x0f\xab\xbb\xae\xb6\x54\xbb\xdd\xb5\x35\x58\x7c\x69\xb3\xde\xc2\xb1\xe1\x9f\x9b\x0c\x2c\x98\x0c\xa6\xb0\xd0\xa3\xd7\x88\x70\xfa\x7f\xc5\xe4\x04\x53\x91\xeb\x4c\xc9\x9e\x05\xce\x08\x49\xec\xc4\xff\x42\x06\x79\x64\xbd\xe6\x2b\xb0\x85\xf3\xd1\x92\x39\x6c\x6e\x4a\xaa\x63\x80\xfb\xff\xa2\x1a\x94\x30\xb7\xb4\xff\x23\x54\x37\x2e\x44\x38\xcc\xdd\x2f\x75\x53\x28\x8d\xe1\xca\x1a\xc3\xa3\x5b\x4b\xfb\x52\x94\x89\x9b\x12\x0c\x26\x78\x82\xfa\x75\x61\x96\x5f\x95\x2c\xbf\xca\x59\x7e\xad\xc9\x52\x41\xa8\x89\x17\x25\xfe\x45\xd8\x09\x8a\x7b\xee\xc2\x89\xcd\x7f\x03\x77\xcb\xd7\x60\x51\xf9\xfe\x90\x3f\x28\x47\xfc\x55\xb2\x81\xa8\xf3\xc8\x88\x78\x8d\x24\x53\x2b\x4c\xf0\xbb\xb9\x1e\x1b\x30\x6f\x96\x21\x3e\x83\xf2\x27\xcc\x0d\x9e\x17\x4c\xe8\xec\x73\xc7\xb4\xec\xa8\x76\x8b\xbd\x81\x77\xca\xc7\x40\x8e\xe3\x1c\xed\x94\x16\x85\xb8\x84\xc9\x0f\xe6\x28\x43\x44\x4e\xa1\x9c\x13\xac\xc1\xc4\x11\xf1\xca\x8a\x1a\xd6\x2f\x8c\xc7\x47\x4d\x9b\x33\xeb\x0c\xaf\x5a\xd9\x62\x0e\x34\x84\xfb\x66\x18\x8f\xb8\x55\x34\x7a\x33\x87\x3d\x96\x74\x1d\x5c\xb5\x55\x0a\x36\x40\x92\x8c\xfa\xab\x43\x81\xe3\x12\x8d\xa1\x17\x3a\xda\x19\x98\x0d\x69\x10\xab\x97\x60\x94\x5d\x9f\x7b\xe3\x14\xec\x04\x76\xa0\xba\x0e\x0e\x88\x2b\x10\x03\x71\x67\x55\x79\x30\xc1\xba\x30\x83\x7c\xd0\x54\x6d\x68\xea\x18\x45\xd4\x52\xe2\x2d\xd4\xc3\x40\x29\x6a\x19\xbd\xb0\xf4\x75\x05\xc9\xe1\x51\x2b\x91\x94\x79\x0f\x1b\xcd\x3e\x37\x6c\xc7\x2c\xc2\x38\x18\x1d\x72\xd3\xc0\x70\x89\xfa\x28\xca\x63\x38\x1a\xd1\x33\x94\x23\xea\x5d\x6a\xde\xf2\xb4\xd8\xbc\x3c\x6d\x92\x2a\x9f\x61\x2f\x8e\xbc\x20\x94\x4d\x09\x49\x8b\xf4\x17\xd1\xca\xca\xc3\xbc\xa1\xde\x5e\x2a\xac\x3f\xba\xb4\x4e\x90\x7b\x0a\x6d\x63\xa0\x65\x39\x73\x03\x2e\xa9\x44\x5a\x46\x49\xa3\x52\x7e\xd0\xac\xda\x1e\x05\xbe\x81\x7f\x4c\x59\xe4\x88\x42\x5a\x9e\x94\x0a\xb2\xf2\x66\xe8\xf2\x77\x9a\x46\xce\x96\xd5\x24\xa8\x4b\x51\x52\xd9\x5e\xb9\x37\x9d\x56\x3d\x6a\x13\xdf\xbf\x35\x96\x19\x59\xaf\x79\xa1\xbf\x88\x1e\x1f\x5f\x44\xb2\x6d\x4a\xd9\x19\x58\x51\x0a\x3a\x7d\x0e\x59\xf6\x9a\x17\x8e\x73\xbc\xb2\xa2\x84\x8f\x2a\xe1\xef\x12\x91\xbe\x4a\x24\x3c\x02\x5c\x59\x79\x82\xba\x46\xb4\xb2\xa2\xd2\xb6\x13\x55\x7a\x62\x52\x66\xeb\x4b\xba\x72\xbe\xc3\x2c\x86\x8b\x07\xef\x60\xda\x65\x4e\xfa\x6c\x71\x4a\x3e\x64\xe7\x66\x63\x66\x43\x7b\xc2\xac\x7d\xc1\x89\x57\x9b\x9d\x77\x81\x49\xaf\x7e\xe9\x98\xa6\xb3\x6e\xf0\x73\xaf\x2e\x3d\xf7\xb2\x36\xd6\xe9\xc9\x17\x9c\x95\xdd\x81\xe6\x6c\x75\xe0\xd0\x4b\x18\x92\xb2\x36\xc0\x3f\xff\x81\x13\xe9\x1b\x2d\x03\xed\x71\x8b\x52\xa7\xcc\x3c\xd8\x09\x00\x5a\x06\xfa\x00\xbf\x50\xc8\x59\x69\xb9\x7b\xab\x65\x18\xe8\x1c\x22\xda\x2d\x03\x5d\x30\xa7\x16\x93\xe6\xd1\x7e\x7f\xf7\xe2\xe2\xec\xe8\xf5\xe5\xc5\x41\xff\xfd\xee\xc9\x81\x81\xde\x3b\x93\xe6\xd9\x87\x0f\x17\x95\x08\x74\xec\x58\xe8\xc8\xd9\x46\xdf\xe1\x3c\xfe\x12\x8e\x0c\x76\x1d\x0b\xed\x97\xfe\x2c\xb8\x55\x23\xe2\xe9\xd0\xd9\x5d\x5d\x9d\xf5\xf6\x4b\x83\x43\xcd\x20\x23\x7d\x42\x08\x35\xe4\x20\xc7\xd3\xde\x2e\x67\xc7\x5b\x63\x1c\x64\xf2\xa0\x05\xc9\x58\x66\x17\x6a\x83\x70\xb7\xb1\x87\xce\xb4\x12\x63\xef\xa3\x3e\x7f\xf6\x99\xbd\x7e\x20\x89\xa9\x4b\x46\xfb\x12\x65\x44\xf3\x3f\xa1\x26\x14\xa5\xd9\x93\x98\x40\x05\x73\x56\xfd\x82\x1c\x1d\x41\x02\x41\xbe\x82\xc7\x47\x64\x46\xf1\x61\x53\xc9\x52\x8f\xcb\xa9\xce\x98\xee\x71\x3f\x4d\x15\x77\x29\x6c\x08\x40\xe9\xca\x8a\x40\xe1\x2e\x50\x24\x9c\x94\xdc\x1c\x9a\x21\x36\x3a\xbe\xc7\x77\xcb\xc9\x32\xa6\x05\x3c\x37\x66\xbb\x91\x43\x5d\xeb\x6c\x6a\x06\x1a\x35\x71\x94\x15\x29\x96\x0c\x46\x32\x62\x83\x68\x04\x46\x10\xc8\x00\xf9\x41\xc7\xe8\x85\x65\xf4\x4e\xab\x66\x1c\x03\x24\xb6\x2e\xb9\x71\x10\xc1\x5e\x26\x00\xbc\x2f\x5d\xc2\x26\x62\x8a\xd2\x19\xbb\x48\x7e\x5e\x0c\xf2\x14\x93\x91\x5a\xf4\xae\x1a\xa2\x59\x6a\xe8\x61\x2f\xc0\xbc\xce\x5d\x73\xec\x82\xb9\x1b\xb9\x22\x5b\x9a\x01\xc3\xff\xe2\x7c\x45\x76\x33\xb4\x04\xab\xa6\xf4\xbd\x79\x37\x3e\x31\xa2\x13\xe4\xfe\x87\x13\x26\xaa\x9a\x81\x06\xcd\x20\xfb\x04\x98\xac\x2d\x2b\x9c\xde\xd6\xd0\xdc\x24\x9a\xef\x68\x0d\x18\x51\xb1\xeb\x37\xe2\x61\x23\x71\x33\x30\x47\xdd\x70\x89\xe2\x13\x8d\x1a\x61\x70\x83\x1b\x2f\xfd\xe0\xf6\x25\x22\x91\x0d\x52\x2a\xbb\xba\xc4\x8b\x21\xf1\x46\x23\x4e\x1b\x7f\xf8\xc1\x6d\x63\xed\x55\x53\xb3\x6b\x5e\x3c\x2f\x2c\x8b\x3a\xba\x23\x45\xbd\x89\xe3\x25\x05\xbd\x89\x63\x5a\xcc\x9b\x38\xa6\xc5\x08\xe7\x8f\xc2\x03\x12\xd3\x28\x76\xb4\xc6\xc5\x38\xc8\x1a\x13\xf7\xa1\x31\xc0\x0d\x0f\x96\xb3\x7e\x63\xf0\xd0\x28\x22\xfa\x4e\x20\x88\x89\xd9\xcf\x46\x18\xbb\x70\x55\xaa\x91\xdf\xc5\x8d\x20\xe2\x47\x94\x79\xc3\x8b\x93\x00\x67\xc0\x14\x5a\x65\xcd\xd6\x34\x2a\x89\x2e\x0a\x9c\x41\x85\xb2\x7d\x34\x25\x2a\x8c\x9d\xcf\x0c\xc9\x05\x71\xe6\xdc\x71\x87\x5d\xae\x93\x81\x2f\xe5\xd8\xc3\x59\x46\x26\x3d\xb6\xec\xd7\x33\xe1\x73\x88\x19\xf5\x71\x9d\x13\x52\x4e\xe1\x24\x7a\x44\x72\x2b\xb8\xad\x89\xa2\xaa\x3e\x81\x35\x13\x79\x34\x02\xec\x73\x7d\x8c\x72\xb6\xa7\x3d\x71\x8a\x3a\xcd\x05\xf4\x89\x62\x10\x06\x1e\x9f\xaf\x74\x98\x16\xe2\x95\x15\x21\x7c\xc6\x34\xa6\x2b\x9d\x89\x31\xe3\x9d\xe7\xb0\x59\x37\xf4\xe8\x05\x0a\x90\x8b\x22\x34\x32\xd0\x64\x76\x38\xa7\x2e\xec\xe6\xcc\xa4\x33\x51\xc3\xfa\x4e\xaa\x47\x30\xd5\xf4\xc1\x50\x40\xa8\xf7\x61\xb6\xf1\x00\x74\xef\xdc\xae\xac\xbc\x28\x56\x56\x5e\x3c\xa0\x1b\xe7\xb0\xb9\x60\x48\xd1\x03\x14\xa1\x7b\xe4\x1a\xcf\xac\x17\x27\x9e\xb8\x9a\x25\x35\xba\x31\xd0\x0d\xef\xff\x95\xde\x26\x8d\x99\x4b\xba\x28\xb3\x59\xcb\xfc\x12\xd6\xd7\x57\xca\x18\xc6\x3c\x65\xa8\xe8\xb6\x98\x24\xe5\x4e\xc2\x74\x9c\x17\xb9\x31\xf5\x40\x95\xb0\x84\x79\x29\xd0\x3b\x40\x07\x19\xbb\x59\xa9\x81\xbf\xe7\x5a\xc1\x0b\x8b\xdf\x01\x62\x27\x14\x97\x57\xf9\xdc\xa8\x77\x8d\xe6\xc6\x4a\xaa\x8a\x82\xcd\x45\x70\xc4\x5f\xa3\xac\x56\x38\x82\xc0\xf8\x0d\xec\x0b\x56\xc6\xed\xae\xa5\x19\xdc\x78\x79\xe0\xa4\xa0\x85\x05\x43\xfd\x06\xae\xd6\x9d\x61\x38\x8e\x24\xde\x4a\x75\x8c\x02\xb1\xba\x21\x5d\xb4\xaf\xba\x5d\x88\x50\xc0\x8f\xa2\x02\x75\xad\x71\xd3\xdc\x3b\x3c\xd8\x7b\x77\x7e\x79\x42\xa6\x7e\x32\xeb\x1b\xbd\x80\xa9\x90\x4f\xe1\x41\x96\x9e\x13\x34\xe3\x22\xc7\xe9\xe1\xc5\xc9\x71\x8f\xd8\x9c\x5c\x9e\x0c\x65\xdc\xaa\x3b\x46\x89\x13\xeb\x3e\x38\x67\x9a\x38\x5a\x43\xf7\xc2\x00\xd4\xd9\x86\xb6\xea\x37\xb3\x62\x40\x07\x47\x3d\x31\xdb\x2d\x94\xac\xb6\x5b\xc6\xaa\xf6\x2d\x6a\xe8\xe4\x95\x54\x6a\x34\xb4\x55\xaf\x16\xab\xa7\xea\xcc\x3b\xc0\xc6\xb6\x86\x26\xc2\xb8\x2e\xf8\xe6\x99\x47\xe9\x68\x1c\x01\xb9\x35\x2e\x3a\x40\x49\x7f\xae\x9e\xdd\x1b\x37\x03\xb2\x89\x76\x91\x62\x4c\x3d\xed\xeb\x20\x0f\x20\xd0\x6c\xf4\x39\x03\x80\x81\xe6\xda\x29\x97\x56\x90\xea\xe5\xb1\xc3\x5f\x5c\x3b\x33\xeb\x0c\x73\xfa\x2b\x46\xa5\x73\x13\xdb\x42\xaa\xcb\x11\xe2\xc3\x59\x62\x4d\xba\x93\xdb\xb9\x6a\x97\x83\x28\xc0\xa8\x0f\x48\x76\x8e\xe0\x88\x51\x24\x9a\x37\xd5\xc6\x91\xf9\x15\xf1\xcb\xb3\x23\x8a\x2d\x03\xd8\xce\x01\x9b\xfc\xd9\x96\x89\xce\x3c\xcf\x6c\x3f\xdf\xac\x08\xab\x71\xb5\xed\xc0\x3e\x79\x91\xe1\x37\x01\x78\x7b\x7e\x61\xcd\x7a\xcf\xdf\xb6\xe7\x46\x7c\xad\x0d\x62\xc5\x77\xed\xdb\xda\xce\xab\x35\x14\x3a\x6b\xff\xfe\xe3\xdb\x8b\x6f\xe6\x37\x73\x0d\x76\x85\xe7\x45\xdc\xd6\x60\xb7\xd0\x24\xb6\x3e\x4d\x62\x19\x34\x2b\x26\x1a\xd8\xaa\xd8\x63\x81\x8b\x98\x76\x5d\x65\x00\x83\xe2\x72\x7a\x3c\xca\x3a\x73\xd8\xcc\x71\x06\x13\xdb\x0e\xb6\x31\x77\x0e\xa2\xa7\x48\x6b\x68\xab\x6e\x4d\xdf\x5a\x7d\xe9\x68\x2f\x57\xf3\xd5\x97\xda\x7f\xad\xbc\x04\x9b\xac\xca\x38\xa1\x0c\x3a\x5c\x42\x72\x75\x40\xa8\xcb\xd5\xe8\x45\x60\x1d\x32\x71\xd3\x0c\x06\x30\x3d\x42\x56\x8b\xab\x8b\x32\xb5\x29\x9c\x36\xcc\x66\x8b\xbd\x99\x56\xd8\xcb\xa3\x4a\x2b\x44\x90\x67\xee\x58\x08\x3c\x8d\x51\x3f\x62\x62\xbf\xc7\x75\xc2\x15\xb3\xdb\x4b\xff\x70\x7b\x25\x6e\x50\x6e\x0c\xa5\xab\xdd\xd6\xf6\x06\xd8\x10\x4b\xff\x08\x7a\xe9\xaa\xd3\x35\xa2\x55\x47\xcf\x57\xa5\xe3\x9d\x5d\xf0\x3b\x6e\xac\xce\x03\x57\xad\x7a\x70\xbb\x1e\xdc\x31\x8c\x5e\xfe\x9b\x13\xa3\xe8\x37\x27\x9e\x01\x31\xbd\xf4\x8f\x90\xf8\xc1\x8a\x56\x9d\x39\x7c\xc1\x20\x91\x06\xe5\x8f\xd1\x1f\x7f\x58\x1b\x44\xd8\x63\x67\x63\x7d\xbd\x6d\x2d\x96\xcb\x0a\xdb\x4a\x3c\xcd\x5a\x6f\x6e\x34\xdb\xda\x13\x62\xcc\x23\xc5\xe9\x53\xc5\x4d\x37\x04\x61\x7a\x54\x67\x45\x1e\x8b\xd9\x24\xea\xaa\x4e\x51\x1b\x39\x1c\x9d\x05\xc4\xd9\xd6\xce\x02\x4f\x77\xd4\x52\x29\x1d\x57\xeb\xac\xf3\xf0\x05\xe0\x4e\xaa\x6b\xdd\xae\x06\xee\x71\xb5\xee\xba\x86\xe4\xf7\x17\x58\x36\x63\x04\xeb\xed\xb0\xb2\x9e\x76\xf9\xaa\x39\x80\x8f\xcd\x16\xf3\xc1\x85\x2a\x9e\xc4\x7f\x65\xef\x51\x12\xc6\x1e\x39\x1f\x14\x4a\xd0\x7b\xce\x1c\x18\x1c\x9b\x7b\x1f\x4e\x4e\x3f\x9c\x1f\x5d\x1c\xf4\x0c\x2c\x63\x09\x2d\x45\xf0\x8a\xa0\x1f\x7e\x38\xbf\xd8\xa9\x43\xb3\xc1\x02\x64\xda\x3c\x38\x39\xbd\xf8\xba\x03\x2c\xe3\x53\x15\xaf\xb7\x65\x6d\xfd\x4a\x5d\xca\x71\xab\xa3\x24\x6b\x2e\xd6\xb6\xa4\x1c\x27\xb1\x5f\x84\x18\xb1\x54\xa8\xdf\xbf\xc3\x83\xc4\xf5\x6e\xfa\xec\x21\x6e\xbf\x5f\x29\x8e\x35\x17\xb5\x5f\xc4\x9e\xc5\x3c\xe8\x3c\xbd\xd6\xef\xe3\xec\x84\xe4\xa9\xa1\x29\x79\xdd\x66\xe7\x69\x81\x67\x46\xc9\xf3\x3e\xac\x16\xd2\x38\x61\x86\x3e\x98\x9d\x79\x3d\x1e\x7c\x17\xba\x62\x3c\xf8\xbe\xb2\x12\x0f\xbe\x37\xcb\xec\x76\xe2\xc1\x77\x7b\xca\xec\x42\xd9\xf1\xe0\x3b\xb5\xc2\x46\x56\x12\xce\x82\x2c\x6b\x6a\xa3\x5b\x86\xd1\x64\xb9\xa0\xcb\x28\xb8\x87\xbb\x1f\xbf\x94\x7e\xb3\x23\xe5\x70\x3e\x8e\x53\x72\x55\xe7\xd7\xb2\xe8\x4a\x59\xf0\x5b\x80\xaf\xdd\xf4\x57\x32\x69\xaf\x6f\x4a\x99\x64\xf9\x43\x88\xb3\x5f\x22\x62\xab\x25\xa5\xf7\xee\x7f\x2d\x6d\xbb\x4c\xdb\xa3\x6b\xcd\xd7\x45\xf6\xf0\x39\x4e\x6f\x70\x9a\x35\xc0\xdc\x4b\xe4\xf3\x05\xa7\x90\xfc\x29\x95\xc8\x72\xe3\xa7\x6e\x41\xaa\xf9\xc1\xad\x86\xa6\x24\x53\xd0\x31\x6c\x5a\xb5\x26\x39\xaa\x3d\xc3\x59\x12\x47\x59\x70\x8b\x67\xa8\x36\x31\xc1\x5a\x98\x7c\x51\x22\x30\x85\xa5\xd1\x3d\xde\x7a\x84\x74\x69\xec\x98\xc5\x6a\x50\xa0\x66\x3c\x85\xc5\x5d\xac\x64\x4f\xa3\x92\x7b\x6f\xd8\x6f\xec\xe6\x4f\xe3\x12\x6d\xc3\x0c\xa2\x5f\x42\x7e\x1a\x53\x5c\x52\xe5\xde\xe3\xe8\xd2\xf8\x8e\x34\x75\x73\xe2\x26\x3a\xfd\x74\x5e\x2d\x6d\xd5\x3c\xd5\x10\xb9\x49\xc3\x12\xd2\x9f\x7e\xe0\x2f\x6a\x13\x9f\x11\xc0\xf0\xbf\xc7\x03\xa2\x59\x1a\xcf\x43\x77\xd3\x51\xd6\xff\x9e\xc5\xd1\x53\xf8\x75\xb1\x7c\x58\x40\xd3\x3c\xb3\x59\x86\xe4\x51\x1d\xf6\xfb\x6e\x3e\x33\xfe\xa9\x3c\x89\xb2\x18\x44\xcf\xca\x53\x4d\xf2\x77\x28\x90\xc6\x19\x34\xa5\xd9\x31\x42\x66\x30\xeb\x12\x0b\x82\x33\xa9\x17\x93\x86\x86\xe9\x2f\x73\x38\x3a\xcd\xf6\x94\xc3\x9b\xe4\xe1\xfe\x87\xa1\x5e\x85\x53\x63\x7b\x06\xd9\x11\x26\x63\x86\x3f\x63\xa3\xc4\x29\xdd\xad\xc1\x8b\xc7\x08\xc9\x0b\xbd\xde\x6c\x92\x86\x34\xa6\x59\x91\xe0\x32\xd8\x23\xdb\xd0\x19\x1c\x4c\x3b\xd3\x81\xa0\xd8\xbe\xba\x66\xcd\x0f\x07\x3b\xf6\xd5\xf5\x6c\xe6\xf1\x6c\x3f\x07\x61\x48\x0c\xe6\xeb\x44\x27\x92\x04\xb9\x4c\x7f\x79\x76\x6c\x4c\x87\x60\x5d\x69\x71\x7c\x33\x1f\xe3\x48\x4f\x71\x96\x38\xaf\xe0\x6f\x13\x64\x4c\x37\x18\x1c\x16\x02\xce\x2b\x28\x00\xbe\xd8\x76\x39\xf7\xc9\xad\xcb\xa4\x42\xfc\xcc\x98\xcd\x8c\x99\x4a\x4d\x49\x7f\x3d\x35\x6a\xfc\xb3\xa8\x09\x71\xde\xa0\xc9\xc0\x73\x57\x0f\xa0\x6a\xb7\x0d\x86\xec\xbb\x09\x27\x22\x2f\x1c\x4d\x33\x58\x83\x33\x6f\x5c\x34\x00\xd4\xf6\x2a\x35\x2a\xc9\x61\xa2\x94\xcd\x0c\xa8\xd6\x48\x94\xb9\xc7\x98\x0e\x54\x78\xf0\xed\xb4\xa4\xe6\x93\xea\x43\x68\x4a\xe2\x38\x74\x5e\x4d\x09\xe2\xaa\x03\x21\x86\xd1\x0f\x7c\xee\xbd\x6a\x26\x94\x52\x82\x36\x1b\xe1\x1c\xa4\x16\x88\xa0\xed\xa4\x43\x3a\x5a\x22\x23\x0a\xea\x2d\x15\x5a\xb6\xc3\x1c\x23\xaa\x45\x06\x91\x17\x16\x3e\xce\x38\x87\x44\x94\xb1\x98\x47\x8c\x3a\x16\x3d\x7b\xd6\xa4\x97\x31\x23\xb0\x8b\xbb\xaf\x36\xc6\x2e\xec\x49\x97\xc3\x32\xed\x48\x8b\x46\xf0\x84\x61\xd6\x33\x9b\xad\xb3\xb4\x06\xe5\x43\x83\xed\xa2\xea\x38\x33\x9a\x0d\x0d\xd5\x33\x4b\x24\x82\x23\xd4\x5b\xcc\xb8\xab\x67\x46\x23\x2e\x72\xd8\xd6\x65\x09\xa5\x96\x47\x5a\x53\x33\x16\xd0\x20\x35\x38\xb4\x55\x59\x90\xf0\x96\x59\xd7\xae\x9c\xbd\xb5\x95\xa6\xba\x03\x4c\x33\x72\x43\xc2\x77\x3f\xf0\x51\xa9\x14\x78\xf7\x3a\xd3\x0b\x12\x37\xc2\x21\x92\x03\x4c\xf1\x31\x66\xe8\xff\x23\xea\x09\x9f\x14\x80\x0d\xa4\x8b\x23\xcd\x6e\x68\x34\x98\x04\xfe\x53\x73\x89\x50\x42\xb4\xa7\x27\x36\x92\xe7\xaf\x4c\x95\xda\xb1\x9b\xe5\x8d\x43\xec\xa6\xf9\x00\xbb\xf9\xb3\xcb\x18\xf3\x14\xcf\x2b\x65\x2f\x8e\xe8\x26\xbf\xf7\xc0\x6b\xee\x95\x20\xc3\xf8\xbb\x7c\x9d\x7a\x31\x18\x64\x88\x6c\xad\xab\xcd\x90\x76\x8e\xd3\xdb\xc0\x83\xa3\x8f\xfa\x7a\x88\xd5\x02\x9a\x06\x39\x9e\xd0\xaa\x70\xcd\x26\x9b\x19\xa4\xb7\xfc\x43\xc4\x2c\xe9\xb7\x19\xf9\x5f\xd9\x7b\xdd\xc8\xe7\x5c\x99\x1b\x6d\xcd\xba\x7c\x02\x3f\xc4\xff\x1c\xa5\xcf\xea\x66\x7f\xbf\xc7\x4a\x2a\x8d\xd0\x7b\xca\x4a\x81\xee\x43\xfe\xcd\x40\xfd\x61\xcb\x58\xbe\xb4\x71\xc4\x28\xdb\x13\x5f\xb2\x5a\x54\x66\x73\x79\x76\x3c\xa7\x1d\xc1\x66\x43\x34\x92\x54\x92\xc5\x48\x4f\x79\xf5\xae\x7b\x2c\xdc\x20\x46\xbc\xa5\x05\x32\x2e\x97\xc7\x35\xc6\xb6\xe1\x0d\x9f\xae\x98\x46\xce\x0d\x03\x0c\x35\xdd\x35\x22\x7c\xd7\x00\x6a\xc8\xcb\x39\x5d\xdb\x73\xa3\x28\xce\x1b\x70\xb0\x23\x4e\x12\xdd\xac\xe1\x36\x78\x96\x9a\x74\x6f\x23\x2c\x73\xc7\x52\x76\x67\x78\x88\xa1\x6f\xf1\x3c\x61\x44\x6f\x8c\xdd\x2c\x7a\x99\x37\x06\x18\x47\x0d\xee\x74\x0a\x8e\x10\xcd\x06\xd5\xef\x0c\x05\x03\xca\xc7\xbe\xc6\x07\xfc\x17\xe0\xa8\x87\x59\x71\x16\x46\x93\xc1\xbb\x8f\xa0\xaa\x84\xee\x60\xbb\xea\x29\x9f\x90\x58\x87\xbb\xb2\x02\xb2\x0a\xa7\x9c\xf5\xdc\x38\x07\xd2\x1a\xf8\x9e\xdc\xc0\x06\x7e\x4e\x8a\x2c\x6f\xe0\x20\x1f\xe3\x14\x8e\x41\x21\x35\x1c\xa0\x96\xec\x41\x0d\x60\x9f\xb6\xca\x4b\x80\x5d\x19\x71\x0b\xc2\x61\xfb\x27\x54\x46\x75\x70\x67\x5f\x46\x22\x59\xfb\xb5\xd9\xf6\x09\x46\x38\x2a\x26\x38\x85\x19\x02\x1c\xe0\x82\x31\x1d\xfa\xdd\x82\x5b\x59\xc3\x60\x54\xb0\x38\xe2\xd6\x33\x5f\x59\xd1\x59\x19\x19\xce\x4f\x79\xd6\x1f\x86\x3b\xb5\x50\xc2\x1b\x1b\x37\xfb\x70\x90\x9a\xc7\xfd\xbe\x93\x1b\xb3\xfa\x3d\x9e\xbc\x76\x77\xe7\x45\x8b\x3d\x32\x0d\xe4\x9b\x1d\xfc\xb3\x81\xab\x97\xfb\x5a\xbd\xe8\x0f\x7e\x01\xaf\x17\xad\xae\xd2\xad\xe9\xd8\xc9\xaf\xa2\xeb\x5e\xdc\x2c\xeb\xea\xc8\x81\xc7\xc7\x17\x16\x8a\x9b\x72\x7d\x9d\x17\x2d\xa4\x11\x16\xc1\xd5\x45\xe2\xcc\xa6\xc9\x79\x03\xaf\xf1\x51\x7d\x2d\x30\x8a\x61\xaf\x11\xee\x0c\xf0\xe3\x3e\x41\x77\xe5\x6a\xc2\xca\x0a\xd6\xe5\xd6\x89\x0c\x14\x13\x18\xdc\x00\xcb\x67\x33\x9d\x59\xc9\x80\x57\xf3\xb1\x9e\xc1\x9b\x79\x91\x15\x96\x78\x90\x97\xca\x5f\x4a\x94\x79\xb8\x19\xc7\x2e\x8f\xea\x79\xc9\xfa\xc7\x47\x46\xf2\x48\x6d\xa2\xdc\x30\x9a\x6e\x92\x84\x0f\x34\x89\xcb\xf7\x0f\x0c\x61\xb4\xc0\xa5\x27\x4c\x81\x9e\xa3\x2b\xb2\xca\x66\x36\xfc\x98\x81\xa9\x79\x67\x55\xd0\xe5\xf7\xc1\x63\x8c\x85\x3b\xbf\x4b\xeb\x8b\x3c\x13\x0a\x96\xc7\x47\xc1\xea\xd0\x0c\x77\xf8\x34\x04\x77\xa4\x31\x4c\xca\x36\x78\xec\x39\x3a\xff\xc0\x5d\x83\xcc\x50\x05\xc0\x1e\x3f\xb7\x90\xb5\x6d\x88\x63\x8d\x35\x73\x6d\x84\xb4\x35\xad\x84\x68\x17\x1a\x1c\x75\xc0\x30\x7c\x0d\xec\xd5\x4b\x02\xc4\xea\xd0\xe8\x15\xf2\xf8\x9b\x67\x76\x89\x54\x8e\xa9\xd4\xae\xbb\xbc\xfa\x44\x39\x47\x73\x8a\xff\x1d\x6a\x17\x0f\xb5\xff\xbf\xff\x1d\x6b\xff\x77\xac\xfd\x9f\x32\xd6\xc2\x01\xd0\x3a\xd8\x27\x89\xf5\x02\xcc\x93\xfc\x4f\x19\x7b\xa1\xec\x27\x07\xd9\x22\x54\xd4\x5f\x5f\xe0\x15\xe1\x4c\xde\x87\x85\x75\x04\xd9\xc5\x10\xc5\xc8\x77\x6e\xa2\x3f\x3a\x3b\x0b\x8b\x08\x03\xb6\x56\x8e\x50\x5d\x41\x61\x30\x03\xd5\xa0\xe3\x38\xd1\x7f\x94\x07\x96\x29\x65\x8b\x8a\x0e\xd2\x1a\x93\x38\xc5\xe2\x1a\xc6\xec\x89\x61\x3f\x91\x87\x7d\xc8\xa9\x76\xe0\xe7\xfb\x91\x75\x71\xa0\x4a\x47\x23\x63\xc1\xac\x90\xcc\xcf\x0a\xe2\x5c\x6d\x73\xc3\xe8\xcd\xdd\xf2\x23\xfa\x86\x73\x75\x85\x9b\x81\x8f\x62\xa4\x69\xd7\xd7\xec\x94\x1c\xde\x0f\xcd\x7a\x61\x53\x18\xc2\x74\xd2\x1e\xe4\xb2\x65\x90\x17\xad\xbd\xb8\x09\x4f\x49\xc3\x0c\xdc\xc6\x48\xa7\x75\x14\x68\xcc\xd3\x91\x3b\x25\x1a\xe4\xb3\x69\x10\xff\xae\x39\xdd\x9b\xa3\x04\xbc\x6c\xf6\xdb\xed\x4e\x70\x60\x16\x21\xf1\x95\x4d\xad\xc1\xdb\x51\x1c\xe1\x5e\x02\x5e\x33\xa2\x91\xdd\x9a\x35\xfb\x56\xf7\xae\x73\x67\x86\x01\x92\xf0\xd9\x75\x7f\x3b\x88\xc0\x3e\xa7\x84\x64\xbb\xc3\x1c\xa7\x53\x66\x12\xca\xd6\x50\x43\x93\x63\x43\x37\xcb\x4d\x7a\x43\x6f\x40\xae\xbd\x94\x98\x64\x41\xb9\x00\xb7\x92\xa9\x36\x7b\x09\xcc\x83\xcb\xef\x94\x03\xce\xb4\x08\x6d\x4d\x90\x07\x66\xc1\x6d\x4d\xe4\xa4\x55\x56\x4a\x52\x4f\x67\x61\x68\x83\xc8\xc1\x57\x16\xbc\x59\xd4\xc0\xff\xe5\x55\xa7\x6a\xc1\x04\x82\xca\x74\x26\x9a\x75\x90\xc7\x6e\xf9\x88\x1e\x7c\x5d\x3a\x69\x93\x39\x26\x50\xbb\x99\x50\x0e\xb4\xb5\xdf\xff\xd5\xa0\x28\x97\x67\xc7\x8e\xb6\xca\x13\xc0\x1d\xbb\x55\xbc\xaa\x35\x7e\x5f\xd3\xc4\xb6\x20\xbc\x84\x87\xad\x07\x37\xd7\x5d\x83\x7f\x5d\x85\xd7\x46\x13\xac\xd1\xea\xda\x37\x98\xf2\x4b\x54\x09\xc8\x4b\xa6\x6b\x40\x20\x31\x77\x80\x5c\xbd\x88\x30\x79\x74\xa9\xe3\x08\x6c\xa2\x5e\x9e\x1d\x89\x8e\xa3\xff\x79\xfe\xe1\x3d\x13\xfd\x60\xf8\x40\xce\xd7\x89\x0d\x1a\x4a\xe1\x89\x9b\x24\x41\x34\x02\xb2\x61\x5b\xd8\x06\x25\x13\x1c\x09\x06\x71\xb4\x06\x1b\xdb\x3d\xb8\x36\x91\xe1\xdc\x29\xf2\xa1\xb9\xd5\x03\xbb\x95\x1b\x5d\xa4\xad\xe6\xac\x32\xa4\xea\xda\x6a\xc4\xea\x58\xca\xa9\xcc\x25\x4a\xe9\xd5\x35\x4b\xd3\xc8\x85\x1b\xb9\x12\x4d\x0c\x58\x64\x64\x53\x87\xb3\xb2\x27\x82\x8e\x2b\x72\xb9\x6a\x5f\xef\x68\xff\xff\x09\xf6\x03\xb7\xa1\xad\xe6\x57\xed\xeb\x55\x6d\xaa\xad\xc6\xab\xda\x4c\xb3\xe3\x19\xe7\xa7\x66\x40\x37\x97\xe6\x4b\x50\xe3\xa7\x73\xdd\x19\x43\x6f\x74\xae\xae\xd8\x35\x44\xda\x9d\xf9\x44\x1a\xc3\x4d\xf6\x14\x3c\x53\xfd\x41\xe8\x63\x13\x6a\xca\x27\xd4\x90\x6c\x58\x5e\xa5\xd7\x57\xad\xeb\xde\x9c\x57\xa1\x10\x46\x0a\xb8\x23\x0d\x16\xc2\x20\x4b\x9a\x13\xbf\xeb\x52\x66\xe3\x3a\xf8\x2a\xad\xc9\xc0\xbd\x6a\x5d\xaf\xac\xc4\x57\xf0\x7b\xfd\xf8\xa8\xc3\xab\x07\x17\xaa\x0f\x7f\x9c\xc8\x86\xa7\xcb\xe4\x53\xd3\xb5\x55\xf8\x58\xd5\xe8\x9e\x8e\x4e\x9a\xc6\xd0\xc4\x60\xe1\xc2\x28\x8b\xf2\x9a\xdd\x86\xea\x85\x32\x55\x87\xc0\x35\x3a\x04\xbe\x8a\xae\x51\xea\x8c\xaf\xe2\x66\xe0\x93\x0e\x96\x82\xa1\xcc\x14\x0f\xb3\xd5\x55\xc1\xba\xd0\x69\xf5\xc2\x3f\x52\xb0\xb9\x98\x0b\xc6\x85\xab\xab\x06\x03\x5d\x85\xd7\x7a\x2c\x3e\x29\xcb\x7b\xe1\x1f\xf1\xc2\x04\xb4\x22\x85\x94\x08\x2e\xe6\x92\x7b\x76\x82\x68\xd7\xb9\xba\x06\x6f\x62\xf5\x19\xb9\xf5\x59\xf4\x58\x4d\x9c\x69\xe0\x83\x8f\x75\x1f\x6c\xa4\x67\xb6\x85\x08\x92\xed\xce\x6a\xac\x3e\xf2\x02\x23\x28\x50\x92\x92\xf9\xb6\x0d\x49\xdb\x12\x1f\x95\xd0\x8b\x76\xc2\xab\xd6\xf5\x2a\xfd\xb6\xe1\x1b\x05\x4e\x78\x65\x5d\xa3\xcc\x09\xaf\xda\xd7\xc8\x73\xc2\xab\xce\x35\x2a\x9c\xa9\x97\x65\x76\x80\x88\x98\xdb\x19\x12\xdd\xd6\xf6\x66\xbd\xf8\xca\xbd\xde\x81\x3f\x0a\x67\x0c\xb0\x70\x09\x5f\x10\x43\x2a\xe3\xb2\x2a\x5c\x15\xd7\x33\xa1\xb5\x44\x73\xeb\x0a\x20\x33\x72\x46\x3a\x66\xf7\x19\x8f\xa2\x9c\xbb\xf4\x93\xb4\x75\xa6\xa9\xef\xc5\x45\xe8\xc3\xfa\x61\x18\x44\x3e\xb9\xdb\xfe\x10\xe2\x06\x7d\x91\xda\xa4\xd7\xc4\x93\x34\x1e\xb8\x83\xf0\xa1\x31\xc1\x6e\x94\x35\xf2\xb1\x9b\x37\xf2\x31\x6e\x10\x35\xb2\x31\x8c\x53\x12\x7a\x59\x16\xf6\xb2\x91\xb8\xa9\x3b\xc1\x39\x4e\x1b\x41\xd6\x60\xc6\xcd\x9a\xec\x62\x6f\xec\x3c\x5c\x3d\x30\xbe\x9a\x16\x11\x38\x62\x40\x8b\x5c\x63\xa2\x99\xec\xe6\x46\xbc\x13\xcb\x2e\xe8\x76\x22\x56\x19\x71\x31\x53\x89\x06\x5e\xc1\xeb\x91\xc8\x67\xf7\x3a\x0d\x7b\x2e\x41\x24\x5f\xd2\x44\x0f\x94\xb7\x39\x75\x71\x4b\xd6\x39\x83\x38\xcf\xe3\x89\xf6\x42\xa1\x63\x8e\x61\xcc\xee\x94\x54\xfb\xb2\xb2\x8c\x07\xbb\xf9\xcb\x66\xe3\x04\x56\x3c\x03\xdc\x78\x99\xc7\xc9\x4b\x58\xeb\xbc\xa4\xf9\xbf\x04\x3e\x54\xa9\x95\x24\xd2\x55\x6e\x7b\x29\x8e\xf7\xd8\xb4\xf7\xc2\xea\xc9\x50\xe5\x42\x2b\x33\x5e\x93\x3b\x0f\x4d\xb8\xb0\x7f\xff\x61\x08\x37\xfd\xf2\x57\x4e\x0b\x9e\xc6\x65\x30\x2b\xc0\xb6\x87\x25\xcd\x42\x41\x39\xb6\xf3\x27\x6a\x55\xe5\x90\x28\x21\x62\x03\xaf\x81\x9b\x6e\x9e\xa7\x19\x33\x5f\x0a\xb7\xf3\xd7\xbc\x2c\xd3\x90\x07\x0a\x34\x8d\x03\xd5\x1c\x7a\x16\x92\x96\x94\xd9\xd3\x05\x11\x7b\xc9\x4f\x96\xc3\xe1\x29\x0e\x1d\x4a\x5b\x36\xc6\x38\x7f\x92\x00\xf0\x51\x99\x1b\x53\xb6\x3e\x20\xf7\xd4\xf2\x1a\x3b\xc1\x91\x31\xc5\xea\x85\xe8\x08\xc1\x42\x0b\x0e\x44\x45\x5e\x85\xd4\xd7\x50\x8c\x52\x14\x82\x20\xe7\xa5\xb2\x08\x7b\xba\x5e\x96\x91\xb6\x0c\x1d\x29\x42\xa7\x70\xf4\x22\xe4\x6a\x8c\x28\xd9\x98\xc2\x35\x4c\x2f\xcb\x9c\x90\x1c\x27\x37\xe1\x11\x48\x88\x73\x6e\xc9\xd7\x73\x6e\x57\x57\x7b\x91\xd3\x7f\x7c\xd4\xfb\xe4\x16\x1f\xbc\xa3\xf5\x9b\x83\x20\xf2\x89\xc0\xa0\x08\x79\xf0\x0e\x08\xa5\xf3\xd0\x16\xbb\xc4\x8c\x99\x56\x73\xe2\x26\xb5\x9a\xd3\xe5\xd9\xf1\x22\x38\x6b\x2e\xca\xc0\x65\x78\x29\xbe\x8d\x6f\x9e\xc0\x7b\x1d\xc6\x83\xda\x08\xd0\x85\xc0\x99\x2b\xb4\x0e\x8a\x9d\xa1\x52\x91\x1c\xae\xf4\xf2\x34\xba\x31\x75\xe1\x79\x44\xd4\x04\xd3\xdf\x2b\x2b\x35\x45\xeb\x34\xce\x98\x11\x6f\xaf\xc0\x32\x14\x3b\x89\x9c\x65\x4d\x86\xe5\xd9\x2f\xa8\x68\xe5\x1c\x4b\x37\x44\xd8\x5f\x68\x45\x32\x66\x79\x59\x06\x9b\xbf\x64\x68\x27\x00\xf2\x05\x20\xc1\x68\x02\x16\x21\xd6\xee\xbd\x58\xc7\x0e\x7f\xd8\x92\xea\xf2\x20\xe0\x97\x0f\x9c\xd8\x65\xc2\x1d\x4d\xb3\x63\x28\x12\xc4\x0c\x37\x89\xd4\x9f\x83\xd4\x1b\x72\x00\x10\xc0\x53\xb4\x73\xa7\xe7\x28\x65\x63\x1b\xe4\x10\x56\xbb\x1c\x60\xc1\xe8\x01\xb6\x84\xe1\xe9\x31\xd1\xff\x01\x90\xf5\xdc\xab\xfc\x7a\x65\x45\x1d\x57\x00\x66\x20\x97\x0d\xdb\x3b\x58\x1d\x5c\x43\x44\xe2\x6d\xac\x0c\x6b\xa1\x5c\xa3\x44\x9e\x9b\x08\x9d\xc4\xe5\x2e\x61\x15\x54\x29\x5e\x59\xa9\x74\x3a\x8d\xc4\x69\xb0\x6d\xf0\x74\x7d\xa3\x9e\x50\x1c\x7a\x8a\x03\x54\xa3\x32\x3e\xca\x93\x40\x4f\xa5\x77\x11\x87\x22\x43\xae\xc8\xb0\xb2\xac\x24\x55\x01\xe7\xdd\xa2\x79\x55\x17\xbb\x5e\x1c\x81\xe5\xfe\x8b\x78\x77\x90\xc5\x61\x91\xe3\xcb\x14\x96\x8c\x69\x4f\x5f\x10\xf7\xf8\x18\x82\x27\xd2\xd8\xb9\xd7\x63\x83\x3c\x32\xd4\xe3\x55\x47\xfb\x16\x95\xcb\x93\x27\xd5\x7d\xa1\xe0\xff\xea\xba\x22\x85\x75\x05\x5d\x05\xb0\x07\x5d\x0e\xcc\x7d\xd0\x57\xf5\xab\xf8\x1a\x4d\xa1\x8f\xda\xe5\x58\x3c\x03\x03\x4b\x98\x74\xb1\x1e\xfd\x71\x6a\xc6\x09\xdd\x35\x50\x50\xdf\x3d\x03\x83\xdc\xfe\x1c\x83\xce\x35\x29\xfb\x21\x9f\x29\x78\x3f\x9c\x5b\x60\xd4\xf9\xd2\x84\x5d\x3c\x58\x64\xd7\x6f\xf3\x10\x75\x79\xe0\x4c\xf4\xb9\xbc\x98\xbb\xde\x95\x95\x1a\xfb\xd6\x6e\x18\xae\xac\xbc\x60\x86\xb7\xdc\x3c\x1e\xcc\xe0\x35\xd8\x1c\x9d\xb0\x53\x50\x25\x35\x5a\x4a\xeb\x55\x04\x76\xe7\x61\x5e\x71\xb0\x64\x83\x22\x02\x42\x89\x41\xb5\x9a\x15\xaa\x30\xc0\xdd\xfc\x51\xe0\xf4\x81\xfb\x01\xd0\x31\xbc\x34\xed\x53\x9b\xcd\xb7\x4e\x0b\x3d\x80\x1a\x0b\xef\x93\xad\x4d\xe5\x25\x46\x99\x23\xf4\xc5\xdc\xc9\x1f\x1f\xa7\xb0\xaa\x22\x33\xa6\xc3\x37\x8a\x4b\x1a\xe9\x3c\xbb\xc3\x7e\x6d\x82\x2b\xa6\xa4\xc7\x47\x79\x82\x72\x06\x3a\x50\xce\xc6\x05\xd0\x02\x49\x7c\x19\x74\xc8\xbd\x17\xad\xc4\xd9\xcd\x25\x8c\xdd\xdc\xe1\xfa\x97\xc1\x9e\x84\x50\xd5\x9c\x73\x35\x86\x67\x36\xd2\x50\x2c\xdd\xf6\x0e\xa1\xb6\xae\xd3\xea\xb9\x7f\x44\x6c\x80\xea\xb9\x5c\x5f\x0f\x9c\xe8\xca\x05\x75\x7c\x7c\x15\x90\xf5\x0d\xa8\x0d\xc3\xcc\x34\x51\x48\x35\xc0\x8c\xdc\xdc\x62\xed\xe8\xf1\x52\x63\xdd\x43\x39\x5d\xe5\x41\x2e\x34\xf7\x70\x2e\x77\xd0\xf2\x5d\xa2\xc2\x42\x67\xa7\x59\x97\x84\x15\x4e\xab\x57\xfc\x91\xa9\x2b\x97\x62\x75\xd5\x60\xa0\xab\xe2\x5a\x37\x7a\xec\x8d\xda\xf8\x2a\x03\xfa\x66\x60\x49\x0f\x12\xdf\x39\x73\xfb\x8d\xe5\xd2\xbb\x76\x8f\x10\x5f\xe5\xd7\x4e\x84\x60\xf8\x03\x5f\x88\xfa\xeb\x38\x0e\xb1\x1b\x29\x1b\x12\x33\xbd\xb2\x31\x65\x4c\xeb\xc4\x83\x4b\xb5\x24\xb9\x62\xf3\x9f\x77\x16\xd6\x27\xb8\x39\x35\xf6\x52\x6f\x4e\x5f\x1e\x06\xf7\x30\xe2\x35\xd8\xa5\xe3\x8c\x75\x36\x91\x4e\xa3\x4b\x13\x30\x63\xc6\xd6\xf2\xa2\xa4\xca\x63\x06\x98\x37\xc8\x26\xaf\x17\x87\xab\xda\xda\x9a\xb6\x9a\xd3\x5b\x30\xb1\x13\xad\xe6\x4d\x70\x35\x06\xd7\x2f\xc4\x39\xd4\xda\xb7\xb5\xab\x7f\x7f\x5b\xbb\xfe\xfd\xbf\xd6\xc8\x01\x15\x6c\x21\x95\x91\x45\x1a\x7e\xcb\x7e\xff\xa6\xeb\xfa\x8e\x7d\xf5\x6f\x43\xbf\x7e\xfc\x26\x3e\x57\x1f\xbf\xe9\xe4\xe3\xf7\x6f\x86\x41\xfe\x33\xbe\x19\x6b\xa3\xa0\xc2\x39\xe0\x51\x4a\x54\xbb\x60\xa2\x4b\x27\x62\xff\xd6\xf4\xe6\xef\x86\xf6\x5f\x6b\x15\x7c\x56\x9b\x7c\x26\xe3\xbe\x04\xdc\x97\x4b\x70\x81\x3d\x6b\xff\xd6\xff\xf5\x48\x76\x76\x1e\x89\x3b\xde\x6f\x6b\xdf\xd6\xc8\x57\x46\x3f\x89\x47\x54\xf8\xfa\xb6\x66\xac\x05\xf4\xf9\x51\x2a\x1e\x17\xd2\xb7\x20\x21\x17\x9e\xd0\x01\x61\x4d\xc5\x02\x01\x58\x69\xec\xa4\x76\x15\xaa\x19\x3b\xd1\x6a\x6a\xc7\xab\xa9\x44\xee\xb7\xe6\xb7\xb5\x35\xa4\x69\xe0\xcd\x38\x0d\x75\x6d\xb5\x32\x81\x84\xc6\xaa\x66\x94\xbb\x64\x8d\xb4\x66\x8f\x82\x6f\x03\x59\x5b\xd6\xff\xfd\x1b\xb2\x6b\xbf\xbf\xf8\x16\x35\x7e\x6f\xbc\x8e\xe3\x1c\x6c\x3a\x27\x8d\xdb\x4e\xb3\xd3\x5c\x6f\xe8\xcc\x31\xf2\x08\xe7\x03\x1e\x07\x7e\xbd\x0d\x82\x0e\xb6\x47\x53\xb0\xd6\xd5\x68\xb7\x2c\xcb\x6c\xb7\xac\x8d\xc6\xc5\x5d\x00\x3e\xeb\x50\xe3\x28\xf2\x9a\x04\xeb\x38\xf0\x70\x04\x37\x27\xa0\x9f\xa5\x8d\x93\xa3\x0b\x9a\x6d\x06\xf9\x06\xf9\xb8\x18\x40\x8e\x6b\xf9\xdd\x20\x5b\x13\x85\xac\x0d\xc2\x78\xb0\x36\x01\x0b\xa6\xe9\xda\xf1\xd1\xde\xc1\xfb\xf3\x03\x52\xe8\xda\xb7\x08\xb4\x04\x4a\xef\x5b\x1c\x81\x71\x75\xc8\x1b\x86\x6c\xb2\x46\x2f\xeb\xb0\x57\x64\x79\x3c\x09\x7e\xe2\x74\x61\x3d\xd6\x3c\x8e\xb3\xb6\x13\xf8\x8e\xef\x6e\xe3\x2e\x6e\xaf\x6f\xb5\x3b\x5b\xed\xcd\x75\x77\xcb\xdb\xc0\xde\xb6\xdf\xee\x0c\x06\x83\x36\xde\x6e\xf3\x6a\xc3\xb9\x7e\x23\x73\x6f\xb1\xdf\xc8\xe3\x06\x3d\x7b\x22\xd7\x59\xc9\x4e\x56\x59\xb9\x2c\x6f\x4a\x35\x7c\x2a\x77\xc8\x7c\x6d\x41\x53\x6c\xfc\x72\x53\xac\xff\xf7\x37\xc5\xda\xef\x2f\x1a\x11\xb8\x63\x0d\x83\x9f\x64\x49\x07\x62\xd3\x6a\x76\x1a\x8f\xa4\x95\x59\x69\x8d\xc7\x86\x94\x77\x84\xbd\x38\x74\xb3\x35\x35\xdd\xef\x6b\xe3\x7c\x12\x4e\x25\x2f\xb4\x76\xe6\x46\x99\x99\xe1\x34\x18\xf6\xcc\x49\x66\x82\x36\x26\xbb\x9b\xb5\xad\x56\xeb\xb7\x9e\x79\x87\x07\x37\x41\x5e\x1f\x3b\x83\xab\x88\xd3\x89\x9b\x12\xaf\x91\x33\x17\xdc\xb2\x86\x18\xb9\x59\xe0\x63\xe4\xe3\xdc\x0d\xc2\x0c\x0d\x83\x11\x18\x2b\x0f\xe2\x08\x3e\x8b\x14\x3c\xbc\xc4\x20\xc0\x30\x93\xc3\x0f\xb8\x09\x4e\xd0\xc4\x0d\x22\x34\xc1\x51\x81\x22\xf7\x16\xb1\x0b\xb0\x28\x2b\x26\x13\x37\x7d\x10\xa7\x12\x83\x30\xf6\x6e\x66\x6e\xe1\x07\x31\x3c\x70\xbc\x75\x33\xc4\x6d\xc8\xa3\x5b\xf0\xb4\x25\x30\xe9\xf9\x85\x49\x12\xf4\xb8\xc7\x58\x93\x38\x05\xb7\xb9\xbf\x63\x9a\x91\x1d\xc5\xb9\x7e\x05\x47\x10\x69\x1c\x66\xd7\x86\xc8\x82\x9c\x97\x50\x7f\x54\x76\x6b\x76\x35\x0e\x7c\x1f\x47\xd7\x28\xc7\x93\x04\xac\x95\x2a\x78\x33\x77\x5a\xba\x3c\xa6\x0e\xf6\x6c\xb2\x58\xa7\x5b\x2c\x33\x17\xdc\x51\x05\xb7\x18\xb9\xf6\x18\x5c\x63\x4e\xe3\x22\x07\x12\x80\x6d\x83\x41\x7a\x45\xfc\x45\x5d\x4f\x07\xc4\x21\xa6\x49\x95\x18\xdb\x4a\xee\x1b\x7e\x9c\xe7\xd8\x9f\x0d\x88\xcf\xb9\x68\x34\x95\x5c\xf5\xda\x9b\xad\xd6\xcc\x1f\x46\xd3\xd2\xe3\xaf\x1d\xe4\x6e\x18\x78\xb3\xb1\xc5\x80\xe0\x64\xb8\x8d\x27\x3d\xd6\x48\xcd\x8d\x4d\x3c\x69\xb4\x66\x13\x37\xbd\x91\x08\xb6\xff\x35\x1c\xb6\x7a\x94\xea\x7f\xb5\x5a\xad\x59\x36\x71\x43\x26\x2d\xd0\xe8\xf6\x56\xeb\xb7\x59\x56\x0c\x50\x56\x24\x12\x74\x73\xfd\xb7\x1e\x54\x82\x39\xed\xb2\x5b\x3d\xee\xbb\xcd\x26\x06\x5d\x83\x5b\xbc\x90\xf5\x90\x13\xb8\xd7\x31\x9b\xeb\x78\x02\x79\x4f\x59\xa5\xcd\x66\x1b\x20\xc1\x64\xc4\xb8\x61\xb7\x66\xd9\xed\x88\xb4\x92\x0d\xb6\x46\x8c\xa9\x70\x4f\x4a\x9b\x64\x46\xe5\x8a\x0b\xa2\x85\x27\x8d\x6e\x2b\xb9\x9f\x8d\xd3\x29\x17\xdf\x41\x7c\x0f\xb2\x0d\x9e\xc9\xd8\x59\x93\x39\x88\xef\x7b\xe6\x24\xfe\xb9\x28\x6e\x01\x58\xc8\x43\x92\xe2\x92\x10\x70\xdc\x3e\x83\x65\x11\xba\x19\xf8\xe0\xb1\x13\x65\xee\x24\x51\xba\xdb\x24\x8e\x62\x62\x3d\x12\x89\xaf\x5e\xc9\x4a\x0b\x4f\x66\xd4\x50\x39\x22\xee\x1c\x50\x9c\xe4\x20\x4c\x09\x62\x5e\x7f\xb8\xf3\x0e\xb8\x2c\x19\xa7\x76\x10\x8d\x71\x1a\xe4\x24\x07\x11\x60\x0c\x68\xb1\x9c\x4a\xf2\x6e\x83\x2c\x80\x07\x4f\xac\x04\x9a\xe5\x14\xb2\x34\xc5\x34\x48\xc5\x98\x61\xc0\x60\xd1\x20\x84\x5c\xc1\x84\xea\x50\xf0\x35\x92\x40\xc4\x47\x83\x02\xa1\x7e\x18\xae\x05\xd3\x61\x59\xec\xa6\x70\xbd\xd0\xa6\xe9\x7b\xcc\xe7\x39\x73\xa5\xca\x0a\xbb\xf2\x83\xcc\x05\xb7\x06\xd7\x72\xb1\x02\x38\x65\x89\xd8\xd9\x2c\x4b\x64\xdb\xa4\xed\x88\x0b\x0c\x33\x88\x22\x9c\x52\x4a\xe6\xe1\x42\x8a\xa4\xa3\x4f\x82\x3a\x95\x45\x97\x8e\x96\x33\xa9\x36\xe4\x39\xce\x20\xbe\x57\x2b\xed\xfa\x41\x5c\xd6\x50\x92\x11\xd1\x73\xe7\xa5\x4a\x8a\xaa\x87\x56\x08\xa3\xfc\xa5\x07\x47\xd7\xb6\xcd\x0b\x23\xb5\x34\xb3\x24\x88\x4c\xd6\x4a\xcb\xb0\x89\x5d\x06\x19\x7b\xca\x6a\x4a\x44\x55\x4a\x99\x61\x37\xf5\xc6\xb5\xad\x06\x02\x32\x0c\x70\xe8\x8b\x69\x60\x41\xa7\xf8\xf5\x7e\x54\x43\x41\x49\x3b\x05\x98\x1e\x10\x11\xd6\x54\x76\x51\x82\xd2\x69\x65\x5d\x6d\x88\x7c\x93\xea\x64\x38\xe7\x52\x01\x43\x6c\x16\xc3\x56\x7c\x16\x84\xb7\x38\xe5\x03\x65\xab\xd1\x4e\xca\x86\x69\x76\xd6\xf1\xa4\xd1\xdc\x80\x91\xa9\xd1\xdc\x84\xf1\x29\xc4\x23\x1c\xf9\x75\xc2\x25\x7a\xaa\x3a\x3a\xf0\x0e\x3d\x37\x82\x93\x6b\x33\x2c\x23\x98\x39\x88\x11\x72\x9b\x7f\xf4\x58\x04\x73\xa5\x68\xb7\x66\xb9\x8f\xf2\xf1\xb4\x2c\x0f\x94\x84\x73\xb2\x6d\x64\x37\x6a\x74\x8d\xf1\xfa\x20\x21\x93\xff\xba\x39\x88\x83\x10\xa7\x64\xf2\x52\x74\x8e\x2c\xf5\x60\xef\x7d\x0d\xe6\x60\xa6\x2d\xb0\xa3\xd4\x04\xac\xe2\x4e\x7f\x47\xf4\xf0\x1f\xf1\xa3\x7d\x69\xe6\x90\x26\xb9\x17\xc1\x04\xb4\x65\x37\xca\xa5\xa9\x44\x02\xf2\x36\x21\x12\x31\x76\x7d\x70\xee\x17\x47\x58\xc2\x58\x1c\x03\x4c\xad\x8f\x9a\xb9\xc8\x25\x03\x5c\x8e\xfd\x69\xc5\x77\xa9\x2d\xbc\x85\xce\xdc\x2b\xd8\x57\xba\xae\xde\x62\x68\xe8\x5a\x03\xf6\x25\x74\x88\x35\x1a\xb0\xea\x90\xe6\xe3\x85\xd8\x64\xba\x66\xe8\x24\xe3\x7f\x3b\xda\xbf\x34\x96\x3b\x12\xa0\xef\xee\xad\x9b\x79\x69\x90\xe4\xb6\x36\x97\x99\x36\x23\xca\xc9\x8f\x22\xce\x31\x4c\x19\xf3\x22\xf9\xaf\xed\xed\xed\x5e\x02\xce\xe2\x89\x4b\x02\x33\x88\x40\xb3\xb2\x5d\x78\x21\x3e\xcb\x41\x7f\x12\x3a\x08\x11\x22\x13\x40\x38\x35\xa1\x69\x92\x59\x30\x19\xa1\x3c\x9d\x2e\x4a\x0f\x13\xec\xc4\xbd\x37\x89\xfb\x4e\xa2\xec\x49\x3c\x1d\xb7\xd1\xb8\x83\x92\x69\x9c\x26\x63\x37\xca\xec\x4e\xef\x2e\xf0\xe3\xbb\xcc\xee\xd0\x28\x39\x57\x52\x67\x96\x69\xf3\xfe\xa7\xe9\xbe\x36\x23\xf7\x76\xe0\xa6\xaa\x82\xd4\xec\x77\xcc\x2f\x97\xa1\x39\xc8\xa3\x57\xcd\xcf\xc3\x2f\x67\x5f\x4d\xcf\x4d\x71\x8e\x9a\xf7\x1f\x4f\xba\x5f\x4c\xf0\x85\x54\x24\xaf\x16\xa1\xf1\x0e\x22\xfc\x17\x57\xa4\x6b\xd6\xec\x77\x46\x37\x6f\xcf\xcd\xd0\x1d\xe0\xb0\x86\x97\xa0\xd6\x34\x87\x87\x87\xeb\xa1\xb9\xbc\xc7\xc9\x79\x4a\xf8\x8d\xdc\x47\x6a\x78\x3c\xaf\xf2\xfd\x6b\x38\x1c\xaa\x34\xfd\xb8\xbc\x39\xa3\x09\x4c\x5a\x20\x2c\x69\x7c\xb4\x28\x66\x5c\x43\xb9\xef\xfb\x52\x9e\xb3\xb9\xde\xa8\xf4\xab\xff\x78\x36\x9a\x95\x0b\x05\xa2\xe4\x59\xad\xe4\xbe\x5c\x06\xb8\x89\x39\x0e\x46\x63\xe2\xac\x9f\xd5\x39\x1d\x0d\x5c\xbd\x85\xc8\x3f\x83\x2e\x09\x64\xbd\xe7\x10\x87\xb7\x18\x54\xc0\xc6\x7b\x5c\x60\x24\x82\x68\x37\x0d\xdc\x10\x49\xab\x10\xa9\xcc\x6e\x72\xaf\x68\x96\x56\xb3\xdb\xde\x5a\xdf\xb4\xba\x1d\x3e\xb4\x74\x3a\x9d\x5e\x2d\xfb\x55\x35\xaa\xaa\x3d\xc9\x94\xc9\x3a\x14\x2d\x97\x43\xe4\xa2\x19\x6c\xc6\x35\xaf\x7f\x75\x3a\x9b\xee\x60\xb3\x57\x1d\x6a\x60\x54\x9a\xb9\x36\xd1\x48\x84\x96\xcf\x92\xb4\x3b\xeb\xed\x4d\xaf\xb7\x64\x74\xa2\xe9\xc4\xaa\x20\x1f\x07\x11\x53\xfd\x7b\x1c\xb6\x9e\xdc\x37\x60\xf2\x6e\xf0\xc6\x20\x49\x4c\xd8\xed\xa2\xd5\xe7\x98\x26\x73\x8f\x6d\xb6\x93\xfb\x8a\x6e\xdc\x82\x51\x61\x5a\xd1\xc9\x27\x81\xef\x87\xd0\x41\xdb\x3f\xcf\xf2\xcc\x0c\x26\x23\x33\x15\x4f\xd7\x44\x27\x26\x83\x55\x4f\x1d\x31\x7a\x8c\x47\x40\x16\xb9\xe0\x65\xdd\x7d\xa6\xe9\xa1\x51\x30\x9f\x1e\x4d\xe6\x74\x7e\x23\xb9\x87\x2e\x31\x39\xfd\xf4\x9a\x60\xe5\xe3\x62\x32\x88\xdc\x20\x14\xb3\xd9\x92\x76\xaf\x6d\xec\x5e\x6d\x6f\xe9\xa9\xc5\x76\x65\x01\x16\x1e\x62\x6c\xb8\x65\xdd\x6c\x67\x0d\xec\x66\xd8\x0c\x22\x33\x2e\xf2\x9e\x19\x3f\x85\xf1\x44\x74\xed\xf2\x73\x39\xd3\x92\xb7\x6f\x27\x84\x1d\x5e\x90\x7a\x21\xae\xf0\x6c\xbd\xf5\x1b\x2c\x64\x68\xfb\x99\xb0\x62\x6a\x43\x87\x64\x61\xb6\x66\x22\x20\xc6\x8a\x16\xaf\x3d\xe0\x4a\x7c\xc1\x98\x34\x71\xe2\x9b\x7f\x99\x59\x6a\xc6\x51\xf8\x30\x15\xcb\x35\x97\x1d\x1d\xf5\x18\x99\x89\x58\xe8\x58\xa2\x30\xdb\xb4\x24\x95\xa8\xd5\x13\xea\x0d\x5d\x85\xf5\xc0\xa1\x88\x9d\x62\x2f\x17\x83\x01\xa3\x84\xdc\x35\x6c\xc7\x67\x77\x05\x2f\x98\xea\xe7\xe4\xea\x34\x5b\x16\x2f\x46\x20\x5f\x25\xa5\xf0\x50\x38\xf0\x18\x9d\xc0\x42\x99\x9d\x9c\xd2\x56\xaf\xba\xf4\xa1\xd4\x01\xce\xec\x0a\x5c\x39\xf3\x15\xcd\xb4\xb2\x24\x69\xf6\xad\xa3\xcf\x83\xc0\x1c\x6f\xc0\xa5\xc8\xc3\x9f\xdb\x5f\xcd\x71\x07\x3e\xcf\xf2\x83\x2d\x73\xdc\x86\x81\x3b\x18\x75\xbf\x98\xe3\x75\x80\xb6\x2e\x0f\x5a\xe6\xb8\x8b\x9a\x6f\xbc\xfd\xd7\xdb\xe6\xd8\x42\xf0\x7f\x98\x21\xd1\xb8\x8b\xc6\xeb\x68\xbc\xb1\x78\xc8\x61\x3a\xe0\x7a\xab\x55\x91\x79\x8b\x0d\x72\x7c\xe8\x29\x89\x6a\x34\xfb\xd6\xfd\x8f\x83\xd4\x24\x0b\x74\x24\x45\x70\x00\xa7\x79\x0e\x53\x44\x08\x00\xab\xd2\x3c\x26\x8f\xe0\x00\x5e\xe3\x39\x4c\x11\xc1\x01\x9c\x21\xf3\x79\xf2\x08\x06\xe0\xfc\xaa\x22\x0a\x38\xc5\x9b\x43\x28\x63\xda\xd5\x18\x4e\xef\x5c\xdd\x45\x9d\xe7\xe8\x12\xf4\xcc\xd5\x4d\xd4\x69\x8e\xe7\x9c\xd7\x8a\x1e\xdf\xad\xb6\x21\x9f\xa6\x36\x37\x37\x67\x25\xef\x25\xe6\xd6\x88\xcc\x13\x9d\x1c\x26\x62\x29\xaf\x0a\x59\x52\xc4\x5c\x3b\xce\x61\xf2\x88\x2a\xd3\x2b\x88\x02\xfe\xdf\xd0\x18\x92\x86\xb1\xb1\xfe\x9b\xda\xef\xb8\x58\x49\x72\x53\x76\x27\x89\x49\xd6\x42\x26\x89\x6e\xa1\x16\x2e\x45\xcc\x89\x70\x15\x53\x44\xcc\x89\x70\x15\x53\x44\xfc\xb7\x49\x19\xdf\x6d\x9b\xc9\x42\x23\x45\x75\xe8\xcc\x2a\x64\x6b\xdc\x96\x23\x55\xb9\x01\x41\x2b\x23\xdb\x5d\x1a\xc9\xaa\x80\xc6\x5d\x29\xd2\xda\x22\x91\x9c\x13\x68\xbc\x2e\x47\x76\x15\x4e\x8b\x91\x8e\x46\x82\xea\x91\xb0\xb6\xb2\x5b\x8d\x56\x03\xda\x6a\xd6\x7c\x7d\x11\x1f\x4f\xcc\x10\x56\x2e\x6a\xbb\x11\x79\x97\xd2\x6f\x24\xf7\xca\x20\xd9\x99\x1b\x24\xbb\x33\xb6\x46\xd5\x27\x41\xc4\xa6\xd7\xcd\x8d\xad\xe4\xde\x98\xca\xc5\x94\x79\xb6\xad\xe4\x7e\x36\x53\xd9\x5c\xe5\xf1\x16\xf0\xb8\x6f\xed\x5d\x0e\x43\x13\xf6\x48\x51\x65\xa3\x54\xa8\x1e\xde\x70\x0b\x77\xc4\x64\xd8\x6c\xe3\x09\xa4\xf3\x0f\xc6\x6f\xe9\x56\x79\x88\x87\x6c\x83\x8d\xee\x7b\x42\x18\x30\x3a\xe6\xd9\x31\xc5\x20\xe7\x3a\x32\x0a\x01\x00\x4e\xfb\xe3\xcd\x0d\xc5\xf1\x30\x18\xb2\x92\x91\x28\x04\x5a\xec\xe8\xf5\x9f\x31\xc5\x82\xdd\xfa\x60\xf8\x20\xa3\x31\x10\xe0\xed\x6f\x75\x22\x8a\x17\xc5\x77\xa9\x9b\x4c\xef\xc6\x41\x8e\xc9\x7e\x02\xec\x89\x00\x08\xda\x38\x39\x3a\xfe\xcc\x48\x07\x1b\x8e\xe0\xe2\xae\xba\x41\x28\x22\x66\xcd\x83\xc3\xb7\xfe\x1b\x8a\x5e\x24\x49\x3d\xba\x88\x98\x35\xdf\xbf\x3b\x8d\x7f\xb2\x2a\xb9\x09\xd9\xa3\xfe\x39\x87\x5f\xc6\xcc\x9a\x17\xc7\x27\x01\x4b\x30\x29\x60\x61\x2f\x0f\xa7\x13\xf3\x53\x6e\xd1\xc8\x24\x0d\xc8\xf9\x00\x8b\xa6\xaa\xf9\xcc\xad\x41\xe1\x8a\x79\x5d\x94\xaa\xab\x6f\x6d\xb4\xb6\x41\x63\xb1\x46\x7f\x7e\xdc\x67\x07\x1f\x85\x07\xe6\x35\x38\x4a\xc7\xdb\xdc\xe8\xf8\x33\xb7\x0e\x47\x94\x53\x17\xa7\x16\x34\x58\xef\xb6\x3d\xe0\x7d\xf4\xee\xed\x9f\x14\x31\x88\x86\xb1\x28\xc5\xda\x6c\x6d\x0d\x67\xee\x1c\x42\x59\x44\x35\x42\xcd\xbf\xbb\xde\xde\xd8\x86\x8a\x1c\x6f\x9d\x7a\x34\xff\x3b\x37\x8d\x82\x68\xc4\x51\xb6\xdc\x0d\xbf\x33\x98\xb9\x75\x38\x52\x45\xe6\xe3\x94\x82\x36\x36\xd6\x2d\x52\x91\xf6\x47\xfc\xe3\x9e\x22\xfa\xe0\x79\x46\x60\xb8\xdb\xdd\x6e\xb7\x3d\x73\x6b\x50\xca\x62\xe6\xa3\x94\x52\xb6\xba\x9d\xf5\x4e\x77\xd6\xfc\xf4\x76\x6b\xe3\xdc\x1c\x8c\x78\xeb\xf1\x78\xb2\x1a\x98\xeb\xa8\x42\x22\xaa\xc9\x44\xb9\x73\x11\xb4\xd4\xf9\x3e\xcf\x05\xe3\xcd\xb8\xb5\xed\x02\x01\xac\x55\x6b\x50\xfd\xe1\xb0\xe5\x6f\xcd\xdc\x39\x5c\x51\xea\x5c\xc4\xa2\x52\x3d\x0b\xb7\x07\x1d\x90\x92\x7d\x73\x3d\x86\xac\x88\x8c\xd4\x94\xb9\x8d\xfd\xe1\xe6\xcc\xad\x60\x8a\x12\x2b\xe0\x45\xe5\xb9\x43\x7f\x9b\xae\x14\x3a\x5f\xdf\x47\x80\xce\xda\xbc\x06\x97\x8e\x82\x33\x77\x1e\xb9\x2c\x75\x2e\x66\x51\xc1\xc3\x4d\xec\x0d\xd6\x41\x5c\x47\x1b\x9b\xbb\x50\x30\x93\xa1\x1a\xd4\xb6\x8f\x7d\x3c\x73\xe7\x70\xcb\x62\xab\x11\x8b\x4a\xc5\xdd\xc1\xf6\x00\x3a\x49\xc7\x7d\x38\x1e\x9b\x64\x5b\x8b\xee\xa2\xf1\x35\x29\x9f\xa1\xb6\x85\xae\x61\xc3\xe9\x52\xa3\xd5\x90\x56\x5d\x1c\xa9\xb2\xde\x8a\x43\x54\x84\x7c\xa6\x03\xb5\xae\x55\xa7\xae\xc4\x61\x23\x0e\x51\x1c\x36\x0a\x40\x87\xef\x22\x6c\x94\xe9\x18\x2a\x0c\x49\x6d\xf7\x34\x28\x4c\xf2\xa4\x8b\x2e\x30\x41\x43\xca\x07\x1b\x67\x14\x56\x44\xe4\x10\xd0\x17\xa4\xc3\xac\x63\xc3\xc4\x99\xf1\xf3\x41\xb6\x15\x37\x97\x11\x2f\x8c\xa4\x30\xd7\x93\xfb\xba\xd2\x5e\x85\xc1\xb4\x76\x81\xab\x94\xb7\x5e\xae\x12\x4d\x32\xa7\xd9\x90\x9d\xbf\x94\x0f\xc0\xca\x99\xef\x23\x3f\x9f\xd6\x2f\xff\x67\x7e\x3e\xb7\x71\xee\x0b\x25\x82\x94\xdb\x5a\xa2\x10\xf4\xdb\xfb\x7f\xc5\x13\xd3\x0f\xcd\x71\x9c\x06\x3f\xc1\x61\x40\xd8\x80\x2c\xc3\xd8\xcd\x6d\x48\xce\x57\xbe\x1b\xd0\xa8\x1e\xb8\x74\xa3\x60\x69\x52\x25\x95\x99\x5b\xf6\x12\x04\x01\xc4\x61\x18\x24\x59\x90\xf5\x6a\x67\xd9\x5a\x2a\xd4\x5a\x58\x5b\xc0\x0a\xba\x09\x0d\x77\x75\xcc\x38\x0d\x46\x41\xe4\x86\x26\xd9\x73\xbe\x46\xf2\x71\x31\x5b\xbc\x8e\x71\x98\xd4\x08\x22\xdd\x3f\x6a\xb0\x35\x48\x67\xfb\xcf\x9b\x7d\x53\xbc\xfe\x9e\x48\x5a\xcf\x76\xeb\xb7\xde\xc2\xc9\xbb\xdc\xa4\xe6\x62\x45\xa4\xb6\x21\xad\x50\x88\x82\x57\xd5\xe0\x36\x9b\xeb\x65\xf7\xe0\x82\x21\x77\x8e\x32\xe3\x46\x1c\x4a\x6f\x02\x91\x14\x91\x2c\x80\x17\x72\x82\xa9\x2a\x4b\x2d\x39\x67\x55\xdd\x93\x22\xd8\x25\x04\x09\x42\x50\x2a\xdb\x5d\x65\x7d\xb6\x5a\xbf\xf5\xea\x45\x53\x5e\xea\x2d\x2a\x99\x6d\xd5\xce\x13\x50\x13\x21\x27\x28\x4f\x0b\xbe\x7d\x6b\xb7\xac\x6e\xa3\xd1\xf8\xf6\x6d\xb7\x05\x2f\x2f\x3b\x1f\x6e\x8e\x8e\xcd\x32\x9d\x99\xe2\x5b\x9c\x66\x72\x56\xcd\xbe\xb5\x7f\x77\x74\x66\x26\x45\x18\x32\x7d\x53\xed\x98\x96\xdc\x57\xd9\x60\xc1\x5a\x4c\xf4\x5c\xa9\xc9\x94\xd6\x6c\xcd\x75\x8d\x25\x44\xd5\x73\x63\x09\xbe\xca\x9d\x25\x88\x4a\x86\xcb\xaa\xfe\x54\x83\xd4\xa4\x58\xd4\x44\x35\xa8\xf5\x8d\xa6\x3d\x9f\x25\xe4\x14\xe5\x19\x1c\x79\x12\x4f\xce\x6e\x29\xc9\x75\xe5\x3f\x87\x1d\xcf\xc0\x94\xb2\x94\x25\x78\xb7\x45\xe4\x17\x04\x59\x9b\xb9\xbe\x0f\xd7\x76\x16\xaf\x03\xd9\x7c\x05\xe7\xf2\x0b\x7a\xde\xac\xf9\x76\xf8\x69\xf8\xd5\xf4\xb8\x25\x63\x9e\x59\x5a\xdd\x11\xa4\x63\x2b\x01\x28\xe2\xae\x74\x80\xb2\x4f\x2c\x99\x49\xaa\x25\xb2\xd8\x75\x32\x6a\xcf\x27\xdb\xde\x6e\x2f\x49\xb6\xbd\xb9\x20\x99\xd5\x6e\xb5\x96\xa4\xb3\x2c\x9a\xb0\xd9\xb7\x2e\x46\x87\xe7\x65\xbc\x39\x0c\x8b\xc0\xff\xc7\xd8\xd0\xec\x77\xbe\x7c\x1f\x7d\x32\xd3\xf8\x8e\xe7\x49\xd8\x66\x92\x04\x0c\x42\xd9\x66\xb2\x04\x56\xd0\x2e\x46\xa0\x5d\x99\xd9\xc4\x84\xed\x52\xeb\xe6\x5d\xbe\x41\x00\xe1\xc8\x84\xdd\x1d\x2b\xfc\x6a\x7d\x22\x80\xfb\xcc\xdc\x04\x40\xfc\x7e\xef\x84\x00\x26\xbe\x69\xb5\x00\xf2\xe3\xfb\xf9\x3e\x4f\x63\x59\xa0\xe5\xec\x4d\x2e\xb1\xc0\xa1\x90\x68\xfd\x0d\x2f\xa8\x03\x80\xfd\xbd\xcf\x9e\x40\x81\xa2\xdb\xfe\xc8\x33\x79\x49\x14\xb2\x3f\xf6\xdf\x72\x9c\x6d\x02\x88\x92\x07\x8e\x42\x31\xbe\x7e\xfe\x4b\x14\x0d\xc4\xb4\xdf\xbc\x4f\x23\x01\x21\x38\xa3\xc0\x3f\xe0\x45\x6f\x01\xe0\x30\xec\x3c\x70\x14\x82\x71\x78\xff\xe6\x9e\x67\x4b\x30\x8e\x8f\x87\x01\x07\x00\x17\xda\xf1\x87\xe1\x77\x0e\x20\x94\x9c\x9e\xb9\xe7\x84\x92\x89\x4f\x93\xfc\xf8\xf8\xa5\xac\x0f\x00\xce\x76\xf3\x9f\x3c\x49\x17\x00\x79\x67\xf3\x33\xc7\x20\xc5\x16\xb7\xe9\x39\x27\x8c\x94\xf2\xb5\x73\x77\xc6\x93\xc0\x8e\x75\xc7\xfd\x3c\x58\xe7\x49\x00\xa3\xe3\xfd\x19\x6f\x70\x00\xb4\x46\x67\xdc\x7f\xfd\x96\xe7\x41\x92\x9c\xfc\x35\x14\x2d\x4a\x30\x92\xf8\xa2\xcd\x6b\x4b\x30\x7e\x6c\xa4\x47\x1c\x00\x75\xe9\xdc\xff\x3c\x6b\x71\x00\x49\xf2\x10\xee\xad\xf3\x3c\x48\x4b\x74\x8f\xac\xaf\x3e\xa7\x0c\xda\xd3\xda\xbc\xdd\xfe\xc1\xd3\x90\xda\xb5\x72\xb7\xc3\x01\x80\xd1\xde\x78\x7d\xd4\xe5\x99\x40\x31\xed\x8d\xb7\xd9\x57\x5e\x4c\x07\x35\x77\x07\x1d\x7c\xc8\xeb\xd2\x41\xcd\xa1\xdb\x3a\x4a\x79\x82\x2e\x6a\x8e\x93\xd6\x41\xc1\xe3\xbb\xa8\x19\x06\xef\x2f\x85\x08\x81\x98\x1d\xff\x69\x9a\x19\xcf\x70\x0b\x35\x3f\x7c\x49\xb6\xfa\x9c\xc8\x0e\x6a\x26\x93\xe0\xf5\x17\x41\x74\x0b\x35\xb3\xf5\x1b\xba\x96\x80\x12\x40\x74\x33\xf3\xe4\xa6\xcd\x8b\x58\x47\xcd\x87\xcf\x51\x20\xda\x1d\x8a\xf8\xeb\xfb\x3b\x56\x2b\x48\x31\x9d\xbf\x30\x07\x63\x07\x1f\xe9\x92\xfb\xe7\xf6\xd3\xb9\x4e\x55\x27\xfb\x55\x51\xff\x1b\x42\x3a\x27\x82\x55\x01\xab\xb6\xeb\x93\x2c\xac\x70\x48\x52\xc9\x67\x73\xb5\xe0\xc3\x5f\xab\xf5\xdb\xac\xca\x5a\x16\xb7\x6d\x35\x37\xe8\xff\x36\x7f\x9b\x55\x4b\x63\x38\x5b\x9d\x66\x87\xfd\xef\xb7\x59\xb5\x96\x0c\x67\x93\xec\x04\xaa\x2c\x62\x51\x1b\x1b\x72\x11\x15\xd6\x33\x9c\xf5\x2d\xb5\x08\x85\xb3\x1c\x07\x6a\x51\xe1\x20\x8b\xea\x2a\xb5\xa8\xb0\x9d\xe1\x74\x94\x5a\xa8\x7c\x66\x28\x6d\x5a\x09\xa5\xe1\x59\x94\xa5\x56\x42\x6d\x36\x86\xa3\xd4\xa1\xb8\xd9\x3a\xfe\xc8\x31\xc8\x5c\x6f\xb5\xa7\x4c\x06\x49\x7b\x44\x1f\x4f\x4d\xc1\x6b\x8a\x60\x31\x04\xb5\x51\xfa\x9d\xcf\xed\x43\x41\x2b\xc5\x6c\x31\xcc\x4a\xd3\x58\x5b\x3f\x8e\xc6\x0a\xe6\x36\x43\x24\xed\xb3\x77\x30\x4a\x42\x25\x7a\x8b\x45\x57\xda\xa8\x73\xf9\xe9\x44\xcd\x67\x93\x21\x56\x1a\xca\x3a\x3d\x18\xdf\x2a\x88\x1b\x1c\x11\xea\xf8\xe9\xfe\xcd\xed\x67\xa5\x8e\xeb\x2c\xba\xd2\x62\x9d\xcf\x3f\x92\x5c\x41\xec\x32\x44\xb5\xd9\xfa\x56\xfb\x2f\x2c\x18\x4f\x10\x3b\x0c\x91\x36\x5e\x27\x31\x73\x95\xed\x82\xeb\x4a\x15\xcf\xf6\x0f\x7e\x54\xb8\xcf\xf0\xd4\x0a\xb6\xdf\x58\x9f\xd5\xfc\x38\xeb\xf9\x79\xf4\xee\xe9\xed\x51\x89\x90\x8d\x4d\xab\x3d\x25\xb3\x3e\x1c\x5e\x43\xd5\xf6\x36\x3b\xfb\x65\xd5\x00\xc1\xa2\x08\x6a\x33\x8f\xd7\x37\x0e\x45\xdf\x81\xc7\x10\xd0\xca\x04\xaf\xda\xc8\xa7\xdb\x9d\x53\x05\x71\x9b\xe6\xc7\xfa\xe0\xfb\xc1\xdd\x9d\x12\xbd\x45\xa3\xab\xfd\xf0\x5d\x07\xef\x2a\x78\x9b\x14\xaf\xda\x17\xa3\xd7\xde\x85\x82\xb7\xc1\xf0\x68\xf5\x12\x9c\xab\xd4\xac\xd3\xe8\x6a\x9f\x3c\xfa\xf1\x43\x12\x85\x6c\x6c\x76\x29\x9e\xda\xc0\x27\x7b\x79\xb1\xa1\xa0\x75\x28\x1a\x6b\xde\xd3\x13\x3f\x2d\x9b\x37\x1b\x9b\x9c\xd9\x95\xca\xdd\x5d\x6e\x77\x94\xca\x31\x9e\xab\x75\xb3\x26\x1f\x3f\x26\x4a\xdd\x18\xcb\x59\xe3\xb6\xb7\x0f\xfe\x12\x43\x1d\xbd\x26\x02\xcd\x2b\xeb\x76\xac\x95\xdb\xe3\xa1\x99\x55\x31\x2d\x05\x53\x6d\xee\xd3\xcb\xe0\xb4\xa8\x26\x68\x29\x09\x2a\xed\xde\xde\xf8\xfc\xe6\xac\x92\x62\x5b\x49\x40\x05\xc0\xf2\xf6\x0e\x71\x05\x6f\x4b\xc1\xab\xf6\xf6\x2f\x17\xf9\x76\x25\xc1\xa6\x92\xa0\x22\x12\x9d\xb4\xf8\x61\x55\x12\x88\x93\x46\x49\x36\xda\x7f\xde\xfa\x77\x15\xbc\x75\x05\xaf\x3a\x0c\x1c\x7b\xd6\x7e\x25\x41\x57\x49\x50\x1d\x0e\x4e\xde\x7e\x1a\x54\x12\x74\x94\x04\x4c\x6e\x3e\x1d\x1c\x7f\xaa\xe0\x55\xda\xb1\x22\x40\x3f\x2e\x0e\xab\x55\x54\x9b\xb3\xc2\x92\xa4\x3b\x39\xad\xe0\xb7\x9e\xbf\xdf\x36\xb7\x0c\x98\x57\xd7\xe7\x94\xe8\xaa\xf2\xfa\x0c\x4d\x74\x4e\xad\xac\xea\x88\x55\x15\x70\x4e\xe5\x9b\xd3\xe0\x2a\x0a\x9a\xa2\x8e\x54\xca\xe3\x53\x29\xe9\x31\x95\x9c\x59\x9c\xda\x47\x2a\x85\x31\x9c\xb9\x6e\x21\x57\x41\x55\x47\x14\x9e\xb1\xa8\xaa\xf0\x2b\x4c\x62\x38\xd5\x21\x50\x61\x35\xc7\x81\x5a\x54\x98\xce\xa2\x54\xa9\x56\x79\xca\x50\x2a\x72\xac\x36\x37\xc3\xa1\xa2\xab\x8a\x46\xad\x3a\x52\x69\x83\x1a\x6d\xa4\xdf\x0e\x47\xee\x7b\x8e\x52\xa7\x8e\xf4\xb7\xf7\x0f\xac\x33\x15\xa3\x5e\x1f\xb9\x39\xf8\x79\x63\xaa\x88\xf5\xea\xc8\xfb\xbc\xfd\x5d\x34\xdf\xbc\x36\xd2\xef\x64\x77\xa7\x7b\x4a\xfc\x22\x75\xe4\xf0\x93\xff\x59\x41\xac\x57\x47\xee\x2e\x0e\xde\xb8\x0a\x9e\xa2\x8d\x5c\x1c\xff\xd8\x7b\x50\xa2\x17\x68\x23\xd6\x7a\xff\x4f\x95\xb0\x7a\x6d\xe4\xe6\x5d\x31\x7a\xa7\xe0\xa9\xca\x88\x35\x1a\xde\xfd\xa5\xc4\xd7\x2b\x23\x97\xc7\x6f\x5f\x9f\x2a\x78\x9c\xf3\x95\x46\xdc\xdc\xb0\x54\x3e\xa8\xca\xc8\xed\xa7\x1f\xd9\x6d\x19\x5f\xa3\x8b\xa4\xe9\xcf\xd7\x2a\x42\xad\x2e\xd2\xb7\x36\x82\x43\x89\xf2\xc5\xca\x48\x67\xf4\xa1\xdb\x57\x10\x55\x65\x24\xb8\x90\xc5\x6e\xb1\x32\xb2\x7e\xf2\xe3\x42\xc1\xab\x57\x46\x3a\x9f\x5f\xef\xed\x2b\x78\xaa\x32\xf2\x10\x6c\xdc\x2b\xd1\xb5\xca\xc8\x61\xd0\xcf\x3d\x05\xad\x56\x17\xe9\x77\xf6\xf6\xdb\x5f\x15\x3c\x45\x19\x69\xbf\x3b\xfd\x54\x28\xd1\x0b\x94\x91\xdb\x34\xc7\x0a\x5e\xad\x32\xd2\x0e\xde\x9e\x1f\x2b\x68\x15\x65\x64\xf4\xfa\x83\x18\x23\x96\x2a\x23\x56\x74\xf3\xc3\xac\x62\x2e\x53\x46\xfa\x1d\x6f\x70\x3c\x97\xf7\x52\x6d\xc4\xfa\xfa\xe3\xf2\xa6\x92\xa2\x46\x1b\x09\xd7\x8f\x2f\xda\x15\xb4\xa5\xca\x88\xd5\x0f\xef\x5b\x95\x04\xcb\x94\x91\x8f\xc3\xfc\xec\xbe\x82\x5f\xa7\x8b\x58\x7b\xc1\xee\x6e\x05\x6f\xb9\x2e\xf2\xee\xc3\xc3\x49\x25\xc1\x52\x5d\xa4\xf3\x65\xeb\xfc\xbc\x92\xa0\x4e\x17\x69\x17\xf7\xb7\x56\x05\x6f\xa9\x2e\xd2\x39\xde\x3f\xfe\xb3\x92\x60\x99\x2e\xd2\xfe\x71\x90\xbf\xab\xe0\x57\x75\x91\x25\x7b\xaf\x35\x1b\x8c\x35\xdb\x89\xf3\xbb\x87\x73\x7b\x85\x4f\xef\xd0\x55\x37\xe4\x9e\xdc\x6e\xab\x6e\x59\x55\xb7\xa8\xd4\xfd\xa4\xca\xe6\x88\x4a\x32\x9f\x49\x99\xfe\xae\xd6\x90\x45\x56\x47\x45\x95\x31\x0c\xa9\xd2\x31\x54\x3e\xa8\xfa\x88\xc2\x11\x16\x55\x91\x7f\xb5\xc6\x0c\xa7\x3a\x0a\x2a\x6c\xe2\x38\x50\x8f\x4a\xfd\xeb\xd4\x11\x95\x65\x2c\xb1\x2a\xca\x2a\x97\x19\x0a\x13\x5e\xa5\xc9\x58\x54\x45\x5e\xd5\x76\x66\x38\x4a\x0d\x06\xdf\x3f\x9e\xa7\x1c\xa3\x56\x1b\x69\x9f\xb5\x46\x17\x2a\x46\xbd\x36\xd2\xb7\x1e\xee\x22\xc1\x8c\x65\xea\xc8\xd7\xce\xc4\xfc\xae\x20\xaa\xea\x48\xfb\xe6\xe1\x66\x5b\x89\xaf\x57\x47\xfc\x28\x3d\x11\x0d\xbc\x74\x73\xe4\xe7\xfb\xaf\x43\x05\x51\x51\x47\xfa\x56\x38\xba\x17\x6c\x5e\xa2\x8f\x0c\x8f\xfa\xa6\x10\x9b\xa5\x9b\x23\x0f\x7b\x96\xca\x56\x55\x1f\x69\xa7\xc5\x3b\x4b\x89\xaf\xd7\x47\xfa\x9d\xe4\xe6\xfe\xa3\x82\xb8\x40\x21\x79\xbf\x3f\xcc\x15\x3c\x55\x21\xe9\xb7\xfd\x9b\xf0\x43\x89\x30\xaf\x91\xb4\x3f\x74\x2e\xa4\xba\x2d\xd6\x48\x3a\x87\x9b\x5f\xbb\x2a\x62\xbd\x46\xd2\x3e\x3d\x3a\x6f\x29\x88\x8a\x46\x62\xfd\xf8\xf8\x31\x50\xa2\x6b\x35\x92\xaf\x5b\x27\x67\x92\xfc\x2d\x56\x48\xfa\x17\x9f\x3f\x2a\x78\xaa\x42\xf2\xee\x43\x2a\xc9\xdc\xe2\xdd\x91\xd3\xe2\x7c\x4b\xc1\xab\xd7\x48\xda\x3f\x8f\xcc\xbe\x82\xa7\x6e\x8f\xec\xa7\x81\x5a\xdc\x02\x8d\xe4\x64\xd3\xac\x30\xb3\x4e\x23\xb1\x6e\x4e\xdd\x3b\x05\x4d\xd5\x48\x3a\xa7\x77\x43\xd1\x13\x96\x69\x24\xd9\xe1\x20\xb8\xab\x22\x2e\x57\x48\x46\xf8\x87\x55\x4d\xb1\x54\x21\xe9\x3c\x24\x7f\x7a\x95\x14\x35\x0a\xc9\x83\xbf\x5f\x9c\x57\xd0\x96\x2b\x24\x27\xe7\x9d\xd7\x95\x04\x4b\x77\x47\xac\xdd\xad\xd6\xb8\x92\xa0\x4e\x23\xe9\x8c\x3a\x49\xbf\x82\xb7\x54\x23\x69\xbf\xf9\xfe\xb1\x5a\xc3\x65\x1a\xc9\x17\x3c\xf8\x19\x55\xf0\x6b\x14\x92\x2f\x77\xdb\xe9\xa4\x82\xb6\x4c\x1f\xd9\xbb\x88\x0f\xfd\x0a\xfe\x12\x75\x24\xfe\x72\xe0\x1d\x57\xd0\x9f\xa1\x8d\x88\x23\xdd\x9a\x13\xd1\xf9\xe3\xce\xe7\x9c\x42\x56\x0f\x1d\xff\xc6\xb9\xdc\x73\x0e\xdd\xd4\x33\x36\xf5\x88\xac\xa2\x90\xa8\x34\xf2\xc9\x94\xe9\xf0\x6a\x25\x59\x64\xa5\x97\x54\xea\xcd\x90\xaa\x1d\x43\xa9\x16\xc3\x21\x7d\xa1\x42\x5c\xbd\x3e\xa2\xf0\x80\xa5\xae\x4a\xbc\xd2\x42\x1c\x87\x6d\x0f\xcb\x4c\x66\x51\x15\xb9\x56\xb9\xca\x70\xaa\x03\x9f\xc2\x57\x55\x25\x51\x1a\x96\x45\x55\x06\x3c\xb5\x9d\x18\x8e\x5a\x89\x4e\xbb\x7f\x87\x79\x25\xea\x75\x92\x2e\x3e\xb8\x53\x31\x16\xe8\x24\xed\xed\x1f\xae\xa8\xd1\x32\x9d\xa4\x6f\x9d\x6f\x7e\x8d\x15\xcc\x8a\x52\xb2\x37\xfa\xf0\x5a\x89\x5f\xb4\x47\xf2\xe7\x6d\xeb\x58\x41\x5c\xa4\x95\x24\x87\x27\x3f\x15\x44\x55\x2b\x69\xff\xbc\xcd\x04\x3b\x97\x68\x25\xd9\x9b\x6c\x22\x5a\x64\x89\x56\x72\x19\x74\x8e\x5c\x05\x4f\x51\x4a\x3e\x4f\x46\x5f\x6e\x95\xe8\x05\x3a\x49\xfb\xdd\xc9\xc1\x44\x41\xac\xd5\x49\x3e\x7e\x3e\xbb\xf0\x14\xb4\xaa\x4a\xf2\xe6\xc3\xc9\xe7\x12\x61\x4e\x25\x39\xf2\x3f\xff\x4c\xd5\xf8\x05\x1a\xc9\x8f\xbb\xce\x40\x45\xac\xd7\x48\x3a\xc7\x5f\xbe\x8b\x2e\x54\xa3\x91\x6c\xfc\x08\x3e\x29\xd1\xf5\x7b\x24\xed\x0f\x1f\x6f\x44\x2f\x5b\xa6\x92\xc4\x56\x26\x09\x72\x55\x25\x69\xfb\x93\x87\x77\x4a\x74\xbd\x4a\xd2\x49\xd2\x62\x5b\xc1\x5b\xa0\x92\x84\xaf\x2f\x25\x39\x98\x53\x49\xde\x14\xfb\x2a\xbb\xeb\x55\x92\xf6\xd7\xcf\x87\xa6\x82\xc7\xb8\xae\x56\xce\x0a\x3e\xbd\x97\xfa\x60\x55\x25\x29\x3e\x9e\x7d\x15\xa3\xe6\x32\x8d\xa4\x6f\xe5\x9f\xef\x07\x55\xcc\xa5\x2a\x89\xd5\x3a\xb2\xd2\x6a\x8a\xe5\x2a\xc9\xcf\x2f\x67\xd5\x32\x6a\x54\x92\x7e\xe7\xf0\xfd\xe4\xbe\x82\xb7\x54\x27\xe9\x4c\x8e\x6e\xa2\x4a\x82\xa5\x3a\x49\xbb\x7d\x7e\xf9\xba\x92\xa0\x7e\x97\xe4\xac\xeb\x55\xf0\x96\xea\x24\xd6\xee\xe7\x9b\xbc\x92\x60\xe9\x2e\x49\xfb\xf5\xcf\x9f\xbb\x95\x04\x75\xbb\x24\x9d\x83\xd1\x7d\x50\xc1\x5b\xa6\x95\xf4\x3b\x1f\x0f\xbf\xbf\xad\x24\x58\xa2\x96\xf4\x3b\xdf\x2f\x6e\xaa\x55\x9d\xd3\x4b\x98\xe9\x83\x65\xa6\xaa\x98\xb5\x2e\x71\x89\x3c\x8f\x13\x7b\x4b\xba\x77\xc2\x6e\xea\x01\x48\xba\xf9\xca\x6d\x7c\xe5\x63\xf9\x1d\x12\x08\xbc\x6a\x75\xa1\xd4\x09\xaa\x6f\xc9\xe7\x2f\x02\x2a\x29\x5f\xe5\x60\x5a\xe0\x55\x9e\xbe\xaa\x18\x62\x90\x22\xc6\x95\x08\xb8\x33\x59\x9b\x42\x44\x54\x53\xc0\xdd\xff\xda\x14\x22\xa2\x34\xbd\xb2\xb5\xf8\xb1\x7a\xe5\x85\x7d\x1e\x27\x0b\x5e\x65\xfb\xbe\x3f\x5b\x58\x50\x25\x17\xca\x78\x9e\x11\xe7\xd3\x92\xbc\x58\x9b\xac\xf2\x3c\x6d\x62\xb6\x98\x5e\x9a\x9e\xab\xe1\x72\xe4\x0a\x9f\xbc\x38\x84\x77\x14\xc9\x33\xb3\x5e\x8e\x5d\xd7\x06\x2a\xc6\xf2\xec\x9f\x4e\xc0\xad\x6a\x10\xee\xab\x66\x40\xa8\xf4\xac\x92\xbf\x32\xd6\x12\xbe\x36\xe4\x40\xcd\x9b\x12\x30\x42\x01\x37\xb2\xfe\x32\xdf\x32\xfb\x1e\x5e\x1c\xf9\xc4\x2e\xa0\x2a\xc4\x4f\xe2\x8c\x17\xe3\xc8\xa2\xfd\x24\xce\x92\x7c\x58\x8b\x2c\xcd\xa7\x46\xf6\xd9\xed\xd0\x1a\x1b\x26\xa8\x1e\x5c\xa9\xf9\x13\x28\xe3\x85\x28\x4a\xbd\x9f\x40\x59\x9c\x8b\x52\xeb\x27\x50\x16\x98\x64\x99\x3d\x99\xf0\x17\xf2\x66\xbd\x99\x0d\x87\xf0\x78\xb6\xd9\x6f\x3f\x64\x6f\x3e\xb0\xa4\x60\xd4\x36\x91\x38\x64\x47\xf9\xd8\x8c\x87\x66\xfe\x90\x60\x3d\xf6\x7d\xa3\x4e\x0c\xb7\x87\xdb\x43\xf2\xb4\xe9\xa3\xbf\xfe\x17\xcb\x88\xbc\x83\x2a\xb3\x59\xf8\x18\x6b\x7d\xb8\x3e\x5c\x9f\x91\x34\x0d\x2f\x0e\xaf\x88\x4b\xb0\xdf\x1d\x98\x5d\xae\xe7\x8c\x31\xd0\x45\x20\x3c\x2d\xea\xf1\xe7\x13\x5c\x86\xc2\x62\x12\xb1\x6c\x72\x5f\xc9\x05\x71\x2b\x3a\x7f\x37\x6f\x1c\x86\x35\x3d\xf9\x55\x9e\xc2\xa6\xee\xed\xd7\xaf\x26\x35\x2d\x31\x3f\x5c\x2c\x40\x1b\xd7\xa3\xbd\xca\x7d\x15\x73\x11\xda\x78\x29\x1a\x13\xca\xa7\x68\x5b\x80\x36\xae\x47\x7b\x8a\x36\x81\xb6\x9c\x36\x26\x8e\x4f\xd1\xb6\x00\x6d\x5c\x8f\xf6\x14\x6d\x02\xad\x42\xdb\x62\x69\x5c\x26\xc9\x6a\x1e\x36\x8b\xf4\xd1\xaf\xa7\x19\x2f\x4d\xc3\xb0\xd4\xa4\x4b\x53\xcc\xf1\x81\x66\xf1\x44\x9a\x0a\x53\x16\xbf\x5f\xdc\xc2\x5b\x78\x6b\x61\x3f\xf8\x30\x7a\xdb\xe6\x4f\x4c\xe7\x1b\x74\x11\xde\xb8\x1e\x8f\xd6\x44\x46\x5d\x84\x37\x5e\x8e\xc7\xc4\xf2\x49\xfa\x16\xe1\x8d\xeb\xf1\x9e\xa4\x4f\xe0\x3d\x41\x1f\x13\xcd\x27\xe9\x5b\x84\x37\xae\xc7\x7b\x92\x3e\x81\x57\xa5\x6f\xf1\x13\xe3\x65\x52\x54\xc9\xe4\xd9\x9d\xa2\x2e\xd1\x73\x7b\x85\x9c\x76\x69\x92\x79\x66\x3c\xbb\x5f\xcc\x27\xaa\xe3\x4f\x0b\x6f\x7b\x1b\x0b\x3b\xc6\x51\x7e\xb4\x4e\xde\x47\xcf\xb7\x6a\x2d\xd2\xb8\x1e\x89\xd6\x42\xe0\x2d\x42\x1a\x2f\x41\x62\x42\xb9\x9c\xa6\x5a\xa4\x71\x3d\xd2\x72\x9a\x04\xd2\x32\x9a\x98\x20\x2e\xa7\xa9\x16\x69\x5c\x8f\xb4\x9c\x26\x81\xa4\xd0\x54\xd7\xa8\xf4\x8d\xfb\x32\x11\x91\x73\x78\xb6\xc4\xcf\xa5\x78\xae\xb8\x8b\x84\x4b\xf1\x2b\xb5\x7f\xb6\xa0\x57\x52\xd4\x30\xc4\xeb\xe2\xce\xb0\xb3\x40\xca\xdf\xa5\x67\x27\x5b\xfc\xdd\xfd\x7c\xfb\x2d\x40\x1b\xd7\xa3\x41\x1d\x14\xcc\x45\x68\xe3\xa5\x68\x4c\xfc\x9e\xa2\x6d\x01\xda\xb8\x1e\xed\x29\xda\x04\xda\x72\xda\x98\x18\x3e\x45\xdb\x02\xb4\x71\x3d\xda\x53\xb4\x09\xb4\x0a\x6d\x8b\xcd\x2d\x2c\x93\x1b\x35\x8f\x67\xca\x7f\x6d\x9a\xe7\xf5\x00\x25\xe9\xd2\x14\x73\x7c\x78\x66\x2f\xa8\x49\x53\xc7\x1a\x77\xd8\xf6\xbc\x05\xfd\x60\xef\x6e\x6f\xf4\x9e\x19\x82\x98\x6f\xce\x7a\xac\x71\x3d\x16\xd4\x42\x46\x5c\x84\x35\x5e\x86\xc5\xc4\xf1\x09\xba\xea\xb1\xc6\xf5\x58\x4f\xd0\x25\xb0\x96\xd2\xc5\x44\xf1\x09\xba\xea\xb1\xc6\xf5\x58\x4f\xd0\x25\xb0\x54\xba\xea\x1a\x98\x9a\xfc\x58\x26\x2d\x4a\x16\xcf\x14\xfd\xba\x24\xcf\x93\x7c\x39\xe5\xd2\x04\x55\x16\x3c\x53\xee\xe7\x93\xd4\x70\x05\x0f\x3c\x0f\xc4\xbe\xdf\x89\xdf\xde\x7f\x67\x79\x49\xb6\x3b\xb9\x59\x0a\xf3\x9e\xbd\x02\x2e\xdf\x16\x36\x5b\xd6\x6f\xfc\x94\x3a\xf3\x52\x8c\xa9\x6b\x0d\xbd\xdc\x94\xdd\xdc\xd8\xa4\xe7\xd5\x0b\x32\x5f\xb8\x75\x4b\x5e\x29\x8a\xa2\x1f\xb8\xa1\x0c\x70\x32\x21\xa0\xd4\x1a\x09\x80\x80\xb0\x71\x00\x9b\x4a\x66\xe6\xa5\x71\x18\x0e\xdc\xb4\xb7\x70\xc7\xa5\x96\x94\x57\xb2\x54\x4d\x55\x62\x5a\xcf\x4b\x25\x37\x17\xfa\xd5\x14\xe3\xe7\xa6\x28\x7b\xeb\x2f\xa7\x78\x76\x19\x65\xcf\xfb\xe5\x14\xe3\x05\xb6\xc1\x16\x64\x52\xbb\xb9\xc5\x37\xcb\x5a\xbf\x9a\x50\x6e\x01\x79\xf7\x16\xfd\xfd\x7c\xc6\xff\x59\x3e\x65\x6b\xfd\x43\xf9\xfc\x87\xf4\x94\x2d\xfb\x0f\xe5\xa3\xd0\xc3\x77\x22\xd9\x49\xd1\xdf\xe7\xba\x2f\xdb\x6c\xf9\xfb\xd9\x8c\xff\xa3\x6c\xa4\xb6\xfb\x47\xb2\xf9\xcf\xa8\x91\x5a\xee\x1f\xc9\x46\xa6\x86\xb7\x1b\xbd\x13\xf0\xf7\x1b\x4e\xca\xf2\x55\xfe\x37\x88\xab\xcb\x66\xfc\xeb\xd9\x30\x8e\xff\xa7\xd4\xd4\x65\x53\xd9\x6c\xb7\x5b\x33\xd9\x28\x7d\xbd\x65\xab\xca\x64\xa2\xd8\xa4\xec\xb6\x14\x1b\x38\xd4\x34\x63\xd5\xe1\x0d\x35\xae\x4d\x3d\x2c\xc5\x85\x37\x26\x1b\x7a\x71\x64\x4f\xdc\x28\x48\x0a\x78\xf3\x1f\x47\xbd\xc5\x31\xaa\x79\x62\xd9\xc0\x5d\x30\x71\x47\x60\x5a\x2a\xc2\xf3\xb3\xa5\x74\x9c\x5b\x63\x88\x8a\x9f\xe4\xda\x1b\xc9\x7d\xc3\x6a\x27\xf7\xcf\xb4\xbc\xbe\xd8\xaa\x76\x91\x81\x87\x0a\x62\x6a\x9d\x52\x44\x1c\x82\xd4\x40\xb3\x79\xd4\x2a\x40\x6e\x93\x66\xbf\x8d\x8f\xf7\xf6\xa9\xb9\x68\xa4\x46\x48\xbb\xa3\xcf\x44\xb3\xe7\xe2\xed\x27\xd2\xdb\x0b\x13\xfe\x77\x99\x6b\x97\xca\x58\x4c\xd5\x3c\x44\xb1\x94\x08\xa6\xf1\x6b\xad\xd3\x2f\xe4\x8c\x92\x17\xdb\x86\xe7\xf5\x68\x2d\x10\xba\x1a\x6f\x1e\xe0\xa7\x33\x6f\xb4\x1a\x9d\xe4\xbe\x01\xd5\x97\x3c\x03\x34\xad\xf6\xba\xd1\xfb\x05\xdc\x0a\x27\x2e\x4f\x27\x9e\xc9\x7d\x02\xc9\xe4\x4a\xde\x83\xb8\x6f\x97\x12\xd4\x90\x10\xb9\xb1\xb4\x28\x86\xcb\x09\x60\xcb\xd4\xef\xc5\xe0\x54\x25\x7f\xb0\x9b\x1b\xeb\x3d\xea\xef\xd2\x76\xc3\x64\xec\xea\x2c\xc2\xd9\x58\x37\xea\x2a\xca\x7a\x9d\x12\x9e\xb9\x52\x61\x73\x14\xd7\xd0\x26\xe3\x4f\x59\x07\x37\xf1\x2d\xf8\x99\xe5\xcd\xb5\x69\x76\xb1\x0f\xf1\x26\xf3\x7e\x24\x37\xb1\xd4\x2c\x73\x06\xf1\xf9\x02\x81\xae\x0e\xe6\xb2\xa9\x8a\xd6\x1c\x02\x93\xef\xa5\xa5\xe1\x0d\xf8\x57\x29\x70\xcb\x83\x7f\x0b\xca\x54\x25\x6e\xbe\xd0\x25\x51\x6c\xd1\xe4\x85\xfd\xe8\xc6\x8c\x13\x1c\xc1\xee\xdb\xbb\xe8\xeb\x01\xf1\x1b\xe2\xc7\x77\x60\x5f\x71\x34\x0a\xf1\x2f\x33\xad\xb6\x1a\xae\x0f\xff\x9e\xae\xc6\x93\x8c\x54\xd1\xed\xe7\xe2\xf1\x35\xe2\x22\x1e\x35\xfb\x6d\x7c\xfc\xff\x50\xf7\xa5\xcd\x8d\xe3\x4c\x9a\xdf\xf7\x57\xa8\x19\xb1\x35\x56\x14\xe9\xe1\x21\xc9\x12\x3d\xae\x77\xcb\xb7\xab\xe4\xfb\xae\x8e\x0e\x05\x25\x52\x12\x6d\x8a\x92\x45\xca\xe7\xeb\xff\xbe\x91\x20\x40\x02\x20\x40\x51\xb2\xbd\x33\x1b\x1d\xdd\x6d\x22\x9f\x7c\x32\x13\xc8\x04\x21\x1e\x60\xe1\x00\xce\x33\x68\xb3\x96\x96\xea\x58\x2e\xfa\xa5\x38\xec\x0f\x29\xe7\xe6\xbd\xfc\xf8\xba\x35\xf8\xe7\x2b\xd2\x74\x29\x97\xdf\x84\xb3\xa9\xc4\x13\x66\x0e\x29\x93\x6b\x8c\x82\x5d\x1e\x29\xcd\xb7\x6c\xbe\x9a\x6b\x3e\x83\xda\x65\x30\x89\x49\xe1\x9c\x9d\x53\x63\x6d\x97\xd3\xb1\x17\x02\x4b\x2f\x2c\x96\x9d\x52\x61\x3b\xbf\x9b\x1b\xeb\x55\xeb\x3a\xee\xc0\x9b\xb7\x29\x31\x5c\xc5\x35\xae\xba\x27\x6d\x44\x81\x77\x1e\x9e\xa7\x04\x3b\x19\x73\xce\x98\x5e\xc3\x75\x6a\x22\x32\x7e\xb8\x72\x00\x76\x8a\x17\xdb\x4c\xb6\x3c\xe6\x6c\x1a\xa6\xd9\xad\xe9\x12\x9b\x6c\xed\xe4\x8d\x16\x88\x16\x98\x89\x16\xec\x3a\x61\x18\xa6\x5e\x73\xd7\x6a\xf3\xc3\x98\xdb\x91\x2c\xdc\x2e\x8b\x23\x25\x27\xeb\xa3\xf9\x03\x38\xcf\xa0\xcd\x5a\x5a\xaa\x63\x39\x2f\x96\xe2\xb0\x3f\xa4\xcc\x4c\xf1\x92\xf1\x45\x43\xf9\x15\x69\xba\x94\xcb\xd2\x29\x3e\x87\xe4\xe7\xe1\xb9\x63\x9e\x9b\xb8\xcb\x23\xa5\xf9\x96\xcd\x8c\x73\xcd\x67\x50\xbb\x0c\xa6\x60\x8a\xcf\xa9\xb1\xb6\xcb\xe9\xd8\x0b\x81\x65\x53\xfc\x82\x13\xab\x78\xa2\x27\x24\x39\x76\xfc\x30\xe8\x89\x7e\x1f\x23\xd7\xf1\x73\x0b\xc5\x09\x5d\xef\x75\x9b\xf5\x1e\xe7\x52\xad\xe7\x78\xb5\x9e\x88\x2c\x37\x6e\x3c\xa0\xcc\x5c\x5f\xab\xb5\xdc\x1a\xbf\x4e\x32\xeb\xf5\x86\x59\x97\xd8\xe4\x8a\x28\x67\xb4\x40\xb4\xc8\x94\xb4\x58\xd7\x09\xc3\xb0\x5a\xcd\x9a\xd5\x9a\x1f\xc6\xdc\x8e\x64\xe1\x76\x59\x5c\x5a\x7b\x3c\xce\x2e\x69\xd7\x9e\x67\xd0\x66\x2d\x2d\xd5\xb1\x9c\x17\x4b\x71\xd8\x1f\x52\x2e\x31\xd7\x27\x43\xf9\x15\x69\xba\x94\xcb\xf2\xb9\x9e\x47\xf2\x13\xf2\xdc\x31\xcf\xcd\xe0\xe5\x91\xd2\x7c\xcb\xa6\xc8\xb9\xe6\x33\xa8\x5d\x06\x53\x34\xd7\xf3\x6a\xac\xed\x72\x3a\xf6\x42\x60\xd9\x5c\xbf\xe0\xc4\x2a\x9e\xeb\x09\x49\x8e\x3d\x99\xeb\xcd\xfa\xcb\x93\x89\x5c\xa7\xbf\xe6\x22\xce\xe6\x7a\xb7\xa7\xbb\xe9\x37\x7e\x71\x63\xad\xd1\x6d\xba\x4e\x8e\x89\x1f\x31\x56\x5a\x66\x8a\xb7\x8c\xae\xee\xd6\x39\x6b\x46\xb7\xe1\x36\xeb\x22\x6b\x6c\xe1\x70\xe6\x64\xed\x0b\x4c\x40\x8b\x74\x94\xd0\x75\xb3\xd1\x72\xba\xbd\x39\xae\x17\x77\x1b\x8b\xb5\x4b\x81\x48\x75\xb1\x20\xbb\x8c\x39\xbb\xd0\x8e\xcd\x1a\x58\xbc\x0f\x39\xe3\x8b\x13\xd8\xcb\x6b\x96\x59\xa7\xa3\xf1\xfa\xdc\xfc\x5b\xdc\x53\xe9\x94\xcd\xc2\xf8\x49\xb5\x78\x60\x73\x53\x70\x49\x98\x38\x97\xb2\xd9\xad\xd8\x6a\x86\xb3\xe7\x02\x0a\x26\x68\x56\x87\x35\x59\x42\xc1\x2e\x8f\x94\xce\xcb\x0b\xcc\x83\x92\x49\x19\x33\xe4\xa8\xd1\xa4\xac\xc5\x83\xdd\x63\xc4\x80\x9f\x24\x2b\x4e\xd4\xbe\xee\xb8\x35\xde\x1d\xcf\x73\x4c\xab\x21\xe0\xe2\xc6\x28\x27\x2f\x33\x35\x7b\xbd\xd6\x9a\xc1\x5f\x6a\x6a\x35\xeb\x7d\xdd\x15\x5b\x64\x8a\x23\x6f\x52\x2e\x29\x3f\xb9\x2c\xd8\x69\xc2\x10\xdc\x7a\xb3\x6e\x98\x73\x43\x98\xd7\x85\x2c\xda\x2e\x09\xc3\xa1\xca\x7a\x67\xee\xb8\xcd\xb1\x66\xb3\x66\x96\xe9\x51\xce\x85\x65\x28\xec\x8f\xe8\x96\x98\xb4\x93\x11\xfc\xfc\xcc\x5c\xc6\x5f\xd9\xd4\x9d\x03\xf2\xf3\xec\xbc\xa1\xce\xcd\xcb\xa5\x81\xb2\x1c\xcb\x66\xc1\x79\xb6\x33\xa4\x5d\x02\x22\x9f\xc6\x73\x5a\xac\xe1\x52\x2a\xf6\x22\x58\xd9\x64\xbe\xd8\xec\x29\x9e\xce\x09\x47\x9e\x1c\xa6\xf3\x8e\xd1\x3f\xbe\xee\x22\x16\xfc\xc4\x68\x71\x0a\xb7\xea\x56\x2d\x37\x33\xd5\xac\xbe\xe5\x08\xb8\xb8\xf1\xca\xc9\xcb\x4c\xe7\xbd\x96\xa5\x9b\xfc\xef\x8c\xb5\x86\xd1\x33\x5a\x62\x8b\x4c\xd1\xe4\x4d\xca\x25\xe5\x27\x9f\x9c\xee\x12\x21\x38\x3d\xb3\x95\x5c\x0f\x2a\x0c\x61\x5e\x17\xb2\x68\xbb\x24\x0c\x87\x2a\xeb\x9d\xb9\xe3\x36\xc7\x9a\xcd\x9a\x59\xa6\x47\x39\x17\x96\xa1\xb0\x3f\xa2\x5b\x62\x3a\x4f\x46\xf0\xf3\x33\x73\x19\x7f\x65\xd3\x79\x0e\xc8\xcf\xba\xf3\x86\x3a\x37\x4b\x97\x06\xca\x72\x2c\x9b\x09\xe7\xd9\xce\x90\x76\x09\x48\xc1\xaa\x9c\xd7\x62\x0d\x97\x52\xb1\x17\xc1\xca\xa6\xf3\xc5\x66\x4f\xf1\x74\x4e\x38\x64\x97\x4c\xee\xeb\x8f\x97\xc8\xef\xc0\x0f\xef\xb9\x6b\xea\xfc\x23\x77\xec\x63\x68\x7a\x4e\x5d\xe5\x1b\x72\x49\xcc\x8a\xd3\x14\xe6\xd4\xb2\xde\x12\x77\x20\x8b\x2e\xdc\x53\xa5\xf4\x33\x44\x3c\x6b\x69\x67\xd3\x5c\xe3\x9a\xf1\xa0\xd2\x63\x47\xf9\xf5\xbe\x18\x0d\x1e\x17\xd3\xaa\x9b\x6b\xbd\xdc\x23\x66\xb3\xd0\xf5\xa6\xb0\x1f\xca\x7a\x51\x57\xbc\xcb\xbb\x59\x62\xbc\x5c\xb5\x08\xe3\x28\x83\x64\x42\x5b\x5b\x5b\x93\x3d\x3a\x57\xbb\x6d\x3e\x1c\x24\x6a\x03\xf0\xb1\xb5\xf5\x72\x8e\x0e\xa1\x5a\x26\x5a\x30\xf8\xc1\x3c\xcf\x85\x1f\xb1\x44\x9f\xca\xcc\xbe\x66\x9e\x7e\x56\x9d\x7b\xc6\x12\x6f\xe3\xc3\xe5\x36\xfe\xaa\xbb\xb7\x73\xbc\x45\x59\x8a\x46\xb4\x25\x78\x16\x4e\x3f\xf6\x27\xf0\xb7\x16\x8d\xe8\x0d\x3a\xd0\x37\xd7\x69\xbb\x66\xce\x6e\x9d\xb3\x68\x21\x8b\xc6\x4f\xf3\xe2\x99\xb2\xf8\x1c\x71\x16\xcd\xfb\xad\xc7\x1d\xf8\x5b\x7b\x8e\xb2\x50\x93\x27\xf9\x96\x31\x68\x8d\xfc\x5e\x32\x89\xa0\xa7\x6e\xb9\x8f\x70\x66\x2f\x9a\xe4\xa1\xdf\xf3\xca\xf8\x91\x5d\xd8\xc4\x05\x76\x29\xf1\xc3\xc9\x2c\xfe\x3b\x7e\x99\x78\x1b\xdd\x59\x1c\x8f\xc3\x7f\x72\x2a\x2a\x85\x99\x7a\x91\x17\x17\x43\xa2\x59\x77\xe4\x0b\x30\xd4\xde\x46\x90\xe5\x2f\xd3\xc3\xe3\xac\x13\xd5\x55\xef\xe8\xf7\xcb\x6f\xaa\x57\xc9\x83\xc2\x82\x6f\x80\x09\x1f\x45\x16\x3e\x58\x9c\x37\xc4\x0e\x95\xcc\x28\x8d\x12\x38\xc0\x6e\xeb\x28\x37\x90\x9f\x5a\xe5\x58\xbb\x14\x28\x9d\x03\x0a\x30\xf8\x24\x5d\x26\x36\xde\xc1\x32\x3a\xf6\x42\x60\xec\x70\x29\x2c\x72\xfc\xed\x55\xf3\x43\xd7\x7b\xb6\xcd\x7c\xdf\x56\x72\x2d\xdf\x73\x2d\x6a\x39\xad\xd4\xaa\x10\x9f\x4a\x4b\xf2\xd3\xf8\xf4\x6f\xee\x9b\x90\xc9\xdc\x71\x71\xd3\x48\xee\xa1\xc4\xe3\x31\xbc\xcb\xc5\x82\xea\x62\x10\x44\x10\x0c\x26\x7f\x34\x54\x89\xa9\x1f\x42\x5c\xce\x5b\x11\x2a\xf3\x91\x49\xe5\x1c\xf4\x47\x49\xc3\x3f\x4a\x19\xfe\x21\xeb\x1c\xfc\x41\x6d\x96\x81\x49\x8d\x70\x1c\xaf\xd0\xaf\xe3\x54\x93\x96\xec\xfd\x85\xa4\x41\xb2\xb6\xae\xbe\xb1\xd3\xaa\x3e\xc7\x1a\xfd\xde\x0f\xed\xe7\x02\x7a\xcb\xf9\x87\xf7\x39\x42\x2f\xab\xa4\xce\x92\x73\x42\x3c\x9e\x70\x12\xa1\x3b\x42\x0b\xf9\x0e\x54\x8b\x43\xc9\x3c\xcf\xab\x72\xde\xc2\x10\x0a\x9d\x65\x04\x62\x5f\xd9\x16\x6e\xbf\xdc\x79\xf0\x12\x59\x41\xc7\x44\xdc\x5e\xc4\xa3\xc2\x21\x95\x75\xb6\xfa\x09\xc4\xa2\x91\xf8\xd4\x2c\xe1\xdc\x29\x1a\x6f\xc6\x1d\x4a\xf0\x49\x79\x50\x91\xe5\x2c\x39\xd1\xf0\x0a\xd4\xaf\x69\x99\x6e\xfa\xee\x89\x38\xfa\x34\x9c\xef\x32\x7d\xbc\x6c\xc3\x3b\x49\xe6\xbe\x2b\xda\x94\xcc\x58\xf4\x32\xb8\x1c\xb7\x61\xe6\xc8\x0d\x53\xc4\x5e\x26\xea\xaf\x7f\xeb\x64\x71\xa7\xf8\x9f\x14\x6f\xe5\x7f\xed\xa5\xc3\x54\x59\xbd\xee\xdf\x9c\xdd\x6a\x3d\x67\xea\xc5\xb9\x29\x99\xee\x74\x16\x89\x93\x10\xef\x50\x8d\xa3\xd2\xd7\xd9\xbc\x4d\xa4\xfa\xfb\xea\xf3\xe9\x61\xed\x06\xb9\x8f\x4e\xea\x25\x69\x75\x44\x8a\x4e\x61\x05\xab\x1c\xae\xdf\xd4\x05\xb0\x74\xbe\x96\x5b\x49\x71\xbf\x10\xa8\x4d\xe2\xa4\xfb\x8b\xbe\x2f\xe9\x10\x9e\xb2\x81\x7c\x11\x0a\xd1\xba\x6a\x21\xed\x05\x7b\x64\x41\x7b\xb4\x5e\xfa\x37\xc9\x3b\xf8\xe9\xa4\x19\xd9\x17\xbe\x49\x1e\x96\xe1\x2b\x71\xca\x22\xd9\x95\x4d\x98\xa5\x88\x8b\xce\x27\x6f\xb2\x73\x03\xbc\xa5\x28\x99\xa8\x29\x11\xae\x13\xf6\xac\xc2\x09\xb9\x39\xbe\x94\xcb\x45\xe7\x1c\xa9\xcb\xba\xcc\x61\xbd\xc8\xdd\x7c\x34\xb4\x6a\x6d\xb1\xe2\x2d\x31\x88\x74\x9c\x24\x94\x52\xbd\xc3\x9b\x2a\x1a\x56\xf9\xfa\xe3\xf3\x0c\x88\x46\xeb\x8d\xed\x49\xf1\xf0\x2c\x9c\x17\xbc\x63\x45\xd9\xc1\x38\x46\x09\x96\xc8\x9a\xf7\xd5\xc1\xeb\x61\xab\x47\xf9\x74\x37\x8b\x62\xbf\xef\x7b\x6e\x3a\x8d\xa2\xd7\xa7\xe9\xb9\x13\x35\x68\x81\xf3\x32\x9e\xc5\x76\xdf\x7f\xf6\x5c\xc2\xdf\x1b\x07\x81\x33\x89\x3c\x3b\xf2\x26\xce\xd4\x89\xbd\x02\xfe\x7c\xd0\x6a\x21\x58\x34\xe9\x0a\xb6\xfd\x24\x8e\xfe\xef\x85\x4c\x33\xbf\x0a\xb3\x50\x17\xe5\xa8\x3b\x7b\xad\xa7\x2c\x21\x47\x5e\x38\xa3\x36\x6c\xff\xdb\x75\x62\x07\x67\x29\xbe\xde\x14\xfd\x93\xe7\xa1\x63\xad\x50\x97\x96\x7a\x43\xaf\x77\xdf\x1d\x3f\xff\xa3\x7e\x90\x08\xb2\x62\x2c\x67\x59\xd2\x78\x81\xa9\xec\x22\x92\xd3\x8d\xc6\xc1\x2c\xf6\xd6\x7b\x81\x3f\xb1\xa7\x5e\x2f\xc6\x2b\x2c\xbd\xba\x2e\x7e\xcd\xd5\x68\x5e\x59\x87\x5a\xe8\x3c\xbe\xf1\xaf\xdb\x33\x0b\x49\x7d\x3d\xf0\xa3\x18\x6f\x1d\xc3\xab\xfe\x08\x7c\x95\x3d\xfc\xe1\x08\x2e\x6c\x91\x64\x42\x17\xec\x38\xfd\x1f\x4e\x76\x2d\x13\x5d\xb6\xc5\x17\x2b\x18\x48\x7a\x81\x8a\xd5\xc4\x97\x91\x45\xd7\x8e\x05\x77\x3d\x3c\x8f\xf7\x9d\xbf\x11\xf5\xc3\xa1\x2e\x49\xcf\xc5\x0a\x9d\x12\xc0\x4a\x5d\xeb\x2e\xbc\x7c\xbf\x9e\x7f\x8b\x9a\xf6\xae\x42\x2d\x97\x7f\x38\xaa\x54\x92\x77\x98\x93\xcb\x6e\x46\x79\x1e\xff\x60\x41\x72\x97\x88\x75\xa2\x63\xdd\x3d\x0d\x3d\xf8\x5b\x73\xfd\x47\xdf\xf5\xa6\x6f\xe4\x0a\x74\xba\xaa\xb1\x5b\x68\xa5\x4c\x76\x24\x22\xbb\x14\x09\x4c\xd6\xbd\xba\x57\xa7\x0d\xa0\x44\xf8\xe1\x8f\x06\x6f\xd9\x12\x13\xe7\xa3\xd9\xf3\x6f\x4f\x90\xe1\xd8\xe9\x46\xec\x79\x24\xbf\x9d\x11\x8b\xfe\x11\xf8\x78\xde\x83\x74\x5f\x67\x6b\x01\x5f\x5e\xcb\x69\xfc\x70\x48\xd1\xe0\x6f\x90\xcc\xdb\xbf\x41\xb2\x4d\x04\xf6\x14\x9f\x34\x6a\x93\xe7\x0a\xfc\xab\x57\x74\xb1\x51\x9c\xef\xec\x50\x78\x9e\x57\x49\xfe\x23\x09\x8f\xbd\x1a\x8b\x12\x64\x2e\x26\x4d\x95\xf9\x48\x26\xbd\xeb\xf5\x7a\xe1\x2b\xf2\xdc\x68\xac\x33\x43\x25\x4f\x7c\xfc\xba\x67\x2e\xba\xd5\x8e\x15\xb6\x7f\xfd\x44\xc7\xe9\x09\x84\xfe\xda\x01\xc3\x6f\xeb\xe5\x08\xb2\x94\x10\xe6\x97\x4c\xe7\x87\xf3\x96\xdf\x98\x84\xcd\x27\x7c\x45\xb2\x04\x1d\xda\xc2\xe5\x6a\x3b\x3d\xe5\x49\xce\x81\xf0\xa3\x01\x4e\x81\xeb\xd9\xc9\xb0\xe0\xfb\xe0\x25\x63\x2f\x3c\xf7\x97\xef\x0c\x36\x74\xfd\x7d\x09\xdd\xa4\xba\xf4\x7c\x9d\x94\xe4\x9a\x9b\xfa\xe5\xd4\x24\xd5\x50\x52\x99\x2e\x5a\x2e\xff\x3f\x3e\x58\x3f\x9c\xc2\xe9\x6e\x81\x19\xe6\xff\x93\x1e\x64\xe7\x0a\x98\x5a\x20\xb1\x2c\xbf\x7e\x7f\x8d\x7c\x98\xf8\x41\xc0\x4d\xea\x42\x79\xd6\x71\x4c\x56\xe5\x81\xdf\x03\x9f\xa4\x23\x2a\x32\x53\x82\xe3\xbd\x56\xcb\x80\xd2\x7e\x29\x01\x2d\xf1\x9c\x51\x7a\x5a\xb6\x26\xfd\xab\x3f\xc8\x72\x14\x3b\xbd\x7b\xc1\x9c\x26\x42\x50\xa1\xe2\xaf\x71\xf0\x17\x23\xe6\xcc\xb7\x62\x40\xde\xf6\xc7\xe6\xcf\xff\x67\xf3\xa5\xd8\xcf\x39\xf3\xe3\xbc\x39\x0d\x87\x82\xe6\x43\xa3\x19\x9d\xb6\xd3\x6a\xc8\x14\xd8\x5c\xb7\xf5\x02\x68\xe9\xf9\x52\x4a\xc0\x27\x9a\xba\x00\x36\xcd\xdf\xf2\x1a\x4b\x4e\x87\x52\x03\x1f\x9b\x03\xff\x27\xf6\x8a\x70\x8a\xdb\x1d\x74\x4f\xb6\x61\x38\xe0\x5b\x33\xb1\x17\xc6\xc0\xf1\x60\x5c\xed\xa2\xb6\x89\x13\x7a\x69\x5e\x26\x55\x26\x54\xa0\x8c\xbe\xf1\xbf\xca\xd8\xa9\x59\x52\x40\xfc\xc5\xca\x65\xae\x8c\x84\x46\xfd\x30\xd4\x26\xce\xc0\x0f\xd1\x0f\xb6\x37\xe1\x93\x17\xdc\xaf\x50\xfc\xfb\x01\xbe\x50\x55\x11\x26\x38\xcf\x4a\x57\x6a\xf2\x48\x87\x10\x03\xf9\x2e\x6a\x8e\x26\x4e\xf1\x33\x1a\xf9\x0d\xdd\x24\xbf\x00\x84\x3f\xf7\x98\x09\x7b\xa1\x25\x73\xfe\xf6\xbf\xc0\x7d\xfa\xb2\x96\x24\x42\x06\x82\xa2\xa5\x99\xf5\x75\x36\x17\x25\xd7\x70\xf9\xd1\x95\x8d\x04\x75\xd9\x4d\xe6\x0e\x85\x40\xde\xb0\xf6\x65\x97\x5d\x73\x89\x27\xf3\x20\xab\x4a\xb1\x10\x3f\xde\x22\x12\x82\x3b\x45\xca\x48\xce\x3d\x65\xb2\xce\x3e\xb6\x57\xe6\x37\x35\x4c\x80\x79\x7e\x7e\xa6\x50\xe7\x43\xa4\xbe\xe6\x80\xb2\xa0\x39\x20\x04\x58\x0e\x55\xd6\xb4\xa0\xcb\xac\xf5\x12\x4b\x1b\xae\xcb\x70\x23\xff\x2b\x51\x64\x9d\xbb\x2a\xa3\x96\x01\x15\x45\xc3\x43\x0b\xba\x92\x85\xca\x3b\x33\x8f\x2b\xef\x00\xd5\xa1\xb8\x6f\xe0\xa9\xca\xa2\x79\x85\xb4\xc0\x19\x52\x74\x7d\xa9\x5d\x3f\xa8\x0d\x28\x9b\xf0\xb0\x25\x94\x91\x2a\x93\x80\x0b\xdc\xb5\xbc\xb2\x8f\x60\xca\xac\xf1\xd3\x58\x09\x94\x60\xee\xa0\x27\xa8\x86\x7c\xee\x42\x0f\x7e\x4a\x2c\x04\x4e\x09\x37\x16\x98\xc1\x1a\x05\x33\x18\xf2\xa3\x63\x4d\x6f\xcd\x07\xda\x46\x34\xc2\xdd\x2f\x13\x31\xfd\x5f\xf2\x49\x54\xa9\x21\xbe\xe7\xcb\xc0\xe6\x74\xbd\x25\xef\x7a\xfc\x40\xaa\xd8\x44\xe0\x94\x71\x64\x81\xce\xb7\x0a\x3a\x1f\x79\xd2\x7d\xec\x1c\x8e\xd1\x9a\x2a\xe0\x56\xed\xb0\xfc\x28\x7b\xb6\x2e\xbc\xd0\x27\x7a\x38\x5d\xaf\x80\xb2\xc1\x3d\x21\xa1\xb3\x0f\x53\x48\x41\xb0\x82\x7b\x7a\x6c\xc3\x34\x11\x7a\x81\x06\x3b\x03\x67\xd5\x88\x7f\x38\x19\xa3\xa7\x26\x96\xc3\xb6\xc6\xf0\x76\x69\xee\xe2\xfb\xba\x6c\x2d\x2d\x88\x47\xd4\x7d\x73\xc6\x38\xef\x82\xe8\xb7\x9b\xf0\x06\x24\xfe\xed\xeb\x87\x43\x6f\xea\xc3\xa3\x5c\x86\x61\x9e\x9e\x62\xb2\xd8\x8f\xb3\x6d\xe8\xe1\xd7\xab\xbe\xce\x0e\x9d\x4e\x97\x02\xd4\xdf\x5c\xb6\x1f\xab\x1d\xe3\xf9\x61\x67\xaa\x45\x23\x27\x08\xd4\xb9\x88\x1f\x8e\x10\x23\x6e\x95\x73\x62\xae\x7c\xb4\x0f\x71\xb7\x8e\x91\xb0\xd3\xb2\x37\x15\x0d\x5e\x3e\x37\xd1\x77\xdc\xa8\x51\x91\xfc\x3c\x9a\x53\x29\x58\x9c\x1b\x51\xaa\x56\xa0\x37\x1e\x0f\x7f\x5f\x6b\xe8\x8e\x11\xbe\xf3\xc8\xc9\x1b\xe6\x85\x8f\x63\x20\xf7\x34\x05\x6a\x5c\xd5\xe9\xf3\xcc\x54\x56\xa7\xc3\x3f\xed\x23\xaa\x45\xf3\x63\x6f\xb4\xa4\x75\x09\x1b\x99\x52\xf0\x6f\x7d\xc1\xef\x90\xb9\x7e\xd2\xd3\xa5\xc4\x0a\x0d\x59\xd2\xff\x05\xad\x90\xb8\x20\x35\xf4\xa5\x2b\x7b\x4e\xe0\x81\x33\xcf\xa3\xc0\xf9\x68\xd8\x8b\xd8\x20\x41\xa7\x93\xc3\x67\x56\x81\x60\x92\xfb\x5e\x3a\x8c\xc5\x46\x8c\xf5\x54\x97\x0d\x91\xfe\x9e\x37\xf4\x5d\x34\xa5\xa8\x52\xef\x3f\xe6\x66\xfa\x34\x1c\xd7\x53\xf3\x3f\x95\x51\x2e\x1b\xe4\x0a\x94\x64\xde\xb4\x22\xdb\xee\x9e\xa2\xa8\xe0\x2f\x36\x2f\xec\x95\x44\x51\x80\xe0\x1e\xe4\xac\xe7\x1f\xe4\xac\x4f\x9e\xcb\xb9\x4e\x8f\x06\x63\x8b\x16\xa8\x9f\x47\x95\x7c\x06\xa4\xe0\xab\xd0\x9f\x6a\x6b\xce\x17\xa8\x39\x5b\x12\x9a\x72\xa8\x45\x03\x5b\xd6\xe7\xb7\xcf\x99\x7e\xbf\x72\x08\x2b\xb1\x5b\x10\xf8\x97\x66\x4f\x25\x1e\x7e\x99\xe9\x39\x03\xf3\x95\x51\xcf\x35\x5d\x14\xf5\xf2\x7d\xe9\x7e\x09\xeb\x52\xbe\x7e\xa8\xf3\x3f\xa9\x5f\xdf\xfe\xe7\x94\x57\xe0\x48\x42\xfd\x62\xcb\xc3\xaf\xb2\x5c\x62\x7c\xff\xbb\x2c\x17\xc4\xbc\x7c\x47\xba\x5f\x41\xba\x8c\xa7\x1f\xe9\xf8\xcf\xe9\xd3\xa2\x33\x5a\xc9\xba\xca\xc8\x58\xa7\xa4\x9e\x2f\x4f\x84\x87\x81\x6e\x98\x7e\x8d\x1d\xf8\x05\x5f\xd6\x8e\x98\xa3\x14\x68\xb1\x80\x96\x72\xf6\x8d\xfd\x6d\xf4\xc1\x9f\x4e\x5f\x34\x72\xc5\xa7\x90\x2f\x34\x3b\xfc\x22\xb3\x85\x43\xf2\x75\xd1\xce\x31\x5b\x14\xed\x92\x1d\xe8\x7e\x3e\xe5\x32\x5e\x7e\xa0\xbf\x3f\xa3\x2f\xdf\xfe\xc7\x54\x51\xe0\x48\xc2\xfc\x42\xab\xc3\xaf\xb1\x3a\x77\x4c\xff\x3b\xac\x16\xc4\xba\x64\xef\xb9\x9f\xce\xb8\x84\x8f\xcb\xf7\xf5\x27\xf4\xe3\x9c\x53\x14\x5f\x3e\xfc\x7d\x94\xef\xb2\xf1\x56\xe7\x2a\x52\xce\x97\x4b\xa1\xef\x79\x16\x79\x7f\x08\xc0\x6f\xd2\x0b\xfd\xef\x52\x1a\x3c\xde\x45\x4b\x3a\x57\xfd\x88\x76\xfa\x11\x48\xf0\xaa\xf4\x65\x37\xe1\x57\x26\x39\x3f\x84\x18\x6c\xed\x83\x96\xa8\xcf\xa2\x16\xcd\xee\x4b\x93\x0e\x3f\x91\x14\x7f\x79\xf3\x73\x3d\x4d\x49\x3f\xd3\x53\xea\xab\xac\x5f\x40\x5a\xec\x69\xf1\x78\xb8\x1f\xd0\x5d\xc2\x6e\xc9\x21\x2b\xd6\x5d\xc2\x6e\xc9\x01\x28\xd6\x65\xec\x92\xe2\x26\x0f\x09\x7f\x68\x28\xa9\xe1\x08\x1c\xa9\x77\x4b\x72\x0e\x3f\x8f\x93\x1a\xbe\xcf\xe7\xfc\x44\x3f\xa9\xe1\xfe\x7c\xce\x42\x3f\x8b\x87\xc2\x5d\x5e\x75\x71\xab\xe5\x06\xab\x58\x75\x71\xab\xe5\xba\xbe\x58\x95\xb6\x4a\xea\x0c\x3f\xe5\xfd\x39\x85\x46\x17\xf2\x8f\xf8\xa3\x99\x21\x24\x1d\x7e\x12\x69\xe0\x7c\xbe\xa3\x81\xf3\x79\x7e\xe2\x54\xf9\x54\x3f\x45\x9c\x1f\xf5\x13\x27\xd7\xe7\x8e\xbc\x90\x34\xef\x69\xe1\x60\xcc\x71\xa8\xbc\xee\x82\x76\x03\x67\x69\xb3\x81\xb3\xb0\xd5\x72\x69\x52\x5a\xb5\xa4\xd5\x92\x83\x5e\x5e\x37\xff\x61\xf7\x52\x09\x84\xb5\xf2\x8f\x67\xc1\xf3\x6b\x57\xcd\xeb\x33\xfc\x5b\x46\xf4\x1c\x10\x3c\x7d\x27\x82\x55\x68\xcb\xfc\x4d\x7e\x72\xfd\x91\x7d\x79\xb0\x90\xe2\xbb\x88\x8f\x6c\x6d\x29\x54\x16\x3d\x34\x91\xef\x9f\xb2\x9a\xe5\x1f\x16\x51\x3f\x81\x73\x91\x5f\x90\x42\x6b\x82\x07\xd3\x28\x0a\xbd\xb4\x96\xc4\xc5\x8a\xdc\x45\xdc\xb5\x79\x2f\x5d\x7f\xd7\xc1\x78\xfc\x54\x38\x51\xc1\x4f\xc5\x49\x71\xe2\xc7\x77\x8a\xbf\x76\xcc\x3e\x62\xb7\x9c\x85\xa5\xc6\x67\x49\x5b\x92\xdd\xff\x71\x14\xb9\xf0\x92\x2f\xe7\x8a\xd9\x4b\x8f\x62\x41\x20\xf8\xaa\x0c\xb6\x96\xc4\x62\x1c\xd4\xcd\x18\x63\xf1\x37\x20\x09\x9c\x7f\xd7\x51\x00\x2d\x1e\xc4\x85\x5f\x30\x58\xc4\xc8\x87\xc6\x71\x71\x73\xe2\xa1\x24\xb1\xe4\x82\x84\xd7\x65\x65\xec\x9f\x3f\x94\x59\x38\xa3\x9d\x81\x89\xe1\xf8\x2b\x6f\x44\x03\x43\xdd\x86\xd7\xea\x35\xc4\xd0\xe2\xd1\xb4\x7a\x6b\x0d\xcb\x15\xc4\xea\xf6\xfb\xba\xdb\x5c\xff\xa8\x9d\x0f\x0d\xe8\xe2\xe6\xc4\x03\x4a\x62\xc9\x05\x99\x44\x2f\x35\xf0\x05\xe5\x49\x22\x32\xa7\x97\x5b\xf8\x14\x89\xbe\x10\x45\xe0\x18\xd7\xed\x79\xcd\xbe\x21\xc0\xcd\x19\x4d\x63\x4d\x6f\x8a\xca\xd3\x6d\x79\x6e\x7f\x6d\xfd\x43\x46\x3e\x34\x94\x0b\xda\x92\x8c\x23\x8e\x22\x3f\x8e\x28\x6e\x31\xfb\xe7\x0f\x62\x1a\x8b\x31\x7e\xbd\xbf\xc4\x70\xfc\x69\x18\xa2\x81\xa1\x7d\xc7\xeb\xf6\x7a\x62\x68\xf1\x50\x36\x9d\x86\x6b\x75\x05\xb1\xf6\x7b\xfd\xa6\x67\xad\x7f\xd4\xce\x87\x46\x73\x71\x73\xe2\x01\x25\xb1\xe4\x82\x4c\xa2\x97\x1a\xf8\xfc\x31\x4d\x23\xb2\xdc\x3f\xa6\x86\xe1\xe4\x93\x1f\x4c\x57\x03\xce\x35\x84\xc8\xe2\x11\x75\x5a\xb5\x5a\xcd\x14\x8d\xa8\xe9\x7a\xae\xb7\xfe\x41\x33\x1f\x1a\xd0\x85\xad\x49\xc6\x13\x47\x92\x0b\x31\x89\x5d\xc6\xff\xf9\xc3\x99\xc6\x63\x5c\x0c\xf6\xcf\xd1\x4b\xdf\x8e\x1f\x7a\x53\xad\x1f\xcc\x7c\xd7\x76\xfa\xc9\xc3\xda\x62\x69\xd7\xeb\x8f\xa7\x9e\x68\xb3\x6c\x4a\x91\x97\xa4\x4a\xe6\xf6\x9f\xf1\x48\x73\x03\x6d\x38\x9e\xfa\xaf\x60\x38\xa8\xb8\x99\x49\x89\x38\x53\xbf\xac\x5f\xf5\xb4\x5e\xe0\x39\xd3\xbe\xff\x9c\xa9\xb1\xcd\x19\x9c\xeb\x8e\x4c\x81\x17\xa4\x2a\xd6\xcd\xdd\xe0\x4a\x9b\x8e\x9f\x52\x6c\xd6\x92\x82\xd2\x8d\xa3\x52\x50\xd6\x42\x40\x0b\xec\x1b\x88\x49\x16\xd0\x20\x46\xf6\xfa\x57\xfd\xdb\x6c\x88\x88\x3b\x7c\x73\x02\x7f\xc3\x2f\xeb\xdb\x4a\x45\x61\x37\xe1\x2b\x91\x0a\xb2\xb1\x9e\x37\xa0\x92\x01\x93\x0d\x4c\xbe\xfb\x73\x7d\xbd\x78\xc7\x0a\x7b\xe9\x0d\x65\x91\xdd\x1d\xc7\x43\x08\xdf\xeb\x5f\xd6\xb5\x64\x63\x25\xfc\xf1\x07\xd2\x43\xe8\x88\x5c\x51\x48\xf7\xf6\x20\x0d\xc9\x05\x53\xd8\x27\x09\x58\xb6\x9f\x0e\xce\xb4\xc9\x2c\x08\x92\xfb\xc0\x78\x6b\x12\xf4\xf7\x5f\xfe\x68\x32\x9e\xc6\x4e\x08\xef\x83\x59\x37\x37\xd7\xbf\x13\x20\x30\x62\x1c\xfc\x49\xc3\x46\x3d\xef\xcf\x91\x36\xf4\x5d\x76\xff\x05\x96\x69\xeb\xe1\x04\x76\x43\x1e\x3f\xa5\x18\xe4\x30\x03\x32\xff\x0c\xee\x6e\x34\x3f\x7c\xf4\x23\x1f\xde\x55\x40\xff\xf7\x03\x3f\x7e\xc1\xbb\xa4\x81\xef\x2f\xcd\xeb\x91\x86\x36\x14\x40\x16\xe1\x8d\x36\x5b\xff\x4f\xbd\xe2\xe0\xb7\xd9\xe8\x77\xf4\x10\x0c\xbf\x35\x38\x7f\x8b\xb9\xec\x96\x69\xc7\xa8\x8f\x46\x01\x84\xe4\x7a\xa1\x34\x28\xed\xd7\xeb\xef\x3b\xcd\xe9\xf7\xfd\xe7\x6c\x9b\x04\xb4\x67\xe5\xfb\xff\xd1\x46\x91\xf6\xe8\x7b\x4f\xd0\x97\x78\x77\x18\xd7\x7b\xf4\x7b\x5e\xb2\xc7\x11\x98\xf8\xe9\xd6\x4d\x0d\x87\xaa\x05\x03\x2d\xd9\xa1\x01\xb2\xb8\xbb\xa6\x1f\xa5\x92\x91\x8b\xbf\x04\x02\xe3\x36\x74\xee\x53\xc1\x73\x94\x09\x0e\x36\xb7\xbc\x54\x10\x8d\x30\x57\x26\x3f\xdc\x3a\xba\x49\xe5\x23\x37\x27\x9f\xde\x3d\xfd\x49\xe5\xcf\x11\x2f\x37\x7b\x7b\x93\xbb\x54\x1e\x8d\x32\xc1\xee\xd1\x54\x40\x0c\x92\xe1\xd6\xe8\x38\x1f\x5e\xa6\x79\xf4\x4b\xbb\xc8\x9b\x84\x89\xec\xe4\x6e\xf4\x4c\x49\xa0\xe9\xe1\xe2\x4f\x2b\x6d\x1a\xb9\x10\x52\xed\x78\xf3\x39\x1f\xb2\xba\x1a\xdf\x37\x47\xd7\xa9\x20\x18\xa8\xab\x8f\xbb\x83\x1e\xdd\x3b\xea\xea\xcb\xeb\xd6\xa0\x4d\x61\xb8\x52\xe2\x06\x3a\xdd\x92\x25\xdd\x74\x6f\xad\xb1\x86\xb7\xc7\xe1\x7c\x95\x26\x37\x9a\xbf\x0a\xf0\x48\x4e\xe3\xa7\xf3\xc0\x30\xf9\xd3\x0a\x6e\x5e\x41\x8d\x87\x73\x59\x60\x6f\x33\x8a\xa6\x28\x58\x71\xfa\x49\x43\x2e\xa2\x92\x8c\x7e\xca\x95\x24\x43\x59\xbf\x8a\xb2\x97\xa3\xd4\xe4\x5e\x72\x1b\xee\x54\x9c\xd0\xa5\xcd\xb5\x5a\x06\xf2\x9d\xcf\x26\x69\xfc\x68\x48\xe5\x70\xc1\x88\x17\x63\xf3\x03\xce\xe3\x61\xbc\xe7\x70\x48\x87\xbb\x64\xf4\x92\xa9\x40\xda\x09\x8b\x1b\x90\x14\x76\x99\xd4\x28\x6d\xa2\x68\xba\x5c\x22\x61\x5a\x2d\x53\x60\xce\x30\x5a\x2d\x3c\x4b\x70\xd3\x97\xb4\xb7\xc8\x2c\x21\xc3\x0b\x67\x89\x42\xb0\x68\x96\xe0\x14\x20\x6b\xe6\xb1\xcc\x4f\x9b\x79\x7d\x20\x3e\xa9\x49\x7b\x62\x09\x0b\x92\x73\xd1\x42\x89\x33\x37\x8a\xa2\x13\xe9\x12\x99\x63\x98\xba\x8e\x98\xf9\xb3\x96\xb4\x63\xd0\x78\xc8\xe1\x82\x0c\x29\xc6\xe6\x13\x84\xc7\x43\x7e\xcc\xe1\x98\x9f\x1e\x69\xa0\x73\x4e\xbd\x8b\xf4\x99\x6c\x09\xb5\xd0\x88\x67\x6c\x85\x2b\x96\xf2\x63\x2b\x38\x3f\x3d\x04\x47\xbf\xf0\x62\x92\x3e\x01\x87\xe3\x79\xce\xcd\x9b\xc7\x2e\x7b\x27\x4d\x42\x1c\x8d\x16\x20\x9e\x93\xe7\x17\x07\xed\xfb\x1d\x42\x3c\x72\x17\x20\xa6\xba\xf3\xe9\xc6\xd9\x26\x1c\xc1\x40\xca\xb1\xda\xb1\x7e\x6e\xdf\x75\xd3\x8e\x9f\x4c\xfd\x30\x96\xa1\xb1\xc1\x04\x53\xac\x29\x9b\x5b\x0b\x54\x84\xd3\xeb\x3c\xbc\x68\x86\xcd\xeb\x40\x11\x95\xe0\xe2\x0b\x69\xb5\x63\x0d\xdc\xeb\x1e\xab\xc3\x65\xe4\x9c\x2e\x9a\xab\x9f\x4b\xe5\xd5\x8e\xb9\xbf\xf5\xab\xcf\x29\x71\xb5\x55\x6c\xb5\x04\x41\xbe\x38\x57\x3b\xe6\xf8\xe5\x8a\x77\x56\x58\x85\x73\xac\x97\xa5\xa1\x1b\x69\x4f\x58\xbe\xe7\xbb\x9e\xb1\x47\x32\x99\x1d\x35\xce\x8f\xf7\xff\x50\x15\xe5\x9f\xaa\xfa\xbf\xe2\xd5\x60\xdc\x73\x82\x68\xe3\x2d\x74\x1e\xe1\x3a\x84\xf2\xfc\xaa\x39\x9b\xb0\x9f\x64\xd7\x99\x2a\x2a\x7c\xf6\x41\xc9\x76\xc3\x57\x54\xf4\xf9\x1b\x5b\xa1\xbe\x85\xa3\xa8\xb0\xf7\xcb\x6c\x62\x2b\xf4\xd7\x73\x14\x35\x70\xba\x5e\x60\x2b\x1d\x6b\x70\xbf\x77\xae\xa1\x23\x45\x45\x99\x6b\x2b\xd4\x23\xc9\x8a\xaa\xb0\xcf\x30\x28\xb6\x22\x7c\xb6\x01\x2b\x6f\xe2\x43\x29\x4a\xf1\x47\x03\xea\x21\x06\xa0\x33\x5f\xcf\xe2\x48\xe3\xda\x55\x7f\x34\x38\x4b\x0f\xa5\xa8\x84\x0e\x6e\x6f\x24\xae\x19\x35\xe3\xe9\x5a\xa3\x1b\x11\x51\xf2\xb7\x58\x8e\x28\xe2\xe1\x6c\xd4\x0d\x1d\x3f\x00\x12\x6b\x74\x72\xb5\xa9\xb1\xcd\x40\x73\x41\x8e\x64\x18\x44\xd5\xf3\xa7\xbd\xc0\x03\x1e\x63\xb2\xb7\x37\xd2\xa8\x36\x20\xd9\x42\x7f\x0a\xa5\x4a\x34\xd5\xc6\x61\xf0\x02\xba\xe6\xc4\xd5\xfe\x68\xa4\x41\x8d\xa6\xc7\x61\xf0\x92\x6f\x27\x2a\x1a\xda\xdf\x0c\xfa\x1a\x29\x8f\xcf\x9e\x66\x5a\x5e\x84\x69\x76\x49\x43\x11\x74\x68\xd8\xca\x6e\x6f\x7b\xb3\xa5\x0d\x0d\x45\x1d\x9a\x80\x3d\x8b\x77\x9a\xda\xd0\x54\xd4\xa1\x05\x87\xfb\xaf\xad\x5b\x6d\x68\x29\xea\xb0\x06\x87\xfa\xe5\x8e\xae\x0d\x6b\x8a\x3a\xac\x43\x07\xf9\x83\xda\x8d\x36\xac\x2b\xea\xb0\x01\xd1\x1e\x5c\x77\x7d\x6d\xd8\x50\x54\xb4\x2b\x8f\xad\xd0\xfb\xfd\x28\x6a\x00\x6f\x42\x2a\x9b\x17\xe3\x36\xec\x90\xe8\xb8\x8a\x3a\x72\xa6\xf7\x80\xda\xba\xec\x07\x1a\x1c\x40\x2a\xc2\x85\x18\xb8\x6e\x04\x41\x1a\xee\xce\x70\x4f\xcb\x9a\x54\xf8\xb3\x0d\x57\xac\x04\xb2\x44\x15\x5d\x9a\x42\xba\x96\x76\xd6\xd6\xa8\x36\xa4\x7c\x06\x62\xa1\x34\x51\x4f\x2e\x98\x21\x7d\xf3\xf4\xfe\x5e\xa3\x1b\x11\xc1\x16\x02\x88\xe5\x09\x45\xb2\x71\x69\x32\xc2\x07\x9b\xbf\xc6\x1a\xd3\x8a\x48\x7e\x25\x10\x09\x22\xa1\x09\xc7\x4f\x53\x67\x82\x58\xb6\x9b\x56\xa8\xd1\x8d\x88\xe4\x08\x01\xc4\x72\xdc\x8d\xe3\x27\x6f\xda\x73\x22\x94\xa9\xd6\xe4\xa0\x7d\xad\x71\xed\x88\xa8\x4d\x0e\xa5\xa8\x84\x6e\x36\x99\xe0\x06\x5b\xd9\xd9\xdf\x73\x77\x35\xae\x19\xb1\x5d\x92\x43\x19\x08\x77\xb4\x33\xf1\x63\x27\xf0\x5f\xc1\xb9\xa3\xdf\x27\xe3\x57\x8d\x6f\x47\x74\x5b\xe9\xb1\x14\x96\x10\x8e\x66\x31\x9a\x1f\x2e\xda\x87\x3e\x06\x25\x4d\x88\xe6\x10\xfe\x14\x09\x13\x65\x7c\x63\x5f\xb1\x95\x91\x76\x15\x1b\x1a\xd3\x88\x08\x4e\x12\x84\x18\x90\x90\xe0\x3b\xc9\xd0\xd9\xc6\xe0\xd7\xe9\xb6\xc6\xb4\x22\x96\xf3\x04\x22\x41\x24\x34\x70\x7f\x18\x38\xac\xf0\xf7\xde\x2f\x2d\x6b\x42\x04\x07\x61\x7f\x2c\x92\x25\xaa\xf8\xae\x24\x68\x1b\xed\xe6\x49\x4f\x63\x5a\x11\xc1\x75\x02\x91\x20\x12\x9a\xe4\xf6\x16\xb0\x98\xa7\xde\xc3\xb3\x46\x37\x22\x92\x6d\x04\x10\xcb\x95\xee\x20\xed\x18\x5b\xb9\xda\x6b\x36\xce\x35\xaa\x49\xed\x0e\xd2\xae\xcc\x0b\x41\x99\x74\x87\xad\xec\x0e\xf5\x96\xa3\x51\x4d\x6a\x77\x90\xf6\x60\x5e\x08\xca\x69\xe7\x6d\x6b\xf5\xb1\x46\x1a\xd4\xee\x80\x74\x1c\xdb\x0e\x2a\x24\x78\x08\xc7\xba\x3d\x0a\x35\xaa\x4d\xed\x0e\xb2\x0e\xcb\x4b\x41\x1d\xc7\x0d\x1d\x3a\x68\xac\xfd\xd4\xb2\x26\xb5\x3b\x48\x3b\x2a\x27\x53\x26\xce\x00\xf6\x76\x74\xdc\x44\xd9\x72\x5e\xda\x43\x8d\x6e\x54\xe1\x60\x1f\xfd\x2d\x96\x2b\x68\x3b\xa1\x59\x88\x3e\x37\x03\xa9\xdf\x31\xe3\x6e\xe3\x4c\x63\x9b\x55\x38\xbc\xc4\x47\x32\x4c\x42\x95\x2c\x72\x10\x91\x73\xe2\xcf\x34\xba\x11\xd1\x1c\x20\x80\x58\xae\x30\xb7\x4c\x10\x49\xfe\x4e\x8a\xa2\xba\xc1\x7e\x7a\x24\xc3\xf8\xa1\x1f\xfb\x4e\xe0\x47\x23\x08\xbc\xf5\xeb\x7e\x5b\xcb\x9a\xa0\xd7\x61\x15\xf6\x30\x1b\xc7\xf0\x54\xf0\xa3\x37\xc5\x13\xdc\xf1\xfd\x41\x5b\x13\xc8\xd4\xac\xed\x2c\x69\x2a\x44\x2b\xd9\xed\x0e\xa0\xe5\x6f\x81\x28\x2a\xfc\x9d\x9e\x47\x72\xd2\xec\xae\x8c\xc2\xdd\xa6\x51\x54\x25\xfd\x3b\xb9\x1d\x85\xf8\x45\xf7\xa9\x28\x9a\x5d\x38\x96\xe3\xe0\xce\x92\x92\xdd\x65\x42\x36\x02\xb8\xb4\x69\x00\x79\xed\xc0\xb8\x45\x37\x30\x92\x16\xb5\x37\x0e\x6e\x22\x43\x20\x40\x5a\xd1\x08\x69\xfd\xb9\xfb\xed\x58\x5a\xda\x00\x4a\xe7\x23\x23\xdf\x8e\x74\x46\x2e\xd2\xe9\x98\x0f\xa7\x37\x3d\x2d\x6d\x01\xa5\x43\xd7\x10\x08\x90\x56\x30\xc0\x5a\x7a\x8c\x29\x51\x0b\x68\xb5\x07\x86\x40\x40\xa2\x32\x91\xd6\x76\x38\x79\x21\xce\x9b\x38\x2a\x53\x20\x20\x51\x21\x2d\xc3\x37\x67\x03\xe2\xbe\x89\xc3\x32\x05\x02\x12\x17\xd2\x32\x63\x6b\xed\x9a\xb8\x6f\xe2\xb8\x4c\x81\x80\xc4\x95\x68\xed\x07\xd6\x0b\x71\x3f\xd1\x6a\x0f\x4c\x81\x80\xc4\x65\x29\xb6\x72\x7c\x33\x69\x76\x88\xf7\x16\x0e\xcb\xca\xb7\x93\xa8\x40\xa7\x63\x6e\x85\xf5\x5d\xe2\xbc\x85\xa3\xb2\x04\x02\x12\x15\x68\xfd\xec\x5a\xde\x3e\xf1\xdd\xc2\x41\x59\xf9\x76\x12\x93\x85\x62\x6a\xec\x45\xb7\x24\x26\x0b\xc7\x64\x09\x04\x24\xa6\x1a\xd2\x3a\xfb\x19\xbf\x12\xe7\x6b\x38\xa8\x9a\x40\x40\xa2\x02\xad\xbe\xa3\x1f\x4c\x89\xef\x35\x1c\x54\x2d\xdf\x4e\x62\x02\x9d\xe1\x44\xdf\x99\x11\xdf\x6b\x38\xa6\x5a\xbe\x9d\xc4\x04\x3a\x1d\x63\xed\xb1\xf5\x40\x5c\xaf\xe1\x98\x6a\x02\x01\x89\xa9\x0e\x5a\xe6\xad\xf5\x74\x46\x5c\xaf\xe3\x98\xea\x02\x01\x89\x09\x69\x59\xc3\xce\xe6\x1e\x71\xbe\x8e\x83\xaa\x0b\x04\x24\x2a\xd0\x8a\xb4\xc3\x7b\x93\x78\x5f\xc7\x51\xd5\xf3\xed\x24\x2a\xd0\xe9\x58\x93\xf1\x85\x49\x9c\xaf\xe3\xa8\xea\x02\x01\x89\xaa\x01\x5a\x66\xbb\xdd\xf7\x89\xf3\x0d\x1c\x55\x43\x20\x20\x51\x25\x5a\xb3\xc7\xe9\x39\xd2\x8a\x46\x58\xeb\x7c\xd4\x10\x08\x48\x54\x48\xcb\x72\xae\xbb\x75\xe2\x7e\x03\x87\xd5\x10\x08\x48\x5c\x48\xcb\xb8\xff\x1d\x37\x88\xfb\x0d\x1c\x57\x43\x20\x20\x71\xad\x21\xad\xe0\xd6\xb8\x22\xee\xaf\xe1\xb8\xd6\x04\x02\x12\x17\xd2\xb2\x0e\xff\xf4\xd3\x49\x61\x0d\xc7\xb5\x26\x10\x90\xb8\x12\xad\xde\xaf\x71\x83\xb8\xbf\x86\xe3\x5a\x13\x08\x48\x5c\x89\xd6\xf3\xeb\x99\x4e\xdc\x5f\xc3\x71\xad\x09\x04\x24\xae\x26\x68\x99\xfb\xcf\xbb\xcf\xc4\xfd\x26\x8e\xab\x29\x10\x90\xb8\x12\xad\x81\xef\xee\x10\xf7\x9b\x38\xae\xa6\x40\x40\xe2\x4a\xb4\x4e\xce\x9c\x73\xe2\x7e\x13\xc7\xd5\x14\x08\x48\x5c\xa0\xd5\xfe\xa5\x69\x11\xf1\xbe\x89\xc3\x6a\xe6\xdb\x49\x54\x2d\x64\x69\x7c\xdc\xbf\x23\xce\xb7\x70\x54\x2d\x81\x80\x44\x95\x68\x35\x36\x0f\x6a\xc4\xf9\x16\x8e\xaa\x25\x10\x90\xa8\x12\xad\xed\xa1\xbb\x47\x9c\x6f\xe1\xa8\x5a\x02\x01\x89\x0a\x69\x59\x0f\x8d\xe9\x01\x71\xbf\x85\xc3\x6a\x09\x04\x24\x2e\x43\x57\x6c\x65\x32\xf2\x37\x6f\x88\xfb\x86\x8e\x03\x33\x74\x81\x84\x44\x86\xf4\xa2\xfa\x7d\xf2\x5c\x27\x6e\xc1\x67\x63\x5d\x20\x21\xb1\x21\xbd\x8e\x31\x3e\xda\x3a\x24\x31\x60\xc5\x43\xd7\xd0\x45\x22\x12\x5f\xa2\x69\x6e\xdf\x5e\xff\x21\x71\x60\xcd\xf6\xc0\xd0\x45\xa2\x34\x46\x38\x9d\xbf\x5c\x87\x7e\x3a\x51\x18\xe9\x72\xc3\x10\x48\xd2\x18\x41\x2f\xf0\x8f\x2e\xd3\x53\x15\xd6\x3b\x1f\x19\x86\x40\x92\xc6\x08\x7a\x1d\x73\x6b\x74\xe9\xa5\x81\xa4\xab\x0e\x43\x24\x4a\x63\x44\x9a\xc6\xc3\xdd\xf9\x76\x1a\x48\xba\xf2\x30\x44\xa2\x34\x46\x13\x34\x4d\x77\xd0\xd3\xd2\x50\xc8\xea\xc3\x30\x45\xa2\x34\x4a\xa4\x69\xbd\x04\x5b\xf5\x34\x18\xb2\x02\x31\x4c\x91\x28\x8d\x13\x2f\x78\xb6\xae\xb3\x35\x94\x49\xe2\x34\x45\xa2\x34\xce\x44\x73\xf7\x68\x1a\xa6\xc1\x98\x24\x4e\x53\x24\x22\x71\xc2\xfa\x16\x1a\x6c\x65\x76\xdf\x6c\x9f\x6a\x5c\x33\x50\xdc\x44\x27\xb3\x20\x30\x4c\x19\x84\xa5\x82\x1e\x0f\x4f\x4f\xb4\x1b\x8d\x6b\xa6\xa8\x0c\x19\x84\xa5\x42\x09\x6a\x5d\x9b\xfb\x1d\x8d\x6b\xa7\xb8\x74\x29\x86\x21\x43\xd5\x6c\x34\x1f\x0e\x86\x0c\x8e\x4c\x3a\x40\xd5\x92\x21\x18\x22\x98\xec\xb6\x76\x06\x93\x80\x41\x91\x29\x19\x78\x9a\x12\x00\x43\x93\x9c\x0b\x2e\xaf\x0e\x59\x6b\xe4\x94\x05\x3c\x6b\x32\x04\x43\x94\x9c\x2c\x4f\x76\x86\x8f\x0c\x8c\x9c\xd3\x81\xa8\x21\x43\x30\x44\x75\xc5\x56\xae\x9e\x77\x1f\xaf\x19\x14\x59\xf1\x00\x4f\x5d\x02\x60\x68\xd0\x52\xcb\xba\x7e\x98\xc4\x0c\x8c\xac\x06\x81\xa7\x26\x43\x30\x44\x68\x1d\x6a\x98\x7f\x3c\x57\x63\x9b\x33\x22\x4b\x86\x60\x88\x20\xb9\x3b\xd6\x44\x8b\xd9\xd4\xa5\x93\xdb\x94\x21\x18\x22\x48\xed\xb3\xed\x9d\x07\x2e\x6f\x29\x1e\x43\x02\x60\x68\x50\x5a\x9b\xbb\xc6\x35\x6b\x8d\xce\x6a\x5d\x86\xc8\x88\xa2\x21\xae\x7a\xe3\xe7\xc9\xe3\x41\x86\x4b\xda\x09\x55\x34\x84\xba\x95\x60\x58\x32\x88\xae\x63\x6d\xad\x59\xdb\x2c\x30\xab\xdc\x68\x68\x18\x52\x0c\x4b\x06\x31\x0e\xeb\x8d\xfd\x67\x8d\x6b\xa6\xb8\x74\x19\x84\xa1\x4a\x0a\xf7\xa4\x65\x9d\x30\xb8\xac\x70\xa3\x61\x4b\x86\x60\x88\xa0\x70\x3b\xe6\x51\xf7\xe9\x89\x81\x65\x95\x1b\x0d\x9b\x32\x04\x43\x84\x4a\xd7\xf8\x6d\x79\x3f\x19\x58\x56\xba\xd1\x70\x4d\x86\x60\x88\x50\xe9\x9a\xe1\x66\xef\x82\x81\x65\xa5\x1b\x0d\x1b\x32\x04\x43\x84\x7f\x08\x78\x31\xdb\x03\x59\xed\x46\xc9\x25\x7c\x11\x82\x21\x42\xc5\x6b\x1e\x3c\x3c\x50\x35\x8e\x9a\x33\xa2\x9a\x0c\xc1\x10\x41\xf1\x1e\x6e\xc5\xb3\x06\x83\xca\x6a\x37\x1a\x5a\x12\x00\x43\x83\x12\xdc\x3a\x39\x74\xa7\x4c\x82\xd3\xf9\x6d\xca\x10\x0c\x11\x4a\x6e\xe3\xe9\xb2\x65\xb1\xc9\x4d\x11\x19\x32\x04\x43\xa4\x23\xa2\xd1\xe9\xe9\x84\x21\xa2\x13\x5b\x97\x21\x08\xd1\xb8\xdf\x8f\xbc\x98\x9c\xb4\x5b\x3b\x7f\xee\xb4\x9c\x24\xa1\x3b\x46\xc7\x86\x59\x80\xe3\x49\x51\xa4\xe6\xb0\xaf\x45\x3c\xd8\x60\x49\x8d\x02\x1c\x4f\x0a\x51\x9f\x5c\xfa\x27\x33\x1e\xab\xb3\x9c\xba\x1c\xc6\x51\xe2\x95\xfc\xf5\xee\x99\xc6\x0b\x68\xc6\x96\x1c\xc5\x11\xa2\xf2\x36\x7a\x5b\xfb\x9e\xc6\x0b\x68\xc2\xa6\x1c\xc5\x11\xa2\x32\xb7\x6e\x2e\xe2\x16\x07\x25\x85\x9e\x10\xae\xc9\x51\x1c\x21\x2a\x77\x6b\x3a\x7b\x30\x38\x28\x29\xf8\x84\xb0\x21\x47\x71\x84\xa8\xec\xcd\x5f\x8f\xee\x93\xc6\x0b\x68\xc2\xba\x1c\xc5\x11\xa2\xf2\xb7\xda\x3d\x63\x5b\xe3\x05\x34\x61\x4d\x8e\xe2\x08\x61\x1a\xe8\x18\x87\x7b\x57\x5d\x0e\x4a\x66\x82\x84\xd0\x92\xa3\x38\x42\x54\x32\xd6\xd5\x4e\xfb\x4a\xe3\x05\x34\xa1\x29\x47\x71\x84\xf8\x07\xc2\xc5\x3e\xdf\xdd\x5c\xb5\xc8\x51\x1c\x21\x94\x4a\xc7\x9a\xd4\x46\x27\x1a\x2f\xa0\x09\x75\x39\x8a\xfc\x90\x20\x0b\x6c\x18\xbe\x60\xe0\x1c\x69\x5c\x3b\xd0\x9d\x8f\xc8\xda\x5c\x82\x61\xc9\x50\xb4\xad\xed\x1d\xe3\x8c\x05\x92\x9f\x61\x64\x75\x2e\xc1\xb0\x64\x10\xe9\xfd\xce\xeb\xbd\xc6\xe2\xc8\xcf\x56\xb2\x3a\x17\x43\x18\x2a\x98\x0c\x8e\x62\xf3\xce\xd5\xd8\xd6\x8c\xa8\x25\x01\x30\x34\x68\x0a\xb0\xa2\xa7\x93\x2d\x06\x46\x2e\x7c\xe0\xb5\xb9\x18\xc1\x10\x25\xa5\xbf\x7f\xe5\x5e\x33\x30\x72\x65\x28\x5d\x9c\x8b\x10\x0c\x11\x94\xfc\xd3\xc5\xce\xae\xc3\xa0\xc8\x95\x33\xbc\x36\x17\x02\x18\x1a\x28\xf4\x8b\xf6\xc3\xd6\x0b\x83\x22\x97\x15\xf1\xd2\x5c\x08\x60\x68\x50\x79\x1b\xf5\xce\x2f\x36\x7a\x72\xcd\x95\x2c\xcd\x85\x08\x86\x08\xca\xfa\xfe\xf7\x6c\xf0\x9b\x41\x91\x0b\xd2\x78\x65\x2e\x04\x30\x34\x28\xb5\x8d\x41\xff\xe9\x0f\x93\xda\x74\x66\x9b\x32\x04\x43\x04\x69\x7d\xd9\xde\xdb\x3c\x61\x50\x4c\x52\x4b\x00\x0c\x0d\x24\x74\xc7\x5c\x6b\x18\xec\xa0\xd2\x09\xad\xcb\x10\x19\x11\x59\x98\x3f\x5e\x3d\x44\x8f\x19\x0c\xaf\xb9\x31\x53\xb2\x2e\x17\x43\x58\x2a\x88\xad\x63\x4d\xa7\xaf\x9b\x2c\x30\x8b\x8e\x2c\xcb\x85\x18\x96\x0c\x45\x68\x34\xfc\x7d\xaa\x3f\xb3\x75\x39\x26\xd3\xa5\x18\x86\x0c\x8a\xb6\x63\x0d\x8e\x6b\x1d\x06\x97\x55\x6d\xb2\x30\x17\x22\x18\x22\x54\xb6\xa6\x7f\x41\xcf\x5e\xe9\xc2\x3c\x21\x6a\xca\x10\x0c\x11\x2a\x5b\xa3\x7e\xf8\x70\x91\x95\x6d\xba\x30\x4f\x88\xd6\x64\x08\x86\x08\xca\xb6\x63\x5d\x6f\x6e\x6d\x33\xb0\xac\x6e\x93\x85\xb9\x10\xc1\x10\xd5\x11\xd1\x8b\xdf\x78\x66\x88\xb2\xca\xc5\x0b\x73\x11\x82\x21\x82\xd2\xdd\xf7\x3b\x71\x8f\x41\x65\x95\x8b\xd6\xe5\x42\x00\x43\x03\x85\xdb\xb1\xb6\xb6\xcd\x5b\x06\x96\x55\x2e\x5a\x97\x8b\x11\x0c\x11\x24\x78\xc7\xfc\x7d\x72\x35\x63\x60\x74\x82\x9b\x32\x04\x43\x84\xd2\xdb\x78\x9c\xc6\x1e\x9b\xde\x14\x91\x21\x43\x30\x44\x28\xb5\x4d\x7f\xef\xbc\xcd\xc0\xe8\xcc\xd6\x65\x08\x42\xc4\x2e\xcc\x07\x9b\xc7\xbb\x5a\x4e\x92\xd0\xe1\x55\x81\x59\x80\xe3\x49\x93\x48\xc3\xfb\x07\x4d\xcb\x49\x18\x52\xa3\x00\xc7\x93\xa2\xa8\xad\x5e\xb7\x9d\xf3\x40\x67\x49\xf5\x02\x1c\x47\x8a\x0a\xdb\xb8\x7d\xb8\xbc\xe7\xb0\xa4\xb4\xb3\xa5\xb9\x04\xc5\x11\x42\x81\x07\xf5\xf6\x85\xc9\x21\x49\x85\xa7\x2b\x73\x09\x88\xa3\x4b\xca\xbc\x13\x3c\xeb\x1a\x2f\xa0\xf9\xd6\xe4\x28\x8e\x10\xca\xfd\xb4\x1f\x9f\x3d\x6b\x7c\x3b\xcd\xd7\x90\x82\x38\x3a\x54\xf4\xc6\x96\xff\xf3\xa7\xc6\x0b\x68\xbe\xba\x1c\xc5\x11\x42\xf1\x77\xac\xdf\xc7\x2f\x87\x1c\x94\xd4\x3f\xb5\x2c\x17\xa3\x38\xc2\x64\x1a\xb8\x69\x9e\x9f\x6b\xbc\x80\x26\xb4\xe4\x28\x8e\x30\x29\x98\xd9\xf3\xa3\xc1\x15\x0c\x5b\x2f\xa6\x1c\xc5\x11\xa2\x62\xb1\xda\xdb\xed\x5f\x1c\x94\xab\x15\x39\x8a\x23\x44\x85\x62\x3e\xec\xc4\xbf\x35\x5e\x40\x13\xea\x72\x14\xb9\x4a\x4f\x96\xd6\xb6\xd2\xbd\x3b\x3d\x9f\x6a\x5c\x33\xb0\x1d\xba\x64\x55\x2e\x86\xb0\x54\x28\x56\xf3\x4c\x1f\x5c\xb0\x40\x72\x8b\x23\x5d\x94\x8b\x31\x2c\x19\x8a\xd3\x78\x79\x0a\xeb\x1a\xd7\x4e\x91\xe9\x52\x0c\x43\x06\x13\xc1\xad\x35\xd2\xee\x18\x18\xb9\x77\x86\x97\xe5\x42\x00\x43\x03\xe5\xdf\x31\xef\x5f\xee\x5b\x0c\x8c\xdc\x59\x24\xcb\x72\x21\x82\x21\x82\xc2\x77\xc3\xe9\xe1\x9e\xc6\xb6\x66\x3c\x6b\x12\x00\x43\x03\xe5\xde\x31\x5e\x8f\x6e\xfb\x0c\x8c\xdc\x99\x4e\xaf\x98\x8b\x10\x0c\x51\x52\xe8\xc1\xe0\x79\x5f\x63\x9b\x33\xa2\xba\x0c\xc1\x10\x41\x81\xf7\x0f\x3a\xda\x39\x83\x22\xcf\x35\xe0\x75\xb9\x10\xc0\xd0\xa0\xb2\x36\x5e\xb6\x0c\x36\xe3\xc8\x33\x1f\xe9\x15\x73\x11\x82\x21\x32\x81\xc8\x9c\xce\x7e\x1b\x0c\x11\x9d\xdc\xa6\x0c\xc1\x10\xa1\xd4\xb6\x26\xf7\xcf\xa7\x6c\x6a\x53\x44\x86\x0c\xc1\x10\xa1\xb4\x36\x8f\xb6\xfb\x31\x93\xd6\x74\x56\xeb\x32\x44\x46\x94\x5e\x33\x37\xdd\xfb\xe0\x38\xc3\x65\x6b\xf3\x43\x37\xbd\x66\x2e\xc6\xb0\x64\x28\x3e\xf3\xd8\xba\xa0\x06\x26\x5b\x9c\x63\x32\x43\x8a\x61\xc9\x50\x8c\xd6\xfe\xda\x6d\x2d\x8b\x31\x5b\x9c\x63\x32\x5d\x8a\x61\xc8\xa0\x74\x3b\xe6\xc9\xc1\xb9\xce\xe0\xb2\xda\x4d\x16\xe7\x42\x04\x43\x84\x8a\xd7\x78\x38\x3d\xf5\xb3\xe2\x4d\x17\xe7\x09\x51\x53\x86\x60\x88\xa0\x78\x6f\x9b\x87\x67\xd4\x04\x96\xae\xcd\x13\x9e\x35\x09\x80\xa1\x41\xc5\x6b\x75\x2e\xae\x4f\x19\x58\x56\xbc\x78\x69\x2e\x42\x30\x44\x75\x7c\x52\x9d\x52\x93\x57\xba\x34\x4f\x88\xea\x32\x04\x43\x04\xc5\xdb\x31\x4f\x66\xe7\x4d\x06\x96\x55\x2f\xbe\x66\x2e\x42\x30\x44\xa8\x7c\xcd\xd7\x03\xad\x93\x95\x6f\xba\x38\x4f\x88\x2c\x19\x82\x21\x42\x29\x6e\x6d\x4f\x7d\xd6\x71\x3a\xc3\x4d\x19\x82\x21\x42\xe9\x6d\x1c\xae\x69\x5c\xb6\x51\x44\x86\x0c\xc1\x10\xa1\xd4\x36\xee\x4f\x9c\x27\x26\xb5\xe9\xcc\xd6\x65\x08\x42\x84\xcf\xc4\x06\xbe\x27\xf0\xd4\xdf\xd3\x72\x92\x84\x0e\xaf\x0d\xcc\x02\x1c\x4f\x0a\x91\x46\xfb\x5d\xff\x49\xcb\x09\x18\x4e\x43\x0e\xe3\x29\x51\xcc\xd6\xc0\x7b\x30\x78\xb0\xce\x72\xea\x05\x38\x8e\x14\x95\xb5\xf5\x32\xf9\xd5\xd3\x78\x01\x4d\xd9\x92\xa3\x38\x42\x28\xef\x17\x77\x7b\x76\xae\xf1\xed\x34\x5f\x53\x0a\xe2\xe8\xa0\xc8\x3b\xc6\xe1\xb9\xb5\xc9\x41\x49\x9d\x53\x4b\x73\x31\x8a\x23\x44\xe5\x6e\xfc\x6c\xea\x43\x8d\x17\xd0\x84\x0d\x39\x8a\x23\x4c\xca\x7e\x60\x4d\x3a\x1a\x2f\xa0\x09\xeb\x72\x14\x47\x98\x94\xff\xee\xdd\x29\xdf\xd9\x64\x02\xc8\x16\xe7\x12\x14\x47\x08\xd3\xc0\x8d\xd7\x7d\x0d\x39\x24\x99\x07\xd2\xb5\xb9\x04\xc4\xd1\x41\xb9\xdc\x3c\xb5\xa6\x23\x0e\xc9\x16\x8b\x29\x05\x71\x74\x50\x28\x5b\x17\xe3\x7d\x97\x2f\x14\x86\xce\x90\x82\x38\x3a\x28\x92\xf1\xcd\x4e\xaf\xcd\x21\xd9\x12\xd1\xa5\x20\xf2\x00\x0c\x59\x5a\xdb\x4a\xc7\x32\x3b\x4f\x9e\xc6\xb5\x03\x5b\x7b\x90\x5e\x2b\x17\x63\x58\x32\x88\xb4\x63\xd6\xbc\x9d\x27\x16\x48\x9e\x1f\xca\x96\xe5\x42\x0c\x4b\xa6\x23\xb2\xd6\x83\xf3\xa0\x71\xed\x14\x99\x2e\xc5\x30\x64\x68\x12\x30\xce\xd7\x6e\xc7\x0c\x8e\x3c\x9d\x86\xd7\xe5\x62\x04\x43\x04\xc5\xdf\x31\xb7\x06\xc7\x9b\x0c\x8c\x3c\xbd\x97\x2e\xcc\x45\x08\x86\x08\x95\xbd\xf5\xeb\x51\x6f\x33\x30\xf2\x74\x63\x7a\xbd\x5c\x84\x60\x88\x92\x72\x9f\xec\x1f\xbe\x32\x30\xf2\xf8\x67\xba\x34\x17\x21\x18\x22\x54\xe6\xe6\xeb\x63\xf4\xa2\xb1\xcd\x19\x51\x5d\x86\x60\x88\xa0\xbc\xa3\xdd\x68\x74\xab\xb1\xad\x19\x4f\x4d\x02\x60\x68\xa0\xa8\x2f\x7d\xeb\xc0\x61\x50\xe4\xc1\x6a\xbc\x32\x17\x02\x18\x1a\x48\xf0\xeb\xd1\xe0\xe6\x91\x41\xd1\xe9\x6d\x4a\x00\x0c\x4d\x92\xda\xbf\x0f\x77\x46\x6c\x6a\x53\x3c\x86\x0c\xc1\x10\x41\x5a\x9f\x5e\x9f\x5d\xf4\x18\x14\x9d\xd4\xba\x04\x90\xd1\x64\x8b\xf2\xdd\xe3\xc3\xeb\x0c\x97\x2d\xca\xdb\x83\x6c\x51\x2e\xc4\xb0\x64\x10\xdd\x81\x7b\xfd\x3a\x65\x71\x59\xdd\x26\x6b\x72\x31\x84\xa5\x82\xf8\x3a\xd6\xc3\x93\xd5\x65\x81\x59\x84\x64\x49\x2e\xc4\x30\x64\xa8\x6c\xad\xf6\xcd\x9d\xae\xb1\xcd\x19\x55\x4b\x86\x60\x88\x50\xd9\x1a\x8d\x07\xff\x2a\x2b\xdb\x74\x49\x9e\x10\x35\x65\x08\x86\x08\x95\xad\x79\x7c\x7a\xdf\xd0\xd8\xe6\x8c\x68\x4d\x86\x60\x88\x50\xd9\x5a\x63\x23\xa2\xe6\xd4\x74\x51\x9e\x10\x35\x64\x08\x86\x28\x29\x5b\x77\xf4\xf2\x3b\x2b\xdb\x74\x51\x9e\x10\xd5\x65\x08\x86\x08\xca\xb6\x63\x4d\xa6\xb3\x16\x03\xcb\xea\x36\x59\x94\x0b\x11\x0c\x11\x14\x6e\xc7\x0c\x36\x2f\xa9\xfa\x4e\x17\xe5\x09\x91\x25\x43\x30\x44\x28\xc5\xad\xdd\xd9\x36\x9b\xbe\x74\x86\x9b\x32\x04\x43\x04\xe9\xdd\x31\x6f\xaf\xf7\x35\x06\xc6\xa4\xb7\x0c\xc1\x10\xa1\xe4\x36\xfc\xab\x23\xea\xe4\x95\x2e\xca\x13\x22\x5d\x86\x20\x44\xf8\x4c\x8c\x16\xe5\xb3\xd3\xb3\xdb\x6d\x2d\x27\x48\xdc\xc2\xeb\x02\x53\x0e\xe3\x29\x51\x9c\x46\x7c\xfd\xdc\xd5\x72\x12\x86\xd3\x28\xc0\xf1\xa4\x49\xcc\xfa\x81\x31\xe5\xc1\x3a\x4b\xaa\x17\xe0\x38\xd2\xa4\xb0\x5f\x6f\xce\x78\x07\x48\x69\x53\x8b\x72\x31\x8a\x23\x44\x05\x6e\xed\x1f\x8d\x9e\x35\x5e\x40\x13\x36\xe5\x28\x8e\x10\x15\xba\x35\x3a\xb8\x0f\x35\x5e\x40\x13\xae\xc9\x51\x1c\x21\x2a\x78\xd3\x3c\xbf\xdc\xd4\x78\x01\x4d\xd8\x90\xa3\x38\x42\x7c\xcd\xfc\xac\xd6\xd3\x78\x01\x4d\x58\x97\xa3\x38\x42\x34\x01\x18\x3f\xaf\xef\x63\x8d\x17\xd0\x84\x35\x39\x8a\x23\x4c\x26\x82\xcd\xd7\xd7\x9f\x1a\x2f\xa0\x09\x2d\x39\x8a\x23\x84\x92\xe9\x58\x3b\x83\x67\x9f\x83\xb2\x25\x63\xca\x51\x1c\x21\x2a\x18\xeb\x74\xff\x6e\x8f\x2f\x18\x86\xd0\x90\xa3\x38\x42\x54\x2c\xd6\xdd\xc5\x3d\xdf\xdd\x6c\xad\xe8\x72\x14\xde\x6c\xa2\x37\x0e\x5d\x2f\x8c\xf0\x96\x0e\xc1\x1f\x6d\x4f\xe3\x05\xc9\x76\x13\x5b\xe4\x58\x8e\xc3\x94\x51\x3c\xf5\x27\x09\xa1\xf9\x12\xed\x1e\x6b\x6c\x73\x42\x77\x9e\x1c\xc9\x30\x98\x6a\x38\x7e\xc4\xaf\xe5\x9e\xba\xf5\x3f\x18\x94\x34\x26\x34\xfb\xf0\xb7\x58\xee\xf4\x62\xbc\x9d\x45\xfc\x78\x7b\xab\x25\x87\x8a\x8a\x5f\x53\x06\xc3\xc7\x83\x3d\x33\x7b\x6d\x19\xde\x44\x86\xd6\x83\xf8\xa0\x8e\x5f\x4b\xc6\x2f\x18\xdb\xca\xef\xe9\xd9\x61\x33\x7b\xdf\xd8\xc5\xaf\x13\x6f\x3d\x6d\x0d\x8e\xb2\x57\x89\xf1\xee\x32\xd9\x9e\x19\xb6\x22\xf9\xa0\x08\xf6\x9e\xd9\x75\x43\x86\x44\xdb\x4d\x80\x63\x5e\x7b\x6b\x3b\xd9\x36\x42\x51\x5d\x3f\x02\x4a\x17\xda\x2f\x4f\x46\x3d\x8d\x34\xc0\x6b\xb9\x71\x48\x3e\x76\x00\x5d\xb7\xa6\xd5\x3c\x57\xa3\x1b\x61\x33\x93\xed\xe4\x6f\xb1\x7c\x3c\xf1\x42\x5b\xe9\x05\x9d\xf0\x5e\x83\xbf\xe1\xad\xe2\xe9\x78\xe2\x8e\x9f\x60\x9f\xd6\xc1\x00\x6f\x7d\xf1\x3b\xbc\xdd\xd1\x78\x81\x4a\x1a\x2e\xd0\xb1\x1c\x87\xb6\x30\xb6\x15\x7a\x43\x63\xec\x3c\x79\x0d\x1d\x84\x57\xdd\x93\xb6\x46\x37\x82\xf3\xe9\x9b\xeb\x22\x39\xa2\x20\xa3\x0a\x14\x27\xfa\x7d\xac\xd1\x8d\x40\x41\xed\x00\x90\x97\x23\x0a\xf2\x0a\xbb\x59\x7f\x79\x32\xb5\xb4\x05\x94\xf1\x4b\xec\xbc\x04\xa9\x91\x24\xb1\x15\x2d\x1e\xec\x1e\x6b\x74\x1b\xe8\xa6\xef\xb1\x0b\xc4\x88\x00\xa7\x13\x78\xde\x3f\xbe\xee\x6a\x54\x1b\xe8\x67\xaf\xb2\xe7\xa4\x48\x3d\xf0\xc3\x7b\xe4\xf6\x7d\xfd\xf1\x52\x4b\x5b\x40\xb5\xed\x87\xf7\x02\x49\xa2\x06\x1e\x77\x6a\xb7\xcd\x87\x03\x0d\x1f\x23\x95\x41\xae\x55\xc9\x76\xc8\x4d\x94\xcc\xd6\xd6\xcb\x39\xb5\x6f\x2e\x56\xdd\x83\x83\xf6\x40\x02\x40\x2c\xd1\x08\xf4\x2d\xfd\xd8\x9f\x68\xf8\x18\x8c\x9e\x8f\x72\xad\x94\xd1\x44\xc9\xf4\x76\x8e\xb7\x28\x4e\xac\x8a\x8c\x9e\x8f\x24\x00\xc4\xf2\x8c\xb2\xc2\xbc\xdf\x7a\xdc\xd1\xf0\x31\x18\xbd\x89\x72\xad\x94\xd1\x44\xc9\xf8\x69\x5e\x3c\x53\x9c\x58\x15\x19\xbd\x89\x24\x00\xc4\x82\x5e\x7d\x07\x0a\x6b\xe4\xf7\x92\x31\x4d\x9a\xc0\xf4\x26\xfc\x25\x92\x65\x0e\x80\x2a\xb7\x09\x71\x66\x5a\x24\x53\xf2\xfb\x18\x2b\xb6\x22\xdb\xe2\x38\xe3\xba\xc2\x2d\x45\x58\x85\xda\xaf\x19\x1c\xcb\x6d\xe2\x8c\xe8\x2e\xf0\x86\xce\x42\xb9\xe2\x87\x93\x19\xfe\x1e\x10\x7e\x8a\x72\xf2\x47\xa3\x1b\x55\x74\x90\xc6\x97\x97\x53\x11\x26\x5b\xb6\xf8\xe8\x9c\x33\x78\x3d\x6c\xf5\x34\x91\x2c\x8d\xf1\x17\x69\x2a\x44\x67\x53\xde\xc8\x0b\x67\xe0\x64\xdd\xd9\x6b\x3d\x65\x13\x19\x6a\x4e\xa7\xbb\x43\x2f\x9c\xc9\x30\xb0\xc9\x34\xbc\xc0\x84\x37\x9c\x56\x54\x25\x74\x1e\x35\xd7\x7f\xf4\xc9\xae\x13\x77\x4f\x43\x4f\xa3\x1b\xd5\xd0\x79\xdc\x4e\xfe\x16\xcb\x11\x45\xec\x74\x51\x62\x9a\x3d\xff\xf6\x44\x4b\x5b\x40\xf9\xc2\xe9\x46\x02\x09\x52\xcb\xa2\x04\xee\xb0\xfd\xeb\xa7\xc6\x36\x03\x01\xd5\x4b\x62\x0c\x09\x12\x38\xc6\x7b\x57\xdb\x69\xd4\xd8\xca\xc4\x0f\x02\xe4\x9d\xe5\xd7\xef\xaf\xb5\xac\x09\xd8\x4f\xe0\x2f\x91\x0c\xa9\x46\xb1\xd3\xbb\xc7\xee\x4d\xfa\x57\x7f\x34\xba\x11\xd4\xcf\x93\xbf\xc5\xf2\xb4\x6b\x28\x6f\x21\x4d\x9b\xd1\x69\x5b\x13\xc8\x48\x77\xd1\x11\xcb\xc1\xb0\x34\xd1\xf0\x8e\xe7\x8a\xad\xec\x0e\xba\x27\xdb\x1a\xdd\x06\xe7\xf6\x2d\xb2\x23\xba\x40\x8c\x08\x60\x2b\x7d\x08\xcf\x7c\x30\xae\x76\xb5\xb4\x05\x74\x4f\x9c\xd0\x13\x48\x26\xce\xc0\x0f\x1d\xb4\x65\xb5\x12\x1a\xf5\xc3\x50\xcb\x5a\x14\x55\xc9\x0e\x60\xee\xb6\x95\x76\xfd\xa0\x36\xa0\x20\xd0\x4a\x71\xb4\x07\x12\x08\x4d\x84\xe7\xe7\xe9\xad\xf9\x40\xc3\x60\xae\xcd\x0e\xd1\x6c\x2b\xc1\x84\xb0\xf1\x19\xf5\x5d\x32\xe4\x27\xd9\x9b\x1d\xb8\xf9\xfd\xf2\xb1\xd2\x26\x6c\xdd\x2e\x92\x2a\xcc\xe7\x10\x80\x41\xf0\x95\x04\x4c\x02\x7b\xb6\xa0\x33\xad\x18\x83\xa9\x62\x3f\xc6\x5b\x88\x19\xe6\xe9\x29\x06\x25\x8d\x09\xcd\x05\xfc\x2d\x96\x63\x8a\xe4\xe3\x09\x88\x23\xf7\x49\x05\x4c\xb2\x8b\x0e\x24\x08\x25\xfb\x36\x1a\x22\xe1\xbe\x97\x96\xec\xf9\x42\x66\x42\xfe\x6b\x6a\xa9\x17\xe4\x6b\x0d\x88\x42\xf0\x15\x07\xec\xc9\x16\x3e\x94\xa2\x28\x6f\x34\x3f\xf6\x20\x05\xa6\xc3\x3f\xed\x23\xca\x66\xd2\x9e\xb9\x75\x10\x7b\x23\x29\x0a\xbb\x97\x4d\xf5\xfc\x77\xd5\xb0\x63\xe9\x54\x9f\x97\x63\x0a\xb2\x3e\x05\x92\xfc\x87\xbd\x30\x4d\xb6\xb0\x15\x62\x30\x15\x59\x2d\xda\x8a\xe8\xc3\x52\x98\x8a\x5a\x66\x8a\x30\x98\x8a\xac\x1a\x6d\x45\xf4\x3d\x23\x4c\x45\x2d\x37\x45\x18\x4c\x95\x2e\x39\xb9\xef\xe9\x60\x12\xb2\xec\xcc\x49\xb1\x3a\x59\x47\xda\x8a\xe8\x03\x2e\x98\x24\xdb\x47\x49\x88\xc1\x54\x78\x49\x69\x2b\x82\x4f\x87\x60\xa2\x74\x21\x2a\x42\xa4\x1f\x66\x50\xb8\x2f\x35\xc0\x15\x15\xea\x63\x08\x10\x6d\xfe\x13\x09\x8a\x9a\x1c\x91\x85\x91\x08\xa1\xa4\x1f\x39\x00\x0e\xee\xbb\x07\xc9\xe6\x40\x78\x87\xba\x9c\x0c\x3e\x43\x60\x2b\xd9\x47\x10\x14\x15\x3e\x73\x60\x2b\xd4\x37\x0f\xe0\xe7\x20\xde\x8e\xd2\x56\xf8\xcf\x1c\x90\xfd\xb9\x90\xae\xad\xf0\x5f\x38\x48\xf6\xe6\xda\x47\x46\xf2\xb2\x64\x57\x4a\x5b\x61\xbe\x58\xa0\xa8\xe8\xa3\x04\xb6\x42\x7d\xa1\x40\x51\x15\x6c\x0f\x96\x91\xb6\x92\xdb\x17\x5e\x51\xf1\xdf\x37\x91\x50\x9a\xaa\xa3\x69\x9c\xdf\x66\x3c\xd5\x3e\x1f\x89\x84\xa9\xf2\x08\xef\x43\xc9\xee\x36\x9d\x6a\x1f\xba\x42\x69\xaa\x8e\xce\x45\xfc\x66\xc4\xa9\x76\x7b\x20\x12\x2a\xfc\x8e\xf5\xe0\x81\x78\x2f\xfb\x94\xe9\x26\x4a\x93\x45\x02\xa4\x59\xf1\x66\x59\xb6\x22\xdb\xd7\x9e\xe2\xcd\x36\xdb\x92\x41\xf3\xcc\xd8\xa6\xad\x14\x6f\x75\x9f\xb3\x92\xc6\x50\xac\xa5\xf0\xbb\xb9\x43\x07\x89\xf7\x79\x4f\x4d\x9c\x8f\x08\xb9\x0c\x48\xb3\xe2\xd0\x6c\x45\xb6\xbb\x3b\xc5\x9b\x76\x90\x14\x9a\x67\xc6\x36\x41\xa9\x68\x6b\xf7\x9c\x95\xb4\x83\x8a\xb5\x14\x7e\xdb\x72\xe8\x20\xf1\x86\xe6\xa9\x89\x43\x37\x25\x97\x00\x69\x56\x1c\x9a\xad\xc8\x76\x31\xa7\x78\xb3\x0c\x92\x41\xf3\xcc\xd8\xa6\xad\x14\xef\x60\x9e\xb3\x92\xc6\x50\xac\xa5\xf0\xfb\x79\xc3\xc3\x26\xc2\x8d\xbe\x53\x0b\xed\x01\xe6\x96\xe1\x68\x4e\x1c\x98\xad\xc8\xb6\xfc\xa6\x68\xb3\xfc\x91\x41\xf3\xcc\xd8\xa6\xad\x14\x6f\x02\x9e\xb3\x42\xba\x67\x8e\x96\x82\xf7\x0e\xc6\x3f\xfb\xd9\xed\xc0\xc9\x1c\x8e\xa6\xdd\x9c\x8c\xa8\xa2\x49\x97\xdf\xf0\x9b\xa8\x9e\x8f\x44\x32\xa2\x8a\xa6\x5c\x6e\x47\x6f\xa2\x79\xe8\x0a\x44\x44\x11\x5f\x8f\x61\xf7\xf1\x26\x9a\xed\x81\x48\x96\x76\x2d\xda\x26\x19\xd4\x05\x7b\x5e\xa7\xdd\x78\x02\x20\x19\x86\xa5\xc2\x5d\x69\x2b\xf2\x0d\xad\x59\x5a\x32\x34\x05\x70\xce\x02\x4e\x0f\x5b\x29\xd8\xbd\x9a\xb5\x91\x55\x62\x81\x82\xd0\x0a\x76\xc1\x56\xe6\xee\x55\x2d\xb2\x98\xa6\xdd\x5c\x5d\x32\x94\x64\x38\xf2\x5b\x59\x93\xf1\xc4\x63\x21\x00\xbc\xbf\xab\xfd\x59\xd8\x83\x1f\x67\x2b\x9e\x1a\x57\xdf\x94\x59\xe4\x55\xe0\xb2\x7c\x2f\x56\xd6\x8f\xbb\x77\x5e\x2f\x5e\x75\xbd\xbe\x1f\x7a\x27\xd3\xf1\xc4\x9b\xc6\x2f\x2b\xb1\xaa\x74\x3a\x5e\x74\x38\x76\x67\xb0\xc0\x79\x7b\x74\x82\x99\x67\xff\xa5\xbf\x57\x55\x84\x85\x15\xf6\x46\xca\x5a\x7d\xeb\x8f\xa7\x2b\x8f\xce\xb4\xe2\x6d\x38\xd3\xc1\x6c\xe4\x85\x71\xb4\x1a\x78\xe1\x20\x1e\xaa\xf1\xc6\xcf\xe9\xd4\x79\x59\xf1\xaa\x6a\xb8\xa1\xaf\x87\xff\xe5\xad\x87\xdf\xbf\x57\xe3\xbf\xc3\x7f\x32\xf0\xdf\xe1\x3f\xeb\x53\x2f\x9e\x4d\xc3\x4a\xbc\x7a\x37\xf6\xc3\x15\xa5\xa2\x54\x39\xcf\xd5\x90\xf3\x9d\x08\x2b\xe3\x15\xaf\xfa\x86\xf5\xbd\x6f\xdf\xbc\xd5\xcc\xf9\x7f\x79\xf6\x1b\x76\xd8\xf6\xde\xdf\x53\x95\x69\xd2\x17\x7e\x7f\xe5\xaf\x15\xaf\xe2\x87\x51\xec\x84\x3d\x6f\xdc\xaf\xc4\xd5\x6a\x3c\x9c\x8e\x9f\x2a\xa1\xf7\x54\xb9\x78\x99\x78\x3b\xd3\xe9\x78\xba\xa2\x6c\x39\x61\x38\x8e\x2b\x3d\x27\x08\x2a\x4e\xa5\x17\x38\x51\x54\x71\xa2\x8a\x53\x21\x94\x4a\x35\x63\x0f\x32\x76\x8f\xa2\x3b\xf3\xfa\xde\xd4\x0b\x7b\x84\x33\x1e\xfa\x51\x65\xe8\x44\xe1\x7f\xc4\x95\xae\xe7\x85\x95\x74\x43\x4d\xcf\xad\x68\x95\x68\x36\xf1\xa6\x2b\x55\x06\x01\xf6\x3d\x57\xa9\xe2\xee\xfa\x2b\xfe\xf7\xbf\x95\x31\x1a\x43\xe5\xaf\x8d\xf8\x65\x82\x42\xf8\xf6\x4d\x49\xbd\xca\x5a\xff\xe5\xd9\x71\xe6\xa2\x93\xba\x28\xc2\x7e\xfb\x16\xce\x82\xe0\xaf\x8d\x8d\x58\xdc\x1b\xe7\xe0\x5a\xc5\x7b\x9e\x4c\xbd\x28\x02\xba\xd1\x2c\x8a\x2b\x9e\x1f\x0f\xbd\x69\xa5\xeb\x55\x40\xbb\x32\x9e\x52\xdd\xa3\x56\xa0\xfb\x94\xef\xc4\x42\x75\xdd\x5b\x9d\x4c\xc7\xf1\x18\x1a\x36\x70\x1a\xf6\xa6\x9e\x13\x7b\x2b\xf1\xb7\x6f\x71\x26\x54\xe1\x8b\x72\x51\x3c\x9d\xf5\xe2\xf1\xd4\xc6\xb9\xe8\xa9\x5e\x38\x1b\x79\x53\xb8\xef\x61\xff\x65\xa8\x4f\x53\x1f\xdd\x2c\xb1\xff\xd2\x61\x97\xce\xbe\x3f\x98\x61\x99\xfe\x0e\x59\xfb\xed\xdb\x0a\xb6\x11\x79\xf1\x09\xa1\x3e\xee\xff\x4b\xd8\x8a\xfa\xc6\x86\x3c\x42\x5e\x74\x3a\x1b\x71\xf5\x7d\xd1\x52\x59\x87\x8a\xf0\x99\x32\xc1\x7f\x56\x3c\x64\x20\x2d\x9b\xa4\x30\x62\x5c\x32\xa8\x3e\xde\x40\x79\xbc\x01\x65\xb2\x3e\x5e\xcd\x62\xdd\xa0\x0f\xfe\xfd\xef\xbf\x0c\x75\xbc\x4a\xc7\xbb\xf1\x97\xae\x2a\xc8\x07\xc5\x0f\x2b\xe3\x6f\xdf\x56\xc6\xab\xa4\x6f\x36\xfe\xd2\xab\xaa\x38\x0a\x4f\x1d\xaf\xde\x7b\x2f\xea\xb8\xfa\xfe\x8e\x0b\x29\xf5\x3b\x56\x43\x75\x9c\xd6\x57\xf8\xed\x9b\xb7\x42\x8f\x4e\x58\x55\xc7\xa8\x4d\x1d\x57\xd5\xf8\xfd\x7d\xa5\xaa\x46\x1b\xe1\x8a\x51\x55\x7b\x1b\xe3\x95\xa8\xaa\xce\xe0\xa8\x59\xab\xaa\xee\xc6\x78\x65\x56\x55\x27\x70\xbc\x66\x55\xd5\xfe\xc6\x78\x65\x52\x55\x87\x70\xdc\xd4\xab\xea\x68\x63\xbc\x32\xac\xaa\x5d\x74\x6c\x56\xd5\xc1\xc6\x78\xa5\x5b\x55\x3b\x59\x17\x7a\x54\x1f\xc6\x2b\x49\x27\x79\xe0\x9e\xea\xac\x4f\x57\xe2\xa1\x1f\xa9\x71\x75\x9d\x74\xab\x9f\x9f\x8d\x22\x3c\x1b\xf9\xe0\x9d\xbe\xde\xfb\x2f\x7f\xbd\xf7\xfd\x7b\x35\xfa\xbb\x47\xcf\x46\xbd\x74\x36\x0a\x37\xc6\x1b\x41\x42\xbc\xe2\x6d\xc4\x59\x42\xfc\xfb\xdf\xb8\x23\x07\x6c\xe2\xc4\xd5\xea\x2a\xd4\xe8\xaa\x33\x99\x04\xd0\xaf\x7f\x83\xf2\x3f\x30\x46\x3d\x27\x5e\x89\xaa\xd5\xaa\x3a\x5e\x8d\x62\x27\xf6\x36\xde\x22\x2f\xf0\x7a\xb0\x05\xf6\xdf\xff\xc0\xc5\x38\xcf\x36\xd4\xde\x78\x06\x1f\xc4\x53\xef\xc6\xdd\xc8\xfe\xfb\x9f\x77\xd5\xd9\x08\xd5\x60\x65\xac\x3a\x55\x32\x30\xce\x4a\xac\x7a\x55\xd5\x5f\x89\xd5\xbf\xdf\xee\xbd\x17\x5b\xe9\x7b\x71\x6f\xa8\xa8\x68\xd8\x6d\x69\x67\x61\x75\x0f\xbb\x06\x7e\xa9\x69\xcc\x29\x7b\xbc\x1a\x8f\xe1\x76\x6c\x38\xa0\x33\x37\xd5\x25\xc2\x95\xea\xbb\x1a\xbf\xaf\x50\x10\xe8\x71\x6f\x03\x58\xd7\xe1\x3f\x90\x21\x93\x68\x15\xb9\x76\x79\xd6\xfe\xf6\x0d\xfd\xb5\x22\x10\x7d\x57\xfe\x05\xc1\x6f\x28\xdf\x91\x10\xf5\xcd\x2a\xb4\x54\x57\xe3\xa1\x17\x66\x36\xa8\x49\x7e\xf5\x2e\x02\xa3\xef\x3c\x24\xae\xbe\x79\x50\xcb\xe7\x40\xb2\xc2\x74\x70\xd2\xb3\xf1\x2a\xfa\x7f\xd2\xbf\x70\xae\xe9\x46\xef\x55\xf4\x8f\x9a\xf4\x65\x6f\x3c\x9a\x8c\x43\x2f\x8c\xaf\xfd\x20\x38\x04\x6c\xae\x63\xab\x6f\xc8\x51\xe4\xff\x0a\x9c\xa5\x12\xcd\xd9\xc4\x75\x62\xef\xc4\x19\x78\x39\x0d\x0f\xab\x64\x9e\x41\x7c\xb6\xf7\xae\x66\x4c\x19\x51\x6f\xe8\xa1\xeb\xdd\x02\x16\x1c\x3f\xd5\x51\x24\xc4\x55\x3f\xec\x05\x33\xd7\x8b\x56\x3c\x8e\x49\xc4\x03\x83\x15\x6f\x88\x69\x5c\xef\xf9\xb8\xbf\xe2\x55\xd7\xe3\x1f\x1b\xfa\xbf\x44\x98\x68\x12\xf8\x3d\xa8\x78\xa3\x6a\x8b\xe4\xf0\xd4\x12\x9c\xf0\xb9\x98\x89\x5c\xa4\xf3\xce\x39\xfd\x33\x08\x72\x7e\xe7\x73\x8c\xb3\x9b\xcc\x9f\x3f\xf4\x7f\xc9\x0c\xff\xfd\xcf\x7b\xd5\x5e\xa1\x54\x61\xfc\x57\x47\xce\x84\xcf\x20\x96\x17\xc5\x13\x57\xdf\x17\x8c\x28\x0b\xc9\xf5\x02\x2f\xf6\xca\xc5\x94\x14\x47\xaa\x21\xae\x1d\x5a\xac\xbe\x8d\xbc\x78\x38\x76\x6d\x65\x32\x8e\x62\x25\x57\x13\x50\x12\x59\x6e\xae\x18\x55\xaa\xaf\x13\x9e\x73\xec\x72\xa1\x77\x6a\xbc\xf1\xf7\x3f\xc2\x6e\x67\xfa\x2f\x04\x73\xb4\x93\x28\x80\x38\xe9\xc3\xa4\x64\x72\xf2\xef\xca\x7f\x2a\xdf\xc3\x55\xd7\xf7\xdc\x8e\x13\xe3\x23\xdf\xcd\x05\x06\x23\x70\x32\x1d\x8f\xfc\xc8\x5b\x75\x82\x60\x25\x16\x85\x4a\xca\x32\x0b\x72\xea\xc5\xd3\x97\x45\x3a\x9f\x28\x88\xfb\x9e\x92\x7e\xa4\xeb\x11\xcd\x17\xf5\x3c\xe2\x96\x77\x3c\x11\x7f\x79\xbf\x87\x70\x47\xb3\xb0\xd7\xf1\x84\xd6\x23\xbf\x2a\xf0\x12\x70\x27\xf0\xe0\xb4\xb4\xa2\xb8\xfe\xa3\xa2\xc2\x6a\x52\x2d\x86\xbc\xa1\x15\xf9\x91\x33\xf2\xec\x15\x5d\x1d\x10\x6c\x75\x65\x94\xaa\xa1\x6b\xd2\x2a\x77\x8c\x6f\x10\x54\xdf\xcb\xf3\x73\x0c\xf8\xc6\xd2\xbb\xaa\x6c\x7b\x8e\x5b\xf9\x35\xee\x46\x4a\x75\x69\x36\xb8\xd7\x55\xe0\xcb\x04\x77\x06\x95\x0c\xc9\xf9\x4c\xa9\xdc\x8d\xbb\x2b\x51\xb5\xe2\x4c\xbd\x8a\xeb\x39\xee\xaa\xcc\x89\xff\xcb\xde\x9b\x77\xb9\x6d\x63\x89\xa3\xff\xbf\x4f\x21\x71\x32\x6c\x20\x82\x65\xa9\xec\xa4\x63\xca\xb0\x7e\x6e\xdb\x79\xc9\x24\x6e\xd7\x78\xe9\x1e\x3f\x59\x5d\xcd\x92\x20\x89\x31\x45\x28\x24\x54\x55\x7a\x12\xbf\xfb\xef\x5c\xec\x5c\x54\x55\x76\x3a\x71\xdc\x93\x73\x12\x97\x88\x7d\xb9\xb8\xb8\x3b\x32\x81\xe6\x26\x9d\xa8\x7b\xc8\x6b\x0d\xbe\x89\xe0\x22\x4e\x9f\x40\xbb\x3e\x62\x53\x1d\x6d\x58\x0e\xb0\x1c\x9d\x0c\xc8\x4f\xdb\xf5\xe6\x35\x77\x1b\x2b\xec\xfd\xe4\xed\xb5\x4e\xa9\x9c\x03\x81\xcb\xb2\xc4\x1f\xb5\x48\x35\x4b\xa9\x6b\x96\x4a\x3f\xa3\x74\xbc\x95\xeb\xea\x2a\xfd\xe2\xf5\x70\x27\xf2\x1b\x4b\xac\x6e\x2a\x21\xcd\x1a\x02\xb2\x07\x66\x44\xdf\x7c\xe7\xfc\x2a\x20\x9a\x04\x68\xbb\x57\x34\x29\xfb\x68\x40\x78\xf6\x64\x15\x67\x4b\x16\xb5\x2d\xb7\xb9\x45\x11\xbe\x7e\xa9\xed\x18\x03\x58\xa3\xe0\x56\x25\x1f\x1b\xaa\xf1\x76\xc5\x25\xb7\x7b\xbb\xa2\x4f\x13\x36\xef\x3c\x16\x01\xc6\xe4\xa6\x5b\x5a\xcf\xf4\x78\xa3\x79\xa0\xf0\x90\xe8\x27\xf3\xeb\xf6\x7a\xae\x3b\xff\xe8\x4d\xd2\x8b\xcd\xe6\x48\xe0\x5b\x6c\x0a\x12\x37\x6d\x89\x19\x91\xe8\x67\xf1\x9a\xdd\xa6\xe4\x7f\xbd\x7a\xf1\xd7\x3e\xc8\x74\xb2\x65\xb2\xd8\x21\xd1\x8f\xf3\x65\x71\xcb\x3e\x58\x9e\xdf\xa6\xe0\xb1\x12\x0b\x93\x4e\xf6\x02\x48\x6a\x51\x62\x8c\xe1\xff\x8f\x3b\xe0\xc6\x2c\x87\xe4\x1c\x14\xed\x4a\x51\x7c\xcd\xf6\x9d\x6f\x85\xe0\x99\xdd\x1d\xf3\x79\xf3\x75\x70\x2e\x32\xef\x32\x70\x46\x90\x72\x0b\xd3\x64\xf6\xbe\x75\x07\xab\x04\x13\x1c\x2e\xc0\xfb\x90\xd6\x31\x89\x37\x5e\x01\xbf\xf1\x90\x2b\x84\x86\x1a\xf1\x4b\x48\xfa\xdd\x0e\xd8\x12\xb7\x95\xe5\x7d\x9c\xa6\xbf\xcb\x95\xb5\xe3\x54\x8b\xea\x86\x89\x71\x59\x4e\x41\x24\x82\xdc\x78\x9f\x18\xee\x12\x8f\xce\x24\xad\x06\x12\xc6\x82\xee\x0d\x2f\x1c\xb9\xa2\xa7\x26\x57\x9f\x6b\x62\xc9\xe5\x9b\x0b\x29\xc6\xe0\xba\x72\x86\x04\xbc\xb1\xcc\x8d\x4d\x95\x9e\x48\xf8\xec\x0f\x89\xed\x1f\x12\xdb\x3f\x24\xb6\xff\x1e\x12\xdb\x81\x27\xb1\xbd\x49\xa8\x68\x85\xaf\xc4\x48\x4b\x6f\x29\x2b\x6d\x95\x45\x5e\x23\xea\x2c\x56\x7c\x9b\xce\x5f\x49\xa3\x9c\xa3\xe2\xb1\x21\xa5\xec\x70\x60\x54\x72\xcf\x7d\xc9\xcd\x00\xdf\x71\x38\x3c\x8f\xc5\xaa\x1f\x9f\x17\x3e\x3b\x0f\x1c\xcf\x1d\x86\x1f\xd2\xe1\xcd\x8c\x6b\xb2\x40\x83\x7a\xab\x58\x77\x0a\x07\xc8\x8a\x9e\x19\x9d\x4c\x89\xa0\xc3\x91\x78\x58\x2b\x3e\x12\xa0\xfd\xa2\xb4\x36\x82\x31\x53\x8c\xfa\xd1\xab\x2d\x4d\x0c\x39\xeb\x5d\x67\x96\x91\xeb\x2b\x87\x96\x6b\xe8\xa4\x62\x13\x67\x86\xe4\xc3\xd8\xc8\xed\xec\x6a\x22\x81\x3f\x60\x08\xd7\xf4\x13\x07\x64\x6f\xee\x4c\x6f\x8e\x8a\x57\x04\xd2\xf7\x48\xf7\x77\x86\x38\x0c\x6f\x3f\x82\xd6\x45\x30\x6e\x2f\xb7\x5b\x86\xa0\xdf\x0f\x30\x36\x78\xb9\x73\xb4\xca\x36\xad\x90\xaa\xae\x3b\x67\xe0\x5a\x12\x4f\xe8\x6a\xb7\x3a\x20\x4b\x26\x7c\xf0\xd1\x3d\x49\x28\x9c\xb1\x24\xf5\xc1\xd0\xf1\xdc\x77\xbd\x54\xcd\x6d\x5f\x4f\x49\x6c\x7c\x4a\x02\xc0\xb9\xf5\xb6\xce\xb6\xeb\x73\x96\xf7\x93\xe2\x25\xfb\x79\x9b\xe4\x6c\x6e\x59\xf9\xdb\x95\xf6\x84\x02\xb7\xab\xa0\xc5\x03\x6d\x85\x61\x4d\xbc\xa2\x3e\x09\xb1\xf1\x48\x88\xb5\x44\xd1\x84\x5d\x6d\x78\x2e\x0a\x72\x76\x76\xc9\xce\x37\xf1\xec\xfd\x59\xae\x2a\x9e\x9d\xd5\xe8\x8b\x23\xa8\x52\xd7\x6f\x43\xfb\x22\xdf\xb2\x12\x3b\xc2\xe4\x2c\x01\xb3\x42\xbe\xd1\x43\xd3\x64\x20\xe2\xe7\x3f\xd9\xed\xe3\xe7\x3f\x85\x21\x3f\xff\xc9\xa7\x59\xf8\xf9\x4f\x8e\x6a\xe1\xe7\x3f\x95\x25\xdc\x06\x2f\x59\x3c\x13\xf4\x48\x93\x2d\xb3\x41\x43\x8c\xcd\x42\x90\x42\xec\x52\x56\x7c\x50\xed\x6f\x06\x5e\xfd\xd9\xd5\x87\xd5\x3d\x71\x75\x47\xf2\x70\x75\xfe\x7b\xcb\xb6\xac\xe8\xb0\x2b\xc1\xb2\x79\xa1\x66\xe3\xe0\xce\xbf\xe3\x51\xbf\xaf\x38\xce\xbd\xd2\x59\x9b\x4f\x4f\x68\x49\xf7\x3f\xcb\xe6\xa4\x2a\x06\x74\xed\xe9\x7f\xf1\xf3\xef\x9f\x46\x41\xa0\x3f\x41\x56\xbf\x2d\xa2\x20\x28\x4b\x2d\x4a\x04\x52\xa7\xeb\x1d\x86\x6d\x9e\x9a\x4d\xd0\x45\x6a\x99\x4a\x2a\x09\xee\x72\xf4\x11\xfc\xab\xb5\x44\x5a\x5a\x39\x8f\x45\x4c\x1f\xd5\x15\x31\x7a\x54\x90\x29\xd5\x40\x4d\xfd\x4f\x5d\xdf\xa3\x5c\xd6\x4e\xe3\x6d\xc1\x90\xac\x8d\xf7\x29\x13\x9d\x6d\x9e\x52\xf9\xd9\xdf\x40\xd6\x7c\xec\x0f\x2e\x93\x69\x40\xbb\x7b\xa9\x26\x6d\x04\xf3\x6c\x4e\xee\x9f\x5f\xec\xb7\x79\x5a\xde\xfd\x42\xad\x1c\x48\x41\xce\x40\x1e\x50\xfe\xf3\x88\xd8\x19\x61\xfa\xa8\x3a\x54\x5c\xda\xa5\x46\x6a\x94\x3f\xc1\xa2\xfb\x8a\x1f\x6f\x2f\xfa\x22\x4f\xd6\x08\x8f\x6a\xcb\xae\x4a\xbc\x79\xf9\xe3\xe1\xd0\x95\xd5\x9b\xe3\x6c\x2b\x0d\x03\x97\xc5\x8f\x8e\xf7\x86\x8d\x82\xe1\xfa\xa0\x41\x21\x5d\x4a\x84\xb6\x05\xa5\x34\xe0\xef\x83\xf1\x3f\xff\x8b\x9f\x77\x4c\x3f\x9d\xcb\xb8\xd0\x35\x52\x36\xef\xff\x33\x6a\xe4\x02\x51\xbe\x80\xf7\xc7\x3b\x49\xd6\x11\x2b\xd6\x91\x2b\x5b\x90\x4e\x22\x3a\x97\x49\x9a\x02\x21\x5c\xbc\x4f\x36\x1b\x36\xef\x24\x0b\x48\x2d\x56\xfc\xb2\xe8\x6c\x37\xfd\x7f\x8e\x6a\x80\x73\x1d\x10\xfb\x1f\x25\x1e\xd5\xf6\x64\xc9\x84\xea\x78\xfe\x44\xc3\x97\x9c\x2a\xfc\xa6\x03\xef\xc8\xf4\x65\x21\x25\xf9\x92\x3f\xe9\xa3\xbd\x14\xc2\xf6\x34\xa0\xc9\x8f\xd2\xdd\x60\xf2\x53\x11\x2f\xee\xb6\x51\xc7\xf6\x06\xf9\xcb\xec\x0a\x29\x7c\xa3\xa4\xd0\x1a\xf9\xd4\x45\xe4\xb7\x6a\xca\xaf\xea\x64\xe3\x72\xc0\xc0\xbb\x7f\x70\x1b\x4a\x22\xde\x5a\xad\x45\x1a\xae\x97\x4c\xab\xfb\x03\xb5\xce\x20\x17\xbf\x4c\xc4\xaa\x13\x77\xe4\x2d\xd6\xe1\x8b\x4e\xa0\x04\x8c\xde\x3e\x90\xa0\x03\x9e\x0c\x50\x58\xa5\xf6\x03\x7c\x7d\xbf\xad\x99\x55\x69\x21\x18\x7f\x07\x64\x93\xc6\x33\xb6\xe2\xa9\x7c\x35\x18\x80\xf2\xfb\xa7\x86\xba\x6c\x3f\x8a\x4e\x8c\xc8\xe8\xa3\x6b\x00\x8f\xf5\x45\x9c\x2f\x99\xe8\xcb\xc6\x00\x81\xb5\x8f\xd8\x0a\x46\xda\xb6\x1c\x84\x1f\xee\xa7\xde\x6e\x2f\xe5\x7f\x0a\x27\x06\x01\x1c\x23\xc7\x63\x47\x01\x96\x00\x60\x1d\x35\x63\x52\xf0\x11\x54\x24\xb7\xfe\x41\x08\xc3\xd6\xa1\xf9\x14\x59\x27\x38\x56\x19\xe3\x23\x33\x3b\x02\x3a\x0d\x3d\x41\x6b\xe5\xa6\x8e\xc0\xaf\x7e\xac\x92\xa7\x1c\x68\x2f\x90\x5f\x9b\x5b\x17\xb8\xdf\x50\x4a\xc2\xe6\xcd\xc5\x7e\x8c\x05\xcb\x66\xbb\x0e\x2a\x18\x78\xf1\x17\xf8\xe6\x2a\xf2\x0a\x9b\xd7\x64\xed\x2d\x38\xe7\x3a\x44\x62\xa5\xec\xb5\x3b\xea\x48\xdf\x46\x98\x5c\x2d\x8d\x6f\x55\x5a\x62\xbb\xdb\x15\x4d\xd5\x62\xdc\x54\xf8\x37\x3a\x2a\x4d\x82\xa1\x24\x15\x4a\x21\x78\xa3\xe8\x03\x78\xbc\x47\xfe\xb5\x12\xf4\xb2\xd4\xc4\xaf\x21\xcd\xa8\x22\xca\x46\xea\x8f\x4f\xf8\x6f\xf3\x34\x52\x13\x6a\x08\xf2\x2c\xf1\x71\x24\x7f\x9b\xdd\x54\xc2\xde\xea\x47\x0a\xfc\x61\xaa\xf9\x87\xa9\xe6\x1f\xa6\x9a\x7f\x98\x6a\xfe\xdb\x9a\x6a\xfe\x9b\x9a\x67\x6e\xf3\xb4\xc5\xc2\x69\x9b\xa7\xbf\xa2\x51\xe6\xe7\x63\x88\xf9\x2f\x33\x60\xda\xdf\xac\x81\xfc\xd5\xad\x93\x94\x7a\xf2\x26\x0d\xea\xaf\x6d\x9e\x54\xcc\x56\x0c\xd0\xef\xbc\x23\x38\x70\xdb\xa0\x63\x4c\xd8\x1f\xd6\x4a\xbf\x27\x6b\xa5\xdf\x85\x25\x90\xd6\xa6\xdf\x6c\x0a\xe4\x10\xd0\xcd\xa6\x40\xec\x96\xa6\x40\xec\x63\x0d\x6f\xd8\xed\x0d\x6f\xd8\xbf\xd6\xf0\x86\xf9\x86\x37\xb7\x37\x3c\x00\xae\xc1\x95\xfa\x43\xb7\xff\x87\x6e\xff\x0f\xdd\xfe\xff\x02\xdd\xfe\x1f\x24\xfe\x1f\x24\xfe\x1f\x24\xfe\xbf\x1b\x89\xff\xca\x12\xd7\xbf\x1b\x32\xff\x0f\xc2\xfe\x7f\x05\x61\xef\x20\xef\x5b\x9e\xff\xdb\x92\xec\xff\xbe\x94\xb8\xf0\xb2\x80\x68\x31\x33\x7c\xce\xd6\x3c\xdf\x7d\x97\x14\x82\xe7\x3b\x2a\xfa\xab\xb8\x58\xb9\xaf\xf3\x9c\x5f\x16\x2c\x77\x09\xf2\x9a\x7d\xc9\xb7\x82\xe5\xcf\x93\xf9\x3c\x65\x97\x71\x0e\x17\xf5\x82\xe7\xeb\x58\x9c\xc6\x42\xb0\x3c\xa3\xa2\xbf\x2d\x98\x2a\xe5\xaa\xae\x63\x31\x5b\x51\xd1\xcf\x65\xfa\xab\x55\xbc\x81\x8a\x29\x9f\xc9\x08\x82\xe6\xdb\x2e\x0b\x15\x7d\x68\x21\xc9\x96\x32\xb8\xe2\x95\xd0\x09\x2c\x77\xdf\x6a\x0a\x50\x8c\x15\x5e\xa7\x85\x29\xea\x4a\xfe\x98\x2c\xd8\x6c\x37\x4b\xa1\x0b\x37\x24\x59\x08\x0a\xb3\x79\x92\xb3\x19\x14\xfc\x3e\x9b\xb3\x2b\x93\xae\x3e\x5c\x26\xa8\x98\x65\x5e\x6e\x32\x7f\x4c\xb2\xf7\xb2\x79\xf9\x47\xe7\x5d\xf0\x64\xde\x19\x48\x5b\xd3\x5c\x12\x63\x0f\xf0\xf1\x30\x1c\xfe\x1c\x02\xb2\x77\xd4\x26\x90\xd6\xed\xe6\x65\x79\x65\xe2\xa5\x26\x7d\x53\xe8\xea\xc1\xe0\x9a\xae\x2a\x6b\x7d\xcb\xbe\xd2\xea\x0e\x81\xe1\xee\xd1\xf6\xbd\x9d\xbd\x75\xeb\x5e\x1d\x33\x91\x58\x4e\xe4\xab\x6b\x26\x52\x81\xb6\x5b\x76\x15\x57\x61\xd4\x74\x96\xc8\xce\xfe\x0c\x06\xb2\x5c\x49\xa2\x33\x74\x72\xf2\x0d\x98\xc8\x72\x34\x03\x22\x1a\xbe\x1f\x00\x19\xcd\xd1\x1c\xe4\xe4\x19\x3a\xb9\x37\x00\x32\x9a\xa3\x05\x26\x6b\xf9\x7d\x02\x64\x34\x47\x6b\x90\x93\xc3\xf7\x7d\x20\xa3\x39\x5a\x62\x72\x21\xf3\xef\x61\xb2\xa3\x1c\x5d\x60\x72\x25\xbf\xbf\xc2\xe4\x92\x72\x74\x85\xc9\x7b\xf9\xfd\x35\x26\xcf\x28\x47\xef\x31\x79\x22\xbf\xff\x8c\xc9\x29\xe5\xe8\x09\x26\xcf\xe5\xf7\x37\x98\xbc\xa0\x1c\x3d\xc7\xe4\xa5\xfc\x7e\x80\xc9\x2b\xca\xd1\x4b\x4c\x5e\xc3\xf7\xc9\x57\x98\xfc\x95\x72\xf4\x1a\x93\x1f\xe1\xfb\xfe\x00\x93\xef\x29\x47\x3f\x62\xf2\x13\xe5\x28\xc5\xe4\x8d\x4c\x1e\x62\xf2\x98\x72\xf4\x06\x93\xa7\xf2\xfb\x2b\x4c\xbe\xa3\x1c\x3d\xc5\xe4\x67\xf9\xfd\x35\x26\x6f\x29\x47\x3f\x63\xf2\xad\xfc\xfe\x33\x26\x7f\xa7\x1c\x7d\x8b\xc9\xdf\xe0\xfb\xab\x01\x26\xff\x43\x39\xfa\x1b\x26\x7f\x81\xef\xfb\x27\x98\xfc\x40\x39\xfa\x0b\x1e\x59\xd8\x2f\x0c\x12\x23\xfa\x54\x6c\xbd\x04\x77\x64\x36\x5e\xaa\x77\xaa\x56\xf5\xc2\xf6\xf0\x9d\x37\x72\x00\x70\xe8\x99\x97\x6c\xcb\xee\xfc\x44\x59\xec\xd2\x4b\x31\xc7\xff\x99\x97\xe6\xd0\xc3\xa9\x97\x5a\xc1\x21\x2f\xbc\x0c\x87\x68\x5e\xd5\x8b\x5b\xec\xf4\xd7\x5a\x8e\x87\xc8\xbe\xf7\xb2\x1c\xbe\xfb\xc9\x4b\x55\xb8\xf2\x71\x4b\x97\x16\xa1\x7e\xe7\x65\xb6\x63\xe5\xb7\x5e\x89\x1a\x22\xff\xbb\x97\xe5\x63\xfc\xff\xf1\xd2\xdb\xee\x87\x1f\x4c\xfe\xaf\x2b\x2a\xb2\x55\xe0\x52\x96\xa6\xde\x16\x5e\xfa\x49\xf1\xb7\x38\x4d\xe6\xe6\x66\x66\x55\x31\x90\xad\x99\x23\x86\x0f\x87\xc7\x79\x1e\xef\xfa\x49\x61\x22\x14\x81\xb0\x8a\x5d\xb0\x7c\x87\x72\xdc\x90\xcd\xe8\x9a\x5b\xb4\x2f\x09\xc8\x0b\x5c\x81\xc4\x45\x54\x60\x7d\xe0\x3c\x49\x46\x63\x64\xef\x6b\xd8\xc3\x82\x68\xdf\x6b\x69\x5d\x97\xf5\x67\xab\x24\x9d\xe7\x2c\x33\x92\x85\xc2\x4b\x23\x19\x1e\x71\xcd\x22\x87\xa1\xc9\xd0\x10\xc5\xb1\x76\xf1\xe9\xb8\x0a\x86\x71\xcc\xdc\x90\x0a\x35\x66\x68\x3c\x03\xb7\x7d\x5d\xc2\xad\xd3\x13\x5d\x17\xd0\xde\xb3\x78\xb6\x42\xcc\xdb\x32\x29\x88\xba\x66\x4d\x71\xb2\x40\x6a\xa6\xfe\x55\xf3\x6d\xce\xd7\xd2\x3a\x40\x17\x34\x73\xbb\xb9\xa4\x1c\xec\x88\x87\x61\xa6\x2c\xd0\x39\x2e\x59\x5a\xb0\x8e\xfe\x84\xf5\x05\xcb\x26\x6f\x7e\x33\x6f\x2f\x53\xc4\xf0\x98\x51\x08\x7c\x11\xb1\x30\xec\x36\x77\x15\x31\x3a\x61\x53\x4c\x58\x9d\xce\x91\x37\xe3\xd6\xc8\xbb\xe2\xa2\x48\x96\xd9\xe1\xe0\x2f\x84\x91\x5e\x28\x5b\x7e\xcb\xac\xeb\xed\x91\xc6\xfc\x7a\x95\x6d\xde\x44\x4c\xad\xd0\x83\x83\xa1\x61\x86\x75\x07\x56\x4c\x03\x74\xd4\x8b\xcb\xcc\x5c\x5a\x52\x46\x81\xc0\x67\x03\xc6\x3a\xe1\x53\x9a\x4d\xf8\xd4\x0a\x04\x58\x39\x82\x4d\x90\x2b\x66\xf6\x8d\xa6\x44\x5c\xb7\xa4\x34\xa9\xe6\x17\xb6\x80\x6d\xa1\xa8\x95\xa0\x33\xb9\x1c\x70\xa5\x0d\xcd\x85\xf6\x41\x07\x19\xa0\xa2\x7a\x88\x8d\x6f\x04\x93\x2d\x0b\xba\x2f\x25\xf8\x4b\x61\x26\xc3\x66\x91\x40\xd2\xda\x61\xb7\x5b\x24\x90\x70\x85\x21\x02\x2f\x1b\xca\x26\xd9\xd4\x88\x5e\x3b\x8e\x3c\x66\x44\xb4\xa3\x8b\x0f\x25\x81\x73\x43\xcb\xe5\x86\x6c\xcc\x35\xe5\x67\x05\x17\x85\xff\xe1\xd1\xab\x40\x2a\x6b\xa4\x28\xfa\x8b\x38\x2d\x76\x2d\xc4\xac\x47\xdc\xf8\x44\xa1\xa4\xd4\x30\x51\x74\xce\x10\x93\x84\xa2\x1c\xc5\x98\xc0\xe7\x7d\x5c\x21\x45\x86\x0f\x4e\x80\x14\x41\x39\xd0\x22\xa9\xc7\x34\xc0\xfc\x81\x3a\xf1\xd3\x94\x60\x9a\x6c\x2a\x89\x05\x90\x6d\x64\x51\x4d\x93\x1c\x07\xa9\x53\xe3\x1b\xb4\x87\xe3\x18\x6d\x7d\x23\xff\x9c\x49\xdb\xc6\x6a\xe2\x92\xd7\xbf\xff\x12\xcf\xde\xd7\xd3\xbe\xe5\xf9\x65\x9c\xcf\xab\xc9\x05\x13\x12\x9e\x7f\x64\xf1\x05\xfb\x8e\xf3\x5a\xad\xa4\x78\x2c\x1d\x5d\x2a\xa9\x25\xd0\x59\xf5\xe5\x85\xd1\xc6\x62\x05\x7a\x9c\x68\xe1\x37\x51\xb0\x38\x9f\xad\x6a\x69\xc0\xa8\x46\x73\x02\x5e\x34\x3c\xab\xe6\x81\x98\x68\x51\x02\x05\x67\x76\xb2\x50\x7f\xc9\xd2\xdb\xe5\xc2\xfc\x22\x67\xde\x50\xe8\x9a\x5c\x54\x20\xa4\x70\xbf\xc9\xce\xcf\x29\xfc\xac\x82\x5c\x99\xb5\xa7\x85\xfa\x4b\x2e\x29\xd2\x49\x85\x49\x2b\x1c\x8c\xae\x80\x54\xdc\xcb\x61\x45\xe7\x44\x0f\x25\x5a\x12\x33\x90\xe8\x8c\xd8\xd6\xa3\x0b\xf7\xbb\x88\x76\x44\x36\x11\x5d\xa9\xbf\x79\x74\x59\x8e\xdc\x59\x7a\xff\xaf\xbf\xc9\x9b\xe7\x6c\x16\x67\x6f\x0a\xf6\x9c\xad\xcf\xf3\x38\xab\x1c\x85\xdc\xc0\x78\x4a\x11\x47\x39\x6e\x16\xee\x0e\xbd\x01\xba\x11\x94\xd8\x9b\x44\xfa\xaf\x9f\x44\x8b\xe6\x4a\xd8\xd0\x49\xc1\x9c\x6d\x72\x36\x8b\x21\xa2\x0e\xee\x52\x7a\x67\x28\x0b\x14\x13\x31\xd5\x08\x71\x04\xbf\x69\x77\x50\x0a\x1a\x4c\x72\x40\xdb\x77\xd4\xea\x4f\x41\x27\x63\x6f\x8f\x8c\xd6\x2f\x1c\xc2\xb5\xc8\x3c\x7b\x74\x32\xce\xee\x9c\x44\x03\x4c\x72\x7a\x32\xca\x1f\x66\xa3\xbc\xd7\xc3\x7c\x92\xdf\x39\xf1\x85\xe7\xf9\x74\x94\x98\x85\xd0\x62\x67\xb5\xbe\x64\xc2\x88\xb0\x92\x70\x8e\x3d\x7a\x26\x45\x78\x5f\xd0\x7d\xdb\x56\xe9\x96\x68\x4e\x44\xff\x2c\x67\x05\x13\x10\x41\x9c\xcd\x69\xea\xf1\x66\xf7\x30\x49\x28\x07\x94\x25\x5b\xb9\x7e\xf1\xa1\x1a\xf7\x05\xdd\xe5\x88\xf5\xb5\xd1\x23\xe5\x1f\xb0\x73\x2a\x1b\xee\x20\xb7\xce\x52\xcf\xa7\x95\x64\x0f\x83\x5e\xd6\xfb\xd3\xa3\x8e\xf2\x20\x94\xfa\xaf\x55\x7c\xc1\x3a\x71\x27\xf8\x53\x4f\xf4\xfe\x14\x74\x80\x40\xfb\x13\x6e\x99\xf5\xed\xf1\xbf\x3e\x79\x1a\x86\x89\xc1\x18\xdc\x41\x33\x26\x29\xcd\x3d\x64\x0b\xf3\x23\x31\xcd\x9b\x88\x3a\xa9\x24\xc6\xb0\xed\x2f\x16\xa4\xa8\x16\xcd\xd8\x8b\x05\xa0\x77\x32\xab\xa4\x33\x45\x58\x91\x6d\x25\x55\x21\xfb\x39\xcd\x5b\x4c\x44\x29\x72\xa3\xdf\xa2\x7d\x9a\x14\x82\x65\x51\xea\x63\x42\x89\xfd\xd3\x36\xec\x5f\x49\x5c\xf2\x28\x6d\xc1\xfe\x69\x3b\xf6\xf7\x93\x41\x35\xe8\x2d\x67\x81\x26\x29\x99\x4f\x31\x26\x0b\x8a\xbc\x8c\x82\x16\x68\xb2\x21\x31\xe8\xb8\x0d\x96\x44\x93\x98\xcc\xa6\x18\x8f\xec\x6e\x15\x68\xb2\x20\x09\x5a\xe0\x29\xfe\x75\xcf\xbf\xab\xd2\xd7\xeb\x81\xee\x4e\xfa\x5f\xf6\xc6\xff\xf8\x62\x5f\x22\x7c\x98\xbc\x9b\xbe\x7b\x37\xbd\xbb\x24\xc1\xbb\x77\x5f\x84\x35\xc5\xb4\x4f\x61\x06\x01\x01\x62\x9d\x70\xf8\x27\x35\x30\x14\xd3\x01\x49\xe8\xdd\x08\x4d\xe2\x3b\xff\xff\xe3\x3b\xff\xdf\xd9\x17\x53\xfd\x6b\x70\xe7\xc1\xd9\x17\xd3\x2f\xf1\xe1\xdd\x97\xef\xbe\x3c\xc0\x7f\xe8\xf0\x0e\xdf\x5d\x8e\x52\x9a\xf4\xd9\x15\x03\x22\x79\x84\x53\x85\x97\xba\x94\xc6\xd2\xb7\x18\x76\x11\xb1\x7e\x21\x43\xb8\xc5\x44\x67\x83\xc8\xb6\x47\xf3\xb6\x0c\x4c\xd2\xc9\x70\x3a\x46\xa2\x47\x03\x34\xf9\xc7\xdd\x69\x0f\x07\x44\x93\xe6\x90\x83\x71\x14\x7c\xf9\x65\x40\x29\x4d\x27\x03\x53\xae\xff\xa5\x2b\x14\x14\x9b\x34\x06\x43\x8e\x28\x68\x16\x1b\xb7\x96\x43\xb6\x9c\xec\x75\x1c\x05\x51\x80\x4d\x1a\xd0\x7f\x3d\x1a\xe0\x71\x00\x06\x7a\x7a\x1c\x83\x29\x90\x4c\x49\x3f\x8d\x0b\x21\x05\x09\x86\x30\x8c\xbb\x94\x32\x8d\x3e\xdb\x16\xc0\xe4\x35\x57\xc0\xe5\x60\x08\x94\x24\xc5\x49\x11\x23\x39\x5b\xb2\xab\xcd\x2b\xbe\xcd\x67\x2c\x12\x64\x13\xe7\xf1\x1a\x04\xeb\x45\x94\x11\xc1\xdf\xb3\xac\x88\xb8\x07\x21\xb1\x07\x21\x8b\x09\x9b\x1e\x0e\x08\xfe\x50\xd8\x7c\x4c\xe0\xa7\x2b\x9b\xe8\x08\xbd\x77\x03\x39\xe8\xd9\x2a\xce\x1f\x0b\x34\x90\x6c\x01\x85\x60\x5d\x4c\x89\xa5\x80\xbf\x64\x98\x70\x9a\xf5\xfd\xd1\x90\x9c\x66\x7d\x37\x1e\x92\xd2\xac\xaf\x46\x34\xaa\x35\x69\xa6\x26\xbd\x6e\x11\xef\xd1\xe0\x2e\xac\xa6\xd9\x9f\xd4\x66\x4f\x75\xf6\x17\x81\x11\x88\x69\xf1\x03\x02\xd4\xfa\x92\x2d\x9f\x5d\x6d\x50\xf0\x8f\xa0\xc7\x49\x90\x04\x18\x1b\xfa\x9e\xd2\xc4\xe2\x60\x70\x8c\x86\xba\x05\x4d\x26\x83\x29\x99\x51\xd1\x2f\xb6\xe7\x85\xc8\x91\xb9\xd6\x64\xb5\x99\xc4\xde\x6a\xa0\x85\x19\xa8\x29\x71\x67\x88\x2b\xed\xcd\xe4\x72\xcc\x34\x8b\xb4\xcf\xd9\x3a\x4e\xe0\x5d\x8b\x53\x43\xf3\xcd\xfc\x8d\xc9\xd5\xc7\xdf\xe2\x14\x3c\x10\x13\xbd\xc3\x43\x7c\x4c\x2d\xc1\xc2\x70\xce\x66\x7c\xce\xde\xbc\xfc\xde\xca\xeb\x11\x03\xeb\x44\xb7\x59\x85\x57\x01\xb6\xc3\x5b\x79\x57\x68\xe6\x33\xe2\x6a\x7b\x61\xae\xdd\xac\xb1\x3a\xbc\xba\x77\x76\x2b\xd5\xa0\x49\x0a\xcc\x93\xae\xc3\x2d\xd7\x6e\xfa\x91\x0d\xef\x53\x80\xab\x7c\x22\xa6\x25\x26\xa9\x1b\xc3\x56\xe5\x0a\x2a\x0e\x87\x7d\xe9\x53\x17\x06\x88\x14\x90\x90\x9c\x0e\x48\x4a\x83\x80\x24\x74\x40\x0a\x83\x80\x66\xe6\xc7\xd6\xfc\x98\xd3\x01\x98\x0b\xe8\xad\x19\xcd\x1f\x2e\x46\xbd\xde\x1c\x17\x94\x4f\xe6\x53\x0d\x44\xc5\xe1\xa0\xb1\x42\x31\x46\x5b\x5a\x65\xca\x45\x5f\xa2\x04\x3c\xd6\x3f\x26\x49\xaf\x37\x8d\xf4\x87\x54\x29\x75\xe9\xf6\x70\xc8\x1f\x0d\xc6\xaa\x4b\xd0\xa9\x5a\x81\x04\x46\xdd\x21\x36\xa5\xc2\x10\xa5\x3d\xca\x32\xbd\x5b\x68\x8b\x2d\xfe\x28\xc6\x79\x8f\x0e\x35\xe2\x28\xc6\xf9\x1d\xf8\x88\x02\xea\x81\xd7\x00\x8f\xd1\x8c\x16\x1a\x1e\x41\xab\x3e\x04\x86\x4a\x4c\x66\xd3\x5f\x34\x0c\x07\x34\x72\x3c\x69\x8f\x5a\x35\x72\xea\x6e\x89\x77\x77\x7b\x70\x2d\xdc\x0d\xda\x88\x0e\xb8\xee\x12\x70\x1b\x91\x78\x87\xc6\x46\xee\x67\x12\x80\xc1\x07\x73\x07\x0b\x32\xb4\xf0\x52\x0a\x3a\x23\x75\xbd\xcb\xd6\xf1\xf7\x0f\xbe\xf6\x44\xd6\x55\x73\x1f\x98\x36\xfe\x30\xa2\x4d\x16\x21\x9c\xe4\x24\x25\x31\x49\x8c\xa9\x95\x14\x8c\x14\x00\xee\x6a\xf5\x28\x18\x30\x15\xd4\xa3\xc9\x9e\x27\x99\x7c\x62\xab\xc3\xae\x66\x6c\x03\x6d\x75\xf8\x6c\xb6\xcd\x73\x36\x1f\x75\xa0\x43\xf0\xd1\xcc\x78\x76\x67\x6d\x0a\xce\xd9\x45\x87\x65\x17\x49\xce\x33\x20\x70\x3a\x0b\x9e\x4b\x47\xce\x05\x98\x38\x31\xa0\xf4\x3a\x6b\x56\x14\xf1\x92\x75\xe2\x6c\xde\x89\xe7\xf3\x04\x9a\x8d\xd3\xce\x8a\xa5\x9b\xc5\x36\xed\xe8\xc7\x6d\x8a\x7e\x80\x47\x20\x65\x92\xa3\x9c\xd1\x89\x37\x81\x29\xd9\xd2\xc1\xc8\x1f\xa9\x70\x9b\xf6\x9f\xc5\xdd\xa5\x5b\x1d\x8b\x01\x66\x93\x6d\xaf\x37\x05\x75\x72\x21\x35\x91\x34\xf8\x3e\xbb\x88\xf3\x24\xce\x44\xe7\x6f\x09\x4f\x63\x18\x46\x50\x2a\xfb\xad\xa2\xbf\xc8\xe1\x94\xbf\xe6\xa7\x7c\x43\x87\xa4\x28\x3f\x96\xea\xfd\x08\x7a\xc5\xe1\x23\x0f\x19\x48\x41\x15\xc3\x8e\x89\xe1\xf8\x11\x1d\x1c\x0e\xb7\x14\xca\x48\xc9\x15\x08\xad\x28\x03\xc9\xd5\xa8\x29\x91\xf4\xa4\xaf\x5d\x30\x95\x82\xc1\x5e\x9c\x9c\x01\x94\xc7\x02\x02\xe0\x9f\x9d\xb5\xdf\x99\x92\x11\x5b\x32\xf1\x04\xc0\x22\x13\x3f\x6a\x8e\xb6\x7e\x62\xf4\xed\xf4\xbb\x17\xe5\x29\x73\xac\x07\xdf\x54\x0c\xb2\x4e\x86\x35\x83\xac\x07\x5f\x57\x0d\xb2\x2a\xe6\x58\x27\xc3\x3f\x7b\xe6\x58\x50\xfa\xbe\xd6\x24\x9d\x7c\x55\xd1\x24\x0d\xbf\x79\x00\x9a\x24\xc8\xf8\x33\x08\x08\x34\x33\xfd\x0c\x98\xe9\xf7\xd8\xb3\x44\xb1\xec\x00\x26\x4f\xe8\xb3\x3e\xec\x03\x39\xa5\xcf\x0c\x27\xf2\x9c\xba\xa2\x4a\xe6\xf8\x04\xac\x19\xd0\x7e\x9e\x00\xc6\xde\x01\xfa\x89\x02\x29\xb7\xc9\x03\x62\x75\xda\xd1\x5e\x33\x14\xd1\x29\x31\xb2\xea\xe8\xcc\xc8\x2e\xd4\x1f\xef\x9b\x65\xe0\x1e\xfb\x84\x54\x74\xeb\xd1\x13\xc2\x33\x79\x06\xe5\xaf\x37\xd2\x7a\x23\x7a\x02\xf7\x79\xc1\xfe\x7b\xcb\xf2\x9d\x32\x95\x8a\x9e\x10\xab\xd2\x97\xc9\xd1\x13\x22\xe9\x14\xad\x55\x89\x4e\x4b\xd0\xfc\x69\x6b\x1b\x98\x6f\xd1\xd4\x02\xee\x95\xc1\xbf\xcb\x70\x50\x58\x5f\x00\x3d\x3c\xe4\x94\x49\x10\x01\x44\xf6\xf1\xbd\x0a\x09\x25\x8d\x8f\x5a\xfa\xb0\x22\x19\x40\xb4\x66\x15\xe4\x6f\x79\xdd\xeb\xdf\x8e\xd9\x91\xdf\x65\x49\x56\x71\x36\x4f\x55\x34\xa7\xca\x00\x6b\xde\xfa\x7a\xb5\xb4\xa1\x28\x1b\x35\xb3\x14\x88\x42\x3a\x01\x4f\xc4\xa6\x11\x56\xbb\x19\x14\xd1\xf1\xa2\x65\x5b\x24\x03\x8e\xac\xbe\x09\x44\xf4\xab\x9b\x40\x64\x0d\x4f\xc8\x9c\xab\xf3\x59\x20\x30\xbc\xa3\x99\xe1\x38\x25\xe1\x2a\xf2\x38\x2b\x24\xb6\x7e\x1e\x67\xf1\x92\xe5\x92\x7e\x95\x0b\x94\xab\x69\x9c\x6d\x33\xc5\x97\xd2\xbc\xaf\x7e\x38\xe2\x4d\xa2\x49\x31\x66\x7d\x6f\x9d\x90\x0c\x09\x65\x0d\xc1\x32\xa3\x84\xe9\x1b\x40\xb2\x21\x9d\xf5\x38\x28\x57\x23\xd6\x52\xb5\xb4\x24\x2d\x63\x6f\x5f\x1e\xdd\xb2\x0f\x73\x70\xf3\x35\x44\xe0\x5e\x59\x33\xf9\xcc\x5f\x58\x6e\xe6\x5c\xc8\x45\x31\xe7\x66\x14\x43\x84\x1e\x34\x20\xd6\xd6\x44\x52\x41\x91\xa6\xc8\x20\x26\x2f\xb0\x45\xaa\xa5\xcb\x3c\xde\x3c\xb5\x32\x28\xad\x9d\x03\x63\x48\x8d\x24\xab\x06\x6a\x82\xa0\x01\xb9\xd2\x70\x2d\x77\xa9\xc0\x88\x1f\x0e\x39\xdc\x65\x14\x0d\xc8\x65\xbf\xb9\x0a\x50\x4d\x0a\xa1\x6b\xf9\x49\xb6\xd4\xfd\xa9\x12\xfa\x26\xb0\x98\x60\x46\x1a\xdb\x1c\x25\x46\xf4\x58\x94\x25\x69\x1d\xbb\x5b\x72\xab\x69\xf3\x96\x2c\xa3\x2d\xa0\xc8\x69\x03\x18\xad\xe9\x85\xde\x92\x9c\xb2\xb1\xb7\x97\x66\xa3\x4a\x67\x86\x03\x2b\x63\xad\xd4\x30\xca\x31\xda\xd7\x3b\x8a\xb2\x3a\xe2\xe1\x65\xfd\x54\xbd\x64\x33\x96\x5c\xb0\x1a\xd6\x61\x78\x5f\x2b\xf7\x26\x5b\xd7\xcf\x5f\x15\xee\xc3\xb0\xfa\x0d\xbe\xf7\x35\x9c\xd5\x61\xa8\xb2\x44\xd2\x68\x8e\x64\x9e\x50\x9a\x70\x23\xc9\x2a\x48\xaa\x96\x2e\x5e\x17\x24\xae\xc8\xb5\x48\xe1\x03\x25\x90\xc8\x15\xbc\x47\x18\x08\xa1\x65\xcf\x20\x63\x42\x05\x99\x04\x95\x02\x01\x31\xa6\x95\x1e\x35\x20\xf9\xc2\x6c\x0c\x08\x2d\x32\x06\xe0\xef\xd9\xae\x40\xcf\x9d\x21\x14\x6e\xe1\x6d\xec\xde\x68\xd5\xe7\x16\x98\x66\x4c\x18\x4a\x40\x19\xbb\x25\x16\xba\xfc\xb3\x6c\x80\xca\x3b\xd0\x4e\x1c\x9e\x19\xc4\xcb\x0d\xd6\xad\xa0\xdc\xb8\x76\x09\xcd\xa4\x6b\x45\x45\xa8\xfc\x9c\x38\x82\xcd\xa6\xfe\xba\x84\x9b\xa9\x12\x28\x80\x0b\xa8\x71\x10\x60\x61\x18\xdc\x0d\xa8\xc7\xdf\x0f\x6a\xe2\x5c\x38\xf7\x8c\x2e\xc1\x05\xf2\xbb\xb8\x58\x9d\xc6\x62\x85\xcc\xbe\x74\xbb\x20\xc4\x3a\x1c\xd0\xd2\x10\xb9\xb6\x08\xf0\xd8\x0c\x93\xee\xd0\x6b\x2e\x36\x53\x33\xe3\xef\x21\xe6\x44\xdf\xe3\x00\x53\x90\x78\x8f\x83\x71\x10\x05\x61\x80\x7b\x48\xf4\x02\x1a\xf4\x32\xaf\x89\xa4\xa2\x51\x77\x62\x33\x5f\xa4\x30\x19\x87\xd3\x71\xd0\x83\xca\x4e\xdc\x35\xed\x81\x90\xc2\x17\xa1\x55\x14\xdd\xac\x45\x34\xf1\xee\xdd\xb8\xff\xe5\xf8\xdd\xbb\x73\xd5\x16\xea\xf7\xc6\x18\xbe\x5c\xe0\xaf\x2c\x0c\xb3\xc9\xd0\x93\xc2\xcc\xaa\xce\x01\x47\x56\x8f\x08\xc3\x0e\x67\x06\xaf\x3c\x1f\x23\x01\x7a\x68\xf2\x1c\x13\x46\x61\x96\xcf\x31\x11\xe3\x8c\x9e\xf5\x73\x16\xcf\x25\x45\x00\x77\x12\xca\x28\x9c\x01\x22\xe8\x0b\x7d\xac\x7e\x60\x3b\x84\x49\x73\x03\x60\xb1\x9f\xcb\x88\x69\x38\x12\x34\xa3\x9e\xb4\x60\x0d\x07\xb7\x00\xfe\x70\x85\x98\x9d\x8c\x69\xd0\x90\xc9\x48\xda\x2b\x70\xb2\x97\x78\x20\xca\x4a\x4c\xf4\xa8\x7d\x0b\x06\x81\x84\x37\x65\xc0\x3b\x29\xc2\x61\xc8\x11\x43\x18\x97\xda\xf9\xa1\xef\x30\xf7\x6b\x6e\xfa\x4b\xe5\xb0\xe3\xf9\xfc\xd9\x05\xcb\xc4\x8f\xf2\x52\x66\x39\xba\x4c\xb2\x39\xbf\x24\x01\x58\x8f\xcc\x64\x20\x93\x00\xc2\x0e\x9a\x2e\x10\xde\xc3\x5c\xd7\xfc\x82\xdd\xa6\x9e\x77\x0c\xbc\x1b\x80\xf5\xcf\xe3\x82\x01\xaf\x45\x32\xca\xfa\x46\xdb\x47\x38\x85\x60\xd9\xa0\xe4\x23\x39\xfc\x84\x89\x93\x94\x32\x19\x23\x8f\x67\x24\xa1\x0c\xbc\x2b\xe0\x76\x06\x9f\x9a\x55\xff\xf4\xc5\xa9\xe6\x55\x29\x02\x0f\x9e\x00\xf7\xb2\x1e\x1f\x3d\x1f\xa3\x82\xc6\xa8\x20\xcf\x49\x82\xc9\x59\xbf\x88\x2f\x98\xda\xc2\x84\xe4\x18\x28\x8b\xf7\x6c\x67\x3a\x70\x3b\x33\x6b\x1c\xb3\x94\xca\x5e\xde\xbc\xfa\x6e\x3c\xeb\x52\x5a\x84\xa1\x9e\xa7\x45\xc9\xc0\x55\xad\x68\x81\x23\x9d\xdf\x04\x84\xc2\x5f\x04\x89\x3e\x86\x94\xd2\x5e\xef\x65\x18\xa2\x57\x54\xa0\x17\xfa\x72\xcf\xe8\x0b\x4d\x1a\xfd\x85\x2d\x78\xce\x3c\xd0\x30\xd5\x11\xde\x67\x08\x93\x01\x9c\xd3\x3b\x2f\xc3\xf0\x15\xf2\xdb\xce\x6f\xd3\xf6\x07\xb7\x0a\xa2\xe8\xfd\x0b\xf3\x30\x88\x4b\x9f\xab\x74\x3d\xdb\x4a\xd6\x46\x65\x2d\x79\x25\xf5\xc2\x43\x83\xff\x11\xf4\x0c\xb8\x7f\x97\xb3\x45\xa5\xdc\x65\xeb\x34\x08\x74\xb5\x84\x29\xe4\xaf\x2d\x30\x83\x96\xb9\x52\xf7\x3d\xd4\x7d\xd1\xdf\x66\x47\xcb\x56\xa7\x69\x2b\x3e\x53\xc8\x48\xcd\x53\x81\x4a\xd5\x52\xe8\x89\x29\xa0\x27\xec\x95\x01\xd0\x39\xa5\x75\xae\xf4\x21\xf0\xe0\x56\x7a\xe2\xf8\xd2\xc1\x74\xbc\x2f\x23\xff\x7b\x74\xae\x55\xa3\x4f\x5f\x3c\x37\xd2\x2a\x4b\x2b\x02\xa9\x28\xb7\xf0\x39\x3d\xed\xff\x0c\x54\xca\x0f\x6c\x37\x72\x62\x99\xe7\x87\xc3\x73\xe0\xe0\x9f\xd3\xc6\xdd\xf2\x7c\xfc\x3c\xba\x52\xb0\xf5\x82\x5a\x6e\x47\xa1\x95\x53\xb2\x6f\x32\xe6\x11\x23\x8b\x24\x4b\x8a\x95\x5b\x35\xa0\x91\xcc\xe1\x89\xbc\x83\x04\xd2\x92\x97\x74\x40\x5e\x19\x04\xba\xec\x17\xdb\x8d\xbc\x53\xff\x5f\xfe\xf7\x44\xac\xf8\x56\xbc\x64\x29\x8f\xe7\x6f\x0a\x20\x2c\xe3\xc2\xdd\x5a\x1d\x39\x82\x17\x44\x6b\xaa\x14\xb4\x47\x9c\x68\xc5\x55\x4e\x60\x0f\xa2\x99\x55\x51\x81\x1e\x2a\xda\xe8\x7b\x1d\xc0\x25\xba\x20\xed\xfb\x1b\x5d\x92\x63\x5b\x1f\xbd\x27\x76\x6b\xa3\x67\xa6\x6d\x39\x97\xe8\x49\x89\x3f\x57\xf3\x22\x69\xc6\x3a\x7c\x20\xcd\x58\x91\x14\x0a\x82\xa4\xe0\x6b\x5c\x91\x41\x9c\x0c\x06\xda\x9e\x75\x30\xd4\x72\x88\xc1\x89\xb6\x68\x1d\xdc\x53\x92\x88\x93\x81\x91\x44\x0c\x7c\x49\x44\x70\xf6\x3e\xf0\x48\xa7\xd9\x47\x90\x4e\x1f\xa2\x26\xae\x55\x6d\xdb\x94\x8c\x06\xa7\x6f\x5e\x7d\x07\xa3\x82\xbf\x34\xd3\x97\x6a\xf0\xf2\xd9\xe9\x8f\x8f\x9f\x3c\x83\x0c\xfd\xd3\x2a\x6f\x83\xd3\x17\xa7\x90\x7e\xfa\xe2\x54\xea\xbf\xcd\x74\xf6\xd0\x42\x94\x11\x5d\x3e\xe2\xe4\xf4\xc5\x69\x94\xff\xca\x21\x71\xdc\x2d\xa8\x68\x9e\xbb\xff\x58\x09\xb1\x29\xc6\xd1\xbb\xbb\xef\xee\x4e\xfe\xf1\xee\xee\xf4\xcb\xbb\xf6\xac\xc0\xe5\x44\x29\xf8\x9c\x32\x4f\xd4\x2d\x26\x83\xa9\x86\x34\x0f\x47\xa5\xae\x6d\xe8\x86\x64\xa0\x10\xe0\xf0\x4f\x4a\x9d\x78\x30\xf8\x8f\x00\x8f\xe0\xfa\xbc\x33\x04\x0d\x91\x55\xe9\x80\x08\x3d\xc5\x44\x54\x12\x06\x24\xd5\x57\x48\xec\x37\x31\xb6\x8e\xb3\x9d\xd8\xb4\x94\x55\x5a\x8a\x9b\x2d\xc5\x18\x28\x40\x10\x25\x4b\x7e\x37\xb8\x1b\x28\xe5\x9c\xd2\xfa\x08\x63\xde\x93\x11\xb8\x52\x23\xde\x66\xc4\xc0\xae\x44\x1e\xcf\x40\x2c\xbe\x92\x1b\x69\xe9\xa8\x8a\x15\xc3\x03\x3c\x02\x1b\x86\x8f\x00\xad\x2e\x0a\xb6\x99\x32\xe5\x9f\x3b\x44\xaa\xae\xfc\xc3\xa1\xab\xef\xfe\x39\x9f\xc9\x03\xdd\x4c\xe9\x57\x98\x0f\x60\x3a\x2c\x66\xa7\xd9\xb5\xe3\x31\x59\x1d\x0b\x72\xac\x41\x9b\x8d\x9b\x49\xd2\xa9\x14\xa4\x09\xac\x1f\x0b\x11\xcf\x56\x92\x28\x43\x01\xcf\x82\x1e\xb4\x52\x36\xed\x2c\x58\x1b\xf9\x36\x6e\x4d\xf5\x5a\x9f\xb3\x6b\x5b\xcf\x1d\x17\xde\x20\x90\x72\xb6\x00\xf5\x50\x22\x24\xe8\x4d\x86\x53\x20\xd4\x5c\x55\x09\xb5\xf5\x4a\x1a\x3f\x37\xa8\x2d\x03\x2e\xbd\x7a\x86\x02\x9e\x5e\xf0\x1f\xc0\xf6\xb8\xc6\xe3\xe3\xe3\xba\xa9\xa9\x7a\x32\x40\xa5\x6b\x18\x2c\x5b\xf7\x2c\x0c\x75\x29\xcd\xb9\xd6\x69\x1e\xcd\xe3\x08\x33\x0f\xe9\x61\x9c\xaf\x41\x7f\xec\x0a\xcd\x2c\x9f\x92\xc5\x17\xc9\x32\x16\x3c\xef\x6f\x0b\x96\x3f\x5e\xb2\x4c\xe8\x63\xe6\x33\x6a\x8f\xb3\x79\x0e\x97\xee\x49\x5f\x73\x6c\x61\xd8\x92\x7b\xbf\x3f\xd0\xd9\x87\x83\x97\xfd\x9c\x9f\x27\x29\xeb\xbc\x8a\x17\x71\x9e\xb4\x15\x78\xb2\xca\x39\xb8\x9a\x75\x1b\x39\x7f\x97\x73\x28\x3a\xa7\x2b\x9e\x99\x02\xd8\x51\xc4\x7a\x09\xc2\x30\xb0\x17\x2d\xb8\x4e\x57\x73\xbd\x69\x6f\x6f\x31\xed\x8e\xd7\xfd\xb7\x49\xce\x16\xfc\x4a\x8f\xb9\x05\x39\xd4\xcf\x06\xcd\x88\x68\x83\x6a\x10\x15\xfa\x74\xbe\xc4\x24\x35\xaa\x5d\x1a\xe9\x2e\x99\x50\x73\x96\xa5\x40\x8d\xb7\xe4\x46\x79\xf7\xa6\x90\xfe\x4a\x8b\x04\x1c\x56\x12\x9e\x49\x15\x9e\x21\x81\xb4\xec\x8b\xce\xbc\xb4\x63\x64\x11\xdd\xfe\xda\xb7\x8d\xae\x52\xf4\x58\x23\xb0\x82\xc8\x77\x7b\x73\xc3\xe8\x8d\x2a\xd4\x23\x03\xaf\x04\xcf\xe3\xa5\xc1\x0a\xdf\x43\x74\x4d\xb8\x53\x70\xd4\x5e\xac\x60\xc2\x96\xa9\xbb\xe9\x09\x0c\xc1\x6c\xe1\xa6\xd3\x96\xc6\x4a\xcb\x46\xe9\x56\x4b\x58\x81\x9f\x9b\xd9\x8d\xd6\xee\x80\x8f\xe8\x20\x0c\x81\xc2\x6d\xef\x50\x5f\x7e\xba\x01\x2d\x32\xf7\x66\x1e\xbb\xbb\x50\x53\xa8\x30\x57\x71\xa4\xb5\xa5\x37\xfc\x1b\x06\x2b\x43\x32\x94\x60\x35\x88\xa1\x45\x9d\x28\xa7\x2c\x6f\x23\x24\xbc\x06\x0c\xa1\x26\xeb\x34\x21\xd6\x92\xd3\x12\xdc\xac\xa0\x81\xc6\x5a\xda\xab\x49\xbb\x02\xb4\x3e\x09\x26\xc1\xff\xf9\x3f\x1a\xb2\xe0\xea\x9c\xd1\x49\xf0\xdf\x5b\x2e\xe2\x67\x57\x33\xc6\xe6\x6c\x2e\x05\xe7\x01\x09\xfe\xfb\xcd\x8b\xd7\x8f\xcf\x9e\xfd\xcf\x93\x67\xcf\x9e\x3e\x7b\x7a\xf6\xec\xe5\xcb\x00\xd4\xa2\xc1\x2b\x36\xdb\xe6\x89\xd8\xa9\x72\xbf\x36\xd4\xd9\x2f\xe1\xc3\x60\x93\xd3\xb1\x36\x8e\xc0\xe9\x90\xcc\xb1\xa9\x52\x78\x91\x51\x2b\xc4\x45\x29\xda\xb7\x1c\xbc\x68\xd6\x76\x1c\xc1\xd1\x63\xbf\xe4\x90\xc9\x4b\x27\x30\x4a\x81\xf3\xc8\x0c\xe7\x11\x89\x23\x84\x7f\xfa\x19\x10\xfe\xb1\x51\x36\x5a\x83\x4d\x4d\xd6\xcf\x0c\x59\xbf\x95\x64\xfd\xd7\x46\x3d\xe9\x91\xf1\xf9\xa7\x93\x80\xaa\x10\xfa\x79\x9c\xcd\xf9\x1a\x61\x17\x12\xe1\xde\xd7\x58\x13\x8b\xe8\x84\x30\xdc\xc0\x54\xa6\x43\x7b\x6d\x03\x1d\x69\x3f\xe0\x06\x54\x14\x80\x4c\x56\x3f\x21\x11\xe4\x3c\x90\xf2\x9e\xed\xc2\xd0\x7a\xac\x21\x2d\xfa\x01\xf4\x0c\x7f\xbd\xee\xe2\x5a\x78\x11\xdb\xf3\x53\x23\x02\x71\xcb\x84\xf7\x4f\xe9\xd3\xfe\x22\x49\x05\xcb\x3d\x75\x96\xad\x22\xc0\x6e\xab\x62\x78\xe4\x85\x92\xf8\x36\x0c\xbf\xd5\x72\x2d\x4a\xe9\x02\x44\x59\xe3\xef\x2c\x12\xfc\x16\xe4\x5c\x38\x7a\xeb\x25\xbd\x55\x49\x77\x86\x2d\x22\xb5\x8c\x0a\x84\x47\x6f\x29\x23\x6f\x2b\x6d\x82\xe0\xea\x3b\x3a\xb1\xc6\xc2\xdf\x69\xfb\xa9\x01\xc9\x7a\x43\x4c\x26\xb2\xcd\x29\x8e\x2a\xb5\x34\x37\x14\x86\xe8\x3b\xf0\xd5\x90\x65\x30\xf9\xb9\x55\xba\xcf\xd0\x5b\x30\xad\x6a\x78\x93\xfc\x6c\x57\xeb\x2d\x86\x32\xce\x5e\x43\xd0\xbf\x22\x3c\xfa\x8e\x4e\xe4\xa6\x4c\x09\x2c\x98\x01\x6b\xdb\x32\xde\xff\x4c\x7f\xfe\xa0\x95\xd5\xae\x5e\x9b\x7e\xca\xf9\xe6\x71\xb1\xcb\x66\xe8\xa9\x3e\x92\xa4\x1e\x81\xc5\x6a\xd0\xd0\xd3\x89\x98\x92\xaa\xff\x14\xa0\xea\x2e\x65\x63\x00\xf3\x48\x06\xcc\xf0\x0f\x06\xde\xbf\x09\xc3\xa6\x14\x7f\xfc\xa6\xe6\x85\x25\x10\xeb\x52\x0a\xd2\x77\x1c\x79\xbf\xdd\x78\xa5\x09\xda\xdb\x30\x4c\xd1\x5b\x22\x45\xf8\xdf\x52\x46\x62\xbf\x15\x15\xf7\xe8\x5b\xd0\x0d\x80\xd7\x96\xd0\xd7\x51\x6d\x7b\x8d\xab\xd6\x25\x7a\x0b\xf6\xe4\x20\x3c\x1b\xe5\x94\x52\xee\x83\xfb\x5b\x0d\xee\x1a\xec\x25\x4e\x31\x0d\xd9\xfd\xc6\xe5\x8f\x88\x01\x55\xd7\x1d\x86\x21\x4c\x42\x79\x72\x25\x0b\xf4\x36\x0c\x6d\x71\x0d\xaa\xaa\xdb\x94\xd6\xc1\x93\xc4\x5e\x92\x3c\x7e\x8e\xd7\x34\x9c\xe2\x4f\x28\xbd\x13\xcb\x87\xf4\xdc\x72\x48\x29\x63\x81\x9e\xc1\x02\xc8\x79\x91\x2b\x84\xfd\x02\x2b\xbf\x80\x1e\x71\xbd\xcc\x39\xc2\xfb\x9f\xd0\x1d\x5f\xe5\x71\x26\x93\xfc\x94\x2b\x77\x04\x73\xf4\xd8\xcb\xb8\xd4\xa0\x6b\x5d\x13\x9b\xdb\x5c\xd7\x08\x7b\xa2\xeb\xcc\x89\xae\x41\x8a\x0d\xcc\x02\xc9\xa9\xa5\x63\xb3\x30\x44\x79\x8f\xaa\x18\x3f\xf0\x8b\x63\x92\xbb\xbe\xdf\x7b\x88\xe6\x12\xb1\xa6\x60\xb2\x76\xa1\x68\xc8\x7e\x48\x4f\x5a\x65\x8c\x27\xd3\xf1\x15\xc2\x9e\x90\xf1\xc4\xf8\x11\x9a\xf7\xab\xec\x9c\xe0\xb1\xa6\xe6\x44\x01\x40\xe8\xbc\xa2\xa6\x50\x7a\x11\xe9\x41\xa9\x35\x12\x02\x54\xf0\x34\x23\xfe\x45\x77\x6f\x7a\x38\xc0\xae\x38\x0b\x15\x7d\x99\xb8\x19\x3d\x81\xb9\xbe\x1d\xa3\x53\x09\xf9\x24\x43\x6f\x31\x8e\x4e\xd1\x5f\x11\xae\xe0\xfd\x53\x85\xf7\x35\xc8\xea\xae\x0d\xda\xc6\xe4\x7b\x05\x5d\x26\xc9\xab\xf8\x1c\xda\x7f\xea\x80\xd0\xf0\x48\x16\x81\xbb\xa2\x2f\x10\xbb\x2d\x0e\xb7\x75\x5e\x6a\xe6\xbd\xbe\x66\xb0\x90\xa2\xb2\x66\x02\x22\x53\x80\xa2\x53\x0e\x3a\x62\x25\x68\x83\x5c\x43\xaf\x3e\xa8\xa1\x55\xa3\x21\x00\x89\xd7\x2d\x20\x71\x5b\xb1\x33\xf9\x2b\x7d\xdd\x6f\x0a\x81\xc9\x8f\xf4\x75\xbf\x2e\x06\x26\xdf\xd3\xd7\x8e\x5a\x25\x3f\x41\x4d\x4e\xde\xd0\xd7\x6d\xb4\x17\x79\x4c\x5f\xc3\xde\xfc\x28\x07\x34\x0a\xd4\xfb\x32\x2e\x28\xda\xe3\x30\x44\x8f\xe9\x4e\x89\x95\x9e\x82\xc5\x3c\x5c\x51\xe4\x67\xf8\xe7\xad\x96\x27\x93\x6f\xf5\x0f\x0d\xb7\x55\x59\x31\x33\xb2\x62\xee\xd9\x24\xbc\xe6\x51\x51\x17\x1d\xaf\x40\x74\xfc\x93\xf1\x63\x38\xf7\x9c\x17\xce\xb4\x3c\xf9\x07\xb6\x8b\xae\xf4\x6f\x58\xec\xe8\x52\x7f\x48\x41\xf3\x7b\xfd\x61\x25\xe4\xcf\x88\xb1\x49\x89\x2e\x2c\x84\x3f\x21\x81\x49\xed\x24\x45\xc7\xf9\x10\x29\x4b\x48\x2b\x41\x78\xcf\x76\x10\x3b\x13\xd6\xb1\x23\xb7\x52\xbe\x85\xc7\xe2\x79\x80\x8f\x09\xb4\x5d\x27\xcf\x49\xd0\x5e\xa6\xb5\x4b\x6f\xb5\xbc\x4e\xb6\xd9\x8d\xdd\xbc\x20\xc1\x36\xbb\x7d\x47\x60\xc0\x09\xa4\xea\x79\x3c\x7b\xdf\x51\x5b\xc5\xe6\x9d\x45\xce\xd7\xc7\xc6\xe0\xa4\xf0\xae\xd3\x97\xc4\xc9\x0c\xda\x7a\x81\x4c\xaf\x09\xbd\xbb\xf5\x56\x5e\x91\xc0\xcf\x69\x6b\x48\xe7\xbb\xb6\x1a\x42\x46\xcd\x79\xfd\xfe\xe9\xff\xc2\x08\xfe\x67\xc0\x1d\x16\xe0\x64\x7a\x32\xf8\x33\xae\xc6\xb7\x00\x79\x3f\x58\x25\x9e\x0c\x07\x3a\xc2\xc5\x40\x46\xb8\x80\x94\xba\x65\xa2\x1f\x28\x0e\xbe\xef\x81\x3e\x80\xa3\x33\xd0\x06\x7c\xed\x31\x0f\xf1\xed\x98\x87\x56\x7e\x41\xdd\xad\x94\x79\x28\x8a\xb5\xb2\x09\x5d\x04\xa6\xa6\xe6\xb6\xb2\xa8\x03\xd2\xea\xe8\xc4\xca\x02\xe0\x26\x33\x6d\x39\x74\xca\xfa\x33\xbe\xd9\x85\x61\x6b\x96\xa4\x86\xc3\xb0\x6b\xbd\x2a\x1e\x0d\xc2\xb0\xd9\xc3\x64\x30\xf5\x31\x77\x6a\xe6\x08\xfb\x99\x92\x2d\xc8\x2f\x80\x5c\x3c\x1c\x38\xe0\x6a\x3d\x83\x21\x24\x7b\xb1\x19\xe1\x81\x47\xf7\x55\x29\x05\xc4\xa0\x49\xe8\x16\xca\x20\x8c\xd1\x58\x9b\xe8\xc2\x2d\xab\x7f\x0b\xb8\x51\x54\xe7\xd2\xf1\x22\x87\x8a\x40\xb4\x74\xf3\x66\xcf\x6a\x59\x64\xb7\x15\x69\x49\x77\x28\x99\xd1\x94\x0e\x46\xe9\x43\x53\x6c\x94\xf6\x7a\x40\x66\xb2\x49\x3a\x85\x2a\x93\xd4\x78\xe2\x75\x87\x1a\x07\x83\xd3\x63\xbe\x93\xb0\x3d\x07\x13\x09\x00\xad\xa4\x22\xee\x30\x15\x40\x3c\x62\x5e\xbb\xee\xd2\x4d\x6b\xf7\xf3\x7e\xc1\x73\x81\x30\xd9\x98\x1f\x29\x35\x75\xee\x0c\x47\xe9\x23\x18\xde\x9d\x3b\x30\xa6\xb9\x1c\xd3\xa6\x32\x24\x35\x83\x23\x15\xb6\x14\xaa\x90\xee\x0c\xb1\xc9\x76\x4a\x04\xfc\x93\x79\xcb\xa3\xa1\xd1\x6c\xaf\x05\x08\x21\x65\x0e\xb1\x76\x79\xb0\x7b\xa5\x98\x26\x02\x11\x65\x4e\x06\xdf\x18\x56\x5b\x1e\x3d\x77\x14\x0c\x74\x18\xd8\x30\x10\x7f\x38\x20\x30\xd7\x06\xf2\x89\x82\x1b\x47\x35\x4a\xea\xd3\x58\xb0\x30\x14\xf5\xa4\x31\x83\x8b\xf5\x75\xb2\x66\x08\xc8\x17\xe1\xbe\x22\x38\x17\xad\x2f\x91\x82\x29\x51\x3d\x4d\x8c\xb3\x3e\x10\x2a\x33\x31\x96\xdd\x47\xf0\xb2\x62\x64\xe0\x17\xd7\x14\x5d\x78\xdf\xe4\x2c\x05\x9d\x38\xdc\xa5\xdd\xfd\x85\xa2\xa4\x32\x2b\x56\x11\xa5\xf0\x56\xa2\xe5\xa4\x69\x6c\x07\x16\x63\x26\x50\x28\xfc\x06\xb7\xab\x7e\xb1\x4a\xd6\x34\xbb\x7e\x24\x9a\x78\x9d\xa8\xf9\x75\x6c\x88\xb3\x69\x40\x8d\xa8\xc6\xed\x96\x91\x29\x98\xe3\x53\xb6\xa2\x22\x6f\xb9\xec\x30\x99\x3b\xfc\x2e\x4d\x83\x58\x18\xde\x0a\x65\x33\x12\xc0\x5f\xc6\x02\x1c\x86\xdd\x46\x95\x8d\x2e\xfc\x7d\xf1\xcc\x86\x36\x6a\x54\x84\xa7\x46\x4b\xad\xb9\x6c\x9d\xb2\x99\x90\x63\x65\x6e\x58\x04\x7b\x13\xe1\x12\xe1\x91\xbf\x59\xf9\x58\x52\x51\x46\x4c\xcd\xe6\x52\x6a\xbe\xcd\xdc\xf7\xf5\x2a\xda\xa6\xde\xaa\x4d\x27\x94\x80\xbc\xb3\x90\x22\x3f\xb4\xf5\xe4\x11\xbc\x3e\x3c\xac\xed\x62\xb3\x23\x81\x36\x6d\xe3\xa9\x7e\x64\x2e\x09\x43\x34\x83\xd6\xbb\x05\x56\xb2\xb9\x02\x6e\xf1\x6e\x12\x86\xf1\x43\x16\x86\xb3\x11\x9e\xd1\xee\x90\x08\xcf\x76\x3a\xee\xf5\x48\x4a\x72\x0b\xbe\x05\x14\x48\xc6\xcd\x8e\xb7\x6a\x34\x28\x7e\x44\xa1\xa9\x30\x44\x72\x22\xf0\xde\x19\x2e\x35\xae\x00\x17\xcf\xee\x90\xc8\x36\x64\x4f\xc6\xd7\x6a\x94\xa2\x76\xe9\xa2\x76\x04\xf7\x36\x4b\xe2\x97\x91\x70\xb2\x0b\x9a\xfd\xaa\xd2\x38\xa3\x78\xb1\x0b\x7b\x33\xf3\x10\xdc\x0d\xaa\xdc\x83\x68\xa9\x3d\x6c\xad\x3d\x9c\x8e\x13\x10\x6f\x79\xf5\x87\x53\x92\xb5\xd4\x3f\xca\xcd\x02\xdd\xe0\x55\x3f\x99\x12\xde\x52\xfd\x5e\x6b\xf5\x7b\x8d\xea\xf7\xa6\xa3\x23\x2c\x6f\x51\x63\x79\x1b\x18\x02\xb8\x3c\x46\xd3\x36\x3e\xf8\x70\x90\xb3\x24\x19\xe5\x58\xdb\x1d\x38\xf9\xc0\xe1\x10\xdc\x0d\x48\x6c\x85\x04\xa0\x02\x95\xd7\x07\x08\x0a\xe4\xc7\xd6\xd8\xa3\x1d\x0e\x30\x5c\x0d\x9b\x4e\x3f\x9e\x1b\xfd\x78\xac\xf4\xe3\x33\x1d\xfa\x62\x6b\x42\x5f\x08\x19\xef\x22\x2b\x3f\x73\x89\xf6\x03\x08\x41\x80\xa4\x48\x1b\x6e\xd9\x01\x36\xf7\xed\xf0\x13\x4b\xb0\x1d\xc9\xc7\x29\x44\x69\xce\xf0\xc8\xdc\x0d\x0f\x4f\x40\x62\xc6\x8f\x29\x13\xac\x4d\x42\xfa\xc9\xa7\x60\x2b\x35\xaf\x90\x63\xb1\x8d\x7f\xef\x93\xb2\x55\x4c\x44\x84\x64\x01\xb1\xa3\xac\x09\xc1\xdd\xff\x3c\x19\x80\xaf\x66\xaf\xe1\xbf\xaf\xeb\x99\x44\x5f\xf2\x2f\xaa\xc2\x40\x65\x79\x67\x28\x22\x73\x88\x47\x3a\x9d\x5e\x22\xdf\xd2\x65\x88\x31\x61\x93\xd5\x94\xee\xf5\x89\x35\xa6\x2d\x7f\x89\x0b\x26\x5f\x49\xd5\x1d\x7b\xac\x8f\xbe\x5a\xa1\xfd\x8c\x70\x0a\xd5\x41\x7c\x38\xbe\x02\xa3\xe3\x20\x00\x82\xbe\xcb\xc3\xb0\x9b\x6b\x22\xb6\xc3\x8e\xa1\x30\x5f\x70\xc4\xb4\xed\x8e\x89\x48\x30\x4a\x41\x2e\x6c\x86\x0f\x52\x62\xfd\x73\x6c\x7e\xc8\x21\x9a\x02\x80\x97\x34\x3f\x6c\xf0\x51\x27\x87\x4b\xb0\x47\x51\x32\x0e\xc2\x20\x0a\xc6\x01\xee\xe5\x98\xc4\x0a\x21\xa2\xcc\xce\x39\x29\x49\xe6\xaf\x41\xe2\xaf\x41\x5a\x92\xcc\x67\xa8\xfc\x8d\xdf\x55\x8d\x60\x4d\x19\xa9\x0b\xdb\x7b\x1a\x1c\x22\x10\x48\x53\x71\x79\x64\x57\x4d\x3b\x5e\x0b\x78\xcf\x10\xd4\xaa\xd4\x01\x46\x66\xbf\xd3\x14\x2d\x62\x44\xef\xa9\x3f\x3c\x60\xcf\xf6\x3b\x0b\x50\x47\x4a\x69\x7f\x71\xdb\xbd\x93\x29\xc9\x1a\xe2\x70\x68\xa9\xb4\x69\xad\x04\xb2\xa7\x6b\x2a\x9d\xfb\x48\xbb\x79\x11\x92\x5c\x47\x9a\xe1\x8f\x86\x63\x7e\x67\x08\x91\x66\x52\x3a\x1c\xa5\x0f\xb9\xe4\xf2\xf2\x49\x7a\x67\xe8\x47\x9a\x49\xa7\xda\x78\x6a\xd7\xaf\x8a\xbb\x34\x46\xd8\x91\x89\x3f\x67\x4b\xbd\xe5\x4e\xb1\xaa\xf3\xc2\x10\xc5\xfa\x4c\x98\xd2\x44\xa0\xd8\x1b\xfb\xd2\xa2\x80\xdb\xc9\x3d\x13\x14\xfb\x72\x4f\x02\x2f\xea\xe6\x70\xcd\xf9\x0b\x72\xf6\x81\x8d\x16\xd7\x34\x0a\x2b\x71\xf1\x21\x94\x51\x5d\xac\xba\xa3\x4c\x79\x71\x5e\x38\x7c\xa4\xbc\x98\x2e\xe9\x45\xc3\xdb\x49\x2f\xa0\xe3\x99\x2c\xef\x76\x15\x86\xe8\x8a\xe6\x98\xb4\xe4\x5d\x82\xd9\x0d\x5d\xeb\x83\xb7\x3b\x66\x4a\x9b\x2a\x79\x68\x62\xe5\xa1\x85\x96\x67\xc2\x4a\x44\x33\xfd\x01\xd0\x66\xed\x6b\xcd\xd6\x47\xe7\x9e\xa4\xce\x59\x23\x2f\x7f\x81\xa4\xce\xb5\x72\xf6\x31\x92\xba\x23\x37\x51\xfc\x19\x50\x36\x0d\x4b\x8e\x0c\x9d\x0c\xbf\xc2\x5a\x57\x3f\x3c\x39\x26\xb4\xf3\xdf\x76\x08\xbe\x70\x08\x34\x20\x6b\x43\xaa\x7a\x77\x6f\xfa\x11\x77\xaf\x0e\x5f\x81\x4e\x86\x5f\xe3\x91\xb5\xaf\xf4\x84\x19\x16\x37\x31\x63\xc3\x37\x76\x36\x7c\xc6\x02\xb3\xa5\xbc\x39\x89\x16\x64\x19\xe8\x1f\x10\xa3\x4c\xbf\x55\xed\xee\xe7\x7f\xa0\x77\xe3\xc3\x7f\x1c\x42\x7c\x17\x1c\x84\x08\x1b\xdb\xae\xc2\x00\xf7\x73\x36\xdf\xce\xbc\x7b\xc0\xdd\x93\xd4\x73\xed\x7f\x27\xc3\x31\x74\x02\x6c\xaa\x52\x88\x30\x43\x33\x90\x2e\x2c\x04\x02\xd5\x68\xa6\x77\xfd\xd1\x60\x9c\xf5\x7f\xe2\x49\x26\x0b\x45\xfa\x66\xd4\xb3\xe4\xb4\x25\x7a\x08\xe8\xea\xf4\x0d\x4a\x29\xcd\x15\x3b\xd1\x52\x2e\xc7\xa4\x0e\x1b\x88\xe3\x71\x35\x80\x06\x00\x08\x1e\xc3\xbf\xea\xce\xc9\x71\x04\x1f\x74\x02\xff\x92\x7c\xaa\xbe\x72\xc2\x4a\xb2\x2f\x71\xb4\x07\x97\x44\xcf\x1f\xb2\x75\x6b\x7c\x99\x0a\x90\x3e\x4a\xa0\x56\x0d\x91\x62\x57\x8d\x01\xed\xae\x6b\xda\x49\x65\xe3\x20\x88\xb4\x74\x36\x1b\x8b\xa8\x3a\xe6\x0c\x83\x1c\x09\x98\x54\xd4\xde\xb8\x1b\x0b\x48\x40\xa5\xd7\x18\xdc\xe9\x25\xd6\x0b\x1d\x06\x38\xf2\x72\x32\x78\x86\xa2\xae\x73\xf3\xe6\x63\x77\xaa\xd2\x00\x50\x4e\x15\x68\xae\xc1\xb2\x83\xfe\xb6\x36\x9b\xf1\x3d\x7c\x1a\x71\xd2\xfd\x13\xc2\x5f\x4e\xfd\xf8\x10\xb6\x6e\xf0\x9f\x41\x4f\xb9\xe7\x3d\xe1\x73\x26\x5d\xf4\xac\x78\x05\x0d\xbf\x06\x23\x96\x37\x9b\x0d\xcb\x9f\xc4\x05\x93\xf6\x02\x37\x9c\xb9\x5f\x4c\xef\x5a\xac\x06\xe8\x89\x81\x84\xf4\x56\x18\x0a\x56\xcc\xc8\x40\x0d\xc0\x77\x87\x0d\x4b\x1b\xfb\xed\xc1\x4c\xd7\x89\x68\x6e\x64\xf6\x71\x18\xfa\x9f\x84\xff\x32\x46\xdf\xba\xfe\xfe\x3f\x7a\xa5\xc0\xe9\x0f\xac\x14\x06\x8a\x51\xee\x52\xca\xc1\x79\xcf\xb7\x24\x37\xd7\x39\xc9\x29\x07\x61\x1a\x8e\x10\x50\xed\xd5\x1b\x0e\x09\x38\xd6\x19\x26\xd5\xc8\x30\x82\xe4\xe4\xc2\x5a\xfd\x92\x0b\xe3\x6f\x7b\xa1\xbd\x6d\x3d\xb2\x03\x4c\xaa\x33\xbc\xdf\x85\xe1\xce\x56\x00\x8d\xc6\x38\x45\x3b\x92\x61\x08\x39\x63\xa3\x5b\x83\x4f\xb5\x6f\xdc\x01\xd4\xac\x18\xc3\x12\x47\x7c\x9c\x02\x3d\x52\x12\x4e\xf6\xa6\x99\x88\x41\xd4\x5c\x63\x80\x72\xcd\x16\x65\x24\xb7\x30\x94\x1d\x0e\xf9\x98\xcb\x24\x89\xd6\xd0\x80\xd8\xb8\xdb\x18\x79\xbd\x4b\x5a\x3a\x1b\x03\x2d\x1c\x09\x19\xf4\x49\x06\xc3\x21\x17\x54\x13\xf2\x7b\xcf\xa3\x96\x97\x55\x82\x59\xcf\x9a\x8d\xe1\x04\xd9\xea\x58\x0b\x2c\xd1\xc0\xbd\xc6\x80\xd1\x05\x68\xf8\x21\x6a\x5e\xca\xe2\x0b\x1d\xac\x56\x46\xc7\x53\x1e\x82\x3a\x01\x02\xe3\xb1\x4c\xb0\x5c\x7d\x8f\xd0\x80\x6c\xfb\xf9\x36\xb3\x91\x44\x0b\x8c\x52\x72\x01\x71\x52\x8f\x23\x8d\xa2\xae\xf1\x2f\x9d\x3f\xf2\x5a\x6e\xb2\x6c\xf3\x89\xec\x58\x37\x9a\x90\x8b\xea\xa6\xb8\xb5\x14\x6a\x2d\x85\xb7\x96\xb2\xfe\x33\x18\xa7\xae\x5e\x10\x58\x89\xb2\x3d\x9e\x74\x13\xe8\x8f\x1d\x98\xc3\xc1\xff\x32\xa8\x19\xb0\x41\x32\x3f\x3b\x3b\x1c\x80\x30\x36\x5f\xf4\xaa\xd7\xf3\xfa\x2b\x7c\x0c\xd2\x7e\x55\xda\x5c\xb8\x6d\x34\x41\xcf\xc8\xe5\x04\x34\x37\x32\xb0\x32\x99\x4c\xbd\x16\xe7\x6a\x77\x6f\x02\x5d\xcb\x1a\x73\xc3\x90\x02\xc0\x75\x32\x84\x47\x3b\xda\x06\xcc\x96\xa6\xca\x69\x81\xea\x40\xb2\xc3\x3e\x80\x60\x17\x41\x2f\xa1\x10\xbb\x2a\xd7\x4b\x38\x02\x20\xa5\x34\x0d\xc3\xe4\xe1\x76\xd4\xeb\x25\x38\xa5\xf9\x24\x99\x82\xcd\x53\x86\xd2\xca\x4e\x2c\x94\x7c\xd8\x1c\x5f\x87\x34\x19\x2d\x5c\xaa\xef\x09\x3c\x00\xbe\xdb\xf4\xd4\x20\x5f\x44\x18\x66\x0f\xf9\xa8\xd7\xcb\xb0\x90\xe1\x8b\x9d\x3b\x9b\xf0\xf0\xf3\xda\x01\x00\x38\x11\x83\xf3\x1e\x6c\x9f\xf6\x7e\xbf\x04\x73\xb3\x1c\x5d\x82\xbd\xd7\xfb\x30\x44\xef\x91\x8c\xed\x02\x61\x9b\xc8\xb3\x30\x44\xcf\x10\x3c\x17\x00\xb3\xac\xd8\x35\x2d\x91\x27\x7a\x82\x7d\x23\x29\xbd\x9c\xf0\x29\x48\x05\x52\xac\xa3\xfd\xbd\x58\xa0\xcc\x98\xba\xa4\x56\x41\x63\xcd\xee\x62\xda\x85\x8e\x47\x50\x8f\x4e\xb2\x29\x81\x90\x82\xef\x29\xab\x72\xdb\x73\x4c\xaa\x29\x6f\x32\xf0\xe1\x83\xa1\xd5\x8a\xaa\x0c\xb4\x80\x81\xea\x75\x30\xe3\xb5\x76\xfd\x66\x8c\x7a\x49\x40\x1e\x7a\xcd\xa5\xdf\xa5\x34\x2b\xf1\x08\x4e\x87\xd9\xf0\xf1\x1a\xf0\x23\x34\x43\xf3\xd2\x5b\xe5\x33\xcf\x40\xc7\x0c\xcb\xb5\x09\x8a\x59\x1f\x1f\x4b\x44\x07\x48\xea\x02\x88\x90\xcc\x41\xb2\x8c\x1b\x65\x11\x21\x1f\x3b\x77\x76\x8e\xa3\x3c\x0c\x75\xb5\x1c\x00\xcb\x30\x86\xc0\x6e\x19\x98\xb9\xa2\x43\x72\x69\xd8\x0f\x3f\x06\x17\x79\x6f\x8a\x3c\xd3\x3f\x34\xac\xec\x6d\x14\xe4\x4c\xc5\xb8\xb1\xbc\x9a\x5a\x6e\xc0\x78\x49\xb6\x94\xe7\x20\x5a\x1a\x36\xee\xec\xb3\xe5\x7c\x7c\xee\x44\x4b\x92\x74\x24\x23\x9f\x13\xfa\x06\xd7\x42\x2a\x3d\xd0\x9c\xd0\x89\x8d\x66\xae\x8c\x17\x4e\x4e\xaa\xcf\x73\x9c\xdc\x37\xcf\x73\x8c\x3e\x9c\xf5\x69\x7a\x46\x81\x90\x4d\x89\xec\x0d\x99\x34\xd4\x0c\x12\x1a\x90\xd4\x06\x68\x83\x98\x49\x05\x46\xba\xa4\xc1\x02\xbc\x5f\xf0\x35\x6b\x83\x6c\x13\xa7\x63\xc2\x40\xb3\xae\x63\x03\x16\x32\x10\x46\x43\x3a\xab\x49\x74\x20\x06\x35\xdd\xe1\xa2\x98\xfb\x81\x45\xf5\x8f\xa4\x0a\x5e\x9d\x6c\xdc\xb5\x03\x30\x8e\xf1\xdd\xe1\x08\xa2\xf1\xd4\x4f\x9e\x9a\x6f\x61\x66\x6a\xc4\xba\x79\x1d\x9b\x80\x51\x43\x26\x9f\x4d\x30\x9d\xa4\x61\x28\x95\x6c\x10\xa9\x10\xee\xe4\x1c\x1e\x5e\x00\xd2\x97\x24\x60\xc2\x15\xc3\x3f\x79\xab\xb5\x30\x74\x21\x68\x56\xbf\xa9\x09\xa7\x0e\x89\x49\x13\xd4\x3b\xc3\x91\x38\x1c\xf8\x38\x31\x36\x7b\x51\x6c\xad\xf7\x40\x85\x19\xa1\xd4\x76\x95\x80\x8c\x64\xef\xdd\x1f\x51\x4a\x7c\xf2\x22\x8a\x89\x47\x5c\x44\x49\xfb\x61\x4a\xcd\xdb\x34\x9f\x54\x96\x5d\x31\x1d\x30\x19\xe8\x76\xc2\x3e\xb8\x36\x07\xbe\x90\xaf\x26\xe2\x03\xe3\x0e\x7d\xff\x0b\x22\x59\x55\x4d\x98\xe8\xab\x25\xa6\xf9\xc4\x20\xde\x3b\xc3\xe9\x28\x86\x30\x00\xed\xb2\xd5\xeb\x49\x0d\xd1\xe7\x8a\x4c\x82\x69\xcb\x5d\xcb\x91\x4d\x23\x82\xdc\xc3\x4d\xb2\x23\xfe\xa0\xd6\x15\x11\x57\x6b\x5e\x25\x12\x41\xee\xb7\xb4\x9f\x98\xb5\x6d\x9e\x7a\xd3\xae\xd4\xff\xa2\xbc\x8d\x91\xd0\xaa\x36\x56\x6a\x5a\x30\xa7\x0c\x83\x8d\x4b\x97\x35\xa8\x1f\x80\x25\xcb\xb2\xc8\x18\x44\x56\xa9\x5b\x21\xc1\x19\xc9\x48\x8a\xf7\x20\xc8\xe6\x5e\x2a\xde\x33\x20\x3c\xc1\x1c\x20\xb7\xe6\xe7\xbe\xf5\x6c\x61\x86\x0d\x1d\x71\x19\x8a\xd6\x9c\xcb\x04\x99\xb8\x9e\xd5\x6e\x72\xbc\xe7\x13\x36\x05\x22\x82\xe4\xb5\xe6\xb4\x35\x11\x90\x74\xd0\x60\x4e\xe3\x4a\x83\x79\x4b\x83\x1c\xc6\x9d\x9b\x06\xe1\xab\x24\xdc\x6b\x52\xc7\x2c\x35\x20\x5b\x23\xac\x0c\x11\x05\x24\x54\x9f\x2b\x12\x3f\x0c\xfd\x2f\xcd\xac\x02\x8d\x22\x1a\x1a\x35\x22\xaa\x44\xb8\x74\xbb\xab\x92\xf5\xd2\xeb\xae\xc2\x3d\x78\xe1\x33\x4f\x4e\x06\x26\x4e\xdf\x09\x28\xaf\x36\xd7\x7b\x09\xdb\x49\xd9\xd3\x6e\x53\xb8\xd3\x9c\xc5\x30\x30\x65\x3f\x80\x66\x9e\x75\x43\xab\x9a\xff\xa3\x2d\x1e\x72\x6d\xf1\x10\x6b\xf4\x4b\xba\x89\xb6\x78\x48\xa4\xc5\x43\x1c\x86\x29\x58\x3c\x14\x23\x5c\xd4\x2d\x1e\xd2\x5e\x8f\xe4\x84\xbb\x9d\x85\x02\x71\x8b\xc5\xc3\x4c\x43\x78\x2a\x2d\x1e\x0a\x90\xe7\xd7\x2c\x1e\x52\x0a\xf7\x4f\x77\x58\x31\x7b\x30\xb7\x90\x1f\x52\xc3\x1e\xb0\x5a\x0a\xc7\xfb\xf8\x70\x40\x62\x6c\x9a\x16\x18\x47\x48\x06\xc0\xe5\x24\xa6\xbd\x5e\x02\x14\x20\xd0\xa7\x99\xa4\xa6\xc0\x03\xdd\x70\x97\x06\x8c\x08\xa0\x7f\x20\x2d\x07\x50\xd6\x1c\x42\x5b\x5e\x93\x47\x72\x90\x83\x11\x6b\xb9\x8b\xe0\xe0\xc3\xd9\xf3\xa8\x41\xb9\xfb\xe6\xae\x93\x14\x5f\x13\xf6\x3c\x03\x0d\x19\xd4\x55\x7f\xf0\x0f\xb8\x15\xf4\x9b\x03\x60\x88\xa5\xc7\xdd\x1d\xb8\xd0\xcb\x5a\xfb\xef\x65\x4a\x03\xc1\x9a\x08\xd1\x88\x71\x3a\xd5\x74\xb0\x1b\x34\x2b\x44\x9d\xd9\x9f\x7b\x1b\xc9\x1b\xa4\x43\x7c\x72\x9f\xe0\x3d\x96\x52\x9a\x14\x3a\x93\x07\xda\xea\x0c\xcf\xc6\x5e\x6a\x04\x0c\xa8\x86\x42\xcf\x40\xec\xf6\x12\xa9\x0c\x63\x3f\xc8\x2c\xe0\x01\xb9\x3c\x2a\x45\x1a\x22\x66\xce\xea\x4f\x7a\xa4\x40\x76\xf7\x56\xcd\x8b\x8a\xd1\x1f\x54\xe3\x1a\xaf\x40\x37\xae\x55\xfd\x63\x60\xe8\x56\x2d\xda\x93\xd4\x89\xfe\x2d\x9a\xa4\x9a\x2a\xac\x82\x5e\x0b\x13\xf4\x7a\x80\x4d\x6c\x83\x9e\xc0\xe4\xda\xd0\xdd\x0c\x42\x77\x07\x98\xd4\x5a\x30\xbb\xa6\x0a\x09\x5d\x48\x54\x2d\x65\xad\x05\xaa\x59\x78\x4e\x19\xc9\x81\x24\xd2\x74\x11\x58\x25\x99\x96\x46\xf1\xc3\x64\xd4\xeb\xc5\x86\x20\x14\x93\x18\xfc\x41\x41\x81\x20\x94\x8e\x17\xb6\x5d\x46\x21\xdb\x9a\x51\xc8\x79\x54\x1a\x35\xf1\x92\x41\x83\xbc\xc5\xda\x0a\x54\x32\xf2\x7e\x6c\x63\x8c\xb6\x80\x66\xc0\x68\x73\x8c\x38\x9d\xf7\x1b\xe1\xbd\x49\xee\x61\xc8\x9c\xcc\xbd\xc8\xd9\x40\xc1\xb8\xbc\xd4\xe4\xa9\x30\xda\x18\x47\x5c\x86\x6a\x52\x31\x24\xac\xdc\x21\x6f\xc2\xb6\xdd\x1c\xb3\x93\x29\xbc\x2b\xe1\x36\x33\x83\x27\xa5\x4a\xfb\x42\x77\x77\xd8\x70\x23\xd3\xd5\xf5\x51\x1c\xeb\xb3\xa9\x05\xe4\x0c\x48\xe2\x6a\x74\x20\x4d\x5e\x10\x0e\x21\x94\x61\x69\x0a\xdf\x37\x68\x66\x0c\x08\x0c\xfa\x85\x76\xba\x14\x7c\x82\x6a\x31\xd3\xe5\xb2\x17\xb0\xe5\xbd\x02\x93\x6e\x17\xe5\xa8\x20\x2e\x54\x01\x06\xd3\x4f\xf0\x19\x2b\x64\x4f\x20\x69\x45\x33\x92\x69\x4d\x6b\x03\x61\xe9\xd0\xed\x4e\x7f\x68\x0f\xf1\xab\xdd\xfa\x9c\xa7\xe0\xc9\x26\x7f\xd4\x33\xfa\x89\x60\x39\x38\xe4\xbb\x20\x8b\x8e\x3c\xd3\x25\x59\x19\xb5\x64\xb2\x30\xbc\xa6\x3b\xd6\xf7\x5e\xa0\x87\xfd\x90\xbd\x8f\xcd\x28\x22\xdb\xb4\x47\x85\x27\x3a\x34\x97\x26\xcf\x3f\x11\x49\x0e\x0e\x78\x2e\xc6\xe2\xe1\xe0\x7d\x14\x35\x1a\x10\x36\x97\x1c\x2f\xac\x39\x4a\x69\xd2\x6b\x75\x11\x87\x43\xf5\x5b\x86\xde\xee\x36\x65\x6b\xf6\x16\xd4\xd2\x0d\x92\x52\x34\x20\xf6\x69\x46\xa0\x30\x73\x78\x82\x4e\xa3\xbf\x94\x64\x4d\xe1\x31\x1a\x90\xd8\x5e\x5d\x18\x59\x46\xb3\xee\x25\x99\xeb\xdb\xba\x6c\x21\xc3\xf4\x05\xab\xe9\x29\x50\x6f\x9e\x9c\xdc\x33\xef\x5b\xfd\x42\x95\xe4\x2f\xde\x2f\x5b\x49\x9b\xe9\x09\xfc\xb9\x9a\xc4\x79\x2b\x99\xeb\x25\x47\xfa\x69\x31\xf8\x73\x82\x81\x84\x8d\x3f\xd1\xa1\x50\xc2\x33\x20\x65\xfc\x67\x0e\x35\xc8\x4e\xf4\xcb\xce\x5e\x8e\x14\x57\x76\xa5\x61\xfb\x93\x66\xf9\xa9\xde\x93\xae\x27\xdd\x28\xa8\x13\x21\xeb\xd7\x3a\x8a\x28\x91\x0b\x56\x92\x19\xad\xaa\x37\x0a\x4f\x42\x51\xef\x04\xcd\x2a\xab\x61\x27\x2c\x68\x17\x8c\xa6\x20\xa6\xae\x17\x27\xb7\xc0\x52\xc4\x2a\xa9\x63\x14\x53\xf9\xda\x92\x26\xd2\xe5\xd4\x21\xf4\x70\x0a\xc4\x6f\xdc\xb8\x90\xbd\x35\x49\xec\x5b\xa6\x38\x47\x7a\x35\xbc\xb4\x91\x71\xae\x95\x63\x75\x0f\x9f\x1a\x5e\xfc\x9a\x99\x17\xb5\x99\xc7\x30\xf3\x5a\x33\xc8\x3b\xcf\x30\xe4\x3d\x8c\xfc\xe8\x64\xc1\xbf\xa5\xd4\xfe\xbe\x95\x3d\x6b\x88\x93\x62\x5a\xc9\x3f\x2e\xcf\xd5\x82\xb4\x12\x83\x36\xc7\xf3\xca\xc6\x28\xd6\x87\xc8\x1f\x60\x0e\xf7\x66\x8a\xe2\x09\x9b\xea\x65\xb4\x99\x16\xfd\x1e\x0e\x96\xeb\x9d\x40\x41\x4b\x28\x54\x89\x60\xd0\x55\x47\x40\xdf\x8d\x12\xa9\xaa\x50\xb3\xca\xeb\x8e\xdc\x6a\x4d\xe4\xbe\x08\x2d\x53\xaa\x70\x2f\xb1\x39\x36\x06\x54\xda\x84\x12\x8e\x6d\x06\xa5\x24\x48\xbb\x5b\xe9\x72\xd0\xb8\x83\xca\x5f\x38\x95\x3f\x78\x7c\x74\x98\x4c\x03\xcd\xbf\xd0\x9a\x7f\xa1\x35\xff\xec\x68\xc8\x55\xad\xa0\x13\x4d\x91\x00\xe1\x24\x26\x85\x1a\xd0\x96\xb2\x1b\xa9\xbb\xcc\x8b\x9f\xa0\x1e\x2e\x8a\x7d\x32\x2f\x93\x64\x9e\x71\xf8\xd9\x00\xcc\xad\x2b\xcf\x58\x00\xa9\x97\xe1\xd1\x66\x8c\x32\xba\x69\xa1\xf3\xb8\x47\xe7\x71\xb2\xa9\xd0\x79\xb1\x47\xe7\xc5\x64\x53\xa3\xf3\x54\xb0\x54\x17\x86\x05\x98\x0c\x10\xb7\x48\xc2\x4f\x03\xc1\xc2\x29\xf9\x0d\x26\xde\xeb\xc0\xc0\x00\x44\xf6\xc8\x70\x12\x63\xfb\xfa\x8a\x3a\xa5\xb0\x4e\xb6\xb2\xe5\xc7\x30\xf4\x32\x32\x0c\x46\x75\x03\x05\xd6\x90\x37\x42\x2e\xc8\x37\xf6\x35\x6a\x1c\x2e\x49\xa8\x0c\x16\x67\xa6\x84\x2c\x80\x04\x1e\x15\x56\x49\x5a\x62\xb2\xbf\xd0\x36\x27\x65\x89\x6e\xc5\x71\x2d\x2a\x1c\x97\xd2\xb9\xe8\x09\x2d\xfa\x17\x65\xa9\x99\xc7\x2e\xcd\x0e\x87\xca\xe9\x54\x83\x5e\xb9\x95\xca\xa5\xe8\x66\x5c\x00\x04\xa6\xe3\x19\x4a\x89\x20\xf5\x30\xec\xe0\x7e\x16\x65\x63\x64\x27\xb1\xcd\x94\x0d\x0d\xc3\xc4\xce\x03\x47\x05\x04\xb0\x06\xf1\x4f\xac\x7f\x9f\x53\x4d\x30\x90\x98\xac\xf0\xe8\x3c\x0c\xcd\xda\x68\x6c\x7e\xae\x17\xa8\x40\xd7\xca\x9f\x6a\x57\xed\x43\x7a\xbf\x55\x6d\x7a\x7f\x3a\x9e\x4c\x3d\x4b\x81\xfb\xc0\xfa\x34\x2b\x7f\xd5\x5a\xf9\xab\x5a\xe5\xaf\xa6\x23\x5b\x8a\x5b\xaa\xdc\x1d\x8f\xca\xb9\x11\x74\x0b\x67\x50\x78\xb1\xeb\x80\x5e\x77\xa5\x21\x36\x26\xf7\x0e\x17\x26\x75\x14\x68\x18\x41\xb7\xf8\x99\x7a\x7d\xa5\xd0\xfc\xa9\x7e\xd0\xc4\x66\x4b\x28\x65\x87\x83\x18\x2b\x6c\x10\xc5\x56\x32\xf8\xf9\x06\xae\xfc\xbc\xf9\x12\xf5\xe4\xee\xc6\xc8\x14\x17\x86\x06\xf6\xf4\x53\xc0\xb0\xc8\x70\x9b\x40\xaa\x91\x25\x68\xbc\xce\x41\xe3\x05\x8f\x7b\xfc\x46\x44\x5b\x1b\x74\xe4\x9f\xe3\xca\x93\xcf\x35\x26\xd3\xb0\xa2\xdb\x94\xb4\xfb\x1c\x20\x61\x0b\x90\x70\x72\xf2\x35\xae\xa8\x38\x01\x34\xd4\xbb\x31\x00\x32\x6b\x28\xb8\xc2\xce\x58\xc2\xbd\xfe\x08\x8a\xcf\xb5\x7a\x5a\x92\x2c\xe9\x5a\x3d\xfc\x72\x46\xd7\xe6\xe1\x97\x0b\x3a\xbb\xf5\xc3\x2f\xfa\xd1\x8b\xd6\xf7\x5f\xce\x4c\x30\xfe\x33\xff\x11\x48\x4b\x9c\x56\x52\xf5\xfd\x7b\xee\xa7\xe9\x8b\xb8\x52\xce\x71\xc3\xd5\xb2\xd5\xc8\xfd\x4b\x2f\xeb\x36\x2f\xc0\x54\x6b\xd7\x67\xaf\xd3\xcb\x52\xbd\x67\xa3\xa7\xdc\x98\xec\x35\x13\xab\x2e\x81\x1c\xd1\x13\xaf\x29\x7f\x44\xf5\xb7\x45\x08\x58\x9f\xe9\x87\x0c\x20\x50\x8c\xee\x4f\x19\x9c\xe8\x1e\x0d\x8d\x02\x2e\xc2\x82\x9a\x40\x65\xcd\x47\x95\xb3\xfe\x31\x83\x81\xd2\x3e\x30\x2f\x8e\x96\xc1\xee\xc5\x85\xcc\xed\x22\x37\x33\x14\x65\x49\x2a\xeb\x15\xb5\xf2\x4d\x5a\x0e\x26\xe5\x62\x91\x9b\x66\x75\xa9\xe5\x45\xd5\x78\xe4\xe2\xda\x87\x69\xec\x3b\xa6\xf2\xa1\x0b\x33\x3c\x12\x3b\x15\x78\xe1\x5e\xbd\xd8\x56\x5f\xbd\x98\x53\xcf\x6d\xae\xb3\x05\xa3\x1b\xba\xd5\x44\xfb\xcb\x64\xb9\x12\x8e\x72\x17\x24\x21\x33\xbc\x6f\xbe\x77\x28\xf4\xed\x19\xc3\x23\x72\x73\x5a\x35\x0c\xdc\x92\x02\x93\x15\x6d\x5f\x3f\x0d\xe6\xfa\x6d\xa4\x68\xab\xfe\x4a\x73\x81\x22\xb2\x27\x23\x96\x8f\xa8\xcb\xa7\x60\x6a\x8f\xc2\x03\xcb\x89\x57\xf6\xcd\x18\x2a\x2c\x7f\x28\xb0\xc1\x52\x6b\xe0\x19\xc4\xed\xb0\x94\x20\x6b\xa0\xf1\x57\x93\xf5\x94\x8a\xc9\x7a\x7a\x2b\x42\x33\xa9\x10\x9a\x39\x4a\xb0\xa6\x7b\xcf\xfd\xf7\xc6\x96\x30\x8c\xe4\x76\xc3\x48\xc8\x12\x86\x71\x3e\x59\x4e\x29\xab\x81\x47\x32\x59\x4e\x49\x8a\xf6\xe0\xb5\xb8\x2c\xc9\x0a\x3b\x6f\x92\x73\x8b\x53\xeb\x75\xc8\x0a\x97\x64\x8e\x15\x97\x42\x29\x9d\x1f\x0e\x73\x0a\x21\xab\x0e\x07\x77\xe2\x93\xe2\x6f\x71\x9a\xcc\x4d\xa5\x39\x36\xb1\x06\xd1\x80\xd8\x70\x83\xf2\x11\x1e\x32\xaf\x3e\x0d\x72\xf1\x0b\xe5\x55\xce\x84\xc3\x3d\xf9\xa8\x38\xb1\x31\x18\xbd\xe5\x47\x6c\x48\x5a\x8d\x25\x6e\xb9\xcf\x0c\x16\x18\x24\xda\x54\x28\xb1\xb6\x34\x1b\x6d\x25\x0b\xf3\xa6\x8d\x03\xff\xa4\x02\x3a\x63\x6d\xda\xc4\x72\xc7\x31\x18\xb1\x26\x54\xb0\xd1\xea\x67\xd9\x94\x72\x9a\x61\xd1\xf8\x5a\x21\xe0\xe7\x61\x43\xd5\x7c\xc4\x49\xc6\xac\x75\xc9\x10\x62\x5f\xc7\x9c\xf5\xed\xac\x86\x20\x1f\x4c\xf0\xaf\xbf\x9f\x16\xe6\x3f\xc5\xa3\x84\x92\x6d\x63\xfd\xf3\xad\x10\x3c\x6b\x35\x29\xe9\x76\x11\xeb\xaf\x99\x88\x7f\x60\x3b\xe0\x91\xe3\x54\xe8\x5f\x33\x91\xa7\xfa\xa7\xe4\x72\x7f\x60\x7e\x88\xe2\xa4\x02\x05\x1f\x6f\x6d\xef\x45\x50\xb1\x6d\x17\xfe\xca\x09\xa5\xc2\x91\xd7\x9e\x09\x1d\xa7\xbc\xc1\xed\xd4\x0f\x07\x78\xd8\x6b\xec\x98\x4e\x66\xad\x53\xa0\x46\xc4\xb5\x91\x4a\x5e\x46\xac\x15\xd4\x67\x9f\x01\x01\x0d\xe6\x7f\xc3\xda\x7b\x8a\x27\xe0\x04\x85\xc0\x54\xc3\xbe\x6d\x50\xe1\xb1\x06\x40\x0a\xcf\x9b\x14\x32\x59\xd2\xf3\xfe\x39\xe7\x29\x39\xa3\xe7\x8e\x2c\x3e\xd7\xbe\x34\x64\x47\xcf\x15\xd5\x7c\x45\xcf\xbd\xd7\xd8\x2f\xe9\xfc\x16\x84\xf3\x8f\x49\xf6\x3e\x20\xb3\x0a\x15\xa9\x69\xa8\xb5\xa6\xf4\x5e\xc1\x93\xed\xa5\x4f\x53\x0b\x1e\x5d\xa1\xc9\x05\x39\x9b\x62\xbd\x77\x67\xca\x97\xff\x42\xef\xdd\x99\xf4\xe5\x87\x98\xc0\xbb\xd4\x7d\x49\xc2\x5d\xd2\xeb\x17\x84\x67\xe9\x4e\xe1\xbc\x17\x99\x14\x1a\x57\xc8\x64\xc2\xb3\x27\x69\x32\x7b\x1f\xed\x88\x88\xf3\x25\x13\xd1\xc5\x6d\x28\xe7\x66\xa3\xdd\x21\x29\xc4\x2e\x65\xd1\xbe\xb4\xef\x17\xaa\x96\x7d\xa0\x01\xea\xc4\x11\x7f\xba\xef\x30\x6c\xa6\x41\xac\xa0\x2e\x33\xcb\x7a\x0a\x06\x83\x99\x60\x73\x10\xa6\x40\x7d\xbd\x8c\x7a\xdd\xbc\xcb\xda\x13\x72\xc3\x65\xdd\x05\xe3\x24\x50\x7f\xc2\xbf\x5d\xaf\x1b\x35\x59\x0c\xd1\x02\x37\xaa\x71\x3d\x63\xad\x32\x6b\x90\x99\x82\xcb\xa3\xf6\xb3\x7e\x5c\x4e\x1f\xba\xc4\x1c\x3a\x32\xa3\x05\xca\x8c\x5b\x28\x57\x9b\x94\xeb\x4d\x4a\x80\x62\x68\x0e\x5b\x09\xfb\x66\xb8\xbc\x9e\xe4\xd5\x83\x10\xe0\x07\xc7\xe5\xeb\x47\xe6\xe4\x33\x75\xf2\x53\x13\x07\x82\xc4\xfa\xe1\x23\x0f\x02\xc8\xd6\xa6\x49\x18\x21\x1b\xca\xfa\x8d\xed\x23\x0b\x29\x8b\x9b\x04\x82\x07\x24\x90\xed\x07\xea\x61\xa6\x80\x04\xb2\xed\x80\x04\xb5\x96\x6d\x8a\x6c\x37\x20\x41\xa3\xd5\x60\x8a\xc9\x8a\xb6\xcc\x1c\x48\xcb\x95\x47\x4e\x1b\xd3\x95\x4e\xfd\x20\x19\x82\x2c\x88\x03\xb2\x50\x3b\xb3\xa6\x05\x72\xfe\xb7\xfa\xe5\x07\xbd\xd0\x69\x89\x47\x8b\xfe\x2a\x67\x0b\xba\xd2\x2d\x80\xab\xaa\xf4\xe6\x88\x8d\xf3\xcf\x36\x0c\xbb\x09\x3c\x1d\x0d\xf2\x46\x43\x0f\xa0\x35\xd9\x00\x8e\x01\x53\xa9\x45\x7f\x66\xe6\x38\xf6\x7e\xf7\x68\xd0\x09\x7a\x71\xe4\x25\xd1\x18\x13\xe0\x1b\x16\x7d\x09\xfb\x74\x06\x94\x83\xfe\x20\x5b\xcf\xd0\xfd\xba\x79\xa9\x4a\x64\xaf\x21\x5f\x71\x47\xde\x01\x2a\xeb\xaf\xd1\x5d\xfe\x36\x14\x58\xdb\x8d\x90\x7f\x06\x37\x42\xaa\x6e\x84\x98\x42\xa0\x09\x15\x82\xeb\xe4\x1b\xa3\x69\x26\x33\x1a\xd7\xf6\xa2\x0d\x59\x4b\xf0\x55\x18\xbb\x79\x34\x75\x57\xf1\x91\x3d\xb5\xba\x75\x92\xc3\xce\x7a\x47\xb8\x0d\x6d\x0e\x1a\xdb\xfb\x31\x2f\xe6\x7c\xc4\xf6\xb6\x06\xc4\x60\x7d\x6f\x19\x80\xee\xd1\x91\x68\xac\x99\x41\xf5\x15\x0e\x8f\x1c\x09\x43\xd1\xbf\x4c\xc4\xea\x25\x5b\x10\x4e\xb7\xb5\xb5\x69\x5b\x63\x78\xdc\xc0\x3c\x23\xdc\x7a\x2d\x2e\x8e\x5e\x8b\xed\x05\xe0\xf1\x85\x3c\xde\x6c\xd8\xfc\x7b\x1d\x1b\xad\x65\xd7\x32\xef\xb2\xf0\xac\x20\xe0\xb2\xb0\xcf\xa9\x7a\x2d\x1c\x43\xcd\xea\x7e\x20\xdc\xc3\xd0\x7a\x30\x87\x43\x0b\xc2\x23\x60\x26\x5b\x03\x06\x3d\x09\x5e\x3a\x62\x35\x0c\x51\xde\x07\xec\x65\x7b\x63\x78\x2f\xea\x63\xa2\xe0\xe9\xb7\x3d\x02\x7d\x60\xce\x51\xba\x26\xb9\xbf\xa1\x34\xb8\xb4\x6b\x8e\x82\x1e\x78\x86\xf6\x02\x1c\x10\xde\xd7\xeb\x66\xb7\x99\x32\x52\x15\x65\x70\xc2\x3e\x67\x76\xa8\xcd\xa5\xe4\x6b\x0f\x27\x48\x8c\xe1\x7b\xd4\xdf\xab\xfa\x91\x0c\x1f\x0c\x6e\x21\x7e\xaf\x1d\x4a\xcd\xe2\x34\xa5\x87\xdd\x41\x15\xe0\xbb\x03\xa2\xdb\x83\x39\xa9\x6f\xef\xa4\x74\x07\x0d\x8a\xac\x3b\x20\xeb\xe4\x2a\xc9\x64\x59\x77\x30\xe0\x51\x93\xdd\x86\x01\x56\x21\x9c\xee\x33\x5d\x5b\x2d\xb7\x2e\xaa\xb0\x2a\x7c\x00\x26\x65\x39\xfc\xb2\xab\xaf\x3e\x12\xb1\x93\x4d\xb4\x8a\xfd\xf5\x6e\x2c\x99\xf0\xf6\x40\xe9\x02\x0a\x6f\x89\xfc\x75\x21\xa9\xbc\xeb\x9b\x1e\x72\xc6\x42\xa0\xb5\x4d\x98\x3b\xa8\x16\x47\x10\x40\x26\x86\xd8\x96\x4a\xfb\x7b\xdd\x00\x40\x26\x86\x2d\xfc\x24\x74\x30\x4a\x1e\x1a\xeb\x01\xe9\xfe\x07\x06\x25\x28\x9b\xc4\x93\x64\x3a\x3d\x1c\xb8\xf9\x91\x86\x61\xaa\x7e\x63\xf9\x46\x07\x53\x1f\x54\xa8\xbf\x4e\xb1\xec\x45\xe2\xf9\xad\x6e\x5c\x09\x9b\x29\xe5\x08\xa2\xe6\x18\xfe\xc6\x05\xbc\x02\x40\xae\x40\xf2\xc9\xbd\x7b\x15\x58\x1e\x3e\xb8\x0f\xb0\x9c\xb6\xf0\x3b\x0b\xba\x31\xbc\xcd\x8a\x6e\x0c\xc7\xb3\xa6\xe9\x2d\xd0\xb7\xbc\x22\x5f\xb2\x79\x92\x83\x80\x50\x12\x60\xc9\xac\x88\xb4\x50\x5d\x22\x99\x6f\x73\xbe\x96\x32\x4b\x8d\x9c\x1c\x12\x95\x87\x05\xdc\x1c\x35\xbb\x2f\x8b\x37\x6e\x8d\xd6\x46\xc0\x7c\xb8\xac\x33\x49\x0b\x9f\xa5\x91\xe4\x6b\xb4\xd2\x34\xe1\x8a\x68\x3f\x92\x68\xde\x5f\xc4\x69\xb1\x73\xcf\xd3\xeb\x84\x36\x1c\xdf\xb8\x1d\xaa\x77\xf4\xfa\x53\x92\x60\x4d\x80\xa8\xe9\x8f\x40\x23\x64\xcc\x1f\xb5\x0e\xe9\x3e\xc0\x42\x1b\x08\x6c\xe8\xdc\x80\xc0\x82\xce\x0d\x08\xac\x6e\x05\x02\x1f\xbd\xfb\xe6\x0e\x95\xab\x7c\x4d\x15\xec\xb9\x96\x88\x3e\xc4\x88\x96\x20\x03\x02\x0d\xaa\xbe\x31\xb1\x7e\x42\xde\xa5\xe9\xbc\x5d\x3c\xc3\x47\xb0\x82\xd4\x8a\x00\xe3\x16\xe7\x0c\x5d\x44\x5f\x70\xa7\xb3\xc7\xd2\x48\x72\x06\x42\xd6\x75\x2c\xac\xf5\x0a\x14\x22\x39\x76\x82\xf6\xbe\xd0\x56\x08\xb1\xd1\xd6\x38\x9f\x72\x01\x7b\xb2\x02\x34\x25\xa7\xa6\x1b\x71\xc6\x93\xf1\x9d\x21\x6c\x57\x62\x3d\x48\xef\xbe\xbb\xfb\xe5\x17\x77\xc1\xb8\x1b\xf7\xa0\xa7\x51\xfb\x20\x0a\xb8\xe0\xe5\x08\x52\xca\xad\xc5\xc0\x28\x43\x4e\xce\x93\xea\x23\xa0\xd9\xd5\xc3\x81\x6b\x61\x91\x8e\x3e\x68\xa2\x06\x72\xf5\x03\xcc\x9c\x44\x49\x6a\x23\xf5\xb6\xab\xea\x9c\x23\x5f\x08\x14\x23\xfe\x88\x0e\x46\xfc\xce\x1d\x63\x86\x01\xb4\x38\x01\x6f\x40\xdf\x8a\x28\xa3\x69\xeb\xfc\x32\xf9\xa8\xaa\x73\xe1\x0b\xee\x06\x18\x9f\xe7\x2c\x7e\xaf\xf1\x2b\xd8\x47\x64\xd5\x63\x0e\xed\x46\x1b\x02\xbb\x1e\x6d\x88\xe0\xd1\xa6\x79\xe8\x17\xfa\xd0\x2f\xec\xa1\xdf\xd6\x0f\xfd\xf6\xda\x43\x9f\x5c\x73\xe8\x57\xbf\xaf\x43\x7f\xfd\x2d\x20\xd5\xc2\xd7\x9f\x7d\x25\xcb\xda\xdc\xea\xa8\x3b\x3b\xc1\x7f\x0d\xaa\x97\x90\x7d\xc3\xc9\xaf\xa1\x79\xb9\xff\x76\x3b\x0d\xa5\x1a\x6d\x9d\x4a\xcf\x7f\xd5\xdd\x4b\x2e\x88\x6f\x28\x1d\xcd\x2b\x9f\x45\x34\xff\x08\xfc\xbf\xf9\x4c\xf0\xff\x7d\xa0\x04\x5a\xb6\x9e\xcc\xe9\xd6\xa0\xfd\x0d\xdd\x2a\x48\x58\xdc\x0a\x12\x3e\x04\x08\xae\x45\xee\xcd\xcd\xf5\xf4\xfc\xd1\xac\x7d\x5b\x67\x47\xb7\x75\x53\xdb\xd6\xcd\x47\x9c\xf0\xc5\x27\xde\x56\xa0\xec\x52\x38\xd3\xb9\xa2\xec\xc0\x2f\x35\xa6\xfb\x0a\xb3\x60\xb5\xc9\xa9\x51\x7d\x97\x6e\x81\xfe\x9e\xa4\xe9\x73\xbe\xf5\xcf\x1f\xde\x4b\x7e\x54\x97\xad\x4a\xe3\x4c\x03\xe5\x87\x07\xeb\xff\xad\xd6\x00\x57\x24\x39\x55\xae\x2d\x6e\x02\xb6\x21\x60\xb6\xc7\x56\x6d\xe6\x5f\x1a\xf2\xca\x8e\x66\x15\x48\xb4\x69\x76\x51\x9f\x26\xf3\xf6\x35\x95\x45\x73\x58\x73\x50\x37\xb2\xa3\x92\x85\x51\x5d\x98\xab\x68\x80\x36\x49\xc1\xe8\x78\x2b\x44\x96\x3e\xdb\x66\xc7\xf4\x9c\xad\x7b\x7b\x54\x2b\x0a\xc0\xdc\x32\x09\x5c\x83\xa6\x37\xd9\xba\x75\xee\xd7\x8c\x23\x0c\x6f\x2a\x81\x70\x05\xe4\xb6\xbf\x5f\x90\xbb\x06\xc4\x0a\xba\x6f\x80\x4d\xe2\x41\x57\xab\xdd\x50\x5b\xb1\x6b\xcc\x82\xb4\xba\x45\xd5\xaa\xc3\x4f\x79\xd3\xc1\xaf\x2c\x72\xf1\xdb\x2c\xf2\xef\x4b\xcf\xdb\x90\xda\xd5\x65\x50\xb7\x7f\x73\x9f\x64\xce\xa6\x88\xd3\x1c\x09\x32\x09\xe4\xbe\x14\xa0\xe6\x68\x78\x55\x61\xc4\x01\x7e\x64\x10\x29\x9b\x0a\x06\xdd\x66\xc4\x52\x1e\x98\x92\xe4\x33\x0f\x52\x79\x32\xbc\x5f\xa1\x3b\x4f\x86\x7f\xae\x49\x1f\xa4\xd7\xfc\xfc\x13\xf8\x78\xdd\x8e\x7a\x3a\x39\xf9\xaa\x4a\x3d\x3d\x38\x71\x2f\xe4\xdc\x96\x20\x4a\xb2\xa5\x3e\xbd\xc1\x4d\x67\xb2\x85\x2a\xd1\xb3\xa9\x77\xa6\xe9\x24\x64\x29\x16\x4f\x7c\x0c\x84\xcb\x27\x56\x1a\x7c\xb2\xd3\xed\x3a\xae\xda\x45\xea\xc3\x99\xfa\x4c\x7f\xa1\xf5\x9a\xba\x5c\x40\xcc\x99\x25\x81\x29\x13\x4c\xf1\x28\x3b\x1c\x52\xef\xe2\xb5\x86\x61\xf2\xfa\x26\x19\xcd\xc6\x19\x5c\xc8\x56\x8d\x87\x91\x76\xcb\xdc\xc2\xb9\x5f\xb8\x73\x9f\x81\xdc\x7c\x55\x73\x97\xe2\x18\x04\x70\x5a\xe4\x90\x8e\x21\xb6\x4e\x2d\xb0\x60\x8a\xa3\x0d\x35\x76\x9a\x15\x9b\xae\x94\x42\x28\x34\x98\xee\x39\x74\xb5\x6e\xb1\xec\xc1\x28\x23\x5b\x3c\xca\xea\xf9\xce\xc4\x47\x95\x20\x5b\xe5\x8d\x83\x2a\x6e\x02\xd2\xff\x0c\xf4\x06\x3c\x0c\xcf\xeb\xe3\xe2\x24\xb1\x8f\x3d\x92\x1c\x9c\x87\x01\x80\x7d\x8b\x50\xad\xc5\x38\x57\xc1\xa9\xcc\x15\xe6\x15\x70\xcf\xad\x3d\x8f\xb3\x78\x09\x2c\xb9\xad\x04\x40\x4c\x36\x61\xb8\x41\xb8\xfc\x6c\xd5\x0b\xfa\x95\x9f\x81\x41\x1f\x5f\x57\x43\x53\xdd\x3f\xa9\x58\xa7\x48\xfc\x68\x43\xf4\x6a\x66\x6d\x0d\x19\x27\x7f\xfe\x04\x38\xd2\x56\xc9\x2b\x52\x39\xef\xce\x62\x98\x64\x4e\xb4\x66\x9b\x15\x25\xe1\x50\xd2\x12\x48\x18\x55\x6f\xc0\x0c\xfb\xd2\x3b\xde\x3f\x3b\xbb\x38\x39\x03\xdc\x18\x8b\xe4\x3c\x65\x67\x67\x10\x1f\x83\xd7\x37\x9d\xb8\x15\xc8\xb5\x7f\xa7\xbe\x63\x3c\x2e\xe0\xe4\xbe\xf5\x1c\x56\x77\xce\xfd\xfb\xe6\xce\x19\x7d\x82\x55\xd3\x55\xda\x1f\x05\x90\x9a\x8f\xee\x95\x67\xec\xb0\x0b\xc3\xc4\x3d\x6a\x6d\x2c\x3d\xe6\x7c\x26\x15\x60\x20\x30\xd4\x88\xbf\xf8\xcb\xee\x75\xbc\x84\x4b\x06\x05\xe7\x71\xc1\x02\x0c\xc1\xc2\x05\x84\x59\x91\xbe\x9b\x8f\x85\xc8\x93\x73\x20\xe5\x03\x30\x79\x08\xb0\x8c\x76\xd8\xa5\x20\x57\xdf\x51\x81\xcb\x2b\xda\x1d\x78\x83\xad\x38\x60\x20\x4c\x76\x61\x68\x1e\x2b\x80\xe6\x41\x5b\x04\xde\x9e\xd2\x34\xce\xc8\x12\x2d\x1e\xdf\xe1\xb1\xb6\x3d\x85\x64\xbf\x84\x7b\xc6\x60\xa7\x4f\x1e\x26\xae\x45\xba\x53\xd1\x17\x5c\x05\xd0\xd2\xd9\x0f\x08\x5c\x80\x71\xe4\x95\x97\x01\x9d\x9b\xcf\x03\x0b\x84\x49\x77\x77\xe3\x5b\x06\xb5\xe7\x58\x46\xe6\x9e\x30\xfd\x11\x0e\x3d\x52\x4a\x77\x3a\x3a\xf1\x9d\x21\x1e\xef\xa2\x5d\x0f\x1e\x5b\xc9\x75\x5e\xe6\x24\xc1\x36\x8a\xf1\x10\x47\x19\xb0\x05\xbd\x7c\x54\xf5\x15\x77\x62\xd7\xb8\xc4\xed\x90\x71\x51\x61\xc2\x1c\x7a\x17\xf5\x07\x0a\x20\xb2\x4d\xed\x81\x82\xb8\xa5\x1d\xaf\x05\x78\xa0\x00\xfe\x54\xea\xc8\xa7\x71\x2f\x94\x79\x11\xac\x9f\x97\x35\x57\x59\x46\x26\x5b\xcb\x5d\x54\x3a\xf3\x9e\x23\xa8\x95\x5b\xb5\x94\x93\x86\x36\xb5\x72\xeb\x2a\x96\x3e\x1a\x89\x4c\x3c\x1a\x8e\x85\xff\xec\x80\x38\xfa\xec\x80\xee\x35\x43\x17\xb5\xbb\xca\xb8\x0e\x92\x09\x0c\xc2\xba\xfa\xe6\xb5\xf7\x7e\xaf\x7b\x41\xd5\x07\x1e\x01\x11\x06\x51\xea\x07\xfd\xf7\x1b\x5a\x7e\x50\x43\xf3\x46\x43\x00\x97\x67\x8d\x05\xf9\x00\x6e\xe4\x82\x32\xf5\xd0\xe2\x99\x3d\x3a\xe4\x8a\xba\x97\xea\x24\x74\x5e\xd4\xc2\xfc\xe7\x26\x3e\x64\x5c\x7f\xf6\xd4\xb8\xd2\xc0\x90\xa3\x85\xfe\x80\x2d\x8d\x56\xa4\xba\xce\xd1\xda\x0b\xf3\x6f\x2d\x04\xd0\xf9\x2f\x08\xf3\xef\x5a\x59\xfe\x0b\xc3\xfc\x7f\xb6\x0f\x18\x9d\x78\x0f\x18\xb5\xc7\xfb\x1f\xde\x33\xd6\x09\x9f\x36\xf8\xa0\xab\x72\xdc\xad\xbf\xa3\x8d\x13\xcb\x23\x81\xf9\x4d\x29\xfd\xba\xbb\x35\xa8\x54\xd1\xec\x5d\x77\xa9\x7f\xaf\x32\x98\x0d\xde\x2f\xa5\x13\x83\x2b\x23\xbc\x6e\x21\xcf\xe5\x18\x09\x00\xa3\xeb\xc9\x39\x3c\x25\xe6\x6e\x1c\xc2\xfd\xfb\x21\x77\xaf\x72\xa7\x14\x65\xa0\xc8\xc2\x3d\xde\x43\xb9\xfc\x65\xc3\x67\x92\xc2\xd0\xf3\xf2\x51\xe9\x31\x4a\xa8\xfc\x41\x0a\x2a\xc0\x0b\x26\x42\x09\xdd\x68\x0c\xf5\x03\xdb\x21\xb9\xa3\xe0\xd1\x2d\x4b\xd1\x04\x6b\x03\xeb\xad\x87\x29\x52\x4b\x2d\x6d\xfa\xd5\x33\xa7\x82\x7d\xcf\xcc\xf3\x61\x45\x89\x89\x1e\x46\xe2\xad\x10\x77\x24\xdc\x79\xcf\x9a\x81\x8b\x47\x74\x10\x86\xe2\xe1\x5a\xc3\xb6\x2b\x9f\xea\xab\x55\xfd\xdb\xe5\x2e\x4a\xd8\xe8\xbc\x47\xcd\x8b\xe5\x10\x44\x66\xd3\x77\x54\xfc\x6b\xae\x46\x23\xc8\x5e\x3f\x23\x36\x87\x47\xcc\x4a\xec\xbf\xad\x0f\x21\x87\xf7\xc5\x65\x02\xaf\x7c\x0a\xfd\x10\x3c\xde\xcf\xe2\x82\x75\xe6\xf2\xb1\xf6\xe8\xbc\x47\x87\xe4\xdc\x8e\x2a\x0c\xd7\xf2\x1d\x86\x19\x83\xe7\x64\xd7\xc6\x0f\x9e\x30\x24\xc1\x82\x68\xa8\xc0\x23\xa9\x24\x1c\xe9\x96\x34\x6b\x12\xc1\x9e\x52\xd1\x28\xac\x1e\xdc\x2b\xdc\x11\xfe\x60\x34\x3b\xaa\x7a\xf5\x17\x78\x5c\xd0\x3d\xcb\x44\x9e\xb0\x22\x2a\xca\xa8\x81\xff\x21\xfe\x9d\x57\x64\x52\x4c\x35\xf7\xb6\xa1\x96\x4b\x54\xeb\x57\x90\x7d\xf3\x29\xed\x28\x23\xf5\x87\xb4\xa3\x84\xd8\x67\xb4\x23\x06\xaf\x53\xa7\xc0\x35\xad\x68\x41\xd6\x74\xd5\xd7\x3d\x91\x73\xb0\x78\x55\x8d\x35\x69\xa3\xf5\x78\x4d\x27\xeb\x69\xed\x8d\x86\x35\x04\x95\x5e\xd3\x49\x70\x17\x24\x58\x6b\xba\x6e\xbc\xd0\x00\xcb\x27\xaa\x70\xac\xc1\xaa\xd1\x05\xab\xf8\x19\x80\x87\x96\x28\x23\xe7\x3e\x66\x4a\x85\x21\x1b\x1b\x1f\x1e\x55\x48\xc7\x49\xb1\x2c\x37\x70\xdc\xa5\xf1\xd6\x3a\x1f\x83\xcf\xaa\x09\x6f\x16\x9d\x4b\x58\x76\x50\x63\x58\xf6\x4a\x65\xb9\xdc\x4b\x9a\xa3\xb5\x3b\x4f\x9f\x35\x6f\x59\x7b\x07\x06\x38\xcc\x2a\x8b\x09\xf7\xc4\xdc\x30\xa1\xe0\x02\x71\x32\xf8\xda\x30\x99\x9f\x9a\xa5\xd4\x55\x4c\x22\xb2\xb2\x9b\xeb\x78\x47\x86\xc1\xbb\xd1\xec\x5e\xd6\xce\x3b\x66\xe5\x2f\x65\x1e\x7f\x1b\x66\xf1\xdf\xc9\x8e\xfa\xab\x0a\xdf\xad\x65\xbb\x33\x1f\xc8\xec\x44\xdc\x3c\x9a\xaf\x86\x12\xa1\x09\x7f\x29\x5c\x18\x8c\xb2\x87\x6c\x94\xf5\x7a\x58\x4c\x32\x9f\xdc\xcf\xa6\x36\xf4\xd9\x91\xb7\x63\x20\xf0\x2f\xe8\x78\x2b\x5e\xe9\xee\xa5\x98\xbf\x70\x9e\xb2\x38\x83\xd1\xdf\xae\x09\xab\x3f\x6e\x69\x63\xe6\xcf\xed\x83\x5f\x43\x4d\xab\x42\xdd\xea\x2d\xa3\x87\x62\xdb\xf7\xc3\x08\x25\xed\x1e\xc9\x3e\xed\x04\xd2\xbb\x0c\x97\x70\xff\x41\xa4\x95\xb2\x6c\x34\xe8\xa6\xcb\xdb\x9b\xf3\x7b\x84\x2f\x86\x4b\x77\x3c\xaf\x37\x70\x67\x64\x5f\xc9\x8f\x66\xa8\xe6\xb8\x8b\x4b\x60\xc2\x4a\xf2\x09\x8f\x5b\x86\x4e\xee\x7f\x53\x95\xfb\xdf\x7f\x60\xe4\xfe\x1e\xf8\x56\x0d\x04\xac\x44\x1e\xff\xe6\xa8\xb3\x4a\xec\xe2\xbd\xb4\x4a\x85\x67\xf0\x2f\x93\x6c\xce\x2f\xad\x7a\x57\x9b\x72\xed\x4b\x6d\xa3\x0a\x51\x5f\xe8\x5e\x51\x3e\xf0\x36\x1e\x18\xc5\xcb\x1a\x92\xc4\x84\xe3\xc6\xe4\x2b\x57\x40\x54\xd9\xa8\xd3\x7c\x9c\xcb\x80\x4c\xf1\x5c\x92\x19\x88\xe3\x08\xe5\xd2\x3b\x9e\x70\x6a\x18\x6d\x45\xc6\x2e\xc3\xb0\x36\x02\x9f\x61\x43\x46\x28\x02\x77\x3b\xd7\xd7\xb8\x16\xc3\xc4\x74\x56\x61\x8b\x0d\x90\xd6\x19\x79\xd5\x46\x6c\x88\xdd\xdc\x11\xbb\x7e\xcc\x68\x81\xaa\xaf\xda\xc0\xbd\x62\x23\xc0\x2a\xfa\x2f\x0c\x39\x02\x92\x50\x7e\x68\x9e\x1b\x70\x89\x4f\xcb\x9a\x41\xcc\xfb\xf1\x7c\xfe\x0c\xfc\xc0\x7e\x94\x1c\x32\xcb\x91\x9a\x26\x09\x36\x7c\xa3\x3d\xa0\x32\xec\x76\x1c\xef\x65\xa4\x52\x7e\xc1\x6e\xae\xe5\x6d\xac\x47\x56\x79\x1c\x48\x4d\x42\x65\x39\x90\xfc\xb8\x8b\x17\xcf\x88\x66\x39\xc0\xce\x2e\x96\x21\x41\x4f\x5f\x9c\xe2\xfd\xa6\x6f\xc9\x45\x94\x98\xc8\xc6\x33\x8a\x84\x64\x60\x7a\x59\x8f\xf7\x72\xb2\xa5\xd2\x89\x3e\x91\xd1\x05\x62\x2a\x2b\xbf\x79\xf5\x9d\x64\x04\xce\x8c\x90\x4d\xef\xb3\xd1\x93\x28\xbf\xaa\x19\xe9\x0e\x47\x35\x08\xb0\x8c\x3f\xc4\xf7\x02\xa0\x99\x61\x1b\x77\xf7\x68\x6b\x1a\x6c\xc0\x4a\xb9\xd9\x62\x05\xa6\x5c\xa3\xde\x42\x72\x58\xc8\x21\xa5\xb4\xd7\xdb\xc9\x67\x0f\x05\xba\xb0\xf2\xbe\x9a\xc0\xcd\x89\xa1\x4d\x75\x84\xf7\x19\xc2\xd2\x08\xf1\xce\x9d\x5d\x18\x5e\x21\x7f\x93\xf2\xdb\xb4\xfd\xc1\xad\xc6\xad\xad\xc2\x73\x51\x6c\x09\x2d\xe6\x8e\xe6\x87\x18\xe4\x95\x67\xe8\x25\x93\x76\xd1\xdf\x66\x47\xcb\x56\x7b\x85\x3d\x5f\xff\x12\xbe\x67\xeb\x24\xd4\x86\xc8\x4e\x1a\x44\x36\xd0\xe6\x0b\x9e\xcf\xd8\x4b\xb6\xc8\x59\xb1\x22\x4b\x30\x26\x56\xcf\xc0\x17\x5a\xf5\x84\x30\x39\xa3\xdd\xe5\xe1\x70\x4e\x2e\xa8\xf5\xce\x54\x67\x7c\xdd\xca\x02\xb1\x26\x0b\x94\x79\x2c\x90\x07\xdf\xc0\x07\xed\x28\xbc\xaa\xa2\xf1\xd8\x75\xb2\x2f\xfb\xc4\x65\x4e\xda\x17\x31\x8a\xc9\xb1\xf5\x8d\x16\x25\xfe\x1c\x65\x4c\xff\x97\xbd\x6b\x61\x6e\xdb\x46\xfe\x5f\x05\xc1\x3f\xf1\x9f\xbc\x52\xac\x9c\xd4\x97\x0e\x75\xb4\x27\x89\xdb\x39\xe7\xda\x34\xe7\x24\x93\xbb\xf1\x78\x24\x50\x84\x2c\xda\x14\xc0\x02\xa4\x2d\x57\xe6\x77\xbf\x59\x3c\x48\xea\x65\x52\x9d\xa4\x49\x3b\x1e\xcf\x58\x24\xb0\x58\x00\x0b\x60\xf1\xdb\x25\x1e\x5b\x8f\x18\x52\x96\xc2\xd8\xda\x0e\x85\xb2\x1d\x9e\x5a\x23\xe2\x99\x35\x22\xbe\x33\x87\x54\xf5\x0f\xec\x06\xea\x3f\xde\xdd\xb4\x1d\xe2\xaf\x1b\xaa\xcb\xbd\x80\x28\x57\xac\xba\x87\xc5\x24\x01\xc3\xc2\x71\x5d\x2f\x2f\x1b\xa8\xe0\xa0\x81\x0a\x1e\x6d\x3c\xe4\x44\x6b\xbb\xbb\xbb\x47\x46\x49\xd9\x4f\x35\xeb\x21\x2b\xb0\xe7\x4b\xda\x17\xe0\x57\xfc\x8c\x80\x67\xa6\x4a\xe2\x19\x12\x6f\x38\xbc\xa1\x51\x46\xc6\x57\x43\xa1\x57\x3f\x0d\x87\x2b\x15\x33\xdd\x58\x0b\xd7\x1a\x21\x8e\x4d\x8f\xeb\x2a\x60\x6f\x71\x0d\xc7\x77\x07\xb9\x28\xe0\xd3\x77\x25\x91\x61\x02\x0b\xba\x79\x66\x16\x4e\xd9\xfd\xda\x3c\xba\xac\x04\xc5\xa3\xcb\xbd\x3d\x1e\x5d\x36\x85\xc5\xa3\xcb\x5a\x5c\x3c\xba\xd4\xd0\x48\xad\x55\x0e\xb7\xb0\xdc\x50\x1b\x58\x15\x66\xc5\xe0\xbd\x25\x17\x14\x60\xc2\x4e\xe9\xbf\xff\xae\xc1\xe1\x03\x4b\xe6\xef\x93\x19\xdd\x89\xc3\xf3\x67\x0d\x0e\x6a\x8f\xb1\xdc\xad\x04\xfd\x46\xfa\xf1\x7c\xb7\xb4\x4f\xeb\xb4\x03\xb5\xff\x19\xbd\x04\xbc\x49\x25\xa2\xf3\x9c\xb2\x58\x6a\x91\xfa\x95\x05\xb5\x68\x1c\xd3\xe6\xf8\xbe\x4f\xc4\x85\x74\x17\xb2\xc8\x68\xfd\xaa\x77\xc5\x2b\x74\x05\x97\xa8\x5c\xd0\x60\xdf\x1b\xab\xe5\x86\x7d\x2f\xd2\xec\x83\xb3\xf3\xb2\x9c\x50\x70\x24\x2a\x74\xd2\xdc\xc4\x5f\x88\xd4\x36\xbd\x21\x19\x3d\x5e\x2c\xc7\x97\x47\xc0\x36\x34\xc1\x2a\x27\x1f\x42\xca\x91\xeb\xe7\x53\xca\x1c\x41\x65\x16\x1e\xc2\x7f\xff\x52\x02\x30\x30\xe1\x31\xc9\x49\x78\x68\x92\xd1\x5c\xcd\x37\xce\x42\x17\x0e\xe2\x7c\xf5\x58\x95\x52\x05\x99\x17\x75\x13\xc4\xfa\x2a\x20\xbb\x6a\xd4\x54\xa6\x2c\xb2\x58\xdd\xab\x7c\x41\x1d\x28\x91\x89\xad\xf3\x82\xc0\x00\xfe\x99\x6d\xa3\x2a\x9d\x5b\x66\x82\x5f\x08\x2a\xa5\xa3\x72\x53\x32\x51\x4f\x7e\xce\x73\x92\x86\x61\xbf\x1a\x0d\xd5\xed\x04\x3f\x93\x7c\xea\x4f\x52\xce\x85\xa3\x53\xf9\xb2\x18\x8f\x29\x8d\x69\xfc\x8d\x7e\x9f\x90\x24\xa5\xb1\xfb\xb7\xfd\x7e\xff\xdb\x06\x37\x70\x07\x80\x79\x5d\x7f\xf6\xd7\x8d\xbc\xa4\xe6\x1c\x1c\x27\xd7\xd8\x5b\x54\xbb\xe2\x83\xf1\xdc\xd1\xdd\xd3\xcf\x08\xa3\xa9\xd7\x7c\x31\xbd\xcc\x2d\xbd\x4e\xac\x9a\x49\xff\x49\x49\x9c\xb0\x8b\xd2\xc3\xa6\xeb\x61\x77\x77\x26\x2f\x79\x7c\xbb\x25\xef\x0c\x6b\xa0\xd9\xe8\x2a\xba\x91\x31\x52\x32\x71\xe0\x04\xd7\x2d\x79\x5a\x95\x00\x47\x7c\x5e\xd0\x60\xa5\xb7\x79\x4a\x9a\xaf\x80\x59\x33\x4a\x73\xcf\xa8\x80\xd4\xc1\xd3\xbe\x77\x59\xcc\xb2\xf7\x5c\x35\x7a\x78\xe8\xb8\xe1\xa1\x22\x5e\xed\x28\xa5\xbb\xb9\x0c\xdb\xea\x9d\x93\x28\xa5\xa7\x54\x66\x9c\xc9\xe4\x9a\x6e\xa9\xbd\xa2\xda\x9a\x7c\x5b\xa2\x88\xc7\xb7\x46\x6c\x9b\x09\xc4\xbd\xb1\x53\x13\x8b\x4f\x8e\xb7\x35\x66\x4d\xf3\x4a\x45\xc4\xe8\x45\xde\x4e\xfb\xd6\x8c\x92\x0e\x94\x94\x41\xaf\x6a\x27\x7c\x67\xc7\x4c\x3b\xe9\x8f\x6a\x38\xb5\xd3\x81\xb2\x84\xe3\xf7\x74\x9d\xcc\x0a\x6f\xdd\x37\x8c\x2e\x51\x5e\x2b\xf5\x1c\x1e\xde\x3b\x06\x73\x81\xb5\x47\x5d\x11\xfb\x49\xbc\xad\xc5\x62\x93\xb9\xa5\xd3\x2a\x0c\xb6\x55\xcc\x92\x3c\xa7\x31\x5c\xeb\x8c\x91\xc3\x78\x8e\xaa\x30\x17\xbb\x2d\xdc\x36\xc5\xda\x49\xce\x5b\xc0\xb1\x8d\x3a\x17\x95\x3c\x1e\x92\xbc\x74\xdb\x58\x5a\x45\xde\xd4\x76\x1e\x7e\xd2\x5a\x14\x45\xe9\x67\xba\x59\xbb\x11\x57\xea\xb0\x1b\xb9\xd1\x96\x9d\x68\xc7\xb6\x89\x87\x24\x3f\xec\x1f\x75\x95\x53\x23\x55\xe9\x06\x18\xc3\x85\xba\xae\xf2\xa2\x19\xc8\x64\x27\xe3\xd0\xe8\xc2\x81\xf9\xf5\xab\x35\xe9\xe1\xa2\x10\x69\xa0\x33\xac\x17\xaf\x83\x77\x8a\x5d\x94\x0f\x50\x4e\x43\xb9\x2f\x0f\xa3\x5e\x71\x36\x56\x16\xf0\xf8\xf6\x93\x41\x29\xf8\x29\xa4\x02\x4f\x1e\x8d\x93\x5c\x06\x8b\x72\x07\x14\xb5\x12\xf9\x7b\x80\x52\x55\x02\x88\xae\xcb\xd0\x0d\x19\x01\xb9\x73\xc9\x23\x98\x85\x3c\x65\x0c\x58\x70\x04\xcc\x7d\x88\x96\x67\x26\xfe\x3c\x54\x04\x83\x95\xfc\x15\x4d\x73\xae\x55\x01\xa5\x5b\x4a\x72\x4d\x2d\x6f\x77\x91\xd2\x1c\xcd\xc8\x3c\x54\x7e\xc9\x13\x96\x3b\x5b\xb3\xf1\xf6\xfb\xee\x60\x45\x72\x92\xe6\x1f\x4e\x7f\xba\xbb\x4b\xe4\x1b\xf2\xc6\x99\x91\xb9\x7b\x77\x37\x23\xf3\x7f\xf4\x57\xe4\xb9\x8c\x4a\x75\xaa\xf2\xdb\xc7\x0b\xc3\x1b\x1e\x67\x64\x5e\x8e\xbc\xc5\x8c\xe6\x53\x1e\x07\x38\xe3\x32\xc7\xa5\x91\x30\x00\x81\x65\x01\xb9\xa5\xa0\x92\x56\x32\x5a\x6b\x52\x15\xfb\xe1\xf4\xa7\x7b\xcb\x21\xe8\x7a\x49\x76\x29\x42\xca\xc7\x57\x27\x6c\xc2\x1d\x10\x57\x21\x6d\x5e\xc8\xa8\x87\x2b\x7a\x2b\x4d\x14\xec\xc1\xbe\x1a\x26\x6c\xc2\xef\xee\x16\xa5\xeb\x4b\x2e\x72\xc7\x55\x53\x5b\xc6\x79\x7a\x72\x1c\x1e\x8e\x1e\x2f\xf4\x63\x19\xa0\xc7\x8b\xd5\x64\x67\x3a\xee\x1c\x50\xbb\xbe\xdd\xdf\x43\xf8\x6b\x05\xa7\xe3\x7a\x40\x63\x77\x77\x46\x1d\x00\x2a\x7e\x01\x9d\x16\x35\x32\x42\x7c\x82\xfa\x68\x46\x09\x93\x88\x71\x94\x26\xb3\x24\xf7\xd1\x2f\xd7\x54\x88\x24\x8e\x29\xd3\x21\x12\x11\x41\xd1\x15\xcd\x72\x44\xc6\x82\x4b\x89\x6e\xb8\xb8\xa2\x02\x81\x78\x91\xa0\x32\x27\x22\x97\x68\xc2\x05\xba\xe4\x91\x44\xf9\x94\xe4\x88\x67\x70\xf3\x84\x8f\xff\x82\xa0\x13\xca\x8b\xdd\x36\xaa\x9f\xc9\x1c\xbd\x6a\x6f\xd3\x3a\xc1\x09\x43\xdd\xe1\xe7\xcb\x5b\xf4\x51\x37\xc2\x5b\xce\xd3\x36\xfa\x65\x8c\x68\x95\xac\x1a\x49\xfa\x25\x3c\x54\x3a\x0d\x74\x57\xb8\xa6\xcb\xcc\xb0\xba\xe4\xd1\x10\xbe\x34\x54\xdf\x1d\xef\x07\x94\x2b\xa9\xca\x16\xd8\xb3\x42\xee\x76\x23\x9f\x91\xf9\xb0\xd1\x9f\x6d\x30\xaf\x7a\xf0\x11\x46\x4e\xfd\xe6\x62\xc0\x44\xdd\x58\x83\x16\x69\x23\x55\xa2\x5a\x55\x68\x6d\x89\x36\xc6\x26\x2c\x2b\x72\xec\x2d\xc0\x77\x18\x60\x56\xcc\x22\x2a\xb0\x37\x4b\x58\x80\xfb\x58\x4f\x66\x01\xb4\x06\x5c\x9d\x67\x5d\x8d\x47\x9b\x85\xa0\xe8\x3c\x7b\x4d\x6d\x40\x8d\x39\x08\xa1\xce\x8a\x94\x3d\x6a\x4e\x34\xf4\x55\x0e\x6e\xb9\xa5\xec\xfa\xc4\xd3\x2d\xfa\x30\xca\x99\xd5\x86\x51\xce\xac\x2e\xac\x4e\x88\xac\xec\x51\x35\x85\xae\x14\xc0\x2d\x3d\xfc\x8e\xe6\xf8\x73\xe6\xab\x26\xac\xd5\x9a\x43\xc6\xa7\x10\x81\xdd\xed\x18\xb9\x31\x7c\x07\x8d\xe7\x6e\x58\xd9\xd3\xd3\xe4\xb6\x58\x41\xef\x8d\x7f\x40\xda\x06\x69\x5b\x2b\xe7\x4f\xea\xf2\x04\x04\x8e\x6e\xa6\x54\xd0\x9f\x48\x44\x53\x19\xc2\x09\x94\x05\x0d\xf0\xbf\xe1\x27\xc6\x5e\xc2\x86\xd6\x58\x0d\x96\xa6\x00\x4f\x8e\xa7\x14\x1a\x29\x0e\xf0\x3b\xfb\x88\x7e\xe4\x02\xce\x16\xcc\xc5\x6d\x80\x4f\x69\x2e\x6e\xc1\x05\xe0\xc5\x94\xc4\x01\x3e\x4e\xb4\x47\xa0\x34\x26\xc2\x8f\x09\x8b\x5f\xf3\xe8\x93\x99\x07\x97\x3c\x3a\x39\x0e\x30\xf6\xf4\x67\x66\x1a\xab\xe7\x31\x61\x8c\xc6\x01\x9c\xaf\x41\xeb\x83\xea\x41\x93\x96\xe5\x24\x61\x31\xa0\xcd\x93\x63\x4f\x8e\x09\x73\x01\xba\x9e\x1c\x87\xea\xbf\x9f\x8b\x64\xe6\xac\x81\xe3\x42\xa4\x77\x77\x8f\x14\xc5\xbd\x30\x14\x9c\xb4\x1a\x0b\x9f\x1c\x97\x8f\x17\xc0\xfe\x08\x1f\xc1\x4f\xb8\x0f\x5a\xfe\x77\x7a\x6a\xab\xaa\xd5\xa5\x86\xda\xc1\x6f\x5d\x39\x30\x4e\x94\xb7\x56\x35\xec\x7b\x3a\xcf\x1d\x1b\xa7\x6d\x03\xd8\xb9\x1d\x36\x5a\xfd\xcc\x46\xfb\x2a\xf0\xfc\xee\x6e\x39\x00\x84\x50\x87\xa8\x79\x7d\x08\xe0\x6a\x98\xc4\xee\x02\x98\x7d\x13\x8e\x90\xd3\x44\x5d\x8f\x17\x5b\xe8\x4b\x77\x64\xfd\xb7\x90\xb0\x06\xbb\x50\x2e\x9b\xa6\x39\xc7\xdb\xb0\x7b\x67\xf5\x55\xb4\xf6\xd9\xd1\x30\xf4\x1c\x40\x93\xd8\xdd\x9d\x4b\x07\x28\xdc\x61\x0e\x36\x17\x95\xc0\x19\x4b\x53\x9e\xc2\xd6\x5b\x0c\x83\xe9\xe4\xd8\x4e\xc7\x0d\x19\xea\xce\xb2\x36\xe5\xd6\xdd\x4a\x11\x04\xcb\xb3\x6d\xf9\x09\xa7\xdb\x46\xc8\x7f\xe4\x86\x39\x10\xc4\xd9\x34\x52\x55\x79\x3c\x35\x66\x61\x26\x04\x4d\xb1\xe2\x48\xb4\x03\x61\x6f\xef\x91\xed\x21\xf6\x28\x64\x43\xa0\x07\xfe\xde\xde\xbd\x82\x56\x32\xc3\x9b\x18\x7b\x18\x25\x92\xfd\x3f\x58\x09\x28\x9f\x52\x68\x6c\xa4\x76\x8d\xf9\xd8\xc3\x08\x7f\x29\xc9\x54\xc5\x83\xef\x88\x20\x9b\x77\x63\xa2\x0b\xa8\x14\xb7\xec\x22\xa6\x4f\x2b\xa5\x1b\xa2\xc4\x34\xe1\x05\x8b\x3d\x94\xe4\x68\x06\x6b\xf0\xd0\x94\x5c\x53\x14\x51\xca\x50\x26\xf8\x98\x4a\x49\x63\x65\x6d\xd5\xc5\xd8\x65\xe0\xfc\x75\xec\xae\x17\x76\x3d\x4a\x3b\xe9\x47\xd0\xbd\x9d\xc8\xd8\x56\x3b\xb6\xa5\xf0\x16\xf4\xdb\x56\x01\xe4\xeb\x77\x31\x70\x5e\xbf\xfb\xe5\x8d\xc1\x82\xc9\xe4\xb6\x9e\x1d\x20\xbd\x9a\xa9\xdb\x18\xa8\x4e\xb8\x61\x82\x6a\x4b\x57\xe5\x24\xc7\x5c\xd0\x76\xcf\xf3\x32\xbd\xf1\x3a\x6f\xc6\xd3\x06\x90\x0c\xcc\xef\x83\xcf\x79\x27\x9f\xf3\x9f\x1c\x09\x83\xc6\x41\x6f\xa9\x48\x78\x9c\x8c\x5f\x83\x9f\xe8\x13\xe2\x52\xe5\xb2\x16\x05\x03\x14\x57\x48\x80\x7f\x7f\xa8\xdb\x5a\x95\xa0\x42\x85\xed\x9e\x6a\x51\xb0\xd7\x3c\x02\x64\xbc\xee\x83\x2d\x58\xab\x07\x56\x91\x80\xff\x95\xb2\x31\x8f\xe9\x87\xd3\x93\x4a\x78\xc0\xd3\x4f\x62\x77\xab\x4b\xb6\xa5\x62\x80\x12\x2b\x39\x86\x23\xdb\x5e\x6a\x76\x56\x68\x1b\x3e\x11\x2e\x4d\x49\xfe\x08\xd0\x2b\x24\x37\x8e\xa5\x30\x0c\x31\xbf\xc2\xee\xa2\xc1\xe7\x07\xa6\x66\xcf\xd8\xf0\xb0\x56\x78\x89\x88\x34\xac\x15\x03\x08\x4f\xe2\xd2\x1f\x95\xf6\xac\xcc\x15\xbe\xa2\x60\x70\xf5\xeb\x32\xf3\xf7\x53\x8a\xe0\x36\x86\x84\x17\x12\x89\x82\x81\x57\x33\xdb\x5c\xf0\x44\x22\x99\x27\x69\x8a\x4c\x71\xb8\x00\xf8\x61\x0d\x30\x7f\x54\xae\x34\x6c\x95\x4b\x50\x3d\x95\xee\x60\xa9\x2d\xbf\x56\x7f\x72\xd5\x74\x30\xd6\xb0\xbb\x3b\xab\x0e\x30\xba\x01\x58\x60\x08\x98\xf5\xa2\x1e\x5e\x12\xbf\x23\x5d\x04\x6b\x3a\x69\x0c\xb2\x86\xc3\x5f\xa5\x8f\x97\x40\x54\x25\xdb\xbd\xbd\xae\xd9\x55\x49\xdc\xdd\x6a\xf6\x57\x59\xd4\xd0\x0d\x0b\x59\xbf\xc1\x27\x45\x4d\x3f\x30\x10\x42\x87\xe5\x0c\x6f\xe8\x3c\x47\xa7\x05\x6b\xa3\x5c\x46\xd4\xaa\x23\x81\x67\xfa\x92\x47\x5d\x17\x2f\x98\xe1\xdd\x82\x6c\x8c\x6a\xec\x40\x55\x79\x09\x3b\xd0\xca\x8c\x8e\xd5\x43\x9e\xcc\xe8\xf0\x37\xce\xe8\xde\xde\x08\x39\x8f\x17\x4b\x61\xa5\x3b\x52\x44\x8c\x0f\xc1\x1d\x9d\x92\x6c\x6f\x0f\x7b\xf0\xd1\xc5\xbc\xe2\xb6\xbc\x56\x00\xa1\xc5\x81\xea\xd3\x58\x5b\x5a\x20\xa6\xba\xd9\x8e\xf0\x7f\xa9\xc4\x01\x7e\xc3\x5b\x73\x84\x54\x8c\xce\xf3\xa1\x28\xd8\x90\xe4\xed\x50\x70\x25\x01\x60\xc1\x5e\x6b\x2e\x7f\x90\x9d\xd7\x98\x72\xc1\xf5\x5b\x30\xf4\x86\xdf\xdc\xe7\xfc\xb5\xfa\x13\xd4\xe7\xa0\xf9\xd2\xd1\xfd\xab\x67\xe9\x0e\xa0\xd6\xac\xe7\x05\x7c\xc8\x61\x69\xf1\xc1\xdf\xdd\xf5\xad\xb8\x70\x59\x34\x0f\xcf\xce\xa8\x9f\xc4\x1e\xf7\x30\x3e\x3f\xd7\x8b\xe9\x85\x97\xc2\x9d\x89\xa9\xde\x6a\x0d\x27\x4f\x87\x62\xc0\x9c\xfd\xe7\xdf\xbb\x0e\x87\x7d\x1c\x1c\x3e\xa3\x92\x14\x76\x18\xd7\x6b\x73\x6d\xe0\x86\x1b\xdb\xf2\xb0\x26\x03\x3e\xcf\xcd\x29\x92\x7a\x6f\xb5\x2e\x00\xf6\x87\xfb\xb7\xe3\x69\xaf\x17\x11\xb1\x98\x52\xb0\x47\x83\xa7\xfd\x6c\x3e\x98\x25\xac\x77\x93\xc4\xf9\x34\xd8\x57\xef\xd0\xb9\x27\x29\xbf\x09\xa6\xea\x6b\xcc\x20\x22\xe3\xab\x0b\x01\x96\x6c\x6f\xcc\x53\x2e\x82\xff\x9b\x1c\xc0\xdf\x20\xe2\x22\xa6\xa2\x27\x48\x9c\x14\x32\xf8\x2e\x9b\x0f\x22\x3e\xef\xc9\x29\x89\xf9\x4d\x90\x30\x49\x73\xd4\x47\xfb\xd9\x1c\x3d\xcd\xe6\x48\x5c\x44\xc4\xe9\x7b\xf0\xe7\xef\xbb\xa5\x3f\x3c\xf8\xf8\xee\xd7\xa8\x37\x49\xd2\xd4\x96\x66\xbf\xdf\x7f\x32\xb0\x59\x4c\x26\x83\x09\x67\x79\x4f\x26\xbf\xd1\x60\xff\x69\x36\x1f\xa4\x09\xa3\xbd\x66\xc1\xc1\xdb\xd3\x23\x69\x72\xc1\x82\x31\x05\x78\x3e\xb8\x99\x26\x39\xed\xc9\x0c\x8e\xb9\x60\xfc\x46\x90\x6c\x43\xe9\x9f\x3d\x7b\x4e\xa2\xe7\xa5\x3f\x7c\xf6\xeb\xbf\x3e\x5c\xf7\x26\x09\x4d\x63\xb9\x98\x11\x71\x91\xb0\xa0\x3f\xc8\x48\x0c\xfe\xac\xa0\x3f\x48\x13\x99\xf7\x54\x17\x0d\x18\x67\x74\xa5\x3c\x25\x86\x16\x05\x21\xeb\x66\x09\x17\x38\x22\x02\x07\xb8\x16\x33\xf6\x30\xd4\x0f\xc2\xea\xda\xaa\x40\xc8\x12\x82\x9b\x45\xc0\x0f\x56\xd3\x17\xb4\x9a\x9e\x1e\x1c\xac\x5b\x3e\x06\x63\xbe\x24\x62\xab\xe1\xb3\xe4\xab\xd5\x6e\xdd\xe6\xe9\xaf\x3a\x04\xd0\xf6\x23\xfd\x58\x2d\x73\x1b\x92\x7a\x57\x27\x28\xd5\x12\x60\x7c\x46\x05\x74\xe5\x50\x2d\xe3\x55\xbd\xd6\x78\x90\x7d\x13\xe3\x0e\x80\x4c\x77\x98\x70\x95\xa3\x0e\x86\xd9\xa5\xdd\x31\xbc\x5d\x8f\x6f\x41\x61\x11\x11\xe5\x4e\x09\xa0\xb3\xdb\x8b\xe2\xb4\x7e\x31\x75\xf8\x06\x3f\x81\xbe\xbe\x5a\xfa\x98\x33\x0a\x47\xc6\xaf\x45\xa8\x15\xb5\xdb\x30\x63\x91\x6e\xce\x1b\x24\xb1\xa5\xbc\x69\x62\xaa\x6f\xca\xe3\xe1\x27\x08\x32\xaf\x72\xa6\x39\x39\xec\x6f\xc1\xb5\x32\x23\xcc\x24\xc7\x1e\xfa\xe1\xfd\x8b\x2d\x6e\xcf\xa5\x49\xb6\xe6\x0b\x33\x97\x67\xc6\xaa\x5a\x36\xa3\x4b\xba\xb4\x50\x06\x4e\x0d\xb9\x1f\x44\x41\x0d\xd4\x0a\xd0\x2b\x7a\x5b\x7a\xb0\xbf\x13\x07\x08\xaf\xfa\x9f\x34\xeb\xb3\x2b\x7a\x7b\xee\x9a\x7d\xb9\x46\x1f\xd8\x7e\x1e\x36\x7a\xf8\xa0\xf1\xdc\x9c\x2e\x75\xd9\xd7\xe6\x44\x7d\xdc\x43\xf3\x70\xdb\x87\x2d\x23\x0f\x5b\x46\xea\x2d\x23\x1f\xb9\xb8\x9a\xa4\xfc\xe6\xb3\x6d\x1a\xb9\xb1\x19\x7c\xe5\xdb\x46\xea\x72\xaa\xc0\xea\xf5\xf3\x6e\x1d\x31\xab\xfc\xa4\x63\xf3\x03\x4b\xe6\xe4\xb8\x5e\xba\x67\xc3\xc1\xb9\x2d\xed\x01\x08\xca\x7c\xd3\x84\xd5\x69\x80\xd6\xb6\x4a\x62\xb8\xa4\x57\x2f\xe5\xb3\x74\xf5\xd2\x8c\xaf\x7f\xad\x5e\xd5\x21\xb1\xbb\x3b\x9b\xdd\xfc\x2a\x76\x2b\x89\x95\xb1\xf3\xb0\x99\xe4\xcf\xb2\x99\xe4\x3e\xc7\x5b\x83\xa3\x5d\xb3\xbf\xbe\x99\xc3\xb6\xb9\x54\x03\xc5\xbe\x75\x75\x8a\x58\xfa\x0e\x9e\x91\x06\xa9\xdb\x42\xda\x09\x9f\x28\x5e\xbb\x6c\xda\xd8\x01\x8e\x81\x25\xf5\x81\xa9\x97\xb8\xf4\x96\x55\x4f\x37\xb7\x51\x85\x78\x6a\x75\x54\x2e\xb9\x7d\x34\x02\x82\x10\xd5\x0e\x2a\x2e\xa6\xb0\x35\x44\x0e\x39\x33\x5e\xce\xc3\xfe\xd1\x08\x39\x64\x92\x53\x81\x8c\xc2\xdf\xa8\x28\x1b\x29\xdd\xd2\x1d\xc1\xe7\xb1\xd2\x6d\x15\x48\x55\xaf\x5d\x37\x82\x6c\x4c\xd8\xb2\x17\xa4\x52\x67\x83\xea\xa9\x9b\x93\xa3\x2c\xcf\xdd\xc1\xff\x06\x00\xe4\xc8\x60\x22\x29\x3e\x05\x00")
 
 func workJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -104,7 +104,7 @@ func workJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "work.js", size: 327697, mode: os.FileMode(420), modTime: time.Unix(1560933077, 0)}
+	info := bindataFileInfo{name: "work.js", size: 343593, mode: os.FileMode(420), modTime: time.Unix(1792163865, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -1,9 +1,8 @@
-!function(e){function t(o){if(n[o])return n[o].exports;var r=n[o]={exports:{},id:o,loaded:!1};return e[o].call(r.exports,r,r.exports,t),r.loaded=!0,r.exports}var n={};return t.m=e,t.c=n,t.p="",t(0)}([function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),s=n(1),c=o(s),u=n(33),d=n(172),p=o(d),f=n(183),h=o(f),m=n(185),b=o(m),g=n(186),_=o(g),v=n(187),y=o(v),x=n(188),w=n(180),k=o(w),E=n(182),C=o(E),P=function(e){function t(e){r(this,t);var n=l(this,(t.__proto__||Object.getPrototypeOf(t)).call(this,e));return n.state={NsValue:""},n}return a(t,e),i(t,[{key:"changeNamespace",value:function(){var e=this.state.NsValue;localStorage.setItem("ns",e),fetch("/change_namespace/"+e,{method:"post"}).then(function(){location.reload()})}},{key:"changeInput",value:function(e){this.setState({NsValue:e.target.value})}},{key:"render",value:function(){var e=this;return c.default.createElement("div",{className:k.default.container,style:{marginTop:30,marginBottom:60}},c.default.createElement("header",null,c.default.createElement("h1",null,"gocraft/work")),c.default.createElement("hr",null),c.default.createElement("div",{className:k.default.row},c.default.createElement("main",{className:k.default.colMd10},this.props.children),c.default.createElement("aside",{className:k.default.colMd2},c.default.createElement("nav",null,c.default.createElement("ul",{className:(0,C.default)(k.default.nav,k.default.navPills,k.default.navStacked)},c.default.createElement("li",null,"Namespace:  ",localStorage.getItem("ns")),c.default.createElement("li",null,c.default.createElement("input",{placeholder:"NameSpace",value:this.state.NsValue,onChange:function(t){return e.changeInput(t)}})," ",c.default.createElement("button",{onClick:function(t){return e.changeNamespace()}},"Reload")),c.default.createElement("li",null,c.default.createElement(x.Link,{to:"/processes"},"Processes")),c.default.createElement("li",null,c.default.createElement(x.Link,{to:"/queues"},"Queues")),c.default.createElement("li",null,c.default.createElement(x.Link,{to:"/retry_jobs"},"Retry Jobs")),c.default.createElement("li",null,c.default.createElement(x.Link,{to:"/scheduled_jobs"},"Scheduled Jobs")),c.default.createElement("li",null,c.default.createElement(x.Link,{to:"/dead_jobs"},"Dead Jobs")))))))}}]),t}(c.default.Component);P.propTypes={children:c.default.PropTypes.element.isRequired},(0,u.render)(c.default.createElement(x.Router,{history:x.hashHistory},c.default.createElement(x.Route,{path:"/",component:P},c.default.createElement(x.Route,{path:"/processes",component:function(){return c.default.createElement(p.default,{busyWorkerURL:"/busy_workers",workerPoolURL:"/worker_pools"})}}),c.default.createElement(x.Route,{path:"/queues",component:function(){return c.default.createElement(b.default,{url:"/queues"})}}),c.default.createElement(x.Route,{path:"/retry_jobs",component:function(){return c.default.createElement(_.default,{url:"/retry_jobs"})}}),c.default.createElement(x.Route,{path:"/scheduled_jobs",component:function(){return c.default.createElement(y.default,{url:"/scheduled_jobs"})}}),c.default.createElement(x.Route,{path:"/dead_jobs",component:function(){return c.default.createElement(h.default,{fetchURL:"/dead_jobs",retryURL:"/retry_dead_job",retryAllURL:"/retry_all_dead_jobs",deleteURL:"/delete_dead_job",deleteAllURL:"/delete_all_dead_jobs"})}}),c.default.createElement(x.IndexRedirect,{from:"",to:"/processes"}))),document.getElementById("app"))},function(e,t,n){"use strict";e.exports=n(2)},function(e,t,n){"use strict";var o=n(3),r=n(4),l=n(13),a=n(21),i=n(15),s=n(22),c=n(29),u=n(30),d=n(32),p=i.createElement,f=i.createFactory,h=i.cloneElement,m=o,b=function(e){return e},g={Children:{map:l.map,forEach:l.forEach,count:l.count,toArray:l.toArray,only:d},Component:r.Component,PureComponent:r.PureComponent,createElement:p,cloneElement:h,isValidElement:i.isValidElement,PropTypes:s,createClass:u,createFactory:f,createMixin:b,DOM:a,version:c,__spread:m};e.exports=g},function(e,t){"use strict";function n(e){if(null===e||void 0===e)throw new TypeError("Object.assign cannot be called with null or undefined");return Object(e)}function o(){try{if(!Object.assign)return!1;var e=new String("abc");if(e[5]="de","5"===Object.getOwnPropertyNames(e)[0])return!1;for(var t={},n=0;n<10;n++)t["_"+String.fromCharCode(n)]=n;var o=Object.getOwnPropertyNames(t).map(function(e){return t[e]});if("0123456789"!==o.join(""))return!1;var r={};return"abcdefghijklmnopqrst".split("").forEach(function(e){r[e]=e}),"abcdefghijklmnopqrst"===Object.keys(Object.assign({},r)).join("")}catch(e){return!1}}var r=Object.getOwnPropertySymbols,l=Object.prototype.hasOwnProperty,a=Object.prototype.propertyIsEnumerable;e.exports=o()?Object.assign:function(e,t){for(var o,i,s=n(e),c=1;c<arguments.length;c++){o=Object(arguments[c]);for(var u in o)l.call(o,u)&&(s[u]=o[u]);if(r){i=r(o);for(var d=0;d<i.length;d++)a.call(o,i[d])&&(s[i[d]]=o[i[d]])}}return s}},function(e,t,n){"use strict";function o(e,t,n){this.props=e,this.context=t,this.refs=c,this.updater=n||s}function r(e,t,n){this.props=e,this.context=t,this.refs=c,this.updater=n||s}function l(){}var a=n(5),i=n(3),s=n(6),c=(n(9),n(10));n(11),n(12);o.prototype.isReactComponent={},o.prototype.setState=function(e,t){"object"!=typeof e&&"function"!=typeof e&&null!=e?a("85"):void 0,this.updater.enqueueSetState(this,e),t&&this.updater.enqueueCallback(this,t,"setState")},o.prototype.forceUpdate=function(e){this.updater.enqueueForceUpdate(this),e&&this.updater.enqueueCallback(this,e,"forceUpdate")};l.prototype=o.prototype,r.prototype=new l,r.prototype.constructor=r,i(r.prototype,o.prototype),r.prototype.isPureReactComponent=!0,e.exports={Component:o,PureComponent:r}},function(e,t){"use strict";function n(e){for(var t=arguments.length-1,n="Minified React error #"+e+"; visit http://facebook.github.io/react/docs/error-decoder.html?invariant="+e,o=0;o<t;o++)n+="&args[]="+encodeURIComponent(arguments[o+1]);n+=" for the full message or use the non-minified dev environment for full errors and additional helpful warnings.";var r=new Error(n);throw r.name="Invariant Violation",r.framesToPop=1,r}e.exports=n},function(e,t,n){"use strict";function o(e,t){}var r=(n(7),{isMounted:function(e){return!1},enqueueCallback:function(e,t){},enqueueForceUpdate:function(e){o(e,"forceUpdate")},enqueueReplaceState:function(e,t){o(e,"replaceState")},enqueueSetState:function(e,t){o(e,"setState")}});e.exports=r},function(e,t,n){"use strict";var o=n(8),r=o;e.exports=r},function(e,t){"use strict";function n(e){return function(){return e}}var o=function(){};o.thatReturns=n,o.thatReturnsFalse=n(!1),o.thatReturnsTrue=n(!0),o.thatReturnsNull=n(null),o.thatReturnsThis=function(){return this},o.thatReturnsArgument=function(e){return e},e.exports=o},function(e,t,n){"use strict";var o=!1;e.exports=o},function(e,t,n){"use strict";var o={};e.exports=o},function(e,t,n){"use strict";function o(e,t,n,o,l,a,i,s){if(r(t),!e){var c;if(void 0===t)c=new Error("Minified exception occurred; use the non-minified dev environment for the full error message and additional helpful warnings.");else{var u=[n,o,l,a,i,s],d=0;c=new Error(t.replace(/%s/g,function(){return u[d++]})),c.name="Invariant Violation"}throw c.framesToPop=1,c}}var r=function(e){};e.exports=o},function(e,t,n){"use strict";var o=function(){};e.exports=o},function(e,t,n){"use strict";function o(e){return(""+e).replace(y,"$&/")}function r(e,t){this.func=e,this.context=t,this.count=0}function l(e,t,n){var o=e.func,r=e.context;o.call(r,t,e.count++)}function a(e,t,n){if(null==e)return e;var o=r.getPooled(t,n);g(e,l,o),r.release(o)}function i(e,t,n,o){this.result=e,this.keyPrefix=t,this.func=n,this.context=o,this.count=0}function s(e,t,n){var r=e.result,l=e.keyPrefix,a=e.func,i=e.context,s=a.call(i,t,e.count++);Array.isArray(s)?c(s,r,n,b.thatReturnsArgument):null!=s&&(m.isValidElement(s)&&(s=m.cloneAndReplaceKey(s,l+(!s.key||t&&t.key===s.key?"":o(s.key)+"/")+n)),r.push(s))}function c(e,t,n,r,l){var a="";null!=n&&(a=o(n)+"/");var c=i.getPooled(t,a,r,l);g(e,s,c),i.release(c)}function u(e,t,n){if(null==e)return e;var o=[];return c(e,o,null,t,n),o}function d(e,t,n){return null}function p(e,t){return g(e,d,null)}function f(e){var t=[];return c(e,t,null,b.thatReturnsArgument),t}var h=n(14),m=n(15),b=n(8),g=n(18),_=h.twoArgumentPooler,v=h.fourArgumentPooler,y=/\/+/g;r.prototype.destructor=function(){this.func=null,this.context=null,this.count=0},h.addPoolingTo(r,_),i.prototype.destructor=function(){this.result=null,this.keyPrefix=null,this.func=null,this.context=null,this.count=0},h.addPoolingTo(i,v);var x={forEach:a,map:u,mapIntoWithKeyPrefixInternal:c,count:p,toArray:f};e.exports=x},function(e,t,n){"use strict";var o=n(5),r=(n(11),function(e){var t=this;if(t.instancePool.length){var n=t.instancePool.pop();return t.call(n,e),n}return new t(e)}),l=function(e,t){var n=this;if(n.instancePool.length){var o=n.instancePool.pop();return n.call(o,e,t),o}return new n(e,t)},a=function(e,t,n){var o=this;if(o.instancePool.length){var r=o.instancePool.pop();return o.call(r,e,t,n),r}return new o(e,t,n)},i=function(e,t,n,o){var r=this;if(r.instancePool.length){var l=r.instancePool.pop();return r.call(l,e,t,n,o),l}return new r(e,t,n,o)},s=function(e){var t=this;e instanceof t?void 0:o("25"),e.destructor(),t.instancePool.length<t.poolSize&&t.instancePool.push(e)},c=10,u=r,d=function(e,t){var n=e;return n.instancePool=[],n.getPooled=t||u,n.poolSize||(n.poolSize=c),n.release=s,n},p={addPoolingTo:d,oneArgumentPooler:r,twoArgumentPooler:l,threeArgumentPooler:a,fourArgumentPooler:i};e.exports=p},function(e,t,n){"use strict";function o(e){return void 0!==e.ref}function r(e){return void 0!==e.key}var l=n(3),a=n(16),i=(n(7),n(9),Object.prototype.hasOwnProperty),s=n(17),c={key:!0,ref:!0,__self:!0,__source:!0},u=function(e,t,n,o,r,l,a){var i={$$typeof:s,type:e,key:t,ref:n,props:a,_owner:l};return i};u.createElement=function(e,t,n){var l,s={},d=null,p=null,f=null,h=null;if(null!=t){o(t)&&(p=t.ref),r(t)&&(d=""+t.key),f=void 0===t.__self?null:t.__self,h=void 0===t.__source?null:t.__source;for(l in t)i.call(t,l)&&!c.hasOwnProperty(l)&&(s[l]=t[l])}var m=arguments.length-2;if(1===m)s.children=n;else if(m>1){for(var b=Array(m),g=0;g<m;g++)b[g]=arguments[g+2];s.children=b}if(e&&e.defaultProps){var _=e.defaultProps;for(l in _)void 0===s[l]&&(s[l]=_[l])}return u(e,d,p,f,h,a.current,s)},u.createFactory=function(e){var t=u.createElement.bind(null,e);return t.type=e,t},u.cloneAndReplaceKey=function(e,t){var n=u(e.type,t,e.ref,e._self,e._source,e._owner,e.props);return n},u.cloneElement=function(e,t,n){var s,d=l({},e.props),p=e.key,f=e.ref,h=e._self,m=e._source,b=e._owner;if(null!=t){o(t)&&(f=t.ref,b=a.current),r(t)&&(p=""+t.key);var g;e.type&&e.type.defaultProps&&(g=e.type.defaultProps);for(s in t)i.call(t,s)&&!c.hasOwnProperty(s)&&(void 0===t[s]&&void 0!==g?d[s]=g[s]:d[s]=t[s])}var _=arguments.length-2;if(1===_)d.children=n;else if(_>1){for(var v=Array(_),y=0;y<_;y++)v[y]=arguments[y+2];d.children=v}return u(e.type,p,f,h,m,b,d)},u.isValidElement=function(e){return"object"==typeof e&&null!==e&&e.$$typeof===s},e.exports=u},function(e,t){"use strict";var n={current:null};e.exports=n},function(e,t){"use strict";var n="function"==typeof Symbol&&Symbol.for&&Symbol.for("react.element")||60103;e.exports=n},function(e,t,n){"use strict";function o(e,t){return e&&"object"==typeof e&&null!=e.key?c.escape(e.key):t.toString(36)}function r(e,t,n,l){var p=typeof e;if("undefined"!==p&&"boolean"!==p||(e=null),null===e||"string"===p||"number"===p||"object"===p&&e.$$typeof===i)return n(l,e,""===t?u+o(e,0):t),1;var f,h,m=0,b=""===t?u:t+d;if(Array.isArray(e))for(var g=0;g<e.length;g++)f=e[g],h=b+o(f,g),m+=r(f,h,n,l);else{var _=s(e);if(_){var v,y=_.call(e);if(_!==e.entries)for(var x=0;!(v=y.next()).done;)f=v.value,h=b+o(f,x++),m+=r(f,h,n,l);else for(;!(v=y.next()).done;){var w=v.value;w&&(f=w[1],h=b+c.escape(w[0])+d+o(f,0),m+=r(f,h,n,l))}}else if("object"===p){var k="",E=String(e);a("31","[object Object]"===E?"object with keys {"+Object.keys(e).join(", ")+"}":E,k)}}return m}function l(e,t,n){return null==e?0:r(e,"",t,n)}var a=n(5),i=(n(16),n(17)),s=n(19),c=(n(11),n(20)),u=(n(7),"."),d=":";e.exports=l},function(e,t){"use strict";function n(e){var t=e&&(o&&e[o]||e[r]);if("function"==typeof t)return t}var o="function"==typeof Symbol&&Symbol.iterator,r="@@iterator";e.exports=n},function(e,t){"use strict";function n(e){var t=/[=:]/g,n={"=":"=0",":":"=2"},o=(""+e).replace(t,function(e){return n[e]});return"$"+o}function o(e){var t=/(=0|=2)/g,n={"=0":"=","=2":":"},o="."===e[0]&&"$"===e[1]?e.substring(2):e.substring(1);return(""+o).replace(t,function(e){return n[e]})}var r={escape:n,unescape:o};e.exports=r},function(e,t,n){"use strict";var o=n(15),r=o.createFactory,l={a:r("a"),abbr:r("abbr"),address:r("address"),area:r("area"),article:r("article"),aside:r("aside"),audio:r("audio"),b:r("b"),base:r("base"),bdi:r("bdi"),bdo:r("bdo"),big:r("big"),blockquote:r("blockquote"),body:r("body"),br:r("br"),button:r("button"),canvas:r("canvas"),caption:r("caption"),cite:r("cite"),code:r("code"),col:r("col"),colgroup:r("colgroup"),data:r("data"),datalist:r("datalist"),dd:r("dd"),del:r("del"),details:r("details"),dfn:r("dfn"),dialog:r("dialog"),div:r("div"),dl:r("dl"),dt:r("dt"),em:r("em"),embed:r("embed"),fieldset:r("fieldset"),figcaption:r("figcaption"),figure:r("figure"),footer:r("footer"),form:r("form"),h1:r("h1"),h2:r("h2"),h3:r("h3"),h4:r("h4"),h5:r("h5"),h6:r("h6"),head:r("head"),header:r("header"),hgroup:r("hgroup"),hr:r("hr"),html:r("html"),i:r("i"),iframe:r("iframe"),img:r("img"),input:r("input"),ins:r("ins"),kbd:r("kbd"),keygen:r("keygen"),label:r("label"),legend:r("legend"),li:r("li"),link:r("link"),main:r("main"),map:r("map"),mark:r("mark"),menu:r("menu"),menuitem:r("menuitem"),meta:r("meta"),meter:r("meter"),nav:r("nav"),noscript:r("noscript"),object:r("object"),ol:r("ol"),optgroup:r("optgroup"),option:r("option"),output:r("output"),p:r("p"),param:r("param"),picture:r("picture"),pre:r("pre"),progress:r("progress"),q:r("q"),rp:r("rp"),rt:r("rt"),ruby:r("ruby"),s:r("s"),samp:r("samp"),script:r("script"),section:r("section"),select:r("select"),small:r("small"),source:r("source"),span:r("span"),strong:r("strong"),style:r("style"),sub:r("sub"),summary:r("summary"),sup:r("sup"),table:r("table"),tbody:r("tbody"),td:r("td"),textarea:r("textarea"),tfoot:r("tfoot"),th:r("th"),thead:r("thead"),time:r("time"),title:r("title"),tr:r("tr"),track:r("track"),u:r("u"),ul:r("ul"),var:r("var"),video:r("video"),wbr:r("wbr"),circle:r("circle"),clipPath:r("clipPath"),defs:r("defs"),ellipse:r("ellipse"),g:r("g"),image:r("image"),line:r("line"),linearGradient:r("linearGradient"),mask:r("mask"),path:r("path"),pattern:r("pattern"),polygon:r("polygon"),polyline:r("polyline"),radialGradient:r("radialGradient"),rect:r("rect"),stop:r("stop"),svg:r("svg"),text:r("text"),tspan:r("tspan")};e.exports=l},function(e,t,n){"use strict";var o=n(15),r=o.isValidElement,l=n(23);e.exports=l(r)},function(e,t,n){"use strict";var o=n(24);e.exports=function(e){var t=!1;return o(e,t)}},function(e,t,n){"use strict";function o(){return null}var r=n(25),l=n(3),a=n(27),i=n(28),s=Function.call.bind(Object.prototype.hasOwnProperty),c=function(){};e.exports=function(e,t){function n(e){var t=e&&(T&&e[T]||e[N]);if("function"==typeof t)return t}function u(e,t){return e===t?0!==e||1/e===1/t:e!==e&&t!==t}function d(e){this.message=e,this.stack=""}function p(e){function n(n,o,r,l,i,s,c){if(l=l||L,s=s||r,c!==a){if(t){var u=new Error("Calling PropTypes validators directly is not supported by the `prop-types` package. Use `PropTypes.checkPropTypes()` to call them. Read more at http://fb.me/use-check-prop-types");throw u.name="Invariant Violation",u}}return null==o[r]?n?new d(null===o[r]?"The "+i+" `"+s+"` is marked as required "+("in `"+l+"`, but its value is `null`."):"The "+i+" `"+s+"` is marked as required in "+("`"+l+"`, but its value is `undefined`.")):null:e(o,r,l,i,s)}var o=n.bind(null,!1);return o.isRequired=n.bind(null,!0),o}function f(e){function t(t,n,o,r,l,a){var i=t[n],s=M(i);if(s!==e){var c=O(i);return new d("Invalid "+r+" `"+l+"` of type "+("`"+c+"` supplied to `"+o+"`, expected ")+("`"+e+"`."))}return null}return p(t)}function h(){return p(o)}function m(e){function t(t,n,o,r,l){if("function"!=typeof e)return new d("Property `"+l+"` of component `"+o+"` has invalid PropType notation inside arrayOf.");var i=t[n];if(!Array.isArray(i)){var s=M(i);return new d("Invalid "+r+" `"+l+"` of type "+("`"+s+"` supplied to `"+o+"`, expected an array."))}for(var c=0;c<i.length;c++){var u=e(i,c,o,r,l+"["+c+"]",a);if(u instanceof Error)return u}return null}return p(t)}function b(){function t(t,n,o,r,l){var a=t[n];if(!e(a)){var i=M(a);return new d("Invalid "+r+" `"+l+"` of type "+("`"+i+"` supplied to `"+o+"`, expected a single ReactElement."))}return null}return p(t)}function g(){function e(e,t,n,o,l){var a=e[t];if(!r.isValidElementType(a)){var i=M(a);return new d("Invalid "+o+" `"+l+"` of type "+("`"+i+"` supplied to `"+n+"`, expected a single ReactElement type."))}return null}return p(e)}function _(e){function t(t,n,o,r,l){if(!(t[n]instanceof e)){var a=e.name||L,i=S(t[n]);return new d("Invalid "+r+" `"+l+"` of type "+("`"+i+"` supplied to `"+o+"`, expected ")+("instance of `"+a+"`."))}return null}return p(t)}function v(e){function t(t,n,o,r,l){for(var a=t[n],i=0;i<e.length;i++)if(u(a,e[i]))return null;var s=JSON.stringify(e,function(e,t){var n=O(t);return"symbol"===n?String(t):t});return new d("Invalid "+r+" `"+l+"` of value `"+String(a)+"` "+("supplied to `"+o+"`, expected one of "+s+"."))}return Array.isArray(e)?p(t):o}function y(e){function t(t,n,o,r,l){if("function"!=typeof e)return new d("Property `"+l+"` of component `"+o+"` has invalid PropType notation inside objectOf.");var i=t[n],c=M(i);if("object"!==c)return new d("Invalid "+r+" `"+l+"` of type "+("`"+c+"` supplied to `"+o+"`, expected an object."));for(var u in i)if(s(i,u)){var p=e(i,u,o,r,l+"."+u,a);if(p instanceof Error)return p}return null}return p(t)}function x(e){function t(t,n,o,r,l){for(var i=0;i<e.length;i++){var s=e[i];if(null==s(t,n,o,r,l,a))return null}return new d("Invalid "+r+" `"+l+"` supplied to "+("`"+o+"`."))}if(!Array.isArray(e))return o;for(var n=0;n<e.length;n++){var r=e[n];if("function"!=typeof r)return c("Invalid argument supplied to oneOfType. Expected an array of check functions, but received "+R(r)+" at index "+n+"."),o}return p(t)}function w(){function e(e,t,n,o,r){return C(e[t])?null:new d("Invalid "+o+" `"+r+"` supplied to "+("`"+n+"`, expected a ReactNode."))}return p(e)}function k(e){function t(t,n,o,r,l){var i=t[n],s=M(i);if("object"!==s)return new d("Invalid "+r+" `"+l+"` of type `"+s+"` "+("supplied to `"+o+"`, expected `object`."));for(var c in e){var u=e[c];if(u){var p=u(i,c,o,r,l+"."+c,a);if(p)return p}}return null}return p(t)}function E(e){function t(t,n,o,r,i){var s=t[n],c=M(s);if("object"!==c)return new d("Invalid "+r+" `"+i+"` of type `"+c+"` "+("supplied to `"+o+"`, expected `object`."));var u=l({},t[n],e);for(var p in u){var f=e[p];if(!f)return new d("Invalid "+r+" `"+i+"` key `"+p+"` supplied to `"+o+"`.\nBad object: "+JSON.stringify(t[n],null,"  ")+"\nValid keys: "+JSON.stringify(Object.keys(e),null,"  "));var h=f(s,p,o,r,i+"."+p,a);if(h)return h}return null}return p(t)}function C(t){switch(typeof t){case"number":case"string":case"undefined":return!0;case"boolean":return!t;case"object":if(Array.isArray(t))return t.every(C);if(null===t||e(t))return!0;var o=n(t);if(!o)return!1;var r,l=o.call(t);if(o!==t.entries){for(;!(r=l.next()).done;)if(!C(r.value))return!1}else for(;!(r=l.next()).done;){var a=r.value;if(a&&!C(a[1]))return!1}return!0;default:return!1}}function P(e,t){return"symbol"===e||!!t&&("Symbol"===t["@@toStringTag"]||"function"==typeof Symbol&&t instanceof Symbol)}function M(e){var t=typeof e;return Array.isArray(e)?"array":e instanceof RegExp?"object":P(t,e)?"symbol":t}function O(e){if("undefined"==typeof e||null===e)return""+e;var t=M(e);if("object"===t){if(e instanceof Date)return"date";if(e instanceof RegExp)return"regexp"}return t}function R(e){var t=O(e);switch(t){case"array":case"object":return"an "+t;case"boolean":case"date":case"regexp":return"a "+t;default:return t}}function S(e){return e.constructor&&e.constructor.name?e.constructor.name:L}var T="function"==typeof Symbol&&Symbol.iterator,N="@@iterator",L="<<anonymous>>",I={array:f("array"),bool:f("boolean"),func:f("function"),number:f("number"),object:f("object"),string:f("string"),symbol:f("symbol"),any:h(),arrayOf:m,element:b(),elementType:g(),instanceOf:_,node:w(),objectOf:y,oneOf:v,oneOfType:x,shape:k,exact:E};return d.prototype=Error.prototype,I.checkPropTypes=i,I.resetWarningCache=i.resetWarningCache,I.PropTypes=I,I}},function(e,t,n){"use strict";e.exports=n(26)},function(e,t){"use strict";function n(e){if("object"==typeof e&&null!==e){var t=e.$$typeof;switch(t){case l:switch(e=e.type){case p:case f:case i:case c:case s:case m:return e;default:switch(e=e&&e.$$typeof){case d:case h:case u:return e;default:return t}}case g:case b:case a:return t}}}function o(e){return n(e)===f}Object.defineProperty(t,"__esModule",{value:!0});var r="function"==typeof Symbol&&Symbol.for,l=r?Symbol.for("react.element"):60103,a=r?Symbol.for("react.portal"):60106,i=r?Symbol.for("react.fragment"):60107,s=r?Symbol.for("react.strict_mode"):60108,c=r?Symbol.for("react.profiler"):60114,u=r?Symbol.for("react.provider"):60109,d=r?Symbol.for("react.context"):60110,p=r?Symbol.for("react.async_mode"):60111,f=r?Symbol.for("react.concurrent_mode"):60111,h=r?Symbol.for("react.forward_ref"):60112,m=r?Symbol.for("react.suspense"):60113,b=r?Symbol.for("react.memo"):60115,g=r?Symbol.for("react.lazy"):60116;t.typeOf=n,t.AsyncMode=p,t.ConcurrentMode=f,t.ContextConsumer=d,t.ContextProvider=u,t.Element=l,t.ForwardRef=h,t.Fragment=i,t.Lazy=g,t.Memo=b,t.Portal=a,t.Profiler=c,t.StrictMode=s,t.Suspense=m,t.isValidElementType=function(e){return"string"==typeof e||"function"==typeof e||e===i||e===f||e===c||e===s||e===m||"object"==typeof e&&null!==e&&(e.$$typeof===g||e.$$typeof===b||e.$$typeof===u||e.$$typeof===d||e.$$typeof===h)},t.isAsyncMode=function(e){return o(e)||n(e)===p},t.isConcurrentMode=o,t.isContextConsumer=function(e){return n(e)===d},t.isContextProvider=function(e){return n(e)===u},t.isElement=function(e){return"object"==typeof e&&null!==e&&e.$$typeof===l},t.isForwardRef=function(e){return n(e)===h},t.isFragment=function(e){return n(e)===i},t.isLazy=function(e){return n(e)===g},t.isMemo=function(e){return n(e)===b},t.isPortal=function(e){return n(e)===a},t.isProfiler=function(e){return n(e)===c},t.isStrictMode=function(e){return n(e)===s},t.isSuspense=function(e){return n(e)===m}},function(e,t){"use strict";var n="SECRET_DO_NOT_PASS_THIS_OR_YOU_WILL_BE_FIRED";e.exports=n},function(e,t,n){"use strict";function o(e,t,n,o,r){}o.resetWarningCache=function(){},e.exports=o},function(e,t){"use strict";e.exports="15.6.2"},function(e,t,n){"use strict";var o=n(4),r=o.Component,l=n(15),a=l.isValidElement,i=n(6),s=n(31);e.exports=s(r,a,i)},function(e,t,n){"use strict";function o(e){return e}function r(e,t,n){function r(e,t){var n=_.hasOwnProperty(t)?_[t]:null;k.hasOwnProperty(t)&&s("OVERRIDE_BASE"===n,"ReactClassInterface: You are attempting to override `%s` from your class specification. Ensure that your method names do not overlap with React methods.",t),e&&s("DEFINE_MANY"===n||"DEFINE_MANY_MERGED"===n,"ReactClassInterface: You are attempting to define `%s` on your component more than once. This conflict may be due to a mixin.",t)}function l(e,n){if(n){s("function"!=typeof n,"ReactClass: You're attempting to use a component class or function as a mixin. Instead, just use a regular object."),s(!t(n),"ReactClass: You're attempting to use a component as a mixin. Instead, just use a regular object.");var o=e.prototype,l=o.__reactAutoBindPairs;n.hasOwnProperty(c)&&y.mixins(e,n.mixins);for(var a in n)if(n.hasOwnProperty(a)&&a!==c){var i=n[a],u=o.hasOwnProperty(a);if(r(u,a),y.hasOwnProperty(a))y[a](e,i);else{var d=_.hasOwnProperty(a),h="function"==typeof i,m=h&&!d&&!u&&n.autobind!==!1;if(m)l.push(a,i),o[a]=i;else if(u){var b=_[a];s(d&&("DEFINE_MANY_MERGED"===b||"DEFINE_MANY"===b),"ReactClass: Unexpected spec policy %s for key %s when mixing in component specs.",b,a),"DEFINE_MANY_MERGED"===b?o[a]=p(o[a],i):"DEFINE_MANY"===b&&(o[a]=f(o[a],i))}else o[a]=i}}}else;}function u(e,t){if(t)for(var n in t){var o=t[n];if(t.hasOwnProperty(n)){var r=n in y;s(!r,'ReactClass: You are attempting to define a reserved property, `%s`, that shouldn\'t be on the "statics" key. Define it as an instance property instead; it will still be accessible on the constructor.',n);var l=n in e;if(l){var a=v.hasOwnProperty(n)?v[n]:null;return s("DEFINE_MANY_MERGED"===a,"ReactClass: You are attempting to define `%s` on your component more than once. This conflict may be due to a mixin.",n),void(e[n]=p(e[n],o))}e[n]=o}}}function d(e,t){s(e&&t&&"object"==typeof e&&"object"==typeof t,"mergeIntoWithNoDuplicateKeys(): Cannot merge non-objects.");for(var n in t)t.hasOwnProperty(n)&&(s(void 0===e[n],"mergeIntoWithNoDuplicateKeys(): Tried to merge two objects with the same key: `%s`. This conflict may be due to a mixin; in particular, this may be caused by two getInitialState() or getDefaultProps() methods returning objects with clashing keys.",n),e[n]=t[n]);return e}function p(e,t){return function(){var n=e.apply(this,arguments),o=t.apply(this,arguments);if(null==n)return o;if(null==o)return n;var r={};return d(r,n),d(r,o),r}}function f(e,t){return function(){e.apply(this,arguments),t.apply(this,arguments)}}function h(e,t){var n=t.bind(e);return n}function m(e){for(var t=e.__reactAutoBindPairs,n=0;n<t.length;n+=2){var o=t[n],r=t[n+1];e[o]=h(e,r)}}function b(e){var t=o(function(e,o,r){this.__reactAutoBindPairs.length&&m(this),this.props=e,this.context=o,this.refs=i,this.updater=r||n,this.state=null;var l=this.getInitialState?this.getInitialState():null;s("object"==typeof l&&!Array.isArray(l),"%s.getInitialState(): must return an object or null",t.displayName||"ReactCompositeComponent"),this.state=l});t.prototype=new E,t.prototype.constructor=t,t.prototype.__reactAutoBindPairs=[],g.forEach(l.bind(null,t)),l(t,x),l(t,e),l(t,w),t.getDefaultProps&&(t.defaultProps=t.getDefaultProps()),s(t.prototype.render,"createClass(...): Class specification must implement a `render` method.");for(var r in _)t.prototype[r]||(t.prototype[r]=null);return t}var g=[],_={mixins:"DEFINE_MANY",statics:"DEFINE_MANY",propTypes:"DEFINE_MANY",contextTypes:"DEFINE_MANY",childContextTypes:"DEFINE_MANY",getDefaultProps:"DEFINE_MANY_MERGED",getInitialState:"DEFINE_MANY_MERGED",getChildContext:"DEFINE_MANY_MERGED",render:"DEFINE_ONCE",componentWillMount:"DEFINE_MANY",componentDidMount:"DEFINE_MANY",componentWillReceiveProps:"DEFINE_MANY",shouldComponentUpdate:"DEFINE_ONCE",componentWillUpdate:"DEFINE_MANY",componentDidUpdate:"DEFINE_MANY",componentWillUnmount:"DEFINE_MANY",UNSAFE_componentWillMount:"DEFINE_MANY",UNSAFE_componentWillReceiveProps:"DEFINE_MANY",UNSAFE_componentWillUpdate:"DEFINE_MANY",updateComponent:"OVERRIDE_BASE"},v={getDerivedStateFromProps:"DEFINE_MANY_MERGED"},y={displayName:function(e,t){e.displayName=t},mixins:function(e,t){if(t)for(var n=0;n<t.length;n++)l(e,t[n])},childContextTypes:function(e,t){e.childContextTypes=a({},e.childContextTypes,t)},contextTypes:function(e,t){e.contextTypes=a({},e.contextTypes,t)},getDefaultProps:function(e,t){e.getDefaultProps?e.getDefaultProps=p(e.getDefaultProps,t):e.getDefaultProps=t},propTypes:function(e,t){e.propTypes=a({},e.propTypes,t)},statics:function(e,t){u(e,t)},autobind:function(){}},x={componentDidMount:function(){this.__isMounted=!0}},w={componentWillUnmount:function(){this.__isMounted=!1}},k={replaceState:function(e,t){this.updater.enqueueReplaceState(this,e,t)},isMounted:function(){return!!this.__isMounted}},E=function(){};return a(E.prototype,e.prototype,k),b}var l,a=n(3),i=n(10),s=n(11),c="mixins";l={},e.exports=r},function(e,t,n){"use strict";function o(e){return l.isValidElement(e)?void 0:r("143"),e}var r=n(5),l=n(15);n(11);e.exports=o},function(e,t,n){"use strict";e.exports=n(34)},function(e,t,n){"use strict";var o=n(35),r=n(39),l=n(163),a=n(60),i=n(57),s=n(168),c=n(169),u=n(170),d=n(171);n(7);r.inject();var p={findDOMNode:c,render:l.render,unmountComponentAtNode:l.unmountComponentAtNode,version:s,unstable_batchedUpdates:i.batchedUpdates,unstable_renderSubtreeIntoContainer:d};"undefined"!=typeof __REACT_DEVTOOLS_GLOBAL_HOOK__&&"function"==typeof __REACT_DEVTOOLS_GLOBAL_HOOK__.inject&&__REACT_DEVTOOLS_GLOBAL_HOOK__.inject({ComponentTree:{getClosestInstanceFromNode:o.getClosestInstanceFromNode,getNodeFromInstance:function(e){return e._renderedComponent&&(e=u(e)),e?o.getNodeFromInstance(e):null}},Mount:l,Reconciler:a});e.exports=p},function(e,t,n){"use strict";function o(e,t){return 1===e.nodeType&&e.getAttribute(h)===String(t)||8===e.nodeType&&e.nodeValue===" react-text: "+t+" "||8===e.nodeType&&e.nodeValue===" react-empty: "+t+" "}function r(e){for(var t;t=e._renderedComponent;)e=t;return e}function l(e,t){var n=r(e);n._hostNode=t,t[b]=n}function a(e){var t=e._hostNode;t&&(delete t[b],e._hostNode=null)}function i(e,t){if(!(e._flags&m.hasCachedChildNodes)){var n=e._renderedChildren,a=t.firstChild;e:for(var i in n)if(n.hasOwnProperty(i)){var s=n[i],c=r(s)._domID;if(0!==c){for(;null!==a;a=a.nextSibling)if(o(a,c)){l(s,a);continue e}d("32",c)}}e._flags|=m.hasCachedChildNodes}}function s(e){if(e[b])return e[b];for(var t=[];!e[b];){if(t.push(e),!e.parentNode)return null;e=e.parentNode}for(var n,o;e&&(o=e[b]);e=t.pop())n=o,t.length&&i(o,e);return n}function c(e){var t=s(e);return null!=t&&t._hostNode===e?t:null}function u(e){if(void 0===e._hostNode?d("33"):void 0,e._hostNode)return e._hostNode;for(var t=[];!e._hostNode;)t.push(e),e._hostParent?void 0:d("34"),e=e._hostParent;for(;t.length;e=t.pop())i(e,e._hostNode);return e._hostNode}var d=n(36),p=n(37),f=n(38),h=(n(11),p.ID_ATTRIBUTE_NAME),m=f,b="__reactInternalInstance$"+Math.random().toString(36).slice(2),g={getClosestInstanceFromNode:s,getInstanceFromNode:c,getNodeFromInstance:u,precacheChildNodes:i,precacheNode:l,uncacheNode:a};e.exports=g},function(e,t){"use strict";function n(e){for(var t=arguments.length-1,n="Minified React error #"+e+"; visit http://facebook.github.io/react/docs/error-decoder.html?invariant="+e,o=0;o<t;o++)n+="&args[]="+encodeURIComponent(arguments[o+1]);n+=" for the full message or use the non-minified dev environment for full errors and additional helpful warnings.";var r=new Error(n);throw r.name="Invariant Violation",r.framesToPop=1,r}e.exports=n},function(e,t,n){"use strict";function o(e,t){return(e&t)===t}var r=n(36),l=(n(11),{MUST_USE_PROPERTY:1,HAS_BOOLEAN_VALUE:4,HAS_NUMERIC_VALUE:8,HAS_POSITIVE_NUMERIC_VALUE:24,HAS_OVERLOADED_BOOLEAN_VALUE:32,injectDOMPropertyConfig:function(e){var t=l,n=e.Properties||{},a=e.DOMAttributeNamespaces||{},s=e.DOMAttributeNames||{},c=e.DOMPropertyNames||{},u=e.DOMMutationMethods||{};e.isCustomAttribute&&i._isCustomAttributeFunctions.push(e.isCustomAttribute);
+!function(e){function t(o){if(n[o])return n[o].exports;var r=n[o]={exports:{},id:o,loaded:!1};return e[o].call(r.exports,r,r.exports,t),r.loaded=!0,r.exports}var n={};return t.m=e,t.c=n,t.p="",t(0)}([function(module,exports,__webpack_require__){"use strict";Object.defineProperty(exports,"__esModule",{value:true});function _interopRequireDefault(obj){return obj&&obj.__esModule?obj:{default:obj}}var React=_interopRequireDefault(__webpack_require__(1)).default,render=__webpack_require__(33).render,Processes=_interopRequireDefault(__webpack_require__(172)).default,DeadJobs=_interopRequireDefault(__webpack_require__(183)).default,Queues=_interopRequireDefault(__webpack_require__(185)).default,Concurrency=_interopRequireDefault(__webpack_require__(252)).default,RetryJobs=_interopRequireDefault(__webpack_require__(186)).default,ScheduledJobs=_interopRequireDefault(__webpack_require__(187)).default,Batches=_interopRequireDefault(__webpack_require__(251)).default,Workflows=_interopRequireDefault(__webpack_require__(258)).default,FindJob=_interopRequireDefault(__webpack_require__(253)).default,PeriodicJobs=_interopRequireDefault(__webpack_require__(254)).default,Router=__webpack_require__(188).Router,Route=__webpack_require__(188).Route,Link=__webpack_require__(188).Link,IndexRedirect=__webpack_require__(188).IndexRedirect,hashHistory=__webpack_require__(188).hashHistory,styles=_interopRequireDefault(__webpack_require__(180)).default,cx=_interopRequireDefault(__webpack_require__(182)).default;class App extends React.Component{constructor(props){super(props);this.state={NsValue:""}}changeNamespace(){let ns=this.state.NsValue;localStorage.setItem("ns",ns);fetch(`/change_namespace/${ns}`,{method:"post"}).then(()=>{location.reload()})}changeInput(v){this.setState({NsValue:v.target.value})}render(){return React.createElement("div",{className:styles.container,style:{marginTop:30,marginBottom:60}},React.createElement("header",null,React.createElement("h1",null,"gocraft/work")),React.createElement("hr",null),React.createElement("div",{className:styles.row},React.createElement("main",{className:styles.colMd10},this.props.children),React.createElement("aside",{className:styles.colMd2},React.createElement("nav",null,React.createElement("ul",{className:cx(styles.nav,styles.navPills,styles.navStacked)},React.createElement("li",null,"Namespace:  ",localStorage.getItem("ns")),React.createElement("li",null,React.createElement("input",{placeholder:"NameSpace",value:this.state.NsValue,onChange:v=>this.changeInput(v)})," ",React.createElement("button",{onClick:v=>this.changeNamespace()},"Reload")),React.createElement("li",null,React.createElement(Link,{to:"/processes"},"Processes")),React.createElement("li",null,React.createElement(Link,{to:"/queues"},"Queues")),React.createElement("li",null,React.createElement(Link,{to:"/concurrency"},"Concurrency")),React.createElement("li",null,React.createElement(Link,{to:"/retry_jobs"},"Retry Jobs")),React.createElement("li",null,React.createElement(Link,{to:"/scheduled_jobs"},"Scheduled Jobs")),React.createElement("li",null,React.createElement(Link,{to:"/dead_jobs"},"Dead Jobs")),React.createElement("li",null,React.createElement(Link,{to:"/periodic_jobs"},"Periodic Jobs")),React.createElement("li",null,React.createElement(Link,{to:"/batches"},"Batches")),React.createElement("li",null,React.createElement(Link,{to:"/workflows"},"Workflows")),React.createElement("li",null,React.createElement(Link,{to:"/find_job"},"Find Job")))))))}}App.propTypes={children:React.PropTypes.element.isRequired};render(React.createElement(Router,{history:hashHistory},React.createElement(Route,{path:"/",component:App},React.createElement(Route,{path:"/processes",component:()=>React.createElement(Processes,{busyWorkerURL:"/busy_workers",workerPoolURL:"/worker_pools"})}),React.createElement(Route,{path:"/queues",component:()=>React.createElement(Queues,{url:"/queues",pauseURL:"/pause_job",unpauseURL:"/unpause_job",cancelURL:"/cancel_job"})}),React.createElement(Route,{path:"/concurrency",component:()=>React.createElement(Concurrency,{url:"/concurrency",setURL:"/set_max_concurrency",resetURL:"/reset_max_concurrency"})}),React.createElement(Route,{path:"/retry_jobs",component:()=>React.createElement(RetryJobs,{url:"/retry_jobs"})}),React.createElement(Route,{path:"/scheduled_jobs",component:()=>React.createElement(ScheduledJobs,{url:"/scheduled_jobs"})}),React.createElement(Route,{path:"/dead_jobs",component:()=>React.createElement(DeadJobs,{fetchURL:"/dead_jobs",retryURL:"/retry_dead_job",retryAllURL:"/retry_all_dead_jobs",deleteURL:"/delete_dead_job",deleteAllURL:"/delete_all_dead_jobs"})}),React.createElement(Route,{path:"/periodic_jobs",component:()=>React.createElement(PeriodicJobs,{url:"/periodic_jobs",runURL:"/run_periodic_job"})}),React.createElement(Route,{path:"/batches",component:()=>React.createElement(Batches,{url:"/batches"})}),React.createElement(Route,{path:"/workflows",component:()=>React.createElement(Workflows,{url:"/workflows"})}),React.createElement(Route,{path:"/find_job",component:()=>React.createElement(FindJob,{url:"/find_job"})}),React.createElement(IndexRedirect,{from:"",to:"/processes"}))),document.getElementById("app"))},function(e,t,n){"use strict";e.exports=n(2)},function(e,t,n){"use strict";var o=n(3),r=n(4),l=n(13),a=n(21),i=n(15),s=n(22),c=n(29),u=n(30),d=n(32),p=i.createElement,f=i.createFactory,h=i.cloneElement,m=o,b=function(e){return e},g={Children:{map:l.map,forEach:l.forEach,count:l.count,toArray:l.toArray,only:d},Component:r.Component,PureComponent:r.PureComponent,createElement:p,cloneElement:h,isValidElement:i.isValidElement,PropTypes:s,createClass:u,createFactory:f,createMixin:b,DOM:a,version:c,__spread:m};e.exports=g},function(e,t){"use strict";function n(e){if(null===e||void 0===e)throw new TypeError("Object.assign cannot be called with null or undefined");return Object(e)}function o(){try{if(!Object.assign)return!1;var e=new String("abc");if(e[5]="de","5"===Object.getOwnPropertyNames(e)[0])return!1;for(var t={},n=0;n<10;n++)t["_"+String.fromCharCode(n)]=n;var o=Object.getOwnPropertyNames(t).map(function(e){return t[e]});if("0123456789"!==o.join(""))return!1;var r={};return"abcdefghijklmnopqrst".split("").forEach(function(e){r[e]=e}),"abcdefghijklmnopqrst"===Object.keys(Object.assign({},r)).join("")}catch(e){return!1}}var r=Object.getOwnPropertySymbols,l=Object.prototype.hasOwnProperty,a=Object.prototype.propertyIsEnumerable;e.exports=o()?Object.assign:function(e,t){for(var o,i,s=n(e),c=1;c<arguments.length;c++){o=Object(arguments[c]);for(var u in o)l.call(o,u)&&(s[u]=o[u]);if(r){i=r(o);for(var d=0;d<i.length;d++)a.call(o,i[d])&&(s[i[d]]=o[i[d]])}}return s}},function(e,t,n){"use strict";function o(e,t,n){this.props=e,this.context=t,this.refs=c,this.updater=n||s}function r(e,t,n){this.props=e,this.context=t,this.refs=c,this.updater=n||s}function l(){}var a=n(5),i=n(3),s=n(6),c=(n(9),n(10));n(11),n(12);o.prototype.isReactComponent={},o.prototype.setState=function(e,t){"object"!=typeof e&&"function"!=typeof e&&null!=e?a("85"):void 0,this.updater.enqueueSetState(this,e),t&&this.updater.enqueueCallback(this,t,"setState")},o.prototype.forceUpdate=function(e){this.updater.enqueueForceUpdate(this),e&&this.updater.enqueueCallback(this,e,"forceUpdate")};l.prototype=o.prototype,r.prototype=new l,r.prototype.constructor=r,i(r.prototype,o.prototype),r.prototype.isPureReactComponent=!0,e.exports={Component:o,PureComponent:r}},function(e,t){"use strict";function n(e){for(var t=arguments.length-1,n="Minified React error #"+e+"; visit http://facebook.github.io/react/docs/error-decoder.html?invariant="+e,o=0;o<t;o++)n+="&args[]="+encodeURIComponent(arguments[o+1]);n+=" for the full message or use the non-minified dev environment for full errors and additional helpful warnings.";var r=new Error(n);throw r.name="Invariant Violation",r.framesToPop=1,r}e.exports=n},function(e,t,n){"use strict";function o(e,t){}var r=(n(7),{isMounted:function(e){return!1},enqueueCallback:function(e,t){},enqueueForceUpdate:function(e){o(e,"forceUpdate")},enqueueReplaceState:function(e,t){o(e,"replaceState")},enqueueSetState:function(e,t){o(e,"setState")}});e.exports=r},function(e,t,n){"use strict";var o=n(8),r=o;e.exports=r},function(e,t){"use strict";function n(e){return function(){return e}}var o=function(){};o.thatReturns=n,o.thatReturnsFalse=n(!1),o.thatReturnsTrue=n(!0),o.thatReturnsNull=n(null),o.thatReturnsThis=function(){return this},o.thatReturnsArgument=function(e){return e},e.exports=o},function(e,t,n){"use strict";var o=!1;e.exports=o},function(e,t,n){"use strict";var o={};e.exports=o},function(e,t,n){"use strict";function o(e,t,n,o,l,a,i,s){if(r(t),!e){var c;if(void 0===t)c=new Error("Minified exception occurred; use the non-minified dev environment for the full error message and additional helpful warnings.");else{var u=[n,o,l,a,i,s],d=0;c=new Error(t.replace(/%s/g,function(){return u[d++]})),c.name="Invariant Violation"}throw c.framesToPop=1,c}}var r=function(e){};e.exports=o},function(e,t,n){"use strict";var o=function(){};e.exports=o},function(e,t,n){"use strict";function o(e){return(""+e).replace(y,"$&/")}function r(e,t){this.func=e,this.context=t,this.count=0}function l(e,t,n){var o=e.func,r=e.context;o.call(r,t,e.count++)}function a(e,t,n){if(null==e)return e;var o=r.getPooled(t,n);g(e,l,o),r.release(o)}function i(e,t,n,o){this.result=e,this.keyPrefix=t,this.func=n,this.context=o,this.count=0}function s(e,t,n){var r=e.result,l=e.keyPrefix,a=e.func,i=e.context,s=a.call(i,t,e.count++);Array.isArray(s)?c(s,r,n,b.thatReturnsArgument):null!=s&&(m.isValidElement(s)&&(s=m.cloneAndReplaceKey(s,l+(!s.key||t&&t.key===s.key?"":o(s.key)+"/")+n)),r.push(s))}function c(e,t,n,r,l){var a="";null!=n&&(a=o(n)+"/");var c=i.getPooled(t,a,r,l);g(e,s,c),i.release(c)}function u(e,t,n){if(null==e)return e;var o=[];return c(e,o,null,t,n),o}function d(e,t,n){return null}function p(e,t){return g(e,d,null)}function f(e){var t=[];return c(e,t,null,b.thatReturnsArgument),t}var h=n(14),m=n(15),b=n(8),g=n(18),_=h.twoArgumentPooler,v=h.fourArgumentPooler,y=/\/+/g;r.prototype.destructor=function(){this.func=null,this.context=null,this.count=0},h.addPoolingTo(r,_),i.prototype.destructor=function(){this.result=null,this.keyPrefix=null,this.func=null,this.context=null,this.count=0},h.addPoolingTo(i,v);var x={forEach:a,map:u,mapIntoWithKeyPrefixInternal:c,count:p,toArray:f};e.exports=x},function(e,t,n){"use strict";var o=n(5),r=(n(11),function(e){var t=this;if(t.instancePool.length){var n=t.instancePool.pop();return t.call(n,e),n}return new t(e)}),l=function(e,t){var n=this;if(n.instancePool.length){var o=n.instancePool.pop();return n.call(o,e,t),o}return new n(e,t)},a=function(e,t,n){var o=this;if(o.instancePool.length){var r=o.instancePool.pop();return o.call(r,e,t,n),r}return new o(e,t,n)},i=function(e,t,n,o){var r=this;if(r.instancePool.length){var l=r.instancePool.pop();return r.call(l,e,t,n,o),l}return new r(e,t,n,o)},s=function(e){var t=this;e instanceof t?void 0:o("25"),e.destructor(),t.instancePool.length<t.poolSize&&t.instancePool.push(e)},c=10,u=r,d=function(e,t){var n=e;return n.instancePool=[],n.getPooled=t||u,n.poolSize||(n.poolSize=c),n.release=s,n},p={addPoolingTo:d,oneArgumentPooler:r,twoArgumentPooler:l,threeArgumentPooler:a,fourArgumentPooler:i};e.exports=p},function(e,t,n){"use strict";function o(e){return void 0!==e.ref}function r(e){return void 0!==e.key}var l=n(3),a=n(16),i=(n(7),n(9),Object.prototype.hasOwnProperty),s=n(17),c={key:!0,ref:!0,__self:!0,__source:!0},u=function(e,t,n,o,r,l,a){var i={$$typeof:s,type:e,key:t,ref:n,props:a,_owner:l};return i};u.createElement=function(e,t,n){var l,s={},d=null,p=null,f=null,h=null;if(null!=t){o(t)&&(p=t.ref),r(t)&&(d=""+t.key),f=void 0===t.__self?null:t.__self,h=void 0===t.__source?null:t.__source;for(l in t)i.call(t,l)&&!c.hasOwnProperty(l)&&(s[l]=t[l])}var m=arguments.length-2;if(1===m)s.children=n;else if(m>1){for(var b=Array(m),g=0;g<m;g++)b[g]=arguments[g+2];s.children=b}if(e&&e.defaultProps){var _=e.defaultProps;for(l in _)void 0===s[l]&&(s[l]=_[l])}return u(e,d,p,f,h,a.current,s)},u.createFactory=function(e){var t=u.createElement.bind(null,e);return t.type=e,t},u.cloneAndReplaceKey=function(e,t){var n=u(e.type,t,e.ref,e._self,e._source,e._owner,e.props);return n},u.cloneElement=function(e,t,n){var s,d=l({},e.props),p=e.key,f=e.ref,h=e._self,m=e._source,b=e._owner;if(null!=t){o(t)&&(f=t.ref,b=a.current),r(t)&&(p=""+t.key);var g;e.type&&e.type.defaultProps&&(g=e.type.defaultProps);for(s in t)i.call(t,s)&&!c.hasOwnProperty(s)&&(void 0===t[s]&&void 0!==g?d[s]=g[s]:d[s]=t[s])}var _=arguments.length-2;if(1===_)d.children=n;else if(_>1){for(var v=Array(_),y=0;y<_;y++)v[y]=arguments[y+2];d.children=v}return u(e.type,p,f,h,m,b,d)},u.isValidElement=function(e){return"object"==typeof e&&null!==e&&e.$$typeof===s},e.exports=u},function(e,t){"use strict";var n={current:null};e.exports=n},function(e,t){"use strict";var n="function"==typeof Symbol&&Symbol.for&&Symbol.for("react.element")||60103;e.exports=n},function(e,t,n){"use strict";function o(e,t){return e&&"object"==typeof e&&null!=e.key?c.escape(e.key):t.toString(36)}function r(e,t,n,l){var p=typeof e;if("undefined"!==p&&"boolean"!==p||(e=null),null===e||"string"===p||"number"===p||"object"===p&&e.$$typeof===i)return n(l,e,""===t?u+o(e,0):t),1;var f,h,m=0,b=""===t?u:t+d;if(Array.isArray(e))for(var g=0;g<e.length;g++)f=e[g],h=b+o(f,g),m+=r(f,h,n,l);else{var _=s(e);if(_){var v,y=_.call(e);if(_!==e.entries)for(var x=0;!(v=y.next()).done;)f=v.value,h=b+o(f,x++),m+=r(f,h,n,l);else for(;!(v=y.next()).done;){var w=v.value;w&&(f=w[1],h=b+c.escape(w[0])+d+o(f,0),m+=r(f,h,n,l))}}else if("object"===p){var k="",E=String(e);a("31","[object Object]"===E?"object with keys {"+Object.keys(e).join(", ")+"}":E,k)}}return m}function l(e,t,n){return null==e?0:r(e,"",t,n)}var a=n(5),i=(n(16),n(17)),s=n(19),c=(n(11),n(20)),u=(n(7),"."),d=":";e.exports=l},function(e,t){"use strict";function n(e){var t=e&&(o&&e[o]||e[r]);if("function"==typeof t)return t}var o="function"==typeof Symbol&&Symbol.iterator,r="@@iterator";e.exports=n},function(e,t){"use strict";function n(e){var t=/[=:]/g,n={"=":"=0",":":"=2"},o=(""+e).replace(t,function(e){return n[e]});return"$"+o}function o(e){var t=/(=0|=2)/g,n={"=0":"=","=2":":"},o="."===e[0]&&"$"===e[1]?e.substring(2):e.substring(1);return(""+o).replace(t,function(e){return n[e]})}var r={escape:n,unescape:o};e.exports=r},function(e,t,n){"use strict";var o=n(15),r=o.createFactory,l={a:r("a"),abbr:r("abbr"),address:r("address"),area:r("area"),article:r("article"),aside:r("aside"),audio:r("audio"),b:r("b"),base:r("base"),bdi:r("bdi"),bdo:r("bdo"),big:r("big"),blockquote:r("blockquote"),body:r("body"),br:r("br"),button:r("button"),canvas:r("canvas"),caption:r("caption"),cite:r("cite"),code:r("code"),col:r("col"),colgroup:r("colgroup"),data:r("data"),datalist:r("datalist"),dd:r("dd"),del:r("del"),details:r("details"),dfn:r("dfn"),dialog:r("dialog"),div:r("div"),dl:r("dl"),dt:r("dt"),em:r("em"),embed:r("embed"),fieldset:r("fieldset"),figcaption:r("figcaption"),figure:r("figure"),footer:r("footer"),form:r("form"),h1:r("h1"),h2:r("h2"),h3:r("h3"),h4:r("h4"),h5:r("h5"),h6:r("h6"),head:r("head"),header:r("header"),hgroup:r("hgroup"),hr:r("hr"),html:r("html"),i:r("i"),iframe:r("iframe"),img:r("img"),input:r("input"),ins:r("ins"),kbd:r("kbd"),keygen:r("keygen"),label:r("label"),legend:r("legend"),li:r("li"),link:r("link"),main:r("main"),map:r("map"),mark:r("mark"),menu:r("menu"),menuitem:r("menuitem"),meta:r("meta"),meter:r("meter"),nav:r("nav"),noscript:r("noscript"),object:r("object"),ol:r("ol"),optgroup:r("optgroup"),option:r("option"),output:r("output"),p:r("p"),param:r("param"),picture:r("picture"),pre:r("pre"),progress:r("progress"),q:r("q"),rp:r("rp"),rt:r("rt"),ruby:r("ruby"),s:r("s"),samp:r("samp"),script:r("script"),section:r("section"),select:r("select"),small:r("small"),source:r("source"),span:r("span"),strong:r("strong"),style:r("style"),sub:r("sub"),summary:r("summary"),sup:r("sup"),table:r("table"),tbody:r("tbody"),td:r("td"),textarea:r("textarea"),tfoot:r("tfoot"),th:r("th"),thead:r("thead"),time:r("time"),title:r("title"),tr:r("tr"),track:r("track"),u:r("u"),ul:r("ul"),var:r("var"),video:r("video"),wbr:r("wbr"),circle:r("circle"),clipPath:r("clipPath"),defs:r("defs"),ellipse:r("ellipse"),g:r("g"),image:r("image"),line:r("line"),linearGradient:r("linearGradient"),mask:r("mask"),path:r("path"),pattern:r("pattern"),polygon:r("polygon"),polyline:r("polyline"),radialGradient:r("radialGradient"),rect:r("rect"),stop:r("stop"),svg:r("svg"),text:r("text"),tspan:r("tspan")};e.exports=l},function(e,t,n){"use strict";var o=n(15),r=o.isValidElement,l=n(23);e.exports=l(r)},function(e,t,n){"use strict";var o=n(24);e.exports=function(e){var t=!1;return o(e,t)}},function(e,t,n){"use strict";function o(){return null}var r=n(25),l=n(3),a=n(27),i=n(28),s=Function.call.bind(Object.prototype.hasOwnProperty),c=function(){};e.exports=function(e,t){function n(e){var t=e&&(T&&e[T]||e[N]);if("function"==typeof t)return t}function u(e,t){return e===t?0!==e||1/e===1/t:e!==e&&t!==t}function d(e){this.message=e,this.stack=""}function p(e){function n(n,o,r,l,i,s,c){if(l=l||L,s=s||r,c!==a){if(t){var u=new Error("Calling PropTypes validators directly is not supported by the `prop-types` package. Use `PropTypes.checkPropTypes()` to call them. Read more at http://fb.me/use-check-prop-types");throw u.name="Invariant Violation",u}}return null==o[r]?n?new d(null===o[r]?"The "+i+" `"+s+"` is marked as required "+("in `"+l+"`, but its value is `null`."):"The "+i+" `"+s+"` is marked as required in "+("`"+l+"`, but its value is `undefined`.")):null:e(o,r,l,i,s)}var o=n.bind(null,!1);return o.isRequired=n.bind(null,!0),o}function f(e){function t(t,n,o,r,l,a){var i=t[n],s=M(i);if(s!==e){var c=O(i);return new d("Invalid "+r+" `"+l+"` of type "+("`"+c+"` supplied to `"+o+"`, expected ")+("`"+e+"`."))}return null}return p(t)}function h(){return p(o)}function m(e){function t(t,n,o,r,l){if("function"!=typeof e)return new d("Property `"+l+"` of component `"+o+"` has invalid PropType notation inside arrayOf.");var i=t[n];if(!Array.isArray(i)){var s=M(i);return new d("Invalid "+r+" `"+l+"` of type "+("`"+s+"` supplied to `"+o+"`, expected an array."))}for(var c=0;c<i.length;c++){var u=e(i,c,o,r,l+"["+c+"]",a);if(u instanceof Error)return u}return null}return p(t)}function b(){function t(t,n,o,r,l){var a=t[n];if(!e(a)){var i=M(a);return new d("Invalid "+r+" `"+l+"` of type "+("`"+i+"` supplied to `"+o+"`, expected a single ReactElement."))}return null}return p(t)}function g(){function e(e,t,n,o,l){var a=e[t];if(!r.isValidElementType(a)){var i=M(a);return new d("Invalid "+o+" `"+l+"` of type "+("`"+i+"` supplied to `"+n+"`, expected a single ReactElement type."))}return null}return p(e)}function _(e){function t(t,n,o,r,l){if(!(t[n]instanceof e)){var a=e.name||L,i=S(t[n]);return new d("Invalid "+r+" `"+l+"` of type "+("`"+i+"` supplied to `"+o+"`, expected ")+("instance of `"+a+"`."))}return null}return p(t)}function v(e){function t(t,n,o,r,l){for(var a=t[n],i=0;i<e.length;i++)if(u(a,e[i]))return null;var s=JSON.stringify(e,function(e,t){var n=O(t);return"symbol"===n?String(t):t});return new d("Invalid "+r+" `"+l+"` of value `"+String(a)+"` "+("supplied to `"+o+"`, expected one of "+s+"."))}return Array.isArray(e)?p(t):o}function y(e){function t(t,n,o,r,l){if("function"!=typeof e)return new d("Property `"+l+"` of component `"+o+"` has invalid PropType notation inside objectOf.");var i=t[n],c=M(i);if("object"!==c)return new d("Invalid "+r+" `"+l+"` of type "+("`"+c+"` supplied to `"+o+"`, expected an object."));for(var u in i)if(s(i,u)){var p=e(i,u,o,r,l+"."+u,a);if(p instanceof Error)return p}return null}return p(t)}function x(e){function t(t,n,o,r,l){for(var i=0;i<e.length;i++){var s=e[i];if(null==s(t,n,o,r,l,a))return null}return new d("Invalid "+r+" `"+l+"` supplied to "+("`"+o+"`."))}if(!Array.isArray(e))return o;for(var n=0;n<e.length;n++){var r=e[n];if("function"!=typeof r)return c("Invalid argument supplied to oneOfType. Expected an array of check functions, but received "+R(r)+" at index "+n+"."),o}return p(t)}function w(){function e(e,t,n,o,r){return C(e[t])?null:new d("Invalid "+o+" `"+r+"` supplied to "+("`"+n+"`, expected a ReactNode."))}return p(e)}function k(e){function t(t,n,o,r,l){var i=t[n],s=M(i);if("object"!==s)return new d("Invalid "+r+" `"+l+"` of type `"+s+"` "+("supplied to `"+o+"`, expected `object`."));for(var c in e){var u=e[c];if(u){var p=u(i,c,o,r,l+"."+c,a);if(p)return p}}return null}return p(t)}function E(e){function t(t,n,o,r,i){var s=t[n],c=M(s);if("object"!==c)return new d("Invalid "+r+" `"+i+"` of type `"+c+"` "+("supplied to `"+o+"`, expected `object`."));var u=l({},t[n],e);for(var p in u){var f=e[p];if(!f)return new d("Invalid "+r+" `"+i+"` key `"+p+"` supplied to `"+o+"`.\nBad object: "+JSON.stringify(t[n],null,"  ")+"\nValid keys: "+JSON.stringify(Object.keys(e),null,"  "));var h=f(s,p,o,r,i+"."+p,a);if(h)return h}return null}return p(t)}function C(t){switch(typeof t){case"number":case"string":case"undefined":return!0;case"boolean":return!t;case"object":if(Array.isArray(t))return t.every(C);if(null===t||e(t))return!0;var o=n(t);if(!o)return!1;var r,l=o.call(t);if(o!==t.entries){for(;!(r=l.next()).done;)if(!C(r.value))return!1}else for(;!(r=l.next()).done;){var a=r.value;if(a&&!C(a[1]))return!1}return!0;default:return!1}}function P(e,t){return"symbol"===e||!!t&&("Symbol"===t["@@toStringTag"]||"function"==typeof Symbol&&t instanceof Symbol)}function M(e){var t=typeof e;return Array.isArray(e)?"array":e instanceof RegExp?"object":P(t,e)?"symbol":t}function O(e){if("undefined"==typeof e||null===e)return""+e;var t=M(e);if("object"===t){if(e instanceof Date)return"date";if(e instanceof RegExp)return"regexp"}return t}function R(e){var t=O(e);switch(t){case"array":case"object":return"an "+t;case"boolean":case"date":case"regexp":return"a "+t;default:return t}}function S(e){return e.constructor&&e.constructor.name?e.constructor.name:L}var T="function"==typeof Symbol&&Symbol.iterator,N="@@iterator",L="<<anonymous>>",I={array:f("array"),bool:f("boolean"),func:f("function"),number:f("number"),object:f("object"),string:f("string"),symbol:f("symbol"),any:h(),arrayOf:m,element:b(),elementType:g(),instanceOf:_,node:w(),objectOf:y,oneOf:v,oneOfType:x,shape:k,exact:E};return d.prototype=Error.prototype,I.checkPropTypes=i,I.resetWarningCache=i.resetWarningCache,I.PropTypes=I,I}},function(e,t,n){"use strict";e.exports=n(26)},function(e,t){"use strict";function n(e){if("object"==typeof e&&null!==e){var t=e.$$typeof;switch(t){case l:switch(e=e.type){case p:case f:case i:case c:case s:case m:return e;default:switch(e=e&&e.$$typeof){case d:case h:case u:return e;default:return t}}case g:case b:case a:return t}}}function o(e){return n(e)===f}Object.defineProperty(t,"__esModule",{value:!0});var r="function"==typeof Symbol&&Symbol.for,l=r?Symbol.for("react.element"):60103,a=r?Symbol.for("react.portal"):60106,i=r?Symbol.for("react.fragment"):60107,s=r?Symbol.for("react.strict_mode"):60108,c=r?Symbol.for("react.profiler"):60114,u=r?Symbol.for("react.provider"):60109,d=r?Symbol.for("react.context"):60110,p=r?Symbol.for("react.async_mode"):60111,f=r?Symbol.for("react.concurrent_mode"):60111,h=r?Symbol.for("react.forward_ref"):60112,m=r?Symbol.for("react.suspense"):60113,b=r?Symbol.for("react.memo"):60115,g=r?Symbol.for("react.lazy"):60116;t.typeOf=n,t.AsyncMode=p,t.ConcurrentMode=f,t.ContextConsumer=d,t.ContextProvider=u,t.Element=l,t.ForwardRef=h,t.Fragment=i,t.Lazy=g,t.Memo=b,t.Portal=a,t.Profiler=c,t.StrictMode=s,t.Suspense=m,t.isValidElementType=function(e){return"string"==typeof e||"function"==typeof e||e===i||e===f||e===c||e===s||e===m||"object"==typeof e&&null!==e&&(e.$$typeof===g||e.$$typeof===b||e.$$typeof===u||e.$$typeof===d||e.$$typeof===h)},t.isAsyncMode=function(e){return o(e)||n(e)===p},t.isConcurrentMode=o,t.isContextConsumer=function(e){return n(e)===d},t.isContextProvider=function(e){return n(e)===u},t.isElement=function(e){return"object"==typeof e&&null!==e&&e.$$typeof===l},t.isForwardRef=function(e){return n(e)===h},t.isFragment=function(e){return n(e)===i},t.isLazy=function(e){return n(e)===g},t.isMemo=function(e){return n(e)===b},t.isPortal=function(e){return n(e)===a},t.isProfiler=function(e){return n(e)===c},t.isStrictMode=function(e){return n(e)===s},t.isSuspense=function(e){return n(e)===m}},function(e,t){"use strict";var n="SECRET_DO_NOT_PASS_THIS_OR_YOU_WILL_BE_FIRED";e.exports=n},function(e,t,n){"use strict";function o(e,t,n,o,r){}o.resetWarningCache=function(){},e.exports=o},function(e,t){"use strict";e.exports="15.6.2"},function(e,t,n){"use strict";var o=n(4),r=o.Component,l=n(15),a=l.isValidElement,i=n(6),s=n(31);e.exports=s(r,a,i)},function(e,t,n){"use strict";function o(e){return e}function r(e,t,n){function r(e,t){var n=_.hasOwnProperty(t)?_[t]:null;k.hasOwnProperty(t)&&s("OVERRIDE_BASE"===n,"ReactClassInterface: You are attempting to override `%s` from your class specification. Ensure that your method names do not overlap with React methods.",t),e&&s("DEFINE_MANY"===n||"DEFINE_MANY_MERGED"===n,"ReactClassInterface: You are attempting to define `%s` on your component more than once. This conflict may be due to a mixin.",t)}function l(e,n){if(n){s("function"!=typeof n,"ReactClass: You're attempting to use a component class or function as a mixin. Instead, just use a regular object."),s(!t(n),"ReactClass: You're attempting to use a component as a mixin. Instead, just use a regular object.");var o=e.prototype,l=o.__reactAutoBindPairs;n.hasOwnProperty(c)&&y.mixins(e,n.mixins);for(var a in n)if(n.hasOwnProperty(a)&&a!==c){var i=n[a],u=o.hasOwnProperty(a);if(r(u,a),y.hasOwnProperty(a))y[a](e,i);else{var d=_.hasOwnProperty(a),h="function"==typeof i,m=h&&!d&&!u&&n.autobind!==!1;if(m)l.push(a,i),o[a]=i;else if(u){var b=_[a];s(d&&("DEFINE_MANY_MERGED"===b||"DEFINE_MANY"===b),"ReactClass: Unexpected spec policy %s for key %s when mixing in component specs.",b,a),"DEFINE_MANY_MERGED"===b?o[a]=p(o[a],i):"DEFINE_MANY"===b&&(o[a]=f(o[a],i))}else o[a]=i}}}else;}function u(e,t){if(t)for(var n in t){var o=t[n];if(t.hasOwnProperty(n)){var r=n in y;s(!r,'ReactClass: You are attempting to define a reserved property, `%s`, that shouldn\'t be on the "statics" key. Define it as an instance property instead; it will still be accessible on the constructor.',n);var l=n in e;if(l){var a=v.hasOwnProperty(n)?v[n]:null;return s("DEFINE_MANY_MERGED"===a,"ReactClass: You are attempting to define `%s` on your component more than once. This conflict may be due to a mixin.",n),void(e[n]=p(e[n],o))}e[n]=o}}}function d(e,t){s(e&&t&&"object"==typeof e&&"object"==typeof t,"mergeIntoWithNoDuplicateKeys(): Cannot merge non-objects.");for(var n in t)t.hasOwnProperty(n)&&(s(void 0===e[n],"mergeIntoWithNoDuplicateKeys(): Tried to merge two objects with the same key: `%s`. This conflict may be due to a mixin; in particular, this may be caused by two getInitialState() or getDefaultProps() methods returning objects with clashing keys.",n),e[n]=t[n]);return e}function p(e,t){return function(){var n=e.apply(this,arguments),o=t.apply(this,arguments);if(null==n)return o;if(null==o)return n;var r={};return d(r,n),d(r,o),r}}function f(e,t){return function(){e.apply(this,arguments),t.apply(this,arguments)}}function h(e,t){var n=t.bind(e);return n}function m(e){for(var t=e.__reactAutoBindPairs,n=0;n<t.length;n+=2){var o=t[n],r=t[n+1];e[o]=h(e,r)}}function b(e){var t=o(function(e,o,r){this.__reactAutoBindPairs.length&&m(this),this.props=e,this.context=o,this.refs=i,this.updater=r||n,this.state=null;var l=this.getInitialState?this.getInitialState():null;s("object"==typeof l&&!Array.isArray(l),"%s.getInitialState(): must return an object or null",t.displayName||"ReactCompositeComponent"),this.state=l});t.prototype=new E,t.prototype.constructor=t,t.prototype.__reactAutoBindPairs=[],g.forEach(l.bind(null,t)),l(t,x),l(t,e),l(t,w),t.getDefaultProps&&(t.defaultProps=t.getDefaultProps()),s(t.prototype.render,"createClass(...): Class specification must implement a `render` method.");for(var r in _)t.prototype[r]||(t.prototype[r]=null);return t}var g=[],_={mixins:"DEFINE_MANY",statics:"DEFINE_MANY",propTypes:"DEFINE_MANY",contextTypes:"DEFINE_MANY",childContextTypes:"DEFINE_MANY",getDefaultProps:"DEFINE_MANY_MERGED",getInitialState:"DEFINE_MANY_MERGED",getChildContext:"DEFINE_MANY_MERGED",render:"DEFINE_ONCE",componentWillMount:"DEFINE_MANY",componentDidMount:"DEFINE_MANY",componentWillReceiveProps:"DEFINE_MANY",shouldComponentUpdate:"DEFINE_ONCE",componentWillUpdate:"DEFINE_MANY",componentDidUpdate:"DEFINE_MANY",componentWillUnmount:"DEFINE_MANY",UNSAFE_componentWillMount:"DEFINE_MANY",UNSAFE_componentWillReceiveProps:"DEFINE_MANY",UNSAFE_componentWillUpdate:"DEFINE_MANY",updateComponent:"OVERRIDE_BASE"},v={getDerivedStateFromProps:"DEFINE_MANY_MERGED"},y={displayName:function(e,t){e.displayName=t},mixins:function(e,t){if(t)for(var n=0;n<t.length;n++)l(e,t[n])},childContextTypes:function(e,t){e.childContextTypes=a({},e.childContextTypes,t)},contextTypes:function(e,t){e.contextTypes=a({},e.contextTypes,t)},getDefaultProps:function(e,t){e.getDefaultProps?e.getDefaultProps=p(e.getDefaultProps,t):e.getDefaultProps=t},propTypes:function(e,t){e.propTypes=a({},e.propTypes,t)},statics:function(e,t){u(e,t)},autobind:function(){}},x={componentDidMount:function(){this.__isMounted=!0}},w={componentWillUnmount:function(){this.__isMounted=!1}},k={replaceState:function(e,t){this.updater.enqueueReplaceState(this,e,t)},isMounted:function(){return!!this.__isMounted}},E=function(){};return a(E.prototype,e.prototype,k),b}var l,a=n(3),i=n(10),s=n(11),c="mixins";l={},e.exports=r},function(e,t,n){"use strict";function o(e){return l.isValidElement(e)?void 0:r("143"),e}var r=n(5),l=n(15);n(11);e.exports=o},function(e,t,n){"use strict";e.exports=n(34)},function(e,t,n){"use strict";var o=n(35),r=n(39),l=n(163),a=n(60),i=n(57),s=n(168),c=n(169),u=n(170),d=n(171);n(7);r.inject();var p={findDOMNode:c,render:l.render,unmountComponentAtNode:l.unmountComponentAtNode,version:s,unstable_batchedUpdates:i.batchedUpdates,unstable_renderSubtreeIntoContainer:d};"undefined"!=typeof __REACT_DEVTOOLS_GLOBAL_HOOK__&&"function"==typeof __REACT_DEVTOOLS_GLOBAL_HOOK__.inject&&__REACT_DEVTOOLS_GLOBAL_HOOK__.inject({ComponentTree:{getClosestInstanceFromNode:o.getClosestInstanceFromNode,getNodeFromInstance:function(e){return e._renderedComponent&&(e=u(e)),e?o.getNodeFromInstance(e):null}},Mount:l,Reconciler:a});e.exports=p},function(e,t,n){"use strict";function o(e,t){return 1===e.nodeType&&e.getAttribute(h)===String(t)||8===e.nodeType&&e.nodeValue===" react-text: "+t+" "||8===e.nodeType&&e.nodeValue===" react-empty: "+t+" "}function r(e){for(var t;t=e._renderedComponent;)e=t;return e}function l(e,t){var n=r(e);n._hostNode=t,t[b]=n}function a(e){var t=e._hostNode;t&&(delete t[b],e._hostNode=null)}function i(e,t){if(!(e._flags&m.hasCachedChildNodes)){var n=e._renderedChildren,a=t.firstChild;e:for(var i in n)if(n.hasOwnProperty(i)){var s=n[i],c=r(s)._domID;if(0!==c){for(;null!==a;a=a.nextSibling)if(o(a,c)){l(s,a);continue e}d("32",c)}}e._flags|=m.hasCachedChildNodes}}function s(e){if(e[b])return e[b];for(var t=[];!e[b];){if(t.push(e),!e.parentNode)return null;e=e.parentNode}for(var n,o;e&&(o=e[b]);e=t.pop())n=o,t.length&&i(o,e);return n}function c(e){var t=s(e);return null!=t&&t._hostNode===e?t:null}function u(e){if(void 0===e._hostNode?d("33"):void 0,e._hostNode)return e._hostNode;for(var t=[];!e._hostNode;)t.push(e),e._hostParent?void 0:d("34"),e=e._hostParent;for(;t.length;e=t.pop())i(e,e._hostNode);return e._hostNode}var d=n(36),p=n(37),f=n(38),h=(n(11),p.ID_ATTRIBUTE_NAME),m=f,b="__reactInternalInstance$"+Math.random().toString(36).slice(2),g={getClosestInstanceFromNode:s,getInstanceFromNode:c,getNodeFromInstance:u,precacheChildNodes:i,precacheNode:l,uncacheNode:a};e.exports=g},function(e,t){"use strict";function n(e){for(var t=arguments.length-1,n="Minified React error #"+e+"; visit http://facebook.github.io/react/docs/error-decoder.html?invariant="+e,o=0;o<t;o++)n+="&args[]="+encodeURIComponent(arguments[o+1]);n+=" for the full message or use the non-minified dev environment for full errors and additional helpful warnings.";var r=new Error(n);throw r.name="Invariant Violation",r.framesToPop=1,r}e.exports=n},function(e,t,n){"use strict";function o(e,t){return(e&t)===t}var r=n(36),l=(n(11),{MUST_USE_PROPERTY:1,HAS_BOOLEAN_VALUE:4,HAS_NUMERIC_VALUE:8,HAS_POSITIVE_NUMERIC_VALUE:24,HAS_OVERLOADED_BOOLEAN_VALUE:32,injectDOMPropertyConfig:function(e){var t=l,n=e.Properties||{},a=e.DOMAttributeNamespaces||{},s=e.DOMAttributeNames||{},c=e.DOMPropertyNames||{},u=e.DOMMutationMethods||{};e.isCustomAttribute&&i._isCustomAttributeFunctions.push(e.isCustomAttribute);
 for(var d in n){i.properties.hasOwnProperty(d)?r("48",d):void 0;var p=d.toLowerCase(),f=n[d],h={attributeName:p,attributeNamespace:null,propertyName:d,mutationMethod:null,mustUseProperty:o(f,t.MUST_USE_PROPERTY),hasBooleanValue:o(f,t.HAS_BOOLEAN_VALUE),hasNumericValue:o(f,t.HAS_NUMERIC_VALUE),hasPositiveNumericValue:o(f,t.HAS_POSITIVE_NUMERIC_VALUE),hasOverloadedBooleanValue:o(f,t.HAS_OVERLOADED_BOOLEAN_VALUE)};if(h.hasBooleanValue+h.hasNumericValue+h.hasOverloadedBooleanValue<=1?void 0:r("50",d),s.hasOwnProperty(d)){var m=s[d];h.attributeName=m}a.hasOwnProperty(d)&&(h.attributeNamespace=a[d]),c.hasOwnProperty(d)&&(h.propertyName=c[d]),u.hasOwnProperty(d)&&(h.mutationMethod=u[d]),i.properties[d]=h}}}),a=":A-Z_a-z\\u00C0-\\u00D6\\u00D8-\\u00F6\\u00F8-\\u02FF\\u0370-\\u037D\\u037F-\\u1FFF\\u200C-\\u200D\\u2070-\\u218F\\u2C00-\\u2FEF\\u3001-\\uD7FF\\uF900-\\uFDCF\\uFDF0-\\uFFFD",i={ID_ATTRIBUTE_NAME:"data-reactid",ROOT_ATTRIBUTE_NAME:"data-reactroot",ATTRIBUTE_NAME_START_CHAR:a,ATTRIBUTE_NAME_CHAR:a+"\\-.0-9\\u00B7\\u0300-\\u036F\\u203F-\\u2040",properties:{},getPossibleStandardName:null,_isCustomAttributeFunctions:[],isCustomAttribute:function(e){for(var t=0;t<i._isCustomAttributeFunctions.length;t++){var n=i._isCustomAttributeFunctions[t];if(n(e))return!0}return!1},injection:l};e.exports=i},function(e,t){"use strict";var n={hasCachedChildNodes:1};e.exports=n},function(e,t,n){"use strict";function o(){k||(k=!0,_.EventEmitter.injectReactEventListener(g),_.EventPluginHub.injectEventPluginOrder(i),_.EventPluginUtils.injectComponentTree(p),_.EventPluginUtils.injectTreeTraversal(h),_.EventPluginHub.injectEventPluginsByName({SimpleEventPlugin:w,EnterLeaveEventPlugin:s,ChangeEventPlugin:a,SelectEventPlugin:x,BeforeInputEventPlugin:l}),_.HostComponent.injectGenericComponentClass(d),_.HostComponent.injectTextComponentClass(m),_.DOMProperty.injectDOMPropertyConfig(r),_.DOMProperty.injectDOMPropertyConfig(c),_.DOMProperty.injectDOMPropertyConfig(y),_.EmptyComponent.injectEmptyComponentFactory(function(e){return new f(e)}),_.Updates.injectReconcileTransaction(v),_.Updates.injectBatchingStrategy(b),_.Component.injectEnvironment(u))}var r=n(40),l=n(41),a=n(56),i=n(69),s=n(70),c=n(75),u=n(76),d=n(89),p=n(35),f=n(134),h=n(135),m=n(136),b=n(137),g=n(138),_=n(141),v=n(142),y=n(150),x=n(151),w=n(152),k=!1;e.exports={inject:o}},function(e,t){"use strict";var n={Properties:{"aria-current":0,"aria-details":0,"aria-disabled":0,"aria-hidden":0,"aria-invalid":0,"aria-keyshortcuts":0,"aria-label":0,"aria-roledescription":0,"aria-autocomplete":0,"aria-checked":0,"aria-expanded":0,"aria-haspopup":0,"aria-level":0,"aria-modal":0,"aria-multiline":0,"aria-multiselectable":0,"aria-orientation":0,"aria-placeholder":0,"aria-pressed":0,"aria-readonly":0,"aria-required":0,"aria-selected":0,"aria-sort":0,"aria-valuemax":0,"aria-valuemin":0,"aria-valuenow":0,"aria-valuetext":0,"aria-atomic":0,"aria-busy":0,"aria-live":0,"aria-relevant":0,"aria-dropeffect":0,"aria-grabbed":0,"aria-activedescendant":0,"aria-colcount":0,"aria-colindex":0,"aria-colspan":0,"aria-controls":0,"aria-describedby":0,"aria-errormessage":0,"aria-flowto":0,"aria-labelledby":0,"aria-owns":0,"aria-posinset":0,"aria-rowcount":0,"aria-rowindex":0,"aria-rowspan":0,"aria-setsize":0},DOMAttributeNames:{},DOMPropertyNames:{}};e.exports=n},function(e,t,n){"use strict";function o(){var e=window.opera;return"object"==typeof e&&"function"==typeof e.version&&parseInt(e.version(),10)<=12}function r(e){return(e.ctrlKey||e.altKey||e.metaKey)&&!(e.ctrlKey&&e.altKey)}function l(e){switch(e){case"topCompositionStart":return P.compositionStart;case"topCompositionEnd":return P.compositionEnd;case"topCompositionUpdate":return P.compositionUpdate}}function a(e,t){return"topKeyDown"===e&&t.keyCode===v}function i(e,t){switch(e){case"topKeyUp":return _.indexOf(t.keyCode)!==-1;case"topKeyDown":return t.keyCode!==v;case"topKeyPress":case"topMouseDown":case"topBlur":return!0;default:return!1}}function s(e){var t=e.detail;return"object"==typeof t&&"data"in t?t.data:null}function c(e,t,n,o){var r,c;if(y?r=l(e):O?i(e,n)&&(r=P.compositionEnd):a(e,n)&&(r=P.compositionStart),!r)return null;k&&(O||r!==P.compositionStart?r===P.compositionEnd&&O&&(c=O.getData()):O=m.getPooled(o));var u=b.getPooled(r,t,n,o);if(c)u.data=c;else{var d=s(n);null!==d&&(u.data=d)}return f.accumulateTwoPhaseDispatches(u),u}function u(e,t){switch(e){case"topCompositionEnd":return s(t);case"topKeyPress":var n=t.which;return n!==E?null:(M=!0,C);case"topTextInput":var o=t.data;return o===C&&M?null:o;default:return null}}function d(e,t){if(O){if("topCompositionEnd"===e||!y&&i(e,t)){var n=O.getData();return m.release(O),O=null,n}return null}switch(e){case"topPaste":return null;case"topKeyPress":return t.which&&!r(t)?String.fromCharCode(t.which):null;case"topCompositionEnd":return k?null:t.data;default:return null}}function p(e,t,n,o){var r;if(r=w?u(e,n):d(e,n),!r)return null;var l=g.getPooled(P.beforeInput,t,n,o);return l.data=r,f.accumulateTwoPhaseDispatches(l),l}var f=n(42),h=n(49),m=n(50),b=n(53),g=n(55),_=[9,13,27,32],v=229,y=h.canUseDOM&&"CompositionEvent"in window,x=null;h.canUseDOM&&"documentMode"in document&&(x=document.documentMode);var w=h.canUseDOM&&"TextEvent"in window&&!x&&!o(),k=h.canUseDOM&&(!y||x&&x>8&&x<=11),E=32,C=String.fromCharCode(E),P={beforeInput:{phasedRegistrationNames:{bubbled:"onBeforeInput",captured:"onBeforeInputCapture"},dependencies:["topCompositionEnd","topKeyPress","topTextInput","topPaste"]},compositionEnd:{phasedRegistrationNames:{bubbled:"onCompositionEnd",captured:"onCompositionEndCapture"},dependencies:["topBlur","topCompositionEnd","topKeyDown","topKeyPress","topKeyUp","topMouseDown"]},compositionStart:{phasedRegistrationNames:{bubbled:"onCompositionStart",captured:"onCompositionStartCapture"},dependencies:["topBlur","topCompositionStart","topKeyDown","topKeyPress","topKeyUp","topMouseDown"]},compositionUpdate:{phasedRegistrationNames:{bubbled:"onCompositionUpdate",captured:"onCompositionUpdateCapture"},dependencies:["topBlur","topCompositionUpdate","topKeyDown","topKeyPress","topKeyUp","topMouseDown"]}},M=!1,O=null,R={eventTypes:P,extractEvents:function(e,t,n,o){return[c(e,t,n,o),p(e,t,n,o)]}};e.exports=R},function(e,t,n){"use strict";function o(e,t,n){var o=t.dispatchConfig.phasedRegistrationNames[n];return g(e,o)}function r(e,t,n){var r=o(e,n,t);r&&(n._dispatchListeners=m(n._dispatchListeners,r),n._dispatchInstances=m(n._dispatchInstances,e))}function l(e){e&&e.dispatchConfig.phasedRegistrationNames&&h.traverseTwoPhase(e._targetInst,r,e)}function a(e){if(e&&e.dispatchConfig.phasedRegistrationNames){var t=e._targetInst,n=t?h.getParentInstance(t):null;h.traverseTwoPhase(n,r,e)}}function i(e,t,n){if(n&&n.dispatchConfig.registrationName){var o=n.dispatchConfig.registrationName,r=g(e,o);r&&(n._dispatchListeners=m(n._dispatchListeners,r),n._dispatchInstances=m(n._dispatchInstances,e))}}function s(e){e&&e.dispatchConfig.registrationName&&i(e._targetInst,null,e)}function c(e){b(e,l)}function u(e){b(e,a)}function d(e,t,n,o){h.traverseEnterLeave(n,o,i,e,t)}function p(e){b(e,s)}var f=n(43),h=n(45),m=n(47),b=n(48),g=(n(7),f.getListener),_={accumulateTwoPhaseDispatches:c,accumulateTwoPhaseDispatchesSkipTarget:u,accumulateDirectDispatches:p,accumulateEnterLeaveDispatches:d};e.exports=_},function(e,t,n){"use strict";function o(e){return"button"===e||"input"===e||"select"===e||"textarea"===e}function r(e,t,n){switch(e){case"onClick":case"onClickCapture":case"onDoubleClick":case"onDoubleClickCapture":case"onMouseDown":case"onMouseDownCapture":case"onMouseMove":case"onMouseMoveCapture":case"onMouseUp":case"onMouseUpCapture":return!(!n.disabled||!o(t));default:return!1}}var l=n(36),a=n(44),i=n(45),s=n(46),c=n(47),u=n(48),d=(n(11),{}),p=null,f=function(e,t){e&&(i.executeDispatchesInOrder(e,t),e.isPersistent()||e.constructor.release(e))},h=function(e){return f(e,!0)},m=function(e){return f(e,!1)},b=function(e){return"."+e._rootNodeID},g={injection:{injectEventPluginOrder:a.injectEventPluginOrder,injectEventPluginsByName:a.injectEventPluginsByName},putListener:function(e,t,n){"function"!=typeof n?l("94",t,typeof n):void 0;var o=b(e),r=d[t]||(d[t]={});r[o]=n;var i=a.registrationNameModules[t];i&&i.didPutListener&&i.didPutListener(e,t,n)},getListener:function(e,t){var n=d[t];if(r(t,e._currentElement.type,e._currentElement.props))return null;var o=b(e);return n&&n[o]},deleteListener:function(e,t){var n=a.registrationNameModules[t];n&&n.willDeleteListener&&n.willDeleteListener(e,t);var o=d[t];if(o){var r=b(e);delete o[r]}},deleteAllListeners:function(e){var t=b(e);for(var n in d)if(d.hasOwnProperty(n)&&d[n][t]){var o=a.registrationNameModules[n];o&&o.willDeleteListener&&o.willDeleteListener(e,n),delete d[n][t]}},extractEvents:function(e,t,n,o){for(var r,l=a.plugins,i=0;i<l.length;i++){var s=l[i];if(s){var u=s.extractEvents(e,t,n,o);u&&(r=c(r,u))}}return r},enqueueEvents:function(e){e&&(p=c(p,e))},processEventQueue:function(e){var t=p;p=null,e?u(t,h):u(t,m),p?l("95"):void 0,s.rethrowCaughtError()},__purge:function(){d={}},__getListenerBank:function(){return d}};e.exports=g},function(e,t,n){"use strict";function o(){if(i)for(var e in s){var t=s[e],n=i.indexOf(e);if(n>-1?void 0:a("96",e),!c.plugins[n]){t.extractEvents?void 0:a("97",e),c.plugins[n]=t;var o=t.eventTypes;for(var l in o)r(o[l],t,l)?void 0:a("98",l,e)}}}function r(e,t,n){c.eventNameDispatchConfigs.hasOwnProperty(n)?a("99",n):void 0,c.eventNameDispatchConfigs[n]=e;var o=e.phasedRegistrationNames;if(o){for(var r in o)if(o.hasOwnProperty(r)){var i=o[r];l(i,t,n)}return!0}return!!e.registrationName&&(l(e.registrationName,t,n),!0)}function l(e,t,n){c.registrationNameModules[e]?a("100",e):void 0,c.registrationNameModules[e]=t,c.registrationNameDependencies[e]=t.eventTypes[n].dependencies}var a=n(36),i=(n(11),null),s={},c={plugins:[],eventNameDispatchConfigs:{},registrationNameModules:{},registrationNameDependencies:{},possibleRegistrationNames:null,injectEventPluginOrder:function(e){i?a("101"):void 0,i=Array.prototype.slice.call(e),o()},injectEventPluginsByName:function(e){var t=!1;for(var n in e)if(e.hasOwnProperty(n)){var r=e[n];s.hasOwnProperty(n)&&s[n]===r||(s[n]?a("102",n):void 0,s[n]=r,t=!0)}t&&o()},getPluginModuleForEvent:function(e){var t=e.dispatchConfig;if(t.registrationName)return c.registrationNameModules[t.registrationName]||null;if(void 0!==t.phasedRegistrationNames){var n=t.phasedRegistrationNames;for(var o in n)if(n.hasOwnProperty(o)){var r=c.registrationNameModules[n[o]];if(r)return r}}return null},_resetEventPlugins:function(){i=null;for(var e in s)s.hasOwnProperty(e)&&delete s[e];c.plugins.length=0;var t=c.eventNameDispatchConfigs;for(var n in t)t.hasOwnProperty(n)&&delete t[n];var o=c.registrationNameModules;for(var r in o)o.hasOwnProperty(r)&&delete o[r]}};e.exports=c},function(e,t,n){"use strict";function o(e){return"topMouseUp"===e||"topTouchEnd"===e||"topTouchCancel"===e}function r(e){return"topMouseMove"===e||"topTouchMove"===e}function l(e){return"topMouseDown"===e||"topTouchStart"===e}function a(e,t,n,o){var r=e.type||"unknown-event";e.currentTarget=g.getNodeFromInstance(o),t?m.invokeGuardedCallbackWithCatch(r,n,e):m.invokeGuardedCallback(r,n,e),e.currentTarget=null}function i(e,t){var n=e._dispatchListeners,o=e._dispatchInstances;if(Array.isArray(n))for(var r=0;r<n.length&&!e.isPropagationStopped();r++)a(e,t,n[r],o[r]);else n&&a(e,t,n,o);e._dispatchListeners=null,e._dispatchInstances=null}function s(e){var t=e._dispatchListeners,n=e._dispatchInstances;if(Array.isArray(t)){for(var o=0;o<t.length&&!e.isPropagationStopped();o++)if(t[o](e,n[o]))return n[o]}else if(t&&t(e,n))return n;return null}function c(e){var t=s(e);return e._dispatchInstances=null,e._dispatchListeners=null,t}function u(e){var t=e._dispatchListeners,n=e._dispatchInstances;Array.isArray(t)?h("103"):void 0,e.currentTarget=t?g.getNodeFromInstance(n):null;var o=t?t(e):null;return e.currentTarget=null,e._dispatchListeners=null,e._dispatchInstances=null,o}function d(e){return!!e._dispatchListeners}var p,f,h=n(36),m=n(46),b=(n(11),n(7),{injectComponentTree:function(e){p=e},injectTreeTraversal:function(e){f=e}}),g={isEndish:o,isMoveish:r,isStartish:l,executeDirectDispatch:u,executeDispatchesInOrder:i,executeDispatchesInOrderStopAtTrue:c,hasDispatches:d,getInstanceFromNode:function(e){return p.getInstanceFromNode(e)},getNodeFromInstance:function(e){return p.getNodeFromInstance(e)},isAncestor:function(e,t){return f.isAncestor(e,t)},getLowestCommonAncestor:function(e,t){return f.getLowestCommonAncestor(e,t)},getParentInstance:function(e){return f.getParentInstance(e)},traverseTwoPhase:function(e,t,n){return f.traverseTwoPhase(e,t,n)},traverseEnterLeave:function(e,t,n,o,r){return f.traverseEnterLeave(e,t,n,o,r)},injection:b};e.exports=g},function(e,t,n){"use strict";function o(e,t,n){try{t(n)}catch(e){null===r&&(r=e)}}var r=null,l={invokeGuardedCallback:o,invokeGuardedCallbackWithCatch:o,rethrowCaughtError:function(){if(r){var e=r;throw r=null,e}}};e.exports=l},function(e,t,n){"use strict";function o(e,t){return null==t?r("30"):void 0,null==e?t:Array.isArray(e)?Array.isArray(t)?(e.push.apply(e,t),e):(e.push(t),e):Array.isArray(t)?[e].concat(t):[e,t]}var r=n(36);n(11);e.exports=o},function(e,t){"use strict";function n(e,t,n){Array.isArray(e)?e.forEach(t,n):e&&t.call(n,e)}e.exports=n},function(e,t){"use strict";var n=!("undefined"==typeof window||!window.document||!window.document.createElement),o={canUseDOM:n,canUseWorkers:"undefined"!=typeof Worker,canUseEventListeners:n&&!(!window.addEventListener&&!window.attachEvent),canUseViewport:n&&!!window.screen,isInWorker:!n};e.exports=o},function(e,t,n){"use strict";function o(e){this._root=e,this._startText=this.getText(),this._fallbackText=null}var r=n(3),l=n(51),a=n(52);r(o.prototype,{destructor:function(){this._root=null,this._startText=null,this._fallbackText=null},getText:function(){return"value"in this._root?this._root.value:this._root[a()]},getData:function(){if(this._fallbackText)return this._fallbackText;var e,t,n=this._startText,o=n.length,r=this.getText(),l=r.length;for(e=0;e<o&&n[e]===r[e];e++);var a=o-e;for(t=1;t<=a&&n[o-t]===r[l-t];t++);var i=t>1?1-t:void 0;return this._fallbackText=r.slice(e,i),this._fallbackText}}),l.addPoolingTo(o),e.exports=o},function(e,t,n){"use strict";var o=n(36),r=(n(11),function(e){var t=this;if(t.instancePool.length){var n=t.instancePool.pop();return t.call(n,e),n}return new t(e)}),l=function(e,t){var n=this;if(n.instancePool.length){var o=n.instancePool.pop();return n.call(o,e,t),o}return new n(e,t)},a=function(e,t,n){var o=this;if(o.instancePool.length){var r=o.instancePool.pop();return o.call(r,e,t,n),r}return new o(e,t,n)},i=function(e,t,n,o){var r=this;if(r.instancePool.length){var l=r.instancePool.pop();return r.call(l,e,t,n,o),l}return new r(e,t,n,o)},s=function(e){var t=this;e instanceof t?void 0:o("25"),e.destructor(),t.instancePool.length<t.poolSize&&t.instancePool.push(e)},c=10,u=r,d=function(e,t){var n=e;return n.instancePool=[],n.getPooled=t||u,n.poolSize||(n.poolSize=c),n.release=s,n},p={addPoolingTo:d,oneArgumentPooler:r,twoArgumentPooler:l,threeArgumentPooler:a,fourArgumentPooler:i};e.exports=p},function(e,t,n){"use strict";function o(){return!l&&r.canUseDOM&&(l="textContent"in document.documentElement?"textContent":"innerText"),l}var r=n(49),l=null;e.exports=o},function(e,t,n){"use strict";function o(e,t,n,o){return r.call(this,e,t,n,o)}var r=n(54),l={data:null};r.augmentClass(o,l),e.exports=o},function(e,t,n){"use strict";function o(e,t,n,o){this.dispatchConfig=e,this._targetInst=t,this.nativeEvent=n;var r=this.constructor.Interface;for(var l in r)if(r.hasOwnProperty(l)){var i=r[l];i?this[l]=i(n):"target"===l?this.target=o:this[l]=n[l]}var s=null!=n.defaultPrevented?n.defaultPrevented:n.returnValue===!1;return s?this.isDefaultPrevented=a.thatReturnsTrue:this.isDefaultPrevented=a.thatReturnsFalse,this.isPropagationStopped=a.thatReturnsFalse,this}var r=n(3),l=n(51),a=n(8),i=(n(7),"function"==typeof Proxy,["dispatchConfig","_targetInst","nativeEvent","isDefaultPrevented","isPropagationStopped","_dispatchListeners","_dispatchInstances"]),s={type:null,target:null,currentTarget:a.thatReturnsNull,eventPhase:null,bubbles:null,cancelable:null,timeStamp:function(e){return e.timeStamp||Date.now()},defaultPrevented:null,isTrusted:null};r(o.prototype,{preventDefault:function(){this.defaultPrevented=!0;var e=this.nativeEvent;e&&(e.preventDefault?e.preventDefault():"unknown"!=typeof e.returnValue&&(e.returnValue=!1),this.isDefaultPrevented=a.thatReturnsTrue)},stopPropagation:function(){var e=this.nativeEvent;e&&(e.stopPropagation?e.stopPropagation():"unknown"!=typeof e.cancelBubble&&(e.cancelBubble=!0),this.isPropagationStopped=a.thatReturnsTrue)},persist:function(){this.isPersistent=a.thatReturnsTrue},isPersistent:a.thatReturnsFalse,destructor:function(){var e=this.constructor.Interface;for(var t in e)this[t]=null;for(var n=0;n<i.length;n++)this[i[n]]=null}}),o.Interface=s,o.augmentClass=function(e,t){var n=this,o=function(){};o.prototype=n.prototype;var a=new o;r(a,e.prototype),e.prototype=a,e.prototype.constructor=e,e.Interface=r({},n.Interface,t),e.augmentClass=n.augmentClass,l.addPoolingTo(e,l.fourArgumentPooler)},l.addPoolingTo(o,l.fourArgumentPooler),e.exports=o},function(e,t,n){"use strict";function o(e,t,n,o){return r.call(this,e,t,n,o)}var r=n(54),l={data:null};r.augmentClass(o,l),e.exports=o},function(e,t,n){"use strict";function o(e,t,n){var o=P.getPooled(T.change,e,t,n);return o.type="change",w.accumulateTwoPhaseDispatches(o),o}function r(e){var t=e.nodeName&&e.nodeName.toLowerCase();return"select"===t||"input"===t&&"file"===e.type}function l(e){var t=o(L,e,O(e));C.batchedUpdates(a,t)}function a(e){x.enqueueEvents(e),x.processEventQueue(!1)}function i(e,t){N=e,L=t,N.attachEvent("onchange",l)}function s(){N&&(N.detachEvent("onchange",l),N=null,L=null)}function c(e,t){var n=M.updateValueIfChanged(e),o=t.simulated===!0&&U._allowSimulatedPassThrough;if(n||o)return e}function u(e,t){if("topChange"===e)return t}function d(e,t,n){"topFocus"===e?(s(),i(t,n)):"topBlur"===e&&s()}function p(e,t){N=e,L=t,N.attachEvent("onpropertychange",h)}function f(){N&&(N.detachEvent("onpropertychange",h),N=null,L=null)}function h(e){"value"===e.propertyName&&c(L,e)&&l(e)}function m(e,t,n){"topFocus"===e?(f(),p(t,n)):"topBlur"===e&&f()}function b(e,t,n){if("topSelectionChange"===e||"topKeyUp"===e||"topKeyDown"===e)return c(L,n)}function g(e){var t=e.nodeName;return t&&"input"===t.toLowerCase()&&("checkbox"===e.type||"radio"===e.type)}function _(e,t,n){if("topClick"===e)return c(t,n)}function v(e,t,n){if("topInput"===e||"topChange"===e)return c(t,n)}function y(e,t){if(null!=e){var n=e._wrapperState||t._wrapperState;if(n&&n.controlled&&"number"===t.type){var o=""+t.value;t.getAttribute("value")!==o&&t.setAttribute("value",o)}}}var x=n(43),w=n(42),k=n(49),E=n(35),C=n(57),P=n(54),M=n(65),O=n(66),R=n(67),S=n(68),T={change:{phasedRegistrationNames:{bubbled:"onChange",captured:"onChangeCapture"},dependencies:["topBlur","topChange","topClick","topFocus","topInput","topKeyDown","topKeyUp","topSelectionChange"]}},N=null,L=null,I=!1;k.canUseDOM&&(I=R("change")&&(!document.documentMode||document.documentMode>8));var j=!1;k.canUseDOM&&(j=R("input")&&(!document.documentMode||document.documentMode>9));var U={eventTypes:T,_allowSimulatedPassThrough:!0,_isInputEventSupported:j,extractEvents:function(e,t,n,l){var a,i,s=t?E.getNodeFromInstance(t):window;if(r(s)?I?a=u:i=d:S(s)?j?a=v:(a=b,i=m):g(s)&&(a=_),a){var c=a(e,t,n);if(c){var p=o(c,n,l);return p}}i&&i(e,s,t),"topBlur"===e&&y(t,s)}};e.exports=U},function(e,t,n){"use strict";function o(){O.ReactReconcileTransaction&&w?void 0:u("123")}function r(){this.reinitializeTransaction(),this.dirtyComponentsLength=null,this.callbackQueue=p.getPooled(),this.reconcileTransaction=O.ReactReconcileTransaction.getPooled(!0)}function l(e,t,n,r,l,a){return o(),w.batchedUpdates(e,t,n,r,l,a)}function a(e,t){return e._mountOrder-t._mountOrder}function i(e){var t=e.dirtyComponentsLength;t!==_.length?u("124",t,_.length):void 0,_.sort(a),v++;for(var n=0;n<t;n++){var o=_[n],r=o._pendingCallbacks;o._pendingCallbacks=null;var l;if(h.logTopLevelRenders){var i=o;o._currentElement.type.isReactTopLevelWrapper&&(i=o._renderedComponent),l="React update: "+i.getName(),console.time(l)}if(m.performUpdateIfNecessary(o,e.reconcileTransaction,v),l&&console.timeEnd(l),r)for(var s=0;s<r.length;s++)e.callbackQueue.enqueue(r[s],o.getPublicInstance())}}function s(e){return o(),w.isBatchingUpdates?(_.push(e),void(null==e._updateBatchNumber&&(e._updateBatchNumber=v+1))):void w.batchedUpdates(s,e)}function c(e,t){g(w.isBatchingUpdates,"ReactUpdates.asap: Can't enqueue an asap callback in a context whereupdates are not being batched."),y.enqueue(e,t),x=!0}var u=n(36),d=n(3),p=n(58),f=n(51),h=n(59),m=n(60),b=n(64),g=n(11),_=[],v=0,y=p.getPooled(),x=!1,w=null,k={initialize:function(){this.dirtyComponentsLength=_.length},close:function(){this.dirtyComponentsLength!==_.length?(_.splice(0,this.dirtyComponentsLength),P()):_.length=0}},E={initialize:function(){this.callbackQueue.reset()},close:function(){this.callbackQueue.notifyAll()}},C=[k,E];d(r.prototype,b,{getTransactionWrappers:function(){return C},destructor:function(){this.dirtyComponentsLength=null,p.release(this.callbackQueue),this.callbackQueue=null,O.ReactReconcileTransaction.release(this.reconcileTransaction),this.reconcileTransaction=null},perform:function(e,t,n){return b.perform.call(this,this.reconcileTransaction.perform,this.reconcileTransaction,e,t,n)}}),f.addPoolingTo(r);var P=function(){for(;_.length||x;){if(_.length){var e=r.getPooled();e.perform(i,null,e),r.release(e)}if(x){x=!1;var t=y;y=p.getPooled(),t.notifyAll(),p.release(t)}}},M={injectReconcileTransaction:function(e){e?void 0:u("126"),O.ReactReconcileTransaction=e},injectBatchingStrategy:function(e){e?void 0:u("127"),"function"!=typeof e.batchedUpdates?u("128"):void 0,"boolean"!=typeof e.isBatchingUpdates?u("129"):void 0,w=e}},O={ReactReconcileTransaction:null,batchedUpdates:l,enqueueUpdate:s,flushBatchedUpdates:P,injection:M,asap:c};e.exports=O},function(e,t,n){"use strict";function o(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}var r=n(36),l=n(51),a=(n(11),function(){function e(t){o(this,e),this._callbacks=null,this._contexts=null,this._arg=t}return e.prototype.enqueue=function(e,t){this._callbacks=this._callbacks||[],this._callbacks.push(e),this._contexts=this._contexts||[],this._contexts.push(t)},e.prototype.notifyAll=function(){var e=this._callbacks,t=this._contexts,n=this._arg;if(e&&t){e.length!==t.length?r("24"):void 0,this._callbacks=null,this._contexts=null;for(var o=0;o<e.length;o++)e[o].call(t[o],n);e.length=0,t.length=0}},e.prototype.checkpoint=function(){return this._callbacks?this._callbacks.length:0},e.prototype.rollback=function(e){this._callbacks&&this._contexts&&(this._callbacks.length=e,this._contexts.length=e)},e.prototype.reset=function(){this._callbacks=null,this._contexts=null},e.prototype.destructor=function(){this.reset()},e}());e.exports=l.addPoolingTo(a)},function(e,t){"use strict";var n={logTopLevelRenders:!1};e.exports=n},function(e,t,n){"use strict";function o(){r.attachRefs(this,this._currentElement)}var r=n(61),l=(n(63),n(7),{mountComponent:function(e,t,n,r,l,a){var i=e.mountComponent(t,n,r,l,a);return e._currentElement&&null!=e._currentElement.ref&&t.getReactMountReady().enqueue(o,e),i},getHostNode:function(e){return e.getHostNode()},unmountComponent:function(e,t){r.detachRefs(e,e._currentElement),e.unmountComponent(t)},receiveComponent:function(e,t,n,l){var a=e._currentElement;if(t!==a||l!==e._context){var i=r.shouldUpdateRefs(a,t);i&&r.detachRefs(e,a),e.receiveComponent(t,n,l),i&&e._currentElement&&null!=e._currentElement.ref&&n.getReactMountReady().enqueue(o,e)}},performUpdateIfNecessary:function(e,t,n){e._updateBatchNumber===n&&e.performUpdateIfNecessary(t)}});e.exports=l},function(e,t,n){"use strict";function o(e,t,n){"function"==typeof e?e(t.getPublicInstance()):l.addComponentAsRefTo(t,e,n)}function r(e,t,n){"function"==typeof e?e(null):l.removeComponentAsRefFrom(t,e,n)}var l=n(62),a={};a.attachRefs=function(e,t){if(null!==t&&"object"==typeof t){var n=t.ref;null!=n&&o(n,e,t._owner)}},a.shouldUpdateRefs=function(e,t){var n=null,o=null;null!==e&&"object"==typeof e&&(n=e.ref,o=e._owner);var r=null,l=null;return null!==t&&"object"==typeof t&&(r=t.ref,l=t._owner),n!==r||"string"==typeof r&&l!==o},a.detachRefs=function(e,t){if(null!==t&&"object"==typeof t){var n=t.ref;null!=n&&r(n,e,t._owner)}},e.exports=a},function(e,t,n){"use strict";function o(e){return!(!e||"function"!=typeof e.attachRef||"function"!=typeof e.detachRef)}var r=n(36),l=(n(11),{addComponentAsRefTo:function(e,t,n){o(n)?void 0:r("119"),n.attachRef(t,e)},removeComponentAsRefFrom:function(e,t,n){o(n)?void 0:r("120");var l=n.getPublicInstance();l&&l.refs[t]===e.getPublicInstance()&&n.detachRef(t)}});e.exports=l},function(e,t,n){"use strict";var o=null;e.exports={debugTool:o}},function(e,t,n){"use strict";var o=n(36),r=(n(11),{}),l={reinitializeTransaction:function(){this.transactionWrappers=this.getTransactionWrappers(),this.wrapperInitData?this.wrapperInitData.length=0:this.wrapperInitData=[],this._isInTransaction=!1},_isInTransaction:!1,getTransactionWrappers:null,isInTransaction:function(){return!!this._isInTransaction},perform:function(e,t,n,r,l,a,i,s){this.isInTransaction()?o("27"):void 0;var c,u;try{this._isInTransaction=!0,c=!0,this.initializeAll(0),u=e.call(t,n,r,l,a,i,s),c=!1}finally{try{if(c)try{this.closeAll(0)}catch(e){}else this.closeAll(0)}finally{this._isInTransaction=!1}}return u},initializeAll:function(e){for(var t=this.transactionWrappers,n=e;n<t.length;n++){var o=t[n];try{this.wrapperInitData[n]=r,this.wrapperInitData[n]=o.initialize?o.initialize.call(this):null}finally{if(this.wrapperInitData[n]===r)try{this.initializeAll(n+1)}catch(e){}}}},closeAll:function(e){this.isInTransaction()?void 0:o("28");for(var t=this.transactionWrappers,n=e;n<t.length;n++){var l,a=t[n],i=this.wrapperInitData[n];try{l=!0,i!==r&&a.close&&a.close.call(this,i),l=!1}finally{if(l)try{this.closeAll(n+1)}catch(e){}}}this.wrapperInitData.length=0}};e.exports=l},function(e,t,n){"use strict";function o(e){var t=e.type,n=e.nodeName;return n&&"input"===n.toLowerCase()&&("checkbox"===t||"radio"===t)}function r(e){return e._wrapperState.valueTracker}function l(e,t){e._wrapperState.valueTracker=t}function a(e){e._wrapperState.valueTracker=null}function i(e){var t;return e&&(t=o(e)?""+e.checked:e.value),t}var s=n(35),c={_getTrackerFromNode:function(e){return r(s.getInstanceFromNode(e))},track:function(e){if(!r(e)){var t=s.getNodeFromInstance(e),n=o(t)?"checked":"value",i=Object.getOwnPropertyDescriptor(t.constructor.prototype,n),c=""+t[n];t.hasOwnProperty(n)||"function"!=typeof i.get||"function"!=typeof i.set||(Object.defineProperty(t,n,{enumerable:i.enumerable,configurable:!0,get:function(){return i.get.call(this)},set:function(e){c=""+e,i.set.call(this,e)}}),l(e,{getValue:function(){return c},setValue:function(e){c=""+e},stopTracking:function(){a(e),delete t[n]}}))}},updateValueIfChanged:function(e){if(!e)return!1;var t=r(e);if(!t)return c.track(e),!0;var n=t.getValue(),o=i(s.getNodeFromInstance(e));return o!==n&&(t.setValue(o),!0)},stopTracking:function(e){var t=r(e);t&&t.stopTracking()}};e.exports=c},function(e,t){"use strict";function n(e){var t=e.target||e.srcElement||window;return t.correspondingUseElement&&(t=t.correspondingUseElement),3===t.nodeType?t.parentNode:t}e.exports=n},function(e,t,n){"use strict";function o(e,t){if(!l.canUseDOM||t&&!("addEventListener"in document))return!1;var n="on"+e,o=n in document;if(!o){var a=document.createElement("div");a.setAttribute(n,"return;"),o="function"==typeof a[n]}return!o&&r&&"wheel"===e&&(o=document.implementation.hasFeature("Events.wheel","3.0")),o}var r,l=n(49);l.canUseDOM&&(r=document.implementation&&document.implementation.hasFeature&&document.implementation.hasFeature("","")!==!0),e.exports=o},function(e,t){"use strict";function n(e){var t=e&&e.nodeName&&e.nodeName.toLowerCase();return"input"===t?!!o[e.type]:"textarea"===t}var o={color:!0,date:!0,datetime:!0,"datetime-local":!0,email:!0,month:!0,number:!0,password:!0,range:!0,search:!0,tel:!0,text:!0,time:!0,url:!0,week:!0};e.exports=n},function(e,t){"use strict";var n=["ResponderEventPlugin","SimpleEventPlugin","TapEventPlugin","EnterLeaveEventPlugin","ChangeEventPlugin","SelectEventPlugin","BeforeInputEventPlugin"];e.exports=n},function(e,t,n){"use strict";var o=n(42),r=n(35),l=n(71),a={mouseEnter:{registrationName:"onMouseEnter",dependencies:["topMouseOut","topMouseOver"]},mouseLeave:{registrationName:"onMouseLeave",dependencies:["topMouseOut","topMouseOver"]}},i={eventTypes:a,extractEvents:function(e,t,n,i){if("topMouseOver"===e&&(n.relatedTarget||n.fromElement))return null;if("topMouseOut"!==e&&"topMouseOver"!==e)return null;var s;if(i.window===i)s=i;else{var c=i.ownerDocument;s=c?c.defaultView||c.parentWindow:window}var u,d;if("topMouseOut"===e){u=t;var p=n.relatedTarget||n.toElement;d=p?r.getClosestInstanceFromNode(p):null}else u=null,d=t;if(u===d)return null;var f=null==u?s:r.getNodeFromInstance(u),h=null==d?s:r.getNodeFromInstance(d),m=l.getPooled(a.mouseLeave,u,n,i);m.type="mouseleave",m.target=f,m.relatedTarget=h;var b=l.getPooled(a.mouseEnter,d,n,i);return b.type="mouseenter",b.target=h,b.relatedTarget=f,o.accumulateEnterLeaveDispatches(m,b,u,d),[m,b]}};e.exports=i},function(e,t,n){"use strict";function o(e,t,n,o){return r.call(this,e,t,n,o)}var r=n(72),l=n(73),a=n(74),i={screenX:null,screenY:null,clientX:null,clientY:null,ctrlKey:null,shiftKey:null,altKey:null,metaKey:null,getModifierState:a,button:function(e){var t=e.button;return"which"in e?t:2===t?2:4===t?1:0},buttons:null,relatedTarget:function(e){return e.relatedTarget||(e.fromElement===e.srcElement?e.toElement:e.fromElement)},pageX:function(e){return"pageX"in e?e.pageX:e.clientX+l.currentScrollLeft},pageY:function(e){return"pageY"in e?e.pageY:e.clientY+l.currentScrollTop}};r.augmentClass(o,i),e.exports=o},function(e,t,n){"use strict";function o(e,t,n,o){return r.call(this,e,t,n,o)}var r=n(54),l=n(66),a={view:function(e){if(e.view)return e.view;var t=l(e);if(t.window===t)return t;var n=t.ownerDocument;return n?n.defaultView||n.parentWindow:window},detail:function(e){return e.detail||0}};r.augmentClass(o,a),e.exports=o},function(e,t){"use strict";var n={currentScrollLeft:0,currentScrollTop:0,refreshScrollValues:function(e){n.currentScrollLeft=e.x,n.currentScrollTop=e.y}};e.exports=n},function(e,t){"use strict";function n(e){var t=this,n=t.nativeEvent;if(n.getModifierState)return n.getModifierState(e);var o=r[e];return!!o&&!!n[o]}function o(e){return n}var r={Alt:"altKey",Control:"ctrlKey",Meta:"metaKey",Shift:"shiftKey"};e.exports=o},function(e,t,n){"use strict";var o=n(37),r=o.injection.MUST_USE_PROPERTY,l=o.injection.HAS_BOOLEAN_VALUE,a=o.injection.HAS_NUMERIC_VALUE,i=o.injection.HAS_POSITIVE_NUMERIC_VALUE,s=o.injection.HAS_OVERLOADED_BOOLEAN_VALUE,c={isCustomAttribute:RegExp.prototype.test.bind(new RegExp("^(data|aria)-["+o.ATTRIBUTE_NAME_CHAR+"]*$")),Properties:{accept:0,acceptCharset:0,accessKey:0,action:0,allowFullScreen:l,allowTransparency:0,alt:0,as:0,async:l,autoComplete:0,autoPlay:l,capture:l,cellPadding:0,cellSpacing:0,charSet:0,challenge:0,checked:r|l,cite:0,classID:0,className:0,cols:i,colSpan:0,content:0,contentEditable:0,contextMenu:0,controls:l,controlsList:0,coords:0,crossOrigin:0,data:0,dateTime:0,default:l,defer:l,dir:0,disabled:l,download:s,draggable:0,encType:0,form:0,formAction:0,formEncType:0,formMethod:0,formNoValidate:l,formTarget:0,frameBorder:0,headers:0,height:0,hidden:l,high:0,href:0,hrefLang:0,htmlFor:0,httpEquiv:0,icon:0,id:0,inputMode:0,integrity:0,is:0,keyParams:0,keyType:0,kind:0,label:0,lang:0,list:0,loop:l,low:0,manifest:0,marginHeight:0,marginWidth:0,max:0,maxLength:0,
 media:0,mediaGroup:0,method:0,min:0,minLength:0,multiple:r|l,muted:r|l,name:0,nonce:0,noValidate:l,open:l,optimum:0,pattern:0,placeholder:0,playsInline:l,poster:0,preload:0,profile:0,radioGroup:0,readOnly:l,referrerPolicy:0,rel:0,required:l,reversed:l,role:0,rows:i,rowSpan:a,sandbox:0,scope:0,scoped:l,scrolling:0,seamless:l,selected:r|l,shape:0,size:i,sizes:0,span:i,spellCheck:0,src:0,srcDoc:0,srcLang:0,srcSet:0,start:a,step:0,style:0,summary:0,tabIndex:0,target:0,title:0,type:0,useMap:0,value:0,width:0,wmode:0,wrap:0,about:0,datatype:0,inlist:0,prefix:0,property:0,resource:0,typeof:0,vocab:0,autoCapitalize:0,autoCorrect:0,autoSave:0,color:0,itemProp:0,itemScope:l,itemType:0,itemID:0,itemRef:0,results:0,security:0,unselectable:0},DOMAttributeNames:{acceptCharset:"accept-charset",className:"class",htmlFor:"for",httpEquiv:"http-equiv"},DOMPropertyNames:{},DOMMutationMethods:{value:function(e,t){return null==t?e.removeAttribute("value"):void("number"!==e.type||e.hasAttribute("value")===!1?e.setAttribute("value",""+t):e.validity&&!e.validity.badInput&&e.ownerDocument.activeElement!==e&&e.setAttribute("value",""+t))}}};e.exports=c},function(e,t,n){"use strict";var o=n(77),r=n(88),l={processChildrenUpdates:r.dangerouslyProcessChildrenUpdates,replaceNodeWithMarkup:o.dangerouslyReplaceNodeWithMarkup};e.exports=l},function(e,t,n){"use strict";function o(e,t){return Array.isArray(t)&&(t=t[1]),t?t.nextSibling:e.firstChild}function r(e,t,n){u.insertTreeBefore(e,t,n)}function l(e,t,n){Array.isArray(t)?i(e,t[0],t[1],n):m(e,t,n)}function a(e,t){if(Array.isArray(t)){var n=t[1];t=t[0],s(e,t,n),e.removeChild(n)}e.removeChild(t)}function i(e,t,n,o){for(var r=t;;){var l=r.nextSibling;if(m(e,r,o),r===n)break;r=l}}function s(e,t,n){for(;;){var o=t.nextSibling;if(o===n)break;e.removeChild(o)}}function c(e,t,n){var o=e.parentNode,r=e.nextSibling;r===t?n&&m(o,document.createTextNode(n),r):n?(h(r,n),s(o,r,t)):s(o,e,t)}var u=n(78),d=n(84),p=(n(35),n(63),n(81)),f=n(80),h=n(82),m=p(function(e,t,n){e.insertBefore(t,n)}),b=d.dangerouslyReplaceNodeWithMarkup,g={dangerouslyReplaceNodeWithMarkup:b,replaceDelimitedText:c,processUpdates:function(e,t){for(var n=0;n<t.length;n++){var i=t[n];switch(i.type){case"INSERT_MARKUP":r(e,i.content,o(e,i.afterNode));break;case"MOVE_EXISTING":l(e,i.fromNode,o(e,i.afterNode));break;case"SET_MARKUP":f(e,i.content);break;case"TEXT_CONTENT":h(e,i.content);break;case"REMOVE_NODE":a(e,i.fromNode)}}}};e.exports=g},function(e,t,n){"use strict";function o(e){if(b){var t=e.node,n=e.children;if(n.length)for(var o=0;o<n.length;o++)g(t,n[o],null);else null!=e.html?d(t,e.html):null!=e.text&&f(t,e.text)}}function r(e,t){e.parentNode.replaceChild(t.node,e),o(t)}function l(e,t){b?e.children.push(t):e.node.appendChild(t.node)}function a(e,t){b?e.html=t:d(e.node,t)}function i(e,t){b?e.text=t:f(e.node,t)}function s(){return this.node.nodeName}function c(e){return{node:e,children:[],html:null,text:null,toString:s}}var u=n(79),d=n(80),p=n(81),f=n(82),h=1,m=11,b="undefined"!=typeof document&&"number"==typeof document.documentMode||"undefined"!=typeof navigator&&"string"==typeof navigator.userAgent&&/\bEdge\/\d/.test(navigator.userAgent),g=p(function(e,t,n){t.node.nodeType===m||t.node.nodeType===h&&"object"===t.node.nodeName.toLowerCase()&&(null==t.node.namespaceURI||t.node.namespaceURI===u.html)?(o(t),e.insertBefore(t.node,n)):(e.insertBefore(t.node,n),o(t))});c.insertTreeBefore=g,c.replaceChildWithTree=r,c.queueChild=l,c.queueHTML=a,c.queueText=i,e.exports=c},function(e,t){"use strict";var n={html:"http://www.w3.org/1999/xhtml",mathml:"http://www.w3.org/1998/Math/MathML",svg:"http://www.w3.org/2000/svg"};e.exports=n},function(e,t,n){"use strict";var o,r=n(49),l=n(79),a=/^[ \r\n\t\f]/,i=/<(!--|link|noscript|meta|script|style)[ \r\n\t\f\/>]/,s=n(81),c=s(function(e,t){if(e.namespaceURI!==l.svg||"innerHTML"in e)e.innerHTML=t;else{o=o||document.createElement("div"),o.innerHTML="<svg>"+t+"</svg>";for(var n=o.firstChild;n.firstChild;)e.appendChild(n.firstChild)}});if(r.canUseDOM){var u=document.createElement("div");u.innerHTML=" ",""===u.innerHTML&&(c=function(e,t){if(e.parentNode&&e.parentNode.replaceChild(e,e),a.test(t)||"<"===t[0]&&i.test(t)){e.innerHTML=String.fromCharCode(65279)+t;var n=e.firstChild;1===n.data.length?e.removeChild(n):n.deleteData(0,1)}else e.innerHTML=t}),u=null}e.exports=c},function(e,t){"use strict";var n=function(e){return"undefined"!=typeof MSApp&&MSApp.execUnsafeLocalFunction?function(t,n,o,r){MSApp.execUnsafeLocalFunction(function(){return e(t,n,o,r)})}:e};e.exports=n},function(e,t,n){"use strict";var o=n(49),r=n(83),l=n(80),a=function(e,t){if(t){var n=e.firstChild;if(n&&n===e.lastChild&&3===n.nodeType)return void(n.nodeValue=t)}e.textContent=t};o.canUseDOM&&("textContent"in document.documentElement||(a=function(e,t){return 3===e.nodeType?void(e.nodeValue=t):void l(e,r(t))})),e.exports=a},function(e,t){"use strict";function n(e){var t=""+e,n=r.exec(t);if(!n)return t;var o,l="",a=0,i=0;for(a=n.index;a<t.length;a++){switch(t.charCodeAt(a)){case 34:o="&quot;";break;case 38:o="&amp;";break;case 39:o="&#x27;";break;case 60:o="&lt;";break;case 62:o="&gt;";break;default:continue}i!==a&&(l+=t.substring(i,a)),i=a+1,l+=o}return i!==a?l+t.substring(i,a):l}function o(e){return"boolean"==typeof e||"number"==typeof e?""+e:n(e)}var r=/["'&<>]/;e.exports=o},function(e,t,n){"use strict";var o=n(36),r=n(78),l=n(49),a=n(85),i=n(8),s=(n(11),{dangerouslyReplaceNodeWithMarkup:function(e,t){if(l.canUseDOM?void 0:o("56"),t?void 0:o("57"),"HTML"===e.nodeName?o("58"):void 0,"string"==typeof t){var n=a(t,i)[0];e.parentNode.replaceChild(n,e)}else r.replaceChildWithTree(e,t)}});e.exports=s},function(e,t,n){"use strict";function o(e){var t=e.match(u);return t&&t[1].toLowerCase()}function r(e,t){var n=c;c?void 0:s(!1);var r=o(e),l=r&&i(r);if(l){n.innerHTML=l[1]+e+l[2];for(var u=l[0];u--;)n=n.lastChild}else n.innerHTML=e;var d=n.getElementsByTagName("script");d.length&&(t?void 0:s(!1),a(d).forEach(t));for(var p=Array.from(n.childNodes);n.lastChild;)n.removeChild(n.lastChild);return p}var l=n(49),a=n(86),i=n(87),s=n(11),c=l.canUseDOM?document.createElement("div"):null,u=/^\s*<(\w+)/;e.exports=r},function(e,t,n){"use strict";function o(e){var t=e.length;if(Array.isArray(e)||"object"!=typeof e&&"function"!=typeof e?a(!1):void 0,"number"!=typeof t?a(!1):void 0,0===t||t-1 in e?void 0:a(!1),"function"==typeof e.callee?a(!1):void 0,e.hasOwnProperty)try{return Array.prototype.slice.call(e)}catch(e){}for(var n=Array(t),o=0;o<t;o++)n[o]=e[o];return n}function r(e){return!!e&&("object"==typeof e||"function"==typeof e)&&"length"in e&&!("setInterval"in e)&&"number"!=typeof e.nodeType&&(Array.isArray(e)||"callee"in e||"item"in e)}function l(e){return r(e)?Array.isArray(e)?e.slice():o(e):[e]}var a=n(11);e.exports=l},function(e,t,n){"use strict";function o(e){return a?void 0:l(!1),p.hasOwnProperty(e)||(e="*"),i.hasOwnProperty(e)||("*"===e?a.innerHTML="<link />":a.innerHTML="<"+e+"></"+e+">",i[e]=!a.firstChild),i[e]?p[e]:null}var r=n(49),l=n(11),a=r.canUseDOM?document.createElement("div"):null,i={},s=[1,'<select multiple="true">',"</select>"],c=[1,"<table>","</table>"],u=[3,"<table><tbody><tr>","</tr></tbody></table>"],d=[1,'<svg xmlns="http://www.w3.org/2000/svg">',"</svg>"],p={"*":[1,"?<div>","</div>"],area:[1,"<map>","</map>"],col:[2,"<table><tbody></tbody><colgroup>","</colgroup></table>"],legend:[1,"<fieldset>","</fieldset>"],param:[1,"<object>","</object>"],tr:[2,"<table><tbody>","</tbody></table>"],optgroup:s,option:s,caption:c,colgroup:c,tbody:c,tfoot:c,thead:c,td:u,th:u},f=["circle","clipPath","defs","ellipse","g","image","line","linearGradient","mask","path","pattern","polygon","polyline","radialGradient","rect","stop","text","tspan"];f.forEach(function(e){p[e]=d,i[e]=!0}),e.exports=o},function(e,t,n){"use strict";var o=n(77),r=n(35),l={dangerouslyProcessChildrenUpdates:function(e,t){var n=r.getNodeFromInstance(e);o.processUpdates(n,t)}};e.exports=l},function(e,t,n){"use strict";function o(e){if(e){var t=e._currentElement._owner||null;if(t){var n=t.getName();if(n)return" This DOM node was rendered by `"+n+"`."}}return""}function r(e,t){t&&(Q[e._tag]&&(null!=t.children||null!=t.dangerouslySetInnerHTML?b("137",e._tag,e._currentElement._owner?" Check the render method of "+e._currentElement._owner.getName()+".":""):void 0),null!=t.dangerouslySetInnerHTML&&(null!=t.children?b("60"):void 0,"object"==typeof t.dangerouslySetInnerHTML&&V in t.dangerouslySetInnerHTML?void 0:b("61")),null!=t.style&&"object"!=typeof t.style?b("62",o(e)):void 0)}function l(e,t,n,o){if(!(o instanceof I)){var r=e._hostContainerInfo,l=r._node&&r._node.nodeType===B,i=l?r._node:r._ownerDocument;q(t,i),o.getReactMountReady().enqueue(a,{inst:e,registrationName:t,listener:n})}}function a(){var e=this;E.putListener(e.inst,e.registrationName,e.listener)}function i(){var e=this;R.postMountWrapper(e)}function s(){var e=this;N.postMountWrapper(e)}function c(){var e=this;S.postMountWrapper(e)}function u(){U.track(this)}function d(){var e=this;e._rootNodeID?void 0:b("63");var t=H(e);switch(t?void 0:b("64"),e._tag){case"iframe":case"object":e._wrapperState.listeners=[P.trapBubbledEvent("topLoad","load",t)];break;case"video":case"audio":e._wrapperState.listeners=[];for(var n in K)K.hasOwnProperty(n)&&e._wrapperState.listeners.push(P.trapBubbledEvent(n,K[n],t));break;case"source":e._wrapperState.listeners=[P.trapBubbledEvent("topError","error",t)];break;case"img":e._wrapperState.listeners=[P.trapBubbledEvent("topError","error",t),P.trapBubbledEvent("topLoad","load",t)];break;case"form":e._wrapperState.listeners=[P.trapBubbledEvent("topReset","reset",t),P.trapBubbledEvent("topSubmit","submit",t)];break;case"input":case"select":case"textarea":e._wrapperState.listeners=[P.trapBubbledEvent("topInvalid","invalid",t)]}}function p(){T.postUpdateWrapper(this)}function f(e){$.call(J,e)||(Z.test(e)?void 0:b("65",e),J[e]=!0)}function h(e,t){return e.indexOf("-")>=0||null!=t.is}function m(e){var t=e.type;f(t),this._currentElement=e,this._tag=t.toLowerCase(),this._namespaceURI=null,this._renderedChildren=null,this._previousStyle=null,this._previousStyleCopy=null,this._hostNode=null,this._hostParent=null,this._rootNodeID=0,this._domID=0,this._hostContainerInfo=null,this._wrapperState=null,this._topLevelWrapper=null,this._flags=0}var b=n(36),g=n(3),_=n(90),v=n(92),y=n(78),x=n(79),w=n(37),k=n(100),E=n(43),C=n(44),P=n(102),M=n(38),O=n(35),R=n(105),S=n(108),T=n(109),N=n(110),L=(n(63),n(111)),I=n(130),j=(n(8),n(83)),U=(n(11),n(67),n(119),n(65)),A=(n(133),n(7),M),D=E.deleteListener,H=O.getNodeFromInstance,q=P.listenTo,Y=C.registrationNameModules,F={string:!0,number:!0},W="style",V="__html",X={children:null,dangerouslySetInnerHTML:null,suppressContentEditableWarning:null},B=11,K={topAbort:"abort",topCanPlay:"canplay",topCanPlayThrough:"canplaythrough",topDurationChange:"durationchange",topEmptied:"emptied",topEncrypted:"encrypted",topEnded:"ended",topError:"error",topLoadedData:"loadeddata",topLoadedMetadata:"loadedmetadata",topLoadStart:"loadstart",topPause:"pause",topPlay:"play",topPlaying:"playing",topProgress:"progress",topRateChange:"ratechange",topSeeked:"seeked",topSeeking:"seeking",topStalled:"stalled",topSuspend:"suspend",topTimeUpdate:"timeupdate",topVolumeChange:"volumechange",topWaiting:"waiting"},G={area:!0,base:!0,br:!0,col:!0,embed:!0,hr:!0,img:!0,input:!0,keygen:!0,link:!0,meta:!0,param:!0,source:!0,track:!0,wbr:!0},z={listing:!0,pre:!0,textarea:!0},Q=g({menuitem:!0},G),Z=/^[a-zA-Z][a-zA-Z:_\.\-\d]*$/,J={},$={}.hasOwnProperty,ee=1;m.displayName="ReactDOMComponent",m.Mixin={mountComponent:function(e,t,n,o){this._rootNodeID=ee++,this._domID=n._idCounter++,this._hostParent=t,this._hostContainerInfo=n;var l=this._currentElement.props;switch(this._tag){case"audio":case"form":case"iframe":case"img":case"link":case"object":case"source":case"video":this._wrapperState={listeners:null},e.getReactMountReady().enqueue(d,this);break;case"input":R.mountWrapper(this,l,t),l=R.getHostProps(this,l),e.getReactMountReady().enqueue(u,this),e.getReactMountReady().enqueue(d,this);break;case"option":S.mountWrapper(this,l,t),l=S.getHostProps(this,l);break;case"select":T.mountWrapper(this,l,t),l=T.getHostProps(this,l),e.getReactMountReady().enqueue(d,this);break;case"textarea":N.mountWrapper(this,l,t),l=N.getHostProps(this,l),e.getReactMountReady().enqueue(u,this),e.getReactMountReady().enqueue(d,this)}r(this,l);var a,p;null!=t?(a=t._namespaceURI,p=t._tag):n._tag&&(a=n._namespaceURI,p=n._tag),(null==a||a===x.svg&&"foreignobject"===p)&&(a=x.html),a===x.html&&("svg"===this._tag?a=x.svg:"math"===this._tag&&(a=x.mathml)),this._namespaceURI=a;var f;if(e.useCreateElement){var h,m=n._ownerDocument;if(a===x.html)if("script"===this._tag){var b=m.createElement("div"),g=this._currentElement.type;b.innerHTML="<"+g+"></"+g+">",h=b.removeChild(b.firstChild)}else h=l.is?m.createElement(this._currentElement.type,l.is):m.createElement(this._currentElement.type);else h=m.createElementNS(a,this._currentElement.type);O.precacheNode(this,h),this._flags|=A.hasCachedChildNodes,this._hostParent||k.setAttributeForRoot(h),this._updateDOMProperties(null,l,e);var v=y(h);this._createInitialChildren(e,l,o,v),f=v}else{var w=this._createOpenTagMarkupAndPutListeners(e,l),E=this._createContentMarkup(e,l,o);f=!E&&G[this._tag]?w+"/>":w+">"+E+"</"+this._currentElement.type+">"}switch(this._tag){case"input":e.getReactMountReady().enqueue(i,this),l.autoFocus&&e.getReactMountReady().enqueue(_.focusDOMComponent,this);break;case"textarea":e.getReactMountReady().enqueue(s,this),l.autoFocus&&e.getReactMountReady().enqueue(_.focusDOMComponent,this);break;case"select":l.autoFocus&&e.getReactMountReady().enqueue(_.focusDOMComponent,this);break;case"button":l.autoFocus&&e.getReactMountReady().enqueue(_.focusDOMComponent,this);break;case"option":e.getReactMountReady().enqueue(c,this)}return f},_createOpenTagMarkupAndPutListeners:function(e,t){var n="<"+this._currentElement.type;for(var o in t)if(t.hasOwnProperty(o)){var r=t[o];if(null!=r)if(Y.hasOwnProperty(o))r&&l(this,o,r,e);else{o===W&&(r&&(r=this._previousStyleCopy=g({},t.style)),r=v.createMarkupForStyles(r,this));var a=null;null!=this._tag&&h(this._tag,t)?X.hasOwnProperty(o)||(a=k.createMarkupForCustomAttribute(o,r)):a=k.createMarkupForProperty(o,r),a&&(n+=" "+a)}}return e.renderToStaticMarkup?n:(this._hostParent||(n+=" "+k.createMarkupForRoot()),n+=" "+k.createMarkupForID(this._domID))},_createContentMarkup:function(e,t,n){var o="",r=t.dangerouslySetInnerHTML;if(null!=r)null!=r.__html&&(o=r.__html);else{var l=F[typeof t.children]?t.children:null,a=null!=l?null:t.children;if(null!=l)o=j(l);else if(null!=a){var i=this.mountChildren(a,e,n);o=i.join("")}}return z[this._tag]&&"\n"===o.charAt(0)?"\n"+o:o},_createInitialChildren:function(e,t,n,o){var r=t.dangerouslySetInnerHTML;if(null!=r)null!=r.__html&&y.queueHTML(o,r.__html);else{var l=F[typeof t.children]?t.children:null,a=null!=l?null:t.children;if(null!=l)""!==l&&y.queueText(o,l);else if(null!=a)for(var i=this.mountChildren(a,e,n),s=0;s<i.length;s++)y.queueChild(o,i[s])}},receiveComponent:function(e,t,n){var o=this._currentElement;this._currentElement=e,this.updateComponent(t,o,e,n)},updateComponent:function(e,t,n,o){var l=t.props,a=this._currentElement.props;switch(this._tag){case"input":l=R.getHostProps(this,l),a=R.getHostProps(this,a);break;case"option":l=S.getHostProps(this,l),a=S.getHostProps(this,a);break;case"select":l=T.getHostProps(this,l),a=T.getHostProps(this,a);break;case"textarea":l=N.getHostProps(this,l),a=N.getHostProps(this,a)}switch(r(this,a),this._updateDOMProperties(l,a,e),this._updateDOMChildren(l,a,e,o),this._tag){case"input":R.updateWrapper(this),U.updateValueIfChanged(this);break;case"textarea":N.updateWrapper(this);break;case"select":e.getReactMountReady().enqueue(p,this)}},_updateDOMProperties:function(e,t,n){var o,r,a;for(o in e)if(!t.hasOwnProperty(o)&&e.hasOwnProperty(o)&&null!=e[o])if(o===W){var i=this._previousStyleCopy;for(r in i)i.hasOwnProperty(r)&&(a=a||{},a[r]="");this._previousStyleCopy=null}else Y.hasOwnProperty(o)?e[o]&&D(this,o):h(this._tag,e)?X.hasOwnProperty(o)||k.deleteValueForAttribute(H(this),o):(w.properties[o]||w.isCustomAttribute(o))&&k.deleteValueForProperty(H(this),o);for(o in t){var s=t[o],c=o===W?this._previousStyleCopy:null!=e?e[o]:void 0;if(t.hasOwnProperty(o)&&s!==c&&(null!=s||null!=c))if(o===W)if(s?s=this._previousStyleCopy=g({},s):this._previousStyleCopy=null,c){for(r in c)!c.hasOwnProperty(r)||s&&s.hasOwnProperty(r)||(a=a||{},a[r]="");for(r in s)s.hasOwnProperty(r)&&c[r]!==s[r]&&(a=a||{},a[r]=s[r])}else a=s;else if(Y.hasOwnProperty(o))s?l(this,o,s,n):c&&D(this,o);else if(h(this._tag,t))X.hasOwnProperty(o)||k.setValueForAttribute(H(this),o,s);else if(w.properties[o]||w.isCustomAttribute(o)){var u=H(this);null!=s?k.setValueForProperty(u,o,s):k.deleteValueForProperty(u,o)}}a&&v.setValueForStyles(H(this),a,this)},_updateDOMChildren:function(e,t,n,o){var r=F[typeof e.children]?e.children:null,l=F[typeof t.children]?t.children:null,a=e.dangerouslySetInnerHTML&&e.dangerouslySetInnerHTML.__html,i=t.dangerouslySetInnerHTML&&t.dangerouslySetInnerHTML.__html,s=null!=r?null:e.children,c=null!=l?null:t.children,u=null!=r||null!=a,d=null!=l||null!=i;null!=s&&null==c?this.updateChildren(null,n,o):u&&!d&&this.updateTextContent(""),null!=l?r!==l&&this.updateTextContent(""+l):null!=i?a!==i&&this.updateMarkup(""+i):null!=c&&this.updateChildren(c,n,o)},getHostNode:function(){return H(this)},unmountComponent:function(e){switch(this._tag){case"audio":case"form":case"iframe":case"img":case"link":case"object":case"source":case"video":var t=this._wrapperState.listeners;if(t)for(var n=0;n<t.length;n++)t[n].remove();break;case"input":case"textarea":U.stopTracking(this);break;case"html":case"head":case"body":b("66",this._tag)}this.unmountChildren(e),O.uncacheNode(this),E.deleteAllListeners(this),this._rootNodeID=0,this._domID=0,this._wrapperState=null},getPublicInstance:function(){return H(this)}},g(m.prototype,m.Mixin,L.Mixin),e.exports=m},function(e,t,n){"use strict";var o=n(35),r=n(91),l={focusDOMComponent:function(){r(o.getNodeFromInstance(this))}};e.exports=l},function(e,t){"use strict";function n(e){try{e.focus()}catch(e){}}e.exports=n},function(e,t,n){"use strict";var o=n(93),r=n(49),l=(n(63),n(94),n(96)),a=n(97),i=n(99),s=(n(7),i(function(e){return a(e)})),c=!1,u="cssFloat";if(r.canUseDOM){var d=document.createElement("div").style;try{d.font=""}catch(e){c=!0}void 0===document.documentElement.style.cssFloat&&(u="styleFloat")}var p={createMarkupForStyles:function(e,t){var n="";for(var o in e)if(e.hasOwnProperty(o)){var r=0===o.indexOf("--"),a=e[o];null!=a&&(n+=s(o)+":",n+=l(o,a,t,r)+";")}return n||null},setValueForStyles:function(e,t,n){var r=e.style;for(var a in t)if(t.hasOwnProperty(a)){var i=0===a.indexOf("--"),s=l(a,t[a],n,i);if("float"!==a&&"cssFloat"!==a||(a=u),i)r.setProperty(a,s);else if(s)r[a]=s;else{var d=c&&o.shorthandPropertyExpansions[a];if(d)for(var p in d)r[p]="";else r[a]=""}}}};e.exports=p},function(e,t){"use strict";function n(e,t){return e+t.charAt(0).toUpperCase()+t.substring(1)}var o={animationIterationCount:!0,borderImageOutset:!0,borderImageSlice:!0,borderImageWidth:!0,boxFlex:!0,boxFlexGroup:!0,boxOrdinalGroup:!0,columnCount:!0,columns:!0,flex:!0,flexGrow:!0,flexPositive:!0,flexShrink:!0,flexNegative:!0,flexOrder:!0,gridRow:!0,gridRowEnd:!0,gridRowSpan:!0,gridRowStart:!0,gridColumn:!0,gridColumnEnd:!0,gridColumnSpan:!0,gridColumnStart:!0,fontWeight:!0,lineClamp:!0,lineHeight:!0,opacity:!0,order:!0,orphans:!0,tabSize:!0,widows:!0,zIndex:!0,zoom:!0,fillOpacity:!0,floodOpacity:!0,stopOpacity:!0,strokeDasharray:!0,strokeDashoffset:!0,strokeMiterlimit:!0,strokeOpacity:!0,strokeWidth:!0},r=["Webkit","ms","Moz","O"];Object.keys(o).forEach(function(e){r.forEach(function(t){o[n(t,e)]=o[e]})});var l={background:{backgroundAttachment:!0,backgroundColor:!0,backgroundImage:!0,backgroundPositionX:!0,backgroundPositionY:!0,backgroundRepeat:!0},backgroundPosition:{backgroundPositionX:!0,backgroundPositionY:!0},border:{borderWidth:!0,borderStyle:!0,borderColor:!0},borderBottom:{borderBottomWidth:!0,borderBottomStyle:!0,borderBottomColor:!0},borderLeft:{borderLeftWidth:!0,borderLeftStyle:!0,borderLeftColor:!0},borderRight:{borderRightWidth:!0,borderRightStyle:!0,borderRightColor:!0},borderTop:{borderTopWidth:!0,borderTopStyle:!0,borderTopColor:!0},font:{fontStyle:!0,fontVariant:!0,fontWeight:!0,fontSize:!0,lineHeight:!0,fontFamily:!0},outline:{outlineWidth:!0,outlineStyle:!0,outlineColor:!0}},a={isUnitlessNumber:o,shorthandPropertyExpansions:l};e.exports=a},function(e,t,n){"use strict";function o(e){return r(e.replace(l,"ms-"))}var r=n(95),l=/^-ms-/;e.exports=o},function(e,t){"use strict";function n(e){return e.replace(o,function(e,t){return t.toUpperCase()})}var o=/-(.)/g;e.exports=n},function(e,t,n){"use strict";function o(e,t,n,o){var r=null==t||"boolean"==typeof t||""===t;if(r)return"";var a=isNaN(t);if(o||a||0===t||l.hasOwnProperty(e)&&l[e])return""+t;if("string"==typeof t){t=t.trim()}return t+"px"}var r=n(93),l=(n(7),r.isUnitlessNumber);e.exports=o},function(e,t,n){"use strict";function o(e){return r(e).replace(l,"-ms-")}var r=n(98),l=/^ms-/;e.exports=o},function(e,t){"use strict";function n(e){return e.replace(o,"-$1").toLowerCase()}var o=/([A-Z])/g;e.exports=n},function(e,t){"use strict";function n(e){var t={};return function(n){return t.hasOwnProperty(n)||(t[n]=e.call(this,n)),t[n]}}e.exports=n},function(e,t,n){"use strict";function o(e){return!!c.hasOwnProperty(e)||!s.hasOwnProperty(e)&&(i.test(e)?(c[e]=!0,!0):(s[e]=!0,!1))}function r(e,t){return null==t||e.hasBooleanValue&&!t||e.hasNumericValue&&isNaN(t)||e.hasPositiveNumericValue&&t<1||e.hasOverloadedBooleanValue&&t===!1}var l=n(37),a=(n(35),n(63),n(101)),i=(n(7),new RegExp("^["+l.ATTRIBUTE_NAME_START_CHAR+"]["+l.ATTRIBUTE_NAME_CHAR+"]*$")),s={},c={},u={createMarkupForID:function(e){return l.ID_ATTRIBUTE_NAME+"="+a(e)},setAttributeForID:function(e,t){e.setAttribute(l.ID_ATTRIBUTE_NAME,t)},createMarkupForRoot:function(){return l.ROOT_ATTRIBUTE_NAME+'=""'},setAttributeForRoot:function(e){e.setAttribute(l.ROOT_ATTRIBUTE_NAME,"")},createMarkupForProperty:function(e,t){var n=l.properties.hasOwnProperty(e)?l.properties[e]:null;if(n){if(r(n,t))return"";var o=n.attributeName;return n.hasBooleanValue||n.hasOverloadedBooleanValue&&t===!0?o+'=""':o+"="+a(t)}return l.isCustomAttribute(e)?null==t?"":e+"="+a(t):null},createMarkupForCustomAttribute:function(e,t){return o(e)&&null!=t?e+"="+a(t):""},setValueForProperty:function(e,t,n){var o=l.properties.hasOwnProperty(t)?l.properties[t]:null;if(o){var a=o.mutationMethod;if(a)a(e,n);else{if(r(o,n))return void this.deleteValueForProperty(e,t);if(o.mustUseProperty)e[o.propertyName]=n;else{var i=o.attributeName,s=o.attributeNamespace;s?e.setAttributeNS(s,i,""+n):o.hasBooleanValue||o.hasOverloadedBooleanValue&&n===!0?e.setAttribute(i,""):e.setAttribute(i,""+n)}}}else if(l.isCustomAttribute(t))return void u.setValueForAttribute(e,t,n)},setValueForAttribute:function(e,t,n){if(o(t)){null==n?e.removeAttribute(t):e.setAttribute(t,""+n)}},deleteValueForAttribute:function(e,t){e.removeAttribute(t)},deleteValueForProperty:function(e,t){var n=l.properties.hasOwnProperty(t)?l.properties[t]:null;if(n){var o=n.mutationMethod;if(o)o(e,void 0);else if(n.mustUseProperty){var r=n.propertyName;n.hasBooleanValue?e[r]=!1:e[r]=""}else e.removeAttribute(n.attributeName)}else l.isCustomAttribute(t)&&e.removeAttribute(t)}};e.exports=u},function(e,t,n){"use strict";function o(e){return'"'+r(e)+'"'}var r=n(83);e.exports=o},function(e,t,n){"use strict";function o(e){return Object.prototype.hasOwnProperty.call(e,m)||(e[m]=f++,d[e[m]]={}),d[e[m]]}var r,l=n(3),a=n(44),i=n(103),s=n(73),c=n(104),u=n(67),d={},p=!1,f=0,h={topAbort:"abort",topAnimationEnd:c("animationend")||"animationend",topAnimationIteration:c("animationiteration")||"animationiteration",topAnimationStart:c("animationstart")||"animationstart",topBlur:"blur",topCanPlay:"canplay",topCanPlayThrough:"canplaythrough",topChange:"change",topClick:"click",topCompositionEnd:"compositionend",topCompositionStart:"compositionstart",topCompositionUpdate:"compositionupdate",topContextMenu:"contextmenu",topCopy:"copy",topCut:"cut",topDoubleClick:"dblclick",topDrag:"drag",topDragEnd:"dragend",topDragEnter:"dragenter",topDragExit:"dragexit",topDragLeave:"dragleave",topDragOver:"dragover",topDragStart:"dragstart",topDrop:"drop",topDurationChange:"durationchange",topEmptied:"emptied",topEncrypted:"encrypted",topEnded:"ended",topError:"error",topFocus:"focus",topInput:"input",topKeyDown:"keydown",topKeyPress:"keypress",topKeyUp:"keyup",topLoadedData:"loadeddata",topLoadedMetadata:"loadedmetadata",topLoadStart:"loadstart",topMouseDown:"mousedown",topMouseMove:"mousemove",topMouseOut:"mouseout",topMouseOver:"mouseover",topMouseUp:"mouseup",topPaste:"paste",topPause:"pause",topPlay:"play",topPlaying:"playing",topProgress:"progress",topRateChange:"ratechange",topScroll:"scroll",topSeeked:"seeked",topSeeking:"seeking",topSelectionChange:"selectionchange",topStalled:"stalled",topSuspend:"suspend",topTextInput:"textInput",topTimeUpdate:"timeupdate",topTouchCancel:"touchcancel",topTouchEnd:"touchend",topTouchMove:"touchmove",topTouchStart:"touchstart",topTransitionEnd:c("transitionend")||"transitionend",topVolumeChange:"volumechange",topWaiting:"waiting",topWheel:"wheel"},m="_reactListenersID"+String(Math.random()).slice(2),b=l({},i,{ReactEventListener:null,injection:{injectReactEventListener:function(e){e.setHandleTopLevel(b.handleTopLevel),b.ReactEventListener=e}},setEnabled:function(e){b.ReactEventListener&&b.ReactEventListener.setEnabled(e)},isEnabled:function(){return!(!b.ReactEventListener||!b.ReactEventListener.isEnabled())},listenTo:function(e,t){for(var n=t,r=o(n),l=a.registrationNameDependencies[e],i=0;i<l.length;i++){var s=l[i];r.hasOwnProperty(s)&&r[s]||("topWheel"===s?u("wheel")?b.ReactEventListener.trapBubbledEvent("topWheel","wheel",n):u("mousewheel")?b.ReactEventListener.trapBubbledEvent("topWheel","mousewheel",n):b.ReactEventListener.trapBubbledEvent("topWheel","DOMMouseScroll",n):"topScroll"===s?u("scroll",!0)?b.ReactEventListener.trapCapturedEvent("topScroll","scroll",n):b.ReactEventListener.trapBubbledEvent("topScroll","scroll",b.ReactEventListener.WINDOW_HANDLE):"topFocus"===s||"topBlur"===s?(u("focus",!0)?(b.ReactEventListener.trapCapturedEvent("topFocus","focus",n),b.ReactEventListener.trapCapturedEvent("topBlur","blur",n)):u("focusin")&&(b.ReactEventListener.trapBubbledEvent("topFocus","focusin",n),b.ReactEventListener.trapBubbledEvent("topBlur","focusout",n)),r.topBlur=!0,r.topFocus=!0):h.hasOwnProperty(s)&&b.ReactEventListener.trapBubbledEvent(s,h[s],n),r[s]=!0)}},trapBubbledEvent:function(e,t,n){return b.ReactEventListener.trapBubbledEvent(e,t,n)},trapCapturedEvent:function(e,t,n){return b.ReactEventListener.trapCapturedEvent(e,t,n)},supportsEventPageXY:function(){if(!document.createEvent)return!1;var e=document.createEvent("MouseEvent");return null!=e&&"pageX"in e},ensureScrollValueMonitoring:function(){if(void 0===r&&(r=b.supportsEventPageXY()),!r&&!p){var e=s.refreshScrollValues;b.ReactEventListener.monitorScrollValue(e),p=!0}}});e.exports=b},function(e,t,n){"use strict";function o(e){r.enqueueEvents(e),r.processEventQueue(!1)}var r=n(43),l={handleTopLevel:function(e,t,n,l){var a=r.extractEvents(e,t,n,l);o(a)}};e.exports=l},function(e,t,n){"use strict";function o(e,t){var n={};return n[e.toLowerCase()]=t.toLowerCase(),n["Webkit"+e]="webkit"+t,n["Moz"+e]="moz"+t,n["ms"+e]="MS"+t,n["O"+e]="o"+t.toLowerCase(),n}function r(e){if(i[e])return i[e];if(!a[e])return e;var t=a[e];for(var n in t)if(t.hasOwnProperty(n)&&n in s)return i[e]=t[n];return""}var l=n(49),a={animationend:o("Animation","AnimationEnd"),animationiteration:o("Animation","AnimationIteration"),animationstart:o("Animation","AnimationStart"),transitionend:o("Transition","TransitionEnd")},i={},s={};l.canUseDOM&&(s=document.createElement("div").style,"AnimationEvent"in window||(delete a.animationend.animation,delete a.animationiteration.animation,delete a.animationstart.animation),"TransitionEvent"in window||delete a.transitionend.transition),e.exports=r},function(e,t,n){"use strict";function o(){this._rootNodeID&&p.updateWrapper(this)}function r(e){var t="checkbox"===e.type||"radio"===e.type;return t?null!=e.checked:null!=e.value}function l(e){var t=this._currentElement.props,n=c.executeOnChange(t,e);d.asap(o,this);var r=t.name;if("radio"===t.type&&null!=r){for(var l=u.getNodeFromInstance(this),i=l;i.parentNode;)i=i.parentNode;for(var s=i.querySelectorAll("input[name="+JSON.stringify(""+r)+'][type="radio"]'),p=0;p<s.length;p++){var f=s[p];if(f!==l&&f.form===l.form){var h=u.getInstanceFromNode(f);h?void 0:a("90"),d.asap(o,h)}}}return n}var a=n(36),i=n(3),s=n(100),c=n(106),u=n(35),d=n(57),p=(n(11),n(7),{getHostProps:function(e,t){var n=c.getValue(t),o=c.getChecked(t),r=i({type:void 0,step:void 0,min:void 0,max:void 0},t,{defaultChecked:void 0,defaultValue:void 0,value:null!=n?n:e._wrapperState.initialValue,checked:null!=o?o:e._wrapperState.initialChecked,onChange:e._wrapperState.onChange});return r},mountWrapper:function(e,t){var n=t.defaultValue;e._wrapperState={initialChecked:null!=t.checked?t.checked:t.defaultChecked,initialValue:null!=t.value?t.value:n,listeners:null,onChange:l.bind(e),controlled:r(t)}},updateWrapper:function(e){var t=e._currentElement.props,n=t.checked;null!=n&&s.setValueForProperty(u.getNodeFromInstance(e),"checked",n||!1);var o=u.getNodeFromInstance(e),r=c.getValue(t);if(null!=r)if(0===r&&""===o.value)o.value="0";else if("number"===t.type){var l=parseFloat(o.value,10)||0;(r!=l||r==l&&o.value!=r)&&(o.value=""+r)}else o.value!==""+r&&(o.value=""+r);else null==t.value&&null!=t.defaultValue&&o.defaultValue!==""+t.defaultValue&&(o.defaultValue=""+t.defaultValue),null==t.checked&&null!=t.defaultChecked&&(o.defaultChecked=!!t.defaultChecked)},postMountWrapper:function(e){var t=e._currentElement.props,n=u.getNodeFromInstance(e);switch(t.type){case"submit":case"reset":break;case"color":case"date":case"datetime":case"datetime-local":case"month":case"time":case"week":n.value="",n.value=n.defaultValue;break;default:n.value=n.value}var o=n.name;""!==o&&(n.name=""),n.defaultChecked=!n.defaultChecked,n.defaultChecked=!n.defaultChecked,""!==o&&(n.name=o)}});e.exports=p},function(e,t,n){"use strict";function o(e){null!=e.checkedLink&&null!=e.valueLink?i("87"):void 0}function r(e){o(e),null!=e.value||null!=e.onChange?i("88"):void 0}function l(e){o(e),null!=e.checked||null!=e.onChange?i("89"):void 0}function a(e){if(e){var t=e.getName();if(t)return" Check the render method of `"+t+"`."}return""}var i=n(36),s=n(107),c=n(23),u=n(2),d=c(u.isValidElement),p=(n(11),n(7),{button:!0,checkbox:!0,image:!0,hidden:!0,radio:!0,reset:!0,submit:!0}),f={value:function(e,t,n){return!e[t]||p[e.type]||e.onChange||e.readOnly||e.disabled?null:new Error("You provided a `value` prop to a form field without an `onChange` handler. This will render a read-only field. If the field should be mutable use `defaultValue`. Otherwise, set either `onChange` or `readOnly`.")},checked:function(e,t,n){return!e[t]||e.onChange||e.readOnly||e.disabled?null:new Error("You provided a `checked` prop to a form field without an `onChange` handler. This will render a read-only field. If the field should be mutable use `defaultChecked`. Otherwise, set either `onChange` or `readOnly`.")},onChange:d.func},h={},m={checkPropTypes:function(e,t,n){for(var o in f){if(f.hasOwnProperty(o))var r=f[o](t,o,e,"prop",null,s);if(r instanceof Error&&!(r.message in h)){h[r.message]=!0;a(n)}}},getValue:function(e){return e.valueLink?(r(e),e.valueLink.value):e.value},getChecked:function(e){return e.checkedLink?(l(e),e.checkedLink.value):e.checked},executeOnChange:function(e,t){return e.valueLink?(r(e),e.valueLink.requestChange(t.target.value)):e.checkedLink?(l(e),e.checkedLink.requestChange(t.target.checked)):e.onChange?e.onChange.call(void 0,t):void 0;
 }};e.exports=m},function(e,t){"use strict";var n="SECRET_DO_NOT_PASS_THIS_OR_YOU_WILL_BE_FIRED";e.exports=n},function(e,t,n){"use strict";function o(e){var t="";return l.Children.forEach(e,function(e){null!=e&&("string"==typeof e||"number"==typeof e?t+=e:s||(s=!0))}),t}var r=n(3),l=n(2),a=n(35),i=n(109),s=(n(7),!1),c={mountWrapper:function(e,t,n){var r=null;if(null!=n){var l=n;"optgroup"===l._tag&&(l=l._hostParent),null!=l&&"select"===l._tag&&(r=i.getSelectValueContext(l))}var a=null;if(null!=r){var s;if(s=null!=t.value?t.value+"":o(t.children),a=!1,Array.isArray(r)){for(var c=0;c<r.length;c++)if(""+r[c]===s){a=!0;break}}else a=""+r===s}e._wrapperState={selected:a}},postMountWrapper:function(e){var t=e._currentElement.props;if(null!=t.value){var n=a.getNodeFromInstance(e);n.setAttribute("value",t.value)}},getHostProps:function(e,t){var n=r({selected:void 0,children:void 0},t);null!=e._wrapperState.selected&&(n.selected=e._wrapperState.selected);var l=o(t.children);return l&&(n.children=l),n}};e.exports=c},function(e,t,n){"use strict";function o(){if(this._rootNodeID&&this._wrapperState.pendingUpdate){this._wrapperState.pendingUpdate=!1;var e=this._currentElement.props,t=i.getValue(e);null!=t&&r(this,Boolean(e.multiple),t)}}function r(e,t,n){var o,r,l=s.getNodeFromInstance(e).options;if(t){for(o={},r=0;r<n.length;r++)o[""+n[r]]=!0;for(r=0;r<l.length;r++){var a=o.hasOwnProperty(l[r].value);l[r].selected!==a&&(l[r].selected=a)}}else{for(o=""+n,r=0;r<l.length;r++)if(l[r].value===o)return void(l[r].selected=!0);l.length&&(l[0].selected=!0)}}function l(e){var t=this._currentElement.props,n=i.executeOnChange(t,e);return this._rootNodeID&&(this._wrapperState.pendingUpdate=!0),c.asap(o,this),n}var a=n(3),i=n(106),s=n(35),c=n(57),u=(n(7),!1),d={getHostProps:function(e,t){return a({},t,{onChange:e._wrapperState.onChange,value:void 0})},mountWrapper:function(e,t){var n=i.getValue(t);e._wrapperState={pendingUpdate:!1,initialValue:null!=n?n:t.defaultValue,listeners:null,onChange:l.bind(e),wasMultiple:Boolean(t.multiple)},void 0===t.value||void 0===t.defaultValue||u||(u=!0)},getSelectValueContext:function(e){return e._wrapperState.initialValue},postUpdateWrapper:function(e){var t=e._currentElement.props;e._wrapperState.initialValue=void 0;var n=e._wrapperState.wasMultiple;e._wrapperState.wasMultiple=Boolean(t.multiple);var o=i.getValue(t);null!=o?(e._wrapperState.pendingUpdate=!1,r(e,Boolean(t.multiple),o)):n!==Boolean(t.multiple)&&(null!=t.defaultValue?r(e,Boolean(t.multiple),t.defaultValue):r(e,Boolean(t.multiple),t.multiple?[]:""))}};e.exports=d},function(e,t,n){"use strict";function o(){this._rootNodeID&&u.updateWrapper(this)}function r(e){var t=this._currentElement.props,n=i.executeOnChange(t,e);return c.asap(o,this),n}var l=n(36),a=n(3),i=n(106),s=n(35),c=n(57),u=(n(11),n(7),{getHostProps:function(e,t){null!=t.dangerouslySetInnerHTML?l("91"):void 0;var n=a({},t,{value:void 0,defaultValue:void 0,children:""+e._wrapperState.initialValue,onChange:e._wrapperState.onChange});return n},mountWrapper:function(e,t){var n=i.getValue(t),o=n;if(null==n){var a=t.defaultValue,s=t.children;null!=s&&(null!=a?l("92"):void 0,Array.isArray(s)&&(s.length<=1?void 0:l("93"),s=s[0]),a=""+s),null==a&&(a=""),o=a}e._wrapperState={initialValue:""+o,listeners:null,onChange:r.bind(e)}},updateWrapper:function(e){var t=e._currentElement.props,n=s.getNodeFromInstance(e),o=i.getValue(t);if(null!=o){var r=""+o;r!==n.value&&(n.value=r),null==t.defaultValue&&(n.defaultValue=r)}null!=t.defaultValue&&(n.defaultValue=t.defaultValue)},postMountWrapper:function(e){var t=s.getNodeFromInstance(e),n=t.textContent;n===e._wrapperState.initialValue&&(t.value=n)}});e.exports=u},function(e,t,n){"use strict";function o(e,t,n){return{type:"INSERT_MARKUP",content:e,fromIndex:null,fromNode:null,toIndex:n,afterNode:t}}function r(e,t,n){return{type:"MOVE_EXISTING",content:null,fromIndex:e._mountIndex,fromNode:p.getHostNode(e),toIndex:n,afterNode:t}}function l(e,t){return{type:"REMOVE_NODE",content:null,fromIndex:e._mountIndex,fromNode:t,toIndex:null,afterNode:null}}function a(e){return{type:"SET_MARKUP",content:e,fromIndex:null,fromNode:null,toIndex:null,afterNode:null}}function i(e){return{type:"TEXT_CONTENT",content:e,fromIndex:null,fromNode:null,toIndex:null,afterNode:null}}function s(e,t){return t&&(e=e||[],e.push(t)),e}function c(e,t){d.processChildrenUpdates(e,t)}var u=n(36),d=n(112),p=(n(113),n(63),n(16),n(60)),f=n(114),h=(n(8),n(129)),m=(n(11),{Mixin:{_reconcilerInstantiateChildren:function(e,t,n){return f.instantiateChildren(e,t,n)},_reconcilerUpdateChildren:function(e,t,n,o,r,l){var a,i=0;return a=h(t,i),f.updateChildren(e,a,n,o,r,this,this._hostContainerInfo,l,i),a},mountChildren:function(e,t,n){var o=this._reconcilerInstantiateChildren(e,t,n);this._renderedChildren=o;var r=[],l=0;for(var a in o)if(o.hasOwnProperty(a)){var i=o[a],s=0,c=p.mountComponent(i,t,this,this._hostContainerInfo,n,s);i._mountIndex=l++,r.push(c)}return r},updateTextContent:function(e){var t=this._renderedChildren;f.unmountChildren(t,!1);for(var n in t)t.hasOwnProperty(n)&&u("118");var o=[i(e)];c(this,o)},updateMarkup:function(e){var t=this._renderedChildren;f.unmountChildren(t,!1);for(var n in t)t.hasOwnProperty(n)&&u("118");var o=[a(e)];c(this,o)},updateChildren:function(e,t,n){this._updateChildren(e,t,n)},_updateChildren:function(e,t,n){var o=this._renderedChildren,r={},l=[],a=this._reconcilerUpdateChildren(o,e,l,r,t,n);if(a||o){var i,u=null,d=0,f=0,h=0,m=null;for(i in a)if(a.hasOwnProperty(i)){var b=o&&o[i],g=a[i];b===g?(u=s(u,this.moveChild(b,m,d,f)),f=Math.max(b._mountIndex,f),b._mountIndex=d):(b&&(f=Math.max(b._mountIndex,f)),u=s(u,this._mountChildAtIndex(g,l[h],m,d,t,n)),h++),d++,m=p.getHostNode(g)}for(i in r)r.hasOwnProperty(i)&&(u=s(u,this._unmountChild(o[i],r[i])));u&&c(this,u),this._renderedChildren=a}},unmountChildren:function(e){var t=this._renderedChildren;f.unmountChildren(t,e),this._renderedChildren=null},moveChild:function(e,t,n,o){if(e._mountIndex<o)return r(e,t,n)},createChild:function(e,t,n){return o(n,t,e._mountIndex)},removeChild:function(e,t){return l(e,t)},_mountChildAtIndex:function(e,t,n,o,r,l){return e._mountIndex=o,this.createChild(e,n,t)},_unmountChild:function(e,t){var n=this.removeChild(e,t);return e._mountIndex=null,n}}});e.exports=m},function(e,t,n){"use strict";var o=n(36),r=(n(11),!1),l={replaceNodeWithMarkup:null,processChildrenUpdates:null,injection:{injectEnvironment:function(e){r?o("104"):void 0,l.replaceNodeWithMarkup=e.replaceNodeWithMarkup,l.processChildrenUpdates=e.processChildrenUpdates,r=!0}}};e.exports=l},function(e,t){"use strict";var n={remove:function(e){e._reactInternalInstance=void 0},get:function(e){return e._reactInternalInstance},has:function(e){return void 0!==e._reactInternalInstance},set:function(e,t){e._reactInternalInstance=t}};e.exports=n},function(e,t,n){(function(t){"use strict";function o(e,t,n,o){var r=void 0===e[n];null!=t&&r&&(e[n]=l(t,!0))}var r=n(60),l=n(116),a=(n(124),n(120)),i=n(125),s=(n(7),{instantiateChildren:function(e,t,n,r){if(null==e)return null;var l={};return i(e,o,l),l},updateChildren:function(e,t,n,o,i,s,c,u,d){if(t||e){var p,f;for(p in t)if(t.hasOwnProperty(p)){f=e&&e[p];var h=f&&f._currentElement,m=t[p];if(null!=f&&a(h,m))r.receiveComponent(f,m,i,u),t[p]=f;else{f&&(o[p]=r.getHostNode(f),r.unmountComponent(f,!1));var b=l(m,!0);t[p]=b;var g=r.mountComponent(b,i,s,c,u,d);n.push(g)}}for(p in e)!e.hasOwnProperty(p)||t&&t.hasOwnProperty(p)||(f=e[p],o[p]=r.getHostNode(f),r.unmountComponent(f,!1))}},unmountChildren:function(e,t){for(var n in e)if(e.hasOwnProperty(n)){var o=e[n];r.unmountComponent(o,t)}}});e.exports=s}).call(t,n(115))},function(e,t){function n(){throw new Error("setTimeout has not been defined")}function o(){throw new Error("clearTimeout has not been defined")}function r(e){if(u===setTimeout)return setTimeout(e,0);if((u===n||!u)&&setTimeout)return u=setTimeout,setTimeout(e,0);try{return u(e,0)}catch(t){try{return u.call(null,e,0)}catch(t){return u.call(this,e,0)}}}function l(e){if(d===clearTimeout)return clearTimeout(e);if((d===o||!d)&&clearTimeout)return d=clearTimeout,clearTimeout(e);try{return d(e)}catch(t){try{return d.call(null,e)}catch(t){return d.call(this,e)}}}function a(){m&&f&&(m=!1,f.length?h=f.concat(h):b=-1,h.length&&i())}function i(){if(!m){var e=r(a);m=!0;for(var t=h.length;t;){for(f=h,h=[];++b<t;)f&&f[b].run();b=-1,t=h.length}f=null,m=!1,l(e)}}function s(e,t){this.fun=e,this.array=t}function c(){}var u,d,p=e.exports={};!function(){try{u="function"==typeof setTimeout?setTimeout:n}catch(e){u=n}try{d="function"==typeof clearTimeout?clearTimeout:o}catch(e){d=o}}();var f,h=[],m=!1,b=-1;p.nextTick=function(e){var t=new Array(arguments.length-1);if(arguments.length>1)for(var n=1;n<arguments.length;n++)t[n-1]=arguments[n];h.push(new s(e,t)),1!==h.length||m||r(i)},s.prototype.run=function(){this.fun.apply(null,this.array)},p.title="browser",p.browser=!0,p.env={},p.argv=[],p.version="",p.versions={},p.on=c,p.addListener=c,p.once=c,p.off=c,p.removeListener=c,p.removeAllListeners=c,p.emit=c,p.prependListener=c,p.prependOnceListener=c,p.listeners=function(e){return[]},p.binding=function(e){throw new Error("process.binding is not supported")},p.cwd=function(){return"/"},p.chdir=function(e){throw new Error("process.chdir is not supported")},p.umask=function(){return 0}},function(e,t,n){"use strict";function o(e){if(e){var t=e.getName();if(t)return" Check the render method of `"+t+"`."}return""}function r(e){return"function"==typeof e&&"undefined"!=typeof e.prototype&&"function"==typeof e.prototype.mountComponent&&"function"==typeof e.prototype.receiveComponent}function l(e,t){var n;if(null===e||e===!1)n=c.create(l);else if("object"==typeof e){var i=e,s=i.type;if("function"!=typeof s&&"string"!=typeof s){var p="";p+=o(i._owner),a("130",null==s?s:typeof s,p)}"string"==typeof i.type?n=u.createInternalComponent(i):r(i.type)?(n=new i.type(i),n.getHostNode||(n.getHostNode=n.getNativeNode)):n=new d(i)}else"string"==typeof e||"number"==typeof e?n=u.createInstanceForText(e):a("131",typeof e);return n._mountIndex=0,n._mountImage=null,n}var a=n(36),i=n(3),s=n(117),c=n(121),u=n(122),d=(n(123),n(11),n(7),function(e){this.construct(e)});i(d.prototype,s,{_instantiateReactComponent:l}),e.exports=l},function(e,t,n){"use strict";function o(e){}function r(e,t){}function l(e){return!(!e.prototype||!e.prototype.isReactComponent)}function a(e){return!(!e.prototype||!e.prototype.isPureReactComponent)}var i=n(36),s=n(3),c=n(2),u=n(112),d=n(16),p=n(46),f=n(113),h=(n(63),n(118)),m=n(60),b=n(10),g=(n(11),n(119)),_=n(120),v=(n(7),{ImpureClass:0,PureClass:1,StatelessFunctional:2});o.prototype.render=function(){var e=f.get(this)._currentElement.type,t=e(this.props,this.context,this.updater);return r(e,t),t};var y=1,x={construct:function(e){this._currentElement=e,this._rootNodeID=0,this._compositeType=null,this._instance=null,this._hostParent=null,this._hostContainerInfo=null,this._updateBatchNumber=null,this._pendingElement=null,this._pendingStateQueue=null,this._pendingReplaceState=!1,this._pendingForceUpdate=!1,this._renderedNodeType=null,this._renderedComponent=null,this._context=null,this._mountOrder=0,this._topLevelWrapper=null,this._pendingCallbacks=null,this._calledComponentWillUnmount=!1},mountComponent:function(e,t,n,s){this._context=s,this._mountOrder=y++,this._hostParent=t,this._hostContainerInfo=n;var u,d=this._currentElement.props,p=this._processContext(s),h=this._currentElement.type,m=e.getUpdateQueue(),g=l(h),_=this._constructComponent(g,d,p,m);g||null!=_&&null!=_.render?a(h)?this._compositeType=v.PureClass:this._compositeType=v.ImpureClass:(u=_,r(h,u),null===_||_===!1||c.isValidElement(_)?void 0:i("105",h.displayName||h.name||"Component"),_=new o(h),this._compositeType=v.StatelessFunctional);_.props=d,_.context=p,_.refs=b,_.updater=m,this._instance=_,f.set(_,this);var x=_.state;void 0===x&&(_.state=x=null),"object"!=typeof x||Array.isArray(x)?i("106",this.getName()||"ReactCompositeComponent"):void 0,this._pendingStateQueue=null,this._pendingReplaceState=!1,this._pendingForceUpdate=!1;var w;return w=_.unstable_handleError?this.performInitialMountWithErrorHandling(u,t,n,e,s):this.performInitialMount(u,t,n,e,s),_.componentDidMount&&e.getReactMountReady().enqueue(_.componentDidMount,_),w},_constructComponent:function(e,t,n,o){return this._constructComponentWithoutOwner(e,t,n,o)},_constructComponentWithoutOwner:function(e,t,n,o){var r=this._currentElement.type;return e?new r(t,n,o):r(t,n,o)},performInitialMountWithErrorHandling:function(e,t,n,o,r){var l,a=o.checkpoint();try{l=this.performInitialMount(e,t,n,o,r)}catch(i){o.rollback(a),this._instance.unstable_handleError(i),this._pendingStateQueue&&(this._instance.state=this._processPendingState(this._instance.props,this._instance.context)),a=o.checkpoint(),this._renderedComponent.unmountComponent(!0),o.rollback(a),l=this.performInitialMount(e,t,n,o,r)}return l},performInitialMount:function(e,t,n,o,r){var l=this._instance,a=0;l.componentWillMount&&(l.componentWillMount(),this._pendingStateQueue&&(l.state=this._processPendingState(l.props,l.context))),void 0===e&&(e=this._renderValidatedComponent());var i=h.getType(e);this._renderedNodeType=i;var s=this._instantiateReactComponent(e,i!==h.EMPTY);this._renderedComponent=s;var c=m.mountComponent(s,o,t,n,this._processChildContext(r),a);return c},getHostNode:function(){return m.getHostNode(this._renderedComponent)},unmountComponent:function(e){if(this._renderedComponent){var t=this._instance;if(t.componentWillUnmount&&!t._calledComponentWillUnmount)if(t._calledComponentWillUnmount=!0,e){var n=this.getName()+".componentWillUnmount()";p.invokeGuardedCallback(n,t.componentWillUnmount.bind(t))}else t.componentWillUnmount();this._renderedComponent&&(m.unmountComponent(this._renderedComponent,e),this._renderedNodeType=null,this._renderedComponent=null,this._instance=null),this._pendingStateQueue=null,this._pendingReplaceState=!1,this._pendingForceUpdate=!1,this._pendingCallbacks=null,this._pendingElement=null,this._context=null,this._rootNodeID=0,this._topLevelWrapper=null,f.remove(t)}},_maskContext:function(e){var t=this._currentElement.type,n=t.contextTypes;if(!n)return b;var o={};for(var r in n)o[r]=e[r];return o},_processContext:function(e){var t=this._maskContext(e);return t},_processChildContext:function(e){var t,n=this._currentElement.type,o=this._instance;if(o.getChildContext&&(t=o.getChildContext()),t){"object"!=typeof n.childContextTypes?i("107",this.getName()||"ReactCompositeComponent"):void 0;for(var r in t)r in n.childContextTypes?void 0:i("108",this.getName()||"ReactCompositeComponent",r);return s({},e,t)}return e},_checkContextTypes:function(e,t,n){},receiveComponent:function(e,t,n){var o=this._currentElement,r=this._context;this._pendingElement=null,this.updateComponent(t,o,e,r,n)},performUpdateIfNecessary:function(e){null!=this._pendingElement?m.receiveComponent(this,this._pendingElement,e,this._context):null!==this._pendingStateQueue||this._pendingForceUpdate?this.updateComponent(e,this._currentElement,this._currentElement,this._context,this._context):this._updateBatchNumber=null},updateComponent:function(e,t,n,o,r){var l=this._instance;null==l?i("136",this.getName()||"ReactCompositeComponent"):void 0;var a,s=!1;this._context===r?a=l.context:(a=this._processContext(r),s=!0);var c=t.props,u=n.props;t!==n&&(s=!0),s&&l.componentWillReceiveProps&&l.componentWillReceiveProps(u,a);var d=this._processPendingState(u,a),p=!0;this._pendingForceUpdate||(l.shouldComponentUpdate?p=l.shouldComponentUpdate(u,d,a):this._compositeType===v.PureClass&&(p=!g(c,u)||!g(l.state,d))),this._updateBatchNumber=null,p?(this._pendingForceUpdate=!1,this._performComponentUpdate(n,u,d,a,e,r)):(this._currentElement=n,this._context=r,l.props=u,l.state=d,l.context=a)},_processPendingState:function(e,t){var n=this._instance,o=this._pendingStateQueue,r=this._pendingReplaceState;if(this._pendingReplaceState=!1,this._pendingStateQueue=null,!o)return n.state;if(r&&1===o.length)return o[0];for(var l=s({},r?o[0]:n.state),a=r?1:0;a<o.length;a++){var i=o[a];s(l,"function"==typeof i?i.call(n,l,e,t):i)}return l},_performComponentUpdate:function(e,t,n,o,r,l){var a,i,s,c=this._instance,u=Boolean(c.componentDidUpdate);u&&(a=c.props,i=c.state,s=c.context),c.componentWillUpdate&&c.componentWillUpdate(t,n,o),this._currentElement=e,this._context=l,c.props=t,c.state=n,c.context=o,this._updateRenderedComponent(r,l),u&&r.getReactMountReady().enqueue(c.componentDidUpdate.bind(c,a,i,s),c)},_updateRenderedComponent:function(e,t){var n=this._renderedComponent,o=n._currentElement,r=this._renderValidatedComponent(),l=0;if(_(o,r))m.receiveComponent(n,r,e,this._processChildContext(t));else{var a=m.getHostNode(n);m.unmountComponent(n,!1);var i=h.getType(r);this._renderedNodeType=i;var s=this._instantiateReactComponent(r,i!==h.EMPTY);this._renderedComponent=s;var c=m.mountComponent(s,e,this._hostParent,this._hostContainerInfo,this._processChildContext(t),l);this._replaceNodeWithMarkup(a,c,n)}},_replaceNodeWithMarkup:function(e,t,n){u.replaceNodeWithMarkup(e,t,n)},_renderValidatedComponentWithoutOwnerOrContext:function(){var e,t=this._instance;return e=t.render()},_renderValidatedComponent:function(){var e;if(this._compositeType!==v.StatelessFunctional){d.current=this;try{e=this._renderValidatedComponentWithoutOwnerOrContext()}finally{d.current=null}}else e=this._renderValidatedComponentWithoutOwnerOrContext();return null===e||e===!1||c.isValidElement(e)?void 0:i("109",this.getName()||"ReactCompositeComponent"),e},attachRef:function(e,t){var n=this.getPublicInstance();null==n?i("110"):void 0;var o=t.getPublicInstance(),r=n.refs===b?n.refs={}:n.refs;r[e]=o},detachRef:function(e){var t=this.getPublicInstance().refs;delete t[e]},getName:function(){var e=this._currentElement.type,t=this._instance&&this._instance.constructor;return e.displayName||t&&t.displayName||e.name||t&&t.name||null},getPublicInstance:function(){var e=this._instance;return this._compositeType===v.StatelessFunctional?null:e},_instantiateReactComponent:null};e.exports=x},function(e,t,n){"use strict";var o=n(36),r=n(2),l=(n(11),{HOST:0,COMPOSITE:1,EMPTY:2,getType:function(e){return null===e||e===!1?l.EMPTY:r.isValidElement(e)?"function"==typeof e.type?l.COMPOSITE:l.HOST:void o("26",e)}});e.exports=l},function(e,t){"use strict";function n(e,t){return e===t?0!==e||0!==t||1/e===1/t:e!==e&&t!==t}function o(e,t){if(n(e,t))return!0;if("object"!=typeof e||null===e||"object"!=typeof t||null===t)return!1;var o=Object.keys(e),l=Object.keys(t);if(o.length!==l.length)return!1;for(var a=0;a<o.length;a++)if(!r.call(t,o[a])||!n(e[o[a]],t[o[a]]))return!1;return!0}var r=Object.prototype.hasOwnProperty;e.exports=o},function(e,t){"use strict";function n(e,t){var n=null===e||e===!1,o=null===t||t===!1;if(n||o)return n===o;var r=typeof e,l=typeof t;return"string"===r||"number"===r?"string"===l||"number"===l:"object"===l&&e.type===t.type&&e.key===t.key}e.exports=n},function(e,t){"use strict";var n,o={injectEmptyComponentFactory:function(e){n=e}},r={create:function(e){return n(e)}};r.injection=o,e.exports=r},function(e,t,n){"use strict";function o(e){return i?void 0:a("111",e.type),new i(e)}function r(e){return new s(e)}function l(e){return e instanceof s}var a=n(36),i=(n(11),null),s=null,c={injectGenericComponentClass:function(e){i=e},injectTextComponentClass:function(e){s=e}},u={createInternalComponent:o,createInstanceForText:r,isTextComponent:l,injection:c};e.exports=u},function(e,t){"use strict";function n(){return o++}var o=1;e.exports=n},function(e,t){"use strict";function n(e){var t=/[=:]/g,n={"=":"=0",":":"=2"},o=(""+e).replace(t,function(e){return n[e]});return"$"+o}function o(e){var t=/(=0|=2)/g,n={"=0":"=","=2":":"},o="."===e[0]&&"$"===e[1]?e.substring(2):e.substring(1);return(""+o).replace(t,function(e){return n[e]})}var r={escape:n,unescape:o};e.exports=r},function(e,t,n){"use strict";function o(e,t){return e&&"object"==typeof e&&null!=e.key?c.escape(e.key):t.toString(36)}function r(e,t,n,l){var p=typeof e;if("undefined"!==p&&"boolean"!==p||(e=null),null===e||"string"===p||"number"===p||"object"===p&&e.$$typeof===i)return n(l,e,""===t?u+o(e,0):t),1;var f,h,m=0,b=""===t?u:t+d;if(Array.isArray(e))for(var g=0;g<e.length;g++)f=e[g],h=b+o(f,g),m+=r(f,h,n,l);else{var _=s(e);if(_){var v,y=_.call(e);if(_!==e.entries)for(var x=0;!(v=y.next()).done;)f=v.value,h=b+o(f,x++),m+=r(f,h,n,l);else for(;!(v=y.next()).done;){var w=v.value;w&&(f=w[1],h=b+c.escape(w[0])+d+o(f,0),m+=r(f,h,n,l))}}else if("object"===p){var k="",E=String(e);a("31","[object Object]"===E?"object with keys {"+Object.keys(e).join(", ")+"}":E,k)}}return m}function l(e,t,n){return null==e?0:r(e,"",t,n)}var a=n(36),i=(n(16),n(126)),s=n(127),c=(n(11),n(124)),u=(n(7),"."),d=":";e.exports=l},function(e,t){"use strict";var n="function"==typeof Symbol&&Symbol.for&&Symbol.for("react.element")||60103;e.exports=n},function(e,t){"use strict";function n(e){var t=e&&(o&&e[o]||e[r]);if("function"==typeof t)return t}var o="function"==typeof Symbol&&Symbol.iterator,r="@@iterator";e.exports=n},function(e,t,n){"use strict";function o(e){var t=Function.prototype.toString,n=Object.prototype.hasOwnProperty,o=RegExp("^"+t.call(n).replace(/[\\^$.*+?()[\]{}|]/g,"\\$&").replace(/hasOwnProperty|(function).*?(?=\\\()| for .+?(?=\\\])/g,"$1.*?")+"$");try{var r=t.call(e);return o.test(r)}catch(e){return!1}}function r(e){var t=c(e);if(t){var n=t.childIDs;u(e),n.forEach(r)}}function l(e,t,n){return"\n    in "+(e||"Unknown")+(t?" (at "+t.fileName.replace(/^.*[\\\/]/,"")+":"+t.lineNumber+")":n?" (created by "+n+")":"")}function a(e){return null==e?"#empty":"string"==typeof e||"number"==typeof e?"#text":"string"==typeof e.type?e.type:e.type.displayName||e.type.name||"Unknown"}function i(e){var t,n=C.getDisplayName(e),o=C.getElement(e),r=C.getOwnerID(e);return r&&(t=C.getDisplayName(r)),l(n,o&&o._source,t)}var s,c,u,d,p,f,h,m=n(5),b=n(16),g=(n(11),n(7),"function"==typeof Array.from&&"function"==typeof Map&&o(Map)&&null!=Map.prototype&&"function"==typeof Map.prototype.keys&&o(Map.prototype.keys)&&"function"==typeof Set&&o(Set)&&null!=Set.prototype&&"function"==typeof Set.prototype.keys&&o(Set.prototype.keys));if(g){var _=new Map,v=new Set;s=function(e,t){_.set(e,t)},c=function(e){return _.get(e)},u=function(e){_.delete(e)},d=function(){return Array.from(_.keys())},p=function(e){v.add(e)},f=function(e){v.delete(e)},h=function(){return Array.from(v.keys())}}else{var y={},x={},w=function(e){return"."+e},k=function(e){return parseInt(e.substr(1),10)};s=function(e,t){var n=w(e);y[n]=t},c=function(e){var t=w(e);return y[t]},u=function(e){var t=w(e);delete y[t]},d=function(){return Object.keys(y).map(k)},p=function(e){var t=w(e);x[t]=!0},f=function(e){var t=w(e);delete x[t]},h=function(){return Object.keys(x).map(k)}}var E=[],C={onSetChildren:function(e,t){var n=c(e);n?void 0:m("144"),n.childIDs=t;for(var o=0;o<t.length;o++){var r=t[o],l=c(r);l?void 0:m("140"),null==l.childIDs&&"object"==typeof l.element&&null!=l.element?m("141"):void 0,l.isMounted?void 0:m("71"),null==l.parentID&&(l.parentID=e),l.parentID!==e?m("142",r,l.parentID,e):void 0}},onBeforeMountComponent:function(e,t,n){var o={element:t,parentID:n,text:null,childIDs:[],isMounted:!1,updateCount:0};s(e,o)},onBeforeUpdateComponent:function(e,t){var n=c(e);n&&n.isMounted&&(n.element=t)},onMountComponent:function(e){var t=c(e);t?void 0:m("144"),t.isMounted=!0;var n=0===t.parentID;n&&p(e)},onUpdateComponent:function(e){var t=c(e);t&&t.isMounted&&t.updateCount++},onUnmountComponent:function(e){var t=c(e);if(t){t.isMounted=!1;var n=0===t.parentID;n&&f(e)}E.push(e)},purgeUnmountedComponents:function(){if(!C._preventPurging){for(var e=0;e<E.length;e++){var t=E[e];r(t)}E.length=0}},isMounted:function(e){var t=c(e);return!!t&&t.isMounted},getCurrentStackAddendum:function(e){var t="";if(e){var n=a(e),o=e._owner;t+=l(n,e._source,o&&o.getName())}var r=b.current,i=r&&r._debugID;return t+=C.getStackAddendumByID(i)},getStackAddendumByID:function(e){for(var t="";e;)t+=i(e),e=C.getParentID(e);return t},getChildIDs:function(e){var t=c(e);return t?t.childIDs:[]},getDisplayName:function(e){var t=C.getElement(e);return t?a(t):null},getElement:function(e){var t=c(e);return t?t.element:null},getOwnerID:function(e){var t=C.getElement(e);return t&&t._owner?t._owner._debugID:null},getParentID:function(e){var t=c(e);return t?t.parentID:null},getSource:function(e){var t=c(e),n=t?t.element:null,o=null!=n?n._source:null;return o},getText:function(e){var t=C.getElement(e);return"string"==typeof t?t:"number"==typeof t?""+t:null},getUpdateCount:function(e){var t=c(e);return t?t.updateCount:0},getRootIDs:h,getRegisteredIDs:d,pushNonStandardWarningStack:function(e,t){if("function"==typeof console.reactStack){var n=[],o=b.current,r=o&&o._debugID;try{for(e&&n.push({name:r?C.getDisplayName(r):null,fileName:t?t.fileName:null,lineNumber:t?t.lineNumber:null});r;){var l=C.getElement(r),a=C.getParentID(r),i=C.getOwnerID(r),s=i?C.getDisplayName(i):null,c=l&&l._source;n.push({name:s,fileName:c?c.fileName:null,lineNumber:c?c.lineNumber:null}),r=a}}catch(e){}console.reactStack(n)}},popNonStandardWarningStack:function(){"function"==typeof console.reactStackEnd&&console.reactStackEnd()}};e.exports=C},function(e,t,n){(function(t){"use strict";function o(e,t,n,o){if(e&&"object"==typeof e){var r=e,l=void 0===r[n];l&&null!=t&&(r[n]=t)}}function r(e,t){if(null==e)return e;var n={};return l(e,o,n),n}var l=(n(124),n(125));n(7);e.exports=r}).call(t,n(115))},function(e,t,n){"use strict";function o(e){this.reinitializeTransaction(),this.renderToStaticMarkup=e,this.useCreateElement=!1,this.updateQueue=new i(this)}var r=n(3),l=n(51),a=n(64),i=(n(63),n(131)),s=[],c={enqueue:function(){}},u={getTransactionWrappers:function(){return s},getReactMountReady:function(){return c},getUpdateQueue:function(){return this.updateQueue},destructor:function(){},checkpoint:function(){},rollback:function(){}};r(o.prototype,a,u),l.addPoolingTo(o),e.exports=o},function(e,t,n){"use strict";function o(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function r(e,t){}var l=n(132),a=(n(7),function(){function e(t){o(this,e),this.transaction=t}return e.prototype.isMounted=function(e){return!1},e.prototype.enqueueCallback=function(e,t,n){this.transaction.isInTransaction()&&l.enqueueCallback(e,t,n)},e.prototype.enqueueForceUpdate=function(e){this.transaction.isInTransaction()?l.enqueueForceUpdate(e):r(e,"forceUpdate")},e.prototype.enqueueReplaceState=function(e,t){this.transaction.isInTransaction()?l.enqueueReplaceState(e,t):r(e,"replaceState")},e.prototype.enqueueSetState=function(e,t){this.transaction.isInTransaction()?l.enqueueSetState(e,t):r(e,"setState")},e}());e.exports=a},function(e,t,n){"use strict";function o(e){s.enqueueUpdate(e)}function r(e){var t=typeof e;if("object"!==t)return t;var n=e.constructor&&e.constructor.name||t,o=Object.keys(e);return o.length>0&&o.length<20?n+" (keys: "+o.join(", ")+")":n}function l(e,t){var n=i.get(e);if(!n){return null}return n}var a=n(36),i=(n(16),n(113)),s=(n(63),n(57)),c=(n(11),n(7),{isMounted:function(e){var t=i.get(e);return!!t&&!!t._renderedComponent},enqueueCallback:function(e,t,n){c.validateCallback(t,n);var r=l(e);return r?(r._pendingCallbacks?r._pendingCallbacks.push(t):r._pendingCallbacks=[t],void o(r)):null},enqueueCallbackInternal:function(e,t){e._pendingCallbacks?e._pendingCallbacks.push(t):e._pendingCallbacks=[t],o(e)},enqueueForceUpdate:function(e){var t=l(e,"forceUpdate");t&&(t._pendingForceUpdate=!0,o(t))},enqueueReplaceState:function(e,t,n){var r=l(e,"replaceState");r&&(r._pendingStateQueue=[t],r._pendingReplaceState=!0,void 0!==n&&null!==n&&(c.validateCallback(n,"replaceState"),r._pendingCallbacks?r._pendingCallbacks.push(n):r._pendingCallbacks=[n]),o(r))},enqueueSetState:function(e,t){var n=l(e,"setState");if(n){var r=n._pendingStateQueue||(n._pendingStateQueue=[]);r.push(t),o(n)}},enqueueElementInternal:function(e,t,n){e._pendingElement=t,e._context=n,o(e)},validateCallback:function(e,t){e&&"function"!=typeof e?a("122",t,r(e)):void 0}});e.exports=c},function(e,t,n){"use strict";var o=(n(3),n(8)),r=(n(7),o);e.exports=r},function(e,t,n){"use strict";var o=n(3),r=n(78),l=n(35),a=function(e){this._currentElement=null,this._hostNode=null,this._hostParent=null,this._hostContainerInfo=null,this._domID=0};o(a.prototype,{mountComponent:function(e,t,n,o){var a=n._idCounter++;this._domID=a,this._hostParent=t,this._hostContainerInfo=n;var i=" react-empty: "+this._domID+" ";if(e.useCreateElement){var s=n._ownerDocument,c=s.createComment(i);return l.precacheNode(this,c),r(c)}return e.renderToStaticMarkup?"":"<!--"+i+"-->"},receiveComponent:function(){},getHostNode:function(){return l.getNodeFromInstance(this)},unmountComponent:function(){l.uncacheNode(this)}}),e.exports=a},function(e,t,n){"use strict";function o(e,t){"_hostNode"in e?void 0:s("33"),"_hostNode"in t?void 0:s("33");for(var n=0,o=e;o;o=o._hostParent)n++;for(var r=0,l=t;l;l=l._hostParent)r++;for(;n-r>0;)e=e._hostParent,n--;for(;r-n>0;)t=t._hostParent,r--;for(var a=n;a--;){if(e===t)return e;e=e._hostParent,t=t._hostParent}return null}function r(e,t){"_hostNode"in e?void 0:s("35"),"_hostNode"in t?void 0:s("35");for(;t;){if(t===e)return!0;t=t._hostParent}return!1}function l(e){return"_hostNode"in e?void 0:s("36"),e._hostParent}function a(e,t,n){for(var o=[];e;)o.push(e),e=e._hostParent;var r;for(r=o.length;r-- >0;)t(o[r],"captured",n);for(r=0;r<o.length;r++)t(o[r],"bubbled",n)}function i(e,t,n,r,l){for(var a=e&&t?o(e,t):null,i=[];e&&e!==a;)i.push(e),e=e._hostParent;for(var s=[];t&&t!==a;)s.push(t),t=t._hostParent;var c;for(c=0;c<i.length;c++)n(i[c],"bubbled",r);for(c=s.length;c-- >0;)n(s[c],"captured",l)}var s=n(36);n(11);e.exports={isAncestor:r,getLowestCommonAncestor:o,getParentInstance:l,traverseTwoPhase:a,traverseEnterLeave:i}},function(e,t,n){"use strict";var o=n(36),r=n(3),l=n(77),a=n(78),i=n(35),s=n(83),c=(n(11),n(133),function(e){this._currentElement=e,this._stringText=""+e,this._hostNode=null,this._hostParent=null,this._domID=0,this._mountIndex=0,this._closingComment=null,this._commentNodes=null});r(c.prototype,{mountComponent:function(e,t,n,o){var r=n._idCounter++,l=" react-text: "+r+" ",c=" /react-text ";if(this._domID=r,this._hostParent=t,e.useCreateElement){var u=n._ownerDocument,d=u.createComment(l),p=u.createComment(c),f=a(u.createDocumentFragment());return a.queueChild(f,a(d)),this._stringText&&a.queueChild(f,a(u.createTextNode(this._stringText))),a.queueChild(f,a(p)),i.precacheNode(this,d),this._closingComment=p,f}var h=s(this._stringText);return e.renderToStaticMarkup?h:"<!--"+l+"-->"+h+"<!--"+c+"-->"},receiveComponent:function(e,t){if(e!==this._currentElement){this._currentElement=e;var n=""+e;if(n!==this._stringText){this._stringText=n;var o=this.getHostNode();l.replaceDelimitedText(o[0],o[1],n)}}},getHostNode:function(){var e=this._commentNodes;if(e)return e;if(!this._closingComment)for(var t=i.getNodeFromInstance(this),n=t.nextSibling;;){if(null==n?o("67",this._domID):void 0,8===n.nodeType&&" /react-text "===n.nodeValue){this._closingComment=n;break}n=n.nextSibling}return e=[this._hostNode,this._closingComment],this._commentNodes=e,e},unmountComponent:function(){this._closingComment=null,this._commentNodes=null,i.uncacheNode(this)}}),e.exports=c},function(e,t,n){"use strict";function o(){this.reinitializeTransaction()}var r=n(3),l=n(57),a=n(64),i=n(8),s={initialize:i,close:function(){p.isBatchingUpdates=!1}},c={initialize:i,close:l.flushBatchedUpdates.bind(l)},u=[c,s];r(o.prototype,a,{getTransactionWrappers:function(){return u}});var d=new o,p={isBatchingUpdates:!1,batchedUpdates:function(e,t,n,o,r,l){var a=p.isBatchingUpdates;return p.isBatchingUpdates=!0,a?e(t,n,o,r,l):d.perform(e,null,t,n,o,r,l)}};e.exports=p},function(e,t,n){"use strict";function o(e){for(;e._hostParent;)e=e._hostParent;var t=d.getNodeFromInstance(e),n=t.parentNode;return d.getClosestInstanceFromNode(n);
-}function r(e,t){this.topLevelType=e,this.nativeEvent=t,this.ancestors=[]}function l(e){var t=f(e.nativeEvent),n=d.getClosestInstanceFromNode(t),r=n;do e.ancestors.push(r),r=r&&o(r);while(r);for(var l=0;l<e.ancestors.length;l++)n=e.ancestors[l],m._handleTopLevel(e.topLevelType,n,e.nativeEvent,f(e.nativeEvent))}function a(e){var t=h(window);e(t)}var i=n(3),s=n(139),c=n(49),u=n(51),d=n(35),p=n(57),f=n(66),h=n(140);i(r.prototype,{destructor:function(){this.topLevelType=null,this.nativeEvent=null,this.ancestors.length=0}}),u.addPoolingTo(r,u.twoArgumentPooler);var m={_enabled:!0,_handleTopLevel:null,WINDOW_HANDLE:c.canUseDOM?window:null,setHandleTopLevel:function(e){m._handleTopLevel=e},setEnabled:function(e){m._enabled=!!e},isEnabled:function(){return m._enabled},trapBubbledEvent:function(e,t,n){return n?s.listen(n,t,m.dispatchEvent.bind(null,e)):null},trapCapturedEvent:function(e,t,n){return n?s.capture(n,t,m.dispatchEvent.bind(null,e)):null},monitorScrollValue:function(e){var t=a.bind(null,e);s.listen(window,"scroll",t)},dispatchEvent:function(e,t){if(m._enabled){var n=r.getPooled(e,t);try{p.batchedUpdates(l,n)}finally{r.release(n)}}}};e.exports=m},function(e,t,n){"use strict";var o=n(8),r={listen:function(e,t,n){return e.addEventListener?(e.addEventListener(t,n,!1),{remove:function(){e.removeEventListener(t,n,!1)}}):e.attachEvent?(e.attachEvent("on"+t,n),{remove:function(){e.detachEvent("on"+t,n)}}):void 0},capture:function(e,t,n){return e.addEventListener?(e.addEventListener(t,n,!0),{remove:function(){e.removeEventListener(t,n,!0)}}):{remove:o}},registerDefault:function(){}};e.exports=r},function(e,t){"use strict";function n(e){return e.Window&&e instanceof e.Window?{x:e.pageXOffset||e.document.documentElement.scrollLeft,y:e.pageYOffset||e.document.documentElement.scrollTop}:{x:e.scrollLeft,y:e.scrollTop}}e.exports=n},function(e,t,n){"use strict";var o=n(37),r=n(43),l=n(45),a=n(112),i=n(121),s=n(102),c=n(122),u=n(57),d={Component:a.injection,DOMProperty:o.injection,EmptyComponent:i.injection,EventPluginHub:r.injection,EventPluginUtils:l.injection,EventEmitter:s.injection,HostComponent:c.injection,Updates:u.injection};e.exports=d},function(e,t,n){"use strict";function o(e){this.reinitializeTransaction(),this.renderToStaticMarkup=!1,this.reactMountReady=l.getPooled(null),this.useCreateElement=e}var r=n(3),l=n(58),a=n(51),i=n(102),s=n(143),c=(n(63),n(64)),u=n(132),d={initialize:s.getSelectionInformation,close:s.restoreSelection},p={initialize:function(){var e=i.isEnabled();return i.setEnabled(!1),e},close:function(e){i.setEnabled(e)}},f={initialize:function(){this.reactMountReady.reset()},close:function(){this.reactMountReady.notifyAll()}},h=[d,p,f],m={getTransactionWrappers:function(){return h},getReactMountReady:function(){return this.reactMountReady},getUpdateQueue:function(){return u},checkpoint:function(){return this.reactMountReady.checkpoint()},rollback:function(e){this.reactMountReady.rollback(e)},destructor:function(){l.release(this.reactMountReady),this.reactMountReady=null}};r(o.prototype,c,m),a.addPoolingTo(o),e.exports=o},function(e,t,n){"use strict";function o(e){return l(document.documentElement,e)}var r=n(144),l=n(146),a=n(91),i=n(149),s={hasSelectionCapabilities:function(e){var t=e&&e.nodeName&&e.nodeName.toLowerCase();return t&&("input"===t&&"text"===e.type||"textarea"===t||"true"===e.contentEditable)},getSelectionInformation:function(){var e=i();return{focusedElem:e,selectionRange:s.hasSelectionCapabilities(e)?s.getSelection(e):null}},restoreSelection:function(e){var t=i(),n=e.focusedElem,r=e.selectionRange;t!==n&&o(n)&&(s.hasSelectionCapabilities(n)&&s.setSelection(n,r),a(n))},getSelection:function(e){var t;if("selectionStart"in e)t={start:e.selectionStart,end:e.selectionEnd};else if(document.selection&&e.nodeName&&"input"===e.nodeName.toLowerCase()){var n=document.selection.createRange();n.parentElement()===e&&(t={start:-n.moveStart("character",-e.value.length),end:-n.moveEnd("character",-e.value.length)})}else t=r.getOffsets(e);return t||{start:0,end:0}},setSelection:function(e,t){var n=t.start,o=t.end;if(void 0===o&&(o=n),"selectionStart"in e)e.selectionStart=n,e.selectionEnd=Math.min(o,e.value.length);else if(document.selection&&e.nodeName&&"input"===e.nodeName.toLowerCase()){var l=e.createTextRange();l.collapse(!0),l.moveStart("character",n),l.moveEnd("character",o-n),l.select()}else r.setOffsets(e,t)}};e.exports=s},function(e,t,n){"use strict";function o(e,t,n,o){return e===n&&t===o}function r(e){var t=document.selection,n=t.createRange(),o=n.text.length,r=n.duplicate();r.moveToElementText(e),r.setEndPoint("EndToStart",n);var l=r.text.length,a=l+o;return{start:l,end:a}}function l(e){var t=window.getSelection&&window.getSelection();if(!t||0===t.rangeCount)return null;var n=t.anchorNode,r=t.anchorOffset,l=t.focusNode,a=t.focusOffset,i=t.getRangeAt(0);try{i.startContainer.nodeType,i.endContainer.nodeType}catch(e){return null}var s=o(t.anchorNode,t.anchorOffset,t.focusNode,t.focusOffset),c=s?0:i.toString().length,u=i.cloneRange();u.selectNodeContents(e),u.setEnd(i.startContainer,i.startOffset);var d=o(u.startContainer,u.startOffset,u.endContainer,u.endOffset),p=d?0:u.toString().length,f=p+c,h=document.createRange();h.setStart(n,r),h.setEnd(l,a);var m=h.collapsed;return{start:m?f:p,end:m?p:f}}function a(e,t){var n,o,r=document.selection.createRange().duplicate();void 0===t.end?(n=t.start,o=n):t.start>t.end?(n=t.end,o=t.start):(n=t.start,o=t.end),r.moveToElementText(e),r.moveStart("character",n),r.setEndPoint("EndToStart",r),r.moveEnd("character",o-n),r.select()}function i(e,t){if(window.getSelection){var n=window.getSelection(),o=e[u()].length,r=Math.min(t.start,o),l=void 0===t.end?r:Math.min(t.end,o);if(!n.extend&&r>l){var a=l;l=r,r=a}var i=c(e,r),s=c(e,l);if(i&&s){var d=document.createRange();d.setStart(i.node,i.offset),n.removeAllRanges(),r>l?(n.addRange(d),n.extend(s.node,s.offset)):(d.setEnd(s.node,s.offset),n.addRange(d))}}}var s=n(49),c=n(145),u=n(52),d=s.canUseDOM&&"selection"in document&&!("getSelection"in window),p={getOffsets:d?r:l,setOffsets:d?a:i};e.exports=p},function(e,t){"use strict";function n(e){for(;e&&e.firstChild;)e=e.firstChild;return e}function o(e){for(;e;){if(e.nextSibling)return e.nextSibling;e=e.parentNode}}function r(e,t){for(var r=n(e),l=0,a=0;r;){if(3===r.nodeType){if(a=l+r.textContent.length,l<=t&&a>=t)return{node:r,offset:t-l};l=a}r=n(o(r))}}e.exports=r},function(e,t,n){"use strict";function o(e,t){return!(!e||!t)&&(e===t||!r(e)&&(r(t)?o(e,t.parentNode):"contains"in e?e.contains(t):!!e.compareDocumentPosition&&!!(16&e.compareDocumentPosition(t))))}var r=n(147);e.exports=o},function(e,t,n){"use strict";function o(e){return r(e)&&3==e.nodeType}var r=n(148);e.exports=o},function(e,t){"use strict";function n(e){var t=e?e.ownerDocument||e:document,n=t.defaultView||window;return!(!e||!("function"==typeof n.Node?e instanceof n.Node:"object"==typeof e&&"number"==typeof e.nodeType&&"string"==typeof e.nodeName))}e.exports=n},function(e,t){"use strict";function n(e){if(e=e||("undefined"!=typeof document?document:void 0),"undefined"==typeof e)return null;try{return e.activeElement||e.body}catch(t){return e.body}}e.exports=n},function(e,t){"use strict";var n={xlink:"http://www.w3.org/1999/xlink",xml:"http://www.w3.org/XML/1998/namespace"},o={accentHeight:"accent-height",accumulate:0,additive:0,alignmentBaseline:"alignment-baseline",allowReorder:"allowReorder",alphabetic:0,amplitude:0,arabicForm:"arabic-form",ascent:0,attributeName:"attributeName",attributeType:"attributeType",autoReverse:"autoReverse",azimuth:0,baseFrequency:"baseFrequency",baseProfile:"baseProfile",baselineShift:"baseline-shift",bbox:0,begin:0,bias:0,by:0,calcMode:"calcMode",capHeight:"cap-height",clip:0,clipPath:"clip-path",clipRule:"clip-rule",clipPathUnits:"clipPathUnits",colorInterpolation:"color-interpolation",colorInterpolationFilters:"color-interpolation-filters",colorProfile:"color-profile",colorRendering:"color-rendering",contentScriptType:"contentScriptType",contentStyleType:"contentStyleType",cursor:0,cx:0,cy:0,d:0,decelerate:0,descent:0,diffuseConstant:"diffuseConstant",direction:0,display:0,divisor:0,dominantBaseline:"dominant-baseline",dur:0,dx:0,dy:0,edgeMode:"edgeMode",elevation:0,enableBackground:"enable-background",end:0,exponent:0,externalResourcesRequired:"externalResourcesRequired",fill:0,fillOpacity:"fill-opacity",fillRule:"fill-rule",filter:0,filterRes:"filterRes",filterUnits:"filterUnits",floodColor:"flood-color",floodOpacity:"flood-opacity",focusable:0,fontFamily:"font-family",fontSize:"font-size",fontSizeAdjust:"font-size-adjust",fontStretch:"font-stretch",fontStyle:"font-style",fontVariant:"font-variant",fontWeight:"font-weight",format:0,from:0,fx:0,fy:0,g1:0,g2:0,glyphName:"glyph-name",glyphOrientationHorizontal:"glyph-orientation-horizontal",glyphOrientationVertical:"glyph-orientation-vertical",glyphRef:"glyphRef",gradientTransform:"gradientTransform",gradientUnits:"gradientUnits",hanging:0,horizAdvX:"horiz-adv-x",horizOriginX:"horiz-origin-x",ideographic:0,imageRendering:"image-rendering",in:0,in2:0,intercept:0,k:0,k1:0,k2:0,k3:0,k4:0,kernelMatrix:"kernelMatrix",kernelUnitLength:"kernelUnitLength",kerning:0,keyPoints:"keyPoints",keySplines:"keySplines",keyTimes:"keyTimes",lengthAdjust:"lengthAdjust",letterSpacing:"letter-spacing",lightingColor:"lighting-color",limitingConeAngle:"limitingConeAngle",local:0,markerEnd:"marker-end",markerMid:"marker-mid",markerStart:"marker-start",markerHeight:"markerHeight",markerUnits:"markerUnits",markerWidth:"markerWidth",mask:0,maskContentUnits:"maskContentUnits",maskUnits:"maskUnits",mathematical:0,mode:0,numOctaves:"numOctaves",offset:0,opacity:0,operator:0,order:0,orient:0,orientation:0,origin:0,overflow:0,overlinePosition:"overline-position",overlineThickness:"overline-thickness",paintOrder:"paint-order",panose1:"panose-1",pathLength:"pathLength",patternContentUnits:"patternContentUnits",patternTransform:"patternTransform",patternUnits:"patternUnits",pointerEvents:"pointer-events",points:0,pointsAtX:"pointsAtX",pointsAtY:"pointsAtY",pointsAtZ:"pointsAtZ",preserveAlpha:"preserveAlpha",preserveAspectRatio:"preserveAspectRatio",primitiveUnits:"primitiveUnits",r:0,radius:0,refX:"refX",refY:"refY",renderingIntent:"rendering-intent",repeatCount:"repeatCount",repeatDur:"repeatDur",requiredExtensions:"requiredExtensions",requiredFeatures:"requiredFeatures",restart:0,result:0,rotate:0,rx:0,ry:0,scale:0,seed:0,shapeRendering:"shape-rendering",slope:0,spacing:0,specularConstant:"specularConstant",specularExponent:"specularExponent",speed:0,spreadMethod:"spreadMethod",startOffset:"startOffset",stdDeviation:"stdDeviation",stemh:0,stemv:0,stitchTiles:"stitchTiles",stopColor:"stop-color",stopOpacity:"stop-opacity",strikethroughPosition:"strikethrough-position",strikethroughThickness:"strikethrough-thickness",string:0,stroke:0,strokeDasharray:"stroke-dasharray",strokeDashoffset:"stroke-dashoffset",strokeLinecap:"stroke-linecap",strokeLinejoin:"stroke-linejoin",strokeMiterlimit:"stroke-miterlimit",strokeOpacity:"stroke-opacity",strokeWidth:"stroke-width",surfaceScale:"surfaceScale",systemLanguage:"systemLanguage",tableValues:"tableValues",targetX:"targetX",targetY:"targetY",textAnchor:"text-anchor",textDecoration:"text-decoration",textRendering:"text-rendering",textLength:"textLength",to:0,transform:0,u1:0,u2:0,underlinePosition:"underline-position",underlineThickness:"underline-thickness",unicode:0,unicodeBidi:"unicode-bidi",unicodeRange:"unicode-range",unitsPerEm:"units-per-em",vAlphabetic:"v-alphabetic",vHanging:"v-hanging",vIdeographic:"v-ideographic",vMathematical:"v-mathematical",values:0,vectorEffect:"vector-effect",version:0,vertAdvY:"vert-adv-y",vertOriginX:"vert-origin-x",vertOriginY:"vert-origin-y",viewBox:"viewBox",viewTarget:"viewTarget",visibility:0,widths:0,wordSpacing:"word-spacing",writingMode:"writing-mode",x:0,xHeight:"x-height",x1:0,x2:0,xChannelSelector:"xChannelSelector",xlinkActuate:"xlink:actuate",xlinkArcrole:"xlink:arcrole",xlinkHref:"xlink:href",xlinkRole:"xlink:role",xlinkShow:"xlink:show",xlinkTitle:"xlink:title",xlinkType:"xlink:type",xmlBase:"xml:base",xmlns:0,xmlnsXlink:"xmlns:xlink",xmlLang:"xml:lang",xmlSpace:"xml:space",y:0,y1:0,y2:0,yChannelSelector:"yChannelSelector",z:0,zoomAndPan:"zoomAndPan"},r={Properties:{},DOMAttributeNamespaces:{xlinkActuate:n.xlink,xlinkArcrole:n.xlink,xlinkHref:n.xlink,xlinkRole:n.xlink,xlinkShow:n.xlink,xlinkTitle:n.xlink,xlinkType:n.xlink,xmlBase:n.xml,xmlLang:n.xml,xmlSpace:n.xml},DOMAttributeNames:{}};Object.keys(o).forEach(function(e){r.Properties[e]=0,o[e]&&(r.DOMAttributeNames[e]=o[e])}),e.exports=r},function(e,t,n){"use strict";function o(e){if("selectionStart"in e&&s.hasSelectionCapabilities(e))return{start:e.selectionStart,end:e.selectionEnd};if(window.getSelection){var t=window.getSelection();return{anchorNode:t.anchorNode,anchorOffset:t.anchorOffset,focusNode:t.focusNode,focusOffset:t.focusOffset}}if(document.selection){var n=document.selection.createRange();return{parentElement:n.parentElement(),text:n.text,top:n.boundingTop,left:n.boundingLeft}}}function r(e,t){if(_||null==m||m!==u())return null;var n=o(m);if(!g||!p(g,n)){g=n;var r=c.getPooled(h.select,b,e,t);return r.type="select",r.target=m,l.accumulateTwoPhaseDispatches(r),r}return null}var l=n(42),a=n(49),i=n(35),s=n(143),c=n(54),u=n(149),d=n(68),p=n(119),f=a.canUseDOM&&"documentMode"in document&&document.documentMode<=11,h={select:{phasedRegistrationNames:{bubbled:"onSelect",captured:"onSelectCapture"},dependencies:["topBlur","topContextMenu","topFocus","topKeyDown","topKeyUp","topMouseDown","topMouseUp","topSelectionChange"]}},m=null,b=null,g=null,_=!1,v=!1,y={eventTypes:h,extractEvents:function(e,t,n,o){if(!v)return null;var l=t?i.getNodeFromInstance(t):window;switch(e){case"topFocus":(d(l)||"true"===l.contentEditable)&&(m=l,b=t,g=null);break;case"topBlur":m=null,b=null,g=null;break;case"topMouseDown":_=!0;break;case"topContextMenu":case"topMouseUp":return _=!1,r(n,o);case"topSelectionChange":if(f)break;case"topKeyDown":case"topKeyUp":return r(n,o)}return null},didPutListener:function(e,t,n){"onSelect"===t&&(v=!0)}};e.exports=y},function(e,t,n){"use strict";function o(e){return"."+e._rootNodeID}function r(e){return"button"===e||"input"===e||"select"===e||"textarea"===e}var l=n(36),a=n(139),i=n(42),s=n(35),c=n(153),u=n(154),d=n(54),p=n(155),f=n(156),h=n(71),m=n(159),b=n(160),g=n(161),_=n(72),v=n(162),y=n(8),x=n(157),w=(n(11),{}),k={};["abort","animationEnd","animationIteration","animationStart","blur","canPlay","canPlayThrough","click","contextMenu","copy","cut","doubleClick","drag","dragEnd","dragEnter","dragExit","dragLeave","dragOver","dragStart","drop","durationChange","emptied","encrypted","ended","error","focus","input","invalid","keyDown","keyPress","keyUp","load","loadedData","loadedMetadata","loadStart","mouseDown","mouseMove","mouseOut","mouseOver","mouseUp","paste","pause","play","playing","progress","rateChange","reset","scroll","seeked","seeking","stalled","submit","suspend","timeUpdate","touchCancel","touchEnd","touchMove","touchStart","transitionEnd","volumeChange","waiting","wheel"].forEach(function(e){var t=e[0].toUpperCase()+e.slice(1),n="on"+t,o="top"+t,r={phasedRegistrationNames:{bubbled:n,captured:n+"Capture"},dependencies:[o]};w[e]=r,k[o]=r});var E={},C={eventTypes:w,extractEvents:function(e,t,n,o){var r=k[e];if(!r)return null;var a;switch(e){case"topAbort":case"topCanPlay":case"topCanPlayThrough":case"topDurationChange":case"topEmptied":case"topEncrypted":case"topEnded":case"topError":case"topInput":case"topInvalid":case"topLoad":case"topLoadedData":case"topLoadedMetadata":case"topLoadStart":case"topPause":case"topPlay":case"topPlaying":case"topProgress":case"topRateChange":case"topReset":case"topSeeked":case"topSeeking":case"topStalled":case"topSubmit":case"topSuspend":case"topTimeUpdate":case"topVolumeChange":case"topWaiting":a=d;break;case"topKeyPress":if(0===x(n))return null;case"topKeyDown":case"topKeyUp":a=f;break;case"topBlur":case"topFocus":a=p;break;case"topClick":if(2===n.button)return null;case"topDoubleClick":case"topMouseDown":case"topMouseMove":case"topMouseUp":case"topMouseOut":case"topMouseOver":case"topContextMenu":a=h;break;case"topDrag":case"topDragEnd":case"topDragEnter":case"topDragExit":case"topDragLeave":case"topDragOver":case"topDragStart":case"topDrop":a=m;break;case"topTouchCancel":case"topTouchEnd":case"topTouchMove":case"topTouchStart":a=b;break;case"topAnimationEnd":case"topAnimationIteration":case"topAnimationStart":a=c;break;case"topTransitionEnd":a=g;break;case"topScroll":a=_;break;case"topWheel":a=v;break;case"topCopy":case"topCut":case"topPaste":a=u}a?void 0:l("86",e);var s=a.getPooled(r,t,n,o);return i.accumulateTwoPhaseDispatches(s),s},didPutListener:function(e,t,n){if("onClick"===t&&!r(e._tag)){var l=o(e),i=s.getNodeFromInstance(e);E[l]||(E[l]=a.listen(i,"click",y))}},willDeleteListener:function(e,t){if("onClick"===t&&!r(e._tag)){var n=o(e);E[n].remove(),delete E[n]}}};e.exports=C},function(e,t,n){"use strict";function o(e,t,n,o){return r.call(this,e,t,n,o)}var r=n(54),l={animationName:null,elapsedTime:null,pseudoElement:null};r.augmentClass(o,l),e.exports=o},function(e,t,n){"use strict";function o(e,t,n,o){return r.call(this,e,t,n,o)}var r=n(54),l={clipboardData:function(e){return"clipboardData"in e?e.clipboardData:window.clipboardData}};r.augmentClass(o,l),e.exports=o},function(e,t,n){"use strict";function o(e,t,n,o){return r.call(this,e,t,n,o)}var r=n(72),l={relatedTarget:null};r.augmentClass(o,l),e.exports=o},function(e,t,n){"use strict";function o(e,t,n,o){return r.call(this,e,t,n,o)}var r=n(72),l=n(157),a=n(158),i=n(74),s={key:a,location:null,ctrlKey:null,shiftKey:null,altKey:null,metaKey:null,repeat:null,locale:null,getModifierState:i,charCode:function(e){return"keypress"===e.type?l(e):0},keyCode:function(e){return"keydown"===e.type||"keyup"===e.type?e.keyCode:0},which:function(e){return"keypress"===e.type?l(e):"keydown"===e.type||"keyup"===e.type?e.keyCode:0}};r.augmentClass(o,s),e.exports=o},function(e,t){"use strict";function n(e){var t,n=e.keyCode;return"charCode"in e?(t=e.charCode,0===t&&13===n&&(t=13)):t=n,t>=32||13===t?t:0}e.exports=n},function(e,t,n){"use strict";function o(e){if(e.key){var t=l[e.key]||e.key;if("Unidentified"!==t)return t}if("keypress"===e.type){var n=r(e);return 13===n?"Enter":String.fromCharCode(n)}return"keydown"===e.type||"keyup"===e.type?a[e.keyCode]||"Unidentified":""}var r=n(157),l={Esc:"Escape",Spacebar:" ",Left:"ArrowLeft",Up:"ArrowUp",Right:"ArrowRight",Down:"ArrowDown",Del:"Delete",Win:"OS",Menu:"ContextMenu",Apps:"ContextMenu",Scroll:"ScrollLock",MozPrintableKey:"Unidentified"},a={8:"Backspace",9:"Tab",12:"Clear",13:"Enter",16:"Shift",17:"Control",18:"Alt",19:"Pause",20:"CapsLock",27:"Escape",32:" ",33:"PageUp",34:"PageDown",35:"End",36:"Home",37:"ArrowLeft",38:"ArrowUp",39:"ArrowRight",40:"ArrowDown",45:"Insert",46:"Delete",112:"F1",113:"F2",114:"F3",115:"F4",116:"F5",117:"F6",118:"F7",119:"F8",120:"F9",121:"F10",122:"F11",123:"F12",144:"NumLock",145:"ScrollLock",224:"Meta"};e.exports=o},function(e,t,n){"use strict";function o(e,t,n,o){return r.call(this,e,t,n,o)}var r=n(71),l={dataTransfer:null};r.augmentClass(o,l),e.exports=o},function(e,t,n){"use strict";function o(e,t,n,o){return r.call(this,e,t,n,o)}var r=n(72),l=n(74),a={touches:null,targetTouches:null,changedTouches:null,altKey:null,metaKey:null,ctrlKey:null,shiftKey:null,getModifierState:l};r.augmentClass(o,a),e.exports=o},function(e,t,n){"use strict";function o(e,t,n,o){return r.call(this,e,t,n,o)}var r=n(54),l={propertyName:null,elapsedTime:null,pseudoElement:null};r.augmentClass(o,l),e.exports=o},function(e,t,n){"use strict";function o(e,t,n,o){return r.call(this,e,t,n,o)}var r=n(71),l={deltaX:function(e){return"deltaX"in e?e.deltaX:"wheelDeltaX"in e?-e.wheelDeltaX:0},deltaY:function(e){return"deltaY"in e?e.deltaY:"wheelDeltaY"in e?-e.wheelDeltaY:"wheelDelta"in e?-e.wheelDelta:0},deltaZ:null,deltaMode:null};r.augmentClass(o,l),e.exports=o},function(e,t,n){"use strict";function o(e,t){for(var n=Math.min(e.length,t.length),o=0;o<n;o++)if(e.charAt(o)!==t.charAt(o))return o;return e.length===t.length?-1:n}function r(e){return e?e.nodeType===I?e.documentElement:e.firstChild:null}function l(e){return e.getAttribute&&e.getAttribute(T)||""}function a(e,t,n,o,r){var l;if(x.logTopLevelRenders){var a=e._currentElement.props.child,i=a.type;l="React mount: "+("string"==typeof i?i:i.displayName||i.name),console.time(l)}var s=E.mountComponent(e,n,null,v(e,t),r,0);l&&console.timeEnd(l),e._renderedComponent._topLevelWrapper=e,H._mountImageIntoNode(s,t,e,o,n)}function i(e,t,n,o){var r=P.ReactReconcileTransaction.getPooled(!n&&y.useCreateElement);r.perform(a,null,e,t,r,n,o),P.ReactReconcileTransaction.release(r)}function s(e,t,n){for(E.unmountComponent(e,n),t.nodeType===I&&(t=t.documentElement);t.lastChild;)t.removeChild(t.lastChild)}function c(e){var t=r(e);if(t){var n=_.getInstanceFromNode(t);return!(!n||!n._hostParent)}}function u(e){return!(!e||e.nodeType!==L&&e.nodeType!==I&&e.nodeType!==j)}function d(e){var t=r(e),n=t&&_.getInstanceFromNode(t);return n&&!n._hostParent?n:null}function p(e){var t=d(e);return t?t._hostContainerInfo._topLevelWrapper:null}var f=n(36),h=n(78),m=n(37),b=n(2),g=n(102),_=(n(16),n(35)),v=n(164),y=n(165),x=n(59),w=n(113),k=(n(63),n(166)),E=n(60),C=n(132),P=n(57),M=n(10),O=n(116),R=(n(11),n(80)),S=n(120),T=(n(7),m.ID_ATTRIBUTE_NAME),N=m.ROOT_ATTRIBUTE_NAME,L=1,I=9,j=11,U={},A=1,D=function(){this.rootID=A++};D.prototype.isReactComponent={},D.prototype.render=function(){return this.props.child},D.isReactTopLevelWrapper=!0;var H={TopLevelWrapper:D,_instancesByReactRootID:U,scrollMonitor:function(e,t){t()},_updateRootComponent:function(e,t,n,o,r){return H.scrollMonitor(o,function(){C.enqueueElementInternal(e,t,n),r&&C.enqueueCallbackInternal(e,r)}),e},_renderNewRootComponent:function(e,t,n,o){u(t)?void 0:f("37"),g.ensureScrollValueMonitoring();var r=O(e,!1);P.batchedUpdates(i,r,t,n,o);var l=r._instance.rootID;return U[l]=r,r},renderSubtreeIntoContainer:function(e,t,n,o){return null!=e&&w.has(e)?void 0:f("38"),H._renderSubtreeIntoContainer(e,t,n,o)},_renderSubtreeIntoContainer:function(e,t,n,o){C.validateCallback(o,"ReactDOM.render"),b.isValidElement(t)?void 0:f("39","string"==typeof t?" Instead of passing a string like 'div', pass React.createElement('div') or <div />.":"function"==typeof t?" Instead of passing a class like Foo, pass React.createElement(Foo) or <Foo />.":null!=t&&void 0!==t.props?" This may be caused by unintentionally loading two independent copies of React.":"");var a,i=b.createElement(D,{child:t});if(e){var s=w.get(e);a=s._processChildContext(s._context)}else a=M;var u=p(n);if(u){var d=u._currentElement,h=d.props.child;if(S(h,t)){var m=u._renderedComponent.getPublicInstance(),g=o&&function(){o.call(m)};return H._updateRootComponent(u,i,a,n,g),m}H.unmountComponentAtNode(n)}var _=r(n),v=_&&!!l(_),y=c(n),x=v&&!u&&!y,k=H._renderNewRootComponent(i,n,x,a)._renderedComponent.getPublicInstance();return o&&o.call(k),k},render:function(e,t,n){return H._renderSubtreeIntoContainer(null,e,t,n)},unmountComponentAtNode:function(e){u(e)?void 0:f("40");var t=p(e);if(!t){c(e),1===e.nodeType&&e.hasAttribute(N);return!1}return delete U[t._instance.rootID],P.batchedUpdates(s,t,e,!1),!0},_mountImageIntoNode:function(e,t,n,l,a){if(u(t)?void 0:f("41"),l){var i=r(t);if(k.canReuseMarkup(e,i))return void _.precacheNode(n,i);var s=i.getAttribute(k.CHECKSUM_ATTR_NAME);i.removeAttribute(k.CHECKSUM_ATTR_NAME);var c=i.outerHTML;i.setAttribute(k.CHECKSUM_ATTR_NAME,s);var d=e,p=o(d,c),m=" (client) "+d.substring(p-20,p+20)+"\n (server) "+c.substring(p-20,p+20);t.nodeType===I?f("42",m):void 0}if(t.nodeType===I?f("43"):void 0,a.useCreateElement){for(;t.lastChild;)t.removeChild(t.lastChild);h.insertTreeBefore(t,e,null)}else R(t,e),_.precacheNode(n,t.firstChild)}};e.exports=H},function(e,t,n){"use strict";function o(e,t){var n={_topLevelWrapper:e,_idCounter:1,_ownerDocument:t?t.nodeType===r?t:t.ownerDocument:null,_node:t,_tag:t?t.nodeName.toLowerCase():null,_namespaceURI:t?t.namespaceURI:null};return n}var r=(n(133),9);e.exports=o},function(e,t){"use strict";var n={useCreateElement:!0,useFiber:!1};e.exports=n},function(e,t,n){"use strict";var o=n(167),r=/\/?>/,l=/^<\!\-\-/,a={CHECKSUM_ATTR_NAME:"data-react-checksum",addChecksumToMarkup:function(e){var t=o(e);return l.test(e)?e:e.replace(r," "+a.CHECKSUM_ATTR_NAME+'="'+t+'"$&')},canReuseMarkup:function(e,t){var n=t.getAttribute(a.CHECKSUM_ATTR_NAME);n=n&&parseInt(n,10);var r=o(e);return r===n}};e.exports=a},function(e,t){"use strict";function n(e){for(var t=1,n=0,r=0,l=e.length,a=l&-4;r<a;){for(var i=Math.min(r+4096,a);r<i;r+=4)n+=(t+=e.charCodeAt(r))+(t+=e.charCodeAt(r+1))+(t+=e.charCodeAt(r+2))+(t+=e.charCodeAt(r+3));t%=o,n%=o}for(;r<l;r++)n+=t+=e.charCodeAt(r);return t%=o,n%=o,t|n<<16}var o=65521;e.exports=n},function(e,t){"use strict";e.exports="15.6.2"},function(e,t,n){"use strict";function o(e){if(null==e)return null;if(1===e.nodeType)return e;var t=a.get(e);return t?(t=i(t),t?l.getNodeFromInstance(t):null):void("function"==typeof e.render?r("44"):r("45",Object.keys(e)))}var r=n(36),l=(n(16),n(35)),a=n(113),i=n(170);n(11),n(7);e.exports=o},function(e,t,n){"use strict";function o(e){for(var t;(t=e._renderedNodeType)===r.COMPOSITE;)e=e._renderedComponent;return t===r.HOST?e._renderedComponent:t===r.EMPTY?null:void 0}var r=n(118);e.exports=o},function(e,t,n){"use strict";var o=n(163);e.exports=o.renderSubtreeIntoContainer},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),s=n(1),c=o(s),u=n(173),d=o(u),p=n(174),f=o(p),h=n(180),m=o(h),b=n(182),g=o(b),_=function(e){function t(){return r(this,t),l(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return a(t,e),i(t,[{key:"render",value:function(){return c.default.createElement("div",{className:m.default.tableResponsive},c.default.createElement("table",{className:m.default.table},c.default.createElement("tbody",null,c.default.createElement("tr",null,c.default.createElement("th",null,"Name"),c.default.createElement("th",null,"Argumentssssss"),c.default.createElement("th",null,"Started At"),c.default.createElement("th",null,"Check-in At"),c.default.createElement("th",null,"Check-in")),this.props.worker.map(function(e){return c.default.createElement("tr",{key:e.worker_id},c.default.createElement("td",null,e.job_name),c.default.createElement("td",null,e.args_json),c.default.createElement("td",null,c.default.createElement(d.default,{ts:e.started_at})),c.default.createElement("td",null,c.default.createElement(d.default,{ts:e.checkin_at})),c.default.createElement("td",null,e.checkin))}))))}}]),t}(c.default.Component);_.propTypes={worker:c.default.PropTypes.arrayOf(c.default.PropTypes.object).isRequired};var v=function(e){function t(){var e,n,o,a;r(this,t);for(var i=arguments.length,s=Array(i),c=0;c<i;c++)s[c]=arguments[c];return n=o=l(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(s))),o.state={busyWorker:[],workerPool:[]},a=n,l(o,a)}return a(t,e),i(t,[{key:"componentWillMount",value:function(){var e=this;this.props.busyWorkerURL&&fetch(this.props.busyWorkerURL).then(function(e){return e.json()}).then(function(t){t&&e.setState({busyWorker:t})}),this.props.workerPoolURL&&fetch(this.props.workerPoolURL).then(function(e){return e.json()}).then(function(t){var n=[];t.map(function(e){""!=e.host&&n.push(e)}),e.setState({workerPool:n})})}},{key:"getBusyPoolWorker",value:function(e){var t=[];return this.state.busyWorker.map(function(n){e.worker_ids.includes(n.worker_id)&&t.push(n)}),t}},{key:"render",value:function(){var e=this;return c.default.createElement("section",null,c.default.createElement("header",null,"Processes"),c.default.createElement("p",null,this.state.workerPool.length," Worker process(es). ",this.state.busyWorker.length," active worker(s) out of ",this.workerCount,"."),this.state.workerPool.map(function(t){var n=e.getBusyPoolWorker(t);return c.default.createElement("div",{key:t.worker_pool_id,className:(0,g.default)(m.default.panel,m.default.panelDefault)},c.default.createElement("div",{className:m.default.tableResponsive},c.default.createElement("table",{className:m.default.table},c.default.createElement("tbody",null,c.default.createElement("tr",null,c.default.createElement("td",null,t.host,": ",t.pid),c.default.createElement("td",null,"Started ",c.default.createElement(d.default,{ts:t.started_at})),c.default.createElement("td",null,"Last Heartbeat ",c.default.createElement(d.default,{ts:t.heartbeat_at})),c.default.createElement("td",null,"Concurrency ",t.concurrency)),c.default.createElement("tr",null,c.default.createElement("td",{colSpan:"4"},"Servicing ",c.default.createElement(f.default,{item:t.job_names}),".")),c.default.createElement("tr",null,c.default.createElement("td",{colSpan:"4"},n.length," active ssssssworker(s) and ",t.worker_ids.length-n.length," idle.")),c.default.createElement("tr",null,c.default.createElement("td",{colSpan:"4"},c.default.createElement("div",{className:(0,g.default)(m.default.panel,m.default.panelDefault)},c.default.createElement(_,{worker:n}))))))))}))}},{key:"workerCount",get:function(){var e=0;return this.state.workerPool.map(function(t){e+=t.worker_ids.length}),e}}]),t}(c.default.Component);v.propTypes={busyWorkerURL:c.default.PropTypes.string,workerPoolURL:c.default.PropTypes.string},t.default=v},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),s=n(1),c=o(s),u=function(e){function t(){return r(this,t),l(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return a(t,e),i(t,[{key:"render",value:function(){var e=new Date(1e3*this.props.ts);return c.default.createElement("time",{dateTime:e.toISOString()},e.toISOString().slice(0,19).replace(/-/g,"/").replace("T"," "))}}]),t}(c.default.Component);u.propTypes={ts:c.default.PropTypes.number.isRequired},t.default=u},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");
+}function r(e,t){this.topLevelType=e,this.nativeEvent=t,this.ancestors=[]}function l(e){var t=f(e.nativeEvent),n=d.getClosestInstanceFromNode(t),r=n;do e.ancestors.push(r),r=r&&o(r);while(r);for(var l=0;l<e.ancestors.length;l++)n=e.ancestors[l],m._handleTopLevel(e.topLevelType,n,e.nativeEvent,f(e.nativeEvent))}function a(e){var t=h(window);e(t)}var i=n(3),s=n(139),c=n(49),u=n(51),d=n(35),p=n(57),f=n(66),h=n(140);i(r.prototype,{destructor:function(){this.topLevelType=null,this.nativeEvent=null,this.ancestors.length=0}}),u.addPoolingTo(r,u.twoArgumentPooler);var m={_enabled:!0,_handleTopLevel:null,WINDOW_HANDLE:c.canUseDOM?window:null,setHandleTopLevel:function(e){m._handleTopLevel=e},setEnabled:function(e){m._enabled=!!e},isEnabled:function(){return m._enabled},trapBubbledEvent:function(e,t,n){return n?s.listen(n,t,m.dispatchEvent.bind(null,e)):null},trapCapturedEvent:function(e,t,n){return n?s.capture(n,t,m.dispatchEvent.bind(null,e)):null},monitorScrollValue:function(e){var t=a.bind(null,e);s.listen(window,"scroll",t)},dispatchEvent:function(e,t){if(m._enabled){var n=r.getPooled(e,t);try{p.batchedUpdates(l,n)}finally{r.release(n)}}}};e.exports=m},function(e,t,n){"use strict";var o=n(8),r={listen:function(e,t,n){return e.addEventListener?(e.addEventListener(t,n,!1),{remove:function(){e.removeEventListener(t,n,!1)}}):e.attachEvent?(e.attachEvent("on"+t,n),{remove:function(){e.detachEvent("on"+t,n)}}):void 0},capture:function(e,t,n){return e.addEventListener?(e.addEventListener(t,n,!0),{remove:function(){e.removeEventListener(t,n,!0)}}):{remove:o}},registerDefault:function(){}};e.exports=r},function(e,t){"use strict";function n(e){return e.Window&&e instanceof e.Window?{x:e.pageXOffset||e.document.documentElement.scrollLeft,y:e.pageYOffset||e.document.documentElement.scrollTop}:{x:e.scrollLeft,y:e.scrollTop}}e.exports=n},function(e,t,n){"use strict";var o=n(37),r=n(43),l=n(45),a=n(112),i=n(121),s=n(102),c=n(122),u=n(57),d={Component:a.injection,DOMProperty:o.injection,EmptyComponent:i.injection,EventPluginHub:r.injection,EventPluginUtils:l.injection,EventEmitter:s.injection,HostComponent:c.injection,Updates:u.injection};e.exports=d},function(e,t,n){"use strict";function o(e){this.reinitializeTransaction(),this.renderToStaticMarkup=!1,this.reactMountReady=l.getPooled(null),this.useCreateElement=e}var r=n(3),l=n(58),a=n(51),i=n(102),s=n(143),c=(n(63),n(64)),u=n(132),d={initialize:s.getSelectionInformation,close:s.restoreSelection},p={initialize:function(){var e=i.isEnabled();return i.setEnabled(!1),e},close:function(e){i.setEnabled(e)}},f={initialize:function(){this.reactMountReady.reset()},close:function(){this.reactMountReady.notifyAll()}},h=[d,p,f],m={getTransactionWrappers:function(){return h},getReactMountReady:function(){return this.reactMountReady},getUpdateQueue:function(){return u},checkpoint:function(){return this.reactMountReady.checkpoint()},rollback:function(e){this.reactMountReady.rollback(e)},destructor:function(){l.release(this.reactMountReady),this.reactMountReady=null}};r(o.prototype,c,m),a.addPoolingTo(o),e.exports=o},function(e,t,n){"use strict";function o(e){return l(document.documentElement,e)}var r=n(144),l=n(146),a=n(91),i=n(149),s={hasSelectionCapabilities:function(e){var t=e&&e.nodeName&&e.nodeName.toLowerCase();return t&&("input"===t&&"text"===e.type||"textarea"===t||"true"===e.contentEditable)},getSelectionInformation:function(){var e=i();return{focusedElem:e,selectionRange:s.hasSelectionCapabilities(e)?s.getSelection(e):null}},restoreSelection:function(e){var t=i(),n=e.focusedElem,r=e.selectionRange;t!==n&&o(n)&&(s.hasSelectionCapabilities(n)&&s.setSelection(n,r),a(n))},getSelection:function(e){var t;if("selectionStart"in e)t={start:e.selectionStart,end:e.selectionEnd};else if(document.selection&&e.nodeName&&"input"===e.nodeName.toLowerCase()){var n=document.selection.createRange();n.parentElement()===e&&(t={start:-n.moveStart("character",-e.value.length),end:-n.moveEnd("character",-e.value.length)})}else t=r.getOffsets(e);return t||{start:0,end:0}},setSelection:function(e,t){var n=t.start,o=t.end;if(void 0===o&&(o=n),"selectionStart"in e)e.selectionStart=n,e.selectionEnd=Math.min(o,e.value.length);else if(document.selection&&e.nodeName&&"input"===e.nodeName.toLowerCase()){var l=e.createTextRange();l.collapse(!0),l.moveStart("character",n),l.moveEnd("character",o-n),l.select()}else r.setOffsets(e,t)}};e.exports=s},function(e,t,n){"use strict";function o(e,t,n,o){return e===n&&t===o}function r(e){var t=document.selection,n=t.createRange(),o=n.text.length,r=n.duplicate();r.moveToElementText(e),r.setEndPoint("EndToStart",n);var l=r.text.length,a=l+o;return{start:l,end:a}}function l(e){var t=window.getSelection&&window.getSelection();if(!t||0===t.rangeCount)return null;var n=t.anchorNode,r=t.anchorOffset,l=t.focusNode,a=t.focusOffset,i=t.getRangeAt(0);try{i.startContainer.nodeType,i.endContainer.nodeType}catch(e){return null}var s=o(t.anchorNode,t.anchorOffset,t.focusNode,t.focusOffset),c=s?0:i.toString().length,u=i.cloneRange();u.selectNodeContents(e),u.setEnd(i.startContainer,i.startOffset);var d=o(u.startContainer,u.startOffset,u.endContainer,u.endOffset),p=d?0:u.toString().length,f=p+c,h=document.createRange();h.setStart(n,r),h.setEnd(l,a);var m=h.collapsed;return{start:m?f:p,end:m?p:f}}function a(e,t){var n,o,r=document.selection.createRange().duplicate();void 0===t.end?(n=t.start,o=n):t.start>t.end?(n=t.end,o=t.start):(n=t.start,o=t.end),r.moveToElementText(e),r.moveStart("character",n),r.setEndPoint("EndToStart",r),r.moveEnd("character",o-n),r.select()}function i(e,t){if(window.getSelection){var n=window.getSelection(),o=e[u()].length,r=Math.min(t.start,o),l=void 0===t.end?r:Math.min(t.end,o);if(!n.extend&&r>l){var a=l;l=r,r=a}var i=c(e,r),s=c(e,l);if(i&&s){var d=document.createRange();d.setStart(i.node,i.offset),n.removeAllRanges(),r>l?(n.addRange(d),n.extend(s.node,s.offset)):(d.setEnd(s.node,s.offset),n.addRange(d))}}}var s=n(49),c=n(145),u=n(52),d=s.canUseDOM&&"selection"in document&&!("getSelection"in window),p={getOffsets:d?r:l,setOffsets:d?a:i};e.exports=p},function(e,t){"use strict";function n(e){for(;e&&e.firstChild;)e=e.firstChild;return e}function o(e){for(;e;){if(e.nextSibling)return e.nextSibling;e=e.parentNode}}function r(e,t){for(var r=n(e),l=0,a=0;r;){if(3===r.nodeType){if(a=l+r.textContent.length,l<=t&&a>=t)return{node:r,offset:t-l};l=a}r=n(o(r))}}e.exports=r},function(e,t,n){"use strict";function o(e,t){return!(!e||!t)&&(e===t||!r(e)&&(r(t)?o(e,t.parentNode):"contains"in e?e.contains(t):!!e.compareDocumentPosition&&!!(16&e.compareDocumentPosition(t))))}var r=n(147);e.exports=o},function(e,t,n){"use strict";function o(e){return r(e)&&3==e.nodeType}var r=n(148);e.exports=o},function(e,t){"use strict";function n(e){var t=e?e.ownerDocument||e:document,n=t.defaultView||window;return!(!e||!("function"==typeof n.Node?e instanceof n.Node:"object"==typeof e&&"number"==typeof e.nodeType&&"string"==typeof e.nodeName))}e.exports=n},function(e,t){"use strict";function n(e){if(e=e||("undefined"!=typeof document?document:void 0),"undefined"==typeof e)return null;try{return e.activeElement||e.body}catch(t){return e.body}}e.exports=n},function(e,t){"use strict";var n={xlink:"http://www.w3.org/1999/xlink",xml:"http://www.w3.org/XML/1998/namespace"},o={accentHeight:"accent-height",accumulate:0,additive:0,alignmentBaseline:"alignment-baseline",allowReorder:"allowReorder",alphabetic:0,amplitude:0,arabicForm:"arabic-form",ascent:0,attributeName:"attributeName",attributeType:"attributeType",autoReverse:"autoReverse",azimuth:0,baseFrequency:"baseFrequency",baseProfile:"baseProfile",baselineShift:"baseline-shift",bbox:0,begin:0,bias:0,by:0,calcMode:"calcMode",capHeight:"cap-height",clip:0,clipPath:"clip-path",clipRule:"clip-rule",clipPathUnits:"clipPathUnits",colorInterpolation:"color-interpolation",colorInterpolationFilters:"color-interpolation-filters",colorProfile:"color-profile",colorRendering:"color-rendering",contentScriptType:"contentScriptType",contentStyleType:"contentStyleType",cursor:0,cx:0,cy:0,d:0,decelerate:0,descent:0,diffuseConstant:"diffuseConstant",direction:0,display:0,divisor:0,dominantBaseline:"dominant-baseline",dur:0,dx:0,dy:0,edgeMode:"edgeMode",elevation:0,enableBackground:"enable-background",end:0,exponent:0,externalResourcesRequired:"externalResourcesRequired",fill:0,fillOpacity:"fill-opacity",fillRule:"fill-rule",filter:0,filterRes:"filterRes",filterUnits:"filterUnits",floodColor:"flood-color",floodOpacity:"flood-opacity",focusable:0,fontFamily:"font-family",fontSize:"font-size",fontSizeAdjust:"font-size-adjust",fontStretch:"font-stretch",fontStyle:"font-style",fontVariant:"font-variant",fontWeight:"font-weight",format:0,from:0,fx:0,fy:0,g1:0,g2:0,glyphName:"glyph-name",glyphOrientationHorizontal:"glyph-orientation-horizontal",glyphOrientationVertical:"glyph-orientation-vertical",glyphRef:"glyphRef",gradientTransform:"gradientTransform",gradientUnits:"gradientUnits",hanging:0,horizAdvX:"horiz-adv-x",horizOriginX:"horiz-origin-x",ideographic:0,imageRendering:"image-rendering",in:0,in2:0,intercept:0,k:0,k1:0,k2:0,k3:0,k4:0,kernelMatrix:"kernelMatrix",kernelUnitLength:"kernelUnitLength",kerning:0,keyPoints:"keyPoints",keySplines:"keySplines",keyTimes:"keyTimes",lengthAdjust:"lengthAdjust",letterSpacing:"letter-spacing",lightingColor:"lighting-color",limitingConeAngle:"limitingConeAngle",local:0,markerEnd:"marker-end",markerMid:"marker-mid",markerStart:"marker-start",markerHeight:"markerHeight",markerUnits:"markerUnits",markerWidth:"markerWidth",mask:0,maskContentUnits:"maskContentUnits",maskUnits:"maskUnits",mathematical:0,mode:0,numOctaves:"numOctaves",offset:0,opacity:0,operator:0,order:0,orient:0,orientation:0,origin:0,overflow:0,overlinePosition:"overline-position",overlineThickness:"overline-thickness",paintOrder:"paint-order",panose1:"panose-1",pathLength:"pathLength",patternContentUnits:"patternContentUnits",patternTransform:"patternTransform",patternUnits:"patternUnits",pointerEvents:"pointer-events",points:0,pointsAtX:"pointsAtX",pointsAtY:"pointsAtY",pointsAtZ:"pointsAtZ",preserveAlpha:"preserveAlpha",preserveAspectRatio:"preserveAspectRatio",primitiveUnits:"primitiveUnits",r:0,radius:0,refX:"refX",refY:"refY",renderingIntent:"rendering-intent",repeatCount:"repeatCount",repeatDur:"repeatDur",requiredExtensions:"requiredExtensions",requiredFeatures:"requiredFeatures",restart:0,result:0,rotate:0,rx:0,ry:0,scale:0,seed:0,shapeRendering:"shape-rendering",slope:0,spacing:0,specularConstant:"specularConstant",specularExponent:"specularExponent",speed:0,spreadMethod:"spreadMethod",startOffset:"startOffset",stdDeviation:"stdDeviation",stemh:0,stemv:0,stitchTiles:"stitchTiles",stopColor:"stop-color",stopOpacity:"stop-opacity",strikethroughPosition:"strikethrough-position",strikethroughThickness:"strikethrough-thickness",string:0,stroke:0,strokeDasharray:"stroke-dasharray",strokeDashoffset:"stroke-dashoffset",strokeLinecap:"stroke-linecap",strokeLinejoin:"stroke-linejoin",strokeMiterlimit:"stroke-miterlimit",strokeOpacity:"stroke-opacity",strokeWidth:"stroke-width",surfaceScale:"surfaceScale",systemLanguage:"systemLanguage",tableValues:"tableValues",targetX:"targetX",targetY:"targetY",textAnchor:"text-anchor",textDecoration:"text-decoration",textRendering:"text-rendering",textLength:"textLength",to:0,transform:0,u1:0,u2:0,underlinePosition:"underline-position",underlineThickness:"underline-thickness",unicode:0,unicodeBidi:"unicode-bidi",unicodeRange:"unicode-range",unitsPerEm:"units-per-em",vAlphabetic:"v-alphabetic",vHanging:"v-hanging",vIdeographic:"v-ideographic",vMathematical:"v-mathematical",values:0,vectorEffect:"vector-effect",version:0,vertAdvY:"vert-adv-y",vertOriginX:"vert-origin-x",vertOriginY:"vert-origin-y",viewBox:"viewBox",viewTarget:"viewTarget",visibility:0,widths:0,wordSpacing:"word-spacing",writingMode:"writing-mode",x:0,xHeight:"x-height",x1:0,x2:0,xChannelSelector:"xChannelSelector",xlinkActuate:"xlink:actuate",xlinkArcrole:"xlink:arcrole",xlinkHref:"xlink:href",xlinkRole:"xlink:role",xlinkShow:"xlink:show",xlinkTitle:"xlink:title",xlinkType:"xlink:type",xmlBase:"xml:base",xmlns:0,xmlnsXlink:"xmlns:xlink",xmlLang:"xml:lang",xmlSpace:"xml:space",y:0,y1:0,y2:0,yChannelSelector:"yChannelSelector",z:0,zoomAndPan:"zoomAndPan"},r={Properties:{},DOMAttributeNamespaces:{xlinkActuate:n.xlink,xlinkArcrole:n.xlink,xlinkHref:n.xlink,xlinkRole:n.xlink,xlinkShow:n.xlink,xlinkTitle:n.xlink,xlinkType:n.xlink,xmlBase:n.xml,xmlLang:n.xml,xmlSpace:n.xml},DOMAttributeNames:{}};Object.keys(o).forEach(function(e){r.Properties[e]=0,o[e]&&(r.DOMAttributeNames[e]=o[e])}),e.exports=r},function(e,t,n){"use strict";function o(e){if("selectionStart"in e&&s.hasSelectionCapabilities(e))return{start:e.selectionStart,end:e.selectionEnd};if(window.getSelection){var t=window.getSelection();return{anchorNode:t.anchorNode,anchorOffset:t.anchorOffset,focusNode:t.focusNode,focusOffset:t.focusOffset}}if(document.selection){var n=document.selection.createRange();return{parentElement:n.parentElement(),text:n.text,top:n.boundingTop,left:n.boundingLeft}}}function r(e,t){if(_||null==m||m!==u())return null;var n=o(m);if(!g||!p(g,n)){g=n;var r=c.getPooled(h.select,b,e,t);return r.type="select",r.target=m,l.accumulateTwoPhaseDispatches(r),r}return null}var l=n(42),a=n(49),i=n(35),s=n(143),c=n(54),u=n(149),d=n(68),p=n(119),f=a.canUseDOM&&"documentMode"in document&&document.documentMode<=11,h={select:{phasedRegistrationNames:{bubbled:"onSelect",captured:"onSelectCapture"},dependencies:["topBlur","topContextMenu","topFocus","topKeyDown","topKeyUp","topMouseDown","topMouseUp","topSelectionChange"]}},m=null,b=null,g=null,_=!1,v=!1,y={eventTypes:h,extractEvents:function(e,t,n,o){if(!v)return null;var l=t?i.getNodeFromInstance(t):window;switch(e){case"topFocus":(d(l)||"true"===l.contentEditable)&&(m=l,b=t,g=null);break;case"topBlur":m=null,b=null,g=null;break;case"topMouseDown":_=!0;break;case"topContextMenu":case"topMouseUp":return _=!1,r(n,o);case"topSelectionChange":if(f)break;case"topKeyDown":case"topKeyUp":return r(n,o)}return null},didPutListener:function(e,t,n){"onSelect"===t&&(v=!0)}};e.exports=y},function(e,t,n){"use strict";function o(e){return"."+e._rootNodeID}function r(e){return"button"===e||"input"===e||"select"===e||"textarea"===e}var l=n(36),a=n(139),i=n(42),s=n(35),c=n(153),u=n(154),d=n(54),p=n(155),f=n(156),h=n(71),m=n(159),b=n(160),g=n(161),_=n(72),v=n(162),y=n(8),x=n(157),w=(n(11),{}),k={};["abort","animationEnd","animationIteration","animationStart","blur","canPlay","canPlayThrough","click","contextMenu","copy","cut","doubleClick","drag","dragEnd","dragEnter","dragExit","dragLeave","dragOver","dragStart","drop","durationChange","emptied","encrypted","ended","error","focus","input","invalid","keyDown","keyPress","keyUp","load","loadedData","loadedMetadata","loadStart","mouseDown","mouseMove","mouseOut","mouseOver","mouseUp","paste","pause","play","playing","progress","rateChange","reset","scroll","seeked","seeking","stalled","submit","suspend","timeUpdate","touchCancel","touchEnd","touchMove","touchStart","transitionEnd","volumeChange","waiting","wheel"].forEach(function(e){var t=e[0].toUpperCase()+e.slice(1),n="on"+t,o="top"+t,r={phasedRegistrationNames:{bubbled:n,captured:n+"Capture"},dependencies:[o]};w[e]=r,k[o]=r});var E={},C={eventTypes:w,extractEvents:function(e,t,n,o){var r=k[e];if(!r)return null;var a;switch(e){case"topAbort":case"topCanPlay":case"topCanPlayThrough":case"topDurationChange":case"topEmptied":case"topEncrypted":case"topEnded":case"topError":case"topInput":case"topInvalid":case"topLoad":case"topLoadedData":case"topLoadedMetadata":case"topLoadStart":case"topPause":case"topPlay":case"topPlaying":case"topProgress":case"topRateChange":case"topReset":case"topSeeked":case"topSeeking":case"topStalled":case"topSubmit":case"topSuspend":case"topTimeUpdate":case"topVolumeChange":case"topWaiting":a=d;break;case"topKeyPress":if(0===x(n))return null;case"topKeyDown":case"topKeyUp":a=f;break;case"topBlur":case"topFocus":a=p;break;case"topClick":if(2===n.button)return null;case"topDoubleClick":case"topMouseDown":case"topMouseMove":case"topMouseUp":case"topMouseOut":case"topMouseOver":case"topContextMenu":a=h;break;case"topDrag":case"topDragEnd":case"topDragEnter":case"topDragExit":case"topDragLeave":case"topDragOver":case"topDragStart":case"topDrop":a=m;break;case"topTouchCancel":case"topTouchEnd":case"topTouchMove":case"topTouchStart":a=b;break;case"topAnimationEnd":case"topAnimationIteration":case"topAnimationStart":a=c;break;case"topTransitionEnd":a=g;break;case"topScroll":a=_;break;case"topWheel":a=v;break;case"topCopy":case"topCut":case"topPaste":a=u}a?void 0:l("86",e);var s=a.getPooled(r,t,n,o);return i.accumulateTwoPhaseDispatches(s),s},didPutListener:function(e,t,n){if("onClick"===t&&!r(e._tag)){var l=o(e),i=s.getNodeFromInstance(e);E[l]||(E[l]=a.listen(i,"click",y))}},willDeleteListener:function(e,t){if("onClick"===t&&!r(e._tag)){var n=o(e);E[n].remove(),delete E[n]}}};e.exports=C},function(e,t,n){"use strict";function o(e,t,n,o){return r.call(this,e,t,n,o)}var r=n(54),l={animationName:null,elapsedTime:null,pseudoElement:null};r.augmentClass(o,l),e.exports=o},function(e,t,n){"use strict";function o(e,t,n,o){return r.call(this,e,t,n,o)}var r=n(54),l={clipboardData:function(e){return"clipboardData"in e?e.clipboardData:window.clipboardData}};r.augmentClass(o,l),e.exports=o},function(e,t,n){"use strict";function o(e,t,n,o){return r.call(this,e,t,n,o)}var r=n(72),l={relatedTarget:null};r.augmentClass(o,l),e.exports=o},function(e,t,n){"use strict";function o(e,t,n,o){return r.call(this,e,t,n,o)}var r=n(72),l=n(157),a=n(158),i=n(74),s={key:a,location:null,ctrlKey:null,shiftKey:null,altKey:null,metaKey:null,repeat:null,locale:null,getModifierState:i,charCode:function(e){return"keypress"===e.type?l(e):0},keyCode:function(e){return"keydown"===e.type||"keyup"===e.type?e.keyCode:0},which:function(e){return"keypress"===e.type?l(e):"keydown"===e.type||"keyup"===e.type?e.keyCode:0}};r.augmentClass(o,s),e.exports=o},function(e,t){"use strict";function n(e){var t,n=e.keyCode;return"charCode"in e?(t=e.charCode,0===t&&13===n&&(t=13)):t=n,t>=32||13===t?t:0}e.exports=n},function(e,t,n){"use strict";function o(e){if(e.key){var t=l[e.key]||e.key;if("Unidentified"!==t)return t}if("keypress"===e.type){var n=r(e);return 13===n?"Enter":String.fromCharCode(n)}return"keydown"===e.type||"keyup"===e.type?a[e.keyCode]||"Unidentified":""}var r=n(157),l={Esc:"Escape",Spacebar:" ",Left:"ArrowLeft",Up:"ArrowUp",Right:"ArrowRight",Down:"ArrowDown",Del:"Delete",Win:"OS",Menu:"ContextMenu",Apps:"ContextMenu",Scroll:"ScrollLock",MozPrintableKey:"Unidentified"},a={8:"Backspace",9:"Tab",12:"Clear",13:"Enter",16:"Shift",17:"Control",18:"Alt",19:"Pause",20:"CapsLock",27:"Escape",32:" ",33:"PageUp",34:"PageDown",35:"End",36:"Home",37:"ArrowLeft",38:"ArrowUp",39:"ArrowRight",40:"ArrowDown",45:"Insert",46:"Delete",112:"F1",113:"F2",114:"F3",115:"F4",116:"F5",117:"F6",118:"F7",119:"F8",120:"F9",121:"F10",122:"F11",123:"F12",144:"NumLock",145:"ScrollLock",224:"Meta"};e.exports=o},function(e,t,n){"use strict";function o(e,t,n,o){return r.call(this,e,t,n,o)}var r=n(71),l={dataTransfer:null};r.augmentClass(o,l),e.exports=o},function(e,t,n){"use strict";function o(e,t,n,o){return r.call(this,e,t,n,o)}var r=n(72),l=n(74),a={touches:null,targetTouches:null,changedTouches:null,altKey:null,metaKey:null,ctrlKey:null,shiftKey:null,getModifierState:l};r.augmentClass(o,a),e.exports=o},function(e,t,n){"use strict";function o(e,t,n,o){return r.call(this,e,t,n,o)}var r=n(54),l={propertyName:null,elapsedTime:null,pseudoElement:null};r.augmentClass(o,l),e.exports=o},function(e,t,n){"use strict";function o(e,t,n,o){return r.call(this,e,t,n,o)}var r=n(71),l={deltaX:function(e){return"deltaX"in e?e.deltaX:"wheelDeltaX"in e?-e.wheelDeltaX:0},deltaY:function(e){return"deltaY"in e?e.deltaY:"wheelDeltaY"in e?-e.wheelDeltaY:"wheelDelta"in e?-e.wheelDelta:0},deltaZ:null,deltaMode:null};r.augmentClass(o,l),e.exports=o},function(e,t,n){"use strict";function o(e,t){for(var n=Math.min(e.length,t.length),o=0;o<n;o++)if(e.charAt(o)!==t.charAt(o))return o;return e.length===t.length?-1:n}function r(e){return e?e.nodeType===I?e.documentElement:e.firstChild:null}function l(e){return e.getAttribute&&e.getAttribute(T)||""}function a(e,t,n,o,r){var l;if(x.logTopLevelRenders){var a=e._currentElement.props.child,i=a.type;l="React mount: "+("string"==typeof i?i:i.displayName||i.name),console.time(l)}var s=E.mountComponent(e,n,null,v(e,t),r,0);l&&console.timeEnd(l),e._renderedComponent._topLevelWrapper=e,H._mountImageIntoNode(s,t,e,o,n)}function i(e,t,n,o){var r=P.ReactReconcileTransaction.getPooled(!n&&y.useCreateElement);r.perform(a,null,e,t,r,n,o),P.ReactReconcileTransaction.release(r)}function s(e,t,n){for(E.unmountComponent(e,n),t.nodeType===I&&(t=t.documentElement);t.lastChild;)t.removeChild(t.lastChild)}function c(e){var t=r(e);if(t){var n=_.getInstanceFromNode(t);return!(!n||!n._hostParent)}}function u(e){return!(!e||e.nodeType!==L&&e.nodeType!==I&&e.nodeType!==j)}function d(e){var t=r(e),n=t&&_.getInstanceFromNode(t);return n&&!n._hostParent?n:null}function p(e){var t=d(e);return t?t._hostContainerInfo._topLevelWrapper:null}var f=n(36),h=n(78),m=n(37),b=n(2),g=n(102),_=(n(16),n(35)),v=n(164),y=n(165),x=n(59),w=n(113),k=(n(63),n(166)),E=n(60),C=n(132),P=n(57),M=n(10),O=n(116),R=(n(11),n(80)),S=n(120),T=(n(7),m.ID_ATTRIBUTE_NAME),N=m.ROOT_ATTRIBUTE_NAME,L=1,I=9,j=11,U={},A=1,D=function(){this.rootID=A++};D.prototype.isReactComponent={},D.prototype.render=function(){return this.props.child},D.isReactTopLevelWrapper=!0;var H={TopLevelWrapper:D,_instancesByReactRootID:U,scrollMonitor:function(e,t){t()},_updateRootComponent:function(e,t,n,o,r){return H.scrollMonitor(o,function(){C.enqueueElementInternal(e,t,n),r&&C.enqueueCallbackInternal(e,r)}),e},_renderNewRootComponent:function(e,t,n,o){u(t)?void 0:f("37"),g.ensureScrollValueMonitoring();var r=O(e,!1);P.batchedUpdates(i,r,t,n,o);var l=r._instance.rootID;return U[l]=r,r},renderSubtreeIntoContainer:function(e,t,n,o){return null!=e&&w.has(e)?void 0:f("38"),H._renderSubtreeIntoContainer(e,t,n,o)},_renderSubtreeIntoContainer:function(e,t,n,o){C.validateCallback(o,"ReactDOM.render"),b.isValidElement(t)?void 0:f("39","string"==typeof t?" Instead of passing a string like 'div', pass React.createElement('div') or <div />.":"function"==typeof t?" Instead of passing a class like Foo, pass React.createElement(Foo) or <Foo />.":null!=t&&void 0!==t.props?" This may be caused by unintentionally loading two independent copies of React.":"");var a,i=b.createElement(D,{child:t});if(e){var s=w.get(e);a=s._processChildContext(s._context)}else a=M;var u=p(n);if(u){var d=u._currentElement,h=d.props.child;if(S(h,t)){var m=u._renderedComponent.getPublicInstance(),g=o&&function(){o.call(m)};return H._updateRootComponent(u,i,a,n,g),m}H.unmountComponentAtNode(n)}var _=r(n),v=_&&!!l(_),y=c(n),x=v&&!u&&!y,k=H._renderNewRootComponent(i,n,x,a)._renderedComponent.getPublicInstance();return o&&o.call(k),k},render:function(e,t,n){return H._renderSubtreeIntoContainer(null,e,t,n)},unmountComponentAtNode:function(e){u(e)?void 0:f("40");var t=p(e);if(!t){c(e),1===e.nodeType&&e.hasAttribute(N);return!1}return delete U[t._instance.rootID],P.batchedUpdates(s,t,e,!1),!0},_mountImageIntoNode:function(e,t,n,l,a){if(u(t)?void 0:f("41"),l){var i=r(t);if(k.canReuseMarkup(e,i))return void _.precacheNode(n,i);var s=i.getAttribute(k.CHECKSUM_ATTR_NAME);i.removeAttribute(k.CHECKSUM_ATTR_NAME);var c=i.outerHTML;i.setAttribute(k.CHECKSUM_ATTR_NAME,s);var d=e,p=o(d,c),m=" (client) "+d.substring(p-20,p+20)+"\n (server) "+c.substring(p-20,p+20);t.nodeType===I?f("42",m):void 0}if(t.nodeType===I?f("43"):void 0,a.useCreateElement){for(;t.lastChild;)t.removeChild(t.lastChild);h.insertTreeBefore(t,e,null)}else R(t,e),_.precacheNode(n,t.firstChild)}};e.exports=H},function(e,t,n){"use strict";function o(e,t){var n={_topLevelWrapper:e,_idCounter:1,_ownerDocument:t?t.nodeType===r?t:t.ownerDocument:null,_node:t,_tag:t?t.nodeName.toLowerCase():null,_namespaceURI:t?t.namespaceURI:null};return n}var r=(n(133),9);e.exports=o},function(e,t){"use strict";var n={useCreateElement:!0,useFiber:!1};e.exports=n},function(e,t,n){"use strict";var o=n(167),r=/\/?>/,l=/^<\!\-\-/,a={CHECKSUM_ATTR_NAME:"data-react-checksum",addChecksumToMarkup:function(e){var t=o(e);return l.test(e)?e:e.replace(r," "+a.CHECKSUM_ATTR_NAME+'="'+t+'"$&')},canReuseMarkup:function(e,t){var n=t.getAttribute(a.CHECKSUM_ATTR_NAME);n=n&&parseInt(n,10);var r=o(e);return r===n}};e.exports=a},function(e,t){"use strict";function n(e){for(var t=1,n=0,r=0,l=e.length,a=l&-4;r<a;){for(var i=Math.min(r+4096,a);r<i;r+=4)n+=(t+=e.charCodeAt(r))+(t+=e.charCodeAt(r+1))+(t+=e.charCodeAt(r+2))+(t+=e.charCodeAt(r+3));t%=o,n%=o}for(;r<l;r++)n+=t+=e.charCodeAt(r);return t%=o,n%=o,t|n<<16}var o=65521;e.exports=n},function(e,t){"use strict";e.exports="15.6.2"},function(e,t,n){"use strict";function o(e){if(null==e)return null;if(1===e.nodeType)return e;var t=a.get(e);return t?(t=i(t),t?l.getNodeFromInstance(t):null):void("function"==typeof e.render?r("44"):r("45",Object.keys(e)))}var r=n(36),l=(n(16),n(35)),a=n(113),i=n(170);n(11),n(7);e.exports=o},function(e,t,n){"use strict";function o(e){for(var t;(t=e._renderedNodeType)===r.COMPOSITE;)e=e._renderedComponent;return t===r.HOST?e._renderedComponent:t===r.EMPTY?null:void 0}var r=n(118);e.exports=o},function(e,t,n){"use strict";var o=n(163);e.exports=o.renderSubtreeIntoContainer},function(module,exports,__webpack_require__){"use strict";Object.defineProperty(exports,"__esModule",{value:true});function _interopRequireDefault(obj){return obj&&obj.__esModule?obj:{default:obj}}var React=_interopRequireDefault(__webpack_require__(1)).default,UnixTime=_interopRequireDefault(__webpack_require__(173)).default,ShortList=_interopRequireDefault(__webpack_require__(174)).default,ProgressBar=_interopRequireDefault(__webpack_require__(257)).default,styles=_interopRequireDefault(__webpack_require__(180)).default,cx=_interopRequireDefault(__webpack_require__(182)).default;class BusyWorkers extends React.Component{render(){return React.createElement("div",{className:styles.tableResponsive},React.createElement("table",{className:styles.table},React.createElement("tbody",null,React.createElement("tr",null,React.createElement("th",null,"Name"),React.createElement("th",null,"Arguments"),React.createElement("th",null,"Started At"),React.createElement("th",null,"Check-in At"),React.createElement("th",null,"Check-in"),React.createElement("th",null,"Progress")),this.props.worker.map(worker=>{return React.createElement("tr",{key:worker.worker_id},React.createElement("td",null,worker.job_name),React.createElement("td",null,worker.args_json),React.createElement("td",null,React.createElement(UnixTime,{ts:worker.started_at})),React.createElement("td",null,React.createElement(UnixTime,{ts:worker.checkin_at})),React.createElement("td",null,worker.checkin),React.createElement("td",null,React.createElement(ProgressBar,{worker:worker})))}))))}}BusyWorkers.propTypes={worker:React.PropTypes.arrayOf(React.PropTypes.object).isRequired};class Processes extends React.Component{constructor(...args){super(...args);this.state={busyWorker:[],workerPool:[]}}componentWillMount(){if(this.props.busyWorkerURL){fetch(this.props.busyWorkerURL).then(resp=>resp.json()).then(data=>{if(data){this.setState({busyWorker:data})}})}if(this.props.workerPoolURL){fetch(this.props.workerPoolURL).then(resp=>resp.json()).then(data=>{let workers=[];data.map(worker=>{if(worker.host!=""){workers.push(worker)}});this.setState({workerPool:workers})})}}get workerCount(){let count=0;this.state.workerPool.map(pool=>{count+=pool.worker_ids.length});return count}getBusyPoolWorker(pool){let workers=[];this.state.busyWorker.map(worker=>{if(pool.worker_ids.includes(worker.worker_id)){workers.push(worker)}});return workers}render(){return React.createElement("section",null,React.createElement("header",null,"Processes"),React.createElement("p",null,this.state.workerPool.length," Worker process(es). ",this.state.busyWorker.length," active worker(s) out of ",this.workerCount,"."),this.state.workerPool.map(pool=>{let busyWorker=this.getBusyPoolWorker(pool);return React.createElement("div",{key:pool.worker_pool_id,className:cx(styles.panel,styles.panelDefault)},React.createElement("div",{className:styles.tableResponsive},React.createElement("table",{className:styles.table},React.createElement("tbody",null,React.createElement("tr",null,React.createElement("td",null,pool.host,": ",pool.pid),React.createElement("td",null,"Started ",React.createElement(UnixTime,{ts:pool.started_at})),React.createElement("td",null,"Last Heartbeat ",React.createElement(UnixTime,{ts:pool.heartbeat_at})),React.createElement("td",null,"Concurrency ",pool.concurrency)),React.createElement("tr",null,React.createElement("td",{colSpan:"4"},"Servicing ",React.createElement(ShortList,{item:pool.job_names}),".")),React.createElement("tr",null,React.createElement("td",{colSpan:"4"},busyWorker.length," active ssssssworker(s) and ",pool.worker_ids.length-busyWorker.length," idle.")),React.createElement("tr",null,React.createElement("td",{colSpan:"4"},React.createElement("div",{className:cx(styles.panel,styles.panelDefault)},React.createElement(BusyWorkers,{worker:busyWorker}))))))))}))}}exports.default=Processes;Processes.propTypes={busyWorkerURL:React.PropTypes.string,workerPoolURL:React.PropTypes.string}},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),s=n(1),c=o(s),u=function(e){function t(){return r(this,t),l(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return a(t,e),i(t,[{key:"render",value:function(){var e=new Date(1e3*this.props.ts);return c.default.createElement("time",{dateTime:e.toISOString()},e.toISOString().slice(0,19).replace(/-/g,"/").replace("T"," "))}}]),t}(c.default.Component);u.propTypes={ts:c.default.PropTypes.number.isRequired},t.default=u},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");
 return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),s=n(1),c=o(s),u=n(175),d=o(u),p=function(e){function t(){return r(this,t),l(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return a(t,e),i(t,[{key:"render",value:function(){var e=this;return c.default.createElement("ul",{className:d.default.ul},this.props.item.map(function(t,n){return n<3?c.default.createElement("li",{key:n,className:d.default.li},t):3==n?c.default.createElement("li",{key:n,className:d.default.li},e.props.item.length-3," more"):void 0}))}}]),t}(c.default.Component);p.propTypes={item:c.default.PropTypes.arrayOf(c.default.PropTypes.string).isRequired},t.default=p},function(e,t,n){var o=n(176);"string"==typeof o&&(o=[[e.id,o,""]]);var r,l={};l.transform=r;n(178)(o,l);o.locals&&(e.exports=o.locals)},function(e,t,n){t=e.exports=n(177)(!1),t.push([e.id,'._223iE-ul{list-style:none;padding:0}._14w3w-li,._223iE-ul{display:inline}._14w3w-li:after{content:", "}._14w3w-li:last-child:before{content:"and "}._14w3w-li:last-child:after{content:""}',""]),t.locals={ul:"_223iE-ul",li:"_14w3w-li"}},function(e,t){function n(e,t){var n=e[1]||"",r=e[3];if(!r)return n;if(t&&"function"==typeof btoa){var l=o(r),a=r.sources.map(function(e){return"/*# sourceURL="+r.sourceRoot+e+" */"});return[n].concat(a).concat([l]).join("\n")}return[n].join("\n")}function o(e){var t=btoa(unescape(encodeURIComponent(JSON.stringify(e)))),n="sourceMappingURL=data:application/json;charset=utf-8;base64,"+t;return"/*# "+n+" */"}e.exports=function(e){var t=[];return t.toString=function(){return this.map(function(t){var o=n(t,e);return t[2]?"@media "+t[2]+"{"+o+"}":o}).join("")},t.i=function(e,n){"string"==typeof e&&(e=[[null,e,""]]);for(var o={},r=0;r<this.length;r++){var l=this[r][0];"number"==typeof l&&(o[l]=!0)}for(r=0;r<e.length;r++){var a=e[r];"number"==typeof a[0]&&o[a[0]]||(n&&!a[2]?a[2]=n:n&&(a[2]="("+a[2]+") and ("+n+")"),t.push(a))}},t}},function(e,t,n){function o(e,t){for(var n=0;n<e.length;n++){var o=e[n],r=h[o.id];if(r){r.refs++;for(var l=0;l<r.parts.length;l++)r.parts[l](o.parts[l]);for(;l<o.parts.length;l++)r.parts.push(u(o.parts[l],t))}else{for(var a=[],l=0;l<o.parts.length;l++)a.push(u(o.parts[l],t));h[o.id]={id:o.id,refs:1,parts:a}}}}function r(e,t){for(var n=[],o={},r=0;r<e.length;r++){var l=e[r],a=t.base?l[0]+t.base:l[0],i=l[1],s=l[2],c=l[3],u={css:i,media:s,sourceMap:c};o[a]?o[a].parts.push(u):n.push(o[a]={id:a,parts:[u]})}return n}function l(e,t){var n=g(e.insertInto);if(!n)throw new Error("Couldn't find a style target. This probably means that the value for the 'insertInto' parameter is invalid.");var o=y[y.length-1];if("top"===e.insertAt)o?o.nextSibling?n.insertBefore(t,o.nextSibling):n.appendChild(t):n.insertBefore(t,n.firstChild),y.push(t);else{if("bottom"!==e.insertAt)throw new Error("Invalid value for parameter 'insertAt'. Must be 'top' or 'bottom'.");n.appendChild(t)}}function a(e){if(null===e.parentNode)return!1;e.parentNode.removeChild(e);var t=y.indexOf(e);t>=0&&y.splice(t,1)}function i(e){var t=document.createElement("style");return e.attrs.type="text/css",c(t,e.attrs),l(e,t),t}function s(e){var t=document.createElement("link");return e.attrs.type="text/css",e.attrs.rel="stylesheet",c(t,e.attrs),l(e,t),t}function c(e,t){Object.keys(t).forEach(function(n){e.setAttribute(n,t[n])})}function u(e,t){var n,o,r,l;if(t.transform&&e.css){if(l=t.transform(e.css),!l)return function(){};e.css=l}if(t.singleton){var c=v++;n=_||(_=i(t)),o=d.bind(null,n,c,!1),r=d.bind(null,n,c,!0)}else e.sourceMap&&"function"==typeof URL&&"function"==typeof URL.createObjectURL&&"function"==typeof URL.revokeObjectURL&&"function"==typeof Blob&&"function"==typeof btoa?(n=s(t),o=f.bind(null,n,t),r=function(){a(n),n.href&&URL.revokeObjectURL(n.href)}):(n=i(t),o=p.bind(null,n),r=function(){a(n)});return o(e),function(t){if(t){if(t.css===e.css&&t.media===e.media&&t.sourceMap===e.sourceMap)return;o(e=t)}else r()}}function d(e,t,n,o){var r=n?"":o.css;if(e.styleSheet)e.styleSheet.cssText=w(t,r);else{var l=document.createTextNode(r),a=e.childNodes;a[t]&&e.removeChild(a[t]),a.length?e.insertBefore(l,a[t]):e.appendChild(l)}}function p(e,t){var n=t.css,o=t.media;if(o&&e.setAttribute("media",o),e.styleSheet)e.styleSheet.cssText=n;else{for(;e.firstChild;)e.removeChild(e.firstChild);e.appendChild(document.createTextNode(n))}}function f(e,t,n){var o=n.css,r=n.sourceMap,l=void 0===t.convertToAbsoluteUrls&&r;(t.convertToAbsoluteUrls||l)&&(o=x(o)),r&&(o+="\n/*# sourceMappingURL=data:application/json;base64,"+btoa(unescape(encodeURIComponent(JSON.stringify(r))))+" */");var a=new Blob([o],{type:"text/css"}),i=e.href;e.href=URL.createObjectURL(a),i&&URL.revokeObjectURL(i)}var h={},m=function(e){var t;return function(){return"undefined"==typeof t&&(t=e.apply(this,arguments)),t}},b=m(function(){return window&&document&&document.all&&!window.atob}),g=function(e){var t={};return function(n){return"undefined"==typeof t[n]&&(t[n]=e.call(this,n)),t[n]}}(function(e){return document.querySelector(e)}),_=null,v=0,y=[],x=n(179);e.exports=function(e,t){t=t||{},t.attrs="object"==typeof t.attrs?t.attrs:{},t.singleton||(t.singleton=b()),t.insertInto||(t.insertInto="head"),t.insertAt||(t.insertAt="bottom");var n=r(e,t);return o(n,t),function(e){for(var l=[],a=0;a<n.length;a++){var i=n[a],s=h[i.id];s.refs--,l.push(s)}if(e){var c=r(e,t);o(c,t)}for(var a=0;a<l.length;a++){var s=l[a];if(0===s.refs){for(var u=0;u<s.parts.length;u++)s.parts[u]();delete h[s.id]}}}};var w=function(){var e=[];return function(t,n){return e[t]=n,e.filter(Boolean).join("\n")}}()},function(e,t){e.exports=function(e){var t="undefined"!=typeof window&&window.location;if(!t)throw new Error("fixUrls requires window.location");if(!e||"string"!=typeof e)return e;var n=t.protocol+"//"+t.host,o=n+t.pathname.replace(/\/[^\/]*$/,"/"),r=e.replace(/url\s*\(((?:[^)(]|\((?:[^)(]+|\([^)(]*\))*\))*)\)/gi,function(e,t){var r=t.trim().replace(/^"(.*)"$/,function(e,t){return t}).replace(/^'(.*)'$/,function(e,t){return t});if(/^(#|data:|http:\/\/|https:\/\/|file:\/\/\/)/i.test(r))return e;var l;return l=0===r.indexOf("//")?r:0===r.indexOf("/")?n+r:o+r.replace(/^\.\//,""),"url("+JSON.stringify(l)+")"});return r}},function(e,t,n){var o=n(181);"string"==typeof o&&(o=[[e.id,o,""]]);var r,l={};l.transform=r;n(178)(o,l);o.locals&&(e.exports=o.locals)},function(e,t,n){t=e.exports=n(177)(!1),t.push([e.id,'/*!\n * Bootstrap v3.3.5 (http://getbootstrap.com)\n * Copyright 2011-2016 Twitter, Inc.\n * Licensed under MIT (https://github.com/twbs/bootstrap/blob/master/LICENSE)\n */\n\n/*!\n * Generated using the Bootstrap Customizer (http://getbootstrap.com/customize/?id=da9e4e258238275a8c6ec9d23bbb2e92)\n * Config saved to config.json and https://gist.github.com/da9e4e258238275a8c6ec9d23bbb2e92\n *//*!\n * Bootstrap v3.3.6 (http://getbootstrap.com)\n * Copyright 2011-2015 Twitter, Inc.\n * Licensed under MIT (https://github.com/twbs/bootstrap/blob/master/LICENSE)\n *//*! normalize.css v3.0.3 | MIT License | github.com/necolas/normalize.css */html{font-family:sans-serif;-ms-text-size-adjust:100%;-webkit-text-size-adjust:100%}body{margin:0}article,aside,details,figcaption,figure,footer,header,hgroup,main,menu,nav,section,summary{display:block}audio,canvas,progress,video{display:inline-block;vertical-align:baseline}audio:not([controls]){display:none;height:0}[hidden],template{display:none}a{background-color:transparent}a:active,a:hover{outline:0}abbr[title]{border-bottom:1px dotted}b,strong{font-weight:700}dfn{font-style:italic}h1{font-size:2em;margin:.67em 0}mark{background:#ff0;color:#000}small{font-size:80%}sub,sup{font-size:75%;line-height:0;position:relative;vertical-align:baseline}sup{top:-.5em}sub{bottom:-.25em}img{border:0}svg:not(:root){overflow:hidden}figure{margin:1em 40px}hr{-webkit-box-sizing:content-box;-moz-box-sizing:content-box;box-sizing:content-box;height:0}pre{overflow:auto}code,kbd,pre,samp{font-family:monospace,monospace;font-size:1em}button,input,optgroup,select,textarea{color:inherit;font:inherit;margin:0}button{overflow:visible}button,select{text-transform:none}button,html input[type=button],input[type=reset],input[type=submit]{-webkit-appearance:button;cursor:pointer}button[disabled],html input[disabled]{cursor:default}button::-moz-focus-inner,input::-moz-focus-inner{border:0;padding:0}input{line-height:normal}input[type=checkbox],input[type=radio]{-webkit-box-sizing:border-box;-moz-box-sizing:border-box;box-sizing:border-box;padding:0}input[type=number]::-webkit-inner-spin-button,input[type=number]::-webkit-outer-spin-button{height:auto}input[type=search]{-webkit-appearance:textfield;-webkit-box-sizing:content-box;-moz-box-sizing:content-box;box-sizing:content-box}input[type=search]::-webkit-search-cancel-button,input[type=search]::-webkit-search-decoration{-webkit-appearance:none}fieldset{border:1px solid silver;margin:0 2px;padding:.35em .625em .75em}legend{border:0;padding:0}textarea{overflow:auto}optgroup{font-weight:700}table{border-collapse:collapse;border-spacing:0}td,th{padding:0}/*! Source: https://github.com/h5bp/html5-boilerplate/blob/master/src/css/main.css */@media print{*,:after,:before{background:transparent!important;color:#000!important;-webkit-box-shadow:none!important;box-shadow:none!important;text-shadow:none!important}a,a:visited{text-decoration:underline}a[href]:after{content:" (" attr(href) ")"}abbr[title]:after{content:" (" attr(title) ")"}a[href^="#"]:after,a[href^="javascript:"]:after{content:""}blockquote,pre{border:1px solid #999;page-break-inside:avoid}thead{display:table-header-group}img,tr{page-break-inside:avoid}img{max-width:100%!important}h2,h3,p{orphans:3;widows:3}h2,h3{page-break-after:avoid}.xz-aB-navbar{display:none}._3-XUl-btn>.WfXRY-caret,.xQM4X-dropup>._3-XUl-btn>.WfXRY-caret{border-top-color:#000!important}._3gkGS-label{border:1px solid #000}.fHH5l-table{border-collapse:collapse!important}.fHH5l-table td,.fHH5l-table th{background-color:#fff!important}._3qUkR-table-bordered td,._3qUkR-table-bordered th{border:1px solid #ddd!important}}*,:after,:before{-webkit-box-sizing:border-box;-moz-box-sizing:border-box;box-sizing:border-box}html{font-size:10px;-webkit-tap-highlight-color:rgba(0,0,0,0)}body{font-family:Helvetica Neue,Helvetica,Arial,sans-serif;font-size:14px;line-height:1.42857143;color:#333;background-color:#fff}button,input,select,textarea{font-family:inherit;font-size:inherit;line-height:inherit}a{color:#337ab7;text-decoration:none}a:focus,a:hover{color:#23527c;text-decoration:underline}a:focus{outline:thin dotted;outline:5px auto -webkit-focus-ring-color;outline-offset:-2px}figure{margin:0}img{vertical-align:middle}._2zRts-img-responsive{display:block;max-width:100%;height:auto}._141wW-img-rounded{border-radius:6px}._3mPVB-img-thumbnail{padding:4px;line-height:1.42857143;background-color:#fff;border:1px solid #ddd;border-radius:4px;-webkit-transition:all .2s ease-in-out;-o-transition:all .2s ease-in-out;transition:all .2s ease-in-out;display:inline-block;max-width:100%;height:auto}._1pGGm-img-circle{border-radius:50%}hr{margin-top:20px;margin-bottom:20px;border:0;border-top:1px solid #eee}._2pd-Z-sr-only{position:absolute;width:1px;height:1px;margin:-1px;padding:0;overflow:hidden;clip:rect(0,0,0,0);border:0}._2oRwu-sr-only-focusable:active,._2oRwu-sr-only-focusable:focus{position:static;width:auto;height:auto;margin:0;overflow:visible;clip:auto}[role=button]{cursor:pointer}._1IWbi-h6,._2Hz9Y-h3,._2RtE8-h2,._3ig4X-h5,._20UE0-h4,.FcDB9-h1,h1,h2,h3,h4,h5,h6{font-family:inherit;font-weight:500;line-height:1.1;color:inherit}._1IWbi-h6 ._1xqEr-small,._1IWbi-h6 small,._2Hz9Y-h3 ._1xqEr-small,._2Hz9Y-h3 small,._2RtE8-h2 ._1xqEr-small,._2RtE8-h2 small,._3ig4X-h5 ._1xqEr-small,._3ig4X-h5 small,._20UE0-h4 ._1xqEr-small,._20UE0-h4 small,.FcDB9-h1 ._1xqEr-small,.FcDB9-h1 small,h1 ._1xqEr-small,h1 small,h2 ._1xqEr-small,h2 small,h3 ._1xqEr-small,h3 small,h4 ._1xqEr-small,h4 small,h5 ._1xqEr-small,h5 small,h6 ._1xqEr-small,h6 small{font-weight:400;line-height:1;color:#777}._2Hz9Y-h3,._2RtE8-h2,.FcDB9-h1,h1,h2,h3{margin-top:20px;margin-bottom:10px}._2Hz9Y-h3 ._1xqEr-small,._2Hz9Y-h3 small,._2RtE8-h2 ._1xqEr-small,._2RtE8-h2 small,.FcDB9-h1 ._1xqEr-small,.FcDB9-h1 small,h1 ._1xqEr-small,h1 small,h2 ._1xqEr-small,h2 small,h3 ._1xqEr-small,h3 small{font-size:65%}._1IWbi-h6,._3ig4X-h5,._20UE0-h4,h4,h5,h6{margin-top:10px;margin-bottom:10px}._1IWbi-h6 ._1xqEr-small,._1IWbi-h6 small,._3ig4X-h5 ._1xqEr-small,._3ig4X-h5 small,._20UE0-h4 ._1xqEr-small,._20UE0-h4 small,h4 ._1xqEr-small,h4 small,h5 ._1xqEr-small,h5 small,h6 ._1xqEr-small,h6 small{font-size:75%}.FcDB9-h1,h1{font-size:36px}._2RtE8-h2,h2{font-size:30px}._2Hz9Y-h3,h3{font-size:24px}._20UE0-h4,h4{font-size:18px}._3ig4X-h5,h5{font-size:14px}._1IWbi-h6,h6{font-size:12px}p{margin:0 0 10px}.BToLm-lead{margin-bottom:20px;font-size:16px;font-weight:300;line-height:1.4}@media (min-width:768px){.BToLm-lead{font-size:21px}}._1xqEr-small,small{font-size:85%}._1CUfl-mark,mark{background-color:#fcf8e3;padding:.2em}._1dEhG-text-left{text-align:left}._13-RL-text-right{text-align:right}._12Qkk-text-center{text-align:center}._2IBJo-text-justify{text-align:justify}._2D83n-text-nowrap{white-space:nowrap}._3pILW-text-lowercase{text-transform:lowercase}.EHGdF-text-uppercase{text-transform:uppercase}.NKPoz-text-capitalize{text-transform:capitalize}.TLMiz-text-muted{color:#777}.m-Vt1-text-primary{color:#337ab7}a.m-Vt1-text-primary:focus,a.m-Vt1-text-primary:hover{color:#286090}._1gJQD-text-success{color:#3c763d}a._1gJQD-text-success:focus,a._1gJQD-text-success:hover{color:#2b542c}._3nKGJ-text-info{color:#31708f}a._3nKGJ-text-info:focus,a._3nKGJ-text-info:hover{color:#245269}._1L8Pc-text-warning{color:#8a6d3b}a._1L8Pc-text-warning:focus,a._1L8Pc-text-warning:hover{color:#66512c}._2Qeqx-text-danger{color:#a94442}a._2Qeqx-text-danger:focus,a._2Qeqx-text-danger:hover{color:#843534}.VG86S-bg-primary{color:#fff;background-color:#337ab7}a.VG86S-bg-primary:focus,a.VG86S-bg-primary:hover{background-color:#286090}.Fh09a-bg-success{background-color:#dff0d8}a.Fh09a-bg-success:focus,a.Fh09a-bg-success:hover{background-color:#c1e2b3}._3D-5o-bg-info{background-color:#d9edf7}a._3D-5o-bg-info:focus,a._3D-5o-bg-info:hover{background-color:#afd9ee}._23YNn-bg-warning{background-color:#fcf8e3}a._23YNn-bg-warning:focus,a._23YNn-bg-warning:hover{background-color:#f7ecb5}._1g67A-bg-danger{background-color:#f2dede}a._1g67A-bg-danger:focus,a._1g67A-bg-danger:hover{background-color:#e4b9b9}._3ayLh-page-header{padding-bottom:9px;margin:40px 0 20px;border-bottom:1px solid #eee}ol,ul{margin-top:0;margin-bottom:10px}ol ol,ol ul,ul ol,ul ul{margin-bottom:0}._2aPiu-list-inline,._2tb6R-list-unstyled{padding-left:0;list-style:none}._2aPiu-list-inline{margin-left:-5px}._2aPiu-list-inline>li{display:inline-block;padding-left:5px;padding-right:5px}dl{margin-top:0;margin-bottom:20px}dd,dt{line-height:1.42857143}dt{font-weight:700}dd{margin-left:0}@media (min-width:768px){._2DZom-dl-horizontal dt{float:left;width:160px;clear:left;text-align:right;overflow:hidden;text-overflow:ellipsis;white-space:nowrap}._2DZom-dl-horizontal dd{margin-left:180px}}abbr[data-original-title],abbr[title]{cursor:help;border-bottom:1px dotted #777}._39JkD-initialism{font-size:90%;text-transform:uppercase}blockquote{padding:10px 20px;margin:0 0 20px;font-size:17.5px;border-left:5px solid #eee}blockquote ol:last-child,blockquote p:last-child,blockquote ul:last-child{margin-bottom:0}blockquote ._1xqEr-small,blockquote footer,blockquote small{display:block;font-size:80%;line-height:1.42857143;color:#777}blockquote ._1xqEr-small:before,blockquote footer:before,blockquote small:before{content:"\\2014   \\A0"}._3OkIL-blockquote-reverse,blockquote._1DwIR-pull-right{padding-right:15px;padding-left:0;border-right:5px solid #eee;border-left:0;text-align:right}._3OkIL-blockquote-reverse ._1xqEr-small:before,._3OkIL-blockquote-reverse footer:before,._3OkIL-blockquote-reverse small:before,blockquote._1DwIR-pull-right ._1xqEr-small:before,blockquote._1DwIR-pull-right footer:before,blockquote._1DwIR-pull-right small:before{content:""}._3OkIL-blockquote-reverse ._1xqEr-small:after,._3OkIL-blockquote-reverse footer:after,._3OkIL-blockquote-reverse small:after,blockquote._1DwIR-pull-right ._1xqEr-small:after,blockquote._1DwIR-pull-right footer:after,blockquote._1DwIR-pull-right small:after{content:"\\A0   \\2014"}address{margin-bottom:20px;font-style:normal;line-height:1.42857143}.GfVfY-container{margin-right:auto;margin-left:auto;padding-left:15px;padding-right:15px}@media (min-width:768px){.GfVfY-container{width:750px}}@media (min-width:992px){.GfVfY-container{width:970px}}@media (min-width:1200px){.GfVfY-container{width:1170px}}._1TgHS-container-fluid{margin-right:auto;margin-left:auto;padding-left:15px;padding-right:15px}._3XjgV-row{margin-left:-15px;margin-right:-15px}._1i2ug-col-sm-2,._1kKt6-col-lg-6,._1lY1V-col-xs-7,._1oNCM-col-md-10,._1qjSD-col-lg-11,._2CmUe-col-md-11,._2Cn5F-col-sm-3,._2DCWc-col-md-12,._2dgc--col-xs-12,._2DhdG-col-md-9,._2Dnpy-col-xs-2,._2DYWZ-col-lg-10,._2FNrn-col-lg-12,._2gidE-col-sm-8,._2Hl3y-col-lg-2,._2HxFx-col-xs-8,._2LLfi-col-xs-6,._2oOfj-col-xs-9,._2PRaS-col-md-8,._2qQXc-col-md-1,._2RAtz-col-xs-4,._2t37W-col-md-2,._2uvrS-col-sm-6,._2Y3wR-col-xs-5,._3aWb5-col-md-6,._3cJo6-col-md-7,._3h_BG-col-sm-5,._3MZfg-col-sm-7,._3poT2-col-lg-5,._3q6rI-col-lg-9,._3xzR0-col-lg-7,._3ylC5-col-sm-12,._4I1Yd-col-xs-1,._17v9q-col-lg-4,._20ta3-col-lg-1,._26BI4-col-sm-9,._26GsY-col-lg-3,.Ab3eH-col-md-3,.fa0Ir-col-sm-4,.hp0Eu-col-md-4,.liNUF-col-sm-11,.LJ--s-col-lg-8,.OXp8_-col-xs-3,.pmiBX-col-xs-10,.s5knd-col-sm-10,.s-Mk2-col-md-5,.yWnii-col-xs-11,.ZjKa3-col-sm-1{position:relative;min-height:1px;padding-left:15px;padding-right:15px}._1lY1V-col-xs-7,._2dgc--col-xs-12,._2Dnpy-col-xs-2,._2HxFx-col-xs-8,._2LLfi-col-xs-6,._2oOfj-col-xs-9,._2RAtz-col-xs-4,._2Y3wR-col-xs-5,._4I1Yd-col-xs-1,.OXp8_-col-xs-3,.pmiBX-col-xs-10,.yWnii-col-xs-11{float:left}._2dgc--col-xs-12{width:100%}.yWnii-col-xs-11{width:91.66666667%}.pmiBX-col-xs-10{width:83.33333333%}._2oOfj-col-xs-9{width:75%}._2HxFx-col-xs-8{width:66.66666667%}._1lY1V-col-xs-7{width:58.33333333%}._2LLfi-col-xs-6{width:50%}._2Y3wR-col-xs-5{width:41.66666667%}._2RAtz-col-xs-4{width:33.33333333%}.OXp8_-col-xs-3{width:25%}._2Dnpy-col-xs-2{width:16.66666667%}._4I1Yd-col-xs-1{width:8.33333333%}.uk8LQ-col-xs-pull-12{right:100%}.nQP-X-col-xs-pull-11{right:91.66666667%}._3W2H_-col-xs-pull-10{right:83.33333333%}._18qIh-col-xs-pull-9{right:75%}.CEgpl-col-xs-pull-8{right:66.66666667%}._3UVMh-col-xs-pull-7{right:58.33333333%}._1PEhv-col-xs-pull-6{right:50%}.VxFvW-col-xs-pull-5{right:41.66666667%}._3Wqpt-col-xs-pull-4{right:33.33333333%}._12Zed-col-xs-pull-3{right:25%}._3p-tQ-col-xs-pull-2{right:16.66666667%}.RDEqX-col-xs-pull-1{right:8.33333333%}._2F1WQ-col-xs-pull-0{right:auto}._1APvI-col-xs-push-12{left:100%}._3C73D-col-xs-push-11{left:91.66666667%}.h56Hx-col-xs-push-10{left:83.33333333%}._1P93P-col-xs-push-9{left:75%}._2Nbww-col-xs-push-8{left:66.66666667%}._1K3eA-col-xs-push-7{left:58.33333333%}._2nBcT-col-xs-push-6{left:50%}._3petP-col-xs-push-5{left:41.66666667%}._2IqqW-col-xs-push-4{left:33.33333333%}.MCtu6-col-xs-push-3{left:25%}._3PMdr-col-xs-push-2{left:16.66666667%}._1wU93-col-xs-push-1{left:8.33333333%}._1mQQp-col-xs-push-0{left:auto}._29EZj-col-xs-offset-12{margin-left:100%}._2hf-s-col-xs-offset-11{margin-left:91.66666667%}.PUiPu-col-xs-offset-10{margin-left:83.33333333%}._26WFR-col-xs-offset-9{margin-left:75%}._1cCHe-col-xs-offset-8{margin-left:66.66666667%}._3XTt9-col-xs-offset-7{margin-left:58.33333333%}._3ruq1-col-xs-offset-6{margin-left:50%}._2Jvdw-col-xs-offset-5{margin-left:41.66666667%}._3Lc1D-col-xs-offset-4{margin-left:33.33333333%}._1MGVb-col-xs-offset-3{margin-left:25%}._3VELV-col-xs-offset-2{margin-left:16.66666667%}._1qTH1-col-xs-offset-1{margin-left:8.33333333%}._3p4mP-col-xs-offset-0{margin-left:0}@media (min-width:768px){._1i2ug-col-sm-2,._2Cn5F-col-sm-3,._2gidE-col-sm-8,._2uvrS-col-sm-6,._3h_BG-col-sm-5,._3MZfg-col-sm-7,._3ylC5-col-sm-12,._26BI4-col-sm-9,.fa0Ir-col-sm-4,.liNUF-col-sm-11,.s5knd-col-sm-10,.ZjKa3-col-sm-1{float:left}._3ylC5-col-sm-12{width:100%}.liNUF-col-sm-11{width:91.66666667%}.s5knd-col-sm-10{width:83.33333333%}._26BI4-col-sm-9{width:75%}._2gidE-col-sm-8{width:66.66666667%}._3MZfg-col-sm-7{width:58.33333333%}._2uvrS-col-sm-6{width:50%}._3h_BG-col-sm-5{width:41.66666667%}.fa0Ir-col-sm-4{width:33.33333333%}._2Cn5F-col-sm-3{width:25%}._1i2ug-col-sm-2{width:16.66666667%}.ZjKa3-col-sm-1{width:8.33333333%}._2lgaN-col-sm-pull-12{right:100%}._9DE1R-col-sm-pull-11{right:91.66666667%}.kEzk--col-sm-pull-10{right:83.33333333%}.Nt2jd-col-sm-pull-9{right:75%}._3swPC-col-sm-pull-8{right:66.66666667%}._3HVdW-col-sm-pull-7{right:58.33333333%}.wTEFa-col-sm-pull-6{right:50%}.TLqCy-col-sm-pull-5{right:41.66666667%}._15_JC-col-sm-pull-4{right:33.33333333%}.kKugK-col-sm-pull-3{right:25%}._1gfwZ-col-sm-pull-2{right:16.66666667%}.ULGBP-col-sm-pull-1{right:8.33333333%}._2761W-col-sm-pull-0{right:auto}.vVqsv-col-sm-push-12{left:100%}._3rrzB-col-sm-push-11{left:91.66666667%}._16iHZ-col-sm-push-10{left:83.33333333%}._3gO4_-col-sm-push-9{left:75%}._2iTaN-col-sm-push-8{left:66.66666667%}._15MqT-col-sm-push-7{left:58.33333333%}._3WBCD-col-sm-push-6{left:50%}._3yi6x-col-sm-push-5{left:41.66666667%}.Hi_tc-col-sm-push-4{left:33.33333333%}._3CD2Y-col-sm-push-3{left:25%}._2KPVu-col-sm-push-2{left:16.66666667%}._1vrte-col-sm-push-1{left:8.33333333%}._2iGSL-col-sm-push-0{left:auto}._2gBOF-col-sm-offset-12{margin-left:100%}._1nkq--col-sm-offset-11{margin-left:91.66666667%}._3cbLF-col-sm-offset-10{margin-left:83.33333333%}._1YqUk-col-sm-offset-9{margin-left:75%}.l5LT2-col-sm-offset-8{margin-left:66.66666667%}._1_lx0-col-sm-offset-7{margin-left:58.33333333%}.QftRx-col-sm-offset-6{margin-left:50%}._1CiAA-col-sm-offset-5{margin-left:41.66666667%}._3KOyM-col-sm-offset-4{margin-left:33.33333333%}._3X8SS-col-sm-offset-3{margin-left:25%}._2uxv1-col-sm-offset-2{margin-left:16.66666667%}._3LDLJ-col-sm-offset-1{margin-left:8.33333333%}._2qEtK-col-sm-offset-0{margin-left:0}}@media (min-width:992px){._1oNCM-col-md-10,._2CmUe-col-md-11,._2DCWc-col-md-12,._2DhdG-col-md-9,._2PRaS-col-md-8,._2qQXc-col-md-1,._2t37W-col-md-2,._3aWb5-col-md-6,._3cJo6-col-md-7,.Ab3eH-col-md-3,.hp0Eu-col-md-4,.s-Mk2-col-md-5{float:left}._2DCWc-col-md-12{width:100%}._2CmUe-col-md-11{width:91.66666667%}._1oNCM-col-md-10{width:83.33333333%}._2DhdG-col-md-9{width:75%}._2PRaS-col-md-8{width:66.66666667%}._3cJo6-col-md-7{width:58.33333333%}._3aWb5-col-md-6{width:50%}.s-Mk2-col-md-5{width:41.66666667%}.hp0Eu-col-md-4{width:33.33333333%}.Ab3eH-col-md-3{width:25%}._2t37W-col-md-2{width:16.66666667%}._2qQXc-col-md-1{width:8.33333333%}.bjQSr-col-md-pull-12{right:100%}._2R0gT-col-md-pull-11{right:91.66666667%}._1ywn5-col-md-pull-10{right:83.33333333%}.Y3m-j-col-md-pull-9{right:75%}._2kyk9-col-md-pull-8{right:66.66666667%}.dnrMG-col-md-pull-7{right:58.33333333%}._1zNYf-col-md-pull-6{right:50%}._1lgxH-col-md-pull-5{right:41.66666667%}.fI_-S-col-md-pull-4{right:33.33333333%}._1yC1r-col-md-pull-3{right:25%}._2ruK1-col-md-pull-2{right:16.66666667%}._3pkxQ-col-md-pull-1{right:8.33333333%}._2NDft-col-md-pull-0{right:auto}._2dklO-col-md-push-12{left:100%}._2O3TS-col-md-push-11{left:91.66666667%}._3H7Y4-col-md-push-10{left:83.33333333%}._2PIS0-col-md-push-9{left:75%}._1qQQi-col-md-push-8{left:66.66666667%}.Y8MRT-col-md-push-7{left:58.33333333%}._3_TWQ-col-md-push-6{left:50%}._3KOrj-col-md-push-5{left:41.66666667%}._2PuS8-col-md-push-4{left:33.33333333%}._2zI-_-col-md-push-3{left:25%}._3Drij-col-md-push-2{left:16.66666667%}._1M7-4-col-md-push-1{left:8.33333333%}._1kPaw-col-md-push-0{left:auto}._3PwfG-col-md-offset-12{margin-left:100%}.sHbiw-col-md-offset-11{margin-left:91.66666667%}._3geq1-col-md-offset-10{margin-left:83.33333333%}._3ypJc-col-md-offset-9{margin-left:75%}.ydDuS-col-md-offset-8{margin-left:66.66666667%}._1MS3B-col-md-offset-7{margin-left:58.33333333%}._1A80h-col-md-offset-6{margin-left:50%}._3g3p_-col-md-offset-5{margin-left:41.66666667%}._2FjQc-col-md-offset-4{margin-left:33.33333333%}.Xebzn-col-md-offset-3{margin-left:25%}.Xw9rm-col-md-offset-2{margin-left:16.66666667%}.CToHd-col-md-offset-1{margin-left:8.33333333%}.oXEcL-col-md-offset-0{margin-left:0}}@media (min-width:1200px){._1kKt6-col-lg-6,._1qjSD-col-lg-11,._2DYWZ-col-lg-10,._2FNrn-col-lg-12,._2Hl3y-col-lg-2,._3poT2-col-lg-5,._3q6rI-col-lg-9,._3xzR0-col-lg-7,._17v9q-col-lg-4,._20ta3-col-lg-1,._26GsY-col-lg-3,.LJ--s-col-lg-8{float:left}._2FNrn-col-lg-12{width:100%}._1qjSD-col-lg-11{width:91.66666667%}._2DYWZ-col-lg-10{width:83.33333333%}._3q6rI-col-lg-9{width:75%}.LJ--s-col-lg-8{width:66.66666667%}._3xzR0-col-lg-7{width:58.33333333%}._1kKt6-col-lg-6{width:50%}._3poT2-col-lg-5{width:41.66666667%}._17v9q-col-lg-4{width:33.33333333%}._26GsY-col-lg-3{width:25%}._2Hl3y-col-lg-2{width:16.66666667%}._20ta3-col-lg-1{width:8.33333333%}._32_we-col-lg-pull-12{right:100%}._24eEw-col-lg-pull-11{right:91.66666667%}._29qaq-col-lg-pull-10{right:83.33333333%}._1S7Yo-col-lg-pull-9{right:75%}._2CgOB-col-lg-pull-8{right:66.66666667%}._3Jv0L-col-lg-pull-7{right:58.33333333%}._1pHMz-col-lg-pull-6{right:50%}._2zvsy-col-lg-pull-5{right:41.66666667%}.sFsmY-col-lg-pull-4{right:33.33333333%}.Ui3Ia-col-lg-pull-3{right:25%}.WmgXv-col-lg-pull-2{right:16.66666667%}._2KMEm-col-lg-pull-1{right:8.33333333%}.QWRTc-col-lg-pull-0{right:auto}._2FOMW-col-lg-push-12{left:100%}.IdWzr-col-lg-push-11{left:91.66666667%}._3qw3b-col-lg-push-10{left:83.33333333%}._3LXj0-col-lg-push-9{left:75%}._16qiV-col-lg-push-8{left:66.66666667%}._2OQk6-col-lg-push-7{left:58.33333333%}._3o1se-col-lg-push-6{left:50%}._2dmyK-col-lg-push-5{left:41.66666667%}._3pru9-col-lg-push-4{left:33.33333333%}._2lBUY-col-lg-push-3{left:25%}._3FuDW-col-lg-push-2{left:16.66666667%}._2YWH--col-lg-push-1{left:8.33333333%}._1iVNw-col-lg-push-0{left:auto}.uQRYD-col-lg-offset-12{margin-left:100%}._1tWxb-col-lg-offset-11{margin-left:91.66666667%}._10I1r-col-lg-offset-10{margin-left:83.33333333%}._3zXRb-col-lg-offset-9{margin-left:75%}._3HNmx-col-lg-offset-8{margin-left:66.66666667%}._3mIkn-col-lg-offset-7{margin-left:58.33333333%}._22SUB-col-lg-offset-6{margin-left:50%}._1CR4c-col-lg-offset-5{margin-left:41.66666667%}._1AWkt-col-lg-offset-4{margin-left:33.33333333%}._2BzzA-col-lg-offset-3{margin-left:25%}._3Egxi-col-lg-offset-2{margin-left:16.66666667%}._3QHjG-col-lg-offset-1{margin-left:8.33333333%}._3jTkc-col-lg-offset-0{margin-left:0}}table{background-color:transparent}caption{padding-top:8px;padding-bottom:8px;color:#777}caption,th{text-align:left}.fHH5l-table{width:100%;max-width:100%;margin-bottom:20px}.fHH5l-table>tbody>tr>td,.fHH5l-table>tbody>tr>th,.fHH5l-table>tfoot>tr>td,.fHH5l-table>tfoot>tr>th,.fHH5l-table>thead>tr>td,.fHH5l-table>thead>tr>th{padding:8px;line-height:1.42857143;vertical-align:top;border-top:1px solid #ddd}.fHH5l-table>thead>tr>th{vertical-align:bottom;border-bottom:2px solid #ddd}.fHH5l-table>caption+thead>tr:first-child>td,.fHH5l-table>caption+thead>tr:first-child>th,.fHH5l-table>colgroup+thead>tr:first-child>td,.fHH5l-table>colgroup+thead>tr:first-child>th,.fHH5l-table>thead:first-child>tr:first-child>td,.fHH5l-table>thead:first-child>tr:first-child>th{border-top:0}.fHH5l-table>tbody+tbody{border-top:2px solid #ddd}.fHH5l-table .fHH5l-table{background-color:#fff}._1lZ-G-table-condensed>tbody>tr>td,._1lZ-G-table-condensed>tbody>tr>th,._1lZ-G-table-condensed>tfoot>tr>td,._1lZ-G-table-condensed>tfoot>tr>th,._1lZ-G-table-condensed>thead>tr>td,._1lZ-G-table-condensed>thead>tr>th{padding:5px}._3qUkR-table-bordered,._3qUkR-table-bordered>tbody>tr>td,._3qUkR-table-bordered>tbody>tr>th,._3qUkR-table-bordered>tfoot>tr>td,._3qUkR-table-bordered>tfoot>tr>th,._3qUkR-table-bordered>thead>tr>td,._3qUkR-table-bordered>thead>tr>th{border:1px solid #ddd}._3qUkR-table-bordered>thead>tr>td,._3qUkR-table-bordered>thead>tr>th{border-bottom-width:2px}._2ysFO-table-striped>tbody>tr:nth-of-type(odd){background-color:#f9f9f9}._3Qd5Z-table-hover>tbody>tr:hover{background-color:#f5f5f5}table col[class*=col-]{position:static;float:none;display:table-column}table td[class*=col-],table th[class*=col-]{position:static;float:none;display:table-cell}.fHH5l-table>tbody>tr._2tvYY-active>td,.fHH5l-table>tbody>tr._2tvYY-active>th,.fHH5l-table>tbody>tr>td._2tvYY-active,.fHH5l-table>tbody>tr>th._2tvYY-active,.fHH5l-table>tfoot>tr._2tvYY-active>td,.fHH5l-table>tfoot>tr._2tvYY-active>th,.fHH5l-table>tfoot>tr>td._2tvYY-active,.fHH5l-table>tfoot>tr>th._2tvYY-active,.fHH5l-table>thead>tr._2tvYY-active>td,.fHH5l-table>thead>tr._2tvYY-active>th,.fHH5l-table>thead>tr>td._2tvYY-active,.fHH5l-table>thead>tr>th._2tvYY-active{background-color:#f5f5f5}._3Qd5Z-table-hover>tbody>tr._2tvYY-active:hover>td,._3Qd5Z-table-hover>tbody>tr._2tvYY-active:hover>th,._3Qd5Z-table-hover>tbody>tr:hover>._2tvYY-active,._3Qd5Z-table-hover>tbody>tr>td._2tvYY-active:hover,._3Qd5Z-table-hover>tbody>tr>th._2tvYY-active:hover{background-color:#e8e8e8}.fHH5l-table>tbody>tr._2OgG2-success>td,.fHH5l-table>tbody>tr._2OgG2-success>th,.fHH5l-table>tbody>tr>td._2OgG2-success,.fHH5l-table>tbody>tr>th._2OgG2-success,.fHH5l-table>tfoot>tr._2OgG2-success>td,.fHH5l-table>tfoot>tr._2OgG2-success>th,.fHH5l-table>tfoot>tr>td._2OgG2-success,.fHH5l-table>tfoot>tr>th._2OgG2-success,.fHH5l-table>thead>tr._2OgG2-success>td,.fHH5l-table>thead>tr._2OgG2-success>th,.fHH5l-table>thead>tr>td._2OgG2-success,.fHH5l-table>thead>tr>th._2OgG2-success{background-color:#dff0d8}._3Qd5Z-table-hover>tbody>tr._2OgG2-success:hover>td,._3Qd5Z-table-hover>tbody>tr._2OgG2-success:hover>th,._3Qd5Z-table-hover>tbody>tr:hover>._2OgG2-success,._3Qd5Z-table-hover>tbody>tr>td._2OgG2-success:hover,._3Qd5Z-table-hover>tbody>tr>th._2OgG2-success:hover{background-color:#d0e9c6}.fHH5l-table>tbody>tr._2ItI5-info>td,.fHH5l-table>tbody>tr._2ItI5-info>th,.fHH5l-table>tbody>tr>td._2ItI5-info,.fHH5l-table>tbody>tr>th._2ItI5-info,.fHH5l-table>tfoot>tr._2ItI5-info>td,.fHH5l-table>tfoot>tr._2ItI5-info>th,.fHH5l-table>tfoot>tr>td._2ItI5-info,.fHH5l-table>tfoot>tr>th._2ItI5-info,.fHH5l-table>thead>tr._2ItI5-info>td,.fHH5l-table>thead>tr._2ItI5-info>th,.fHH5l-table>thead>tr>td._2ItI5-info,.fHH5l-table>thead>tr>th._2ItI5-info{background-color:#d9edf7}._3Qd5Z-table-hover>tbody>tr._2ItI5-info:hover>td,._3Qd5Z-table-hover>tbody>tr._2ItI5-info:hover>th,._3Qd5Z-table-hover>tbody>tr:hover>._2ItI5-info,._3Qd5Z-table-hover>tbody>tr>td._2ItI5-info:hover,._3Qd5Z-table-hover>tbody>tr>th._2ItI5-info:hover{background-color:#c4e3f3}.fHH5l-table>tbody>tr.KrRM8-warning>td,.fHH5l-table>tbody>tr.KrRM8-warning>th,.fHH5l-table>tbody>tr>td.KrRM8-warning,.fHH5l-table>tbody>tr>th.KrRM8-warning,.fHH5l-table>tfoot>tr.KrRM8-warning>td,.fHH5l-table>tfoot>tr.KrRM8-warning>th,.fHH5l-table>tfoot>tr>td.KrRM8-warning,.fHH5l-table>tfoot>tr>th.KrRM8-warning,.fHH5l-table>thead>tr.KrRM8-warning>td,.fHH5l-table>thead>tr.KrRM8-warning>th,.fHH5l-table>thead>tr>td.KrRM8-warning,.fHH5l-table>thead>tr>th.KrRM8-warning{background-color:#fcf8e3}._3Qd5Z-table-hover>tbody>tr.KrRM8-warning:hover>td,._3Qd5Z-table-hover>tbody>tr.KrRM8-warning:hover>th,._3Qd5Z-table-hover>tbody>tr:hover>.KrRM8-warning,._3Qd5Z-table-hover>tbody>tr>td.KrRM8-warning:hover,._3Qd5Z-table-hover>tbody>tr>th.KrRM8-warning:hover{background-color:#faf2cc}.fHH5l-table>tbody>tr.CwCgN-danger>td,.fHH5l-table>tbody>tr.CwCgN-danger>th,.fHH5l-table>tbody>tr>td.CwCgN-danger,.fHH5l-table>tbody>tr>th.CwCgN-danger,.fHH5l-table>tfoot>tr.CwCgN-danger>td,.fHH5l-table>tfoot>tr.CwCgN-danger>th,.fHH5l-table>tfoot>tr>td.CwCgN-danger,.fHH5l-table>tfoot>tr>th.CwCgN-danger,.fHH5l-table>thead>tr.CwCgN-danger>td,.fHH5l-table>thead>tr.CwCgN-danger>th,.fHH5l-table>thead>tr>td.CwCgN-danger,.fHH5l-table>thead>tr>th.CwCgN-danger{background-color:#f2dede}._3Qd5Z-table-hover>tbody>tr.CwCgN-danger:hover>td,._3Qd5Z-table-hover>tbody>tr.CwCgN-danger:hover>th,._3Qd5Z-table-hover>tbody>tr:hover>.CwCgN-danger,._3Qd5Z-table-hover>tbody>tr>td.CwCgN-danger:hover,._3Qd5Z-table-hover>tbody>tr>th.CwCgN-danger:hover{background-color:#ebcccc}._3oGxj-table-responsive{overflow-x:auto;min-height:.01%}@media screen and (max-width:767px){._3oGxj-table-responsive{width:100%;margin-bottom:15px;overflow-y:hidden;-ms-overflow-style:-ms-autohiding-scrollbar;border:1px solid #ddd}._3oGxj-table-responsive>.fHH5l-table{margin-bottom:0}._3oGxj-table-responsive>.fHH5l-table>tbody>tr>td,._3oGxj-table-responsive>.fHH5l-table>tbody>tr>th,._3oGxj-table-responsive>.fHH5l-table>tfoot>tr>td,._3oGxj-table-responsive>.fHH5l-table>tfoot>tr>th,._3oGxj-table-responsive>.fHH5l-table>thead>tr>td,._3oGxj-table-responsive>.fHH5l-table>thead>tr>th{white-space:nowrap}._3oGxj-table-responsive>._3qUkR-table-bordered{border:0}._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>td:first-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>th:first-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>td:first-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>th:first-child,._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>td:first-child,._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>th:first-child{border-left:0}._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>td:last-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>th:last-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>td:last-child,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>th:last-child,._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>td:last-child,._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>th:last-child{border-right:0}._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:last-child>td,._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:last-child>th,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr:last-child>td,._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr:last-child>th{border-bottom:0}}._3-XUl-btn{display:inline-block;margin-bottom:0;font-weight:400;text-align:center;vertical-align:middle;-ms-touch-action:manipulation;touch-action:manipulation;cursor:pointer;background-image:none;border:1px solid transparent;white-space:nowrap;padding:6px 12px;font-size:14px;line-height:1.42857143;border-radius:4px;-webkit-user-select:none;-moz-user-select:none;-ms-user-select:none;user-select:none}._3-XUl-btn._2eLCD-focus,._3-XUl-btn._2tvYY-active._2eLCD-focus,._3-XUl-btn._2tvYY-active:focus,._3-XUl-btn:active._2eLCD-focus,._3-XUl-btn:active:focus,._3-XUl-btn:focus{outline:thin dotted;outline:5px auto -webkit-focus-ring-color;outline-offset:-2px}._3-XUl-btn._2eLCD-focus,._3-XUl-btn:focus,._3-XUl-btn:hover{color:#333;text-decoration:none}._3-XUl-btn._2tvYY-active,._3-XUl-btn:active{outline:0;background-image:none;-webkit-box-shadow:inset 0 3px 5px rgba(0,0,0,.125);box-shadow:inset 0 3px 5px rgba(0,0,0,.125)}._3-XUl-btn._2UPmc-disabled,._3-XUl-btn[disabled],fieldset[disabled] ._3-XUl-btn{cursor:not-allowed;opacity:.65;filter:alpha(opacity=65);-webkit-box-shadow:none;box-shadow:none}a._3-XUl-btn._2UPmc-disabled,fieldset[disabled] a._3-XUl-btn{pointer-events:none}._7-4ed-btn-default{color:#333;background-color:#fff;border-color:#ccc}._7-4ed-btn-default._2eLCD-focus,._7-4ed-btn-default:focus{color:#333;background-color:#e6e6e6;border-color:#8c8c8c}._7-4ed-btn-default._2tvYY-active,._7-4ed-btn-default:active,._7-4ed-btn-default:hover,.cl_nk-open>._2KnYE-dropdown-toggle._7-4ed-btn-default{color:#333;background-color:#e6e6e6;border-color:#adadad}._7-4ed-btn-default._2tvYY-active._2eLCD-focus,._7-4ed-btn-default._2tvYY-active:focus,._7-4ed-btn-default._2tvYY-active:hover,._7-4ed-btn-default:active._2eLCD-focus,._7-4ed-btn-default:active:focus,._7-4ed-btn-default:active:hover,.cl_nk-open>._2KnYE-dropdown-toggle._7-4ed-btn-default._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle._7-4ed-btn-default:focus,.cl_nk-open>._2KnYE-dropdown-toggle._7-4ed-btn-default:hover{color:#333;background-color:#d4d4d4;border-color:#8c8c8c}._7-4ed-btn-default._2tvYY-active,._7-4ed-btn-default:active,.cl_nk-open>._2KnYE-dropdown-toggle._7-4ed-btn-default{background-image:none}._7-4ed-btn-default._2UPmc-disabled._2eLCD-focus,._7-4ed-btn-default._2UPmc-disabled:focus,._7-4ed-btn-default._2UPmc-disabled:hover,._7-4ed-btn-default[disabled]._2eLCD-focus,._7-4ed-btn-default[disabled]:focus,._7-4ed-btn-default[disabled]:hover,fieldset[disabled] ._7-4ed-btn-default._2eLCD-focus,fieldset[disabled] ._7-4ed-btn-default:focus,fieldset[disabled] ._7-4ed-btn-default:hover{background-color:#fff;border-color:#ccc}._7-4ed-btn-default ._1XX3z-badge{color:#fff;background-color:#333}._1VbPL-btn-primary{color:#fff;background-color:#337ab7;border-color:#2e6da4}._1VbPL-btn-primary._2eLCD-focus,._1VbPL-btn-primary:focus{color:#fff;background-color:#286090;border-color:#122b40}._1VbPL-btn-primary._2tvYY-active,._1VbPL-btn-primary:active,._1VbPL-btn-primary:hover,.cl_nk-open>._2KnYE-dropdown-toggle._1VbPL-btn-primary{color:#fff;background-color:#286090;border-color:#204d74}._1VbPL-btn-primary._2tvYY-active._2eLCD-focus,._1VbPL-btn-primary._2tvYY-active:focus,._1VbPL-btn-primary._2tvYY-active:hover,._1VbPL-btn-primary:active._2eLCD-focus,._1VbPL-btn-primary:active:focus,._1VbPL-btn-primary:active:hover,.cl_nk-open>._2KnYE-dropdown-toggle._1VbPL-btn-primary._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle._1VbPL-btn-primary:focus,.cl_nk-open>._2KnYE-dropdown-toggle._1VbPL-btn-primary:hover{color:#fff;background-color:#204d74;border-color:#122b40}._1VbPL-btn-primary._2tvYY-active,._1VbPL-btn-primary:active,.cl_nk-open>._2KnYE-dropdown-toggle._1VbPL-btn-primary{background-image:none}._1VbPL-btn-primary._2UPmc-disabled._2eLCD-focus,._1VbPL-btn-primary._2UPmc-disabled:focus,._1VbPL-btn-primary._2UPmc-disabled:hover,._1VbPL-btn-primary[disabled]._2eLCD-focus,._1VbPL-btn-primary[disabled]:focus,._1VbPL-btn-primary[disabled]:hover,fieldset[disabled] ._1VbPL-btn-primary._2eLCD-focus,fieldset[disabled] ._1VbPL-btn-primary:focus,fieldset[disabled] ._1VbPL-btn-primary:hover{background-color:#337ab7;border-color:#2e6da4}._1VbPL-btn-primary ._1XX3z-badge{color:#337ab7;background-color:#fff}._1P0kt-btn-success{color:#fff;background-color:#5cb85c;border-color:#4cae4c}._1P0kt-btn-success._2eLCD-focus,._1P0kt-btn-success:focus{color:#fff;background-color:#449d44;border-color:#255625}._1P0kt-btn-success._2tvYY-active,._1P0kt-btn-success:active,._1P0kt-btn-success:hover,.cl_nk-open>._2KnYE-dropdown-toggle._1P0kt-btn-success{color:#fff;background-color:#449d44;border-color:#398439}._1P0kt-btn-success._2tvYY-active._2eLCD-focus,._1P0kt-btn-success._2tvYY-active:focus,._1P0kt-btn-success._2tvYY-active:hover,._1P0kt-btn-success:active._2eLCD-focus,._1P0kt-btn-success:active:focus,._1P0kt-btn-success:active:hover,.cl_nk-open>._2KnYE-dropdown-toggle._1P0kt-btn-success._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle._1P0kt-btn-success:focus,.cl_nk-open>._2KnYE-dropdown-toggle._1P0kt-btn-success:hover{color:#fff;background-color:#398439;border-color:#255625}._1P0kt-btn-success._2tvYY-active,._1P0kt-btn-success:active,.cl_nk-open>._2KnYE-dropdown-toggle._1P0kt-btn-success{background-image:none}._1P0kt-btn-success._2UPmc-disabled._2eLCD-focus,._1P0kt-btn-success._2UPmc-disabled:focus,._1P0kt-btn-success._2UPmc-disabled:hover,._1P0kt-btn-success[disabled]._2eLCD-focus,._1P0kt-btn-success[disabled]:focus,._1P0kt-btn-success[disabled]:hover,fieldset[disabled] ._1P0kt-btn-success._2eLCD-focus,fieldset[disabled] ._1P0kt-btn-success:focus,fieldset[disabled] ._1P0kt-btn-success:hover{background-color:#5cb85c;border-color:#4cae4c}._1P0kt-btn-success ._1XX3z-badge{color:#5cb85c;background-color:#fff}._25yw2-btn-info{color:#fff;background-color:#5bc0de;border-color:#46b8da}._25yw2-btn-info._2eLCD-focus,._25yw2-btn-info:focus{color:#fff;background-color:#31b0d5;border-color:#1b6d85}._25yw2-btn-info._2tvYY-active,._25yw2-btn-info:active,._25yw2-btn-info:hover,.cl_nk-open>._2KnYE-dropdown-toggle._25yw2-btn-info{color:#fff;background-color:#31b0d5;border-color:#269abc}._25yw2-btn-info._2tvYY-active._2eLCD-focus,._25yw2-btn-info._2tvYY-active:focus,._25yw2-btn-info._2tvYY-active:hover,._25yw2-btn-info:active._2eLCD-focus,._25yw2-btn-info:active:focus,._25yw2-btn-info:active:hover,.cl_nk-open>._2KnYE-dropdown-toggle._25yw2-btn-info._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle._25yw2-btn-info:focus,.cl_nk-open>._2KnYE-dropdown-toggle._25yw2-btn-info:hover{color:#fff;background-color:#269abc;border-color:#1b6d85}._25yw2-btn-info._2tvYY-active,._25yw2-btn-info:active,.cl_nk-open>._2KnYE-dropdown-toggle._25yw2-btn-info{background-image:none}._25yw2-btn-info._2UPmc-disabled._2eLCD-focus,._25yw2-btn-info._2UPmc-disabled:focus,._25yw2-btn-info._2UPmc-disabled:hover,._25yw2-btn-info[disabled]._2eLCD-focus,._25yw2-btn-info[disabled]:focus,._25yw2-btn-info[disabled]:hover,fieldset[disabled] ._25yw2-btn-info._2eLCD-focus,fieldset[disabled] ._25yw2-btn-info:focus,fieldset[disabled] ._25yw2-btn-info:hover{background-color:#5bc0de;border-color:#46b8da}._25yw2-btn-info ._1XX3z-badge{color:#5bc0de;background-color:#fff}.-tgFO-btn-warning{color:#fff;background-color:#f0ad4e;border-color:#eea236}.-tgFO-btn-warning._2eLCD-focus,.-tgFO-btn-warning:focus{color:#fff;background-color:#ec971f;border-color:#985f0d}.-tgFO-btn-warning._2tvYY-active,.-tgFO-btn-warning:active,.-tgFO-btn-warning:hover,.cl_nk-open>._2KnYE-dropdown-toggle.-tgFO-btn-warning{color:#fff;background-color:#ec971f;border-color:#d58512}.-tgFO-btn-warning._2tvYY-active._2eLCD-focus,.-tgFO-btn-warning._2tvYY-active:focus,.-tgFO-btn-warning._2tvYY-active:hover,.-tgFO-btn-warning:active._2eLCD-focus,.-tgFO-btn-warning:active:focus,.-tgFO-btn-warning:active:hover,.cl_nk-open>._2KnYE-dropdown-toggle.-tgFO-btn-warning._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle.-tgFO-btn-warning:focus,.cl_nk-open>._2KnYE-dropdown-toggle.-tgFO-btn-warning:hover{color:#fff;background-color:#d58512;border-color:#985f0d}.-tgFO-btn-warning._2tvYY-active,.-tgFO-btn-warning:active,.cl_nk-open>._2KnYE-dropdown-toggle.-tgFO-btn-warning{background-image:none}.-tgFO-btn-warning._2UPmc-disabled._2eLCD-focus,.-tgFO-btn-warning._2UPmc-disabled:focus,.-tgFO-btn-warning._2UPmc-disabled:hover,.-tgFO-btn-warning[disabled]._2eLCD-focus,.-tgFO-btn-warning[disabled]:focus,.-tgFO-btn-warning[disabled]:hover,fieldset[disabled] .-tgFO-btn-warning._2eLCD-focus,fieldset[disabled] .-tgFO-btn-warning:focus,fieldset[disabled] .-tgFO-btn-warning:hover{background-color:#f0ad4e;border-color:#eea236}.-tgFO-btn-warning ._1XX3z-badge{color:#f0ad4e;background-color:#fff}._1fOWb-btn-danger{color:#fff;background-color:#d9534f;border-color:#d43f3a}._1fOWb-btn-danger._2eLCD-focus,._1fOWb-btn-danger:focus{color:#fff;background-color:#c9302c;border-color:#761c19}._1fOWb-btn-danger._2tvYY-active,._1fOWb-btn-danger:active,._1fOWb-btn-danger:hover,.cl_nk-open>._2KnYE-dropdown-toggle._1fOWb-btn-danger{color:#fff;background-color:#c9302c;border-color:#ac2925}._1fOWb-btn-danger._2tvYY-active._2eLCD-focus,._1fOWb-btn-danger._2tvYY-active:focus,._1fOWb-btn-danger._2tvYY-active:hover,._1fOWb-btn-danger:active._2eLCD-focus,._1fOWb-btn-danger:active:focus,._1fOWb-btn-danger:active:hover,.cl_nk-open>._2KnYE-dropdown-toggle._1fOWb-btn-danger._2eLCD-focus,.cl_nk-open>._2KnYE-dropdown-toggle._1fOWb-btn-danger:focus,.cl_nk-open>._2KnYE-dropdown-toggle._1fOWb-btn-danger:hover{color:#fff;background-color:#ac2925;border-color:#761c19}._1fOWb-btn-danger._2tvYY-active,._1fOWb-btn-danger:active,.cl_nk-open>._2KnYE-dropdown-toggle._1fOWb-btn-danger{background-image:none}._1fOWb-btn-danger._2UPmc-disabled._2eLCD-focus,._1fOWb-btn-danger._2UPmc-disabled:focus,._1fOWb-btn-danger._2UPmc-disabled:hover,._1fOWb-btn-danger[disabled]._2eLCD-focus,._1fOWb-btn-danger[disabled]:focus,._1fOWb-btn-danger[disabled]:hover,fieldset[disabled] ._1fOWb-btn-danger._2eLCD-focus,fieldset[disabled] ._1fOWb-btn-danger:focus,fieldset[disabled] ._1fOWb-btn-danger:hover{background-color:#d9534f;border-color:#d43f3a}._1fOWb-btn-danger ._1XX3z-badge{color:#d9534f;background-color:#fff}._2k5vU-btn-link{color:#337ab7;font-weight:400;border-radius:0}._2k5vU-btn-link,._2k5vU-btn-link._2tvYY-active,._2k5vU-btn-link:active,._2k5vU-btn-link[disabled],fieldset[disabled] ._2k5vU-btn-link{background-color:transparent;-webkit-box-shadow:none;box-shadow:none}._2k5vU-btn-link,._2k5vU-btn-link:active,._2k5vU-btn-link:focus,._2k5vU-btn-link:hover{border-color:transparent}._2k5vU-btn-link:focus,._2k5vU-btn-link:hover{color:#23527c;text-decoration:underline;background-color:transparent}._2k5vU-btn-link[disabled]:focus,._2k5vU-btn-link[disabled]:hover,fieldset[disabled] ._2k5vU-btn-link:focus,fieldset[disabled] ._2k5vU-btn-link:hover{color:#777;text-decoration:none}._4Y8qI-btn-lg,._29CyS-btn-group-lg>._3-XUl-btn{padding:10px 16px;font-size:18px;line-height:1.3333333;border-radius:6px}._2eEOC-btn-group-sm>._3-XUl-btn,._30Oip-btn-sm{padding:5px 10px;font-size:12px;line-height:1.5;border-radius:3px}._1A2Tx-btn-group-xs>._3-XUl-btn,._2kCvE-btn-xs{padding:1px 5px;font-size:12px;line-height:1.5;border-radius:3px}._3micb-btn-block{display:block;width:100%}._3micb-btn-block+._3micb-btn-block{margin-top:5px}input[type=button]._3micb-btn-block,input[type=reset]._3micb-btn-block,input[type=submit]._3micb-btn-block{width:100%}._2yrMO-btn-group,.eNKyK-btn-group-vertical{position:relative;display:inline-block;vertical-align:middle}._2yrMO-btn-group>._3-XUl-btn,.eNKyK-btn-group-vertical>._3-XUl-btn{position:relative;float:left}._2yrMO-btn-group>._3-XUl-btn._2tvYY-active,._2yrMO-btn-group>._3-XUl-btn:active,._2yrMO-btn-group>._3-XUl-btn:focus,._2yrMO-btn-group>._3-XUl-btn:hover,.eNKyK-btn-group-vertical>._3-XUl-btn._2tvYY-active,.eNKyK-btn-group-vertical>._3-XUl-btn:active,.eNKyK-btn-group-vertical>._3-XUl-btn:focus,.eNKyK-btn-group-vertical>._3-XUl-btn:hover{z-index:2}._2yrMO-btn-group ._2yrMO-btn-group+._2yrMO-btn-group,._2yrMO-btn-group ._2yrMO-btn-group+._3-XUl-btn,._2yrMO-btn-group ._3-XUl-btn+._2yrMO-btn-group,._2yrMO-btn-group ._3-XUl-btn+._3-XUl-btn{margin-left:-1px}._1TX6t-btn-toolbar{margin-left:-5px}._1TX6t-btn-toolbar ._2lgpZ-input-group,._1TX6t-btn-toolbar ._2yrMO-btn-group,._1TX6t-btn-toolbar ._3-XUl-btn{float:left}._1TX6t-btn-toolbar>._2lgpZ-input-group,._1TX6t-btn-toolbar>._2yrMO-btn-group,._1TX6t-btn-toolbar>._3-XUl-btn{margin-left:5px}._2yrMO-btn-group>._3-XUl-btn:not(:first-child):not(:last-child):not(._2KnYE-dropdown-toggle){border-radius:0}._2yrMO-btn-group>._3-XUl-btn:first-child{margin-left:0}._2yrMO-btn-group>._3-XUl-btn:first-child:not(:last-child):not(._2KnYE-dropdown-toggle){border-bottom-right-radius:0;border-top-right-radius:0}._2yrMO-btn-group>._2KnYE-dropdown-toggle:not(:first-child),._2yrMO-btn-group>._3-XUl-btn:last-child:not(:first-child){border-bottom-left-radius:0;border-top-left-radius:0}._2yrMO-btn-group>._2yrMO-btn-group{float:left}._2yrMO-btn-group>._2yrMO-btn-group:not(:first-child):not(:last-child)>._3-XUl-btn{border-radius:0}._2yrMO-btn-group>._2yrMO-btn-group:first-child:not(:last-child)>._2KnYE-dropdown-toggle,._2yrMO-btn-group>._2yrMO-btn-group:first-child:not(:last-child)>._3-XUl-btn:last-child{border-bottom-right-radius:0;border-top-right-radius:0}._2yrMO-btn-group>._2yrMO-btn-group:last-child:not(:first-child)>._3-XUl-btn:first-child{border-bottom-left-radius:0;border-top-left-radius:0}._2yrMO-btn-group ._2KnYE-dropdown-toggle:active,._2yrMO-btn-group.cl_nk-open ._2KnYE-dropdown-toggle{outline:0}._2yrMO-btn-group>._3-XUl-btn+._2KnYE-dropdown-toggle{padding-left:8px;padding-right:8px}._2yrMO-btn-group>._4Y8qI-btn-lg+._2KnYE-dropdown-toggle{padding-left:12px;padding-right:12px}._2yrMO-btn-group.cl_nk-open ._2KnYE-dropdown-toggle{-webkit-box-shadow:inset 0 3px 5px rgba(0,0,0,.125);box-shadow:inset 0 3px 5px rgba(0,0,0,.125)}._2yrMO-btn-group.cl_nk-open ._2KnYE-dropdown-toggle._2k5vU-btn-link{-webkit-box-shadow:none;box-shadow:none}._3-XUl-btn .WfXRY-caret{margin-left:0}._4Y8qI-btn-lg .WfXRY-caret{border-width:5px 5px 0;border-bottom-width:0}.xQM4X-dropup ._4Y8qI-btn-lg .WfXRY-caret{border-width:0 5px 5px}.eNKyK-btn-group-vertical>._2yrMO-btn-group,.eNKyK-btn-group-vertical>._2yrMO-btn-group>._3-XUl-btn,.eNKyK-btn-group-vertical>._3-XUl-btn{display:block;float:none;width:100%;max-width:100%}.eNKyK-btn-group-vertical>._2yrMO-btn-group>._3-XUl-btn{float:none}.eNKyK-btn-group-vertical>._2yrMO-btn-group+._2yrMO-btn-group,.eNKyK-btn-group-vertical>._2yrMO-btn-group+._3-XUl-btn,.eNKyK-btn-group-vertical>._3-XUl-btn+._2yrMO-btn-group,.eNKyK-btn-group-vertical>._3-XUl-btn+._3-XUl-btn{margin-top:-1px;margin-left:0}.eNKyK-btn-group-vertical>._3-XUl-btn:not(:first-child):not(:last-child){border-radius:0}.eNKyK-btn-group-vertical>._3-XUl-btn:first-child:not(:last-child){border-top-right-radius:4px;border-top-left-radius:4px;border-bottom-right-radius:0;border-bottom-left-radius:0}.eNKyK-btn-group-vertical>._3-XUl-btn:last-child:not(:first-child){border-top-right-radius:0;border-top-left-radius:0;border-bottom-right-radius:4px;border-bottom-left-radius:4px}.eNKyK-btn-group-vertical>._2yrMO-btn-group:not(:first-child):not(:last-child)>._3-XUl-btn{border-radius:0}.eNKyK-btn-group-vertical>._2yrMO-btn-group:first-child:not(:last-child)>._2KnYE-dropdown-toggle,.eNKyK-btn-group-vertical>._2yrMO-btn-group:first-child:not(:last-child)>._3-XUl-btn:last-child{border-bottom-right-radius:0;border-bottom-left-radius:0}.eNKyK-btn-group-vertical>._2yrMO-btn-group:last-child:not(:first-child)>._3-XUl-btn:first-child{border-top-right-radius:0;border-top-left-radius:0}.gzM9c-btn-group-justified{display:table;width:100%;table-layout:fixed;border-collapse:separate}.gzM9c-btn-group-justified>._2yrMO-btn-group,.gzM9c-btn-group-justified>._3-XUl-btn{float:none;display:table-cell;width:1%}.gzM9c-btn-group-justified>._2yrMO-btn-group ._3-XUl-btn{width:100%}.gzM9c-btn-group-justified>._2yrMO-btn-group ._5aG9w-dropdown-menu{left:auto}[data-toggle=buttons]>._2yrMO-btn-group>._3-XUl-btn input[type=checkbox],[data-toggle=buttons]>._2yrMO-btn-group>._3-XUl-btn input[type=radio],[data-toggle=buttons]>._3-XUl-btn input[type=checkbox],[data-toggle=buttons]>._3-XUl-btn input[type=radio]{position:absolute;clip:rect(0,0,0,0);pointer-events:none}._18V3M-nav{margin-bottom:0;padding-left:0;list-style:none}._18V3M-nav>li,._18V3M-nav>li>a{position:relative;display:block}._18V3M-nav>li>a{padding:10px 15px}._18V3M-nav>li>a:focus,._18V3M-nav>li>a:hover{text-decoration:none;background-color:#eee}._18V3M-nav>li._2UPmc-disabled>a{color:#777}._18V3M-nav>li._2UPmc-disabled>a:focus,._18V3M-nav>li._2UPmc-disabled>a:hover{color:#777;text-decoration:none;background-color:transparent;cursor:not-allowed}._18V3M-nav .cl_nk-open>a,._18V3M-nav .cl_nk-open>a:focus,._18V3M-nav .cl_nk-open>a:hover{background-color:#eee;border-color:#337ab7}._18V3M-nav ._3jwhe-nav-divider{height:1px;margin:9px 0;overflow:hidden;background-color:#e5e5e5}._18V3M-nav>li>a>img{max-width:none}._2ciYP-nav-tabs{border-bottom:1px solid #ddd}._2ciYP-nav-tabs>li{float:left;margin-bottom:-1px}._2ciYP-nav-tabs>li>a{margin-right:2px;line-height:1.42857143;border:1px solid transparent;border-radius:4px 4px 0 0}._2ciYP-nav-tabs>li>a:hover{border-color:#eee #eee #ddd}._2ciYP-nav-tabs>li._2tvYY-active>a,._2ciYP-nav-tabs>li._2tvYY-active>a:focus,._2ciYP-nav-tabs>li._2tvYY-active>a:hover{color:#555;background-color:#fff;border:1px solid #ddd;border-bottom-color:transparent;cursor:default}._2ciYP-nav-tabs._3nLJA-nav-justified{width:100%;border-bottom:0}._2ciYP-nav-tabs._3nLJA-nav-justified>li{float:none}._2ciYP-nav-tabs._3nLJA-nav-justified>li>a{text-align:center;margin-bottom:5px}._2ciYP-nav-tabs._3nLJA-nav-justified>._3oGVD-dropdown ._5aG9w-dropdown-menu{top:auto;left:auto}@media (min-width:768px){._2ciYP-nav-tabs._3nLJA-nav-justified>li{display:table-cell;width:1%}._2ciYP-nav-tabs._3nLJA-nav-justified>li>a{margin-bottom:0}}._2ciYP-nav-tabs._3nLJA-nav-justified>li>a{margin-right:0;border-radius:4px}._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a,._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a:focus,._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a:hover{border:1px solid #ddd}@media (min-width:768px){._2ciYP-nav-tabs._3nLJA-nav-justified>li>a{border-bottom:1px solid #ddd;border-radius:4px 4px 0 0}._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a,._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a:focus,._2ciYP-nav-tabs._3nLJA-nav-justified>._2tvYY-active>a:hover{border-bottom-color:#fff}}._3i5kW-nav-pills>li{float:left}._3i5kW-nav-pills>li>a{border-radius:4px}._3i5kW-nav-pills>li+li{margin-left:2px}._3i5kW-nav-pills>li._2tvYY-active>a,._3i5kW-nav-pills>li._2tvYY-active>a:focus,._3i5kW-nav-pills>li._2tvYY-active>a:hover{color:#fff;background-color:#337ab7}._3pfVZ-nav-stacked>li{float:none}._3pfVZ-nav-stacked>li+li{margin-top:2px;margin-left:0}._3nLJA-nav-justified{width:100%}._3nLJA-nav-justified>li{float:none}._3nLJA-nav-justified>li>a{text-align:center;margin-bottom:5px}._3nLJA-nav-justified>._3oGVD-dropdown ._5aG9w-dropdown-menu{top:auto;left:auto}@media (min-width:768px){._3nLJA-nav-justified>li{display:table-cell;width:1%}._3nLJA-nav-justified>li>a{margin-bottom:0}}._18sQL-nav-tabs-justified{border-bottom:0}._18sQL-nav-tabs-justified>li>a{margin-right:0;border-radius:4px}._18sQL-nav-tabs-justified>._2tvYY-active>a,._18sQL-nav-tabs-justified>._2tvYY-active>a:focus,._18sQL-nav-tabs-justified>._2tvYY-active>a:hover{border:1px solid #ddd}@media (min-width:768px){._18sQL-nav-tabs-justified>li>a{border-bottom:1px solid #ddd;border-radius:4px 4px 0 0}._18sQL-nav-tabs-justified>._2tvYY-active>a,._18sQL-nav-tabs-justified>._2tvYY-active>a:focus,._18sQL-nav-tabs-justified>._2tvYY-active>a:hover{border-bottom-color:#fff}}.FgbPD-tab-content>._2q1VF-tab-pane{display:none}.FgbPD-tab-content>._2tvYY-active{display:block}._2ciYP-nav-tabs ._5aG9w-dropdown-menu{margin-top:-1px;border-top-right-radius:0;border-top-left-radius:0}.n15Mn-pagination{display:inline-block;padding-left:0;margin:20px 0;border-radius:4px}.n15Mn-pagination>li{display:inline}.n15Mn-pagination>li>a,.n15Mn-pagination>li>span{position:relative;float:left;padding:6px 12px;line-height:1.42857143;text-decoration:none;color:#337ab7;background-color:#fff;border:1px solid #ddd;margin-left:-1px}.n15Mn-pagination>li:first-child>a,.n15Mn-pagination>li:first-child>span{margin-left:0;border-bottom-left-radius:4px;border-top-left-radius:4px}.n15Mn-pagination>li:last-child>a,.n15Mn-pagination>li:last-child>span{border-bottom-right-radius:4px;border-top-right-radius:4px}.n15Mn-pagination>li>a:focus,.n15Mn-pagination>li>a:hover,.n15Mn-pagination>li>span:focus,.n15Mn-pagination>li>span:hover{z-index:2;color:#23527c;background-color:#eee;border-color:#ddd}.n15Mn-pagination>._2tvYY-active>a,.n15Mn-pagination>._2tvYY-active>a:focus,.n15Mn-pagination>._2tvYY-active>a:hover,.n15Mn-pagination>._2tvYY-active>span,.n15Mn-pagination>._2tvYY-active>span:focus,.n15Mn-pagination>._2tvYY-active>span:hover{z-index:3;color:#fff;background-color:#337ab7;border-color:#337ab7;cursor:default}.n15Mn-pagination>._2UPmc-disabled>a,.n15Mn-pagination>._2UPmc-disabled>a:focus,.n15Mn-pagination>._2UPmc-disabled>a:hover,.n15Mn-pagination>._2UPmc-disabled>span,.n15Mn-pagination>._2UPmc-disabled>span:focus,.n15Mn-pagination>._2UPmc-disabled>span:hover{color:#777;background-color:#fff;border-color:#ddd;cursor:not-allowed}.L5I4g-pagination-lg>li>a,.L5I4g-pagination-lg>li>span{padding:10px 16px;font-size:18px;line-height:1.3333333}.L5I4g-pagination-lg>li:first-child>a,.L5I4g-pagination-lg>li:first-child>span{border-bottom-left-radius:6px;border-top-left-radius:6px}.L5I4g-pagination-lg>li:last-child>a,.L5I4g-pagination-lg>li:last-child>span{border-bottom-right-radius:6px;border-top-right-radius:6px}._3rY2q-pagination-sm>li>a,._3rY2q-pagination-sm>li>span{padding:5px 10px;font-size:12px;line-height:1.5}._3rY2q-pagination-sm>li:first-child>a,._3rY2q-pagination-sm>li:first-child>span{border-bottom-left-radius:3px;border-top-left-radius:3px}._3rY2q-pagination-sm>li:last-child>a,._3rY2q-pagination-sm>li:last-child>span{border-bottom-right-radius:3px;border-top-right-radius:3px}.bv_Mo-panel{margin-bottom:20px;background-color:#fff;border:1px solid transparent;border-radius:4px;-webkit-box-shadow:0 1px 1px rgba(0,0,0,.05);box-shadow:0 1px 1px rgba(0,0,0,.05)}._2wvLn-panel-body{padding:15px}._31mw8-panel-heading{padding:10px 15px;border-bottom:1px solid transparent;border-top-right-radius:3px;border-top-left-radius:3px}._31mw8-panel-heading>._3oGVD-dropdown ._2KnYE-dropdown-toggle{color:inherit}._112QQ-panel-title{margin-top:0;margin-bottom:0;font-size:16px;color:inherit}._112QQ-panel-title>._1xqEr-small,._112QQ-panel-title>._1xqEr-small>a,._112QQ-panel-title>a,._112QQ-panel-title>small,._112QQ-panel-title>small>a{color:inherit}._1qtb5-panel-footer{padding:10px 15px;background-color:#f5f5f5;border-top:1px solid #ddd;border-bottom-right-radius:3px;border-bottom-left-radius:3px}.bv_Mo-panel>._1vMKW-list-group,.bv_Mo-panel>._162Ti-panel-collapse>._1vMKW-list-group{margin-bottom:0}.bv_Mo-panel>._1vMKW-list-group .rhZLN-list-group-item,.bv_Mo-panel>._162Ti-panel-collapse>._1vMKW-list-group .rhZLN-list-group-item{border-width:1px 0;border-radius:0}.bv_Mo-panel>._1vMKW-list-group:first-child .rhZLN-list-group-item:first-child,.bv_Mo-panel>._162Ti-panel-collapse>._1vMKW-list-group:first-child .rhZLN-list-group-item:first-child{border-top:0;border-top-right-radius:3px;border-top-left-radius:3px}.bv_Mo-panel>._1vMKW-list-group:last-child .rhZLN-list-group-item:last-child,.bv_Mo-panel>._162Ti-panel-collapse>._1vMKW-list-group:last-child .rhZLN-list-group-item:last-child{border-bottom:0;border-bottom-right-radius:3px;border-bottom-left-radius:3px}.bv_Mo-panel>._31mw8-panel-heading+._162Ti-panel-collapse>._1vMKW-list-group .rhZLN-list-group-item:first-child{border-top-right-radius:0;border-top-left-radius:0}._1vMKW-list-group+._1qtb5-panel-footer,._31mw8-panel-heading+._1vMKW-list-group .rhZLN-list-group-item:first-child{border-top-width:0}.bv_Mo-panel>._3oGxj-table-responsive>.fHH5l-table,.bv_Mo-panel>._162Ti-panel-collapse>.fHH5l-table,.bv_Mo-panel>.fHH5l-table{margin-bottom:0}.bv_Mo-panel>._3oGxj-table-responsive>.fHH5l-table caption,.bv_Mo-panel>._162Ti-panel-collapse>.fHH5l-table caption,.bv_Mo-panel>.fHH5l-table caption{padding-left:15px;padding-right:15px}.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>tbody:first-child>tr:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>thead:first-child>tr:first-child,.bv_Mo-panel>.fHH5l-table:first-child,.bv_Mo-panel>.fHH5l-table:first-child>tbody:first-child>tr:first-child,.bv_Mo-panel>.fHH5l-table:first-child>thead:first-child>tr:first-child{border-top-right-radius:3px;border-top-left-radius:3px}.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>tbody:first-child>tr:first-child td:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>tbody:first-child>tr:first-child th:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>thead:first-child>tr:first-child td:first-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>thead:first-child>tr:first-child th:first-child,.bv_Mo-panel>.fHH5l-table:first-child>tbody:first-child>tr:first-child td:first-child,.bv_Mo-panel>.fHH5l-table:first-child>tbody:first-child>tr:first-child th:first-child,.bv_Mo-panel>.fHH5l-table:first-child>thead:first-child>tr:first-child td:first-child,.bv_Mo-panel>.fHH5l-table:first-child>thead:first-child>tr:first-child th:first-child{border-top-left-radius:3px}.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>tbody:first-child>tr:first-child td:last-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>tbody:first-child>tr:first-child th:last-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>thead:first-child>tr:first-child td:last-child,.bv_Mo-panel>._3oGxj-table-responsive:first-child>.fHH5l-table:first-child>thead:first-child>tr:first-child th:last-child,.bv_Mo-panel>.fHH5l-table:first-child>tbody:first-child>tr:first-child td:last-child,.bv_Mo-panel>.fHH5l-table:first-child>tbody:first-child>tr:first-child th:last-child,.bv_Mo-panel>.fHH5l-table:first-child>thead:first-child>tr:first-child td:last-child,.bv_Mo-panel>.fHH5l-table:first-child>thead:first-child>tr:first-child th:last-child{border-top-right-radius:3px}.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tbody:last-child>tr:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tfoot:last-child>tr:last-child,.bv_Mo-panel>.fHH5l-table:last-child,.bv_Mo-panel>.fHH5l-table:last-child>tbody:last-child>tr:last-child,.bv_Mo-panel>.fHH5l-table:last-child>tfoot:last-child>tr:last-child{border-bottom-right-radius:3px;border-bottom-left-radius:3px}.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tbody:last-child>tr:last-child td:first-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tbody:last-child>tr:last-child th:first-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tfoot:last-child>tr:last-child td:first-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tfoot:last-child>tr:last-child th:first-child,.bv_Mo-panel>.fHH5l-table:last-child>tbody:last-child>tr:last-child td:first-child,.bv_Mo-panel>.fHH5l-table:last-child>tbody:last-child>tr:last-child th:first-child,.bv_Mo-panel>.fHH5l-table:last-child>tfoot:last-child>tr:last-child td:first-child,.bv_Mo-panel>.fHH5l-table:last-child>tfoot:last-child>tr:last-child th:first-child{border-bottom-left-radius:3px}.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tbody:last-child>tr:last-child td:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tbody:last-child>tr:last-child th:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tfoot:last-child>tr:last-child td:last-child,.bv_Mo-panel>._3oGxj-table-responsive:last-child>.fHH5l-table:last-child>tfoot:last-child>tr:last-child th:last-child,.bv_Mo-panel>.fHH5l-table:last-child>tbody:last-child>tr:last-child td:last-child,.bv_Mo-panel>.fHH5l-table:last-child>tbody:last-child>tr:last-child th:last-child,.bv_Mo-panel>.fHH5l-table:last-child>tfoot:last-child>tr:last-child td:last-child,.bv_Mo-panel>.fHH5l-table:last-child>tfoot:last-child>tr:last-child th:last-child{border-bottom-right-radius:3px}.bv_Mo-panel>._2wvLn-panel-body+._3oGxj-table-responsive,.bv_Mo-panel>._2wvLn-panel-body+.fHH5l-table,.bv_Mo-panel>._3oGxj-table-responsive+._2wvLn-panel-body,.bv_Mo-panel>.fHH5l-table+._2wvLn-panel-body{border-top:1px solid #ddd}.bv_Mo-panel>.fHH5l-table>tbody:first-child>tr:first-child td,.bv_Mo-panel>.fHH5l-table>tbody:first-child>tr:first-child th{border-top:0}.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered,.bv_Mo-panel>._3qUkR-table-bordered{border:0}.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>td:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>th:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>td:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>th:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>td:first-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>th:first-child,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr>td:first-child,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr>th:first-child,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr>td:first-child,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr>th:first-child,.bv_Mo-panel>._3qUkR-table-bordered>thead>tr>td:first-child,.bv_Mo-panel>._3qUkR-table-bordered>thead>tr>th:first-child{border-left:0}.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>td:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr>th:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>td:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr>th:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>td:last-child,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr>th:last-child,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr>td:last-child,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr>th:last-child,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr>td:last-child,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr>th:last-child,.bv_Mo-panel>._3qUkR-table-bordered>thead>tr>td:last-child,.bv_Mo-panel>._3qUkR-table-bordered>thead>tr>th:last-child{border-right:0}.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:first-child>td,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:first-child>th,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:last-child>td,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tbody>tr:last-child>th,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr:last-child>td,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>tfoot>tr:last-child>th,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr:first-child>td,.bv_Mo-panel>._3oGxj-table-responsive>._3qUkR-table-bordered>thead>tr:first-child>th,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr:first-child>td,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr:first-child>th,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr:last-child>td,.bv_Mo-panel>._3qUkR-table-bordered>tbody>tr:last-child>th,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr:last-child>td,.bv_Mo-panel>._3qUkR-table-bordered>tfoot>tr:last-child>th,.bv_Mo-panel>._3qUkR-table-bordered>thead>tr:first-child>td,.bv_Mo-panel>._3qUkR-table-bordered>thead>tr:first-child>th{border-bottom:0}.bv_Mo-panel>._3oGxj-table-responsive{border:0;margin-bottom:0}._2V8WR-panel-group{margin-bottom:20px}._2V8WR-panel-group .bv_Mo-panel{margin-bottom:0;border-radius:4px}._2V8WR-panel-group .bv_Mo-panel+.bv_Mo-panel{margin-top:5px}._2V8WR-panel-group ._31mw8-panel-heading{border-bottom:0}._2V8WR-panel-group ._31mw8-panel-heading+._162Ti-panel-collapse>._1vMKW-list-group,._2V8WR-panel-group ._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top:1px solid #ddd}._2V8WR-panel-group ._1qtb5-panel-footer{border-top:0}._2V8WR-panel-group ._1qtb5-panel-footer+._162Ti-panel-collapse ._2wvLn-panel-body{border-bottom:1px solid #ddd}._2diFa-panel-default{border-color:#ddd}._2diFa-panel-default>._31mw8-panel-heading{color:#333;background-color:#f5f5f5;border-color:#ddd}._2diFa-panel-default>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#ddd}._2diFa-panel-default>._31mw8-panel-heading ._1XX3z-badge{color:#f5f5f5;background-color:#333}._2diFa-panel-default>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#ddd}._1I52t-panel-primary{border-color:#337ab7}._1I52t-panel-primary>._31mw8-panel-heading{color:#fff;background-color:#337ab7;border-color:#337ab7}._1I52t-panel-primary>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#337ab7}._1I52t-panel-primary>._31mw8-panel-heading ._1XX3z-badge{color:#337ab7;background-color:#fff}._1I52t-panel-primary>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#337ab7}._1mEg2-panel-success{border-color:#d6e9c6}._1mEg2-panel-success>._31mw8-panel-heading{color:#3c763d;background-color:#dff0d8;border-color:#d6e9c6}._1mEg2-panel-success>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#d6e9c6}._1mEg2-panel-success>._31mw8-panel-heading ._1XX3z-badge{color:#dff0d8;background-color:#3c763d}._1mEg2-panel-success>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#d6e9c6}._2rUCo-panel-info{border-color:#bce8f1}._2rUCo-panel-info>._31mw8-panel-heading{color:#31708f;background-color:#d9edf7;border-color:#bce8f1}._2rUCo-panel-info>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#bce8f1}._2rUCo-panel-info>._31mw8-panel-heading ._1XX3z-badge{color:#d9edf7;background-color:#31708f}._2rUCo-panel-info>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#bce8f1}._1ozkU-panel-warning{border-color:#faebcc}._1ozkU-panel-warning>._31mw8-panel-heading{color:#8a6d3b;background-color:#fcf8e3;border-color:#faebcc}._1ozkU-panel-warning>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#faebcc}._1ozkU-panel-warning>._31mw8-panel-heading ._1XX3z-badge{color:#fcf8e3;background-color:#8a6d3b}._1ozkU-panel-warning>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#faebcc}._3dZ2--panel-danger{border-color:#ebccd1}._3dZ2--panel-danger>._31mw8-panel-heading{color:#a94442;background-color:#f2dede;border-color:#ebccd1}._3dZ2--panel-danger>._31mw8-panel-heading+._162Ti-panel-collapse>._2wvLn-panel-body{border-top-color:#ebccd1}._3dZ2--panel-danger>._31mw8-panel-heading ._1XX3z-badge{color:#f2dede;background-color:#a94442}._3dZ2--panel-danger>._1qtb5-panel-footer+._162Ti-panel-collapse>._2wvLn-panel-body{border-bottom-color:#ebccd1}._1TgHS-container-fluid:after,._1TgHS-container-fluid:before,._1TX6t-btn-toolbar:after,._1TX6t-btn-toolbar:before,._2DZom-dl-horizontal dd:after,._2DZom-dl-horizontal dd:before,._2U5Vc-clearfix:after,._2U5Vc-clearfix:before,._2wvLn-panel-body:after,._2wvLn-panel-body:before,._3XjgV-row:after,._3XjgV-row:before,._18V3M-nav:after,._18V3M-nav:before,.eNKyK-btn-group-vertical>._2yrMO-btn-group:after,.eNKyK-btn-group-vertical>._2yrMO-btn-group:before,.GfVfY-container:after,.GfVfY-container:before{content:" ";display:table}._1TgHS-container-fluid:after,._1TX6t-btn-toolbar:after,._2DZom-dl-horizontal dd:after,._2U5Vc-clearfix:after,._2wvLn-panel-body:after,._3XjgV-row:after,._18V3M-nav:after,.eNKyK-btn-group-vertical>._2yrMO-btn-group:after,.GfVfY-container:after{clear:both}._1efU5-center-block{display:block;margin-left:auto;margin-right:auto}._1DwIR-pull-right{float:right!important}._3XXWK-pull-left{float:left!important}.mceZN-hide{display:none!important}._3CqPx-show{display:block!important}._2ZgjX-invisible{visibility:hidden}._1y8Wm-text-hide{font:0/0 a;color:transparent;text-shadow:none;background-color:transparent;border:0}._15mml-hidden{display:none!important}.-JzKj-affix{position:fixed}@-ms-viewport{width:device-width}._1Ad52-visible-lg-inline,._1b70N-visible-md-block,._1Dhak-visible-xs-block,._1IBCe-visible-sm-inline-block,._1MCNX-visible-md-inline-block,._1rjwZ-visible-xs-inline-block,._2cGpj-visible-sm-block,._2FNrX-visible-md-inline,._2hCmO-visible-lg-inline-block,._2NJ-T-visible-xs-inline,._3Pjmx-visible-xs,._3qTZ9-visible-md,._14OBx-visible-sm-inline,.tk8mW-visible-lg,.vFgce-visible-sm,.yzCgL-visible-lg-block{display:none!important}@media (max-width:767px){._3Pjmx-visible-xs{display:block!important}table._3Pjmx-visible-xs{display:table!important}tr._3Pjmx-visible-xs{display:table-row!important}td._3Pjmx-visible-xs,th._3Pjmx-visible-xs{display:table-cell!important}}@media (max-width:767px){._1Dhak-visible-xs-block{display:block!important}}@media (max-width:767px){._2NJ-T-visible-xs-inline{display:inline!important}}@media (max-width:767px){._1rjwZ-visible-xs-inline-block{display:inline-block!important}}@media (min-width:768px) and (max-width:991px){.vFgce-visible-sm{display:block!important}table.vFgce-visible-sm{display:table!important}tr.vFgce-visible-sm{display:table-row!important}td.vFgce-visible-sm,th.vFgce-visible-sm{display:table-cell!important}}@media (min-width:768px) and (max-width:991px){._2cGpj-visible-sm-block{display:block!important}}@media (min-width:768px) and (max-width:991px){._14OBx-visible-sm-inline{display:inline!important}}@media (min-width:768px) and (max-width:991px){._1IBCe-visible-sm-inline-block{display:inline-block!important}}@media (min-width:992px) and (max-width:1199px){._3qTZ9-visible-md{display:block!important}table._3qTZ9-visible-md{display:table!important}tr._3qTZ9-visible-md{display:table-row!important}td._3qTZ9-visible-md,th._3qTZ9-visible-md{display:table-cell!important}}@media (min-width:992px) and (max-width:1199px){._1b70N-visible-md-block{display:block!important}}@media (min-width:992px) and (max-width:1199px){._2FNrX-visible-md-inline{display:inline!important}}@media (min-width:992px) and (max-width:1199px){._1MCNX-visible-md-inline-block{display:inline-block!important}}@media (min-width:1200px){.tk8mW-visible-lg{display:block!important}table.tk8mW-visible-lg{display:table!important}tr.tk8mW-visible-lg{display:table-row!important}td.tk8mW-visible-lg,th.tk8mW-visible-lg{display:table-cell!important}}@media (min-width:1200px){.yzCgL-visible-lg-block{display:block!important}}@media (min-width:1200px){._1Ad52-visible-lg-inline{display:inline!important}}@media (min-width:1200px){._2hCmO-visible-lg-inline-block{display:inline-block!important}}@media (max-width:767px){._1qlNJ-hidden-xs{display:none!important}}@media (min-width:768px) and (max-width:991px){._1UcP8-hidden-sm{display:none!important}}@media (min-width:992px) and (max-width:1199px){.TILkE-hidden-md{display:none!important}}@media (min-width:1200px){._2wXaD-hidden-lg{display:none!important}}._3ADjb-visible-print{display:none!important}@media print{._3ADjb-visible-print{display:block!important}table._3ADjb-visible-print{display:table!important}tr._3ADjb-visible-print{display:table-row!important}td._3ADjb-visible-print,th._3ADjb-visible-print{display:table-cell!important}}._3gdWc-visible-print-block{display:none!important}@media print{._3gdWc-visible-print-block{display:block!important}}._2HCJf-visible-print-inline{display:none!important}@media print{._2HCJf-visible-print-inline{display:inline!important}}._2oyVc-visible-print-inline-block{display:none!important}@media print{._2oyVc-visible-print-inline-block{display:inline-block!important}}@media print{.xjc1G-hidden-print{display:none!important}}',""]),
-t.locals={navbar:"xz-aB-navbar",btn:"_3-XUl-btn",caret:"WfXRY-caret",dropup:"xQM4X-dropup",label:"_3gkGS-label",table:"fHH5l-table","table-bordered":"_3qUkR-table-bordered",tableBordered:"_3qUkR-table-bordered","img-responsive":"_2zRts-img-responsive",imgResponsive:"_2zRts-img-responsive","img-rounded":"_141wW-img-rounded",imgRounded:"_141wW-img-rounded","img-thumbnail":"_3mPVB-img-thumbnail",imgThumbnail:"_3mPVB-img-thumbnail","img-circle":"_1pGGm-img-circle",imgCircle:"_1pGGm-img-circle","sr-only":"_2pd-Z-sr-only",srOnly:"_2pd-Z-sr-only","sr-only-focusable":"_2oRwu-sr-only-focusable",srOnlyFocusable:"_2oRwu-sr-only-focusable",h1:"FcDB9-h1",h2:"_2RtE8-h2",h3:"_2Hz9Y-h3",h4:"_20UE0-h4",h5:"_3ig4X-h5",h6:"_1IWbi-h6",small:"_1xqEr-small",lead:"BToLm-lead",mark:"_1CUfl-mark","text-left":"_1dEhG-text-left",textLeft:"_1dEhG-text-left","text-right":"_13-RL-text-right",textRight:"_13-RL-text-right","text-center":"_12Qkk-text-center",textCenter:"_12Qkk-text-center","text-justify":"_2IBJo-text-justify",textJustify:"_2IBJo-text-justify","text-nowrap":"_2D83n-text-nowrap",textNowrap:"_2D83n-text-nowrap","text-lowercase":"_3pILW-text-lowercase",textLowercase:"_3pILW-text-lowercase","text-uppercase":"EHGdF-text-uppercase",textUppercase:"EHGdF-text-uppercase","text-capitalize":"NKPoz-text-capitalize",textCapitalize:"NKPoz-text-capitalize","text-muted":"TLMiz-text-muted",textMuted:"TLMiz-text-muted","text-primary":"m-Vt1-text-primary",textPrimary:"m-Vt1-text-primary","text-success":"_1gJQD-text-success",textSuccess:"_1gJQD-text-success","text-info":"_3nKGJ-text-info",textInfo:"_3nKGJ-text-info","text-warning":"_1L8Pc-text-warning",textWarning:"_1L8Pc-text-warning","text-danger":"_2Qeqx-text-danger",textDanger:"_2Qeqx-text-danger","bg-primary":"VG86S-bg-primary",bgPrimary:"VG86S-bg-primary","bg-success":"Fh09a-bg-success",bgSuccess:"Fh09a-bg-success","bg-info":"_3D-5o-bg-info",bgInfo:"_3D-5o-bg-info","bg-warning":"_23YNn-bg-warning",bgWarning:"_23YNn-bg-warning","bg-danger":"_1g67A-bg-danger",bgDanger:"_1g67A-bg-danger","page-header":"_3ayLh-page-header",pageHeader:"_3ayLh-page-header","list-unstyled":"_2tb6R-list-unstyled",listUnstyled:"_2tb6R-list-unstyled","list-inline":"_2aPiu-list-inline",listInline:"_2aPiu-list-inline","dl-horizontal":"_2DZom-dl-horizontal",dlHorizontal:"_2DZom-dl-horizontal",initialism:"_39JkD-initialism","blockquote-reverse":"_3OkIL-blockquote-reverse",blockquoteReverse:"_3OkIL-blockquote-reverse","pull-right":"_1DwIR-pull-right",pullRight:"_1DwIR-pull-right",container:"GfVfY-container","container-fluid":"_1TgHS-container-fluid",containerFluid:"_1TgHS-container-fluid",row:"_3XjgV-row","col-xs-1":"_4I1Yd-col-xs-1",colXs1:"_4I1Yd-col-xs-1","col-sm-1":"ZjKa3-col-sm-1",colSm1:"ZjKa3-col-sm-1","col-md-1":"_2qQXc-col-md-1",colMd1:"_2qQXc-col-md-1","col-lg-1":"_20ta3-col-lg-1",colLg1:"_20ta3-col-lg-1","col-xs-2":"_2Dnpy-col-xs-2",colXs2:"_2Dnpy-col-xs-2","col-sm-2":"_1i2ug-col-sm-2",colSm2:"_1i2ug-col-sm-2","col-md-2":"_2t37W-col-md-2",colMd2:"_2t37W-col-md-2","col-lg-2":"_2Hl3y-col-lg-2",colLg2:"_2Hl3y-col-lg-2","col-xs-3":"OXp8_-col-xs-3",colXs3:"OXp8_-col-xs-3","col-sm-3":"_2Cn5F-col-sm-3",colSm3:"_2Cn5F-col-sm-3","col-md-3":"Ab3eH-col-md-3",colMd3:"Ab3eH-col-md-3","col-lg-3":"_26GsY-col-lg-3",colLg3:"_26GsY-col-lg-3","col-xs-4":"_2RAtz-col-xs-4",colXs4:"_2RAtz-col-xs-4","col-sm-4":"fa0Ir-col-sm-4",colSm4:"fa0Ir-col-sm-4","col-md-4":"hp0Eu-col-md-4",colMd4:"hp0Eu-col-md-4","col-lg-4":"_17v9q-col-lg-4",colLg4:"_17v9q-col-lg-4","col-xs-5":"_2Y3wR-col-xs-5",colXs5:"_2Y3wR-col-xs-5","col-sm-5":"_3h_BG-col-sm-5",colSm5:"_3h_BG-col-sm-5","col-md-5":"s-Mk2-col-md-5",colMd5:"s-Mk2-col-md-5","col-lg-5":"_3poT2-col-lg-5",colLg5:"_3poT2-col-lg-5","col-xs-6":"_2LLfi-col-xs-6",colXs6:"_2LLfi-col-xs-6","col-sm-6":"_2uvrS-col-sm-6",colSm6:"_2uvrS-col-sm-6","col-md-6":"_3aWb5-col-md-6",colMd6:"_3aWb5-col-md-6","col-lg-6":"_1kKt6-col-lg-6",colLg6:"_1kKt6-col-lg-6","col-xs-7":"_1lY1V-col-xs-7",colXs7:"_1lY1V-col-xs-7","col-sm-7":"_3MZfg-col-sm-7",colSm7:"_3MZfg-col-sm-7","col-md-7":"_3cJo6-col-md-7",colMd7:"_3cJo6-col-md-7","col-lg-7":"_3xzR0-col-lg-7",colLg7:"_3xzR0-col-lg-7","col-xs-8":"_2HxFx-col-xs-8",colXs8:"_2HxFx-col-xs-8","col-sm-8":"_2gidE-col-sm-8",colSm8:"_2gidE-col-sm-8","col-md-8":"_2PRaS-col-md-8",colMd8:"_2PRaS-col-md-8","col-lg-8":"LJ--s-col-lg-8",colLg8:"LJ--s-col-lg-8","col-xs-9":"_2oOfj-col-xs-9",colXs9:"_2oOfj-col-xs-9","col-sm-9":"_26BI4-col-sm-9",colSm9:"_26BI4-col-sm-9","col-md-9":"_2DhdG-col-md-9",colMd9:"_2DhdG-col-md-9","col-lg-9":"_3q6rI-col-lg-9",colLg9:"_3q6rI-col-lg-9","col-xs-10":"pmiBX-col-xs-10",colXs10:"pmiBX-col-xs-10","col-sm-10":"s5knd-col-sm-10",colSm10:"s5knd-col-sm-10","col-md-10":"_1oNCM-col-md-10",colMd10:"_1oNCM-col-md-10","col-lg-10":"_2DYWZ-col-lg-10",colLg10:"_2DYWZ-col-lg-10","col-xs-11":"yWnii-col-xs-11",colXs11:"yWnii-col-xs-11","col-sm-11":"liNUF-col-sm-11",colSm11:"liNUF-col-sm-11","col-md-11":"_2CmUe-col-md-11",colMd11:"_2CmUe-col-md-11","col-lg-11":"_1qjSD-col-lg-11",colLg11:"_1qjSD-col-lg-11","col-xs-12":"_2dgc--col-xs-12",colXs12:"_2dgc--col-xs-12","col-sm-12":"_3ylC5-col-sm-12",colSm12:"_3ylC5-col-sm-12","col-md-12":"_2DCWc-col-md-12",colMd12:"_2DCWc-col-md-12","col-lg-12":"_2FNrn-col-lg-12",colLg12:"_2FNrn-col-lg-12","col-xs-pull-12":"uk8LQ-col-xs-pull-12",colXsPull12:"uk8LQ-col-xs-pull-12","col-xs-pull-11":"nQP-X-col-xs-pull-11",colXsPull11:"nQP-X-col-xs-pull-11","col-xs-pull-10":"_3W2H_-col-xs-pull-10",colXsPull10:"_3W2H_-col-xs-pull-10","col-xs-pull-9":"_18qIh-col-xs-pull-9",colXsPull9:"_18qIh-col-xs-pull-9","col-xs-pull-8":"CEgpl-col-xs-pull-8",colXsPull8:"CEgpl-col-xs-pull-8","col-xs-pull-7":"_3UVMh-col-xs-pull-7",colXsPull7:"_3UVMh-col-xs-pull-7","col-xs-pull-6":"_1PEhv-col-xs-pull-6",colXsPull6:"_1PEhv-col-xs-pull-6","col-xs-pull-5":"VxFvW-col-xs-pull-5",colXsPull5:"VxFvW-col-xs-pull-5","col-xs-pull-4":"_3Wqpt-col-xs-pull-4",colXsPull4:"_3Wqpt-col-xs-pull-4","col-xs-pull-3":"_12Zed-col-xs-pull-3",colXsPull3:"_12Zed-col-xs-pull-3","col-xs-pull-2":"_3p-tQ-col-xs-pull-2",colXsPull2:"_3p-tQ-col-xs-pull-2","col-xs-pull-1":"RDEqX-col-xs-pull-1",colXsPull1:"RDEqX-col-xs-pull-1","col-xs-pull-0":"_2F1WQ-col-xs-pull-0",colXsPull0:"_2F1WQ-col-xs-pull-0","col-xs-push-12":"_1APvI-col-xs-push-12",colXsPush12:"_1APvI-col-xs-push-12","col-xs-push-11":"_3C73D-col-xs-push-11",colXsPush11:"_3C73D-col-xs-push-11","col-xs-push-10":"h56Hx-col-xs-push-10",colXsPush10:"h56Hx-col-xs-push-10","col-xs-push-9":"_1P93P-col-xs-push-9",colXsPush9:"_1P93P-col-xs-push-9","col-xs-push-8":"_2Nbww-col-xs-push-8",colXsPush8:"_2Nbww-col-xs-push-8","col-xs-push-7":"_1K3eA-col-xs-push-7",colXsPush7:"_1K3eA-col-xs-push-7","col-xs-push-6":"_2nBcT-col-xs-push-6",colXsPush6:"_2nBcT-col-xs-push-6","col-xs-push-5":"_3petP-col-xs-push-5",colXsPush5:"_3petP-col-xs-push-5","col-xs-push-4":"_2IqqW-col-xs-push-4",colXsPush4:"_2IqqW-col-xs-push-4","col-xs-push-3":"MCtu6-col-xs-push-3",colXsPush3:"MCtu6-col-xs-push-3","col-xs-push-2":"_3PMdr-col-xs-push-2",colXsPush2:"_3PMdr-col-xs-push-2","col-xs-push-1":"_1wU93-col-xs-push-1",colXsPush1:"_1wU93-col-xs-push-1","col-xs-push-0":"_1mQQp-col-xs-push-0",colXsPush0:"_1mQQp-col-xs-push-0","col-xs-offset-12":"_29EZj-col-xs-offset-12",colXsOffset12:"_29EZj-col-xs-offset-12","col-xs-offset-11":"_2hf-s-col-xs-offset-11",colXsOffset11:"_2hf-s-col-xs-offset-11","col-xs-offset-10":"PUiPu-col-xs-offset-10",colXsOffset10:"PUiPu-col-xs-offset-10","col-xs-offset-9":"_26WFR-col-xs-offset-9",colXsOffset9:"_26WFR-col-xs-offset-9","col-xs-offset-8":"_1cCHe-col-xs-offset-8",colXsOffset8:"_1cCHe-col-xs-offset-8","col-xs-offset-7":"_3XTt9-col-xs-offset-7",colXsOffset7:"_3XTt9-col-xs-offset-7","col-xs-offset-6":"_3ruq1-col-xs-offset-6",colXsOffset6:"_3ruq1-col-xs-offset-6","col-xs-offset-5":"_2Jvdw-col-xs-offset-5",colXsOffset5:"_2Jvdw-col-xs-offset-5","col-xs-offset-4":"_3Lc1D-col-xs-offset-4",colXsOffset4:"_3Lc1D-col-xs-offset-4","col-xs-offset-3":"_1MGVb-col-xs-offset-3",colXsOffset3:"_1MGVb-col-xs-offset-3","col-xs-offset-2":"_3VELV-col-xs-offset-2",colXsOffset2:"_3VELV-col-xs-offset-2","col-xs-offset-1":"_1qTH1-col-xs-offset-1",colXsOffset1:"_1qTH1-col-xs-offset-1","col-xs-offset-0":"_3p4mP-col-xs-offset-0",colXsOffset0:"_3p4mP-col-xs-offset-0","col-sm-pull-12":"_2lgaN-col-sm-pull-12",colSmPull12:"_2lgaN-col-sm-pull-12","col-sm-pull-11":"_9DE1R-col-sm-pull-11",colSmPull11:"_9DE1R-col-sm-pull-11","col-sm-pull-10":"kEzk--col-sm-pull-10",colSmPull10:"kEzk--col-sm-pull-10","col-sm-pull-9":"Nt2jd-col-sm-pull-9",colSmPull9:"Nt2jd-col-sm-pull-9","col-sm-pull-8":"_3swPC-col-sm-pull-8",colSmPull8:"_3swPC-col-sm-pull-8","col-sm-pull-7":"_3HVdW-col-sm-pull-7",colSmPull7:"_3HVdW-col-sm-pull-7","col-sm-pull-6":"wTEFa-col-sm-pull-6",colSmPull6:"wTEFa-col-sm-pull-6","col-sm-pull-5":"TLqCy-col-sm-pull-5",colSmPull5:"TLqCy-col-sm-pull-5","col-sm-pull-4":"_15_JC-col-sm-pull-4",colSmPull4:"_15_JC-col-sm-pull-4","col-sm-pull-3":"kKugK-col-sm-pull-3",colSmPull3:"kKugK-col-sm-pull-3","col-sm-pull-2":"_1gfwZ-col-sm-pull-2",colSmPull2:"_1gfwZ-col-sm-pull-2","col-sm-pull-1":"ULGBP-col-sm-pull-1",colSmPull1:"ULGBP-col-sm-pull-1","col-sm-pull-0":"_2761W-col-sm-pull-0",colSmPull0:"_2761W-col-sm-pull-0","col-sm-push-12":"vVqsv-col-sm-push-12",colSmPush12:"vVqsv-col-sm-push-12","col-sm-push-11":"_3rrzB-col-sm-push-11",colSmPush11:"_3rrzB-col-sm-push-11","col-sm-push-10":"_16iHZ-col-sm-push-10",colSmPush10:"_16iHZ-col-sm-push-10","col-sm-push-9":"_3gO4_-col-sm-push-9",colSmPush9:"_3gO4_-col-sm-push-9","col-sm-push-8":"_2iTaN-col-sm-push-8",colSmPush8:"_2iTaN-col-sm-push-8","col-sm-push-7":"_15MqT-col-sm-push-7",colSmPush7:"_15MqT-col-sm-push-7","col-sm-push-6":"_3WBCD-col-sm-push-6",colSmPush6:"_3WBCD-col-sm-push-6","col-sm-push-5":"_3yi6x-col-sm-push-5",colSmPush5:"_3yi6x-col-sm-push-5","col-sm-push-4":"Hi_tc-col-sm-push-4",colSmPush4:"Hi_tc-col-sm-push-4","col-sm-push-3":"_3CD2Y-col-sm-push-3",colSmPush3:"_3CD2Y-col-sm-push-3","col-sm-push-2":"_2KPVu-col-sm-push-2",colSmPush2:"_2KPVu-col-sm-push-2","col-sm-push-1":"_1vrte-col-sm-push-1",colSmPush1:"_1vrte-col-sm-push-1","col-sm-push-0":"_2iGSL-col-sm-push-0",colSmPush0:"_2iGSL-col-sm-push-0","col-sm-offset-12":"_2gBOF-col-sm-offset-12",colSmOffset12:"_2gBOF-col-sm-offset-12","col-sm-offset-11":"_1nkq--col-sm-offset-11",colSmOffset11:"_1nkq--col-sm-offset-11","col-sm-offset-10":"_3cbLF-col-sm-offset-10",colSmOffset10:"_3cbLF-col-sm-offset-10","col-sm-offset-9":"_1YqUk-col-sm-offset-9",colSmOffset9:"_1YqUk-col-sm-offset-9","col-sm-offset-8":"l5LT2-col-sm-offset-8",colSmOffset8:"l5LT2-col-sm-offset-8","col-sm-offset-7":"_1_lx0-col-sm-offset-7",colSmOffset7:"_1_lx0-col-sm-offset-7","col-sm-offset-6":"QftRx-col-sm-offset-6",colSmOffset6:"QftRx-col-sm-offset-6","col-sm-offset-5":"_1CiAA-col-sm-offset-5",colSmOffset5:"_1CiAA-col-sm-offset-5","col-sm-offset-4":"_3KOyM-col-sm-offset-4",colSmOffset4:"_3KOyM-col-sm-offset-4","col-sm-offset-3":"_3X8SS-col-sm-offset-3",colSmOffset3:"_3X8SS-col-sm-offset-3","col-sm-offset-2":"_2uxv1-col-sm-offset-2",colSmOffset2:"_2uxv1-col-sm-offset-2","col-sm-offset-1":"_3LDLJ-col-sm-offset-1",colSmOffset1:"_3LDLJ-col-sm-offset-1","col-sm-offset-0":"_2qEtK-col-sm-offset-0",colSmOffset0:"_2qEtK-col-sm-offset-0","col-md-pull-12":"bjQSr-col-md-pull-12",colMdPull12:"bjQSr-col-md-pull-12","col-md-pull-11":"_2R0gT-col-md-pull-11",colMdPull11:"_2R0gT-col-md-pull-11","col-md-pull-10":"_1ywn5-col-md-pull-10",colMdPull10:"_1ywn5-col-md-pull-10","col-md-pull-9":"Y3m-j-col-md-pull-9",colMdPull9:"Y3m-j-col-md-pull-9","col-md-pull-8":"_2kyk9-col-md-pull-8",colMdPull8:"_2kyk9-col-md-pull-8","col-md-pull-7":"dnrMG-col-md-pull-7",colMdPull7:"dnrMG-col-md-pull-7","col-md-pull-6":"_1zNYf-col-md-pull-6",colMdPull6:"_1zNYf-col-md-pull-6","col-md-pull-5":"_1lgxH-col-md-pull-5",colMdPull5:"_1lgxH-col-md-pull-5","col-md-pull-4":"fI_-S-col-md-pull-4",colMdPull4:"fI_-S-col-md-pull-4","col-md-pull-3":"_1yC1r-col-md-pull-3",colMdPull3:"_1yC1r-col-md-pull-3","col-md-pull-2":"_2ruK1-col-md-pull-2",colMdPull2:"_2ruK1-col-md-pull-2","col-md-pull-1":"_3pkxQ-col-md-pull-1",colMdPull1:"_3pkxQ-col-md-pull-1","col-md-pull-0":"_2NDft-col-md-pull-0",colMdPull0:"_2NDft-col-md-pull-0","col-md-push-12":"_2dklO-col-md-push-12",colMdPush12:"_2dklO-col-md-push-12","col-md-push-11":"_2O3TS-col-md-push-11",colMdPush11:"_2O3TS-col-md-push-11","col-md-push-10":"_3H7Y4-col-md-push-10",colMdPush10:"_3H7Y4-col-md-push-10","col-md-push-9":"_2PIS0-col-md-push-9",colMdPush9:"_2PIS0-col-md-push-9","col-md-push-8":"_1qQQi-col-md-push-8",colMdPush8:"_1qQQi-col-md-push-8","col-md-push-7":"Y8MRT-col-md-push-7",colMdPush7:"Y8MRT-col-md-push-7","col-md-push-6":"_3_TWQ-col-md-push-6",colMdPush6:"_3_TWQ-col-md-push-6","col-md-push-5":"_3KOrj-col-md-push-5",colMdPush5:"_3KOrj-col-md-push-5","col-md-push-4":"_2PuS8-col-md-push-4",colMdPush4:"_2PuS8-col-md-push-4","col-md-push-3":"_2zI-_-col-md-push-3",colMdPush3:"_2zI-_-col-md-push-3","col-md-push-2":"_3Drij-col-md-push-2",colMdPush2:"_3Drij-col-md-push-2","col-md-push-1":"_1M7-4-col-md-push-1",colMdPush1:"_1M7-4-col-md-push-1","col-md-push-0":"_1kPaw-col-md-push-0",colMdPush0:"_1kPaw-col-md-push-0","col-md-offset-12":"_3PwfG-col-md-offset-12",colMdOffset12:"_3PwfG-col-md-offset-12","col-md-offset-11":"sHbiw-col-md-offset-11",colMdOffset11:"sHbiw-col-md-offset-11","col-md-offset-10":"_3geq1-col-md-offset-10",colMdOffset10:"_3geq1-col-md-offset-10","col-md-offset-9":"_3ypJc-col-md-offset-9",colMdOffset9:"_3ypJc-col-md-offset-9","col-md-offset-8":"ydDuS-col-md-offset-8",colMdOffset8:"ydDuS-col-md-offset-8","col-md-offset-7":"_1MS3B-col-md-offset-7",colMdOffset7:"_1MS3B-col-md-offset-7","col-md-offset-6":"_1A80h-col-md-offset-6",colMdOffset6:"_1A80h-col-md-offset-6","col-md-offset-5":"_3g3p_-col-md-offset-5",colMdOffset5:"_3g3p_-col-md-offset-5","col-md-offset-4":"_2FjQc-col-md-offset-4",colMdOffset4:"_2FjQc-col-md-offset-4","col-md-offset-3":"Xebzn-col-md-offset-3",colMdOffset3:"Xebzn-col-md-offset-3","col-md-offset-2":"Xw9rm-col-md-offset-2",colMdOffset2:"Xw9rm-col-md-offset-2","col-md-offset-1":"CToHd-col-md-offset-1",colMdOffset1:"CToHd-col-md-offset-1","col-md-offset-0":"oXEcL-col-md-offset-0",colMdOffset0:"oXEcL-col-md-offset-0","col-lg-pull-12":"_32_we-col-lg-pull-12",colLgPull12:"_32_we-col-lg-pull-12","col-lg-pull-11":"_24eEw-col-lg-pull-11",colLgPull11:"_24eEw-col-lg-pull-11","col-lg-pull-10":"_29qaq-col-lg-pull-10",colLgPull10:"_29qaq-col-lg-pull-10","col-lg-pull-9":"_1S7Yo-col-lg-pull-9",colLgPull9:"_1S7Yo-col-lg-pull-9","col-lg-pull-8":"_2CgOB-col-lg-pull-8",colLgPull8:"_2CgOB-col-lg-pull-8","col-lg-pull-7":"_3Jv0L-col-lg-pull-7",colLgPull7:"_3Jv0L-col-lg-pull-7","col-lg-pull-6":"_1pHMz-col-lg-pull-6",colLgPull6:"_1pHMz-col-lg-pull-6","col-lg-pull-5":"_2zvsy-col-lg-pull-5",colLgPull5:"_2zvsy-col-lg-pull-5","col-lg-pull-4":"sFsmY-col-lg-pull-4",colLgPull4:"sFsmY-col-lg-pull-4","col-lg-pull-3":"Ui3Ia-col-lg-pull-3",colLgPull3:"Ui3Ia-col-lg-pull-3","col-lg-pull-2":"WmgXv-col-lg-pull-2",colLgPull2:"WmgXv-col-lg-pull-2","col-lg-pull-1":"_2KMEm-col-lg-pull-1",colLgPull1:"_2KMEm-col-lg-pull-1","col-lg-pull-0":"QWRTc-col-lg-pull-0",colLgPull0:"QWRTc-col-lg-pull-0","col-lg-push-12":"_2FOMW-col-lg-push-12",colLgPush12:"_2FOMW-col-lg-push-12","col-lg-push-11":"IdWzr-col-lg-push-11",colLgPush11:"IdWzr-col-lg-push-11","col-lg-push-10":"_3qw3b-col-lg-push-10",colLgPush10:"_3qw3b-col-lg-push-10","col-lg-push-9":"_3LXj0-col-lg-push-9",colLgPush9:"_3LXj0-col-lg-push-9","col-lg-push-8":"_16qiV-col-lg-push-8",colLgPush8:"_16qiV-col-lg-push-8","col-lg-push-7":"_2OQk6-col-lg-push-7",colLgPush7:"_2OQk6-col-lg-push-7","col-lg-push-6":"_3o1se-col-lg-push-6",colLgPush6:"_3o1se-col-lg-push-6","col-lg-push-5":"_2dmyK-col-lg-push-5",colLgPush5:"_2dmyK-col-lg-push-5","col-lg-push-4":"_3pru9-col-lg-push-4",colLgPush4:"_3pru9-col-lg-push-4","col-lg-push-3":"_2lBUY-col-lg-push-3",colLgPush3:"_2lBUY-col-lg-push-3","col-lg-push-2":"_3FuDW-col-lg-push-2",colLgPush2:"_3FuDW-col-lg-push-2","col-lg-push-1":"_2YWH--col-lg-push-1",colLgPush1:"_2YWH--col-lg-push-1","col-lg-push-0":"_1iVNw-col-lg-push-0",colLgPush0:"_1iVNw-col-lg-push-0","col-lg-offset-12":"uQRYD-col-lg-offset-12",colLgOffset12:"uQRYD-col-lg-offset-12","col-lg-offset-11":"_1tWxb-col-lg-offset-11",colLgOffset11:"_1tWxb-col-lg-offset-11","col-lg-offset-10":"_10I1r-col-lg-offset-10",colLgOffset10:"_10I1r-col-lg-offset-10","col-lg-offset-9":"_3zXRb-col-lg-offset-9",colLgOffset9:"_3zXRb-col-lg-offset-9","col-lg-offset-8":"_3HNmx-col-lg-offset-8",colLgOffset8:"_3HNmx-col-lg-offset-8","col-lg-offset-7":"_3mIkn-col-lg-offset-7",colLgOffset7:"_3mIkn-col-lg-offset-7","col-lg-offset-6":"_22SUB-col-lg-offset-6",colLgOffset6:"_22SUB-col-lg-offset-6","col-lg-offset-5":"_1CR4c-col-lg-offset-5",colLgOffset5:"_1CR4c-col-lg-offset-5","col-lg-offset-4":"_1AWkt-col-lg-offset-4",colLgOffset4:"_1AWkt-col-lg-offset-4","col-lg-offset-3":"_2BzzA-col-lg-offset-3",colLgOffset3:"_2BzzA-col-lg-offset-3","col-lg-offset-2":"_3Egxi-col-lg-offset-2",colLgOffset2:"_3Egxi-col-lg-offset-2","col-lg-offset-1":"_3QHjG-col-lg-offset-1",colLgOffset1:"_3QHjG-col-lg-offset-1","col-lg-offset-0":"_3jTkc-col-lg-offset-0",colLgOffset0:"_3jTkc-col-lg-offset-0","table-condensed":"_1lZ-G-table-condensed",tableCondensed:"_1lZ-G-table-condensed","table-striped":"_2ysFO-table-striped",tableStriped:"_2ysFO-table-striped","table-hover":"_3Qd5Z-table-hover",tableHover:"_3Qd5Z-table-hover",active:"_2tvYY-active",success:"_2OgG2-success",info:"_2ItI5-info",warning:"KrRM8-warning",danger:"CwCgN-danger","table-responsive":"_3oGxj-table-responsive",tableResponsive:"_3oGxj-table-responsive",focus:"_2eLCD-focus",disabled:"_2UPmc-disabled","btn-default":"_7-4ed-btn-default",btnDefault:"_7-4ed-btn-default",open:"cl_nk-open","dropdown-toggle":"_2KnYE-dropdown-toggle",dropdownToggle:"_2KnYE-dropdown-toggle",badge:"_1XX3z-badge","btn-primary":"_1VbPL-btn-primary",btnPrimary:"_1VbPL-btn-primary","btn-success":"_1P0kt-btn-success",btnSuccess:"_1P0kt-btn-success","btn-info":"_25yw2-btn-info",btnInfo:"_25yw2-btn-info","btn-warning":"-tgFO-btn-warning",btnWarning:"-tgFO-btn-warning","btn-danger":"_1fOWb-btn-danger",btnDanger:"_1fOWb-btn-danger","btn-link":"_2k5vU-btn-link",btnLink:"_2k5vU-btn-link","btn-lg":"_4Y8qI-btn-lg",btnLg:"_4Y8qI-btn-lg","btn-group-lg":"_29CyS-btn-group-lg",btnGroupLg:"_29CyS-btn-group-lg","btn-sm":"_30Oip-btn-sm",btnSm:"_30Oip-btn-sm","btn-group-sm":"_2eEOC-btn-group-sm",btnGroupSm:"_2eEOC-btn-group-sm","btn-xs":"_2kCvE-btn-xs",btnXs:"_2kCvE-btn-xs","btn-group-xs":"_1A2Tx-btn-group-xs",btnGroupXs:"_1A2Tx-btn-group-xs","btn-block":"_3micb-btn-block",btnBlock:"_3micb-btn-block","btn-group":"_2yrMO-btn-group",btnGroup:"_2yrMO-btn-group","btn-group-vertical":"eNKyK-btn-group-vertical",btnGroupVertical:"eNKyK-btn-group-vertical","btn-toolbar":"_1TX6t-btn-toolbar",btnToolbar:"_1TX6t-btn-toolbar","input-group":"_2lgpZ-input-group",inputGroup:"_2lgpZ-input-group","btn-group-justified":"gzM9c-btn-group-justified",btnGroupJustified:"gzM9c-btn-group-justified","dropdown-menu":"_5aG9w-dropdown-menu",dropdownMenu:"_5aG9w-dropdown-menu",nav:"_18V3M-nav","nav-divider":"_3jwhe-nav-divider",navDivider:"_3jwhe-nav-divider","nav-tabs":"_2ciYP-nav-tabs",navTabs:"_2ciYP-nav-tabs","nav-justified":"_3nLJA-nav-justified",navJustified:"_3nLJA-nav-justified",dropdown:"_3oGVD-dropdown","nav-pills":"_3i5kW-nav-pills",navPills:"_3i5kW-nav-pills","nav-stacked":"_3pfVZ-nav-stacked",navStacked:"_3pfVZ-nav-stacked","nav-tabs-justified":"_18sQL-nav-tabs-justified",navTabsJustified:"_18sQL-nav-tabs-justified","tab-content":"FgbPD-tab-content",tabContent:"FgbPD-tab-content","tab-pane":"_2q1VF-tab-pane",tabPane:"_2q1VF-tab-pane",pagination:"n15Mn-pagination","pagination-lg":"L5I4g-pagination-lg",paginationLg:"L5I4g-pagination-lg","pagination-sm":"_3rY2q-pagination-sm",paginationSm:"_3rY2q-pagination-sm",panel:"bv_Mo-panel","panel-body":"_2wvLn-panel-body",panelBody:"_2wvLn-panel-body","panel-heading":"_31mw8-panel-heading",panelHeading:"_31mw8-panel-heading","panel-title":"_112QQ-panel-title",panelTitle:"_112QQ-panel-title","panel-footer":"_1qtb5-panel-footer",panelFooter:"_1qtb5-panel-footer","list-group":"_1vMKW-list-group",listGroup:"_1vMKW-list-group","panel-collapse":"_162Ti-panel-collapse",panelCollapse:"_162Ti-panel-collapse","list-group-item":"rhZLN-list-group-item",listGroupItem:"rhZLN-list-group-item","panel-group":"_2V8WR-panel-group",panelGroup:"_2V8WR-panel-group","panel-default":"_2diFa-panel-default",panelDefault:"_2diFa-panel-default","panel-primary":"_1I52t-panel-primary",panelPrimary:"_1I52t-panel-primary","panel-success":"_1mEg2-panel-success",panelSuccess:"_1mEg2-panel-success","panel-info":"_2rUCo-panel-info",panelInfo:"_2rUCo-panel-info","panel-warning":"_1ozkU-panel-warning",panelWarning:"_1ozkU-panel-warning","panel-danger":"_3dZ2--panel-danger",panelDanger:"_3dZ2--panel-danger",clearfix:"_2U5Vc-clearfix","center-block":"_1efU5-center-block",centerBlock:"_1efU5-center-block","pull-left":"_3XXWK-pull-left",pullLeft:"_3XXWK-pull-left",hide:"mceZN-hide",show:"_3CqPx-show",invisible:"_2ZgjX-invisible","text-hide":"_1y8Wm-text-hide",textHide:"_1y8Wm-text-hide",hidden:"_15mml-hidden",affix:"-JzKj-affix","visible-xs":"_3Pjmx-visible-xs",visibleXs:"_3Pjmx-visible-xs","visible-sm":"vFgce-visible-sm",visibleSm:"vFgce-visible-sm","visible-md":"_3qTZ9-visible-md",visibleMd:"_3qTZ9-visible-md","visible-lg":"tk8mW-visible-lg",visibleLg:"tk8mW-visible-lg","visible-xs-block":"_1Dhak-visible-xs-block",visibleXsBlock:"_1Dhak-visible-xs-block","visible-xs-inline":"_2NJ-T-visible-xs-inline",visibleXsInline:"_2NJ-T-visible-xs-inline","visible-xs-inline-block":"_1rjwZ-visible-xs-inline-block",visibleXsInlineBlock:"_1rjwZ-visible-xs-inline-block","visible-sm-block":"_2cGpj-visible-sm-block",visibleSmBlock:"_2cGpj-visible-sm-block","visible-sm-inline":"_14OBx-visible-sm-inline",visibleSmInline:"_14OBx-visible-sm-inline","visible-sm-inline-block":"_1IBCe-visible-sm-inline-block",visibleSmInlineBlock:"_1IBCe-visible-sm-inline-block","visible-md-block":"_1b70N-visible-md-block",visibleMdBlock:"_1b70N-visible-md-block","visible-md-inline":"_2FNrX-visible-md-inline",visibleMdInline:"_2FNrX-visible-md-inline","visible-md-inline-block":"_1MCNX-visible-md-inline-block",visibleMdInlineBlock:"_1MCNX-visible-md-inline-block","visible-lg-block":"yzCgL-visible-lg-block",visibleLgBlock:"yzCgL-visible-lg-block","visible-lg-inline":"_1Ad52-visible-lg-inline",visibleLgInline:"_1Ad52-visible-lg-inline","visible-lg-inline-block":"_2hCmO-visible-lg-inline-block",visibleLgInlineBlock:"_2hCmO-visible-lg-inline-block","hidden-xs":"_1qlNJ-hidden-xs",hiddenXs:"_1qlNJ-hidden-xs","hidden-sm":"_1UcP8-hidden-sm",hiddenSm:"_1UcP8-hidden-sm","hidden-md":"TILkE-hidden-md",hiddenMd:"TILkE-hidden-md","hidden-lg":"_2wXaD-hidden-lg",hiddenLg:"_2wXaD-hidden-lg","visible-print":"_3ADjb-visible-print",visiblePrint:"_3ADjb-visible-print","visible-print-block":"_3gdWc-visible-print-block",visiblePrintBlock:"_3gdWc-visible-print-block","visible-print-inline":"_2HCJf-visible-print-inline",visiblePrintInline:"_2HCJf-visible-print-inline","visible-print-inline-block":"_2oyVc-visible-print-inline-block",visiblePrintInlineBlock:"_2oyVc-visible-print-inline-block","hidden-print":"xjc1G-hidden-print",hiddenPrint:"xjc1G-hidden-print"}},function(e,t){"use strict";Object.defineProperty(t,"__esModule",{value:!0}),t.default=function(){for(var e=arguments.length,t=Array(e),n=0;n<e;n++)t[n]=arguments[n];return t.join(" ")}},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),s=n(1),c=o(s),u=n(184),d=o(u),p=n(173),f=o(p),h=n(180),m=o(h),b=n(182),g=o(b),_=function(e){function t(){var e,n,o,a;r(this,t);for(var i=arguments.length,s=Array(i),c=0;c<i;c++)s[c]=arguments[c];return n=o=l(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(s))),o.state={selected:[],page:1,count:0,jobs:[]},a=n,l(o,a)}return a(t,e),i(t,[{key:"fetch",value:function(e){function t(){return e.apply(this,arguments)}return t.toString=function(){return e.toString()},t}(function(){var e=this;this.props.fetchURL&&fetch(this.props.fetchURL+"?page="+this.state.page).then(function(e){return e.json()}).then(function(t){e.setState({selected:[],count:t.count,jobs:t.jobs})})})},{key:"componentWillMount",value:function(){this.fetch()}},{key:"updatePage",value:function(e){this.setState({page:e},this.fetch)}},{key:"checked",value:function(e){return this.state.selected.includes(e)}},{key:"check",value:function(e){var t=this.state.selected.indexOf(e);t>=0?this.state.selected.splice(t,1):this.state.selected.push(e),this.setState({selected:this.state.selected})}},{key:"checkAll",value:function(){var e=this;this.state.selected.length>0?this.setState({selected:[]}):(this.state.jobs.map(function(t){e.state.selected.push(t)}),this.setState({selected:this.state.selected}))}},{key:"deleteAll",value:function(){var e=this;this.props.deleteAllURL&&fetch(this.props.deleteAllURL,{method:"post"}).then(function(){e.updatePage(1)})}},{key:"deleteSelected",value:function(){var e=this,t=[];this.state.selected.map(function(n){e.props.deleteURL&&t.push(fetch(e.props.deleteURL+"/"+n.died_at+"/"+n.id,{method:"post"}))}),Promise.all(t).then(function(){e.fetch()})}},{key:"retryAll",value:function(){var e=this;this.props.retryAllURL&&fetch(this.props.retryAllURL,{method:"post"}).then(function(){e.updatePage(1)})}},{key:"retrySelected",value:function(){var e=this,t=[];this.state.selected.map(function(n){e.props.retryURL&&t.push(fetch(e.props.retryURL+"/"+n.died_at+"/"+n.id,{method:"post"}))}),Promise.all(t).then(function(){e.fetch()})}},{key:"render",value:function(){var e=this;return c.default.createElement("div",null,c.default.createElement("div",{className:(0,g.default)(m.default.panel,m.default.panelDefault)},c.default.createElement("div",{className:m.default.panelHeading},"Dead Jobs"),c.default.createElement("div",{className:m.default.panelBody},c.default.createElement("p",null,this.state.count," job(s) are dead."),c.default.createElement(d.default,{page:this.state.page,totalCount:this.state.count,perPage:20,jumpTo:function(t){return function(){return e.updatePage(t)}}})),c.default.createElement("div",{className:m.default.tableResponsive},c.default.createElement("table",{className:m.default.table},c.default.createElement("tbody",null,c.default.createElement("tr",null,c.default.createElement("th",null,c.default.createElement("input",{type:"checkbox",checked:this.state.selected.length>0,onChange:function(){return e.checkAll()}})),c.default.createElement("th",null,"Name"),c.default.createElement("th",null,"Arguments"),c.default.createElement("th",null,"Error"),c.default.createElement("th",null,"Died At")),this.state.jobs.map(function(t){return c.default.createElement("tr",{key:t.id},c.default.createElement("td",null,c.default.createElement("input",{type:"checkbox",checked:e.checked(t),onChange:function(){return e.check(t)}})),c.default.createElement("td",null,t.name),c.default.createElement("td",null,JSON.stringify(t.args)),c.default.createElement("td",null,t.err),c.default.createElement("td",null,c.default.createElement(f.default,{ts:t.t})))}))))),c.default.createElement("div",{className:m.default.btnGroup,role:"group"},c.default.createElement("button",{type:"button",className:(0,g.default)(m.default.btn,m.default.btnDefault),onClick:function(){return e.deleteSelected()}},"Delete Selected Jobs"),c.default.createElement("button",{type:"button",className:(0,g.default)(m.default.btn,m.default.btnDefault),onClick:function(){return e.retrySelected()}},"Retry Selected Jobs"),c.default.createElement("button",{type:"button",className:(0,g.default)(m.default.btn,m.default.btnDefault),onClick:function(){return e.deleteAll()}},"Delete All Jobs"),c.default.createElement("button",{type:"button",className:(0,g.default)(m.default.btn,m.default.btnDefault),onClick:function(){return e.retryAll()}},"Retry All Jobs")))}}]),t}(c.default.Component);_.propTypes={fetchURL:c.default.PropTypes.string,deleteURL:c.default.PropTypes.string,deleteAllURL:c.default.PropTypes.string,retryURL:c.default.PropTypes.string,retryAllURL:c.default.PropTypes.string},t.default=_},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),s=n(1),c=o(s),u=n(180),d=o(u),p=function(e){function t(){return r(this,t),l(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return a(t,e),i(t,[{key:"shouldShow",value:function(e){return 1==e||e==this.totalPage||Math.abs(this.props.page-e)<=1}},{key:"render",value:function(){if(0==this.totalPage)return null;for(var e=[],t=1;t<=this.totalPage;t++)t==this.props.page?e.push(c.default.createElement("li",{key:t,className:d.default.active},c.default.createElement("span",null,t))):this.shouldShow(t)?e.push(c.default.createElement("li",{key:t},c.default.createElement("a",{onClick:this.props.jumpTo(t)},t))):this.shouldShow(t-1)&&e.push(c.default.createElement("li",{key:t,className:d.default.disabled},c.default.createElement("span",null,"..")));return c.default.createElement("ul",{className:d.default.pagination},e)}},{key:"totalPage",get:function(){return Math.ceil(this.props.totalCount/this.props.perPage)}}]),t}(c.default.Component);p.propTypes={page:c.default.PropTypes.number.isRequired,perPage:c.default.PropTypes.number.isRequired,totalCount:c.default.PropTypes.number.isRequired,jumpTo:c.default.PropTypes.func.isRequired},t.default=p},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){
-return n&&e(t.prototype,n),o&&e(t,o),t}}(),s=n(1),c=o(s),u=n(180),d=o(u),p=n(182),f=o(p),h=function(e){function t(){var e,n,o,a;r(this,t);for(var i=arguments.length,s=Array(i),c=0;c<i;c++)s[c]=arguments[c];return n=o=l(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(s))),o.state={queues:[]},a=n,l(o,a)}return a(t,e),i(t,[{key:"componentWillMount",value:function(){var e=this;this.props.url&&fetch(this.props.url).then(function(e){return e.json()}).then(function(t){e.setState({queues:t})})}},{key:"render",value:function(){return c.default.createElement("div",{className:(0,f.default)(d.default.panel,d.default.panelDefault)},c.default.createElement("div",{className:d.default.panelHeading},"queues"),c.default.createElement("div",{className:d.default.panelBody},c.default.createElement("p",null,this.state.queues.length," queue(s) with a total of ",this.queuedCount," item(s) queued.")),c.default.createElement("div",{className:d.default.tableResponsive},c.default.createElement("table",{className:d.default.table},c.default.createElement("tbody",null,c.default.createElement("tr",null,c.default.createElement("th",null,"Name"),c.default.createElement("th",null,"Count"),c.default.createElement("th",null,"Latency (seconds)")),this.state.queues.map(function(e){return c.default.createElement("tr",{key:e.job_name},c.default.createElement("td",null,e.job_name),c.default.createElement("td",null,e.count),c.default.createElement("td",null,e.latency))})))))}},{key:"queuedCount",get:function(){var e=0;return this.state.queues.map(function(t){e+=t.count}),e}}]),t}(c.default.Component);h.propTypes={url:c.default.PropTypes.string},t.default=h},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),s=n(1),c=o(s),u=n(184),d=o(u),p=n(173),f=o(p),h=n(180),m=o(h),b=n(182),g=o(b),_=function(e){function t(){var e,n,o,a;r(this,t);for(var i=arguments.length,s=Array(i),c=0;c<i;c++)s[c]=arguments[c];return n=o=l(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(s))),o.state={page:1,count:0,jobs:[]},a=n,l(o,a)}return a(t,e),i(t,[{key:"fetch",value:function(e){function t(){return e.apply(this,arguments)}return t.toString=function(){return e.toString()},t}(function(){var e=this;this.props.url&&fetch(this.props.url+"?page="+this.state.page).then(function(e){return e.json()}).then(function(t){e.setState({count:t.count,jobs:t.jobs})})})},{key:"componentWillMount",value:function(){this.fetch()}},{key:"updatePage",value:function(e){this.setState({page:e},this.fetch)}},{key:"render",value:function(){var e=this;return c.default.createElement("div",{className:(0,g.default)(m.default.panel,m.default.panelDefault)},c.default.createElement("div",{className:m.default.panelHeading},"Retry Jobs"),c.default.createElement("div",{className:m.default.panelBody},c.default.createElement("p",null,this.state.count," job(s) scheduled to be retried."),c.default.createElement(d.default,{page:this.state.page,totalCount:this.state.count,perPage:20,jumpTo:function(t){return function(){return e.updatePage(t)}}})),c.default.createElement("div",{className:m.default.tableResponsive},c.default.createElement("table",{className:m.default.table},c.default.createElement("tbody",null,c.default.createElement("tr",null,c.default.createElement("th",null,"Name"),c.default.createElement("th",null,"Arguments"),c.default.createElement("th",null,"Error"),c.default.createElement("th",null,"Retry At")),this.state.jobs.map(function(e){return c.default.createElement("tr",{key:e.id},c.default.createElement("td",null,e.name),c.default.createElement("td",null,JSON.stringify(e.args)),c.default.createElement("td",null,e.err),c.default.createElement("td",null,c.default.createElement(f.default,{ts:e.t})))})))))}}]),t}(c.default.Component);_.propTypes={url:c.default.PropTypes.string},t.default=_},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),s=n(1),c=o(s),u=n(184),d=o(u),p=n(173),f=o(p),h=n(180),m=o(h),b=n(182),g=o(b),_=function(e){function t(){var e,n,o,a;r(this,t);for(var i=arguments.length,s=Array(i),c=0;c<i;c++)s[c]=arguments[c];return n=o=l(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(s))),o.state={page:1,count:0,jobs:[]},a=n,l(o,a)}return a(t,e),i(t,[{key:"fetch",value:function(e){function t(){return e.apply(this,arguments)}return t.toString=function(){return e.toString()},t}(function(){var e=this;this.props.url&&fetch(this.props.url+"?page="+this.state.page).then(function(e){return e.json()}).then(function(t){e.setState({count:t.count,jobs:t.jobs})})})},{key:"componentWillMount",value:function(){this.fetch()}},{key:"updatePage",value:function(e){this.setState({page:e},this.fetch)}},{key:"render",value:function(){var e=this;return c.default.createElement("div",{className:(0,g.default)(m.default.panel,m.default.panelDefault)},c.default.createElement("div",{className:m.default.panelHeading},"Scheduled Jobs"),c.default.createElement("div",{className:m.default.panelBody},c.default.createElement("p",null,this.state.count," job(s) scheduled."),c.default.createElement(d.default,{page:this.state.page,totalCount:this.state.count,perPage:20,jumpTo:function(t){return function(){return e.updatePage(t)}}})),c.default.createElement("div",{className:m.default.tableResponsive},c.default.createElement("table",{className:m.default.table},c.default.createElement("tbody",null,c.default.createElement("tr",null,c.default.createElement("th",null,"Name"),c.default.createElement("th",null,"Arguments"),c.default.createElement("th",null,"Scheduled For")),this.state.jobs.map(function(e){return c.default.createElement("tr",{key:e.id},c.default.createElement("td",null,e.name),c.default.createElement("td",null,JSON.stringify(e.args)),c.default.createElement("td",null,c.default.createElement(f.default,{ts:e.t})))})))))}}]),t}(c.default.Component);_.propTypes={url:c.default.PropTypes.string},t.default=_},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0,t.createMemoryHistory=t.hashHistory=t.browserHistory=t.applyRouterMiddleware=t.formatPattern=t.useRouterHistory=t.match=t.routerShape=t.locationShape=t.PropTypes=t.RoutingContext=t.RouterContext=t.createRoutes=t.useRoutes=t.RouteContext=t.Lifecycle=t.History=t.Route=t.Redirect=t.IndexRoute=t.IndexRedirect=t.withRouter=t.IndexLink=t.Link=t.Router=void 0;var r=n(189);Object.defineProperty(t,"createRoutes",{enumerable:!0,get:function(){return r.createRoutes}});var l=n(190);Object.defineProperty(t,"locationShape",{enumerable:!0,get:function(){return l.locationShape}}),Object.defineProperty(t,"routerShape",{enumerable:!0,get:function(){return l.routerShape}});var a=n(195);Object.defineProperty(t,"formatPattern",{enumerable:!0,get:function(){return a.formatPattern}});var i=n(197),s=o(i),c=n(228),u=o(c),d=n(229),p=o(d),f=n(230),h=o(f),m=n(232),b=o(m),g=n(234),_=o(g),v=n(233),y=o(v),x=n(235),w=o(x),k=n(236),E=o(k),C=n(237),P=o(C),M=n(238),O=o(M),R=n(239),S=o(R),T=n(225),N=o(T),L=n(240),I=o(L),j=o(l),U=n(241),A=o(U),D=n(245),H=o(D),q=n(246),Y=o(q),F=n(247),W=o(F),V=n(250),X=o(V),B=n(242),K=o(B);t.Router=s.default,t.Link=u.default,t.IndexLink=p.default,t.withRouter=h.default,t.IndexRedirect=b.default,t.IndexRoute=_.default,t.Redirect=y.default,t.Route=w.default,t.History=E.default,t.Lifecycle=P.default,t.RouteContext=O.default,t.useRoutes=S.default,t.RouterContext=N.default,t.RoutingContext=I.default,t.PropTypes=j.default,t.match=A.default,t.useRouterHistory=H.default,t.applyRouterMiddleware=Y.default,t.browserHistory=W.default,t.hashHistory=X.default,t.createMemoryHistory=K.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){return null==e||p.default.isValidElement(e)}function l(e){return r(e)||Array.isArray(e)&&e.every(r)}function a(e,t){return u({},e,t)}function i(e){var t=e.type,n=a(t.defaultProps,e.props);if(n.children){var o=s(n.children,n);o.length&&(n.childRoutes=o),delete n.children}return n}function s(e,t){var n=[];return p.default.Children.forEach(e,function(e){if(p.default.isValidElement(e))if(e.type.createRouteFromReactElement){var o=e.type.createRouteFromReactElement(e,t);o&&n.push(o)}else n.push(i(e))}),n}function c(e){return l(e)?e=s(e):e&&!Array.isArray(e)&&(e=[e]),e}t.__esModule=!0;var u=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e};t.isReactChildren=l,t.createRouteFromReactElement=i,t.createRoutesFromReactChildren=s,t.createRoutes=c;var d=n(1),p=o(d)},function(e,t,n){"use strict";function o(e){if(e&&e.__esModule)return e;var t={};if(null!=e)for(var n in e)Object.prototype.hasOwnProperty.call(e,n)&&(t[n]=e[n]);return t.default=e,t}function r(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0,t.router=t.routes=t.route=t.components=t.component=t.location=t.history=t.falsy=t.locationShape=t.routerShape=void 0;var l=n(1),a=n(191),i=(r(a),n(194)),s=o(i),c=n(192),u=(r(c),l.PropTypes.func),d=l.PropTypes.object,p=l.PropTypes.shape,f=l.PropTypes.string,h=t.routerShape=p({push:u.isRequired,replace:u.isRequired,go:u.isRequired,goBack:u.isRequired,goForward:u.isRequired,setRouteLeaveHook:u.isRequired,isActive:u.isRequired}),m=t.locationShape=p({pathname:f.isRequired,search:f.isRequired,state:d,action:f.isRequired,key:f}),b=t.falsy=s.falsy,g=t.history=s.history,_=t.location=m,v=t.component=s.component,y=t.components=s.components,x=t.route=s.route,w=(t.routes=s.routes,t.router=h),k={falsy:b,history:g,location:_,component:v,components:y,route:x,router:w};t.default=k},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0,t.canUseMembrane=void 0;var r=n(192),l=(o(r),t.canUseMembrane=!1,function(e){return e});t.default=l},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(t.indexOf("deprecated")!==-1){if(s[t])return;s[t]=!0}t="[react-router] "+t;for(var n=arguments.length,o=Array(n>2?n-2:0),r=2;r<n;r++)o[r-2]=arguments[r];i.default.apply(void 0,[e,t].concat(o))}function l(){s={}}t.__esModule=!0,t.default=r,t._resetWarned=l;var a=n(193),i=o(a),s={}},function(e,t,n){"use strict";var o=function(){};e.exports=o},function(e,t,n){"use strict";function o(e,t,n){if(e[t])return new Error("<"+n+'> should not have a "'+t+'" prop')}t.__esModule=!0,t.routes=t.route=t.components=t.component=t.history=void 0,t.falsy=o;var r=n(1),l=r.PropTypes.func,a=r.PropTypes.object,i=r.PropTypes.arrayOf,s=r.PropTypes.oneOfType,c=r.PropTypes.element,u=r.PropTypes.shape,d=r.PropTypes.string,p=(t.history=u({listen:l.isRequired,push:l.isRequired,replace:l.isRequired,go:l.isRequired,goBack:l.isRequired,goForward:l.isRequired}),t.component=s([l,d])),f=(t.components=s([p,a]),t.route=s([a,c]));t.routes=s([f,i(f)])},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){return e.replace(/[.*+?^${}()|[\]\\]/g,"\\$&")}function l(e){for(var t="",n=[],o=[],l=void 0,a=0,i=/:([a-zA-Z_$][a-zA-Z0-9_$]*)|\*\*|\*|\(|\)/g;l=i.exec(e);)l.index!==a&&(o.push(e.slice(a,l.index)),t+=r(e.slice(a,l.index))),l[1]?(t+="([^/]+)",n.push(l[1])):"**"===l[0]?(t+="(.*)",n.push("splat")):"*"===l[0]?(t+="(.*?)",n.push("splat")):"("===l[0]?t+="(?:":")"===l[0]&&(t+=")?"),o.push(l[0]),a=i.lastIndex;return a!==e.length&&(o.push(e.slice(a,e.length)),t+=r(e.slice(a,e.length))),{pattern:e,regexpSource:t,paramNames:n,tokens:o}}function a(e){return f[e]||(f[e]=l(e)),f[e]}function i(e,t){"/"!==e.charAt(0)&&(e="/"+e);var n=a(e),o=n.regexpSource,r=n.paramNames,l=n.tokens;"/"!==e.charAt(e.length-1)&&(o+="/?"),"*"===l[l.length-1]&&(o+="$");var i=t.match(new RegExp("^"+o,"i"));if(null==i)return null;var s=i[0],c=t.substr(s.length);if(c){if("/"!==s.charAt(s.length-1))return null;c="/"+c}return{remainingPathname:c,paramNames:r,paramValues:i.slice(1).map(function(e){return e&&decodeURIComponent(e)})}}function s(e){return a(e).paramNames}function c(e,t){var n=i(e,t);if(!n)return null;var o=n.paramNames,r=n.paramValues,l={};return o.forEach(function(e,t){l[e]=r[t]}),l}function u(e,t){t=t||{};for(var n=a(e),o=n.tokens,r=0,l="",i=0,s=void 0,c=void 0,u=void 0,d=0,f=o.length;d<f;++d)s=o[d],"*"===s||"**"===s?(u=Array.isArray(t.splat)?t.splat[i++]:t.splat,null!=u||r>0?void 0:(0,p.default)(!1),null!=u&&(l+=encodeURI(u))):"("===s?r+=1:")"===s?r-=1:":"===s.charAt(0)?(c=s.substring(1),u=t[c],null!=u||r>0?void 0:(0,p.default)(!1),null!=u&&(l+=encodeURIComponent(u))):l+=s;return l.replace(/\/+/g,"/")}t.__esModule=!0,t.compilePattern=a,t.matchPattern=i,t.getParamNames=s,t.getParams=c,t.formatPattern=u;var d=n(196),p=o(d),f=Object.create(null)},function(e,t,n){"use strict";var o=function(e,t,n,o,r,l,a,i){if(!e){var s;if(void 0===t)s=new Error("Minified exception occurred; use the non-minified dev environment for the full error message and additional helpful warnings.");else{var c=[n,o,r,l,a,i],u=0;s=new Error(t.replace(/%s/g,function(){return c[u++]})),s.name="Invariant Violation"}throw s.framesToPop=1,s}};e.exports=o},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){var n={};for(var o in e)t.indexOf(o)>=0||Object.prototype.hasOwnProperty.call(e,o)&&(n[o]=e[o]);return n}function l(e){return!e||!e.__v2_compatible__}function a(e){return e&&e.getCurrentLocation}t.__esModule=!0;var i=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},s=n(198),c=o(s),u=n(214),d=o(u),p=n(196),f=o(p),h=n(1),m=o(h),b=n(217),g=o(b),_=n(194),v=n(225),y=o(v),x=n(189),w=n(227),k=n(192),E=(o(k),m.default.PropTypes),C=E.func,P=E.object,M=m.default.createClass({displayName:"Router",propTypes:{history:P,children:_.routes,routes:_.routes,render:C,createElement:C,onError:C,onUpdate:C,parseQueryString:C,stringifyQuery:C,matchContext:P},getDefaultProps:function(){return{render:function(e){return m.default.createElement(y.default,e)}}},getInitialState:function(){return{location:null,routes:null,params:null,components:null}},handleError:function(e){if(!this.props.onError)throw e;this.props.onError.call(this,e)},componentWillMount:function(){var e=this,t=this.props,n=(t.parseQueryString,t.stringifyQuery,this.createRouterObjects()),o=n.history,r=n.transitionManager,l=n.router;this._unlisten=r.listen(function(t,n){t?e.handleError(t):e.setState(n,e.props.onUpdate)}),this.history=o,this.router=l},createRouterObjects:function(){var e=this.props.matchContext;if(e)return e;var t=this.props.history,n=this.props,o=n.routes,r=n.children;a(t)?(0,f.default)(!1):void 0,l(t)&&(t=this.wrapDeprecatedHistory(t));var i=(0,g.default)(t,(0,x.createRoutes)(o||r)),s=(0,w.createRouterObject)(t,i),c=(0,w.createRoutingHistory)(t,i);return{history:c,transitionManager:i,router:s}},wrapDeprecatedHistory:function(e){var t=this.props,n=t.parseQueryString,o=t.stringifyQuery,r=void 0;return r=e?function(){return e}:c.default,(0,d.default)(r)({parseQueryString:n,stringifyQuery:o})},componentWillReceiveProps:function(e){},componentWillUnmount:function(){this._unlisten&&this._unlisten()},render:function e(){var t=this.state,n=t.location,o=t.routes,l=t.params,a=t.components,s=this.props,c=s.createElement,e=s.render,u=r(s,["createElement","render"]);return null==n?null:(Object.keys(M.propTypes).forEach(function(e){return delete u[e]}),e(i({},u,{history:this.history,router:this.router,location:n,routes:o,params:l,components:a,createElement:c})))}});t.default=M,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){return"string"==typeof e&&"/"===e.charAt(0)}function l(){var e=g.getHashPath();return!!r(e)||(g.replaceHashPath("/"+e),!1)}function a(e,t,n){return e+(e.indexOf("?")===-1?"?":"&")+(t+"="+n)}function i(e,t){return e.replace(new RegExp("[?&]?"+t+"=[a-zA-Z0-9]+"),"")}function s(e,t){var n=e.match(new RegExp("\\?.*?\\b"+t+"=(.+?)\\b"));return n&&n[1]}function c(){function e(){var e=g.getHashPath(),t=void 0,n=void 0;M?(t=s(e,M),e=i(e,M),t?n=_.readState(t):(n=null,t=O.createKey(),g.replaceHashPath(a(e,M,t)))):t=n=null;var o=m.parsePath(e);return O.createLocation(u({},o,{state:n}),void 0,t)}function t(t){function n(){l()&&o(e())}var o=t.transitionTo;return l(),g.addEventListener(window,"hashchange",n),function(){g.removeEventListener(window,"hashchange",n)}}function n(e){var t=e.basename,n=e.pathname,o=e.search,r=e.state,l=e.action,i=e.key;if(l!==h.POP){var s=(t||"")+n+o;M?(s=a(s,M,i),_.saveState(i,r)):e.key=e.state=null;var c=g.getHashPath();l===h.PUSH?c!==s&&(window.location.hash=s):c!==s&&g.replaceHashPath(s)}}function o(e){1===++R&&(S=t(O));var n=O.listenBefore(e);return function(){n(),0===--R&&S()}}function r(e){1===++R&&(S=t(O));var n=O.listen(e);return function(){n(),0===--R&&S()}}function c(e){O.push(e)}function d(e){O.replace(e)}function p(e){O.go(e)}function v(e){return"#"+O.createHref(e)}function w(e){1===++R&&(S=t(O)),O.registerTransitionHook(e)}function k(e){O.unregisterTransitionHook(e),0===--R&&S()}function E(e,t){O.pushState(e,t)}function C(e,t){O.replaceState(e,t)}var P=arguments.length<=0||void 0===arguments[0]?{}:arguments[0];b.canUseDOM?void 0:f.default(!1);var M=P.queryKey;(void 0===M||M)&&(M="string"==typeof M?M:x);var O=y.default(u({},P,{getCurrentLocation:e,finishTransition:n,saveState:_.saveState})),R=0,S=void 0;g.supportsGoWithoutReloadUsingHash();return u({},O,{listenBefore:o,listen:r,push:c,replace:d,go:p,createHref:v,registerTransitionHook:w,unregisterTransitionHook:k,pushState:E,replaceState:C})}t.__esModule=!0;var u=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},d=n(199),p=(o(d),n(196)),f=o(p),h=n(200),m=n(201),b=n(202),g=n(203),_=n(204),v=n(205),y=o(v),x="_k";t.default=c,e.exports=t.default},function(e,t,n){"use strict";var o=function(){};e.exports=o},function(e,t){"use strict";t.__esModule=!0;var n="PUSH";t.PUSH=n;var o="REPLACE";t.REPLACE=o;var r="POP";t.POP=r,t.default={PUSH:n,REPLACE:o,POP:r}},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){var t=e.match(/^https?:\/\/[^\/]*/);return null==t?e:e.substring(t[0].length)}function l(e){var t=r(e),n="",o="",l=t.indexOf("#");l!==-1&&(o=t.substring(l),t=t.substring(0,l));var a=t.indexOf("?");return a!==-1&&(n=t.substring(a),t=t.substring(0,a)),""===t&&(t="/"),{pathname:t,search:n,hash:o}}t.__esModule=!0,t.extractPath=r,t.parsePath=l;var a=n(199);o(a)},function(e,t){"use strict";t.__esModule=!0;var n=!("undefined"==typeof window||!window.document||!window.document.createElement);t.canUseDOM=n},function(e,t){"use strict";function n(e,t,n){e.addEventListener?e.addEventListener(t,n,!1):e.attachEvent("on"+t,n)}function o(e,t,n){e.removeEventListener?e.removeEventListener(t,n,!1):e.detachEvent("on"+t,n)}function r(){return window.location.href.split("#")[1]||""}function l(e){window.location.replace(window.location.pathname+window.location.search+"#"+e)}function a(){return window.location.pathname+window.location.search+window.location.hash}function i(e){e&&window.history.go(e)}function s(e,t){t(window.confirm(e))}function c(){var e=navigator.userAgent;return(e.indexOf("Android 2.")===-1&&e.indexOf("Android 4.0")===-1||e.indexOf("Mobile Safari")===-1||e.indexOf("Chrome")!==-1||e.indexOf("Windows Phone")!==-1)&&(window.history&&"pushState"in window.history)}function u(){var e=navigator.userAgent;return e.indexOf("Firefox")===-1}t.__esModule=!0,t.addEventListener=n,t.removeEventListener=o,t.getHashPath=r,t.replaceHashPath=l,t.getWindowPath=a,t.go=i,t.getUserConfirmation=s,t.supportsHistory=c,t.supportsGoWithoutReloadUsingHash=u},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){return s+e}function l(e,t){try{null==t?window.sessionStorage.removeItem(r(e)):window.sessionStorage.setItem(r(e),JSON.stringify(t))}catch(e){if(e.name===u)return;if(c.indexOf(e.name)>=0&&0===window.sessionStorage.length)return;throw e}}function a(e){var t=void 0;try{t=window.sessionStorage.getItem(r(e))}catch(e){if(e.name===u)return null}if(t)try{return JSON.parse(t)}catch(e){}return null}t.__esModule=!0,t.saveState=l,t.readState=a;var i=n(199),s=(o(i),"@@History/"),c=["QuotaExceededError","QUOTA_EXCEEDED_ERR"],u="SecurityError"},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){function t(e){return s.canUseDOM?void 0:i.default(!1),n.listen(e)}var n=d.default(l({getUserConfirmation:c.getUserConfirmation},e,{go:c.go}));return l({},n,{listen:t})}t.__esModule=!0;var l=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},a=n(196),i=o(a),s=n(202),c=n(203),u=n(206),d=o(u);t.default=r,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){return Math.random().toString(36).substr(2,e)}function l(e,t){return e.pathname===t.pathname&&e.search===t.search&&e.key===t.key&&u.default(e.state,t.state)}function a(){function e(e){return D.push(e),function(){D=D.filter(function(t){return t!==e})}}function t(){return F&&F.action===f.POP?H.indexOf(F.key):Y?H.indexOf(Y.key):-1}function n(e){var n=t();Y=e,Y.action===f.PUSH?H=[].concat(H.slice(0,n+1),[Y.key]):Y.action===f.REPLACE&&(H[n]=Y.key),q.forEach(function(e){e(Y)})}function o(e){if(q.push(e),Y)e(Y);else{var t=N();H=[t.key],n(t)}return function(){q=q.filter(function(t){return t!==e})}}function a(e,t){p.loopAsync(D.length,function(t,n,o){g.default(D[t],e,function(e){null!=e?o(e):n()})},function(e){U&&"string"==typeof e?U(e,function(e){t(e!==!1)}):t(e!==!1)})}function s(e){Y&&l(Y,e)||(F=e,a(e,function(t){if(F===e)if(t){if(e.action===f.PUSH){var o=w(Y),r=w(e);r===o&&u.default(Y.state,e.state)&&(e.action=f.REPLACE)}L(e)!==!1&&n(e)}else if(Y&&e.action===f.POP){var l=H.indexOf(Y.key),a=H.indexOf(e.key);l!==-1&&a!==-1&&j(l-a)}}))}function c(e){s(E(e,f.PUSH,x()))}function h(e){s(E(e,f.REPLACE,x()))}function b(){j(-1)}function _(){j(1)}function x(){return r(A)}function w(e){if(null==e||"string"==typeof e)return e;var t=e.pathname,n=e.search,o=e.hash,r=t;return n&&(r+=n),o&&(r+=o),r}function k(e){return w(e)}function E(e,t){var n=arguments.length<=2||void 0===arguments[2]?x():arguments[2];return"object"==typeof t&&("string"==typeof e&&(e=d.parsePath(e)),e=i({},e,{state:t}),t=n,n=arguments[3]||x()),m.default(e,t,n)}function C(e){Y?(P(Y,e),n(Y)):P(N(),e)}function P(e,t){e.state=i({},e.state,t),I(e.key,e.state)}function M(e){D.indexOf(e)===-1&&D.push(e)}function O(e){D=D.filter(function(t){return t!==e})}function R(e,t){"string"==typeof t&&(t=d.parsePath(t)),c(i({state:e},t))}function S(e,t){"string"==typeof t&&(t=d.parsePath(t)),h(i({state:e},t))}var T=arguments.length<=0||void 0===arguments[0]?{}:arguments[0],N=T.getCurrentLocation,L=T.finishTransition,I=T.saveState,j=T.go,U=T.getUserConfirmation,A=T.keyLength;"number"!=typeof A&&(A=y);var D=[],H=[],q=[],Y=void 0,F=void 0;return{listenBefore:e,listen:o,transitionTo:s,push:c,replace:h,go:j,goBack:b,goForward:_,createKey:x,createPath:w,createHref:k,createLocation:E,setState:v.default(C,"setState is deprecated; use location.key to save state instead"),registerTransitionHook:v.default(M,"registerTransitionHook is deprecated; use listenBefore instead"),unregisterTransitionHook:v.default(O,"unregisterTransitionHook is deprecated; use the callback returned from listenBefore instead"),pushState:v.default(R,"pushState is deprecated; use push instead"),replaceState:v.default(S,"replaceState is deprecated; use replace instead")}}t.__esModule=!0;var i=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},s=n(199),c=(o(s),n(207)),u=o(c),d=n(201),p=n(210),f=n(200),h=n(211),m=o(h),b=n(212),g=o(b),_=n(213),v=o(_),y=6;t.default=a,e.exports=t.default},function(e,t,n){function o(e){return null===e||void 0===e}function r(e){return!(!e||"object"!=typeof e||"number"!=typeof e.length)&&("function"==typeof e.copy&&"function"==typeof e.slice&&!(e.length>0&&"number"!=typeof e[0]))}function l(e,t,n){var l,u;if(o(e)||o(t))return!1;if(e.prototype!==t.prototype)return!1;if(s(e))return!!s(t)&&(e=a.call(e),t=a.call(t),c(e,t,n));if(r(e)){if(!r(t))return!1;if(e.length!==t.length)return!1;for(l=0;l<e.length;l++)if(e[l]!==t[l])return!1;return!0}try{var d=i(e),p=i(t)}catch(e){return!1}if(d.length!=p.length)return!1;for(d.sort(),p.sort(),l=d.length-1;l>=0;l--)if(d[l]!=p[l])return!1;for(l=d.length-1;l>=0;l--)if(u=d[l],!c(e[u],t[u],n))return!1;return typeof e==typeof t}var a=Array.prototype.slice,i=n(208),s=n(209),c=e.exports=function(e,t,n){return n||(n={}),e===t||(e instanceof Date&&t instanceof Date?e.getTime()===t.getTime():!e||!t||"object"!=typeof e&&"object"!=typeof t?n.strict?e===t:e==t:l(e,t,n))}},function(e,t){function n(e){var t=[];for(var n in e)t.push(n);return t}t=e.exports="function"==typeof Object.keys?Object.keys:n,t.shim=n},function(e,t){function n(e){return"[object Arguments]"==Object.prototype.toString.call(e)}function o(e){return e&&"object"==typeof e&&"number"==typeof e.length&&Object.prototype.hasOwnProperty.call(e,"callee")&&!Object.prototype.propertyIsEnumerable.call(e,"callee")||!1}var r="[object Arguments]"==function(){return Object.prototype.toString.call(arguments)}();t=e.exports=r?n:o,t.supported=n,t.unsupported=o},function(e,t){"use strict";function n(e,t,n){function r(){return i=!0,s?void(u=[].concat(o.call(arguments))):void n.apply(this,arguments)}function l(){if(!i&&(c=!0,!s)){for(s=!0;!i&&a<e&&c;)c=!1,t.call(this,a++,l,r);return s=!1,i?void n.apply(this,u):void(a>=e&&c&&(i=!0,n()))}}var a=0,i=!1,s=!1,c=!1,u=void 0;l()}t.__esModule=!0;var o=Array.prototype.slice;t.loopAsync=n},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(){var e=arguments.length<=0||void 0===arguments[0]?"/":arguments[0],t=arguments.length<=1||void 0===arguments[1]?i.POP:arguments[1],n=arguments.length<=2||void 0===arguments[2]?null:arguments[2],o=arguments.length<=3||void 0===arguments[3]?null:arguments[3];"string"==typeof e&&(e=s.parsePath(e)),"object"==typeof t&&(e=l({},e,{state:t}),t=n||i.POP,n=o);var r=e.pathname||"/",a=e.search||"",c=e.hash||"",u=e.state||null;return{pathname:r,search:a,hash:c,state:u,action:t,key:n}}t.__esModule=!0;var l=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},a=n(199),i=(o(a),n(200)),s=n(201);t.default=r,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t,n){var o=e(t,n);e.length<2&&n(o)}t.__esModule=!0;var l=n(199);o(l);t.default=r,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){return function(){return e.apply(this,arguments)}}t.__esModule=!0;var l=n(199);o(l);t.default=r,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){return s.stringify(e).replace(/%20/g,"+")}function l(e){return function(){function t(e){if(null==e.query){var t=e.search;e.query=w(t.substring(1)),e[h]={search:t,searchBase:""}}return e}function n(e,t){var n,o=e[h],r=t?x(t):"";if(!o&&!r)return e;"string"==typeof e&&(e=d.parsePath(e));var l=void 0;l=o&&e.search===o.search?o.searchBase:e.search||"";var i=l;return r&&(i+=(i?"&":"?")+r),a({},e,(n={search:i},n[h]={search:i,searchBase:l},n))}function o(e){return y.listenBefore(function(n,o){u.default(e,t(n),o)})}function l(e){return y.listen(function(n){e(t(n))})}function i(e){y.push(n(e,e.query))}function s(e){y.replace(n(e,e.query))}function c(e,t){return y.createPath(n(e,t||e.query))}function p(e,t){return y.createHref(n(e,t||e.query))}function b(e){for(var o=arguments.length,r=Array(o>1?o-1:0),l=1;l<o;l++)r[l-1]=arguments[l];var a=y.createLocation.apply(y,[n(e,e.query)].concat(r));return e.query&&(a.query=e.query),t(a)}function g(e,t,n){"string"==typeof t&&(t=d.parsePath(t)),i(a({state:e},t,{query:n}))}function _(e,t,n){"string"==typeof t&&(t=d.parsePath(t)),s(a({state:e},t,{query:n}))}var v=arguments.length<=0||void 0===arguments[0]?{}:arguments[0],y=e(v),x=v.stringifyQuery,w=v.parseQueryString;return"function"!=typeof x&&(x=r),"function"!=typeof w&&(w=m),a({},y,{listenBefore:o,listen:l,push:i,replace:s,createPath:c,createHref:p,createLocation:b,pushState:f.default(g,"pushState is deprecated; use push instead"),replaceState:f.default(_,"replaceState is deprecated; use replace instead")})}}t.__esModule=!0;var a=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},i=n(199),s=(o(i),n(215)),c=n(212),u=o(c),d=n(201),p=n(213),f=o(p),h="$searchBase",m=s.parse;t.default=l,e.exports=t.default},function(e,t,n){"use strict";var o=n(216);t.extract=function(e){return e.split("?")[1]||""},t.parse=function(e){return"string"!=typeof e?{}:(e=e.trim().replace(/^(\?|#|&)/,""),e?e.split("&").reduce(function(e,t){var n=t.replace(/\+/g," ").split("="),o=n.shift(),r=n.length>0?n.join("="):void 0;return o=decodeURIComponent(o),r=void 0===r?null:decodeURIComponent(r),e.hasOwnProperty(o)?Array.isArray(e[o])?e[o].push(r):e[o]=[e[o],r]:e[o]=r,e},{}):{})},t.stringify=function(e){return e?Object.keys(e).sort().map(function(t){var n=e[t];return void 0===n?"":null===n?t:Array.isArray(n)?n.slice().sort().map(function(e){return o(t)+"="+o(e)}).join("&"):o(t)+"="+o(n)}).filter(function(e){return e.length>0}).join("&"):""}},function(e,t){"use strict";e.exports=function(e){return encodeURIComponent(e).replace(/[!'()*]/g,function(e){return"%"+e.charCodeAt(0).toString(16).toUpperCase()})}},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){for(var t in e)if(Object.prototype.hasOwnProperty.call(e,t))return!0;return!1}function l(e,t){function n(t){var n=!(arguments.length<=1||void 0===arguments[1])&&arguments[1],o=arguments.length<=2||void 0===arguments[2]?null:arguments[2],r=void 0;
-return n&&n!==!0||null!==o?(t={pathname:t,query:n},r=o||!1):(t=e.createLocation(t),r=n),(0,p.default)(t,r,v.location,v.routes,v.params)}function o(e,n){y&&y.location===e?l(y,n):(0,b.default)(t,e,function(t,o){t?n(t):o?l(a({},o,{location:e}),n):n()})}function l(e,t){function n(n,r){return n||r?o(n,r):void(0,h.default)(e,function(n,o){n?t(n):t(null,null,v=a({},e,{components:o}))})}function o(e,n){e?t(e):t(null,n)}var r=(0,c.default)(v,e),l=r.leaveRoutes,i=r.changeRoutes,s=r.enterRoutes;(0,u.runLeaveHooks)(l,v),l.filter(function(e){return s.indexOf(e)===-1}).forEach(m),(0,u.runChangeHooks)(i,v,e,function(t,r){return t||r?o(t,r):void(0,u.runEnterHooks)(s,e,n)})}function i(e){var t=arguments.length<=1||void 0===arguments[1]||arguments[1];return e.__id__||t&&(e.__id__=x++)}function s(e){return e.reduce(function(e,t){return e.push.apply(e,w[i(t)]),e},[])}function d(e,n){(0,b.default)(t,e,function(t,o){if(null==o)return void n();y=a({},o,{location:e});for(var r=s((0,c.default)(v,y).leaveRoutes),l=void 0,i=0,u=r.length;null==l&&i<u;++i)l=r[i](e);n(l)})}function f(){if(v.routes){for(var e=s(v.routes),t=void 0,n=0,o=e.length;"string"!=typeof t&&n<o;++n)t=e[n]();return t}}function m(e){var t=i(e,!1);t&&(delete w[t],r(w)||(k&&(k(),k=null),E&&(E(),E=null)))}function g(t,n){var o=i(t),l=w[o];if(l)l.indexOf(n)===-1&&l.push(n);else{var a=!r(w);w[o]=[n],a&&(k=e.listenBefore(d),e.listenBeforeUnload&&(E=e.listenBeforeUnload(f)))}return function(){var e=w[o];if(e){var r=e.filter(function(e){return e!==n});0===r.length?m(t):w[o]=r}}}function _(t){return e.listen(function(n){v.location===n?t(null,v):o(n,function(n,o,r){n?t(n):o?e.replace(o):r&&t(null,r)})})}var v={},y=void 0,x=1,w=Object.create(null),k=void 0,E=void 0;return{isActive:n,match:o,listenBeforeLeavingRoute:g,listen:_}}t.__esModule=!0;var a=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e};t.default=l;var i=n(192),s=(o(i),n(218)),c=o(s),u=n(219),d=n(221),p=o(d),f=n(222),h=o(f),m=n(224),b=o(m);e.exports=t.default},function(e,t,n){"use strict";function o(e,t,n){if(!e.path)return!1;var o=(0,l.getParamNames)(e.path);return o.some(function(e){return t.params[e]!==n.params[e]})}function r(e,t){var n=e&&e.routes,r=t.routes,l=void 0,a=void 0,i=void 0;return n?!function(){var s=!1;l=n.filter(function(n){if(s)return!0;var l=r.indexOf(n)===-1||o(n,e,t);return l&&(s=!0),l}),l.reverse(),i=[],a=[],r.forEach(function(e){var t=n.indexOf(e)===-1,o=l.indexOf(e)!==-1;t||o?i.push(e):a.push(e)})}():(l=[],a=[],i=r),{leaveRoutes:l,changeRoutes:a,enterRoutes:i}}t.__esModule=!0;var l=n(195);t.default=r,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t,n){return function(){for(var o=arguments.length,r=Array(o),l=0;l<o;l++)r[l]=arguments[l];if(e.apply(t,r),e.length<n){var a=r[r.length-1];a()}}}function l(e){return e.reduce(function(e,t){return t.onEnter&&e.push(r(t.onEnter,t,3)),e},[])}function a(e){return e.reduce(function(e,t){return t.onChange&&e.push(r(t.onChange,t,4)),e},[])}function i(e,t,n){function o(e,t,n){return t?void(r={pathname:t,query:n,state:e}):void(r=e)}if(!e)return void n();var r=void 0;(0,d.loopAsync)(e,function(e,n,l){t(e,o,function(e){e||r?l(e,r):n()})},n)}function s(e,t,n){var o=l(e);return i(o.length,function(e,n,r){o[e](t,n,r)},n)}function c(e,t,n,o){var r=a(e);return i(r.length,function(e,o,l){r[e](t,n,o,l)},o)}function u(e,t){for(var n=0,o=e.length;n<o;++n)e[n].onLeave&&e[n].onLeave.call(e[n],t)}t.__esModule=!0,t.runEnterHooks=s,t.runChangeHooks=c,t.runLeaveHooks=u;var d=n(220),p=n(192);o(p)},function(e,t){"use strict";function n(e,t,n){function o(){return a=!0,i?void(c=[].concat(Array.prototype.slice.call(arguments))):void n.apply(this,arguments)}function r(){if(!a&&(s=!0,!i)){for(i=!0;!a&&l<e&&s;)s=!1,t.call(this,l++,r,o);return i=!1,a?void n.apply(this,c):void(l>=e&&s&&(a=!0,n()))}}var l=0,a=!1,i=!1,s=!1,c=void 0;r()}function o(e,t,n){function o(e,t,o){a||(t?(a=!0,n(t)):(l[e]=o,a=++i===r,a&&n(null,l)))}var r=e.length,l=[];if(0===r)return n(null,l);var a=!1,i=0;e.forEach(function(e,n){t(e,n,function(e,t){o(n,e,t)})})}t.__esModule=!0,t.loopAsync=n,t.mapAsync=o},function(e,t,n){"use strict";function o(e,t){if(e==t)return!0;if(null==e||null==t)return!1;if(Array.isArray(e))return Array.isArray(t)&&e.length===t.length&&e.every(function(e,n){return o(e,t[n])});if("object"===("undefined"==typeof e?"undefined":s(e))){for(var n in e)if(Object.prototype.hasOwnProperty.call(e,n))if(void 0===e[n]){if(void 0!==t[n])return!1}else{if(!Object.prototype.hasOwnProperty.call(t,n))return!1;if(!o(e[n],t[n]))return!1}return!0}return String(e)===String(t)}function r(e,t){return"/"!==t.charAt(0)&&(t="/"+t),"/"!==e.charAt(e.length-1)&&(e+="/"),"/"!==t.charAt(t.length-1)&&(t+="/"),t===e}function l(e,t,n){for(var o=e,r=[],l=[],a=0,i=t.length;a<i;++a){var s=t[a],u=s.path||"";if("/"===u.charAt(0)&&(o=e,r=[],l=[]),null!==o&&u){var d=(0,c.matchPattern)(u,o);if(d?(o=d.remainingPathname,r=[].concat(r,d.paramNames),l=[].concat(l,d.paramValues)):o=null,""===o)return r.every(function(e,t){return String(l[t])===String(n[e])})}}return!1}function a(e,t){return null==t?null==e:null==e||o(e,t)}function i(e,t,n,o,i){var s=e.pathname,c=e.query;return null!=n&&("/"!==s.charAt(0)&&(s="/"+s),!!(r(s,n.pathname)||!t&&l(s,o,i))&&a(c,n.query))}t.__esModule=!0;var s="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(e){return typeof e}:function(e){return e&&"function"==typeof Symbol&&e.constructor===Symbol?"symbol":typeof e};t.default=i;var c=n(195);e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t,n){if(t.component||t.components)return void n(null,t.component||t.components);var o=t.getComponent||t.getComponents;if(!o)return void n();var r=e.location,l=(0,s.default)(e,r);o.call(t,l,n)}function l(e,t){(0,a.mapAsync)(e.routes,function(t,n,o){r(e,t,o)},t)}t.__esModule=!0;var a=n(220),i=n(223),s=o(i);t.default=l,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){return l({},e,t)}t.__esModule=!0;var l=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e};t.default=r;var a=(n(191),n(192));o(a);e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t,n,o,r){if(e.childRoutes)return[null,e.childRoutes];if(!e.getChildRoutes)return[];var l=!0,a=void 0,s={location:t,params:i(n,o)},c=(0,h.default)(s,t);return e.getChildRoutes(c,function(e,t){return t=!e&&(0,g.createRoutes)(t),l?void(a=[e,t]):void r(e,t)}),l=!1,a}function l(e,t,n,o,r){if(e.indexRoute)r(null,e.indexRoute);else if(e.getIndexRoute){var a={location:t,params:i(n,o)},s=(0,h.default)(a,t);e.getIndexRoute(s,function(e,t){r(e,!e&&(0,g.createRoutes)(t)[0])})}else e.childRoutes?!function(){var a=e.childRoutes.filter(function(e){return!e.path});(0,p.loopAsync)(a.length,function(e,r,i){l(a[e],t,n,o,function(t,n){if(t||n){var o=[a[e]].concat(Array.isArray(n)?n:[n]);i(t,o)}else r()})},function(e,t){r(null,t)})}():r()}function a(e,t,n){return t.reduce(function(e,t,o){var r=n&&n[o];return Array.isArray(e[t])?e[t].push(r):t in e?e[t]=[e[t],r]:e[t]=r,e},e)}function i(e,t){return a({},e,t)}function s(e,t,n,o,a,s){var u=e.path||"";if("/"===u.charAt(0)&&(n=t.pathname,o=[],a=[]),null!==n&&u){try{var p=(0,m.matchPattern)(u,n);p?(n=p.remainingPathname,o=[].concat(o,p.paramNames),a=[].concat(a,p.paramValues)):n=null}catch(e){s(e)}if(""===n){var f=function(){var n={routes:[e],params:i(o,a)};return l(e,t,o,a,function(e,t){if(e)s(e);else{if(Array.isArray(t)){var o;(o=n.routes).push.apply(o,t)}else t&&n.routes.push(t);s(null,n)}}),{v:void 0}}();if("object"===("undefined"==typeof f?"undefined":d(f)))return f.v}}if(null!=n||e.childRoutes){var h=function(r,l){r?s(r):l?c(l,t,function(t,n){t?s(t):n?(n.routes.unshift(e),s(null,n)):s()},n,o,a):s()},b=r(e,t,o,a,h);b&&h.apply(void 0,b)}else s()}function c(e,t,n,o){var r=arguments.length<=4||void 0===arguments[4]?[]:arguments[4],l=arguments.length<=5||void 0===arguments[5]?[]:arguments[5];void 0===o&&("/"!==t.pathname.charAt(0)&&(t=u({},t,{pathname:"/"+t.pathname})),o=t.pathname),(0,p.loopAsync)(e.length,function(n,a,i){s(e[n],t,o,r,l,function(e,t){e||t?i(e,t):a()})},n)}t.__esModule=!0;var u=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},d="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(e){return typeof e}:function(e){return e&&"function"==typeof Symbol&&e.constructor===Symbol?"symbol":typeof e};t.default=c;var p=n(220),f=n(223),h=o(f),m=n(195),b=n(192),g=(o(b),n(189));e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(e){return typeof e}:function(e){return e&&"function"==typeof Symbol&&e.constructor===Symbol?"symbol":typeof e},l=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},a=n(196),i=o(a),s=n(1),c=o(s),u=n(191),d=(o(u),n(226)),p=o(d),f=n(189),h=n(192),m=(o(h),c.default.PropTypes),b=m.array,g=m.func,_=m.object,v=c.default.createClass({displayName:"RouterContext",propTypes:{history:_,router:_.isRequired,location:_.isRequired,routes:b.isRequired,params:_.isRequired,components:b.isRequired,createElement:g.isRequired},getDefaultProps:function(){return{createElement:c.default.createElement}},childContextTypes:{history:_,location:_.isRequired,router:_.isRequired},getChildContext:function(){var e=this.props,t=e.router,n=e.history,o=e.location;return t||(t=l({},n,{setRouteLeaveHook:n.listenBeforeLeavingRoute}),delete t.listenBeforeLeavingRoute),{history:n,location:o,router:t}},createElement:function(e,t){return null==e?null:this.props.createElement(e,t)},render:function(){var e=this,t=this.props,n=t.history,o=t.location,a=t.routes,s=t.params,u=t.components,d=null;return u&&(d=u.reduceRight(function(t,i,c){if(null==i)return t;var u=a[c],d=(0,p.default)(u,s),h={history:n,location:o,params:s,route:u,routeParams:d,routes:a};if((0,f.isReactChildren)(t))h.children=t;else if(t)for(var m in t)Object.prototype.hasOwnProperty.call(t,m)&&(h[m]=t[m]);if("object"===("undefined"==typeof i?"undefined":r(i))){var b={};for(var g in i)Object.prototype.hasOwnProperty.call(i,g)&&(b[g]=e.createElement(i[g],l({key:g},h)));return b}return e.createElement(i,h)},d)),null===d||d===!1||c.default.isValidElement(d)?void 0:(0,i.default)(!1),d}});t.default=v,e.exports=t.default},function(e,t,n){"use strict";function o(e,t){var n={};return e.path?((0,r.getParamNames)(e.path).forEach(function(e){Object.prototype.hasOwnProperty.call(t,e)&&(n[e]=t[e])}),n):n}t.__esModule=!0;var r=n(195);t.default=o,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){return a({},e,{setRouteLeaveHook:t.listenBeforeLeavingRoute,isActive:t.isActive})}function l(e,t){return e=a({},e,t)}t.__esModule=!0;var a=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e};t.createRouterObject=r,t.createRoutingHistory=l;var i=n(191);o(i)},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){var n={};for(var o in e)t.indexOf(o)>=0||Object.prototype.hasOwnProperty.call(e,o)&&(n[o]=e[o]);return n}function l(e){return 0===e.button}function a(e){return!!(e.metaKey||e.altKey||e.ctrlKey||e.shiftKey)}function i(e){for(var t in e)if(Object.prototype.hasOwnProperty.call(e,t))return!1;return!0}function s(e,t){var n=t.query,o=t.hash,r=t.state;return n||o||r?{pathname:e,query:n,hash:o,state:r}:e}t.__esModule=!0;var c=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},u=n(1),d=o(u),p=n(192),f=(o(p),n(196)),h=o(f),m=n(190),b=d.default.PropTypes,g=b.bool,_=b.object,v=b.string,y=b.func,x=b.oneOfType,w=d.default.createClass({displayName:"Link",contextTypes:{router:m.routerShape},propTypes:{to:x([v,_]),query:_,hash:v,state:_,activeStyle:_,activeClassName:v,onlyActiveOnIndex:g.isRequired,onClick:y,target:v},getDefaultProps:function(){return{onlyActiveOnIndex:!1,style:{}}},handleClick:function(e){if(this.props.onClick&&this.props.onClick(e),!e.defaultPrevented&&(this.context.router?void 0:(0,h.default)(!1),!a(e)&&l(e)&&!this.props.target)){e.preventDefault();var t=this.props,n=t.to,o=t.query,r=t.hash,i=t.state,c=s(n,{query:o,hash:r,state:i});this.context.router.push(c)}},render:function(){var e=this.props,t=e.to,n=e.query,o=e.hash,l=e.state,a=e.activeClassName,u=e.activeStyle,p=e.onlyActiveOnIndex,f=r(e,["to","query","hash","state","activeClassName","activeStyle","onlyActiveOnIndex"]),h=this.context.router;if(h){if(null==t)return d.default.createElement("a",f);var m=s(t,{query:n,hash:o,state:l});f.href=h.createHref(m),(a||null!=u&&!i(u))&&h.isActive(m,p)&&(a&&(f.className?f.className+=" "+a:f.className=a),u&&(f.style=c({},f.style,u)))}return d.default.createElement("a",c({},f,{onClick:this.handleClick}))}});t.default=w,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},l=n(1),a=o(l),i=n(228),s=o(i),c=a.default.createClass({displayName:"IndexLink",render:function(){return a.default.createElement(s.default,r({},this.props,{onlyActiveOnIndex:!0}))}});t.default=c,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){return e.displayName||e.name||"Component"}function l(e,t){var n=t&&t.withRef,o=u.default.createClass({displayName:"WithRouter",contextTypes:{router:f.routerShape},propTypes:{router:f.routerShape},getWrappedInstance:function(){return n?void 0:(0,s.default)(!1),this.wrappedInstance},render:function(){var t=this,o=this.props.router||this.context.router,r=a({},this.props,{router:o});return n&&(r.ref=function(e){t.wrappedInstance=e}),u.default.createElement(e,r)}});return o.displayName="withRouter("+r(e)+")",o.WrappedComponent=e,(0,p.default)(o,e)}t.__esModule=!0;var a=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e};t.default=l;var i=n(196),s=o(i),c=n(1),u=o(c),d=n(231),p=o(d),f=n(190);e.exports=t.default},function(e,t){"use strict";var n={childContextTypes:!0,contextTypes:!0,defaultProps:!0,displayName:!0,getDefaultProps:!0,mixins:!0,propTypes:!0,type:!0},o={name:!0,length:!0,prototype:!0,caller:!0,arguments:!0,arity:!0},r="function"==typeof Object.getOwnPropertySymbols;e.exports=function(e,t,l){if("string"!=typeof t){var a=Object.getOwnPropertyNames(t);r&&(a=a.concat(Object.getOwnPropertySymbols(t)));for(var i=0;i<a.length;++i)if(!(n[a[i]]||o[a[i]]||l&&l[a[i]]))try{e[a[i]]=t[a[i]]}catch(e){}}return e}},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=n(1),l=o(r),a=n(192),i=(o(a),n(196)),s=o(i),c=n(233),u=o(c),d=n(194),p=l.default.PropTypes,f=p.string,h=p.object,m=l.default.createClass({displayName:"IndexRedirect",statics:{createRouteFromReactElement:function(e,t){t&&(t.indexRoute=u.default.createRouteFromReactElement(e))}},propTypes:{to:f.isRequired,query:h,state:h,onEnter:d.falsy,children:d.falsy},render:function(){(0,s.default)(!1)}});t.default=m,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=n(1),l=o(r),a=n(196),i=o(a),s=n(189),c=n(195),u=n(194),d=l.default.PropTypes,p=d.string,f=d.object,h=l.default.createClass({displayName:"Redirect",statics:{createRouteFromReactElement:function(e){var t=(0,s.createRouteFromReactElement)(e);return t.from&&(t.path=t.from),t.onEnter=function(e,n){var o=e.location,r=e.params,l=void 0;if("/"===t.to.charAt(0))l=(0,c.formatPattern)(t.to,r);else if(t.to){var a=e.routes.indexOf(t),i=h.getRoutePattern(e.routes,a-1),s=i.replace(/\/*$/,"/")+t.to;l=(0,c.formatPattern)(s,r)}else l=o.pathname;n({pathname:l,query:t.query||o.query,state:t.state||o.state})},t},getRoutePattern:function(e,t){for(var n="",o=t;o>=0;o--){var r=e[o],l=r.path||"";if(n=l.replace(/\/*$/,"/")+n,0===l.indexOf("/"))break}return"/"+n}},propTypes:{path:p,from:p,to:p.isRequired,query:f,state:f,onEnter:u.falsy,children:u.falsy},render:function(){(0,i.default)(!1)}});t.default=h,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=n(1),l=o(r),a=n(192),i=(o(a),n(196)),s=o(i),c=n(189),u=n(194),d=l.default.PropTypes.func,p=l.default.createClass({displayName:"IndexRoute",statics:{createRouteFromReactElement:function(e,t){t&&(t.indexRoute=(0,c.createRouteFromReactElement)(e))}},propTypes:{path:u.falsy,component:u.component,components:u.components,getComponent:d,getComponents:d},render:function(){(0,s.default)(!1)}});t.default=p,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=n(1),l=o(r),a=n(196),i=o(a),s=n(189),c=n(194),u=l.default.PropTypes,d=u.string,p=u.func,f=l.default.createClass({displayName:"Route",statics:{createRouteFromReactElement:s.createRouteFromReactElement},propTypes:{path:d,component:c.component,components:c.components,getComponent:p,getComponents:p},render:function(){(0,i.default)(!1)}});t.default=f,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=n(192),l=(o(r),n(194)),a={contextTypes:{history:l.history},componentWillMount:function(){this.history=this.context.history}};t.default=a,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=n(192),l=(o(r),n(1)),a=o(l),i=n(196),s=o(i),c=a.default.PropTypes.object,u={contextTypes:{history:c.isRequired,route:c},propTypes:{route:c},componentDidMount:function(){this.routerWillLeave?void 0:(0,s.default)(!1);var e=this.props.route||this.context.route;e?void 0:(0,s.default)(!1),this._unlistenBeforeLeavingRoute=this.context.history.listenBeforeLeavingRoute(e,this.routerWillLeave)},componentWillUnmount:function(){this._unlistenBeforeLeavingRoute&&this._unlistenBeforeLeavingRoute()}};t.default=u,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=n(192),l=(o(r),n(1)),a=o(l),i=a.default.PropTypes.object,s={propTypes:{route:i.isRequired},childContextTypes:{route:i.isRequired},getChildContext:function(){return{route:this.props.route}},componentWillMount:function(){}};t.default=s,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){var n={};for(var o in e)t.indexOf(o)>=0||Object.prototype.hasOwnProperty.call(e,o)&&(n[o]=e[o]);return n}function l(e){return function(){var t=arguments.length<=0||void 0===arguments[0]?{}:arguments[0],n=t.routes,o=r(t,["routes"]),l=(0,s.default)(e)(o),i=(0,u.default)(l,n);return a({},l,i)}}t.__esModule=!0;var a=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},i=n(214),s=o(i),c=n(217),u=o(c),d=n(192);o(d);t.default=l,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=n(1),l=o(r),a=n(225),i=o(a),s=n(192),c=(o(s),l.default.createClass({displayName:"RoutingContext",componentWillMount:function(){},render:function(){return l.default.createElement(i.default,this.props)}}));t.default=c,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){var n={};for(var o in e)t.indexOf(o)>=0||Object.prototype.hasOwnProperty.call(e,o)&&(n[o]=e[o]);return n}function l(e,t){var n=e.history,o=e.routes,l=e.location,s=r(e,["history","routes","location"]);n||l?void 0:(0,c.default)(!1),n=n?n:(0,d.default)(s);var u=(0,f.default)(n,(0,h.createRoutes)(o)),p=void 0;l?l=n.createLocation(l):p=n.listen(function(e){l=e});var b=(0,m.createRouterObject)(n,u);n=(0,m.createRoutingHistory)(n,u),u.match(l,function(e,o,r){t(e,o&&b.createLocation(o,i.REPLACE),r&&a({},r,{history:n,router:b,matchContext:{history:n,transitionManager:u,router:b}})),p&&p()})}t.__esModule=!0;var a=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},i=n(200),s=n(196),c=o(s),u=n(242),d=o(u),p=n(217),f=o(p),h=n(189),m=n(227);t.default=l,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){var t=(0,u.default)(e),n=function(){return t},o=(0,a.default)((0,s.default)(n))(e);return o.__v2_compatible__=!0,o}t.__esModule=!0,t.default=r;var l=n(214),a=o(l),i=n(243),s=o(i),c=n(244),u=o(c);e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){return function(){function t(){if(!x){if(null==y&&i.canUseDOM){var e=document.getElementsByTagName("base")[0],t=e&&e.getAttribute("href");null!=t&&(y=t)}x=!0}}function n(e){return t(),y&&null==e.basename&&(0===e.pathname.indexOf(y)?(e.pathname=e.pathname.substring(y.length),e.basename=y,""===e.pathname&&(e.pathname="/")):e.basename=""),e}function o(e){if(t(),!y)return e;"string"==typeof e&&(e=s.parsePath(e));var n=e.pathname,o="/"===y.slice(-1)?y:y+"/",r="/"===n.charAt(0)?n.slice(1):n,a=o+r;return l({},e,{pathname:a})}function r(e){return v.listenBefore(function(t,o){u.default(e,n(t),o)})}function a(e){return v.listen(function(t){e(n(t))})}function c(e){v.push(o(e))}function d(e){v.replace(o(e))}function f(e){return v.createPath(o(e))}function h(e){return v.createHref(o(e))}function m(e){for(var t=arguments.length,r=Array(t>1?t-1:0),l=1;l<t;l++)r[l-1]=arguments[l];return n(v.createLocation.apply(v,[o(e)].concat(r)))}function b(e,t){"string"==typeof t&&(t=s.parsePath(t)),c(l({state:e},t))}function g(e,t){"string"==typeof t&&(t=s.parsePath(t)),d(l({state:e},t))}var _=arguments.length<=0||void 0===arguments[0]?{}:arguments[0],v=e(_),y=_.basename,x=!1;return l({},v,{listenBefore:r,listen:a,push:c,replace:d,createPath:f,createHref:h,createLocation:m,pushState:p.default(b,"pushState is deprecated; use push instead"),replaceState:p.default(g,"replaceState is deprecated; use replace instead")})}}t.__esModule=!0;var l=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},a=n(199),i=(o(a),n(202)),s=n(201),c=n(212),u=o(c),d=n(213),p=o(d);t.default=r,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){return e.filter(function(e){return e.state}).reduce(function(e,t){return e[t.key]=t.state,e},{})}function l(){function e(e,t){g[e]=t}function t(e){return g[e]}function n(){var e=m[b],n=e.basename,o=e.pathname,r=e.search,l=(n||"")+o+(r||""),i=void 0,s=void 0;e.key?(i=e.key,s=t(i)):(i=p.createKey(),s=null,e.key=i);var c=u.parsePath(l);return p.createLocation(a({},c,{state:s}),void 0,i)}function o(e){var t=b+e;return t>=0&&t<m.length}function l(e){if(e){if(!o(e))return;b+=e;var t=n();p.transitionTo(a({},t,{action:d.POP}))}}function i(t){switch(t.action){case d.PUSH:b+=1,b<m.length&&m.splice(b),m.push(t),e(t.key,t.state);break;case d.REPLACE:m[b]=t,e(t.key,t.state)}}var s=arguments.length<=0||void 0===arguments[0]?{}:arguments[0];Array.isArray(s)?s={entries:s}:"string"==typeof s&&(s={entries:[s]});var p=f.default(a({},s,{getCurrentLocation:n,finishTransition:i,saveState:e,go:l})),h=s,m=h.entries,b=h.current;"string"==typeof m?m=[m]:Array.isArray(m)||(m=["/"]),m=m.map(function(e){var t=p.createKey();return"string"==typeof e?{pathname:e,key:t}:"object"==typeof e&&e?a({},e,{key:t}):void c.default(!1)}),null==b?b=m.length-1:b>=0&&b<m.length?void 0:c.default(!1);var g=r(m);return p}t.__esModule=!0;var a=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},i=n(199),s=(o(i),n(196)),c=o(s),u=n(201),d=n(200),p=n(206),f=o(p);t.default=l,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){return function(t){var n=(0,a.default)((0,s.default)(e))(t);return n.__v2_compatible__=!0,n}}t.__esModule=!0,t.default=r;var l=n(214),a=o(l),i=n(243),s=o(i);e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},l=n(1),a=o(l),i=n(225),s=o(i),c=n(192);o(c);t.default=function(){for(var e=arguments.length,t=Array(e),n=0;n<e;n++)t[n]=arguments[n];var o=t.map(function(e){return e.renderRouterContext}).filter(Boolean),i=t.map(function(e){return e.renderRouteComponent}).filter(Boolean),c=function(){var e=arguments.length<=0||void 0===arguments[0]?l.createElement:arguments[0];return function(t,n){return i.reduceRight(function(e,t){return t(e,n)},e(t,n))}};return function(e){return o.reduceRight(function(t,n){return n(t,e)},a.default.createElement(s.default,r({},e,{createElement:c(e.createElement)})))}},e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=n(248),l=o(r),a=n(249),i=o(a);t.default=(0,i.default)(l.default),e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(){function e(e){try{e=e||window.history.state||{}}catch(t){e={}}var t=d.getWindowPath(),n=e,o=n.key,r=void 0;o?r=p.readState(o):(r=null,o=v.createKey(),g&&window.history.replaceState(l({},e,{key:o}),null));var a=c.parsePath(t);return v.createLocation(l({},a,{state:r}),void 0,o)}function t(t){function n(t){void 0!==t.state&&o(e(t.state))}var o=t.transitionTo;return d.addEventListener(window,"popstate",n),function(){d.removeEventListener(window,"popstate",n)}}function n(e){var t=e.basename,n=e.pathname,o=e.search,r=e.hash,l=e.state,a=e.action,i=e.key;if(a!==s.POP){p.saveState(i,l);var c=(t||"")+n+o+r,u={key:i};if(a===s.PUSH){if(_)return window.location.href=c,!1;window.history.pushState(u,null,c)}else{if(_)return window.location.replace(c),!1;window.history.replaceState(u,null,c)}}}function o(e){1===++y&&(x=t(v));var n=v.listenBefore(e);return function(){n(),0===--y&&x()}}function r(e){1===++y&&(x=t(v));var n=v.listen(e);return function(){n(),0===--y&&x()}}function a(e){1===++y&&(x=t(v)),v.registerTransitionHook(e)}function f(e){v.unregisterTransitionHook(e),0===--y&&x()}var m=arguments.length<=0||void 0===arguments[0]?{}:arguments[0];u.canUseDOM?void 0:i.default(!1);var b=m.forceRefresh,g=d.supportsHistory(),_=!g||b,v=h.default(l({},m,{getCurrentLocation:e,finishTransition:n,saveState:p.saveState})),y=0,x=void 0;return l({},v,{listenBefore:o,listen:r,registerTransitionHook:a,unregisterTransitionHook:f})}t.__esModule=!0;var l=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},a=n(196),i=o(a),s=n(200),c=n(201),u=n(202),d=n(203),p=n(204),f=n(205),h=o(f);t.default=r,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0,t.default=function(e){var t=void 0;return a&&(t=(0,l.default)(e)()),t};var r=n(245),l=o(r),a=!("undefined"==typeof window||!window.document||!window.document.createElement);e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=n(198),l=o(r),a=n(249),i=o(a);t.default=(0,i.default)(l.default),e.exports=t.default}]);
\ No newline at end of file
+t.locals={navbar:"xz-aB-navbar",btn:"_3-XUl-btn",caret:"WfXRY-caret",dropup:"xQM4X-dropup",label:"_3gkGS-label",table:"fHH5l-table","table-bordered":"_3qUkR-table-bordered",tableBordered:"_3qUkR-table-bordered","img-responsive":"_2zRts-img-responsive",imgResponsive:"_2zRts-img-responsive","img-rounded":"_141wW-img-rounded",imgRounded:"_141wW-img-rounded","img-thumbnail":"_3mPVB-img-thumbnail",imgThumbnail:"_3mPVB-img-thumbnail","img-circle":"_1pGGm-img-circle",imgCircle:"_1pGGm-img-circle","sr-only":"_2pd-Z-sr-only",srOnly:"_2pd-Z-sr-only","sr-only-focusable":"_2oRwu-sr-only-focusable",srOnlyFocusable:"_2oRwu-sr-only-focusable",h1:"FcDB9-h1",h2:"_2RtE8-h2",h3:"_2Hz9Y-h3",h4:"_20UE0-h4",h5:"_3ig4X-h5",h6:"_1IWbi-h6",small:"_1xqEr-small",lead:"BToLm-lead",mark:"_1CUfl-mark","text-left":"_1dEhG-text-left",textLeft:"_1dEhG-text-left","text-right":"_13-RL-text-right",textRight:"_13-RL-text-right","text-center":"_12Qkk-text-center",textCenter:"_12Qkk-text-center","text-justify":"_2IBJo-text-justify",textJustify:"_2IBJo-text-justify","text-nowrap":"_2D83n-text-nowrap",textNowrap:"_2D83n-text-nowrap","text-lowercase":"_3pILW-text-lowercase",textLowercase:"_3pILW-text-lowercase","text-uppercase":"EHGdF-text-uppercase",textUppercase:"EHGdF-text-uppercase","text-capitalize":"NKPoz-text-capitalize",textCapitalize:"NKPoz-text-capitalize","text-muted":"TLMiz-text-muted",textMuted:"TLMiz-text-muted","text-primary":"m-Vt1-text-primary",textPrimary:"m-Vt1-text-primary","text-success":"_1gJQD-text-success",textSuccess:"_1gJQD-text-success","text-info":"_3nKGJ-text-info",textInfo:"_3nKGJ-text-info","text-warning":"_1L8Pc-text-warning",textWarning:"_1L8Pc-text-warning","text-danger":"_2Qeqx-text-danger",textDanger:"_2Qeqx-text-danger","bg-primary":"VG86S-bg-primary",bgPrimary:"VG86S-bg-primary","bg-success":"Fh09a-bg-success",bgSuccess:"Fh09a-bg-success","bg-info":"_3D-5o-bg-info",bgInfo:"_3D-5o-bg-info","bg-warning":"_23YNn-bg-warning",bgWarning:"_23YNn-bg-warning","bg-danger":"_1g67A-bg-danger",bgDanger:"_1g67A-bg-danger","page-header":"_3ayLh-page-header",pageHeader:"_3ayLh-page-header","list-unstyled":"_2tb6R-list-unstyled",listUnstyled:"_2tb6R-list-unstyled","list-inline":"_2aPiu-list-inline",listInline:"_2aPiu-list-inline","dl-horizontal":"_2DZom-dl-horizontal",dlHorizontal:"_2DZom-dl-horizontal",initialism:"_39JkD-initialism","blockquote-reverse":"_3OkIL-blockquote-reverse",blockquoteReverse:"_3OkIL-blockquote-reverse","pull-right":"_1DwIR-pull-right",pullRight:"_1DwIR-pull-right",container:"GfVfY-container","container-fluid":"_1TgHS-container-fluid",containerFluid:"_1TgHS-container-fluid",row:"_3XjgV-row","col-xs-1":"_4I1Yd-col-xs-1",colXs1:"_4I1Yd-col-xs-1","col-sm-1":"ZjKa3-col-sm-1",colSm1:"ZjKa3-col-sm-1","col-md-1":"_2qQXc-col-md-1",colMd1:"_2qQXc-col-md-1","col-lg-1":"_20ta3-col-lg-1",colLg1:"_20ta3-col-lg-1","col-xs-2":"_2Dnpy-col-xs-2",colXs2:"_2Dnpy-col-xs-2","col-sm-2":"_1i2ug-col-sm-2",colSm2:"_1i2ug-col-sm-2","col-md-2":"_2t37W-col-md-2",colMd2:"_2t37W-col-md-2","col-lg-2":"_2Hl3y-col-lg-2",colLg2:"_2Hl3y-col-lg-2","col-xs-3":"OXp8_-col-xs-3",colXs3:"OXp8_-col-xs-3","col-sm-3":"_2Cn5F-col-sm-3",colSm3:"_2Cn5F-col-sm-3","col-md-3":"Ab3eH-col-md-3",colMd3:"Ab3eH-col-md-3","col-lg-3":"_26GsY-col-lg-3",colLg3:"_26GsY-col-lg-3","col-xs-4":"_2RAtz-col-xs-4",colXs4:"_2RAtz-col-xs-4","col-sm-4":"fa0Ir-col-sm-4",colSm4:"fa0Ir-col-sm-4","col-md-4":"hp0Eu-col-md-4",colMd4:"hp0Eu-col-md-4","col-lg-4":"_17v9q-col-lg-4",colLg4:"_17v9q-col-lg-4","col-xs-5":"_2Y3wR-col-xs-5",colXs5:"_2Y3wR-col-xs-5","col-sm-5":"_3h_BG-col-sm-5",colSm5:"_3h_BG-col-sm-5","col-md-5":"s-Mk2-col-md-5",colMd5:"s-Mk2-col-md-5","col-lg-5":"_3poT2-col-lg-5",colLg5:"_3poT2-col-lg-5","col-xs-6":"_2LLfi-col-xs-6",colXs6:"_2LLfi-col-xs-6","col-sm-6":"_2uvrS-col-sm-6",colSm6:"_2uvrS-col-sm-6","col-md-6":"_3aWb5-col-md-6",colMd6:"_3aWb5-col-md-6","col-lg-6":"_1kKt6-col-lg-6",colLg6:"_1kKt6-col-lg-6","col-xs-7":"_1lY1V-col-xs-7",colXs7:"_1lY1V-col-xs-7","col-sm-7":"_3MZfg-col-sm-7",colSm7:"_3MZfg-col-sm-7","col-md-7":"_3cJo6-col-md-7",colMd7:"_3cJo6-col-md-7","col-lg-7":"_3xzR0-col-lg-7",colLg7:"_3xzR0-col-lg-7","col-xs-8":"_2HxFx-col-xs-8",colXs8:"_2HxFx-col-xs-8","col-sm-8":"_2gidE-col-sm-8",colSm8:"_2gidE-col-sm-8","col-md-8":"_2PRaS-col-md-8",colMd8:"_2PRaS-col-md-8","col-lg-8":"LJ--s-col-lg-8",colLg8:"LJ--s-col-lg-8","col-xs-9":"_2oOfj-col-xs-9",colXs9:"_2oOfj-col-xs-9","col-sm-9":"_26BI4-col-sm-9",colSm9:"_26BI4-col-sm-9","col-md-9":"_2DhdG-col-md-9",colMd9:"_2DhdG-col-md-9","col-lg-9":"_3q6rI-col-lg-9",colLg9:"_3q6rI-col-lg-9","col-xs-10":"pmiBX-col-xs-10",colXs10:"pmiBX-col-xs-10","col-sm-10":"s5knd-col-sm-10",colSm10:"s5knd-col-sm-10","col-md-10":"_1oNCM-col-md-10",colMd10:"_1oNCM-col-md-10","col-lg-10":"_2DYWZ-col-lg-10",colLg10:"_2DYWZ-col-lg-10","col-xs-11":"yWnii-col-xs-11",colXs11:"yWnii-col-xs-11","col-sm-11":"liNUF-col-sm-11",colSm11:"liNUF-col-sm-11","col-md-11":"_2CmUe-col-md-11",colMd11:"_2CmUe-col-md-11","col-lg-11":"_1qjSD-col-lg-11",colLg11:"_1qjSD-col-lg-11","col-xs-12":"_2dgc--col-xs-12",colXs12:"_2dgc--col-xs-12","col-sm-12":"_3ylC5-col-sm-12",colSm12:"_3ylC5-col-sm-12","col-md-12":"_2DCWc-col-md-12",colMd12:"_2DCWc-col-md-12","col-lg-12":"_2FNrn-col-lg-12",colLg12:"_2FNrn-col-lg-12","col-xs-pull-12":"uk8LQ-col-xs-pull-12",colXsPull12:"uk8LQ-col-xs-pull-12","col-xs-pull-11":"nQP-X-col-xs-pull-11",colXsPull11:"nQP-X-col-xs-pull-11","col-xs-pull-10":"_3W2H_-col-xs-pull-10",colXsPull10:"_3W2H_-col-xs-pull-10","col-xs-pull-9":"_18qIh-col-xs-pull-9",colXsPull9:"_18qIh-col-xs-pull-9","col-xs-pull-8":"CEgpl-col-xs-pull-8",colXsPull8:"CEgpl-col-xs-pull-8","col-xs-pull-7":"_3UVMh-col-xs-pull-7",colXsPull7:"_3UVMh-col-xs-pull-7","col-xs-pull-6":"_1PEhv-col-xs-pull-6",colXsPull6:"_1PEhv-col-xs-pull-6","col-xs-pull-5":"VxFvW-col-xs-pull-5",colXsPull5:"VxFvW-col-xs-pull-5","col-xs-pull-4":"_3Wqpt-col-xs-pull-4",colXsPull4:"_3Wqpt-col-xs-pull-4","col-xs-pull-3":"_12Zed-col-xs-pull-3",colXsPull3:"_12Zed-col-xs-pull-3","col-xs-pull-2":"_3p-tQ-col-xs-pull-2",colXsPull2:"_3p-tQ-col-xs-pull-2","col-xs-pull-1":"RDEqX-col-xs-pull-1",colXsPull1:"RDEqX-col-xs-pull-1","col-xs-pull-0":"_2F1WQ-col-xs-pull-0",colXsPull0:"_2F1WQ-col-xs-pull-0","col-xs-push-12":"_1APvI-col-xs-push-12",colXsPush12:"_1APvI-col-xs-push-12","col-xs-push-11":"_3C73D-col-xs-push-11",colXsPush11:"_3C73D-col-xs-push-11","col-xs-push-10":"h56Hx-col-xs-push-10",colXsPush10:"h56Hx-col-xs-push-10","col-xs-push-9":"_1P93P-col-xs-push-9",colXsPush9:"_1P93P-col-xs-push-9","col-xs-push-8":"_2Nbww-col-xs-push-8",colXsPush8:"_2Nbww-col-xs-push-8","col-xs-push-7":"_1K3eA-col-xs-push-7",colXsPush7:"_1K3eA-col-xs-push-7","col-xs-push-6":"_2nBcT-col-xs-push-6",colXsPush6:"_2nBcT-col-xs-push-6","col-xs-push-5":"_3petP-col-xs-push-5",colXsPush5:"_3petP-col-xs-push-5","col-xs-push-4":"_2IqqW-col-xs-push-4",colXsPush4:"_2IqqW-col-xs-push-4","col-xs-push-3":"MCtu6-col-xs-push-3",colXsPush3:"MCtu6-col-xs-push-3","col-xs-push-2":"_3PMdr-col-xs-push-2",colXsPush2:"_3PMdr-col-xs-push-2","col-xs-push-1":"_1wU93-col-xs-push-1",colXsPush1:"_1wU93-col-xs-push-1","col-xs-push-0":"_1mQQp-col-xs-push-0",colXsPush0:"_1mQQp-col-xs-push-0","col-xs-offset-12":"_29EZj-col-xs-offset-12",colXsOffset12:"_29EZj-col-xs-offset-12","col-xs-offset-11":"_2hf-s-col-xs-offset-11",colXsOffset11:"_2hf-s-col-xs-offset-11","col-xs-offset-10":"PUiPu-col-xs-offset-10",colXsOffset10:"PUiPu-col-xs-offset-10","col-xs-offset-9":"_26WFR-col-xs-offset-9",colXsOffset9:"_26WFR-col-xs-offset-9","col-xs-offset-8":"_1cCHe-col-xs-offset-8",colXsOffset8:"_1cCHe-col-xs-offset-8","col-xs-offset-7":"_3XTt9-col-xs-offset-7",colXsOffset7:"_3XTt9-col-xs-offset-7","col-xs-offset-6":"_3ruq1-col-xs-offset-6",colXsOffset6:"_3ruq1-col-xs-offset-6","col-xs-offset-5":"_2Jvdw-col-xs-offset-5",colXsOffset5:"_2Jvdw-col-xs-offset-5","col-xs-offset-4":"_3Lc1D-col-xs-offset-4",colXsOffset4:"_3Lc1D-col-xs-offset-4","col-xs-offset-3":"_1MGVb-col-xs-offset-3",colXsOffset3:"_1MGVb-col-xs-offset-3","col-xs-offset-2":"_3VELV-col-xs-offset-2",colXsOffset2:"_3VELV-col-xs-offset-2","col-xs-offset-1":"_1qTH1-col-xs-offset-1",colXsOffset1:"_1qTH1-col-xs-offset-1","col-xs-offset-0":"_3p4mP-col-xs-offset-0",colXsOffset0:"_3p4mP-col-xs-offset-0","col-sm-pull-12":"_2lgaN-col-sm-pull-12",colSmPull12:"_2lgaN-col-sm-pull-12","col-sm-pull-11":"_9DE1R-col-sm-pull-11",colSmPull11:"_9DE1R-col-sm-pull-11","col-sm-pull-10":"kEzk--col-sm-pull-10",colSmPull10:"kEzk--col-sm-pull-10","col-sm-pull-9":"Nt2jd-col-sm-pull-9",colSmPull9:"Nt2jd-col-sm-pull-9","col-sm-pull-8":"_3swPC-col-sm-pull-8",colSmPull8:"_3swPC-col-sm-pull-8","col-sm-pull-7":"_3HVdW-col-sm-pull-7",colSmPull7:"_3HVdW-col-sm-pull-7","col-sm-pull-6":"wTEFa-col-sm-pull-6",colSmPull6:"wTEFa-col-sm-pull-6","col-sm-pull-5":"TLqCy-col-sm-pull-5",colSmPull5:"TLqCy-col-sm-pull-5","col-sm-pull-4":"_15_JC-col-sm-pull-4",colSmPull4:"_15_JC-col-sm-pull-4","col-sm-pull-3":"kKugK-col-sm-pull-3",colSmPull3:"kKugK-col-sm-pull-3","col-sm-pull-2":"_1gfwZ-col-sm-pull-2",colSmPull2:"_1gfwZ-col-sm-pull-2","col-sm-pull-1":"ULGBP-col-sm-pull-1",colSmPull1:"ULGBP-col-sm-pull-1","col-sm-pull-0":"_2761W-col-sm-pull-0",colSmPull0:"_2761W-col-sm-pull-0","col-sm-push-12":"vVqsv-col-sm-push-12",colSmPush12:"vVqsv-col-sm-push-12","col-sm-push-11":"_3rrzB-col-sm-push-11",colSmPush11:"_3rrzB-col-sm-push-11","col-sm-push-10":"_16iHZ-col-sm-push-10",colSmPush10:"_16iHZ-col-sm-push-10","col-sm-push-9":"_3gO4_-col-sm-push-9",colSmPush9:"_3gO4_-col-sm-push-9","col-sm-push-8":"_2iTaN-col-sm-push-8",colSmPush8:"_2iTaN-col-sm-push-8","col-sm-push-7":"_15MqT-col-sm-push-7",colSmPush7:"_15MqT-col-sm-push-7","col-sm-push-6":"_3WBCD-col-sm-push-6",colSmPush6:"_3WBCD-col-sm-push-6","col-sm-push-5":"_3yi6x-col-sm-push-5",colSmPush5:"_3yi6x-col-sm-push-5","col-sm-push-4":"Hi_tc-col-sm-push-4",colSmPush4:"Hi_tc-col-sm-push-4","col-sm-push-3":"_3CD2Y-col-sm-push-3",colSmPush3:"_3CD2Y-col-sm-push-3","col-sm-push-2":"_2KPVu-col-sm-push-2",colSmPush2:"_2KPVu-col-sm-push-2","col-sm-push-1":"_1vrte-col-sm-push-1",colSmPush1:"_1vrte-col-sm-push-1","col-sm-push-0":"_2iGSL-col-sm-push-0",colSmPush0:"_2iGSL-col-sm-push-0","col-sm-offset-12":"_2gBOF-col-sm-offset-12",colSmOffset12:"_2gBOF-col-sm-offset-12","col-sm-offset-11":"_1nkq--col-sm-offset-11",colSmOffset11:"_1nkq--col-sm-offset-11","col-sm-offset-10":"_3cbLF-col-sm-offset-10",colSmOffset10:"_3cbLF-col-sm-offset-10","col-sm-offset-9":"_1YqUk-col-sm-offset-9",colSmOffset9:"_1YqUk-col-sm-offset-9","col-sm-offset-8":"l5LT2-col-sm-offset-8",colSmOffset8:"l5LT2-col-sm-offset-8","col-sm-offset-7":"_1_lx0-col-sm-offset-7",colSmOffset7:"_1_lx0-col-sm-offset-7","col-sm-offset-6":"QftRx-col-sm-offset-6",colSmOffset6:"QftRx-col-sm-offset-6","col-sm-offset-5":"_1CiAA-col-sm-offset-5",colSmOffset5:"_1CiAA-col-sm-offset-5","col-sm-offset-4":"_3KOyM-col-sm-offset-4",colSmOffset4:"_3KOyM-col-sm-offset-4","col-sm-offset-3":"_3X8SS-col-sm-offset-3",colSmOffset3:"_3X8SS-col-sm-offset-3","col-sm-offset-2":"_2uxv1-col-sm-offset-2",colSmOffset2:"_2uxv1-col-sm-offset-2","col-sm-offset-1":"_3LDLJ-col-sm-offset-1",colSmOffset1:"_3LDLJ-col-sm-offset-1","col-sm-offset-0":"_2qEtK-col-sm-offset-0",colSmOffset0:"_2qEtK-col-sm-offset-0","col-md-pull-12":"bjQSr-col-md-pull-12",colMdPull12:"bjQSr-col-md-pull-12","col-md-pull-11":"_2R0gT-col-md-pull-11",colMdPull11:"_2R0gT-col-md-pull-11","col-md-pull-10":"_1ywn5-col-md-pull-10",colMdPull10:"_1ywn5-col-md-pull-10","col-md-pull-9":"Y3m-j-col-md-pull-9",colMdPull9:"Y3m-j-col-md-pull-9","col-md-pull-8":"_2kyk9-col-md-pull-8",colMdPull8:"_2kyk9-col-md-pull-8","col-md-pull-7":"dnrMG-col-md-pull-7",colMdPull7:"dnrMG-col-md-pull-7","col-md-pull-6":"_1zNYf-col-md-pull-6",colMdPull6:"_1zNYf-col-md-pull-6","col-md-pull-5":"_1lgxH-col-md-pull-5",colMdPull5:"_1lgxH-col-md-pull-5","col-md-pull-4":"fI_-S-col-md-pull-4",colMdPull4:"fI_-S-col-md-pull-4","col-md-pull-3":"_1yC1r-col-md-pull-3",colMdPull3:"_1yC1r-col-md-pull-3","col-md-pull-2":"_2ruK1-col-md-pull-2",colMdPull2:"_2ruK1-col-md-pull-2","col-md-pull-1":"_3pkxQ-col-md-pull-1",colMdPull1:"_3pkxQ-col-md-pull-1","col-md-pull-0":"_2NDft-col-md-pull-0",colMdPull0:"_2NDft-col-md-pull-0","col-md-push-12":"_2dklO-col-md-push-12",colMdPush12:"_2dklO-col-md-push-12","col-md-push-11":"_2O3TS-col-md-push-11",colMdPush11:"_2O3TS-col-md-push-11","col-md-push-10":"_3H7Y4-col-md-push-10",colMdPush10:"_3H7Y4-col-md-push-10","col-md-push-9":"_2PIS0-col-md-push-9",colMdPush9:"_2PIS0-col-md-push-9","col-md-push-8":"_1qQQi-col-md-push-8",colMdPush8:"_1qQQi-col-md-push-8","col-md-push-7":"Y8MRT-col-md-push-7",colMdPush7:"Y8MRT-col-md-push-7","col-md-push-6":"_3_TWQ-col-md-push-6",colMdPush6:"_3_TWQ-col-md-push-6","col-md-push-5":"_3KOrj-col-md-push-5",colMdPush5:"_3KOrj-col-md-push-5","col-md-push-4":"_2PuS8-col-md-push-4",colMdPush4:"_2PuS8-col-md-push-4","col-md-push-3":"_2zI-_-col-md-push-3",colMdPush3:"_2zI-_-col-md-push-3","col-md-push-2":"_3Drij-col-md-push-2",colMdPush2:"_3Drij-col-md-push-2","col-md-push-1":"_1M7-4-col-md-push-1",colMdPush1:"_1M7-4-col-md-push-1","col-md-push-0":"_1kPaw-col-md-push-0",colMdPush0:"_1kPaw-col-md-push-0","col-md-offset-12":"_3PwfG-col-md-offset-12",colMdOffset12:"_3PwfG-col-md-offset-12","col-md-offset-11":"sHbiw-col-md-offset-11",colMdOffset11:"sHbiw-col-md-offset-11","col-md-offset-10":"_3geq1-col-md-offset-10",colMdOffset10:"_3geq1-col-md-offset-10","col-md-offset-9":"_3ypJc-col-md-offset-9",colMdOffset9:"_3ypJc-col-md-offset-9","col-md-offset-8":"ydDuS-col-md-offset-8",colMdOffset8:"ydDuS-col-md-offset-8","col-md-offset-7":"_1MS3B-col-md-offset-7",colMdOffset7:"_1MS3B-col-md-offset-7","col-md-offset-6":"_1A80h-col-md-offset-6",colMdOffset6:"_1A80h-col-md-offset-6","col-md-offset-5":"_3g3p_-col-md-offset-5",colMdOffset5:"_3g3p_-col-md-offset-5","col-md-offset-4":"_2FjQc-col-md-offset-4",colMdOffset4:"_2FjQc-col-md-offset-4","col-md-offset-3":"Xebzn-col-md-offset-3",colMdOffset3:"Xebzn-col-md-offset-3","col-md-offset-2":"Xw9rm-col-md-offset-2",colMdOffset2:"Xw9rm-col-md-offset-2","col-md-offset-1":"CToHd-col-md-offset-1",colMdOffset1:"CToHd-col-md-offset-1","col-md-offset-0":"oXEcL-col-md-offset-0",colMdOffset0:"oXEcL-col-md-offset-0","col-lg-pull-12":"_32_we-col-lg-pull-12",colLgPull12:"_32_we-col-lg-pull-12","col-lg-pull-11":"_24eEw-col-lg-pull-11",colLgPull11:"_24eEw-col-lg-pull-11","col-lg-pull-10":"_29qaq-col-lg-pull-10",colLgPull10:"_29qaq-col-lg-pull-10","col-lg-pull-9":"_1S7Yo-col-lg-pull-9",colLgPull9:"_1S7Yo-col-lg-pull-9","col-lg-pull-8":"_2CgOB-col-lg-pull-8",colLgPull8:"_2CgOB-col-lg-pull-8","col-lg-pull-7":"_3Jv0L-col-lg-pull-7",colLgPull7:"_3Jv0L-col-lg-pull-7","col-lg-pull-6":"_1pHMz-col-lg-pull-6",colLgPull6:"_1pHMz-col-lg-pull-6","col-lg-pull-5":"_2zvsy-col-lg-pull-5",colLgPull5:"_2zvsy-col-lg-pull-5","col-lg-pull-4":"sFsmY-col-lg-pull-4",colLgPull4:"sFsmY-col-lg-pull-4","col-lg-pull-3":"Ui3Ia-col-lg-pull-3",colLgPull3:"Ui3Ia-col-lg-pull-3","col-lg-pull-2":"WmgXv-col-lg-pull-2",colLgPull2:"WmgXv-col-lg-pull-2","col-lg-pull-1":"_2KMEm-col-lg-pull-1",colLgPull1:"_2KMEm-col-lg-pull-1","col-lg-pull-0":"QWRTc-col-lg-pull-0",colLgPull0:"QWRTc-col-lg-pull-0","col-lg-push-12":"_2FOMW-col-lg-push-12",colLgPush12:"_2FOMW-col-lg-push-12","col-lg-push-11":"IdWzr-col-lg-push-11",colLgPush11:"IdWzr-col-lg-push-11","col-lg-push-10":"_3qw3b-col-lg-push-10",colLgPush10:"_3qw3b-col-lg-push-10","col-lg-push-9":"_3LXj0-col-lg-push-9",colLgPush9:"_3LXj0-col-lg-push-9","col-lg-push-8":"_16qiV-col-lg-push-8",colLgPush8:"_16qiV-col-lg-push-8","col-lg-push-7":"_2OQk6-col-lg-push-7",colLgPush7:"_2OQk6-col-lg-push-7","col-lg-push-6":"_3o1se-col-lg-push-6",colLgPush6:"_3o1se-col-lg-push-6","col-lg-push-5":"_2dmyK-col-lg-push-5",colLgPush5:"_2dmyK-col-lg-push-5","col-lg-push-4":"_3pru9-col-lg-push-4",colLgPush4:"_3pru9-col-lg-push-4","col-lg-push-3":"_2lBUY-col-lg-push-3",colLgPush3:"_2lBUY-col-lg-push-3","col-lg-push-2":"_3FuDW-col-lg-push-2",colLgPush2:"_3FuDW-col-lg-push-2","col-lg-push-1":"_2YWH--col-lg-push-1",colLgPush1:"_2YWH--col-lg-push-1","col-lg-push-0":"_1iVNw-col-lg-push-0",colLgPush0:"_1iVNw-col-lg-push-0","col-lg-offset-12":"uQRYD-col-lg-offset-12",colLgOffset12:"uQRYD-col-lg-offset-12","col-lg-offset-11":"_1tWxb-col-lg-offset-11",colLgOffset11:"_1tWxb-col-lg-offset-11","col-lg-offset-10":"_10I1r-col-lg-offset-10",colLgOffset10:"_10I1r-col-lg-offset-10","col-lg-offset-9":"_3zXRb-col-lg-offset-9",colLgOffset9:"_3zXRb-col-lg-offset-9","col-lg-offset-8":"_3HNmx-col-lg-offset-8",colLgOffset8:"_3HNmx-col-lg-offset-8","col-lg-offset-7":"_3mIkn-col-lg-offset-7",colLgOffset7:"_3mIkn-col-lg-offset-7","col-lg-offset-6":"_22SUB-col-lg-offset-6",colLgOffset6:"_22SUB-col-lg-offset-6","col-lg-offset-5":"_1CR4c-col-lg-offset-5",colLgOffset5:"_1CR4c-col-lg-offset-5","col-lg-offset-4":"_1AWkt-col-lg-offset-4",colLgOffset4:"_1AWkt-col-lg-offset-4","col-lg-offset-3":"_2BzzA-col-lg-offset-3",colLgOffset3:"_2BzzA-col-lg-offset-3","col-lg-offset-2":"_3Egxi-col-lg-offset-2",colLgOffset2:"_3Egxi-col-lg-offset-2","col-lg-offset-1":"_3QHjG-col-lg-offset-1",colLgOffset1:"_3QHjG-col-lg-offset-1","col-lg-offset-0":"_3jTkc-col-lg-offset-0",colLgOffset0:"_3jTkc-col-lg-offset-0","table-condensed":"_1lZ-G-table-condensed",tableCondensed:"_1lZ-G-table-condensed","table-striped":"_2ysFO-table-striped",tableStriped:"_2ysFO-table-striped","table-hover":"_3Qd5Z-table-hover",tableHover:"_3Qd5Z-table-hover",active:"_2tvYY-active",success:"_2OgG2-success",info:"_2ItI5-info",warning:"KrRM8-warning",danger:"CwCgN-danger","table-responsive":"_3oGxj-table-responsive",tableResponsive:"_3oGxj-table-responsive",focus:"_2eLCD-focus",disabled:"_2UPmc-disabled","btn-default":"_7-4ed-btn-default",btnDefault:"_7-4ed-btn-default",open:"cl_nk-open","dropdown-toggle":"_2KnYE-dropdown-toggle",dropdownToggle:"_2KnYE-dropdown-toggle",badge:"_1XX3z-badge","btn-primary":"_1VbPL-btn-primary",btnPrimary:"_1VbPL-btn-primary","btn-success":"_1P0kt-btn-success",btnSuccess:"_1P0kt-btn-success","btn-info":"_25yw2-btn-info",btnInfo:"_25yw2-btn-info","btn-warning":"-tgFO-btn-warning",btnWarning:"-tgFO-btn-warning","btn-danger":"_1fOWb-btn-danger",btnDanger:"_1fOWb-btn-danger","btn-link":"_2k5vU-btn-link",btnLink:"_2k5vU-btn-link","btn-lg":"_4Y8qI-btn-lg",btnLg:"_4Y8qI-btn-lg","btn-group-lg":"_29CyS-btn-group-lg",btnGroupLg:"_29CyS-btn-group-lg","btn-sm":"_30Oip-btn-sm",btnSm:"_30Oip-btn-sm","btn-group-sm":"_2eEOC-btn-group-sm",btnGroupSm:"_2eEOC-btn-group-sm","btn-xs":"_2kCvE-btn-xs",btnXs:"_2kCvE-btn-xs","btn-group-xs":"_1A2Tx-btn-group-xs",btnGroupXs:"_1A2Tx-btn-group-xs","btn-block":"_3micb-btn-block",btnBlock:"_3micb-btn-block","btn-group":"_2yrMO-btn-group",btnGroup:"_2yrMO-btn-group","btn-group-vertical":"eNKyK-btn-group-vertical",btnGroupVertical:"eNKyK-btn-group-vertical","btn-toolbar":"_1TX6t-btn-toolbar",btnToolbar:"_1TX6t-btn-toolbar","input-group":"_2lgpZ-input-group",inputGroup:"_2lgpZ-input-group","btn-group-justified":"gzM9c-btn-group-justified",btnGroupJustified:"gzM9c-btn-group-justified","dropdown-menu":"_5aG9w-dropdown-menu",dropdownMenu:"_5aG9w-dropdown-menu",nav:"_18V3M-nav","nav-divider":"_3jwhe-nav-divider",navDivider:"_3jwhe-nav-divider","nav-tabs":"_2ciYP-nav-tabs",navTabs:"_2ciYP-nav-tabs","nav-justified":"_3nLJA-nav-justified",navJustified:"_3nLJA-nav-justified",dropdown:"_3oGVD-dropdown","nav-pills":"_3i5kW-nav-pills",navPills:"_3i5kW-nav-pills","nav-stacked":"_3pfVZ-nav-stacked",navStacked:"_3pfVZ-nav-stacked","nav-tabs-justified":"_18sQL-nav-tabs-justified",navTabsJustified:"_18sQL-nav-tabs-justified","tab-content":"FgbPD-tab-content",tabContent:"FgbPD-tab-content","tab-pane":"_2q1VF-tab-pane",tabPane:"_2q1VF-tab-pane",pagination:"n15Mn-pagination","pagination-lg":"L5I4g-pagination-lg",paginationLg:"L5I4g-pagination-lg","pagination-sm":"_3rY2q-pagination-sm",paginationSm:"_3rY2q-pagination-sm",panel:"bv_Mo-panel","panel-body":"_2wvLn-panel-body",panelBody:"_2wvLn-panel-body","panel-heading":"_31mw8-panel-heading",panelHeading:"_31mw8-panel-heading","panel-title":"_112QQ-panel-title",panelTitle:"_112QQ-panel-title","panel-footer":"_1qtb5-panel-footer",panelFooter:"_1qtb5-panel-footer","list-group":"_1vMKW-list-group",listGroup:"_1vMKW-list-group","panel-collapse":"_162Ti-panel-collapse",panelCollapse:"_162Ti-panel-collapse","list-group-item":"rhZLN-list-group-item",listGroupItem:"rhZLN-list-group-item","panel-group":"_2V8WR-panel-group",panelGroup:"_2V8WR-panel-group","panel-default":"_2diFa-panel-default",panelDefault:"_2diFa-panel-default","panel-primary":"_1I52t-panel-primary",panelPrimary:"_1I52t-panel-primary","panel-success":"_1mEg2-panel-success",panelSuccess:"_1mEg2-panel-success","panel-info":"_2rUCo-panel-info",panelInfo:"_2rUCo-panel-info","panel-warning":"_1ozkU-panel-warning",panelWarning:"_1ozkU-panel-warning","panel-danger":"_3dZ2--panel-danger",panelDanger:"_3dZ2--panel-danger",clearfix:"_2U5Vc-clearfix","center-block":"_1efU5-center-block",centerBlock:"_1efU5-center-block","pull-left":"_3XXWK-pull-left",pullLeft:"_3XXWK-pull-left",hide:"mceZN-hide",show:"_3CqPx-show",invisible:"_2ZgjX-invisible","text-hide":"_1y8Wm-text-hide",textHide:"_1y8Wm-text-hide",hidden:"_15mml-hidden",affix:"-JzKj-affix","visible-xs":"_3Pjmx-visible-xs",visibleXs:"_3Pjmx-visible-xs","visible-sm":"vFgce-visible-sm",visibleSm:"vFgce-visible-sm","visible-md":"_3qTZ9-visible-md",visibleMd:"_3qTZ9-visible-md","visible-lg":"tk8mW-visible-lg",visibleLg:"tk8mW-visible-lg","visible-xs-block":"_1Dhak-visible-xs-block",visibleXsBlock:"_1Dhak-visible-xs-block","visible-xs-inline":"_2NJ-T-visible-xs-inline",visibleXsInline:"_2NJ-T-visible-xs-inline","visible-xs-inline-block":"_1rjwZ-visible-xs-inline-block",visibleXsInlineBlock:"_1rjwZ-visible-xs-inline-block","visible-sm-block":"_2cGpj-visible-sm-block",visibleSmBlock:"_2cGpj-visible-sm-block","visible-sm-inline":"_14OBx-visible-sm-inline",visibleSmInline:"_14OBx-visible-sm-inline","visible-sm-inline-block":"_1IBCe-visible-sm-inline-block",visibleSmInlineBlock:"_1IBCe-visible-sm-inline-block","visible-md-block":"_1b70N-visible-md-block",visibleMdBlock:"_1b70N-visible-md-block","visible-md-inline":"_2FNrX-visible-md-inline",visibleMdInline:"_2FNrX-visible-md-inline","visible-md-inline-block":"_1MCNX-visible-md-inline-block",visibleMdInlineBlock:"_1MCNX-visible-md-inline-block","visible-lg-block":"yzCgL-visible-lg-block",visibleLgBlock:"yzCgL-visible-lg-block","visible-lg-inline":"_1Ad52-visible-lg-inline",visibleLgInline:"_1Ad52-visible-lg-inline","visible-lg-inline-block":"_2hCmO-visible-lg-inline-block",visibleLgInlineBlock:"_2hCmO-visible-lg-inline-block","hidden-xs":"_1qlNJ-hidden-xs",hiddenXs:"_1qlNJ-hidden-xs","hidden-sm":"_1UcP8-hidden-sm",hiddenSm:"_1UcP8-hidden-sm","hidden-md":"TILkE-hidden-md",hiddenMd:"TILkE-hidden-md","hidden-lg":"_2wXaD-hidden-lg",hiddenLg:"_2wXaD-hidden-lg","visible-print":"_3ADjb-visible-print",visiblePrint:"_3ADjb-visible-print","visible-print-block":"_3gdWc-visible-print-block",visiblePrintBlock:"_3gdWc-visible-print-block","visible-print-inline":"_2HCJf-visible-print-inline",visiblePrintInline:"_2HCJf-visible-print-inline","visible-print-inline-block":"_2oyVc-visible-print-inline-block",visiblePrintInlineBlock:"_2oyVc-visible-print-inline-block","hidden-print":"xjc1G-hidden-print",hiddenPrint:"xjc1G-hidden-print"}},function(e,t){"use strict";Object.defineProperty(t,"__esModule",{value:!0}),t.default=function(){for(var e=arguments.length,t=Array(e),n=0;n<e;n++)t[n]=arguments[n];return t.join(" ")}},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),s=n(1),c=o(s),u=n(184),d=o(u),p=n(173),f=o(p),h=n(180),m=o(h),b=n(182),g=o(b),_=function(e){function t(){var e,n,o,a;r(this,t);for(var i=arguments.length,s=Array(i),c=0;c<i;c++)s[c]=arguments[c];return n=o=l(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(s))),o.state={selected:[],page:1,count:0,jobs:[]},a=n,l(o,a)}return a(t,e),i(t,[{key:"fetch",value:function(e){function t(){return e.apply(this,arguments)}return t.toString=function(){return e.toString()},t}(function(){var e=this;this.props.fetchURL&&fetch(this.props.fetchURL+"?page="+this.state.page).then(function(e){return e.json()}).then(function(t){e.setState({selected:[],count:t.count,jobs:t.jobs})})})},{key:"componentWillMount",value:function(){this.fetch()}},{key:"updatePage",value:function(e){this.setState({page:e},this.fetch)}},{key:"checked",value:function(e){return this.state.selected.includes(e)}},{key:"check",value:function(e){var t=this.state.selected.indexOf(e);t>=0?this.state.selected.splice(t,1):this.state.selected.push(e),this.setState({selected:this.state.selected})}},{key:"checkAll",value:function(){var e=this;this.state.selected.length>0?this.setState({selected:[]}):(this.state.jobs.map(function(t){e.state.selected.push(t)}),this.setState({selected:this.state.selected}))}},{key:"deleteAll",value:function(){var e=this;this.props.deleteAllURL&&fetch(this.props.deleteAllURL,{method:"post"}).then(function(){e.updatePage(1)})}},{key:"deleteSelected",value:function(){var e=this,t=[];this.state.selected.map(function(n){e.props.deleteURL&&t.push(fetch(e.props.deleteURL+"/"+n.died_at+"/"+n.id,{method:"post"}))}),Promise.all(t).then(function(){e.fetch()})}},{key:"retryAll",value:function(){var e=this;this.props.retryAllURL&&fetch(this.props.retryAllURL,{method:"post"}).then(function(){e.updatePage(1)})}},{key:"retrySelected",value:function(){var e=this,t=[];this.state.selected.map(function(n){e.props.retryURL&&t.push(fetch(e.props.retryURL+"/"+n.died_at+"/"+n.id,{method:"post"}))}),Promise.all(t).then(function(){e.fetch()})}},{key:"render",value:function(){var e=this;return c.default.createElement("div",null,c.default.createElement("div",{className:(0,g.default)(m.default.panel,m.default.panelDefault)},c.default.createElement("div",{className:m.default.panelHeading},"Dead Jobs"),c.default.createElement("div",{className:m.default.panelBody},c.default.createElement("p",null,this.state.count," job(s) are dead."),c.default.createElement(d.default,{page:this.state.page,totalCount:this.state.count,perPage:20,jumpTo:function(t){return function(){return e.updatePage(t)}}})),c.default.createElement("div",{className:m.default.tableResponsive},c.default.createElement("table",{className:m.default.table},c.default.createElement("tbody",null,c.default.createElement("tr",null,c.default.createElement("th",null,c.default.createElement("input",{type:"checkbox",checked:this.state.selected.length>0,onChange:function(){return e.checkAll()}})),c.default.createElement("th",null,"Name"),c.default.createElement("th",null,"Arguments"),c.default.createElement("th",null,"Error"),c.default.createElement("th",null,"Died At")),this.state.jobs.map(function(t){return c.default.createElement("tr",{key:t.id},c.default.createElement("td",null,c.default.createElement("input",{type:"checkbox",checked:e.checked(t),onChange:function(){return e.check(t)}})),c.default.createElement("td",null,t.name),c.default.createElement("td",null,JSON.stringify(t.args)),c.default.createElement("td",null,t.err),c.default.createElement("td",null,c.default.createElement(f.default,{ts:t.t})))}))))),c.default.createElement("div",{className:m.default.btnGroup,role:"group"},c.default.createElement("button",{type:"button",className:(0,g.default)(m.default.btn,m.default.btnDefault),onClick:function(){return e.deleteSelected()}},"Delete Selected Jobs"),c.default.createElement("button",{type:"button",className:(0,g.default)(m.default.btn,m.default.btnDefault),onClick:function(){return e.retrySelected()}},"Retry Selected Jobs"),c.default.createElement("button",{type:"button",className:(0,g.default)(m.default.btn,m.default.btnDefault),onClick:function(){return e.deleteAll()}},"Delete All Jobs"),c.default.createElement("button",{type:"button",className:(0,g.default)(m.default.btn,m.default.btnDefault),onClick:function(){return e.retryAll()}},"Retry All Jobs")))}}]),t}(c.default.Component);_.propTypes={fetchURL:c.default.PropTypes.string,deleteURL:c.default.PropTypes.string,deleteAllURL:c.default.PropTypes.string,retryURL:c.default.PropTypes.string,retryAllURL:c.default.PropTypes.string},t.default=_},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),s=n(1),c=o(s),u=n(180),d=o(u),p=function(e){function t(){return r(this,t),l(this,(t.__proto__||Object.getPrototypeOf(t)).apply(this,arguments))}return a(t,e),i(t,[{key:"shouldShow",value:function(e){return 1==e||e==this.totalPage||Math.abs(this.props.page-e)<=1}},{key:"render",value:function(){if(0==this.totalPage)return null;for(var e=[],t=1;t<=this.totalPage;t++)t==this.props.page?e.push(c.default.createElement("li",{key:t,className:d.default.active},c.default.createElement("span",null,t))):this.shouldShow(t)?e.push(c.default.createElement("li",{key:t},c.default.createElement("a",{onClick:this.props.jumpTo(t)},t))):this.shouldShow(t-1)&&e.push(c.default.createElement("li",{key:t,className:d.default.disabled},c.default.createElement("span",null,"..")));return c.default.createElement("ul",{className:d.default.pagination},e)}},{key:"totalPage",get:function(){return Math.ceil(this.props.totalCount/this.props.perPage)}}]),t}(c.default.Component);p.propTypes={page:c.default.PropTypes.number.isRequired,perPage:c.default.PropTypes.number.isRequired,totalCount:c.default.PropTypes.number.isRequired,jumpTo:c.default.PropTypes.func.isRequired},t.default=p},function(module,exports,__webpack_require__){"use strict";Object.defineProperty(exports,"__esModule",{value:true});function _interopRequireDefault(obj){return obj&&obj.__esModule?obj:{default:obj}}var React=_interopRequireDefault(__webpack_require__(1)).default,styles=_interopRequireDefault(__webpack_require__(180)).default,cx=_interopRequireDefault(__webpack_require__(182)).default;class Queues extends React.Component{constructor(...args){super(...args);this.state={queues:[],cancelJobID:"",cancelStatus:""}}fetch(){if(!this.props.url){return}fetch(this.props.url).then(resp=>resp.json()).then(data=>{this.setState({queues:data})})}componentWillMount(){this.fetch()}togglePause(queue){let url=queue.paused?this.props.unpauseURL:this.props.pauseURL;if(!url){return}fetch(`${url}/${queue.job_name}`,{method:"post"}).then(()=>{this.fetch()})}cancelJob(){let jobID=this.state.cancelJobID.trim();if(!this.props.cancelURL||!jobID){return}fetch(`${this.props.cancelURL}/${jobID}`,{method:"post"}).then(resp=>resp.json()).then(data=>{let cancelStatus=data.status==="ok"?`Job ${jobID} was cancelled.`:`Job ${jobID} wasn't found in the queues, it will be skipped if it shows up.`;this.setState({cancelJobID:"",cancelStatus:cancelStatus});this.fetch()})}get queuedCount(){let count=0;this.state.queues.map(queue=>{count+=queue.count});return count}render(){return React.createElement("div",{className:cx(styles.panel,styles.panelDefault)},React.createElement("div",{className:styles.panelHeading},"queues"),React.createElement("div",{className:styles.panelBody},React.createElement("p",null,this.state.queues.length," queue(s) with a total of ",this.queuedCount," item(s) queued."),React.createElement("p",null,React.createElement("input",{type:"text",placeholder:"Job ID",value:this.state.cancelJobID,onChange:e=>this.setState({cancelJobID:e.target.value})}),React.createElement("button",{className:cx(styles.btn,styles.btnDefault,styles.btnXs),onClick:()=>this.cancelJob()},"Cancel Job"),this.state.cancelStatus&&React.createElement("span",null," ",this.state.cancelStatus))),React.createElement("div",{className:styles.tableResponsive},React.createElement("table",{className:styles.table},React.createElement("tbody",null,React.createElement("tr",null,React.createElement("th",null,"Name"),React.createElement("th",null,"Count"),React.createElement("th",null,"Latency (seconds)"),React.createElement("th",null,"Paused")),this.state.queues.map(queue=>{return React.createElement("tr",{key:queue.job_name},React.createElement("td",null,queue.job_name),React.createElement("td",null,queue.count),React.createElement("td",null,queue.latency),React.createElement("td",null,React.createElement("button",{className:cx(styles.btn,styles.btnDefault,styles.btnXs),onClick:()=>this.togglePause(queue)},queue.paused?"Unpause":"Pause")))})))))}}exports.default=Queues;Queues.propTypes={url:React.PropTypes.string,pauseURL:React.PropTypes.string,unpauseURL:React.PropTypes.string,cancelURL:React.PropTypes.string}},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),s=n(1),c=o(s),u=n(184),d=o(u),p=n(173),f=o(p),h=n(180),m=o(h),b=n(182),g=o(b),_=function(e){function t(){var e,n,o,a;r(this,t);for(var i=arguments.length,s=Array(i),c=0;c<i;c++)s[c]=arguments[c];return n=o=l(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(s))),o.state={page:1,count:0,jobs:[]},a=n,l(o,a)}return a(t,e),i(t,[{key:"fetch",value:function(e){function t(){return e.apply(this,arguments)}return t.toString=function(){return e.toString()},t}(function(){var e=this;this.props.url&&fetch(this.props.url+"?page="+this.state.page).then(function(e){return e.json()}).then(function(t){e.setState({count:t.count,jobs:t.jobs})})})},{key:"componentWillMount",value:function(){this.fetch()}},{key:"updatePage",value:function(e){this.setState({page:e},this.fetch)}},{key:"render",value:function(){var e=this;return c.default.createElement("div",{className:(0,g.default)(m.default.panel,m.default.panelDefault)},c.default.createElement("div",{className:m.default.panelHeading},"Retry Jobs"),c.default.createElement("div",{className:m.default.panelBody},c.default.createElement("p",null,this.state.count," job(s) scheduled to be retried."),c.default.createElement(d.default,{page:this.state.page,totalCount:this.state.count,perPage:20,jumpTo:function(t){return function(){return e.updatePage(t)}}})),c.default.createElement("div",{className:m.default.tableResponsive},c.default.createElement("table",{className:m.default.table},c.default.createElement("tbody",null,c.default.createElement("tr",null,c.default.createElement("th",null,"Name"),c.default.createElement("th",null,"Arguments"),c.default.createElement("th",null,"Error"),c.default.createElement("th",null,"Retry At")),this.state.jobs.map(function(e){return c.default.createElement("tr",{key:e.id},c.default.createElement("td",null,e.name),c.default.createElement("td",null,JSON.stringify(e.args)),c.default.createElement("td",null,e.err),c.default.createElement("td",null,c.default.createElement(f.default,{ts:e.t})))})))))}}]),t}(c.default.Component);_.propTypes={url:c.default.PropTypes.string},t.default=_},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(!(e instanceof t))throw new TypeError("Cannot call a class as a function")}function l(e,t){if(!e)throw new ReferenceError("this hasn't been initialised - super() hasn't been called");return!t||"object"!=typeof t&&"function"!=typeof t?e:t}function a(e,t){if("function"!=typeof t&&null!==t)throw new TypeError("Super expression must either be null or a function, not "+typeof t);e.prototype=Object.create(t&&t.prototype,{constructor:{value:e,enumerable:!1,writable:!0,configurable:!0}}),t&&(Object.setPrototypeOf?Object.setPrototypeOf(e,t):e.__proto__=t)}Object.defineProperty(t,"__esModule",{value:!0});var i=function(){function e(e,t){for(var n=0;n<t.length;n++){var o=t[n];o.enumerable=o.enumerable||!1,o.configurable=!0,"value"in o&&(o.writable=!0),Object.defineProperty(e,o.key,o)}}return function(t,n,o){return n&&e(t.prototype,n),o&&e(t,o),t}}(),s=n(1),c=o(s),u=n(184),d=o(u),p=n(173),f=o(p),h=n(180),m=o(h),b=n(182),g=o(b),_=function(e){function t(){var e,n,o,a;r(this,t);for(var i=arguments.length,s=Array(i),c=0;c<i;c++)s[c]=arguments[c];return n=o=l(this,(e=t.__proto__||Object.getPrototypeOf(t)).call.apply(e,[this].concat(s))),o.state={page:1,count:0,jobs:[]},a=n,l(o,a)}return a(t,e),i(t,[{key:"fetch",value:function(e){function t(){return e.apply(this,arguments)}return t.toString=function(){return e.toString()},t}(function(){var e=this;this.props.url&&fetch(this.props.url+"?page="+this.state.page).then(function(e){return e.json()}).then(function(t){e.setState({count:t.count,jobs:t.jobs})})})},{key:"componentWillMount",value:function(){this.fetch()}},{key:"updatePage",value:function(e){this.setState({page:e},this.fetch)}},{key:"render",value:function(){var e=this;return c.default.createElement("div",{className:(0,g.default)(m.default.panel,m.default.panelDefault)},c.default.createElement("div",{className:m.default.panelHeading},"Scheduled Jobs"),c.default.createElement("div",{className:m.default.panelBody},c.default.createElement("p",null,this.state.count," job(s) scheduled."),c.default.createElement(d.default,{page:this.state.page,totalCount:this.state.count,perPage:20,jumpTo:function(t){return function(){return e.updatePage(t)}}})),c.default.createElement("div",{className:m.default.tableResponsive},c.default.createElement("table",{className:m.default.table},c.default.createElement("tbody",null,c.default.createElement("tr",null,c.default.createElement("th",null,"Name"),c.default.createElement("th",null,"Arguments"),c.default.createElement("th",null,"Scheduled For")),this.state.jobs.map(function(e){return c.default.createElement("tr",{key:e.id},c.default.createElement("td",null,e.name),c.default.createElement("td",null,JSON.stringify(e.args)),c.default.createElement("td",null,c.default.createElement(f.default,{ts:e.t})))})))))}}]),t}(c.default.Component);_.propTypes={url:c.default.PropTypes.string},t.default=_},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0,t.createMemoryHistory=t.hashHistory=t.browserHistory=t.applyRouterMiddleware=t.formatPattern=t.useRouterHistory=t.match=t.routerShape=t.locationShape=t.PropTypes=t.RoutingContext=t.RouterContext=t.createRoutes=t.useRoutes=t.RouteContext=t.Lifecycle=t.History=t.Route=t.Redirect=t.IndexRoute=t.IndexRedirect=t.withRouter=t.IndexLink=t.Link=t.Router=void 0;var r=n(189);Object.defineProperty(t,"createRoutes",{enumerable:!0,get:function(){return r.createRoutes}});var l=n(190);Object.defineProperty(t,"locationShape",{enumerable:!0,get:function(){return l.locationShape}}),Object.defineProperty(t,"routerShape",{enumerable:!0,get:function(){return l.routerShape}});var a=n(195);Object.defineProperty(t,"formatPattern",{enumerable:!0,get:function(){return a.formatPattern}});var i=n(197),s=o(i),c=n(228),u=o(c),d=n(229),p=o(d),f=n(230),h=o(f),m=n(232),b=o(m),g=n(234),_=o(g),v=n(233),y=o(v),x=n(235),w=o(x),k=n(236),E=o(k),C=n(237),P=o(C),M=n(238),O=o(M),R=n(239),S=o(R),T=n(225),N=o(T),L=n(240),I=o(L),j=o(l),U=n(241),A=o(U),D=n(245),H=o(D),q=n(246),Y=o(q),F=n(247),W=o(F),V=n(250),X=o(V),B=n(242),K=o(B);t.Router=s.default,t.Link=u.default,t.IndexLink=p.default,t.withRouter=h.default,t.IndexRedirect=b.default,t.IndexRoute=_.default,t.Redirect=y.default,t.Route=w.default,t.History=E.default,t.Lifecycle=P.default,t.RouteContext=O.default,t.useRoutes=S.default,t.RouterContext=N.default,t.RoutingContext=I.default,t.PropTypes=j.default,t.match=A.default,t.useRouterHistory=H.default,t.applyRouterMiddleware=Y.default,t.browserHistory=W.default,t.hashHistory=X.default,t.createMemoryHistory=K.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){return null==e||p.default.isValidElement(e)}function l(e){return r(e)||Array.isArray(e)&&e.every(r)}function a(e,t){return u({},e,t)}function i(e){var t=e.type,n=a(t.defaultProps,e.props);if(n.children){var o=s(n.children,n);o.length&&(n.childRoutes=o),delete n.children}return n}function s(e,t){var n=[];return p.default.Children.forEach(e,function(e){if(p.default.isValidElement(e))if(e.type.createRouteFromReactElement){var o=e.type.createRouteFromReactElement(e,t);o&&n.push(o)}else n.push(i(e))}),n}function c(e){return l(e)?e=s(e):e&&!Array.isArray(e)&&(e=[e]),e}t.__esModule=!0;var u=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e};t.isReactChildren=l,t.createRouteFromReactElement=i,t.createRoutesFromReactChildren=s,t.createRoutes=c;var d=n(1),p=o(d)},function(e,t,n){"use strict";function o(e){if(e&&e.__esModule)return e;var t={};if(null!=e)for(var n in e)Object.prototype.hasOwnProperty.call(e,n)&&(t[n]=e[n]);return t.default=e,t}function r(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0,t.router=t.routes=t.route=t.components=t.component=t.location=t.history=t.falsy=t.locationShape=t.routerShape=void 0;var l=n(1),a=n(191),i=(r(a),n(194)),s=o(i),c=n(192),u=(r(c),l.PropTypes.func),d=l.PropTypes.object,p=l.PropTypes.shape,f=l.PropTypes.string,h=t.routerShape=p({push:u.isRequired,replace:u.isRequired,go:u.isRequired,goBack:u.isRequired,goForward:u.isRequired,setRouteLeaveHook:u.isRequired,isActive:u.isRequired}),m=t.locationShape=p({pathname:f.isRequired,search:f.isRequired,state:d,action:f.isRequired,key:f}),b=t.falsy=s.falsy,g=t.history=s.history,_=t.location=m,v=t.component=s.component,y=t.components=s.components,x=t.route=s.route,w=(t.routes=s.routes,t.router=h),k={falsy:b,history:g,location:_,component:v,components:y,route:x,router:w};t.default=k},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0,t.canUseMembrane=void 0;var r=n(192),l=(o(r),t.canUseMembrane=!1,function(e){return e});t.default=l},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){if(t.indexOf("deprecated")!==-1){if(s[t])return;s[t]=!0}t="[react-router] "+t;for(var n=arguments.length,o=Array(n>2?n-2:0),r=2;r<n;r++)o[r-2]=arguments[r];i.default.apply(void 0,[e,t].concat(o))}function l(){s={}}t.__esModule=!0,t.default=r,t._resetWarned=l;var a=n(193),i=o(a),s={}},function(e,t,n){"use strict";var o=function(){};e.exports=o},function(e,t,n){"use strict";function o(e,t,n){if(e[t])return new Error("<"+n+'> should not have a "'+t+'" prop')}t.__esModule=!0,t.routes=t.route=t.components=t.component=t.history=void 0,t.falsy=o;var r=n(1),l=r.PropTypes.func,a=r.PropTypes.object,i=r.PropTypes.arrayOf,s=r.PropTypes.oneOfType,c=r.PropTypes.element,u=r.PropTypes.shape,d=r.PropTypes.string,p=(t.history=u({listen:l.isRequired,push:l.isRequired,replace:l.isRequired,go:l.isRequired,goBack:l.isRequired,goForward:l.isRequired}),t.component=s([l,d])),f=(t.components=s([p,a]),t.route=s([a,c]));t.routes=s([f,i(f)])},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){return e.replace(/[.*+?^${}()|[\]\\]/g,"\\$&")}function l(e){for(var t="",n=[],o=[],l=void 0,a=0,i=/:([a-zA-Z_$][a-zA-Z0-9_$]*)|\*\*|\*|\(|\)/g;l=i.exec(e);)l.index!==a&&(o.push(e.slice(a,l.index)),t+=r(e.slice(a,l.index))),l[1]?(t+="([^/]+)",n.push(l[1])):"**"===l[0]?(t+="(.*)",n.push("splat")):"*"===l[0]?(t+="(.*?)",n.push("splat")):"("===l[0]?t+="(?:":")"===l[0]&&(t+=")?"),o.push(l[0]),a=i.lastIndex;return a!==e.length&&(o.push(e.slice(a,e.length)),t+=r(e.slice(a,e.length))),{pattern:e,regexpSource:t,paramNames:n,tokens:o}}function a(e){return f[e]||(f[e]=l(e)),f[e]}function i(e,t){"/"!==e.charAt(0)&&(e="/"+e);var n=a(e),o=n.regexpSource,r=n.paramNames,l=n.tokens;"/"!==e.charAt(e.length-1)&&(o+="/?"),"*"===l[l.length-1]&&(o+="$");var i=t.match(new RegExp("^"+o,"i"));if(null==i)return null;var s=i[0],c=t.substr(s.length);if(c){if("/"!==s.charAt(s.length-1))return null;c="/"+c}return{remainingPathname:c,paramNames:r,paramValues:i.slice(1).map(function(e){return e&&decodeURIComponent(e)})}}function s(e){return a(e).paramNames}function c(e,t){var n=i(e,t);if(!n)return null;var o=n.paramNames,r=n.paramValues,l={};return o.forEach(function(e,t){l[e]=r[t]}),l}function u(e,t){t=t||{};for(var n=a(e),o=n.tokens,r=0,l="",i=0,s=void 0,c=void 0,u=void 0,d=0,f=o.length;d<f;++d)s=o[d],"*"===s||"**"===s?(u=Array.isArray(t.splat)?t.splat[i++]:t.splat,null!=u||r>0?void 0:(0,p.default)(!1),null!=u&&(l+=encodeURI(u))):"("===s?r+=1:")"===s?r-=1:":"===s.charAt(0)?(c=s.substring(1),u=t[c],null!=u||r>0?void 0:(0,p.default)(!1),null!=u&&(l+=encodeURIComponent(u))):l+=s;return l.replace(/\/+/g,"/")}t.__esModule=!0,t.compilePattern=a,t.matchPattern=i,t.getParamNames=s,t.getParams=c,t.formatPattern=u;var d=n(196),p=o(d),f=Object.create(null)},function(e,t,n){"use strict";var o=function(e,t,n,o,r,l,a,i){if(!e){var s;if(void 0===t)s=new Error("Minified exception occurred; use the non-minified dev environment for the full error message and additional helpful warnings.");else{var c=[n,o,r,l,a,i],u=0;s=new Error(t.replace(/%s/g,function(){return c[u++]})),s.name="Invariant Violation"}throw s.framesToPop=1,s}};e.exports=o},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){var n={};for(var o in e)t.indexOf(o)>=0||Object.prototype.hasOwnProperty.call(e,o)&&(n[o]=e[o]);return n}function l(e){return!e||!e.__v2_compatible__}function a(e){return e&&e.getCurrentLocation}t.__esModule=!0;var i=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},s=n(198),c=o(s),u=n(214),d=o(u),p=n(196),f=o(p),h=n(1),m=o(h),b=n(217),g=o(b),_=n(194),v=n(225),y=o(v),x=n(189),w=n(227),k=n(192),E=(o(k),m.default.PropTypes),C=E.func,P=E.object,M=m.default.createClass({displayName:"Router",propTypes:{history:P,children:_.routes,routes:_.routes,render:C,createElement:C,onError:C,onUpdate:C,parseQueryString:C,stringifyQuery:C,matchContext:P},getDefaultProps:function(){return{render:function(e){return m.default.createElement(y.default,e)}}},getInitialState:function(){return{location:null,routes:null,params:null,components:null}},handleError:function(e){if(!this.props.onError)throw e;this.props.onError.call(this,e)},componentWillMount:function(){var e=this,t=this.props,n=(t.parseQueryString,t.stringifyQuery,this.createRouterObjects()),o=n.history,r=n.transitionManager,l=n.router;this._unlisten=r.listen(function(t,n){t?e.handleError(t):e.setState(n,e.props.onUpdate)}),this.history=o,this.router=l},createRouterObjects:function(){var e=this.props.matchContext;if(e)return e;var t=this.props.history,n=this.props,o=n.routes,r=n.children;a(t)?(0,f.default)(!1):void 0,l(t)&&(t=this.wrapDeprecatedHistory(t));var i=(0,g.default)(t,(0,x.createRoutes)(o||r)),s=(0,w.createRouterObject)(t,i),c=(0,w.createRoutingHistory)(t,i);return{history:c,transitionManager:i,router:s}},wrapDeprecatedHistory:function(e){var t=this.props,n=t.parseQueryString,o=t.stringifyQuery,r=void 0;return r=e?function(){return e}:c.default,(0,d.default)(r)({parseQueryString:n,stringifyQuery:o})},componentWillReceiveProps:function(e){},componentWillUnmount:function(){this._unlisten&&this._unlisten()},render:function e(){var t=this.state,n=t.location,o=t.routes,l=t.params,a=t.components,s=this.props,c=s.createElement,e=s.render,u=r(s,["createElement","render"]);return null==n?null:(Object.keys(M.propTypes).forEach(function(e){return delete u[e]}),e(i({},u,{history:this.history,router:this.router,location:n,routes:o,params:l,components:a,createElement:c})))}});t.default=M,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){return"string"==typeof e&&"/"===e.charAt(0)}function l(){var e=g.getHashPath();return!!r(e)||(g.replaceHashPath("/"+e),!1)}function a(e,t,n){return e+(e.indexOf("?")===-1?"?":"&")+(t+"="+n)}function i(e,t){return e.replace(new RegExp("[?&]?"+t+"=[a-zA-Z0-9]+"),"")}function s(e,t){var n=e.match(new RegExp("\\?.*?\\b"+t+"=(.+?)\\b"));return n&&n[1]}function c(){function e(){var e=g.getHashPath(),t=void 0,n=void 0;M?(t=s(e,M),e=i(e,M),t?n=_.readState(t):(n=null,t=O.createKey(),g.replaceHashPath(a(e,M,t)))):t=n=null;var o=m.parsePath(e);return O.createLocation(u({},o,{state:n}),void 0,t)}function t(t){function n(){l()&&o(e())}var o=t.transitionTo;return l(),g.addEventListener(window,"hashchange",n),function(){g.removeEventListener(window,"hashchange",n)}}function n(e){var t=e.basename,n=e.pathname,o=e.search,r=e.state,l=e.action,i=e.key;if(l!==h.POP){var s=(t||"")+n+o;M?(s=a(s,M,i),_.saveState(i,r)):e.key=e.state=null;var c=g.getHashPath();l===h.PUSH?c!==s&&(window.location.hash=s):c!==s&&g.replaceHashPath(s)}}function o(e){1===++R&&(S=t(O));var n=O.listenBefore(e);return function(){n(),0===--R&&S()}}function r(e){1===++R&&(S=t(O));var n=O.listen(e);return function(){n(),0===--R&&S()}}function c(e){O.push(e)}function d(e){O.replace(e)}function p(e){O.go(e)}function v(e){return"#"+O.createHref(e)}function w(e){1===++R&&(S=t(O)),O.registerTransitionHook(e)}function k(e){O.unregisterTransitionHook(e),0===--R&&S()}function E(e,t){O.pushState(e,t)}function C(e,t){O.replaceState(e,t)}var P=arguments.length<=0||void 0===arguments[0]?{}:arguments[0];b.canUseDOM?void 0:f.default(!1);var M=P.queryKey;(void 0===M||M)&&(M="string"==typeof M?M:x);var O=y.default(u({},P,{getCurrentLocation:e,finishTransition:n,saveState:_.saveState})),R=0,S=void 0;g.supportsGoWithoutReloadUsingHash();return u({},O,{listenBefore:o,listen:r,push:c,replace:d,go:p,createHref:v,registerTransitionHook:w,unregisterTransitionHook:k,pushState:E,replaceState:C})}t.__esModule=!0;var u=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},d=n(199),p=(o(d),n(196)),f=o(p),h=n(200),m=n(201),b=n(202),g=n(203),_=n(204),v=n(205),y=o(v),x="_k";t.default=c,e.exports=t.default},function(e,t,n){"use strict";var o=function(){};e.exports=o},function(e,t){"use strict";t.__esModule=!0;var n="PUSH";t.PUSH=n;var o="REPLACE";t.REPLACE=o;var r="POP";t.POP=r,t.default={PUSH:n,REPLACE:o,POP:r}},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){var t=e.match(/^https?:\/\/[^\/]*/);return null==t?e:e.substring(t[0].length)}function l(e){var t=r(e),n="",o="",l=t.indexOf("#");l!==-1&&(o=t.substring(l),t=t.substring(0,l));var a=t.indexOf("?");return a!==-1&&(n=t.substring(a),t=t.substring(0,a)),""===t&&(t="/"),{pathname:t,search:n,hash:o}}t.__esModule=!0,t.extractPath=r,t.parsePath=l;var a=n(199);o(a)},function(e,t){"use strict";t.__esModule=!0;var n=!("undefined"==typeof window||!window.document||!window.document.createElement);t.canUseDOM=n},function(e,t){"use strict";function n(e,t,n){e.addEventListener?e.addEventListener(t,n,!1):e.attachEvent("on"+t,n)}function o(e,t,n){e.removeEventListener?e.removeEventListener(t,n,!1):e.detachEvent("on"+t,n)}function r(){return window.location.href.split("#")[1]||""}function l(e){window.location.replace(window.location.pathname+window.location.search+"#"+e)}function a(){return window.location.pathname+window.location.search+window.location.hash}function i(e){e&&window.history.go(e)}function s(e,t){t(window.confirm(e))}function c(){var e=navigator.userAgent;return(e.indexOf("Android 2.")===-1&&e.indexOf("Android 4.0")===-1||e.indexOf("Mobile Safari")===-1||e.indexOf("Chrome")!==-1||e.indexOf("Windows Phone")!==-1)&&(window.history&&"pushState"in window.history)}function u(){var e=navigator.userAgent;return e.indexOf("Firefox")===-1}t.__esModule=!0,t.addEventListener=n,t.removeEventListener=o,t.getHashPath=r,t.replaceHashPath=l,t.getWindowPath=a,t.go=i,t.getUserConfirmation=s,t.supportsHistory=c,t.supportsGoWithoutReloadUsingHash=u},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){return s+e}function l(e,t){try{null==t?window.sessionStorage.removeItem(r(e)):window.sessionStorage.setItem(r(e),JSON.stringify(t))}catch(e){if(e.name===u)return;if(c.indexOf(e.name)>=0&&0===window.sessionStorage.length)return;throw e}}function a(e){var t=void 0;try{t=window.sessionStorage.getItem(r(e))}catch(e){if(e.name===u)return null}if(t)try{return JSON.parse(t)}catch(e){}return null}t.__esModule=!0,t.saveState=l,t.readState=a;var i=n(199),s=(o(i),"@@History/"),c=["QuotaExceededError","QUOTA_EXCEEDED_ERR"],u="SecurityError"},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){function t(e){return s.canUseDOM?void 0:i.default(!1),n.listen(e)}var n=d.default(l({getUserConfirmation:c.getUserConfirmation},e,{go:c.go}));return l({},n,{listen:t})}t.__esModule=!0;var l=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},a=n(196),i=o(a),s=n(202),c=n(203),u=n(206),d=o(u);t.default=r,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){return Math.random().toString(36).substr(2,e)}function l(e,t){return e.pathname===t.pathname&&e.search===t.search&&e.key===t.key&&u.default(e.state,t.state)}function a(){function e(e){return D.push(e),function(){D=D.filter(function(t){return t!==e})}}function t(){return F&&F.action===f.POP?H.indexOf(F.key):Y?H.indexOf(Y.key):-1}function n(e){var n=t();Y=e,Y.action===f.PUSH?H=[].concat(H.slice(0,n+1),[Y.key]):Y.action===f.REPLACE&&(H[n]=Y.key),q.forEach(function(e){e(Y)})}function o(e){if(q.push(e),Y)e(Y);else{var t=N();H=[t.key],n(t)}return function(){q=q.filter(function(t){return t!==e})}}function a(e,t){p.loopAsync(D.length,function(t,n,o){g.default(D[t],e,function(e){null!=e?o(e):n()})},function(e){U&&"string"==typeof e?U(e,function(e){t(e!==!1)}):t(e!==!1)})}function s(e){Y&&l(Y,e)||(F=e,a(e,function(t){if(F===e)if(t){if(e.action===f.PUSH){var o=w(Y),r=w(e);r===o&&u.default(Y.state,e.state)&&(e.action=f.REPLACE)}L(e)!==!1&&n(e)}else if(Y&&e.action===f.POP){var l=H.indexOf(Y.key),a=H.indexOf(e.key);l!==-1&&a!==-1&&j(l-a)}}))}function c(e){s(E(e,f.PUSH,x()))}function h(e){s(E(e,f.REPLACE,x()))}function b(){j(-1)}function _(){j(1)}function x(){return r(A)}function w(e){if(null==e||"string"==typeof e)return e;var t=e.pathname,n=e.search,o=e.hash,r=t;return n&&(r+=n),o&&(r+=o),r}function k(e){return w(e)}function E(e,t){var n=arguments.length<=2||void 0===arguments[2]?x():arguments[2];return"object"==typeof t&&("string"==typeof e&&(e=d.parsePath(e)),e=i({},e,{state:t}),t=n,n=arguments[3]||x()),m.default(e,t,n)}function C(e){Y?(P(Y,e),n(Y)):P(N(),e)}function P(e,t){e.state=i({},e.state,t),I(e.key,e.state)}function M(e){D.indexOf(e)===-1&&D.push(e)}function O(e){D=D.filter(function(t){return t!==e})}function R(e,t){"string"==typeof t&&(t=d.parsePath(t)),c(i({state:e},t))}function S(e,t){"string"==typeof t&&(t=d.parsePath(t)),h(i({state:e},t))}var T=arguments.length<=0||void 0===arguments[0]?{}:arguments[0],N=T.getCurrentLocation,L=T.finishTransition,I=T.saveState,j=T.go,U=T.getUserConfirmation,A=T.keyLength;"number"!=typeof A&&(A=y);var D=[],H=[],q=[],Y=void 0,F=void 0;return{listenBefore:e,listen:o,transitionTo:s,push:c,replace:h,go:j,goBack:b,goForward:_,createKey:x,createPath:w,createHref:k,createLocation:E,setState:v.default(C,"setState is deprecated; use location.key to save state instead"),registerTransitionHook:v.default(M,"registerTransitionHook is deprecated; use listenBefore instead"),unregisterTransitionHook:v.default(O,"unregisterTransitionHook is deprecated; use the callback returned from listenBefore instead"),pushState:v.default(R,"pushState is deprecated; use push instead"),replaceState:v.default(S,"replaceState is deprecated; use replace instead")}}t.__esModule=!0;var i=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},s=n(199),c=(o(s),n(207)),u=o(c),d=n(201),p=n(210),f=n(200),h=n(211),m=o(h),b=n(212),g=o(b),_=n(213),v=o(_),y=6;t.default=a,e.exports=t.default},function(e,t,n){function o(e){return null===e||void 0===e}function r(e){return!(!e||"object"!=typeof e||"number"!=typeof e.length)&&("function"==typeof e.copy&&"function"==typeof e.slice&&!(e.length>0&&"number"!=typeof e[0]))}function l(e,t,n){var l,u;if(o(e)||o(t))return!1;if(e.prototype!==t.prototype)return!1;if(s(e))return!!s(t)&&(e=a.call(e),t=a.call(t),c(e,t,n));if(r(e)){if(!r(t))return!1;if(e.length!==t.length)return!1;for(l=0;l<e.length;l++)if(e[l]!==t[l])return!1;return!0}try{var d=i(e),p=i(t)}catch(e){return!1}if(d.length!=p.length)return!1;for(d.sort(),p.sort(),l=d.length-1;l>=0;l--)if(d[l]!=p[l])return!1;for(l=d.length-1;l>=0;l--)if(u=d[l],!c(e[u],t[u],n))return!1;return typeof e==typeof t}var a=Array.prototype.slice,i=n(208),s=n(209),c=e.exports=function(e,t,n){return n||(n={}),e===t||(e instanceof Date&&t instanceof Date?e.getTime()===t.getTime():!e||!t||"object"!=typeof e&&"object"!=typeof t?n.strict?e===t:e==t:l(e,t,n))}},function(e,t){function n(e){var t=[];for(var n in e)t.push(n);return t}t=e.exports="function"==typeof Object.keys?Object.keys:n,t.shim=n},function(e,t){function n(e){return"[object Arguments]"==Object.prototype.toString.call(e)}function o(e){return e&&"object"==typeof e&&"number"==typeof e.length&&Object.prototype.hasOwnProperty.call(e,"callee")&&!Object.prototype.propertyIsEnumerable.call(e,"callee")||!1}var r="[object Arguments]"==function(){return Object.prototype.toString.call(arguments)}();t=e.exports=r?n:o,t.supported=n,t.unsupported=o},function(e,t){"use strict";function n(e,t,n){function r(){return i=!0,s?void(u=[].concat(o.call(arguments))):void n.apply(this,arguments)}function l(){if(!i&&(c=!0,!s)){for(s=!0;!i&&a<e&&c;)c=!1,t.call(this,a++,l,r);return s=!1,i?void n.apply(this,u):void(a>=e&&c&&(i=!0,n()))}}var a=0,i=!1,s=!1,c=!1,u=void 0;l()}t.__esModule=!0;var o=Array.prototype.slice;t.loopAsync=n},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(){var e=arguments.length<=0||void 0===arguments[0]?"/":arguments[0],t=arguments.length<=1||void 0===arguments[1]?i.POP:arguments[1],n=arguments.length<=2||void 0===arguments[2]?null:arguments[2],o=arguments.length<=3||void 0===arguments[3]?null:arguments[3];"string"==typeof e&&(e=s.parsePath(e)),"object"==typeof t&&(e=l({},e,{state:t}),t=n||i.POP,n=o);var r=e.pathname||"/",a=e.search||"",c=e.hash||"",u=e.state||null;return{pathname:r,search:a,hash:c,state:u,action:t,key:n}}t.__esModule=!0;var l=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},a=n(199),i=(o(a),n(200)),s=n(201);t.default=r,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t,n){var o=e(t,n);e.length<2&&n(o)}t.__esModule=!0;var l=n(199);o(l);t.default=r,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){return function(){return e.apply(this,arguments)}}t.__esModule=!0;var l=n(199);o(l);t.default=r,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){return s.stringify(e).replace(/%20/g,"+")}function l(e){return function(){function t(e){if(null==e.query){var t=e.search;e.query=w(t.substring(1)),e[h]={search:t,searchBase:""}}return e}function n(e,t){var n,o=e[h],r=t?x(t):"";if(!o&&!r)return e;"string"==typeof e&&(e=d.parsePath(e));var l=void 0;l=o&&e.search===o.search?o.searchBase:e.search||"";var i=l;return r&&(i+=(i?"&":"?")+r),a({},e,(n={search:i},n[h]={search:i,searchBase:l},n))}function o(e){return y.listenBefore(function(n,o){u.default(e,t(n),o)})}function l(e){return y.listen(function(n){e(t(n))})}function i(e){y.push(n(e,e.query))}function s(e){y.replace(n(e,e.query))}function c(e,t){return y.createPath(n(e,t||e.query))}function p(e,t){return y.createHref(n(e,t||e.query))}function b(e){for(var o=arguments.length,r=Array(o>1?o-1:0),l=1;l<o;l++)r[l-1]=arguments[l];var a=y.createLocation.apply(y,[n(e,e.query)].concat(r));return e.query&&(a.query=e.query),t(a)}function g(e,t,n){"string"==typeof t&&(t=d.parsePath(t)),i(a({state:e},t,{query:n}))}function _(e,t,n){"string"==typeof t&&(t=d.parsePath(t)),s(a({state:e},t,{query:n}))}var v=arguments.length<=0||void 0===arguments[0]?{}:arguments[0],y=e(v),x=v.stringifyQuery,w=v.parseQueryString;return"function"!=typeof x&&(x=r),"function"!=typeof w&&(w=m),a({},y,{listenBefore:o,listen:l,push:i,replace:s,createPath:c,createHref:p,createLocation:b,pushState:f.default(g,"pushState is deprecated; use push instead"),replaceState:f.default(_,"replaceState is deprecated; use replace instead")})}}t.__esModule=!0;var a=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},i=n(199),s=(o(i),n(215)),c=n(212),u=o(c),d=n(201),p=n(213),f=o(p),h="$searchBase",m=s.parse;t.default=l,e.exports=t.default},function(e,t,n){"use strict";var o=n(216);t.extract=function(e){return e.split("?")[1]||""},t.parse=function(e){return"string"!=typeof e?{}:(e=e.trim().replace(/^(\?|#|&)/,""),e?e.split("&").reduce(function(e,t){var n=t.replace(/\+/g," ").split("="),o=n.shift(),r=n.length>0?n.join("="):void 0;return o=decodeURIComponent(o),r=void 0===r?null:decodeURIComponent(r),e.hasOwnProperty(o)?Array.isArray(e[o])?e[o].push(r):e[o]=[e[o],r]:e[o]=r,e},{}):{})},t.stringify=function(e){return e?Object.keys(e).sort().map(function(t){var n=e[t];return void 0===n?"":null===n?t:Array.isArray(n)?n.slice().sort().map(function(e){return o(t)+"="+o(e)}).join("&"):o(t)+"="+o(n)}).filter(function(e){return e.length>0}).join("&"):""}},function(e,t){"use strict";e.exports=function(e){return encodeURIComponent(e).replace(/[!'()*]/g,function(e){return"%"+e.charCodeAt(0).toString(16).toUpperCase()})}},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){for(var t in e)if(Object.prototype.hasOwnProperty.call(e,t))return!0;return!1}function l(e,t){function n(t){var n=!(arguments.length<=1||void 0===arguments[1])&&arguments[1],o=arguments.length<=2||void 0===arguments[2]?null:arguments[2],r=void 0;
+return n&&n!==!0||null!==o?(t={pathname:t,query:n},r=o||!1):(t=e.createLocation(t),r=n),(0,p.default)(t,r,v.location,v.routes,v.params)}function o(e,n){y&&y.location===e?l(y,n):(0,b.default)(t,e,function(t,o){t?n(t):o?l(a({},o,{location:e}),n):n()})}function l(e,t){function n(n,r){return n||r?o(n,r):void(0,h.default)(e,function(n,o){n?t(n):t(null,null,v=a({},e,{components:o}))})}function o(e,n){e?t(e):t(null,n)}var r=(0,c.default)(v,e),l=r.leaveRoutes,i=r.changeRoutes,s=r.enterRoutes;(0,u.runLeaveHooks)(l,v),l.filter(function(e){return s.indexOf(e)===-1}).forEach(m),(0,u.runChangeHooks)(i,v,e,function(t,r){return t||r?o(t,r):void(0,u.runEnterHooks)(s,e,n)})}function i(e){var t=arguments.length<=1||void 0===arguments[1]||arguments[1];return e.__id__||t&&(e.__id__=x++)}function s(e){return e.reduce(function(e,t){return e.push.apply(e,w[i(t)]),e},[])}function d(e,n){(0,b.default)(t,e,function(t,o){if(null==o)return void n();y=a({},o,{location:e});for(var r=s((0,c.default)(v,y).leaveRoutes),l=void 0,i=0,u=r.length;null==l&&i<u;++i)l=r[i](e);n(l)})}function f(){if(v.routes){for(var e=s(v.routes),t=void 0,n=0,o=e.length;"string"!=typeof t&&n<o;++n)t=e[n]();return t}}function m(e){var t=i(e,!1);t&&(delete w[t],r(w)||(k&&(k(),k=null),E&&(E(),E=null)))}function g(t,n){var o=i(t),l=w[o];if(l)l.indexOf(n)===-1&&l.push(n);else{var a=!r(w);w[o]=[n],a&&(k=e.listenBefore(d),e.listenBeforeUnload&&(E=e.listenBeforeUnload(f)))}return function(){var e=w[o];if(e){var r=e.filter(function(e){return e!==n});0===r.length?m(t):w[o]=r}}}function _(t){return e.listen(function(n){v.location===n?t(null,v):o(n,function(n,o,r){n?t(n):o?e.replace(o):r&&t(null,r)})})}var v={},y=void 0,x=1,w=Object.create(null),k=void 0,E=void 0;return{isActive:n,match:o,listenBeforeLeavingRoute:g,listen:_}}t.__esModule=!0;var a=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e};t.default=l;var i=n(192),s=(o(i),n(218)),c=o(s),u=n(219),d=n(221),p=o(d),f=n(222),h=o(f),m=n(224),b=o(m);e.exports=t.default},function(e,t,n){"use strict";function o(e,t,n){if(!e.path)return!1;var o=(0,l.getParamNames)(e.path);return o.some(function(e){return t.params[e]!==n.params[e]})}function r(e,t){var n=e&&e.routes,r=t.routes,l=void 0,a=void 0,i=void 0;return n?!function(){var s=!1;l=n.filter(function(n){if(s)return!0;var l=r.indexOf(n)===-1||o(n,e,t);return l&&(s=!0),l}),l.reverse(),i=[],a=[],r.forEach(function(e){var t=n.indexOf(e)===-1,o=l.indexOf(e)!==-1;t||o?i.push(e):a.push(e)})}():(l=[],a=[],i=r),{leaveRoutes:l,changeRoutes:a,enterRoutes:i}}t.__esModule=!0;var l=n(195);t.default=r,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t,n){return function(){for(var o=arguments.length,r=Array(o),l=0;l<o;l++)r[l]=arguments[l];if(e.apply(t,r),e.length<n){var a=r[r.length-1];a()}}}function l(e){return e.reduce(function(e,t){return t.onEnter&&e.push(r(t.onEnter,t,3)),e},[])}function a(e){return e.reduce(function(e,t){return t.onChange&&e.push(r(t.onChange,t,4)),e},[])}function i(e,t,n){function o(e,t,n){return t?void(r={pathname:t,query:n,state:e}):void(r=e)}if(!e)return void n();var r=void 0;(0,d.loopAsync)(e,function(e,n,l){t(e,o,function(e){e||r?l(e,r):n()})},n)}function s(e,t,n){var o=l(e);return i(o.length,function(e,n,r){o[e](t,n,r)},n)}function c(e,t,n,o){var r=a(e);return i(r.length,function(e,o,l){r[e](t,n,o,l)},o)}function u(e,t){for(var n=0,o=e.length;n<o;++n)e[n].onLeave&&e[n].onLeave.call(e[n],t)}t.__esModule=!0,t.runEnterHooks=s,t.runChangeHooks=c,t.runLeaveHooks=u;var d=n(220),p=n(192);o(p)},function(e,t){"use strict";function n(e,t,n){function o(){return a=!0,i?void(c=[].concat(Array.prototype.slice.call(arguments))):void n.apply(this,arguments)}function r(){if(!a&&(s=!0,!i)){for(i=!0;!a&&l<e&&s;)s=!1,t.call(this,l++,r,o);return i=!1,a?void n.apply(this,c):void(l>=e&&s&&(a=!0,n()))}}var l=0,a=!1,i=!1,s=!1,c=void 0;r()}function o(e,t,n){function o(e,t,o){a||(t?(a=!0,n(t)):(l[e]=o,a=++i===r,a&&n(null,l)))}var r=e.length,l=[];if(0===r)return n(null,l);var a=!1,i=0;e.forEach(function(e,n){t(e,n,function(e,t){o(n,e,t)})})}t.__esModule=!0,t.loopAsync=n,t.mapAsync=o},function(e,t,n){"use strict";function o(e,t){if(e==t)return!0;if(null==e||null==t)return!1;if(Array.isArray(e))return Array.isArray(t)&&e.length===t.length&&e.every(function(e,n){return o(e,t[n])});if("object"===("undefined"==typeof e?"undefined":s(e))){for(var n in e)if(Object.prototype.hasOwnProperty.call(e,n))if(void 0===e[n]){if(void 0!==t[n])return!1}else{if(!Object.prototype.hasOwnProperty.call(t,n))return!1;if(!o(e[n],t[n]))return!1}return!0}return String(e)===String(t)}function r(e,t){return"/"!==t.charAt(0)&&(t="/"+t),"/"!==e.charAt(e.length-1)&&(e+="/"),"/"!==t.charAt(t.length-1)&&(t+="/"),t===e}function l(e,t,n){for(var o=e,r=[],l=[],a=0,i=t.length;a<i;++a){var s=t[a],u=s.path||"";if("/"===u.charAt(0)&&(o=e,r=[],l=[]),null!==o&&u){var d=(0,c.matchPattern)(u,o);if(d?(o=d.remainingPathname,r=[].concat(r,d.paramNames),l=[].concat(l,d.paramValues)):o=null,""===o)return r.every(function(e,t){return String(l[t])===String(n[e])})}}return!1}function a(e,t){return null==t?null==e:null==e||o(e,t)}function i(e,t,n,o,i){var s=e.pathname,c=e.query;return null!=n&&("/"!==s.charAt(0)&&(s="/"+s),!!(r(s,n.pathname)||!t&&l(s,o,i))&&a(c,n.query))}t.__esModule=!0;var s="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(e){return typeof e}:function(e){return e&&"function"==typeof Symbol&&e.constructor===Symbol?"symbol":typeof e};t.default=i;var c=n(195);e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t,n){if(t.component||t.components)return void n(null,t.component||t.components);var o=t.getComponent||t.getComponents;if(!o)return void n();var r=e.location,l=(0,s.default)(e,r);o.call(t,l,n)}function l(e,t){(0,a.mapAsync)(e.routes,function(t,n,o){r(e,t,o)},t)}t.__esModule=!0;var a=n(220),i=n(223),s=o(i);t.default=l,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){return l({},e,t)}t.__esModule=!0;var l=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e};t.default=r;var a=(n(191),n(192));o(a);e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t,n,o,r){if(e.childRoutes)return[null,e.childRoutes];if(!e.getChildRoutes)return[];var l=!0,a=void 0,s={location:t,params:i(n,o)},c=(0,h.default)(s,t);return e.getChildRoutes(c,function(e,t){return t=!e&&(0,g.createRoutes)(t),l?void(a=[e,t]):void r(e,t)}),l=!1,a}function l(e,t,n,o,r){if(e.indexRoute)r(null,e.indexRoute);else if(e.getIndexRoute){var a={location:t,params:i(n,o)},s=(0,h.default)(a,t);e.getIndexRoute(s,function(e,t){r(e,!e&&(0,g.createRoutes)(t)[0])})}else e.childRoutes?!function(){var a=e.childRoutes.filter(function(e){return!e.path});(0,p.loopAsync)(a.length,function(e,r,i){l(a[e],t,n,o,function(t,n){if(t||n){var o=[a[e]].concat(Array.isArray(n)?n:[n]);i(t,o)}else r()})},function(e,t){r(null,t)})}():r()}function a(e,t,n){return t.reduce(function(e,t,o){var r=n&&n[o];return Array.isArray(e[t])?e[t].push(r):t in e?e[t]=[e[t],r]:e[t]=r,e},e)}function i(e,t){return a({},e,t)}function s(e,t,n,o,a,s){var u=e.path||"";if("/"===u.charAt(0)&&(n=t.pathname,o=[],a=[]),null!==n&&u){try{var p=(0,m.matchPattern)(u,n);p?(n=p.remainingPathname,o=[].concat(o,p.paramNames),a=[].concat(a,p.paramValues)):n=null}catch(e){s(e)}if(""===n){var f=function(){var n={routes:[e],params:i(o,a)};return l(e,t,o,a,function(e,t){if(e)s(e);else{if(Array.isArray(t)){var o;(o=n.routes).push.apply(o,t)}else t&&n.routes.push(t);s(null,n)}}),{v:void 0}}();if("object"===("undefined"==typeof f?"undefined":d(f)))return f.v}}if(null!=n||e.childRoutes){var h=function(r,l){r?s(r):l?c(l,t,function(t,n){t?s(t):n?(n.routes.unshift(e),s(null,n)):s()},n,o,a):s()},b=r(e,t,o,a,h);b&&h.apply(void 0,b)}else s()}function c(e,t,n,o){var r=arguments.length<=4||void 0===arguments[4]?[]:arguments[4],l=arguments.length<=5||void 0===arguments[5]?[]:arguments[5];void 0===o&&("/"!==t.pathname.charAt(0)&&(t=u({},t,{pathname:"/"+t.pathname})),o=t.pathname),(0,p.loopAsync)(e.length,function(n,a,i){s(e[n],t,o,r,l,function(e,t){e||t?i(e,t):a()})},n)}t.__esModule=!0;var u=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},d="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(e){return typeof e}:function(e){return e&&"function"==typeof Symbol&&e.constructor===Symbol?"symbol":typeof e};t.default=c;var p=n(220),f=n(223),h=o(f),m=n(195),b=n(192),g=(o(b),n(189));e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r="function"==typeof Symbol&&"symbol"==typeof Symbol.iterator?function(e){return typeof e}:function(e){return e&&"function"==typeof Symbol&&e.constructor===Symbol?"symbol":typeof e},l=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},a=n(196),i=o(a),s=n(1),c=o(s),u=n(191),d=(o(u),n(226)),p=o(d),f=n(189),h=n(192),m=(o(h),c.default.PropTypes),b=m.array,g=m.func,_=m.object,v=c.default.createClass({displayName:"RouterContext",propTypes:{history:_,router:_.isRequired,location:_.isRequired,routes:b.isRequired,params:_.isRequired,components:b.isRequired,createElement:g.isRequired},getDefaultProps:function(){return{createElement:c.default.createElement}},childContextTypes:{history:_,location:_.isRequired,router:_.isRequired},getChildContext:function(){var e=this.props,t=e.router,n=e.history,o=e.location;return t||(t=l({},n,{setRouteLeaveHook:n.listenBeforeLeavingRoute}),delete t.listenBeforeLeavingRoute),{history:n,location:o,router:t}},createElement:function(e,t){return null==e?null:this.props.createElement(e,t)},render:function(){var e=this,t=this.props,n=t.history,o=t.location,a=t.routes,s=t.params,u=t.components,d=null;return u&&(d=u.reduceRight(function(t,i,c){if(null==i)return t;var u=a[c],d=(0,p.default)(u,s),h={history:n,location:o,params:s,route:u,routeParams:d,routes:a};if((0,f.isReactChildren)(t))h.children=t;else if(t)for(var m in t)Object.prototype.hasOwnProperty.call(t,m)&&(h[m]=t[m]);if("object"===("undefined"==typeof i?"undefined":r(i))){var b={};for(var g in i)Object.prototype.hasOwnProperty.call(i,g)&&(b[g]=e.createElement(i[g],l({key:g},h)));return b}return e.createElement(i,h)},d)),null===d||d===!1||c.default.isValidElement(d)?void 0:(0,i.default)(!1),d}});t.default=v,e.exports=t.default},function(e,t,n){"use strict";function o(e,t){var n={};return e.path?((0,r.getParamNames)(e.path).forEach(function(e){Object.prototype.hasOwnProperty.call(t,e)&&(n[e]=t[e])}),n):n}t.__esModule=!0;var r=n(195);t.default=o,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){return a({},e,{setRouteLeaveHook:t.listenBeforeLeavingRoute,isActive:t.isActive})}function l(e,t){return e=a({},e,t)}t.__esModule=!0;var a=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e};t.createRouterObject=r,t.createRoutingHistory=l;var i=n(191);o(i)},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){var n={};for(var o in e)t.indexOf(o)>=0||Object.prototype.hasOwnProperty.call(e,o)&&(n[o]=e[o]);return n}function l(e){return 0===e.button}function a(e){return!!(e.metaKey||e.altKey||e.ctrlKey||e.shiftKey)}function i(e){for(var t in e)if(Object.prototype.hasOwnProperty.call(e,t))return!1;return!0}function s(e,t){var n=t.query,o=t.hash,r=t.state;return n||o||r?{pathname:e,query:n,hash:o,state:r}:e}t.__esModule=!0;var c=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},u=n(1),d=o(u),p=n(192),f=(o(p),n(196)),h=o(f),m=n(190),b=d.default.PropTypes,g=b.bool,_=b.object,v=b.string,y=b.func,x=b.oneOfType,w=d.default.createClass({displayName:"Link",contextTypes:{router:m.routerShape},propTypes:{to:x([v,_]),query:_,hash:v,state:_,activeStyle:_,activeClassName:v,onlyActiveOnIndex:g.isRequired,onClick:y,target:v},getDefaultProps:function(){return{onlyActiveOnIndex:!1,style:{}}},handleClick:function(e){if(this.props.onClick&&this.props.onClick(e),!e.defaultPrevented&&(this.context.router?void 0:(0,h.default)(!1),!a(e)&&l(e)&&!this.props.target)){e.preventDefault();var t=this.props,n=t.to,o=t.query,r=t.hash,i=t.state,c=s(n,{query:o,hash:r,state:i});this.context.router.push(c)}},render:function(){var e=this.props,t=e.to,n=e.query,o=e.hash,l=e.state,a=e.activeClassName,u=e.activeStyle,p=e.onlyActiveOnIndex,f=r(e,["to","query","hash","state","activeClassName","activeStyle","onlyActiveOnIndex"]),h=this.context.router;if(h){if(null==t)return d.default.createElement("a",f);var m=s(t,{query:n,hash:o,state:l});f.href=h.createHref(m),(a||null!=u&&!i(u))&&h.isActive(m,p)&&(a&&(f.className?f.className+=" "+a:f.className=a),u&&(f.style=c({},f.style,u)))}return d.default.createElement("a",c({},f,{onClick:this.handleClick}))}});t.default=w,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},l=n(1),a=o(l),i=n(228),s=o(i),c=a.default.createClass({displayName:"IndexLink",render:function(){return a.default.createElement(s.default,r({},this.props,{onlyActiveOnIndex:!0}))}});t.default=c,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){return e.displayName||e.name||"Component"}function l(e,t){var n=t&&t.withRef,o=u.default.createClass({displayName:"WithRouter",contextTypes:{router:f.routerShape},propTypes:{router:f.routerShape},getWrappedInstance:function(){return n?void 0:(0,s.default)(!1),this.wrappedInstance},render:function(){var t=this,o=this.props.router||this.context.router,r=a({},this.props,{router:o});return n&&(r.ref=function(e){t.wrappedInstance=e}),u.default.createElement(e,r)}});return o.displayName="withRouter("+r(e)+")",o.WrappedComponent=e,(0,p.default)(o,e)}t.__esModule=!0;var a=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e};t.default=l;var i=n(196),s=o(i),c=n(1),u=o(c),d=n(231),p=o(d),f=n(190);e.exports=t.default},function(e,t){"use strict";var n={childContextTypes:!0,contextTypes:!0,defaultProps:!0,displayName:!0,getDefaultProps:!0,mixins:!0,propTypes:!0,type:!0},o={name:!0,length:!0,prototype:!0,caller:!0,arguments:!0,arity:!0},r="function"==typeof Object.getOwnPropertySymbols;e.exports=function(e,t,l){if("string"!=typeof t){var a=Object.getOwnPropertyNames(t);r&&(a=a.concat(Object.getOwnPropertySymbols(t)));for(var i=0;i<a.length;++i)if(!(n[a[i]]||o[a[i]]||l&&l[a[i]]))try{e[a[i]]=t[a[i]]}catch(e){}}return e}},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=n(1),l=o(r),a=n(192),i=(o(a),n(196)),s=o(i),c=n(233),u=o(c),d=n(194),p=l.default.PropTypes,f=p.string,h=p.object,m=l.default.createClass({displayName:"IndexRedirect",statics:{createRouteFromReactElement:function(e,t){t&&(t.indexRoute=u.default.createRouteFromReactElement(e))}},propTypes:{to:f.isRequired,query:h,state:h,onEnter:d.falsy,children:d.falsy},render:function(){(0,s.default)(!1)}});t.default=m,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=n(1),l=o(r),a=n(196),i=o(a),s=n(189),c=n(195),u=n(194),d=l.default.PropTypes,p=d.string,f=d.object,h=l.default.createClass({displayName:"Redirect",statics:{createRouteFromReactElement:function(e){var t=(0,s.createRouteFromReactElement)(e);return t.from&&(t.path=t.from),t.onEnter=function(e,n){var o=e.location,r=e.params,l=void 0;if("/"===t.to.charAt(0))l=(0,c.formatPattern)(t.to,r);else if(t.to){var a=e.routes.indexOf(t),i=h.getRoutePattern(e.routes,a-1),s=i.replace(/\/*$/,"/")+t.to;l=(0,c.formatPattern)(s,r)}else l=o.pathname;n({pathname:l,query:t.query||o.query,state:t.state||o.state})},t},getRoutePattern:function(e,t){for(var n="",o=t;o>=0;o--){var r=e[o],l=r.path||"";if(n=l.replace(/\/*$/,"/")+n,0===l.indexOf("/"))break}return"/"+n}},propTypes:{path:p,from:p,to:p.isRequired,query:f,state:f,onEnter:u.falsy,children:u.falsy},render:function(){(0,i.default)(!1)}});t.default=h,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=n(1),l=o(r),a=n(192),i=(o(a),n(196)),s=o(i),c=n(189),u=n(194),d=l.default.PropTypes.func,p=l.default.createClass({displayName:"IndexRoute",statics:{createRouteFromReactElement:function(e,t){t&&(t.indexRoute=(0,c.createRouteFromReactElement)(e))}},propTypes:{path:u.falsy,component:u.component,components:u.components,getComponent:d,getComponents:d},render:function(){(0,s.default)(!1)}});t.default=p,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=n(1),l=o(r),a=n(196),i=o(a),s=n(189),c=n(194),u=l.default.PropTypes,d=u.string,p=u.func,f=l.default.createClass({displayName:"Route",statics:{createRouteFromReactElement:s.createRouteFromReactElement},propTypes:{path:d,component:c.component,components:c.components,getComponent:p,getComponents:p},render:function(){(0,i.default)(!1)}});t.default=f,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=n(192),l=(o(r),n(194)),a={contextTypes:{history:l.history},componentWillMount:function(){this.history=this.context.history}};t.default=a,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=n(192),l=(o(r),n(1)),a=o(l),i=n(196),s=o(i),c=a.default.PropTypes.object,u={contextTypes:{history:c.isRequired,route:c},propTypes:{route:c},componentDidMount:function(){this.routerWillLeave?void 0:(0,s.default)(!1);var e=this.props.route||this.context.route;e?void 0:(0,s.default)(!1),this._unlistenBeforeLeavingRoute=this.context.history.listenBeforeLeavingRoute(e,this.routerWillLeave)},componentWillUnmount:function(){this._unlistenBeforeLeavingRoute&&this._unlistenBeforeLeavingRoute()}};t.default=u,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=n(192),l=(o(r),n(1)),a=o(l),i=a.default.PropTypes.object,s={propTypes:{route:i.isRequired},childContextTypes:{route:i.isRequired},getChildContext:function(){return{route:this.props.route}},componentWillMount:function(){}};t.default=s,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){var n={};for(var o in e)t.indexOf(o)>=0||Object.prototype.hasOwnProperty.call(e,o)&&(n[o]=e[o]);return n}function l(e){return function(){var t=arguments.length<=0||void 0===arguments[0]?{}:arguments[0],n=t.routes,o=r(t,["routes"]),l=(0,s.default)(e)(o),i=(0,u.default)(l,n);return a({},l,i)}}t.__esModule=!0;var a=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},i=n(214),s=o(i),c=n(217),u=o(c),d=n(192);o(d);t.default=l,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=n(1),l=o(r),a=n(225),i=o(a),s=n(192),c=(o(s),l.default.createClass({displayName:"RoutingContext",componentWillMount:function(){},render:function(){return l.default.createElement(i.default,this.props)}}));t.default=c,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e,t){var n={};for(var o in e)t.indexOf(o)>=0||Object.prototype.hasOwnProperty.call(e,o)&&(n[o]=e[o]);return n}function l(e,t){var n=e.history,o=e.routes,l=e.location,s=r(e,["history","routes","location"]);n||l?void 0:(0,c.default)(!1),n=n?n:(0,d.default)(s);var u=(0,f.default)(n,(0,h.createRoutes)(o)),p=void 0;l?l=n.createLocation(l):p=n.listen(function(e){l=e});var b=(0,m.createRouterObject)(n,u);n=(0,m.createRoutingHistory)(n,u),u.match(l,function(e,o,r){t(e,o&&b.createLocation(o,i.REPLACE),r&&a({},r,{history:n,router:b,matchContext:{history:n,transitionManager:u,router:b}})),p&&p()})}t.__esModule=!0;var a=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},i=n(200),s=n(196),c=o(s),u=n(242),d=o(u),p=n(217),f=o(p),h=n(189),m=n(227);t.default=l,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){var t=(0,u.default)(e),n=function(){return t},o=(0,a.default)((0,s.default)(n))(e);return o.__v2_compatible__=!0,o}t.__esModule=!0,t.default=r;var l=n(214),a=o(l),i=n(243),s=o(i),c=n(244),u=o(c);e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){return function(){function t(){if(!x){if(null==y&&i.canUseDOM){var e=document.getElementsByTagName("base")[0],t=e&&e.getAttribute("href");null!=t&&(y=t)}x=!0}}function n(e){return t(),y&&null==e.basename&&(0===e.pathname.indexOf(y)?(e.pathname=e.pathname.substring(y.length),e.basename=y,""===e.pathname&&(e.pathname="/")):e.basename=""),e}function o(e){if(t(),!y)return e;"string"==typeof e&&(e=s.parsePath(e));var n=e.pathname,o="/"===y.slice(-1)?y:y+"/",r="/"===n.charAt(0)?n.slice(1):n,a=o+r;return l({},e,{pathname:a})}function r(e){return v.listenBefore(function(t,o){u.default(e,n(t),o)})}function a(e){return v.listen(function(t){e(n(t))})}function c(e){v.push(o(e))}function d(e){v.replace(o(e))}function f(e){return v.createPath(o(e))}function h(e){return v.createHref(o(e))}function m(e){for(var t=arguments.length,r=Array(t>1?t-1:0),l=1;l<t;l++)r[l-1]=arguments[l];return n(v.createLocation.apply(v,[o(e)].concat(r)))}function b(e,t){"string"==typeof t&&(t=s.parsePath(t)),c(l({state:e},t))}function g(e,t){"string"==typeof t&&(t=s.parsePath(t)),d(l({state:e},t))}var _=arguments.length<=0||void 0===arguments[0]?{}:arguments[0],v=e(_),y=_.basename,x=!1;return l({},v,{listenBefore:r,listen:a,push:c,replace:d,createPath:f,createHref:h,createLocation:m,pushState:p.default(b,"pushState is deprecated; use push instead"),replaceState:p.default(g,"replaceState is deprecated; use replace instead")})}}t.__esModule=!0;var l=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},a=n(199),i=(o(a),n(202)),s=n(201),c=n(212),u=o(c),d=n(213),p=o(d);t.default=r,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){return e.filter(function(e){return e.state}).reduce(function(e,t){return e[t.key]=t.state,e},{})}function l(){function e(e,t){g[e]=t}function t(e){return g[e]}function n(){var e=m[b],n=e.basename,o=e.pathname,r=e.search,l=(n||"")+o+(r||""),i=void 0,s=void 0;e.key?(i=e.key,s=t(i)):(i=p.createKey(),s=null,e.key=i);var c=u.parsePath(l);return p.createLocation(a({},c,{state:s}),void 0,i)}function o(e){var t=b+e;return t>=0&&t<m.length}function l(e){if(e){if(!o(e))return;b+=e;var t=n();p.transitionTo(a({},t,{action:d.POP}))}}function i(t){switch(t.action){case d.PUSH:b+=1,b<m.length&&m.splice(b),m.push(t),e(t.key,t.state);break;case d.REPLACE:m[b]=t,e(t.key,t.state)}}var s=arguments.length<=0||void 0===arguments[0]?{}:arguments[0];Array.isArray(s)?s={entries:s}:"string"==typeof s&&(s={entries:[s]});var p=f.default(a({},s,{getCurrentLocation:n,finishTransition:i,saveState:e,go:l})),h=s,m=h.entries,b=h.current;"string"==typeof m?m=[m]:Array.isArray(m)||(m=["/"]),m=m.map(function(e){var t=p.createKey();return"string"==typeof e?{pathname:e,key:t}:"object"==typeof e&&e?a({},e,{key:t}):void c.default(!1)}),null==b?b=m.length-1:b>=0&&b<m.length?void 0:c.default(!1);var g=r(m);return p}t.__esModule=!0;var a=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},i=n(199),s=(o(i),n(196)),c=o(s),u=n(201),d=n(200),p=n(206),f=o(p);t.default=l,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(e){return function(t){var n=(0,a.default)((0,s.default)(e))(t);return n.__v2_compatible__=!0,n}}t.__esModule=!0,t.default=r;var l=n(214),a=o(l),i=n(243),s=o(i);e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},l=n(1),a=o(l),i=n(225),s=o(i),c=n(192);o(c);t.default=function(){for(var e=arguments.length,t=Array(e),n=0;n<e;n++)t[n]=arguments[n];var o=t.map(function(e){return e.renderRouterContext}).filter(Boolean),i=t.map(function(e){return e.renderRouteComponent}).filter(Boolean),c=function(){var e=arguments.length<=0||void 0===arguments[0]?l.createElement:arguments[0];return function(t,n){return i.reduceRight(function(e,t){return t(e,n)},e(t,n))}};return function(e){return o.reduceRight(function(t,n){return n(t,e)},a.default.createElement(s.default,r({},e,{createElement:c(e.createElement)})))}},e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=n(248),l=o(r),a=n(249),i=o(a);t.default=(0,i.default)(l.default),e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}function r(){function e(e){try{e=e||window.history.state||{}}catch(t){e={}}var t=d.getWindowPath(),n=e,o=n.key,r=void 0;o?r=p.readState(o):(r=null,o=v.createKey(),g&&window.history.replaceState(l({},e,{key:o}),null));var a=c.parsePath(t);return v.createLocation(l({},a,{state:r}),void 0,o)}function t(t){function n(t){void 0!==t.state&&o(e(t.state))}var o=t.transitionTo;return d.addEventListener(window,"popstate",n),function(){d.removeEventListener(window,"popstate",n)}}function n(e){var t=e.basename,n=e.pathname,o=e.search,r=e.hash,l=e.state,a=e.action,i=e.key;if(a!==s.POP){p.saveState(i,l);var c=(t||"")+n+o+r,u={key:i};if(a===s.PUSH){if(_)return window.location.href=c,!1;window.history.pushState(u,null,c)}else{if(_)return window.location.replace(c),!1;window.history.replaceState(u,null,c)}}}function o(e){1===++y&&(x=t(v));var n=v.listenBefore(e);return function(){n(),0===--y&&x()}}function r(e){1===++y&&(x=t(v));var n=v.listen(e);return function(){n(),0===--y&&x()}}function a(e){1===++y&&(x=t(v)),v.registerTransitionHook(e)}function f(e){v.unregisterTransitionHook(e),0===--y&&x()}var m=arguments.length<=0||void 0===arguments[0]?{}:arguments[0];u.canUseDOM?void 0:i.default(!1);var b=m.forceRefresh,g=d.supportsHistory(),_=!g||b,v=h.default(l({},m,{getCurrentLocation:e,finishTransition:n,saveState:p.saveState})),y=0,x=void 0;return l({},v,{listenBefore:o,listen:r,registerTransitionHook:a,unregisterTransitionHook:f})}t.__esModule=!0;var l=Object.assign||function(e){for(var t=1;t<arguments.length;t++){var n=arguments[t];for(var o in n)Object.prototype.hasOwnProperty.call(n,o)&&(e[o]=n[o])}return e},a=n(196),i=o(a),s=n(200),c=n(201),u=n(202),d=n(203),p=n(204),f=n(205),h=o(f);t.default=r,e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0,t.default=function(e){var t=void 0;return a&&(t=(0,l.default)(e)()),t};var r=n(245),l=o(r),a=!("undefined"==typeof window||!window.document||!window.document.createElement);e.exports=t.default},function(e,t,n){"use strict";function o(e){return e&&e.__esModule?e:{default:e}}t.__esModule=!0;var r=n(198),l=o(r),a=n(249),i=o(a);t.default=(0,i.default)(l.default),e.exports=t.default},function(module,exports,__webpack_require__){"use strict";Object.defineProperty(exports,"__esModule",{value:true});function _interopRequireDefault(obj){return obj&&obj.__esModule?obj:{default:obj}}var React=_interopRequireDefault(__webpack_require__(1)).default,PageList=_interopRequireDefault(__webpack_require__(184)).default,UnixTime=_interopRequireDefault(__webpack_require__(173)).default,styles=_interopRequireDefault(__webpack_require__(180)).default,cx=_interopRequireDefault(__webpack_require__(182)).default;class Batches extends React.Component{constructor(...args){super(...args);this.state={page:1,count:0,batches:[]}}fetch(){if(!this.props.url){return}fetch(`${this.props.url}?page=${this.state.page}`).then(resp=>resp.json()).then(data=>{this.setState({count:data.count,batches:data.batches})})}componentWillMount(){this.fetch()}updatePage(page){this.setState({page:page},this.fetch)}progress(batch){if(batch.total==0){return 0}return Math.floor((batch.succeeded+batch.failed)*100/batch.total)}render(){return React.createElement("div",{className:cx(styles.panel,styles.panelDefault)},React.createElement("div",{className:styles.panelHeading},"Batches"),React.createElement("div",{className:styles.panelBody},React.createElement("p",null,this.state.count," batch(es)."),React.createElement(PageList,{page:this.state.page,totalCount:this.state.count,perPage:20,jumpTo:page=>()=>this.updatePage(page)})),React.createElement("div",{className:styles.tableResponsive},React.createElement("table",{className:styles.table},React.createElement("tbody",null,React.createElement("tr",null,React.createElement("th",null,"ID"),React.createElement("th",null,"Created At"),React.createElement("th",null,"Progress"),React.createElement("th",null,"Pending"),React.createElement("th",null,"Succeeded"),React.createElement("th",null,"Failed"),React.createElement("th",null,"Completed At")),this.state.batches.map(batch=>{return React.createElement("tr",{key:batch.id},React.createElement("td",null,batch.id,batch.committed?"":" (not committed)"),React.createElement("td",null,React.createElement(UnixTime,{ts:batch.created_at})),React.createElement("td",null,this.progress(batch),"%"),React.createElement("td",null,batch.pending),React.createElement("td",null,batch.succeeded),React.createElement("td",null,batch.failed),React.createElement("td",null,batch.completed_at>0?React.createElement(UnixTime,{ts:batch.completed_at}):""))})))))}}exports.default=Batches;Batches.propTypes={url:React.PropTypes.string}},function(module,exports,__webpack_require__){"use strict";Object.defineProperty(exports,"__esModule",{value:true});function _interopRequireDefault(obj){return obj&&obj.__esModule?obj:{default:obj}}var React=_interopRequireDefault(__webpack_require__(1)).default,styles=_interopRequireDefault(__webpack_require__(180)).default,cx=_interopRequireDefault(__webpack_require__(182)).default;class Concurrency extends React.Component{constructor(...args){super(...args);this.state={statuses:[],edits:{}}}fetch(){if(!this.props.url){return}fetch(this.props.url).then(resp=>resp.json()).then(data=>{this.setState({statuses:data,edits:{}})})}componentWillMount(){this.fetch()}edit(jobName,value){this.state.edits[jobName]=value;this.setState({edits:this.state.edits})}save(jobName){let max=parseInt(this.state.edits[jobName],10);if(!this.props.setURL||isNaN(max)||max<0){return}fetch(`${this.props.setURL}/${jobName}/${max}`,{method:"post"}).then(()=>{this.fetch()})}reset(jobName){if(!this.props.resetURL){return}fetch(`${this.props.resetURL}/${jobName}`,{method:"post"}).then(()=>{this.fetch()})}lockInfo(status){return Object.keys(status.lock_info||{}).sort().map(poolID=>`${poolID}: ${status.lock_info[poolID]}`).join(", ")}render(){return React.createElement("div",{className:cx(styles.panel,styles.panelDefault)},React.createElement("div",{className:styles.panelHeading},"concurrency"),React.createElement("div",{className:styles.panelBody},React.createElement("p",null,"A max concurrency of 0 means no limit. Overridden limits are kept across worker pool restarts for jobs that opt in.")),React.createElement("div",{className:styles.tableResponsive},React.createElement("table",{className:styles.table},React.createElement("tbody",null,React.createElement("tr",null,React.createElement("th",null,"Name"),React.createElement("th",null,"Max Concurrency"),React.createElement("th",null,"In Progress"),React.createElement("th",null,"By Worker Pool"),React.createElement("th",null)),this.state.statuses.map(status=>{let edit=this.state.edits[status.job_name];return React.createElement("tr",{key:status.job_name},React.createElement("td",null,status.job_name),React.createElement("td",null,status.max_concurrency,status.overridden?" (overridden)":""),React.createElement("td",null,status.lock),React.createElement("td",null,this.lockInfo(status)),React.createElement("td",null,React.createElement("input",{type:"number",min:"0",value:edit===undefined?status.max_concurrency:edit,onChange:e=>this.edit(status.job_name,e.target.value)}),React.createElement("button",{className:cx(styles.btn,styles.btnDefault),onClick:()=>this.save(status.job_name)},"Set"),React.createElement("button",{className:cx(styles.btn,styles.btnDefault),onClick:()=>this.reset(status.job_name)},"Reset")))})))))}}exports.default=Concurrency;Concurrency.propTypes={url:React.PropTypes.string,setURL:React.PropTypes.string,resetURL:React.PropTypes.string}},function(module,exports,__webpack_require__){"use strict";Object.defineProperty(exports,"__esModule",{value:true});function _interopRequireDefault(obj){return obj&&obj.__esModule?obj:{default:obj}}var React=_interopRequireDefault(__webpack_require__(1)).default,UnixTime=_interopRequireDefault(__webpack_require__(173)).default,styles=_interopRequireDefault(__webpack_require__(180)).default,cx=_interopRequireDefault(__webpack_require__(182)).default;const whereLabels={queue:"Queued",in_progress:"In Progress",scheduled:"Scheduled For",retry:"Retry At",dead:"Died At"};class FindJob extends React.Component{constructor(...args){super(...args);this.state={jobID:"",searched:"",scanned:false,location:null}}find(jobID,scan){jobID=jobID.trim();if(!this.props.url||!jobID){return}fetch(`${this.props.url}/${jobID}${scan?"?scan=1":""}`).then(resp=>resp.json()).then(data=>{this.setState({searched:jobID,scanned:scan,location:data})})}whereText(location){let text=whereLabels[location.where]||location.where;if(location.worker_pool_id){text+=` (worker pool ${location.worker_pool_id})`}return text}render(){let location=this.state.location;return React.createElement("div",{className:cx(styles.panel,styles.panelDefault)},React.createElement("div",{className:styles.panelHeading},"find job"),React.createElement("div",{className:styles.panelBody},React.createElement("p",null,React.createElement("input",{type:"text",placeholder:"Job ID",value:this.state.jobID,onChange:e=>this.setState({jobID:e.target.value})}),React.createElement("button",{className:cx(styles.btn,styles.btnDefault,styles.btnXs),onClick:()=>this.find(this.state.jobID,false)},"Find")),this.state.searched&&!location&&!this.state.scanned&&React.createElement("p",null,"Job ",this.state.searched," isn't in the job index."," ",React.createElement("button",{className:cx(styles.btn,styles.btnDefault,styles.btnXs),onClick:()=>this.find(this.state.searched,true)},"Scan the queues")),this.state.searched&&!location&&this.state.scanned&&React.createElement("p",null,"Job ",this.state.searched," wasn't found, it might have been processed.")),location&&React.createElement("div",{className:styles.tableResponsive},React.createElement("table",{className:styles.table},React.createElement("tbody",null,React.createElement("tr",null,React.createElement("th",null,"Name"),React.createElement("th",null,"Arguments"),React.createElement("th",null,"Where"),React.createElement("th",null,"When")),React.createElement("tr",null,React.createElement("td",null,location.job.name),React.createElement("td",null,JSON.stringify(location.job.args)),React.createElement("td",null,this.whereText(location)),React.createElement("td",null,location.score?React.createElement(UnixTime,{ts:location.score}):""))))))}}exports.default=FindJob;FindJob.propTypes={url:React.PropTypes.string}},function(module,exports,__webpack_require__){"use strict";Object.defineProperty(exports,"__esModule",{value:true});function _interopRequireDefault(obj){return obj&&obj.__esModule?obj:{default:obj}}var React=_interopRequireDefault(__webpack_require__(1)).default,UnixTime=_interopRequireDefault(__webpack_require__(173)).default,styles=_interopRequireDefault(__webpack_require__(180)).default,cx=_interopRequireDefault(__webpack_require__(182)).default;class PeriodicJobs extends React.Component{constructor(...args){super(...args);this.state={jobs:[],runStatus:""}}fetch(){if(!this.props.url){return}fetch(this.props.url).then(resp=>resp.json()).then(data=>{this.setState({jobs:data})})}componentWillMount(){this.fetch()}runJob(job){if(!this.props.runURL){return}fetch(`${this.props.runURL}/${encodeURIComponent(job.id)}`,{method:"post"}).then(resp=>resp.json()).then(data=>{let runStatus=`Periodic job ${job.id} wasn't found.`;if(data.status==="ok"){runStatus=`Enqueued ${job.job_name} as job ${data.job_id}.`}else if(data.status==="running"){runStatus=`The previous run of periodic job ${job.id} is still queued or in progress.`}this.setState({runStatus:runStatus});this.fetch()})}render(){return React.createElement("div",{className:cx(styles.panel,styles.panelDefault)},React.createElement("div",{className:styles.panelHeading},"Periodic Jobs"),React.createElement("div",{className:styles.panelBody},React.createElement("p",null,this.state.jobs.length," periodic job(s) stored in Redis."),this.state.runStatus&&React.createElement("p",null,this.state.runStatus)),React.createElement("div",{className:styles.tableResponsive},React.createElement("table",{className:styles.table},React.createElement("tbody",null,React.createElement("tr",null,React.createElement("th",null,"ID"),React.createElement("th",null,"Name"),React.createElement("th",null,"Schedule"),React.createElement("th",null,"Arguments"),React.createElement("th",null,"Enabled"),React.createElement("th",null,"Next Run"),React.createElement("th",null)),this.state.jobs.map(job=>{return React.createElement("tr",{key:job.id},React.createElement("td",null,job.id),React.createElement("td",null,job.job_name),React.createElement("td",null,job.spec,job.time_zone&&` (${job.time_zone})`,job.no_overlap&&", no overlap"),React.createElement("td",null,JSON.stringify(job.args||{})),React.createElement("td",null,job.enabled?"Yes":"No"),React.createElement("td",null,job.next_run_at?React.createElement(UnixTime,{ts:job.next_run_at}):"-"),React.createElement("td",null,React.createElement("button",{className:cx(styles.btn,styles.btnDefault,styles.btnXs),onClick:()=>this.runJob(job)},"Run Now")))})))))}}exports.default=PeriodicJobs;PeriodicJobs.propTypes={url:React.PropTypes.string,runURL:React.PropTypes.string}},function(e,t,n){var o=n(256);"string"==typeof o&&(o=[[e.id,o,""]]);var r,l={};l.transform=r;n(178)(o,l);o.locals&&(e.exports=o.locals)},function(e,t,n){t=e.exports=n(177)(!1),t.push([e.id,"._1ych--bar{height:20px;min-width:120px;overflow:hidden;background-color:#f5f5f5;border-radius:4px;box-shadow:inset 0 1px 2px rgba(0,0,0,.1)}._5WSqb-fill{height:100%;color:#fff;font-size:12px;line-height:20px;text-align:center;white-space:nowrap;background-color:#337ab7}._3qKUv-fields{margin:0;padding:0;list-style:none;font-size:12px}",""]),t.locals={"bar":"_1ych--bar","fill":"_5WSqb-fill","fields":"_3qKUv-fields"}},function(module,exports,__webpack_require__){"use strict";Object.defineProperty(exports,"__esModule",{value:true});function _interopRequireDefault(obj){return obj&&obj.__esModule?obj:{default:obj}}var React=_interopRequireDefault(__webpack_require__(1)).default,UnixTime=_interopRequireDefault(__webpack_require__(173)).default,styles=_interopRequireDefault(__webpack_require__(255)).default;class ProgressBar extends React.Component{render(){let worker=this.props.worker;if(!worker.progress_at){return null}let percent=Math.round(worker.percent);let fields=worker.progress_fields||{};return React.createElement("div",null,React.createElement("div",{className:styles.bar},React.createElement("div",{className:styles.fill,style:{width:percent+"%"}},worker.progress_done,"/",worker.progress_total)),React.createElement("ul",{className:styles.fields},React.createElement("li",null,percent,"% done",worker.eta>0&&React.createElement("span",null,", ETA ",React.createElement(UnixTime,{ts:worker.eta}))),Object.keys(fields).sort().map(key=>{return React.createElement("li",{key:key},key,": ",JSON.stringify(fields[key]))})))}}exports.default=ProgressBar;ProgressBar.propTypes={worker:React.PropTypes.object.isRequired}},function(module,exports,__webpack_require__){"use strict";Object.defineProperty(exports,"__esModule",{value:true});function _interopRequireDefault(obj){return obj&&obj.__esModule?obj:{default:obj}}var React=_interopRequireDefault(__webpack_require__(1)).default,PageList=_interopRequireDefault(__webpack_require__(184)).default,UnixTime=_interopRequireDefault(__webpack_require__(173)).default,styles=_interopRequireDefault(__webpack_require__(180)).default,cx=_interopRequireDefault(__webpack_require__(182)).default;class Workflows extends React.Component{constructor(...args){super(...args);this.state={page:1,count:0,workflows:[]}}fetch(){if(!this.props.url){return}fetch(`${this.props.url}?page=${this.state.page}`).then(resp=>resp.json()).then(data=>{this.setState({count:data.count,workflows:data.workflows})})}componentWillMount(){this.fetch()}updatePage(page){this.setState({page:page},this.fetch)}jobNames(workflow,jobIDs){return workflow.jobs.filter(job=>jobIDs.indexOf(job.job_id)>=0).map(job=>job.job_name).join(", ")}render(){return React.createElement("div",{className:cx(styles.panel,styles.panelDefault)},React.createElement("div",{className:styles.panelHeading},"Workflows"),React.createElement("div",{className:styles.panelBody},React.createElement("p",null,this.state.count," workflow(s)."),React.createElement(PageList,{page:this.state.page,totalCount:this.state.count,perPage:20,jumpTo:page=>()=>this.updatePage(page)})),React.createElement("div",{className:styles.tableResponsive},React.createElement("table",{className:styles.table},React.createElement("tbody",null,React.createElement("tr",null,React.createElement("th",null,"ID"),React.createElement("th",null,"Created At"),React.createElement("th",null,"Jobs"),React.createElement("th",null,"Completed At")),this.state.workflows.map(workflow=>{return React.createElement("tr",{key:workflow.id},React.createElement("td",null,workflow.id),React.createElement("td",null,React.createElement(UnixTime,{ts:workflow.created_at})),React.createElement("td",null,React.createElement("ul",{className:styles.listUnstyled},workflow.jobs.map(job=>{return React.createElement("li",{key:job.job_id},job.job_name,": ",job.state,job.depends_on.length>0?` (after ${this.jobNames(workflow,job.depends_on)})`:"")}))),React.createElement("td",null,workflow.completed_at>0?React.createElement(UnixTime,{ts:workflow.completed_at}):""))})))))}}exports.default=Workflows;Workflows.propTypes={url:React.PropTypes.string}}]);
\ No newline at end of file
@@ -0,0 +1,88 @@
+import React from 'react';
+import PageList from './PageList';
+import UnixTime from './UnixTime';
+import styles from './bootstrap.min.css';
+import cx from './cx';
+
+export default class Batches extends React.Component {
+  static propTypes = {
+    url: React.PropTypes.string,
+  }
+
+  state = {
+    page: 1,
+    count: 0,
+    batches: []
+  }
+
+  fetch() {
+    if (!this.props.url) {
+      return;
+    }
+    fetch(`${this.props.url}?page=${this.state.page}`).
+      then((resp) => resp.json()).
+      then((data) => {
+        this.setState({
+          count: data.count,
+          batches: data.batches
+        });
+      });
+  }
+
+  componentWillMount() {
+    this.fetch();
+  }
+
+  updatePage(page) {
+    this.setState({page: page}, this.fetch);
+  }
+
+  progress(batch) {
+    if (batch.total == 0) {
+      return 0;
+    }
+    return Math.floor((batch.succeeded + batch.failed) * 100 / batch.total);
+  }
+
+  render() {
+    return (
+      <div className={cx(styles.panel, styles.panelDefault)}>
+        <div className={styles.panelHeading}>Batches</div>
+        <div className={styles.panelBody}>
+          <p>{this.state.count} batch(es).</p>
+          <PageList page={this.state.page} totalCount={this.state.count} perPage={20} jumpTo={(page) => () => this.updatePage(page)}/>
+        </div>
+        <div className={styles.tableResponsive}>
+          <table className={styles.table}>
+            <tbody>
+              <tr>
+                <th>ID</th>
+                <th>Created At</th>
+                <th>Progress</th>
+                <th>Pending</th>
+                <th>Succeeded</th>
+                <th>Failed</th>
+                <th>Completed At</th>
+              </tr>
+              {
+                this.state.batches.map((batch) => {
+                  return (
+                    <tr key={batch.id}>
+                      <td>{batch.id}{batch.committed ? '' : ' (not committed)'}</td>
+                      <td><UnixTime ts={batch.created_at} /></td>
+                      <td>{this.progress(batch)}%</td>
+                      <td>{batch.pending}</td>
+                      <td>{batch.succeeded}</td>
+                      <td>{batch.failed}</td>
+                      <td>{batch.completed_at > 0 ? <UnixTime ts={batch.completed_at} /> : ''}</td>
+                    </tr>
+                    );
+                })
+              }
+            </tbody>
+          </table>
+        </div>
+      </div>
+    );
+  }
+}
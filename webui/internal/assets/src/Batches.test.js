@@ -0,0 +1,34 @@
+import expect from 'expect';
+import Batches from './Batches';
+import React from 'react';
+import ReactTestUtils from 'react-addons-test-utils';
+import { findAllByTag } from './TestUtils';
+
+describe('Batches', () => {
+  it('shows batches', () => {
+    let r = ReactTestUtils.createRenderer();
+    r.render(<Batches />);
+    let batches = r.getMountedInstance();
+
+    expect(batches.state.batches.length).toEqual(0);
+
+    batches.setState({
+      count: 2,
+      batches: [
+        {id: 'a', created_at: 1467760821, completed_at: 0, committed: true, total: 4, pending: 2, succeeded: 1, failed: 1},
+        {id: 'b', created_at: 1467760822, completed_at: 0, committed: false, total: 0, pending: 0, succeeded: 0, failed: 0}
+      ]
+    });
+
+    expect(batches.state.batches.length).toEqual(2);
+    expect(batches.progress(batches.state.batches[0])).toEqual(50);
+    expect(batches.progress(batches.state.batches[1])).toEqual(0);
+
+    let output = r.getRenderOutput();
+    let pageList = findAllByTag(output, 'PageList');
+    expect(pageList.length).toEqual(1);
+
+    pageList[0].props.jumpTo(2)();
+    expect(batches.state.page).toEqual(2);
+  });
+});
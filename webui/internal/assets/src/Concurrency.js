@@ -0,0 +1,101 @@
+import React from 'react';
+import styles from './bootstrap.min.css';
+import cx from './cx';
+
+export default class Concurrency extends React.Component {
+  static propTypes = {
+    url: React.PropTypes.string,
+    setURL: React.PropTypes.string,
+    resetURL: React.PropTypes.string,
+  }
+
+  state = {
+    statuses: [],
+    edits: {}
+  }
+
+  fetch() {
+    if (!this.props.url) {
+      return;
+    }
+    fetch(this.props.url).
+      then((resp) => resp.json()).
+      then((data) => {
+        this.setState({statuses: data, edits: {}});
+      });
+  }
+
+  componentWillMount() {
+    this.fetch();
+  }
+
+  edit(jobName, value) {
+    this.state.edits[jobName] = value;
+    this.setState({edits: this.state.edits});
+  }
+
+  save(jobName) {
+    let max = parseInt(this.state.edits[jobName], 10);
+    if (!this.props.setURL || isNaN(max) || max < 0) {
+      return;
+    }
+    fetch(`${this.props.setURL}/${jobName}/${max}`, {method: 'post'}).then(() => {
+      this.fetch();
+    });
+  }
+
+  reset(jobName) {
+    if (!this.props.resetURL) {
+      return;
+    }
+    fetch(`${this.props.resetURL}/${jobName}`, {method: 'post'}).then(() => {
+      this.fetch();
+    });
+  }
+
+  lockInfo(status) {
+    return Object.keys(status.lock_info || {}).sort().map((poolID) => `${poolID}: ${status.lock_info[poolID]}`).join(', ');
+  }
+
+  render() {
+    return (
+      <div className={cx(styles.panel, styles.panelDefault)}>
+        <div className={styles.panelHeading}>concurrency</div>
+        <div className={styles.panelBody}>
+          <p>A max concurrency of 0 means no limit. Overridden limits are kept across worker pool restarts for jobs that opt in.</p>
+        </div>
+        <div className={styles.tableResponsive}>
+          <table className={styles.table}>
+            <tbody>
+              <tr>
+                <th>Name</th>
+                <th>Max Concurrency</th>
+                <th>In Progress</th>
+                <th>By Worker Pool</th>
+                <th></th>
+              </tr>
+              {
+                this.state.statuses.map((status) => {
+                  let edit = this.state.edits[status.job_name];
+                  return (
+                    <tr key={status.job_name}>
+                      <td>{status.job_name}</td>
+                      <td>{status.max_concurrency}{status.overridden ? ' (overridden)' : ''}</td>
+                      <td>{status.lock}</td>
+                      <td>{this.lockInfo(status)}</td>
+                      <td>
+                        <input type="number" min="0" value={edit === undefined ? status.max_concurrency : edit} onChange={(e) => this.edit(status.job_name, e.target.value)} />
+                        <button className={cx(styles.btn, styles.btnDefault)} onClick={() => this.save(status.job_name)}>Set</button>
+                        <button className={cx(styles.btn, styles.btnDefault)} onClick={() => this.reset(status.job_name)}>Reset</button>
+                      </td>
+                    </tr>
+                    );
+                })
+              }
+            </tbody>
+          </table>
+        </div>
+      </div>
+    );
+  }
+}
@@ -0,0 +1,33 @@
+import expect from 'expect';
+import Concurrency from './Concurrency';
+import React from 'react';
+import ReactTestUtils from 'react-addons-test-utils';
+import { findAllByTag } from './TestUtils';
+
+describe('Concurrency', () => {
+  it('shows concurrency statuses', () => {
+    let r = ReactTestUtils.createRenderer();
+    r.render(<Concurrency />);
+    let concurrency = r.getMountedInstance();
+    expect(concurrency.state.statuses.length).toEqual(0);
+
+    concurrency.setState({
+      statuses: [
+        {job_name: 'test', max_concurrency: 2, overridden: true, lock: 1, lock_info: {b: 1}},
+        {job_name: 'test2', max_concurrency: 0, overridden: false, lock: 3, lock_info: {b: 1, a: 2}}
+      ]
+    });
+
+    expect(concurrency.lockInfo(concurrency.state.statuses[1])).toEqual('a: 2, b: 1');
+
+    let output = r.getRenderOutput();
+    let inputs = findAllByTag(output, 'input');
+    expect(inputs.length).toEqual(2);
+    expect(inputs[0].props.value).toEqual(2);
+
+    inputs[0].props.onChange({target: {value: '5'}});
+    output = r.getRenderOutput();
+    inputs = findAllByTag(output, 'input');
+    expect(inputs[0].props.value).toEqual('5');
+  });
+});
@@ -0,0 +1,90 @@
+import React from 'react';
+import UnixTime from './UnixTime';
+import styles from './bootstrap.min.css';
+import cx from './cx';
+
+const whereLabels = {
+  queue: 'Queued',
+  in_progress: 'In Progress',
+  scheduled: 'Scheduled For',
+  retry: 'Retry At',
+  dead: 'Died At',
+};
+
+export default class FindJob extends React.Component {
+  static propTypes = {
+    url: React.PropTypes.string,
+  }
+
+  state = {
+    jobID: '',
+    searched: '',
+    scanned: false,
+    location: null
+  }
+
+  // find looks the job up in the job index, or scans the queues for it if scan is set, which is slower.
+  find(jobID, scan) {
+    jobID = jobID.trim();
+    if (!this.props.url || !jobID) {
+      return;
+    }
+    fetch(`${this.props.url}/${jobID}${scan ? '?scan=1' : ''}`).
+      then((resp) => resp.json()).
+      then((data) => {
+        this.setState({searched: jobID, scanned: scan, location: data});
+      });
+  }
+
+  whereText(location) {
+    let text = whereLabels[location.where] || location.where;
+    if (location.worker_pool_id) {
+      text += ` (worker pool ${location.worker_pool_id})`;
+    }
+    return text;
+  }
+
+  render() {
+    let location = this.state.location;
+    return (
+      <div className={cx(styles.panel, styles.panelDefault)}>
+        <div className={styles.panelHeading}>find job</div>
+        <div className={styles.panelBody}>
+          <p>
+            <input type="text" placeholder="Job ID" value={this.state.jobID} onChange={(e) => this.setState({jobID: e.target.value})} />
+            <button className={cx(styles.btn, styles.btnDefault, styles.btnXs)} onClick={() => this.find(this.state.jobID, false)}>Find</button>
+          </p>
+          {
+            this.state.searched && !location && !this.state.scanned &&
+              <p>
+                Job {this.state.searched} isn't in the job index.{' '}
+                <button className={cx(styles.btn, styles.btnDefault, styles.btnXs)} onClick={() => this.find(this.state.searched, true)}>Scan the queues</button>
+              </p>
+          }
+          {this.state.searched && !location && this.state.scanned && <p>Job {this.state.searched} wasn't found, it might have been processed.</p>}
+        </div>
+        {
+          location &&
+            <div className={styles.tableResponsive}>
+              <table className={styles.table}>
+                <tbody>
+                  <tr>
+                    <th>Name</th>
+                    <th>Arguments</th>
+                    <th>Where</th>
+                    <th>When</th>
+                  </tr>
+                  <tr>
+                    <td>{location.job.name}</td>
+                    <td>{JSON.stringify(location.job.args)}</td>
+                    <td>{this.whereText(location)}</td>
+                    <td>{location.score ? <UnixTime ts={location.score} /> : ''}</td>
+                  </tr>
+                </tbody>
+              </table>
+            </div>
+        }
+      </div>
+    );
+  }
+}
@@ -0,0 +1,63 @@
+import expect from 'expect';
+import FindJob from './FindJob';
+import React from 'react';
+import ReactTestUtils from 'react-addons-test-utils';
+import { findAllByTag } from './TestUtils';
+
+describe('FindJob', () => {
+  it('edits the job ID', () => {
+    let r = ReactTestUtils.createRenderer();
+    r.render(<FindJob />);
+    let findJob = r.getMountedInstance();
+
+    let output = r.getRenderOutput();
+    let inputs = findAllByTag(output, 'input');
+    expect(inputs.length).toEqual(1);
+
+    inputs[0].props.onChange({target: {value: 'abc123'}});
+    expect(findJob.state.jobID).toEqual('abc123');
+  });
+
+  it('shows where the job is', () => {
+    let r = ReactTestUtils.createRenderer();
+    r.render(<FindJob />);
+    let findJob = r.getMountedInstance();
+
+    findJob.setState({
+      searched: 'abc123',
+      location: {job: {name: 'test', id: 'abc123', args: {a: 1}}, where: 'in_progress', worker_pool_id: 'pool1'}
+    });
+
+    let output = r.getRenderOutput();
+    let tds = findAllByTag(output, 'td');
+    expect(tds.length).toEqual(4);
+    expect(tds[0].props.children).toEqual('test');
+    expect(tds[1].props.children).toEqual('{"a":1}');
+    expect(tds[2].props.children).toEqual('In Progress (worker pool pool1)');
+  });
+
+  it('shows a job that was not found', () => {
+    let r = ReactTestUtils.createRenderer();
+    r.render(<FindJob />);
+    let findJob = r.getMountedInstance();
+
+    findJob.setState({searched: 'abc123', scanned: true, location: null});
+
+    let output = r.getRenderOutput();
+    expect(findAllByTag(output, 'td').length).toEqual(0);
+    expect(findAllByTag(output, 'p').length).toEqual(2);
+    expect(findAllByTag(output, 'button').length).toEqual(1);
+  });
+
+  it('offers to scan for a job that is not indexed', () => {
+    let r = ReactTestUtils.createRenderer();
+    r.render(<FindJob />);
+    let findJob = r.getMountedInstance();
+
+    findJob.setState({searched: 'abc123', scanned: false, location: null});
+
+    let output = r.getRenderOutput();
+    expect(findAllByTag(output, 'td').length).toEqual(0);
+    expect(findAllByTag(output, 'button').length).toEqual(2);
+  });
+});
@@ -0,0 +1,93 @@
+import React from 'react';
+import UnixTime from './UnixTime';
+import styles from './bootstrap.min.css';
+import cx from './cx';
+
+export default class PeriodicJobs extends React.Component {
+  static propTypes = {
+    url: React.PropTypes.string,
+    runURL: React.PropTypes.string,
+  }
+
+  state = {
+    jobs: [],
+    runStatus: ''
+  }
+
+  fetch() {
+    if (!this.props.url) {
+      return;
+    }
+    fetch(this.props.url).
+      then((resp) => resp.json()).
+      then((data) => {
+        this.setState({jobs: data});
+      });
+  }
+
+  componentWillMount() {
+    this.fetch();
+  }
+
+  runJob(job) {
+    if (!this.props.runURL) {
+      return;
+    }
+    fetch(`${this.props.runURL}/${encodeURIComponent(job.id)}`, {method: 'post'}).
+      then((resp) => resp.json()).
+      then((data) => {
+        let runStatus = `Periodic job ${job.id} wasn't found.`;
+        if (data.status === 'ok') {
+          runStatus = `Enqueued ${job.job_name} as job ${data.job_id}.`;
+        } else if (data.status === 'running') {
+          runStatus = `The previous run of periodic job ${job.id} is still queued or in progress.`;
+        }
+        this.setState({runStatus: runStatus});
+        this.fetch();
+      });
+  }
+
+  render() {
+    return (
+      <div className={cx(styles.panel, styles.panelDefault)}>
+        <div className={styles.panelHeading}>Periodic Jobs</div>
+        <div className={styles.panelBody}>
+          <p>{this.state.jobs.length} periodic job(s) stored in Redis.</p>
+          {this.state.runStatus && <p>{this.state.runStatus}</p>}
+        </div>
+        <div className={styles.tableResponsive}>
+          <table className={styles.table}>
+            <tbody>
+              <tr>
+                <th>ID</th>
+                <th>Name</th>
+                <th>Schedule</th>
+                <th>Arguments</th>
+                <th>Enabled</th>
+                <th>Next Run</th>
+                <th></th>
+              </tr>
+              {
+                this.state.jobs.map((job) => {
+                  return (
+                    <tr key={job.id}>
+                      <td>{job.id}</td>
+                      <td>{job.job_name}</td>
+                      <td>{job.spec}{job.time_zone && ` (${job.time_zone})`}{job.no_overlap && ', no overlap'}</td>
+                      <td>{JSON.stringify(job.args || {})}</td>
+                      <td>{job.enabled ? 'Yes' : 'No'}</td>
+                      <td>{job.next_run_at ? <UnixTime ts={job.next_run_at} /> : '-'}</td>
+                      <td>
+                        <button className={cx(styles.btn, styles.btnDefault, styles.btnXs)} onClick={() => this.runJob(job)}>Run Now</button>
+                      </td>
+                    </tr>
+                  );
+                })
+              }
+            </tbody>
+          </table>
+        </div>
+      </div>
+    );
+  }
+}
@@ -0,0 +1,30 @@
+import expect from 'expect';
+import PeriodicJobs from './PeriodicJobs';
+import React from 'react';
+import ReactTestUtils from 'react-addons-test-utils';
+import { findAllByTag } from './TestUtils';
+
+describe('PeriodicJobs', () => {
+  it('shows periodic jobs', () => {
+    let r = ReactTestUtils.createRenderer();
+    r.render(<PeriodicJobs />);
+    let periodicJobs = r.getMountedInstance();
+    expect(periodicJobs.state.jobs.length).toEqual(0);
+
+    periodicJobs.setState({
+      jobs: [
+        {id: 'tenant1', job_name: 'report', spec: '0 0 * * * *', args: {tenant: 1}, enabled: true, next_run_at: 1467753603},
+        {id: 'tenant2', job_name: 'report', spec: '0 0 * * * *', args: {tenant: 2}, enabled: false}
+      ]
+    });
+
+    let output = r.getRenderOutput();
+    let times = findAllByTag(output, 'UnixTime');
+    expect(times.length).toEqual(1);
+    expect(times[0].props.ts).toEqual(1467753603);
+
+    let buttons = findAllByTag(output, 'button');
+    expect(buttons.length).toEqual(2);
+    expect(buttons[0].props.children).toEqual('Run Now');
+  });
+});
@@ -1,6 +1,7 @@
 import React from 'react';
 import UnixTime from './UnixTime';
 import ShortList from './ShortList';
+import ProgressBar from './ProgressBar';
 import styles from './bootstrap.min.css';
 import cx from './cx';
 
@@ -20,6 +21,7 @@ class BusyWorkers extends React.Component {
               <th>Started At</th>
               <th>Check-in At</th>
               <th>Check-in</th>
+              <th>Progress</th>
             </tr>
             {
               this.props.worker.map((worker) => {
@@ -30,6 +32,7 @@ class BusyWorkers extends React.Component {
                     <td><UnixTime ts={worker.started_at}/></td>
                     <td><UnixTime ts={worker.checkin_at}/></td>
                     <td>{worker.checkin}</td>
+                    <td><ProgressBar worker={worker} /></td>
                   </tr>
                 );
               })
@@ -0,0 +1,33 @@
+import React from 'react';
+import UnixTime from './UnixTime';
+import styles from './ProgressBar.css';
+
+export default class ProgressBar extends React.Component {
+  static propTypes = {
+    worker: React.PropTypes.object.isRequired,
+  }
+
+  render() {
+    let worker = this.props.worker;
+    if (!worker.progress_at) {
+      return null;
+    }
+    let percent = Math.round(worker.percent);
+    let fields = worker.progress_fields || {};
+    return (
+      <div>
+        <div className={styles.bar}>
+          <div className={styles.fill} style={{width: percent + '%'}}>{worker.progress_done}/{worker.progress_total}</div>
+        </div>
+        <ul className={styles.fields}>
+          <li>{percent}% done{worker.eta > 0 && <span>, ETA <UnixTime ts={worker.eta}/></span>}</li>
+          {
+            Object.keys(fields).sort().map((key) => {
+              return (<li key={key}>{key}: {JSON.stringify(fields[key])}</li>);
+            })
+          }
+        </ul>
+      </div>
+    );
+  }
+}
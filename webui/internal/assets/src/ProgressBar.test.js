@@ -0,0 +1,28 @@
+import expect from 'expect';
+import ProgressBar from './ProgressBar';
+import React from 'react';
+import ReactTestUtils from 'react-addons-test-utils';
+import { findAllByTag } from './TestUtils';
+
+describe('ProgressBar', () => {
+  it('shows progress', () => {
+    let r = ReactTestUtils.createRenderer();
+    r.render(<ProgressBar worker={{progress_done: 25, progress_total: 100, progress_at: 1467753613, percent: 25, eta: 1467753643, progress_fields: {file: 'a.csv'}}} />);
+    let output = r.getRenderOutput();
+
+    let divs = findAllByTag(output, 'div');
+    expect(divs.length).toEqual(3);
+    expect(divs[2].props.style).toEqual({width: '25%'});
+    expect(findAllByTag(output, 'UnixTime')[0].props.ts).toEqual(1467753643);
+
+    let items = findAllByTag(output, 'li');
+    expect(items.length).toEqual(2);
+    expect(items[1].props.children).toEqual(['file', ': ', '"a.csv"']);
+  });
+
+  it('shows nothing without progress', () => {
+    let r = ReactTestUtils.createRenderer();
+    r.render(<ProgressBar worker={{checkin: 'sup'}} />);
+    expect(r.getRenderOutput()).toEqual(null);
+  });
+});
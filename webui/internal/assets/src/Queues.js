@@ -5,13 +5,18 @@ import cx from './cx';
 export default class Queues extends React.Component {
   static propTypes = {
     url: React.PropTypes.string,
+    pauseURL: React.PropTypes.string,
+    unpauseURL: React.PropTypes.string,
+    cancelURL: React.PropTypes.string,
   }
 
   state = {
-    queues: []
+    queues: [],
+    cancelJobID: '',
+    cancelStatus: ''
   }
 
-  componentWillMount() {
+  fetch() {
     if (!this.props.url) {
       return;
     }
@@ -22,6 +27,34 @@ export default class Queues extends React.Component {
       });
   }
 
+  componentWillMount() {
+    this.fetch();
+  }
+
+  togglePause(queue) {
+    let url = queue.paused ? this.props.unpauseURL : this.props.pauseURL;
+    if (!url) {
+      return;
+    }
+    fetch(`${url}/${queue.job_name}`, {method: 'post'}).then(() => {
+      this.fetch();
+    });
+  }
+
+  cancelJob() {
+    let jobID = this.state.cancelJobID.trim();
+    if (!this.props.cancelURL || !jobID) {
+      return;
+    }
+    fetch(`${this.props.cancelURL}/${jobID}`, {method: 'post'}).
+      then((resp) => resp.json()).
+      then((data) => {
+        let cancelStatus = data.status === 'ok' ? `Job ${jobID} was cancelled.` : `Job ${jobID} wasn't found in the queues, it will be skipped if it shows up.`;
+        this.setState({cancelJobID: '', cancelStatus: cancelStatus});
+        this.fetch();
+      });
+  }
+
   get queuedCount() {
     let count = 0;
     this.state.queues.map((queue) => {
@@ -36,6 +69,11 @@ export default class Queues extends React.Component {
         <div className={styles.panelHeading}>queues</div>
         <div className={styles.panelBody}>
           <p>{this.state.queues.length} queue(s) with a total of {this.queuedCount} item(s) queued.</p>
+          <p>
+            <input type="text" placeholder="Job ID" value={this.state.cancelJobID} onChange={(e) => this.setState({cancelJobID: e.target.value})} />
+            <button className={cx(styles.btn, styles.btnDefault, styles.btnXs)} onClick={() => this.cancelJob()}>Cancel Job</button>
+            {this.state.cancelStatus && <span> {this.state.cancelStatus}</span>}
+          </p>
         </div>
         <div className={styles.tableResponsive}>
           <table className={styles.table}>
@@ -44,6 +82,7 @@ export default class Queues extends React.Component {
                 <th>Name</th>
                 <th>Count</th>
                 <th>Latency (seconds)</th>
+                <th>Paused</th>
               </tr>
               {
                 this.state.queues.map((queue) => {
@@ -52,6 +91,11 @@ export default class Queues extends React.Component {
                       <td>{queue.job_name}</td>
                       <td>{queue.count}</td>
                       <td>{queue.latency}</td>
+                      <td>
+                        <button className={cx(styles.btn, styles.btnDefault, styles.btnXs)} onClick={() => this.togglePause(queue)}>
+                          {queue.paused ? 'Unpause' : 'Pause'}
+                        </button>
+                      </td>
                     </tr>
                     );
                 })
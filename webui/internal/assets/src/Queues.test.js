@@ -2,6 +2,7 @@ import expect from 'expect';
 import Queues from './Queues';
 import React from 'react';
 import ReactTestUtils from 'react-addons-test-utils';
+import { findAllByTag } from './TestUtils';
 
 describe('Queues', () => {
   it('gets queued count', () => {
@@ -20,4 +21,41 @@ describe('Queues', () => {
     expect(queues.state.queues.length).toEqual(2);
     expect(queues.queuedCount).toEqual(3);
   });
+
+  it('renders pause state', () => {
+    let r = ReactTestUtils.createRenderer();
+    r.render(<Queues />);
+    let queues = r.getMountedInstance();
+
+    queues.setState({
+      queues: [
+        {job_name: 'test', count: 1, latency: 0, paused: true},
+        {job_name: 'test2', count: 2, latency: 0, paused: false}
+      ]
+    });
+
+    let output = r.getRenderOutput();
+    let buttons = findAllByTag(output, 'button');
+    expect(buttons.length).toEqual(3);
+    expect(buttons[1].props.children).toEqual('Unpause');
+    expect(buttons[2].props.children).toEqual('Pause');
+  });
+
+  it('edits the job to cancel', () => {
+    let r = ReactTestUtils.createRenderer();
+    r.render(<Queues />);
+    let queues = r.getMountedInstance();
+
+    let output = r.getRenderOutput();
+    let inputs = findAllByTag(output, 'input');
+    expect(inputs.length).toEqual(1);
+    expect(inputs[0].props.value).toEqual('');
+
+    inputs[0].props.onChange({target: {value: 'abc123'}});
+    expect(queues.state.cancelJobID).toEqual('abc123');
+
+    output = r.getRenderOutput();
+    let buttons = findAllByTag(output, 'button');
+    expect(buttons[0].props.children).toEqual('Cancel Job');
+  });
 });
@@ -0,0 +1,92 @@
+import React from 'react';
+import PageList from './PageList';
+import UnixTime from './UnixTime';
+import styles from './bootstrap.min.css';
+import cx from './cx';
+
+export default class Workflows extends React.Component {
+  static propTypes = {
+    url: React.PropTypes.string,
+  }
+
+  state = {
+    page: 1,
+    count: 0,
+    workflows: []
+  }
+
+  fetch() {
+    if (!this.props.url) {
+      return;
+    }
+    fetch(`${this.props.url}?page=${this.state.page}`).
+      then((resp) => resp.json()).
+      then((data) => {
+        this.setState({
+          count: data.count,
+          workflows: data.workflows
+        });
+      });
+  }
+
+  componentWillMount() {
+    this.fetch();
+  }
+
+  updatePage(page) {
+    this.setState({page: page}, this.fetch);
+  }
+
+  jobNames(workflow, jobIDs) {
+    return workflow.jobs.filter((job) => jobIDs.indexOf(job.job_id) >= 0).map((job) => job.job_name).join(', ');
+  }
+
+  render() {
+    return (
+      <div className={cx(styles.panel, styles.panelDefault)}>
+        <div className={styles.panelHeading}>Workflows</div>
+        <div className={styles.panelBody}>
+          <p>{this.state.count} workflow(s).</p>
+          <PageList page={this.state.page} totalCount={this.state.count} perPage={20} jumpTo={(page) => () => this.updatePage(page)}/>
+        </div>
+        <div className={styles.tableResponsive}>
+          <table className={styles.table}>
+            <tbody>
+              <tr>
+                <th>ID</th>
+                <th>Created At</th>
+                <th>Jobs</th>
+                <th>Completed At</th>
+              </tr>
+              {
+                this.state.workflows.map((workflow) => {
+                  return (
+                    <tr key={workflow.id}>
+                      <td>{workflow.id}</td>
+                      <td><UnixTime ts={workflow.created_at} /></td>
+                      <td>
+                        <ul className={styles.listUnstyled}>
+                          {
+                            workflow.jobs.map((job) => {
+                              return (
+                                <li key={job.job_id}>
+                                  {job.job_name}: {job.state}
+                                  {job.depends_on.length > 0 ? ` (after ${this.jobNames(workflow, job.depends_on)})` : ''}
+                                </li>
+                              );
+                            })
+                          }
+                        </ul>
+                      </td>
+                      <td>{workflow.completed_at > 0 ? <UnixTime ts={workflow.completed_at} /> : ''}</td>
+                    </tr>
+                    );
+                })
+              }
+            </tbody>
+          </table>
+        </div>
+      </div>
+    );
+  }
+}
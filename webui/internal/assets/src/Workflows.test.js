@@ -0,0 +1,42 @@
+import expect from 'expect';
+import Workflows from './Workflows';
+import React from 'react';
+import ReactTestUtils from 'react-addons-test-utils';
+import { findAllByTag } from './TestUtils';
+
+describe('Workflows', () => {
+  it('shows workflows', () => {
+    let r = ReactTestUtils.createRenderer();
+    r.render(<Workflows />);
+    let workflows = r.getMountedInstance();
+
+    expect(workflows.state.workflows.length).toEqual(0);
+
+    workflows.setState({
+      count: 1,
+      workflows: [
+        {
+          id: 'a', created_at: 1467760821, completed_at: 0,
+          jobs: [
+            {job_id: '1', job_name: 'fetch', depends_on: [], state: 'succeeded'},
+            {job_id: '2', job_name: 'resize', depends_on: ['1'], state: 'queued'},
+            {job_id: '3', job_name: 'publish', depends_on: ['1', '2'], state: 'waiting'}
+          ]
+        }
+      ]
+    });
+
+    expect(workflows.state.workflows.length).toEqual(1);
+    expect(workflows.jobNames(workflows.state.workflows[0], ['1', '2'])).toEqual('fetch, resize');
+
+    let output = r.getRenderOutput();
+    let jobs = findAllByTag(output, 'li');
+    expect(jobs.length).toEqual(3);
+
+    let pageList = findAllByTag(output, 'PageList');
+    expect(pageList.length).toEqual(1);
+
+    pageList[0].props.jumpTo(2)();
+    expect(workflows.state.page).toEqual(2);
+  });
+});
@@ -3,8 +3,13 @@ import { render } from 'react-dom';
 import Processes from './Processes';
 import DeadJobs from './DeadJobs';
 import Queues from './Queues';
+import Concurrency from './Concurrency';
 import RetryJobs from './RetryJobs';
 import ScheduledJobs from './ScheduledJobs';
+import Batches from './Batches';
+import Workflows from './Workflows';
+import FindJob from './FindJob';
+import PeriodicJobs from './PeriodicJobs';
 import { Router, Route, Link, IndexRedirect, hashHistory } from 'react-router';
 import styles from './bootstrap.min.css';
 import cx from './cx';
@@ -50,9 +55,14 @@ class App extends React.Component {
                 <li><input placeholder="NameSpace" value={this.state.NsValue} onChange={v=>this.changeInput(v)}/> <button onClick={v=>this.changeNamespace()}>Reload</button></li>
                 <li><Link to="/processes">Processes</Link></li>
                 <li><Link to="/queues">Queues</Link></li>
+                <li><Link to="/concurrency">Concurrency</Link></li>
                 <li><Link to="/retry_jobs">Retry Jobs</Link></li>
                 <li><Link to="/scheduled_jobs">Scheduled Jobs</Link></li>
                 <li><Link to="/dead_jobs">Dead Jobs</Link></li>
+                <li><Link to="/periodic_jobs">Periodic Jobs</Link></li>
+                <li><Link to="/batches">Batches</Link></li>
+                <li><Link to="/workflows">Workflows</Link></li>
+                <li><Link to="/find_job">Find Job</Link></li>
               </ul>
             </nav>
           </aside>
@@ -68,7 +78,8 @@ render(
   <Router history={hashHistory}>
     <Route path="/" component={App}>
       <Route path="/processes" component={ () => <Processes busyWorkerURL="/busy_workers" workerPoolURL="/worker_pools" /> } />
-      <Route path="/queues" component={ () => <Queues url="/queues" /> } />
+      <Route path="/queues" component={ () => <Queues url="/queues" pauseURL="/pause_job" unpauseURL="/unpause_job" cancelURL="/cancel_job" /> } />
+      <Route path="/concurrency" component={ () => <Concurrency url="/concurrency" setURL="/set_max_concurrency" resetURL="/reset_max_concurrency" /> } />
       <Route path="/retry_jobs" component={ () => <RetryJobs url="/retry_jobs" /> } />
       <Route path="/scheduled_jobs" component={ () => <ScheduledJobs url="/scheduled_jobs" /> } />
       <Route path="/dead_jobs" component={ () =>
@@ -80,6 +91,10 @@ render(
           deleteAllURL="/delete_all_dead_jobs"
         />
       } />
+      <Route path="/periodic_jobs" component={ () => <PeriodicJobs url="/periodic_jobs" runURL="/run_periodic_job" /> } />
+      <Route path="/batches" component={ () => <Batches url="/batches" /> } />
+      <Route path="/workflows" component={ () => <Workflows url="/workflows" /> } />
+      <Route path="/find_job" component={ () => <FindJob url="/find_job" /> } />
       <IndexRedirect from="" to="/processes" />
     </Route>
   </Router>,
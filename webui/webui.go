@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
 	"strconv"
 	"sync"
 
@@ -23,6 +24,7 @@ type Server struct {
 	server    *manners.GracefulServer
 	wg        sync.WaitGroup
 	router    *web.Router
+	logger    work.Logger
 }
 
 type context struct {
@@ -41,6 +43,7 @@ func NewServer(namespace string, pool *redis.Pool, hostPort string) *Server {
 		hostPort:  hostPort,
 		server:    manners.NewWithServer(&http.Server{Addr: hostPort, Handler: router}),
 		router:    router,
+		logger:    work.NewStdLogger(os.Stdout, work.LogLevelInfo),
 	}
 
 	router.Middleware(func(c *context, rw web.ResponseWriter, r *web.Request, next web.NextMiddlewareFunc) {
@@ -48,6 +51,15 @@ func NewServer(namespace string, pool *redis.Pool, hostPort string) *Server {
 		next(rw, r)
 	})
 
+	router.Middleware(func(c *context, rw web.ResponseWriter, r *web.Request, next web.NextMiddlewareFunc) {
+		next(rw, r)
+		level := work.LogLevelDebug
+		if rw.StatusCode() >= 500 {
+			level = work.LogLevelError
+		}
+		c.logger.Log(level, "webui.request", "method", r.Method, "path", r.URL.Path, "status", rw.StatusCode())
+	})
+
 	router.Middleware(func(c *context, rw web.ResponseWriter, r *web.Request, next web.NextMiddlewareFunc) {
 		rw.Header().Add("Access-Control-Allow-Origin", "*")
 		next(rw, r)
@@ -69,6 +81,20 @@ func NewServer(namespace string, pool *redis.Pool, hostPort string) *Server {
 	router.Post("/retry_all_dead_jobs", (*context).retryAllDeadJobs)
 	router.Post("/change_namespace/:ns", (*context).changeNamespace)
 	router.Post("/clearWorker/:workerPool_id/:worker_id", (*context).clearWorker)
+	router.Post("/pause_job/:job_name", (*context).pauseJob)
+	router.Post("/unpause_job/:job_name", (*context).unpauseJob)
+	router.Post("/cancel_job/:job_id", (*context).cancelJob)
+	router.Get("/find_job/:job_id", (*context).findJob)
+	router.Get("/periodic_jobs", (*context).periodicJobs)
+	router.Post("/run_periodic_job/:periodic_job_id", (*context).runPeriodicJob)
+	router.Get("/concurrency", (*context).concurrency)
+	router.Post("/set_max_concurrency/:job_name/:max:\\d+", (*context).setMaxConcurrency)
+	router.Post("/reset_max_concurrency/:job_name", (*context).resetMaxConcurrency)
+	router.Get("/batches", (*context).batches)
+	router.Get("/batch/:batch_id", (*context).batch)
+	router.Get("/workflows", (*context).workflows)
+	router.Get("/workflow/:workflow_id", (*context).workflow)
+	router.Get("/metrics", (*context).metrics)
 
 	//
 	// Build the HTML page:
@@ -86,6 +112,14 @@ func NewServer(namespace string, pool *redis.Pool, hostPort string) *Server {
 	return server
 }
 
+// SetLogger sets the logger that the server logs requests and Redis errors to, instead of the default one that writes to stdout.
+// Requests are logged at LogLevelDebug, or LogLevelError when they fail.
+func (w *Server) SetLogger(l work.Logger) {
+	w.logger = l
+	w.client.SetLogger(l)
+	enqueuer.SetLogger(l)
+}
+
 // Start starts the server listening for requests on the hostPort specified in NewServer.
 func (w *Server) Start() {
 	w.wg.Add(1)
@@ -191,6 +225,62 @@ func (c *context) deadJobs(rw web.ResponseWriter, r *web.Request) {
 	render(rw, response, err)
 }
 
+func (c *context) batches(rw web.ResponseWriter, r *web.Request) {
+	page, err := parsePage(r)
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	batches, count, err := c.client.Batches(page)
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	response := struct {
+		Count   int64               `json:"count"`
+		Batches []*work.BatchStatus `json:"batches"`
+	}{Count: count, Batches: batches}
+
+	render(rw, response, err)
+}
+
+func (c *context) batch(rw web.ResponseWriter, r *web.Request) {
+	response, err := c.client.BatchStatus(r.PathParams["batch_id"])
+	render(rw, response, err)
+}
+
+func (c *context) workflows(rw web.ResponseWriter, r *web.Request) {
+	page, err := parsePage(r)
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	workflows, count, err := c.client.Workflows(page)
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	response := struct {
+		Count     int64                  `json:"count"`
+		Workflows []*work.WorkflowStatus `json:"workflows"`
+	}{Count: count, Workflows: workflows}
+
+	render(rw, response, err)
+}
+
+func (c *context) workflow(rw web.ResponseWriter, r *web.Request) {
+	response, err := c.client.WorkflowStatus(r.PathParams["workflow_id"])
+	render(rw, response, err)
+}
+
+func (c *context) metrics(rw web.ResponseWriter, r *web.Request) {
+	c.client.MetricsHandler().ServeHTTP(rw, r.Request)
+}
+
 func (c *context) deleteDeadJob(rw web.ResponseWriter, r *web.Request) {
 	diedAt, err := strconv.ParseInt(r.PathParams["died_at"], 10, 64)
 	if err != nil {
@@ -213,10 +303,107 @@ func (c *context) clearWorker(rw web.ResponseWriter, r *web.Request) {
 	render(rw, map[string]string{"status": "ok"}, err)
 }
 
+// pauseJob pauses the job_name queue. An optional "ttl" query parameter (seconds) unpauses it automatically.
+func (c *context) pauseJob(rw web.ResponseWriter, r *web.Request) {
+	var ttl int64
+	if ttlStr := r.URL.Query().Get("ttl"); ttlStr != "" {
+		var err error
+		ttl, err = strconv.ParseInt(ttlStr, 10, 64)
+		if err != nil {
+			renderError(rw, err)
+			return
+		}
+	}
+
+	err := c.client.PauseJobFor(r.PathParams["job_name"], ttl)
+	render(rw, map[string]string{"status": "ok"}, err)
+}
+
+func (c *context) unpauseJob(rw web.ResponseWriter, r *web.Request) {
+	err := c.client.UnpauseJob(r.PathParams["job_name"])
+	render(rw, map[string]string{"status": "ok"}, err)
+}
+
+// cancelJob cancels the job_id job. The status is "not_found" if it isn't waiting in a queue, in which case it's skipped if it shows up.
+func (c *context) cancelJob(rw web.ResponseWriter, r *web.Request) {
+	cancelled, err := c.client.CancelJob(r.PathParams["job_id"])
+	status := "ok"
+	if !cancelled {
+		status = "not_found"
+	}
+	render(rw, map[string]string{"status": status}, err)
+}
+
+// findJob renders where the job_id job is, or null if it isn't found. It's only looked for where the job index says it is, unless
+// the scan query parameter is set, eg scan=1, see Client.FindJob.
+func (c *context) findJob(rw web.ResponseWriter, r *web.Request) {
+	location, err := c.client.FindJob(r.PathParams["job_id"], r.URL.Query().Get("scan") != "")
+	render(rw, location, err)
+}
+
+func (c *context) periodicJobs(rw web.ResponseWriter, r *web.Request) {
+	jobs, err := c.client.ListPeriodicJobs()
+	render(rw, jobs, err)
+}
+
+// runPeriodicJob enqueues the periodic_job_id periodic job right away. The status is "not_found" if there's no such job, and
+// "running" if its previous run is still going and it mustn't overlap.
+func (c *context) runPeriodicJob(rw web.ResponseWriter, r *web.Request) {
+	job, err := c.client.RunPeriodicJob(r.PathParams["periodic_job_id"])
+	if err == work.ErrPeriodicJobNotFound {
+		render(rw, map[string]string{"status": "not_found"}, nil)
+		return
+	} else if err == work.ErrPeriodicJobRunning {
+		render(rw, map[string]string{"status": "running"}, nil)
+		return
+	} else if err != nil {
+		renderError(rw, err)
+		return
+	}
+	render(rw, map[string]string{"status": "ok", "job_id": job.ID}, nil)
+}
+
+func (c *context) concurrency(rw web.ResponseWriter, r *web.Request) {
+	queues, err := c.client.Queues()
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	statuses := make([]*work.ConcurrencyStatus, 0, len(queues))
+	for _, q := range queues {
+		status, err := c.client.GetConcurrencyStatus(q.JobName)
+		if err != nil {
+			renderError(rw, err)
+			return
+		}
+		statuses = append(statuses, status)
+	}
+
+	render(rw, statuses, nil)
+}
+
+func (c *context) setMaxConcurrency(rw web.ResponseWriter, r *web.Request) {
+	max, err := strconv.ParseUint(r.PathParams["max"], 10, 0)
+	if err != nil {
+		renderError(rw, err)
+		return
+	}
+
+	err = c.client.SetMaxConcurrency(r.PathParams["job_name"], uint(max))
+	render(rw, map[string]string{"status": "ok"}, err)
+}
+
+func (c *context) resetMaxConcurrency(rw web.ResponseWriter, r *web.Request) {
+	err := c.client.ResetMaxConcurrency(r.PathParams["job_name"])
+	render(rw, map[string]string{"status": "ok"}, err)
+}
+
 func (c *context) changeNamespace(rw web.ResponseWriter, r *web.Request) {
 	ns := fmt.Sprint(r.PathParams["ns"])
 
 	c.client = work.NewClient(string(ns), c.pool)
+	c.client.SetLogger(c.logger)
 
 	render(rw, map[string]string{"status": "ok"}, nil)
 }
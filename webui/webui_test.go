@@ -447,6 +447,313 @@ func TestWebUIDeadJobsDeleteRetryAll(t *testing.T) {
 	assert.EqualValues(t, 0, res.Count)
 }
 
+func TestWebUIPauseUnpauseJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+
+	s := NewServer(ns, pool, ":6666")
+	client := work.NewClient(ns, pool)
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/pause_job/wat?ttl=60", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+
+	paused, err := client.PausedJobs()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"wat"}, paused)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/queues", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	var queueRes []struct {
+		JobName string `json:"job_name"`
+		Paused  bool   `json:"paused"`
+	}
+	err = json.Unmarshal(recorder.Body.Bytes(), &queueRes)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(queueRes))
+	if len(queueRes) == 1 {
+		assert.True(t, queueRes[0].Paused)
+	}
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", "/pause_job/wat?ttl=abc", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 500, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", "/unpause_job/wat", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+
+	paused, err = client.PausedJobs()
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(paused))
+}
+
+func TestWebUICancelJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	job, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+
+	s := NewServer(ns, pool, ":6666")
+
+	for _, expected := range []string{"ok", "not_found"} {
+		recorder := httptest.NewRecorder()
+		request, _ := http.NewRequest("POST", "/cancel_job/"+job.ID, nil)
+		s.router.ServeHTTP(recorder, request)
+		assert.Equal(t, 200, recorder.Code)
+		var res map[string]string
+		err = json.Unmarshal(recorder.Body.Bytes(), &res)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, res["status"])
+	}
+
+	queues, err := work.NewClient(ns, pool).Queues()
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(queues)) {
+		assert.EqualValues(t, 0, queues[0].Count)
+	}
+}
+
+func TestWebUIPeriodicJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	client := work.NewClient(ns, pool)
+	err := client.AddPeriodicJob(&work.PeriodicJob{ID: "tenant1", JobName: "report", Spec: "0 0 * * * *", Args: work.Q{"tenant": 1}, Enabled: true})
+	assert.NoError(t, err)
+	err = client.AddPeriodicJob(&work.PeriodicJob{ID: "tenant3", JobName: "report", Spec: "0 0 * * * *", NoOverlap: true})
+	assert.NoError(t, err)
+
+	s := NewServer(ns, pool, ":6666")
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/periodic_jobs", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	var jobs []*work.PeriodicJob
+	err = json.Unmarshal(recorder.Body.Bytes(), &jobs)
+	assert.NoError(t, err)
+	if assert.Equal(t, 2, len(jobs)) {
+		assert.Equal(t, "tenant1", jobs[0].ID)
+		assert.True(t, jobs[0].NextRunAt > 0)
+	}
+
+	for _, run := range []struct{ id, expected string }{
+		{"tenant1", "ok"},
+		{"tenant2", "not_found"},
+		{"tenant3", "ok"},
+		{"tenant3", "running"}, // it doesn't overlap the run above
+	} {
+		recorder = httptest.NewRecorder()
+		request, _ = http.NewRequest("POST", "/run_periodic_job/"+run.id, nil)
+		s.router.ServeHTTP(recorder, request)
+		assert.Equal(t, 200, recorder.Code)
+		var res map[string]string
+		err = json.Unmarshal(recorder.Body.Bytes(), &res)
+		assert.NoError(t, err)
+		assert.Equal(t, run.expected, res["status"])
+	}
+
+	queues, err := client.Queues()
+	assert.NoError(t, err)
+	if assert.Equal(t, 1, len(queues)) {
+		assert.EqualValues(t, 2, queues[0].Count)
+	}
+}
+
+func TestWebUIFindJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	job, err := enqueuer.EnqueueIn("wat", 100, nil)
+	assert.NoError(t, err)
+
+	s := NewServer(ns, pool, ":6666")
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/find_job/"+job.ID, nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	assert.Equal(t, "null", recorder.Body.String()) // it's not indexed
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/find_job/"+job.ID+"?scan=1", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	var res struct {
+		Job struct {
+			ID string `json:"id"`
+		} `json:"job"`
+		Where string `json:"where"`
+		Score int64  `json:"score"`
+	}
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	assert.NoError(t, err)
+	assert.Equal(t, job.ID, res.Job.ID)
+	assert.Equal(t, "scheduled", res.Where)
+	assert.Equal(t, job.RunAt, res.Score)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/find_job/nope?scan=1", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	assert.Equal(t, "null", recorder.Body.String())
+}
+
+func TestWebUIConcurrency(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+
+	s := NewServer(ns, pool, ":6666")
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("POST", "/set_max_concurrency/wat/4", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/concurrency", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	var res []*work.ConcurrencyStatus
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(res))
+	if len(res) == 1 {
+		assert.Equal(t, "wat", res[0].JobName)
+		assert.EqualValues(t, 4, res[0].MaxConcurrency)
+		assert.True(t, res[0].Overridden)
+	}
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("POST", "/reset_max_concurrency/wat", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+
+	status, err := work.NewClient(ns, pool).GetConcurrencyStatus("wat")
+	assert.NoError(t, err)
+	assert.False(t, status.Overridden)
+}
+
+func TestWebUIBatches(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	batch := enqueuer.NewBatch()
+	_, err := batch.Enqueue("wat", nil)
+	assert.NoError(t, err)
+	assert.NoError(t, batch.Commit())
+
+	s := NewServer(ns, pool, ":6666")
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/batches", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	var res struct {
+		Count   int64               `json:"count"`
+		Batches []*work.BatchStatus `json:"batches"`
+	}
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, res.Count)
+	assert.Equal(t, 1, len(res.Batches))
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/batch/"+batch.ID, nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	var status work.BatchStatus
+	err = json.Unmarshal(recorder.Body.Bytes(), &status)
+	assert.NoError(t, err)
+	assert.Equal(t, batch.ID, status.ID)
+	assert.EqualValues(t, 1, status.Pending)
+}
+
+func TestWebUIWorkflows(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	wf := enqueuer.NewWorkflow()
+	first, err := wf.Add("wat", nil)
+	assert.NoError(t, err)
+	_, err = wf.Add("then", nil, first)
+	assert.NoError(t, err)
+	assert.NoError(t, wf.Enqueue())
+
+	s := NewServer(ns, pool, ":6666")
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/workflows", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	var res struct {
+		Count     int64                  `json:"count"`
+		Workflows []*work.WorkflowStatus `json:"workflows"`
+	}
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, res.Count)
+	assert.Equal(t, 1, len(res.Workflows))
+
+	recorder = httptest.NewRecorder()
+	request, _ = http.NewRequest("GET", "/workflow/"+wf.ID, nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	var status work.WorkflowStatus
+	err = json.Unmarshal(recorder.Body.Bytes(), &status)
+	assert.NoError(t, err)
+	assert.Equal(t, wf.ID, status.ID)
+	if assert.Equal(t, 2, len(status.Jobs)) {
+		assert.Equal(t, work.WorkflowJobQueued, status.Jobs[0].State)
+		assert.Equal(t, work.WorkflowJobWaiting, status.Jobs[1].State)
+	}
+}
+
+func TestWebUIMetrics(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "testwork"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := work.NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue("wat", nil)
+	assert.NoError(t, err)
+
+	s := NewServer(ns, pool, ":6666")
+
+	recorder := httptest.NewRecorder()
+	request, _ := http.NewRequest("GET", "/metrics", nil)
+	s.router.ServeHTTP(recorder, request)
+	assert.Equal(t, 200, recorder.Code)
+	assert.Regexp(t, "^text/plain", recorder.Header().Get("Content-Type"))
+	assert.Contains(t, recorder.Body.String(), `work_queue_jobs{job_name="wat"} 1`)
+}
+
 func TestWebUIAssets(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "testwork"
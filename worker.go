@@ -1,12 +1,14 @@
 package work
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"math/rand"
 	"reflect"
+	"sync"
 	"time"
 
-	"errors"
 	"github.com/garyburd/redigo/redis"
 )
 
@@ -32,8 +34,19 @@ type worker struct {
 	drainChan        chan struct{}
 	doneDrainingChan chan struct{}
 
-	clearChan        chan struct{}
-	doneClearingChan chan struct{}
+	// ctx is cancelled when the worker is stopped. Every job's context derives from it.
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// jobCancel cancels the context of the job currently being processed, if any.
+	jobMtx     sync.Mutex
+	jobCancel  context.CancelFunc
+	jobCleared bool
+
+	logger   Logger
+	metrics  bool // record job metrics in Redis, see WorkerPool.EnableMetrics
+	jobIndex bool // update the job index, see WorkerPool.EnableJobIndex
+	tracer   Tracer
 }
 
 func newWorker(namespace string, poolID string, pool *redis.Pool, contextType reflect.Type, middleware, hook []*middlewareHandler, jobTypes map[string]*jobType) *worker {
@@ -54,16 +67,20 @@ func newWorker(namespace string, poolID string, pool *redis.Pool, contextType re
 
 		drainChan:        make(chan struct{}),
 		doneDrainingChan: make(chan struct{}),
-
-		clearChan:        make(chan struct{}),
-		doneClearingChan: make(chan struct{}),
 	}
 
 	w.updateMiddlewareAndJobTypes(middleware, hook, jobTypes)
+	w.setLogger(withFields(nil, "pool_id", poolID))
 
 	return w
 }
 
+// setLogger sets the logger of the worker and its observer, adding the worker ID to l's fields. It can't be called while the worker is started.
+func (w *worker) setLogger(l Logger) {
+	w.logger = withFields(l, "worker_id", w.workerID)
+	w.observer.logger = w.logger
+}
+
 // note: can't be called while the thing is started
 func (w *worker) updateMiddlewareAndJobTypes(middleware, hook []*middlewareHandler, jobTypes map[string]*jobType) {
 	if middleware != nil {
@@ -88,11 +105,14 @@ func (w *worker) updateMiddlewareAndJobTypes(middleware, hook []*middlewareHandl
 }
 
 func (w *worker) start() {
+	w.ctx, w.cancel = context.WithCancel(context.Background())
 	go w.loop()
 	go w.observer.start()
 }
 
+// stop cancels the context of the job in progress, waits for its handler to return, and then stops the worker.
 func (w *worker) stop() {
+	w.cancel()
 	w.stopChan <- struct{}{}
 	<-w.doneStoppingChan
 	w.observer.drain()
@@ -105,9 +125,15 @@ func (w *worker) drain() {
 	w.observer.drain()
 }
 
+// ClearWorker cancels the context of the job the worker is processing, if any. The worker abandons that job
+// without retrying it and moves on to the next one. The job's batch or workflow, if any, counts it as failed.
 func (w *worker) ClearWorker() {
-	w.clearChan <- struct{}{}
-	<-w.doneClearingChan
+	w.jobMtx.Lock()
+	defer w.jobMtx.Unlock()
+	if w.jobCancel != nil {
+		w.jobCleared = true
+		w.jobCancel()
+	}
 }
 
 var sleepBackoffsInMilliseconds = []int64{0, 10, 100, 1000, 5000}
@@ -129,9 +155,13 @@ func (w *worker) loop() {
 			drained = true
 			timer.Reset(0)
 		case <-timer.C:
+			if w.ctx.Err() != nil {
+				// The worker is stopping: don't start another job with a cancelled context, stopChan is on its way.
+				continue
+			}
 			job, err := w.fetchJob()
 			if err != nil {
-				logError("worker.fetch", err)
+				logError(w.logger, "worker.fetch", err)
 				timer.Reset(10 * time.Millisecond)
 			} else if job != nil {
 				w.processJob(job)
@@ -158,12 +188,19 @@ func (w *worker) fetchJob() (*Job, error) {
 	// NOTE: we could optimize this to only resort every second, or something.
 	w.sampler.sample()
 	numKeys := len(w.sampler.samples) * fetchKeysPerJobType
-	var scriptArgs = make([]interface{}, 0, numKeys+1)
+	var scriptArgs = make([]interface{}, 0, numKeys+4)
 
 	for _, s := range w.sampler.samples {
 		scriptArgs = append(scriptArgs, s.redisJobs, s.redisJobsInProg, s.redisJobsPaused, s.redisJobsLock, s.redisJobsLockInfo, s.redisJobsMaxConcurrency) // KEYS[1-6 * N]
 	}
-	scriptArgs = append(scriptArgs, w.poolID) // ARGV[1]
+	scriptArgs = append(scriptArgs, w.poolID)                       // ARGV[1]
+	scriptArgs = append(scriptArgs, redisKeyCancelled(w.namespace)) // ARGV[2]
+	if w.jobIndex {
+		scriptArgs = append(scriptArgs, redisKeyJobIndexPrefix(w.namespace)) // ARGV[3]
+	} else {
+		scriptArgs = append(scriptArgs, "")
+	}
+	scriptArgs = append(scriptArgs, jobIndexTTL) // ARGV[4]
 	conn := w.pool.Get()
 	defer conn.Close()
 
@@ -174,8 +211,8 @@ func (w *worker) fetchJob() (*Job, error) {
 		return nil, err
 	}
 
-	if len(values) != 3 {
-		return nil, fmt.Errorf("need 3 elements back")
+	if len(values) != 4 {
+		return nil, fmt.Errorf("need 4 elements back")
 	}
 
 	rawJSON, ok := values[0].([]byte)
@@ -193,89 +230,228 @@ func (w *worker) fetchJob() (*Job, error) {
 		return nil, fmt.Errorf("response in prog not bytes")
 	}
 
+	cancelled, err := redis.Bool(values[3], nil)
+	if err != nil {
+		return nil, err
+	}
+
 	job, err := newJob(rawJSON, dequeuedFrom, inProgQueue)
 	if err != nil {
 		return nil, err
 	}
+	job.cancelled = cancelled
 
 	return job, nil
 }
 
+// ErrJobTimeout is the error recorded on a job whose handler didn't return within JobOptions.Timeout.
+var ErrJobTimeout = fmt.Errorf("job timed out")
+
+// abandonedJobGrace is how long a worker waits for the handler of a job that timed out or was cleared to return once its context is
+// cancelled, before moving on without it.
+var abandonedJobGrace = 5 * time.Second
+
+type runResult struct {
+	ctx reflect.Value
+	err error
+}
+
 func (w *worker) processJob(job *Job) {
+	// Whether the job still holds its uniqueness, to release once it's processed. See UniqueMode.
+	uniqueHeld := job.Unique
 	defer func() {
-		if job.Unique {
+		if uniqueHeld {
 			w.deleteUniqueJob(job)
 		}
 	}()
+	if job.cancelled {
+		// Client.CancelJob didn't find it, since it was on its way to the queue.
+		logInfo(w.logger, "worker.process_job.cancelled", "job_name", job.Name, "job_id", job.ID)
+		w.removeJobFromInProgress(job)
+		w.writeJobStatus(job, JobStatusDead, "error", "cancelled", "finished_at", nowEpochSeconds())
+		w.jobFinished(job, false)
+		return
+	}
+	if job.expired(nowEpochSeconds()) {
+		w.dropExpiredJob(job)
+		return
+	}
 	if jt, ok := w.jobTypes[job.Name]; ok {
 		if jt.StartingDeadline > 0 && job.ScheduledAt > 0 && job.ScheduledAt < jt.StartingDeadline {
 			w.removeJobFromInProgress(job)
+			w.writeJobStatus(job, JobStatusDead, "error", "missed its starting deadline", "finished_at", nowEpochSeconds())
+			w.jobFinished(job, false)
 			return
 		}
-		timeout := time.Duration(jt.Timeout) * time.Millisecond
-		if timeout <= 0 {
-			timeout = time.Minute * 1440 * 14
+		if uniqueHeld && job.UniqueMode == UniqueUntilStart {
+			w.deleteUniqueJob(job)
+			uniqueHeld = false
 		}
+		ctx, cancel := w.startJobContext(jt, job)
+		defer w.finishJobContext(cancel)
+
+		startedAt := time.Now()
+		w.writeJobStatus(job, JobStatusRunning, "started_at", startedAt.Unix(), "attempts", job.Fails+1)
+		latency := queueLatency(job, startedAt)
+		runCtx, span := w.startJobSpan(ctx, job, latency)
 		w.observeStarted(job.Name, job.ID, job.Args)
 		job.observer = w.observer // for Checkin
 		middleware := append(w.middleware, jt.middleware...)
 		hook := append(w.hook, jt.hook...)
 		var runErr error
-		chErr := make(chan error)
-		chCtx := make(chan reflect.Value)
-		go func() {
-			ctx, err := runJob(job, w.contextType, middleware, jt)
-			chErr <- err
-			chCtx <- ctx
-		}()
+		var cleared bool
+
+		// Buffered so that a handler we stop waiting for can still finish and be garbage collected.
+		chRes := make(chan runResult, 1)
+		go func(job *Job) {
+			returnCtx, err := runJob(runCtx, job, w.contextType, middleware, jt)
+			chRes <- runResult{ctx: returnCtx, err: err}
+		}(job)
+
+		var res runResult
+		var done bool
 		select {
-		case <-time.After(timeout):
-			if timeout > 0 {
-				fmt.Printf("Job %s Timeout", job.Name)
-				runErr = errors.New("Run Job Timeout")
-				break
-			}
-		case runErr = <-chErr:
-			ctx := <-chCtx
-			if runErr != nil {
-				job.Success = false
+		case res = <-chRes:
+			done = true
+		case <-ctx.Done():
+			if w.ctx.Err() != nil {
+				// The worker is stopping: let the handler wind down so it doesn't outlive the pool.
+				res = <-chRes
+				done = true
 			} else {
-				job.Success = true
+				if cleared = w.isJobCleared(); !cleared {
+					logWarn(w.logger, "worker.process_job.timeout", "job_name", job.Name, "job_id", job.ID)
+					runErr = ErrJobTimeout
+				}
+				// Give the handler a moment to return, so that it doesn't run alongside the job's retry.
+				select {
+				case <-chRes:
+				case <-time.After(abandonedJobGrace):
+					// It keeps running with job, so the rest is done on a copy.
+					logWarn(w.logger, "worker.process_job.abandoned", "job_name", job.Name, "job_id", job.ID)
+					abandoned := *job
+					job = &abandoned
+				}
 			}
-			runHook(job, ctx, hook)
-			break
-		case <-w.clearChan:
-			w.doneClearingChan <- struct{}{}
-			break
 		}
+		if done {
+			runErr = res.err
+			job.Success = runErr == nil
+			runHook(runCtx, job, res.ctx, hook)
+		}
+		if span != nil {
+			span.End(runErr)
+		}
+
+		// A job whose handler gave up because the pool is stopping didn't really fail: it's put back in its queue to run again.
+		interrupted := !cleared && w.ctx.Err() != nil && errors.Is(runErr, context.Canceled)
+
 		w.observeDone(job.Name, job.ID, runErr)
-		if runErr != nil {
+		if !cleared && !interrupted {
+			w.recordJobMetrics(job, time.Since(startedAt), latency, runErr)
+		}
+		logDebug(w.logger, "worker.process_job.done", "job_name", job.Name, "job_id", job.ID, "success", runErr == nil, "cleared", cleared, "interrupted", interrupted)
+		if interrupted {
+			w.requeueInterruptedJob(job)
+			// It's still pending, so it keeps its uniqueness.
+			uniqueHeld = false
+		} else if runErr != nil {
 			job.failed(runErr)
-			w.addToRetryOrDead(jt, job, runErr)
+			if w.addToRetryOrDead(jt, job, runErr) && job.UniqueMode == UniqueUntilSuccess {
+				// addToRetry kept the uniqueness for the retry.
+				uniqueHeld = false
+			}
+		} else if cleared {
+			// It was abandoned rather than done, so its status says so and its batch or workflow counts it as failed.
+			w.removeJobFromInProgress(job)
+			w.writeJobStatus(job, JobStatusDead, "error", "cleared", "finished_at", nowEpochSeconds())
+			w.jobFinished(job, false)
 		} else {
 			w.removeJobFromInProgress(job)
+			fields := []interface{}{"finished_at", nowEpochSeconds()}
+			if job.result != nil {
+				fields = append(fields, "result", job.result)
+			}
+			w.writeJobStatus(job, JobStatusSucceeded, fields...)
+			w.jobFinished(job, true)
 		}
 
 	} else {
 		// NOTE: since we don't have a jobType, we don't know max retries
 		runErr := fmt.Errorf("stray job: no handler")
-		logError("process_job.stray", runErr)
+		logError(w.logger, "process_job.stray", runErr, "job_name", job.Name, "job_id", job.ID)
 		job.failed(runErr)
 		w.addToDead(job, runErr)
+		w.writeJobStatus(job, JobStatusDead, "error", runErr.Error(), "finished_at", nowEpochSeconds())
+		w.jobFinished(job, false)
 	}
 }
 
+// startJobContext derives the context for a single job from the worker's context, applying the job's timeout, or the job type's.
+// The context carries a logger with the job's fields, see LoggerFromContext.
+func (w *worker) startJobContext(jt *jobType, job *Job) (context.Context, context.CancelFunc) {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	timeout := jt.Timeout
+	if job.Timeout > 0 {
+		timeout = job.Timeout
+	}
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(w.ctx, time.Duration(timeout)*time.Millisecond)
+	} else {
+		ctx, cancel = context.WithCancel(w.ctx)
+	}
+	ctx = contextWithLogger(ctx, withFields(w.logger, "job_name", job.Name, "job_id", job.ID))
+
+	w.jobMtx.Lock()
+	w.jobCancel = cancel
+	w.jobCleared = false
+	w.jobMtx.Unlock()
+
+	return ctx, cancel
+}
+
+func (w *worker) finishJobContext(cancel context.CancelFunc) {
+	w.jobMtx.Lock()
+	w.jobCancel = nil
+	w.jobMtx.Unlock()
+	cancel()
+}
+
+func (w *worker) isJobCleared() bool {
+	w.jobMtx.Lock()
+	defer w.jobMtx.Unlock()
+	return w.jobCleared
+}
+
 func (w *worker) deleteUniqueJob(job *Job) {
-	uniqueKey, err := redisKeyUniqueJob(w.namespace, job.Name, job.Args)
+	uniqueKey, err := job.uniqueRedisKey(w.namespace)
 	if err != nil {
-		logError("worker.delete_unique_job.key", err)
+		logError(w.logger, "worker.delete_unique_job.key", err, "job_name", job.Name, "job_id", job.ID)
 	}
 	conn := w.pool.Get()
 	defer conn.Close()
 
-	_, err = conn.Do("DEL", uniqueKey)
+	// A debounced enqueue might have set the key to a job enqueued since this one was fetched, which must stay unique.
+	script := redis.NewScript(1, redisLuaDeleteUniqueJob)
+	_, err = script.Do(conn, uniqueKey, job.rawJSON)
 	if err != nil {
-		logError("worker.delete_unique_job.del", err)
+		logError(w.logger, "worker.delete_unique_job.del", err, "job_name", job.Name, "job_id", job.ID)
+	}
+}
+
+// dropExpiredJob removes a job that expired before it could start, see WithTTL, and counts it as expired.
+func (w *worker) dropExpiredJob(job *Job) {
+	logInfo(w.logger, "worker.process_job.expired", "job_name", job.Name, "job_id", job.ID, "expires_at", job.ExpiresAt)
+	w.removeJobFromInProgress(job)
+	w.writeJobStatus(job, JobStatusDead, "error", "expired", "finished_at", nowEpochSeconds())
+	w.jobFinished(job, false)
+
+	conn := w.pool.Get()
+	defer conn.Close()
+
+	if _, err := conn.Do("HINCRBY", redisKeyMetricsCounter(w.namespace, metricExpired), job.Name, 1); err != nil {
+		logError(w.logger, "worker.drop_expired_job", err, "job_name", job.Name, "job_id", job.ID)
 	}
 }
 
@@ -288,11 +464,37 @@ func (w *worker) removeJobFromInProgress(job *Job) {
 	conn.Send("LREM", job.inProgQueue, 1, job.rawJSON)
 	conn.Send("DECR", redisKeyJobsLock(w.namespace, job.Name))
 	conn.Send("HINCRBY", redisKeyJobsLockInfo(w.namespace, job.Name), w.poolID, -1)
+	if w.jobIndex {
+		conn.Send("DEL", redisKeyJobIndex(w.namespace, job.ID))
+	}
 	if _, err := conn.Do("EXEC"); err != nil {
-		logError("worker.remove_job_from_in_progress.lrem", err)
+		logError(w.logger, "worker.remove_job_from_in_progress.lrem", err, "job_name", job.Name, "job_id", job.ID)
 	}
 }
 
+// requeueInterruptedJob puts a job that was interrupted by the worker stopping back at the front of its queue, as it was fetched,
+// so that it runs again without counting as a failure.
+func (w *worker) requeueInterruptedJob(job *Job) {
+	logInfo(w.logger, "worker.process_job.interrupted", "job_name", job.Name, "job_id", job.ID)
+
+	conn := w.pool.Get()
+	defer conn.Close()
+
+	conn.Send("MULTI")
+	conn.Send("LREM", job.inProgQueue, 1, job.rawJSON)
+	conn.Send("DECR", redisKeyJobsLock(w.namespace, job.Name))
+	conn.Send("HINCRBY", redisKeyJobsLockInfo(w.namespace, job.Name), w.poolID, -1)
+	conn.Send("RPUSH", job.dequeuedFrom, job.rawJSON)
+	if w.jobIndex {
+		conn.Send("SET", redisKeyJobIndex(w.namespace, job.ID), job.dequeuedFrom, "EX", jobIndexTTL)
+	}
+	if _, err := conn.Do("EXEC"); err != nil {
+		logError(w.logger, "worker.requeue_interrupted_job.exec", err, "job_name", job.Name, "job_id", job.ID)
+		return
+	}
+	w.writeJobStatus(job, JobStatusQueued)
+}
+
 type NoRetryError struct {
 	msg string
 }
@@ -301,22 +503,43 @@ func (n *NoRetryError) Error() string {
 	return n.msg
 }
 
-func (w *worker) addToRetryOrDead(jt *jobType, job *Job, runErr error) {
+// addToRetryOrDead returns whether the job was retried.
+func (w *worker) addToRetryOrDead(jt *jobType, job *Job, runErr error) bool {
 	_, isNoRetryError := runErr.(*NoRetryError)
-	failsRemaining := int64(jt.MaxFails) - job.Fails
+	maxFails := jt.MaxFails
+	if job.MaxFails > 0 {
+		maxFails = job.MaxFails
+	}
+	failsRemaining := int64(maxFails) - job.Fails
 	if failsRemaining > 0 && !isNoRetryError {
 		w.addToRetry(job, runErr)
+		w.writeJobStatus(job, JobStatusFailed, "error", runErr.Error())
+		return true
 	} else if !jt.SkipDead {
 		w.addToDead(job, runErr)
 	} else {
 		w.removeJobFromInProgress(job)
 	}
+	w.writeJobStatus(job, JobStatusDead, "error", runErr.Error(), "finished_at", nowEpochSeconds())
+	w.jobFinished(job, false)
+	return false
+}
+
+// writeJobStatus sets the state of the job in its status, if it has one, see WithStatus.
+func (w *worker) writeJobStatus(job *Job, state string, fields ...interface{}) {
+	writeJobStatus(w.namespace, w.pool, w.logger, job, state, fields...)
+}
+
+// jobFinished records the final outcome of a job in the batch or workflow it belongs to, if any.
+func (w *worker) jobFinished(job *Job, succeeded bool) {
+	markBatchJobDone(w.namespace, w.pool, w.logger, job, succeeded)
+	markWorkflowJobDone(w.namespace, w.pool, w.logger, job, succeeded)
 }
 
 func (w *worker) addToRetry(job *Job, runErr error) {
 	rawJSON, err := job.serialize()
 	if err != nil {
-		logError("worker.add_to_retry", err)
+		logError(w.logger, "worker.add_to_retry", err, "job_name", job.Name, "job_id", job.ID)
 		return
 	}
 
@@ -330,26 +553,54 @@ func (w *worker) addToRetry(job *Job, runErr error) {
 	if ok {
 		backoff = jt.Backoff
 	}
+	if job.Backoff != "" {
+		if b, ok := lookupBackoff(job.Backoff); ok {
+			backoff = b
+		} else {
+			logWarn(w.logger, "worker.add_to_retry.unknown_backoff", "backoff", job.Backoff, "job_name", job.Name, "job_id", job.ID)
+		}
+	}
 
 	if backoff == nil {
 		backoff = defaultBackoffCalculator
 	}
 
+	delay := backoff(job)
+
+	var uniqueKey string
+	if job.Unique && job.UniqueMode == UniqueUntilSuccess {
+		if uniqueKey, err = job.uniqueRedisKey(w.namespace); err != nil {
+			logError(w.logger, "worker.add_to_retry.unique_key", err, "job_name", job.Name, "job_id", job.ID)
+		}
+	}
+
 	conn.Send("MULTI")
 	conn.Send("LREM", job.inProgQueue, 1, job.rawJSON)
 	conn.Send("DECR", redisKeyJobsLock(w.namespace, job.Name))
 	conn.Send("HINCRBY", redisKeyJobsLockInfo(w.namespace, job.Name), w.poolID, -1)
-	conn.Send("ZADD", redisKeyRetry(w.namespace), nowEpochSeconds()+backoff(job), rawJSON)
+	conn.Send("ZADD", redisKeyRetry(w.namespace), nowEpochSeconds()+delay, rawJSON)
+	if w.jobIndex {
+		conn.Send("SET", redisKeyJobIndex(w.namespace, job.ID), redisKeyRetry(w.namespace), "EX", delay+jobIndexTTL)
+	}
+	if uniqueKey != "" {
+		expire := int64(expireTime)
+		if job.UniqueExpire > 0 {
+			expire = int64(job.UniqueExpire)
+		}
+		redis.NewScript(1, redisLuaRetainUniqueJob).Send(conn, uniqueKey, job.rawJSON, delay+expire)
+	}
 	if _, err = conn.Do("EXEC"); err != nil {
-		logError("worker.add_to_retry.exec", err)
+		logError(w.logger, "worker.add_to_retry.exec", err, "job_name", job.Name, "job_id", job.ID)
+		return
 	}
+	w.countJobMetric(job, metricRetried)
 }
 
 func (w *worker) addToDead(job *Job, runErr error) {
 	rawJSON, err := job.serialize()
 
 	if err != nil {
-		logError("worker.add_to_dead.serialize", err)
+		logError(w.logger, "worker.add_to_dead.serialize", err, "job_name", job.Name, "job_id", job.ID)
 		return
 	}
 
@@ -366,10 +617,15 @@ func (w *worker) addToDead(job *Job, runErr error) {
 	conn.Send("DECR", redisKeyJobsLock(w.namespace, job.Name))
 	conn.Send("HINCRBY", redisKeyJobsLockInfo(w.namespace, job.Name), w.poolID, -1)
 	conn.Send("ZADD", redisKeyDead(w.namespace), nowEpochSeconds(), rawJSON)
+	if w.jobIndex {
+		conn.Send("SET", redisKeyJobIndex(w.namespace, job.ID), redisKeyDead(w.namespace), "EX", jobIndexTTL)
+	}
 	_, err = conn.Do("EXEC")
 	if err != nil {
-		logError("worker.add_to_dead.exec", err)
+		logError(w.logger, "worker.add_to_dead.exec", err, "job_name", job.Name, "job_id", job.ID)
+		return
 	}
+	w.countJobMetric(job, metricDead)
 }
 
 // Default algorithm returns an fastly increasing backoff counter which grows in an unbounded fashion
@@ -1,6 +1,7 @@
 package work
 
 import (
+	"context"
 	"fmt"
 	"github.com/garyburd/redigo/redis"
 	"github.com/robfig/cron"
@@ -30,17 +31,22 @@ type WorkerPool struct {
 	scheduler        *requeuer
 	deadPoolReaper   *deadPoolReaper
 	periodicEnqueuer *periodicEnqueuer
+
+	logger   Logger
+	jobIndex bool // see EnableJobIndex
 }
 
 type jobType struct {
 	Name string
 	JobOptions
 
-	IsGeneric      bool
-	GenericHandler GenericHandler
-	DynamicHandler reflect.Value
-	middleware     []*middlewareHandler
-	hook           []*middlewareHandler
+	IsGeneric             bool
+	GenericHandler        GenericHandler
+	GenericContextHandler GenericContextHandler
+	DynamicHandler        reflect.Value
+	TakesContext          bool // DynamicHandler takes a context.Context after the custom context
+	middleware            []*middlewareHandler
+	hook                  []*middlewareHandler
 }
 
 // You may provide your own backoff function for retrying failed jobs or use the builtin one.
@@ -58,22 +64,34 @@ type JobOptions struct {
 	Backoff          BackoffCalculator // If not set, uses the default backoff algorithm
 	StartingDeadline int64             // UTC time in seconds(time.Now().Unix()), the deadline for starting the job if it misses its scheduled time for any reason
 	RetryOnStart     bool              // If true, when a worker pool is started, jobs that are "in progress" will be retried
-	Timeout          int
+	Timeout          int               // Milliseconds a job may run before its context.Context is cancelled (default is 0, meaning no timeout)
+
+	// If true, Start won't overwrite a max concurrency that was set at runtime with Client.SetMaxConcurrency.
+	KeepMaxConcurrencyOverride bool
 }
 
 // GenericHandler is a job handler without any custom context.
 type GenericHandler func(*Job) error
 
+// GenericContextHandler is a job handler without any custom context that receives a context.Context.
+// The context is cancelled when the job times out, when the worker pool is stopped, or when the worker is cleared from the web UI.
+type GenericContextHandler func(context.Context, *Job) error
+
 // GenericMiddlewareHandler is a middleware without any custom context.
 type GenericMiddlewareHandler func(*Job, NextMiddlewareFunc) error
 
+// GenericContextMiddlewareHandler is a middleware without any custom context that receives the job's context.Context.
+type GenericContextMiddlewareHandler func(context.Context, *Job, NextMiddlewareFunc) error
+
 // NextMiddlewareFunc is a function type (whose instances are named 'next') that you call to advance to the next middleware.
 type NextMiddlewareFunc func() error
 
 type middlewareHandler struct {
-	IsGeneric                bool
-	DynamicMiddleware        reflect.Value
-	GenericMiddlewareHandler GenericMiddlewareHandler
+	IsGeneric                       bool
+	DynamicMiddleware               reflect.Value
+	GenericMiddlewareHandler        GenericMiddlewareHandler
+	GenericContextMiddlewareHandler GenericContextMiddlewareHandler
+	TakesContext                    bool // DynamicMiddleware takes a context.Context after the custom context
 }
 
 // NewWorkerPool creates a new worker pool. ctx should be a struct literal whose type will be used for middleware and handlers.
@@ -93,6 +111,7 @@ func NewWorkerPool(ctx interface{}, concurrency uint, namespace string, pool *re
 		contextType:  ctxType,
 		jobTypes:     make(map[string]*jobType),
 	}
+	wp.logger = withFields(nil, "pool_id", wp.workerPoolID)
 
 	for i := uint(0); i < wp.concurrency; i++ {
 		w := newWorker(wp.namespace, wp.workerPoolID, wp.pool, wp.contextType, nil, nil, wp.jobTypes)
@@ -106,15 +125,26 @@ func (wp *WorkerPool) workerDrain(job *Job) error {
 	workerID := fmt.Sprint(job.Args["worker_id"])
 	for _, v := range wp.workers {
 		if v.workerID == workerID {
-			v.drain()
+			v.ClearWorker()
 		}
 	}
 	return nil
 }
 
+// SetLogger sets the logger that the pool and its workers log to, instead of the default one that writes to stdout.
+// Entries carry the pool ID, and the worker ID, job name and job ID when they're about a worker or a job. It must be called before Start.
+func (wp *WorkerPool) SetLogger(l Logger) *WorkerPool {
+	wp.logger = withFields(l, "pool_id", wp.workerPoolID)
+	for _, w := range wp.workers {
+		w.setLogger(wp.logger)
+	}
+	return wp
+}
+
 // Middleware appends the specified function to the middleware chain. The fn can take one of these forms:
 // (*ContextType).func(*Job, NextMiddlewareFunc) error, (ContextType matches the type of ctx specified when creating a pool)
 // func(*Job, NextMiddlewareFunc) error, for the generic middleware format.
+// Any of these forms may also accept a context.Context right before the *Job, eg func(context.Context, *Job, NextMiddlewareFunc) error.
 func (wp *WorkerPool) Middleware(fn interface{}) *WorkerPool {
 	return wp.Middlewares([]interface{}{fn})
 }
@@ -156,6 +186,11 @@ func (wp *WorkerPool) Hooks(fns []interface{}) *WorkerPool {
 // fn can take one of these forms:
 // (*ContextType).func(*Job) error, (ContextType matches the type of ctx specified when creating a pool)
 // func(*Job) error, for the generic handler format.
+// Any of these forms may also accept a context.Context right before the *Job, eg func(context.Context, *Job) error.
+// That context is cancelled when the job exceeds JobOptions.Timeout, when the pool is stopped, or when the worker is cleared.
+// The worker waits a few seconds for a job that timed out or was cleared to return, then moves on: a handler that ignores its
+// context keeps running, alongside the job's retry and beyond the pool's concurrency. A handler that returns the context's error
+// while the pool is stopping has its job put back in its queue, without counting as a failure.
 func (wp *WorkerPool) Job(name string, fn interface{}) *WorkerPool {
 	return wp.JobWithOptionsAndMiddlewares(name, JobOptions{}, fn, []interface{}{}, []interface{}{})
 }
@@ -189,9 +224,15 @@ func (wp *WorkerPool) JobWithOptionsAndMiddlewares(name string, jobOpts JobOptio
 		middleware:     jobMiddleware,
 		hook:           hookMiddleware,
 	}
-	if gh, ok := fn.(func(*Job) error); ok {
+	switch gh := fn.(type) {
+	case func(*Job) error:
 		jt.IsGeneric = true
 		jt.GenericHandler = gh
+	case func(context.Context, *Job) error:
+		jt.IsGeneric = true
+		jt.GenericContextHandler = gh
+	default:
+		jt.TakesContext = takesContextArg(vfn.Type())
 	}
 
 	wp.jobTypes[name] = jt
@@ -218,6 +259,28 @@ func (wp *WorkerPool) PeriodicallyEnqueue(spec string, jobName string) *WorkerPo
 	return wp
 }
 
+// PeriodicOptions can be passed to PeriodicallyEnqueueWithOptions.
+type PeriodicOptions struct {
+	Args      map[string]interface{} // The arguments of each run of the job.
+	TimeZone  string                 // The IANA time zone the spec is in, eg "America/New_York". If empty, the spec is in the local time zone.
+	NoOverlap bool                   // If true, a run is skipped while the previous one is still queued or in progress.
+	Expire    int                    // With NoOverlap, how long in seconds a run can keep the next ones from happening at most, in case it's lost. Default: 600.
+}
+
+// PeriodicallyEnqueueWithOptions will periodically enqueue jobName according to the cron-based spec, as per PeriodicallyEnqueue,
+// with the specified options. In a time zone, the spec follows the wall clock across DST changes: a run falling in the hour skipped
+// when clocks go forward happens right after it, and a run falling in the hour repeated when clocks go back only happens once.
+// It panics if the spec or the time zone is invalid.
+func (wp *WorkerPool) PeriodicallyEnqueueWithOptions(spec string, jobName string, opts PeriodicOptions) *WorkerPool {
+	pj, err := newPeriodicJob(spec, jobName, opts)
+	if err != nil {
+		panic(err)
+	}
+	wp.periodicJobs = append(wp.periodicJobs, pj)
+
+	return wp
+}
+
 // Start starts the workers and associated processes.
 func (wp *WorkerPool) Start() {
 	if wp.started {
@@ -230,14 +293,17 @@ func (wp *WorkerPool) Start() {
 	go wp.writeKnownJobsToRedis()
 
 	for _, w := range wp.workers {
-		go w.start()
+		w.start()
 	}
 
 	wp.heartbeater = newWorkerPoolHeartbeater(wp.namespace, wp.pool, wp.workerPoolID, wp.jobTypes, wp.concurrency, wp.workerIDs())
+	wp.heartbeater.logger = wp.logger
 	wp.heartbeater.start()
 	wp.startRequeuers()
 	wp.periodicEnqueuer = newPeriodicEnqueuer(wp.namespace, wp.pool, wp.periodicJobs)
+	wp.periodicEnqueuer.logger = wp.logger
 	wp.periodicEnqueuer.start()
+	logDebug(wp.logger, "worker_pool.start", "namespace", wp.namespace, "concurrency", wp.concurrency)
 }
 
 // Stop stops the workers and associated processes.
@@ -261,6 +327,7 @@ func (wp *WorkerPool) Stop() {
 	wp.scheduler.stop()
 	wp.deadPoolReaper.stop()
 	wp.periodicEnqueuer.stop()
+	logDebug(wp.logger, "worker_pool.stop", "namespace", wp.namespace)
 }
 
 // Drain drains all jobs in the queue before returning. Note that if jobs are added faster than we can process them, this function wouldn't return.
@@ -284,6 +351,13 @@ func (wp *WorkerPool) startRequeuers() {
 	wp.retrier = newRequeuer(wp.namespace, wp.pool, redisKeyRetry(wp.namespace), jobNames)
 	wp.scheduler = newRequeuer(wp.namespace, wp.pool, redisKeyScheduled(wp.namespace), jobNames)
 	wp.deadPoolReaper = newDeadPoolReaper(wp.namespace, wp.pool, jobNames, wp.jobTypes)
+	wp.retrier.logger = wp.logger
+	wp.scheduler.logger = wp.logger
+	if wp.jobIndex {
+		wp.retrier.indexJobs()
+		wp.scheduler.indexJobs()
+	}
+	wp.deadPoolReaper.logger = wp.logger
 	wp.retrier.start()
 	wp.scheduler.start()
 	wp.deadPoolReaper.start()
@@ -313,7 +387,7 @@ func (wp *WorkerPool) writeKnownJobsToRedis() {
 	}
 
 	if _, err := conn.Do("SADD", jobNames...); err != nil {
-		logError("write_known_jobs", err)
+		logError(wp.logger, "write_known_jobs", err)
 	}
 }
 
@@ -324,9 +398,20 @@ func (wp *WorkerPool) writeConcurrencyControlsToRedis() {
 
 	conn := wp.pool.Get()
 	defer conn.Close()
+	script := redis.NewScript(2, redisLuaSetMaxConcurrencyUnlessOverridden)
 	for jobName, jobType := range wp.jobTypes {
-		if _, err := conn.Do("SET", redisKeyJobsConcurrency(wp.namespace, jobName), jobType.MaxConcurrency); err != nil {
-			logError("write_concurrency_controls_max_concurrency", err)
+		if jobType.KeepMaxConcurrencyOverride {
+			if _, err := script.Do(conn, redisKeyJobsConcurrency(wp.namespace, jobName), redisKeyJobsConcurrencyOverride(wp.namespace, jobName), jobType.MaxConcurrency); err != nil {
+				logError(wp.logger, "write_concurrency_controls_max_concurrency", err)
+			}
+			continue
+		}
+		// The value isn't an override anymore.
+		conn.Send("MULTI")
+		conn.Send("SET", redisKeyJobsConcurrency(wp.namespace, jobName), jobType.MaxConcurrency)
+		conn.Send("DEL", redisKeyJobsConcurrencyOverride(wp.namespace, jobName))
+		if _, err := conn.Do("EXEC"); err != nil {
+			logError(wp.logger, "write_concurrency_controls_max_concurrency", err)
 		}
 	}
 }
@@ -341,9 +426,15 @@ func funcToMiddleware(fns []interface{}, ctxType reflect.Type) []*middlewareHand
 			DynamicMiddleware: vfn,
 		}
 
-		if gmh, ok := fn.(func(*Job, NextMiddlewareFunc) error); ok {
+		switch gmh := fn.(type) {
+		case func(*Job, NextMiddlewareFunc) error:
 			mw.IsGeneric = true
 			mw.GenericMiddlewareHandler = gmh
+		case func(context.Context, *Job, NextMiddlewareFunc) error:
+			mw.IsGeneric = true
+			mw.GenericContextMiddlewareHandler = gmh
+		default:
+			mw.TakesContext = takesContextArg(vfn.Type())
 		}
 
 		middleware = append(middleware, mw)
@@ -401,6 +492,9 @@ func instructiveMessage(vfn reflect.Value, addingType string, yourType string, a
 	str += "* func (c *" + ctxString + ") YourFunctionName(" + args + ") error  // or,\n"
 	str += "* func YourFunctionName(c *" + ctxString + ", " + args + ") error\n"
 	str += "*\n"
+	str += "* // Any of the above can also accept a context.Context (cancelled on timeout or stop) before the job:\n"
+	str += "* func YourFunctionName(ctx context.Context, " + args + ") error\n"
+	str += "*\n"
 	str += "* Unfortunately, your function has this signature: " + vfn.Type().String() + "\n"
 	str += "*\n"
 	str += strings.Repeat("*", 120) + "\n"
@@ -408,6 +502,29 @@ func instructiveMessage(vfn reflect.Value, addingType string, yourType string, a
 	return str
 }
 
+var stdContextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+
+// takesContextArg reports whether a handler or middleware with a custom context also accepts a context.Context,
+// ie, whether it looks like (*ContextType).func(context.Context, *Job, ...) error.
+func takesContextArg(fnType reflect.Type) bool {
+	return fnType.NumIn() > 1 && fnType.In(1) == stdContextType
+}
+
+// fnArgsAfterContexts returns the argument types of fnType, skipping the optional leading *ContextType and context.Context.
+func fnArgsAfterContexts(ctxType reflect.Type, fnType reflect.Type) []reflect.Type {
+	args := make([]reflect.Type, 0, fnType.NumIn())
+	for i := 0; i < fnType.NumIn(); i++ {
+		args = append(args, fnType.In(i))
+	}
+	if len(args) > 0 && args[0] == reflect.PtrTo(ctxType) {
+		args = args[1:]
+	}
+	if len(args) > 0 && args[0] == stdContextType {
+		args = args[1:]
+	}
+	return args
+}
+
 func isValidHandlerType(ctxType reflect.Type, vfn reflect.Value) bool {
 	fnType := vfn.Type()
 
@@ -415,7 +532,6 @@ func isValidHandlerType(ctxType reflect.Type, vfn reflect.Value) bool {
 		return false
 	}
 
-	numIn := fnType.NumIn()
 	numOut := fnType.NumOut()
 
 	if numOut != 1 {
@@ -430,18 +546,11 @@ func isValidHandlerType(ctxType reflect.Type, vfn reflect.Value) bool {
 	}
 
 	var j *Job
-	if numIn == 1 {
-		if fnType.In(0) != reflect.TypeOf(j) {
-			return false
-		}
-	} else if numIn == 2 {
-		if fnType.In(0) != reflect.PtrTo(ctxType) {
-			return false
-		}
-		if fnType.In(1) != reflect.TypeOf(j) {
-			return false
-		}
-	} else {
+	args := fnArgsAfterContexts(ctxType, fnType)
+	if len(args) != 1 {
+		return false
+	}
+	if args[0] != reflect.TypeOf(j) {
 		return false
 	}
 
@@ -455,7 +564,6 @@ func isValidMiddlewareType(ctxType reflect.Type, vfn reflect.Value) bool {
 		return false
 	}
 
-	numIn := fnType.NumIn()
 	numOut := fnType.NumOut()
 
 	if numOut != 1 {
@@ -471,24 +579,14 @@ func isValidMiddlewareType(ctxType reflect.Type, vfn reflect.Value) bool {
 
 	var j *Job
 	var nfn NextMiddlewareFunc
-	if numIn == 2 {
-		if fnType.In(0) != reflect.TypeOf(j) {
-			return false
-		}
-		if fnType.In(1) != reflect.TypeOf(nfn) {
-			return false
-		}
-	} else if numIn == 3 {
-		if fnType.In(0) != reflect.PtrTo(ctxType) {
-			return false
-		}
-		if fnType.In(1) != reflect.TypeOf(j) {
-			return false
-		}
-		if fnType.In(2) != reflect.TypeOf(nfn) {
-			return false
-		}
-	} else {
+	args := fnArgsAfterContexts(ctxType, fnType)
+	if len(args) != 2 {
+		return false
+	}
+	if args[0] != reflect.TypeOf(j) {
+		return false
+	}
+	if args[1] != reflect.TypeOf(nfn) {
 		return false
 	}
 
@@ -2,6 +2,7 @@ package work
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
@@ -29,6 +30,9 @@ func TestWorkerPoolHandlerValidations(t *testing.T) {
 	}{
 		{func(j *Job) error { return nil }, true},
 		{func(c *tstCtx, j *Job) error { return nil }, true},
+		{func(ctx context.Context, j *Job) error { return nil }, true},
+		{func(c *tstCtx, ctx context.Context, j *Job) error { return nil }, true},
+		{func(ctx context.Context, c *tstCtx, j *Job) error { return nil }, false},
 		{func(c *tstCtx, j *Job) {}, false},
 		{func(c *tstCtx, j *Job) string { return "" }, false},
 		{func(c *tstCtx, j *Job) (error, string) { return nil, "" }, false},
@@ -53,6 +57,9 @@ func TestWorkerPoolMiddlewareValidations(t *testing.T) {
 	}{
 		{func(j *Job, n NextMiddlewareFunc) error { return nil }, true},
 		{func(c *tstCtx, j *Job, n NextMiddlewareFunc) error { return nil }, true},
+		{func(ctx context.Context, j *Job, n NextMiddlewareFunc) error { return nil }, true},
+		{func(c *tstCtx, ctx context.Context, j *Job, n NextMiddlewareFunc) error { return nil }, true},
+		{func(c *tstCtx, ctx context.Context, j *Job) error { return nil }, false},
 		{func(c *tstCtx, j *Job) error { return nil }, false},
 		{func(c *tstCtx, j *Job, n NextMiddlewareFunc) {}, false},
 		{func(c *tstCtx, j *Job, n NextMiddlewareFunc) string { return "" }, false},
@@ -206,6 +213,38 @@ func TestWorkerPoolPauseSingleThreadedJobs(t *testing.T) {
 	assert.EqualValues(t, 0, hgetInt64(pool, redisKeyJobsLockInfo(ns, job1), wp.workerPoolID))
 }
 
+func TestWorkerPoolKeepMaxConcurrencyOverride(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns, job1, job2 := "work", "job1", "job2"
+	cleanKeyspace(ns, pool)
+
+	client := NewClient(ns, pool)
+	assert.NoError(t, client.SetMaxConcurrency(job1, 7))
+	assert.NoError(t, client.SetMaxConcurrency(job2, 7))
+
+	wp := NewWorkerPool(TestContext{}, 1, ns, pool)
+	wp.JobWithOptions(job1, JobOptions{MaxConcurrency: 2, KeepMaxConcurrencyOverride: true}, (*TestContext).SleepyJob)
+	wp.JobWithOptions(job2, JobOptions{MaxConcurrency: 2}, (*TestContext).SleepyJob)
+	wp.Start()
+	wp.Stop()
+
+	// job1 keeps the operator's value, job2 is clobbered by its JobOptions
+	assert.EqualValues(t, 7, getInt64(pool, redisKeyJobsConcurrency(ns, job1)))
+	assert.EqualValues(t, 2, getInt64(pool, redisKeyJobsConcurrency(ns, job2)))
+	status, err := client.GetConcurrencyStatus(job1)
+	assert.NoError(t, err)
+	assert.True(t, status.Overridden)
+	status, err = client.GetConcurrencyStatus(job2)
+	assert.NoError(t, err)
+	assert.False(t, status.Overridden)
+
+	// once the override is reset, the next start writes the JobOptions value
+	assert.NoError(t, client.ResetMaxConcurrency(job1))
+	wp.Start()
+	wp.Stop()
+	assert.EqualValues(t, 2, getInt64(pool, redisKeyJobsConcurrency(ns, job1)))
+}
+
 // Test Helpers
 func (t *TestContext) SleepyJob(job *Job) error {
 	sleepTime := time.Duration(job.ArgInt64("sleep"))
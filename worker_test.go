@@ -1,6 +1,7 @@
 package work
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"sync/atomic"
@@ -61,7 +62,7 @@ func TestWorkerBasics(t *testing.T) {
 	_, err = enqueuer.Enqueue(job3, Q{"a": 3})
 	assert.Nil(t, err)
 
-	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
 	w.start()
 	w.drain()
 	w.stop()
@@ -111,7 +112,7 @@ func TestWorkerInProgress(t *testing.T) {
 	_, err := enqueuer.Enqueue(job1, Q{"a": 1})
 	assert.Nil(t, err)
 
-	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
 	w.start()
 
 	// instead of w.forceIter(), we'll wait for 10 milliseconds to let the job start
@@ -162,7 +163,7 @@ func TestWorkerRetry(t *testing.T) {
 	enqueuer := NewEnqueuer(ns, pool)
 	_, err := enqueuer.Enqueue(job1, Q{"a": 1})
 	assert.Nil(t, err)
-	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
 	w.start()
 	w.drain()
 	w.stop()
@@ -187,6 +188,173 @@ func TestWorkerRetry(t *testing.T) {
 	assert.True(t, (nowEpochSeconds() - job.FailedAt) <= 2)
 }
 
+func TestWorkerTimeoutCancelsContext(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	deleteQueue(pool, ns, job1)
+	deleteRetryAndDead(pool, ns)
+	deletePausedAndLockedKeys(ns, job1, pool)
+
+	cancelled := make(chan error, 1)
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:       job1,
+		JobOptions: JobOptions{Priority: 1, MaxFails: 3, Timeout: 10},
+		IsGeneric:  true,
+		GenericContextHandler: func(ctx context.Context, job *Job) error {
+			<-ctx.Done()
+			cancelled <- ctx.Err()
+			return ctx.Err()
+		},
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue(job1, Q{"a": 1})
+	assert.Nil(t, err)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
+	w.start()
+	w.drain()
+	w.stop()
+
+	select {
+	case err := <-cancelled:
+		assert.Equal(t, context.DeadlineExceeded, err)
+	case <-time.After(time.Second):
+		t.Fatal("handler context was not cancelled")
+	}
+
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, "1", job1)))
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, job1)))
+
+	_, job := jobOnZset(pool, redisKeyRetry(ns))
+	assert.Equal(t, ErrJobTimeout.Error(), job.LastErr)
+}
+
+func TestWorkerStopRequeuesInterruptedJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	deleteQueue(pool, ns, job1)
+	deleteRetryAndDead(pool, ns)
+	deletePausedAndLockedKeys(ns, job1, pool)
+
+	started := make(chan struct{}, 2)
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:       job1,
+		JobOptions: JobOptions{Priority: 1, MaxFails: 1},
+		IsGeneric:  true,
+		GenericContextHandler: func(ctx context.Context, job *Job) error {
+			started <- struct{}{}
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	job, err := enqueuer.Enqueue(job1, Q{"a": 1})
+	assert.Nil(t, err)
+	_, err = enqueuer.Enqueue(job1, Q{"a": 2})
+	assert.Nil(t, err)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
+	w.start()
+	<-started
+	w.stop()
+
+	// The job is back at the front of its queue, without a failure, and the other one wasn't started.
+	assert.Equal(t, 0, len(started))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyDead(ns)))
+	assert.EqualValues(t, 2, listSize(pool, redisKeyJobs(ns, job1)))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, "1", job1)))
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, job1)))
+
+	next := jobOnQueue(pool, redisKeyJobs(ns, job1))
+	assert.Equal(t, job.ID, next.ID)
+	assert.EqualValues(t, 0, next.Fails)
+}
+
+func TestWorkerStopFailedJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	deleteQueue(pool, ns, job1)
+	deleteRetryAndDead(pool, ns)
+	deletePausedAndLockedKeys(ns, job1, pool)
+
+	started := make(chan struct{}, 1)
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:       job1,
+		JobOptions: JobOptions{Priority: 1, MaxFails: 3},
+		IsGeneric:  true,
+		GenericContextHandler: func(ctx context.Context, job *Job) error {
+			started <- struct{}{}
+			<-ctx.Done()
+			return fmt.Errorf("sorry kid")
+		},
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue(job1, Q{"a": 1})
+	assert.Nil(t, err)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
+	w.start()
+	<-started
+	w.stop()
+
+	// It failed for another reason than the pool stopping, so it's retried rather than put back in its queue.
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, job1)))
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyRetry(ns)))
+	_, job := jobOnZset(pool, redisKeyRetry(ns))
+	assert.EqualValues(t, 1, job.Fails)
+	assert.Equal(t, "sorry kid", job.LastErr)
+}
+
+func TestWorkerAbandonsStuckJob(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	deleteQueue(pool, ns, job1)
+	deleteRetryAndDead(pool, ns)
+	deletePausedAndLockedKeys(ns, job1, pool)
+
+	abandonedJobGrace = 50 * time.Millisecond
+	defer func() { abandonedJobGrace = 5 * time.Second }()
+
+	release := make(chan struct{})
+	fails := make(chan int64, 1)
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:       job1,
+		JobOptions: JobOptions{Priority: 1, MaxFails: 3, Timeout: 10},
+		IsGeneric:  true,
+		GenericHandler: func(job *Job) error {
+			<-release
+			fails <- job.Fails
+			return nil
+		},
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue(job1, Q{"a": 1})
+	assert.Nil(t, err)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
+	w.start()
+	w.drain()
+	w.stop()
+
+	// The worker moved on without the handler, which still has the job as it was.
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, "1", job1)))
+	_, job := jobOnZset(pool, redisKeyRetry(ns))
+	assert.Equal(t, ErrJobTimeout.Error(), job.LastErr)
+	close(release)
+	assert.EqualValues(t, 0, <-fails)
+}
+
 func TestWorkerRetryWithMaxFailsAndSkipDead(t *testing.T) {
 	pool := newTestPool(":6379")
 	ns := "work"
@@ -208,7 +376,7 @@ func TestWorkerRetryWithMaxFailsAndSkipDead(t *testing.T) {
 	enqueuer := NewEnqueuer(ns, pool)
 	_, err := enqueuer.Enqueue(job1, Q{"a": 1})
 	assert.Nil(t, err)
-	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
 	w.start()
 	w.drain()
 	w.stop()
@@ -229,7 +397,7 @@ func TestWorkerRetryWithMaxFailsAndSkipDead(t *testing.T) {
 
 	_, err = enqueuer.Enqueue(job1, Q{"a": 1})
 	assert.Nil(t, err)
-	w = newWorker(ns, "1", pool, tstCtxType, nil, jobTypes)
+	w = newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
 	w.start()
 	w.drain()
 	w.stop()
@@ -270,7 +438,7 @@ func TestWorkerRetryWithCustomBackoff(t *testing.T) {
 	enqueuer := NewEnqueuer(ns, pool)
 	_, err := enqueuer.Enqueue(job1, Q{"a": 1})
 	assert.Nil(t, err)
-	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
 	w.start()
 	w.drain()
 	w.stop()
@@ -327,7 +495,7 @@ func TestWorkerDead(t *testing.T) {
 	assert.Nil(t, err)
 	_, err = enqueuer.Enqueue(job2, nil)
 	assert.Nil(t, err)
-	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
 	w.start()
 	w.drain()
 	w.stop()
@@ -374,7 +542,7 @@ func TestWorkersPaused(t *testing.T) {
 	_, err := enqueuer.Enqueue(job1, Q{"a": 1})
 	assert.Nil(t, err)
 
-	w := newWorker(ns, "1", pool, tstCtxType, nil, jobTypes)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
 	// pause the jobs prior to starting
 	err = pauseJobs(ns, job1, pool)
 	assert.Nil(t, err)
@@ -560,6 +728,25 @@ func jobOnZset(pool *redis.Pool, key string) (int64, *Job) {
 	return scoreInt, job
 }
 
+// zsetScore returns the score of the job at index i of the zset.
+func zsetScore(pool *redis.Pool, key string, i int) float64 {
+	conn := pool.Get()
+	defer conn.Close()
+
+	v, err := redis.Strings(conn.Do("ZRANGE", key, i, i, "WITHSCORES"))
+	if err != nil {
+		panic("ZRANGE error: " + err.Error())
+	}
+	if len(v) != 2 {
+		return 0
+	}
+	score, err := strconv.ParseFloat(v[1], 64)
+	if err != nil {
+		panic("couldn't parse float: " + err.Error())
+	}
+	return score
+}
+
 func jobOnQueue(pool *redis.Pool, key string) *Job {
 	conn := pool.Get()
 	defer conn.Close()
@@ -681,3 +868,250 @@ func TestWorkerPoolStop(t *testing.T) {
 		t.Errorf("Expected that jobs queue was not completely emptied.")
 	}
 }
+
+func TestWorkerJobOptionsOverride(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	deleteQueue(pool, ns, job1)
+	deleteRetryAndDead(pool, ns)
+	deletePausedAndLockedKeys(ns, job1, pool)
+
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:       job1,
+		JobOptions: JobOptions{Priority: 1, MaxFails: 1},
+		IsGeneric:  true,
+		GenericContextHandler: func(ctx context.Context, job *Job) error {
+			if job.ArgBool("wait") {
+				<-ctx.Done()
+				return ctx.Err()
+			}
+			return fmt.Errorf("sorry kid")
+		},
+	}
+
+	// MaxFails: 1 would send it straight to dead, but the job has its own MaxFails and backoff.
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue(job1, Q{"wait": false}, WithMaxFails(3), WithBackoff("constant"))
+	assert.NoError(t, err)
+	w := newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
+	w.start()
+	w.drain()
+	w.stop()
+
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyRetry(ns)))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyDead(ns)))
+	ts, job := jobOnZset(pool, redisKeyRetry(ns))
+	assert.True(t, ts >= nowEpochSeconds()+28)
+	assert.True(t, ts <= nowEpochSeconds()+30)
+	assert.EqualValues(t, 3, job.MaxFails)
+	assert.Equal(t, "constant", job.Backoff)
+
+	// The job type has no timeout, but the job has one.
+	deleteRetryAndDead(pool, ns)
+	_, err = enqueuer.Enqueue(job1, Q{"wait": true}, WithTimeout(10*time.Millisecond), WithMaxFails(2))
+	assert.NoError(t, err)
+	w = newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
+	w.start()
+	w.drain()
+	w.stop()
+
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyRetry(ns)))
+	_, job = jobOnZset(pool, redisKeyRetry(ns))
+	assert.Equal(t, ErrJobTimeout.Error(), job.LastErr)
+	assert.Equal(t, 10, job.Timeout)
+}
+
+func TestWorkerUniqueModes(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	var dupWhileRunning *Job
+	var dupTried bool
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:       job1,
+		JobOptions: JobOptions{Priority: 1, MaxFails: 3},
+		IsGeneric:  true,
+		GenericHandler: func(job *Job) error {
+			if !dupTried {
+				dupTried = true
+				var err error
+				dupWhileRunning, err = enqueuer.EnqueueUnique(job1, Q{"a": 1}, WithUniqueMode(job.UniqueMode))
+				if err != nil {
+					return err
+				}
+			}
+			return fmt.Errorf("sorry kid")
+		},
+	}
+	uniqueKey, err := redisKeyUniqueJob(ns, job1, Q{"a": 1})
+	assert.NoError(t, err)
+
+	process := func(mode UniqueMode) {
+		cleanKeyspace(ns, pool)
+		dupTried = false
+		job, err := enqueuer.EnqueueUnique(job1, Q{"a": 1}, WithUniqueMode(mode))
+		assert.NoError(t, err)
+		assert.NotNil(t, job)
+
+		w := newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
+		w.start()
+		w.drain()
+		w.stop()
+	}
+
+	// By default, the job is unique while it runs, and stops being unique when it fails.
+	process(UniqueUntilDone)
+	assert.Nil(t, dupWhileRunning)
+	assert.False(t, keyExists(pool, uniqueKey))
+
+	// With UniqueUntilStart, a duplicate can be enqueued once it's started.
+	process(UniqueUntilStart)
+	assert.NotNil(t, dupWhileRunning)
+	assert.EqualValues(t, 2, zsetSize(pool, redisKeyRetry(ns)))
+
+	// With UniqueUntilSuccess, the job is unique while it's retried, until the retry is deleted.
+	process(UniqueUntilSuccess)
+	assert.Nil(t, dupWhileRunning)
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyRetry(ns)))
+	assert.True(t, keyExists(pool, uniqueKey))
+	job, err := enqueuer.EnqueueUnique(job1, Q{"a": 1}, WithUniqueMode(UniqueUntilSuccess))
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+
+	retryAt, j := jobOnZset(pool, redisKeyRetry(ns))
+	client := NewClient(ns, pool)
+	assert.NoError(t, client.DeleteRetryJob(retryAt, j.ID))
+	assert.False(t, keyExists(pool, uniqueKey))
+}
+
+func TestWorkerUniqueUntilSuccessDies(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	cleanKeyspace(ns, pool)
+
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:       job1,
+		JobOptions: JobOptions{Priority: 1, MaxFails: 1},
+		IsGeneric:  true,
+		GenericHandler: func(job *Job) error {
+			return fmt.Errorf("sorry kid")
+		},
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	job, err := enqueuer.EnqueueUnique(job1, Q{"a": 1}, WithUniqueMode(UniqueUntilSuccess))
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+
+	w := newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
+	w.start()
+	w.drain()
+	w.stop()
+
+	assert.EqualValues(t, 1, zsetSize(pool, redisKeyDead(ns)))
+	job, err = enqueuer.EnqueueUnique(job1, Q{"a": 1}, WithUniqueMode(UniqueUntilSuccess))
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+}
+
+func keyExists(pool *redis.Pool, key string) bool {
+	conn := pool.Get()
+	defer conn.Close()
+
+	v, err := redis.Bool(conn.Do("EXISTS", key))
+	if err != nil {
+		panic("could not check key: " + err.Error())
+	}
+	return v
+}
+
+func TestWorkerDropsExpiredJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	cleanKeyspace(ns, pool)
+
+	var ran []int64
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:       job1,
+		JobOptions: JobOptions{Priority: 1},
+		IsGeneric:  true,
+		GenericHandler: func(job *Job) error {
+			ran = append(ran, job.ArgInt64("a"))
+			return nil
+		},
+	}
+
+	enqueuer := NewEnqueuer(ns, pool)
+	_, err := enqueuer.Enqueue(job1, Q{"a": 1}, WithExpiresAt(time.Now().Add(-time.Second)))
+	assert.NoError(t, err)
+	_, err = enqueuer.Enqueue(job1, Q{"a": 2}, WithTTL(time.Hour))
+	assert.NoError(t, err)
+	_, err = enqueuer.EnqueueUnique(job1, Q{"a": 3}, WithExpiresAt(time.Now().Add(-time.Second)))
+	assert.NoError(t, err)
+
+	w := newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
+	w.start()
+	w.drain()
+	w.stop()
+
+	assert.Equal(t, []int64{2}, ran)
+	assert.EqualValues(t, 2, hgetInt64(pool, redisKeyMetricsCounter(ns, metricExpired), job1))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, "1", job1)))
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, job1)))
+
+	// The expired unique job released its uniqueness.
+	job, err := enqueuer.EnqueueUnique(job1, Q{"a": 3})
+	assert.NoError(t, err)
+	assert.NotNil(t, job)
+}
+
+func TestWorkerSkipsCancelledJobs(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	job1 := "job1"
+	cleanKeyspace(ns, pool)
+
+	var ran []int64
+	jobTypes := make(map[string]*jobType)
+	jobTypes[job1] = &jobType{
+		Name:       job1,
+		JobOptions: JobOptions{Priority: 1},
+		IsGeneric:  true,
+		GenericHandler: func(job *Job) error {
+			ran = append(ran, job.ArgInt64("a"))
+			return nil
+		},
+	}
+
+	// The job is cancelled before it reaches its queue, eg while it's being moved from the scheduled job queue.
+	job := &Job{Name: job1, ID: makeIdentifier(), EnqueuedAt: 12345, Args: Q{"a": 1}}
+	client := NewClient(ns, pool)
+	ok, err := client.CancelJob(job.ID)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	assert.Len(t, enqueuer.EnqueueJobs([]*Job{job}), 1)
+	_, err = enqueuer.Enqueue(job1, Q{"a": 2})
+	assert.NoError(t, err)
+
+	w := newWorker(ns, "1", pool, tstCtxType, nil, nil, jobTypes)
+	w.start()
+	w.drain()
+	w.stop()
+
+	assert.Equal(t, []int64{2}, ran)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobsInProgress(ns, "1", job1)))
+	assert.EqualValues(t, 0, getInt64(pool, redisKeyJobsLock(ns, job1)))
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyCancelled(ns)))
+}
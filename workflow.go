@@ -0,0 +1,243 @@
+package work
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/garyburd/redigo/redis"
+)
+
+// ErrWorkflowEnqueued is returned when adding jobs to a workflow that was already enqueued, or enqueueing it twice.
+var ErrWorkflowEnqueued = fmt.Errorf("workflow already enqueued")
+
+// ErrWorkflowEmpty is returned when enqueueing a workflow without any jobs, which would never complete.
+var ErrWorkflowEmpty = fmt.Errorf("workflow has no jobs")
+
+// ErrWorkflowNotFound is returned by Client.WorkflowStatus when there's no such workflow, or it expired after completing.
+var ErrWorkflowNotFound = fmt.Errorf("workflow not found")
+
+// The states of a job in a workflow.
+const (
+	WorkflowJobWaiting   = "waiting"   // waiting on the jobs it depends on
+	WorkflowJobQueued    = "queued"    // on its queue, or being processed or retried
+	WorkflowJobSucceeded = "succeeded" // done
+	WorkflowJobFailed    = "failed"    // failed and won't be retried anymore
+	WorkflowJobCancelled = "cancelled" // won't run because a job it depends on failed
+)
+
+// workflowRetention is how long a workflow is kept in Redis after all of its jobs are done, in seconds.
+const workflowRetention = 7 * 24 * 60 * 60
+
+// Workflow is a set of jobs with dependencies between them. A job is only enqueued once all the jobs it depends on have succeeded.
+// If a job fails for good, all of the jobs that depend on it, directly or not, are cancelled.
+// Create one with Enqueuer.NewWorkflow, add jobs to it, then call Enqueue.
+type Workflow struct {
+	ID string
+
+	enqueuer *Enqueuer
+	jobs     []*Job
+	nodes    map[string]*workflowNode
+	enqueued bool
+	mtx      sync.Mutex
+}
+
+// workflowNode is what's stored for each job in the workflow's graph.
+type workflowNode struct {
+	Name     string   `json:"name"`
+	Index    int      `json:"index"`
+	Parents  []string `json:"parents"`
+	Children []string `json:"children"`
+}
+
+// WorkflowJobStatus represents the state of a single job of a workflow.
+type WorkflowJobStatus struct {
+	JobID     string   `json:"job_id"`
+	JobName   string   `json:"job_name"`
+	DependsOn []string `json:"depends_on"`
+	State     string   `json:"state"`
+}
+
+// WorkflowStatus represents the progress of a workflow. Jobs are in the order they were added to the workflow.
+type WorkflowStatus struct {
+	ID          string               `json:"id"`
+	CreatedAt   int64                `json:"created_at"`
+	CompletedAt int64                `json:"completed_at"` // 0 until every job succeeded, failed or was cancelled
+	Jobs        []*WorkflowJobStatus `json:"jobs"`
+}
+
+// NewWorkflow creates a new, empty workflow. Nothing is written to Redis until it's enqueued.
+func (e *Enqueuer) NewWorkflow() *Workflow {
+	return &Workflow{
+		ID:       makeIdentifier(),
+		enqueuer: e,
+		nodes:    make(map[string]*workflowNode),
+	}
+}
+
+// Add adds a job to the workflow that runs once all of the dependsOn jobs have succeeded. Jobs without dependencies run as soon as the workflow is enqueued.
+// dependsOn must be jobs previously returned by Add on the same workflow.
+func (wf *Workflow) Add(jobName string, args map[string]interface{}, dependsOn ...*Job) (*Job, error) {
+	wf.mtx.Lock()
+	defer wf.mtx.Unlock()
+	if wf.enqueued {
+		return nil, ErrWorkflowEnqueued
+	}
+
+	job := &Job{
+		Name:       jobName,
+		ID:         makeIdentifier(),
+		Args:       args,
+		WorkflowID: wf.ID,
+	}
+
+	node := &workflowNode{
+		Name:     jobName,
+		Index:    len(wf.jobs),
+		Parents:  make([]string, 0, len(dependsOn)),
+		Children: []string{},
+	}
+	for _, parent := range dependsOn {
+		parentNode, ok := wf.nodes[parent.ID]
+		if !ok {
+			return nil, fmt.Errorf("workflow: job %s (%s) isn't part of the workflow", parent.Name, parent.ID)
+		}
+		node.Parents = append(node.Parents, parent.ID)
+		parentNode.Children = append(parentNode.Children, job.ID)
+	}
+
+	wf.jobs = append(wf.jobs, job)
+	wf.nodes[job.ID] = node
+
+	return job, nil
+}
+
+// Enqueue writes the workflow to Redis and enqueues the jobs that don't depend on any other job.
+// It returns ErrWorkflowEmpty if no jobs were added to the workflow.
+func (wf *Workflow) Enqueue() error {
+	wf.mtx.Lock()
+	defer wf.mtx.Unlock()
+	if wf.enqueued {
+		return ErrWorkflowEnqueued
+	}
+	if len(wf.jobs) == 0 {
+		return ErrWorkflowEmpty
+	}
+
+	e := wf.enqueuer
+	now := nowEpochSeconds()
+	metaKey, graphKey, stateKey, jobsKey := redisKeysWorkflow(e.Namespace, wf.ID)
+
+	conn := e.Pool.Get()
+	defer conn.Close()
+
+	conn.Send("MULTI")
+	conn.Send("HMSET", metaKey, "created_at", now, "total", len(wf.jobs), "remaining", len(wf.jobs))
+	conn.Send("ZADD", redisKeyWorkflows(e.Namespace), now, wf.ID)
+	for _, job := range wf.jobs {
+		node := wf.nodes[job.ID]
+		nodeJSON, err := json.Marshal(node)
+		if err != nil {
+			conn.Do("DISCARD")
+			return err
+		}
+		job.EnqueuedAt = now
+		rawJSON, err := job.serialize()
+		if err != nil {
+			conn.Do("DISCARD")
+			return err
+		}
+
+		conn.Send("HSET", graphKey, job.ID, nodeJSON)
+		if len(node.Parents) == 0 {
+			conn.Send("HSET", stateKey, job.ID, WorkflowJobQueued)
+			conn.Send("LPUSH", e.queuePrefix+job.Name, rawJSON)
+			conn.Send("SADD", redisKeyKnownJobs(e.Namespace), job.Name)
+		} else {
+			conn.Send("HSET", stateKey, job.ID, WorkflowJobWaiting)
+			conn.Send("HSET", jobsKey, job.ID, rawJSON)
+		}
+	}
+	if _, err := conn.Do("EXEC"); err != nil {
+		return err
+	}
+
+	wf.enqueued = true
+	return nil
+}
+
+// markWorkflowJobDone records the final outcome of a workflow job: it enqueues the jobs that were only waiting on it when it succeeded,
+// or cancels everything that depends on it when it failed.
+func markWorkflowJobDone(namespace string, pool *redis.Pool, logger Logger, job *Job, succeeded bool) {
+	if job.WorkflowID == "" {
+		return
+	}
+
+	outcome := WorkflowJobFailed
+	if succeeded {
+		outcome = WorkflowJobSucceeded
+	}
+
+	conn := pool.Get()
+	defer conn.Close()
+
+	metaKey, graphKey, stateKey, jobsKey := redisKeysWorkflow(namespace, job.WorkflowID)
+	script := redis.NewScript(5, redisLuaWorkflowJobDone)
+	_, err := script.Do(conn,
+		metaKey,                       // KEYS[1]
+		graphKey,                      // KEYS[2]
+		stateKey,                      // KEYS[3]
+		jobsKey,                       // KEYS[4]
+		redisKeyKnownJobs(namespace),  // KEYS[5]
+		redisKeyJobsPrefix(namespace), // ARGV[1]
+		nowEpochSeconds(),             // ARGV[2]
+		workflowRetention,             // ARGV[3]
+		job.ID,                        // ARGV[4]
+		outcome,                       // ARGV[5]
+	)
+	if err != nil && err != redis.ErrNil {
+		logError(logger, "workflow.job_done", err, "job_name", job.Name, "job_id", job.ID)
+	}
+}
+
+func parseWorkflowStatus(workflowID string, meta []string, graph, states map[string]string) (*WorkflowStatus, error) {
+	status := &WorkflowStatus{ID: workflowID}
+
+	for i := 0; i < len(meta)-1; i += 2 {
+		key := meta[i]
+		value := meta[i+1]
+
+		var err error
+		if key == "created_at" {
+			status.CreatedAt, err = strconv.ParseInt(value, 10, 64)
+		} else if key == "completed_at" {
+			status.CompletedAt, err = strconv.ParseInt(value, 10, 64)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	indexes := make(map[string]int, len(graph))
+	status.Jobs = make([]*WorkflowJobStatus, 0, len(graph))
+	for jobID, nodeJSON := range graph {
+		var node workflowNode
+		if err := json.Unmarshal([]byte(nodeJSON), &node); err != nil {
+			return nil, err
+		}
+		indexes[jobID] = node.Index
+		status.Jobs = append(status.Jobs, &WorkflowJobStatus{
+			JobID:     jobID,
+			JobName:   node.Name,
+			DependsOn: node.Parents,
+			State:     states[jobID],
+		})
+	}
+	sort.Slice(status.Jobs, func(i, j int) bool {
+		return indexes[status.Jobs[i].JobID] < indexes[status.Jobs[j].JobID]
+	})
+
+	return status, nil
+}
@@ -0,0 +1,148 @@
+package work
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkflowRunsJobsInOrder(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	wf := enqueuer.NewWorkflow()
+	fetch, err := wf.Add("fetch", Q{"url": "x"})
+	assert.NoError(t, err)
+	resize, err := wf.Add("resize", nil, fetch)
+	assert.NoError(t, err)
+	thumb, err := wf.Add("thumb", nil, fetch)
+	assert.NoError(t, err)
+	_, err = wf.Add("publish", nil, resize, thumb)
+	assert.NoError(t, err)
+	assert.Equal(t, wf.ID, fetch.WorkflowID)
+	assert.NoError(t, wf.Enqueue())
+
+	// Only the root is on its queue until it succeeds.
+	assert.EqualValues(t, 1, listSize(pool, redisKeyJobs(ns, "fetch")))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "resize")))
+
+	_, err = wf.Add("late", nil)
+	assert.Equal(t, ErrWorkflowEnqueued, err)
+	assert.Equal(t, ErrWorkflowEnqueued, wf.Enqueue())
+
+	var mtx sync.Mutex
+	var ran []string
+	handler := func(job *Job) error {
+		mtx.Lock()
+		defer mtx.Unlock()
+		ran = append(ran, job.Name)
+		return nil
+	}
+
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.Job("fetch", handler)
+	wp.Job("resize", handler)
+	wp.Job("thumb", handler)
+	wp.Job("publish", handler)
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	assert.Equal(t, 4, len(ran))
+	assert.Equal(t, "fetch", ran[0])
+	assert.Equal(t, "publish", ran[3])
+
+	client := NewClient(ns, pool)
+	status, err := client.WorkflowStatus(wf.ID)
+	assert.NoError(t, err)
+	assert.True(t, status.CompletedAt > 0)
+	if assert.Equal(t, 4, len(status.Jobs)) {
+		assert.Equal(t, "fetch", status.Jobs[0].JobName)
+		assert.Equal(t, []string{resize.ID, thumb.ID}, status.Jobs[3].DependsOn)
+		for _, j := range status.Jobs {
+			assert.Equal(t, WorkflowJobSucceeded, j.State)
+		}
+	}
+
+	workflows, count, err := client.Workflows(1)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+	assert.Equal(t, 1, len(workflows))
+	assert.Equal(t, wf.ID, workflows[0].ID)
+
+	_, err = client.WorkflowStatus("nope")
+	assert.Equal(t, ErrWorkflowNotFound, err)
+}
+
+func TestWorkflowFailureCancelsDependents(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	wf := enqueuer.NewWorkflow()
+	fetch, err := wf.Add("fetch", nil)
+	assert.NoError(t, err)
+	other, err := wf.Add("other", nil)
+	assert.NoError(t, err)
+	resize, err := wf.Add("resize", nil, fetch)
+	assert.NoError(t, err)
+	_, err = wf.Add("publish", nil, resize, other)
+	assert.NoError(t, err)
+	assert.NoError(t, wf.Enqueue())
+
+	wp := NewWorkerPool(TestContext{}, 2, ns, pool)
+	wp.JobWithOptions("fetch", JobOptions{MaxFails: 1}, func(job *Job) error {
+		return fmt.Errorf("sorry kid")
+	})
+	wp.Job("other", func(job *Job) error {
+		return nil
+	})
+	wp.Start()
+	wp.Drain()
+	wp.Stop()
+
+	client := NewClient(ns, pool)
+	status, err := client.WorkflowStatus(wf.ID)
+	assert.NoError(t, err)
+	assert.True(t, status.CompletedAt > 0)
+	states := make(map[string]string)
+	for _, j := range status.Jobs {
+		states[j.JobName] = j.State
+	}
+	assert.Equal(t, map[string]string{
+		"fetch":   WorkflowJobFailed,
+		"other":   WorkflowJobSucceeded,
+		"resize":  WorkflowJobCancelled,
+		"publish": WorkflowJobCancelled,
+	}, states)
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "resize")))
+	assert.EqualValues(t, 0, listSize(pool, redisKeyJobs(ns, "publish")))
+}
+
+func TestWorkflowAddUnknownDependency(t *testing.T) {
+	enqueuer := NewEnqueuer("work", newTestPool(":6379"))
+	stranger, err := enqueuer.NewWorkflow().Add("fetch", nil)
+	assert.NoError(t, err)
+
+	_, err = enqueuer.NewWorkflow().Add("resize", nil, stranger)
+	assert.Error(t, err)
+}
+
+func TestWorkflowEnqueueEmpty(t *testing.T) {
+	pool := newTestPool(":6379")
+	ns := "work"
+	cleanKeyspace(ns, pool)
+
+	enqueuer := NewEnqueuer(ns, pool)
+	wf := enqueuer.NewWorkflow()
+	assert.Equal(t, ErrWorkflowEmpty, wf.Enqueue())
+	assert.EqualValues(t, 0, zsetSize(pool, redisKeyWorkflows(ns)))
+
+	_, err := NewClient(ns, pool).WorkflowStatus(wf.ID)
+	assert.Equal(t, ErrWorkflowNotFound, err)
+}